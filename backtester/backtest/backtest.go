@@ -44,6 +44,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 	"github.com/thrasher-corp/gocryptotrader/log"
 )
 
@@ -338,7 +339,7 @@ func (bt *BackTest) setupExchangeSettings(cfg *config.Config) (exchange.Exchange
 		}
 		if makerFee.IsZero() || takerFee.IsZero() {
 			var apiMakerFee, apiTakerFee decimal.Decimal
-			apiMakerFee, apiTakerFee = getFees(context.TODO(), exch, pair)
+			apiMakerFee, apiTakerFee = getFees(context.TODO(), exch, pair, a)
 			if makerFee.IsZero() {
 				makerFee = apiMakerFee
 			}
@@ -485,8 +486,25 @@ func (bt *BackTest) setupBot(cfg *config.Config, bot *engine.Engine) error {
 	return nil
 }
 
-// getFees will return an exchange's fee rate from GCT's wrapper function
-func getFees(ctx context.Context, exch gctexchange.IBotExchange, fPair currency.Pair) (makerFee, takerFee decimal.Decimal) {
+// getFees will return an exchange's fee rate from its fee schedule. The
+// schedule is loaded from the account when authenticated requests are allowed,
+// otherwise GCT's offline fee wrapper function is used
+func getFees(ctx context.Context, exch gctexchange.IBotExchange, fPair currency.Pair, a asset.Item) (makerFee, takerFee decimal.Decimal) {
+	if b := exch.GetBase(); b != nil {
+		if !b.Fees.IsLoaded() &&
+			exch.GetAuthenticatedAPISupport(gctexchange.RestAuthentication) &&
+			b.AllowAuthenticatedRequest() {
+			err := exch.UpdateFeeSchedule(ctx)
+			if err != nil && !errors.Is(err, gctcommon.ErrNotYetImplemented) {
+				log.Errorf(log.BackTester, "Could not retrieve fee schedule for %v. %v", exch.GetName(), err)
+			}
+		}
+		c, err := b.Fees.GetCommission(a, fPair)
+		if err == nil {
+			return decimal.NewFromFloat(c.Maker), decimal.NewFromFloat(c.Taker)
+		}
+	}
+
 	fTakerFee, err := exch.GetFeeByType(ctx,
 		&gctexchange.FeeBuilder{FeeType: gctexchange.OfflineTradeFee,
 			Pair:          fPair,
@@ -547,13 +565,16 @@ func (bt *BackTest) loadData(cfg *config.Config, exch gctexchange.IBotExchange,
 		if cfg.DataSettings.Interval <= 0 {
 			return nil, errIntervalUnset
 		}
+		barType, barThreshold := barSettings(cfg)
 		resp, err = csv.LoadData(
 			dataType,
 			cfg.DataSettings.CSVData.FullPath,
 			strings.ToLower(exch.GetName()),
 			cfg.DataSettings.Interval,
 			fPair,
-			a)
+			a,
+			barType,
+			barThreshold)
 		if err != nil {
 			return nil, fmt.Errorf("%v. Please check your GoCryptoTrader configuration", err)
 		}
@@ -658,7 +679,7 @@ func (bt *BackTest) loadData(cfg *config.Config, exch gctexchange.IBotExchange,
 
 	err = b.ValidateKline(fPair, a, resp.Item.Interval)
 	if err != nil {
-		if dataType != common.DataTrade || !strings.EqualFold(err.Error(), "interval not supported") {
+		if (dataType != common.DataTrade && dataType != common.DataBar) || !strings.EqualFold(err.Error(), "interval not supported") {
 			return nil, err
 		}
 	}
@@ -679,6 +700,7 @@ func loadDatabaseData(cfg *config.Config, name string, fPair currency.Pair, a as
 		return nil, errIntervalUnset
 	}
 
+	barType, barThreshold := barSettings(cfg)
 	return database.LoadData(
 		cfg.DataSettings.DatabaseData.StartDate,
 		cfg.DataSettings.DatabaseData.EndDate,
@@ -686,7 +708,17 @@ func loadDatabaseData(cfg *config.Config, name string, fPair currency.Pair, a as
 		strings.ToLower(name),
 		dataType,
 		fPair,
-		a)
+		a,
+		barType,
+		barThreshold)
+}
+
+// barSettings returns the bar type and threshold used by the bar data type
+func barSettings(cfg *config.Config) (trade.BarType, float64) {
+	if cfg.DataSettings.BarSettings == nil {
+		return "", 0
+	}
+	return trade.BarType(strings.ToLower(cfg.DataSettings.BarSettings.Type)), cfg.DataSettings.BarSettings.Threshold
 }
 
 func loadAPIData(cfg *config.Config, exch gctexchange.IBotExchange, fPair currency.Pair, a asset.Item, resultLimit uint32, dataType int64) (*kline.DataFromKline, error) {
@@ -701,6 +733,7 @@ func loadAPIData(cfg *config.Config, exch gctexchange.IBotExchange, fPair curren
 	if err != nil {
 		return nil, err
 	}
+	barType, barThreshold := barSettings(cfg)
 	candles, err := api.LoadData(context.TODO(),
 		dataType,
 		cfg.DataSettings.APIData.StartDate,
@@ -708,7 +741,9 @@ func loadAPIData(cfg *config.Config, exch gctexchange.IBotExchange, fPair curren
 		cfg.DataSettings.Interval,
 		exch,
 		fPair,
-		a)
+		a,
+		barType,
+		barThreshold)
 	if err != nil {
 		return nil, fmt.Errorf("%v. Please check your GoCryptoTrader configuration", err)
 	}
@@ -717,7 +752,10 @@ func loadAPIData(cfg *config.Config, exch gctexchange.IBotExchange, fPair curren
 	if len(summary) > 0 {
 		log.Warnf(log.BackTester, "%v", summary)
 	}
-	candles.FillMissingDataWithEmptyEntries(dates)
+	// bars are not bound to time, so periods without a bar are not filled
+	if dataType != common.DataBar {
+		candles.FillMissingDataWithEmptyEntries(dates)
+	}
 	candles.RemoveOutsideRange(cfg.DataSettings.APIData.StartDate, cfg.DataSettings.APIData.EndDate)
 	return &kline.DataFromKline{
 		Item:        *candles,
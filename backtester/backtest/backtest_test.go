@@ -1,6 +1,7 @@
 package backtest
 
 import (
+	"context"
 	"errors"
 	"log"
 	"os"
@@ -35,6 +36,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/engine"
 	gctexchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fee"
 	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 )
 
@@ -226,6 +228,39 @@ func TestLoadDataAPI(t *testing.T) {
 	}
 }
 
+func TestGetFees(t *testing.T) {
+	t.Parallel()
+	em := engine.ExchangeManager{}
+	exch, err := em.NewExchangeByName("Binance")
+	if err != nil {
+		t.Fatal(err)
+	}
+	exch.SetDefaults()
+	b := exch.GetBase()
+	b.Fees = fee.NewDefinitions()
+	cp := currency.NewPair(currency.BTC, currency.USDT)
+
+	// Without a loaded schedule the offline fee rate is used
+	maker, taker := getFees(context.Background(), exch, cp, asset.Spot)
+	if !maker.Equal(decimal.NewFromFloat(0.002)) || !taker.Equal(decimal.NewFromFloat(0.002)) {
+		t.Errorf("received maker '%v' taker '%v' expected '%v'", maker, taker, 0.002)
+	}
+
+	err = b.Fees.Load(&fee.Schedule{
+		Commissions: map[asset.Item]fee.Commission{asset.Spot: {Maker: 0.0009, Taker: 0.001}},
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	maker, taker = getFees(context.Background(), exch, cp, asset.Spot)
+	if !maker.Equal(decimal.NewFromFloat(0.0009)) {
+		t.Errorf("received '%v' expected '%v'", maker, 0.0009)
+	}
+	if !taker.Equal(decimal.NewFromFloat(0.001)) {
+		t.Errorf("received '%v' expected '%v'", taker, 0.001)
+	}
+}
+
 func TestLoadDataDatabase(t *testing.T) {
 	t.Parallel()
 	bt := BackTest{
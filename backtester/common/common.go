@@ -9,6 +9,8 @@ func DataTypeToInt(dataType string) (int64, error) {
 		return DataCandle, nil
 	case TradeStr:
 		return DataTrade, nil
+	case BarStr:
+		return DataBar, nil
 	default:
 		return 0, fmt.Errorf("unrecognised dataType '%v'", dataType)
 	}
@@ -30,12 +30,16 @@ const (
 	CandleStr = "candle"
 	// TradeStr is a config readable data type to tell the backtester to retrieve trade data
 	TradeStr = "trade"
+	// BarStr is a config readable data type to tell the backtester to retrieve trade data
+	// and sample it into information driven bars
+	BarStr = "bar"
 )
 
 // DataCandle is an int64 representation of a candle data type
 const (
 	DataCandle = iota
 	DataTrade
+	DataBar
 )
 
 var (
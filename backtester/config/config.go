@@ -8,10 +8,12 @@ import (
 	"strings"
 
 	"github.com/shopspring/decimal"
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies"
 	"github.com/thrasher-corp/gocryptotrader/backtester/eventhandlers/strategies/base"
 	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/common/file"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 	"github.com/thrasher-corp/gocryptotrader/log"
 )
 
@@ -137,6 +139,10 @@ func (c *Config) PrintSetting() {
 		log.Infof(log.BackTester, "Start date: %v", c.DataSettings.DatabaseData.StartDate.Format(gctcommon.SimpleTimeFormat))
 		log.Infof(log.BackTester, "End date: %v", c.DataSettings.DatabaseData.EndDate.Format(gctcommon.SimpleTimeFormat))
 	}
+	if c.DataSettings.BarSettings != nil {
+		log.Infof(log.BackTester, "Bar type: %v", c.DataSettings.BarSettings.Type)
+		log.Infof(log.BackTester, "Bar threshold: %v", c.DataSettings.BarSettings.Threshold)
+	}
 	log.Info(log.BackTester, "-------------------------------------------------------------\n\n")
 }
 
@@ -150,6 +156,10 @@ func (c *Config) Validate() error {
 	if err != nil {
 		return err
 	}
+	err = c.validateBarSettings()
+	if err != nil {
+		return err
+	}
 	err = c.validateCurrencySettings()
 	if err != nil {
 		return err
@@ -262,6 +272,28 @@ func (c *Config) validateDate() error {
 	return nil
 }
 
+// validateBarSettings ensures bar settings are present and usable when
+// the bar data type is selected
+func (c *Config) validateBarSettings() error {
+	if c.DataSettings.DataType != common.BarStr {
+		return nil
+	}
+	if c.DataSettings.BarSettings == nil {
+		return errBarSettingsUnset
+	}
+	if c.DataSettings.LiveData != nil {
+		return errBarLiveDataUnsupported
+	}
+	barType := trade.BarType(strings.ToLower(c.DataSettings.BarSettings.Type))
+	if !barType.Valid() {
+		return fmt.Errorf("%w '%v'", trade.ErrInvalidBarType, c.DataSettings.BarSettings.Type)
+	}
+	if c.DataSettings.BarSettings.Threshold <= 0 {
+		return trade.ErrInvalidBarThreshold
+	}
+	return nil
+}
+
 // validateCurrencySettings checks whether someone has set invalid currency setting data in their config
 func (c *Config) validateCurrencySettings() error {
 	if len(c.CurrencySettings) == 0 {
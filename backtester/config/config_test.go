@@ -18,6 +18,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/database/drivers"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 )
 
 const (
@@ -1001,6 +1002,41 @@ func TestValidateDate(t *testing.T) {
 	}
 }
 
+func TestValidateBarSettings(t *testing.T) {
+	t.Parallel()
+	c := Config{}
+	err := c.validateBarSettings()
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+	c.DataSettings.DataType = common.BarStr
+	err = c.validateBarSettings()
+	if !errors.Is(err, errBarSettingsUnset) {
+		t.Errorf("received: %v, expected: %v", err, errBarSettingsUnset)
+	}
+	c.DataSettings.BarSettings = &BarSettings{Type: "renko"}
+	c.DataSettings.LiveData = &LiveData{}
+	err = c.validateBarSettings()
+	if !errors.Is(err, errBarLiveDataUnsupported) {
+		t.Errorf("received: %v, expected: %v", err, errBarLiveDataUnsupported)
+	}
+	c.DataSettings.LiveData = nil
+	err = c.validateBarSettings()
+	if !errors.Is(err, trade.ErrInvalidBarType) {
+		t.Errorf("received: %v, expected: %v", err, trade.ErrInvalidBarType)
+	}
+	c.DataSettings.BarSettings.Type = string(trade.DollarBar)
+	err = c.validateBarSettings()
+	if !errors.Is(err, trade.ErrInvalidBarThreshold) {
+		t.Errorf("received: %v, expected: %v", err, trade.ErrInvalidBarThreshold)
+	}
+	c.DataSettings.BarSettings.Threshold = 1000000
+	err = c.validateBarSettings()
+	if !errors.Is(err, nil) {
+		t.Errorf("received: %v, expected: %v", err, nil)
+	}
+}
+
 func TestValidateCurrencySettings(t *testing.T) {
 	c := Config{}
 	err := c.validateCurrencySettings()
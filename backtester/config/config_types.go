@@ -24,6 +24,8 @@ var (
 	errSizeLessThanZero                 = errors.New("size less than zero")
 	errMaxSizeMinSizeMismatch           = errors.New("maximum size must be greater to minimum size")
 	errMinMaxEqual                      = errors.New("minimum and maximum limits cannot be equal")
+	errBarSettingsUnset                 = errors.New("bar data type requires bar settings, please check your config")
+	errBarLiveDataUnsupported           = errors.New("bar data type is not supported for live data")
 )
 
 // Config defines what is in an individual strategy config
@@ -47,6 +49,16 @@ type DataSettings struct {
 	DatabaseData *DatabaseData `json:"database-data,omitempty"`
 	LiveData     *LiveData     `json:"live-data,omitempty"`
 	CSVData      *CSVData      `json:"csv-data,omitempty"`
+	BarSettings  *BarSettings  `json:"bar-settings,omitempty"`
+}
+
+// BarSettings defines how trades are sampled into bars when using the bar
+// data type. Type is one of tick, volume, dollar or imbalance and a bar closes
+// once its measure reaches the threshold. Bars are not bound to time, the
+// data settings interval is only used to report gaps in the data
+type BarSettings struct {
+	Type      string  `json:"type"`
+	Threshold float64 `json:"threshold"`
 }
 
 // StrategySettings contains what strategy to load, along with custom settings map
@@ -20,6 +20,7 @@ import (
 	gctcommon "github.com/thrasher-corp/gocryptotrader/common"
 	gctconfig "github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/database"
+	dbMySQL "github.com/thrasher-corp/gocryptotrader/database/drivers/mysql"
 	dbPSQL "github.com/thrasher-corp/gocryptotrader/database/drivers/postgres"
 	dbsqlite3 "github.com/thrasher-corp/gocryptotrader/database/drivers/sqlite3"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
@@ -182,10 +183,17 @@ func parseStatisticsSettings(cfg *config.Config, reader *bufio.Reader) error {
 
 func parseDataSettings(cfg *config.Config, reader *bufio.Reader) error {
 	var err error
-	fmt.Println("Will you be using \"candle\" or \"trade\" data?")
+	fmt.Println("Will you be using \"candle\", \"trade\" or \"bar\" data?")
 	cfg.DataSettings.DataType = quickParse(reader)
-	if cfg.DataSettings.DataType == common.TradeStr {
+	switch cfg.DataSettings.DataType {
+	case common.TradeStr:
 		fmt.Println("Trade data will be converted into candles")
+	case common.BarStr:
+		fmt.Println("Trade data will be sampled into bars")
+		err = parseBarSettings(cfg, reader)
+		if err != nil {
+			return err
+		}
 	}
 	fmt.Println("What candle time interval will you use?")
 	cfg.DataSettings.Interval, err = parseKlineInterval(reader)
@@ -195,7 +203,7 @@ func parseDataSettings(cfg *config.Config, reader *bufio.Reader) error {
 
 	fmt.Println("Where will this data be sourced?")
 	var choice string
-	choice, err = parseDataChoice(reader, len(cfg.CurrencySettings) > 1)
+	choice, err = parseDataChoice(reader, len(cfg.CurrencySettings) > 1 || cfg.DataSettings.DataType == common.BarStr)
 	if err != nil {
 		return err
 	}
@@ -422,7 +430,7 @@ func parseDatabase(reader *bufio.Reader, cfg *config.Config) error {
 		input = quickParse(reader)
 		cfg.DataSettings.DatabaseData.ConfigOverride.Verbose = input == y || input == yes
 
-		fmt.Printf("What database driver to use? %v %v or %v\n", database.DBPostgreSQL, database.DBSQLite, database.DBSQLite3)
+		fmt.Printf("What database driver to use? %v %v %v or %v\n", database.DBPostgreSQL, database.DBMySQL, database.DBSQLite, database.DBSQLite3)
 		cfg.DataSettings.DatabaseData.ConfigOverride.Driver = quickParse(reader)
 
 		fmt.Println("What is the database host?")
@@ -437,7 +445,8 @@ func parseDatabase(reader *bufio.Reader, cfg *config.Config) error {
 		fmt.Println("What is the database? eg database.db")
 		cfg.DataSettings.DatabaseData.ConfigOverride.Database = quickParse(reader)
 
-		if cfg.DataSettings.DatabaseData.ConfigOverride.Driver == database.DBPostgreSQL {
+		if cfg.DataSettings.DatabaseData.ConfigOverride.Driver == database.DBPostgreSQL ||
+			cfg.DataSettings.DatabaseData.ConfigOverride.Driver == database.DBMySQL {
 			fmt.Println("What is the database SSLMode? eg disable")
 			cfg.DataSettings.DatabaseData.ConfigOverride.SSLMode = quickParse(reader)
 		}
@@ -460,6 +469,11 @@ func parseDatabase(reader *bufio.Reader, cfg *config.Config) error {
 			if err != nil {
 				return fmt.Errorf("database failed to connect: %v", err)
 			}
+		} else if cfg.DataSettings.DatabaseData.ConfigOverride.Driver == database.DBMySQL {
+			_, err = dbMySQL.Connect(cfg.DataSettings.DatabaseData.ConfigOverride)
+			if err != nil {
+				return fmt.Errorf("database failed to connect: %v", err)
+			}
 		} else if cfg.DataSettings.DatabaseData.ConfigOverride.Driver == database.DBSQLite ||
 			cfg.DataSettings.DatabaseData.ConfigOverride.Driver == database.DBSQLite3 {
 			_, err = dbsqlite3.Connect(cfg.DataSettings.DatabaseData.ConfigOverride.Database)
@@ -495,9 +509,9 @@ func parseLive(reader *bufio.Reader, cfg *config.Config) {
 	}
 }
 
-func parseDataChoice(reader *bufio.Reader, multiCurrency bool) (string, error) {
-	if multiCurrency {
-		// live trading does not support multiple currencies
+func parseDataChoice(reader *bufio.Reader, liveUnsupported bool) (string, error) {
+	if liveUnsupported {
+		// live trading does not support multiple currencies or bars
 		dataOptions = dataOptions[:3]
 	}
 	for i := range dataOptions {
@@ -520,6 +534,22 @@ func parseDataChoice(reader *bufio.Reader, multiCurrency bool) (string, error) {
 	return "", errors.New("unrecognised data option")
 }
 
+func parseBarSettings(cfg *config.Config, reader *bufio.Reader) error {
+	fmt.Println("Will you be using \"tick\", \"volume\", \"dollar\" or \"imbalance\" bars?")
+	barType := quickParse(reader)
+	fmt.Println("What threshold will close a bar? eg 1000000 for dollar bars of $1,000,000")
+	threshold, err := strconv.ParseFloat(quickParse(reader), 64)
+	if err != nil {
+		return err
+	}
+	cfg.DataSettings.BarSettings = &config.BarSettings{
+		Type:      barType,
+		Threshold: threshold,
+	}
+	fmt.Println("Bars are not bound to time, the candle interval will be used to report gaps in the data")
+	return nil
+}
+
 func parseKlineInterval(reader *bufio.Reader) (time.Duration, error) {
 	allCandles := gctkline.SupportedIntervals
 	for i := range allCandles {
@@ -15,7 +15,8 @@ import (
 )
 
 // LoadData retrieves data from a GoCryptoTrader exchange wrapper which calls the exchange's API
-func LoadData(ctx context.Context, dataType int64, startDate, endDate time.Time, interval time.Duration, exch exchange.IBotExchange, fPair currency.Pair, a asset.Item) (*kline.Item, error) {
+// bar type and threshold are only used by the bar data type
+func LoadData(ctx context.Context, dataType int64, startDate, endDate time.Time, interval time.Duration, exch exchange.IBotExchange, fPair currency.Pair, a asset.Item, barType trade.BarType, barThreshold float64) (*kline.Item, error) {
 	var candles kline.Item
 	var err error
 	switch dataType {
@@ -29,7 +30,7 @@ func LoadData(ctx context.Context, dataType int64, startDate, endDate time.Time,
 		if err != nil {
 			return nil, fmt.Errorf("could not retrieve candle data for %v %v %v, %v", exch.GetName(), a, fPair, err)
 		}
-	case common.DataTrade:
+	case common.DataTrade, common.DataBar:
 		var trades []trade.Data
 		trades, err = exch.GetHistoricTrades(ctx,
 			fPair,
@@ -40,6 +41,19 @@ func LoadData(ctx context.Context, dataType int64, startDate, endDate time.Time,
 			return nil, fmt.Errorf("could not retrieve trade data for %v %v %v, %v", exch.GetName(), a, fPair, err)
 		}
 
+		if dataType == common.DataBar {
+			var bars []trade.Bar
+			bars, err = trade.ConvertTradesToBars(barType, barThreshold, trades...)
+			if err != nil {
+				return nil, fmt.Errorf("could not convert trade data to bars for %v %v %v, %v", exch.GetName(), a, fPair, err)
+			}
+			candles, err = trade.ConvertBarsToCandles(kline.Interval(interval), bars...)
+			if err != nil {
+				return nil, fmt.Errorf("could not convert trade data to bars for %v %v %v, %v", exch.GetName(), a, fPair, err)
+			}
+			break
+		}
+
 		candles, err = trade.ConvertTradesToCandles(kline.Interval(interval), trades...)
 		if err != nil {
 			return nil, fmt.Errorf("could not convert trade data to candles for %v %v %v, %v", exch.GetName(), a, fPair, err)
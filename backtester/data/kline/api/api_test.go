@@ -39,7 +39,7 @@ func TestLoadCandles(t *testing.T) {
 	a := asset.Spot
 	var data *gctkline.Item
 	data, err = LoadData(context.Background(),
-		common.DataCandle, tt1, tt2, interval.Duration(), exch, cp, a)
+		common.DataCandle, tt1, tt2, interval.Duration(), exch, cp, a, "", 0)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -48,7 +48,7 @@ func TestLoadCandles(t *testing.T) {
 	}
 
 	_, err = LoadData(context.Background(),
-		-1, tt1, tt2, interval.Duration(), exch, cp, a)
+		-1, tt1, tt2, interval.Duration(), exch, cp, a, "", 0)
 	if !errors.Is(err, common.ErrInvalidDataType) {
 		t.Errorf("received: %v, expected: %v", err, common.ErrInvalidDataType)
 	}
@@ -77,7 +77,7 @@ func TestLoadTrades(t *testing.T) {
 	a := asset.Spot
 	var data *gctkline.Item
 	data, err = LoadData(context.Background(),
-		common.DataTrade, tt1, tt2, interval.Duration(), exch, cp, a)
+		common.DataTrade, tt1, tt2, interval.Duration(), exch, cp, a, "", 0)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -20,7 +20,8 @@ import (
 )
 
 // LoadData is a basic csv reader which converts the found CSV file into a kline item
-func LoadData(dataType int64, filepath, exchangeName string, interval time.Duration, fPair currency.Pair, a asset.Item) (*gctkline.DataFromKline, error) {
+// bar type and threshold are only used by the bar data type
+func LoadData(dataType int64, filepath, exchangeName string, interval time.Duration, fPair currency.Pair, a asset.Item, barType trade.BarType, barThreshold float64) (*gctkline.DataFromKline, error) {
 	resp := &gctkline.DataFromKline{}
 	csvFile, err := os.Open(filepath)
 	if err != nil {
@@ -102,7 +103,7 @@ func LoadData(dataType int64, filepath, exchangeName string, interval time.Durat
 		}
 
 		resp.Item = candles
-	case common.DataTrade:
+	case common.DataTrade, common.DataBar:
 		var trades []trade.Data
 		for {
 			row, errCSV := csvData.Read()
@@ -144,6 +145,18 @@ func LoadData(dataType int64, filepath, exchangeName string, interval time.Durat
 
 			trades = append(trades, t)
 		}
+		if dataType == common.DataBar {
+			var bars []trade.Bar
+			bars, err = trade.ConvertTradesToBars(barType, barThreshold, trades...)
+			if err != nil {
+				return nil, fmt.Errorf("could not convert csv trade data to bars for %v %v %v, %v", exchangeName, a, fPair, err)
+			}
+			resp.Item, err = trade.ConvertBarsToCandles(kline.Interval(interval), bars...)
+			if err != nil {
+				return nil, fmt.Errorf("could not convert csv trade data to bars for %v %v %v, %v", exchangeName, a, fPair, err)
+			}
+			break
+		}
 		resp.Item, err = trade.ConvertTradesToCandles(kline.Interval(interval), trades...)
 		if err != nil {
 			return nil, fmt.Errorf("could not read csv trade data for %v %v %v, %v", exchangeName, a, fPair, err)
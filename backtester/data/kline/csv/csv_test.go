@@ -9,6 +9,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 )
 
 const testExchange = "binance"
@@ -23,7 +24,9 @@ func TestLoadDataCandles(t *testing.T) {
 		exch,
 		gctkline.FifteenMin.Duration(),
 		p,
-		a)
+		a,
+		"",
+		0)
 	if err != nil {
 		t.Error(err)
 	}
@@ -39,12 +42,35 @@ func TestLoadDataTrades(t *testing.T) {
 		exch,
 		gctkline.FifteenMin.Duration(),
 		p,
-		a)
+		a,
+		"",
+		0)
 	if err != nil {
 		t.Error(err)
 	}
 }
 
+func TestLoadDataBars(t *testing.T) {
+	exch := testExchange
+	a := asset.Spot
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	data, err := LoadData(
+		common.DataBar,
+		filepath.Join("..", "..", "..", "..", "testdata", "binance_BTCUSDT_24h-trades_2020_11_16.csv"),
+		exch,
+		gctkline.FifteenMin.Duration(),
+		p,
+		a,
+		trade.TickBar,
+		100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(data.Item.Candles) == 0 {
+		t.Error("expected bars")
+	}
+}
+
 func TestLoadDataInvalid(t *testing.T) {
 	exch := testExchange
 	a := asset.Spot
@@ -55,7 +81,9 @@ func TestLoadDataInvalid(t *testing.T) {
 		exch,
 		gctkline.FifteenMin.Duration(),
 		p,
-		a)
+		a,
+		"",
+		0)
 	if !errors.Is(err, common.ErrInvalidDataType) {
 		t.Errorf("received: %v, expected: %v", err, common.ErrInvalidDataType)
 	}
@@ -15,7 +15,8 @@ import (
 )
 
 // LoadData retrieves data from an existing database using GoCryptoTrader's database handling implementation
-func LoadData(startDate, endDate time.Time, interval time.Duration, exchangeName string, dataType int64, fPair currency.Pair, a asset.Item) (*kline.DataFromKline, error) {
+// bar type and threshold are only used by the bar data type
+func LoadData(startDate, endDate time.Time, interval time.Duration, exchangeName string, dataType int64, fPair currency.Pair, a asset.Item, barType trade.BarType, barThreshold float64) (*kline.DataFromKline, error) {
 	resp := &kline.DataFromKline{}
 	switch dataType {
 	case common.DataCandle:
@@ -35,7 +36,7 @@ func LoadData(startDate, endDate time.Time, interval time.Duration, exchangeName
 				log.Warnf(log.BackTester, "candle validation issue for %v %v %v: %v", klineItem.Exchange, klineItem.Asset, klineItem.Pair, klineItem.Candles[i].ValidationIssues)
 			}
 		}
-	case common.DataTrade:
+	case common.DataTrade, common.DataBar:
 		trades, err := trade.GetTradesInRange(
 			exchangeName,
 			a.String(),
@@ -46,6 +47,18 @@ func LoadData(startDate, endDate time.Time, interval time.Duration, exchangeName
 		if err != nil {
 			return nil, err
 		}
+		if dataType == common.DataBar {
+			var bars []trade.Bar
+			bars, err = trade.ConvertTradesToBars(barType, barThreshold, trades...)
+			if err != nil {
+				return nil, fmt.Errorf("could not convert database trade data to bars for %v %v %v, %v", exchangeName, a, fPair, err)
+			}
+			resp.Item, err = trade.ConvertBarsToCandles(gctkline.Interval(interval), bars...)
+			if err != nil {
+				return nil, fmt.Errorf("could not convert database trade data to bars for %v %v %v, %v", exchangeName, a, fPair, err)
+			}
+			break
+		}
 		klineItem, err := trade.ConvertTradesToCandles(
 			gctkline.Interval(interval),
 			trades...)
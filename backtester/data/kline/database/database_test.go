@@ -21,6 +21,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	gctkline "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	gctorder "github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	gcttrade "github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 )
 
 const (
@@ -125,7 +126,7 @@ func TestLoadDataCandles(t *testing.T) {
 		t.Error(err)
 	}
 
-	_, err = LoadData(dStart, dEnd, gctkline.FifteenMin.Duration(), exch, common.DataCandle, p, a)
+	_, err = LoadData(dStart, dEnd, gctkline.FifteenMin.Duration(), exch, common.DataCandle, p, a, "", 0)
 	if err != nil {
 		t.Error(err)
 	}
@@ -198,10 +199,18 @@ func TestLoadDataTrades(t *testing.T) {
 		t.Error(err)
 	}
 
-	_, err = LoadData(dStart, dEnd, gctkline.FifteenMin.Duration(), exch, common.DataTrade, p, a)
+	_, err = LoadData(dStart, dEnd, gctkline.FifteenMin.Duration(), exch, common.DataTrade, p, a, "", 0)
 	if err != nil {
 		t.Error(err)
 	}
+
+	data, err := LoadData(dStart, dEnd, gctkline.FifteenMin.Duration(), exch, common.DataBar, p, a, gcttrade.TickBar, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(data.Item.Candles) != 1 {
+		t.Errorf("received: %v, expected: %v", len(data.Item.Candles), 1)
+	}
 }
 
 func TestLoadDataInvalid(t *testing.T) {
@@ -210,7 +219,7 @@ func TestLoadDataInvalid(t *testing.T) {
 	p := currency.NewPair(currency.BTC, currency.USDT)
 	dStart := time.Date(2020, 1, 0, 0, 0, 0, 0, time.UTC)
 	dEnd := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
-	_, err := LoadData(dStart, dEnd, gctkline.FifteenMin.Duration(), exch, -1, p, a)
+	_, err := LoadData(dStart, dEnd, gctkline.FifteenMin.Duration(), exch, -1, p, a, "", 0)
 	if !errors.Is(err, common.ErrInvalidDataType) {
 		t.Errorf("received: %v, expected: %v", err, common.ErrInvalidDataType)
 	}
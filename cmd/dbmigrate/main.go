@@ -11,6 +11,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/core"
 	"github.com/thrasher-corp/gocryptotrader/database"
+	dbMySQL "github.com/thrasher-corp/gocryptotrader/database/drivers/mysql"
 	dbPSQL "github.com/thrasher-corp/gocryptotrader/database/drivers/postgres"
 	dbsqlite3 "github.com/thrasher-corp/gocryptotrader/database/drivers/sqlite3"
 	"github.com/thrasher-corp/gocryptotrader/database/repository"
@@ -33,6 +34,12 @@ func openDBConnection(cfg *database.Config) (err error) {
 			return fmt.Errorf("database failed to connect: %v, some features that utilise a database will be unavailable", err)
 		}
 		return nil
+	} else if cfg.Driver == database.DBMySQL {
+		dbConn, err = dbMySQL.Connect(cfg)
+		if err != nil {
+			return fmt.Errorf("database failed to connect: %v, some features that utilise a database will be unavailable", err)
+		}
+		return nil
 	} else if cfg.Driver == database.DBSQLite || cfg.Driver == database.DBSQLite3 {
 		dbConn, err = dbsqlite3.Connect(cfg.Database)
 		if err != nil {
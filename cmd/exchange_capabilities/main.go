@@ -0,0 +1,423 @@
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+const (
+	interfaceFile = "interfaces.go"
+	interfaceName = "IBotExchange"
+	baseType      = "Base"
+	// exchangesImport is the import path of the exchanges package, types
+	// embedded from packages beneath it are resolved relative to -path
+	exchangesImport = "github.com/thrasher-corp/gocryptotrader/exchanges"
+)
+
+// stubErrors are the errors returned by wrapper methods which have not been
+// implemented for an exchange
+var stubErrors = map[string]bool{
+	"ErrNotYetImplemented":    true,
+	"ErrFunctionNotSupported": true,
+}
+
+// methodSet maps a method name to whether it is a stub
+type methodSet map[string]bool
+
+func main() {
+	var path, out string
+	flag.StringVar(&path, "path", filepath.Join("..", "..", "exchanges"), "path to the exchanges package")
+	flag.StringVar(&out, "out", "capabilities_generated.go", "output file, relative to the exchanges package")
+	flag.Parse()
+
+	src, err := generate(path)
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = os.WriteFile(filepath.Join(path, out), src, 0o600)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
+
+// generate returns the formatted source listing the unsupported methods of
+// each exchange beneath path
+func generate(path string) ([]byte, error) {
+	methods, err := interfaceMethods(path)
+	if err != nil {
+		return nil, err
+	}
+
+	base, err := packageMethods(path, path, baseType)
+	if err != nil {
+		return nil, err
+	}
+
+	unsupported := make(map[string][]string)
+	dirs, err := os.ReadDir(path)
+	if err != nil {
+		return nil, err
+	}
+	for x := range dirs {
+		if !dirs[x].IsDir() {
+			continue
+		}
+		dir := filepath.Join(path, dirs[x].Name())
+		wrappers, err := filepath.Glob(filepath.Join(dir, "*_wrapper.go"))
+		if err != nil {
+			return nil, err
+		}
+		if len(wrappers) == 0 {
+			continue
+		}
+		name, typeName, err := exchangeName(dir)
+		if err != nil {
+			return nil, err
+		}
+		if name == "" {
+			continue
+		}
+		exch, err := packageMethods(path, dir, typeName)
+		if err != nil {
+			return nil, err
+		}
+		var missing []string
+		for i := range methods {
+			stub, ok := exch[methods[i]]
+			if !ok {
+				stub, ok = base[methods[i]]
+			}
+			if !ok || stub {
+				missing = append(missing, methods[i])
+			}
+		}
+		unsupported[strings.ToLower(name)] = missing
+	}
+	return render(unsupported)
+}
+
+// interfaceMethods returns the sorted method names of IBotExchange, including
+// methods of the interfaces it embeds
+func interfaceMethods(path string) ([]string, error) {
+	f, err := parser.ParseFile(token.NewFileSet(), filepath.Join(path, interfaceFile), nil, 0)
+	if err != nil {
+		return nil, err
+	}
+	interfaces := make(map[string]*ast.InterfaceType)
+	ast.Inspect(f, func(n ast.Node) bool {
+		if ts, ok := n.(*ast.TypeSpec); ok {
+			if it, ok := ts.Type.(*ast.InterfaceType); ok {
+				interfaces[ts.Name.Name] = it
+			}
+		}
+		return true
+	})
+
+	var methods []string
+	var collect func(name string) error
+	collect = func(name string) error {
+		it, ok := interfaces[name]
+		if !ok {
+			return fmt.Errorf("interface %s not found in %s", name, interfaceFile)
+		}
+		for _, field := range it.Methods.List {
+			if len(field.Names) == 0 {
+				ident, ok := field.Type.(*ast.Ident)
+				if !ok {
+					return fmt.Errorf("unsupported embedded interface in %s", name)
+				}
+				if err := collect(ident.Name); err != nil {
+					return err
+				}
+				continue
+			}
+			for i := range field.Names {
+				methods = append(methods, field.Names[i].Name)
+			}
+		}
+		return nil
+	}
+	if err := collect(interfaceName); err != nil {
+		return nil, err
+	}
+	sort.Strings(methods)
+	return methods, nil
+}
+
+// parseDir parses the non test files of a package
+func parseDir(dir string) ([]*ast.File, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*.go"))
+	if err != nil {
+		return nil, err
+	}
+	fset := token.NewFileSet()
+	var parsed []*ast.File
+	for x := range files {
+		if strings.HasSuffix(files[x], "_test.go") {
+			continue
+		}
+		f, err := parser.ParseFile(fset, files[x], nil, 0)
+		if err != nil {
+			return nil, err
+		}
+		parsed = append(parsed, f)
+	}
+	return parsed, nil
+}
+
+// packageMethods returns the methods declared on a type, methods promoted
+// from types embedded from the same package or packages beneath the exchanges
+// package at root are included unless the type overrides them
+func packageMethods(root, dir, typeName string) (methodSet, error) {
+	files, err := parseDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	methods := make(methodSet)
+	for _, f := range files {
+		for _, decl := range f.Decls {
+			fn, ok := decl.(*ast.FuncDecl)
+			if !ok || fn.Recv == nil || len(fn.Recv.List) == 0 {
+				continue
+			}
+			if receiverType(fn.Recv.List[0].Type) != typeName {
+				continue
+			}
+			methods[fn.Name.Name] = isStub(fn)
+		}
+	}
+
+	for _, embedded := range embeddedTypes(files, typeName) {
+		embeddedDir := dir
+		if embedded[0] != "" {
+			if embedded[0] == exchangesImport {
+				// exchange.Base methods are resolved by the caller
+				continue
+			}
+			rel := strings.TrimPrefix(embedded[0], exchangesImport+"/")
+			if rel == embedded[0] {
+				// types embedded from outside the exchanges tree are not
+				// wrappers
+				continue
+			}
+			embeddedDir = filepath.Join(root, filepath.FromSlash(rel))
+		}
+		promoted, err := packageMethods(root, embeddedDir, embedded[1])
+		if err != nil {
+			return nil, err
+		}
+		for name, stub := range promoted {
+			if _, ok := methods[name]; !ok {
+				methods[name] = stub
+			}
+		}
+	}
+	return methods, nil
+}
+
+// embeddedTypes returns the import paths and type names of fields embedded in
+// a struct, the import path is empty for types declared in the same package
+func embeddedTypes(files []*ast.File, typeName string) [][2]string {
+	var embedded [][2]string
+	for _, f := range files {
+		imports := importPaths(f)
+		ast.Inspect(f, func(n ast.Node) bool {
+			ts, ok := n.(*ast.TypeSpec)
+			if !ok || ts.Name.Name != typeName {
+				return true
+			}
+			st, ok := ts.Type.(*ast.StructType)
+			if !ok {
+				return false
+			}
+			for _, field := range st.Fields.List {
+				if len(field.Names) != 0 {
+					continue
+				}
+				t := field.Type
+				if star, ok := t.(*ast.StarExpr); ok {
+					t = star.X
+				}
+				switch e := t.(type) {
+				case *ast.Ident:
+					embedded = append(embedded, [2]string{"", e.Name})
+				case *ast.SelectorExpr:
+					if pkg, ok := e.X.(*ast.Ident); ok && imports[pkg.Name] != "" {
+						embedded = append(embedded, [2]string{imports[pkg.Name], e.Sel.Name})
+					}
+				}
+			}
+			return false
+		})
+	}
+	return embedded
+}
+
+// importPaths maps the names a file refers to its imports by to their import
+// paths. Unnamed imports are assumed to use the last path element, except for
+// the exchanges package which is named exchange
+func importPaths(f *ast.File) map[string]string {
+	imports := make(map[string]string)
+	for _, spec := range f.Imports {
+		path, err := strconv.Unquote(spec.Path.Value)
+		if err != nil {
+			continue
+		}
+		var name string
+		switch {
+		case spec.Name != nil:
+			name = spec.Name.Name
+		case path == exchangesImport:
+			name = "exchange"
+		default:
+			name = path[strings.LastIndex(path, "/")+1:]
+		}
+		imports[name] = path
+	}
+	return imports
+}
+
+// receiverType returns the type name of a method receiver
+func receiverType(expr ast.Expr) string {
+	if star, ok := expr.(*ast.StarExpr); ok {
+		expr = star.X
+	}
+	if ident, ok := expr.(*ast.Ident); ok {
+		return ident.Name
+	}
+	return ""
+}
+
+// isStub returns true when every return statement in a function returns a
+// not yet implemented or not supported error
+func isStub(fn *ast.FuncDecl) bool {
+	if fn.Body == nil {
+		return false
+	}
+	var returns, stubs int
+	ast.Inspect(fn.Body, func(n ast.Node) bool {
+		switch r := n.(type) {
+		case *ast.FuncLit:
+			return false
+		case *ast.ReturnStmt:
+			returns++
+			for i := range r.Results {
+				if containsStubError(r.Results[i]) {
+					stubs++
+					break
+				}
+			}
+		}
+		return true
+	})
+	return returns > 0 && returns == stubs
+}
+
+// containsStubError returns true if the expression references a stub error
+func containsStubError(expr ast.Expr) bool {
+	var found bool
+	ast.Inspect(expr, func(n ast.Node) bool {
+		if sel, ok := n.(*ast.SelectorExpr); ok {
+			if pkg, ok := sel.X.(*ast.Ident); ok && pkg.Name == "common" && stubErrors[sel.Sel.Name] {
+				found = true
+			}
+		}
+		return !found
+	})
+	return found
+}
+
+// exchangeName returns the exchange name and type assigned in SetDefaults,
+// resolving package level constants
+func exchangeName(dir string) (name, typeName string, err error) {
+	files, err := parseDir(dir)
+	if err != nil {
+		return "", "", err
+	}
+	consts := make(map[string]string)
+	var nameExpr ast.Expr
+	for _, f := range files {
+		for _, decl := range f.Decls {
+			switch d := decl.(type) {
+			case *ast.GenDecl:
+				if d.Tok != token.CONST {
+					continue
+				}
+				for _, spec := range d.Specs {
+					vs, ok := spec.(*ast.ValueSpec)
+					if !ok {
+						continue
+					}
+					for i := range vs.Names {
+						if i >= len(vs.Values) {
+							continue
+						}
+						if lit, ok := vs.Values[i].(*ast.BasicLit); ok && lit.Kind == token.STRING {
+							consts[vs.Names[i].Name], _ = strconv.Unquote(lit.Value)
+						}
+					}
+				}
+			case *ast.FuncDecl:
+				if d.Name.Name != "SetDefaults" || d.Recv == nil || len(d.Recv.List) == 0 {
+					continue
+				}
+				typeName = receiverType(d.Recv.List[0].Type)
+				ast.Inspect(d.Body, func(n ast.Node) bool {
+					as, ok := n.(*ast.AssignStmt)
+					if !ok || len(as.Lhs) != 1 || len(as.Rhs) != 1 {
+						return true
+					}
+					if sel, ok := as.Lhs[0].(*ast.SelectorExpr); ok && sel.Sel.Name == "Name" && nameExpr == nil {
+						nameExpr = as.Rhs[0]
+					}
+					return true
+				})
+			}
+		}
+	}
+	switch v := nameExpr.(type) {
+	case *ast.BasicLit:
+		name, err = strconv.Unquote(v.Value)
+		return name, typeName, err
+	case *ast.Ident:
+		return consts[v.Name], typeName, nil
+	}
+	return "", typeName, nil
+}
+
+// render formats the unsupported methods as the generated source file
+func render(unsupported map[string][]string) ([]byte, error) {
+	names := make([]string, 0, len(unsupported))
+	for name := range unsupported {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by cmd/exchange_capabilities; DO NOT EDIT.\n\n")
+	buf.WriteString("package exchange\n\n")
+	buf.WriteString("// unsupportedMethods lists the IBotExchange methods each exchange has not\n")
+	buf.WriteString("// implemented, keyed by lower case exchange name\n")
+	buf.WriteString("var unsupportedMethods = map[string][]string{\n")
+	for _, name := range names {
+		fmt.Fprintf(&buf, "\t%q: {\n", name)
+		for _, method := range unsupported[name] {
+			fmt.Fprintf(&buf, "\t\t%q,\n", method)
+		}
+		buf.WriteString("\t},\n")
+	}
+	buf.WriteString("}\n")
+
+	return format.Source(buf.Bytes())
+}
@@ -0,0 +1,38 @@
+package main
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestGeneratedCapabilitiesUpToDate(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join("..", "..", "exchanges")
+	src, err := generate(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	committed, err := os.ReadFile(filepath.Join(path, "capabilities_generated.go"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(src, committed) {
+		t.Fatal("exchanges/capabilities_generated.go is out of date, run go generate in the exchanges package")
+	}
+}
+
+func TestPackageMethodsPromoted(t *testing.T) {
+	t.Parallel()
+	path := filepath.Join("..", "..", "exchanges")
+	base, err := packageMethods(path, path, baseType)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, method := range []string{"CanDeposit", "CanTradePair", "GetCurrencyStateSnapshot", "CheckOrderExecutionLimits"} {
+		if _, ok := base[method]; !ok {
+			t.Errorf("expected %s to be promoted to Base", method)
+		}
+	}
+}
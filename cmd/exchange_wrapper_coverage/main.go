@@ -139,11 +139,21 @@ func testWrappers(e exchange.IBotExchange) []string {
 		funcs = append(funcs, "SubmitOrder")
 	}
 
+	_, err = e.SubmitBatchOrders(context.TODO(), nil)
+	if errors.Is(err, common.ErrNotYetImplemented) {
+		funcs = append(funcs, "SubmitBatchOrders")
+	}
+
 	_, err = e.ModifyOrder(context.TODO(), nil)
 	if errors.Is(err, common.ErrNotYetImplemented) {
 		funcs = append(funcs, "ModifyOrder")
 	}
 
+	_, err = e.ModifyBatchOrders(context.TODO(), nil)
+	if errors.Is(err, common.ErrNotYetImplemented) {
+		funcs = append(funcs, "ModifyBatchOrders")
+	}
+
 	err = e.CancelOrder(context.TODO(), nil)
 	if errors.Is(err, common.ErrNotYetImplemented) {
 		funcs = append(funcs, "CancelOrder")
@@ -159,6 +169,11 @@ func testWrappers(e exchange.IBotExchange) []string {
 		funcs = append(funcs, "CancelAllOrders")
 	}
 
+	err = e.SetDeadMansSwitch(context.TODO(), assetType, 0)
+	if errors.Is(err, common.ErrNotYetImplemented) {
+		funcs = append(funcs, "SetDeadMansSwitch")
+	}
+
 	_, err = e.GetOrderInfo(context.TODO(), "1", p, assetType)
 	if errors.Is(err, common.ErrNotYetImplemented) {
 		funcs = append(funcs, "GetOrderInfo")
@@ -174,11 +189,16 @@ func testWrappers(e exchange.IBotExchange) []string {
 		funcs = append(funcs, "GetActiveOrders")
 	}
 
-	_, err = e.GetDepositAddress(context.TODO(), currency.BTC, "")
+	_, err = e.GetDepositAddress(context.TODO(), currency.BTC, "", "")
 	if errors.Is(err, common.ErrNotYetImplemented) {
 		funcs = append(funcs, "GetDepositAddress")
 	}
 
+	_, err = e.GetAvailableTransferChains(context.TODO(), currency.USDT)
+	if errors.Is(err, common.ErrNotYetImplemented) {
+		funcs = append(funcs, "GetAvailableTransferChains")
+	}
+
 	_, err = e.WithdrawCryptocurrencyFunds(context.TODO(), nil)
 	if errors.Is(err, common.ErrNotYetImplemented) {
 		funcs = append(funcs, "WithdrawCryptocurrencyFunds")
@@ -193,6 +213,56 @@ func testWrappers(e exchange.IBotExchange) []string {
 		funcs = append(funcs, "WithdrawFiatFundsToInternationalBank")
 	}
 
+	_, err = e.TransferFunds(context.TODO(), nil)
+	if errors.Is(err, common.ErrNotYetImplemented) {
+		funcs = append(funcs, "TransferFunds")
+	}
+
+	_, err = e.GetMarginInterestRates(context.TODO(), currency.Code{})
+	if errors.Is(err, common.ErrNotYetImplemented) {
+		funcs = append(funcs, "GetMarginInterestRates")
+	}
+
+	_, err = e.MarginBorrow(context.TODO(), nil)
+	if errors.Is(err, common.ErrNotYetImplemented) {
+		funcs = append(funcs, "MarginBorrow")
+	}
+
+	_, err = e.MarginRepay(context.TODO(), nil)
+	if errors.Is(err, common.ErrNotYetImplemented) {
+		funcs = append(funcs, "MarginRepay")
+	}
+
+	_, err = e.SubmitMarginLendOffer(context.TODO(), nil)
+	if errors.Is(err, common.ErrNotYetImplemented) {
+		funcs = append(funcs, "SubmitMarginLendOffer")
+	}
+
+	err = e.CancelMarginLendOffer(context.TODO(), currency.Code{}, "")
+	if errors.Is(err, common.ErrNotYetImplemented) {
+		funcs = append(funcs, "CancelMarginLendOffer")
+	}
+
+	_, err = e.GetMarginLoans(context.TODO(), currency.Code{})
+	if errors.Is(err, common.ErrNotYetImplemented) {
+		funcs = append(funcs, "GetMarginLoans")
+	}
+
+	_, err = e.GetMarginInterestHistory(context.TODO(), currency.Code{}, time.Time{}, time.Time{})
+	if errors.Is(err, common.ErrNotYetImplemented) {
+		funcs = append(funcs, "GetMarginInterestHistory")
+	}
+
+	_, err = e.GetOptionsChain(context.TODO(), currency.Pair{})
+	if errors.Is(err, common.ErrNotYetImplemented) {
+		funcs = append(funcs, "GetOptionsChain")
+	}
+
+	_, err = e.GetOptionsGreeks(context.TODO(), currency.Pair{})
+	if errors.Is(err, common.ErrNotYetImplemented) {
+		funcs = append(funcs, "GetOptionsGreeks")
+	}
+
 	_, err = e.GetHistoricCandles(context.TODO(), currency.Pair{}, asset.Spot, time.Unix(0, 0), time.Unix(0, 0), kline.OneDay)
 	if errors.Is(err, common.ErrNotYetImplemented) {
 		funcs = append(funcs, "GetHistoricCandles")
@@ -213,6 +283,11 @@ func testWrappers(e exchange.IBotExchange) []string {
 		funcs = append(funcs, "GetFeeByType")
 	}
 
+	err = e.UpdateFeeSchedule(context.TODO())
+	if errors.Is(err, common.ErrNotYetImplemented) {
+		funcs = append(funcs, "UpdateFeeSchedule")
+	}
+
 	err = e.UpdateOrderExecutionLimits(context.TODO(), asset.DownsideProfitContract)
 	if errors.Is(err, common.ErrNotYetImplemented) {
 		funcs = append(funcs, "UpdateOrderExecutionLimits")
@@ -23,6 +23,7 @@ import (
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/deposit"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
@@ -566,6 +567,20 @@ func testWrappers(e exchange.IBotExchange, base *exchange.Base, config *Config)
 			Response:   jsonifyInterface([]interface{}{submitOrderResponse}),
 		})
 
+		var submitBatchOrdersResponse order.SubmitBatchResponse
+		submitBatchOrdersResponse, err = e.SubmitBatchOrders(context.TODO(), []*order.Submit{s})
+		msg = ""
+		if err != nil {
+			msg = err.Error()
+			responseContainer.ErrorCount++
+		}
+		responseContainer.EndpointResponses = append(responseContainer.EndpointResponses, EndpointResponse{
+			SentParams: jsonifyInterface([]interface{}{[]*order.Submit{s}}),
+			Function:   "SubmitBatchOrders",
+			Error:      msg,
+			Response:   jsonifyInterface([]interface{}{submitBatchOrdersResponse}),
+		})
+
 		modifyRequest := order.Modify{
 			ID:     config.OrderSubmission.OrderID,
 			Type:   testOrderType,
@@ -587,6 +602,20 @@ func testWrappers(e exchange.IBotExchange, base *exchange.Base, config *Config)
 			Response:   modifyOrderResponse,
 		})
 
+		var modifyBatchOrdersResponse order.ModifyBatchResponse
+		modifyBatchOrdersResponse, err = e.ModifyBatchOrders(context.TODO(), []order.Modify{modifyRequest})
+		msg = ""
+		if err != nil {
+			msg = err.Error()
+			responseContainer.ErrorCount++
+		}
+		responseContainer.EndpointResponses = append(responseContainer.EndpointResponses, EndpointResponse{
+			SentParams: jsonifyInterface([]interface{}{[]order.Modify{modifyRequest}}),
+			Function:   "ModifyBatchOrders",
+			Error:      msg,
+			Response:   jsonifyInterface([]interface{}{modifyBatchOrdersResponse}),
+		})
+
 		cancelRequest := order.Cancel{
 			Side:      testOrderSide,
 			Pair:      p,
@@ -642,6 +671,19 @@ func testWrappers(e exchange.IBotExchange, base *exchange.Base, config *Config)
 			Response:   jsonifyInterface([]interface{}{cancellAllOrdersResponse}),
 		})
 
+		// a zero timeout disarms the timer so no orders are cancelled
+		err = e.SetDeadMansSwitch(context.TODO(), assetTypes[i], 0)
+		msg = ""
+		if err != nil {
+			msg = err.Error()
+			responseContainer.ErrorCount++
+		}
+		responseContainer.EndpointResponses = append(responseContainer.EndpointResponses, EndpointResponse{
+			SentParams: jsonifyInterface([]interface{}{assetTypes[i], 0}),
+			Function:   "SetDeadMansSwitch",
+			Error:      msg,
+		})
+
 		var r15 order.Detail
 		r15, err = e.GetOrderInfo(context.TODO(), config.OrderSubmission.OrderID, p, assetTypes[i])
 		msg = ""
@@ -694,18 +736,32 @@ func testWrappers(e exchange.IBotExchange, base *exchange.Base, config *Config)
 			Response:   jsonifyInterface([]interface{}{getActiveOrdersResponse}),
 		})
 
-		var getDepositAddressResponse string
-		getDepositAddressResponse, err = e.GetDepositAddress(context.TODO(), p.Base, "")
+		var getDepositAddressResponse *deposit.Address
+		getDepositAddressResponse, err = e.GetDepositAddress(context.TODO(), p.Base, "", "")
 		msg = ""
 		if err != nil {
 			msg = err.Error()
 			responseContainer.ErrorCount++
 		}
 		responseContainer.EndpointResponses = append(responseContainer.EndpointResponses, EndpointResponse{
-			SentParams: jsonifyInterface([]interface{}{p.Base, ""}),
+			SentParams: jsonifyInterface([]interface{}{p.Base, "", ""}),
 			Function:   "GetDepositAddress",
 			Error:      msg,
-			Response:   getDepositAddressResponse,
+			Response:   jsonifyInterface([]interface{}{getDepositAddressResponse}),
+		})
+
+		var getAvailableTransferChainsResponse []deposit.Network
+		getAvailableTransferChainsResponse, err = e.GetAvailableTransferChains(context.TODO(), p.Base)
+		msg = ""
+		if err != nil {
+			msg = err.Error()
+			responseContainer.ErrorCount++
+		}
+		responseContainer.EndpointResponses = append(responseContainer.EndpointResponses, EndpointResponse{
+			SentParams: jsonifyInterface([]interface{}{p.Base}),
+			Function:   "GetAvailableTransferChains",
+			Error:      msg,
+			Response:   jsonifyInterface([]interface{}{getAvailableTransferChainsResponse}),
 		})
 
 		feeType = exchange.FeeBuilder{
@@ -0,0 +1,212 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli/v2"
+)
+
+const batchOrderSeparator = ":"
+
+var errNoBatchOrders = errors.New("at least one order must be set")
+
+var submitBatchOrdersCommand = &cli.Command{
+	Name:      "submitbatchorders",
+	Usage:     "submits multiple orders to an exchange in as few requests as the exchange allows",
+	ArgsUsage: "<exchange> <asset> <side:type:pair:amount:price[:client_id]>...",
+	Action:    submitBatchOrders,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to submit the orders to",
+		},
+		&cli.StringFlag{
+			Name:  "asset",
+			Usage: "the asset type shared by all orders",
+		},
+		&cli.StringSliceFlag{
+			Name:  "order",
+			Usage: "an order formatted as side:type:pair:amount:price[:client_id], can be repeated",
+		},
+	},
+}
+
+var modifyBatchOrdersCommand = &cli.Command{
+	Name:      "modifybatchorders",
+	Usage:     "modifies the price and/or amount of multiple previously submitted orders",
+	ArgsUsage: "<exchange> <asset> <order_id:price:amount>...",
+	Action:    modifyBatchOrders,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange the orders were submitted to",
+		},
+		&cli.StringFlag{
+			Name:  "asset",
+			Usage: "the asset type shared by all orders",
+		},
+		&cli.StringSliceFlag{
+			Name:  "order",
+			Usage: "an amendment formatted as order_id:price:amount, a zero value leaves that field unchanged, can be repeated",
+		},
+	},
+}
+
+// batchArgs returns the exchange, asset and order specifications from the
+// flags or positional arguments
+func batchArgs(c *cli.Context) (exchangeName, assetType string, orders []string, err error) {
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(1)
+	}
+	assetType = strings.ToLower(assetType)
+	if !validAsset(assetType) {
+		return "", "", nil, errInvalidAsset
+	}
+
+	if c.IsSet("order") {
+		orders = c.StringSlice("order")
+	} else if c.NArg() > 2 {
+		orders = c.Args().Slice()[2:]
+	}
+	if len(orders) == 0 {
+		return "", "", nil, errNoBatchOrders
+	}
+	return exchangeName, assetType, orders, nil
+}
+
+func submitBatchOrders(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "submitbatchorders")
+	}
+
+	exchangeName, assetType, specs, err := batchArgs(c)
+	if err != nil {
+		return err
+	}
+
+	orders := make([]*gctrpc.BatchOrder, len(specs))
+	for x := range specs {
+		fields := strings.Split(specs[x], batchOrderSeparator)
+		if len(fields) != 5 && len(fields) != 6 {
+			return fmt.Errorf("invalid order %q, expected side:type:pair:amount:price[:client_id]", specs[x])
+		}
+		if !validPair(fields[2]) {
+			return errInvalidPair
+		}
+		var p currency.Pair
+		p, err = currency.NewPairDelimiter(fields[2], pairDelimiter)
+		if err != nil {
+			return err
+		}
+		var amount, price float64
+		amount, err = strconv.ParseFloat(fields[3], 64)
+		if err != nil {
+			return fmt.Errorf("invalid order %q amount: %w", specs[x], err)
+		}
+		price, err = strconv.ParseFloat(fields[4], 64)
+		if err != nil {
+			return fmt.Errorf("invalid order %q price: %w", specs[x], err)
+		}
+		orders[x] = &gctrpc.BatchOrder{
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: p.Delimiter,
+				Base:      p.Base.String(),
+				Quote:     p.Quote.String(),
+			},
+			Side:      strings.ToUpper(fields[0]),
+			OrderType: strings.ToUpper(fields[1]),
+			Amount:    amount,
+			Price:     price,
+		}
+		if len(fields) == 6 {
+			orders[x].ClientId = fields[5]
+		}
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.SubmitBatchOrders(c.Context, &gctrpc.SubmitBatchOrdersRequest{
+		Exchange:  exchangeName,
+		AssetType: assetType,
+		Orders:    orders,
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func modifyBatchOrders(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "modifybatchorders")
+	}
+
+	exchangeName, assetType, specs, err := batchArgs(c)
+	if err != nil {
+		return err
+	}
+
+	orders := make([]*gctrpc.BatchModifyOrder, len(specs))
+	for x := range specs {
+		fields := strings.Split(specs[x], batchOrderSeparator)
+		if len(fields) != 3 {
+			return fmt.Errorf("invalid order %q, expected order_id:price:amount", specs[x])
+		}
+		var amount, price float64
+		price, err = strconv.ParseFloat(fields[1], 64)
+		if err != nil {
+			return fmt.Errorf("invalid order %q price: %w", specs[x], err)
+		}
+		amount, err = strconv.ParseFloat(fields[2], 64)
+		if err != nil {
+			return fmt.Errorf("invalid order %q amount: %w", specs[x], err)
+		}
+		if price == 0 && amount == 0 {
+			return fmt.Errorf("invalid order %q, either price or amount should be set", specs[x])
+		}
+		orders[x] = &gctrpc.BatchModifyOrder{
+			OrderId: fields[0],
+			Price:   price,
+			Amount:  amount,
+		}
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.ModifyBatchOrders(c.Context, &gctrpc.ModifyBatchOrdersRequest{
+		Exchange: exchangeName,
+		Asset:    assetType,
+		Orders:   orders,
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
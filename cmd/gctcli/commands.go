@@ -473,6 +473,51 @@ func getExchangeInfo(c *cli.Context) error {
 	return nil
 }
 
+var getExchangeCapabilitiesCommand = &cli.Command{
+	Name:      "getexchangecapabilities",
+	Usage:     "gets the methods, assets, order types, time in force options, kline intervals and websocket channels supported by an exchange",
+	ArgsUsage: "<exchange>",
+	Action:    getExchangeCapabilities,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to get the capabilities for",
+		},
+	},
+}
+
+func getExchangeCapabilities(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "getexchangecapabilities")
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetExchangeCapabilities(c.Context,
+		&gctrpc.GenericExchangeNameRequest{
+			Exchange: exchangeName,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
 var getTickerCommand = &cli.Command{
 	Name:      "getticker",
 	Usage:     "gets the ticker for a specific currency pair and exchange",
@@ -2592,7 +2637,7 @@ func getCryptocurrencyDepositAddresses(c *cli.Context) error {
 var getCryptocurrencyDepositAddressCommand = &cli.Command{
 	Name:      "getcryptocurrencydepositaddress",
 	Usage:     "gets the cryptocurrency deposit address for an exchange and cryptocurrency",
-	ArgsUsage: "<exchange> <cryptocurrency>",
+	ArgsUsage: "<exchange> <cryptocurrency> <chain>",
 	Action:    getCryptocurrencyDepositAddress,
 	Flags: []cli.Flag{
 		&cli.StringFlag{
@@ -2603,6 +2648,10 @@ var getCryptocurrencyDepositAddressCommand = &cli.Command{
 			Name:  "cryptocurrency",
 			Usage: "the cryptocurrency to get the deposit address for",
 		},
+		&cli.StringFlag{
+			Name:  "chain",
+			Usage: "the chain to get the deposit address for e.g. TRC20, if left empty the exchange default is used",
+		},
 	},
 }
 
@@ -2613,6 +2662,7 @@ func getCryptocurrencyDepositAddress(c *cli.Context) error {
 
 	var exchangeName string
 	var cryptocurrency string
+	var chain string
 
 	if c.IsSet("exchange") {
 		exchangeName = c.String("exchange")
@@ -2630,6 +2680,12 @@ func getCryptocurrencyDepositAddress(c *cli.Context) error {
 		return errors.New("cryptocurrency must be set")
 	}
 
+	if c.IsSet("chain") {
+		chain = c.String("chain")
+	} else if c.Args().Get(2) != "" {
+		chain = c.Args().Get(2)
+	}
+
 	conn, cancel, err := setupClient(c)
 	if err != nil {
 		return err
@@ -2641,6 +2697,69 @@ func getCryptocurrencyDepositAddress(c *cli.Context) error {
 		&gctrpc.GetCryptocurrencyDepositAddressRequest{
 			Exchange:       exchangeName,
 			Cryptocurrency: cryptocurrency,
+			Chain:          chain,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getAvailableTransferChainsCommand = &cli.Command{
+	Name:      "getavailabletransferchains",
+	Usage:     "gets the networks a cryptocurrency can be deposited and withdrawn on with their fees and limits",
+	ArgsUsage: "<exchange> <cryptocurrency>",
+	Action:    getAvailableTransferChains,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to get the available transfer chains for",
+		},
+		&cli.StringFlag{
+			Name:  "cryptocurrency",
+			Usage: "the cryptocurrency to get the available transfer chains for",
+		},
+	},
+}
+
+func getAvailableTransferChains(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "getavailabletransferchains")
+	}
+
+	var exchangeName string
+	var cryptocurrency string
+
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	if c.IsSet("cryptocurrency") {
+		cryptocurrency = c.String("cryptocurrency")
+	} else if c.Args().Get(1) != "" {
+		cryptocurrency = c.Args().Get(1)
+	}
+
+	if cryptocurrency == "" {
+		return errors.New("cryptocurrency must be set")
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetAvailableTransferChains(c.Context,
+		&gctrpc.GetAvailableTransferChainsRequest{
+			Exchange:       exchangeName,
+			Cryptocurrency: cryptocurrency,
 		},
 	)
 	if err != nil {
@@ -2654,7 +2773,7 @@ func getCryptocurrencyDepositAddress(c *cli.Context) error {
 var withdrawCryptocurrencyFundsCommand = &cli.Command{
 	Name:      "withdrawcryptofunds",
 	Usage:     "withdraws cryptocurrency funds from the desired exchange",
-	ArgsUsage: "<exchange> <currency>  <amount> <address> <addresstag> <fee> <description>",
+	ArgsUsage: "<exchange> <currency>  <amount> <address> <addresstag> <fee> <description> <chain>",
 	Action:    withdrawCryptocurrencyFunds,
 	Flags: []cli.Flag{
 		&cli.StringFlag{
@@ -2685,6 +2804,10 @@ var withdrawCryptocurrencyFundsCommand = &cli.Command{
 			Name:  "description",
 			Usage: "description to submit with request",
 		},
+		&cli.StringFlag{
+			Name:  "chain",
+			Usage: "the chain to withdraw on e.g. TRC20, if left empty the exchange default is used",
+		},
 	},
 }
 
@@ -2693,7 +2816,7 @@ func withdrawCryptocurrencyFunds(c *cli.Context) error {
 		return cli.ShowCommandHelp(c, "withdrawcryptofunds")
 	}
 
-	var exchange, cur, address, addressTag, description string
+	var exchange, cur, address, addressTag, description, chain string
 	var amount, fee float64
 
 	if c.IsSet("exchange") {
@@ -2744,6 +2867,12 @@ func withdrawCryptocurrencyFunds(c *cli.Context) error {
 		description = c.Args().Get(6)
 	}
 
+	if c.IsSet("chain") {
+		chain = c.String("chain")
+	} else if c.Args().Get(7) != "" {
+		chain = c.Args().Get(7)
+	}
+
 	conn, cancel, err := setupClient(c)
 	if err != nil {
 		return err
@@ -2761,6 +2890,131 @@ func withdrawCryptocurrencyFunds(c *cli.Context) error {
 			Amount:      amount,
 			Fee:         fee,
 			Description: description,
+			Chain:       chain,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
+var transferFundsCommand = &cli.Command{
+	Name:      "transferfunds",
+	Usage:     "transfers funds between wallets or sub-accounts on the desired exchange",
+	ArgsUsage: "<exchange> <currency> <amount> <fromasset> <toasset> <fromsubaccount> <tosubaccount>",
+	Action:    transferFunds,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to transfer on",
+		},
+		&cli.StringFlag{
+			Name:  "currency",
+			Usage: "the currency to transfer",
+		},
+		&cli.Float64Flag{
+			Name:  "amount",
+			Usage: "amount of funds to transfer",
+		},
+		&cli.StringFlag{
+			Name:  "fromasset",
+			Usage: "the asset wallet to transfer from, defaults to the exchange's main wallet",
+		},
+		&cli.StringFlag{
+			Name:  "toasset",
+			Usage: "the asset wallet to transfer to, defaults to the exchange's main wallet",
+		},
+		&cli.StringFlag{
+			Name:  "fromsubaccount",
+			Usage: "the sub-account to transfer from, defaults to the main account",
+		},
+		&cli.StringFlag{
+			Name:  "tosubaccount",
+			Usage: "the sub-account to transfer to, defaults to the main account",
+		},
+	},
+}
+
+func transferFunds(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "transferfunds")
+	}
+
+	var exchange, cur, fromAsset, toAsset, fromSubAccount, toSubAccount string
+	var amount float64
+
+	if c.IsSet("exchange") {
+		exchange = c.String("exchange")
+	} else {
+		exchange = c.Args().Get(0)
+	}
+
+	if c.IsSet("currency") {
+		cur = c.String("currency")
+	} else {
+		cur = c.Args().Get(1)
+	}
+
+	if c.IsSet("amount") {
+		amount = c.Float64("amount")
+	} else if c.Args().Get(2) != "" {
+		var err error
+		amount, err = strconv.ParseFloat(c.Args().Get(2), 64)
+		if err != nil {
+			return err
+		}
+	}
+
+	if c.IsSet("fromasset") {
+		fromAsset = c.String("fromasset")
+	} else {
+		fromAsset = c.Args().Get(3)
+	}
+	fromAsset = strings.ToLower(fromAsset)
+	if fromAsset != "" && !validAsset(fromAsset) {
+		return errInvalidAsset
+	}
+
+	if c.IsSet("toasset") {
+		toAsset = c.String("toasset")
+	} else {
+		toAsset = c.Args().Get(4)
+	}
+	toAsset = strings.ToLower(toAsset)
+	if toAsset != "" && !validAsset(toAsset) {
+		return errInvalidAsset
+	}
+
+	if c.IsSet("fromsubaccount") {
+		fromSubAccount = c.String("fromsubaccount")
+	} else {
+		fromSubAccount = c.Args().Get(5)
+	}
+
+	if c.IsSet("tosubaccount") {
+		toSubAccount = c.String("tosubaccount")
+	} else {
+		toSubAccount = c.Args().Get(6)
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.TransferFunds(c.Context,
+		&gctrpc.TransferFundsRequest{
+			Exchange:       exchange,
+			Currency:       cur,
+			Amount:         amount,
+			FromAsset:      fromAsset,
+			ToAsset:        toAsset,
+			FromSubAccount: fromSubAccount,
+			ToSubAccount:   toSubAccount,
 		},
 	)
 	if err != nil {
@@ -3616,79 +3870,278 @@ func getExchangeTickerStream(c *cli.Context) error {
 	}
 }
 
-var getAuditEventCommand = &cli.Command{
-	Name:      "getauditevent",
-	Usage:     "gets audit events matching query parameters",
-	ArgsUsage: "<starttime> <endtime> <orderby> <limit>",
-	Action:    getAuditEvent,
+var getLiveCandleStreamCommand = &cli.Command{
+	Name:      "getlivecandlestream",
+	Usage:     "gets a stream of candles built from an exchange's live trades as each interval closes",
+	ArgsUsage: "<exchange> <pair> <asset> <interval>",
+	Action:    getLiveCandleStream,
 	Flags: []cli.Flag{
 		&cli.StringFlag{
-			Name:        "start",
-			Aliases:     []string{"s"},
-			Usage:       "start date to search",
-			Value:       time.Now().Add(-time.Hour).Format(common.SimpleTimeFormat),
-			Destination: &startTime,
+			Name:    "exchange",
+			Aliases: []string{"e"},
+			Usage:   "the exchange to get the candles for",
 		},
 		&cli.StringFlag{
-			Name:        "end",
-			Aliases:     []string{"e"},
-			Usage:       "end time to search",
-			Value:       time.Now().Format(common.SimpleTimeFormat),
-			Destination: &endTime,
+			Name:    "pair",
+			Aliases: []string{"p"},
+			Usage:   "the currency pair to get the candles for",
 		},
 		&cli.StringFlag{
-			Name:        "order",
-			Aliases:     []string{"o"},
-			Usage:       "order results by ascending/descending",
-			Value:       "asc",
-			Destination: &order,
+			Name:    "asset",
+			Aliases: []string{"a"},
+			Usage:   "the asset type of the currency pair",
 		},
-		&cli.IntFlag{
-			Name:        "limit",
-			Aliases:     []string{"l"},
-			Usage:       "how many results to retrieve",
-			Value:       100,
-			Destination: &limit,
+		&cli.Int64Flag{
+			Name:    "interval",
+			Aliases: []string{"i"},
+			Usage:   klineMessage + ", must be an interval configured for the live candle manager",
+			Value:   60,
 		},
 	},
 }
 
-func getAuditEvent(c *cli.Context) error {
-	if !c.IsSet("start") {
-		if c.Args().Get(0) != "" {
-			startTime = c.Args().Get(0)
-		}
-	}
-
-	if !c.IsSet("end") {
-		if c.Args().Get(1) != "" {
-			endTime = c.Args().Get(1)
-		}
+func getLiveCandleStream(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "getlivecandlestream")
 	}
 
-	if !c.IsSet("order") {
-		if c.Args().Get(2) != "" {
-			order = c.Args().Get(2)
-		}
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
 	}
 
-	if !c.IsSet("limit") {
-		if c.Args().Get(3) != "" {
-			limitStr, err := strconv.ParseInt(c.Args().Get(3), 10, 64)
-			if err == nil {
-				limit = int(limitStr)
-			}
-		}
+	var currencyPair string
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(1)
 	}
-
-	s, err := time.Parse(common.SimpleTimeFormat, startTime)
-	if err != nil {
-		return fmt.Errorf("invalid time format for start: %v", err)
+	if !validPair(currencyPair) {
+		return errInvalidPair
 	}
 
-	e, err := time.Parse(common.SimpleTimeFormat, endTime)
+	p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
 	if err != nil {
-		return fmt.Errorf("invalid time format for end: %v", err)
+		return err
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(2)
+	}
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	interval := c.Int64("interval")
+	if !c.IsSet("interval") && c.Args().Get(3) != "" {
+		interval, err = strconv.ParseInt(c.Args().Get(3), 10, 64)
+		if err != nil {
+			return err
+		}
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetLiveCandleStream(c.Context,
+		&gctrpc.GetLiveCandleStreamRequest{
+			Exchange: exchangeName,
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: p.Delimiter,
+				Base:      p.Base.String(),
+				Quote:     p.Quote.String(),
+			},
+			AssetType: assetType,
+			Interval:  int64(time.Duration(interval) * time.Second),
+		})
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := result.Recv()
+		if err != nil {
+			return err
+		}
+		jsonOutput(resp)
+	}
+}
+
+var getFillStreamCommand = &cli.Command{
+	Name:      "getfillstream",
+	Usage:     "gets a stream of your order fills received over an exchange's authenticated websocket",
+	ArgsUsage: "<exchange> <asset> <pair>",
+	Action:    getFillStream,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to get the fills from",
+		},
+		&cli.StringFlag{
+			Name:  "asset",
+			Usage: "optional - the asset type to filter fills by",
+		},
+		&cli.StringFlag{
+			Name:  "pair",
+			Usage: "optional - the currency pair to filter fills by",
+		},
+	},
+}
+
+func getFillStream(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "getfillstream")
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(1)
+	}
+	assetType = strings.ToLower(assetType)
+	if assetType != "" && !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	var currencyPair string
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(2)
+	}
+
+	var pair *gctrpc.CurrencyPair
+	if currencyPair != "" {
+		if !validPair(currencyPair) {
+			return errInvalidPair
+		}
+		p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+		if err != nil {
+			return err
+		}
+		pair = &gctrpc.CurrencyPair{
+			Delimiter: p.Delimiter,
+			Base:      p.Base.String(),
+			Quote:     p.Quote.String(),
+		}
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetFillStream(c.Context,
+		&gctrpc.GetFillStreamRequest{
+			Exchange:  exchangeName,
+			AssetType: assetType,
+			Pair:      pair,
+		})
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := result.Recv()
+		if err != nil {
+			return err
+		}
+		jsonOutput(resp)
+	}
+}
+
+var getAuditEventCommand = &cli.Command{
+	Name:      "getauditevent",
+	Usage:     "gets audit events matching query parameters",
+	ArgsUsage: "<starttime> <endtime> <orderby> <limit>",
+	Action:    getAuditEvent,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:        "start",
+			Aliases:     []string{"s"},
+			Usage:       "start date to search",
+			Value:       time.Now().Add(-time.Hour).Format(common.SimpleTimeFormat),
+			Destination: &startTime,
+		},
+		&cli.StringFlag{
+			Name:        "end",
+			Aliases:     []string{"e"},
+			Usage:       "end time to search",
+			Value:       time.Now().Format(common.SimpleTimeFormat),
+			Destination: &endTime,
+		},
+		&cli.StringFlag{
+			Name:        "order",
+			Aliases:     []string{"o"},
+			Usage:       "order results by ascending/descending",
+			Value:       "asc",
+			Destination: &order,
+		},
+		&cli.IntFlag{
+			Name:        "limit",
+			Aliases:     []string{"l"},
+			Usage:       "how many results to retrieve",
+			Value:       100,
+			Destination: &limit,
+		},
+	},
+}
+
+func getAuditEvent(c *cli.Context) error {
+	if !c.IsSet("start") {
+		if c.Args().Get(0) != "" {
+			startTime = c.Args().Get(0)
+		}
+	}
+
+	if !c.IsSet("end") {
+		if c.Args().Get(1) != "" {
+			endTime = c.Args().Get(1)
+		}
+	}
+
+	if !c.IsSet("order") {
+		if c.Args().Get(2) != "" {
+			order = c.Args().Get(2)
+		}
+	}
+
+	if !c.IsSet("limit") {
+		if c.Args().Get(3) != "" {
+			limitStr, err := strconv.ParseInt(c.Args().Get(3), 10, 64)
+			if err == nil {
+				limit = int(limitStr)
+			}
+		}
+	}
+
+	s, err := time.Parse(common.SimpleTimeFormat, startTime)
+	if err != nil {
+		return fmt.Errorf("invalid time format for start: %v", err)
+	}
+
+	e, err := time.Parse(common.SimpleTimeFormat, endTime)
+	if err != nil {
+		return fmt.Errorf("invalid time format for end: %v", err)
 	}
 
 	if e.Before(s) {
@@ -4622,6 +5075,418 @@ func findMissingSavedCandleIntervals(c *cli.Context) error {
 	return nil
 }
 
+var exportDataCommand = &cli.Command{
+	Name:      "exportdata",
+	Usage:     "exports saved candles or trades from the database to csv, jsonl or parquet files in the data directory",
+	ArgsUsage: "<exchange> <pair> <asset> <datatype> <format>",
+	Action:    exportData,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:    "exchange",
+			Aliases: []string{"e"},
+			Usage:   "the exchange to export data for",
+		},
+		&cli.StringFlag{
+			Name:    "pair",
+			Aliases: []string{"p"},
+			Usage:   "the currency pair to export data for",
+		},
+		&cli.StringFlag{
+			Name:    "asset",
+			Aliases: []string{"a"},
+			Usage:   "the asset type of the currency pair",
+		},
+		&cli.StringFlag{
+			Name:    "datatype",
+			Aliases: []string{"d"},
+			Usage:   "the data to export, candles or trades",
+			Value:   "candles",
+		},
+		&cli.StringFlag{
+			Name:    "format",
+			Aliases: []string{"f"},
+			Usage:   "the file format, csv, jsonl or parquet",
+			Value:   "csv",
+		},
+		&cli.Int64Flag{
+			Name:    "interval",
+			Aliases: []string{"i"},
+			Usage:   klineMessage,
+			Value:   86400,
+		},
+		&cli.StringFlag{
+			Name:  "start",
+			Usage: "the date to begin exporting data from",
+			Value: time.Now().AddDate(0, -1, 0).Truncate(time.Hour).Format(common.SimpleTimeFormat),
+		},
+		&cli.StringFlag{
+			Name:  "end",
+			Usage: "the date to stop exporting data, data at this time is excluded",
+			Value: time.Now().Truncate(time.Hour).Format(common.SimpleTimeFormat),
+		},
+		&cli.BoolFlag{
+			Name:  "gzip",
+			Usage: "compresses exported files with gzip <true/false>",
+		},
+		&cli.BoolFlag{
+			Name:  "partitionbyday",
+			Usage: "writes a separate file for each day of data <true/false>",
+		},
+	},
+}
+
+func exportData(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "exportdata")
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	var currencyPair string
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(1)
+	}
+	if !validPair(currencyPair) {
+		return errInvalidPair
+	}
+
+	p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	if err != nil {
+		return err
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(2)
+	}
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	dataType := c.String("datatype")
+	if !c.IsSet("datatype") && c.Args().Get(3) != "" {
+		dataType = c.Args().Get(3)
+	}
+
+	format := c.String("format")
+	if !c.IsSet("format") && c.Args().Get(4) != "" {
+		format = c.Args().Get(4)
+	}
+
+	s, err := time.Parse(common.SimpleTimeFormat, c.String("start"))
+	if err != nil {
+		return fmt.Errorf("invalid time format for start: %v", err)
+	}
+	e, err := time.Parse(common.SimpleTimeFormat, c.String("end"))
+	if err != nil {
+		return fmt.Errorf("invalid time format for end: %v", err)
+	}
+	if e.Before(s) {
+		return errors.New("start cannot be after end")
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.ExportData(c.Context,
+		&gctrpc.ExportDataRequest{
+			Exchange:  exchangeName,
+			AssetType: assetType,
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: p.Delimiter,
+				Base:      p.Base.String(),
+				Quote:     p.Quote.String(),
+			},
+			DataType:       dataType,
+			Interval:       int64(time.Duration(c.Int64("interval")) * time.Second),
+			Start:          negateLocalOffset(s),
+			End:            negateLocalOffset(e),
+			Format:         format,
+			Gzip:           c.Bool("gzip"),
+			PartitionByDay: c.Bool("partitionbyday"),
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getDataQualityReportCommand = &cli.Command{
+	Name:      "getdataqualityreport",
+	Usage:     "scans saved candles or trades in the database for gaps, duplicates and bad values and returns a quality score",
+	ArgsUsage: "<exchange> <pair> <asset> <datatype>",
+	Action:    getDataQualityReport,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:    "exchange",
+			Aliases: []string{"e"},
+			Usage:   "the exchange to scan data for",
+		},
+		&cli.StringFlag{
+			Name:    "pair",
+			Aliases: []string{"p"},
+			Usage:   "the currency pair to scan data for",
+		},
+		&cli.StringFlag{
+			Name:    "asset",
+			Aliases: []string{"a"},
+			Usage:   "the asset type of the currency pair",
+		},
+		&cli.StringFlag{
+			Name:    "datatype",
+			Aliases: []string{"d"},
+			Usage:   "the data to scan, candles or trades",
+			Value:   "candles",
+		},
+		&cli.Int64Flag{
+			Name:    "interval",
+			Aliases: []string{"i"},
+			Usage:   klineMessage + ", trades are grouped by this interval when checking for gaps",
+			Value:   86400,
+		},
+		&cli.StringFlag{
+			Name:  "start",
+			Usage: "the date to begin scanning data from",
+			Value: time.Now().AddDate(0, -1, 0).Truncate(time.Hour).Format(common.SimpleTimeFormat),
+		},
+		&cli.StringFlag{
+			Name:  "end",
+			Usage: "the date to stop scanning data, data at this time is excluded",
+			Value: time.Now().Truncate(time.Hour).Format(common.SimpleTimeFormat),
+		},
+		&cli.Int64Flag{
+			Name:  "zerovolumerun",
+			Usage: "the number of consecutive candles without volume to report, defaults to 3",
+		},
+		&cli.Float64Flag{
+			Name:  "wickmultiplier",
+			Usage: "the multiple of the median candle range a wick must exceed to be reported, defaults to 10",
+		},
+		&cli.Float64Flag{
+			Name:  "mismatchtolerance",
+			Usage: "the percentage a candle may differ from the candle built from saved trades, defaults to 0.5",
+		},
+		&cli.BoolFlag{
+			Name:  "store",
+			Usage: "saves the findings to the database, replacing findings previously saved for the range <true/false>",
+		},
+	},
+}
+
+func getDataQualityReport(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "getdataqualityreport")
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	var currencyPair string
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(1)
+	}
+	if !validPair(currencyPair) {
+		return errInvalidPair
+	}
+
+	p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	if err != nil {
+		return err
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(2)
+	}
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	dataType := c.String("datatype")
+	if !c.IsSet("datatype") && c.Args().Get(3) != "" {
+		dataType = c.Args().Get(3)
+	}
+
+	s, err := time.Parse(common.SimpleTimeFormat, c.String("start"))
+	if err != nil {
+		return fmt.Errorf("invalid time format for start: %v", err)
+	}
+	e, err := time.Parse(common.SimpleTimeFormat, c.String("end"))
+	if err != nil {
+		return fmt.Errorf("invalid time format for end: %v", err)
+	}
+	if e.Before(s) {
+		return errors.New("start cannot be after end")
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetDataQualityReport(c.Context,
+		&gctrpc.GetDataQualityReportRequest{
+			Exchange:  exchangeName,
+			AssetType: assetType,
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: p.Delimiter,
+				Base:      p.Base.String(),
+				Quote:     p.Quote.String(),
+			},
+			DataType:          dataType,
+			Interval:          int64(time.Duration(c.Int64("interval")) * time.Second),
+			Start:             negateLocalOffset(s),
+			End:               negateLocalOffset(e),
+			ZeroVolumeRun:     c.Int64("zerovolumerun"),
+			WickMultiplier:    c.Float64("wickmultiplier"),
+			MismatchTolerance: c.Float64("mismatchtolerance"),
+			Store:             c.Bool("store"),
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var runRetentionPoliciesCommand = &cli.Command{
+	Name:      "runretentionpolicies",
+	Usage:     "applies the configured retention policies to saved candles and trades, downsampling and archiving expired data before deleting it",
+	ArgsUsage: "<dryrun>",
+	Action:    runRetentionPolicies,
+	Flags: []cli.Flag{
+		&cli.BoolFlag{
+			Name:  "dryrun",
+			Usage: "reports the data which would be downsampled, archived and deleted without modifying anything",
+		},
+	},
+}
+
+func runRetentionPolicies(c *cli.Context) error {
+	var dryRun bool
+	if c.IsSet("dryrun") {
+		dryRun = c.Bool("dryrun")
+	} else {
+		dr, err := strconv.ParseBool(c.Args().First())
+		if err == nil {
+			dryRun = dr
+		}
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.RunRetentionPolicies(c.Context,
+		&gctrpc.RunRetentionPoliciesRequest{
+			DryRun: dryRun,
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getCompositeIndexPricesCommand = &cli.Command{
+	Name:      "getcompositeindexprices",
+	Usage:     "gets the latest composite index prices calculated from multiple exchanges, all indices are returned if no pair is set",
+	ArgsUsage: "<pair> <asset>",
+	Action:    getCompositeIndexPrices,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:    "pair",
+			Aliases: []string{"p"},
+			Usage:   "the currency pair of the index",
+		},
+		&cli.StringFlag{
+			Name:    "asset",
+			Aliases: []string{"a"},
+			Usage:   "the asset type of the index, defaults to spot",
+		},
+	},
+}
+
+func getCompositeIndexPrices(c *cli.Context) error {
+	var currencyPair string
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().First()
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(1)
+	}
+	if assetType != "" && !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	req := &gctrpc.GetCompositeIndexPricesRequest{AssetType: assetType}
+	if currencyPair != "" {
+		if !validPair(currencyPair) {
+			return errInvalidPair
+		}
+		p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+		if err != nil {
+			return err
+		}
+		req.Pair = &gctrpc.CurrencyPair{
+			Delimiter: p.Delimiter,
+			Base:      p.Base.String(),
+			Quote:     p.Quote.String(),
+		}
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetCompositeIndexPrices(c.Context, req)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
 // negateLocalOffset helps negate the offset of time generation
 // when the unix time gets to rpcserver, it no longer is the same time
 // that was sent as it handles it as a UTC value, even though when
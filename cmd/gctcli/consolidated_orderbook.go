@@ -0,0 +1,206 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli/v2"
+)
+
+var consolidatedOrderbookCommand = &cli.Command{
+	Name:      "consolidatedorderbook",
+	Usage:     "execute cross exchange consolidated orderbook command",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []*cli.Command{
+		{
+			Name:      "get",
+			Usage:     "gets the merged orderbook for a currency pair across enabled exchanges",
+			ArgsUsage: "<pair> <asset>",
+			Flags:     consolidatedOrderbookFlags,
+			Action:    getConsolidatedOrderbook,
+		},
+		{
+			Name:      "stream",
+			Usage:     "streams the merged orderbook for a currency pair across enabled exchanges",
+			ArgsUsage: "<pair> <asset>",
+			Flags:     consolidatedOrderbookFlags,
+			Action:    getConsolidatedOrderbookStream,
+		},
+	},
+}
+
+var consolidatedOrderbookFlags = []cli.Flag{
+	&cli.StringFlag{
+		Name:  "pair",
+		Usage: "the currency pair e.g. btc-usd",
+	},
+	&cli.StringFlag{
+		Name:  "asset",
+		Usage: "the asset type",
+	},
+	&cli.BoolFlag{
+		Name:  "convertquote",
+		Usage: "includes pairs with the same base currency converted into the requested quote currency",
+	},
+	&cli.StringFlag{
+		Name:  "exchanges",
+		Usage: "comma separated list of exchanges to consolidate, defaults to all enabled exchanges",
+	},
+	&cli.Int64Flag{
+		Name:  "depth",
+		Usage: "the maximum number of price levels returned for each side, 0 returns all levels",
+	},
+}
+
+// parseConsolidatedOrderbookRequest builds the consolidated orderbook request
+// from the supplied flags or arguments
+func parseConsolidatedOrderbookRequest(c *cli.Context) (*gctrpc.GetConsolidatedOrderbookRequest, error) {
+	var pair string
+	if c.IsSet("pair") {
+		pair = c.String("pair")
+	} else {
+		pair = c.Args().First()
+	}
+
+	if !validPair(pair) {
+		return nil, errInvalidPair
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(1)
+	}
+
+	assetType = strings.ToLower(assetType)
+	if !validAsset(assetType) {
+		return nil, errInvalidAsset
+	}
+
+	p, err := currency.NewPairDelimiter(pair, pairDelimiter)
+	if err != nil {
+		return nil, err
+	}
+
+	var exchanges []string
+	if c.IsSet("exchanges") {
+		exchanges = strings.Split(c.String("exchanges"), ",")
+	}
+
+	return &gctrpc.GetConsolidatedOrderbookRequest{
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: p.Delimiter,
+			Base:      p.Base.String(),
+			Quote:     p.Quote.String(),
+		},
+		AssetType:    assetType,
+		ConvertQuote: c.Bool("convertquote"),
+		Exchanges:    exchanges,
+		Depth:        c.Int64("depth"),
+	}, nil
+}
+
+func getConsolidatedOrderbook(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	req, err := parseConsolidatedOrderbookRequest(c)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetConsolidatedOrderbook(c.Context, req)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func getConsolidatedOrderbookStream(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	req, err := parseConsolidatedOrderbookRequest(c)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetConsolidatedOrderbookStream(c.Context, req)
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := result.Recv()
+		if err != nil {
+			return err
+		}
+
+		err = clearScreen()
+		if err != nil {
+			return err
+		}
+
+		fmt.Printf("Consolidated orderbook stream for %s %s across %s:\n\n",
+			resp.Pair.String(),
+			resp.AssetType,
+			strings.Join(resp.Exchanges, ", "))
+		fmt.Println("\t\tBids\t\t\t\tAsks")
+		fmt.Println()
+
+		maxLen := len(resp.Bids)
+		if len(resp.Asks) > maxLen {
+			maxLen = len(resp.Asks)
+		}
+
+		for i := 0; i < maxLen; i++ {
+			var bidAmount, bidPrice float64
+			if i < len(resp.Bids) {
+				bidAmount = resp.Bids[i].Amount
+				bidPrice = resp.Bids[i].Price
+			}
+
+			var askAmount, askPrice float64
+			if i < len(resp.Asks) {
+				askAmount = resp.Asks[i].Amount
+				askPrice = resp.Asks[i].Price
+			}
+
+			fmt.Printf("%.8f %s @ %.8f %s\t\t%.8f %s @ %.8f %s\n",
+				bidAmount,
+				resp.Pair.Base,
+				bidPrice,
+				resp.Pair.Quote,
+				askAmount,
+				resp.Pair.Base,
+				askPrice,
+				resp.Pair.Quote)
+
+			if i >= 49 {
+				// limits orderbook display output
+				break
+			}
+		}
+	}
+}
@@ -135,6 +135,12 @@ var dataHistoryJobCommands = &cli.Command{
 			Flags:  append(baseJobSubCommands, dataHandlingJobSubCommands...),
 			Action: upsertDataHistoryJob,
 		},
+		{
+			Name:   "convertbars",
+			Usage:  "convert trades saved to the database to tick, volume, dollar or imbalance bars",
+			Flags:  append(baseJobSubCommands, tradeBarJobSubCommands...),
+			Action: upsertDataHistoryJob,
+		},
 		{
 			Name:   "validatecandles",
 			Usage:  "will compare database candle data with API candle data - useful for validating converted trades and candles",
@@ -215,9 +221,12 @@ var (
 			Required: true,
 		},
 		&cli.StringFlag{
-			Name:     "pair",
-			Usage:    "eg btc-usdt",
-			Required: true,
+			Name:  "pair",
+			Usage: "eg btc-usdt - required unless all_enabled_pairs is set",
+		},
+		&cli.BoolFlag{
+			Name:  "all_enabled_pairs",
+			Usage: "if true, creates a job for every enabled pair of the exchange asset, each nickname is suffixed with its pair",
 		},
 		&cli.StringFlag{
 			Name:        "start_date",
@@ -256,6 +265,22 @@ var (
 			Name:  "upsert",
 			Usage: "if true, will update an existing job if the nickname is shared. if false, will reject a job if the nickname already exists",
 		},
+		&cli.Uint64Flag{
+			Name:  "recurrence_interval",
+			Usage: "if set, the job will be rescheduled every recurrence_interval seconds once complete, fetching data since its last end date",
+		},
+		&cli.Uint64Flag{
+			Name:  "settle_delay",
+			Usage: "the number of seconds before now that scheduled jobs will fetch data up to, allowing the latest data to settle",
+		},
+		&cli.Uint64Flag{
+			Name:  "rolling_window",
+			Usage: "if set, each run of the job will start rolling_window seconds before its end date",
+		},
+		&cli.BoolFlag{
+			Name:  "backfill_to_inception",
+			Usage: "candle and trade jobs only. if true, searches for the earliest data available and uses it as the start date",
+		},
 	}
 	dataHandlingJobSubCommands = []cli.Flag{
 		requestSize500Flag,
@@ -274,6 +299,19 @@ var (
 		requestSize500Flag,
 		overwriteDataFlag,
 	}
+	tradeBarJobSubCommands = []cli.Flag{
+		&cli.StringFlag{
+			Name:     "bar_type",
+			Usage:    "the bar to sample trades into: tick, volume, dollar or imbalance",
+			Required: true,
+		},
+		&cli.Float64Flag{
+			Name:     "bar_threshold",
+			Usage:    "the number of trades, volume, notional or absolute signed volume which closes a bar",
+			Required: true,
+		},
+		requestSize500Flag,
+	}
 	validationJobSubCommands = []cli.Flag{
 		requestSize50Flag,
 		comparisonDecimalPlacesFlag,
@@ -364,6 +402,7 @@ func upsertDataHistoryJob(c *cli.Context) error {
 		err                                 error
 		nickname, exchange, assetType, pair string
 		interval, dataType                  int64
+		allEnabledPairs                     bool
 	)
 	if c.IsSet("nickname") {
 		nickname = c.String("nickname")
@@ -380,32 +419,58 @@ func upsertDataHistoryJob(c *cli.Context) error {
 		return errInvalidAsset
 	}
 
-	if c.IsSet("pair") {
-		pair = c.String("pair")
-	}
-	if !validPair(pair) {
-		return errInvalidPair
+	if c.IsSet("all_enabled_pairs") {
+		allEnabledPairs = c.Bool("all_enabled_pairs")
 	}
-	p, err := currency.NewPairDelimiter(pair, pairDelimiter)
-	if err != nil {
-		return fmt.Errorf("cannot process pair: %w", err)
+	var p currency.Pair
+	if !allEnabledPairs {
+		if c.IsSet("pair") {
+			pair = c.String("pair")
+		}
+		if !validPair(pair) {
+			return errInvalidPair
+		}
+		p, err = currency.NewPairDelimiter(pair, pairDelimiter)
+		if err != nil {
+			return fmt.Errorf("cannot process pair: %w", err)
+		}
 	}
 
-	if c.IsSet("start_date") {
-		startTime = c.String("start_date")
+	var recurrenceInterval, settleDelay, rollingWindow int64
+	if c.IsSet("recurrence_interval") {
+		recurrenceInterval = c.Int64("recurrence_interval")
 	}
-	if c.IsSet("end_date") {
-		endTime = c.String("end_date")
+	if c.IsSet("settle_delay") {
+		settleDelay = c.Int64("settle_delay")
+	}
+	if c.IsSet("rolling_window") {
+		rollingWindow = c.Int64("rolling_window")
 	}
+	var backfillToInception bool
+	if c.IsSet("backfill_to_inception") {
+		backfillToInception = c.Bool("backfill_to_inception")
+	}
+	// scheduled jobs calculate their own dates unless they are explicitly set
+	scheduled := recurrenceInterval > 0 || rollingWindow > 0 || backfillToInception
 
-	var s, e time.Time
-	s, err = time.Parse(common.SimpleTimeFormat, startTime)
-	if err != nil {
-		return fmt.Errorf("invalid time format for start: %v", err)
+	var start, end string
+	if c.IsSet("start_date") || !scheduled {
+		startTime = c.String("start_date")
+		var s time.Time
+		s, err = time.Parse(common.SimpleTimeFormat, startTime)
+		if err != nil {
+			return fmt.Errorf("invalid time format for start: %v", err)
+		}
+		start = negateLocalOffset(s)
 	}
-	e, err = time.Parse(common.SimpleTimeFormat, endTime)
-	if err != nil {
-		return fmt.Errorf("invalid time format for end: %v", err)
+	if c.IsSet("end_date") || !scheduled {
+		endTime = c.String("end_date")
+		var e time.Time
+		e, err = time.Parse(common.SimpleTimeFormat, endTime)
+		if err != nil {
+			return fmt.Errorf("invalid time format for end: %v", err)
+		}
+		end = negateLocalOffset(e)
 	}
 
 	if c.IsSet("interval") {
@@ -461,6 +526,8 @@ func upsertDataHistoryJob(c *cli.Context) error {
 		dataType = 4
 	case "secondaryvalidatecandles":
 		dataType = 5
+	case "convertbars":
+		dataType = 6
 	default:
 		return errors.New("unrecognised command, cannot set data type")
 	}
@@ -488,6 +555,15 @@ func upsertDataHistoryJob(c *cli.Context) error {
 		}
 	}
 
+	var barType string
+	var barThreshold float64
+	if c.IsSet("bar_type") {
+		barType = c.String("bar_type")
+	}
+	if c.IsSet("bar_threshold") {
+		barThreshold = c.Float64("bar_threshold")
+	}
+
 	conn, cancel, err := setupClient(c)
 	if err != nil {
 		return err
@@ -504,8 +580,8 @@ func upsertDataHistoryJob(c *cli.Context) error {
 			Base:      p.Base.String(),
 			Quote:     p.Quote.String(),
 		},
-		StartDate:                negateLocalOffset(s),
-		EndDate:                  negateLocalOffset(e),
+		StartDate:                start,
+		EndDate:                  end,
 		Interval:                 int64(candleInterval),
 		RequestSizeLimit:         int64(requestSizeLimit),
 		DataType:                 dataType,
@@ -519,6 +595,22 @@ func upsertDataHistoryJob(c *cli.Context) error {
 		SecondaryExchangeName:    secondaryExchange,
 		IssueTolerancePercentage: intolerancePercentage,
 		ReplaceOnIssue:           replaceOnIssue,
+		RecurrenceInterval:       int64(time.Duration(recurrenceInterval) * time.Second),
+		SettleDelay:              int64(time.Duration(settleDelay) * time.Second),
+		RollingWindow:            int64(time.Duration(rollingWindow) * time.Second),
+		BackfillToInception:      backfillToInception,
+		BarType:                  barType,
+		BarThreshold:             barThreshold,
+	}
+
+	if allEnabledPairs {
+		var results *gctrpc.UpsertDataHistoryJobsResponse
+		results, err = client.UpsertDataHistoryJobsForEnabledPairs(c.Context, request)
+		if err != nil {
+			return err
+		}
+		jsonOutput(results)
+		return nil
 	}
 
 	result, err := client.UpsertDataHistoryJob(c.Context, request)
@@ -0,0 +1,50 @@
+package main
+
+import (
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli/v2"
+)
+
+var getFeeScheduleCommand = &cli.Command{
+	Name:      "getfeeschedule",
+	Usage:     "gets the loaded fee schedule for an exchange including the account tier, commissions and transfer fees",
+	ArgsUsage: "<exchange>",
+	Action:    getFeeSchedule,
+	Flags: []cli.Flag{
+		&cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to get the fee schedule for",
+		},
+	},
+}
+
+func getFeeSchedule(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "getfeeschedule")
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetFeeSchedule(c.Context,
+		&gctrpc.GetFeeScheduleRequest{
+			Exchange: exchangeName,
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
@@ -0,0 +1,442 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli/v2"
+)
+
+var futuresCommands = &cli.Command{
+	Name:      "futures",
+	Usage:     "execute futures market data and position commands",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []*cli.Command{
+		{
+			Name:      "getfundingrate",
+			Usage:     "gets the latest and predicted funding rate for a futures contract",
+			ArgsUsage: "<exchange> <pair> <asset>",
+			Action:    getFundingRate,
+			Flags:     orderbookAnalyticsFlags,
+		},
+		{
+			Name:      "getfundingratehistory",
+			Usage:     "gets historical funding rates for a futures contract",
+			ArgsUsage: "<exchange> <pair> <asset> <start> <end>",
+			Action:    getFundingRateHistory,
+			Flags: append(orderbookAnalyticsFlags,
+				&cli.StringFlag{
+					Name:        "start",
+					Usage:       "<start> rounded down to the nearest hour",
+					Value:       time.Now().AddDate(0, 0, -7).Truncate(time.Hour).Format(common.SimpleTimeFormat),
+					Destination: &startTime,
+				},
+				&cli.StringFlag{
+					Name:        "end",
+					Usage:       "<end>",
+					Value:       time.Now().Truncate(time.Hour).Format(common.SimpleTimeFormat),
+					Destination: &endTime,
+				},
+				&cli.BoolFlag{
+					Name:  "sync",
+					Usage: "<true/false> stores the retrieved funding rates in the database",
+				},
+				&cli.BoolFlag{
+					Name:  "db",
+					Usage: "<true/false> source funding rates from the database instead of the exchange",
+				},
+			),
+		},
+		{
+			Name:      "getopeninterest",
+			Usage:     "gets the current open interest for a futures contract",
+			ArgsUsage: "<exchange> <pair> <asset>",
+			Action:    getOpenInterest,
+			Flags:     orderbookAnalyticsFlags,
+		},
+		{
+			Name:      "getmarkprice",
+			Usage:     "gets the current mark and index price for a futures contract",
+			ArgsUsage: "<exchange> <pair> <asset>",
+			Action:    getMarkPrice,
+			Flags:     orderbookAnalyticsFlags,
+		},
+		{
+			Name:      "getpositions",
+			Usage:     "gets open futures positions, all contracts are returned when pair is unset",
+			ArgsUsage: "<exchange> <asset> <pair>",
+			Action:    getFuturesPositions,
+			Flags:     orderbookAnalyticsFlags,
+		},
+		{
+			Name:      "changeleverage",
+			Usage:     "sets the leverage for a futures contract",
+			ArgsUsage: "<exchange> <pair> <asset> <leverage>",
+			Action:    changeFuturesLeverage,
+			Flags: append(orderbookAnalyticsFlags,
+				&cli.Float64Flag{
+					Name:  "leverage",
+					Usage: "the leverage to apply",
+				},
+			),
+		},
+		{
+			Name:      "changemargintype",
+			Usage:     "sets the margin type for a futures contract",
+			ArgsUsage: "<exchange> <pair> <asset> <margintype>",
+			Action:    changeFuturesMarginType,
+			Flags: append(orderbookAnalyticsFlags,
+				&cli.StringFlag{
+					Name:  "margintype",
+					Usage: "isolated or cross",
+				},
+			),
+		},
+		{
+			Name:      "getmargininfo",
+			Usage:     "gets the leverage and margin settings for a futures contract",
+			ArgsUsage: "<exchange> <pair> <asset>",
+			Action:    getFuturesMarginInfo,
+			Flags:     orderbookAnalyticsFlags,
+		},
+	},
+}
+
+func getFundingRate(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	exchangeName, pair, assetType, err := parseOrderbookAnalyticsParams(c)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetFundingRate(c.Context,
+		&gctrpc.FuturesMarketDataRequest{
+			Exchange:  exchangeName,
+			Pair:      pair,
+			AssetType: assetType,
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func getFundingRateHistory(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	exchangeName, pair, assetType, err := parseOrderbookAnalyticsParams(c)
+	if err != nil {
+		return err
+	}
+
+	if !c.IsSet("start") {
+		if c.Args().Get(3) != "" {
+			startTime = c.Args().Get(3)
+		}
+	}
+
+	if !c.IsSet("end") {
+		if c.Args().Get(4) != "" {
+			endTime = c.Args().Get(4)
+		}
+	}
+
+	s, err := time.Parse(common.SimpleTimeFormat, startTime)
+	if err != nil {
+		return fmt.Errorf("invalid time format for start: %v", err)
+	}
+	e, err := time.Parse(common.SimpleTimeFormat, endTime)
+	if err != nil {
+		return fmt.Errorf("invalid time format for end: %v", err)
+	}
+
+	if e.Before(s) {
+		return errors.New("start cannot be after end")
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetFundingRateHistory(c.Context,
+		&gctrpc.GetFundingRateHistoryRequest{
+			Exchange:  exchangeName,
+			Pair:      pair,
+			AssetType: assetType,
+			Start:     negateLocalOffset(s),
+			End:       negateLocalOffset(e),
+			Sync:      c.Bool("sync"),
+			UseDb:     c.Bool("db"),
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func getOpenInterest(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	exchangeName, pair, assetType, err := parseOrderbookAnalyticsParams(c)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetOpenInterest(c.Context,
+		&gctrpc.FuturesMarketDataRequest{
+			Exchange:  exchangeName,
+			Pair:      pair,
+			AssetType: assetType,
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func getMarkPrice(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	exchangeName, pair, assetType, err := parseOrderbookAnalyticsParams(c)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetMarkPrice(c.Context,
+		&gctrpc.FuturesMarketDataRequest{
+			Exchange:  exchangeName,
+			Pair:      pair,
+			AssetType: assetType,
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func getFuturesPositions(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(1)
+	}
+
+	assetType = strings.ToLower(assetType)
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	var p string
+	if c.IsSet("pair") {
+		p = c.String("pair")
+	} else {
+		p = c.Args().Get(2)
+	}
+
+	var pair *gctrpc.CurrencyPair
+	if p != "" {
+		if !validPair(p) {
+			return errInvalidPair
+		}
+		cp, err := currency.NewPairDelimiter(p, pairDelimiter)
+		if err != nil {
+			return err
+		}
+		pair = &gctrpc.CurrencyPair{
+			Delimiter: cp.Delimiter,
+			Base:      cp.Base.String(),
+			Quote:     cp.Quote.String(),
+		}
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetFuturesPositions(c.Context,
+		&gctrpc.FuturesMarketDataRequest{
+			Exchange:  exchangeName,
+			Pair:      pair,
+			AssetType: assetType,
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func changeFuturesLeverage(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	exchangeName, pair, assetType, err := parseOrderbookAnalyticsParams(c)
+	if err != nil {
+		return err
+	}
+
+	var leverage float64
+	if c.IsSet("leverage") {
+		leverage = c.Float64("leverage")
+	} else {
+		leverage, err = strconv.ParseFloat(c.Args().Get(3), 64)
+		if err != nil {
+			return err
+		}
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.ChangeFuturesLeverage(c.Context,
+		&gctrpc.ChangeFuturesLeverageRequest{
+			Exchange:  exchangeName,
+			Pair:      pair,
+			AssetType: assetType,
+			Leverage:  leverage,
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func changeFuturesMarginType(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	exchangeName, pair, assetType, err := parseOrderbookAnalyticsParams(c)
+	if err != nil {
+		return err
+	}
+
+	var marginType string
+	if c.IsSet("margintype") {
+		marginType = c.String("margintype")
+	} else {
+		marginType = c.Args().Get(3)
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.ChangeFuturesMarginType(c.Context,
+		&gctrpc.ChangeFuturesMarginTypeRequest{
+			Exchange:   exchangeName,
+			Pair:       pair,
+			AssetType:  assetType,
+			MarginType: marginType,
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func getFuturesMarginInfo(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	exchangeName, pair, assetType, err := parseOrderbookAnalyticsParams(c)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetFuturesMarginInfo(c.Context,
+		&gctrpc.FuturesMarketDataRequest{
+			Exchange:  exchangeName,
+			Pair:      pair,
+			AssetType: assetType,
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
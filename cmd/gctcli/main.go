@@ -114,6 +114,7 @@ func main() {
 		getExchangeOTPCommand,
 		getExchangeOTPsCommand,
 		getExchangeInfoCommand,
+		getExchangeCapabilitiesCommand,
 		getTickerCommand,
 		getTickersCommand,
 		getOrderbookCommand,
@@ -138,13 +139,17 @@ func main() {
 		cancelBatchOrdersCommand,
 		cancelAllOrdersCommand,
 		modifyOrderCommand,
+		submitBatchOrdersCommand,
+		modifyBatchOrdersCommand,
 		getEventsCommand,
 		addEventCommand,
 		removeEventCommand,
 		getCryptocurrencyDepositAddressesCommand,
 		getCryptocurrencyDepositAddressCommand,
+		getAvailableTransferChainsCommand,
 		withdrawCryptocurrencyFundsCommand,
 		withdrawFiatFundsCommand,
+		transferFundsCommand,
 		withdrawalRequestCommand,
 		getLoggerDetailsCommand,
 		setLoggerDetailsCommand,
@@ -153,15 +158,28 @@ func main() {
 		getExchangeOrderbookStreamCommand,
 		getTickerStreamCommand,
 		getExchangeTickerStreamCommand,
+		getFillStreamCommand,
+		getLiveCandleStreamCommand,
 		getAuditEventCommand,
 		getHistoricCandlesCommand,
 		getHistoricCandlesExtendedCommand,
 		findMissingSavedCandleIntervalsCommand,
+		exportDataCommand,
+		getDataQualityReportCommand,
+		runRetentionPoliciesCommand,
+		symbolHistoryCommand,
+		getCompositeIndexPricesCommand,
 		gctScriptCommand,
 		websocketManagerCommand,
 		tradeCommand,
 		dataHistoryCommands,
 		currencyStateManagementCommand,
+		consolidatedOrderbookCommand,
+		orderbookAnalyticsCommand,
+		getOrderbookHistoryCommand,
+		futuresCommands,
+		marginCommands,
+		getFeeScheduleCommand,
 	}
 
 	ctx, cancel := context.WithCancel(context.Background())
@@ -0,0 +1,430 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli/v2"
+)
+
+var marginCurrencyFlags = []cli.Flag{
+	&cli.StringFlag{
+		Name:  "exchange",
+		Usage: "the exchange to act on",
+	},
+	&cli.StringFlag{
+		Name:  "currency",
+		Usage: "the currency to act on",
+	},
+}
+
+var marginLoanFlags = append(marginCurrencyFlags,
+	&cli.Float64Flag{
+		Name:  "amount",
+		Usage: "the amount to borrow or repay",
+	},
+	&cli.StringFlag{
+		Name:  "pair",
+		Usage: "the isolated margin pair, the cross margin account is used when unset",
+	},
+)
+
+var marginCommands = &cli.Command{
+	Name:      "margin",
+	Usage:     "execute margin borrowing and lending commands",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []*cli.Command{
+		{
+			Name:      "getinterestrates",
+			Usage:     "gets the current daily margin interest rates for a currency",
+			ArgsUsage: "<exchange> <currency>",
+			Action:    getMarginInterestRates,
+			Flags:     marginCurrencyFlags,
+		},
+		{
+			Name:      "borrow",
+			Usage:     "borrows funds against a margin account",
+			ArgsUsage: "<exchange> <currency> <amount> <pair>",
+			Action:    marginBorrow,
+			Flags:     marginLoanFlags,
+		},
+		{
+			Name:      "repay",
+			Usage:     "repays funds borrowed against a margin account",
+			ArgsUsage: "<exchange> <currency> <amount> <pair>",
+			Action:    marginRepay,
+			Flags:     marginLoanFlags,
+		},
+		{
+			Name:      "lend",
+			Usage:     "offers funds on the exchange lending market",
+			ArgsUsage: "<exchange> <currency> <amount> <rate> <period>",
+			Action:    submitMarginLendOffer,
+			Flags: append(marginCurrencyFlags,
+				&cli.Float64Flag{
+					Name:  "amount",
+					Usage: "the amount to lend",
+				},
+				&cli.Float64Flag{
+					Name:  "rate",
+					Usage: "the daily interest rate as a fraction e.g. 0.0002 for 0.02%",
+				},
+				&cli.Int64Flag{
+					Name:  "period",
+					Usage: "the loan period in days, the exchange default is used when unset",
+				},
+				&cli.BoolFlag{
+					Name:  "autorenew",
+					Usage: "<true/false> renews the offer once the loan is repaid",
+				},
+			),
+		},
+		{
+			Name:      "cancellend",
+			Usage:     "cancels an open lending offer",
+			ArgsUsage: "<exchange> <currency> <id>",
+			Action:    cancelMarginLendOffer,
+			Flags: append(marginCurrencyFlags,
+				&cli.StringFlag{
+					Name:  "id",
+					Usage: "the lending offer ID",
+				},
+			),
+		},
+		{
+			Name:      "getloans",
+			Usage:     "gets open lending offers and active loans, all currencies are returned when currency is unset",
+			ArgsUsage: "<exchange> <currency>",
+			Action:    getMarginLoans,
+			Flags:     marginCurrencyFlags,
+		},
+		{
+			Name:      "getinteresthistory",
+			Usage:     "gets interest paid and earned, all currencies are returned when currency is unset",
+			ArgsUsage: "<exchange> <currency> <start> <end>",
+			Action:    getMarginInterestHistory,
+			Flags: append(marginCurrencyFlags,
+				&cli.StringFlag{
+					Name:        "start",
+					Usage:       "<start>",
+					Value:       time.Now().AddDate(0, 0, -30).Truncate(time.Hour).Format(common.SimpleTimeFormat),
+					Destination: &startTime,
+				},
+				&cli.StringFlag{
+					Name:        "end",
+					Usage:       "<end>",
+					Value:       time.Now().Truncate(time.Hour).Format(common.SimpleTimeFormat),
+					Destination: &endTime,
+				},
+			),
+		},
+	},
+}
+
+func parseMarginCurrencyParams(c *cli.Context) (exchangeName, cur string) {
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+	if c.IsSet("currency") {
+		cur = c.String("currency")
+	} else {
+		cur = c.Args().Get(1)
+	}
+	return exchangeName, cur
+}
+
+func parseMarginAmount(c *cli.Context, name string, position int) (float64, error) {
+	if c.IsSet(name) {
+		return c.Float64(name), nil
+	}
+	if c.Args().Get(position) == "" {
+		return 0, nil
+	}
+	return strconv.ParseFloat(c.Args().Get(position), 64)
+}
+
+func getMarginInterestRates(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	exchangeName, cur := parseMarginCurrencyParams(c)
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetMarginInterestRates(c.Context,
+		&gctrpc.MarginCurrencyRequest{
+			Exchange: exchangeName,
+			Currency: cur,
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func parseMarginLoanRequest(c *cli.Context) (*gctrpc.MarginLoanRequest, error) {
+	exchangeName, cur := parseMarginCurrencyParams(c)
+	amount, err := parseMarginAmount(c, "amount", 2)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &gctrpc.MarginLoanRequest{
+		Exchange: exchangeName,
+		Currency: cur,
+		Amount:   amount,
+	}
+
+	var p string
+	if c.IsSet("pair") {
+		p = c.String("pair")
+	} else {
+		p = c.Args().Get(3)
+	}
+	if p != "" {
+		if !validPair(p) {
+			return nil, errInvalidPair
+		}
+		cp, err := currency.NewPairDelimiter(p, pairDelimiter)
+		if err != nil {
+			return nil, err
+		}
+		req.Pair = &gctrpc.CurrencyPair{
+			Delimiter: cp.Delimiter,
+			Base:      cp.Base.String(),
+			Quote:     cp.Quote.String(),
+		}
+	}
+	return req, nil
+}
+
+func marginBorrow(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	req, err := parseMarginLoanRequest(c)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.MarginBorrow(c.Context, req)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func marginRepay(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	req, err := parseMarginLoanRequest(c)
+	if err != nil {
+		return err
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.MarginRepay(c.Context, req)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func submitMarginLendOffer(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	exchangeName, cur := parseMarginCurrencyParams(c)
+	amount, err := parseMarginAmount(c, "amount", 2)
+	if err != nil {
+		return err
+	}
+	rate, err := parseMarginAmount(c, "rate", 3)
+	if err != nil {
+		return err
+	}
+
+	var period int64
+	if c.IsSet("period") {
+		period = c.Int64("period")
+	} else if c.Args().Get(4) != "" {
+		period, err = strconv.ParseInt(c.Args().Get(4), 10, 64)
+		if err != nil {
+			return err
+		}
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.SubmitMarginLendOffer(c.Context,
+		&gctrpc.SubmitMarginLendOfferRequest{
+			Exchange:  exchangeName,
+			Currency:  cur,
+			Amount:    amount,
+			Rate:      rate,
+			Period:    period,
+			AutoRenew: c.Bool("autorenew"),
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func cancelMarginLendOffer(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	exchangeName, cur := parseMarginCurrencyParams(c)
+	var id string
+	if c.IsSet("id") {
+		id = c.String("id")
+	} else {
+		id = c.Args().Get(2)
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.CancelMarginLendOffer(c.Context,
+		&gctrpc.CancelMarginLendOfferRequest{
+			Exchange: exchangeName,
+			Currency: cur,
+			Id:       id,
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func getMarginLoans(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	exchangeName, cur := parseMarginCurrencyParams(c)
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetMarginLoans(c.Context,
+		&gctrpc.MarginCurrencyRequest{
+			Exchange: exchangeName,
+			Currency: cur,
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func getMarginInterestHistory(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	exchangeName, cur := parseMarginCurrencyParams(c)
+
+	if !c.IsSet("start") {
+		if c.Args().Get(2) != "" {
+			startTime = c.Args().Get(2)
+		}
+	}
+
+	if !c.IsSet("end") {
+		if c.Args().Get(3) != "" {
+			endTime = c.Args().Get(3)
+		}
+	}
+
+	s, err := time.Parse(common.SimpleTimeFormat, startTime)
+	if err != nil {
+		return fmt.Errorf("invalid time format for start: %v", err)
+	}
+	e, err := time.Parse(common.SimpleTimeFormat, endTime)
+	if err != nil {
+		return fmt.Errorf("invalid time format for end: %v", err)
+	}
+
+	if e.Before(s) {
+		return errors.New("start cannot be after end")
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetMarginInterestHistory(c.Context,
+		&gctrpc.GetMarginInterestHistoryRequest{
+			Exchange: exchangeName,
+			Currency: cur,
+			Start:    negateLocalOffset(s),
+			End:      negateLocalOffset(e),
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
@@ -0,0 +1,195 @@
+package main
+
+import (
+	"strconv"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli/v2"
+)
+
+var orderbookAnalyticsCommand = &cli.Command{
+	Name:      "orderbookanalytics",
+	Usage:     "execute orderbook analytics command",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []*cli.Command{
+		{
+			Name:      "get",
+			Usage:     "gets imbalance, microprice, liquidity and market impact for an exchange orderbook",
+			ArgsUsage: "<exchange> <pair> <asset>",
+			Flags: append(orderbookAnalyticsFlags,
+				&cli.Int64Flag{
+					Name:  "levels",
+					Usage: "the number of levels used to calculate imbalance, 0 uses the entire book",
+				},
+				&cli.Float64Flag{
+					Name:  "bps",
+					Usage: "the distance from the mid price in basis points to sum liquidity",
+				},
+				&cli.StringFlag{
+					Name:  "impactamounts",
+					Usage: "comma separated list of base amounts to calculate market impact e.g. 0.1,1,10",
+				},
+			),
+			Action: getOrderbookAnalytics,
+		},
+		{
+			Name:      "spread",
+			Usage:     "samples an exchange orderbook spread and returns its statistics",
+			ArgsUsage: "<exchange> <pair> <asset> <seconds>",
+			Flags: append(orderbookAnalyticsFlags,
+				&cli.Int64Flag{
+					Name:  "seconds",
+					Usage: "the number of seconds to sample the spread",
+					Value: 10,
+				},
+			),
+			Action: getOrderbookSpreadStatistics,
+		},
+	},
+}
+
+var orderbookAnalyticsFlags = []cli.Flag{
+	&cli.StringFlag{
+		Name:  "exchange",
+		Usage: "the exchange to act on",
+	},
+	&cli.StringFlag{
+		Name:  "pair",
+		Usage: "the currency pair e.g. btc-usd",
+	},
+	&cli.StringFlag{
+		Name:  "asset",
+		Usage: "the asset type",
+	},
+}
+
+// parseOrderbookAnalyticsParams returns the exchange, pair and asset from the
+// supplied flags or arguments
+func parseOrderbookAnalyticsParams(c *cli.Context) (exchangeName string, pair *gctrpc.CurrencyPair, assetType string, err error) {
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	var p string
+	if c.IsSet("pair") {
+		p = c.String("pair")
+	} else {
+		p = c.Args().Get(1)
+	}
+
+	if !validPair(p) {
+		return "", nil, "", errInvalidPair
+	}
+
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(2)
+	}
+
+	assetType = strings.ToLower(assetType)
+	if !validAsset(assetType) {
+		return "", nil, "", errInvalidAsset
+	}
+
+	cp, err := currency.NewPairDelimiter(p, pairDelimiter)
+	if err != nil {
+		return "", nil, "", err
+	}
+
+	return exchangeName, &gctrpc.CurrencyPair{
+		Delimiter: cp.Delimiter,
+		Base:      cp.Base.String(),
+		Quote:     cp.Quote.String(),
+	}, assetType, nil
+}
+
+func getOrderbookAnalytics(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	exchangeName, pair, assetType, err := parseOrderbookAnalyticsParams(c)
+	if err != nil {
+		return err
+	}
+
+	var impactAmounts []float64
+	if c.IsSet("impactamounts") {
+		amounts := strings.Split(c.String("impactamounts"), ",")
+		impactAmounts = make([]float64, len(amounts))
+		for x := range amounts {
+			impactAmounts[x], err = strconv.ParseFloat(strings.TrimSpace(amounts[x]), 64)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetOrderbookAnalytics(c.Context,
+		&gctrpc.GetOrderbookAnalyticsRequest{
+			Exchange:      exchangeName,
+			Pair:          pair,
+			AssetType:     assetType,
+			Levels:        c.Int64("levels"),
+			BasisPoints:   c.Float64("bps"),
+			ImpactAmounts: impactAmounts,
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func getOrderbookSpreadStatistics(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	exchangeName, pair, assetType, err := parseOrderbookAnalyticsParams(c)
+	if err != nil {
+		return err
+	}
+
+	seconds := c.Int64("seconds")
+	if !c.IsSet("seconds") && c.Args().Get(3) != "" {
+		seconds, err = strconv.ParseInt(c.Args().Get(3), 10, 64)
+		if err != nil {
+			return err
+		}
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetOrderbookSpreadStatistics(c.Context,
+		&gctrpc.GetOrderbookSpreadStatisticsRequest{
+			Exchange:      exchangeName,
+			Pair:          pair,
+			AssetType:     assetType,
+			SampleSeconds: seconds,
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
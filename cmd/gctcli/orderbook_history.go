@@ -0,0 +1,95 @@
+package main
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli/v2"
+)
+
+var getOrderbookHistoryCommand = &cli.Command{
+	Name:      "getorderbookhistory",
+	Usage:     "gets captured orderbook snapshots from the orderbook history manager",
+	ArgsUsage: "<exchange> <pair> <asset> <start> <end>",
+	Action:    getOrderbookHistory,
+	Flags: append(orderbookAnalyticsFlags,
+		&cli.StringFlag{
+			Name:        "start",
+			Usage:       "<start> rounded down to the nearest second",
+			Value:       time.Now().Add(-time.Hour).Format(common.SimpleTimeFormat),
+			Destination: &startTime,
+		},
+		&cli.StringFlag{
+			Name:        "end",
+			Usage:       "<end> rounded down to the nearest second",
+			Value:       time.Now().Format(common.SimpleTimeFormat),
+			Destination: &endTime,
+		},
+		&cli.BoolFlag{
+			Name:  "database",
+			Usage: "read snapshots from database storage rather than file storage",
+		},
+	),
+}
+
+func getOrderbookHistory(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "getorderbookhistory")
+	}
+
+	exchangeName, pair, assetType, err := parseOrderbookAnalyticsParams(c)
+	if err != nil {
+		return err
+	}
+
+	if !c.IsSet("start") {
+		if c.Args().Get(3) != "" {
+			startTime = c.Args().Get(3)
+		}
+	}
+
+	if !c.IsSet("end") {
+		if c.Args().Get(4) != "" {
+			endTime = c.Args().Get(4)
+		}
+	}
+
+	s, err := time.Parse(common.SimpleTimeFormat, startTime)
+	if err != nil {
+		return fmt.Errorf("invalid time format for start: %v", err)
+	}
+	e, err := time.Parse(common.SimpleTimeFormat, endTime)
+	if err != nil {
+		return fmt.Errorf("invalid time format for end: %v", err)
+	}
+
+	if e.Before(s) {
+		return errors.New("start cannot be after end")
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetOrderbookHistory(c.Context,
+		&gctrpc.GetOrderbookHistoryRequest{
+			Exchange:    exchangeName,
+			Pair:        pair,
+			AssetType:   assetType,
+			Start:       negateLocalOffset(s),
+			End:         negateLocalOffset(e),
+			UseDatabase: c.Bool("database"),
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
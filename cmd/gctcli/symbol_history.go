@@ -0,0 +1,226 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/urfave/cli/v2"
+)
+
+var symbolHistoryCommand = &cli.Command{
+	Name:      "symbolhistory",
+	Usage:     "execute symbol history command",
+	ArgsUsage: "<command> <args>",
+	Subcommands: []*cli.Command{
+		{
+			Name:      "get",
+			Usage:     "returns the recorded listings, delistings and renames of an exchange's pairs",
+			ArgsUsage: "<exchange> <asset> <pair>",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "exchange",
+					Usage: "the exchange to get the symbol history for",
+				},
+				&cli.StringFlag{
+					Name:  "asset",
+					Usage: "optional - the asset type to filter by",
+				},
+				&cli.StringFlag{
+					Name:  "pair",
+					Usage: "optional - the currency pair to filter by",
+				},
+			},
+			Action: getSymbolHistory,
+		},
+		{
+			Name:      "addalias",
+			Usage:     "declares that a pair continues under a new symbol, saved candles and trades of the old pair are returned with the new pair's data",
+			ArgsUsage: "<exchange> <asset> <oldpair> <newpair> <date>",
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:  "exchange",
+					Usage: "the exchange the pair was renamed on",
+				},
+				&cli.StringFlag{
+					Name:  "asset",
+					Usage: "the asset type of the pair",
+				},
+				&cli.StringFlag{
+					Name:  "oldpair",
+					Usage: "the currency pair before the rename",
+				},
+				&cli.StringFlag{
+					Name:  "newpair",
+					Usage: "the currency pair after the rename",
+				},
+				&cli.StringFlag{
+					Name:  "date",
+					Usage: "the date the new pair is effective from, rounded down to the nearest second",
+					Value: time.Now().Format(common.SimpleTimeFormat),
+				},
+			},
+			Action: addSymbolAlias,
+		},
+	},
+}
+
+func getSymbolHistory(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(1)
+	}
+	assetType = strings.ToLower(assetType)
+	if assetType != "" && !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	var currencyPair string
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(2)
+	}
+	var pair *gctrpc.CurrencyPair
+	if currencyPair != "" {
+		if !validPair(currencyPair) {
+			return errInvalidPair
+		}
+		p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+		if err != nil {
+			return err
+		}
+		pair = &gctrpc.CurrencyPair{
+			Delimiter: p.Delimiter,
+			Base:      p.Base.String(),
+			Quote:     p.Quote.String(),
+		}
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetSymbolHistory(c.Context,
+		&gctrpc.GetSymbolHistoryRequest{
+			Exchange:  exchangeName,
+			AssetType: assetType,
+			Pair:      pair,
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+func addSymbolAlias(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowSubcommandHelp(c)
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(1)
+	}
+	assetType = strings.ToLower(assetType)
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	var oldPair string
+	if c.IsSet("oldpair") {
+		oldPair = c.String("oldpair")
+	} else {
+		oldPair = c.Args().Get(2)
+	}
+	if !validPair(oldPair) {
+		return errInvalidPair
+	}
+	oldP, err := currency.NewPairDelimiter(oldPair, pairDelimiter)
+	if err != nil {
+		return err
+	}
+
+	var newPair string
+	if c.IsSet("newpair") {
+		newPair = c.String("newpair")
+	} else {
+		newPair = c.Args().Get(3)
+	}
+	if !validPair(newPair) {
+		return errInvalidPair
+	}
+	newP, err := currency.NewPairDelimiter(newPair, pairDelimiter)
+	if err != nil {
+		return err
+	}
+
+	effectiveDate := c.String("date")
+	if !c.IsSet("date") && c.Args().Get(4) != "" {
+		effectiveDate = c.Args().Get(4)
+	}
+	d, err := time.Parse(common.SimpleTimeFormat, effectiveDate)
+	if err != nil {
+		return fmt.Errorf("invalid time format for date: %v", err)
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.AddSymbolAlias(c.Context,
+		&gctrpc.AddSymbolAliasRequest{
+			Exchange:  exchangeName,
+			AssetType: assetType,
+			OldPair: &gctrpc.CurrencyPair{
+				Delimiter: oldP.Delimiter,
+				Base:      oldP.Base.String(),
+				Quote:     oldP.Quote.String(),
+			},
+			NewPair: &gctrpc.CurrencyPair{
+				Delimiter: newP.Delimiter,
+				Base:      newP.Base.String(),
+				Quote:     newP.Quote.String(),
+			},
+			EffectiveDate: negateLocalOffset(d),
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
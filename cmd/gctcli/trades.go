@@ -214,6 +214,56 @@ var tradeCommand = &cli.Command{
 				},
 			},
 		},
+		{
+			Name:      "convertsavedtradestobars",
+			Usage:     "converts stored trade data to tick, volume, dollar or imbalance bars",
+			ArgsUsage: "<exchange> <pair> <asset> <bar_type> <bar_threshold> <start> <end>",
+			Action:    convertSavedTradesToBars,
+			Flags: []cli.Flag{
+				&cli.StringFlag{
+					Name:    "exchange",
+					Aliases: []string{"e"},
+					Usage:   "the exchange",
+				},
+				&cli.StringFlag{
+					Name:    "pair",
+					Aliases: []string{"p"},
+					Usage:   "the currency pair to get the trades for",
+				},
+				&cli.StringFlag{
+					Name:    "asset",
+					Aliases: []string{"a"},
+					Usage:   "the asset type of the currency pair",
+				},
+				&cli.StringFlag{
+					Name:    "bar_type",
+					Aliases: []string{"b"},
+					Usage:   "the bar to sample trades into: tick, volume, dollar or imbalance",
+				},
+				&cli.Float64Flag{
+					Name:    "bar_threshold",
+					Aliases: []string{"t"},
+					Usage:   "the number of trades, volume, notional or absolute signed volume which closes a bar",
+				},
+				&cli.StringFlag{
+					Name:        "start",
+					Usage:       "<start>",
+					Value:       time.Now().AddDate(0, -1, 0).Format(common.SimpleTimeFormat),
+					Destination: &startTime,
+				},
+				&cli.StringFlag{
+					Name:        "end",
+					Usage:       "<end>",
+					Value:       time.Now().Format(common.SimpleTimeFormat),
+					Destination: &endTime,
+				},
+				&cli.BoolFlag{
+					Name:    "sync",
+					Aliases: []string{"s"},
+					Usage:   "will replace any bars saved to the database for the range with the result <true/false>",
+				},
+			},
+		},
 	},
 }
 
@@ -727,3 +777,118 @@ func convertSavedTradesToCandles(c *cli.Context) error {
 	jsonOutput(result)
 	return nil
 }
+
+func convertSavedTradesToBars(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		return cli.ShowCommandHelp(c, "convertsavedtradestobars")
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+	var currencyPair string
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(1)
+	}
+	if !validPair(currencyPair) {
+		return errInvalidPair
+	}
+
+	p, err := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	if err != nil {
+		return err
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(2)
+	}
+
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	var barType string
+	if c.IsSet("bar_type") {
+		barType = c.String("bar_type")
+	} else {
+		barType = c.Args().Get(3)
+	}
+
+	var barThreshold float64
+	if c.IsSet("bar_threshold") {
+		barThreshold = c.Float64("bar_threshold")
+	} else if c.Args().Get(4) != "" {
+		barThreshold, err = strconv.ParseFloat(c.Args().Get(4), 64)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !c.IsSet("start") {
+		if c.Args().Get(5) != "" {
+			startTime = c.Args().Get(5)
+		}
+	}
+
+	if !c.IsSet("end") {
+		if c.Args().Get(6) != "" {
+			endTime = c.Args().Get(6)
+		}
+	}
+
+	var sync bool
+	if c.IsSet("sync") {
+		sync = c.Bool("sync")
+	}
+
+	var s, e time.Time
+	s, err = time.Parse(common.SimpleTimeFormat, startTime)
+	if err != nil {
+		return fmt.Errorf("invalid time format for start: %v", err)
+	}
+	e, err = time.Parse(common.SimpleTimeFormat, endTime)
+	if err != nil {
+		return fmt.Errorf("invalid time format for end: %v", err)
+	}
+
+	if e.Before(s) {
+		return errors.New("start cannot be after end")
+	}
+
+	conn, cancel, err := setupClient(c)
+	if err != nil {
+		return err
+	}
+	defer closeConn(conn, cancel)
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.ConvertTradesToBars(c.Context,
+		&gctrpc.ConvertTradesToBarsRequest{
+			Exchange: exchangeName,
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: p.Delimiter,
+				Base:      p.Base.String(),
+				Quote:     p.Quote.String(),
+			},
+			AssetType:    assetType,
+			Start:        negateLocalOffset(s),
+			End:          negateLocalOffset(e),
+			BarType:      barType,
+			BarThreshold: barThreshold,
+			Sync:         sync,
+		})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
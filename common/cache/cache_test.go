@@ -55,6 +55,9 @@ func TestClear(t *testing.T) {
 	if lruCache.Len() != 0 {
 		t.Fatal("expected cache to have 0 entries")
 	}
+	if lruCache.Contains(0) {
+		t.Fatal("expected cleared entry to be removed")
+	}
 }
 
 func TestAdd(t *testing.T) {
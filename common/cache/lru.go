@@ -81,7 +81,7 @@ func (l *LRU) Remove(key interface{}) bool {
 // Clear is used to completely clear the cache.
 func (l *LRU) Clear() {
 	for x := range l.items {
-		delete(l.items, l.items[x])
+		delete(l.items, x)
 	}
 	l.l.Init()
 }
@@ -0,0 +1,131 @@
+package parquet
+
+import (
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/xitongsys/parquet-go-source/writer"
+	"github.com/xitongsys/parquet-go/parquet"
+	pqwriter "github.com/xitongsys/parquet-go/writer"
+)
+
+// NewWriter returns a parquet writer for the supplied columns which writes to
+// w. Rows are buffered by the parquet writer and written in row groups.
+func NewWriter(w io.Writer, columns ...Column) (*Writer, error) {
+	if w == nil {
+		return nil, errNilWriter
+	}
+	if len(columns) == 0 {
+		return nil, errNoColumns
+	}
+	names := make(map[string]bool, len(columns))
+	schema := make([]string, len(columns))
+	for i := range columns {
+		if columns[i].Name == "" {
+			return nil, errEmptyColumnName
+		}
+		if names[columns[i].Name] {
+			return nil, fmt.Errorf("%w: %s", errDuplicateColumn, columns[i].Name)
+		}
+		names[columns[i].Name] = true
+		t := columns[i].Type.schemaType()
+		if t == "" {
+			return nil, fmt.Errorf("%w: %s", ErrUnsupportedType, columns[i].Name)
+		}
+		schema[i] = "name=" + columns[i].Name + ", type=" + t
+	}
+	pw, err := pqwriter.NewCSVWriter(schema, writer.NewWriterFile(w), 1)
+	if err != nil {
+		return nil, err
+	}
+	pw.CompressionType = parquet.CompressionCodec_SNAPPY
+	return &Writer{pw: pw, columns: columns}, nil
+}
+
+// WriteRow appends a row, values must be supplied in column order and match
+// each column's type: int64 for Int64, float64 for Double, string for String
+// and time.Time for Timestamp
+func (w *Writer) WriteRow(values ...interface{}) error {
+	if w.closed {
+		return ErrWriterClosed
+	}
+	if len(values) != len(w.columns) {
+		return fmt.Errorf("%w: received %d values for %d columns", errColumnCountMismatch, len(values), len(w.columns))
+	}
+	row := make([]interface{}, len(values))
+	for i := range values {
+		if !w.columns[i].Type.accepts(values[i]) {
+			return fmt.Errorf("%w: column %s expects %s, received %T", ErrTypeMismatch, w.columns[i].Name, w.columns[i].Type, values[i])
+		}
+		row[i] = values[i]
+		if t, ok := values[i].(time.Time); ok {
+			row[i] = t.UnixNano() / int64(time.Millisecond)
+		}
+	}
+	if err := w.pw.Write(row); err != nil {
+		return err
+	}
+	w.rows++
+	return nil
+}
+
+// Rows returns the number of rows written
+func (w *Writer) Rows() int64 {
+	return w.rows
+}
+
+// Close writes any buffered rows and the file footer, the underlying writer
+// is not closed
+func (w *Writer) Close() error {
+	if w.closed {
+		return ErrWriterClosed
+	}
+	w.closed = true
+	return w.pw.WriteStop()
+}
+
+// String implements the stringer interface
+func (t Type) String() string {
+	switch t {
+	case Int64:
+		return "int64"
+	case Double:
+		return "double"
+	case String:
+		return "string"
+	case Timestamp:
+		return "timestamp"
+	}
+	return "unknown"
+}
+
+// schemaType returns the parquet-go schema type of a column, converted types
+// set the annotation and the physical type
+func (t Type) schemaType() string {
+	switch t {
+	case Int64:
+		return "INT64"
+	case Double:
+		return "DOUBLE"
+	case String:
+		return "UTF8"
+	case Timestamp:
+		return "TIMESTAMP_MILLIS"
+	}
+	return ""
+}
+
+func (t Type) accepts(v interface{}) bool {
+	switch v.(type) {
+	case int64:
+		return t == Int64
+	case float64:
+		return t == Double
+	case string:
+		return t == String
+	case time.Time:
+		return t == Timestamp
+	}
+	return false
+}
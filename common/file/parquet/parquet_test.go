@@ -0,0 +1,165 @@
+package parquet
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/xitongsys/parquet-go-source/buffer"
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/reader"
+)
+
+func TestNewWriter(t *testing.T) {
+	t.Parallel()
+	_, err := NewWriter(nil)
+	if !errors.Is(err, errNilWriter) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errNilWriter)
+	}
+	var buf bytes.Buffer
+	_, err = NewWriter(&buf)
+	if !errors.Is(err, errNoColumns) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errNoColumns)
+	}
+	_, err = NewWriter(&buf, Column{Type: Int64})
+	if !errors.Is(err, errEmptyColumnName) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errEmptyColumnName)
+	}
+	_, err = NewWriter(&buf, Column{Name: "a", Type: Int64}, Column{Name: "a", Type: Double})
+	if !errors.Is(err, errDuplicateColumn) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errDuplicateColumn)
+	}
+	_, err = NewWriter(&buf, Column{Name: "a"})
+	if !errors.Is(err, ErrUnsupportedType) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrUnsupportedType)
+	}
+}
+
+func TestWriteRow(t *testing.T) {
+	t.Parallel()
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, Column{Name: "a", Type: Int64}, Column{Name: "b", Type: String})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	err = w.WriteRow(int64(1))
+	if !errors.Is(err, errColumnCountMismatch) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errColumnCountMismatch)
+	}
+	err = w.WriteRow(1, "b")
+	if !errors.Is(err, ErrTypeMismatch) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrTypeMismatch)
+	}
+	err = w.WriteRow(int64(1), "b")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	err = w.Close()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	err = w.WriteRow(int64(1), "b")
+	if !errors.Is(err, ErrWriterClosed) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrWriterClosed)
+	}
+	err = w.Close()
+	if !errors.Is(err, ErrWriterClosed) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrWriterClosed)
+	}
+}
+
+func TestFileLayout(t *testing.T) {
+	t.Parallel()
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf,
+		Column{Name: "timestamp", Type: Timestamp},
+		Column{Name: "id", Type: Int64},
+		Column{Name: "price", Type: Double},
+		Column{Name: "side", Type: String})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	tn := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	rows := [][]interface{}{
+		{tn, int64(1), 29000.5, "BUY"},
+		{tn.Add(time.Minute), int64(2), 29001.25, "SELL"},
+		{tn.Add(time.Minute * 2), int64(3), 28999.75, "BUY"},
+	}
+	for i := range rows {
+		if err = w.WriteRow(rows[i]...); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if w.Rows() != 3 {
+		t.Errorf("received: '%v' but expected: '%v'", w.Rows(), 3)
+	}
+	if err = w.Close(); err != nil {
+		t.Fatal(err)
+	}
+
+	pf, err := buffer.NewBufferFile(buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	pr, err := reader.NewParquetColumnReader(pf, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pr.GetNumRows() != 3 {
+		t.Errorf("received: '%v' but expected: '%v'", pr.GetNumRows(), 3)
+	}
+	// the first schema element is the root
+	ts := pr.Footer.Schema[1]
+	if ts.ConvertedType == nil || *ts.ConvertedType != parquet.ConvertedType_TIMESTAMP_MILLIS {
+		t.Errorf("received: '%v' but expected: '%v'", ts.ConvertedType, parquet.ConvertedType_TIMESTAMP_MILLIS)
+	}
+	for _, rg := range pr.Footer.RowGroups {
+		for _, c := range rg.Columns {
+			if c.MetaData.Codec != parquet.CompressionCodec_SNAPPY {
+				t.Errorf("received: '%v' but expected: '%v'", c.MetaData.Codec, parquet.CompressionCodec_SNAPPY)
+			}
+		}
+	}
+	for col := range rows[0] {
+		values, _, _, err := pr.ReadColumnByIndex(int64(col), int64(len(rows)))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(values) != len(rows) {
+			t.Fatalf("received: '%v' but expected: '%v'", len(values), len(rows))
+		}
+		for i := range rows {
+			expected := rows[i][col]
+			if ts, ok := expected.(time.Time); ok {
+				expected = ts.UnixNano() / int64(time.Millisecond)
+			}
+			if values[i] != expected {
+				t.Errorf("received: '%v' but expected: '%v'", values[i], expected)
+			}
+		}
+	}
+}
+
+func TestEmptyFile(t *testing.T) {
+	t.Parallel()
+	var buf bytes.Buffer
+	w, err := NewWriter(&buf, Column{Name: "a", Type: Double})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if err = w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	pf, err := buffer.NewBufferFile(buf.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	pr, err := reader.NewParquetColumnReader(pf, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pr.GetNumRows() != 0 {
+		t.Errorf("received: '%v' but expected: '%v'", pr.GetNumRows(), 0)
+	}
+}
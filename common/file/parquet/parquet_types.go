@@ -0,0 +1,49 @@
+package parquet
+
+import (
+	"errors"
+
+	"github.com/xitongsys/parquet-go/writer"
+)
+
+var (
+	// ErrUnsupportedType is returned when a column type is not supported
+	ErrUnsupportedType = errors.New("unsupported column type")
+	// ErrTypeMismatch is returned when a row value does not match its column
+	ErrTypeMismatch = errors.New("value does not match column type")
+	// ErrWriterClosed is returned when writing to a closed writer
+	ErrWriterClosed = errors.New("parquet writer closed")
+
+	errNilWriter           = errors.New("nil writer")
+	errNoColumns           = errors.New("no columns supplied")
+	errEmptyColumnName     = errors.New("column name cannot be empty")
+	errDuplicateColumn     = errors.New("duplicate column name")
+	errColumnCountMismatch = errors.New("value count does not match column count")
+)
+
+// Type defines a supported column type
+type Type uint8
+
+// Supported column types
+const (
+	Int64 Type = iota + 1
+	Double
+	String
+	// Timestamp columns are written as milliseconds since the unix epoch
+	// with the TIMESTAMP_MILLIS annotation
+	Timestamp
+)
+
+// Column defines a named, typed column
+type Column struct {
+	Name string
+	Type Type
+}
+
+// Writer writes rows to a snappy compressed parquet file
+type Writer struct {
+	pw      *writer.CSVWriter
+	columns []Column
+	rows    int64
+	closed  bool
+}
@@ -1346,6 +1346,23 @@ func (c *Config) checkDatabaseConfig() error {
 		database.DB.DataPath = databaseDir
 	}
 
+	switch c.Database.TimeSeries.Driver {
+	case "", database.TimeSeriesSQL:
+	case database.TimeSeriesFileStore:
+		if c.Database.TimeSeries.Path == "" {
+			c.Database.TimeSeries.Path = c.GetDataPath("database", "timeseries")
+		}
+		err := common.CreateDir(c.Database.TimeSeries.Path)
+		if err != nil {
+			return err
+		}
+	default:
+		c.Database.Enabled = false
+		return fmt.Errorf("%w %v, database disabled",
+			database.ErrUnsupportedTimeSeriesDriver,
+			c.Database.TimeSeries.Driver)
+	}
+
 	return database.DB.SetConfig(&c.Database)
 }
 
@@ -1435,6 +1452,193 @@ func (c *Config) CheckCurrencyStateManager() {
 	}
 }
 
+// CheckFeeManager ensures the fee manager config is valid, or sets default
+// values
+func (c *Config) CheckFeeManager() {
+	m.Lock()
+	defer m.Unlock()
+	if c.FeeManager.Delay <= 0 {
+		c.FeeManager.Delay = defaultFeeManagerDelay
+	}
+	if c.FeeManager.Enabled == nil { // default on, when being upgraded
+		c.FeeManager.Enabled = convert.BoolPtr(true)
+	}
+}
+
+// CheckOrderbookHistoryManager ensures the orderbook history config is valid,
+// or sets default values
+func (c *Config) CheckOrderbookHistoryManager() {
+	m.Lock()
+	defer m.Unlock()
+	if c.OrderbookHistory.Depth < 0 {
+		c.OrderbookHistory.Depth = 0
+	}
+	if c.OrderbookHistory.Interval < 0 {
+		c.OrderbookHistory.Interval = 0
+	}
+	if c.OrderbookHistory.FlushInterval <= 0 {
+		c.OrderbookHistory.FlushInterval = defaultOrderbookHistoryFlushInterval
+	}
+	if c.OrderbookHistory.RetentionPeriod < 0 {
+		c.OrderbookHistory.RetentionPeriod = 0
+	}
+	if !c.OrderbookHistory.FileStorage && !c.OrderbookHistory.DatabaseStorage {
+		c.OrderbookHistory.FileStorage = true
+	}
+}
+
+// CheckDeadMansSwitchManager ensures the dead man's switch config is valid,
+// or sets default values
+func (c *Config) CheckDeadMansSwitchManager() {
+	m.Lock()
+	defer m.Unlock()
+	if c.DeadMansSwitch.Timeout <= 0 {
+		c.DeadMansSwitch.Timeout = defaultDeadMansSwitchTimeout
+	}
+	if c.DeadMansSwitch.RefreshInterval <= 0 ||
+		c.DeadMansSwitch.RefreshInterval >= c.DeadMansSwitch.Timeout {
+		c.DeadMansSwitch.RefreshInterval = defaultDeadMansSwitchRefreshInterval
+		if c.DeadMansSwitch.RefreshInterval >= c.DeadMansSwitch.Timeout {
+			c.DeadMansSwitch.RefreshInterval = c.DeadMansSwitch.Timeout / 4
+		}
+	}
+}
+
+// CheckCompositeIndexManager ensures the composite index config is valid, or
+// sets default values. Indices without a pair or with an invalid asset are
+// removed and candle saving is disabled when the database is not enabled
+func (c *Config) CheckCompositeIndexManager() {
+	m.Lock()
+	defer m.Unlock()
+	if c.CompositeIndex.ExchangeName == "" {
+		c.CompositeIndex.ExchangeName = defaultCompositeIndexExchangeName
+	}
+	if c.CompositeIndex.ValuationCurrency == "" {
+		c.CompositeIndex.ValuationCurrency = currency.USD.String()
+	}
+	if c.CompositeIndex.PublishInterval <= 0 {
+		c.CompositeIndex.PublishInterval = defaultCompositeIndexPublishInterval
+	}
+	if c.CompositeIndex.StaleAfter <= 0 {
+		c.CompositeIndex.StaleAfter = defaultCompositeIndexStaleAfter
+	}
+	if c.CompositeIndex.MaxDeviation <= 0 {
+		c.CompositeIndex.MaxDeviation = defaultCompositeIndexMaxDeviation
+	}
+	if c.CompositeIndex.MinimumSources <= 0 {
+		c.CompositeIndex.MinimumSources = 1
+	}
+	if c.CompositeIndex.CandleInterval <= 0 {
+		c.CompositeIndex.CandleInterval = defaultCompositeIndexCandleInterval
+	}
+	if c.CompositeIndex.SaveCandles && !c.Database.Enabled {
+		log.Warnf(log.ConfigMgr,
+			"Composite index candle saving requires the database to be enabled, disabling.\n")
+		c.CompositeIndex.SaveCandles = false
+	}
+	indices := c.CompositeIndex.Indices[:0]
+	for i := range c.CompositeIndex.Indices {
+		if c.CompositeIndex.Indices[i].Asset == "" {
+			c.CompositeIndex.Indices[i].Asset = asset.Spot
+		}
+		if c.CompositeIndex.Indices[i].Pair.IsEmpty() || !c.CompositeIndex.Indices[i].Asset.IsValid() {
+			log.Warnf(log.ConfigMgr,
+				"Composite index %s %s is invalid, removing.\n",
+				c.CompositeIndex.Indices[i].Pair,
+				c.CompositeIndex.Indices[i].Asset)
+			continue
+		}
+		indices = append(indices, c.CompositeIndex.Indices[i])
+	}
+	c.CompositeIndex.Indices = indices
+}
+
+// CheckLiveCandleManager ensures the live candle config is valid, or sets
+// default values. Non-positive and duplicate intervals are removed and
+// saving is disabled when the database is not enabled
+func (c *Config) CheckLiveCandleManager() {
+	m.Lock()
+	defer m.Unlock()
+	if c.LiveCandles.FinaliseDelay <= 0 {
+		c.LiveCandles.FinaliseDelay = defaultLiveCandleFinaliseDelay
+	}
+	var intervals []time.Duration
+	seen := make(map[time.Duration]bool)
+	for i := range c.LiveCandles.Intervals {
+		if c.LiveCandles.Intervals[i] <= 0 {
+			log.Warnf(log.ConfigMgr,
+				"Live candle interval %v is invalid, removing.\n",
+				c.LiveCandles.Intervals[i])
+			continue
+		}
+		if seen[c.LiveCandles.Intervals[i]] {
+			continue
+		}
+		seen[c.LiveCandles.Intervals[i]] = true
+		intervals = append(intervals, c.LiveCandles.Intervals[i])
+	}
+	if len(intervals) == 0 {
+		intervals = append(intervals, defaultLiveCandleIntervals...)
+	}
+	c.LiveCandles.Intervals = intervals
+	if c.LiveCandles.SaveToDatabase && !c.Database.Enabled {
+		log.Warnf(log.ConfigMgr,
+			"Live candle saving requires the database to be enabled, disabling.\n")
+		c.LiveCandles.SaveToDatabase = false
+	}
+}
+
+// CheckSymbolHistoryManager ensures the symbol history config is valid, or
+// sets default values
+func (c *Config) CheckSymbolHistoryManager() {
+	m.Lock()
+	defer m.Unlock()
+	if c.SymbolHistory.CheckInterval <= 0 {
+		c.SymbolHistory.CheckInterval = defaultSymbolHistoryCheckInterval
+	}
+}
+
+// CheckRetentionManager ensures the retention config is valid, or sets
+// default values. Policies with an unknown data type, negative durations or
+// a downsample interval that cannot be built from the policy interval are
+// removed
+func (c *Config) CheckRetentionManager() {
+	m.Lock()
+	defer m.Unlock()
+	if c.Retention.CheckInterval <= 0 {
+		c.Retention.CheckInterval = defaultRetentionCheckInterval
+	}
+	policies := c.Retention.Policies[:0]
+	for i := range c.Retention.Policies {
+		p := &c.Retention.Policies[i]
+		p.DataType = strings.ToLower(p.DataType)
+		var reason string
+		switch {
+		case p.DataType != RetentionCandles && p.DataType != RetentionTrades:
+			reason = "unsupported data type"
+		case p.Asset != "" && !p.Asset.IsValid():
+			reason = "invalid asset"
+		case p.MaxAge < 0 || p.Interval < 0 || p.DownsampleInterval < 0:
+			reason = "negative duration"
+		case p.DataType == RetentionTrades && p.Interval != 0:
+			reason = "interval set on trade policy"
+		case p.Interval > 0 && p.DownsampleInterval > 0 &&
+			(p.DownsampleInterval <= p.Interval || p.DownsampleInterval%p.Interval != 0):
+			reason = "downsample interval must be a multiple of the interval"
+		}
+		if reason != "" {
+			log.Warnf(log.ConfigMgr,
+				"Retention policy %d %s is invalid: %s, removing.\n",
+				i,
+				p.DataType,
+				reason)
+			continue
+		}
+		policies = append(policies, *p)
+	}
+	c.Retention.Policies = policies
+}
+
 // CheckConnectionMonitorConfig checks and if zero value assigns default values
 func (c *Config) CheckConnectionMonitorConfig() {
 	m.Lock()
@@ -1783,6 +1987,13 @@ func (c *Config) CheckConfig() error {
 	c.CheckConnectionMonitorConfig()
 	c.CheckDataHistoryMonitorConfig()
 	c.CheckCurrencyStateManager()
+	c.CheckFeeManager()
+	c.CheckOrderbookHistoryManager()
+	c.CheckDeadMansSwitchManager()
+	c.CheckCompositeIndexManager()
+	c.CheckLiveCandleManager()
+	c.CheckRetentionManager()
+	c.CheckSymbolHistoryManager()
 	c.CheckCommunicationsConfig()
 	c.CheckClientBankAccounts()
 	c.CheckBankAccountConfig()
@@ -1828,6 +1828,175 @@ func TestCheckConnectionMonitorConfig(t *testing.T) {
 	}
 }
 
+func TestCheckOrderbookHistoryManager(t *testing.T) {
+	t.Parallel()
+
+	var c Config
+	c.OrderbookHistory.Depth = -1
+	c.OrderbookHistory.Interval = -1
+	c.OrderbookHistory.RetentionPeriod = -1
+	c.CheckOrderbookHistoryManager()
+
+	if c.OrderbookHistory.Depth != 0 ||
+		c.OrderbookHistory.Interval != 0 ||
+		c.OrderbookHistory.RetentionPeriod != 0 ||
+		c.OrderbookHistory.FlushInterval != defaultOrderbookHistoryFlushInterval ||
+		!c.OrderbookHistory.FileStorage {
+		t.Error("unexpected values")
+	}
+
+	c.OrderbookHistory.FileStorage = false
+	c.OrderbookHistory.DatabaseStorage = true
+	c.CheckOrderbookHistoryManager()
+	if c.OrderbookHistory.FileStorage {
+		t.Error("file storage should not be enabled when database storage is set")
+	}
+}
+
+func TestCheckFeeManager(t *testing.T) {
+	t.Parallel()
+
+	var c Config
+	c.FeeManager.Delay = -1
+	c.CheckFeeManager()
+	if c.FeeManager.Delay != defaultFeeManagerDelay {
+		t.Errorf("received '%v' expected '%v'", c.FeeManager.Delay, defaultFeeManagerDelay)
+	}
+	if c.FeeManager.Enabled == nil || !*c.FeeManager.Enabled {
+		t.Error("fee manager should default to enabled")
+	}
+
+	c.FeeManager.Enabled = convert.BoolPtr(false)
+	c.CheckFeeManager()
+	if *c.FeeManager.Enabled {
+		t.Error("fee manager should remain disabled")
+	}
+}
+
+func TestCheckDeadMansSwitchManager(t *testing.T) {
+	t.Parallel()
+
+	var c Config
+	c.DeadMansSwitch.Timeout = -1
+	c.CheckDeadMansSwitchManager()
+	if c.DeadMansSwitch.Timeout != defaultDeadMansSwitchTimeout {
+		t.Errorf("received '%v' expected '%v'", c.DeadMansSwitch.Timeout, defaultDeadMansSwitchTimeout)
+	}
+	if c.DeadMansSwitch.RefreshInterval != defaultDeadMansSwitchRefreshInterval {
+		t.Errorf("received '%v' expected '%v'", c.DeadMansSwitch.RefreshInterval, defaultDeadMansSwitchRefreshInterval)
+	}
+
+	c.DeadMansSwitch.Timeout = time.Second * 10
+	c.DeadMansSwitch.RefreshInterval = time.Minute
+	c.CheckDeadMansSwitchManager()
+	if c.DeadMansSwitch.RefreshInterval != time.Second*10/4 {
+		t.Errorf("received '%v' expected '%v'", c.DeadMansSwitch.RefreshInterval, time.Second*10/4)
+	}
+}
+
+func TestCheckCompositeIndexManager(t *testing.T) {
+	t.Parallel()
+
+	var c Config
+	c.CompositeIndex.PublishInterval = -1
+	c.CompositeIndex.Indices = []CompositeIndex{
+		{Pair: currency.NewPair(currency.BTC, currency.USD)},
+		{Pair: currency.NewPair(currency.ETH, currency.USD), Asset: "bruh"},
+		{},
+	}
+	c.CheckCompositeIndexManager()
+	if c.CompositeIndex.ExchangeName != defaultCompositeIndexExchangeName ||
+		c.CompositeIndex.ValuationCurrency != currency.USD.String() ||
+		c.CompositeIndex.PublishInterval != defaultCompositeIndexPublishInterval ||
+		c.CompositeIndex.StaleAfter != defaultCompositeIndexStaleAfter ||
+		c.CompositeIndex.MaxDeviation != defaultCompositeIndexMaxDeviation ||
+		c.CompositeIndex.MinimumSources != 1 ||
+		c.CompositeIndex.CandleInterval != defaultCompositeIndexCandleInterval {
+		t.Errorf("unexpected values %+v", c.CompositeIndex)
+	}
+	if len(c.CompositeIndex.Indices) != 1 {
+		t.Fatalf("received '%v' expected '%v'", len(c.CompositeIndex.Indices), 1)
+	}
+	if c.CompositeIndex.Indices[0].Asset != asset.Spot {
+		t.Errorf("received '%v' expected '%v'", c.CompositeIndex.Indices[0].Asset, asset.Spot)
+	}
+
+	c.CompositeIndex.SaveCandles = true
+	c.CheckCompositeIndexManager()
+	if c.CompositeIndex.SaveCandles {
+		t.Error("expected candle saving to be disabled without the database")
+	}
+	c.Database.Enabled = true
+	c.CompositeIndex.SaveCandles = true
+	c.CheckCompositeIndexManager()
+	if !c.CompositeIndex.SaveCandles {
+		t.Error("expected candle saving to remain enabled with the database")
+	}
+}
+
+func TestCheckLiveCandleManager(t *testing.T) {
+	t.Parallel()
+
+	var c Config
+	c.CheckLiveCandleManager()
+	if c.LiveCandles.FinaliseDelay != defaultLiveCandleFinaliseDelay {
+		t.Errorf("received '%v' expected '%v'", c.LiveCandles.FinaliseDelay, defaultLiveCandleFinaliseDelay)
+	}
+	if len(c.LiveCandles.Intervals) != len(defaultLiveCandleIntervals) {
+		t.Errorf("received '%v' expected '%v'", len(c.LiveCandles.Intervals), len(defaultLiveCandleIntervals))
+	}
+
+	c.LiveCandles.Intervals = []time.Duration{time.Minute, -1, time.Minute, time.Hour}
+	c.CheckLiveCandleManager()
+	if len(c.LiveCandles.Intervals) != 2 ||
+		c.LiveCandles.Intervals[0] != time.Minute ||
+		c.LiveCandles.Intervals[1] != time.Hour {
+		t.Errorf("unexpected intervals %v", c.LiveCandles.Intervals)
+	}
+
+	c.LiveCandles.SaveToDatabase = true
+	c.CheckLiveCandleManager()
+	if c.LiveCandles.SaveToDatabase {
+		t.Error("expected saving to be disabled without the database")
+	}
+	c.Database.Enabled = true
+	c.LiveCandles.SaveToDatabase = true
+	c.CheckLiveCandleManager()
+	if !c.LiveCandles.SaveToDatabase {
+		t.Error("expected saving to remain enabled with the database")
+	}
+}
+
+func TestCheckRetentionManager(t *testing.T) {
+	t.Parallel()
+
+	var c Config
+	c.Retention.Policies = []RetentionPolicy{
+		{DataType: "Candles", Interval: time.Hour * 24},
+		{DataType: RetentionCandles, Interval: time.Minute, MaxAge: time.Hour * 24 * 365, DownsampleInterval: time.Hour},
+		{DataType: RetentionTrades, MaxAge: time.Hour * 24 * 30, DownsampleInterval: time.Minute, Archive: true},
+		{DataType: "orderbooks"},
+		{DataType: RetentionTrades, Asset: "bruh"},
+		{DataType: RetentionTrades, MaxAge: -1},
+		{DataType: RetentionTrades, Interval: time.Minute},
+		{DataType: RetentionCandles, Interval: time.Hour, DownsampleInterval: time.Minute},
+		{DataType: RetentionCandles, Interval: time.Hour * 4, DownsampleInterval: time.Hour * 6},
+	}
+	c.CheckRetentionManager()
+	if c.Retention.CheckInterval != defaultRetentionCheckInterval {
+		t.Errorf("received '%v' expected '%v'", c.Retention.CheckInterval, defaultRetentionCheckInterval)
+	}
+	if len(c.Retention.Policies) != 3 {
+		t.Fatalf("received '%v' expected '%v'", len(c.Retention.Policies), 3)
+	}
+	if c.Retention.Policies[0].DataType != RetentionCandles {
+		t.Errorf("received '%v' expected '%v'", c.Retention.Policies[0].DataType, RetentionCandles)
+	}
+	if c.Retention.Policies[2].DataType != RetentionTrades {
+		t.Errorf("received '%v' expected '%v'", c.Retention.Policies[2].DataType, RetentionTrades)
+	}
+}
+
 func TestDefaultFilePath(t *testing.T) {
 	// This is tricky to test because we're dealing with a config file stored
 	// in a persons default directory and to properly test it, it would
@@ -2087,6 +2256,24 @@ func TestCheckDatabaseConfig(t *testing.T) {
 	if err := c.checkDatabaseConfig(); err != nil {
 		t.Error(err)
 	}
+
+	c.Database.TimeSeries.Driver = "influx"
+	if err := c.checkDatabaseConfig(); !errors.Is(err, database.ErrUnsupportedTimeSeriesDriver) {
+		t.Errorf("received: '%v' but expected: '%v'", err, database.ErrUnsupportedTimeSeriesDriver)
+	}
+	if c.Database.Enabled {
+		t.Error("expected database to be disabled")
+	}
+
+	c.Database.Enabled = true
+	c.Database.TimeSeries.Driver = database.TimeSeriesFileStore
+	c.Database.TimeSeries.Path = filepath.Join(t.TempDir(), "timeseries")
+	if err := c.checkDatabaseConfig(); err != nil {
+		t.Error(err)
+	}
+	if _, err := os.Stat(c.Database.TimeSeries.Path); err != nil {
+		t.Error(err)
+	}
 }
 
 func TestCheckNTPConfig(t *testing.T) {
@@ -2353,3 +2540,19 @@ func TestMigrateConfig(t *testing.T) {
 		})
 	}
 }
+
+func TestCheckSymbolHistoryManager(t *testing.T) {
+	t.Parallel()
+
+	var c Config
+	c.CheckSymbolHistoryManager()
+	if c.SymbolHistory.CheckInterval != defaultSymbolHistoryCheckInterval {
+		t.Errorf("received '%v' expected '%v'", c.SymbolHistory.CheckInterval, defaultSymbolHistoryCheckInterval)
+	}
+
+	c.SymbolHistory.CheckInterval = time.Minute
+	c.CheckSymbolHistoryManager()
+	if c.SymbolHistory.CheckInterval != time.Minute {
+		t.Errorf("received '%v' expected '%v'", c.SymbolHistory.CheckInterval, time.Minute)
+	}
+}
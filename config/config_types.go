@@ -8,6 +8,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/communications/base"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	gctscript "github.com/thrasher-corp/gocryptotrader/gctscript/vm"
 	"github.com/thrasher-corp/gocryptotrader/log"
@@ -38,7 +39,29 @@ const (
 	DefaultAPIClientID                   = "ClientID"
 	defaultDataHistoryMonitorCheckTimer  = time.Minute
 	defaultCurrencyStateManagerDelay     = time.Minute
+	defaultFeeManagerDelay               = time.Hour
 	defaultMaxJobsPerCycle               = 5
+	defaultOrderbookHistoryFlushInterval = time.Second * 10
+	defaultDeadMansSwitchTimeout         = time.Minute
+	defaultDeadMansSwitchRefreshInterval = time.Second * 15
+	defaultCompositeIndexExchangeName    = "GCTIndex"
+	defaultCompositeIndexPublishInterval = time.Second * 5
+	defaultCompositeIndexStaleAfter      = time.Minute
+	defaultCompositeIndexMaxDeviation    = 5.0
+	defaultCompositeIndexCandleInterval  = time.Minute
+	defaultLiveCandleFinaliseDelay       = time.Second * 2
+	defaultRetentionCheckInterval        = time.Hour * 24
+	defaultSymbolHistoryCheckInterval    = time.Hour
+)
+
+// defaultLiveCandleIntervals are the candle intervals built from live trades
+// when none are configured
+var defaultLiveCandleIntervals = []time.Duration{time.Minute, time.Minute * 5, time.Hour}
+
+// Constants here define the data types retention policies apply to
+const (
+	RetentionCandles = "candles"
+	RetentionTrades  = "trades"
 )
 
 // Constants here hold some messages
@@ -82,6 +105,13 @@ type Config struct {
 	ConnectionMonitor    ConnectionMonitorConfig   `json:"connectionMonitor"`
 	DataHistoryManager   DataHistoryManager        `json:"dataHistoryManager"`
 	CurrencyStateManager CurrencyStateManager      `json:"currencyStateManager"`
+	FeeManager           FeeManager                `json:"feeManager"`
+	OrderbookHistory     OrderbookHistoryManager   `json:"orderbookHistory"`
+	DeadMansSwitch       DeadMansSwitchManager     `json:"deadMansSwitch"`
+	CompositeIndex       CompositeIndexManager     `json:"compositeIndex"`
+	LiveCandles          LiveCandleManager         `json:"liveCandles"`
+	Retention            RetentionManager          `json:"retention"`
+	SymbolHistory        SymbolHistoryManager      `json:"symbolHistory"`
 	Profiler             Profiler                  `json:"profiler"`
 	NTPClient            NTPClientConfig           `json:"ntpclient"`
 	GCTScript            gctscript.Config          `json:"gctscript"`
@@ -119,6 +149,157 @@ type CurrencyStateManager struct {
 	Delay   time.Duration `json:"delay"`
 }
 
+// FeeManager defines a set of configuration options for the fee manager which
+// loads and refreshes each exchange's fee schedule
+type FeeManager struct {
+	Enabled *bool         `json:"enabled"`
+	Delay   time.Duration `json:"delay"`
+	Verbose bool          `json:"verbose"`
+}
+
+// DeadMansSwitchManager defines a set of configuration options for arming
+// exchange side auto-cancel timers while managed orders are open
+type DeadMansSwitchManager struct {
+	Enabled bool `json:"enabled"`
+	Verbose bool `json:"verbose"`
+	// Timeout is the duration the exchange waits for a refresh before
+	// cancelling all open orders
+	Timeout time.Duration `json:"timeout"`
+	// RefreshInterval is the duration between timer refreshes and must be
+	// shorter than the timeout
+	RefreshInterval time.Duration `json:"refreshInterval"`
+}
+
+// CompositeIndexManager defines a set of configuration options for
+// calculating composite index prices from multiple exchanges
+type CompositeIndexManager struct {
+	Enabled bool `json:"enabled"`
+	Verbose bool `json:"verbose"`
+	// ExchangeName is the synthetic exchange name index prices are published
+	// and stored under
+	ExchangeName string `json:"exchangeName"`
+	// ValuationCurrency is the quote currency used when valuing holdings
+	// with index prices
+	ValuationCurrency string `json:"valuationCurrency"`
+	// PublishInterval is the duration between index price calculations
+	PublishInterval time.Duration `json:"publishInterval"`
+	// StaleAfter is the duration without an update before an exchange is
+	// excluded from an index
+	StaleAfter time.Duration `json:"staleAfter"`
+	// MaxDeviation is the percentage an exchange price can deviate from the
+	// median of all sources before it is excluded from an index
+	MaxDeviation float64 `json:"maxDeviation"`
+	// MinimumSources is the number of exchanges required to publish an index
+	// price
+	MinimumSources int `json:"minimumSources"`
+	// SaveCandles stores index prices as candles in the database
+	SaveCandles    bool             `json:"saveCandles"`
+	CandleInterval time.Duration    `json:"candleInterval"`
+	Indices        []CompositeIndex `json:"indices"`
+}
+
+// CompositeIndex defines a currency pair to calculate an index price for
+type CompositeIndex struct {
+	Pair  currency.Pair `json:"pair"`
+	Asset asset.Item    `json:"asset"`
+	// Exchanges restricts the index to the named exchanges, empty uses all
+	// enabled exchanges
+	Exchanges []string `json:"exchanges,omitempty"`
+}
+
+// LiveCandleManager defines a set of configuration options for building
+// candles from live exchange trades
+type LiveCandleManager struct {
+	Enabled bool `json:"enabled"`
+	Verbose bool `json:"verbose"`
+	// Intervals are the candle durations to build
+	Intervals []time.Duration `json:"intervals"`
+	// Exchanges restricts candle building to the named exchanges, empty uses
+	// all enabled exchanges
+	Exchanges []string `json:"exchanges,omitempty"`
+	// FinaliseDelay is the duration after an interval closes to wait for
+	// delayed trades before the candle is finalised
+	FinaliseDelay time.Duration `json:"finaliseDelay"`
+	// SaveToDatabase upserts finalised candles into the candle table
+	SaveToDatabase bool `json:"saveToDatabase"`
+}
+
+// RetentionManager defines a set of configuration options for pruning,
+// downsampling and archiving stored candles and trades
+type RetentionManager struct {
+	Enabled bool `json:"enabled"`
+	Verbose bool `json:"verbose"`
+	// CheckInterval is the duration between retention runs
+	CheckInterval time.Duration `json:"checkInterval"`
+	// DryRun reports what scheduled runs would do without modifying any
+	// stored data
+	DryRun bool `json:"dryRun"`
+	// ArchiveDirectory is where expired data is archived, empty uses the
+	// archive folder in the data directory
+	ArchiveDirectory string `json:"archiveDirectory"`
+	// Policies are matched in order, the first policy matching a stored
+	// series is applied to it
+	Policies []RetentionPolicy `json:"policies"`
+}
+
+// SymbolHistoryManager defines a set of configuration options for recording
+// when pairs are listed and delisted on each exchange
+type SymbolHistoryManager struct {
+	Enabled bool `json:"enabled"`
+	Verbose bool `json:"verbose"`
+	// CheckInterval is the duration between comparing each exchange's
+	// available pairs against the recorded symbol history
+	CheckInterval time.Duration `json:"checkInterval"`
+}
+
+// RetentionPolicy defines how long a type of stored data is kept and what
+// happens to it once expired
+type RetentionPolicy struct {
+	// DataType is either "candles" or "trades"
+	DataType string `json:"dataType"`
+	// Exchange, Asset and Pair restrict the policy, empty values match all
+	Exchange string        `json:"exchange,omitempty"`
+	Asset    asset.Item    `json:"asset,omitempty"`
+	Pair     currency.Pair `json:"pair,omitempty"`
+	// Interval restricts a candle policy to a candle interval, zero matches
+	// all intervals
+	Interval time.Duration `json:"interval,omitempty"`
+	// MaxAge is the duration data is kept for, zero keeps data forever
+	MaxAge time.Duration `json:"maxAge"`
+	// DownsampleInterval converts expired data into candles of this interval
+	// before it is deleted, zero deletes without downsampling
+	DownsampleInterval time.Duration `json:"downsampleInterval,omitempty"`
+	// Archive writes expired data to compressed files before it is deleted
+	Archive bool `json:"archive"`
+}
+
+// OrderbookHistoryManager defines a set of configuration options for
+// capturing orderbook snapshots to compressed files and/or the database
+type OrderbookHistoryManager struct {
+	Enabled bool `json:"enabled"`
+	Verbose bool `json:"verbose"`
+	// Depth is the number of price levels captured for each side of the
+	// book, zero captures the entire book
+	Depth int `json:"depth"`
+	// Interval is the duration between snapshots, zero captures a snapshot
+	// on every orderbook update
+	Interval time.Duration `json:"interval"`
+	// FlushInterval is the duration between writing captured snapshots to
+	// storage
+	FlushInterval time.Duration `json:"flushInterval"`
+	// RetentionPeriod is the duration snapshots are kept before being
+	// removed from storage, zero keeps snapshots indefinitely
+	RetentionPeriod time.Duration `json:"retentionPeriod"`
+	FileStorage     bool          `json:"fileStorage"`
+	DatabaseStorage bool          `json:"databaseStorage"`
+	// Directory overrides the default file storage directory of
+	// <datadir>/orderbookhistory
+	Directory string `json:"directory,omitempty"`
+	// Exchanges restricts capture to the named exchanges, empty captures all
+	// enabled exchanges
+	Exchanges []string `json:"exchanges,omitempty"`
+}
+
 // ConnectionMonitorConfig defines the connection monitor variables to ensure
 // that there is internet connectivity
 type ConnectionMonitorConfig struct {
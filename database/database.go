@@ -40,6 +40,18 @@ func (i *Instance) SetSQLiteConnection(con *sql.DB) error {
 // SetPostgresConnection safely sets the global database instance's connection
 // to use Postgres
 func (i *Instance) SetPostgresConnection(con *sql.DB) error {
+	return i.setServerConnection(con)
+}
+
+// SetMySQLConnection safely sets the global database instance's connection
+// to use MySQL or MariaDB
+func (i *Instance) SetMySQLConnection(con *sql.DB) error {
+	return i.setServerConnection(con)
+}
+
+// setServerConnection verifies and sets the connection pool settings shared
+// by database server drivers
+func (i *Instance) setServerConnection(con *sql.DB) error {
 	if i == nil {
 		return ErrNilInstance
 	}
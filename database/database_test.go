@@ -76,6 +76,39 @@ func TestSetPostgresConnection(t *testing.T) {
 	}
 }
 
+func TestSetMySQLConnection(t *testing.T) {
+	t.Parallel()
+	var inst *Instance
+	err := inst.SetMySQLConnection(nil)
+	if !errors.Is(err, ErrNilInstance) {
+		t.Errorf("received %v, expected %v", err, ErrNilInstance)
+	}
+
+	inst = &Instance{}
+	err = inst.SetMySQLConnection(nil)
+	if !errors.Is(err, errNilSQL) {
+		t.Errorf("received %v, expected %v", err, errNilSQL)
+	}
+
+	databaseFullLocation := filepath.Join(DB.DataPath, "TestSetMySQLConnection")
+	con, err := sql.Open("sqlite3", databaseFullLocation)
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v, expected %v", err, nil)
+	}
+	err = inst.SetMySQLConnection(con)
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v, expected %v", err, nil)
+	}
+	err = con.Close()
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v, expected %v", err, nil)
+	}
+	err = os.Remove(databaseFullLocation)
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v, expected %v", err, nil)
+	}
+}
+
 func TestSetConnected(t *testing.T) {
 	t.Parallel()
 	inst := &Instance{}
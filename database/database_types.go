@@ -25,6 +25,15 @@ type Config struct {
 	Verbose                   bool   `json:"verbose"`
 	Driver                    string `json:"driver"`
 	drivers.ConnectionDetails `json:"connectionDetails"`
+	TimeSeries                TimeSeriesConfig `json:"timeSeries"`
+}
+
+// TimeSeriesConfig selects where candles and trades are stored. The default
+// SQL driver stores them in the configured database, the file store driver
+// stores them in an embedded columnar store at Path
+type TimeSeriesConfig struct {
+	Driver string `json:"driver"`
+	Path   string `json:"path"`
 }
 
 var (
@@ -37,7 +46,7 @@ var (
 	// ErrDatabaseSupportDisabled error to display when no database is provided
 	ErrDatabaseSupportDisabled = errors.New("database support is disabled")
 	// SupportedDrivers slice of supported database driver types
-	SupportedDrivers = []string{DBSQLite, DBSQLite3, DBPostgreSQL}
+	SupportedDrivers = []string{DBSQLite, DBSQLite3, DBPostgreSQL, DBMySQL}
 	// ErrFailedToConnect for when a database fails to connect
 	ErrFailedToConnect = errors.New("database failed to connect")
 	// ErrDatabaseNotConnected for when a database is not connected
@@ -47,9 +56,11 @@ var (
 	// ErrNilInstance for when a database is nil
 	ErrNilInstance = errors.New("database instance is nil")
 	// ErrNilConfig for when a config is nil
-	ErrNilConfig  = errors.New("received nil config")
-	errNilSQL     = errors.New("database SQL connection is nil")
-	errFailedPing = errors.New("unable to verify database is connected, failed ping")
+	ErrNilConfig = errors.New("received nil config")
+	// ErrUnsupportedTimeSeriesDriver for when a time series driver is not supported
+	ErrUnsupportedTimeSeriesDriver = errors.New("unsupported time series driver")
+	errNilSQL                      = errors.New("database SQL connection is nil")
+	errFailedPing                  = errors.New("unable to verify database is connected, failed ping")
 )
 
 const (
@@ -59,8 +70,14 @@ const (
 	DBSQLite3 = "sqlite3"
 	// DBPostgreSQL const string for PostgreSQL across code base
 	DBPostgreSQL = "postgres"
+	// DBMySQL const string for MySQL and MariaDB across code base
+	DBMySQL = "mysql"
 	// DBInvalidDriver const string for invalid driver
 	DBInvalidDriver = "invalid driver"
+	// TimeSeriesSQL stores candles and trades in the SQL database
+	TimeSeriesSQL = "sql"
+	// TimeSeriesFileStore stores candles and trades in the embedded file store
+	TimeSeriesFileStore = "filestore"
 )
 
 // IDatabase allows for the passing of a database struct
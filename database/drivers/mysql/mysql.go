@@ -0,0 +1,51 @@
+package mysql
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/go-sql-driver/mysql"
+	"github.com/thrasher-corp/gocryptotrader/database"
+)
+
+// Connect opens a connection to a MySQL or MariaDB database and returns a
+// pointer to database.DB
+func Connect(cfg *database.Config) (*database.Instance, error) {
+	if cfg == nil {
+		return nil, database.ErrNilConfig
+	}
+	if !cfg.Enabled {
+		return nil, database.ErrDatabaseSupportDisabled
+	}
+
+	db, err := sql.Open(database.DBMySQL, DSN(cfg))
+	if err != nil {
+		return nil, err
+	}
+	err = database.DB.SetMySQLConnection(db)
+	if err != nil {
+		return nil, err
+	}
+	return database.DB, nil
+}
+
+// DSN returns the data source name for the supplied config. Times are read
+// and written as UTC, the session time zone is set to UTC so that column
+// defaults match and SSLMode is passed through as the TLS setting unless
+// it is empty or disabled
+func DSN(cfg *database.Config) string {
+	mysqlCfg := mysql.NewConfig()
+	mysqlCfg.User = cfg.Username
+	mysqlCfg.Passwd = cfg.Password
+	mysqlCfg.Net = "tcp"
+	mysqlCfg.Addr = fmt.Sprintf("%s:%d", cfg.Host, cfg.Port)
+	mysqlCfg.DBName = cfg.Database
+	mysqlCfg.ParseTime = true
+	mysqlCfg.Loc = time.UTC
+	mysqlCfg.Params = map[string]string{"time_zone": "'+00:00'"}
+	if cfg.SSLMode != "" && cfg.SSLMode != "disable" {
+		mysqlCfg.TLSConfig = cfg.SSLMode
+	}
+	return mysqlCfg.FormatDSN()
+}
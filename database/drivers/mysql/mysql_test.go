@@ -0,0 +1,43 @@
+package mysql
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+)
+
+func TestConnect(t *testing.T) {
+	t.Parallel()
+	_, err := Connect(nil)
+	if !errors.Is(err, database.ErrNilConfig) {
+		t.Errorf("received %v, expected %v", err, database.ErrNilConfig)
+	}
+
+	_, err = Connect(&database.Config{})
+	if !errors.Is(err, database.ErrDatabaseSupportDisabled) {
+		t.Errorf("received %v, expected %v", err, database.ErrDatabaseSupportDisabled)
+	}
+}
+
+func TestDSN(t *testing.T) {
+	t.Parallel()
+	cfg := &database.Config{
+		ConnectionDetails: drivers.ConnectionDetails{
+			Host:     "localhost",
+			Port:     3306,
+			Username: "gct",
+			Password: "hunter2",
+			Database: "gct",
+		},
+	}
+	if dsn := DSN(cfg); dsn != "gct:hunter2@tcp(localhost:3306)/gct?parseTime=true&time_zone=%27%2B00%3A00%27" {
+		t.Errorf("received %v", dsn)
+	}
+
+	cfg.SSLMode = "skip-verify"
+	if dsn := DSN(cfg); dsn != "gct:hunter2@tcp(localhost:3306)/gct?parseTime=true&tls=skip-verify&time_zone=%27%2B00%3A00%27" {
+		t.Errorf("received %v", dsn)
+	}
+}
@@ -0,0 +1,16 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS script
+(
+    id char(36) PRIMARY KEY DEFAULT (UUID()),
+    script_id varchar(255) not null,
+    script_name varchar(255) not null,
+    script_path varchar(255) not null,
+    script_data longblob null,
+    last_executed_at DATETIME(6) DEFAULT CURRENT_TIMESTAMP(6),
+    created_at DATETIME(6) DEFAULT CURRENT_TIMESTAMP(6),
+    CONSTRAINT script_event_uniq UNIQUE (script_id)
+);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE script;
@@ -0,0 +1,19 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS withdrawal_fiat
+(
+    id bigint AUTO_INCREMENT PRIMARY KEY NOT NULL,
+    withdrawal_fiat_id        char(36),
+    bank_name                 text not null,
+    bank_address              text not null,
+    bank_account_name         text not null,
+    bank_account_number       text not null,
+    bsb                       varchar(255) not null DEFAULT '',
+    swift_code                varchar(255) not null DEFAULT '',
+    iban                      varchar(255) not null DEFAULT '',
+    bank_code                 DOUBLE not null,
+    FOREIGN KEY (withdrawal_fiat_id) REFERENCES withdrawal_history(id) ON DELETE RESTRICT
+);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE IF EXISTS  withdrawal_fiat;
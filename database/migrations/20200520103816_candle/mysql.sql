@@ -0,0 +1,22 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS candle
+(
+    id char(36) PRIMARY KEY DEFAULT (UUID()),
+    exchange_id char(36) NOT NULL,
+    base varchar(30) NOT NULL,
+    quote varchar(30) NOT NULL,
+    `interval` varchar(30) NOT NULL,
+    timestamp DATETIME(6) NOT NULL,
+    open DOUBLE NOT NULL,
+    high DOUBLE NOT NULL,
+    low DOUBLE NOT NULL,
+    close DOUBLE NOT NULL,
+    volume DOUBLE NOT NULL,
+    CONSTRAINT candle_exchange_id_fkey FOREIGN KEY (exchange_id) REFERENCES exchange(id),
+    CONSTRAINT candle_timestamp_exchange_id_base_quote_interval_key UNIQUE (timestamp, exchange_id, base, quote, `interval`)
+);
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE candle;
@@ -0,0 +1,7 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+ALTER TABLE candle MODIFY COLUMN asset VARCHAR(255) NOT NULL;
+
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+ALTER TABLE candle MODIFY COLUMN asset VARCHAR(255) NULL;
@@ -0,0 +1,12 @@
+-- +goose Up
+ALTER TABLE candle DROP INDEX candle_timestamp_exchange_id_base_quote_interval_asset_key;
+ALTER TABLE candle DROP FOREIGN KEY candle_exchange_id_fkey;
+ALTER TABLE candle CHANGE COLUMN exchange_id exchange_name_id char(36) NOT NULL;
+ALTER TABLE candle ADD CONSTRAINT candle_exchange_name_id_fkey FOREIGN KEY (exchange_name_id) REFERENCES exchange(id);
+ALTER TABLE candle ADD CONSTRAINT candle_timestamp_exchange_id_base_quote_interval_asset_key UNIQUE (timestamp, exchange_name_id, base, quote, `interval`, asset);
+-- +goose Down
+ALTER TABLE candle DROP INDEX candle_timestamp_exchange_id_base_quote_interval_asset_key;
+ALTER TABLE candle DROP FOREIGN KEY candle_exchange_name_id_fkey;
+ALTER TABLE candle CHANGE COLUMN exchange_name_id exchange_id char(36) NOT NULL;
+ALTER TABLE candle ADD CONSTRAINT candle_exchange_id_fkey FOREIGN KEY (exchange_id) REFERENCES exchange(id);
+ALTER TABLE candle ADD CONSTRAINT candle_timestamp_exchange_id_base_quote_interval_asset_key UNIQUE (timestamp, exchange_id, base, quote, `interval`);
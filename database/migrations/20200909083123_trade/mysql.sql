@@ -0,0 +1,21 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS trade
+(
+    id char(36) PRIMARY KEY DEFAULT (UUID()),
+    exchange_name_id char(36) NOT NULL,
+    tid varchar(255),
+    base varchar(30) NOT NULL,
+    quote varchar(30) NOT NULL,
+    asset varchar(255) NOT NULL,
+    price DOUBLE NOT NULL,
+    amount DOUBLE NOT NULL,
+    side varchar(255) NOT NULL,
+    timestamp DATETIME(6) NOT NULL,
+    FOREIGN KEY (exchange_name_id) REFERENCES exchange(id),
+    CONSTRAINT uniquetradeid
+        unique(exchange_name_id, tid),
+    CONSTRAINT uniquetrade
+        unique(exchange_name_id, base, quote, asset, price, amount, side, timestamp)
+);
+-- +goose Down
+DROP TABLE trade;
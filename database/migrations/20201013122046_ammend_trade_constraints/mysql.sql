@@ -0,0 +1,7 @@
+-- +goose Up
+-- MySQL does not support partial indexes, trades without an id are not
+-- constrained as NULL values are never considered equal in a unique index
+ALTER TABLE trade DROP INDEX uniquetrade;
+-- +goose Down
+ALTER TABLE trade ADD CONSTRAINT uniquetrade
+    unique(exchange_name_id, base, quote, asset, price, amount, side, timestamp);
@@ -0,0 +1,6 @@
+-- +goose Up
+ALTER TABLE trade MODIFY COLUMN side varchar(255) NULL;
+UPDATE trade SET side = null WHERE side = 'UNKNOWN';
+-- +goose Down
+UPDATE trade SET side = '' WHERE side IS NULL;
+ALTER TABLE trade MODIFY COLUMN side varchar(255) NOT NULL;
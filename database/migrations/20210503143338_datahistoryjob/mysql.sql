@@ -0,0 +1,37 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS datahistoryjob
+(
+    id char(36) PRIMARY KEY DEFAULT (UUID()),
+    nickname varchar(255) NOT NULL,
+    exchange_name_id char(36) NOT NULL,
+    asset varchar(255) NOT NULL,
+    base varchar(30) NOT NULL,
+    quote varchar(30) NOT NULL,
+    start_time DATETIME(6) NOT NULL,
+    end_time DATETIME(6) NOT NULL,
+    data_type DOUBLE NOT NULL,
+    `interval` DOUBLE NOT NULL,
+    request_size DOUBLE NOT NULL,
+    max_retries DOUBLE NOT NULL,
+    batch_count DOUBLE NOT NULL,
+    status DOUBLE NOT NULL,
+    created DATETIME(6) NOT NULL,
+    FOREIGN KEY (exchange_name_id) REFERENCES exchange(id),
+    CONSTRAINT uniquenickname
+        unique(nickname)
+);
+
+CREATE TABLE IF NOT EXISTS datahistoryjobresult
+(
+    id char(36) PRIMARY KEY DEFAULT (UUID()),
+    job_id char(36) NOT NULL,
+    result TEXT NULL,
+    status DOUBLE NOT NULL,
+    interval_start_time DATETIME(6) NOT NULL,
+    interval_end_time DATETIME(6) NOT NULL,
+    run_time DATETIME(6) NOT NULL,
+    FOREIGN KEY (job_id) REFERENCES datahistoryjob(id) ON DELETE RESTRICT
+);
+-- +goose Down
+DROP TABLE datahistoryjobresult;
+DROP TABLE datahistoryjob;
@@ -0,0 +1,11 @@
+-- +goose Up
+CREATE TABLE datahistoryjobrelations
+(
+    prerequisite_job_id char(36) not null,
+    job_id char(36) not null,
+    PRIMARY KEY (prerequisite_job_id, job_id),
+    FOREIGN KEY (prerequisite_job_id) REFERENCES datahistoryjob(id),
+    FOREIGN KEY (job_id) REFERENCES datahistoryjob(id)
+);
+-- +goose Down
+DROP TABLE datahistoryjobrelations;
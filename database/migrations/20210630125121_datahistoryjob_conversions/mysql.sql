@@ -0,0 +1,19 @@
+-- +goose Up
+ALTER TABLE datahistoryjob
+    ADD conversion_interval DOUBLE,
+    ADD overwrite_data boolean,
+    ADD decimal_place_comparison INTEGER,
+    ADD secondary_exchange_id char(36),
+    ADD issue_tolerance_percentage DOUBLE,
+    ADD replace_on_issue boolean,
+    ADD CONSTRAINT datahistoryjob_secondary_exchange_id_fkey FOREIGN KEY (secondary_exchange_id) REFERENCES exchange(id);
+
+-- +goose Down
+ALTER TABLE datahistoryjob DROP FOREIGN KEY datahistoryjob_secondary_exchange_id_fkey;
+ALTER TABLE datahistoryjob
+    DROP replace_on_issue,
+    DROP issue_tolerance_percentage,
+    DROP secondary_exchange_id,
+    DROP decimal_place_comparison,
+    DROP overwrite_data,
+    DROP conversion_interval;
@@ -0,0 +1,14 @@
+-- +goose Up
+ALTER TABLE candle
+    ADD source_job_id char(36),
+    ADD validation_job_id char(36),
+    ADD validation_issues TEXT,
+    ADD CONSTRAINT candle_source_job_id_fkey FOREIGN KEY (source_job_id) REFERENCES datahistoryjob(id),
+    ADD CONSTRAINT candle_validation_job_id_fkey FOREIGN KEY (validation_job_id) REFERENCES datahistoryjob(id);
+-- +goose Down
+ALTER TABLE candle DROP FOREIGN KEY candle_validation_job_id_fkey;
+ALTER TABLE candle DROP FOREIGN KEY candle_source_job_id_fkey;
+ALTER TABLE candle
+    DROP validation_issues,
+    DROP validation_job_id,
+    DROP source_job_id;
@@ -0,0 +1,17 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS orderbook_snapshot
+(
+    id char(36) PRIMARY KEY DEFAULT (UUID()),
+    exchange_name_id char(36) NOT NULL,
+    base varchar(30) NOT NULL,
+    quote varchar(30) NOT NULL,
+    asset varchar(255) NOT NULL,
+    bids LONGTEXT NOT NULL,
+    asks LONGTEXT NOT NULL,
+    timestamp DATETIME(6) NOT NULL,
+    FOREIGN KEY (exchange_name_id) REFERENCES exchange(id),
+    CONSTRAINT uniqueorderbooksnapshot
+        unique(exchange_name_id, base, quote, asset, timestamp)
+);
+-- +goose Down
+DROP TABLE orderbook_snapshot;
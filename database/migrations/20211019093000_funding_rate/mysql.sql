@@ -0,0 +1,16 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS funding_rate
+(
+    id char(36) PRIMARY KEY DEFAULT (UUID()),
+    exchange_name_id char(36) NOT NULL,
+    base varchar(30) NOT NULL,
+    quote varchar(30) NOT NULL,
+    asset varchar(255) NOT NULL,
+    rate DOUBLE NOT NULL,
+    timestamp DATETIME(6) NOT NULL,
+    FOREIGN KEY (exchange_name_id) REFERENCES exchange(id),
+    CONSTRAINT uniquefundingrate
+        unique(exchange_name_id, base, quote, asset, timestamp)
+);
+-- +goose Down
+DROP TABLE funding_rate;
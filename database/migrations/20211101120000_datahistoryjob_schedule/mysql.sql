@@ -0,0 +1,15 @@
+-- +goose Up
+ALTER TABLE datahistoryjob
+    ADD recurrence_interval DOUBLE,
+    ADD settle_delay DOUBLE,
+    ADD rolling_window DOUBLE,
+    ADD backfill_to_inception boolean,
+    ADD next_run_time DATETIME(6);
+
+-- +goose Down
+ALTER TABLE datahistoryjob
+    DROP next_run_time,
+    DROP backfill_to_inception,
+    DROP rolling_window,
+    DROP settle_delay,
+    DROP recurrence_interval;
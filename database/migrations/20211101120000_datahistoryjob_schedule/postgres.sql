@@ -0,0 +1,15 @@
+-- +goose Up
+ALTER TABLE datahistoryjob
+    ADD recurrence_interval DOUBLE PRECISION,
+    ADD settle_delay DOUBLE PRECISION,
+    ADD rolling_window DOUBLE PRECISION,
+    ADD backfill_to_inception boolean,
+    ADD next_run_time TIMESTAMPTZ;
+
+-- +goose Down
+ALTER TABLE datahistoryjob
+    DROP next_run_time,
+    DROP backfill_to_inception,
+    DROP rolling_window,
+    DROP settle_delay,
+    DROP recurrence_interval;
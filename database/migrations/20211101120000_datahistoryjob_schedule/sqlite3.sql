@@ -0,0 +1,23 @@
+-- +goose Up
+ALTER TABLE datahistoryjob
+    ADD recurrence_interval real;
+ALTER TABLE datahistoryjob
+    ADD settle_delay real;
+ALTER TABLE datahistoryjob
+    ADD rolling_window real;
+ALTER TABLE datahistoryjob
+    ADD backfill_to_inception integer;
+ALTER TABLE datahistoryjob
+    ADD next_run_time timestamp;
+
+-- +goose Down
+ALTER TABLE datahistoryjob
+    DROP next_run_time;
+ALTER TABLE datahistoryjob
+    DROP backfill_to_inception;
+ALTER TABLE datahistoryjob
+    DROP rolling_window;
+ALTER TABLE datahistoryjob
+    DROP settle_delay;
+ALTER TABLE datahistoryjob
+    DROP recurrence_interval;
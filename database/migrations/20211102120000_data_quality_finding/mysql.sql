@@ -0,0 +1,18 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS data_quality_finding
+(
+    id char(36) PRIMARY KEY DEFAULT (UUID()),
+    exchange_name_id char(36) NOT NULL,
+    base varchar(30) NOT NULL,
+    quote varchar(30) NOT NULL,
+    asset varchar(255) NOT NULL,
+    data_type varchar(255) NOT NULL,
+    `interval` BIGINT NOT NULL,
+    check_type varchar(255) NOT NULL,
+    start_time DATETIME(6) NOT NULL,
+    end_time DATETIME(6) NOT NULL,
+    detail TEXT NOT NULL,
+    FOREIGN KEY (exchange_name_id) REFERENCES exchange(id)
+);
+-- +goose Down
+DROP TABLE data_quality_finding;
@@ -0,0 +1,17 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS data_quality_finding
+(
+    id text not null primary key,
+    exchange_name_id uuid REFERENCES exchange(id) NOT NULL,
+    base text NOT NULL,
+    quote text NOT NULL,
+    asset TEXT NOT NULL,
+    data_type TEXT NOT NULL,
+    interval integer NOT NULL,
+    check_type TEXT NOT NULL,
+    start_time TIMESTAMP NOT NULL,
+    end_time TIMESTAMP NOT NULL,
+    detail TEXT NOT NULL
+);
+-- +goose Down
+DROP TABLE data_quality_finding;
@@ -0,0 +1,32 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS trade_bar
+(
+    id char(36) PRIMARY KEY DEFAULT (UUID()),
+    exchange_name_id char(36) NOT NULL,
+    base varchar(30) NOT NULL,
+    quote varchar(30) NOT NULL,
+    asset varchar(255) NOT NULL,
+    bar_type varchar(30) NOT NULL,
+    threshold DOUBLE NOT NULL,
+    start_time DATETIME(6) NOT NULL,
+    end_time DATETIME(6) NOT NULL,
+    open DOUBLE NOT NULL,
+    high DOUBLE NOT NULL,
+    low DOUBLE NOT NULL,
+    close DOUBLE NOT NULL,
+    volume DOUBLE NOT NULL,
+    notional DOUBLE NOT NULL,
+    imbalance DOUBLE NOT NULL,
+    trade_count BIGINT NOT NULL,
+    source_job_id char(36),
+    FOREIGN KEY (exchange_name_id) REFERENCES exchange(id),
+    FOREIGN KEY (source_job_id) REFERENCES datahistoryjob(id)
+);
+ALTER TABLE datahistoryjob
+    ADD bar_type varchar(30),
+    ADD bar_threshold DOUBLE;
+-- +goose Down
+ALTER TABLE datahistoryjob
+    DROP bar_threshold,
+    DROP bar_type;
+DROP TABLE trade_bar;
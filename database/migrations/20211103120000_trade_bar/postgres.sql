@@ -0,0 +1,30 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS trade_bar
+(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    exchange_name_id uuid REFERENCES exchange(id) NOT NULL,
+    base varchar(30) NOT NULL,
+    quote varchar(30) NOT NULL,
+    asset varchar NOT NULL,
+    bar_type varchar(30) NOT NULL,
+    threshold DOUBLE PRECISION NOT NULL,
+    start_time TIMESTAMPTZ NOT NULL,
+    end_time TIMESTAMPTZ NOT NULL,
+    open DOUBLE PRECISION NOT NULL,
+    high DOUBLE PRECISION NOT NULL,
+    low DOUBLE PRECISION NOT NULL,
+    close DOUBLE PRECISION NOT NULL,
+    volume DOUBLE PRECISION NOT NULL,
+    notional DOUBLE PRECISION NOT NULL,
+    imbalance DOUBLE PRECISION NOT NULL,
+    trade_count BIGINT NOT NULL,
+    source_job_id uuid REFERENCES datahistoryjob(id)
+);
+ALTER TABLE datahistoryjob
+    ADD bar_type varchar(30),
+    ADD bar_threshold DOUBLE PRECISION;
+-- +goose Down
+ALTER TABLE datahistoryjob
+    DROP bar_threshold,
+    DROP bar_type;
+DROP TABLE trade_bar;
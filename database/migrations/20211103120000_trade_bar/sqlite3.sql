@@ -0,0 +1,32 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS trade_bar
+(
+    id text not null primary key,
+    exchange_name_id uuid REFERENCES exchange(id) NOT NULL,
+    base text NOT NULL,
+    quote text NOT NULL,
+    asset TEXT NOT NULL,
+    bar_type TEXT NOT NULL,
+    threshold REAL NOT NULL,
+    start_time TIMESTAMP NOT NULL,
+    end_time TIMESTAMP NOT NULL,
+    open REAL NOT NULL,
+    high REAL NOT NULL,
+    low REAL NOT NULL,
+    close REAL NOT NULL,
+    volume REAL NOT NULL,
+    notional REAL NOT NULL,
+    imbalance REAL NOT NULL,
+    trade_count integer NOT NULL,
+    source_job_id TEXT REFERENCES datahistoryjob(id)
+);
+ALTER TABLE datahistoryjob
+    ADD bar_type TEXT;
+ALTER TABLE datahistoryjob
+    ADD bar_threshold real;
+-- +goose Down
+ALTER TABLE datahistoryjob
+    DROP bar_threshold;
+ALTER TABLE datahistoryjob
+    DROP bar_type;
+DROP TABLE trade_bar;
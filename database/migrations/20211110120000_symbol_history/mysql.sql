@@ -0,0 +1,16 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS symbol_history
+(
+    id char(36) PRIMARY KEY DEFAULT (UUID()),
+    exchange_name_id char(36) NOT NULL,
+    asset varchar(255) NOT NULL,
+    base varchar(30) NOT NULL,
+    quote varchar(30) NOT NULL,
+    event varchar(30) NOT NULL,
+    new_base varchar(30),
+    new_quote varchar(30),
+    effective_date DATETIME(6) NOT NULL,
+    FOREIGN KEY (exchange_name_id) REFERENCES exchange(id)
+);
+-- +goose Down
+DROP TABLE symbol_history;
@@ -0,0 +1,15 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS symbol_history
+(
+    id uuid PRIMARY KEY DEFAULT gen_random_uuid(),
+    exchange_name_id uuid REFERENCES exchange(id) NOT NULL,
+    asset varchar NOT NULL,
+    base varchar(30) NOT NULL,
+    quote varchar(30) NOT NULL,
+    event varchar(30) NOT NULL,
+    new_base varchar(30),
+    new_quote varchar(30),
+    effective_date TIMESTAMPTZ NOT NULL
+);
+-- +goose Down
+DROP TABLE symbol_history;
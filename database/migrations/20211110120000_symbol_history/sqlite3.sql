@@ -0,0 +1,15 @@
+-- +goose Up
+CREATE TABLE IF NOT EXISTS symbol_history
+(
+    id text not null primary key,
+    exchange_name_id uuid REFERENCES exchange(id) NOT NULL,
+    asset TEXT NOT NULL,
+    base text NOT NULL,
+    quote text NOT NULL,
+    event TEXT NOT NULL,
+    new_base TEXT,
+    new_quote TEXT,
+    effective_date TIMESTAMP NOT NULL
+);
+-- +goose Down
+DROP TABLE symbol_history;
@@ -894,6 +894,83 @@ func testDatahistoryjobToManyJobDatahistoryjobresults(t *testing.T) {
 	}
 }
 
+func testDatahistoryjobToManySourceJobTradeBars(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Datahistoryjob
+	var b, c TradeBar
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, datahistoryjobDBTypes, true, datahistoryjobColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Datahistoryjob struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, tradeBarDBTypes, false, tradeBarColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, tradeBarDBTypes, false, tradeBarColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	queries.Assign(&b.SourceJobID, a.ID)
+	queries.Assign(&c.SourceJobID, a.ID)
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.SourceJobTradeBars().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if queries.Equal(v.SourceJobID, b.SourceJobID) {
+			bFound = true
+		}
+		if queries.Equal(v.SourceJobID, c.SourceJobID) {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := DatahistoryjobSlice{&a}
+	if err = a.L.LoadSourceJobTradeBars(ctx, tx, false, (*[]*Datahistoryjob)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.SourceJobTradeBars); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.SourceJobTradeBars = nil
+	if err = a.L.LoadSourceJobTradeBars(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.SourceJobTradeBars); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
 func testDatahistoryjobToManyAddOpSourceJobCandles(t *testing.T) {
 	var err error
 
@@ -1927,6 +2004,257 @@ func testDatahistoryjobToManyAddOpJobDatahistoryjobresults(t *testing.T) {
 		}
 	}
 }
+func testDatahistoryjobToManyAddOpSourceJobTradeBars(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Datahistoryjob
+	var b, c, d, e TradeBar
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, datahistoryjobDBTypes, false, strmangle.SetComplement(datahistoryjobPrimaryKeyColumns, datahistoryjobColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*TradeBar{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, tradeBarDBTypes, false, strmangle.SetComplement(tradeBarPrimaryKeyColumns, tradeBarColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*TradeBar{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddSourceJobTradeBars(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.SourceJobID) {
+			t.Error("foreign key was wrong value", a.ID, first.SourceJobID)
+		}
+		if !queries.Equal(a.ID, second.SourceJobID) {
+			t.Error("foreign key was wrong value", a.ID, second.SourceJobID)
+		}
+
+		if first.R.SourceJob != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.SourceJob != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.SourceJobTradeBars[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.SourceJobTradeBars[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.SourceJobTradeBars().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testDatahistoryjobToManySetOpSourceJobTradeBars(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Datahistoryjob
+	var b, c, d, e TradeBar
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, datahistoryjobDBTypes, false, strmangle.SetComplement(datahistoryjobPrimaryKeyColumns, datahistoryjobColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*TradeBar{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, tradeBarDBTypes, false, strmangle.SetComplement(tradeBarPrimaryKeyColumns, tradeBarColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetSourceJobTradeBars(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.SourceJobTradeBars().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetSourceJobTradeBars(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.SourceJobTradeBars().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.SourceJobID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.SourceJobID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.SourceJobID) {
+		t.Error("foreign key was wrong value", a.ID, d.SourceJobID)
+	}
+	if !queries.Equal(a.ID, e.SourceJobID) {
+		t.Error("foreign key was wrong value", a.ID, e.SourceJobID)
+	}
+
+	if b.R.SourceJob != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.SourceJob != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.SourceJob != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.SourceJob != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.SourceJobTradeBars[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.SourceJobTradeBars[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testDatahistoryjobToManyRemoveOpSourceJobTradeBars(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Datahistoryjob
+	var b, c, d, e TradeBar
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, datahistoryjobDBTypes, false, strmangle.SetComplement(datahistoryjobPrimaryKeyColumns, datahistoryjobColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*TradeBar{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, tradeBarDBTypes, false, strmangle.SetComplement(tradeBarPrimaryKeyColumns, tradeBarColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddSourceJobTradeBars(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.SourceJobTradeBars().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveSourceJobTradeBars(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.SourceJobTradeBars().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.SourceJobID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.SourceJobID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.SourceJob != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.SourceJob != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.SourceJob != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.SourceJob != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.SourceJobTradeBars) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.SourceJobTradeBars[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.SourceJobTradeBars[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
 func testDatahistoryjobToOneExchangeUsingExchangeName(t *testing.T) {
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
@@ -2269,7 +2597,7 @@ func testDatahistoryjobsSelect(t *testing.T) {
 }
 
 var (
-	datahistoryjobDBTypes = map[string]string{`ID`: `uuid`, `Nickname`: `character varying`, `ExchangeNameID`: `uuid`, `Asset`: `character varying`, `Base`: `character varying`, `Quote`: `character varying`, `StartTime`: `timestamp with time zone`, `EndTime`: `timestamp with time zone`, `DataType`: `double precision`, `Interval`: `double precision`, `RequestSize`: `double precision`, `MaxRetries`: `double precision`, `BatchCount`: `double precision`, `Status`: `double precision`, `Created`: `timestamp with time zone`, `ConversionInterval`: `double precision`, `OverwriteData`: `boolean`, `DecimalPlaceComparison`: `integer`, `SecondaryExchangeID`: `uuid`, `IssueTolerancePercentage`: `double precision`, `ReplaceOnIssue`: `boolean`}
+	datahistoryjobDBTypes = map[string]string{`ID`: `uuid`, `Nickname`: `character varying`, `ExchangeNameID`: `uuid`, `Asset`: `character varying`, `Base`: `character varying`, `Quote`: `character varying`, `StartTime`: `timestamp with time zone`, `EndTime`: `timestamp with time zone`, `DataType`: `double precision`, `Interval`: `double precision`, `RequestSize`: `double precision`, `MaxRetries`: `double precision`, `BatchCount`: `double precision`, `Status`: `double precision`, `Created`: `timestamp with time zone`, `ConversionInterval`: `double precision`, `OverwriteData`: `boolean`, `DecimalPlaceComparison`: `integer`, `SecondaryExchangeID`: `uuid`, `IssueTolerancePercentage`: `double precision`, `ReplaceOnIssue`: `boolean`, `RecurrenceInterval`: `double precision`, `SettleDelay`: `double precision`, `RollingWindow`: `double precision`, `BackfillToInception`: `boolean`, `NextRunTime`: `timestamp with time zone`, `BarType`: `character varying`, `BarThreshold`: `double precision`}
 	_                     = bytes.MinRead
 )
 
@@ -51,24 +51,39 @@ var ExchangeWhere = struct {
 // ExchangeRels is where relationship names are stored.
 var ExchangeRels = struct {
 	ExchangeNameCandles              string
+	ExchangeNameDataQualityFindings  string
 	ExchangeNameDatahistoryjobs      string
 	SecondaryExchangeDatahistoryjobs string
+	ExchangeNameFundingRates         string
+	ExchangeNameOrderbookSnapshots   string
+	ExchangeNameSymbolHistories      string
 	ExchangeNameTrades               string
+	ExchangeNameTradeBars            string
 	ExchangeNameWithdrawalHistories  string
 }{
 	ExchangeNameCandles:              "ExchangeNameCandles",
+	ExchangeNameDataQualityFindings:  "ExchangeNameDataQualityFindings",
 	ExchangeNameDatahistoryjobs:      "ExchangeNameDatahistoryjobs",
 	SecondaryExchangeDatahistoryjobs: "SecondaryExchangeDatahistoryjobs",
+	ExchangeNameFundingRates:         "ExchangeNameFundingRates",
+	ExchangeNameOrderbookSnapshots:   "ExchangeNameOrderbookSnapshots",
+	ExchangeNameSymbolHistories:      "ExchangeNameSymbolHistories",
 	ExchangeNameTrades:               "ExchangeNameTrades",
+	ExchangeNameTradeBars:            "ExchangeNameTradeBars",
 	ExchangeNameWithdrawalHistories:  "ExchangeNameWithdrawalHistories",
 }
 
 // exchangeR is where relationships are stored.
 type exchangeR struct {
 	ExchangeNameCandles              CandleSlice
+	ExchangeNameDataQualityFindings  DataQualityFindingSlice
 	ExchangeNameDatahistoryjobs      DatahistoryjobSlice
 	SecondaryExchangeDatahistoryjobs DatahistoryjobSlice
+	ExchangeNameFundingRates         FundingRateSlice
+	ExchangeNameOrderbookSnapshots   OrderbookSnapshotSlice
+	ExchangeNameSymbolHistories      SymbolHistorySlice
 	ExchangeNameTrades               TradeSlice
+	ExchangeNameTradeBars            TradeBarSlice
 	ExchangeNameWithdrawalHistories  WithdrawalHistorySlice
 }
 
@@ -383,6 +398,27 @@ func (o *Exchange) ExchangeNameCandles(mods ...qm.QueryMod) candleQuery {
 	return query
 }
 
+// ExchangeNameDataQualityFindings retrieves all the data_quality_finding's DataQualityFindings with an executor via exchange_name_id column.
+func (o *Exchange) ExchangeNameDataQualityFindings(mods ...qm.QueryMod) dataQualityFindingQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"data_quality_finding\".\"exchange_name_id\"=?", o.ID),
+	)
+
+	query := DataQualityFindings(queryMods...)
+	queries.SetFrom(query.Query, "\"data_quality_finding\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"data_quality_finding\".*"})
+	}
+
+	return query
+}
+
 // ExchangeNameDatahistoryjobs retrieves all the datahistoryjob's Datahistoryjobs with an executor via exchange_name_id column.
 func (o *Exchange) ExchangeNameDatahistoryjobs(mods ...qm.QueryMod) datahistoryjobQuery {
 	var queryMods []qm.QueryMod
@@ -425,6 +461,69 @@ func (o *Exchange) SecondaryExchangeDatahistoryjobs(mods ...qm.QueryMod) datahis
 	return query
 }
 
+// ExchangeNameFundingRates retrieves all the funding_rate's FundingRates with an executor via exchange_name_id column.
+func (o *Exchange) ExchangeNameFundingRates(mods ...qm.QueryMod) fundingRateQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"funding_rate\".\"exchange_name_id\"=?", o.ID),
+	)
+
+	query := FundingRates(queryMods...)
+	queries.SetFrom(query.Query, "\"funding_rate\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"funding_rate\".*"})
+	}
+
+	return query
+}
+
+// ExchangeNameOrderbookSnapshots retrieves all the orderbook_snapshot's OrderbookSnapshots with an executor via exchange_name_id column.
+func (o *Exchange) ExchangeNameOrderbookSnapshots(mods ...qm.QueryMod) orderbookSnapshotQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"orderbook_snapshot\".\"exchange_name_id\"=?", o.ID),
+	)
+
+	query := OrderbookSnapshots(queryMods...)
+	queries.SetFrom(query.Query, "\"orderbook_snapshot\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"orderbook_snapshot\".*"})
+	}
+
+	return query
+}
+
+// ExchangeNameSymbolHistories retrieves all the symbol_history's SymbolHistories with an executor via exchange_name_id column.
+func (o *Exchange) ExchangeNameSymbolHistories(mods ...qm.QueryMod) symbolHistoryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"symbol_history\".\"exchange_name_id\"=?", o.ID),
+	)
+
+	query := SymbolHistories(queryMods...)
+	queries.SetFrom(query.Query, "\"symbol_history\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"symbol_history\".*"})
+	}
+
+	return query
+}
+
 // ExchangeNameTrades retrieves all the trade's Trades with an executor via exchange_name_id column.
 func (o *Exchange) ExchangeNameTrades(mods ...qm.QueryMod) tradeQuery {
 	var queryMods []qm.QueryMod
@@ -446,6 +545,27 @@ func (o *Exchange) ExchangeNameTrades(mods ...qm.QueryMod) tradeQuery {
 	return query
 }
 
+// ExchangeNameTradeBars retrieves all the trade_bar's TradeBars with an executor via exchange_name_id column.
+func (o *Exchange) ExchangeNameTradeBars(mods ...qm.QueryMod) tradeBarQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"trade_bar\".\"exchange_name_id\"=?", o.ID),
+	)
+
+	query := TradeBars(queryMods...)
+	queries.SetFrom(query.Query, "\"trade_bar\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"trade_bar\".*"})
+	}
+
+	return query
+}
+
 // ExchangeNameWithdrawalHistories retrieves all the withdrawal_history's WithdrawalHistories with an executor via exchange_name_id column.
 func (o *Exchange) ExchangeNameWithdrawalHistories(mods ...qm.QueryMod) withdrawalHistoryQuery {
 	var queryMods []qm.QueryMod
@@ -562,6 +682,101 @@ func (exchangeL) LoadExchangeNameCandles(ctx context.Context, e boil.ContextExec
 	return nil
 }
 
+// LoadExchangeNameDataQualityFindings allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (exchangeL) LoadExchangeNameDataQualityFindings(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
+	var slice []*Exchange
+	var object *Exchange
+
+	if singular {
+		object = maybeExchange.(*Exchange)
+	} else {
+		slice = *maybeExchange.(*[]*Exchange)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &exchangeR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &exchangeR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`data_quality_finding`), qm.WhereIn(`data_quality_finding.exchange_name_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load data_quality_finding")
+	}
+
+	var resultSlice []*DataQualityFinding
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice data_quality_finding")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on data_quality_finding")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for data_quality_finding")
+	}
+
+	if len(dataQualityFindingAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ExchangeNameDataQualityFindings = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &dataQualityFindingR{}
+			}
+			foreign.R.ExchangeName = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ExchangeNameID {
+				local.R.ExchangeNameDataQualityFindings = append(local.R.ExchangeNameDataQualityFindings, foreign)
+				if foreign.R == nil {
+					foreign.R = &dataQualityFindingR{}
+				}
+				foreign.R.ExchangeName = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadExchangeNameDatahistoryjobs allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
 func (exchangeL) LoadExchangeNameDatahistoryjobs(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
@@ -752,9 +967,9 @@ func (exchangeL) LoadSecondaryExchangeDatahistoryjobs(ctx context.Context, e boi
 	return nil
 }
 
-// LoadExchangeNameTrades allows an eager lookup of values, cached into the
+// LoadExchangeNameFundingRates allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (exchangeL) LoadExchangeNameTrades(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
+func (exchangeL) LoadExchangeNameFundingRates(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
 	var slice []*Exchange
 	var object *Exchange
 
@@ -791,29 +1006,29 @@ func (exchangeL) LoadExchangeNameTrades(ctx context.Context, e boil.ContextExecu
 		return nil
 	}
 
-	query := NewQuery(qm.From(`trade`), qm.WhereIn(`trade.exchange_name_id in ?`, args...))
+	query := NewQuery(qm.From(`funding_rate`), qm.WhereIn(`funding_rate.exchange_name_id in ?`, args...))
 	if mods != nil {
 		mods.Apply(query)
 	}
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load trade")
+		return errors.Wrap(err, "failed to eager load funding_rate")
 	}
 
-	var resultSlice []*Trade
+	var resultSlice []*FundingRate
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice trade")
+		return errors.Wrap(err, "failed to bind eager loaded slice funding_rate")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on trade")
+		return errors.Wrap(err, "failed to close results in eager load on funding_rate")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for trade")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for funding_rate")
 	}
 
-	if len(tradeAfterSelectHooks) != 0 {
+	if len(fundingRateAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -821,10 +1036,10 @@ func (exchangeL) LoadExchangeNameTrades(ctx context.Context, e boil.ContextExecu
 		}
 	}
 	if singular {
-		object.R.ExchangeNameTrades = resultSlice
+		object.R.ExchangeNameFundingRates = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &tradeR{}
+				foreign.R = &fundingRateR{}
 			}
 			foreign.R.ExchangeName = object
 		}
@@ -834,9 +1049,9 @@ func (exchangeL) LoadExchangeNameTrades(ctx context.Context, e boil.ContextExecu
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.ExchangeNameID {
-				local.R.ExchangeNameTrades = append(local.R.ExchangeNameTrades, foreign)
+				local.R.ExchangeNameFundingRates = append(local.R.ExchangeNameFundingRates, foreign)
 				if foreign.R == nil {
-					foreign.R = &tradeR{}
+					foreign.R = &fundingRateR{}
 				}
 				foreign.R.ExchangeName = local
 				break
@@ -847,9 +1062,9 @@ func (exchangeL) LoadExchangeNameTrades(ctx context.Context, e boil.ContextExecu
 	return nil
 }
 
-// LoadExchangeNameWithdrawalHistories allows an eager lookup of values, cached into the
+// LoadExchangeNameOrderbookSnapshots allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (exchangeL) LoadExchangeNameWithdrawalHistories(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
+func (exchangeL) LoadExchangeNameOrderbookSnapshots(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
 	var slice []*Exchange
 	var object *Exchange
 
@@ -886,29 +1101,29 @@ func (exchangeL) LoadExchangeNameWithdrawalHistories(ctx context.Context, e boil
 		return nil
 	}
 
-	query := NewQuery(qm.From(`withdrawal_history`), qm.WhereIn(`withdrawal_history.exchange_name_id in ?`, args...))
+	query := NewQuery(qm.From(`orderbook_snapshot`), qm.WhereIn(`orderbook_snapshot.exchange_name_id in ?`, args...))
 	if mods != nil {
 		mods.Apply(query)
 	}
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load withdrawal_history")
+		return errors.Wrap(err, "failed to eager load orderbook_snapshot")
 	}
 
-	var resultSlice []*WithdrawalHistory
+	var resultSlice []*OrderbookSnapshot
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice withdrawal_history")
+		return errors.Wrap(err, "failed to bind eager loaded slice orderbook_snapshot")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on withdrawal_history")
+		return errors.Wrap(err, "failed to close results in eager load on orderbook_snapshot")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for withdrawal_history")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for orderbook_snapshot")
 	}
 
-	if len(withdrawalHistoryAfterSelectHooks) != 0 {
+	if len(orderbookSnapshotAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -916,10 +1131,10 @@ func (exchangeL) LoadExchangeNameWithdrawalHistories(ctx context.Context, e boil
 		}
 	}
 	if singular {
-		object.R.ExchangeNameWithdrawalHistories = resultSlice
+		object.R.ExchangeNameOrderbookSnapshots = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &withdrawalHistoryR{}
+				foreign.R = &orderbookSnapshotR{}
 			}
 			foreign.R.ExchangeName = object
 		}
@@ -929,9 +1144,9 @@ func (exchangeL) LoadExchangeNameWithdrawalHistories(ctx context.Context, e boil
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.ExchangeNameID {
-				local.R.ExchangeNameWithdrawalHistories = append(local.R.ExchangeNameWithdrawalHistories, foreign)
+				local.R.ExchangeNameOrderbookSnapshots = append(local.R.ExchangeNameOrderbookSnapshots, foreign)
 				if foreign.R == nil {
-					foreign.R = &withdrawalHistoryR{}
+					foreign.R = &orderbookSnapshotR{}
 				}
 				foreign.R.ExchangeName = local
 				break
@@ -942,62 +1157,495 @@ func (exchangeL) LoadExchangeNameWithdrawalHistories(ctx context.Context, e boil
 	return nil
 }
 
-// AddExchangeNameCandles adds the given related objects to the existing relationships
-// of the exchange, optionally inserting them as new records.
-// Appends related to o.R.ExchangeNameCandles.
-// Sets related.R.ExchangeName appropriately.
-func (o *Exchange) AddExchangeNameCandles(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Candle) error {
-	var err error
-	for _, rel := range related {
-		if insert {
-			rel.ExchangeNameID = o.ID
-			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
-				return errors.Wrap(err, "failed to insert into foreign table")
-			}
-		} else {
-			updateQuery := fmt.Sprintf(
-				"UPDATE \"candle\" SET %s WHERE %s",
-				strmangle.SetParamNames("\"", "\"", 1, []string{"exchange_name_id"}),
-				strmangle.WhereClause("\"", "\"", 2, candlePrimaryKeyColumns),
-			)
-			values := []interface{}{o.ID, rel.ID}
+// LoadExchangeNameSymbolHistories allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (exchangeL) LoadExchangeNameSymbolHistories(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
+	var slice []*Exchange
+	var object *Exchange
 
-			if boil.DebugMode {
-				fmt.Fprintln(boil.DebugWriter, updateQuery)
-				fmt.Fprintln(boil.DebugWriter, values)
+	if singular {
+		object = maybeExchange.(*Exchange)
+	} else {
+		slice = *maybeExchange.(*[]*Exchange)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &exchangeR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &exchangeR{}
 			}
 
-			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
-				return errors.Wrap(err, "failed to update foreign table")
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
 			}
 
-			rel.ExchangeNameID = o.ID
+			args = append(args, obj.ID)
 		}
 	}
 
-	if o.R == nil {
-		o.R = &exchangeR{
-			ExchangeNameCandles: related,
-		}
-	} else {
-		o.R.ExchangeNameCandles = append(o.R.ExchangeNameCandles, related...)
+	if len(args) == 0 {
+		return nil
 	}
 
-	for _, rel := range related {
-		if rel.R == nil {
-			rel.R = &candleR{
-				ExchangeName: o,
-			}
-		} else {
-			rel.R.ExchangeName = o
-		}
+	query := NewQuery(qm.From(`symbol_history`), qm.WhereIn(`symbol_history.exchange_name_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
 	}
-	return nil
-}
 
-// AddExchangeNameDatahistoryjobs adds the given related objects to the existing relationships
-// of the exchange, optionally inserting them as new records.
-// Appends related to o.R.ExchangeNameDatahistoryjobs.
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load symbol_history")
+	}
+
+	var resultSlice []*SymbolHistory
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice symbol_history")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on symbol_history")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for symbol_history")
+	}
+
+	if len(symbolHistoryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ExchangeNameSymbolHistories = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &symbolHistoryR{}
+			}
+			foreign.R.ExchangeName = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ExchangeNameID {
+				local.R.ExchangeNameSymbolHistories = append(local.R.ExchangeNameSymbolHistories, foreign)
+				if foreign.R == nil {
+					foreign.R = &symbolHistoryR{}
+				}
+				foreign.R.ExchangeName = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadExchangeNameTrades allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (exchangeL) LoadExchangeNameTrades(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
+	var slice []*Exchange
+	var object *Exchange
+
+	if singular {
+		object = maybeExchange.(*Exchange)
+	} else {
+		slice = *maybeExchange.(*[]*Exchange)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &exchangeR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &exchangeR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`trade`), qm.WhereIn(`trade.exchange_name_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load trade")
+	}
+
+	var resultSlice []*Trade
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice trade")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on trade")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for trade")
+	}
+
+	if len(tradeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ExchangeNameTrades = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &tradeR{}
+			}
+			foreign.R.ExchangeName = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ExchangeNameID {
+				local.R.ExchangeNameTrades = append(local.R.ExchangeNameTrades, foreign)
+				if foreign.R == nil {
+					foreign.R = &tradeR{}
+				}
+				foreign.R.ExchangeName = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadExchangeNameTradeBars allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (exchangeL) LoadExchangeNameTradeBars(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
+	var slice []*Exchange
+	var object *Exchange
+
+	if singular {
+		object = maybeExchange.(*Exchange)
+	} else {
+		slice = *maybeExchange.(*[]*Exchange)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &exchangeR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &exchangeR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`trade_bar`), qm.WhereIn(`trade_bar.exchange_name_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load trade_bar")
+	}
+
+	var resultSlice []*TradeBar
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice trade_bar")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on trade_bar")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for trade_bar")
+	}
+
+	if len(tradeBarAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ExchangeNameTradeBars = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &tradeBarR{}
+			}
+			foreign.R.ExchangeName = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ExchangeNameID {
+				local.R.ExchangeNameTradeBars = append(local.R.ExchangeNameTradeBars, foreign)
+				if foreign.R == nil {
+					foreign.R = &tradeBarR{}
+				}
+				foreign.R.ExchangeName = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadExchangeNameWithdrawalHistories allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (exchangeL) LoadExchangeNameWithdrawalHistories(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
+	var slice []*Exchange
+	var object *Exchange
+
+	if singular {
+		object = maybeExchange.(*Exchange)
+	} else {
+		slice = *maybeExchange.(*[]*Exchange)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &exchangeR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &exchangeR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`withdrawal_history`), qm.WhereIn(`withdrawal_history.exchange_name_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load withdrawal_history")
+	}
+
+	var resultSlice []*WithdrawalHistory
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice withdrawal_history")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on withdrawal_history")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for withdrawal_history")
+	}
+
+	if len(withdrawalHistoryAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ExchangeNameWithdrawalHistories = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &withdrawalHistoryR{}
+			}
+			foreign.R.ExchangeName = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ExchangeNameID {
+				local.R.ExchangeNameWithdrawalHistories = append(local.R.ExchangeNameWithdrawalHistories, foreign)
+				if foreign.R == nil {
+					foreign.R = &withdrawalHistoryR{}
+				}
+				foreign.R.ExchangeName = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// AddExchangeNameCandles adds the given related objects to the existing relationships
+// of the exchange, optionally inserting them as new records.
+// Appends related to o.R.ExchangeNameCandles.
+// Sets related.R.ExchangeName appropriately.
+func (o *Exchange) AddExchangeNameCandles(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Candle) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ExchangeNameID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"candle\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"exchange_name_id"}),
+				strmangle.WhereClause("\"", "\"", 2, candlePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ExchangeNameID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &exchangeR{
+			ExchangeNameCandles: related,
+		}
+	} else {
+		o.R.ExchangeNameCandles = append(o.R.ExchangeNameCandles, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &candleR{
+				ExchangeName: o,
+			}
+		} else {
+			rel.R.ExchangeName = o
+		}
+	}
+	return nil
+}
+
+// AddExchangeNameDataQualityFindings adds the given related objects to the existing relationships
+// of the exchange, optionally inserting them as new records.
+// Appends related to o.R.ExchangeNameDataQualityFindings.
+// Sets related.R.ExchangeName appropriately.
+func (o *Exchange) AddExchangeNameDataQualityFindings(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*DataQualityFinding) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ExchangeNameID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"data_quality_finding\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"exchange_name_id"}),
+				strmangle.WhereClause("\"", "\"", 2, dataQualityFindingPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ExchangeNameID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &exchangeR{
+			ExchangeNameDataQualityFindings: related,
+		}
+	} else {
+		o.R.ExchangeNameDataQualityFindings = append(o.R.ExchangeNameDataQualityFindings, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &dataQualityFindingR{
+				ExchangeName: o,
+			}
+		} else {
+			rel.R.ExchangeName = o
+		}
+	}
+	return nil
+}
+
+// AddExchangeNameDatahistoryjobs adds the given related objects to the existing relationships
+// of the exchange, optionally inserting them as new records.
+// Appends related to o.R.ExchangeNameDatahistoryjobs.
 // Sets related.R.ExchangeName appropriately.
 func (o *Exchange) AddExchangeNameDatahistoryjobs(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*Datahistoryjob) error {
 	var err error
@@ -1171,6 +1819,165 @@ func (o *Exchange) RemoveSecondaryExchangeDatahistoryjobs(ctx context.Context, e
 	return nil
 }
 
+// AddExchangeNameFundingRates adds the given related objects to the existing relationships
+// of the exchange, optionally inserting them as new records.
+// Appends related to o.R.ExchangeNameFundingRates.
+// Sets related.R.ExchangeName appropriately.
+func (o *Exchange) AddExchangeNameFundingRates(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*FundingRate) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ExchangeNameID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"funding_rate\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"exchange_name_id"}),
+				strmangle.WhereClause("\"", "\"", 2, fundingRatePrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ExchangeNameID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &exchangeR{
+			ExchangeNameFundingRates: related,
+		}
+	} else {
+		o.R.ExchangeNameFundingRates = append(o.R.ExchangeNameFundingRates, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &fundingRateR{
+				ExchangeName: o,
+			}
+		} else {
+			rel.R.ExchangeName = o
+		}
+	}
+	return nil
+}
+
+// AddExchangeNameOrderbookSnapshots adds the given related objects to the existing relationships
+// of the exchange, optionally inserting them as new records.
+// Appends related to o.R.ExchangeNameOrderbookSnapshots.
+// Sets related.R.ExchangeName appropriately.
+func (o *Exchange) AddExchangeNameOrderbookSnapshots(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*OrderbookSnapshot) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ExchangeNameID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"orderbook_snapshot\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"exchange_name_id"}),
+				strmangle.WhereClause("\"", "\"", 2, orderbookSnapshotPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ExchangeNameID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &exchangeR{
+			ExchangeNameOrderbookSnapshots: related,
+		}
+	} else {
+		o.R.ExchangeNameOrderbookSnapshots = append(o.R.ExchangeNameOrderbookSnapshots, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &orderbookSnapshotR{
+				ExchangeName: o,
+			}
+		} else {
+			rel.R.ExchangeName = o
+		}
+	}
+	return nil
+}
+
+// AddExchangeNameSymbolHistories adds the given related objects to the existing relationships
+// of the exchange, optionally inserting them as new records.
+// Appends related to o.R.ExchangeNameSymbolHistories.
+// Sets related.R.ExchangeName appropriately.
+func (o *Exchange) AddExchangeNameSymbolHistories(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*SymbolHistory) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ExchangeNameID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"symbol_history\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"exchange_name_id"}),
+				strmangle.WhereClause("\"", "\"", 2, symbolHistoryPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ExchangeNameID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &exchangeR{
+			ExchangeNameSymbolHistories: related,
+		}
+	} else {
+		o.R.ExchangeNameSymbolHistories = append(o.R.ExchangeNameSymbolHistories, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &symbolHistoryR{
+				ExchangeName: o,
+			}
+		} else {
+			rel.R.ExchangeName = o
+		}
+	}
+	return nil
+}
+
 // AddExchangeNameTrades adds the given related objects to the existing relationships
 // of the exchange, optionally inserting them as new records.
 // Appends related to o.R.ExchangeNameTrades.
@@ -1224,6 +2031,59 @@ func (o *Exchange) AddExchangeNameTrades(ctx context.Context, exec boil.ContextE
 	return nil
 }
 
+// AddExchangeNameTradeBars adds the given related objects to the existing relationships
+// of the exchange, optionally inserting them as new records.
+// Appends related to o.R.ExchangeNameTradeBars.
+// Sets related.R.ExchangeName appropriately.
+func (o *Exchange) AddExchangeNameTradeBars(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TradeBar) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ExchangeNameID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"trade_bar\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 1, []string{"exchange_name_id"}),
+				strmangle.WhereClause("\"", "\"", 2, tradeBarPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ExchangeNameID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &exchangeR{
+			ExchangeNameTradeBars: related,
+		}
+	} else {
+		o.R.ExchangeNameTradeBars = append(o.R.ExchangeNameTradeBars, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &tradeBarR{
+				ExchangeName: o,
+			}
+		} else {
+			rel.R.ExchangeName = o
+		}
+	}
+	return nil
+}
+
 // AddExchangeNameWithdrawalHistories adds the given related objects to the existing relationships
 // of the exchange, optionally inserting them as new records.
 // Appends related to o.R.ExchangeNameWithdrawalHistories.
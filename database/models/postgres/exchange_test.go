@@ -572,6 +572,84 @@ func testExchangeToManyExchangeNameCandles(t *testing.T) {
 	}
 }
 
+func testExchangeToManyExchangeNameDataQualityFindings(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Exchange
+	var b, c DataQualityFinding
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, exchangeDBTypes, true, exchangeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Exchange struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, dataQualityFindingDBTypes, false, dataQualityFindingColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, dataQualityFindingDBTypes, false, dataQualityFindingColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.ExchangeNameID = a.ID
+	c.ExchangeNameID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.ExchangeNameDataQualityFindings().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.ExchangeNameID == b.ExchangeNameID {
+			bFound = true
+		}
+		if v.ExchangeNameID == c.ExchangeNameID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := ExchangeSlice{&a}
+	if err = a.L.LoadExchangeNameDataQualityFindings(ctx, tx, false, (*[]*Exchange)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ExchangeNameDataQualityFindings); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.ExchangeNameDataQualityFindings = nil
+	if err = a.L.LoadExchangeNameDataQualityFindings(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ExchangeNameDataQualityFindings); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
 func testExchangeToManyExchangeNameDatahistoryjobs(t *testing.T) {
 	var err error
 	ctx := context.Background()
@@ -727,14 +805,14 @@ func testExchangeToManySecondaryExchangeDatahistoryjobs(t *testing.T) {
 	}
 }
 
-func testExchangeToManyExchangeNameTrades(t *testing.T) {
+func testExchangeToManyExchangeNameFundingRates(t *testing.T) {
 	var err error
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
 	defer func() { _ = tx.Rollback() }()
 
 	var a Exchange
-	var b, c Trade
+	var b, c FundingRate
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, exchangeDBTypes, true, exchangeColumnsWithDefault...); err != nil {
@@ -745,10 +823,10 @@ func testExchangeToManyExchangeNameTrades(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if err = randomize.Struct(seed, &b, tradeDBTypes, false, tradeColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &b, fundingRateDBTypes, false, fundingRateColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
-	if err = randomize.Struct(seed, &c, tradeDBTypes, false, tradeColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &c, fundingRateDBTypes, false, fundingRateColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
 
@@ -762,7 +840,7 @@ func testExchangeToManyExchangeNameTrades(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	check, err := a.ExchangeNameTrades().All(ctx, tx)
+	check, err := a.ExchangeNameFundingRates().All(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -785,18 +863,18 @@ func testExchangeToManyExchangeNameTrades(t *testing.T) {
 	}
 
 	slice := ExchangeSlice{&a}
-	if err = a.L.LoadExchangeNameTrades(ctx, tx, false, (*[]*Exchange)(&slice), nil); err != nil {
+	if err = a.L.LoadExchangeNameFundingRates(ctx, tx, false, (*[]*Exchange)(&slice), nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.ExchangeNameTrades); got != 2 {
+	if got := len(a.R.ExchangeNameFundingRates); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
-	a.R.ExchangeNameTrades = nil
-	if err = a.L.LoadExchangeNameTrades(ctx, tx, true, &a, nil); err != nil {
+	a.R.ExchangeNameFundingRates = nil
+	if err = a.L.LoadExchangeNameFundingRates(ctx, tx, true, &a, nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.ExchangeNameTrades); got != 2 {
+	if got := len(a.R.ExchangeNameFundingRates); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
@@ -805,14 +883,14 @@ func testExchangeToManyExchangeNameTrades(t *testing.T) {
 	}
 }
 
-func testExchangeToManyExchangeNameWithdrawalHistories(t *testing.T) {
+func testExchangeToManyExchangeNameOrderbookSnapshots(t *testing.T) {
 	var err error
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
 	defer func() { _ = tx.Rollback() }()
 
 	var a Exchange
-	var b, c WithdrawalHistory
+	var b, c OrderbookSnapshot
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, exchangeDBTypes, true, exchangeColumnsWithDefault...); err != nil {
@@ -823,10 +901,10 @@ func testExchangeToManyExchangeNameWithdrawalHistories(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	if err = randomize.Struct(seed, &b, withdrawalHistoryDBTypes, false, withdrawalHistoryColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &b, orderbookSnapshotDBTypes, false, orderbookSnapshotColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
-	if err = randomize.Struct(seed, &c, withdrawalHistoryDBTypes, false, withdrawalHistoryColumnsWithDefault...); err != nil {
+	if err = randomize.Struct(seed, &c, orderbookSnapshotDBTypes, false, orderbookSnapshotColumnsWithDefault...); err != nil {
 		t.Fatal(err)
 	}
 
@@ -840,7 +918,7 @@ func testExchangeToManyExchangeNameWithdrawalHistories(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	check, err := a.ExchangeNameWithdrawalHistories().All(ctx, tx)
+	check, err := a.ExchangeNameOrderbookSnapshots().All(ctx, tx)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -863,18 +941,18 @@ func testExchangeToManyExchangeNameWithdrawalHistories(t *testing.T) {
 	}
 
 	slice := ExchangeSlice{&a}
-	if err = a.L.LoadExchangeNameWithdrawalHistories(ctx, tx, false, (*[]*Exchange)(&slice), nil); err != nil {
+	if err = a.L.LoadExchangeNameOrderbookSnapshots(ctx, tx, false, (*[]*Exchange)(&slice), nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.ExchangeNameWithdrawalHistories); got != 2 {
+	if got := len(a.R.ExchangeNameOrderbookSnapshots); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
-	a.R.ExchangeNameWithdrawalHistories = nil
-	if err = a.L.LoadExchangeNameWithdrawalHistories(ctx, tx, true, &a, nil); err != nil {
+	a.R.ExchangeNameOrderbookSnapshots = nil
+	if err = a.L.LoadExchangeNameOrderbookSnapshots(ctx, tx, true, &a, nil); err != nil {
 		t.Fatal(err)
 	}
-	if got := len(a.R.ExchangeNameWithdrawalHistories); got != 2 {
+	if got := len(a.R.ExchangeNameOrderbookSnapshots); got != 2 {
 		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
@@ -883,30 +961,34 @@ func testExchangeToManyExchangeNameWithdrawalHistories(t *testing.T) {
 	}
 }
 
-func testExchangeToManyAddOpExchangeNameCandles(t *testing.T) {
+func testExchangeToManyExchangeNameSymbolHistories(t *testing.T) {
 	var err error
-
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
 	defer func() { _ = tx.Rollback() }()
 
 	var a Exchange
-	var b, c, d, e Candle
+	var b, c SymbolHistory
 
 	seed := randomize.NewSeed()
-	if err = randomize.Struct(seed, &a, exchangeDBTypes, false, strmangle.SetComplement(exchangePrimaryKeyColumns, exchangeColumnsWithoutDefault)...); err != nil {
-		t.Fatal(err)
-	}
-	foreigners := []*Candle{&b, &c, &d, &e}
-	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, candleDBTypes, false, strmangle.SetComplement(candlePrimaryKeyColumns, candleColumnsWithoutDefault)...); err != nil {
-			t.Fatal(err)
-		}
+	if err = randomize.Struct(seed, &a, exchangeDBTypes, true, exchangeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Exchange struct: %s", err)
 	}
 
 	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
+
+	if err = randomize.Struct(seed, &b, symbolHistoryDBTypes, false, symbolHistoryColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, symbolHistoryDBTypes, false, symbolHistoryColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.ExchangeNameID = a.ID
+	c.ExchangeNameID = a.ID
+
 	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
@@ -914,144 +996,527 @@ func testExchangeToManyAddOpExchangeNameCandles(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	foreignersSplitByInsertion := [][]*Candle{
-		{&b, &c},
-		{&d, &e},
+	check, err := a.ExchangeNameSymbolHistories().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
 	}
 
-	for i, x := range foreignersSplitByInsertion {
-		err = a.AddExchangeNameCandles(ctx, tx, i != 0, x...)
-		if err != nil {
-			t.Fatal(err)
-		}
-
-		first := x[0]
-		second := x[1]
-
-		if a.ID != first.ExchangeNameID {
-			t.Error("foreign key was wrong value", a.ID, first.ExchangeNameID)
-		}
-		if a.ID != second.ExchangeNameID {
-			t.Error("foreign key was wrong value", a.ID, second.ExchangeNameID)
-		}
-
-		if first.R.ExchangeName != &a {
-			t.Error("relationship was not added properly to the foreign slice")
-		}
-		if second.R.ExchangeName != &a {
-			t.Error("relationship was not added properly to the foreign slice")
-		}
-
-		if a.R.ExchangeNameCandles[i*2] != first {
-			t.Error("relationship struct slice not set to correct value")
-		}
-		if a.R.ExchangeNameCandles[i*2+1] != second {
-			t.Error("relationship struct slice not set to correct value")
-		}
-
-		count, err := a.ExchangeNameCandles().Count(ctx, tx)
-		if err != nil {
-			t.Fatal(err)
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.ExchangeNameID == b.ExchangeNameID {
+			bFound = true
 		}
-		if want := int64((i + 1) * 2); count != want {
-			t.Error("want", want, "got", count)
+		if v.ExchangeNameID == c.ExchangeNameID {
+			cFound = true
 		}
 	}
-}
-func testExchangeToManyAddOpExchangeNameDatahistoryjobs(t *testing.T) {
-	var err error
-
-	ctx := context.Background()
-	tx := MustTx(boil.BeginTx(ctx, nil))
-	defer func() { _ = tx.Rollback() }()
-
-	var a Exchange
-	var b, c, d, e Datahistoryjob
 
-	seed := randomize.NewSeed()
-	if err = randomize.Struct(seed, &a, exchangeDBTypes, false, strmangle.SetComplement(exchangePrimaryKeyColumns, exchangeColumnsWithoutDefault)...); err != nil {
-		t.Fatal(err)
+	if !bFound {
+		t.Error("expected to find b")
 	}
-	foreigners := []*Datahistoryjob{&b, &c, &d, &e}
-	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, datahistoryjobDBTypes, false, strmangle.SetComplement(datahistoryjobPrimaryKeyColumns, datahistoryjobColumnsWithoutDefault)...); err != nil {
-			t.Fatal(err)
-		}
+	if !cFound {
+		t.Error("expected to find c")
 	}
 
-	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+	slice := ExchangeSlice{&a}
+	if err = a.L.LoadExchangeNameSymbolHistories(ctx, tx, false, (*[]*Exchange)(&slice), nil); err != nil {
 		t.Fatal(err)
 	}
-	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
-		t.Fatal(err)
+	if got := len(a.R.ExchangeNameSymbolHistories); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
 	}
-	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+
+	a.R.ExchangeNameSymbolHistories = nil
+	if err = a.L.LoadExchangeNameSymbolHistories(ctx, tx, true, &a, nil); err != nil {
 		t.Fatal(err)
 	}
-
-	foreignersSplitByInsertion := [][]*Datahistoryjob{
-		{&b, &c},
-		{&d, &e},
+	if got := len(a.R.ExchangeNameSymbolHistories); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
 	}
 
-	for i, x := range foreignersSplitByInsertion {
-		err = a.AddExchangeNameDatahistoryjobs(ctx, tx, i != 0, x...)
-		if err != nil {
-			t.Fatal(err)
-		}
-
-		first := x[0]
-		second := x[1]
-
-		if a.ID != first.ExchangeNameID {
-			t.Error("foreign key was wrong value", a.ID, first.ExchangeNameID)
-		}
-		if a.ID != second.ExchangeNameID {
-			t.Error("foreign key was wrong value", a.ID, second.ExchangeNameID)
-		}
-
-		if first.R.ExchangeName != &a {
-			t.Error("relationship was not added properly to the foreign slice")
-		}
-		if second.R.ExchangeName != &a {
-			t.Error("relationship was not added properly to the foreign slice")
-		}
-
-		if a.R.ExchangeNameDatahistoryjobs[i*2] != first {
-			t.Error("relationship struct slice not set to correct value")
-		}
-		if a.R.ExchangeNameDatahistoryjobs[i*2+1] != second {
-			t.Error("relationship struct slice not set to correct value")
-		}
-
-		count, err := a.ExchangeNameDatahistoryjobs().Count(ctx, tx)
-		if err != nil {
-			t.Fatal(err)
-		}
-		if want := int64((i + 1) * 2); count != want {
-			t.Error("want", want, "got", count)
-		}
+	if t.Failed() {
+		t.Logf("%#v", check)
 	}
 }
-func testExchangeToManyAddOpSecondaryExchangeDatahistoryjobs(t *testing.T) {
-	var err error
 
+func testExchangeToManyExchangeNameTrades(t *testing.T) {
+	var err error
 	ctx := context.Background()
 	tx := MustTx(boil.BeginTx(ctx, nil))
 	defer func() { _ = tx.Rollback() }()
 
 	var a Exchange
-	var b, c, d, e Datahistoryjob
+	var b, c Trade
 
 	seed := randomize.NewSeed()
-	if err = randomize.Struct(seed, &a, exchangeDBTypes, false, strmangle.SetComplement(exchangePrimaryKeyColumns, exchangeColumnsWithoutDefault)...); err != nil {
-		t.Fatal(err)
-	}
-	foreigners := []*Datahistoryjob{&b, &c, &d, &e}
-	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, datahistoryjobDBTypes, false, strmangle.SetComplement(datahistoryjobPrimaryKeyColumns, datahistoryjobColumnsWithoutDefault)...); err != nil {
-			t.Fatal(err)
-		}
+	if err = randomize.Struct(seed, &a, exchangeDBTypes, true, exchangeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Exchange struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, tradeDBTypes, false, tradeColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, tradeDBTypes, false, tradeColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.ExchangeNameID = a.ID
+	c.ExchangeNameID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.ExchangeNameTrades().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.ExchangeNameID == b.ExchangeNameID {
+			bFound = true
+		}
+		if v.ExchangeNameID == c.ExchangeNameID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := ExchangeSlice{&a}
+	if err = a.L.LoadExchangeNameTrades(ctx, tx, false, (*[]*Exchange)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ExchangeNameTrades); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.ExchangeNameTrades = nil
+	if err = a.L.LoadExchangeNameTrades(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ExchangeNameTrades); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testExchangeToManyExchangeNameTradeBars(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Exchange
+	var b, c TradeBar
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, exchangeDBTypes, true, exchangeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Exchange struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, tradeBarDBTypes, false, tradeBarColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, tradeBarDBTypes, false, tradeBarColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.ExchangeNameID = a.ID
+	c.ExchangeNameID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.ExchangeNameTradeBars().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.ExchangeNameID == b.ExchangeNameID {
+			bFound = true
+		}
+		if v.ExchangeNameID == c.ExchangeNameID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := ExchangeSlice{&a}
+	if err = a.L.LoadExchangeNameTradeBars(ctx, tx, false, (*[]*Exchange)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ExchangeNameTradeBars); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.ExchangeNameTradeBars = nil
+	if err = a.L.LoadExchangeNameTradeBars(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ExchangeNameTradeBars); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testExchangeToManyExchangeNameWithdrawalHistories(t *testing.T) {
+	var err error
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Exchange
+	var b, c WithdrawalHistory
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, exchangeDBTypes, true, exchangeColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize Exchange struct: %s", err)
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	if err = randomize.Struct(seed, &b, withdrawalHistoryDBTypes, false, withdrawalHistoryColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+	if err = randomize.Struct(seed, &c, withdrawalHistoryDBTypes, false, withdrawalHistoryColumnsWithDefault...); err != nil {
+		t.Fatal(err)
+	}
+
+	b.ExchangeNameID = a.ID
+	c.ExchangeNameID = a.ID
+
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	check, err := a.ExchangeNameWithdrawalHistories().All(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	bFound, cFound := false, false
+	for _, v := range check {
+		if v.ExchangeNameID == b.ExchangeNameID {
+			bFound = true
+		}
+		if v.ExchangeNameID == c.ExchangeNameID {
+			cFound = true
+		}
+	}
+
+	if !bFound {
+		t.Error("expected to find b")
+	}
+	if !cFound {
+		t.Error("expected to find c")
+	}
+
+	slice := ExchangeSlice{&a}
+	if err = a.L.LoadExchangeNameWithdrawalHistories(ctx, tx, false, (*[]*Exchange)(&slice), nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ExchangeNameWithdrawalHistories); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	a.R.ExchangeNameWithdrawalHistories = nil
+	if err = a.L.LoadExchangeNameWithdrawalHistories(ctx, tx, true, &a, nil); err != nil {
+		t.Fatal(err)
+	}
+	if got := len(a.R.ExchangeNameWithdrawalHistories); got != 2 {
+		t.Error("number of eager loaded records wrong, got:", got)
+	}
+
+	if t.Failed() {
+		t.Logf("%#v", check)
+	}
+}
+
+func testExchangeToManyAddOpExchangeNameCandles(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Exchange
+	var b, c, d, e Candle
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, exchangeDBTypes, false, strmangle.SetComplement(exchangePrimaryKeyColumns, exchangeColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Candle{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, candleDBTypes, false, strmangle.SetComplement(candlePrimaryKeyColumns, candleColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Candle{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddExchangeNameCandles(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.ExchangeNameID {
+			t.Error("foreign key was wrong value", a.ID, first.ExchangeNameID)
+		}
+		if a.ID != second.ExchangeNameID {
+			t.Error("foreign key was wrong value", a.ID, second.ExchangeNameID)
+		}
+
+		if first.R.ExchangeName != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.ExchangeName != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.ExchangeNameCandles[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.ExchangeNameCandles[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.ExchangeNameCandles().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testExchangeToManyAddOpExchangeNameDataQualityFindings(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Exchange
+	var b, c, d, e DataQualityFinding
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, exchangeDBTypes, false, strmangle.SetComplement(exchangePrimaryKeyColumns, exchangeColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*DataQualityFinding{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, dataQualityFindingDBTypes, false, strmangle.SetComplement(dataQualityFindingPrimaryKeyColumns, dataQualityFindingColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*DataQualityFinding{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddExchangeNameDataQualityFindings(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.ExchangeNameID {
+			t.Error("foreign key was wrong value", a.ID, first.ExchangeNameID)
+		}
+		if a.ID != second.ExchangeNameID {
+			t.Error("foreign key was wrong value", a.ID, second.ExchangeNameID)
+		}
+
+		if first.R.ExchangeName != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.ExchangeName != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.ExchangeNameDataQualityFindings[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.ExchangeNameDataQualityFindings[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.ExchangeNameDataQualityFindings().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testExchangeToManyAddOpExchangeNameDatahistoryjobs(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Exchange
+	var b, c, d, e Datahistoryjob
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, exchangeDBTypes, false, strmangle.SetComplement(exchangePrimaryKeyColumns, exchangeColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Datahistoryjob{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, datahistoryjobDBTypes, false, strmangle.SetComplement(datahistoryjobPrimaryKeyColumns, datahistoryjobColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*Datahistoryjob{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddExchangeNameDatahistoryjobs(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.ExchangeNameID {
+			t.Error("foreign key was wrong value", a.ID, first.ExchangeNameID)
+		}
+		if a.ID != second.ExchangeNameID {
+			t.Error("foreign key was wrong value", a.ID, second.ExchangeNameID)
+		}
+
+		if first.R.ExchangeName != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.ExchangeName != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.ExchangeNameDatahistoryjobs[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.ExchangeNameDatahistoryjobs[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.ExchangeNameDatahistoryjobs().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+func testExchangeToManyAddOpSecondaryExchangeDatahistoryjobs(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Exchange
+	var b, c, d, e Datahistoryjob
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, exchangeDBTypes, false, strmangle.SetComplement(exchangePrimaryKeyColumns, exchangeColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Datahistoryjob{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, datahistoryjobDBTypes, false, strmangle.SetComplement(datahistoryjobPrimaryKeyColumns, datahistoryjobColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
 	}
 
 	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
@@ -1070,7 +1535,258 @@ func testExchangeToManyAddOpSecondaryExchangeDatahistoryjobs(t *testing.T) {
 	}
 
 	for i, x := range foreignersSplitByInsertion {
-		err = a.AddSecondaryExchangeDatahistoryjobs(ctx, tx, i != 0, x...)
+		err = a.AddSecondaryExchangeDatahistoryjobs(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if !queries.Equal(a.ID, first.SecondaryExchangeID) {
+			t.Error("foreign key was wrong value", a.ID, first.SecondaryExchangeID)
+		}
+		if !queries.Equal(a.ID, second.SecondaryExchangeID) {
+			t.Error("foreign key was wrong value", a.ID, second.SecondaryExchangeID)
+		}
+
+		if first.R.SecondaryExchange != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.SecondaryExchange != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.SecondaryExchangeDatahistoryjobs[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.SecondaryExchangeDatahistoryjobs[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.SecondaryExchangeDatahistoryjobs().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
+
+func testExchangeToManySetOpSecondaryExchangeDatahistoryjobs(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Exchange
+	var b, c, d, e Datahistoryjob
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, exchangeDBTypes, false, strmangle.SetComplement(exchangePrimaryKeyColumns, exchangeColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Datahistoryjob{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, datahistoryjobDBTypes, false, strmangle.SetComplement(datahistoryjobPrimaryKeyColumns, datahistoryjobColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.SetSecondaryExchangeDatahistoryjobs(ctx, tx, false, &b, &c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.SecondaryExchangeDatahistoryjobs().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.SetSecondaryExchangeDatahistoryjobs(ctx, tx, true, &d, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.SecondaryExchangeDatahistoryjobs().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.SecondaryExchangeID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.SecondaryExchangeID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+	if !queries.Equal(a.ID, d.SecondaryExchangeID) {
+		t.Error("foreign key was wrong value", a.ID, d.SecondaryExchangeID)
+	}
+	if !queries.Equal(a.ID, e.SecondaryExchangeID) {
+		t.Error("foreign key was wrong value", a.ID, e.SecondaryExchangeID)
+	}
+
+	if b.R.SecondaryExchange != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.SecondaryExchange != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.SecondaryExchange != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+	if e.R.SecondaryExchange != &a {
+		t.Error("relationship was not added properly to the foreign struct")
+	}
+
+	if a.R.SecondaryExchangeDatahistoryjobs[0] != &d {
+		t.Error("relationship struct slice not set to correct value")
+	}
+	if a.R.SecondaryExchangeDatahistoryjobs[1] != &e {
+		t.Error("relationship struct slice not set to correct value")
+	}
+}
+
+func testExchangeToManyRemoveOpSecondaryExchangeDatahistoryjobs(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Exchange
+	var b, c, d, e Datahistoryjob
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, exchangeDBTypes, false, strmangle.SetComplement(exchangePrimaryKeyColumns, exchangeColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*Datahistoryjob{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, datahistoryjobDBTypes, false, strmangle.SetComplement(datahistoryjobPrimaryKeyColumns, datahistoryjobColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	err = a.AddSecondaryExchangeDatahistoryjobs(ctx, tx, true, foreigners...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err := a.SecondaryExchangeDatahistoryjobs().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 4 {
+		t.Error("count was wrong:", count)
+	}
+
+	err = a.RemoveSecondaryExchangeDatahistoryjobs(ctx, tx, foreigners[:2]...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	count, err = a.SecondaryExchangeDatahistoryjobs().Count(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if count != 2 {
+		t.Error("count was wrong:", count)
+	}
+
+	if !queries.IsValuerNil(b.SecondaryExchangeID) {
+		t.Error("want b's foreign key value to be nil")
+	}
+	if !queries.IsValuerNil(c.SecondaryExchangeID) {
+		t.Error("want c's foreign key value to be nil")
+	}
+
+	if b.R.SecondaryExchange != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if c.R.SecondaryExchange != nil {
+		t.Error("relationship was not removed properly from the foreign struct")
+	}
+	if d.R.SecondaryExchange != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+	if e.R.SecondaryExchange != &a {
+		t.Error("relationship to a should have been preserved")
+	}
+
+	if len(a.R.SecondaryExchangeDatahistoryjobs) != 2 {
+		t.Error("should have preserved two relationships")
+	}
+
+	// Removal doesn't do a stable deletion for performance so we have to flip the order
+	if a.R.SecondaryExchangeDatahistoryjobs[1] != &d {
+		t.Error("relationship to d should have been preserved")
+	}
+	if a.R.SecondaryExchangeDatahistoryjobs[0] != &e {
+		t.Error("relationship to e should have been preserved")
+	}
+}
+
+func testExchangeToManyAddOpExchangeNameFundingRates(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Exchange
+	var b, c, d, e FundingRate
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, exchangeDBTypes, false, strmangle.SetComplement(exchangePrimaryKeyColumns, exchangeColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*FundingRate{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, fundingRateDBTypes, false, strmangle.SetComplement(fundingRatePrimaryKeyColumns, fundingRateColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*FundingRate{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddExchangeNameFundingRates(ctx, tx, i != 0, x...)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -1078,28 +1794,28 @@ func testExchangeToManyAddOpSecondaryExchangeDatahistoryjobs(t *testing.T) {
 		first := x[0]
 		second := x[1]
 
-		if !queries.Equal(a.ID, first.SecondaryExchangeID) {
-			t.Error("foreign key was wrong value", a.ID, first.SecondaryExchangeID)
+		if a.ID != first.ExchangeNameID {
+			t.Error("foreign key was wrong value", a.ID, first.ExchangeNameID)
 		}
-		if !queries.Equal(a.ID, second.SecondaryExchangeID) {
-			t.Error("foreign key was wrong value", a.ID, second.SecondaryExchangeID)
+		if a.ID != second.ExchangeNameID {
+			t.Error("foreign key was wrong value", a.ID, second.ExchangeNameID)
 		}
 
-		if first.R.SecondaryExchange != &a {
+		if first.R.ExchangeName != &a {
 			t.Error("relationship was not added properly to the foreign slice")
 		}
-		if second.R.SecondaryExchange != &a {
+		if second.R.ExchangeName != &a {
 			t.Error("relationship was not added properly to the foreign slice")
 		}
 
-		if a.R.SecondaryExchangeDatahistoryjobs[i*2] != first {
+		if a.R.ExchangeNameFundingRates[i*2] != first {
 			t.Error("relationship struct slice not set to correct value")
 		}
-		if a.R.SecondaryExchangeDatahistoryjobs[i*2+1] != second {
+		if a.R.ExchangeNameFundingRates[i*2+1] != second {
 			t.Error("relationship struct slice not set to correct value")
 		}
 
-		count, err := a.SecondaryExchangeDatahistoryjobs().Count(ctx, tx)
+		count, err := a.ExchangeNameFundingRates().Count(ctx, tx)
 		if err != nil {
 			t.Fatal(err)
 		}
@@ -1108,8 +1824,7 @@ func testExchangeToManyAddOpSecondaryExchangeDatahistoryjobs(t *testing.T) {
 		}
 	}
 }
-
-func testExchangeToManySetOpSecondaryExchangeDatahistoryjobs(t *testing.T) {
+func testExchangeToManyAddOpExchangeNameOrderbookSnapshots(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -1117,20 +1832,20 @@ func testExchangeToManySetOpSecondaryExchangeDatahistoryjobs(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a Exchange
-	var b, c, d, e Datahistoryjob
+	var b, c, d, e OrderbookSnapshot
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, exchangeDBTypes, false, strmangle.SetComplement(exchangePrimaryKeyColumns, exchangeColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*Datahistoryjob{&b, &c, &d, &e}
+	foreigners := []*OrderbookSnapshot{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, datahistoryjobDBTypes, false, strmangle.SetComplement(datahistoryjobPrimaryKeyColumns, datahistoryjobColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, orderbookSnapshotDBTypes, false, strmangle.SetComplement(orderbookSnapshotPrimaryKeyColumns, orderbookSnapshotColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
 
-	if err = a.Insert(ctx, tx, boil.Infer()); err != nil {
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
 	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
@@ -1140,67 +1855,51 @@ func testExchangeToManySetOpSecondaryExchangeDatahistoryjobs(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	err = a.SetSecondaryExchangeDatahistoryjobs(ctx, tx, false, &b, &c)
-	if err != nil {
-		t.Fatal(err)
+	foreignersSplitByInsertion := [][]*OrderbookSnapshot{
+		{&b, &c},
+		{&d, &e},
 	}
 
-	count, err := a.SecondaryExchangeDatahistoryjobs().Count(ctx, tx)
-	if err != nil {
-		t.Fatal(err)
-	}
-	if count != 2 {
-		t.Error("count was wrong:", count)
-	}
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddExchangeNameOrderbookSnapshots(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
 
-	err = a.SetSecondaryExchangeDatahistoryjobs(ctx, tx, true, &d, &e)
-	if err != nil {
-		t.Fatal(err)
-	}
+		first := x[0]
+		second := x[1]
 
-	count, err = a.SecondaryExchangeDatahistoryjobs().Count(ctx, tx)
-	if err != nil {
-		t.Fatal(err)
-	}
-	if count != 2 {
-		t.Error("count was wrong:", count)
-	}
+		if a.ID != first.ExchangeNameID {
+			t.Error("foreign key was wrong value", a.ID, first.ExchangeNameID)
+		}
+		if a.ID != second.ExchangeNameID {
+			t.Error("foreign key was wrong value", a.ID, second.ExchangeNameID)
+		}
 
-	if !queries.IsValuerNil(b.SecondaryExchangeID) {
-		t.Error("want b's foreign key value to be nil")
-	}
-	if !queries.IsValuerNil(c.SecondaryExchangeID) {
-		t.Error("want c's foreign key value to be nil")
-	}
-	if !queries.Equal(a.ID, d.SecondaryExchangeID) {
-		t.Error("foreign key was wrong value", a.ID, d.SecondaryExchangeID)
-	}
-	if !queries.Equal(a.ID, e.SecondaryExchangeID) {
-		t.Error("foreign key was wrong value", a.ID, e.SecondaryExchangeID)
-	}
+		if first.R.ExchangeName != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.ExchangeName != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
 
-	if b.R.SecondaryExchange != nil {
-		t.Error("relationship was not removed properly from the foreign struct")
-	}
-	if c.R.SecondaryExchange != nil {
-		t.Error("relationship was not removed properly from the foreign struct")
-	}
-	if d.R.SecondaryExchange != &a {
-		t.Error("relationship was not added properly to the foreign struct")
-	}
-	if e.R.SecondaryExchange != &a {
-		t.Error("relationship was not added properly to the foreign struct")
-	}
+		if a.R.ExchangeNameOrderbookSnapshots[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.ExchangeNameOrderbookSnapshots[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
 
-	if a.R.SecondaryExchangeDatahistoryjobs[0] != &d {
-		t.Error("relationship struct slice not set to correct value")
-	}
-	if a.R.SecondaryExchangeDatahistoryjobs[1] != &e {
-		t.Error("relationship struct slice not set to correct value")
+		count, err := a.ExchangeNameOrderbookSnapshots().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
 	}
 }
-
-func testExchangeToManyRemoveOpSecondaryExchangeDatahistoryjobs(t *testing.T) {
+func testExchangeToManyAddOpExchangeNameSymbolHistories(t *testing.T) {
 	var err error
 
 	ctx := context.Background()
@@ -1208,15 +1907,15 @@ func testExchangeToManyRemoveOpSecondaryExchangeDatahistoryjobs(t *testing.T) {
 	defer func() { _ = tx.Rollback() }()
 
 	var a Exchange
-	var b, c, d, e Datahistoryjob
+	var b, c, d, e SymbolHistory
 
 	seed := randomize.NewSeed()
 	if err = randomize.Struct(seed, &a, exchangeDBTypes, false, strmangle.SetComplement(exchangePrimaryKeyColumns, exchangeColumnsWithoutDefault)...); err != nil {
 		t.Fatal(err)
 	}
-	foreigners := []*Datahistoryjob{&b, &c, &d, &e}
+	foreigners := []*SymbolHistory{&b, &c, &d, &e}
 	for _, x := range foreigners {
-		if err = randomize.Struct(seed, x, datahistoryjobDBTypes, false, strmangle.SetComplement(datahistoryjobPrimaryKeyColumns, datahistoryjobColumnsWithoutDefault)...); err != nil {
+		if err = randomize.Struct(seed, x, symbolHistoryDBTypes, false, strmangle.SetComplement(symbolHistoryPrimaryKeyColumns, symbolHistoryColumnsWithoutDefault)...); err != nil {
 			t.Fatal(err)
 		}
 	}
@@ -1224,66 +1923,57 @@ func testExchangeToManyRemoveOpSecondaryExchangeDatahistoryjobs(t *testing.T) {
 	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
-
-	err = a.AddSecondaryExchangeDatahistoryjobs(ctx, tx, true, foreigners...)
-	if err != nil {
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
-
-	count, err := a.SecondaryExchangeDatahistoryjobs().Count(ctx, tx)
-	if err != nil {
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
 		t.Fatal(err)
 	}
-	if count != 4 {
-		t.Error("count was wrong:", count)
-	}
 
-	err = a.RemoveSecondaryExchangeDatahistoryjobs(ctx, tx, foreigners[:2]...)
-	if err != nil {
-		t.Fatal(err)
+	foreignersSplitByInsertion := [][]*SymbolHistory{
+		{&b, &c},
+		{&d, &e},
 	}
 
-	count, err = a.SecondaryExchangeDatahistoryjobs().Count(ctx, tx)
-	if err != nil {
-		t.Fatal(err)
-	}
-	if count != 2 {
-		t.Error("count was wrong:", count)
-	}
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddExchangeNameSymbolHistories(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
 
-	if !queries.IsValuerNil(b.SecondaryExchangeID) {
-		t.Error("want b's foreign key value to be nil")
-	}
-	if !queries.IsValuerNil(c.SecondaryExchangeID) {
-		t.Error("want c's foreign key value to be nil")
-	}
+		first := x[0]
+		second := x[1]
 
-	if b.R.SecondaryExchange != nil {
-		t.Error("relationship was not removed properly from the foreign struct")
-	}
-	if c.R.SecondaryExchange != nil {
-		t.Error("relationship was not removed properly from the foreign struct")
-	}
-	if d.R.SecondaryExchange != &a {
-		t.Error("relationship to a should have been preserved")
-	}
-	if e.R.SecondaryExchange != &a {
-		t.Error("relationship to a should have been preserved")
-	}
+		if a.ID != first.ExchangeNameID {
+			t.Error("foreign key was wrong value", a.ID, first.ExchangeNameID)
+		}
+		if a.ID != second.ExchangeNameID {
+			t.Error("foreign key was wrong value", a.ID, second.ExchangeNameID)
+		}
 
-	if len(a.R.SecondaryExchangeDatahistoryjobs) != 2 {
-		t.Error("should have preserved two relationships")
-	}
+		if first.R.ExchangeName != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.ExchangeName != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
 
-	// Removal doesn't do a stable deletion for performance so we have to flip the order
-	if a.R.SecondaryExchangeDatahistoryjobs[1] != &d {
-		t.Error("relationship to d should have been preserved")
-	}
-	if a.R.SecondaryExchangeDatahistoryjobs[0] != &e {
-		t.Error("relationship to e should have been preserved")
+		if a.R.ExchangeNameSymbolHistories[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.ExchangeNameSymbolHistories[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.ExchangeNameSymbolHistories().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
 	}
 }
-
 func testExchangeToManyAddOpExchangeNameTrades(t *testing.T) {
 	var err error
 
@@ -1359,6 +2049,81 @@ func testExchangeToManyAddOpExchangeNameTrades(t *testing.T) {
 		}
 	}
 }
+func testExchangeToManyAddOpExchangeNameTradeBars(t *testing.T) {
+	var err error
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+
+	var a Exchange
+	var b, c, d, e TradeBar
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, &a, exchangeDBTypes, false, strmangle.SetComplement(exchangePrimaryKeyColumns, exchangeColumnsWithoutDefault)...); err != nil {
+		t.Fatal(err)
+	}
+	foreigners := []*TradeBar{&b, &c, &d, &e}
+	for _, x := range foreigners {
+		if err = randomize.Struct(seed, x, tradeBarDBTypes, false, strmangle.SetComplement(tradeBarPrimaryKeyColumns, tradeBarColumnsWithoutDefault)...); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	if err := a.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = b.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+	if err = c.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Fatal(err)
+	}
+
+	foreignersSplitByInsertion := [][]*TradeBar{
+		{&b, &c},
+		{&d, &e},
+	}
+
+	for i, x := range foreignersSplitByInsertion {
+		err = a.AddExchangeNameTradeBars(ctx, tx, i != 0, x...)
+		if err != nil {
+			t.Fatal(err)
+		}
+
+		first := x[0]
+		second := x[1]
+
+		if a.ID != first.ExchangeNameID {
+			t.Error("foreign key was wrong value", a.ID, first.ExchangeNameID)
+		}
+		if a.ID != second.ExchangeNameID {
+			t.Error("foreign key was wrong value", a.ID, second.ExchangeNameID)
+		}
+
+		if first.R.ExchangeName != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+		if second.R.ExchangeName != &a {
+			t.Error("relationship was not added properly to the foreign slice")
+		}
+
+		if a.R.ExchangeNameTradeBars[i*2] != first {
+			t.Error("relationship struct slice not set to correct value")
+		}
+		if a.R.ExchangeNameTradeBars[i*2+1] != second {
+			t.Error("relationship struct slice not set to correct value")
+		}
+
+		count, err := a.ExchangeNameTradeBars().Count(ctx, tx)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if want := int64((i + 1) * 2); count != want {
+			t.Error("want", want, "got", count)
+		}
+	}
+}
 func testExchangeToManyAddOpExchangeNameWithdrawalHistories(t *testing.T) {
 	var err error
 
@@ -14,12 +14,17 @@ import "testing"
 func TestParent(t *testing.T) {
 	t.Run("AuditEvents", testAuditEvents)
 	t.Run("Candles", testCandles)
+	t.Run("DataQualityFindings", testDataQualityFindings)
 	t.Run("Datahistoryjobs", testDatahistoryjobs)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresults)
 	t.Run("Exchanges", testExchanges)
+	t.Run("FundingRates", testFundingRates)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshots)
 	t.Run("Scripts", testScripts)
 	t.Run("ScriptExecutions", testScriptExecutions)
+	t.Run("SymbolHistories", testSymbolHistories)
 	t.Run("Trades", testTrades)
+	t.Run("TradeBars", testTradeBars)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptos)
 	t.Run("WithdrawalFiats", testWithdrawalFiats)
 	t.Run("WithdrawalHistories", testWithdrawalHistories)
@@ -28,12 +33,17 @@ func TestParent(t *testing.T) {
 func TestDelete(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsDelete)
 	t.Run("Candles", testCandlesDelete)
+	t.Run("DataQualityFindings", testDataQualityFindingsDelete)
 	t.Run("Datahistoryjobs", testDatahistoryjobsDelete)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsDelete)
 	t.Run("Exchanges", testExchangesDelete)
+	t.Run("FundingRates", testFundingRatesDelete)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsDelete)
 	t.Run("Scripts", testScriptsDelete)
 	t.Run("ScriptExecutions", testScriptExecutionsDelete)
+	t.Run("SymbolHistories", testSymbolHistoriesDelete)
 	t.Run("Trades", testTradesDelete)
+	t.Run("TradeBars", testTradeBarsDelete)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosDelete)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsDelete)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesDelete)
@@ -42,12 +52,17 @@ func TestDelete(t *testing.T) {
 func TestQueryDeleteAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsQueryDeleteAll)
 	t.Run("Candles", testCandlesQueryDeleteAll)
+	t.Run("DataQualityFindings", testDataQualityFindingsQueryDeleteAll)
 	t.Run("Datahistoryjobs", testDatahistoryjobsQueryDeleteAll)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsQueryDeleteAll)
 	t.Run("Exchanges", testExchangesQueryDeleteAll)
+	t.Run("FundingRates", testFundingRatesQueryDeleteAll)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsQueryDeleteAll)
 	t.Run("Scripts", testScriptsQueryDeleteAll)
 	t.Run("ScriptExecutions", testScriptExecutionsQueryDeleteAll)
+	t.Run("SymbolHistories", testSymbolHistoriesQueryDeleteAll)
 	t.Run("Trades", testTradesQueryDeleteAll)
+	t.Run("TradeBars", testTradeBarsQueryDeleteAll)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosQueryDeleteAll)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsQueryDeleteAll)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesQueryDeleteAll)
@@ -56,12 +71,17 @@ func TestQueryDeleteAll(t *testing.T) {
 func TestSliceDeleteAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsSliceDeleteAll)
 	t.Run("Candles", testCandlesSliceDeleteAll)
+	t.Run("DataQualityFindings", testDataQualityFindingsSliceDeleteAll)
 	t.Run("Datahistoryjobs", testDatahistoryjobsSliceDeleteAll)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsSliceDeleteAll)
 	t.Run("Exchanges", testExchangesSliceDeleteAll)
+	t.Run("FundingRates", testFundingRatesSliceDeleteAll)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsSliceDeleteAll)
 	t.Run("Scripts", testScriptsSliceDeleteAll)
 	t.Run("ScriptExecutions", testScriptExecutionsSliceDeleteAll)
+	t.Run("SymbolHistories", testSymbolHistoriesSliceDeleteAll)
 	t.Run("Trades", testTradesSliceDeleteAll)
+	t.Run("TradeBars", testTradeBarsSliceDeleteAll)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosSliceDeleteAll)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsSliceDeleteAll)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesSliceDeleteAll)
@@ -70,12 +90,17 @@ func TestSliceDeleteAll(t *testing.T) {
 func TestExists(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsExists)
 	t.Run("Candles", testCandlesExists)
+	t.Run("DataQualityFindings", testDataQualityFindingsExists)
 	t.Run("Datahistoryjobs", testDatahistoryjobsExists)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsExists)
 	t.Run("Exchanges", testExchangesExists)
+	t.Run("FundingRates", testFundingRatesExists)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsExists)
 	t.Run("Scripts", testScriptsExists)
 	t.Run("ScriptExecutions", testScriptExecutionsExists)
+	t.Run("SymbolHistories", testSymbolHistoriesExists)
 	t.Run("Trades", testTradesExists)
+	t.Run("TradeBars", testTradeBarsExists)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosExists)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsExists)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesExists)
@@ -84,12 +109,17 @@ func TestExists(t *testing.T) {
 func TestFind(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsFind)
 	t.Run("Candles", testCandlesFind)
+	t.Run("DataQualityFindings", testDataQualityFindingsFind)
 	t.Run("Datahistoryjobs", testDatahistoryjobsFind)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsFind)
 	t.Run("Exchanges", testExchangesFind)
+	t.Run("FundingRates", testFundingRatesFind)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsFind)
 	t.Run("Scripts", testScriptsFind)
 	t.Run("ScriptExecutions", testScriptExecutionsFind)
+	t.Run("SymbolHistories", testSymbolHistoriesFind)
 	t.Run("Trades", testTradesFind)
+	t.Run("TradeBars", testTradeBarsFind)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosFind)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsFind)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesFind)
@@ -98,12 +128,17 @@ func TestFind(t *testing.T) {
 func TestBind(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsBind)
 	t.Run("Candles", testCandlesBind)
+	t.Run("DataQualityFindings", testDataQualityFindingsBind)
 	t.Run("Datahistoryjobs", testDatahistoryjobsBind)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsBind)
 	t.Run("Exchanges", testExchangesBind)
+	t.Run("FundingRates", testFundingRatesBind)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsBind)
 	t.Run("Scripts", testScriptsBind)
 	t.Run("ScriptExecutions", testScriptExecutionsBind)
+	t.Run("SymbolHistories", testSymbolHistoriesBind)
 	t.Run("Trades", testTradesBind)
+	t.Run("TradeBars", testTradeBarsBind)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosBind)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsBind)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesBind)
@@ -112,12 +147,17 @@ func TestBind(t *testing.T) {
 func TestOne(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsOne)
 	t.Run("Candles", testCandlesOne)
+	t.Run("DataQualityFindings", testDataQualityFindingsOne)
 	t.Run("Datahistoryjobs", testDatahistoryjobsOne)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsOne)
 	t.Run("Exchanges", testExchangesOne)
+	t.Run("FundingRates", testFundingRatesOne)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsOne)
 	t.Run("Scripts", testScriptsOne)
 	t.Run("ScriptExecutions", testScriptExecutionsOne)
+	t.Run("SymbolHistories", testSymbolHistoriesOne)
 	t.Run("Trades", testTradesOne)
+	t.Run("TradeBars", testTradeBarsOne)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosOne)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsOne)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesOne)
@@ -126,12 +166,17 @@ func TestOne(t *testing.T) {
 func TestAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsAll)
 	t.Run("Candles", testCandlesAll)
+	t.Run("DataQualityFindings", testDataQualityFindingsAll)
 	t.Run("Datahistoryjobs", testDatahistoryjobsAll)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsAll)
 	t.Run("Exchanges", testExchangesAll)
+	t.Run("FundingRates", testFundingRatesAll)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsAll)
 	t.Run("Scripts", testScriptsAll)
 	t.Run("ScriptExecutions", testScriptExecutionsAll)
+	t.Run("SymbolHistories", testSymbolHistoriesAll)
 	t.Run("Trades", testTradesAll)
+	t.Run("TradeBars", testTradeBarsAll)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosAll)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsAll)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesAll)
@@ -140,12 +185,17 @@ func TestAll(t *testing.T) {
 func TestCount(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsCount)
 	t.Run("Candles", testCandlesCount)
+	t.Run("DataQualityFindings", testDataQualityFindingsCount)
 	t.Run("Datahistoryjobs", testDatahistoryjobsCount)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsCount)
 	t.Run("Exchanges", testExchangesCount)
+	t.Run("FundingRates", testFundingRatesCount)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsCount)
 	t.Run("Scripts", testScriptsCount)
 	t.Run("ScriptExecutions", testScriptExecutionsCount)
+	t.Run("SymbolHistories", testSymbolHistoriesCount)
 	t.Run("Trades", testTradesCount)
+	t.Run("TradeBars", testTradeBarsCount)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosCount)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsCount)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesCount)
@@ -154,12 +204,17 @@ func TestCount(t *testing.T) {
 func TestHooks(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsHooks)
 	t.Run("Candles", testCandlesHooks)
+	t.Run("DataQualityFindings", testDataQualityFindingsHooks)
 	t.Run("Datahistoryjobs", testDatahistoryjobsHooks)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsHooks)
 	t.Run("Exchanges", testExchangesHooks)
+	t.Run("FundingRates", testFundingRatesHooks)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsHooks)
 	t.Run("Scripts", testScriptsHooks)
 	t.Run("ScriptExecutions", testScriptExecutionsHooks)
+	t.Run("SymbolHistories", testSymbolHistoriesHooks)
 	t.Run("Trades", testTradesHooks)
+	t.Run("TradeBars", testTradeBarsHooks)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosHooks)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsHooks)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesHooks)
@@ -170,18 +225,28 @@ func TestInsert(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsInsertWhitelist)
 	t.Run("Candles", testCandlesInsert)
 	t.Run("Candles", testCandlesInsertWhitelist)
+	t.Run("DataQualityFindings", testDataQualityFindingsInsert)
+	t.Run("DataQualityFindings", testDataQualityFindingsInsertWhitelist)
 	t.Run("Datahistoryjobs", testDatahistoryjobsInsert)
 	t.Run("Datahistoryjobs", testDatahistoryjobsInsertWhitelist)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsInsert)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsInsertWhitelist)
 	t.Run("Exchanges", testExchangesInsert)
 	t.Run("Exchanges", testExchangesInsertWhitelist)
+	t.Run("FundingRates", testFundingRatesInsert)
+	t.Run("FundingRates", testFundingRatesInsertWhitelist)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsInsert)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsInsertWhitelist)
 	t.Run("Scripts", testScriptsInsert)
 	t.Run("Scripts", testScriptsInsertWhitelist)
 	t.Run("ScriptExecutions", testScriptExecutionsInsert)
 	t.Run("ScriptExecutions", testScriptExecutionsInsertWhitelist)
+	t.Run("SymbolHistories", testSymbolHistoriesInsert)
+	t.Run("SymbolHistories", testSymbolHistoriesInsertWhitelist)
 	t.Run("Trades", testTradesInsert)
 	t.Run("Trades", testTradesInsertWhitelist)
+	t.Run("TradeBars", testTradeBarsInsert)
+	t.Run("TradeBars", testTradeBarsInsertWhitelist)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosInsert)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosInsertWhitelist)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsInsert)
@@ -196,11 +261,17 @@ func TestToOne(t *testing.T) {
 	t.Run("CandleToDatahistoryjobUsingValidationJob", testCandleToOneDatahistoryjobUsingValidationJob)
 	t.Run("CandleToDatahistoryjobUsingSourceJob", testCandleToOneDatahistoryjobUsingSourceJob)
 	t.Run("CandleToExchangeUsingExchangeName", testCandleToOneExchangeUsingExchangeName)
+	t.Run("DataQualityFindingToExchangeUsingExchangeName", testDataQualityFindingToOneExchangeUsingExchangeName)
 	t.Run("DatahistoryjobToExchangeUsingExchangeName", testDatahistoryjobToOneExchangeUsingExchangeName)
 	t.Run("DatahistoryjobToExchangeUsingSecondaryExchange", testDatahistoryjobToOneExchangeUsingSecondaryExchange)
 	t.Run("DatahistoryjobresultToDatahistoryjobUsingJob", testDatahistoryjobresultToOneDatahistoryjobUsingJob)
+	t.Run("FundingRateToExchangeUsingExchangeName", testFundingRateToOneExchangeUsingExchangeName)
+	t.Run("OrderbookSnapshotToExchangeUsingExchangeName", testOrderbookSnapshotToOneExchangeUsingExchangeName)
 	t.Run("ScriptExecutionToScriptUsingScript", testScriptExecutionToOneScriptUsingScript)
+	t.Run("SymbolHistoryToExchangeUsingExchangeName", testSymbolHistoryToOneExchangeUsingExchangeName)
 	t.Run("TradeToExchangeUsingExchangeName", testTradeToOneExchangeUsingExchangeName)
+	t.Run("TradeBarToDatahistoryjobUsingSourceJob", testTradeBarToOneDatahistoryjobUsingSourceJob)
+	t.Run("TradeBarToExchangeUsingExchangeName", testTradeBarToOneExchangeUsingExchangeName)
 	t.Run("WithdrawalCryptoToWithdrawalHistoryUsingWithdrawalHistory", testWithdrawalCryptoToOneWithdrawalHistoryUsingWithdrawalHistory)
 	t.Run("WithdrawalFiatToWithdrawalHistoryUsingWithdrawalHistory", testWithdrawalFiatToOneWithdrawalHistoryUsingWithdrawalHistory)
 	t.Run("WithdrawalHistoryToExchangeUsingExchangeName", testWithdrawalHistoryToOneExchangeUsingExchangeName)
@@ -210,6 +281,8 @@ func TestToOne(t *testing.T) {
 // or deadlocks can occur.
 func TestOneToOne(t *testing.T) {
 	t.Run("ExchangeToCandleUsingExchangeNameCandle", testExchangeOneToOneCandleUsingExchangeNameCandle)
+	t.Run("ExchangeToFundingRateUsingExchangeNameFundingRate", testExchangeOneToOneFundingRateUsingExchangeNameFundingRate)
+	t.Run("ExchangeToOrderbookSnapshotUsingExchangeNameOrderbookSnapshot", testExchangeOneToOneOrderbookSnapshotUsingExchangeNameOrderbookSnapshot)
 	t.Run("ExchangeToTradeUsingExchangeNameTrade", testExchangeOneToOneTradeUsingExchangeNameTrade)
 }
 
@@ -221,8 +294,12 @@ func TestToMany(t *testing.T) {
 	t.Run("DatahistoryjobToPrerequisiteJobDatahistoryjobs", testDatahistoryjobToManyPrerequisiteJobDatahistoryjobs)
 	t.Run("DatahistoryjobToJobDatahistoryjobs", testDatahistoryjobToManyJobDatahistoryjobs)
 	t.Run("DatahistoryjobToJobDatahistoryjobresults", testDatahistoryjobToManyJobDatahistoryjobresults)
+	t.Run("DatahistoryjobToSourceJobTradeBars", testDatahistoryjobToManySourceJobTradeBars)
+	t.Run("ExchangeToExchangeNameDataQualityFindings", testExchangeToManyExchangeNameDataQualityFindings)
 	t.Run("ExchangeToExchangeNameDatahistoryjobs", testExchangeToManyExchangeNameDatahistoryjobs)
 	t.Run("ExchangeToSecondaryExchangeDatahistoryjobs", testExchangeToManySecondaryExchangeDatahistoryjobs)
+	t.Run("ExchangeToExchangeNameSymbolHistories", testExchangeToManyExchangeNameSymbolHistories)
+	t.Run("ExchangeToExchangeNameTradeBars", testExchangeToManyExchangeNameTradeBars)
 	t.Run("ExchangeToExchangeNameWithdrawalHistories", testExchangeToManyExchangeNameWithdrawalHistories)
 	t.Run("ScriptToScriptExecutions", testScriptToManyScriptExecutions)
 	t.Run("WithdrawalHistoryToWithdrawalCryptos", testWithdrawalHistoryToManyWithdrawalCryptos)
@@ -235,11 +312,17 @@ func TestToOneSet(t *testing.T) {
 	t.Run("CandleToDatahistoryjobUsingValidationJobCandles", testCandleToOneSetOpDatahistoryjobUsingValidationJob)
 	t.Run("CandleToDatahistoryjobUsingSourceJobCandles", testCandleToOneSetOpDatahistoryjobUsingSourceJob)
 	t.Run("CandleToExchangeUsingExchangeNameCandle", testCandleToOneSetOpExchangeUsingExchangeName)
+	t.Run("DataQualityFindingToExchangeUsingExchangeNameDataQualityFindings", testDataQualityFindingToOneSetOpExchangeUsingExchangeName)
 	t.Run("DatahistoryjobToExchangeUsingExchangeNameDatahistoryjobs", testDatahistoryjobToOneSetOpExchangeUsingExchangeName)
 	t.Run("DatahistoryjobToExchangeUsingSecondaryExchangeDatahistoryjobs", testDatahistoryjobToOneSetOpExchangeUsingSecondaryExchange)
 	t.Run("DatahistoryjobresultToDatahistoryjobUsingJobDatahistoryjobresults", testDatahistoryjobresultToOneSetOpDatahistoryjobUsingJob)
+	t.Run("FundingRateToExchangeUsingExchangeNameFundingRate", testFundingRateToOneSetOpExchangeUsingExchangeName)
+	t.Run("OrderbookSnapshotToExchangeUsingExchangeNameOrderbookSnapshot", testOrderbookSnapshotToOneSetOpExchangeUsingExchangeName)
 	t.Run("ScriptExecutionToScriptUsingScriptExecutions", testScriptExecutionToOneSetOpScriptUsingScript)
+	t.Run("SymbolHistoryToExchangeUsingExchangeNameSymbolHistories", testSymbolHistoryToOneSetOpExchangeUsingExchangeName)
 	t.Run("TradeToExchangeUsingExchangeNameTrade", testTradeToOneSetOpExchangeUsingExchangeName)
+	t.Run("TradeBarToDatahistoryjobUsingSourceJobTradeBars", testTradeBarToOneSetOpDatahistoryjobUsingSourceJob)
+	t.Run("TradeBarToExchangeUsingExchangeNameTradeBars", testTradeBarToOneSetOpExchangeUsingExchangeName)
 	t.Run("WithdrawalCryptoToWithdrawalHistoryUsingWithdrawalCryptos", testWithdrawalCryptoToOneSetOpWithdrawalHistoryUsingWithdrawalHistory)
 	t.Run("WithdrawalFiatToWithdrawalHistoryUsingWithdrawalFiats", testWithdrawalFiatToOneSetOpWithdrawalHistoryUsingWithdrawalHistory)
 	t.Run("WithdrawalHistoryToExchangeUsingExchangeNameWithdrawalHistories", testWithdrawalHistoryToOneSetOpExchangeUsingExchangeName)
@@ -251,12 +334,15 @@ func TestToOneRemove(t *testing.T) {
 	t.Run("CandleToDatahistoryjobUsingValidationJobCandles", testCandleToOneRemoveOpDatahistoryjobUsingValidationJob)
 	t.Run("CandleToDatahistoryjobUsingSourceJobCandles", testCandleToOneRemoveOpDatahistoryjobUsingSourceJob)
 	t.Run("DatahistoryjobToExchangeUsingSecondaryExchangeDatahistoryjobs", testDatahistoryjobToOneRemoveOpExchangeUsingSecondaryExchange)
+	t.Run("TradeBarToDatahistoryjobUsingSourceJobTradeBars", testTradeBarToOneRemoveOpDatahistoryjobUsingSourceJob)
 }
 
 // TestOneToOneSet tests cannot be run in parallel
 // or deadlocks can occur.
 func TestOneToOneSet(t *testing.T) {
 	t.Run("ExchangeToCandleUsingExchangeNameCandle", testExchangeOneToOneSetOpCandleUsingExchangeNameCandle)
+	t.Run("ExchangeToFundingRateUsingExchangeNameFundingRate", testExchangeOneToOneSetOpFundingRateUsingExchangeNameFundingRate)
+	t.Run("ExchangeToOrderbookSnapshotUsingExchangeNameOrderbookSnapshot", testExchangeOneToOneSetOpOrderbookSnapshotUsingExchangeNameOrderbookSnapshot)
 	t.Run("ExchangeToTradeUsingExchangeNameTrade", testExchangeOneToOneSetOpTradeUsingExchangeNameTrade)
 }
 
@@ -272,8 +358,12 @@ func TestToManyAdd(t *testing.T) {
 	t.Run("DatahistoryjobToPrerequisiteJobDatahistoryjobs", testDatahistoryjobToManyAddOpPrerequisiteJobDatahistoryjobs)
 	t.Run("DatahistoryjobToJobDatahistoryjobs", testDatahistoryjobToManyAddOpJobDatahistoryjobs)
 	t.Run("DatahistoryjobToJobDatahistoryjobresults", testDatahistoryjobToManyAddOpJobDatahistoryjobresults)
+	t.Run("DatahistoryjobToSourceJobTradeBars", testDatahistoryjobToManyAddOpSourceJobTradeBars)
+	t.Run("ExchangeToExchangeNameDataQualityFindings", testExchangeToManyAddOpExchangeNameDataQualityFindings)
 	t.Run("ExchangeToExchangeNameDatahistoryjobs", testExchangeToManyAddOpExchangeNameDatahistoryjobs)
 	t.Run("ExchangeToSecondaryExchangeDatahistoryjobs", testExchangeToManyAddOpSecondaryExchangeDatahistoryjobs)
+	t.Run("ExchangeToExchangeNameSymbolHistories", testExchangeToManyAddOpExchangeNameSymbolHistories)
+	t.Run("ExchangeToExchangeNameTradeBars", testExchangeToManyAddOpExchangeNameTradeBars)
 	t.Run("ExchangeToExchangeNameWithdrawalHistories", testExchangeToManyAddOpExchangeNameWithdrawalHistories)
 	t.Run("ScriptToScriptExecutions", testScriptToManyAddOpScriptExecutions)
 	t.Run("WithdrawalHistoryToWithdrawalCryptos", testWithdrawalHistoryToManyAddOpWithdrawalCryptos)
@@ -287,6 +377,7 @@ func TestToManySet(t *testing.T) {
 	t.Run("DatahistoryjobToSourceJobCandles", testDatahistoryjobToManySetOpSourceJobCandles)
 	t.Run("DatahistoryjobToPrerequisiteJobDatahistoryjobs", testDatahistoryjobToManySetOpPrerequisiteJobDatahistoryjobs)
 	t.Run("DatahistoryjobToJobDatahistoryjobs", testDatahistoryjobToManySetOpJobDatahistoryjobs)
+	t.Run("DatahistoryjobToSourceJobTradeBars", testDatahistoryjobToManySetOpSourceJobTradeBars)
 	t.Run("ExchangeToSecondaryExchangeDatahistoryjobs", testExchangeToManySetOpSecondaryExchangeDatahistoryjobs)
 }
 
@@ -297,18 +388,24 @@ func TestToManyRemove(t *testing.T) {
 	t.Run("DatahistoryjobToSourceJobCandles", testDatahistoryjobToManyRemoveOpSourceJobCandles)
 	t.Run("DatahistoryjobToPrerequisiteJobDatahistoryjobs", testDatahistoryjobToManyRemoveOpPrerequisiteJobDatahistoryjobs)
 	t.Run("DatahistoryjobToJobDatahistoryjobs", testDatahistoryjobToManyRemoveOpJobDatahistoryjobs)
+	t.Run("DatahistoryjobToSourceJobTradeBars", testDatahistoryjobToManyRemoveOpSourceJobTradeBars)
 	t.Run("ExchangeToSecondaryExchangeDatahistoryjobs", testExchangeToManyRemoveOpSecondaryExchangeDatahistoryjobs)
 }
 
 func TestReload(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsReload)
 	t.Run("Candles", testCandlesReload)
+	t.Run("DataQualityFindings", testDataQualityFindingsReload)
 	t.Run("Datahistoryjobs", testDatahistoryjobsReload)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsReload)
 	t.Run("Exchanges", testExchangesReload)
+	t.Run("FundingRates", testFundingRatesReload)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsReload)
 	t.Run("Scripts", testScriptsReload)
 	t.Run("ScriptExecutions", testScriptExecutionsReload)
+	t.Run("SymbolHistories", testSymbolHistoriesReload)
 	t.Run("Trades", testTradesReload)
+	t.Run("TradeBars", testTradeBarsReload)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosReload)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsReload)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesReload)
@@ -317,12 +414,17 @@ func TestReload(t *testing.T) {
 func TestReloadAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsReloadAll)
 	t.Run("Candles", testCandlesReloadAll)
+	t.Run("DataQualityFindings", testDataQualityFindingsReloadAll)
 	t.Run("Datahistoryjobs", testDatahistoryjobsReloadAll)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsReloadAll)
 	t.Run("Exchanges", testExchangesReloadAll)
+	t.Run("FundingRates", testFundingRatesReloadAll)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsReloadAll)
 	t.Run("Scripts", testScriptsReloadAll)
 	t.Run("ScriptExecutions", testScriptExecutionsReloadAll)
+	t.Run("SymbolHistories", testSymbolHistoriesReloadAll)
 	t.Run("Trades", testTradesReloadAll)
+	t.Run("TradeBars", testTradeBarsReloadAll)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosReloadAll)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsReloadAll)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesReloadAll)
@@ -331,12 +433,17 @@ func TestReloadAll(t *testing.T) {
 func TestSelect(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsSelect)
 	t.Run("Candles", testCandlesSelect)
+	t.Run("DataQualityFindings", testDataQualityFindingsSelect)
 	t.Run("Datahistoryjobs", testDatahistoryjobsSelect)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsSelect)
 	t.Run("Exchanges", testExchangesSelect)
+	t.Run("FundingRates", testFundingRatesSelect)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsSelect)
 	t.Run("Scripts", testScriptsSelect)
 	t.Run("ScriptExecutions", testScriptExecutionsSelect)
+	t.Run("SymbolHistories", testSymbolHistoriesSelect)
 	t.Run("Trades", testTradesSelect)
+	t.Run("TradeBars", testTradeBarsSelect)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosSelect)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsSelect)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesSelect)
@@ -345,12 +452,17 @@ func TestSelect(t *testing.T) {
 func TestUpdate(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsUpdate)
 	t.Run("Candles", testCandlesUpdate)
+	t.Run("DataQualityFindings", testDataQualityFindingsUpdate)
 	t.Run("Datahistoryjobs", testDatahistoryjobsUpdate)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsUpdate)
 	t.Run("Exchanges", testExchangesUpdate)
+	t.Run("FundingRates", testFundingRatesUpdate)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsUpdate)
 	t.Run("Scripts", testScriptsUpdate)
 	t.Run("ScriptExecutions", testScriptExecutionsUpdate)
+	t.Run("SymbolHistories", testSymbolHistoriesUpdate)
 	t.Run("Trades", testTradesUpdate)
+	t.Run("TradeBars", testTradeBarsUpdate)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosUpdate)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsUpdate)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesUpdate)
@@ -359,12 +471,17 @@ func TestUpdate(t *testing.T) {
 func TestSliceUpdateAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsSliceUpdateAll)
 	t.Run("Candles", testCandlesSliceUpdateAll)
+	t.Run("DataQualityFindings", testDataQualityFindingsSliceUpdateAll)
 	t.Run("Datahistoryjobs", testDatahistoryjobsSliceUpdateAll)
 	t.Run("Datahistoryjobresults", testDatahistoryjobresultsSliceUpdateAll)
 	t.Run("Exchanges", testExchangesSliceUpdateAll)
+	t.Run("FundingRates", testFundingRatesSliceUpdateAll)
+	t.Run("OrderbookSnapshots", testOrderbookSnapshotsSliceUpdateAll)
 	t.Run("Scripts", testScriptsSliceUpdateAll)
 	t.Run("ScriptExecutions", testScriptExecutionsSliceUpdateAll)
+	t.Run("SymbolHistories", testSymbolHistoriesSliceUpdateAll)
 	t.Run("Trades", testTradesSliceUpdateAll)
+	t.Run("TradeBars", testTradeBarsSliceUpdateAll)
 	t.Run("WithdrawalCryptos", testWithdrawalCryptosSliceUpdateAll)
 	t.Run("WithdrawalFiats", testWithdrawalFiatsSliceUpdateAll)
 	t.Run("WithdrawalHistories", testWithdrawalHistoriesSliceUpdateAll)
@@ -6,26 +6,36 @@ package sqlite3
 var TableNames = struct {
 	AuditEvent              string
 	Candle                  string
+	DataQualityFinding      string
 	Datahistoryjob          string
 	Datahistoryjobrelations string
 	Datahistoryjobresult    string
 	Exchange                string
+	FundingRate             string
+	OrderbookSnapshot       string
 	Script                  string
 	ScriptExecution         string
+	SymbolHistory           string
 	Trade                   string
+	TradeBar                string
 	WithdrawalCrypto        string
 	WithdrawalFiat          string
 	WithdrawalHistory       string
 }{
 	AuditEvent:              "audit_event",
 	Candle:                  "candle",
+	DataQualityFinding:      "data_quality_finding",
 	Datahistoryjob:          "datahistoryjob",
 	Datahistoryjobrelations: "datahistoryjobrelations",
 	Datahistoryjobresult:    "datahistoryjobresult",
 	Exchange:                "exchange",
+	FundingRate:             "funding_rate",
+	OrderbookSnapshot:       "orderbook_snapshot",
 	Script:                  "script",
 	ScriptExecution:         "script_execution",
+	SymbolHistory:           "symbol_history",
 	Trade:                   "trade",
+	TradeBar:                "trade_bar",
 	WithdrawalCrypto:        "withdrawal_crypto",
 	WithdrawalFiat:          "withdrawal_fiat",
 	WithdrawalHistory:       "withdrawal_history",
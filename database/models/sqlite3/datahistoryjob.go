@@ -44,6 +44,13 @@ type Datahistoryjob struct {
 	SecondaryExchangeID      null.String  `boil:"secondary_exchange_id" json:"secondary_exchange_id,omitempty" toml:"secondary_exchange_id" yaml:"secondary_exchange_id,omitempty"`
 	IssueTolerancePercentage null.Float64 `boil:"issue_tolerance_percentage" json:"issue_tolerance_percentage,omitempty" toml:"issue_tolerance_percentage" yaml:"issue_tolerance_percentage,omitempty"`
 	ReplaceOnIssue           null.Int64   `boil:"replace_on_issue" json:"replace_on_issue,omitempty" toml:"replace_on_issue" yaml:"replace_on_issue,omitempty"`
+	RecurrenceInterval       null.Float64 `boil:"recurrence_interval" json:"recurrence_interval,omitempty" toml:"recurrence_interval" yaml:"recurrence_interval,omitempty"`
+	SettleDelay              null.Float64 `boil:"settle_delay" json:"settle_delay,omitempty" toml:"settle_delay" yaml:"settle_delay,omitempty"`
+	RollingWindow            null.Float64 `boil:"rolling_window" json:"rolling_window,omitempty" toml:"rolling_window" yaml:"rolling_window,omitempty"`
+	BackfillToInception      null.Int64   `boil:"backfill_to_inception" json:"backfill_to_inception,omitempty" toml:"backfill_to_inception" yaml:"backfill_to_inception,omitempty"`
+	NextRunTime              null.String  `boil:"next_run_time" json:"next_run_time,omitempty" toml:"next_run_time" yaml:"next_run_time,omitempty"`
+	BarType                  null.String  `boil:"bar_type" json:"bar_type,omitempty" toml:"bar_type" yaml:"bar_type,omitempty"`
+	BarThreshold             null.Float64 `boil:"bar_threshold" json:"bar_threshold,omitempty" toml:"bar_threshold" yaml:"bar_threshold,omitempty"`
 
 	R *datahistoryjobR `boil:"-" json:"-" toml:"-" yaml:"-"`
 	L datahistoryjobL  `boil:"-" json:"-" toml:"-" yaml:"-"`
@@ -71,6 +78,13 @@ var DatahistoryjobColumns = struct {
 	SecondaryExchangeID      string
 	IssueTolerancePercentage string
 	ReplaceOnIssue           string
+	RecurrenceInterval       string
+	SettleDelay              string
+	RollingWindow            string
+	BackfillToInception      string
+	NextRunTime              string
+	BarType                  string
+	BarThreshold             string
 }{
 	ID:                       "id",
 	Nickname:                 "nickname",
@@ -93,6 +107,13 @@ var DatahistoryjobColumns = struct {
 	SecondaryExchangeID:      "secondary_exchange_id",
 	IssueTolerancePercentage: "issue_tolerance_percentage",
 	ReplaceOnIssue:           "replace_on_issue",
+	RecurrenceInterval:       "recurrence_interval",
+	SettleDelay:              "settle_delay",
+	RollingWindow:            "rolling_window",
+	BackfillToInception:      "backfill_to_inception",
+	NextRunTime:              "next_run_time",
+	BarType:                  "bar_type",
+	BarThreshold:             "bar_threshold",
 }
 
 // Generated where
@@ -165,6 +186,13 @@ var DatahistoryjobWhere = struct {
 	SecondaryExchangeID      whereHelpernull_String
 	IssueTolerancePercentage whereHelpernull_Float64
 	ReplaceOnIssue           whereHelpernull_Int64
+	RecurrenceInterval       whereHelpernull_Float64
+	SettleDelay              whereHelpernull_Float64
+	RollingWindow            whereHelpernull_Float64
+	BackfillToInception      whereHelpernull_Int64
+	NextRunTime              whereHelpernull_String
+	BarType                  whereHelpernull_String
+	BarThreshold             whereHelpernull_Float64
 }{
 	ID:                       whereHelperstring{field: "\"datahistoryjob\".\"id\""},
 	Nickname:                 whereHelperstring{field: "\"datahistoryjob\".\"nickname\""},
@@ -187,6 +215,13 @@ var DatahistoryjobWhere = struct {
 	SecondaryExchangeID:      whereHelpernull_String{field: "\"datahistoryjob\".\"secondary_exchange_id\""},
 	IssueTolerancePercentage: whereHelpernull_Float64{field: "\"datahistoryjob\".\"issue_tolerance_percentage\""},
 	ReplaceOnIssue:           whereHelpernull_Int64{field: "\"datahistoryjob\".\"replace_on_issue\""},
+	RecurrenceInterval:       whereHelpernull_Float64{field: "\"datahistoryjob\".\"recurrence_interval\""},
+	SettleDelay:              whereHelpernull_Float64{field: "\"datahistoryjob\".\"settle_delay\""},
+	RollingWindow:            whereHelpernull_Float64{field: "\"datahistoryjob\".\"rolling_window\""},
+	BackfillToInception:      whereHelpernull_Int64{field: "\"datahistoryjob\".\"backfill_to_inception\""},
+	NextRunTime:              whereHelpernull_String{field: "\"datahistoryjob\".\"next_run_time\""},
+	BarType:                  whereHelpernull_String{field: "\"datahistoryjob\".\"bar_type\""},
+	BarThreshold:             whereHelpernull_Float64{field: "\"datahistoryjob\".\"bar_threshold\""},
 }
 
 // DatahistoryjobRels is where relationship names are stored.
@@ -198,6 +233,7 @@ var DatahistoryjobRels = struct {
 	PrerequisiteJobDatahistoryjobs string
 	JobDatahistoryjobs             string
 	JobDatahistoryjobresults       string
+	SourceJobTradeBars             string
 }{
 	ExchangeName:                   "ExchangeName",
 	SecondaryExchange:              "SecondaryExchange",
@@ -206,6 +242,7 @@ var DatahistoryjobRels = struct {
 	PrerequisiteJobDatahistoryjobs: "PrerequisiteJobDatahistoryjobs",
 	JobDatahistoryjobs:             "JobDatahistoryjobs",
 	JobDatahistoryjobresults:       "JobDatahistoryjobresults",
+	SourceJobTradeBars:             "SourceJobTradeBars",
 }
 
 // datahistoryjobR is where relationships are stored.
@@ -217,6 +254,7 @@ type datahistoryjobR struct {
 	PrerequisiteJobDatahistoryjobs DatahistoryjobSlice
 	JobDatahistoryjobs             DatahistoryjobSlice
 	JobDatahistoryjobresults       DatahistoryjobresultSlice
+	SourceJobTradeBars             TradeBarSlice
 }
 
 // NewStruct creates a new relationship struct
@@ -228,8 +266,8 @@ func (*datahistoryjobR) NewStruct() *datahistoryjobR {
 type datahistoryjobL struct{}
 
 var (
-	datahistoryjobAllColumns            = []string{"id", "nickname", "exchange_name_id", "asset", "base", "quote", "start_time", "end_time", "interval", "data_type", "request_size", "max_retries", "batch_count", "status", "created", "conversion_interval", "overwrite_data", "decimal_place_comparison", "secondary_exchange_id", "issue_tolerance_percentage", "replace_on_issue"}
-	datahistoryjobColumnsWithoutDefault = []string{"id", "nickname", "exchange_name_id", "asset", "base", "quote", "start_time", "end_time", "interval", "data_type", "request_size", "max_retries", "batch_count", "status", "conversion_interval", "overwrite_data", "decimal_place_comparison", "secondary_exchange_id", "issue_tolerance_percentage", "replace_on_issue"}
+	datahistoryjobAllColumns            = []string{"id", "nickname", "exchange_name_id", "asset", "base", "quote", "start_time", "end_time", "interval", "data_type", "request_size", "max_retries", "batch_count", "status", "created", "conversion_interval", "overwrite_data", "decimal_place_comparison", "secondary_exchange_id", "issue_tolerance_percentage", "replace_on_issue", "recurrence_interval", "settle_delay", "rolling_window", "backfill_to_inception", "next_run_time", "bar_type", "bar_threshold"}
+	datahistoryjobColumnsWithoutDefault = []string{"id", "nickname", "exchange_name_id", "asset", "base", "quote", "start_time", "end_time", "interval", "data_type", "request_size", "max_retries", "batch_count", "status", "conversion_interval", "overwrite_data", "decimal_place_comparison", "secondary_exchange_id", "issue_tolerance_percentage", "replace_on_issue", "recurrence_interval", "settle_delay", "rolling_window", "backfill_to_inception", "next_run_time", "bar_type", "bar_threshold"}
 	datahistoryjobColumnsWithDefault    = []string{"created"}
 	datahistoryjobPrimaryKeyColumns     = []string{"id"}
 )
@@ -644,6 +682,27 @@ func (o *Datahistoryjob) JobDatahistoryjobresults(mods ...qm.QueryMod) datahisto
 	return query
 }
 
+// SourceJobTradeBars retrieves all the trade_bar's TradeBars with an executor via source_job_id column.
+func (o *Datahistoryjob) SourceJobTradeBars(mods ...qm.QueryMod) tradeBarQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"trade_bar\".\"source_job_id\"=?", o.ID),
+	)
+
+	query := TradeBars(queryMods...)
+	queries.SetFrom(query.Query, "\"trade_bar\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"trade_bar\".*"})
+	}
+
+	return query
+}
+
 // LoadExchangeName allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for an N-1 relationship.
 func (datahistoryjobL) LoadExchangeName(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDatahistoryjob interface{}, mods queries.Applicator) error {
@@ -1101,7 +1160,7 @@ func (datahistoryjobL) LoadPrerequisiteJobDatahistoryjobs(ctx context.Context, e
 		one := new(Datahistoryjob)
 		var localJoinCol string
 
-		err = results.Scan(&one.ID, &one.Nickname, &one.ExchangeNameID, &one.Asset, &one.Base, &one.Quote, &one.StartTime, &one.EndTime, &one.Interval, &one.DataType, &one.RequestSize, &one.MaxRetries, &one.BatchCount, &one.Status, &one.Created, &one.ConversionInterval, &one.OverwriteData, &one.DecimalPlaceComparison, &one.SecondaryExchangeID, &one.IssueTolerancePercentage, &one.ReplaceOnIssue, &localJoinCol)
+		err = results.Scan(&one.ID, &one.Nickname, &one.ExchangeNameID, &one.Asset, &one.Base, &one.Quote, &one.StartTime, &one.EndTime, &one.Interval, &one.DataType, &one.RequestSize, &one.MaxRetries, &one.BatchCount, &one.Status, &one.Created, &one.ConversionInterval, &one.OverwriteData, &one.DecimalPlaceComparison, &one.SecondaryExchangeID, &one.IssueTolerancePercentage, &one.ReplaceOnIssue, &one.RecurrenceInterval, &one.SettleDelay, &one.RollingWindow, &one.BackfillToInception, &one.NextRunTime, &one.BarType, &one.BarThreshold, &localJoinCol)
 		if err != nil {
 			return errors.Wrap(err, "failed to scan eager loaded results for datahistoryjob")
 		}
@@ -1216,7 +1275,7 @@ func (datahistoryjobL) LoadJobDatahistoryjobs(ctx context.Context, e boil.Contex
 		one := new(Datahistoryjob)
 		var localJoinCol string
 
-		err = results.Scan(&one.ID, &one.Nickname, &one.ExchangeNameID, &one.Asset, &one.Base, &one.Quote, &one.StartTime, &one.EndTime, &one.Interval, &one.DataType, &one.RequestSize, &one.MaxRetries, &one.BatchCount, &one.Status, &one.Created, &one.ConversionInterval, &one.OverwriteData, &one.DecimalPlaceComparison, &one.SecondaryExchangeID, &one.IssueTolerancePercentage, &one.ReplaceOnIssue, &localJoinCol)
+		err = results.Scan(&one.ID, &one.Nickname, &one.ExchangeNameID, &one.Asset, &one.Base, &one.Quote, &one.StartTime, &one.EndTime, &one.Interval, &one.DataType, &one.RequestSize, &one.MaxRetries, &one.BatchCount, &one.Status, &one.Created, &one.ConversionInterval, &one.OverwriteData, &one.DecimalPlaceComparison, &one.SecondaryExchangeID, &one.IssueTolerancePercentage, &one.ReplaceOnIssue, &one.RecurrenceInterval, &one.SettleDelay, &one.RollingWindow, &one.BackfillToInception, &one.NextRunTime, &one.BarType, &one.BarThreshold, &localJoinCol)
 		if err != nil {
 			return errors.Wrap(err, "failed to scan eager loaded results for datahistoryjob")
 		}
@@ -1365,6 +1424,101 @@ func (datahistoryjobL) LoadJobDatahistoryjobresults(ctx context.Context, e boil.
 	return nil
 }
 
+// LoadSourceJobTradeBars allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (datahistoryjobL) LoadSourceJobTradeBars(ctx context.Context, e boil.ContextExecutor, singular bool, maybeDatahistoryjob interface{}, mods queries.Applicator) error {
+	var slice []*Datahistoryjob
+	var object *Datahistoryjob
+
+	if singular {
+		object = maybeDatahistoryjob.(*Datahistoryjob)
+	} else {
+		slice = *maybeDatahistoryjob.(*[]*Datahistoryjob)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &datahistoryjobR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &datahistoryjobR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`trade_bar`), qm.WhereIn(`trade_bar.source_job_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load trade_bar")
+	}
+
+	var resultSlice []*TradeBar
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice trade_bar")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on trade_bar")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for trade_bar")
+	}
+
+	if len(tradeBarAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.SourceJobTradeBars = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &tradeBarR{}
+			}
+			foreign.R.SourceJob = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.SourceJobID) {
+				local.R.SourceJobTradeBars = append(local.R.SourceJobTradeBars, foreign)
+				if foreign.R == nil {
+					foreign.R = &tradeBarR{}
+				}
+				foreign.R.SourceJob = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // SetExchangeName of the datahistoryjob to the related item.
 // Sets o.R.ExchangeName to related.
 // Adds o to related.R.ExchangeNameDatahistoryjobs.
@@ -2069,6 +2223,129 @@ func (o *Datahistoryjob) AddJobDatahistoryjobresults(ctx context.Context, exec b
 	return nil
 }
 
+// AddSourceJobTradeBars adds the given related objects to the existing relationships
+// of the datahistoryjob, optionally inserting them as new records.
+// Appends related to o.R.SourceJobTradeBars.
+// Sets related.R.SourceJob appropriately.
+func (o *Datahistoryjob) AddSourceJobTradeBars(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TradeBar) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			queries.Assign(&rel.SourceJobID, o.ID)
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"trade_bar\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"source_job_id"}),
+				strmangle.WhereClause("\"", "\"", 0, tradeBarPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			queries.Assign(&rel.SourceJobID, o.ID)
+		}
+	}
+
+	if o.R == nil {
+		o.R = &datahistoryjobR{
+			SourceJobTradeBars: related,
+		}
+	} else {
+		o.R.SourceJobTradeBars = append(o.R.SourceJobTradeBars, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &tradeBarR{
+				SourceJob: o,
+			}
+		} else {
+			rel.R.SourceJob = o
+		}
+	}
+	return nil
+}
+
+// SetSourceJobTradeBars removes all previously related items of the
+// datahistoryjob replacing them completely with the passed
+// in related items, optionally inserting them as new records.
+// Sets o.R.SourceJob's SourceJobTradeBars accordingly.
+// Replaces o.R.SourceJobTradeBars with related.
+// Sets related.R.SourceJob's SourceJobTradeBars accordingly.
+func (o *Datahistoryjob) SetSourceJobTradeBars(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TradeBar) error {
+	query := "update \"trade_bar\" set \"source_job_id\" = null where \"source_job_id\" = ?"
+	values := []interface{}{o.ID}
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	_, err := exec.ExecContext(ctx, query, values...)
+	if err != nil {
+		return errors.Wrap(err, "failed to remove relationships before set")
+	}
+
+	if o.R != nil {
+		for _, rel := range o.R.SourceJobTradeBars {
+			queries.SetScanner(&rel.SourceJobID, nil)
+			if rel.R == nil {
+				continue
+			}
+
+			rel.R.SourceJob = nil
+		}
+
+		o.R.SourceJobTradeBars = nil
+	}
+	return o.AddSourceJobTradeBars(ctx, exec, insert, related...)
+}
+
+// RemoveSourceJobTradeBars relationships from objects passed in.
+// Removes related items from R.SourceJobTradeBars (uses pointer comparison, removal does not keep order)
+// Sets related.R.SourceJob.
+func (o *Datahistoryjob) RemoveSourceJobTradeBars(ctx context.Context, exec boil.ContextExecutor, related ...*TradeBar) error {
+	var err error
+	for _, rel := range related {
+		queries.SetScanner(&rel.SourceJobID, nil)
+		if rel.R != nil {
+			rel.R.SourceJob = nil
+		}
+		if _, err = rel.Update(ctx, exec, boil.Whitelist("source_job_id")); err != nil {
+			return err
+		}
+	}
+	if o.R == nil {
+		return nil
+	}
+
+	for _, rel := range related {
+		for i, ri := range o.R.SourceJobTradeBars {
+			if rel != ri {
+				continue
+			}
+
+			ln := len(o.R.SourceJobTradeBars)
+			if ln > 1 && i < ln-1 {
+				o.R.SourceJobTradeBars[i] = o.R.SourceJobTradeBars[ln-1]
+			}
+			o.R.SourceJobTradeBars = o.R.SourceJobTradeBars[:ln-1]
+			break
+		}
+	}
+
+	return nil
+}
+
 // Datahistoryjobs retrieves all the records using an executor.
 func Datahistoryjobs(mods ...qm.QueryMod) datahistoryjobQuery {
 	mods = append(mods, qm.From("\"datahistoryjob\""))
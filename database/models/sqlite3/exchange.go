@@ -50,24 +50,39 @@ var ExchangeWhere = struct {
 // ExchangeRels is where relationship names are stored.
 var ExchangeRels = struct {
 	ExchangeNameCandle               string
+	ExchangeNameFundingRate          string
+	ExchangeNameOrderbookSnapshot    string
 	ExchangeNameTrade                string
+	ExchangeNameDataQualityFindings  string
 	ExchangeNameDatahistoryjobs      string
 	SecondaryExchangeDatahistoryjobs string
+	ExchangeNameSymbolHistories      string
+	ExchangeNameTradeBars            string
 	ExchangeNameWithdrawalHistories  string
 }{
 	ExchangeNameCandle:               "ExchangeNameCandle",
+	ExchangeNameFundingRate:          "ExchangeNameFundingRate",
+	ExchangeNameOrderbookSnapshot:    "ExchangeNameOrderbookSnapshot",
 	ExchangeNameTrade:                "ExchangeNameTrade",
+	ExchangeNameDataQualityFindings:  "ExchangeNameDataQualityFindings",
 	ExchangeNameDatahistoryjobs:      "ExchangeNameDatahistoryjobs",
 	SecondaryExchangeDatahistoryjobs: "SecondaryExchangeDatahistoryjobs",
+	ExchangeNameSymbolHistories:      "ExchangeNameSymbolHistories",
+	ExchangeNameTradeBars:            "ExchangeNameTradeBars",
 	ExchangeNameWithdrawalHistories:  "ExchangeNameWithdrawalHistories",
 }
 
 // exchangeR is where relationships are stored.
 type exchangeR struct {
 	ExchangeNameCandle               *Candle
+	ExchangeNameFundingRate          *FundingRate
+	ExchangeNameOrderbookSnapshot    *OrderbookSnapshot
 	ExchangeNameTrade                *Trade
+	ExchangeNameDataQualityFindings  DataQualityFindingSlice
 	ExchangeNameDatahistoryjobs      DatahistoryjobSlice
 	SecondaryExchangeDatahistoryjobs DatahistoryjobSlice
+	ExchangeNameSymbolHistories      SymbolHistorySlice
+	ExchangeNameTradeBars            TradeBarSlice
 	ExchangeNameWithdrawalHistories  WithdrawalHistorySlice
 }
 
@@ -375,6 +390,34 @@ func (o *Exchange) ExchangeNameCandle(mods ...qm.QueryMod) candleQuery {
 	return query
 }
 
+// ExchangeNameFundingRate pointed to by the foreign key.
+func (o *Exchange) ExchangeNameFundingRate(mods ...qm.QueryMod) fundingRateQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"exchange_name_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := FundingRates(queryMods...)
+	queries.SetFrom(query.Query, "\"funding_rate\"")
+
+	return query
+}
+
+// ExchangeNameOrderbookSnapshot pointed to by the foreign key.
+func (o *Exchange) ExchangeNameOrderbookSnapshot(mods ...qm.QueryMod) orderbookSnapshotQuery {
+	queryMods := []qm.QueryMod{
+		qm.Where("\"exchange_name_id\" = ?", o.ID),
+	}
+
+	queryMods = append(queryMods, mods...)
+
+	query := OrderbookSnapshots(queryMods...)
+	queries.SetFrom(query.Query, "\"orderbook_snapshot\"")
+
+	return query
+}
+
 // ExchangeNameTrade pointed to by the foreign key.
 func (o *Exchange) ExchangeNameTrade(mods ...qm.QueryMod) tradeQuery {
 	queryMods := []qm.QueryMod{
@@ -389,6 +432,27 @@ func (o *Exchange) ExchangeNameTrade(mods ...qm.QueryMod) tradeQuery {
 	return query
 }
 
+// ExchangeNameDataQualityFindings retrieves all the data_quality_finding's DataQualityFindings with an executor via exchange_name_id column.
+func (o *Exchange) ExchangeNameDataQualityFindings(mods ...qm.QueryMod) dataQualityFindingQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"data_quality_finding\".\"exchange_name_id\"=?", o.ID),
+	)
+
+	query := DataQualityFindings(queryMods...)
+	queries.SetFrom(query.Query, "\"data_quality_finding\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"data_quality_finding\".*"})
+	}
+
+	return query
+}
+
 // ExchangeNameDatahistoryjobs retrieves all the datahistoryjob's Datahistoryjobs with an executor via exchange_name_id column.
 func (o *Exchange) ExchangeNameDatahistoryjobs(mods ...qm.QueryMod) datahistoryjobQuery {
 	var queryMods []qm.QueryMod
@@ -431,6 +495,48 @@ func (o *Exchange) SecondaryExchangeDatahistoryjobs(mods ...qm.QueryMod) datahis
 	return query
 }
 
+// ExchangeNameSymbolHistories retrieves all the symbol_history's SymbolHistories with an executor via exchange_name_id column.
+func (o *Exchange) ExchangeNameSymbolHistories(mods ...qm.QueryMod) symbolHistoryQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"symbol_history\".\"exchange_name_id\"=?", o.ID),
+	)
+
+	query := SymbolHistories(queryMods...)
+	queries.SetFrom(query.Query, "\"symbol_history\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"symbol_history\".*"})
+	}
+
+	return query
+}
+
+// ExchangeNameTradeBars retrieves all the trade_bar's TradeBars with an executor via exchange_name_id column.
+func (o *Exchange) ExchangeNameTradeBars(mods ...qm.QueryMod) tradeBarQuery {
+	var queryMods []qm.QueryMod
+	if len(mods) != 0 {
+		queryMods = append(queryMods, mods...)
+	}
+
+	queryMods = append(queryMods,
+		qm.Where("\"trade_bar\".\"exchange_name_id\"=?", o.ID),
+	)
+
+	query := TradeBars(queryMods...)
+	queries.SetFrom(query.Query, "\"trade_bar\"")
+
+	if len(queries.GetSelect(query.Query)) == 0 {
+		queries.SetSelect(query.Query, []string{"\"trade_bar\".*"})
+	}
+
+	return query
+}
+
 // ExchangeNameWithdrawalHistories retrieves all the withdrawal_history's WithdrawalHistories with an executor via exchange_name_id column.
 func (o *Exchange) ExchangeNameWithdrawalHistories(mods ...qm.QueryMod) withdrawalHistoryQuery {
 	var queryMods []qm.QueryMod
@@ -550,6 +656,202 @@ func (exchangeL) LoadExchangeNameCandle(ctx context.Context, e boil.ContextExecu
 	return nil
 }
 
+// LoadExchangeNameFundingRate allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (exchangeL) LoadExchangeNameFundingRate(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
+	var slice []*Exchange
+	var object *Exchange
+
+	if singular {
+		object = maybeExchange.(*Exchange)
+	} else {
+		slice = *maybeExchange.(*[]*Exchange)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &exchangeR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &exchangeR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`funding_rate`), qm.WhereIn(`funding_rate.exchange_name_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load FundingRate")
+	}
+
+	var resultSlice []*FundingRate
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice FundingRate")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for funding_rate")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for funding_rate")
+	}
+
+	if len(exchangeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ExchangeNameFundingRate = foreign
+		if foreign.R == nil {
+			foreign.R = &fundingRateR{}
+		}
+		foreign.R.ExchangeName = object
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.ExchangeNameID {
+				local.R.ExchangeNameFundingRate = foreign
+				if foreign.R == nil {
+					foreign.R = &fundingRateR{}
+				}
+				foreign.R.ExchangeName = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadExchangeNameOrderbookSnapshot allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-1 relationship.
+func (exchangeL) LoadExchangeNameOrderbookSnapshot(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
+	var slice []*Exchange
+	var object *Exchange
+
+	if singular {
+		object = maybeExchange.(*Exchange)
+	} else {
+		slice = *maybeExchange.(*[]*Exchange)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &exchangeR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &exchangeR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`orderbook_snapshot`), qm.WhereIn(`orderbook_snapshot.exchange_name_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load OrderbookSnapshot")
+	}
+
+	var resultSlice []*OrderbookSnapshot
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice OrderbookSnapshot")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results of eager load for orderbook_snapshot")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for orderbook_snapshot")
+	}
+
+	if len(exchangeAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(resultSlice) == 0 {
+		return nil
+	}
+
+	if singular {
+		foreign := resultSlice[0]
+		object.R.ExchangeNameOrderbookSnapshot = foreign
+		if foreign.R == nil {
+			foreign.R = &orderbookSnapshotR{}
+		}
+		foreign.R.ExchangeName = object
+	}
+
+	for _, local := range slice {
+		for _, foreign := range resultSlice {
+			if local.ID == foreign.ExchangeNameID {
+				local.R.ExchangeNameOrderbookSnapshot = foreign
+				if foreign.R == nil {
+					foreign.R = &orderbookSnapshotR{}
+				}
+				foreign.R.ExchangeName = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
 // LoadExchangeNameTrade allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-1 relationship.
 func (exchangeL) LoadExchangeNameTrade(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
@@ -648,9 +950,294 @@ func (exchangeL) LoadExchangeNameTrade(ctx context.Context, e boil.ContextExecut
 	return nil
 }
 
-// LoadExchangeNameDatahistoryjobs allows an eager lookup of values, cached into the
+// LoadExchangeNameDataQualityFindings allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (exchangeL) LoadExchangeNameDataQualityFindings(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
+	var slice []*Exchange
+	var object *Exchange
+
+	if singular {
+		object = maybeExchange.(*Exchange)
+	} else {
+		slice = *maybeExchange.(*[]*Exchange)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &exchangeR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &exchangeR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`data_quality_finding`), qm.WhereIn(`data_quality_finding.exchange_name_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load data_quality_finding")
+	}
+
+	var resultSlice []*DataQualityFinding
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice data_quality_finding")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on data_quality_finding")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for data_quality_finding")
+	}
+
+	if len(dataQualityFindingAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ExchangeNameDataQualityFindings = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &dataQualityFindingR{}
+			}
+			foreign.R.ExchangeName = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ExchangeNameID {
+				local.R.ExchangeNameDataQualityFindings = append(local.R.ExchangeNameDataQualityFindings, foreign)
+				if foreign.R == nil {
+					foreign.R = &dataQualityFindingR{}
+				}
+				foreign.R.ExchangeName = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadExchangeNameDatahistoryjobs allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (exchangeL) LoadExchangeNameDatahistoryjobs(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
+	var slice []*Exchange
+	var object *Exchange
+
+	if singular {
+		object = maybeExchange.(*Exchange)
+	} else {
+		slice = *maybeExchange.(*[]*Exchange)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &exchangeR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &exchangeR{}
+			}
+
+			for _, a := range args {
+				if a == obj.ID {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`datahistoryjob`), qm.WhereIn(`datahistoryjob.exchange_name_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load datahistoryjob")
+	}
+
+	var resultSlice []*Datahistoryjob
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice datahistoryjob")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on datahistoryjob")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for datahistoryjob")
+	}
+
+	if len(datahistoryjobAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.ExchangeNameDatahistoryjobs = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &datahistoryjobR{}
+			}
+			foreign.R.ExchangeName = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if local.ID == foreign.ExchangeNameID {
+				local.R.ExchangeNameDatahistoryjobs = append(local.R.ExchangeNameDatahistoryjobs, foreign)
+				if foreign.R == nil {
+					foreign.R = &datahistoryjobR{}
+				}
+				foreign.R.ExchangeName = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadSecondaryExchangeDatahistoryjobs allows an eager lookup of values, cached into the
+// loaded structs of the objects. This is for a 1-M or N-M relationship.
+func (exchangeL) LoadSecondaryExchangeDatahistoryjobs(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
+	var slice []*Exchange
+	var object *Exchange
+
+	if singular {
+		object = maybeExchange.(*Exchange)
+	} else {
+		slice = *maybeExchange.(*[]*Exchange)
+	}
+
+	args := make([]interface{}, 0, 1)
+	if singular {
+		if object.R == nil {
+			object.R = &exchangeR{}
+		}
+		args = append(args, object.ID)
+	} else {
+	Outer:
+		for _, obj := range slice {
+			if obj.R == nil {
+				obj.R = &exchangeR{}
+			}
+
+			for _, a := range args {
+				if queries.Equal(a, obj.ID) {
+					continue Outer
+				}
+			}
+
+			args = append(args, obj.ID)
+		}
+	}
+
+	if len(args) == 0 {
+		return nil
+	}
+
+	query := NewQuery(qm.From(`datahistoryjob`), qm.WhereIn(`datahistoryjob.secondary_exchange_id in ?`, args...))
+	if mods != nil {
+		mods.Apply(query)
+	}
+
+	results, err := query.QueryContext(ctx, e)
+	if err != nil {
+		return errors.Wrap(err, "failed to eager load datahistoryjob")
+	}
+
+	var resultSlice []*Datahistoryjob
+	if err = queries.Bind(results, &resultSlice); err != nil {
+		return errors.Wrap(err, "failed to bind eager loaded slice datahistoryjob")
+	}
+
+	if err = results.Close(); err != nil {
+		return errors.Wrap(err, "failed to close results in eager load on datahistoryjob")
+	}
+	if err = results.Err(); err != nil {
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for datahistoryjob")
+	}
+
+	if len(datahistoryjobAfterSelectHooks) != 0 {
+		for _, obj := range resultSlice {
+			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
+				return err
+			}
+		}
+	}
+	if singular {
+		object.R.SecondaryExchangeDatahistoryjobs = resultSlice
+		for _, foreign := range resultSlice {
+			if foreign.R == nil {
+				foreign.R = &datahistoryjobR{}
+			}
+			foreign.R.SecondaryExchange = object
+		}
+		return nil
+	}
+
+	for _, foreign := range resultSlice {
+		for _, local := range slice {
+			if queries.Equal(local.ID, foreign.SecondaryExchangeID) {
+				local.R.SecondaryExchangeDatahistoryjobs = append(local.R.SecondaryExchangeDatahistoryjobs, foreign)
+				if foreign.R == nil {
+					foreign.R = &datahistoryjobR{}
+				}
+				foreign.R.SecondaryExchange = local
+				break
+			}
+		}
+	}
+
+	return nil
+}
+
+// LoadExchangeNameSymbolHistories allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (exchangeL) LoadExchangeNameDatahistoryjobs(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
+func (exchangeL) LoadExchangeNameSymbolHistories(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
 	var slice []*Exchange
 	var object *Exchange
 
@@ -687,29 +1274,29 @@ func (exchangeL) LoadExchangeNameDatahistoryjobs(ctx context.Context, e boil.Con
 		return nil
 	}
 
-	query := NewQuery(qm.From(`datahistoryjob`), qm.WhereIn(`datahistoryjob.exchange_name_id in ?`, args...))
+	query := NewQuery(qm.From(`symbol_history`), qm.WhereIn(`symbol_history.exchange_name_id in ?`, args...))
 	if mods != nil {
 		mods.Apply(query)
 	}
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load datahistoryjob")
+		return errors.Wrap(err, "failed to eager load symbol_history")
 	}
 
-	var resultSlice []*Datahistoryjob
+	var resultSlice []*SymbolHistory
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice datahistoryjob")
+		return errors.Wrap(err, "failed to bind eager loaded slice symbol_history")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on datahistoryjob")
+		return errors.Wrap(err, "failed to close results in eager load on symbol_history")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for datahistoryjob")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for symbol_history")
 	}
 
-	if len(datahistoryjobAfterSelectHooks) != 0 {
+	if len(symbolHistoryAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -717,10 +1304,10 @@ func (exchangeL) LoadExchangeNameDatahistoryjobs(ctx context.Context, e boil.Con
 		}
 	}
 	if singular {
-		object.R.ExchangeNameDatahistoryjobs = resultSlice
+		object.R.ExchangeNameSymbolHistories = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &datahistoryjobR{}
+				foreign.R = &symbolHistoryR{}
 			}
 			foreign.R.ExchangeName = object
 		}
@@ -730,9 +1317,9 @@ func (exchangeL) LoadExchangeNameDatahistoryjobs(ctx context.Context, e boil.Con
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
 			if local.ID == foreign.ExchangeNameID {
-				local.R.ExchangeNameDatahistoryjobs = append(local.R.ExchangeNameDatahistoryjobs, foreign)
+				local.R.ExchangeNameSymbolHistories = append(local.R.ExchangeNameSymbolHistories, foreign)
 				if foreign.R == nil {
-					foreign.R = &datahistoryjobR{}
+					foreign.R = &symbolHistoryR{}
 				}
 				foreign.R.ExchangeName = local
 				break
@@ -743,9 +1330,9 @@ func (exchangeL) LoadExchangeNameDatahistoryjobs(ctx context.Context, e boil.Con
 	return nil
 }
 
-// LoadSecondaryExchangeDatahistoryjobs allows an eager lookup of values, cached into the
+// LoadExchangeNameTradeBars allows an eager lookup of values, cached into the
 // loaded structs of the objects. This is for a 1-M or N-M relationship.
-func (exchangeL) LoadSecondaryExchangeDatahistoryjobs(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
+func (exchangeL) LoadExchangeNameTradeBars(ctx context.Context, e boil.ContextExecutor, singular bool, maybeExchange interface{}, mods queries.Applicator) error {
 	var slice []*Exchange
 	var object *Exchange
 
@@ -769,7 +1356,7 @@ func (exchangeL) LoadSecondaryExchangeDatahistoryjobs(ctx context.Context, e boi
 			}
 
 			for _, a := range args {
-				if queries.Equal(a, obj.ID) {
+				if a == obj.ID {
 					continue Outer
 				}
 			}
@@ -782,29 +1369,29 @@ func (exchangeL) LoadSecondaryExchangeDatahistoryjobs(ctx context.Context, e boi
 		return nil
 	}
 
-	query := NewQuery(qm.From(`datahistoryjob`), qm.WhereIn(`datahistoryjob.secondary_exchange_id in ?`, args...))
+	query := NewQuery(qm.From(`trade_bar`), qm.WhereIn(`trade_bar.exchange_name_id in ?`, args...))
 	if mods != nil {
 		mods.Apply(query)
 	}
 
 	results, err := query.QueryContext(ctx, e)
 	if err != nil {
-		return errors.Wrap(err, "failed to eager load datahistoryjob")
+		return errors.Wrap(err, "failed to eager load trade_bar")
 	}
 
-	var resultSlice []*Datahistoryjob
+	var resultSlice []*TradeBar
 	if err = queries.Bind(results, &resultSlice); err != nil {
-		return errors.Wrap(err, "failed to bind eager loaded slice datahistoryjob")
+		return errors.Wrap(err, "failed to bind eager loaded slice trade_bar")
 	}
 
 	if err = results.Close(); err != nil {
-		return errors.Wrap(err, "failed to close results in eager load on datahistoryjob")
+		return errors.Wrap(err, "failed to close results in eager load on trade_bar")
 	}
 	if err = results.Err(); err != nil {
-		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for datahistoryjob")
+		return errors.Wrap(err, "error occurred during iteration of eager loaded relations for trade_bar")
 	}
 
-	if len(datahistoryjobAfterSelectHooks) != 0 {
+	if len(tradeBarAfterSelectHooks) != 0 {
 		for _, obj := range resultSlice {
 			if err := obj.doAfterSelectHooks(ctx, e); err != nil {
 				return err
@@ -812,24 +1399,24 @@ func (exchangeL) LoadSecondaryExchangeDatahistoryjobs(ctx context.Context, e boi
 		}
 	}
 	if singular {
-		object.R.SecondaryExchangeDatahistoryjobs = resultSlice
+		object.R.ExchangeNameTradeBars = resultSlice
 		for _, foreign := range resultSlice {
 			if foreign.R == nil {
-				foreign.R = &datahistoryjobR{}
+				foreign.R = &tradeBarR{}
 			}
-			foreign.R.SecondaryExchange = object
+			foreign.R.ExchangeName = object
 		}
 		return nil
 	}
 
 	for _, foreign := range resultSlice {
 		for _, local := range slice {
-			if queries.Equal(local.ID, foreign.SecondaryExchangeID) {
-				local.R.SecondaryExchangeDatahistoryjobs = append(local.R.SecondaryExchangeDatahistoryjobs, foreign)
+			if local.ID == foreign.ExchangeNameID {
+				local.R.ExchangeNameTradeBars = append(local.R.ExchangeNameTradeBars, foreign)
 				if foreign.R == nil {
-					foreign.R = &datahistoryjobR{}
+					foreign.R = &tradeBarR{}
 				}
-				foreign.R.SecondaryExchange = local
+				foreign.R.ExchangeName = local
 				break
 			}
 		}
@@ -984,6 +1571,108 @@ func (o *Exchange) SetExchangeNameCandle(ctx context.Context, exec boil.ContextE
 	return nil
 }
 
+// SetExchangeNameFundingRate of the exchange to the related item.
+// Sets o.R.ExchangeNameFundingRate to related.
+// Adds o to related.R.ExchangeName.
+func (o *Exchange) SetExchangeNameFundingRate(ctx context.Context, exec boil.ContextExecutor, insert bool, related *FundingRate) error {
+	var err error
+
+	if insert {
+		related.ExchangeNameID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"funding_rate\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, []string{"exchange_name_id"}),
+			strmangle.WhereClause("\"", "\"", 0, fundingRatePrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.ID}
+
+		if boil.DebugMode {
+			fmt.Fprintln(boil.DebugWriter, updateQuery)
+			fmt.Fprintln(boil.DebugWriter, values)
+		}
+
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.ExchangeNameID = o.ID
+
+	}
+
+	if o.R == nil {
+		o.R = &exchangeR{
+			ExchangeNameFundingRate: related,
+		}
+	} else {
+		o.R.ExchangeNameFundingRate = related
+	}
+
+	if related.R == nil {
+		related.R = &fundingRateR{
+			ExchangeName: o,
+		}
+	} else {
+		related.R.ExchangeName = o
+	}
+	return nil
+}
+
+// SetExchangeNameOrderbookSnapshot of the exchange to the related item.
+// Sets o.R.ExchangeNameOrderbookSnapshot to related.
+// Adds o to related.R.ExchangeName.
+func (o *Exchange) SetExchangeNameOrderbookSnapshot(ctx context.Context, exec boil.ContextExecutor, insert bool, related *OrderbookSnapshot) error {
+	var err error
+
+	if insert {
+		related.ExchangeNameID = o.ID
+
+		if err = related.Insert(ctx, exec, boil.Infer()); err != nil {
+			return errors.Wrap(err, "failed to insert into foreign table")
+		}
+	} else {
+		updateQuery := fmt.Sprintf(
+			"UPDATE \"orderbook_snapshot\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, []string{"exchange_name_id"}),
+			strmangle.WhereClause("\"", "\"", 0, orderbookSnapshotPrimaryKeyColumns),
+		)
+		values := []interface{}{o.ID, related.ID}
+
+		if boil.DebugMode {
+			fmt.Fprintln(boil.DebugWriter, updateQuery)
+			fmt.Fprintln(boil.DebugWriter, values)
+		}
+
+		if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+			return errors.Wrap(err, "failed to update foreign table")
+		}
+
+		related.ExchangeNameID = o.ID
+
+	}
+
+	if o.R == nil {
+		o.R = &exchangeR{
+			ExchangeNameOrderbookSnapshot: related,
+		}
+	} else {
+		o.R.ExchangeNameOrderbookSnapshot = related
+	}
+
+	if related.R == nil {
+		related.R = &orderbookSnapshotR{
+			ExchangeName: o,
+		}
+	} else {
+		related.R.ExchangeName = o
+	}
+	return nil
+}
+
 // SetExchangeNameTrade of the exchange to the related item.
 // Sets o.R.ExchangeNameTrade to related.
 // Adds o to related.R.ExchangeName.
@@ -1035,6 +1724,59 @@ func (o *Exchange) SetExchangeNameTrade(ctx context.Context, exec boil.ContextEx
 	return nil
 }
 
+// AddExchangeNameDataQualityFindings adds the given related objects to the existing relationships
+// of the exchange, optionally inserting them as new records.
+// Appends related to o.R.ExchangeNameDataQualityFindings.
+// Sets related.R.ExchangeName appropriately.
+func (o *Exchange) AddExchangeNameDataQualityFindings(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*DataQualityFinding) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ExchangeNameID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"data_quality_finding\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"exchange_name_id"}),
+				strmangle.WhereClause("\"", "\"", 0, dataQualityFindingPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ExchangeNameID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &exchangeR{
+			ExchangeNameDataQualityFindings: related,
+		}
+	} else {
+		o.R.ExchangeNameDataQualityFindings = append(o.R.ExchangeNameDataQualityFindings, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &dataQualityFindingR{
+				ExchangeName: o,
+			}
+		} else {
+			rel.R.ExchangeName = o
+		}
+	}
+	return nil
+}
+
 // AddExchangeNameDatahistoryjobs adds the given related objects to the existing relationships
 // of the exchange, optionally inserting them as new records.
 // Appends related to o.R.ExchangeNameDatahistoryjobs.
@@ -1211,6 +1953,112 @@ func (o *Exchange) RemoveSecondaryExchangeDatahistoryjobs(ctx context.Context, e
 	return nil
 }
 
+// AddExchangeNameSymbolHistories adds the given related objects to the existing relationships
+// of the exchange, optionally inserting them as new records.
+// Appends related to o.R.ExchangeNameSymbolHistories.
+// Sets related.R.ExchangeName appropriately.
+func (o *Exchange) AddExchangeNameSymbolHistories(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*SymbolHistory) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ExchangeNameID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"symbol_history\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"exchange_name_id"}),
+				strmangle.WhereClause("\"", "\"", 0, symbolHistoryPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ExchangeNameID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &exchangeR{
+			ExchangeNameSymbolHistories: related,
+		}
+	} else {
+		o.R.ExchangeNameSymbolHistories = append(o.R.ExchangeNameSymbolHistories, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &symbolHistoryR{
+				ExchangeName: o,
+			}
+		} else {
+			rel.R.ExchangeName = o
+		}
+	}
+	return nil
+}
+
+// AddExchangeNameTradeBars adds the given related objects to the existing relationships
+// of the exchange, optionally inserting them as new records.
+// Appends related to o.R.ExchangeNameTradeBars.
+// Sets related.R.ExchangeName appropriately.
+func (o *Exchange) AddExchangeNameTradeBars(ctx context.Context, exec boil.ContextExecutor, insert bool, related ...*TradeBar) error {
+	var err error
+	for _, rel := range related {
+		if insert {
+			rel.ExchangeNameID = o.ID
+			if err = rel.Insert(ctx, exec, boil.Infer()); err != nil {
+				return errors.Wrap(err, "failed to insert into foreign table")
+			}
+		} else {
+			updateQuery := fmt.Sprintf(
+				"UPDATE \"trade_bar\" SET %s WHERE %s",
+				strmangle.SetParamNames("\"", "\"", 0, []string{"exchange_name_id"}),
+				strmangle.WhereClause("\"", "\"", 0, tradeBarPrimaryKeyColumns),
+			)
+			values := []interface{}{o.ID, rel.ID}
+
+			if boil.DebugMode {
+				fmt.Fprintln(boil.DebugWriter, updateQuery)
+				fmt.Fprintln(boil.DebugWriter, values)
+			}
+
+			if _, err = exec.ExecContext(ctx, updateQuery, values...); err != nil {
+				return errors.Wrap(err, "failed to update foreign table")
+			}
+
+			rel.ExchangeNameID = o.ID
+		}
+	}
+
+	if o.R == nil {
+		o.R = &exchangeR{
+			ExchangeNameTradeBars: related,
+		}
+	} else {
+		o.R.ExchangeNameTradeBars = append(o.R.ExchangeNameTradeBars, related...)
+	}
+
+	for _, rel := range related {
+		if rel.R == nil {
+			rel.R = &tradeBarR{
+				ExchangeName: o,
+			}
+		} else {
+			rel.R.ExchangeName = o
+		}
+	}
+	return nil
+}
+
 // AddExchangeNameWithdrawalHistories adds the given related objects to the existing relationships
 // of the exchange, optionally inserting them as new records.
 // Appends related to o.R.ExchangeNameWithdrawalHistories.
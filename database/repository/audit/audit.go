@@ -5,8 +5,6 @@ import (
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/database"
-	modelPSQL "github.com/thrasher-corp/gocryptotrader/database/models/postgres"
-	modelSQLite "github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
 	"github.com/thrasher-corp/gocryptotrader/database/repository"
 	"github.com/thrasher-corp/gocryptotrader/log"
 	"github.com/thrasher-corp/sqlboiler/boil"
@@ -19,6 +17,12 @@ func Event(id, msgtype, message string) {
 		return
 	}
 
+	d, err := repository.GetDialect()
+	if err != nil {
+		log.Errorf(log.Global, "Event dialect failed: %v", err)
+		return
+	}
+
 	ctx := context.Background()
 	ctx = boil.SkipTimestamps(ctx)
 
@@ -28,22 +32,11 @@ func Event(id, msgtype, message string) {
 		return
 	}
 
-	if repository.GetSQLDialect() == database.DBSQLite3 {
-		var tempEvent = modelSQLite.AuditEvent{
-			Type:       msgtype,
-			Identifier: id,
-			Message:    message,
-		}
-		err = tempEvent.Insert(ctx, tx, boil.Blacklist("created_at"))
-	} else {
-		var tempEvent = modelPSQL.AuditEvent{
-			Type:       msgtype,
-			Identifier: id,
-			Message:    message,
-		}
-		err = tempEvent.Insert(ctx, tx, boil.Blacklist("created_at"))
-	}
-
+	err = d.Insert(ctx, tx, "audit_event", &eventRow{
+		Type:       msgtype,
+		Identifier: id,
+		Message:    message,
+	})
 	if err != nil {
 		log.Errorf(log.Global, "Event insert failed: %v", err)
 		err = tx.Rollback()
@@ -61,11 +54,13 @@ func Event(id, msgtype, message string) {
 }
 
 // GetEvent () returns list of order events matching query
-func GetEvent(startTime, endTime time.Time, order string, limit int) (interface{}, error) {
+func GetEvent(startTime, endTime time.Time, order string, limit int) ([]Record, error) {
 	if database.DB.SQL == nil {
 		return nil, database.ErrDatabaseSupportDisabled
 	}
 
+	// created_at is set by the database, the times are not converted as
+	// SQLite stores the default in its own text format
 	query := qm.Where("created_at BETWEEN ? AND ?", startTime, endTime)
 
 	orderByQueryString := "id"
@@ -77,9 +72,14 @@ func GetEvent(startTime, endTime time.Time, order string, limit int) (interface{
 	limitQuery := qm.Limit(limit)
 
 	ctx := context.Background()
-	if repository.GetSQLDialect() == database.DBSQLite3 {
-		return modelSQLite.AuditEvents(query, orderByQuery, limitQuery).All(ctx, database.DB.SQL)
+	d, err := repository.GetDialect()
+	if err != nil {
+		return nil, err
 	}
-
-	return modelPSQL.AuditEvents(query, orderByQuery, limitQuery).All(ctx, database.DB.SQL)
+	var events []Record
+	err = d.Query("audit_event", query, orderByQuery, limitQuery).Bind(ctx, database.DB.SQL, &events)
+	if err != nil {
+		return nil, err
+	}
+	return events, nil
 }
@@ -16,6 +16,7 @@ import (
 func TestMain(m *testing.M) {
 	var err error
 	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.MySQLTestDatabase = testhelpers.GetMySQLConnectionDetails()
 	testhelpers.TempDir, err = ioutil.TempDir("", "gct-temp")
 	if err != nil {
 		fmt.Printf("failed to create temp file: %v", err)
@@ -75,6 +76,20 @@ func TestAudit(t *testing.T) {
 			nil,
 			nil,
 		},
+		{
+			"MySQL-Write",
+			testhelpers.MySQLTestDatabase,
+			writeAudit,
+			nil,
+			nil,
+		},
+		{
+			"MySQL-Read",
+			testhelpers.MySQLTestDatabase,
+			readHelper,
+			nil,
+			nil,
+		},
 	}
 
 	for _, tests := range testCases {
@@ -120,8 +135,11 @@ func writeAudit(t *testing.T) {
 func readHelper(t *testing.T) {
 	t.Helper()
 
-	_, err := GetEvent(time.Now().Add(-time.Hour*60), time.Now(), "asc", 1)
+	events, err := GetEvent(time.Now().Add(-time.Hour*60), time.Now().Add(time.Minute), "asc", 1)
 	if err != nil {
-		t.Error(err)
+		t.Fatal(err)
+	}
+	if len(events) != 1 {
+		t.Errorf("received '%v' expected '%v'", len(events), 1)
 	}
 }
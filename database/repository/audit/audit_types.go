@@ -0,0 +1,20 @@
+package audit
+
+import "time"
+
+// Record is an audit event as stored by every driver
+type Record struct {
+	ID         int64     `boil:"id"`
+	Type       string    `boil:"type"`
+	Identifier string    `boil:"identifier"`
+	Message    string    `boil:"message"`
+	CreatedAt  time.Time `boil:"created_at"`
+}
+
+// eventRow holds the columns inserted for a new audit event, the id and
+// creation time are set by the database
+type eventRow struct {
+	Type       string `boil:"type"`
+	Identifier string `boil:"identifier"`
+	Message    string `boil:"message"`
+}
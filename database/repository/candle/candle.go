@@ -14,78 +14,36 @@ import (
 
 	"github.com/gofrs/uuid"
 	"github.com/thrasher-corp/gocryptotrader/database"
-	modelPSQL "github.com/thrasher-corp/gocryptotrader/database/models/postgres"
-	modelSQLite "github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
 	"github.com/thrasher-corp/gocryptotrader/database/repository"
 	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/symbolhistory"
+	"github.com/thrasher-corp/gocryptotrader/database/timeseries"
 	"github.com/thrasher-corp/gocryptotrader/log"
-	"github.com/thrasher-corp/sqlboiler/boil"
 	"github.com/thrasher-corp/sqlboiler/queries/qm"
 	"github.com/volatiletech/null"
 )
 
-// Series returns candle data
+// Series returns candle data. Candles stored under a previous symbol of the
+// pair are included for the periods before each rename
 func Series(exchangeName, base, quote string, interval int64, asset string, start, end time.Time) (out Item, err error) {
 	if exchangeName == "" || base == "" || quote == "" || asset == "" || interval <= 0 {
 		return out, errInvalidInput
 	}
 
-	queries := []qm.QueryMod{
-		qm.Where("base = ?", strings.ToUpper(base)),
-		qm.Where("quote = ?", strings.ToUpper(quote)),
-		qm.Where("interval = ?", interval),
-		qm.Where("asset = ?", strings.ToLower(asset)),
-		qm.OrderBy("timestamp"),
-	}
-
 	exchangeUUID, errS := exchange.UUIDByName(exchangeName)
 	if errS != nil {
 		return out, errS
 	}
-	queries = append(queries, qm.Where("exchange_name_id = ?", exchangeUUID.String()))
-	if repository.GetSQLDialect() == database.DBSQLite3 {
-		queries = append(queries, qm.Where("timestamp between ? and ?", start.UTC().Format(time.RFC3339), end.UTC().Format(time.RFC3339)))
-		retCandle, errC := modelSQLite.Candles(queries...).All(context.Background(), database.DB.SQL)
-		if errC != nil {
-			return out, errC
-		}
-		for x := range retCandle {
-			t, errT := time.Parse(time.RFC3339, retCandle[x].Timestamp)
-			if errT != nil {
-				return out, errT
-			}
-			out.Candles = append(out.Candles, Candle{
-				Timestamp:        t,
-				Open:             retCandle[x].Open,
-				High:             retCandle[x].High,
-				Low:              retCandle[x].Low,
-				Close:            retCandle[x].Close,
-				Volume:           retCandle[x].Volume,
-				SourceJobID:      retCandle[x].SourceJobID.String,
-				ValidationJobID:  retCandle[x].ValidationJobID.String,
-				ValidationIssues: retCandle[x].ValidationIssues.String,
-			})
-		}
-	} else {
-		queries = append(queries, qm.Where("timestamp between ? and ?", start.UTC(), end.UTC()))
-		retCandle, errC := modelPSQL.Candles(queries...).All(context.Background(), database.DB.SQL)
+	segments, err := symbolhistory.ResolveSegments(exchangeName, asset, base, quote, start, end)
+	if err != nil {
+		return out, err
+	}
+	for i := range segments {
+		candles, errC := series(exchangeUUID.String(), segments[i].Base, segments[i].Quote, interval, asset, segments[i].Start, segments[i].End)
 		if errC != nil {
 			return out, errC
 		}
-
-		for x := range retCandle {
-			out.Candles = append(out.Candles, Candle{
-				Timestamp:        retCandle[x].Timestamp,
-				Open:             retCandle[x].Open,
-				High:             retCandle[x].High,
-				Low:              retCandle[x].Low,
-				Close:            retCandle[x].Close,
-				Volume:           retCandle[x].Volume,
-				SourceJobID:      retCandle[x].SourceJobID.String,
-				ValidationJobID:  retCandle[x].ValidationJobID.String,
-				ValidationIssues: retCandle[x].ValidationIssues.String,
-			})
-		}
+		out.Candles = append(out.Candles, candles...)
 	}
 	if len(out.Candles) < 1 {
 		return out, fmt.Errorf("%w: %s %s %s %v %s", ErrNoCandleDataFound, exchangeName, base, quote, interval, asset)
@@ -99,84 +57,155 @@ func Series(exchangeName, base, quote string, interval int64, asset string, star
 	return out, err
 }
 
-// DeleteCandles will delete all existing matching candles
-func DeleteCandles(in *Item) (int64, error) {
-	if database.DB.SQL == nil {
-		return 0, database.ErrDatabaseSupportDisabled
+func series(exchangeID, base, quote string, interval int64, asset string, start, end time.Time) ([]Candle, error) {
+	store, err := repository.GetTimeSeriesStore()
+	if err != nil {
+		return nil, err
 	}
-	if len(in.Candles) < 1 {
-		return 0, errNoCandleData
+	if store != nil {
+		return seriesTimeSeries(store, exchangeID, base, quote, interval, asset, start, end)
 	}
 
-	ctx := context.Background()
+	d, err := repository.GetDialect()
+	if err != nil {
+		return nil, err
+	}
 	queries := []qm.QueryMod{
-		qm.Where("base = ?", strings.ToUpper(in.Base)),
-		qm.Where("quote = ?", strings.ToUpper(in.Quote)),
-		qm.Where("interval = ?", in.Interval),
-		qm.Where("asset = ?", strings.ToLower(in.Asset)),
-		qm.Where("exchange_name_id = ?", in.ExchangeID),
+		qm.Select(d.Columns(candleColumns...)...),
+		qm.Where("base = ?", strings.ToUpper(base)),
+		qm.Where("quote = ?", strings.ToUpper(quote)),
+		qm.Where("asset = ?", strings.ToLower(asset)),
+		qm.Where("exchange_name_id = ?", exchangeID),
+		qm.Where(d.Quote("interval")+" = ?", interval),
+		qm.Where("timestamp between ? and ?", d.Time(start), d.Time(end)),
+		qm.OrderBy("timestamp"),
+	}
+	var retCandle []candleRow
+	err = d.Query("candle", queries...).Bind(context.Background(), database.DB.SQL, &retCandle)
+	if err != nil {
+		return nil, err
 	}
-	if repository.GetSQLDialect() == database.DBSQLite3 {
-		queries = append(queries, qm.Where("timestamp between ? and ?", in.Candles[0].Timestamp.UTC().Format(time.RFC3339), in.Candles[len(in.Candles)-1].Timestamp.UTC().Format(time.RFC3339)))
-		return deleteSQLite(ctx, queries)
+	candles := make([]Candle, 0, len(retCandle))
+	for x := range retCandle {
+		candles = append(candles, Candle{
+			Timestamp:        retCandle[x].Timestamp,
+			Open:             retCandle[x].Open,
+			High:             retCandle[x].High,
+			Low:              retCandle[x].Low,
+			Close:            retCandle[x].Close,
+			Volume:           retCandle[x].Volume,
+			SourceJobID:      retCandle[x].SourceJobID.String,
+			ValidationJobID:  retCandle[x].ValidationJobID.String,
+			ValidationIssues: retCandle[x].ValidationIssues.String,
+		})
 	}
-
-	queries = append(queries, qm.Where("timestamp between ? and ?", in.Candles[0].Timestamp.UTC(), in.Candles[len(in.Candles)-1].Timestamp.UTC()))
-	return deletePostgres(ctx, queries)
+	return candles, nil
 }
 
-func deleteSQLite(ctx context.Context, queries []qm.QueryMod) (int64, error) {
-	retCandle, err := modelSQLite.Candles(queries...).All(context.Background(), database.DB.SQL)
-	if err != nil {
-		return 0, err
+// ListSeries returns every stored candle series along with the timestamp of
+// its oldest candle
+func ListSeries() ([]StoredSeries, error) {
+	if database.DB.SQL == nil {
+		return nil, database.ErrDatabaseSupportDisabled
 	}
-	var tx *sql.Tx
-	tx, err = database.DB.SQL.BeginTx(ctx, nil)
+	store, err := repository.GetTimeSeriesStore()
 	if err != nil {
-		return 0, err
+		return nil, err
 	}
-	var totalDeleted int64
-	totalDeleted, err = retCandle.DeleteAll(ctx, tx)
-	if err != nil {
-		errRB := tx.Rollback()
-		if errRB != nil {
-			log.Errorln(log.DatabaseMgr, errRB)
+	var resp []StoredSeries
+	switch {
+	case store != nil:
+		series, errL := store.ListCandleSeries()
+		if errL != nil {
+			return nil, errL
+		}
+		for i := range series {
+			resp = append(resp, StoredSeries{
+				ExchangeID: series[i].Exchange,
+				Base:       series[i].Base,
+				Quote:      series[i].Quote,
+				Interval:   series[i].Interval,
+				Asset:      series[i].Asset,
+				Oldest:     series[i].FirstDay,
+			})
+		}
+	default:
+		d, errD := repository.GetDialect()
+		if errD != nil {
+			return nil, errD
+		}
+		interval := d.Quote("interval")
+		var rows []seriesRow
+		cols := append(d.Columns("exchange_name_id", "base", "quote", "interval", "asset"), "MIN(timestamp) AS oldest")
+		err = d.Query("candle",
+			qm.Select(cols...),
+			qm.GroupBy("exchange_name_id, base, quote, "+interval+", asset"),
+		).Bind(context.Background(), database.DB.SQL, &rows)
+		if err != nil {
+			return nil, err
+		}
+		for i := range rows {
+			resp = append(resp, StoredSeries{
+				ExchangeID: rows[i].ExchangeNameID,
+				Base:       rows[i].Base,
+				Quote:      rows[i].Quote,
+				Interval:   rows[i].Interval,
+				Asset:      rows[i].Asset,
+				Oldest:     rows[i].Oldest.UTC(),
+			})
 		}
-		return 0, err
 	}
 
-	err = tx.Commit()
-	if err != nil {
-		return 0, err
+	names := make(map[string]string)
+	for i := range resp {
+		name, ok := names[resp[i].ExchangeID]
+		if !ok {
+			exchangeUUID, errU := uuid.FromString(resp[i].ExchangeID)
+			if errU != nil {
+				return nil, errU
+			}
+			details, errE := exchange.OneByUUID(exchangeUUID)
+			if errE != nil {
+				return nil, errE
+			}
+			name = details.Name
+			names[resp[i].ExchangeID] = name
+		}
+		resp[i].Exchange = name
 	}
-	return totalDeleted, nil
+	return resp, nil
 }
 
-func deletePostgres(ctx context.Context, queries []qm.QueryMod) (int64, error) {
-	retCandle, err := modelPSQL.Candles(queries...).All(context.Background(), database.DB.SQL)
-	if err != nil {
-		return 0, err
+// DeleteCandles will delete all existing matching candles
+func DeleteCandles(in *Item) (int64, error) {
+	if database.DB.SQL == nil {
+		return 0, database.ErrDatabaseSupportDisabled
 	}
-	var tx *sql.Tx
-	tx, err = database.DB.SQL.BeginTx(ctx, nil)
-	if err != nil {
-		return 0, err
+	if len(in.Candles) < 1 {
+		return 0, errNoCandleData
 	}
-	var totalDeleted int64
-	totalDeleted, err = retCandle.DeleteAll(ctx, tx)
+
+	store, err := repository.GetTimeSeriesStore()
 	if err != nil {
-		errRB := tx.Rollback()
-		if errRB != nil {
-			log.Errorln(log.DatabaseMgr, errRB)
-		}
 		return 0, err
 	}
+	if store != nil {
+		return store.DeleteCandles(timeSeriesSeries(in.ExchangeID, in.Base, in.Quote, in.Interval, in.Asset),
+			in.Candles[0].Timestamp,
+			in.Candles[len(in.Candles)-1].Timestamp)
+	}
 
-	err = tx.Commit()
+	d, err := repository.GetDialect()
 	if err != nil {
 		return 0, err
 	}
-	return totalDeleted, nil
+	return d.Delete(context.Background(), database.DB.SQL, "candle",
+		qm.Where("base = ?", strings.ToUpper(in.Base)),
+		qm.Where("quote = ?", strings.ToUpper(in.Quote)),
+		qm.Where("asset = ?", strings.ToLower(in.Asset)),
+		qm.Where("exchange_name_id = ?", in.ExchangeID),
+		qm.Where(d.Quote("interval")+" = ?", in.Interval),
+		qm.Where("timestamp between ? and ?", d.Time(in.Candles[0].Timestamp), d.Time(in.Candles[len(in.Candles)-1].Timestamp)))
 }
 
 // Insert series of candles
@@ -189,18 +218,21 @@ func Insert(in *Item) (uint64, error) {
 		return 0, errNoCandleData
 	}
 
+	store, err := repository.GetTimeSeriesStore()
+	if err != nil {
+		return 0, err
+	}
+	if store != nil {
+		return insertTimeSeries(store, in)
+	}
+
 	ctx := context.Background()
 	tx, err := database.DB.SQL.BeginTx(ctx, nil)
 	if err != nil {
 		return 0, err
 	}
 
-	var totalInserted uint64
-	if repository.GetSQLDialect() == database.DBSQLite3 {
-		totalInserted, err = insertSQLite(ctx, tx, in)
-	} else {
-		totalInserted, err = insertPostgresSQL(ctx, tx, in)
-	}
+	totalInserted, err := insert(ctx, tx, in)
 	if err != nil {
 		errRB := tx.Rollback()
 		if errRB != nil {
@@ -216,31 +248,36 @@ func Insert(in *Item) (uint64, error) {
 	return totalInserted, nil
 }
 
-func insertSQLite(ctx context.Context, tx *sql.Tx, in *Item) (uint64, error) {
+func insert(ctx context.Context, tx *sql.Tx, in *Item) (uint64, error) {
+	d, err := repository.GetDialect()
+	if err != nil {
+		return 0, err
+	}
 	var totalInserted uint64
 	for x := range in.Candles {
-		var tempCandle = modelSQLite.Candle{
-			ExchangeNameID: in.ExchangeID,
-			Base:           strings.ToUpper(in.Base),
-			Quote:          strings.ToUpper(in.Quote),
-			Interval:       strconv.FormatInt(in.Interval, 10),
-			Asset:          strings.ToLower(in.Asset),
-			Timestamp:      in.Candles[x].Timestamp.UTC().Format(time.RFC3339),
-			Open:           in.Candles[x].Open,
-			High:           in.Candles[x].High,
-			Low:            in.Candles[x].Low,
-			Close:          in.Candles[x].Close,
-			Volume:         in.Candles[x].Volume,
-		}
-		tempUUID, err := uuid.NewV4()
+		var tempUUID uuid.UUID
+		tempUUID, err = uuid.NewV4()
 		if err != nil {
 			return 0, err
 		}
-		tempCandle.ID = tempUUID.String()
-		tempCandle.ValidationJobID = null.String{String: in.Candles[x].ValidationJobID, Valid: in.Candles[x].ValidationJobID != ""}
-		tempCandle.ValidationIssues = null.String{String: in.Candles[x].ValidationIssues, Valid: in.Candles[x].ValidationIssues != ""}
-		tempCandle.SourceJobID = null.String{String: in.Candles[x].SourceJobID, Valid: in.Candles[x].SourceJobID != ""}
-		err = tempCandle.Insert(ctx, tx, boil.Infer())
+		var tempCandle = candleRow{
+			ID:               tempUUID.String(),
+			ExchangeNameID:   in.ExchangeID,
+			Base:             strings.ToUpper(in.Base),
+			Quote:            strings.ToUpper(in.Quote),
+			Interval:         in.Interval,
+			Asset:            strings.ToLower(in.Asset),
+			Timestamp:        in.Candles[x].Timestamp.UTC(),
+			Open:             in.Candles[x].Open,
+			High:             in.Candles[x].High,
+			Low:              in.Candles[x].Low,
+			Close:            in.Candles[x].Close,
+			Volume:           in.Candles[x].Volume,
+			ValidationJobID:  null.String{String: in.Candles[x].ValidationJobID, Valid: in.Candles[x].ValidationJobID != ""},
+			ValidationIssues: null.String{String: in.Candles[x].ValidationIssues, Valid: in.Candles[x].ValidationIssues != ""},
+			SourceJobID:      null.String{String: in.Candles[x].SourceJobID, Valid: in.Candles[x].SourceJobID != ""},
+		}
+		err = d.Upsert(ctx, tx, "candle", []string{"timestamp", "exchange_name_id", "base", "quote", "interval", "asset"}, &tempCandle)
 		if err != nil {
 			return 0, err
 		}
@@ -251,34 +288,54 @@ func insertSQLite(ctx context.Context, tx *sql.Tx, in *Item) (uint64, error) {
 	return totalInserted, nil
 }
 
-func insertPostgresSQL(ctx context.Context, tx *sql.Tx, in *Item) (uint64, error) {
-	var totalInserted uint64
+func insertTimeSeries(store *timeseries.Store, in *Item) (uint64, error) {
+	candles := make([]timeseries.Candle, len(in.Candles))
 	for x := range in.Candles {
-		var tempCandle = modelPSQL.Candle{
-			ExchangeNameID: in.ExchangeID,
-			Base:           strings.ToUpper(in.Base),
-			Quote:          strings.ToUpper(in.Quote),
-			Interval:       in.Interval,
-			Asset:          strings.ToLower(in.Asset),
-			Timestamp:      in.Candles[x].Timestamp,
-			Open:           in.Candles[x].Open,
-			High:           in.Candles[x].High,
-			Low:            in.Candles[x].Low,
-			Close:          in.Candles[x].Close,
-			Volume:         in.Candles[x].Volume,
+		candles[x] = timeseries.Candle{
+			Timestamp:        in.Candles[x].Timestamp,
+			Open:             in.Candles[x].Open,
+			High:             in.Candles[x].High,
+			Low:              in.Candles[x].Low,
+			Close:            in.Candles[x].Close,
+			Volume:           in.Candles[x].Volume,
+			SourceJobID:      in.Candles[x].SourceJobID,
+			ValidationJobID:  in.Candles[x].ValidationJobID,
+			ValidationIssues: in.Candles[x].ValidationIssues,
 		}
-		tempCandle.ValidationJobID = null.String{String: in.Candles[x].ValidationJobID, Valid: in.Candles[x].ValidationJobID != ""}
-		tempCandle.ValidationIssues = null.String{String: in.Candles[x].ValidationIssues, Valid: in.Candles[x].ValidationIssues != ""}
-		tempCandle.SourceJobID = null.String{String: in.Candles[x].SourceJobID, Valid: in.Candles[x].SourceJobID != ""}
-		err := tempCandle.Upsert(ctx, tx, true, []string{"timestamp", "exchange_name_id", "base", "quote", "interval", "asset"}, boil.Infer(), boil.Infer())
-		if err != nil {
-			return 0, err
-		}
-		if totalInserted < math.MaxUint64 {
-			totalInserted++
+	}
+	return store.InsertCandles(timeSeriesSeries(in.ExchangeID, in.Base, in.Quote, in.Interval, in.Asset), candles...)
+}
+
+func seriesTimeSeries(store *timeseries.Store, exchangeID, base, quote string, interval int64, asset string, start, end time.Time) ([]Candle, error) {
+	retCandle, err := store.CandlesInRange(timeSeriesSeries(exchangeID, base, quote, interval, asset), start, end)
+	if err != nil {
+		return nil, err
+	}
+	candles := make([]Candle, len(retCandle))
+	for x := range retCandle {
+		candles[x] = Candle{
+			Timestamp:        retCandle[x].Timestamp,
+			Open:             retCandle[x].Open,
+			High:             retCandle[x].High,
+			Low:              retCandle[x].Low,
+			Close:            retCandle[x].Close,
+			Volume:           retCandle[x].Volume,
+			SourceJobID:      retCandle[x].SourceJobID,
+			ValidationJobID:  retCandle[x].ValidationJobID,
+			ValidationIssues: retCandle[x].ValidationIssues,
 		}
 	}
-	return totalInserted, nil
+	return candles, nil
+}
+
+func timeSeriesSeries(exchangeID, base, quote string, interval int64, asset string) timeseries.CandleSeries {
+	return timeseries.CandleSeries{
+		Exchange: exchangeID,
+		Asset:    strings.ToLower(asset),
+		Base:     strings.ToUpper(base),
+		Quote:    strings.ToUpper(quote),
+		Interval: interval,
+	}
 }
 
 // InsertFromCSV load a CSV list of candle data and insert into database
@@ -13,6 +13,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/database"
 	"github.com/thrasher-corp/gocryptotrader/database/drivers"
 	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/symbolhistory"
 	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
 )
 
@@ -35,6 +36,7 @@ func TestMain(m *testing.M) {
 
 	var err error
 	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.MySQLTestDatabase = testhelpers.GetMySQLConnectionDetails()
 	testhelpers.TempDir, err = ioutil.TempDir("", "gct-temp")
 	if err != nil {
 		fmt.Printf("failed to create temp file: %v", err)
@@ -64,6 +66,11 @@ func TestInsert(t *testing.T) {
 			config: testhelpers.PostgresTestDatabase,
 			seedDB: seedDB,
 		},
+		{
+			name:   "mysql",
+			config: testhelpers.MySQLTestDatabase,
+			seedDB: seedDB,
+		},
 		{
 			name: "SQLite",
 			config: &database.Config{
@@ -72,6 +79,15 @@ func TestInsert(t *testing.T) {
 			},
 			seedDB: seedDB,
 		},
+		{
+			name: "SQLite time series file store",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb-timeseries"},
+				TimeSeries:        database.TimeSeriesConfig{Driver: database.TimeSeriesFileStore},
+			},
+			seedDB: seedDB,
+		},
 	}
 
 	for x := range testCases {
@@ -137,6 +153,11 @@ func TestInsertFromCSV(t *testing.T) {
 			config: testhelpers.PostgresTestDatabase,
 			seedDB: seedDB,
 		},
+		{
+			name:   "mysql",
+			config: testhelpers.MySQLTestDatabase,
+			seedDB: seedDB,
+		},
 		{
 			name: "SQLite",
 			config: &database.Config{
@@ -168,6 +189,7 @@ func TestInsertFromCSV(t *testing.T) {
 			}
 
 			exchange.ResetExchangeCache()
+			symbolhistory.ResetRenameCache()
 			testFile := filepath.Join("..", "..", "..", "testdata", "binance_BTCUSDT_24h_2019_01_01_2020_01_01.csv")
 			count, err := InsertFromCSV(testExchanges[0].Name, "BTC", "USDT", 86400, "spot", testFile)
 			if err != nil {
@@ -198,6 +220,11 @@ func TestSeries(t *testing.T) {
 			config: testhelpers.PostgresTestDatabase,
 			seedDB: seedDB,
 		},
+		{
+			name:   "mysql",
+			config: testhelpers.MySQLTestDatabase,
+			seedDB: seedDB,
+		},
 		{
 			name: "SQLite",
 			config: &database.Config{
@@ -206,6 +233,15 @@ func TestSeries(t *testing.T) {
 			},
 			seedDB: seedDB,
 		},
+		{
+			name: "SQLite time series file store",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb-timeseries"},
+				TimeSeries:        database.TimeSeriesConfig{Driver: database.TimeSeriesFileStore},
+			},
+			seedDB: seedDB,
+		},
 	}
 
 	for x := range testCases {
@@ -241,11 +277,58 @@ func TestSeries(t *testing.T) {
 				t.Errorf("unexpected number of results received:  %v", len(ret.Candles))
 			}
 
+			series, err := ListSeries()
+			if !errors.Is(err, nil) {
+				t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+			}
+			if len(series) != 1 {
+				t.Fatalf("received: '%v' but expected: '%v'", len(series), 1)
+			}
+			if series[0].Exchange != testExchanges[0].Name ||
+				series[0].Base != "BTC" ||
+				series[0].Interval != 86400 ||
+				!series[0].Oldest.Equal(start) {
+				t.Errorf("unexpected series %+v", series[0])
+			}
+
 			ret, err = Series("", "", "", 0, "", start, end)
 			if !errors.Is(err, errInvalidInput) {
 				t.Fatal(err)
 			}
 
+			// candles stored before a rename are returned under the new symbol
+			history, err := symbolhistory.Setup(dbConn)
+			if err != nil {
+				t.Fatal(err)
+			}
+			renameDate := time.Date(2019, 7, 1, 0, 0, 0, 0, time.UTC)
+			err = history.Insert(&symbolhistory.Event{
+				Exchange:      testExchanges[0].Name,
+				Asset:         "spot",
+				Base:          "BTC",
+				Quote:         "USDT",
+				Event:         symbolhistory.Renamed,
+				NewBase:       "XBT",
+				NewQuote:      "USDT",
+				EffectiveDate: renameDate,
+			})
+			if err != nil {
+				t.Fatal(err)
+			}
+			ret, err = Series(testExchanges[0].Name,
+				"XBT", "USDT",
+				86400, "spot",
+				start, end)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if len(ret.Candles) != 181 {
+				t.Errorf("received: '%v' but expected: '%v'", len(ret.Candles), 181)
+			}
+			if ret.Base != "XBT" || !ret.Candles[len(ret.Candles)-1].Timestamp.Before(renameDate) {
+				t.Errorf("unexpected stitched series %v %v", ret.Base, ret.Candles[len(ret.Candles)-1].Timestamp)
+			}
+
 			ret, err = Series(testExchanges[0].Name,
 				"BTC", "MOON",
 				864000, "spot",
@@ -274,6 +357,7 @@ func seedDB(includeOHLCVData bool) error {
 
 	if includeOHLCVData {
 		exchange.ResetExchangeCache()
+		symbolhistory.ResetRenameCache()
 		data, err := genOHCLVData()
 		if err != nil {
 			return err
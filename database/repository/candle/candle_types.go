@@ -3,16 +3,38 @@ package candle
 import (
 	"errors"
 	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/volatiletech/null"
 )
 
 var (
+	// candleColumns are the columns of candleRow, they are selected by name
+	// as SQLite declares the candle columns capitalised
+	candleColumns = []string{
+		"id",
+		"exchange_name_id",
+		"base",
+		"quote",
+		"interval",
+		"timestamp",
+		"open",
+		"high",
+		"low",
+		"close",
+		"volume",
+		"asset",
+		"source_job_id",
+		"validation_job_id",
+		"validation_issues",
+	}
 	errInvalidInput = errors.New("exchange, base, quote, asset, interval, start & end cannot be empty")
 	errNoCandleData = errors.New("no candle data provided")
 	// ErrNoCandleDataFound returns when no candle data is found
 	ErrNoCandleDataFound = errors.New("no candle data found")
 )
 
-// Item generic candle holder for modelPSQL & modelSQLite
+// Item generic candle holder for all database drivers
 type Item struct {
 	ID         string
 	ExchangeID string
@@ -35,3 +57,45 @@ type Candle struct {
 	ValidationJobID  string
 	ValidationIssues string
 }
+
+// StoredSeries identifies a stored candle series and the timestamp of its
+// oldest candle
+type StoredSeries struct {
+	ExchangeID string
+	Exchange   string
+	Base       string
+	Quote      string
+	Interval   int64
+	Asset      string
+	Oldest     time.Time
+}
+
+// seriesRow is a stored candle series along with the timestamp of its
+// oldest candle
+type seriesRow struct {
+	ExchangeNameID string               `boil:"exchange_name_id"`
+	Base           string               `boil:"base"`
+	Quote          string               `boil:"quote"`
+	Interval       int64                `boil:"interval"`
+	Asset          string               `boil:"asset"`
+	Oldest         repository.Timestamp `boil:"oldest"`
+}
+
+// candleRow is a candle as stored by every driver
+type candleRow struct {
+	ID               string      `boil:"id"`
+	ExchangeNameID   string      `boil:"exchange_name_id"`
+	Base             string      `boil:"base"`
+	Quote            string      `boil:"quote"`
+	Interval         int64       `boil:"interval"`
+	Timestamp        time.Time   `boil:"timestamp"`
+	Open             float64     `boil:"open"`
+	High             float64     `boil:"high"`
+	Low              float64     `boil:"low"`
+	Close            float64     `boil:"close"`
+	Volume           float64     `boil:"volume"`
+	Asset            string      `boil:"asset"`
+	SourceJobID      null.String `boil:"source_job_id"`
+	ValidationJobID  null.String `boil:"validation_job_id"`
+	ValidationIssues null.String `boil:"validation_issues"`
+}
@@ -3,14 +3,17 @@ package datahistoryjob
 import (
 	"context"
 	"database/sql"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
 
+	"github.com/gofrs/uuid"
 	"github.com/thrasher-corp/gocryptotrader/database"
-	"github.com/thrasher-corp/gocryptotrader/database/models/postgres"
 	"github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
 	"github.com/thrasher-corp/gocryptotrader/database/repository/datahistoryjobresult"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
 	"github.com/thrasher-corp/gocryptotrader/log"
 	"github.com/thrasher-corp/sqlboiler/boil"
 	"github.com/thrasher-corp/sqlboiler/queries/qm"
@@ -56,8 +59,8 @@ func (db *DBService) Upsert(jobs ...*DataHistoryJob) error {
 	switch db.driver {
 	case database.DBSQLite3, database.DBSQLite:
 		err = upsertSqlite(ctx, tx, jobs...)
-	case database.DBPostgreSQL:
-		err = upsertPostgres(ctx, tx, jobs...)
+	case database.DBPostgreSQL, database.DBMySQL:
+		err = upsert(ctx, tx, db.driver, jobs...)
 	default:
 		return database.ErrNoDatabaseProvided
 	}
@@ -73,8 +76,8 @@ func (db *DBService) GetByNickName(nickname string) (*DataHistoryJob, error) {
 	switch db.driver {
 	case database.DBSQLite3, database.DBSQLite:
 		return db.getByNicknameSQLite(nickname)
-	case database.DBPostgreSQL:
-		return db.getByNicknamePostgres(nickname)
+	case database.DBPostgreSQL, database.DBMySQL:
+		return db.getByNickname(nickname)
 	default:
 		return nil, database.ErrNoDatabaseProvided
 	}
@@ -85,8 +88,8 @@ func (db *DBService) GetByID(id string) (*DataHistoryJob, error) {
 	switch db.driver {
 	case database.DBSQLite3, database.DBSQLite:
 		return db.getByIDSQLite(id)
-	case database.DBPostgreSQL:
-		return db.getByIDPostgres(id)
+	case database.DBPostgreSQL, database.DBMySQL:
+		return db.getByID(id)
 	default:
 		return nil, database.ErrNoDatabaseProvided
 	}
@@ -97,8 +100,8 @@ func (db *DBService) GetJobsBetween(startDate, endDate time.Time) ([]DataHistory
 	switch db.driver {
 	case database.DBSQLite3, database.DBSQLite:
 		return db.getJobsBetweenSQLite(startDate, endDate)
-	case database.DBPostgreSQL:
-		return db.getJobsBetweenPostgres(startDate, endDate)
+	case database.DBPostgreSQL, database.DBMySQL:
+		return db.getJobsBetween(startDate, endDate)
 	default:
 		return nil, database.ErrNoDatabaseProvided
 	}
@@ -109,8 +112,8 @@ func (db *DBService) GetAllIncompleteJobsAndResults() ([]DataHistoryJob, error)
 	switch db.driver {
 	case database.DBSQLite3, database.DBSQLite:
 		return db.getAllIncompleteJobsAndResultsSQLite()
-	case database.DBPostgreSQL:
-		return db.getAllIncompleteJobsAndResultsPostgres()
+	case database.DBPostgreSQL, database.DBMySQL:
+		return db.getAllIncompleteJobsAndResults()
 	default:
 		return nil, database.ErrNoDatabaseProvided
 	}
@@ -121,8 +124,8 @@ func (db *DBService) GetJobAndAllResults(nickname string) (*DataHistoryJob, erro
 	switch db.driver {
 	case database.DBSQLite3, database.DBSQLite:
 		return db.getJobAndAllResultsSQLite(nickname)
-	case database.DBPostgreSQL:
-		return db.getJobAndAllResultsPostgres(nickname)
+	case database.DBPostgreSQL, database.DBMySQL:
+		return db.getJobAndAllResults(nickname)
 	default:
 		return nil, database.ErrNoDatabaseProvided
 	}
@@ -133,8 +136,8 @@ func (db *DBService) GetRelatedUpcomingJobs(nickname string) ([]*DataHistoryJob,
 	switch db.driver {
 	case database.DBSQLite3, database.DBSQLite:
 		return db.getRelatedUpcomingJobsSQLite(nickname)
-	case database.DBPostgreSQL:
-		return db.getRelatedUpcomingJobsPostgres(nickname)
+	case database.DBPostgreSQL, database.DBMySQL:
+		return db.getRelatedUpcomingJobs(nickname)
 	default:
 		return nil, database.ErrNoDatabaseProvided
 	}
@@ -146,8 +149,8 @@ func (db *DBService) GetPrerequisiteJob(nickname string) (*DataHistoryJob, error
 	switch db.driver {
 	case database.DBSQLite3, database.DBSQLite:
 		return db.getPrerequisiteJobSQLite(nickname)
-	case database.DBPostgreSQL:
-		return db.getPrerequisiteJobPostgres(nickname)
+	case database.DBPostgreSQL, database.DBMySQL:
+		return db.getPrerequisiteJob(nickname)
 	default:
 		return nil, database.ErrNoDatabaseProvided
 	}
@@ -176,8 +179,8 @@ func (db *DBService) SetRelationshipByID(prerequisiteJobID, followingJobID strin
 	switch db.driver {
 	case database.DBSQLite3, database.DBSQLite:
 		err = setRelationshipByIDSQLite(ctx, tx, prerequisiteJobID, followingJobID, status)
-	case database.DBPostgreSQL:
-		err = setRelationshipByIDPostgres(ctx, tx, prerequisiteJobID, followingJobID, status)
+	case database.DBPostgreSQL, database.DBMySQL:
+		err = setRelationshipByID(ctx, tx, db.driver, prerequisiteJobID, followingJobID, status)
 	default:
 		return database.ErrNoDatabaseProvided
 	}
@@ -211,8 +214,8 @@ func (db *DBService) SetRelationshipByNickname(prerequisiteNickname, followingNi
 	switch db.driver {
 	case database.DBSQLite3, database.DBSQLite:
 		err = setRelationshipByNicknameSQLite(ctx, tx, prerequisiteNickname, followingNickname, status)
-	case database.DBPostgreSQL:
-		err = setRelationshipByNicknamePostgres(ctx, tx, prerequisiteNickname, followingNickname, status)
+	case database.DBPostgreSQL, database.DBMySQL:
+		err = setRelationshipByNickname(ctx, tx, db.driver, prerequisiteNickname, followingNickname, status)
 	default:
 		return database.ErrNoDatabaseProvided
 	}
@@ -239,13 +242,20 @@ func upsertSqlite(ctx context.Context, tx *sql.Tx, jobs ...*DataHistoryJob) erro
 			}
 		}
 
-		var overwrite, replaceOnIssue int64
+		var overwrite, replaceOnIssue, backfill int64
 		if jobs[i].OverwriteData {
 			overwrite = 1
 		}
 		if jobs[i].ReplaceOnIssue {
 			replaceOnIssue = 1
 		}
+		if jobs[i].BackfillToInception {
+			backfill = 1
+		}
+		var nextRun null.String
+		if !jobs[i].NextRunDate.IsZero() {
+			nextRun = null.String{String: jobs[i].NextRunDate.UTC().Format(time.RFC3339), Valid: true}
+		}
 		var tempEvent = sqlite3.Datahistoryjob{
 			ID:                       jobs[i].ID,
 			ExchangeNameID:           exch.ID,
@@ -267,6 +277,13 @@ func upsertSqlite(ctx context.Context, tx *sql.Tx, jobs ...*DataHistoryJob) erro
 			DecimalPlaceComparison:   null.Int64{Int64: jobs[i].DecimalPlaceComparison, Valid: jobs[i].DecimalPlaceComparison > 0},
 			ReplaceOnIssue:           null.Int64{Int64: replaceOnIssue, Valid: replaceOnIssue == 1},
 			IssueTolerancePercentage: null.Float64{Float64: jobs[i].IssueTolerancePercentage, Valid: jobs[i].IssueTolerancePercentage > 0},
+			RecurrenceInterval:       null.Float64{Float64: float64(jobs[i].RecurrenceInterval), Valid: jobs[i].RecurrenceInterval > 0},
+			SettleDelay:              null.Float64{Float64: float64(jobs[i].SettleDelay), Valid: jobs[i].SettleDelay > 0},
+			RollingWindow:            null.Float64{Float64: float64(jobs[i].RollingWindow), Valid: jobs[i].RollingWindow > 0},
+			BackfillToInception:      null.Int64{Int64: backfill, Valid: backfill == 1},
+			NextRunTime:              nextRun,
+			BarType:                  null.String{String: jobs[i].BarType, Valid: jobs[i].BarType != ""},
+			BarThreshold:             null.Float64{Float64: jobs[i].BarThreshold, Valid: jobs[i].BarThreshold > 0},
 		}
 		if secondaryExch != nil {
 			tempEvent.SecondaryExchangeID = null.String{String: secondaryExch.ID, Valid: true}
@@ -281,26 +298,39 @@ func upsertSqlite(ctx context.Context, tx *sql.Tx, jobs ...*DataHistoryJob) erro
 	return nil
 }
 
-func upsertPostgres(ctx context.Context, tx *sql.Tx, jobs ...*DataHistoryJob) error {
+func upsert(ctx context.Context, tx *sql.Tx, driver string, jobs ...*DataHistoryJob) error {
+	d, err := repository.NewDialect(driver)
+	if err != nil {
+		return err
+	}
 	for i := range jobs {
-		exch, err := postgres.Exchanges(
-			qm.Where("name = ?", strings.ToLower(jobs[i].ExchangeName))).One(ctx, tx)
+		var exch exchange.Details
+		exch, err = exchange.OneByName(ctx, tx, d.Driver(), jobs[i].ExchangeName)
 		if err != nil {
 			return fmt.Errorf("could not retrieve exchange '%v', %w", jobs[i].ExchangeName, err)
 		}
-		var secondaryExch *postgres.Exchange
+		var secondaryExchangeID null.String
 		if jobs[i].SecondarySourceExchangeName != "" {
-			secondaryExch, err = postgres.Exchanges(
-				qm.Where("name = ?", strings.ToLower(jobs[i].SecondarySourceExchangeName))).One(ctx, tx)
+			var secondaryExch exchange.Details
+			secondaryExch, err = exchange.OneByName(ctx, tx, d.Driver(), jobs[i].SecondarySourceExchangeName)
 			if err != nil {
 				return fmt.Errorf("could not retrieve secondary exchange '%v', %w", jobs[i].SecondarySourceExchangeName, err)
 			}
+			secondaryExchangeID = null.String{String: secondaryExch.UUID.String(), Valid: true}
+		}
+		if jobs[i].ID == "" {
+			var freshUUID uuid.UUID
+			freshUUID, err = uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			jobs[i].ID = freshUUID.String()
 		}
 
-		var tempEvent = postgres.Datahistoryjob{
+		var tempEvent = jobRow{
 			ID:                       jobs[i].ID,
 			Nickname:                 strings.ToLower(jobs[i].Nickname),
-			ExchangeNameID:           exch.ID,
+			ExchangeNameID:           exch.UUID.String(),
 			Asset:                    strings.ToLower(jobs[i].Asset),
 			Base:                     strings.ToUpper(jobs[i].Base),
 			Quote:                    strings.ToUpper(jobs[i].Quote),
@@ -316,13 +346,18 @@ func upsertPostgres(ctx context.Context, tx *sql.Tx, jobs ...*DataHistoryJob) er
 			ConversionInterval:       null.Float64{Float64: float64(jobs[i].ConversionInterval), Valid: jobs[i].ConversionInterval > 0},
 			OverwriteData:            null.Bool{Bool: jobs[i].OverwriteData, Valid: jobs[i].OverwriteData},
 			DecimalPlaceComparison:   null.Int{Int: int(jobs[i].DecimalPlaceComparison), Valid: jobs[i].DecimalPlaceComparison > 0},
+			SecondaryExchangeID:      secondaryExchangeID,
 			ReplaceOnIssue:           null.Bool{Bool: jobs[i].ReplaceOnIssue, Valid: jobs[i].ReplaceOnIssue},
 			IssueTolerancePercentage: null.Float64{Float64: jobs[i].IssueTolerancePercentage, Valid: jobs[i].IssueTolerancePercentage > 0},
+			RecurrenceInterval:       null.Float64{Float64: float64(jobs[i].RecurrenceInterval), Valid: jobs[i].RecurrenceInterval > 0},
+			SettleDelay:              null.Float64{Float64: float64(jobs[i].SettleDelay), Valid: jobs[i].SettleDelay > 0},
+			RollingWindow:            null.Float64{Float64: float64(jobs[i].RollingWindow), Valid: jobs[i].RollingWindow > 0},
+			BackfillToInception:      null.Bool{Bool: jobs[i].BackfillToInception, Valid: jobs[i].BackfillToInception},
+			NextRunTime:              null.Time{Time: jobs[i].NextRunDate.UTC(), Valid: !jobs[i].NextRunDate.IsZero()},
+			BarType:                  null.String{String: jobs[i].BarType, Valid: jobs[i].BarType != ""},
+			BarThreshold:             null.Float64{Float64: jobs[i].BarThreshold, Valid: jobs[i].BarThreshold > 0},
 		}
-		if secondaryExch != nil {
-			tempEvent.SecondaryExchangeID = null.String{String: secondaryExch.ID, Valid: true}
-		}
-		err = tempEvent.Upsert(ctx, tx, true, []string{"nickname"}, boil.Infer(), boil.Infer())
+		err = d.Upsert(ctx, tx, "datahistoryjob", []string{"nickname"}, &tempEvent)
 		if err != nil {
 			return err
 		}
@@ -330,6 +365,7 @@ func upsertPostgres(ctx context.Context, tx *sql.Tx, jobs ...*DataHistoryJob) er
 
 	return nil
 }
+
 func (db *DBService) getByNicknameSQLite(nickname string) (*DataHistoryJob, error) {
 	result, err := sqlite3.Datahistoryjobs(qm.Where("nickname = ?", strings.ToLower(nickname))).One(context.Background(), db.sql)
 	if err != nil {
@@ -339,13 +375,8 @@ func (db *DBService) getByNicknameSQLite(nickname string) (*DataHistoryJob, erro
 	return db.createSQLiteDataHistoryJobResponse(result)
 }
 
-func (db *DBService) getByNicknamePostgres(nickname string) (*DataHistoryJob, error) {
-	query := postgres.Datahistoryjobs(qm.Where("nickname = ?", strings.ToLower(nickname)))
-	result, err := query.One(context.Background(), db.sql)
-	if err != nil {
-		return nil, err
-	}
-	return db.createPostgresDataHistoryJobResponse(result)
+func (db *DBService) getByNickname(nickname string) (*DataHistoryJob, error) {
+	return db.getOne(false, qm.Where("nickname = ?", strings.ToLower(nickname)))
 }
 
 func (db *DBService) getByIDSQLite(id string) (*DataHistoryJob, error) {
@@ -357,14 +388,8 @@ func (db *DBService) getByIDSQLite(id string) (*DataHistoryJob, error) {
 	return db.createSQLiteDataHistoryJobResponse(result)
 }
 
-func (db *DBService) getByIDPostgres(id string) (*DataHistoryJob, error) {
-	query := postgres.Datahistoryjobs(qm.Where("id = ?", id))
-	result, err := query.One(context.Background(), db.sql)
-	if err != nil {
-		return nil, err
-	}
-
-	return db.createPostgresDataHistoryJobResponse(result)
+func (db *DBService) getByID(id string) (*DataHistoryJob, error) {
+	return db.getOne(false, qm.Where("id = ?", id))
 }
 
 func (db *DBService) getJobsBetweenSQLite(startDate, endDate time.Time) ([]DataHistoryJob, error) {
@@ -386,23 +411,8 @@ func (db *DBService) getJobsBetweenSQLite(startDate, endDate time.Time) ([]DataH
 	return jobs, nil
 }
 
-func (db *DBService) getJobsBetweenPostgres(startDate, endDate time.Time) ([]DataHistoryJob, error) {
-	var jobs []DataHistoryJob
-	query := postgres.Datahistoryjobs(qm.Where("created BETWEEN ? AND  ? ", startDate, endDate))
-	results, err := query.All(context.Background(), db.sql)
-	if err != nil {
-		return jobs, err
-	}
-
-	for i := range results {
-		job, err := db.createPostgresDataHistoryJobResponse(results[i])
-		if err != nil {
-			return nil, err
-		}
-		jobs = append(jobs, *job)
-	}
-
-	return jobs, nil
+func (db *DBService) getJobsBetween(startDate, endDate time.Time) ([]DataHistoryJob, error) {
+	return db.getAll(false, qm.Where("created BETWEEN ? AND ?", startDate.UTC(), endDate.UTC()))
 }
 
 func (db *DBService) getJobAndAllResultsSQLite(nickname string) (*DataHistoryJob, error) {
@@ -418,16 +428,8 @@ func (db *DBService) getJobAndAllResultsSQLite(nickname string) (*DataHistoryJob
 	return db.createSQLiteDataHistoryJobResponse(result)
 }
 
-func (db *DBService) getJobAndAllResultsPostgres(nickname string) (*DataHistoryJob, error) {
-	query := postgres.Datahistoryjobs(
-		qm.Load(postgres.DatahistoryjobRels.JobDatahistoryjobresults),
-		qm.Where("nickname = ?", strings.ToLower(nickname)))
-	result, err := query.One(context.Background(), db.sql)
-	if err != nil {
-		return nil, err
-	}
-
-	return db.createPostgresDataHistoryJobResponse(result)
+func (db *DBService) getJobAndAllResults(nickname string) (*DataHistoryJob, error) {
+	return db.getOne(true, qm.Where("nickname = ?", strings.ToLower(nickname)))
 }
 
 func (db *DBService) getAllIncompleteJobsAndResultsSQLite() ([]DataHistoryJob, error) {
@@ -453,25 +455,8 @@ func (db *DBService) getAllIncompleteJobsAndResultsSQLite() ([]DataHistoryJob, e
 	return jobs, nil
 }
 
-func (db *DBService) getAllIncompleteJobsAndResultsPostgres() ([]DataHistoryJob, error) {
-	query := postgres.Datahistoryjobs(
-		qm.Load(postgres.DatahistoryjobRels.JobDatahistoryjobresults),
-		qm.Where("status = ?", 0))
-	results, err := query.All(context.Background(), db.sql)
-	if err != nil {
-		return nil, err
-	}
-
-	var jobs []DataHistoryJob
-	for i := range results {
-		job, err := db.createPostgresDataHistoryJobResponse(results[i])
-		if err != nil {
-			return nil, err
-		}
-		jobs = append(jobs, *job)
-	}
-
-	return jobs, nil
+func (db *DBService) getAllIncompleteJobsAndResults() ([]DataHistoryJob, error) {
+	return db.getAll(true, qm.Where("status = ?", 0))
 }
 
 func (db *DBService) getRelatedUpcomingJobsSQLite(nickname string) ([]*DataHistoryJob, error) {
@@ -494,17 +479,25 @@ func (db *DBService) getRelatedUpcomingJobsSQLite(nickname string) ([]*DataHisto
 	return resp, nil
 }
 
-func (db *DBService) getRelatedUpcomingJobsPostgres(nickname string) ([]*DataHistoryJob, error) {
-	q := postgres.Datahistoryjobs(qm.Load(postgres.DatahistoryjobRels.JobDatahistoryjobs), qm.Where("nickname = ?", nickname))
-	jobWithRelations, err := q.One(context.Background(), db.sql)
+func (db *DBService) getRelatedUpcomingJobs(nickname string) ([]*DataHistoryJob, error) {
+	d, err := repository.NewDialect(db.driver)
+	if err != nil {
+		return nil, err
+	}
+	var results []jobRow
+	err = d.Query("datahistoryjob",
+		qm.Select("datahistoryjob.*"),
+		qm.InnerJoin("datahistoryjobrelations on datahistoryjob.id = datahistoryjobrelations.job_id"),
+		qm.InnerJoin("datahistoryjob prerequisite on prerequisite.id = datahistoryjobrelations.prerequisite_job_id"),
+		qm.Where("prerequisite.nickname = ?", nickname)).Bind(context.Background(), db.sql, &results)
 	if err != nil {
 		return nil, err
 	}
 	var response []*DataHistoryJob
-	for i := range jobWithRelations.R.JobDatahistoryjobs {
-		job, err := db.getByIDPostgres(jobWithRelations.R.JobDatahistoryjobs[i].ID)
+	for i := range results {
+		job, err := db.createDataHistoryJobResponse(d, &results[i], false)
 		if err != nil {
-			return nil, err
+			return nil, fmt.Errorf("could not return job %v: %w", results[i].Nickname, err)
 		}
 		response = append(response, job)
 	}
@@ -533,26 +526,12 @@ func setRelationshipByIDSQLite(ctx context.Context, tx *sql.Tx, prerequisiteJobI
 	return job.SetPrerequisiteJobDatahistoryjobs(ctx, tx, true, result)
 }
 
-func setRelationshipByIDPostgres(ctx context.Context, tx *sql.Tx, prerequisiteJobID, followingJobID string, status int64) error {
-	job, err := postgres.Datahistoryjobs(qm.Where("id = ?", followingJobID)).One(ctx, tx)
+func setRelationshipByID(ctx context.Context, tx *sql.Tx, driver, prerequisiteJobID, followingJobID string, status int64) error {
+	d, err := repository.NewDialect(driver)
 	if err != nil {
 		return err
 	}
-	job.Status = float64(status)
-	_, err = job.Update(ctx, tx, boil.Infer())
-	if err != nil {
-		return err
-	}
-
-	if prerequisiteJobID == "" {
-		return job.SetPrerequisiteJobDatahistoryjobs(ctx, tx, false)
-	}
-	result, err := postgres.Datahistoryjobs(qm.Where("id = ?", prerequisiteJobID)).One(ctx, tx)
-	if err != nil {
-		return err
-	}
-
-	return job.SetPrerequisiteJobDatahistoryjobs(ctx, tx, false, result)
+	return setRelationship(ctx, tx, d, "id", prerequisiteJobID, followingJobID, status)
 }
 
 func (db *DBService) getPrerequisiteJobSQLite(nickname string) (*DataHistoryJob, error) {
@@ -568,17 +547,12 @@ func (db *DBService) getPrerequisiteJobSQLite(nickname string) (*DataHistoryJob,
 	return db.createSQLiteDataHistoryJobResponse(job)
 }
 
-func (db *DBService) getPrerequisiteJobPostgres(nickname string) (*DataHistoryJob, error) {
-	job, err := postgres.Datahistoryjobs(qm.Where("nickname = ?", nickname)).One(context.Background(), db.sql)
-	if err != nil {
-		return nil, err
-	}
-	result, err := job.PrerequisiteJobDatahistoryjobs().One(context.Background(), db.sql)
-	if err != nil {
-		return nil, err
-	}
-
-	return db.createPostgresDataHistoryJobResponse(result)
+func (db *DBService) getPrerequisiteJob(nickname string) (*DataHistoryJob, error) {
+	return db.getOne(false,
+		qm.Select("datahistoryjob.*"),
+		qm.InnerJoin("datahistoryjobrelations on datahistoryjob.id = datahistoryjobrelations.prerequisite_job_id"),
+		qm.InnerJoin("datahistoryjob following on following.id = datahistoryjobrelations.job_id"),
+		qm.Where("following.nickname = ?", nickname))
 }
 
 func setRelationshipByNicknameSQLite(ctx context.Context, tx *sql.Tx, prerequisiteJobNickname, followingJobNickname string, status int64) error {
@@ -602,25 +576,42 @@ func setRelationshipByNicknameSQLite(ctx context.Context, tx *sql.Tx, prerequisi
 	return job.SetPrerequisiteJobDatahistoryjobs(ctx, tx, true, result)
 }
 
-func setRelationshipByNicknamePostgres(ctx context.Context, tx *sql.Tx, prerequisiteJobNickname, followingJobNickname string, status int64) error {
-	job, err := postgres.Datahistoryjobs(qm.Where("nickname = ?", followingJobNickname)).One(ctx, tx)
+func setRelationshipByNickname(ctx context.Context, tx *sql.Tx, driver, prerequisiteJobNickname, followingJobNickname string, status int64) error {
+	d, err := repository.NewDialect(driver)
 	if err != nil {
 		return err
 	}
-	job.Status = float64(status)
-	_, err = job.Update(ctx, tx, boil.Infer())
+	return setRelationship(ctx, tx, d, "nickname", prerequisiteJobNickname, followingJobNickname, status)
+}
+
+// setRelationship sets the status of the following job and replaces its
+// prerequisite, jobs are matched on the supplied column
+func setRelationship(ctx context.Context, tx *sql.Tx, d *repository.Dialect, column, prerequisite, following string, status int64) error {
+	var job jobRow
+	err := d.Query("datahistoryjob", qm.Where(column+" = ?", following)).Bind(ctx, tx, &job)
 	if err != nil {
 		return err
 	}
-
-	if prerequisiteJobNickname == "" {
-		return job.SetPrerequisiteJobDatahistoryjobs(ctx, tx, false)
+	_, err = d.Update(ctx, tx, "datahistoryjob", map[string]interface{}{"status": float64(status)}, qm.Where("id = ?", job.ID))
+	if err != nil {
+		return err
 	}
-	result, err := postgres.Datahistoryjobs(qm.Where("nickname = ?", prerequisiteJobNickname)).One(ctx, tx)
+	_, err = d.Delete(ctx, tx, "datahistoryjobrelations", qm.Where("job_id = ?", job.ID))
 	if err != nil {
 		return err
 	}
-	return job.SetPrerequisiteJobDatahistoryjobs(ctx, tx, false, result)
+	if prerequisite == "" {
+		return nil
+	}
+	var result jobRow
+	err = d.Query("datahistoryjob", qm.Where(column+" = ?", prerequisite)).Bind(ctx, tx, &result)
+	if err != nil {
+		return err
+	}
+	return d.Insert(ctx, tx, "datahistoryjobrelations", &relationRow{
+		PrerequisiteJobID: result.ID,
+		JobID:             job.ID,
+	})
 }
 
 // helpers
@@ -660,6 +651,13 @@ func (db *DBService) createSQLiteDataHistoryJobResponse(result *sqlite3.Datahist
 	if err != nil {
 		return nil, err
 	}
+	var nextRun time.Time
+	if result.NextRunTime.String != "" {
+		nextRun, err = time.Parse(time.RFC3339, result.NextRunTime.String)
+		if err != nil {
+			return nil, err
+		}
+	}
 
 	prereqJob, err := result.PrerequisiteJobDatahistoryjobs().One(context.Background(), db.sql)
 	if err != nil && err != sql.ErrNoRows {
@@ -725,55 +723,96 @@ func (db *DBService) createSQLiteDataHistoryJobResponse(result *sqlite3.Datahist
 		SecondarySourceExchangeName: secondaryExchangeName,
 		IssueTolerancePercentage:    result.IssueTolerancePercentage.Float64,
 		ReplaceOnIssue:              result.ReplaceOnIssue.Int64 == 1,
+		RecurrenceInterval:          int64(result.RecurrenceInterval.Float64),
+		SettleDelay:                 int64(result.SettleDelay.Float64),
+		RollingWindow:               int64(result.RollingWindow.Float64),
+		BackfillToInception:         result.BackfillToInception.Int64 == 1,
+		NextRunDate:                 nextRun,
+		BarType:                     result.BarType.String,
+		BarThreshold:                result.BarThreshold.Float64,
 		Results:                     jobResults,
 	}, nil
 }
 
-func (db *DBService) createPostgresDataHistoryJobResponse(result *postgres.Datahistoryjob) (*DataHistoryJob, error) {
-	var exchange *postgres.Exchange
-	var err error
-	if result.R != nil && result.R.ExchangeName != nil {
-		exchange = result.R.ExchangeName
-	} else {
-		exchange, err = result.ExchangeName().One(context.Background(), db.sql)
+// getOne returns the first job matching mods, optionally with its results
+func (db *DBService) getOne(withResults bool, mods ...qm.QueryMod) (*DataHistoryJob, error) {
+	d, err := repository.NewDialect(db.driver)
+	if err != nil {
+		return nil, err
+	}
+	var result jobRow
+	err = d.Query("datahistoryjob", append(mods, qm.Limit(1))...).Bind(context.Background(), db.sql, &result)
+	if err != nil {
+		return nil, err
+	}
+	return db.createDataHistoryJobResponse(d, &result, withResults)
+}
+
+// getAll returns every job matching mods, optionally with their results
+func (db *DBService) getAll(withResults bool, mods ...qm.QueryMod) ([]DataHistoryJob, error) {
+	d, err := repository.NewDialect(db.driver)
+	if err != nil {
+		return nil, err
+	}
+	var results []jobRow
+	err = d.Query("datahistoryjob", mods...).Bind(context.Background(), db.sql, &results)
+	if err != nil {
+		return nil, err
+	}
+	var jobs []DataHistoryJob
+	for i := range results {
+		job, err := db.createDataHistoryJobResponse(d, &results[i], withResults)
 		if err != nil {
-			return nil, fmt.Errorf("could not retrieve exchange '%v' %w", result.ExchangeNameID, err)
+			return nil, fmt.Errorf("could not return job %v: %w", results[i].Nickname, err)
 		}
+		jobs = append(jobs, *job)
+	}
+	return jobs, nil
+}
+
+func (db *DBService) createDataHistoryJobResponse(d *repository.Dialect, result *jobRow, withResults bool) (*DataHistoryJob, error) {
+	ctx := context.Background()
+	exch, err := exchange.OneByID(ctx, db.sql, d.Driver(), result.ExchangeNameID)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve exchange '%v' %w", result.ExchangeNameID, err)
 	}
 
 	var secondaryExchangeName string
 	if result.SecondaryExchangeID.String != "" {
-		var secondaryExchangeResult *postgres.Exchange
-		secondaryExchangeResult, err = result.SecondaryExchange().One(context.Background(), db.sql)
+		var secondaryExchange exchange.Details
+		secondaryExchange, err = exchange.OneByID(ctx, db.sql, d.Driver(), result.SecondaryExchangeID.String)
 		if err != nil {
 			return nil, fmt.Errorf("could not retrieve secondary exchange '%v' %w", result.SecondaryExchangeID, err)
 		}
-		if secondaryExchangeResult != nil {
-			secondaryExchangeName = secondaryExchangeResult.Name
-		}
+		secondaryExchangeName = secondaryExchange.Name
 	}
 
-	prereqJob, err := result.PrerequisiteJobDatahistoryjobs().One(context.Background(), db.sql)
-	if err != nil && err != sql.ErrNoRows {
+	var prereqJob jobRow
+	err = d.Query("datahistoryjob",
+		qm.Select("datahistoryjob.*"),
+		qm.InnerJoin("datahistoryjobrelations on datahistoryjob.id = datahistoryjobrelations.prerequisite_job_id"),
+		qm.Where("datahistoryjobrelations.job_id = ?", result.ID),
+		qm.Limit(1)).Bind(ctx, db.sql, &prereqJob)
+	if err != nil && !errors.Is(err, sql.ErrNoRows) {
 		return nil, err
 	}
-	var prereqNickname, prereqID string
-	if prereqJob != nil {
-		prereqID = prereqJob.ID
-		prereqNickname = prereqJob.Nickname
-	}
 
 	var jobResults []*datahistoryjobresult.DataHistoryJobResult
-	if result.R != nil {
-		for i := range result.R.JobDatahistoryjobresults {
+	if withResults {
+		var results []jobResultRow
+		err = d.Query("datahistoryjobresult", qm.Where("job_id = ?", result.ID)).Bind(ctx, db.sql, &results)
+		if err != nil {
+			return nil, err
+		}
+		for i := range results {
 			jobResults = append(jobResults, &datahistoryjobresult.DataHistoryJobResult{
-				ID:                result.R.JobDatahistoryjobresults[i].ID,
-				JobID:             result.R.JobDatahistoryjobresults[i].JobID,
-				IntervalStartDate: result.R.JobDatahistoryjobresults[i].IntervalStartTime,
-				IntervalEndDate:   result.R.JobDatahistoryjobresults[i].IntervalEndTime,
-				Status:            int64(result.R.JobDatahistoryjobresults[i].Status),
-				Result:            result.R.JobDatahistoryjobresults[i].Result.String,
-				Date:              result.R.JobDatahistoryjobresults[i].RunTime,
+				ID:                results[i].ID,
+				JobID:             results[i].JobID,
+				IntervalStartDate: results[i].IntervalStartTime,
+				IntervalEndDate:   results[i].IntervalEndTime,
+				Status:            int64(results[i].Status),
+				Result:            results[i].Result.String,
+				Date:              results[i].RunTime,
 			})
 		}
 	}
@@ -781,8 +820,8 @@ func (db *DBService) createPostgresDataHistoryJobResponse(result *postgres.Datah
 	return &DataHistoryJob{
 		ID:                          result.ID,
 		Nickname:                    result.Nickname,
-		ExchangeID:                  exchange.ID,
-		ExchangeName:                exchange.Name,
+		ExchangeID:                  exch.UUID.String(),
+		ExchangeName:                exch.Name,
 		Asset:                       result.Asset,
 		Base:                        result.Base,
 		Quote:                       result.Quote,
@@ -796,13 +835,20 @@ func (db *DBService) createPostgresDataHistoryJobResponse(result *postgres.Datah
 		Status:                      int64(result.Status),
 		CreatedDate:                 result.Created,
 		Results:                     jobResults,
-		PrerequisiteJobID:           prereqID,
-		PrerequisiteJobNickname:     prereqNickname,
+		PrerequisiteJobID:           prereqJob.ID,
+		PrerequisiteJobNickname:     prereqJob.Nickname,
 		ConversionInterval:          int64(result.ConversionInterval.Float64),
 		OverwriteData:               result.OverwriteData.Bool,
 		DecimalPlaceComparison:      int64(result.DecimalPlaceComparison.Int),
 		SecondarySourceExchangeName: secondaryExchangeName,
 		IssueTolerancePercentage:    result.IssueTolerancePercentage.Float64,
 		ReplaceOnIssue:              result.ReplaceOnIssue.Bool,
+		RecurrenceInterval:          int64(result.RecurrenceInterval.Float64),
+		SettleDelay:                 int64(result.SettleDelay.Float64),
+		RollingWindow:               int64(result.RollingWindow.Float64),
+		BackfillToInception:         result.BackfillToInception.Bool,
+		NextRunDate:                 result.NextRunTime.Time,
+		BarType:                     result.BarType.String,
+		BarThreshold:                result.BarThreshold.Float64,
 	}, nil
 }
@@ -37,6 +37,7 @@ func TestMain(m *testing.M) {
 	}
 	var err error
 	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.MySQLTestDatabase = testhelpers.GetMySQLConnectionDetails()
 	testhelpers.TempDir, err = ioutil.TempDir("", "gct-temp")
 	if err != nil {
 		log.Fatal(err)
@@ -80,6 +81,11 @@ func TestDataHistoryJob(t *testing.T) {
 			config: testhelpers.PostgresTestDatabase,
 			seedDB: seedDB,
 		},
+		{
+			name:   "mysql",
+			config: testhelpers.MySQLTestDatabase,
+			seedDB: seedDB,
+		},
 		{
 			name: "SQLite",
 			config: &database.Config{
@@ -151,6 +157,13 @@ func TestDataHistoryJob(t *testing.T) {
 				}
 				if i == 19 {
 					j.Status = 1
+					j.RecurrenceInterval = int64(time.Hour)
+					j.SettleDelay = int64(time.Minute)
+					j.RollingWindow = int64(time.Hour * 24)
+					j.BackfillToInception = true
+					j.NextRunDate = time.Date(2021, 11, 1, 12, 0, 0, 0, time.UTC)
+					j.BarType = "dollar"
+					j.BarThreshold = 1000000
 				}
 				jerberoos = append(jerberoos, j)
 			}
@@ -171,6 +184,16 @@ func TestDataHistoryJob(t *testing.T) {
 			if !strings.EqualFold(resp.Nickname, "TestDataHistoryJob19") {
 				t.Fatal("the database no longer functions")
 			}
+			if resp.RecurrenceInterval != int64(time.Hour) ||
+				resp.SettleDelay != int64(time.Minute) ||
+				resp.RollingWindow != int64(time.Hour*24) ||
+				!resp.BackfillToInception ||
+				!resp.NextRunDate.Equal(jerberoos[19].NextRunDate) {
+				t.Errorf("schedule not persisted, received %+v", resp)
+			}
+			if resp.BarType != "dollar" || resp.BarThreshold != 1000000 {
+				t.Errorf("bar settings not persisted, received %+v", resp)
+			}
 
 			results, err := db.GetAllIncompleteJobsAndResults()
 			if !errors.Is(err, nil) {
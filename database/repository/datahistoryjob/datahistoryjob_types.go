@@ -6,6 +6,7 @@ import (
 
 	"github.com/thrasher-corp/gocryptotrader/database"
 	"github.com/thrasher-corp/gocryptotrader/database/repository/datahistoryjobresult"
+	"github.com/volatiletech/null"
 )
 
 var errCannotSetSamePrerequisite = errors.New("prerequisite job cannot be the same as the following job")
@@ -37,6 +38,13 @@ type DataHistoryJob struct {
 	SecondarySourceExchangeName string
 	IssueTolerancePercentage    float64
 	ReplaceOnIssue              bool
+	RecurrenceInterval          int64
+	SettleDelay                 int64
+	RollingWindow               int64
+	BackfillToInception         bool
+	NextRunDate                 time.Time
+	BarType                     string
+	BarThreshold                float64
 }
 
 // DBService is a service which allows the interaction with
@@ -59,3 +67,53 @@ type IDBService interface {
 	SetRelationshipByID(string, string, int64) error
 	SetRelationshipByNickname(string, string, int64) error
 }
+
+// jobRow is a data history job as stored by drivers sharing a schema
+type jobRow struct {
+	ID                       string       `boil:"id"`
+	Nickname                 string       `boil:"nickname"`
+	ExchangeNameID           string       `boil:"exchange_name_id"`
+	Asset                    string       `boil:"asset"`
+	Base                     string       `boil:"base"`
+	Quote                    string       `boil:"quote"`
+	StartTime                time.Time    `boil:"start_time"`
+	EndTime                  time.Time    `boil:"end_time"`
+	DataType                 float64      `boil:"data_type"`
+	Interval                 float64      `boil:"interval"`
+	RequestSize              float64      `boil:"request_size"`
+	MaxRetries               float64      `boil:"max_retries"`
+	BatchCount               float64      `boil:"batch_count"`
+	Status                   float64      `boil:"status"`
+	Created                  time.Time    `boil:"created"`
+	ConversionInterval       null.Float64 `boil:"conversion_interval"`
+	OverwriteData            null.Bool    `boil:"overwrite_data"`
+	DecimalPlaceComparison   null.Int     `boil:"decimal_place_comparison"`
+	SecondaryExchangeID      null.String  `boil:"secondary_exchange_id"`
+	IssueTolerancePercentage null.Float64 `boil:"issue_tolerance_percentage"`
+	ReplaceOnIssue           null.Bool    `boil:"replace_on_issue"`
+	RecurrenceInterval       null.Float64 `boil:"recurrence_interval"`
+	SettleDelay              null.Float64 `boil:"settle_delay"`
+	RollingWindow            null.Float64 `boil:"rolling_window"`
+	BackfillToInception      null.Bool    `boil:"backfill_to_inception"`
+	NextRunTime              null.Time    `boil:"next_run_time"`
+	BarType                  null.String  `boil:"bar_type"`
+	BarThreshold             null.Float64 `boil:"bar_threshold"`
+}
+
+// jobResultRow is a data history job result as stored by drivers sharing a
+// schema
+type jobResultRow struct {
+	ID                string      `boil:"id"`
+	JobID             string      `boil:"job_id"`
+	Result            null.String `boil:"result"`
+	Status            float64     `boil:"status"`
+	IntervalStartTime time.Time   `boil:"interval_start_time"`
+	IntervalEndTime   time.Time   `boil:"interval_end_time"`
+	RunTime           time.Time   `boil:"run_time"`
+}
+
+// relationRow links a job to the prerequisite job which must complete first
+type relationRow struct {
+	PrerequisiteJobID string `boil:"prerequisite_job_id"`
+	JobID             string `boil:"job_id"`
+}
@@ -8,8 +8,8 @@ import (
 
 	"github.com/gofrs/uuid"
 	"github.com/thrasher-corp/gocryptotrader/database"
-	"github.com/thrasher-corp/gocryptotrader/database/models/postgres"
 	"github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
 	"github.com/thrasher-corp/gocryptotrader/log"
 	"github.com/thrasher-corp/sqlboiler/boil"
 	"github.com/thrasher-corp/sqlboiler/queries/qm"
@@ -58,8 +58,8 @@ func (db *DBService) Upsert(jobs ...*DataHistoryJobResult) error {
 	switch db.driver {
 	case database.DBSQLite3, database.DBSQLite:
 		err = upsertSqlite(ctx, tx, jobs...)
-	case database.DBPostgreSQL:
-		err = upsertPostgres(ctx, tx, jobs...)
+	case database.DBPostgreSQL, database.DBMySQL:
+		err = upsert(ctx, tx, db.driver, jobs...)
 	default:
 		return database.ErrNoDatabaseProvided
 	}
@@ -77,8 +77,8 @@ func (db *DBService) GetByJobID(jobID string) ([]DataHistoryJobResult, error) {
 	switch db.driver {
 	case database.DBSQLite3, database.DBSQLite:
 		job, err = db.getByJobIDSQLite(jobID)
-	case database.DBPostgreSQL:
-		job, err = db.getByJobIDPostgres(jobID)
+	case database.DBPostgreSQL, database.DBMySQL:
+		job, err = db.getByJobID(jobID)
 	default:
 		return nil, database.ErrNoDatabaseProvided
 	}
@@ -95,8 +95,8 @@ func (db *DBService) GetJobResultsBetween(jobID string, startDate, endDate time.
 	switch db.driver {
 	case database.DBSQLite3, database.DBSQLite:
 		jobs, err = db.getJobResultsBetweenSQLite(jobID, startDate, endDate)
-	case database.DBPostgreSQL:
-		jobs, err = db.getJobResultsBetweenPostgres(jobID, startDate, endDate)
+	case database.DBPostgreSQL, database.DBMySQL:
+		jobs, err = db.getJobResultsBetween(jobID, startDate, endDate)
 	default:
 		return nil, database.ErrNoDatabaseProvided
 	}
@@ -134,8 +134,11 @@ func upsertSqlite(ctx context.Context, tx *sql.Tx, results ...*DataHistoryJobRes
 	return nil
 }
 
-func upsertPostgres(ctx context.Context, tx *sql.Tx, results ...*DataHistoryJobResult) error {
-	var err error
+func upsert(ctx context.Context, tx *sql.Tx, driver string, results ...*DataHistoryJobResult) error {
+	d, err := repository.NewDialect(driver)
+	if err != nil {
+		return err
+	}
 	for i := range results {
 		if results[i].ID == "" {
 			var freshUUID uuid.UUID
@@ -145,7 +148,7 @@ func upsertPostgres(ctx context.Context, tx *sql.Tx, results ...*DataHistoryJobR
 			}
 			results[i].ID = freshUUID.String()
 		}
-		var tempEvent = postgres.Datahistoryjobresult{
+		var tempEvent = resultRow{
 			ID:                results[i].ID,
 			JobID:             results[i].JobID,
 			Result:            null.NewString(results[i].Result, results[i].Result != ""),
@@ -154,7 +157,7 @@ func upsertPostgres(ctx context.Context, tx *sql.Tx, results ...*DataHistoryJobR
 			IntervalEndTime:   results[i].IntervalEndDate.UTC(),
 			RunTime:           results[i].Date.UTC(),
 		}
-		err = tempEvent.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer())
+		err = d.InsertIgnore(ctx, tx, "datahistoryjobresult", &tempEvent)
 		if err != nil {
 			return err
 		}
@@ -198,9 +201,13 @@ func (db *DBService) getByJobIDSQLite(jobID string) ([]DataHistoryJobResult, err
 	return resp, nil
 }
 
-func (db *DBService) getByJobIDPostgres(jobID string) ([]DataHistoryJobResult, error) {
-	query := postgres.Datahistoryjobresults(qm.Where("job_id = ?", jobID))
-	results, err := query.All(context.Background(), db.sql)
+func (db *DBService) getByJobID(jobID string) ([]DataHistoryJobResult, error) {
+	d, err := repository.NewDialect(db.driver)
+	if err != nil {
+		return nil, err
+	}
+	var results []resultRow
+	err = d.Query("datahistoryjobresult", qm.Where("job_id = ?", jobID)).Bind(context.Background(), db.sql, &results)
 	if err != nil {
 		return nil, err
 	}
@@ -256,10 +263,14 @@ func (db *DBService) getJobResultsBetweenSQLite(jobID string, startDate, endDate
 	return results, nil
 }
 
-func (db *DBService) getJobResultsBetweenPostgres(jobID string, startDate, endDate time.Time) ([]DataHistoryJobResult, error) {
+func (db *DBService) getJobResultsBetween(jobID string, startDate, endDate time.Time) ([]DataHistoryJobResult, error) {
 	var jobs []DataHistoryJobResult
-	query := postgres.Datahistoryjobresults(qm.Where("job_id = ? AND run_time BETWEEN ? AND  ? ", jobID, startDate, endDate))
-	results, err := query.All(context.Background(), db.sql)
+	d, err := repository.NewDialect(db.driver)
+	if err != nil {
+		return jobs, err
+	}
+	var results []resultRow
+	err = d.Query("datahistoryjobresult", qm.Where("job_id = ? AND run_time BETWEEN ? AND ?", jobID, startDate.UTC(), endDate.UTC())).Bind(context.Background(), db.sql, &results)
 	if err != nil {
 		return jobs, err
 	}
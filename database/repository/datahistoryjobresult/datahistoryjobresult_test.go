@@ -34,6 +34,7 @@ func TestMain(m *testing.M) {
 	}
 	var err error
 	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.MySQLTestDatabase = testhelpers.GetMySQLConnectionDetails()
 	testhelpers.TempDir, err = ioutil.TempDir("", "gct-temp")
 	if err != nil {
 		log.Fatal(err)
@@ -77,6 +78,11 @@ func TestDataHistoryJob(t *testing.T) {
 			config: testhelpers.PostgresTestDatabase,
 			seedDB: seedDB,
 		},
+		{
+			name:   "mysql",
+			config: testhelpers.MySQLTestDatabase,
+			seedDB: seedDB,
+		},
 		{
 			name: "SQLite",
 			config: &database.Config{
@@ -111,9 +117,9 @@ func TestDataHistoryJob(t *testing.T) {
 				t.Fatal(err)
 			}
 
-			// postgres requires job for tests to function
+			// postgres and mysql require job for tests to function
 			var id string
-			if test.name == "postgresql" {
+			if test.config.Driver != database.DBSQLite3 {
 				var selectID *sql.Rows
 				selectID, err = db.sql.Query("select id from datahistoryjob where nickname = 'testdatahistoryjob1'")
 				if err != nil {
@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/volatiletech/null"
 )
 
 // DataHistoryJobResult is a DTO for database data
@@ -31,3 +32,14 @@ type IDBService interface {
 	GetByJobID(jobID string) ([]DataHistoryJobResult, error)
 	GetJobResultsBetween(jobID string, startDate, endDate time.Time) ([]DataHistoryJobResult, error)
 }
+
+// resultRow is a job result as stored by drivers sharing a schema
+type resultRow struct {
+	ID                string      `boil:"id"`
+	JobID             string      `boil:"job_id"`
+	Result            null.String `boil:"result"`
+	Status            float64     `boil:"status"`
+	IntervalStartTime time.Time   `boil:"interval_start_time"`
+	IntervalEndTime   time.Time   `boil:"interval_end_time"`
+	RunTime           time.Time   `boil:"run_time"`
+}
@@ -0,0 +1,294 @@
+package dataquality
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+// findingScopeQuery is formatted with the interval column, which must be
+// quoted by dialects where it is a reserved word
+const findingScopeQuery = "exchange_name_id = ? AND asset = ? AND base = ? AND quote = ? AND data_type = ? AND %s = ? AND start_time >= ? AND start_time < ?"
+
+// Setup returns a DBService
+func Setup(db database.IDatabase) (*DBService, error) {
+	if db == nil {
+		return nil, nil
+	}
+	if !db.IsConnected() {
+		return nil, nil
+	}
+	cfg := db.GetConfig()
+	dbCon, err := db.GetSQL()
+	if err != nil {
+		return nil, err
+	}
+	return &DBService{
+		sql:    dbCon,
+		driver: cfg.Driver,
+	}, nil
+}
+
+// Replace removes all stored findings for an exchange, asset, pair, data type
+// and interval which start within the date range and saves the supplied
+// findings in their place. Findings are stored against the supplied scope
+// so a rescan of a range never leaves stale findings behind
+func (db *DBService) Replace(exchangeName, assetType, base, quote, dataType string, interval int64, startDate, endDate time.Time, findings ...*Finding) error {
+	if exchangeName == "" {
+		return errExchangeNameUnset
+	}
+	for i := range findings {
+		findings[i].Exchange = exchangeName
+		findings[i].Asset = strings.ToLower(assetType)
+		findings[i].Base = strings.ToUpper(base)
+		findings[i].Quote = strings.ToUpper(quote)
+		findings[i].DataType = dataType
+		findings[i].Interval = interval
+	}
+	ctx := context.Background()
+
+	tx, err := db.sql.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginTx %w", err)
+	}
+	defer func() {
+		if err != nil {
+			errRB := tx.Rollback()
+			if errRB != nil {
+				log.Errorf(log.DatabaseMgr, "Replace tx.Rollback %v", errRB)
+			}
+		}
+	}()
+
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		err = replaceSQLite(ctx, tx, exchangeName, assetType, base, quote, dataType, interval, startDate, endDate, findings...)
+	case database.DBPostgreSQL, database.DBMySQL:
+		err = replace(ctx, tx, db.driver, exchangeName, assetType, base, quote, dataType, interval, startDate, endDate, findings...)
+	default:
+		return database.ErrNoDatabaseProvided
+	}
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// GetInRange returns all stored findings for an exchange, asset, pair, data
+// type and interval which start within the date range ordered by start time
+func (db *DBService) GetInRange(exchangeName, assetType, base, quote, dataType string, interval int64, startDate, endDate time.Time) ([]Finding, error) {
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		return db.getInRangeSQLite(exchangeName, assetType, base, quote, dataType, interval, startDate, endDate)
+	case database.DBPostgreSQL, database.DBMySQL:
+		return db.getInRange(exchangeName, assetType, base, quote, dataType, interval, startDate, endDate)
+	default:
+		return nil, database.ErrNoDatabaseProvided
+	}
+}
+
+func replaceSQLite(ctx context.Context, tx *sql.Tx, exchangeName, assetType, base, quote, dataType string, interval int64, startDate, endDate time.Time, findings ...*Finding) error {
+	exch, err := sqlite3.Exchanges(
+		qm.Where("name = ?", strings.ToLower(exchangeName))).One(ctx, tx)
+	if err != nil {
+		return fmt.Errorf("could not retrieve exchange '%v', %w", exchangeName, err)
+	}
+	_, err = sqlite3.DataQualityFindings(
+		qm.Where(fmt.Sprintf(findingScopeQuery, "interval"),
+			exch.ID,
+			strings.ToLower(assetType),
+			strings.ToUpper(base),
+			strings.ToUpper(quote),
+			dataType,
+			interval,
+			startDate.UTC().Format(time.RFC3339),
+			endDate.UTC().Format(time.RFC3339))).DeleteAll(ctx, tx)
+	if err != nil {
+		return err
+	}
+	for i := range findings {
+		if findings[i].ID == "" {
+			var freshUUID uuid.UUID
+			freshUUID, err = uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			findings[i].ID = freshUUID.String()
+		}
+		var tempEvent = sqlite3.DataQualityFinding{
+			ID:             findings[i].ID,
+			ExchangeNameID: exch.ID,
+			Base:           findings[i].Base,
+			Quote:          findings[i].Quote,
+			Asset:          findings[i].Asset,
+			DataType:       findings[i].DataType,
+			Interval:       findings[i].Interval,
+			CheckType:      findings[i].CheckType,
+			StartTime:      findings[i].StartTime.UTC().Format(time.RFC3339),
+			EndTime:        findings[i].EndTime.UTC().Format(time.RFC3339),
+			Detail:         findings[i].Detail,
+		}
+		err = tempEvent.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func replace(ctx context.Context, tx *sql.Tx, driver, exchangeName, assetType, base, quote, dataType string, interval int64, startDate, endDate time.Time, findings ...*Finding) error {
+	d, err := repository.NewDialect(driver)
+	if err != nil {
+		return err
+	}
+	var exch exchange.Details
+	exch, err = exchange.OneByName(ctx, tx, d.Driver(), exchangeName)
+	if err != nil {
+		return fmt.Errorf("could not retrieve exchange '%v', %w", exchangeName, err)
+	}
+	_, err = d.Delete(ctx, tx, "data_quality_finding",
+		qm.Where(fmt.Sprintf(findingScopeQuery, d.Quote("interval")),
+			exch.UUID.String(),
+			strings.ToLower(assetType),
+			strings.ToUpper(base),
+			strings.ToUpper(quote),
+			dataType,
+			interval,
+			startDate.UTC(),
+			endDate.UTC()))
+	if err != nil {
+		return err
+	}
+	for i := range findings {
+		if findings[i].ID == "" {
+			var freshUUID uuid.UUID
+			freshUUID, err = uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			findings[i].ID = freshUUID.String()
+		}
+		var tempEvent = findingRow{
+			ID:             findings[i].ID,
+			ExchangeNameID: exch.UUID.String(),
+			Base:           findings[i].Base,
+			Quote:          findings[i].Quote,
+			Asset:          findings[i].Asset,
+			DataType:       findings[i].DataType,
+			Interval:       findings[i].Interval,
+			CheckType:      findings[i].CheckType,
+			StartTime:      findings[i].StartTime.UTC(),
+			EndTime:        findings[i].EndTime.UTC(),
+			Detail:         findings[i].Detail,
+		}
+		err = d.Insert(ctx, tx, "data_quality_finding", &tempEvent)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (db *DBService) getInRangeSQLite(exchangeName, assetType, base, quote, dataType string, interval int64, startDate, endDate time.Time) ([]Finding, error) {
+	ctx := context.Background()
+	exch, err := sqlite3.Exchanges(
+		qm.Where("name = ?", strings.ToLower(exchangeName))).One(ctx, db.sql)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve exchange '%v', %w", exchangeName, err)
+	}
+	results, err := sqlite3.DataQualityFindings(
+		qm.Where(fmt.Sprintf(findingScopeQuery, "interval"),
+			exch.ID,
+			strings.ToLower(assetType),
+			strings.ToUpper(base),
+			strings.ToUpper(quote),
+			dataType,
+			interval,
+			startDate.UTC().Format(time.RFC3339),
+			endDate.UTC().Format(time.RFC3339)),
+		qm.OrderBy("start_time")).All(ctx, db.sql)
+	if err != nil {
+		return nil, err
+	}
+	findings := make([]Finding, len(results))
+	for i := range results {
+		findings[i] = Finding{
+			ID:        results[i].ID,
+			Exchange:  exch.Name,
+			Base:      results[i].Base,
+			Quote:     results[i].Quote,
+			Asset:     results[i].Asset,
+			DataType:  results[i].DataType,
+			Interval:  results[i].Interval,
+			CheckType: results[i].CheckType,
+			Detail:    results[i].Detail,
+		}
+		findings[i].StartTime, err = time.Parse(time.RFC3339, results[i].StartTime)
+		if err != nil {
+			return nil, err
+		}
+		findings[i].EndTime, err = time.Parse(time.RFC3339, results[i].EndTime)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return findings, nil
+}
+
+func (db *DBService) getInRange(exchangeName, assetType, base, quote, dataType string, interval int64, startDate, endDate time.Time) ([]Finding, error) {
+	d, err := repository.NewDialect(db.driver)
+	if err != nil {
+		return nil, err
+	}
+	ctx := context.Background()
+	var exch exchange.Details
+	exch, err = exchange.OneByName(ctx, db.sql, d.Driver(), exchangeName)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve exchange '%v', %w", exchangeName, err)
+	}
+	var results []findingRow
+	err = d.Query("data_quality_finding",
+		qm.Where(fmt.Sprintf(findingScopeQuery, d.Quote("interval")),
+			exch.UUID.String(),
+			strings.ToLower(assetType),
+			strings.ToUpper(base),
+			strings.ToUpper(quote),
+			dataType,
+			interval,
+			startDate.UTC(),
+			endDate.UTC()),
+		qm.OrderBy("start_time")).Bind(ctx, db.sql, &results)
+	if err != nil {
+		return nil, err
+	}
+	findings := make([]Finding, len(results))
+	for i := range results {
+		findings[i] = Finding{
+			ID:        results[i].ID,
+			Exchange:  exch.Name,
+			Base:      results[i].Base,
+			Quote:     results[i].Quote,
+			Asset:     results[i].Asset,
+			DataType:  results[i].DataType,
+			Interval:  results[i].Interval,
+			CheckType: results[i].CheckType,
+			StartTime: results[i].StartTime.UTC(),
+			EndTime:   results[i].EndTime.UTC(),
+			Detail:    results[i].Detail,
+		}
+	}
+	return findings, nil
+}
@@ -0,0 +1,54 @@
+package dataquality
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+)
+
+var errExchangeNameUnset = errors.New("exchange name not set, cannot insert")
+
+// Finding is a DTO for data quality finding database data
+type Finding struct {
+	ID        string
+	Exchange  string
+	Base      string
+	Quote     string
+	Asset     string
+	DataType  string
+	Interval  int64
+	CheckType string
+	StartTime time.Time
+	EndTime   time.Time
+	Detail    string
+}
+
+// DBService is a service which allows the interaction with
+// the database without a direct reference to a global
+type DBService struct {
+	sql    database.ISQL
+	driver string
+}
+
+// IDBService allows using data quality finding database service
+// without needing to care about implementation
+type IDBService interface {
+	Replace(exchangeName, assetType, base, quote, dataType string, interval int64, startDate, endDate time.Time, findings ...*Finding) error
+	GetInRange(exchangeName, assetType, base, quote, dataType string, interval int64, startDate, endDate time.Time) ([]Finding, error)
+}
+
+// findingRow is a finding as stored by drivers sharing a schema
+type findingRow struct {
+	ID             string    `boil:"id"`
+	ExchangeNameID string    `boil:"exchange_name_id"`
+	Base           string    `boil:"base"`
+	Quote          string    `boil:"quote"`
+	Asset          string    `boil:"asset"`
+	DataType       string    `boil:"data_type"`
+	Interval       int64     `boil:"interval"`
+	CheckType      string    `boil:"check_type"`
+	StartTime      time.Time `boil:"start_time"`
+	EndTime        time.Time `boil:"end_time"`
+	Detail         string    `boil:"detail"`
+}
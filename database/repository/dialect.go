@@ -0,0 +1,329 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/drivers"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+	"github.com/volatiletech/null"
+)
+
+var (
+	// ErrUnsupportedDialect is returned when a driver has no query dialect
+	ErrUnsupportedDialect = errors.New("driver has no SQL query dialect")
+	errInvalidRow         = errors.New("row must be a pointer to a struct with boil tags")
+	errNoConflictColumns  = errors.New("upsert requires conflict columns")
+	errInvalidTimestamp   = errors.New("cannot scan timestamp")
+)
+
+// Dialect builds queries for every supported SQL driver, it is the single
+// path through which repositories query PostgreSQL and MySQL so that adding
+// a driver only requires a new dialect rather than a copy of each query.
+// SQLite shares the dialect where its schema matches, time values are
+// converted to the RFC3339 text SQLite stores them as
+type Dialect struct {
+	driver string
+	boil   drivers.Dialect
+}
+
+// GetDialect returns the query dialect for the configured driver
+func GetDialect() (*Dialect, error) {
+	return NewDialect(GetSQLDialect())
+}
+
+// NewDialect returns the query dialect for the supplied driver
+func NewDialect(driver string) (*Dialect, error) {
+	switch driver {
+	case database.DBPostgreSQL:
+		return &Dialect{
+			driver: database.DBPostgreSQL,
+			boil: drivers.Dialect{
+				LQ:                   '"',
+				RQ:                   '"',
+				UseIndexPlaceholders: true,
+				UseDefaultKeyword:    true,
+			},
+		}, nil
+	case database.DBMySQL:
+		return &Dialect{
+			driver: database.DBMySQL,
+			boil: drivers.Dialect{
+				LQ:              '`',
+				RQ:              '`',
+				UseLastInsertID: true,
+			},
+		}, nil
+	case database.DBSQLite3, database.DBSQLite:
+		return &Dialect{
+			driver: database.DBSQLite3,
+			boil: drivers.Dialect{
+				LQ:              '"',
+				RQ:              '"',
+				UseLastInsertID: true,
+			},
+		}, nil
+	}
+	return nil, fmt.Errorf("%w: %s", ErrUnsupportedDialect, driver)
+}
+
+// Driver returns the driver name the dialect builds queries for
+func (d *Dialect) Driver() string {
+	return d.driver
+}
+
+// Quote quotes an identifier, required for columns such as interval which
+// are reserved words in some dialects
+func (d *Dialect) Quote(identifier string) string {
+	return strmangle.IdentQuote(d.boil.LQ, d.boil.RQ, identifier)
+}
+
+// Columns returns the columns quoted and aliased to their own names for use
+// in a select, SQLite names result columns as the schema declares them which
+// differs in case for tables such as candle
+func (d *Dialect) Columns(cols ...string) []string {
+	aliased := make([]string, len(cols))
+	for i := range cols {
+		aliased[i] = d.Quote(cols[i]) + " AS " + d.Quote(cols[i])
+	}
+	return aliased
+}
+
+// Time returns t as the driver stores it, queries comparing against a
+// stored timestamp must use this value as SQLite compares timestamps as text
+func (d *Dialect) Time(t time.Time) interface{} {
+	if d.driver == database.DBSQLite3 {
+		return t.UTC().Format(time.RFC3339)
+	}
+	return t.UTC()
+}
+
+// Query returns a query against the table. Clauses are written with ?
+// placeholders which are converted to the dialect's placeholders when built
+func (d *Dialect) Query(table string, mods ...qm.QueryMod) *queries.Query {
+	q := &queries.Query{}
+	queries.SetDialect(q, &d.boil)
+	queries.SetFrom(q, table)
+	qm.Apply(q, mods...)
+	return q
+}
+
+// Insert inserts a row into the table, row must be a pointer to a struct
+// and every field with a boil tag is inserted
+func (d *Dialect) Insert(ctx context.Context, exec boil.ContextExecutor, table string, row interface{}) error {
+	return d.InsertWith(ctx, exec, table, row, nil)
+}
+
+// InsertWith inserts a row into the table along with the extra columns, for
+// columns which a driver names differently
+func (d *Dialect) InsertWith(ctx context.Context, exec boil.ContextExecutor, table string, row interface{}, extra map[string]interface{}) error {
+	cols, vals, err := d.rowColumns(row)
+	if err != nil {
+		return err
+	}
+	names := make([]string, 0, len(extra))
+	for k := range extra {
+		names = append(names, k)
+	}
+	sort.Strings(names)
+	for i := range names {
+		cols = append(cols, names[i])
+		vals = append(vals, d.value(extra[names[i]]))
+	}
+	_, err = exec.ExecContext(ctx, d.insertSQL(table, cols), vals...)
+	return err
+}
+
+// InsertIgnore inserts a row into the table, leaving the existing row
+// unchanged when the row conflicts with any unique constraint
+func (d *Dialect) InsertIgnore(ctx context.Context, exec boil.ContextExecutor, table string, row interface{}) error {
+	cols, vals, err := d.rowColumns(row)
+	if err != nil {
+		return err
+	}
+	query := d.insertSQL(table, cols)
+	switch d.driver {
+	case database.DBMySQL:
+		// INSERT IGNORE would also discard unrelated errors such as
+		// truncation, assigning a column to itself only ignores duplicates
+		query += fmt.Sprintf(" ON DUPLICATE KEY UPDATE %s = %s", d.Quote(cols[0]), d.Quote(cols[0]))
+	default:
+		query += " ON CONFLICT DO NOTHING"
+	}
+	_, err = exec.ExecContext(ctx, query, vals...)
+	return err
+}
+
+// Upsert inserts a row into the table, or updates every column apart from
+// the id and conflict columns when the row conflicts with an existing row on
+// the conflict columns. The conflict columns must match a unique constraint
+func (d *Dialect) Upsert(ctx context.Context, exec boil.ContextExecutor, table string, conflict []string, row interface{}) error {
+	if len(conflict) == 0 {
+		return errNoConflictColumns
+	}
+	cols, vals, err := d.rowColumns(row)
+	if err != nil {
+		return err
+	}
+	var update []string
+	for i := range cols {
+		if cols[i] != "id" && !strmangle.SetInclude(cols[i], conflict) {
+			update = append(update, cols[i])
+		}
+	}
+
+	query := d.insertSQL(table, cols)
+	switch d.driver {
+	case database.DBMySQL:
+		if len(update) == 0 {
+			// MySQL has no conflict target, assigning the first conflict
+			// column to itself leaves the existing row unchanged
+			update = conflict[:1]
+		}
+		set := make([]string, len(update))
+		for i := range update {
+			set[i] = fmt.Sprintf("%s = VALUES(%s)", d.Quote(update[i]), d.Quote(update[i]))
+		}
+		query += " ON DUPLICATE KEY UPDATE " + strings.Join(set, ", ")
+	default:
+		query += " ON CONFLICT (" + strings.Join(strmangle.IdentQuoteSlice(d.boil.LQ, d.boil.RQ, conflict), ", ") + ")"
+		if len(update) == 0 {
+			query += " DO NOTHING"
+		} else {
+			set := make([]string, len(update))
+			for i := range update {
+				set[i] = fmt.Sprintf("%s = EXCLUDED.%s", d.Quote(update[i]), d.Quote(update[i]))
+			}
+			query += " DO UPDATE SET " + strings.Join(set, ", ")
+		}
+	}
+	_, err = exec.ExecContext(ctx, query, vals...)
+	return err
+}
+
+// Update sets the columns on every row in the table matching mods and
+// returns the number of rows affected
+func (d *Dialect) Update(ctx context.Context, exec boil.ContextExecutor, table string, cols map[string]interface{}, mods ...qm.QueryMod) (int64, error) {
+	q := d.Query(table, mods...)
+	set := make(map[string]interface{}, len(cols))
+	for k, v := range cols {
+		set[k] = d.value(v)
+	}
+	queries.SetUpdate(q, set)
+	result, err := q.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+// Delete deletes every row in the table matching mods and returns the
+// number of rows deleted
+func (d *Dialect) Delete(ctx context.Context, exec boil.ContextExecutor, table string, mods ...qm.QueryMod) (int64, error) {
+	q := d.Query(table, mods...)
+	queries.SetDelete(q)
+	result, err := q.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, err
+	}
+	return result.RowsAffected()
+}
+
+func (d *Dialect) insertSQL(table string, cols []string) string {
+	return fmt.Sprintf("INSERT INTO %s (%s) VALUES (%s)",
+		d.Quote(table),
+		strings.Join(strmangle.IdentQuoteSlice(d.boil.LQ, d.boil.RQ, cols), ", "),
+		strmangle.Placeholders(d.boil.UseIndexPlaceholders, len(cols), 1, 1))
+}
+
+// value converts time values to the form the driver stores them in
+func (d *Dialect) value(v interface{}) interface{} {
+	switch t := v.(type) {
+	case time.Time:
+		return d.Time(t)
+	case null.Time:
+		if t.Valid {
+			return d.Time(t.Time)
+		}
+	}
+	return v
+}
+
+// rowColumns returns the column names and values of every field with a boil
+// tag, converted for the driver
+func (d *Dialect) rowColumns(row interface{}) (cols []string, vals []interface{}, err error) {
+	cols, vals, err = rowColumns(row)
+	if err != nil {
+		return nil, nil, err
+	}
+	for i := range vals {
+		vals[i] = d.value(vals[i])
+	}
+	return cols, vals, nil
+}
+
+// rowColumns returns the column names and values of every field with a boil
+// tag
+func rowColumns(row interface{}) (cols []string, vals []interface{}, err error) {
+	v := reflect.ValueOf(row)
+	if v.Kind() != reflect.Ptr || v.Elem().Kind() != reflect.Struct {
+		return nil, nil, errInvalidRow
+	}
+	v = v.Elem()
+	for i := 0; i < v.NumField(); i++ {
+		tag := v.Type().Field(i).Tag.Get("boil")
+		if idx := strings.IndexByte(tag, ','); idx != -1 {
+			tag = tag[:idx]
+		}
+		if tag == "" || tag == "-" {
+			continue
+		}
+		cols = append(cols, tag)
+		vals = append(vals, v.Field(i).Interface())
+	}
+	if len(cols) == 0 {
+		return nil, nil, errInvalidRow
+	}
+	return cols, vals, nil
+}
+
+// Timestamp scans a timestamp returned as a time or, by SQLite for
+// aggregates such as MIN, as RFC3339 text
+type Timestamp struct {
+	time.Time
+}
+
+// Scan implements the sql.Scanner interface
+func (t *Timestamp) Scan(src interface{}) error {
+	switch v := src.(type) {
+	case time.Time:
+		t.Time = v
+	case string:
+		return t.parse(v)
+	case []byte:
+		return t.parse(string(v))
+	case nil:
+		t.Time = time.Time{}
+	default:
+		return fmt.Errorf("%w from %T", errInvalidTimestamp, src)
+	}
+	return nil
+}
+
+func (t *Timestamp) parse(s string) error {
+	parsed, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return fmt.Errorf("%w: %v", errInvalidTimestamp, err)
+	}
+	t.Time = parsed
+	return nil
+}
@@ -0,0 +1,305 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/volatiletech/null"
+)
+
+type testRow struct {
+	ID       string      `boil:"id"`
+	Interval int64       `boil:"interval,bind"`
+	Name     null.String `boil:"name"`
+	Ignored  string      `boil:"-"`
+	Untagged string
+}
+
+// recordExecutor records the last query executed
+type recordExecutor struct {
+	query string
+	args  []interface{}
+}
+
+func (r *recordExecutor) Exec(query string, args ...interface{}) (sql.Result, error) {
+	return r.ExecContext(context.Background(), query, args...)
+}
+
+func (r *recordExecutor) Query(string, ...interface{}) (*sql.Rows, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (r *recordExecutor) QueryRow(string, ...interface{}) *sql.Row {
+	return nil
+}
+
+func (r *recordExecutor) ExecContext(_ context.Context, query string, args ...interface{}) (sql.Result, error) {
+	r.query = query
+	r.args = args
+	return nil, nil
+}
+
+func (r *recordExecutor) QueryContext(context.Context, string, ...interface{}) (*sql.Rows, error) {
+	return nil, errors.New("not implemented")
+}
+
+func (r *recordExecutor) QueryRowContext(context.Context, string, ...interface{}) *sql.Row {
+	return nil
+}
+
+func TestNewDialect(t *testing.T) {
+	t.Parallel()
+	_, err := NewDialect("invalid")
+	if !errors.Is(err, ErrUnsupportedDialect) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrUnsupportedDialect)
+	}
+
+	d, err := NewDialect(database.DBSQLite)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if d.Driver() != database.DBSQLite3 {
+		t.Errorf("received '%v' expected '%v'", d.Driver(), database.DBSQLite3)
+	}
+
+	for _, driver := range []string{database.DBSQLite3, database.DBPostgreSQL, database.DBMySQL} {
+		d, err := NewDialect(driver)
+		if !errors.Is(err, nil) {
+			t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+		}
+		if d.Driver() != driver {
+			t.Errorf("received '%v' expected '%v'", d.Driver(), driver)
+		}
+	}
+}
+
+func TestQuote(t *testing.T) {
+	t.Parallel()
+	d, err := NewDialect(database.DBPostgreSQL)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if q := d.Quote("interval"); q != `"interval"` {
+		t.Errorf("received '%v' expected '%v'", q, `"interval"`)
+	}
+
+	d, err = NewDialect(database.DBMySQL)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if q := d.Quote("interval"); q != "`interval`" {
+		t.Errorf("received '%v' expected '%v'", q, "`interval`")
+	}
+}
+
+func TestRowColumns(t *testing.T) {
+	t.Parallel()
+	_, _, err := rowColumns(testRow{})
+	if !errors.Is(err, errInvalidRow) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errInvalidRow)
+	}
+
+	_, _, err = rowColumns(&struct{ Untagged string }{})
+	if !errors.Is(err, errInvalidRow) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errInvalidRow)
+	}
+
+	cols, vals, err := rowColumns(&testRow{ID: "1337", Interval: 60})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if len(cols) != 3 || cols[0] != "id" || cols[1] != "interval" || cols[2] != "name" {
+		t.Errorf("unexpected columns %v", cols)
+	}
+	if len(vals) != 3 || vals[0] != "1337" || vals[1] != int64(60) {
+		t.Errorf("unexpected values %v", vals)
+	}
+}
+
+func TestDialectInsert(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		driver      string
+		insert      string
+		ignore      string
+		upsert      string
+		upsertNoSet string
+	}{
+		{
+			driver:      database.DBPostgreSQL,
+			insert:      `INSERT INTO "test" ("id", "interval", "name") VALUES ($1,$2,$3)`,
+			ignore:      `INSERT INTO "test" ("id", "interval", "name") VALUES ($1,$2,$3) ON CONFLICT DO NOTHING`,
+			upsert:      `INSERT INTO "test" ("id", "interval", "name") VALUES ($1,$2,$3) ON CONFLICT ("interval") DO UPDATE SET "name" = EXCLUDED."name"`,
+			upsertNoSet: `INSERT INTO "test" ("id", "interval", "name") VALUES ($1,$2,$3) ON CONFLICT ("interval", "name") DO NOTHING`,
+		},
+		{
+			driver:      database.DBSQLite3,
+			insert:      `INSERT INTO "test" ("id", "interval", "name") VALUES (?,?,?)`,
+			ignore:      `INSERT INTO "test" ("id", "interval", "name") VALUES (?,?,?) ON CONFLICT DO NOTHING`,
+			upsert:      `INSERT INTO "test" ("id", "interval", "name") VALUES (?,?,?) ON CONFLICT ("interval") DO UPDATE SET "name" = EXCLUDED."name"`,
+			upsertNoSet: `INSERT INTO "test" ("id", "interval", "name") VALUES (?,?,?) ON CONFLICT ("interval", "name") DO NOTHING`,
+		},
+		{
+			driver:      database.DBMySQL,
+			insert:      "INSERT INTO `test` (`id`, `interval`, `name`) VALUES (?,?,?)",
+			ignore:      "INSERT INTO `test` (`id`, `interval`, `name`) VALUES (?,?,?) ON DUPLICATE KEY UPDATE `id` = `id`",
+			upsert:      "INSERT INTO `test` (`id`, `interval`, `name`) VALUES (?,?,?) ON DUPLICATE KEY UPDATE `name` = VALUES(`name`)",
+			upsertNoSet: "INSERT INTO `test` (`id`, `interval`, `name`) VALUES (?,?,?) ON DUPLICATE KEY UPDATE `interval` = VALUES(`interval`)",
+		},
+	}
+	for x := range testCases {
+		test := testCases[x]
+		t.Run(test.driver, func(t *testing.T) {
+			t.Parallel()
+			d, err := NewDialect(test.driver)
+			if !errors.Is(err, nil) {
+				t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+			}
+			exec := &recordExecutor{}
+			ctx := context.Background()
+			row := &testRow{ID: "1337", Interval: 60}
+
+			err = d.Insert(ctx, exec, "test", testRow{})
+			if !errors.Is(err, errInvalidRow) {
+				t.Fatalf("received: '%v' but expected: '%v'", err, errInvalidRow)
+			}
+
+			err = d.Insert(ctx, exec, "test", row)
+			if !errors.Is(err, nil) {
+				t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+			}
+			if exec.query != test.insert {
+				t.Errorf("received '%v' expected '%v'", exec.query, test.insert)
+			}
+			if len(exec.args) != 3 {
+				t.Errorf("received '%v' expected '%v'", len(exec.args), 3)
+			}
+
+			err = d.InsertIgnore(ctx, exec, "test", row)
+			if !errors.Is(err, nil) {
+				t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+			}
+			if exec.query != test.ignore {
+				t.Errorf("received '%v' expected '%v'", exec.query, test.ignore)
+			}
+
+			err = d.Upsert(ctx, exec, "test", nil, row)
+			if !errors.Is(err, errNoConflictColumns) {
+				t.Fatalf("received: '%v' but expected: '%v'", err, errNoConflictColumns)
+			}
+
+			err = d.Upsert(ctx, exec, "test", []string{"interval"}, row)
+			if !errors.Is(err, nil) {
+				t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+			}
+			if exec.query != test.upsert {
+				t.Errorf("received '%v' expected '%v'", exec.query, test.upsert)
+			}
+
+			err = d.Upsert(ctx, exec, "test", []string{"interval", "name"}, row)
+			if !errors.Is(err, nil) {
+				t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+			}
+			if exec.query != test.upsertNoSet {
+				t.Errorf("received '%v' expected '%v'", exec.query, test.upsertNoSet)
+			}
+		})
+	}
+}
+
+func TestColumns(t *testing.T) {
+	t.Parallel()
+	d, err := NewDialect(database.DBMySQL)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	cols := d.Columns("base", "interval")
+	if len(cols) != 2 || cols[0] != "`base` AS `base`" || cols[1] != "`interval` AS `interval`" {
+		t.Errorf("unexpected columns %v", cols)
+	}
+}
+
+func TestTime(t *testing.T) {
+	t.Parallel()
+	tt := time.Date(2020, 1, 1, 10, 0, 0, 0, time.FixedZone("test", 3600))
+	d, err := NewDialect(database.DBSQLite3)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if v := d.Time(tt); v != "2020-01-01T09:00:00Z" {
+		t.Errorf("received '%v' expected '%v'", v, "2020-01-01T09:00:00Z")
+	}
+
+	d, err = NewDialect(database.DBPostgreSQL)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	v, ok := d.Time(tt).(time.Time)
+	if !ok || !v.Equal(tt) || v.Location() != time.UTC {
+		t.Errorf("received '%v' expected '%v'", v, tt.UTC())
+	}
+}
+
+func TestInsertWith(t *testing.T) {
+	t.Parallel()
+	d, err := NewDialect(database.DBSQLite3)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	exec := &recordExecutor{}
+	tt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	err = d.InsertWith(context.Background(), exec, "test", &struct {
+		Created null.Time `boil:"created"`
+	}{Created: null.TimeFrom(tt)}, map[string]interface{}{"b": tt, "a": "1337"})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	expected := `INSERT INTO "test" ("created", "a", "b") VALUES (?,?,?)`
+	if exec.query != expected {
+		t.Errorf("received '%v' expected '%v'", exec.query, expected)
+	}
+	if len(exec.args) != 3 ||
+		exec.args[0] != "2020-01-01T00:00:00Z" ||
+		exec.args[1] != "1337" ||
+		exec.args[2] != "2020-01-01T00:00:00Z" {
+		t.Errorf("unexpected values %v", exec.args)
+	}
+}
+
+func TestTimestampScan(t *testing.T) {
+	t.Parallel()
+	tt := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, src := range []interface{}{tt, "2020-01-01T00:00:00Z", []byte("2020-01-01T00:00:00Z")} {
+		var ts Timestamp
+		err := ts.Scan(src)
+		if !errors.Is(err, nil) {
+			t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+		}
+		if !ts.Equal(tt) {
+			t.Errorf("received '%v' expected '%v'", ts.Time, tt)
+		}
+	}
+
+	var ts Timestamp
+	err := ts.Scan(nil)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if !ts.IsZero() {
+		t.Errorf("received '%v' expected zero time", ts.Time)
+	}
+
+	err = ts.Scan("2020-01-01 00:00:00")
+	if !errors.Is(err, errInvalidTimestamp) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errInvalidTimestamp)
+	}
+
+	err = ts.Scan(1337)
+	if !errors.Is(err, errInvalidTimestamp) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errInvalidTimestamp)
+	}
+}
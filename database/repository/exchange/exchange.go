@@ -11,8 +11,6 @@ import (
 	"github.com/gofrs/uuid"
 	"github.com/thrasher-corp/gocryptotrader/common/cache"
 	"github.com/thrasher-corp/gocryptotrader/database"
-	modelPSQL "github.com/thrasher-corp/gocryptotrader/database/models/postgres"
-	modelSQLite "github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
 	"github.com/thrasher-corp/gocryptotrader/database/repository"
 	"github.com/thrasher-corp/gocryptotrader/log"
 	"github.com/thrasher-corp/sqlboiler/boil"
@@ -30,34 +28,37 @@ func OneByUUID(in uuid.UUID) (Details, error) {
 }
 
 // one returns one exchange by clause
-func one(in, clause string) (out Details, err error) {
+func one(in, clause string) (Details, error) {
 	if database.DB.SQL == nil {
-		return out, database.ErrDatabaseSupportDisabled
+		return Details{}, database.ErrDatabaseSupportDisabled
 	}
+	return lookup(context.Background(), database.DB.SQL, repository.GetSQLDialect(), in, clause)
+}
 
-	whereQM := qm.Where(clause+"= ?", in)
-	if repository.GetSQLDialect() == database.DBSQLite3 {
-		ret, errS := modelSQLite.Exchanges(whereQM).One(context.Background(), database.DB.SQL)
-		if errS != nil {
-			return out, errS
-		}
-		out.Name = ret.Name
-		out.UUID, errS = uuid.FromString(ret.ID)
-		if errS != nil {
-			return out, errS
-		}
-	} else {
-		ret, errS := modelPSQL.Exchanges(whereQM).One(context.Background(), database.DB.SQL)
-		if errS != nil {
-			return out, errS
-		}
-		out.Name = ret.Name
-		out.UUID, errS = uuid.FromString(ret.ID)
-		if errS != nil {
-			return out, errS
-		}
-	}
+// OneByName returns one exchange by name for the supplied driver using exec,
+// allowing repositories to resolve an exchange within their own transaction
+func OneByName(ctx context.Context, exec boil.ContextExecutor, driver, name string) (Details, error) {
+	return lookup(ctx, exec, driver, strings.ToLower(name), "name")
+}
 
+// OneByID returns one exchange by ID for the supplied driver using exec
+func OneByID(ctx context.Context, exec boil.ContextExecutor, driver, id string) (Details, error) {
+	return lookup(ctx, exec, driver, id, "id")
+}
+
+// lookup returns one exchange by clause for the supplied driver
+func lookup(ctx context.Context, exec boil.ContextExecutor, driver, in, clause string) (out Details, err error) {
+	d, err := repository.NewDialect(driver)
+	if err != nil {
+		return out, err
+	}
+	var ret exchangeRow
+	err = d.Query("exchange", qm.Where(clause+"= ?", in)).Bind(ctx, exec, &ret)
+	if err != nil {
+		return out, err
+	}
+	out.Name = ret.Name
+	out.UUID, err = uuid.FromString(ret.ID)
 	return out, err
 }
 
@@ -72,12 +73,7 @@ func Insert(in Details) error {
 	if err != nil {
 		return err
 	}
-	if repository.GetSQLDialect() == database.DBSQLite3 {
-		err = insertSQLite(ctx, tx, []Details{in})
-	} else {
-		err = insertPostgresql(ctx, tx, []Details{in})
-	}
-
+	err = insert(ctx, tx, []Details{in})
 	if err != nil {
 		errRB := tx.Rollback()
 		if errRB != nil {
@@ -106,12 +102,7 @@ func InsertMany(in []Details) error {
 		return err
 	}
 
-	if repository.GetSQLDialect() == database.DBSQLite3 {
-		err = insertSQLite(ctx, tx, in)
-	} else {
-		err = insertPostgresql(ctx, tx, in)
-	}
-
+	err = insert(ctx, tx, in)
 	if err != nil {
 		errRB := tx.Rollback()
 		if errRB != nil {
@@ -131,37 +122,22 @@ func InsertMany(in []Details) error {
 	return nil
 }
 
-func insertSQLite(ctx context.Context, tx *sql.Tx, in []Details) (err error) {
+func insert(ctx context.Context, tx *sql.Tx, in []Details) (err error) {
+	d, err := repository.GetDialect()
+	if err != nil {
+		return err
+	}
 	for x := range in {
 		tempUUID, errUUID := uuid.NewV4()
 		if errUUID != nil {
 			return errUUID
 		}
-		var tempInsert = modelSQLite.Exchange{
-			Name: strings.ToLower(in[x].Name),
+		var tempInsert = exchangeRow{
 			ID:   tempUUID.String(),
-		}
-
-		err = tempInsert.Insert(ctx, tx, boil.Infer())
-		if err != nil {
-			errRB := tx.Rollback()
-			if errRB != nil {
-				log.Errorln(log.DatabaseMgr, errRB)
-			}
-			return err
-		}
-	}
-
-	return nil
-}
-
-func insertPostgresql(ctx context.Context, tx *sql.Tx, in []Details) (err error) {
-	for x := range in {
-		var tempInsert = modelPSQL.Exchange{
 			Name: strings.ToLower(in[x].Name),
 		}
 
-		err = tempInsert.Upsert(ctx, tx, true, []string{"name"}, boil.Infer(), boil.Infer())
+		err = d.Upsert(ctx, tx, "exchange", []string{"name"}, &tempInsert)
 		if err != nil {
 			errRB := tx.Rollback()
 			if errRB != nil {
@@ -1,6 +1,7 @@
 package exchange
 
 import (
+	"context"
 	"fmt"
 	"io/ioutil"
 	"os"
@@ -35,6 +36,7 @@ func TestMain(m *testing.M) {
 
 	var err error
 	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.MySQLTestDatabase = testhelpers.GetMySQLConnectionDetails()
 	testhelpers.TempDir, err = ioutil.TempDir("", "gct-temp")
 	if err != nil {
 		fmt.Printf("failed to create temp file: %v", err)
@@ -64,6 +66,11 @@ func TestInsertMany(t *testing.T) {
 			config: testhelpers.PostgresTestDatabase,
 			seedDB: seed,
 		},
+		{
+			name:   "mysql",
+			config: testhelpers.MySQLTestDatabase,
+			seedDB: seed,
+		},
 		{
 			name: "SQLite",
 			config: &database.Config{
@@ -120,6 +127,11 @@ func TestOneAndOneByUUID(t *testing.T) {
 			config: testhelpers.PostgresTestDatabase,
 			seedDB: seed,
 		},
+		{
+			name:   "mysql",
+			config: testhelpers.MySQLTestDatabase,
+			seedDB: seed,
+		},
 		{
 			name: "SQLite",
 			config: &database.Config{
@@ -163,6 +175,29 @@ func TestOneAndOneByUUID(t *testing.T) {
 			if ret.Name != ret2.Name {
 				t.Fatalf("unexpected value received: %v", ret2.Name)
 			}
+
+			tx, err := dbConn.SQL.BeginTx(context.Background(), nil)
+			if err != nil {
+				t.Fatal(err)
+			}
+			ret3, err := OneByName(context.Background(), tx, test.config.Driver, "ONE")
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ret3.UUID != ret.UUID {
+				t.Fatalf("received: '%v' but expected: '%v'", ret3.UUID, ret.UUID)
+			}
+			ret3, err = OneByID(context.Background(), tx, test.config.Driver, ret.UUID.String())
+			if err != nil {
+				t.Fatal(err)
+			}
+			if ret3.Name != ret.Name {
+				t.Fatalf("received: '%v' but expected: '%v'", ret3.Name, ret.Name)
+			}
+			err = tx.Rollback()
+			if err != nil {
+				t.Fatal(err)
+			}
 			err = testhelpers.CloseDatabase(dbConn)
 			if err != nil {
 				t.Error(err)
@@ -18,3 +18,9 @@ type Details struct {
 	UUID uuid.UUID
 	Name string
 }
+
+// exchangeRow is an exchange as stored by every driver
+type exchangeRow struct {
+	ID   string `boil:"id"`
+	Name string `boil:"name"`
+}
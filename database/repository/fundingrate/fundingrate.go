@@ -0,0 +1,237 @@
+package fundingrate
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+// Setup returns a DBService
+func Setup(db database.IDatabase) (*DBService, error) {
+	if db == nil {
+		return nil, nil
+	}
+	if !db.IsConnected() {
+		return nil, nil
+	}
+	cfg := db.GetConfig()
+	dbCon, err := db.GetSQL()
+	if err != nil {
+		return nil, err
+	}
+	return &DBService{
+		sql:    dbCon,
+		driver: cfg.Driver,
+	}, nil
+}
+
+// Insert saves funding rates to the database, rates which already exist for
+// an exchange, pair, asset and timestamp are ignored
+func (db *DBService) Insert(rates ...*Rate) error {
+	if len(rates) == 0 {
+		return nil
+	}
+	for i := range rates {
+		if rates[i].Exchange == "" {
+			return errExchangeNameUnset
+		}
+	}
+	ctx := context.Background()
+
+	tx, err := db.sql.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginTx %w", err)
+	}
+	defer func() {
+		if err != nil {
+			errRB := tx.Rollback()
+			if errRB != nil {
+				log.Errorf(log.DatabaseMgr, "Insert tx.Rollback %v", errRB)
+			}
+		}
+	}()
+
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		err = insertSQLite(ctx, tx, rates...)
+	case database.DBPostgreSQL, database.DBMySQL:
+		err = insert(ctx, tx, db.driver, rates...)
+	default:
+		return database.ErrNoDatabaseProvided
+	}
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// GetInRange returns all funding rates for an exchange, asset and pair
+// within a date range ordered by time
+func (db *DBService) GetInRange(exchangeName, assetType, base, quote string, startDate, endDate time.Time) ([]Rate, error) {
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		return db.getInRangeSQLite(exchangeName, assetType, base, quote, startDate, endDate)
+	case database.DBPostgreSQL, database.DBMySQL:
+		return db.getInRange(exchangeName, assetType, base, quote, startDate, endDate)
+	default:
+		return nil, database.ErrNoDatabaseProvided
+	}
+}
+
+func insertSQLite(ctx context.Context, tx *sql.Tx, rates ...*Rate) error {
+	for i := range rates {
+		exch, err := sqlite3.Exchanges(
+			qm.Where("name = ?", strings.ToLower(rates[i].Exchange))).One(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("could not retrieve exchange '%v', %w", rates[i].Exchange, err)
+		}
+		if rates[i].ID == "" {
+			var freshUUID uuid.UUID
+			freshUUID, err = uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			rates[i].ID = freshUUID.String()
+		}
+		var tempEvent = sqlite3.FundingRate{
+			ID:             rates[i].ID,
+			ExchangeNameID: exch.ID,
+			Base:           strings.ToUpper(rates[i].Base),
+			Quote:          strings.ToUpper(rates[i].Quote),
+			Asset:          strings.ToLower(rates[i].Asset),
+			Rate:           rates[i].Rate,
+			Timestamp:      rates[i].Timestamp.UTC().Format(time.RFC3339),
+		}
+		err = tempEvent.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func insert(ctx context.Context, tx *sql.Tx, driver string, rates ...*Rate) error {
+	d, err := repository.NewDialect(driver)
+	if err != nil {
+		return err
+	}
+	for i := range rates {
+		var exch exchange.Details
+		exch, err = exchange.OneByName(ctx, tx, d.Driver(), rates[i].Exchange)
+		if err != nil {
+			return fmt.Errorf("could not retrieve exchange '%v', %w", rates[i].Exchange, err)
+		}
+		if rates[i].ID == "" {
+			var freshUUID uuid.UUID
+			freshUUID, err = uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			rates[i].ID = freshUUID.String()
+		}
+		var tempEvent = rateRow{
+			ID:             rates[i].ID,
+			ExchangeNameID: exch.UUID.String(),
+			Base:           strings.ToUpper(rates[i].Base),
+			Quote:          strings.ToUpper(rates[i].Quote),
+			Asset:          strings.ToLower(rates[i].Asset),
+			Rate:           rates[i].Rate,
+			Timestamp:      rates[i].Timestamp.UTC(),
+		}
+		err = d.InsertIgnore(ctx, tx, "funding_rate", &tempEvent)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (db *DBService) getInRangeSQLite(exchangeName, assetType, base, quote string, startDate, endDate time.Time) ([]Rate, error) {
+	ctx := context.Background()
+	exch, err := sqlite3.Exchanges(
+		qm.Where("name = ?", strings.ToLower(exchangeName))).One(ctx, db.sql)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve exchange '%v', %w", exchangeName, err)
+	}
+	results, err := sqlite3.FundingRates(
+		qm.Where("exchange_name_id = ? AND asset = ? AND base = ? AND quote = ? AND timestamp BETWEEN ? AND ?",
+			exch.ID,
+			strings.ToLower(assetType),
+			strings.ToUpper(base),
+			strings.ToUpper(quote),
+			startDate.UTC().Format(time.RFC3339),
+			endDate.UTC().Format(time.RFC3339)),
+		qm.OrderBy("timestamp")).All(ctx, db.sql)
+	if err != nil {
+		return nil, err
+	}
+	rates := make([]Rate, len(results))
+	for i := range results {
+		rates[i] = Rate{
+			ID:       results[i].ID,
+			Exchange: exch.Name,
+			Base:     results[i].Base,
+			Quote:    results[i].Quote,
+			Asset:    results[i].Asset,
+			Rate:     results[i].Rate,
+		}
+		rates[i].Timestamp, err = time.Parse(time.RFC3339, results[i].Timestamp)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return rates, nil
+}
+
+func (db *DBService) getInRange(exchangeName, assetType, base, quote string, startDate, endDate time.Time) ([]Rate, error) {
+	d, err := repository.NewDialect(db.driver)
+	if err != nil {
+		return nil, err
+	}
+	ctx := context.Background()
+	var exch exchange.Details
+	exch, err = exchange.OneByName(ctx, db.sql, d.Driver(), exchangeName)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve exchange '%v', %w", exchangeName, err)
+	}
+	var results []rateRow
+	err = d.Query("funding_rate",
+		qm.Where("exchange_name_id = ? AND asset = ? AND base = ? AND quote = ? AND timestamp BETWEEN ? AND ?",
+			exch.UUID.String(),
+			strings.ToLower(assetType),
+			strings.ToUpper(base),
+			strings.ToUpper(quote),
+			startDate.UTC(),
+			endDate.UTC()),
+		qm.OrderBy("timestamp")).Bind(ctx, db.sql, &results)
+	if err != nil {
+		return nil, err
+	}
+	rates := make([]Rate, len(results))
+	for i := range results {
+		rates[i] = Rate{
+			ID:        results[i].ID,
+			Exchange:  exch.Name,
+			Base:      results[i].Base,
+			Quote:     results[i].Quote,
+			Asset:     results[i].Asset,
+			Rate:      results[i].Rate,
+			Timestamp: results[i].Timestamp.UTC(),
+		}
+	}
+	return rates, nil
+}
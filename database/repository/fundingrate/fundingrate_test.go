@@ -0,0 +1,179 @@
+package fundingrate
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+var (
+	verbose       = false
+	testExchanges = []exchange.Details{
+		{
+			Name: "one",
+		},
+		{
+			Name: "two",
+		},
+	}
+)
+
+func TestMain(m *testing.M) {
+	if verbose {
+		testhelpers.EnableVerboseTestOutput()
+	}
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.MySQLTestDatabase = testhelpers.GetMySQLConnectionDetails()
+	testhelpers.TempDir, err = ioutil.TempDir("", "gct-temp")
+	if err != nil {
+		log.Fatal(err)
+	}
+	t := m.Run()
+	err = os.RemoveAll(testhelpers.TempDir)
+	if err != nil {
+		fmt.Printf("Failed to remove temp db file: %v", err)
+	}
+
+	os.Exit(t)
+}
+
+func seedDB() error {
+	return exchange.InsertMany(testExchanges)
+}
+
+func TestSetup(t *testing.T) {
+	t.Parallel()
+	db, err := Setup(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if db != nil {
+		t.Fatal("expected nil service without a database")
+	}
+}
+
+func TestFundingRates(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *database.Config
+		seedDB func() error
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+			seedDB: seedDB,
+		},
+		{
+			name:   "mysql",
+			config: testhelpers.MySQLTestDatabase,
+			seedDB: seedDB,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+			seedDB: seedDB,
+		},
+	}
+
+	for x := range testCases {
+		test := testCases[x]
+		t.Run(test.name, func(t *testing.T) {
+			if !testhelpers.CheckValidConfig(&test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(test.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if test.seedDB != nil {
+				err = test.seedDB()
+				if err != nil {
+					t.Error(err)
+				}
+			}
+
+			db, err := Setup(dbConn)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			rateSQLTester(t, db)
+
+			err = testhelpers.CloseDatabase(dbConn)
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func rateSQLTester(t *testing.T, db *DBService) {
+	t.Helper()
+	err := db.Insert(&Rate{})
+	if !errors.Is(err, errExchangeNameUnset) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errExchangeNameUnset)
+	}
+
+	firstTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	var rates []*Rate
+	for i := 0; i < 20; i++ {
+		rates = append(rates, &Rate{
+			Exchange:  testExchanges[0].Name,
+			Base:      currency.BTC.String(),
+			Quote:     currency.USDT.String(),
+			Asset:     asset.USDTMarginedFutures.String(),
+			Rate:      0.0001 * float64(i),
+			Timestamp: firstTime.Add(time.Hour * time.Duration(i*8)),
+		})
+	}
+	err = db.Insert(rates...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// insert the same rates with fresh IDs to test conflict resolution
+	for i := range rates {
+		rates[i].ID = ""
+	}
+	err = db.Insert(rates...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := db.GetInRange(testExchanges[0].Name,
+		asset.USDTMarginedFutures.String(),
+		currency.BTC.String(),
+		currency.USDT.String(),
+		firstTime,
+		firstTime.Add(time.Hour*24))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// range is inclusive of both start and end dates
+	if len(results) != 4 {
+		t.Fatalf("received: '%v' but expected: '%v'", len(results), 4)
+	}
+	if !results[1].Timestamp.Equal(firstTime.Add(time.Hour * 8)) {
+		t.Errorf("unexpected timestamp %v", results[1].Timestamp)
+	}
+	if results[1].Rate != 0.0001 {
+		t.Errorf("received: '%v' but expected: '%v'", results[1].Rate, 0.0001)
+	}
+}
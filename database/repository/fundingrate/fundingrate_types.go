@@ -0,0 +1,46 @@
+package fundingrate
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+)
+
+var errExchangeNameUnset = errors.New("exchange name not set, cannot insert")
+
+// Rate is a DTO for funding rate database data
+type Rate struct {
+	ID        string
+	Exchange  string
+	Base      string
+	Quote     string
+	Asset     string
+	Rate      float64
+	Timestamp time.Time
+}
+
+// DBService is a service which allows the interaction with
+// the database without a direct reference to a global
+type DBService struct {
+	sql    database.ISQL
+	driver string
+}
+
+// IDBService allows using funding rate database service
+// without needing to care about implementation
+type IDBService interface {
+	Insert(rates ...*Rate) error
+	GetInRange(exchangeName, assetType, base, quote string, startDate, endDate time.Time) ([]Rate, error)
+}
+
+// rateRow is a funding rate as stored by drivers sharing a schema
+type rateRow struct {
+	ID             string    `boil:"id"`
+	ExchangeNameID string    `boil:"exchange_name_id"`
+	Base           string    `boil:"base"`
+	Quote          string    `boil:"quote"`
+	Asset          string    `boil:"asset"`
+	Rate           float64   `boil:"rate"`
+	Timestamp      time.Time `boil:"timestamp"`
+}
@@ -0,0 +1,326 @@
+package orderbooksnapshot
+
+import (
+	"context"
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+// Setup returns a DBService
+func Setup(db database.IDatabase) (*DBService, error) {
+	if db == nil {
+		return nil, nil
+	}
+	if !db.IsConnected() {
+		return nil, nil
+	}
+	cfg := db.GetConfig()
+	dbCon, err := db.GetSQL()
+	if err != nil {
+		return nil, err
+	}
+	return &DBService{
+		sql:    dbCon,
+		driver: cfg.Driver,
+	}, nil
+}
+
+// Insert saves orderbook snapshots to the database, snapshots which already
+// exist for an exchange, pair, asset and timestamp are ignored
+func (db *DBService) Insert(snapshots ...*Snapshot) error {
+	if len(snapshots) == 0 {
+		return nil
+	}
+	for i := range snapshots {
+		if snapshots[i].Exchange == "" {
+			return errExchangeNameUnset
+		}
+	}
+	ctx := context.Background()
+
+	tx, err := db.sql.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginTx %w", err)
+	}
+	defer func() {
+		if err != nil {
+			errRB := tx.Rollback()
+			if errRB != nil {
+				log.Errorf(log.DatabaseMgr, "Insert tx.Rollback %v", errRB)
+			}
+		}
+	}()
+
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		err = insertSQLite(ctx, tx, snapshots...)
+	case database.DBPostgreSQL, database.DBMySQL:
+		err = insert(ctx, tx, db.driver, snapshots...)
+	default:
+		return database.ErrNoDatabaseProvided
+	}
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// GetInRange returns all orderbook snapshots for an exchange, asset and pair
+// within a date range ordered by time
+func (db *DBService) GetInRange(exchangeName, assetType, base, quote string, startDate, endDate time.Time) ([]Snapshot, error) {
+	var err error
+	var snapshots []Snapshot
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		snapshots, err = db.getInRangeSQLite(exchangeName, assetType, base, quote, startDate, endDate)
+	case database.DBPostgreSQL, database.DBMySQL:
+		snapshots, err = db.getInRange(exchangeName, assetType, base, quote, startDate, endDate)
+	default:
+		return nil, database.ErrNoDatabaseProvided
+	}
+	if err != nil {
+		return nil, err
+	}
+	return snapshots, nil
+}
+
+// DeleteBefore removes all orderbook snapshots taken before the supplied date
+// and returns the number of snapshots removed
+func (db *DBService) DeleteBefore(date time.Time) (int64, error) {
+	ctx := context.Background()
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		return sqlite3.OrderbookSnapshots(
+			qm.Where("timestamp < ?", date.UTC().Format(sqliteTimeFormat))).DeleteAll(ctx, db.sql)
+	case database.DBPostgreSQL, database.DBMySQL:
+		d, err := repository.NewDialect(db.driver)
+		if err != nil {
+			return 0, err
+		}
+		return d.Delete(ctx, db.sql, "orderbook_snapshot", qm.Where("timestamp < ?", date.UTC()))
+	default:
+		return 0, database.ErrNoDatabaseProvided
+	}
+}
+
+func insertSQLite(ctx context.Context, tx *sql.Tx, snapshots ...*Snapshot) error {
+	for i := range snapshots {
+		exch, err := sqlite3.Exchanges(
+			qm.Where("name = ?", strings.ToLower(snapshots[i].Exchange))).One(ctx, tx)
+		if err != nil {
+			return fmt.Errorf("could not retrieve exchange '%v', %w", snapshots[i].Exchange, err)
+		}
+		if snapshots[i].ID == "" {
+			var freshUUID uuid.UUID
+			freshUUID, err = uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			snapshots[i].ID = freshUUID.String()
+		}
+		bids, asks, err := encodeLevels(snapshots[i])
+		if err != nil {
+			return err
+		}
+		var tempEvent = sqlite3.OrderbookSnapshot{
+			ID:             snapshots[i].ID,
+			ExchangeNameID: exch.ID,
+			Base:           strings.ToUpper(snapshots[i].Base),
+			Quote:          strings.ToUpper(snapshots[i].Quote),
+			Asset:          strings.ToLower(snapshots[i].Asset),
+			Bids:           bids,
+			Asks:           asks,
+			Timestamp:      snapshots[i].Timestamp.UTC().Format(sqliteTimeFormat),
+		}
+		err = tempEvent.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func insert(ctx context.Context, tx *sql.Tx, driver string, snapshots ...*Snapshot) error {
+	d, err := repository.NewDialect(driver)
+	if err != nil {
+		return err
+	}
+	for i := range snapshots {
+		var exch exchange.Details
+		exch, err = exchange.OneByName(ctx, tx, d.Driver(), snapshots[i].Exchange)
+		if err != nil {
+			return fmt.Errorf("could not retrieve exchange '%v', %w", snapshots[i].Exchange, err)
+		}
+		if snapshots[i].ID == "" {
+			var freshUUID uuid.UUID
+			freshUUID, err = uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			snapshots[i].ID = freshUUID.String()
+		}
+		var bids, asks string
+		bids, asks, err = encodeLevels(snapshots[i])
+		if err != nil {
+			return err
+		}
+		var tempEvent = snapshotRow{
+			ID:             snapshots[i].ID,
+			ExchangeNameID: exch.UUID.String(),
+			Base:           strings.ToUpper(snapshots[i].Base),
+			Quote:          strings.ToUpper(snapshots[i].Quote),
+			Asset:          strings.ToLower(snapshots[i].Asset),
+			Bids:           bids,
+			Asks:           asks,
+			Timestamp:      snapshots[i].Timestamp.UTC(),
+		}
+		err = d.InsertIgnore(ctx, tx, "orderbook_snapshot", &tempEvent)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (db *DBService) getInRangeSQLite(exchangeName, assetType, base, quote string, startDate, endDate time.Time) ([]Snapshot, error) {
+	ctx := context.Background()
+	exch, err := sqlite3.Exchanges(
+		qm.Where("name = ?", strings.ToLower(exchangeName))).One(ctx, db.sql)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve exchange '%v', %w", exchangeName, err)
+	}
+	results, err := sqlite3.OrderbookSnapshots(
+		qm.Where("exchange_name_id = ? AND asset = ? AND base = ? AND quote = ? AND timestamp BETWEEN ? AND ?",
+			exch.ID,
+			strings.ToLower(assetType),
+			strings.ToUpper(base),
+			strings.ToUpper(quote),
+			startDate.UTC().Format(sqliteTimeFormat),
+			endDate.UTC().Format(sqliteTimeFormat)),
+		qm.OrderBy("timestamp")).All(ctx, db.sql)
+	if err != nil {
+		return nil, err
+	}
+	snapshots := make([]Snapshot, len(results))
+	for i := range results {
+		snapshots[i] = Snapshot{
+			ID:       results[i].ID,
+			Exchange: exch.Name,
+			Base:     results[i].Base,
+			Quote:    results[i].Quote,
+			Asset:    results[i].Asset,
+		}
+		snapshots[i].Timestamp, err = time.Parse(time.RFC3339Nano, results[i].Timestamp)
+		if err != nil {
+			return nil, err
+		}
+		err = decodeLevels(&snapshots[i], results[i].Bids, results[i].Asks)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return snapshots, nil
+}
+
+func (db *DBService) getInRange(exchangeName, assetType, base, quote string, startDate, endDate time.Time) ([]Snapshot, error) {
+	d, err := repository.NewDialect(db.driver)
+	if err != nil {
+		return nil, err
+	}
+	ctx := context.Background()
+	var exch exchange.Details
+	exch, err = exchange.OneByName(ctx, db.sql, d.Driver(), exchangeName)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve exchange '%v', %w", exchangeName, err)
+	}
+	var results []snapshotRow
+	err = d.Query("orderbook_snapshot",
+		qm.Where("exchange_name_id = ? AND asset = ? AND base = ? AND quote = ? AND timestamp BETWEEN ? AND ?",
+			exch.UUID.String(),
+			strings.ToLower(assetType),
+			strings.ToUpper(base),
+			strings.ToUpper(quote),
+			startDate.UTC(),
+			endDate.UTC()),
+		qm.OrderBy("timestamp")).Bind(ctx, db.sql, &results)
+	if err != nil {
+		return nil, err
+	}
+	snapshots := make([]Snapshot, len(results))
+	for i := range results {
+		snapshots[i] = Snapshot{
+			ID:        results[i].ID,
+			Exchange:  exch.Name,
+			Base:      results[i].Base,
+			Quote:     results[i].Quote,
+			Asset:     results[i].Asset,
+			Timestamp: results[i].Timestamp.UTC(),
+		}
+		err = decodeLevels(&snapshots[i], results[i].Bids, results[i].Asks)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return snapshots, nil
+}
+
+// encodeLevels converts both sides of the snapshot into compact JSON arrays
+// of price and amount pairs
+func encodeLevels(s *Snapshot) (bids, asks string, err error) {
+	b, err := json.Marshal(itemsToLevels(s.Bids))
+	if err != nil {
+		return "", "", err
+	}
+	a, err := json.Marshal(itemsToLevels(s.Asks))
+	if err != nil {
+		return "", "", err
+	}
+	return string(b), string(a), nil
+}
+
+// decodeLevels populates both sides of the snapshot from JSON arrays of
+// price and amount pairs
+func decodeLevels(s *Snapshot, bids, asks string) error {
+	var b, a [][2]float64
+	if err := json.Unmarshal([]byte(bids), &b); err != nil {
+		return err
+	}
+	if err := json.Unmarshal([]byte(asks), &a); err != nil {
+		return err
+	}
+	s.Bids = levelsToItems(b)
+	s.Asks = levelsToItems(a)
+	return nil
+}
+
+func itemsToLevels(items orderbook.Items) [][2]float64 {
+	levels := make([][2]float64, len(items))
+	for i := range items {
+		levels[i] = [2]float64{items[i].Price, items[i].Amount}
+	}
+	return levels
+}
+
+func levelsToItems(levels [][2]float64) orderbook.Items {
+	items := make(orderbook.Items, len(levels))
+	for i := range levels {
+		items[i] = orderbook.Item{Price: levels[i][0], Amount: levels[i][1]}
+	}
+	return items
+}
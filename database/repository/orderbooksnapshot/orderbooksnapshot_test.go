@@ -0,0 +1,205 @@
+package orderbooksnapshot
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+var (
+	verbose       = false
+	testExchanges = []exchange.Details{
+		{
+			Name: "one",
+		},
+		{
+			Name: "two",
+		},
+	}
+)
+
+func TestMain(m *testing.M) {
+	if verbose {
+		testhelpers.EnableVerboseTestOutput()
+	}
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.MySQLTestDatabase = testhelpers.GetMySQLConnectionDetails()
+	testhelpers.TempDir, err = ioutil.TempDir("", "gct-temp")
+	if err != nil {
+		log.Fatal(err)
+	}
+	t := m.Run()
+	err = os.RemoveAll(testhelpers.TempDir)
+	if err != nil {
+		fmt.Printf("Failed to remove temp db file: %v", err)
+	}
+
+	os.Exit(t)
+}
+
+func seedDB() error {
+	return exchange.InsertMany(testExchanges)
+}
+
+func TestSetup(t *testing.T) {
+	t.Parallel()
+	db, err := Setup(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if db != nil {
+		t.Fatal("expected nil service without a database")
+	}
+}
+
+func TestOrderbookSnapshots(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *database.Config
+		seedDB func() error
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+			seedDB: seedDB,
+		},
+		{
+			name:   "mysql",
+			config: testhelpers.MySQLTestDatabase,
+			seedDB: seedDB,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+			seedDB: seedDB,
+		},
+	}
+
+	for x := range testCases {
+		test := testCases[x]
+		t.Run(test.name, func(t *testing.T) {
+			if !testhelpers.CheckValidConfig(&test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(test.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if test.seedDB != nil {
+				err = test.seedDB()
+				if err != nil {
+					t.Error(err)
+				}
+			}
+
+			db, err := Setup(dbConn)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			snapshotSQLTester(t, db)
+
+			err = testhelpers.CloseDatabase(dbConn)
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func snapshotSQLTester(t *testing.T, db *DBService) {
+	t.Helper()
+	err := db.Insert(&Snapshot{})
+	if !errors.Is(err, errExchangeNameUnset) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errExchangeNameUnset)
+	}
+
+	firstTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	var snapshots []*Snapshot
+	for i := 0; i < 20; i++ {
+		snapshots = append(snapshots, &Snapshot{
+			Exchange:  testExchanges[0].Name,
+			Base:      currency.BTC.String(),
+			Quote:     currency.USD.String(),
+			Asset:     asset.Spot.String(),
+			Bids:      orderbook.Items{{Price: float64(100 + i), Amount: 1}, {Price: float64(99 + i), Amount: 2}},
+			Asks:      orderbook.Items{{Price: float64(101 + i), Amount: 1.5}},
+			Timestamp: firstTime.Add(time.Millisecond * time.Duration(i*100)),
+		})
+	}
+	err = db.Insert(snapshots...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// insert the same snapshots with fresh IDs to test conflict resolution
+	for i := range snapshots {
+		snapshots[i].ID = ""
+	}
+	err = db.Insert(snapshots...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := db.GetInRange(testExchanges[0].Name,
+		asset.Spot.String(),
+		currency.BTC.String(),
+		currency.USD.String(),
+		firstTime,
+		firstTime.Add(time.Second))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// range is inclusive of both start and end dates
+	if len(results) != 11 {
+		t.Fatalf("received: '%v' but expected: '%v'", len(results), 11)
+	}
+	if !results[1].Timestamp.Equal(firstTime.Add(time.Millisecond * 100)) {
+		t.Errorf("unexpected timestamp %v", results[1].Timestamp)
+	}
+	if len(results[1].Bids) != 2 || results[1].Bids[1].Price != 100 || results[1].Bids[1].Amount != 2 {
+		t.Errorf("unexpected bids %+v", results[1].Bids)
+	}
+	if len(results[1].Asks) != 1 || results[1].Asks[0].Amount != 1.5 {
+		t.Errorf("unexpected asks %+v", results[1].Asks)
+	}
+
+	removed, err := db.DeleteBefore(firstTime.Add(time.Second))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if removed != 10 {
+		t.Errorf("received: '%v' but expected: '%v'", removed, 10)
+	}
+
+	results, err = db.GetInRange(testExchanges[0].Name,
+		asset.Spot.String(),
+		currency.BTC.String(),
+		currency.USD.String(),
+		firstTime,
+		firstTime.Add(time.Hour))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 10 {
+		t.Errorf("received: '%v' but expected: '%v'", len(results), 10)
+	}
+}
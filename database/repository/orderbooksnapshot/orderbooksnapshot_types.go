@@ -0,0 +1,54 @@
+package orderbooksnapshot
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+// sqliteTimeFormat is a fixed width timestamp layout which allows snapshot
+// times to be compared lexicographically with sub second precision
+const sqliteTimeFormat = "2006-01-02T15:04:05.000000000Z07:00"
+
+var errExchangeNameUnset = errors.New("exchange name not set, cannot insert")
+
+// Snapshot is a DTO for orderbook snapshot database data
+type Snapshot struct {
+	ID        string
+	Exchange  string
+	Base      string
+	Quote     string
+	Asset     string
+	Bids      orderbook.Items
+	Asks      orderbook.Items
+	Timestamp time.Time
+}
+
+// DBService is a service which allows the interaction with
+// the database without a direct reference to a global
+type DBService struct {
+	sql    database.ISQL
+	driver string
+}
+
+// IDBService allows using orderbook snapshot database service
+// without needing to care about implementation
+type IDBService interface {
+	Insert(snapshots ...*Snapshot) error
+	GetInRange(exchangeName, assetType, base, quote string, startDate, endDate time.Time) ([]Snapshot, error)
+	DeleteBefore(date time.Time) (int64, error)
+}
+
+// snapshotRow is an orderbook snapshot as stored by drivers sharing a schema
+type snapshotRow struct {
+	ID             string    `boil:"id"`
+	ExchangeNameID string    `boil:"exchange_name_id"`
+	Base           string    `boil:"base"`
+	Quote          string    `boil:"quote"`
+	Asset          string    `boil:"asset"`
+	Bids           string    `boil:"bids"`
+	Asks           string    `boil:"asks"`
+	Timestamp      time.Time `boil:"timestamp"`
+}
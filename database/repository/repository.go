@@ -1,7 +1,10 @@
 package repository
 
 import (
+	"path/filepath"
+
 	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/timeseries"
 )
 
 // GetSQLDialect returns current SQL Dialect based on enabled driver
@@ -12,6 +15,23 @@ func GetSQLDialect() string {
 		return database.DBSQLite3
 	case "psql", "postgres", "postgresql":
 		return database.DBPostgreSQL
+	case "mysql", "mariadb":
+		return database.DBMySQL
 	}
 	return "invalid driver"
 }
+
+// GetTimeSeriesStore returns the time series file store when it is the
+// configured storage for candles and trades, nil is returned when candles
+// and trades are stored in the SQL database
+func GetTimeSeriesStore() (*timeseries.Store, error) {
+	cfg := database.DB.GetConfig()
+	if cfg == nil || cfg.TimeSeries.Driver != database.TimeSeriesFileStore {
+		return nil, nil
+	}
+	path := cfg.TimeSeries.Path
+	if path == "" {
+		path = filepath.Join(database.DB.DataPath, "timeseries")
+	}
+	return timeseries.Open(path)
+}
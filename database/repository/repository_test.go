@@ -23,6 +23,14 @@ func TestGetSQLDialect(t *testing.T) {
 			"sqlite3",
 			database.DBSQLite3,
 		},
+		{
+			"mysql",
+			database.DBMySQL,
+		},
+		{
+			"mariadb",
+			database.DBMySQL,
+		},
 		{
 			"invalid",
 			database.DBInvalidDriver,
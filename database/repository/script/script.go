@@ -2,15 +2,16 @@ package script
 
 import (
 	"context"
+	"database/sql"
+	"errors"
 	"time"
 
 	"github.com/gofrs/uuid"
 	"github.com/thrasher-corp/gocryptotrader/database"
-	modelPSQL "github.com/thrasher-corp/gocryptotrader/database/models/postgres"
-	modelSQLite "github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
 	"github.com/thrasher-corp/gocryptotrader/database/repository"
 	"github.com/thrasher-corp/gocryptotrader/log"
 	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
 	"github.com/volatiletech/null"
 )
 
@@ -28,95 +29,61 @@ func Event(id, name, path string, data null.Bytes, executionType, status string,
 		return
 	}
 
-	if repository.GetSQLDialect() == database.DBSQLite3 {
-		query := modelSQLite.ScriptWhere.ScriptID.EQ(id)
-		f, errQry := modelSQLite.Scripts(query).Exists(ctx, tx)
-		if errQry != nil {
-			log.Errorf(log.DatabaseMgr, "Query failed: %v", errQry)
-			err = tx.Rollback()
-			if err != nil {
-				log.Errorf(log.DatabaseMgr, "Event Transaction rollback failed: %v", err)
-			}
-			return
-		}
-		var tempEvent = modelSQLite.Script{}
-		if !f {
-			newUUID, errUUID := uuid.NewV4()
-			if errUUID != nil {
-				log.Errorf(log.DatabaseMgr, "Failed to generate UUID: %v", errUUID)
-				_ = tx.Rollback()
-				return
-			}
-
-			tempEvent.ID = newUUID.String()
-			tempEvent.ScriptID = id
-			tempEvent.ScriptName = name
-			tempEvent.ScriptPath = path
-			tempEvent.ScriptData = data
-			err = tempEvent.Insert(ctx, tx, boil.Infer())
-			if err != nil {
-				log.Errorf(log.DatabaseMgr, "Event insert failed: %v", err)
-				err = tx.Rollback()
-				if err != nil {
-					log.Errorf(log.DatabaseMgr, "Event Transaction rollback failed: %v", err)
-				}
-				return
-			}
-		} else {
-			tempEvent.ID = id
-		}
-
-		tempScriptExecution := &modelSQLite.ScriptExecution{
-			ScriptID:        id,
-			ExecutionTime:   time.UTC().String(),
-			ExecutionStatus: status,
-			ExecutionType:   executionType,
-		}
-		err = tempEvent.AddScriptExecutions(ctx, tx, true, tempScriptExecution)
-		if err != nil {
-			log.Errorf(log.DatabaseMgr, "Event insert failed: %v", err)
-			err = tx.Rollback()
-			if err != nil {
-				log.Errorf(log.DatabaseMgr, "Event Transaction rollback failed: %v", err)
-			}
-			return
-		}
-	} else {
-		var tempEvent = modelPSQL.Script{
-			ScriptID:   id,
-			ScriptName: name,
-			ScriptPath: path,
-			ScriptData: data,
-		}
-		err = tempEvent.Upsert(ctx, tx, true, []string{"script_id"}, boil.Whitelist("last_executed_at"), boil.Infer())
+	err = event(ctx, tx, id, name, path, data, executionType, status, time)
+	if err != nil {
+		log.Errorf(log.DatabaseMgr, "Event insert failed: %v", err)
+		err = tx.Rollback()
 		if err != nil {
-			log.Errorf(log.DatabaseMgr, "Event insert failed: %v", err)
-			err = tx.Rollback()
-			if err != nil {
-				log.Errorf(log.DatabaseMgr, "Event Transaction rollback failed: %v", err)
-			}
-			return
+			log.Errorf(log.DatabaseMgr, "Event Transaction rollback failed: %v", err)
 		}
+		return
+	}
 
-		tempScriptExecution := &modelPSQL.ScriptExecution{
-			ExecutionTime:   time.UTC(),
-			ExecutionStatus: status,
-			ExecutionType:   executionType,
-		}
+	err = tx.Commit()
+	if err != nil {
+		log.Errorf(log.DatabaseMgr, "Event Transaction commit failed: %v", err)
+	}
+}
 
-		err = tempEvent.AddScriptExecutions(ctx, tx, true, tempScriptExecution)
+// event stores the script if it has not been seen before, otherwise updates
+// when it was last executed, and records the execution
+func event(ctx context.Context, tx *sql.Tx, id, name, path string, data null.Bytes, executionType, status string, executed time.Time) error {
+	d, err := repository.GetDialect()
+	if err != nil {
+		return err
+	}
+	var tempEvent scriptRow
+	err = d.Query("script", qm.Where("script_id = ?", id)).Bind(ctx, tx, &tempEvent)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		var newUUID uuid.UUID
+		newUUID, err = uuid.NewV4()
 		if err != nil {
-			log.Errorf(log.DatabaseMgr, "Event insert failed: %v", err)
-			err = tx.Rollback()
-			if err != nil {
-				log.Errorf(log.DatabaseMgr, "Event Transaction rollback failed: %v", err)
-			}
-			return
+			return err
+		}
+		tempEvent = scriptRow{
+			ID:             newUUID.String(),
+			ScriptID:       id,
+			ScriptName:     name,
+			ScriptPath:     path,
+			ScriptData:     data,
+			LastExecutedAt: null.TimeFrom(executed.UTC()),
+			CreatedAt:      null.TimeFrom(executed.UTC()),
 		}
+		err = d.Insert(ctx, tx, "script", &tempEvent)
+	case err == nil:
+		_, err = d.Update(ctx, tx, "script",
+			map[string]interface{}{"last_executed_at": executed.UTC()},
+			qm.Where("id = ?", tempEvent.ID))
 	}
-
-	err = tx.Commit()
 	if err != nil {
-		log.Errorf(log.DatabaseMgr, "Event Transaction commit failed: %v", err)
+		return err
 	}
+
+	return d.Insert(ctx, tx, "script_execution", &executionRow{
+		ScriptID:        null.StringFrom(tempEvent.ID),
+		ExecutionType:   executionType,
+		ExecutionStatus: status,
+		ExecutionTime:   executed.UTC(),
+	})
 }
@@ -10,7 +10,9 @@ import (
 
 	"github.com/thrasher-corp/gocryptotrader/database"
 	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
 	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
 	"github.com/volatiletech/null"
 )
 
@@ -21,6 +23,7 @@ var (
 func TestMain(m *testing.M) {
 	var err error
 	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.MySQLTestDatabase = testhelpers.GetMySQLConnectionDetails()
 	testhelpers.TempDir, err = ioutil.TempDir("", "gct-temp")
 	if err != nil {
 		fmt.Printf("failed to create temp file: %v", err)
@@ -45,7 +48,7 @@ func TestScript(t *testing.T) {
 	testCases := []struct {
 		name   string
 		config *database.Config
-		runner func()
+		runner func(t *testing.T)
 		closer func(dbConn *database.Instance) error
 		output interface{}
 	}{
@@ -66,6 +69,13 @@ func TestScript(t *testing.T) {
 			nil,
 			nil,
 		},
+		{
+			"MySQL-Write",
+			testhelpers.MySQLTestDatabase,
+			writeScript,
+			nil,
+			nil,
+		},
 	}
 
 	for x := range testCases {
@@ -81,7 +91,7 @@ func TestScript(t *testing.T) {
 			}
 
 			if test.runner != nil {
-				test.runner()
+				test.runner(t)
 			}
 
 			if test.closer != nil {
@@ -94,7 +104,8 @@ func TestScript(t *testing.T) {
 	}
 }
 
-func writeScript() {
+func writeScript(t *testing.T) {
+	t.Helper()
 	var wg sync.WaitGroup
 	for x := 0; x < 20; x++ {
 		wg.Add(1)
@@ -107,4 +118,21 @@ func writeScript() {
 		}(x)
 	}
 	wg.Wait()
+
+	executionType := fmt.Sprintf("test-%v", time.Now().UnixNano())
+	Event("test-0", "test-0", "test-0", null.Bytes{}, executionType, "test", time.Now())
+	d, err := repository.GetDialect()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var executions int
+	err = d.Query("script_execution",
+		qm.Select("COUNT(*)"),
+		qm.Where("execution_type = ?", executionType)).QueryRow(database.DB.SQL).Scan(&executions)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if executions != 1 {
+		t.Errorf("received '%v' expected '%v'", executions, 1)
+	}
 }
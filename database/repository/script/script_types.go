@@ -0,0 +1,27 @@
+package script
+
+import (
+	"time"
+
+	"github.com/volatiletech/null"
+)
+
+// scriptRow is a script as stored by every driver
+type scriptRow struct {
+	ID             string     `boil:"id"`
+	ScriptID       string     `boil:"script_id"`
+	ScriptName     string     `boil:"script_name"`
+	ScriptPath     string     `boil:"script_path"`
+	ScriptData     null.Bytes `boil:"script_data"`
+	LastExecutedAt null.Time  `boil:"last_executed_at"`
+	CreatedAt      null.Time  `boil:"created_at"`
+}
+
+// executionRow holds the columns inserted for a script execution, the id
+// is set by the database
+type executionRow struct {
+	ScriptID        null.String `boil:"script_id"`
+	ExecutionType   string      `boil:"execution_type"`
+	ExecutionStatus string      `boil:"execution_status"`
+	ExecutionTime   time.Time   `boil:"execution_time"`
+}
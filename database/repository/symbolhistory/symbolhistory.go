@@ -0,0 +1,433 @@
+package symbolhistory
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/volatiletech/null"
+)
+
+// Setup returns a DBService
+func Setup(db database.IDatabase) (*DBService, error) {
+	if db == nil {
+		return nil, nil
+	}
+	if !db.IsConnected() {
+		return nil, nil
+	}
+	cfg := db.GetConfig()
+	dbCon, err := db.GetSQL()
+	if err != nil {
+		return nil, err
+	}
+	return &DBService{
+		sql:    dbCon,
+		driver: cfg.Driver,
+	}, nil
+}
+
+// ResolveSegments returns the symbols a pair's data is stored under across
+// the date range using the global database connection. When the database is
+// not connected, the symbol history table has not been migrated or no renames
+// are recorded the pair is returned as a single segment. Renames are cached
+// per exchange and asset until the next insert
+func ResolveSegments(exchangeName, assetType, base, quote string, startDate, endDate time.Time) ([]Segment, error) {
+	db, err := Setup(database.DB)
+	if err != nil {
+		return nil, err
+	}
+	if db == nil {
+		return segments(nil, base, quote, startDate, endDate), nil
+	}
+	renames, err := db.cachedRenames(exchangeName, assetType)
+	if err != nil {
+		return nil, err
+	}
+	return segments(renames, base, quote, startDate, endDate), nil
+}
+
+// ResetRenameCache reinitialise cache to blank state used to clear cache for testing
+func ResetRenameCache() {
+	renameCache.Clear()
+}
+
+// Insert saves symbol history events. Effective dates are stored to the
+// second so that series split on a rename do not overlap
+func (db *DBService) Insert(events ...*Event) error {
+	for i := range events {
+		if events[i].Exchange == "" {
+			return errExchangeNameUnset
+		}
+		events[i].Asset = strings.ToLower(events[i].Asset)
+		events[i].Base = strings.ToUpper(events[i].Base)
+		events[i].Quote = strings.ToUpper(events[i].Quote)
+		events[i].Event = strings.ToLower(events[i].Event)
+		events[i].EffectiveDate = events[i].EffectiveDate.UTC().Truncate(time.Second)
+		switch events[i].Event {
+		case Listed, Delisted:
+			events[i].NewBase = ""
+			events[i].NewQuote = ""
+		case Renamed:
+			events[i].NewBase = strings.ToUpper(events[i].NewBase)
+			events[i].NewQuote = strings.ToUpper(events[i].NewQuote)
+			if events[i].NewBase == "" || events[i].NewQuote == "" {
+				return errRenameTargetUnset
+			}
+			if events[i].NewBase == events[i].Base && events[i].NewQuote == events[i].Quote {
+				return errRenameToSelf
+			}
+		default:
+			return fmt.Errorf("%w '%v'", errInvalidEvent, events[i].Event)
+		}
+	}
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite, database.DBPostgreSQL, database.DBMySQL:
+	default:
+		return database.ErrNoDatabaseProvided
+	}
+	ctx := context.Background()
+
+	tx, err := db.sql.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginTx %w", err)
+	}
+	defer func() {
+		if err != nil {
+			errRB := tx.Rollback()
+			if errRB != nil {
+				log.Errorf(log.DatabaseMgr, "Insert tx.Rollback %v", errRB)
+			}
+		}
+	}()
+
+	if db.driver == database.DBSQLite3 || db.driver == database.DBSQLite {
+		err = insertSQLite(ctx, tx, events...)
+	} else {
+		err = insert(ctx, tx, db.driver, events...)
+	}
+	if err != nil {
+		return err
+	}
+
+	err = tx.Commit()
+	if err != nil {
+		return err
+	}
+	renameCache.Clear()
+	return nil
+}
+
+// GetEvents returns symbol history events for an exchange ordered by
+// effective date. Empty asset, base or quote values match all
+func (db *DBService) GetEvents(exchangeName, assetType, base, quote string) ([]Event, error) {
+	return db.getEvents(exchangeName, eventQueries(assetType, base, quote)...)
+}
+
+// Segments returns the symbols a pair's data is stored under across the date
+// range in chronological order. Each rename of a previous symbol to the pair
+// after the start date splits the range, with data before the rename being
+// stored under the previous symbol. Renames are followed back through each
+// previous symbol
+func (db *DBService) Segments(exchangeName, assetType, base, quote string, startDate, endDate time.Time) ([]Segment, error) {
+	renames, err := db.getRenames(exchangeName, assetType)
+	if err != nil {
+		return nil, err
+	}
+	return segments(renames, base, quote, startDate, endDate), nil
+}
+
+// segments splits the date range on renames to the pair, renames must be
+// ordered by effective date
+func segments(renames []Event, base, quote string, startDate, endDate time.Time) []Segment {
+	base = strings.ToUpper(base)
+	quote = strings.ToUpper(quote)
+	var resp []Segment
+	end := endDate
+	var limit time.Time
+	for depth := 0; depth < maxRenameDepth; depth++ {
+		var rename *Event
+		for i := len(renames) - 1; i >= 0; i-- {
+			if !renames[i].EffectiveDate.After(startDate) {
+				break
+			}
+			if renames[i].NewBase != base || renames[i].NewQuote != quote {
+				continue
+			}
+			// a previous symbol's rename must precede the segment it
+			// continues into, later renames belong to a reused symbol
+			if depth > 0 && renames[i].EffectiveDate.After(limit) {
+				continue
+			}
+			rename = &renames[i]
+			break
+		}
+		if rename == nil {
+			break
+		}
+		if !rename.EffectiveDate.After(end) {
+			resp = append([]Segment{{
+				Base:  base,
+				Quote: quote,
+				Start: rename.EffectiveDate,
+				End:   end,
+			}}, resp...)
+			end = rename.EffectiveDate.Add(-time.Microsecond)
+		}
+		limit = rename.EffectiveDate
+		base = rename.Base
+		quote = rename.Quote
+	}
+	return append([]Segment{{
+		Base:  base,
+		Quote: quote,
+		Start: startDate,
+		End:   end,
+	}}, resp...)
+}
+
+// getRenames returns an exchange's renames for the asset ordered by
+// effective date
+func (db *DBService) getRenames(exchangeName, assetType string) ([]Event, error) {
+	return db.getEvents(exchangeName,
+		qm.Where("asset = ?", strings.ToLower(assetType)),
+		qm.Where("event = ?", Renamed))
+}
+
+// cachedRenames returns an exchange's renames for the asset from the cache,
+// loading them on a miss. An exchange or symbol history table which does not
+// exist has no renames
+func (db *DBService) cachedRenames(exchangeName, assetType string) ([]Event, error) {
+	key := db.driver + "-" + strings.ToLower(exchangeName) + "-" + strings.ToLower(assetType)
+	if v := renameCache.Get(key); v != nil {
+		return v.([]Event), nil
+	}
+	renames, err := db.getRenames(exchangeName, assetType)
+	if err != nil {
+		if !errors.Is(err, sql.ErrNoRows) {
+			migrated, errT := db.hasEventTable()
+			if errT != nil || migrated {
+				return nil, err
+			}
+		}
+		renames = []Event{}
+	}
+	renameCache.Add(key, renames)
+	return renames, nil
+}
+
+// hasEventTable returns whether the symbol history table has been migrated
+func (db *DBService) hasEventTable() (bool, error) {
+	var query string
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		query = "SELECT COUNT(*) FROM sqlite_master WHERE type = 'table' AND name = 'symbol_history'"
+	case database.DBPostgreSQL:
+		query = "SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = current_schema() AND table_name = 'symbol_history'"
+	case database.DBMySQL:
+		query = "SELECT COUNT(*) FROM information_schema.tables WHERE table_schema = DATABASE() AND table_name = 'symbol_history'"
+	default:
+		return false, database.ErrNoDatabaseProvided
+	}
+	var count int
+	err := db.sql.QueryRowContext(context.Background(), query).Scan(&count)
+	if err != nil {
+		return false, err
+	}
+	return count > 0, nil
+}
+
+func eventQueries(assetType, base, quote string) []qm.QueryMod {
+	var queries []qm.QueryMod
+	if assetType != "" {
+		queries = append(queries, qm.Where("asset = ?", strings.ToLower(assetType)))
+	}
+	if base != "" {
+		queries = append(queries, qm.Where("base = ?", strings.ToUpper(base)))
+	}
+	if quote != "" {
+		queries = append(queries, qm.Where("quote = ?", strings.ToUpper(quote)))
+	}
+	return queries
+}
+
+func (db *DBService) getEvents(exchangeName string, queries ...qm.QueryMod) ([]Event, error) {
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		return db.getEventsSQLite(exchangeName, queries...)
+	case database.DBPostgreSQL, database.DBMySQL:
+		return db.getEventsDialect(exchangeName, queries...)
+	default:
+		return nil, database.ErrNoDatabaseProvided
+	}
+}
+
+func insertSQLite(ctx context.Context, tx *sql.Tx, events ...*Event) error {
+	exchangeIDs := make(map[string]string)
+	for i := range events {
+		exchangeName := strings.ToLower(events[i].Exchange)
+		exchangeID, ok := exchangeIDs[exchangeName]
+		if !ok {
+			exch, err := sqlite3.Exchanges(
+				qm.Where("name = ?", exchangeName)).One(ctx, tx)
+			if err != nil {
+				return fmt.Errorf("could not retrieve exchange '%v', %w", events[i].Exchange, err)
+			}
+			exchangeID = exch.ID
+			exchangeIDs[exchangeName] = exchangeID
+		}
+		if events[i].ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			events[i].ID = freshUUID.String()
+		}
+		var tempEvent = sqlite3.SymbolHistory{
+			ID:             events[i].ID,
+			ExchangeNameID: exchangeID,
+			Asset:          events[i].Asset,
+			Base:           events[i].Base,
+			Quote:          events[i].Quote,
+			Event:          events[i].Event,
+			EffectiveDate:  events[i].EffectiveDate.Format(time.RFC3339),
+		}
+		if events[i].Event == Renamed {
+			tempEvent.NewBase = null.NewString(events[i].NewBase, true)
+			tempEvent.NewQuote = null.NewString(events[i].NewQuote, true)
+		}
+		err := tempEvent.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func insert(ctx context.Context, tx *sql.Tx, driver string, events ...*Event) error {
+	d, err := repository.NewDialect(driver)
+	if err != nil {
+		return err
+	}
+	exchangeIDs := make(map[string]string)
+	for i := range events {
+		exchangeName := strings.ToLower(events[i].Exchange)
+		exchangeID, ok := exchangeIDs[exchangeName]
+		if !ok {
+			var exch exchange.Details
+			exch, err = exchange.OneByName(ctx, tx, d.Driver(), exchangeName)
+			if err != nil {
+				return fmt.Errorf("could not retrieve exchange '%v', %w", events[i].Exchange, err)
+			}
+			exchangeID = exch.UUID.String()
+			exchangeIDs[exchangeName] = exchangeID
+		}
+		if events[i].ID == "" {
+			var freshUUID uuid.UUID
+			freshUUID, err = uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			events[i].ID = freshUUID.String()
+		}
+		var tempEvent = eventRow{
+			ID:             events[i].ID,
+			ExchangeNameID: exchangeID,
+			Asset:          events[i].Asset,
+			Base:           events[i].Base,
+			Quote:          events[i].Quote,
+			Event:          events[i].Event,
+			EffectiveDate:  events[i].EffectiveDate,
+		}
+		if events[i].Event == Renamed {
+			tempEvent.NewBase = null.NewString(events[i].NewBase, true)
+			tempEvent.NewQuote = null.NewString(events[i].NewQuote, true)
+		}
+		err = d.Insert(ctx, tx, "symbol_history", &tempEvent)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (db *DBService) getEventsSQLite(exchangeName string, queries ...qm.QueryMod) ([]Event, error) {
+	ctx := context.Background()
+	exch, err := sqlite3.Exchanges(
+		qm.Where("name = ?", strings.ToLower(exchangeName))).One(ctx, db.sql)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve exchange '%v', %w", exchangeName, err)
+	}
+	queries = append(queries,
+		qm.Where("exchange_name_id = ?", exch.ID),
+		qm.OrderBy("effective_date"))
+	results, err := sqlite3.SymbolHistories(queries...).All(ctx, db.sql)
+	if err != nil {
+		return nil, err
+	}
+	events := make([]Event, len(results))
+	for i := range results {
+		events[i] = Event{
+			ID:       results[i].ID,
+			Exchange: exch.Name,
+			Asset:    results[i].Asset,
+			Base:     results[i].Base,
+			Quote:    results[i].Quote,
+			Event:    results[i].Event,
+			NewBase:  results[i].NewBase.String,
+			NewQuote: results[i].NewQuote.String,
+		}
+		events[i].EffectiveDate, err = time.Parse(time.RFC3339, results[i].EffectiveDate)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return events, nil
+}
+
+func (db *DBService) getEventsDialect(exchangeName string, queries ...qm.QueryMod) ([]Event, error) {
+	d, err := repository.NewDialect(db.driver)
+	if err != nil {
+		return nil, err
+	}
+	ctx := context.Background()
+	var exch exchange.Details
+	exch, err = exchange.OneByName(ctx, db.sql, d.Driver(), exchangeName)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve exchange '%v', %w", exchangeName, err)
+	}
+	queries = append(queries,
+		qm.Where("exchange_name_id = ?", exch.UUID.String()),
+		qm.OrderBy("effective_date"))
+	var results []eventRow
+	err = d.Query("symbol_history", queries...).Bind(ctx, db.sql, &results)
+	if err != nil {
+		return nil, err
+	}
+	events := make([]Event, len(results))
+	for i := range results {
+		events[i] = Event{
+			ID:            results[i].ID,
+			Exchange:      exch.Name,
+			Asset:         results[i].Asset,
+			Base:          results[i].Base,
+			Quote:         results[i].Quote,
+			Event:         results[i].Event,
+			NewBase:       results[i].NewBase.String,
+			NewQuote:      results[i].NewQuote.String,
+			EffectiveDate: results[i].EffectiveDate.UTC(),
+		}
+	}
+	return events, nil
+}
@@ -0,0 +1,381 @@
+package symbolhistory
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+var (
+	verbose       = false
+	testExchanges = []exchange.Details{
+		{
+			Name: "one",
+		},
+		{
+			Name: "two",
+		},
+	}
+)
+
+func TestMain(m *testing.M) {
+	if verbose {
+		testhelpers.EnableVerboseTestOutput()
+	}
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.MySQLTestDatabase = testhelpers.GetMySQLConnectionDetails()
+	testhelpers.TempDir, err = ioutil.TempDir("", "gct-temp")
+	if err != nil {
+		log.Fatal(err)
+	}
+	t := m.Run()
+	err = os.RemoveAll(testhelpers.TempDir)
+	if err != nil {
+		fmt.Printf("Failed to remove temp db file: %v", err)
+	}
+
+	os.Exit(t)
+}
+
+func seedDB() error {
+	return exchange.InsertMany(testExchanges)
+}
+
+func TestSetup(t *testing.T) {
+	t.Parallel()
+	db, err := Setup(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if db != nil {
+		t.Fatal("expected nil service without a database")
+	}
+}
+
+func TestSymbolHistory(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *database.Config
+		seedDB func() error
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+			seedDB: seedDB,
+		},
+		{
+			name:   "mysql",
+			config: testhelpers.MySQLTestDatabase,
+			seedDB: seedDB,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+			seedDB: seedDB,
+		},
+	}
+
+	for x := range testCases {
+		test := testCases[x]
+		t.Run(test.name, func(t *testing.T) {
+			if !testhelpers.CheckValidConfig(&test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+			ResetRenameCache()
+
+			dbConn, err := testhelpers.ConnectToDatabase(test.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if test.seedDB != nil {
+				err = test.seedDB()
+				if err != nil {
+					t.Error(err)
+				}
+			}
+
+			db, err := Setup(dbConn)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			symbolHistorySQLTester(t, db)
+
+			err = testhelpers.CloseDatabase(dbConn)
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func symbolHistorySQLTester(t *testing.T, db *DBService) {
+	t.Helper()
+	firstTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	err := db.Insert(&Event{
+		Asset: asset.Spot.String(),
+		Base:  currency.BTC.String(),
+		Quote: currency.USDT.String(),
+		Event: Listed,
+	})
+	if !errors.Is(err, errExchangeNameUnset) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errExchangeNameUnset)
+	}
+	err = db.Insert(&Event{
+		Exchange: testExchanges[0].Name,
+		Asset:    asset.Spot.String(),
+		Base:     currency.BTC.String(),
+		Quote:    currency.USDT.String(),
+		Event:    "split",
+	})
+	if !errors.Is(err, errInvalidEvent) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errInvalidEvent)
+	}
+	err = db.Insert(&Event{
+		Exchange: testExchanges[0].Name,
+		Asset:    asset.Spot.String(),
+		Base:     currency.BTC.String(),
+		Quote:    currency.USDT.String(),
+		Event:    Renamed,
+	})
+	if !errors.Is(err, errRenameTargetUnset) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errRenameTargetUnset)
+	}
+	err = db.Insert(&Event{
+		Exchange: testExchanges[0].Name,
+		Asset:    asset.Spot.String(),
+		Base:     currency.BTC.String(),
+		Quote:    currency.USDT.String(),
+		Event:    Renamed,
+		NewBase:  "btc",
+		NewQuote: "usdt",
+	})
+	if !errors.Is(err, errRenameToSelf) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errRenameToSelf)
+	}
+
+	// LUNA becomes LUNC on day 10, then LUNC becomes ATOM on day 20
+	err = db.Insert(&Event{
+		Exchange:      testExchanges[0].Name,
+		Asset:         asset.Spot.String(),
+		Base:          "luna",
+		Quote:         "usdt",
+		Event:         Listed,
+		EffectiveDate: firstTime,
+	}, &Event{
+		Exchange:      testExchanges[0].Name,
+		Asset:         asset.Spot.String(),
+		Base:          "luna",
+		Quote:         "usdt",
+		Event:         Renamed,
+		NewBase:       "lunc",
+		NewQuote:      "usdt",
+		EffectiveDate: firstTime.AddDate(0, 0, 10),
+	}, &Event{
+		Exchange:      testExchanges[0].Name,
+		Asset:         asset.Spot.String(),
+		Base:          "lunc",
+		Quote:         "usdt",
+		Event:         Renamed,
+		NewBase:       "atom",
+		NewQuote:      "usdt",
+		EffectiveDate: firstTime.AddDate(0, 0, 20),
+	}, &Event{
+		Exchange:      testExchanges[1].Name,
+		Asset:         asset.Spot.String(),
+		Base:          "luna",
+		Quote:         "usdt",
+		Event:         Delisted,
+		EffectiveDate: firstTime.AddDate(0, 0, 10),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	events, err := db.GetEvents(testExchanges[0].Name, asset.Spot.String(), "LUNA", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 2 {
+		t.Fatalf("received: '%v' but expected: '%v'", len(events), 2)
+	}
+	if events[0].Event != Listed || events[0].NewBase != "" {
+		t.Errorf("unexpected event %+v", events[0])
+	}
+	if events[1].Event != Renamed || events[1].NewBase != "LUNC" ||
+		events[1].NewQuote != currency.USDT.String() ||
+		!events[1].EffectiveDate.Equal(firstTime.AddDate(0, 0, 10)) {
+		t.Errorf("unexpected event %+v", events[1])
+	}
+
+	events, err = db.GetEvents(testExchanges[1].Name, "", "", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(events) != 1 || events[0].Event != Delisted {
+		t.Fatalf("unexpected events %+v", events)
+	}
+
+	end := firstTime.AddDate(0, 0, 30)
+	segments, err := db.Segments(testExchanges[0].Name, asset.Spot.String(), "atom", "usdt", firstTime, end)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(segments) != 3 {
+		t.Fatalf("received: '%v' but expected: '%v'", len(segments), 3)
+	}
+	if segments[0].Base != "LUNA" || !segments[0].Start.Equal(firstTime) ||
+		!segments[0].End.Equal(firstTime.AddDate(0, 0, 10).Add(-time.Microsecond)) {
+		t.Errorf("unexpected segment %+v", segments[0])
+	}
+	if segments[1].Base != "LUNC" || !segments[1].Start.Equal(firstTime.AddDate(0, 0, 10)) {
+		t.Errorf("unexpected segment %+v", segments[1])
+	}
+	if segments[2].Base != "ATOM" || segments[2].Quote != currency.USDT.String() ||
+		!segments[2].Start.Equal(firstTime.AddDate(0, 0, 20)) || !segments[2].End.Equal(end) {
+		t.Errorf("unexpected segment %+v", segments[2])
+	}
+
+	// a range entirely before the rename is stored under the old symbol
+	segments, err = db.Segments(testExchanges[0].Name, asset.Spot.String(), "atom", "usdt", firstTime, firstTime.AddDate(0, 0, 5))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(segments) != 1 || segments[0].Base != "LUNA" {
+		t.Fatalf("unexpected segments %+v", segments)
+	}
+
+	// a range after the rename needs no stitching
+	segments, err = db.Segments(testExchanges[0].Name, asset.Spot.String(), "atom", "usdt", firstTime.AddDate(0, 0, 25), end)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(segments) != 1 || segments[0].Base != "ATOM" {
+		t.Fatalf("unexpected segments %+v", segments)
+	}
+
+	segments, err = db.Segments(testExchanges[1].Name, asset.Spot.String(), "luna", "usdt", firstTime, end)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(segments) != 1 || segments[0].Base != "LUNA" || !segments[0].End.Equal(end) {
+		t.Fatalf("unexpected segments %+v", segments)
+	}
+
+	segments, err = ResolveSegments(testExchanges[1].Name, asset.Spot.String(), "btc", "usdt", firstTime, end)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(segments) != 1 || segments[0].Base != "BTC" || !segments[0].End.Equal(end) {
+		t.Fatalf("unexpected segments %+v", segments)
+	}
+
+	// a symbol renamed back to a previous symbol resolves each period once
+	err = db.Insert(&Event{
+		Exchange:      testExchanges[1].Name,
+		Asset:         asset.Spot.String(),
+		Base:          "btc",
+		Quote:         "usdt",
+		Event:         Renamed,
+		NewBase:       "xbt",
+		NewQuote:      "usdt",
+		EffectiveDate: firstTime.AddDate(0, 0, 10),
+	}, &Event{
+		Exchange:      testExchanges[1].Name,
+		Asset:         asset.Spot.String(),
+		Base:          "xbt",
+		Quote:         "usdt",
+		Event:         Renamed,
+		NewBase:       "btc",
+		NewQuote:      "usdt",
+		EffectiveDate: firstTime.AddDate(0, 0, 20),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	segments, err = db.Segments(testExchanges[1].Name, asset.Spot.String(), "btc", "usdt", firstTime, end)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(segments) != 3 {
+		t.Fatalf("received: '%v' but expected: '%v'", len(segments), 3)
+	}
+	if segments[0].Base != "BTC" || segments[1].Base != "XBT" || segments[2].Base != "BTC" {
+		t.Errorf("unexpected segments %+v", segments)
+	}
+
+	// inserting renames clears the cached segments
+	segments, err = ResolveSegments(testExchanges[1].Name, asset.Spot.String(), "btc", "usdt", firstTime, end)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(segments) != 3 {
+		t.Fatalf("received: '%v' but expected: '%v'", len(segments), 3)
+	}
+}
+
+func TestInsertInvalidDriver(t *testing.T) {
+	t.Parallel()
+	db := &DBService{driver: "invalid"}
+	err := db.Insert(&Event{
+		Exchange: testExchanges[0].Name,
+		Asset:    asset.Spot.String(),
+		Base:     currency.BTC.String(),
+		Quote:    currency.USDT.String(),
+		Event:    Listed,
+	})
+	if !errors.Is(err, database.ErrNoDatabaseProvided) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, database.ErrNoDatabaseProvided)
+	}
+}
+
+func TestResolveSegmentsUnmigrated(t *testing.T) {
+	ResetRenameCache()
+	dbConn, err := testhelpers.ConnectToDatabase(&database.Config{
+		Driver:            database.DBSQLite3,
+		ConnectionDetails: drivers.ConnectionDetails{Database: "./unmigrated"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = seedDB()
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = dbConn.SQL.Exec("DROP TABLE symbol_history")
+	if err != nil {
+		t.Fatal(err)
+	}
+	start := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := start.AddDate(0, 0, 30)
+	for _, exch := range []string{testExchanges[0].Name, "unknown"} {
+		segments, err := ResolveSegments(exch, asset.Spot.String(), "btc", "usdt", start, end)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(segments) != 1 || segments[0].Base != "BTC" || segments[0].Quote != "USDT" ||
+			!segments[0].Start.Equal(start) || !segments[0].End.Equal(end) {
+			t.Fatalf("unexpected segments %+v", segments)
+		}
+	}
+	err = testhelpers.CloseDatabase(dbConn)
+	if err != nil {
+		t.Error(err)
+	}
+}
@@ -0,0 +1,83 @@
+package symbolhistory
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common/cache"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/volatiletech/null"
+)
+
+// Event types recorded against a symbol
+const (
+	// Listed is recorded when a pair becomes available on an exchange
+	Listed = "listed"
+	// Delisted is recorded when a pair is no longer available on an exchange
+	Delisted = "delisted"
+	// Renamed declares that a pair continues under a new symbol from the
+	// effective date, eg a token migration or redenomination
+	Renamed = "renamed"
+)
+
+// maxRenameDepth limits how many renames are followed when stitching a
+// series, guarding against circular aliases
+const maxRenameDepth = 10
+
+var (
+	renameCache = cache.New(100)
+
+	errExchangeNameUnset = errors.New("exchange name not set, cannot insert")
+	errInvalidEvent      = errors.New("invalid symbol history event")
+	errRenameTargetUnset = errors.New("renamed event requires a new base and quote")
+	errRenameToSelf      = errors.New("renamed event cannot rename a pair to itself")
+)
+
+// Event is a DTO for symbol history database data
+type Event struct {
+	ID            string
+	Exchange      string
+	Asset         string
+	Base          string
+	Quote         string
+	Event         string
+	NewBase       string
+	NewQuote      string
+	EffectiveDate time.Time
+}
+
+// Segment is the symbol data is stored under for part of a date range
+type Segment struct {
+	Base  string
+	Quote string
+	Start time.Time
+	End   time.Time
+}
+
+// DBService is a service which allows the interaction with
+// the database without a direct reference to a global
+type DBService struct {
+	sql    database.ISQL
+	driver string
+}
+
+// IDBService allows using symbol history database service
+// without needing to care about implementation
+type IDBService interface {
+	Insert(events ...*Event) error
+	GetEvents(exchangeName, assetType, base, quote string) ([]Event, error)
+	Segments(exchangeName, assetType, base, quote string, startDate, endDate time.Time) ([]Segment, error)
+}
+
+// eventRow is a symbol history event as stored by drivers sharing a schema
+type eventRow struct {
+	ID             string      `boil:"id"`
+	ExchangeNameID string      `boil:"exchange_name_id"`
+	Asset          string      `boil:"asset"`
+	Base           string      `boil:"base"`
+	Quote          string      `boil:"quote"`
+	Event          string      `boil:"event"`
+	NewBase        null.String `boil:"new_base"`
+	NewQuote       null.String `boil:"new_quote"`
+	EffectiveDate  time.Time   `boil:"effective_date"`
+}
@@ -10,10 +10,10 @@ import (
 
 	"github.com/gofrs/uuid"
 	"github.com/thrasher-corp/gocryptotrader/database"
-	"github.com/thrasher-corp/gocryptotrader/database/models/postgres"
-	"github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
 	"github.com/thrasher-corp/gocryptotrader/database/repository"
 	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/symbolhistory"
+	"github.com/thrasher-corp/gocryptotrader/database/timeseries"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/log"
 	"github.com/thrasher-corp/sqlboiler/boil"
@@ -34,6 +34,14 @@ func Insert(trades ...Data) error {
 		}
 	}
 
+	store, err := repository.GetTimeSeriesStore()
+	if err != nil {
+		return err
+	}
+	if store != nil {
+		return insertTimeSeries(store, trades...)
+	}
+
 	ctx := context.Background()
 	ctx = boil.SkipTimestamps(ctx)
 
@@ -50,11 +58,7 @@ func Insert(trades ...Data) error {
 		}
 	}()
 
-	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
-		err = insertSQLite(ctx, tx, trades...)
-	} else {
-		err = insertPostgres(ctx, tx, trades...)
-	}
+	err = insert(ctx, tx, trades...)
 	if err != nil {
 		return err
 	}
@@ -65,6 +69,14 @@ func Insert(trades ...Data) error {
 // VerifyTradeInIntervals will query for ONE trade within each kline interval and verify if data exists
 // if it does, it will set the range holder property "HasData" to true
 func VerifyTradeInIntervals(exchangeName, assetType, base, quote string, irh *kline.IntervalRangeHolder) error {
+	store, err := repository.GetTimeSeriesStore()
+	if err != nil {
+		return err
+	}
+	if store != nil {
+		return verifyTradeInIntervalsTimeSeries(store, exchangeName, assetType, base, quote, irh)
+	}
+
 	ctx := context.Background()
 	ctx = boil.SkipTimestamps(ctx)
 
@@ -81,11 +93,7 @@ func VerifyTradeInIntervals(exchangeName, assetType, base, quote string, irh *kl
 		}
 	}()
 
-	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
-		err = verifyTradeInIntervalsSqlite(ctx, tx, exchangeName, assetType, base, quote, irh)
-	} else {
-		err = verifyTradeInIntervalsPostgres(ctx, tx, exchangeName, assetType, base, quote, irh)
-	}
+	err = verifyTradeInIntervals(ctx, tx, exchangeName, assetType, base, quote, irh)
 	if err != nil {
 		return err
 	}
@@ -93,50 +101,29 @@ func VerifyTradeInIntervals(exchangeName, assetType, base, quote string, irh *kl
 	return tx.Commit()
 }
 
-func verifyTradeInIntervalsSqlite(ctx context.Context, tx *sql.Tx, exchangeName, assetType, base, quote string, irh *kline.IntervalRangeHolder) error {
-	exch, err := sqlite3.Exchanges(qm.Where("name = ?", exchangeName)).One(ctx, tx)
+func verifyTradeInIntervals(ctx context.Context, tx *sql.Tx, exchangeName, assetType, base, quote string, irh *kline.IntervalRangeHolder) error {
+	d, err := repository.GetDialect()
 	if err != nil {
 		return err
 	}
-	for i := range irh.Ranges {
-		for j := range irh.Ranges[i].Intervals {
-			result, err := sqlite3.Trades(qm.Where("exchange_name_id = ? AND asset = ? AND base = ? AND quote = ? AND timestamp between ? AND ?",
-				exch.ID,
-				assetType,
-				base,
-				quote,
-				irh.Ranges[i].Intervals[j].Start.Time.UTC().Format(time.RFC3339),
-				irh.Ranges[i].Intervals[j].End.Time.UTC().Format(time.RFC3339))).One(ctx, tx)
-			if err != nil && err != sql.ErrNoRows {
-				return err
-			}
-			if result != nil {
-				irh.Ranges[i].Intervals[j].HasData = true
-			}
-		}
-	}
-
-	return nil
-}
-
-func verifyTradeInIntervalsPostgres(ctx context.Context, tx *sql.Tx, exchangeName, assetType, base, quote string, irh *kline.IntervalRangeHolder) error {
-	exch, err := postgres.Exchanges(qm.Where("name = ?", exchangeName)).One(ctx, tx)
+	exchangeUUID, err := exchange.UUIDByName(exchangeName)
 	if err != nil {
 		return err
 	}
 	for i := range irh.Ranges {
 		for j := range irh.Ranges[i].Intervals {
-			result, err := postgres.Trades(qm.Where("exchange_name_id = ? AND asset = ? AND base = ? AND quote = ? AND timestamp between ? AND ?",
-				exch.ID,
+			var result tradeRow
+			err = d.Query("trade", qm.Where("exchange_name_id = ? AND asset = ? AND base = ? AND quote = ? AND timestamp between ? AND ?",
+				exchangeUUID.String(),
 				assetType,
 				base,
 				quote,
-				irh.Ranges[i].Intervals[j].Start.Time.UTC(),
-				irh.Ranges[i].Intervals[j].End.Time.UTC())).One(ctx, tx)
-			if err != nil && err != sql.ErrNoRows {
+				d.Time(irh.Ranges[i].Intervals[j].Start.Time),
+				d.Time(irh.Ranges[i].Intervals[j].End.Time)), qm.Limit(1)).Bind(ctx, tx, &result)
+			if err != nil && !errors.Is(err, sql.ErrNoRows) {
 				return err
 			}
-			if result != nil {
+			if err == nil {
 				irh.Ranges[i].Intervals[j].HasData = true
 			}
 		}
@@ -145,42 +132,11 @@ func verifyTradeInIntervalsPostgres(ctx context.Context, tx *sql.Tx, exchangeNam
 	return nil
 }
 
-func insertSQLite(ctx context.Context, tx *sql.Tx, trades ...Data) error {
-	for i := range trades {
-		if trades[i].ID == "" {
-			freshUUID, err := uuid.NewV4()
-			if err != nil {
-				return err
-			}
-			trades[i].ID = freshUUID.String()
-		}
-		var tempEvent = sqlite3.Trade{
-			ID:             trades[i].ID,
-			ExchangeNameID: trades[i].ExchangeNameID,
-			Base:           strings.ToUpper(trades[i].Base),
-			Quote:          strings.ToUpper(trades[i].Quote),
-			Asset:          strings.ToLower(trades[i].AssetType),
-			Price:          trades[i].Price,
-			Amount:         trades[i].Amount,
-			Timestamp:      trades[i].Timestamp.UTC().Format(time.RFC3339),
-		}
-		if trades[i].Side != "" {
-			tempEvent.Side.SetValid(strings.ToUpper(trades[i].Side))
-		}
-		if trades[i].TID != "" {
-			tempEvent.Tid.SetValid(trades[i].TID)
-		}
-		err := tempEvent.Insert(ctx, tx, boil.Infer())
-		if err != nil {
-			return err
-		}
+func insert(ctx context.Context, tx *sql.Tx, trades ...Data) error {
+	d, err := repository.GetDialect()
+	if err != nil {
+		return err
 	}
-
-	return nil
-}
-
-func insertPostgres(ctx context.Context, tx *sql.Tx, trades ...Data) error {
-	var err error
 	for i := range trades {
 		if trades[i].ID == "" {
 			var freshUUID uuid.UUID
@@ -190,7 +146,7 @@ func insertPostgres(ctx context.Context, tx *sql.Tx, trades ...Data) error {
 			}
 			trades[i].ID = freshUUID.String()
 		}
-		var tempEvent = postgres.Trade{
+		var tempEvent = tradeRow{
 			ExchangeNameID: trades[i].ExchangeNameID,
 			Base:           strings.ToUpper(trades[i].Base),
 			Quote:          strings.ToUpper(trades[i].Quote),
@@ -207,7 +163,7 @@ func insertPostgres(ctx context.Context, tx *sql.Tx, trades ...Data) error {
 			tempEvent.Tid.SetValid(trades[i].TID)
 		}
 
-		err = tempEvent.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer())
+		err = d.InsertIgnore(ctx, tx, "trade", &tempEvent)
 		if err != nil {
 			return err
 		}
@@ -218,43 +174,45 @@ func insertPostgres(ctx context.Context, tx *sql.Tx, trades ...Data) error {
 
 // GetByUUID returns a trade by its unique ID
 func GetByUUID(uuid string) (td Data, err error) {
-	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
-		td, err = getByUUIDSQLite(uuid)
+	store, err := repository.GetTimeSeriesStore()
+	if err != nil {
+		return td, err
+	}
+	if store != nil {
+		td, err = getByUUIDTimeSeries(store, uuid)
 		if err != nil {
-			return td, fmt.Errorf("trade.Get getByUUIDSQLite %w", err)
+			return td, fmt.Errorf("trade.Get getByUUIDTimeSeries %w", err)
 		}
 	} else {
-		td, err = getByUUIDPostgres(uuid)
+		td, err = getByUUID(uuid)
 		if err != nil {
-			return td, fmt.Errorf("trade.Get getByUUIDPostgres %w", err)
+			return td, fmt.Errorf("trade.Get getByUUID %w", err)
 		}
 	}
 
 	return td, nil
 }
 
-func getByUUIDSQLite(uuid string) (Data, error) {
-	var td Data
-	var ts time.Time
-	query := sqlite3.Trades(qm.Where("id = ?", uuid))
-	result, err := query.One(context.Background(), database.DB.SQL)
+func getByUUID(uuid string) (td Data, err error) {
+	d, err := repository.GetDialect()
 	if err != nil {
 		return td, err
 	}
-	ts, err = time.Parse(time.RFC3339, result.Timestamp)
+	var result tradeRow
+	err = d.Query("trade", qm.Where("id = ?", uuid)).Bind(context.Background(), database.DB.SQL, &result)
 	if err != nil {
 		return td, err
 	}
 
 	td = Data{
 		ID:        result.ID,
+		Timestamp: result.Timestamp.UTC(),
 		Exchange:  result.ExchangeNameID,
 		Base:      strings.ToUpper(result.Base),
 		Quote:     strings.ToUpper(result.Quote),
 		AssetType: strings.ToLower(result.Asset),
 		Price:     result.Price,
 		Amount:    result.Amount,
-		Timestamp: ts,
 	}
 	if result.Side.Valid {
 		td.Side = result.Side.String
@@ -262,74 +220,78 @@ func getByUUIDSQLite(uuid string) (Data, error) {
 	return td, nil
 }
 
-func getByUUIDPostgres(uuid string) (td Data, err error) {
-	query := postgres.Trades(qm.Where("id = ?", uuid))
-	var result *postgres.Trade
-	result, err = query.One(context.Background(), database.DB.SQL)
+// GetInRange returns all trades by an exchange in a date range. Trades stored
+// under a previous symbol of the pair are included for the periods before each
+// rename and are returned under the requested pair
+func GetInRange(exchangeName, assetType, base, quote string, startDate, endDate time.Time) (td []Data, err error) {
+	segments, err := symbolhistory.ResolveSegments(exchangeName, assetType, base, quote, startDate, endDate)
 	if err != nil {
-		return td, err
+		return nil, fmt.Errorf("trade.GetByExchangeInRange %w", err)
 	}
-
-	td = Data{
-		ID:        result.ID,
-		Timestamp: result.Timestamp.UTC(),
-		Exchange:  result.ExchangeNameID,
-		Base:      strings.ToUpper(result.Base),
-		Quote:     strings.ToUpper(result.Quote),
-		AssetType: strings.ToLower(result.Asset),
-		Price:     result.Price,
-		Amount:    result.Amount,
-	}
-	if result.Side.Valid {
-		td.Side = result.Side.String
+	for i := range segments {
+		var trades []Data
+		trades, err = getSegmentInRange(exchangeName, assetType, segments[i].Base, segments[i].Quote, segments[i].Start, segments[i].End)
+		if err != nil {
+			return td, err
+		}
+		if len(segments) > 1 {
+			for j := range trades {
+				trades[j].Base = strings.ToUpper(base)
+				trades[j].Quote = strings.ToUpper(quote)
+			}
+		}
+		td = append(td, trades...)
 	}
 	return td, nil
 }
 
-// GetInRange returns all trades by an exchange in a date range
-func GetInRange(exchangeName, assetType, base, quote string, startDate, endDate time.Time) (td []Data, err error) {
-	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
-		td, err = getInRangeSQLite(exchangeName, assetType, base, quote, startDate, endDate)
+func getSegmentInRange(exchangeName, assetType, base, quote string, startDate, endDate time.Time) (td []Data, err error) {
+	store, err := repository.GetTimeSeriesStore()
+	if err != nil {
+		return nil, err
+	}
+	if store != nil {
+		td, err = getInRangeTimeSeries(store, exchangeName, assetType, base, quote, startDate, endDate)
 		if err != nil {
-			return td, fmt.Errorf("trade.GetByExchangeInRange getInRangeSQLite %w", err)
+			return td, fmt.Errorf("trade.GetByExchangeInRange getInRangeTimeSeries %w", err)
 		}
 	} else {
-		td, err = getInRangePostgres(exchangeName, assetType, base, quote, startDate, endDate)
+		td, err = getInRange(exchangeName, assetType, base, quote, startDate, endDate)
 		if err != nil {
-			return td, fmt.Errorf("trade.GetByExchangeInRange getInRangePostgres %w", err)
+			return td, fmt.Errorf("trade.GetByExchangeInRange getInRange %w", err)
 		}
 	}
 
 	return td, nil
 }
 
-func getInRangeSQLite(exchangeName, assetType, base, quote string, startDate, endDate time.Time) (td []Data, err error) {
+func getInRange(exchangeName, assetType, base, quote string, startDate, endDate time.Time) (td []Data, err error) {
+	d, err := repository.GetDialect()
+	if err != nil {
+		return nil, err
+	}
 	var exchangeUUID uuid.UUID
 	exchangeUUID, err = exchange.UUIDByName(exchangeName)
 	if err != nil {
 		return nil, err
 	}
 	wheres := map[string]interface{}{
-		"exchange_name_id": exchangeUUID,
+		"exchange_name_id": exchangeUUID.String(),
 		"asset":            strings.ToLower(assetType),
 		"base":             strings.ToUpper(base),
 		"quote":            strings.ToUpper(quote),
 	}
-	q := generateQuery(wheres, startDate, endDate, true)
-	query := sqlite3.Trades(q...)
-	var result []*sqlite3.Trade
-	result, err = query.All(context.Background(), database.DB.SQL)
+
+	q := generateQuery(d, wheres, startDate, endDate)
+	var result []tradeRow
+	err = d.Query("trade", q...).Bind(context.Background(), database.DB.SQL, &result)
 	if err != nil {
 		return td, err
 	}
 	for i := range result {
-		ts, err := time.Parse(time.RFC3339, result[i].Timestamp)
-		if err != nil {
-			return td, err
-		}
 		t := Data{
 			ID:        result[i].ID,
-			Timestamp: ts,
+			Timestamp: result[i].Timestamp,
 			Exchange:  strings.ToLower(exchangeName),
 			Base:      strings.ToUpper(result[i].Base),
 			Quote:     strings.ToUpper(result[i].Quote),
@@ -345,47 +307,86 @@ func getInRangeSQLite(exchangeName, assetType, base, quote string, startDate, en
 	return td, nil
 }
 
-func getInRangePostgres(exchangeName, assetType, base, quote string, startDate, endDate time.Time) (td []Data, err error) {
-	var exchangeUUID uuid.UUID
-	exchangeUUID, err = exchange.UUIDByName(exchangeName)
+// ListSeries returns every stored trade series along with the timestamp of
+// its oldest trade
+func ListSeries() ([]StoredSeries, error) {
+	if database.DB.SQL == nil {
+		return nil, database.ErrDatabaseSupportDisabled
+	}
+	store, err := repository.GetTimeSeriesStore()
 	if err != nil {
 		return nil, err
 	}
-	wheres := map[string]interface{}{
-		"exchange_name_id": exchangeUUID,
-		"asset":            strings.ToLower(assetType),
-		"base":             strings.ToUpper(base),
-		"quote":            strings.ToUpper(quote),
+	var resp []StoredSeries
+	switch {
+	case store != nil:
+		series, errL := store.ListTradeSeries()
+		if errL != nil {
+			return nil, errL
+		}
+		for i := range series {
+			resp = append(resp, StoredSeries{
+				ExchangeNameID: series[i].Exchange,
+				Base:           series[i].Base,
+				Quote:          series[i].Quote,
+				AssetType:      series[i].Asset,
+				Oldest:         series[i].FirstDay,
+			})
+		}
+	default:
+		d, errD := repository.GetDialect()
+		if errD != nil {
+			return nil, errD
+		}
+		var rows []seriesRow
+		err = d.Query("trade",
+			qm.Select("exchange_name_id", "base", "quote", "asset", "MIN(timestamp) AS oldest"),
+			qm.GroupBy("exchange_name_id, base, quote, asset"),
+		).Bind(context.Background(), database.DB.SQL, &rows)
+		if err != nil {
+			return nil, err
+		}
+		for i := range rows {
+			resp = append(resp, StoredSeries{
+				ExchangeNameID: rows[i].ExchangeNameID,
+				Base:           rows[i].Base,
+				Quote:          rows[i].Quote,
+				AssetType:      rows[i].Asset,
+				Oldest:         rows[i].Oldest.UTC(),
+			})
+		}
 	}
 
-	q := generateQuery(wheres, startDate, endDate, false)
-	query := postgres.Trades(q...)
-	var result []*postgres.Trade
-	result, err = query.All(context.Background(), database.DB.SQL)
-	if err != nil {
-		return td, err
-	}
-	for i := range result {
-		t := Data{
-			ID:        result[i].ID,
-			Timestamp: result[i].Timestamp,
-			Exchange:  strings.ToLower(exchangeName),
-			Base:      strings.ToUpper(result[i].Base),
-			Quote:     strings.ToUpper(result[i].Quote),
-			AssetType: strings.ToLower(result[i].Asset),
-			Price:     result[i].Price,
-			Amount:    result[i].Amount,
-		}
-		if result[i].Side.Valid {
-			t.Side = result[i].Side.String
+	names := make(map[string]string)
+	for i := range resp {
+		name, ok := names[resp[i].ExchangeNameID]
+		if !ok {
+			exchangeUUID, errU := uuid.FromString(resp[i].ExchangeNameID)
+			if errU != nil {
+				return nil, errU
+			}
+			details, errE := exchange.OneByUUID(exchangeUUID)
+			if errE != nil {
+				return nil, errE
+			}
+			name = details.Name
+			names[resp[i].ExchangeNameID] = name
 		}
-		td = append(td, t)
+		resp[i].Exchange = name
 	}
-	return td, nil
+	return resp, nil
 }
 
 // DeleteTrades will remove trades from the database using trade.Data
 func DeleteTrades(trades ...Data) error {
+	store, err := repository.GetTimeSeriesStore()
+	if err != nil {
+		return err
+	}
+	if store != nil {
+		return deleteTradesTimeSeries(store, trades...)
+	}
+
 	ctx := context.Background()
 	ctx = boil.SkipTimestamps(ctx)
 
@@ -401,11 +402,7 @@ func DeleteTrades(trades ...Data) error {
 			}
 		}
 	}()
-	if repository.GetSQLDialect() == database.DBSQLite3 || repository.GetSQLDialect() == database.DBSQLite {
-		err = deleteTradesSQLite(context.Background(), tx, trades...)
-	} else {
-		err = deleteTradesPostgres(context.Background(), tx, trades...)
-	}
+	err = deleteTrades(context.Background(), tx, trades...)
 	if err != nil {
 		return err
 	}
@@ -413,34 +410,172 @@ func DeleteTrades(trades ...Data) error {
 	return tx.Commit()
 }
 
-func deleteTradesSQLite(ctx context.Context, tx *sql.Tx, trades ...Data) error {
+func deleteTrades(ctx context.Context, tx *sql.Tx, trades ...Data) error {
+	d, err := repository.GetDialect()
+	if err != nil {
+		return err
+	}
 	var tradeIDs []interface{}
 	for i := range trades {
 		tradeIDs = append(tradeIDs, trades[i].ID)
 	}
-	query := sqlite3.Trades(qm.WhereIn(`id in ?`, tradeIDs...))
-	_, err := query.DeleteAll(ctx, tx)
+	_, err = d.Delete(ctx, tx, "trade", qm.WhereIn(`id in ?`, tradeIDs...))
 	return err
 }
 
-func deleteTradesPostgres(ctx context.Context, tx *sql.Tx, trades ...Data) error {
-	var tradeIDs []interface{}
+func insertTimeSeries(store *timeseries.Store, trades ...Data) error {
+	bySeries := make(map[timeseries.TradeSeries][]timeseries.Trade)
+	var order []timeseries.TradeSeries
 	for i := range trades {
-		tradeIDs = append(tradeIDs, trades[i].ID)
+		if trades[i].ID == "" {
+			freshUUID, err := uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			trades[i].ID = freshUUID.String()
+		}
+		series := timeSeriesSeries(trades[i].ExchangeNameID, trades[i].AssetType, trades[i].Base, trades[i].Quote)
+		if _, ok := bySeries[series]; !ok {
+			order = append(order, series)
+		}
+		bySeries[series] = append(bySeries[series], timeseries.Trade{
+			ID:        trades[i].ID,
+			TID:       trades[i].TID,
+			Price:     trades[i].Price,
+			Amount:    trades[i].Amount,
+			Side:      trades[i].Side,
+			Timestamp: trades[i].Timestamp,
+		})
+	}
+	for i := range order {
+		if _, err := store.InsertTrades(order[i], bySeries[order[i]]...); err != nil {
+			return err
+		}
 	}
-	query := postgres.Trades(qm.WhereIn(`id in ?`, tradeIDs...))
-	_, err := query.DeleteAll(ctx, tx)
-	return err
+	return nil
 }
 
-func generateQuery(clauses map[string]interface{}, start, end time.Time, isSQLite bool) []qm.QueryMod {
+func verifyTradeInIntervalsTimeSeries(store *timeseries.Store, exchangeName, assetType, base, quote string, irh *kline.IntervalRangeHolder) error {
+	if len(irh.Ranges) == 0 {
+		return nil
+	}
+	exchangeUUID, err := exchange.UUIDByName(exchangeName)
+	if err != nil {
+		return err
+	}
+	series := timeSeriesSeries(exchangeUUID.String(), assetType, base, quote)
+	for i := range irh.Ranges {
+		if len(irh.Ranges[i].Intervals) == 0 {
+			continue
+		}
+		trades, err := store.TradesInRange(series,
+			irh.Ranges[i].Intervals[0].Start.Time,
+			irh.Ranges[i].Intervals[len(irh.Ranges[i].Intervals)-1].End.Time)
+		if err != nil {
+			return err
+		}
+		for j := range irh.Ranges[i].Intervals {
+			start := irh.Ranges[i].Intervals[j].Start.Time
+			end := irh.Ranges[i].Intervals[j].End.Time
+			for k := range trades {
+				if !trades[k].Timestamp.Before(start) && !trades[k].Timestamp.After(end) {
+					irh.Ranges[i].Intervals[j].HasData = true
+					break
+				}
+			}
+		}
+	}
+	return nil
+}
+
+func getByUUIDTimeSeries(store *timeseries.Store, uuid string) (Data, error) {
+	series, result, err := store.TradeByID(uuid)
+	if err != nil {
+		return Data{}, err
+	}
+	return Data{
+		ID:        result.ID,
+		TID:       result.TID,
+		Exchange:  series.Exchange,
+		Base:      strings.ToUpper(series.Base),
+		Quote:     strings.ToUpper(series.Quote),
+		AssetType: strings.ToLower(series.Asset),
+		Price:     result.Price,
+		Amount:    result.Amount,
+		Side:      result.Side,
+		Timestamp: result.Timestamp,
+	}, nil
+}
+
+func getInRangeTimeSeries(store *timeseries.Store, exchangeName, assetType, base, quote string, startDate, endDate time.Time) ([]Data, error) {
+	exchangeUUID, err := exchange.UUIDByName(exchangeName)
+	if err != nil {
+		return nil, err
+	}
+	result, err := store.TradesInRange(timeSeriesSeries(exchangeUUID.String(), assetType, base, quote), startDate, endDate)
+	if err != nil {
+		return nil, err
+	}
+	var td []Data
+	for i := range result {
+		td = append(td, Data{
+			ID:        result[i].ID,
+			TID:       result[i].TID,
+			Timestamp: result[i].Timestamp,
+			Exchange:  strings.ToLower(exchangeName),
+			Base:      strings.ToUpper(base),
+			Quote:     strings.ToUpper(quote),
+			AssetType: strings.ToLower(assetType),
+			Price:     result[i].Price,
+			Amount:    result[i].Amount,
+			Side:      result[i].Side,
+		})
+	}
+	return td, nil
+}
+
+func deleteTradesTimeSeries(store *timeseries.Store, trades ...Data) error {
+	bySeries := make(map[timeseries.TradeSeries][]timeseries.Trade)
+	var order []timeseries.TradeSeries
+	for i := range trades {
+		exchangeID := trades[i].ExchangeNameID
+		if exchangeID == "" {
+			exchangeUUID, err := exchange.UUIDByName(trades[i].Exchange)
+			if err != nil {
+				return err
+			}
+			exchangeID = exchangeUUID.String()
+		}
+		series := timeSeriesSeries(exchangeID, trades[i].AssetType, trades[i].Base, trades[i].Quote)
+		if _, ok := bySeries[series]; !ok {
+			order = append(order, series)
+		}
+		bySeries[series] = append(bySeries[series], timeseries.Trade{
+			ID:        trades[i].ID,
+			Timestamp: trades[i].Timestamp,
+		})
+	}
+	for i := range order {
+		if _, err := store.DeleteTrades(order[i], bySeries[order[i]]...); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func timeSeriesSeries(exchangeID, assetType, base, quote string) timeseries.TradeSeries {
+	return timeseries.TradeSeries{
+		Exchange: exchangeID,
+		Asset:    strings.ToLower(assetType),
+		Base:     strings.ToUpper(base),
+		Quote:    strings.ToUpper(quote),
+	}
+}
+
+func generateQuery(d *repository.Dialect, clauses map[string]interface{}, start, end time.Time) []qm.QueryMod {
 	query := []qm.QueryMod{
 		qm.OrderBy("timestamp"),
-	}
-	if isSQLite {
-		query = append(query, qm.Where("timestamp BETWEEN ? AND ?", start.UTC().Format(time.RFC3339), end.UTC().Format(time.RFC3339)))
-	} else {
-		query = append(query, qm.Where("timestamp BETWEEN ? AND ?", start.UTC(), end.UTC()))
+		qm.Where("timestamp BETWEEN ? AND ?", d.Time(start), d.Time(end)),
 	}
 	for k, v := range clauses {
 		query = append(query, qm.Where(k+` = ?`, v))
@@ -1,6 +1,7 @@
 package trade
 
 import (
+	"errors"
 	"fmt"
 	"io/ioutil"
 	"log"
@@ -13,6 +14,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/database"
 	"github.com/thrasher-corp/gocryptotrader/database/drivers"
 	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/symbolhistory"
 	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
@@ -37,6 +39,7 @@ func TestMain(m *testing.M) {
 	}
 	var err error
 	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.MySQLTestDatabase = testhelpers.GetMySQLConnectionDetails()
 	testhelpers.TempDir, err = ioutil.TempDir("", "gct-temp")
 	if err != nil {
 		log.Fatal(err)
@@ -57,6 +60,11 @@ func TestTrades(t *testing.T) {
 			config: testhelpers.PostgresTestDatabase,
 			seedDB: seedDB,
 		},
+		{
+			name:   "mysql",
+			config: testhelpers.MySQLTestDatabase,
+			seedDB: seedDB,
+		},
 		{
 			name: "SQLite",
 			config: &database.Config{
@@ -65,6 +73,15 @@ func TestTrades(t *testing.T) {
 			},
 			seedDB: seedDB,
 		},
+		{
+			name: "SQLite time series file store",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb-timeseries"},
+				TimeSeries:        database.TimeSeriesConfig{Driver: database.TimeSeriesFileStore},
+			},
+			seedDB: seedDB,
+		},
 	}
 
 	for x := range testCases {
@@ -87,7 +104,7 @@ func TestTrades(t *testing.T) {
 				}
 			}
 
-			tradeSQLTester(t)
+			tradeSQLTester(t, dbConn)
 			err = testhelpers.CloseDatabase(dbConn)
 			if err != nil {
 				t.Error(err)
@@ -96,7 +113,7 @@ func TestTrades(t *testing.T) {
 	}
 }
 
-func tradeSQLTester(t *testing.T) {
+func tradeSQLTester(t *testing.T, dbConn *database.Instance) {
 	var trades, trades2 []Data
 	firstTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
 	for i := 0; i < 20; i++ {
@@ -167,6 +184,20 @@ func tradeSQLTester(t *testing.T) {
 		t.Error("Bad get!")
 	}
 
+	series, err := ListSeries()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if len(series) != 1 {
+		t.Fatalf("received: '%v' but expected: '%v'", len(series), 1)
+	}
+	if series[0].Exchange != testExchanges[0].Name ||
+		series[0].Base != currency.BTC.String() ||
+		series[0].AssetType != asset.Spot.String() ||
+		series[0].Oldest.After(firstTime.Add(time.Minute)) {
+		t.Errorf("unexpected series %+v", series[0])
+	}
+
 	ranges, err := kline.CalculateCandleDateRanges(firstTime, firstTime.Add(20*time.Minute), kline.OneMin, 100)
 	if err != nil {
 		t.Error(err)
@@ -179,6 +210,52 @@ func tradeSQLTester(t *testing.T) {
 	if err != nil {
 		t.Error(err)
 	}
+	if !ranges.Ranges[0].Intervals[0].HasData {
+		t.Error("expected interval to have data")
+	}
+
+	td, err := GetByUUID(trades[0].ID)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if !td.Timestamp.Equal(trades[0].Timestamp) {
+		t.Errorf("received: '%v' but expected: '%v'", td.Timestamp, trades[0].Timestamp)
+	}
+
+	// trades stored before a rename are returned under the new symbol
+	history, err := symbolhistory.Setup(dbConn)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = history.Insert(&symbolhistory.Event{
+		Exchange:      testExchanges[0].Name,
+		Asset:         asset.Spot.String(),
+		Base:          currency.BTC.String(),
+		Quote:         currency.USD.String(),
+		Event:         symbolhistory.Renamed,
+		NewBase:       currency.XBT.String(),
+		NewQuote:      currency.USD.String(),
+		EffectiveDate: firstTime.Add(time.Minute * 10),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	v, err = GetInRange(
+		testExchanges[0].Name,
+		asset.Spot.String(),
+		currency.XBT.String(),
+		currency.USD.String(),
+		firstTime.Add(-time.Hour),
+		firstTime.Add(time.Hour))
+	if err != nil {
+		t.Error(err)
+	}
+	if len(v) != 9 {
+		t.Fatalf("received: '%v' but expected: '%v'", len(v), 9)
+	}
+	if v[0].Base != currency.XBT.String() {
+		t.Errorf("received: '%v' but expected: '%v'", v[0].Base, currency.XBT)
+	}
 
 	err = DeleteTrades(trades...)
 	if err != nil {
@@ -209,6 +286,7 @@ func seedDB() error {
 	if err != nil {
 		return err
 	}
-
+	exchange.ResetExchangeCache()
+	symbolhistory.ResetRenameCache()
 	return nil
 }
@@ -1,6 +1,11 @@
 package trade
 
-import "time"
+import (
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/volatiletech/null"
+)
 
 // Data defines trade data in its simplest
 // db friendly form
@@ -17,3 +22,38 @@ type Data struct {
 	Side           string
 	Timestamp      time.Time
 }
+
+// StoredSeries identifies a stored trade series and the timestamp of its
+// oldest trade
+type StoredSeries struct {
+	Exchange       string
+	ExchangeNameID string
+	Base           string
+	Quote          string
+	AssetType      string
+	Oldest         time.Time
+}
+
+// seriesRow is a stored trade series along with the timestamp of its
+// oldest trade
+type seriesRow struct {
+	ExchangeNameID string               `boil:"exchange_name_id"`
+	Base           string               `boil:"base"`
+	Quote          string               `boil:"quote"`
+	Asset          string               `boil:"asset"`
+	Oldest         repository.Timestamp `boil:"oldest"`
+}
+
+// tradeRow is a trade as stored by every driver
+type tradeRow struct {
+	ID             string      `boil:"id"`
+	ExchangeNameID string      `boil:"exchange_name_id"`
+	Tid            null.String `boil:"tid"`
+	Base           string      `boil:"base"`
+	Quote          string      `boil:"quote"`
+	Asset          string      `boil:"asset"`
+	Price          float64     `boil:"price"`
+	Amount         float64     `boil:"amount"`
+	Side           null.String `boil:"side"`
+	Timestamp      time.Time   `boil:"timestamp"`
+}
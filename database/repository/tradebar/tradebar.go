@@ -0,0 +1,325 @@
+package tradebar
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/volatiletech/null"
+)
+
+const barScopeQuery = "exchange_name_id = ? AND asset = ? AND base = ? AND quote = ? AND bar_type = ? AND threshold = ? AND start_time >= ? AND start_time < ?"
+
+// Setup returns a DBService
+func Setup(db database.IDatabase) (*DBService, error) {
+	if db == nil {
+		return nil, nil
+	}
+	if !db.IsConnected() {
+		return nil, nil
+	}
+	cfg := db.GetConfig()
+	dbCon, err := db.GetSQL()
+	if err != nil {
+		return nil, err
+	}
+	return &DBService{
+		sql:    dbCon,
+		driver: cfg.Driver,
+	}, nil
+}
+
+// Replace removes all stored bars for an exchange, asset, pair, bar type and
+// threshold which start within the date range and saves the supplied bars in
+// their place. Bars are built from every trade in a range, so a rebuild
+// always replaces the range in full
+func (db *DBService) Replace(exchangeName, assetType, base, quote, barType string, threshold float64, startDate, endDate time.Time, bars ...*Bar) error {
+	if exchangeName == "" {
+		return errExchangeNameUnset
+	}
+	for i := range bars {
+		bars[i].Exchange = exchangeName
+		bars[i].Asset = strings.ToLower(assetType)
+		bars[i].Base = strings.ToUpper(base)
+		bars[i].Quote = strings.ToUpper(quote)
+		bars[i].BarType = barType
+		bars[i].Threshold = threshold
+	}
+	ctx := context.Background()
+
+	tx, err := db.sql.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("beginTx %w", err)
+	}
+	defer func() {
+		if err != nil {
+			errRB := tx.Rollback()
+			if errRB != nil {
+				log.Errorf(log.DatabaseMgr, "Replace tx.Rollback %v", errRB)
+			}
+		}
+	}()
+
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		err = replaceSQLite(ctx, tx, exchangeName, assetType, base, quote, barType, threshold, startDate, endDate, bars...)
+	case database.DBPostgreSQL, database.DBMySQL:
+		err = replace(ctx, tx, db.driver, exchangeName, assetType, base, quote, barType, threshold, startDate, endDate, bars...)
+	default:
+		return database.ErrNoDatabaseProvided
+	}
+	if err != nil {
+		return err
+	}
+
+	return tx.Commit()
+}
+
+// GetInRange returns all stored bars for an exchange, asset, pair, bar type
+// and threshold which start within the date range ordered by start time
+func (db *DBService) GetInRange(exchangeName, assetType, base, quote, barType string, threshold float64, startDate, endDate time.Time) ([]Bar, error) {
+	switch db.driver {
+	case database.DBSQLite3, database.DBSQLite:
+		return db.getInRangeSQLite(exchangeName, assetType, base, quote, barType, threshold, startDate, endDate)
+	case database.DBPostgreSQL, database.DBMySQL:
+		return db.getInRange(exchangeName, assetType, base, quote, barType, threshold, startDate, endDate)
+	default:
+		return nil, database.ErrNoDatabaseProvided
+	}
+}
+
+func replaceSQLite(ctx context.Context, tx *sql.Tx, exchangeName, assetType, base, quote, barType string, threshold float64, startDate, endDate time.Time, bars ...*Bar) error {
+	exch, err := sqlite3.Exchanges(
+		qm.Where("name = ?", strings.ToLower(exchangeName))).One(ctx, tx)
+	if err != nil {
+		return fmt.Errorf("could not retrieve exchange '%v', %w", exchangeName, err)
+	}
+	_, err = sqlite3.TradeBars(
+		qm.Where(barScopeQuery,
+			exch.ID,
+			strings.ToLower(assetType),
+			strings.ToUpper(base),
+			strings.ToUpper(quote),
+			barType,
+			threshold,
+			startDate.UTC().Format(time.RFC3339),
+			endDate.UTC().Format(time.RFC3339))).DeleteAll(ctx, tx)
+	if err != nil {
+		return err
+	}
+	for i := range bars {
+		if bars[i].ID == "" {
+			var freshUUID uuid.UUID
+			freshUUID, err = uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			bars[i].ID = freshUUID.String()
+		}
+		var tempEvent = sqlite3.TradeBar{
+			ID:             bars[i].ID,
+			ExchangeNameID: exch.ID,
+			Base:           bars[i].Base,
+			Quote:          bars[i].Quote,
+			Asset:          bars[i].Asset,
+			BarType:        bars[i].BarType,
+			Threshold:      bars[i].Threshold,
+			StartTime:      bars[i].StartTime.UTC().Format(time.RFC3339),
+			EndTime:        bars[i].EndTime.UTC().Format(time.RFC3339),
+			Open:           bars[i].Open,
+			High:           bars[i].High,
+			Low:            bars[i].Low,
+			Close:          bars[i].Close,
+			Volume:         bars[i].Volume,
+			Notional:       bars[i].Notional,
+			Imbalance:      bars[i].Imbalance,
+			TradeCount:     bars[i].TradeCount,
+		}
+		if bars[i].SourceJobID != "" {
+			tempEvent.SourceJobID = null.NewString(bars[i].SourceJobID, true)
+		}
+		err = tempEvent.Insert(ctx, tx, boil.Infer())
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func replace(ctx context.Context, tx *sql.Tx, driver, exchangeName, assetType, base, quote, barType string, threshold float64, startDate, endDate time.Time, bars ...*Bar) error {
+	d, err := repository.NewDialect(driver)
+	if err != nil {
+		return err
+	}
+	var exch exchange.Details
+	exch, err = exchange.OneByName(ctx, tx, d.Driver(), exchangeName)
+	if err != nil {
+		return fmt.Errorf("could not retrieve exchange '%v', %w", exchangeName, err)
+	}
+	_, err = d.Delete(ctx, tx, "trade_bar",
+		qm.Where(barScopeQuery,
+			exch.UUID.String(),
+			strings.ToLower(assetType),
+			strings.ToUpper(base),
+			strings.ToUpper(quote),
+			barType,
+			threshold,
+			startDate.UTC(),
+			endDate.UTC()))
+	if err != nil {
+		return err
+	}
+	for i := range bars {
+		if bars[i].ID == "" {
+			var freshUUID uuid.UUID
+			freshUUID, err = uuid.NewV4()
+			if err != nil {
+				return err
+			}
+			bars[i].ID = freshUUID.String()
+		}
+		var tempEvent = barRow{
+			ID:             bars[i].ID,
+			ExchangeNameID: exch.UUID.String(),
+			Base:           bars[i].Base,
+			Quote:          bars[i].Quote,
+			Asset:          bars[i].Asset,
+			BarType:        bars[i].BarType,
+			Threshold:      bars[i].Threshold,
+			StartTime:      bars[i].StartTime.UTC(),
+			EndTime:        bars[i].EndTime.UTC(),
+			Open:           bars[i].Open,
+			High:           bars[i].High,
+			Low:            bars[i].Low,
+			Close:          bars[i].Close,
+			Volume:         bars[i].Volume,
+			Notional:       bars[i].Notional,
+			Imbalance:      bars[i].Imbalance,
+			TradeCount:     bars[i].TradeCount,
+		}
+		if bars[i].SourceJobID != "" {
+			tempEvent.SourceJobID = null.NewString(bars[i].SourceJobID, true)
+		}
+		err = d.Insert(ctx, tx, "trade_bar", &tempEvent)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (db *DBService) getInRangeSQLite(exchangeName, assetType, base, quote, barType string, threshold float64, startDate, endDate time.Time) ([]Bar, error) {
+	ctx := context.Background()
+	exch, err := sqlite3.Exchanges(
+		qm.Where("name = ?", strings.ToLower(exchangeName))).One(ctx, db.sql)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve exchange '%v', %w", exchangeName, err)
+	}
+	results, err := sqlite3.TradeBars(
+		qm.Where(barScopeQuery,
+			exch.ID,
+			strings.ToLower(assetType),
+			strings.ToUpper(base),
+			strings.ToUpper(quote),
+			barType,
+			threshold,
+			startDate.UTC().Format(time.RFC3339),
+			endDate.UTC().Format(time.RFC3339)),
+		qm.OrderBy("start_time")).All(ctx, db.sql)
+	if err != nil {
+		return nil, err
+	}
+	bars := make([]Bar, len(results))
+	for i := range results {
+		bars[i] = Bar{
+			ID:          results[i].ID,
+			Exchange:    exch.Name,
+			Base:        results[i].Base,
+			Quote:       results[i].Quote,
+			Asset:       results[i].Asset,
+			BarType:     results[i].BarType,
+			Threshold:   results[i].Threshold,
+			Open:        results[i].Open,
+			High:        results[i].High,
+			Low:         results[i].Low,
+			Close:       results[i].Close,
+			Volume:      results[i].Volume,
+			Notional:    results[i].Notional,
+			Imbalance:   results[i].Imbalance,
+			TradeCount:  results[i].TradeCount,
+			SourceJobID: results[i].SourceJobID.String,
+		}
+		bars[i].StartTime, err = time.Parse(time.RFC3339, results[i].StartTime)
+		if err != nil {
+			return nil, err
+		}
+		bars[i].EndTime, err = time.Parse(time.RFC3339, results[i].EndTime)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return bars, nil
+}
+
+func (db *DBService) getInRange(exchangeName, assetType, base, quote, barType string, threshold float64, startDate, endDate time.Time) ([]Bar, error) {
+	d, err := repository.NewDialect(db.driver)
+	if err != nil {
+		return nil, err
+	}
+	ctx := context.Background()
+	var exch exchange.Details
+	exch, err = exchange.OneByName(ctx, db.sql, d.Driver(), exchangeName)
+	if err != nil {
+		return nil, fmt.Errorf("could not retrieve exchange '%v', %w", exchangeName, err)
+	}
+	var results []barRow
+	err = d.Query("trade_bar",
+		qm.Where(barScopeQuery,
+			exch.UUID.String(),
+			strings.ToLower(assetType),
+			strings.ToUpper(base),
+			strings.ToUpper(quote),
+			barType,
+			threshold,
+			startDate.UTC(),
+			endDate.UTC()),
+		qm.OrderBy("start_time")).Bind(ctx, db.sql, &results)
+	if err != nil {
+		return nil, err
+	}
+	bars := make([]Bar, len(results))
+	for i := range results {
+		bars[i] = Bar{
+			ID:          results[i].ID,
+			Exchange:    exch.Name,
+			Base:        results[i].Base,
+			Quote:       results[i].Quote,
+			Asset:       results[i].Asset,
+			BarType:     results[i].BarType,
+			Threshold:   results[i].Threshold,
+			StartTime:   results[i].StartTime.UTC(),
+			EndTime:     results[i].EndTime.UTC(),
+			Open:        results[i].Open,
+			High:        results[i].High,
+			Low:         results[i].Low,
+			Close:       results[i].Close,
+			Volume:      results[i].Volume,
+			Notional:    results[i].Notional,
+			Imbalance:   results[i].Imbalance,
+			TradeCount:  results[i].TradeCount,
+			SourceJobID: results[i].SourceJobID.String,
+		}
+	}
+	return bars, nil
+}
@@ -0,0 +1,225 @@
+package tradebar
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/database/testhelpers"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+var (
+	verbose       = false
+	testExchanges = []exchange.Details{
+		{
+			Name: "one",
+		},
+		{
+			Name: "two",
+		},
+	}
+)
+
+func TestMain(m *testing.M) {
+	if verbose {
+		testhelpers.EnableVerboseTestOutput()
+	}
+	var err error
+	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.MySQLTestDatabase = testhelpers.GetMySQLConnectionDetails()
+	testhelpers.TempDir, err = ioutil.TempDir("", "gct-temp")
+	if err != nil {
+		log.Fatal(err)
+	}
+	t := m.Run()
+	err = os.RemoveAll(testhelpers.TempDir)
+	if err != nil {
+		fmt.Printf("Failed to remove temp db file: %v", err)
+	}
+
+	os.Exit(t)
+}
+
+func seedDB() error {
+	return exchange.InsertMany(testExchanges)
+}
+
+func TestSetup(t *testing.T) {
+	t.Parallel()
+	db, err := Setup(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if db != nil {
+		t.Fatal("expected nil service without a database")
+	}
+}
+
+func TestTradeBars(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *database.Config
+		seedDB func() error
+	}{
+		{
+			name:   "postgresql",
+			config: testhelpers.PostgresTestDatabase,
+			seedDB: seedDB,
+		},
+		{
+			name:   "mysql",
+			config: testhelpers.MySQLTestDatabase,
+			seedDB: seedDB,
+		},
+		{
+			name: "SQLite",
+			config: &database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+			seedDB: seedDB,
+		},
+	}
+
+	for x := range testCases {
+		test := testCases[x]
+		t.Run(test.name, func(t *testing.T) {
+			if !testhelpers.CheckValidConfig(&test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := testhelpers.ConnectToDatabase(test.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if test.seedDB != nil {
+				err = test.seedDB()
+				if err != nil {
+					t.Error(err)
+				}
+			}
+
+			db, err := Setup(dbConn)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			barSQLTester(t, db)
+
+			err = testhelpers.CloseDatabase(dbConn)
+			if err != nil {
+				t.Error(err)
+			}
+		})
+	}
+}
+
+func barSQLTester(t *testing.T, db *DBService) {
+	t.Helper()
+	firstTime := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	err := db.Replace("", asset.Spot.String(), currency.BTC.String(), currency.USDT.String(), "tick", 100, firstTime, firstTime.Add(time.Hour*24))
+	if !errors.Is(err, errExchangeNameUnset) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errExchangeNameUnset)
+	}
+
+	var bars []*Bar
+	for i := 0; i < 10; i++ {
+		bars = append(bars, &Bar{
+			StartTime:  firstTime.Add(time.Hour * time.Duration(i*4)),
+			EndTime:    firstTime.Add(time.Hour * time.Duration(i*4+1)),
+			Open:       1,
+			High:       2,
+			Low:        0.5,
+			Close:      1.5,
+			Volume:     10,
+			Notional:   15,
+			Imbalance:  -2,
+			TradeCount: 100,
+		})
+	}
+	err = db.Replace(testExchanges[0].Name,
+		asset.Spot.String(),
+		currency.BTC.String(),
+		currency.USDT.String(),
+		"tick",
+		100,
+		firstTime,
+		firstTime.Add(time.Hour*40),
+		bars...)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// rebuilding the first day replaces only the bars within it
+	err = db.Replace(testExchanges[0].Name,
+		asset.Spot.String(),
+		currency.BTC.String(),
+		currency.USDT.String(),
+		"tick",
+		100,
+		firstTime,
+		firstTime.Add(time.Hour*24),
+		&Bar{
+			StartTime:  firstTime.Add(time.Hour),
+			EndTime:    firstTime.Add(time.Hour * 2),
+			Open:       3,
+			High:       3,
+			Low:        3,
+			Close:      3,
+			Volume:     1,
+			Notional:   3,
+			Imbalance:  1,
+			TradeCount: 100,
+		})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	results, err := db.GetInRange(testExchanges[0].Name,
+		asset.Spot.String(),
+		currency.BTC.String(),
+		currency.USDT.String(),
+		"tick",
+		100,
+		firstTime,
+		firstTime.Add(time.Hour*40))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 5 {
+		t.Fatalf("received: '%v' but expected: '%v'", len(results), 5)
+	}
+	if results[0].Open != 3 || !results[0].StartTime.Equal(firstTime.Add(time.Hour)) {
+		t.Errorf("unexpected bar %+v", results[0])
+	}
+	if results[1].Base != currency.BTC.String() || results[1].Threshold != 100 ||
+		results[1].Imbalance != -2 || results[1].TradeCount != 100 ||
+		!results[1].EndTime.Equal(firstTime.Add(time.Hour*25)) {
+		t.Errorf("unexpected bar %+v", results[1])
+	}
+
+	results, err = db.GetInRange(testExchanges[0].Name,
+		asset.Spot.String(),
+		currency.BTC.String(),
+		currency.USDT.String(),
+		"tick",
+		50,
+		firstTime,
+		firstTime.Add(time.Hour*40))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(results) != 0 {
+		t.Fatalf("received: '%v' but expected: '%v'", len(results), 0)
+	}
+}
@@ -0,0 +1,69 @@
+package tradebar
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/volatiletech/null"
+)
+
+var errExchangeNameUnset = errors.New("exchange name not set, cannot insert")
+
+// Bar is a DTO for trade bar database data
+type Bar struct {
+	ID          string
+	Exchange    string
+	Base        string
+	Quote       string
+	Asset       string
+	BarType     string
+	Threshold   float64
+	StartTime   time.Time
+	EndTime     time.Time
+	Open        float64
+	High        float64
+	Low         float64
+	Close       float64
+	Volume      float64
+	Notional    float64
+	Imbalance   float64
+	TradeCount  int64
+	SourceJobID string
+}
+
+// DBService is a service which allows the interaction with
+// the database without a direct reference to a global
+type DBService struct {
+	sql    database.ISQL
+	driver string
+}
+
+// IDBService allows using trade bar database service
+// without needing to care about implementation
+type IDBService interface {
+	Replace(exchangeName, assetType, base, quote, barType string, threshold float64, startDate, endDate time.Time, bars ...*Bar) error
+	GetInRange(exchangeName, assetType, base, quote, barType string, threshold float64, startDate, endDate time.Time) ([]Bar, error)
+}
+
+// barRow is a trade bar as stored by drivers sharing a schema
+type barRow struct {
+	ID             string      `boil:"id"`
+	ExchangeNameID string      `boil:"exchange_name_id"`
+	Base           string      `boil:"base"`
+	Quote          string      `boil:"quote"`
+	Asset          string      `boil:"asset"`
+	BarType        string      `boil:"bar_type"`
+	Threshold      float64     `boil:"threshold"`
+	StartTime      time.Time   `boil:"start_time"`
+	EndTime        time.Time   `boil:"end_time"`
+	Open           float64     `boil:"open"`
+	High           float64     `boil:"high"`
+	Low            float64     `boil:"low"`
+	Close          float64     `boil:"close"`
+	Volume         float64     `boil:"volume"`
+	Notional       float64     `boil:"notional"`
+	Imbalance      float64     `boil:"imbalance"`
+	TradeCount     int64       `boil:"trade_count"`
+	SourceJobID    null.String `boil:"source_job_id"`
+}
@@ -9,8 +9,6 @@ import (
 	"github.com/gofrs/uuid"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/database"
-	modelPSQL "github.com/thrasher-corp/gocryptotrader/database/models/postgres"
-	modelSQLite "github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
 	"github.com/thrasher-corp/gocryptotrader/database/repository"
 	exchangeDB "github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
 	"github.com/thrasher-corp/gocryptotrader/log"
@@ -46,11 +44,7 @@ func Event(res *withdraw.Response) {
 		return
 	}
 
-	if repository.GetSQLDialect() == database.DBSQLite3 {
-		err = addSQLiteEvent(ctx, tx, res)
-	} else {
-		err = addPSQLEvent(ctx, tx, res)
-	}
+	err = addEvent(ctx, tx, res)
 	if err != nil {
 		log.Errorf(log.DatabaseMgr, "Event insert failed: %v", err)
 		err = tx.Rollback()
@@ -67,113 +61,38 @@ func Event(res *withdraw.Response) {
 	}
 }
 
-func addPSQLEvent(ctx context.Context, tx *sql.Tx, res *withdraw.Response) (err error) {
-	var tempEvent = modelPSQL.WithdrawalHistory{
-		ExchangeNameID: res.Exchange.Name,
-		ExchangeID:     res.Exchange.ID,
-		Status:         res.Exchange.Status,
-		Currency:       res.RequestDetails.Currency.String(),
-		Amount:         res.RequestDetails.Amount,
-		WithdrawType:   int(res.RequestDetails.Type),
-	}
-
-	if res.RequestDetails.Description != "" {
-		tempEvent.Description.SetValid(res.RequestDetails.Description)
-	}
-
-	err = tempEvent.Insert(ctx, tx, boil.Infer())
+func addEvent(ctx context.Context, tx *sql.Tx, res *withdraw.Response) error {
+	d, err := repository.GetDialect()
 	if err != nil {
-		log.Errorf(log.DatabaseMgr, "Event Insert failed: %v", err)
-		err = tx.Rollback()
-		if err != nil {
-			log.Errorf(log.DatabaseMgr, "Rollback failed: %v", err)
-		}
-		return
+		return err
 	}
-
-	if res.RequestDetails.Type == withdraw.Fiat {
-		fiatEvent := &modelPSQL.WithdrawalFiat{
-			BankName:          res.RequestDetails.Fiat.Bank.BankName,
-			BankAddress:       res.RequestDetails.Fiat.Bank.BankAddress,
-			BankAccountName:   res.RequestDetails.Fiat.Bank.AccountName,
-			BankAccountNumber: res.RequestDetails.Fiat.Bank.AccountNumber,
-			BSB:               res.RequestDetails.Fiat.Bank.BSBNumber,
-			SwiftCode:         res.RequestDetails.Fiat.Bank.SWIFTCode,
-			Iban:              res.RequestDetails.Fiat.Bank.IBAN,
-		}
-		err = tempEvent.SetWithdrawalFiatWithdrawalFiats(ctx, tx, true, fiatEvent)
-		if err != nil {
-			log.Errorf(log.DatabaseMgr, "Event Insert failed: %v", err)
-			err = tx.Rollback()
-			if err != nil {
-				log.Errorf(log.DatabaseMgr, "Rollback failed: %v", err)
-			}
-			return
-		}
-	}
-
-	if res.RequestDetails.Type == withdraw.Crypto {
-		cryptoEvent := &modelPSQL.WithdrawalCrypto{
-			Address: res.RequestDetails.Crypto.Address,
-			Fee:     res.RequestDetails.Crypto.FeeAmount,
-		}
-		if res.RequestDetails.Crypto.AddressTag != "" {
-			cryptoEvent.AddressTag.SetValid(res.RequestDetails.Crypto.AddressTag)
-		}
-		err = tempEvent.AddWithdrawalCryptoWithdrawalCryptos(ctx, tx, true, cryptoEvent)
-		if err != nil {
-			log.Errorf(log.DatabaseMgr, "Event Insert failed: %v", err)
-			err = tx.Rollback()
-			if err != nil {
-				log.Errorf(log.DatabaseMgr, "Rollback failed: %v", err)
-			}
-			return
-		}
-	}
-
-	realID, _ := uuid.FromString(tempEvent.ID)
-	res.ID = realID
-
-	return nil
-}
-
-func addSQLiteEvent(ctx context.Context, tx *sql.Tx, res *withdraw.Response) (err error) {
-	newUUID, errUUID := uuid.NewV4()
-	if errUUID != nil {
-		log.Errorf(log.DatabaseMgr, "Failed to generate UUID: %v", errUUID)
-		err = tx.Rollback()
-		if err != nil {
-			log.Errorf(log.DatabaseMgr, "Rollback failed: %v", err)
-		}
-		return
+	newUUID, err := uuid.NewV4()
+	if err != nil {
+		return err
 	}
-
-	var tempEvent = modelSQLite.WithdrawalHistory{
+	now := time.Now().UTC()
+	var tempEvent = historyRow{
 		ID:             newUUID.String(),
 		ExchangeNameID: res.Exchange.Name,
 		ExchangeID:     res.Exchange.ID,
 		Status:         res.Exchange.Status,
 		Currency:       res.RequestDetails.Currency.String(),
 		Amount:         res.RequestDetails.Amount,
-		WithdrawType:   int64(res.RequestDetails.Type),
+		WithdrawType:   int(res.RequestDetails.Type),
+		CreatedAt:      now,
+		UpdatedAt:      now,
 	}
-
 	if res.RequestDetails.Description != "" {
 		tempEvent.Description.SetValid(res.RequestDetails.Description)
 	}
-
-	err = tempEvent.Insert(ctx, tx, boil.Infer())
+	err = d.Insert(ctx, tx, "withdrawal_history", &tempEvent)
 	if err != nil {
-		log.Errorf(log.DatabaseMgr, "Event Insert failed: %v", err)
-		err = tx.Rollback()
-		if err != nil {
-			log.Errorf(log.DatabaseMgr, "Rollback failed: %v", err)
-		}
-		return
+		return err
 	}
 
-	if res.RequestDetails.Type == withdraw.Fiat {
-		fiatEvent := &modelSQLite.WithdrawalFiat{
+	switch res.RequestDetails.Type {
+	case withdraw.Fiat:
+		err = d.InsertWith(ctx, tx, "withdrawal_fiat", &fiatRow{
 			BankName:          res.RequestDetails.Fiat.Bank.BankName,
 			BankAddress:       res.RequestDetails.Fiat.Bank.BankAddress,
 			BankAccountName:   res.RequestDetails.Fiat.Bank.AccountName,
@@ -181,38 +100,20 @@ func addSQLiteEvent(ctx context.Context, tx *sql.Tx, res *withdraw.Response) (er
 			BSB:               res.RequestDetails.Fiat.Bank.BSBNumber,
 			SwiftCode:         res.RequestDetails.Fiat.Bank.SWIFTCode,
 			Iban:              res.RequestDetails.Fiat.Bank.IBAN,
-		}
-
-		err = tempEvent.AddWithdrawalFiats(ctx, tx, true, fiatEvent)
-		if err != nil {
-			log.Errorf(log.DatabaseMgr, "Event Insert failed: %v", err)
-			err = tx.Rollback()
-			if err != nil {
-				log.Errorf(log.DatabaseMgr, "Rollback failed: %v", err)
-			}
-			return
-		}
-	}
-
-	if res.RequestDetails.Type == withdraw.Crypto {
-		cryptoEvent := &modelSQLite.WithdrawalCrypto{
+		}, map[string]interface{}{historyColumn(d, "withdrawal_fiat"): tempEvent.ID})
+	case withdraw.Crypto:
+		cryptoEvent := &cryptoRow{
 			Address: res.RequestDetails.Crypto.Address,
 			Fee:     res.RequestDetails.Crypto.FeeAmount,
 		}
-
 		if res.RequestDetails.Crypto.AddressTag != "" {
 			cryptoEvent.AddressTag.SetValid(res.RequestDetails.Crypto.AddressTag)
 		}
-
-		err = tempEvent.AddWithdrawalCryptos(ctx, tx, true, cryptoEvent)
-		if err != nil {
-			log.Errorf(log.DatabaseMgr, "Event Insert failed: %v", err)
-			err = tx.Rollback()
-			if err != nil {
-				log.Errorf(log.DatabaseMgr, "Rollback failed: %v", err)
-			}
-			return
-		}
+		err = d.InsertWith(ctx, tx, "withdrawal_crypto", cryptoEvent,
+			map[string]interface{}{historyColumn(d, "withdrawal_crypto"): tempEvent.ID})
+	}
+	if err != nil {
+		return err
 	}
 
 	res.ID = newUUID
@@ -254,7 +155,14 @@ func GetEventByExchangeID(exchange, id string) (*withdraw.Response, error) {
 
 // GetEventsByDate returns requested withdraw information by date range
 func GetEventsByDate(exchange string, start, end time.Time, limit int) ([]*withdraw.Response, error) {
-	betweenQuery := generateWhereBetweenQuery("created_at", start, end, limit)
+	if database.DB.SQL == nil {
+		return nil, database.ErrDatabaseSupportDisabled
+	}
+	d, err := repository.GetDialect()
+	if err != nil {
+		return nil, err
+	}
+	betweenQuery := generateWhereBetweenQuery("created_at", d.Time(start), d.Time(end), limit)
 	if exchange == "" {
 		return getByColumns(betweenQuery)
 	}
@@ -289,138 +197,99 @@ func getByColumns(q []qm.QueryMod) ([]*withdraw.Response, error) {
 		return nil, database.ErrDatabaseSupportDisabled
 	}
 
-	var resp []*withdraw.Response
-	var ctx = context.Background()
-	if repository.GetSQLDialect() == database.DBSQLite3 {
-		v, err := modelSQLite.WithdrawalHistories(q...).All(ctx, database.DB.SQL)
-		if err != nil {
-			return nil, err
-		}
-		for x := range v {
-			var tempResp = &withdraw.Response{}
-			var newUUID uuid.UUID
-			newUUID, err = uuid.FromString(v[x].ID)
-			if err != nil {
-				return nil, err
-			}
-			tempResp.ID = newUUID
-			tempResp.Exchange.ID = v[x].ExchangeID
-			tempResp.Exchange.Status = v[x].Status
-			tempResp.RequestDetails = withdraw.Request{
-				Currency:    currency.NewCode(v[x].Currency),
-				Description: v[x].Description.String,
-				Amount:      v[x].Amount,
-				Type:        withdraw.RequestType(v[x].WithdrawType),
-			}
-
-			exchangeName, err := v[x].ExchangeName().One(ctx, database.DB.SQL)
-			if err != nil {
-				log.Errorf(log.DatabaseMgr, "Unable to get exchange name")
-				tempUUID, errUUID := uuid.FromString(v[x].ExchangeNameID)
-				if errUUID != nil {
-					log.Errorf(log.DatabaseMgr, "invalid exchange name UUID for record %v", v[x].ID)
-				} else {
-					tempResp.Exchange.UUID = tempUUID
-				}
-			} else {
-				tempResp.Exchange.Name = exchangeName.Name
-			}
-
-			createdAtTime, err := time.Parse(time.RFC3339, v[x].CreatedAt)
-			if err != nil {
-				log.Errorf(log.DatabaseMgr, "record: %v has an incorrect time format ( %v ) - defaulting to empty time: %v", tempResp.ID, v[x].CreatedAt, err)
-				tempResp.CreatedAt = time.Time{}
-			} else {
-				tempResp.CreatedAt = createdAtTime
-			}
+	resp, err := getEvents(context.Background(), q)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp) == 0 {
+		return nil, ErrNoResults
+	}
+	return resp, nil
+}
 
-			updatedAtTime, err := time.Parse(time.RFC3339, v[x].UpdatedAt)
-			if err != nil {
-				log.Errorf(log.DatabaseMgr, "record: %v has an incorrect time format ( %v ) - defaulting to empty time: %v", tempResp.ID, v[x].UpdatedAt, err)
-				tempResp.UpdatedAt = time.Time{}
-			} else {
-				tempResp.UpdatedAt = updatedAtTime
-			}
+func getEvents(ctx context.Context, q []qm.QueryMod) ([]*withdraw.Response, error) {
+	d, err := repository.GetDialect()
+	if err != nil {
+		return nil, err
+	}
+	var v []historyRow
+	err = d.Query("withdrawal_history", q...).Bind(ctx, database.DB.SQL, &v)
+	if err != nil {
+		return nil, err
+	}
 
-			if withdraw.RequestType(v[x].WithdrawType) == withdraw.Crypto {
-				x, err := v[x].WithdrawalCryptos().One(ctx, database.DB.SQL)
-				if err != nil {
-					return nil, err
-				}
-				tempResp.RequestDetails.Crypto.Address = x.Address
-				tempResp.RequestDetails.Crypto.AddressTag = x.AddressTag.String
-				tempResp.RequestDetails.Crypto.FeeAmount = x.Fee
-			} else {
-				x, err := v[x].WithdrawalFiats().One(ctx, database.DB.SQL)
-				if err != nil {
-					return nil, err
-				}
-				tempResp.RequestDetails.Fiat.Bank.AccountName = x.BankAccountName
-				tempResp.RequestDetails.Fiat.Bank.AccountNumber = x.BankAccountNumber
-				tempResp.RequestDetails.Fiat.Bank.IBAN = x.Iban
-				tempResp.RequestDetails.Fiat.Bank.SWIFTCode = x.SwiftCode
-				tempResp.RequestDetails.Fiat.Bank.BSBNumber = x.BSB
-			}
-			resp = append(resp, tempResp)
-		}
-	} else {
-		v, err := modelPSQL.WithdrawalHistories(q...).All(ctx, database.DB.SQL)
+	resp := make([]*withdraw.Response, 0, len(v))
+	for x := range v {
+		var tempResp = &withdraw.Response{}
+		var newUUID uuid.UUID
+		newUUID, err = uuid.FromString(v[x].ID)
 		if err != nil {
 			return nil, err
 		}
+		tempResp.ID = newUUID
+		tempResp.Exchange.ID = v[x].ExchangeID
+		tempResp.Exchange.Status = v[x].Status
+		tempResp.RequestDetails = withdraw.Request{
+			Currency:    currency.NewCode(v[x].Currency),
+			Description: v[x].Description.String,
+			Amount:      v[x].Amount,
+			Type:        withdraw.RequestType(v[x].WithdrawType),
+		}
+		tempResp.CreatedAt = v[x].CreatedAt
+		tempResp.UpdatedAt = v[x].UpdatedAt
 
-		for x := range v {
-			var tempResp = &withdraw.Response{}
-			newUUID, _ := uuid.FromString(v[x].ID)
-			tempResp.ID = newUUID
-			tempResp.Exchange.ID = v[x].ExchangeID
-			tempResp.Exchange.Status = v[x].Status
-			tempResp.RequestDetails = withdraw.Request{
-				Currency:    currency.NewCode(v[x].Currency),
-				Description: v[x].Description.String,
-				Amount:      v[x].Amount,
-				Type:        withdraw.RequestType(v[x].WithdrawType),
+		var exchangeName exchangeDB.Details
+		exchangeName, err = exchangeDB.OneByID(ctx, database.DB.SQL, d.Driver(), v[x].ExchangeNameID)
+		if err != nil {
+			log.Errorf(log.DatabaseMgr, "Unable to get exchange name")
+			tempUUID, errUUID := uuid.FromString(v[x].ExchangeNameID)
+			if errUUID != nil {
+				log.Errorf(log.DatabaseMgr, "invalid exchange name UUID for record %v", v[x].ID)
+			} else {
+				tempResp.Exchange.UUID = tempUUID
 			}
-			tempResp.CreatedAt = v[x].CreatedAt
-			tempResp.UpdatedAt = v[x].UpdatedAt
+		} else {
+			tempResp.Exchange.Name = exchangeName.Name
+		}
 
-			exchangeName, err := v[x].ExchangeName().One(ctx, database.DB.SQL)
+		switch withdraw.RequestType(v[x].WithdrawType) {
+		case withdraw.Crypto:
+			var c cryptoRow
+			err = d.Query("withdrawal_crypto",
+				qm.Select(cryptoColumns...),
+				qm.Where(historyColumn(d, "withdrawal_crypto")+" = ?", v[x].ID),
+				qm.Limit(1)).Bind(ctx, database.DB.SQL, &c)
 			if err != nil {
-				log.Errorf(log.DatabaseMgr, "Unable to get exchange name")
-				tempUUID, errUUID := uuid.FromString(v[x].ExchangeNameID)
-				if errUUID != nil {
-					log.Errorf(log.DatabaseMgr, "invalid exchange name UUID for record %v", v[x].ID)
-				} else {
-					tempResp.Exchange.UUID = tempUUID
-				}
-			} else {
-				tempResp.Exchange.Name = exchangeName.Name
+				return nil, err
 			}
-
-			if withdraw.RequestType(v[x].WithdrawType) == withdraw.Crypto {
-				x, err := v[x].WithdrawalCryptoWithdrawalCryptos().One(ctx, database.DB.SQL)
-				if err != nil {
-					return nil, err
-				}
-				tempResp.RequestDetails.Crypto.Address = x.Address
-				tempResp.RequestDetails.Crypto.AddressTag = x.AddressTag.String
-				tempResp.RequestDetails.Crypto.FeeAmount = x.Fee
-			} else if withdraw.RequestType(v[x].WithdrawType) == withdraw.Fiat {
-				x, err := v[x].WithdrawalFiatWithdrawalFiats().One(ctx, database.DB.SQL)
-				if err != nil {
-					return nil, err
-				}
-				tempResp.RequestDetails.Fiat.Bank.AccountName = x.BankAccountName
-				tempResp.RequestDetails.Fiat.Bank.AccountNumber = x.BankAccountNumber
-				tempResp.RequestDetails.Fiat.Bank.IBAN = x.Iban
-				tempResp.RequestDetails.Fiat.Bank.SWIFTCode = x.SwiftCode
-				tempResp.RequestDetails.Fiat.Bank.BSBNumber = x.BSB
+			tempResp.RequestDetails.Crypto.Address = c.Address
+			tempResp.RequestDetails.Crypto.AddressTag = c.AddressTag.String
+			tempResp.RequestDetails.Crypto.FeeAmount = c.Fee
+		case withdraw.Fiat:
+			var f fiatRow
+			err = d.Query("withdrawal_fiat",
+				qm.Select(fiatColumns...),
+				qm.Where(historyColumn(d, "withdrawal_fiat")+" = ?", v[x].ID),
+				qm.Limit(1)).Bind(ctx, database.DB.SQL, &f)
+			if err != nil {
+				return nil, err
 			}
-			resp = append(resp, tempResp)
+			tempResp.RequestDetails.Fiat.Bank.AccountName = f.BankAccountName
+			tempResp.RequestDetails.Fiat.Bank.AccountNumber = f.BankAccountNumber
+			tempResp.RequestDetails.Fiat.Bank.IBAN = f.Iban
+			tempResp.RequestDetails.Fiat.Bank.SWIFTCode = f.SwiftCode
+			tempResp.RequestDetails.Fiat.Bank.BSBNumber = f.BSB
 		}
-	}
-	if len(resp) == 0 {
-		return nil, ErrNoResults
+		resp = append(resp, tempResp)
 	}
 	return resp, nil
 }
+
+// historyColumn returns the column of the table referencing the withdrawal
+// history, SQLite names the column after the history table
+func historyColumn(d *repository.Dialect, table string) string {
+	if d.Driver() == database.DBSQLite3 {
+		return "withdrawal_history_id"
+	}
+	return table + "_id"
+}
@@ -34,6 +34,7 @@ func TestMain(m *testing.M) {
 
 	var err error
 	testhelpers.PostgresTestDatabase = testhelpers.GetConnectionDetails()
+	testhelpers.MySQLTestDatabase = testhelpers.GetMySQLConnectionDetails()
 	testhelpers.TempDir, err = ioutil.TempDir("", "gct-temp")
 	if err != nil {
 		fmt.Printf("failed to create temp file: %v", err)
@@ -75,6 +76,13 @@ func TestWithdraw(t *testing.T) {
 			nil,
 			nil,
 		},
+		{
+			"MySQL-Write",
+			testhelpers.MySQLTestDatabase,
+			withdrawHelper,
+			nil,
+			nil,
+		},
 	}
 
 	for _, tests := range testCases {
@@ -0,0 +1,64 @@
+package withdraw
+
+import (
+	"time"
+
+	"github.com/volatiletech/null"
+)
+
+var (
+	// fiatColumns are the columns of fiatRow, the auto incrementing id is
+	// set by the database
+	fiatColumns = []string{
+		"bank_name",
+		"bank_address",
+		"bank_account_name",
+		"bank_account_number",
+		"bsb",
+		"swift_code",
+		"iban",
+		"bank_code",
+	}
+	// cryptoColumns are the columns of cryptoRow, the auto incrementing id is
+	// set by the database
+	cryptoColumns = []string{
+		"address",
+		"address_tag",
+		"fee",
+	}
+)
+
+// historyRow is a withdrawal as stored by every driver
+type historyRow struct {
+	ID             string      `boil:"id"`
+	ExchangeNameID string      `boil:"exchange_name_id"`
+	ExchangeID     string      `boil:"exchange_id"`
+	Status         string      `boil:"status"`
+	Currency       string      `boil:"currency"`
+	Amount         float64     `boil:"amount"`
+	Description    null.String `boil:"description"`
+	WithdrawType   int         `boil:"withdraw_type"`
+	CreatedAt      time.Time   `boil:"created_at"`
+	UpdatedAt      time.Time   `boil:"updated_at"`
+}
+
+// fiatRow holds the bank details of a fiat withdrawal, the column
+// referencing the withdrawal history is named by historyColumn
+type fiatRow struct {
+	BankName          string  `boil:"bank_name"`
+	BankAddress       string  `boil:"bank_address"`
+	BankAccountName   string  `boil:"bank_account_name"`
+	BankAccountNumber string  `boil:"bank_account_number"`
+	BSB               string  `boil:"bsb"`
+	SwiftCode         string  `boil:"swift_code"`
+	Iban              string  `boil:"iban"`
+	BankCode          float64 `boil:"bank_code"`
+}
+
+// cryptoRow holds the address details of a crypto withdrawal, the column
+// referencing the withdrawal history is named by historyColumn
+type cryptoRow struct {
+	Address    string      `boil:"address"`
+	AddressTag null.String `boil:"address_tag"`
+	Fee        float64     `boil:"fee"`
+}
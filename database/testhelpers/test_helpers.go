@@ -5,9 +5,11 @@ import (
 	"os"
 	"path/filepath"
 	"reflect"
+	"strconv"
 
 	"github.com/thrasher-corp/gocryptotrader/database"
 	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	mysqlConn "github.com/thrasher-corp/gocryptotrader/database/drivers/mysql"
 	psqlConn "github.com/thrasher-corp/gocryptotrader/database/drivers/postgres"
 	sqliteConn "github.com/thrasher-corp/gocryptotrader/database/drivers/sqlite3"
 	"github.com/thrasher-corp/gocryptotrader/database/repository"
@@ -21,6 +23,8 @@ var (
 	TempDir string
 	// PostgresTestDatabase postgresql database config details
 	PostgresTestDatabase *database.Config
+	// MySQLTestDatabase mysql database config details
+	MySQLTestDatabase *database.Config
 	// MigrationDir default folder for migration's
 	MigrationDir = filepath.Join("..", "..", "migrations")
 )
@@ -73,6 +77,34 @@ func GetConnectionDetails() *database.Config {
 	}
 }
 
+// GetMySQLConnectionDetails returns connection details for a MySQL or MariaDB
+// test instance, such as a local container, configured through the
+// GCT_MYSQL_HOST, GCT_MYSQL_PORT, GCT_MYSQL_USER, GCT_MYSQL_PASSWORD and
+// GCT_MYSQL_DATABASE environment variables. Empty connection details are
+// returned when GCT_MYSQL_HOST is not set so that tests are skipped
+func GetMySQLConnectionDetails() *database.Config {
+	cfg := &database.Config{
+		Enabled: true,
+		Driver:  database.DBMySQL,
+	}
+	host, exists := os.LookupEnv("GCT_MYSQL_HOST")
+	if !exists {
+		return cfg
+	}
+	port, err := strconv.ParseUint(os.Getenv("GCT_MYSQL_PORT"), 10, 16)
+	if err != nil {
+		port = 3306
+	}
+	cfg.ConnectionDetails = drivers.ConnectionDetails{
+		Host:     host,
+		Port:     uint16(port),
+		Username: os.Getenv("GCT_MYSQL_USER"),
+		Password: os.Getenv("GCT_MYSQL_PASSWORD"),
+		Database: os.Getenv("GCT_MYSQL_DATABASE"),
+	}
+	return cfg
+}
+
 // ConnectToDatabase opens connection to database and returns pointer to instance of database.DB
 func ConnectToDatabase(conn *database.Config) (dbConn *database.Instance, err error) {
 	err = database.DB.SetConfig(conn)
@@ -84,6 +116,11 @@ func ConnectToDatabase(conn *database.Config) (dbConn *database.Instance, err er
 		if err != nil {
 			return nil, err
 		}
+	} else if conn.Driver == database.DBMySQL {
+		dbConn, err = mysqlConn.Connect(conn)
+		if err != nil {
+			return nil, err
+		}
 	} else if conn.Driver == database.DBSQLite3 || conn.Driver == database.DBSQLite {
 		database.DB.DataPath = TempDir
 		dbConn, err = sqliteConn.Connect(conn.Database)
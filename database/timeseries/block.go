@@ -0,0 +1,179 @@
+package timeseries
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"math"
+)
+
+// encodeBlock writes columns as a single block. Timestamps and other integer
+// columns are delta encoded, floats are written as little endian bits and
+// strings are length prefixed. Columns must hold the same number of rows.
+func encodeBlock(w io.Writer, columns []column) error {
+	bw := bufio.NewWriter(w)
+	if _, err := bw.WriteString(blockMagic); err != nil {
+		return err
+	}
+	if err := bw.WriteByte(blockVersion); err != nil {
+		return err
+	}
+	rows := 0
+	if len(columns) > 0 {
+		rows = columns[0].len()
+	}
+	writeUvarint(bw, uint64(rows))
+	writeUvarint(bw, uint64(len(columns)))
+	var b [8]byte
+	for i := range columns {
+		if columns[i].len() != rows {
+			return fmt.Errorf("%w: column %d has %d rows, expected %d", errColumnLength, i, columns[i].len(), rows)
+		}
+		if err := bw.WriteByte(byte(columns[i].typ)); err != nil {
+			return err
+		}
+		switch columns[i].typ {
+		case columnInt64:
+			var prev int64
+			for _, v := range columns[i].ints {
+				writeVarint(bw, v-prev)
+				prev = v
+			}
+		case columnFloat64:
+			for _, v := range columns[i].floats {
+				binary.LittleEndian.PutUint64(b[:], math.Float64bits(v))
+				if _, err := bw.Write(b[:]); err != nil {
+					return err
+				}
+			}
+		case columnString:
+			for _, v := range columns[i].strings {
+				writeUvarint(bw, uint64(len(v)))
+				if _, err := bw.WriteString(v); err != nil {
+					return err
+				}
+			}
+		default:
+			return fmt.Errorf("%w: %d", errUnknownColumnType, columns[i].typ)
+		}
+	}
+	return bw.Flush()
+}
+
+// decodeBlock decodes a single block written by encodeBlock and verifies it
+// matches the expected column layout
+func decodeBlock(payload []byte, layout []columnType) ([]column, error) {
+	r := bytes.NewReader(payload)
+	header := make([]byte, len(blockMagic)+1)
+	if _, err := io.ReadFull(r, header); err != nil {
+		return nil, fmt.Errorf("%w: %v", errInvalidBlock, err)
+	}
+	if string(header[:len(blockMagic)]) != blockMagic {
+		return nil, errInvalidBlock
+	}
+	if header[len(blockMagic)] != blockVersion {
+		return nil, fmt.Errorf("%w: unsupported version %d", errInvalidBlock, header[len(blockMagic)])
+	}
+	rows, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, err
+	}
+	count, err := binary.ReadUvarint(r)
+	if err != nil {
+		return nil, err
+	}
+	if count != uint64(len(layout)) {
+		return nil, fmt.Errorf("%w: %d columns, expected %d", errInvalidBlock, count, len(layout))
+	}
+	// every row takes at least minRowSize bytes so the row count cannot
+	// exceed what remains of the payload
+	var minRowSize uint64
+	for i := range layout {
+		if layout[i] == columnFloat64 {
+			minRowSize += 8
+		} else {
+			minRowSize++
+		}
+	}
+	if minRowSize > 0 && rows > uint64(r.Len())/minRowSize {
+		return nil, fmt.Errorf("%w: %d rows exceeds payload", errInvalidBlock, rows)
+	}
+	columns := make([]column, len(layout))
+	var b [8]byte
+	for i := range columns {
+		var typ byte
+		typ, err = r.ReadByte()
+		if err != nil {
+			return nil, err
+		}
+		if columnType(typ) != layout[i] {
+			return nil, fmt.Errorf("%w: column %d type %d, expected %d", errInvalidBlock, i, typ, layout[i])
+		}
+		columns[i].typ = layout[i]
+		switch layout[i] {
+		case columnInt64:
+			columns[i].ints = make([]int64, rows)
+			var prev int64
+			for j := range columns[i].ints {
+				var delta int64
+				delta, err = binary.ReadVarint(r)
+				if err != nil {
+					return nil, err
+				}
+				prev += delta
+				columns[i].ints[j] = prev
+			}
+		case columnFloat64:
+			columns[i].floats = make([]float64, rows)
+			for j := range columns[i].floats {
+				if _, err = io.ReadFull(r, b[:]); err != nil {
+					return nil, err
+				}
+				columns[i].floats[j] = math.Float64frombits(binary.LittleEndian.Uint64(b[:]))
+			}
+		case columnString:
+			columns[i].strings = make([]string, rows)
+			for j := range columns[i].strings {
+				var size uint64
+				size, err = binary.ReadUvarint(r)
+				if err != nil {
+					return nil, err
+				}
+				if size > maxStringLength || size > uint64(r.Len()) {
+					return nil, fmt.Errorf("%w: string length %d", errInvalidBlock, size)
+				}
+				s := make([]byte, size)
+				if _, err = io.ReadFull(r, s); err != nil {
+					return nil, err
+				}
+				columns[i].strings[j] = string(s)
+			}
+		}
+	}
+	return columns, nil
+}
+
+func writeUvarint(w *bufio.Writer, v uint64) {
+	var b [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(b[:], v)
+	// bufio errors are sticky and returned by Flush
+	_, _ = w.Write(b[:n])
+}
+
+func writeVarint(w *bufio.Writer, v int64) {
+	var b [binary.MaxVarintLen64]byte
+	n := binary.PutVarint(b[:], v)
+	_, _ = w.Write(b[:n])
+}
+
+func (c *column) len() int {
+	switch c.typ {
+	case columnInt64:
+		return len(c.ints)
+	case columnFloat64:
+		return len(c.floats)
+	}
+	return len(c.strings)
+}
@@ -0,0 +1,212 @@
+package timeseries
+
+import (
+	"fmt"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// InsertCandles appends candles to their day partitions. A candle stored
+// again for the same timestamp replaces the earlier one when read.
+func (s *Store) InsertCandles(series CandleSeries, candles ...Candle) (uint64, error) {
+	if err := series.validate(); err != nil {
+		return 0, err
+	}
+	order, groups := groupByDay(len(candles), func(i int) time.Time { return candles[i].Timestamp })
+	var inserted uint64
+	for _, day := range order {
+		columns := newCandleColumns(len(groups[day]))
+		for _, i := range groups[day] {
+			columns.add(&candles[i])
+		}
+		partition := series.partition(s.path, day)
+		l := s.lock(partition)
+		l.Lock()
+		err := s.appendBlock(partition, columns)
+		l.Unlock()
+		if err != nil {
+			return inserted, err
+		}
+		inserted += uint64(len(groups[day]))
+	}
+	return inserted, nil
+}
+
+// CandlesInRange returns the candles between start and end inclusive,
+// ordered by timestamp
+func (s *Store) CandlesInRange(series CandleSeries, start, end time.Time) ([]Candle, error) {
+	if err := series.validate(); err != nil {
+		return nil, err
+	}
+	var resp []Candle
+	for _, day := range days(start, end) {
+		candles, err := s.readCandles(series.partition(s.path, day))
+		if err != nil {
+			return nil, err
+		}
+		for i := range candles {
+			if candles[i].Timestamp.Before(start) || candles[i].Timestamp.After(end) {
+				continue
+			}
+			resp = append(resp, candles[i])
+		}
+	}
+	return resp, nil
+}
+
+// DeleteCandles removes candles between start and end inclusive and returns
+// the number removed
+func (s *Store) DeleteCandles(series CandleSeries, start, end time.Time) (int64, error) {
+	if err := series.validate(); err != nil {
+		return 0, err
+	}
+	var deleted int64
+	for _, day := range days(start, end) {
+		partition := series.partition(s.path, day)
+		l := s.lock(partition)
+		l.Lock()
+		n, err := s.deleteCandles(partition, start, end)
+		l.Unlock()
+		if err != nil {
+			return deleted, err
+		}
+		deleted += n
+	}
+	return deleted, nil
+}
+
+func (s *Store) deleteCandles(partition string, start, end time.Time) (int64, error) {
+	candles, err := mergeCandles(readPartition(partition, candleLayout))
+	if err != nil || len(candles) == 0 {
+		return 0, err
+	}
+	columns := newCandleColumns(len(candles))
+	for i := range candles {
+		if !candles[i].Timestamp.Before(start) && !candles[i].Timestamp.After(end) {
+			continue
+		}
+		columns.add(&candles[i])
+	}
+	deleted := int64(len(candles) - columns[0].len())
+	if deleted == 0 {
+		return 0, nil
+	}
+	return deleted, s.rewritePartition(partition, columns)
+}
+
+func (s *Store) readCandles(partition string) ([]Candle, error) {
+	l := s.lock(partition)
+	l.RLock()
+	defer l.RUnlock()
+	return mergeCandles(readPartition(partition, candleLayout))
+}
+
+// mergeCandles flattens blocks into candles ordered by timestamp, later
+// blocks replace earlier candles with the same timestamp
+func mergeCandles(blocks [][]column, err error) ([]Candle, error) {
+	if err != nil {
+		return nil, err
+	}
+	byTime := make(map[int64]Candle)
+	for _, b := range blocks {
+		for i := range b[0].ints {
+			byTime[b[0].ints[i]] = Candle{
+				Timestamp:        time.Unix(0, b[0].ints[i]).UTC(),
+				Open:             b[1].floats[i],
+				High:             b[2].floats[i],
+				Low:              b[3].floats[i],
+				Close:            b[4].floats[i],
+				Volume:           b[5].floats[i],
+				SourceJobID:      b[6].strings[i],
+				ValidationJobID:  b[7].strings[i],
+				ValidationIssues: b[8].strings[i],
+			}
+		}
+	}
+	resp := make([]Candle, 0, len(byTime))
+	for _, c := range byTime {
+		resp = append(resp, c)
+	}
+	sort.Slice(resp, func(i, j int) bool { return resp[i].Timestamp.Before(resp[j].Timestamp) })
+	return resp, nil
+}
+
+type candleColumns []column
+
+func newCandleColumns(size int) candleColumns {
+	columns := make(candleColumns, len(candleLayout))
+	for i := range columns {
+		columns[i].typ = candleLayout[i]
+	}
+	columns[0].ints = make([]int64, 0, size)
+	for i := 1; i <= 5; i++ {
+		columns[i].floats = make([]float64, 0, size)
+	}
+	for i := 6; i <= 8; i++ {
+		columns[i].strings = make([]string, 0, size)
+	}
+	return columns
+}
+
+func (c candleColumns) add(candle *Candle) {
+	c[0].ints = append(c[0].ints, candle.Timestamp.UnixNano())
+	c[1].floats = append(c[1].floats, candle.Open)
+	c[2].floats = append(c[2].floats, candle.High)
+	c[3].floats = append(c[3].floats, candle.Low)
+	c[4].floats = append(c[4].floats, candle.Close)
+	c[5].floats = append(c[5].floats, candle.Volume)
+	c[6].strings = append(c[6].strings, candle.SourceJobID)
+	c[7].strings = append(c[7].strings, candle.ValidationJobID)
+	c[8].strings = append(c[8].strings, candle.ValidationIssues)
+}
+
+// ListCandleSeries returns every candle series held by the store along with
+// the first day each holds data for
+func (s *Store) ListCandleSeries() ([]StoredCandleSeries, error) {
+	dirs, err := listSeries(filepath.Join(s.path, candleDirectory), 4)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]StoredCandleSeries, 0, len(dirs))
+	for i := range dirs {
+		base, quote, ok := splitPair(dirs[i].components[2])
+		if !ok {
+			continue
+		}
+		interval, err := strconv.ParseInt(dirs[i].components[3], 10, 64)
+		if err != nil || interval <= 0 {
+			continue
+		}
+		resp = append(resp, StoredCandleSeries{
+			CandleSeries: CandleSeries{
+				Exchange: dirs[i].components[0],
+				Asset:    dirs[i].components[1],
+				Base:     base,
+				Quote:    quote,
+				Interval: interval,
+			},
+			FirstDay: dirs[i].firstDay,
+		})
+	}
+	return resp, nil
+}
+
+func (c *CandleSeries) validate() error {
+	if c.Interval <= 0 {
+		return fmt.Errorf("%w: interval %d", errInvalidSeries, c.Interval)
+	}
+	return checkComponents(c.Exchange, c.Asset, c.Base, c.Quote)
+}
+
+func (c *CandleSeries) partition(root string, day time.Time) string {
+	return filepath.Join(root,
+		candleDirectory,
+		c.Exchange,
+		strings.ToLower(c.Asset),
+		strings.ToUpper(c.Base)+"-"+strings.ToUpper(c.Quote),
+		strconv.FormatInt(c.Interval, 10),
+		day.Format(dateFormat)+fileExtension)
+}
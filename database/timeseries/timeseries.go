@@ -0,0 +1,250 @@
+package timeseries
+
+import (
+	"bufio"
+	"compress/gzip"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+)
+
+// Open returns the store rooted at path, creating the directory if needed.
+// Stores are shared per path so concurrent callers use the same partition
+// locks.
+func Open(path string) (*Store, error) {
+	if path == "" {
+		return nil, errPathUnset
+	}
+	abs, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+	storesMu.Lock()
+	defer storesMu.Unlock()
+	if s, ok := stores[abs]; ok {
+		return s, nil
+	}
+	if err = os.MkdirAll(abs, dirPermission); err != nil {
+		return nil, err
+	}
+	s := &Store{
+		path:       abs,
+		partitions: make(map[string]*sync.RWMutex),
+	}
+	stores[abs] = s
+	return s, nil
+}
+
+// Path returns the root directory of the store
+func (s *Store) Path() string {
+	return s.path
+}
+
+// lock returns the lock guarding a partition file
+func (s *Store) lock(partition string) *sync.RWMutex {
+	s.m.Lock()
+	defer s.m.Unlock()
+	l, ok := s.partitions[partition]
+	if !ok {
+		l = new(sync.RWMutex)
+		s.partitions[partition] = l
+	}
+	return l
+}
+
+// appendBlock appends columns to a partition as a new compressed block
+func (s *Store) appendBlock(partition string, columns []column) (err error) {
+	if err = os.MkdirAll(filepath.Dir(partition), dirPermission); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(partition, os.O_WRONLY|os.O_CREATE|os.O_APPEND, filePermission)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+	zw := gzip.NewWriter(f)
+	if err = encodeBlock(zw, columns); err != nil {
+		return err
+	}
+	return zw.Close()
+}
+
+// readPartition returns every block stored in a partition in insertion
+// order, a partition that does not exist holds no blocks
+func readPartition(partition string, layout []columnType) (blocks [][]column, err error) {
+	f, err := os.Open(partition)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	defer func() {
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+	}()
+
+	br := bufio.NewReader(f)
+	zr, err := gzip.NewReader(br)
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("%s: %w", partition, err)
+	}
+	for {
+		zr.Multistream(false)
+		// reading the member to EOF verifies its checksum
+		var payload []byte
+		payload, err = ioutil.ReadAll(zr)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", partition, err)
+		}
+		var columns []column
+		columns, err = decodeBlock(payload, layout)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", partition, err)
+		}
+		blocks = append(blocks, columns)
+		if err = zr.Reset(br); err != nil {
+			if errors.Is(err, io.EOF) {
+				return blocks, nil
+			}
+			return nil, fmt.Errorf("%s: %w", partition, err)
+		}
+	}
+}
+
+// rewritePartition atomically replaces a partition with a single block, the
+// partition is removed when no rows remain
+func (s *Store) rewritePartition(partition string, columns []column) error {
+	if len(columns) == 0 || columns[0].len() == 0 {
+		err := os.Remove(partition)
+		if errors.Is(err, os.ErrNotExist) {
+			return nil
+		}
+		return err
+	}
+	tmp := partition + ".tmp"
+	if err := os.Remove(tmp); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return err
+	}
+	if err := s.appendBlock(tmp, columns); err != nil {
+		return err
+	}
+	return os.Rename(tmp, partition)
+}
+
+// days returns the UTC days spanned by start and end inclusive
+func days(start, end time.Time) []time.Time {
+	start = start.UTC().Truncate(24 * time.Hour)
+	end = end.UTC()
+	var resp []time.Time
+	for d := start; !d.After(end); d = d.AddDate(0, 0, 1) {
+		resp = append(resp, d)
+	}
+	return resp
+}
+
+// groupByDay groups row indexes by the UTC day of their timestamp, days are
+// returned in ascending order
+func groupByDay(count int, timestamp func(int) time.Time) ([]time.Time, map[time.Time][]int) {
+	groups := make(map[time.Time][]int)
+	var order []time.Time
+	for i := 0; i < count; i++ {
+		day := timestamp(i).UTC().Truncate(24 * time.Hour)
+		if _, ok := groups[day]; !ok {
+			order = append(order, day)
+		}
+		groups[day] = append(groups[day], i)
+	}
+	sort.Slice(order, func(i, j int) bool { return order[i].Before(order[j]) })
+	return order, groups
+}
+
+// seriesDirectory is a directory holding the day partitions of a series
+type seriesDirectory struct {
+	components []string
+	firstDay   time.Time
+}
+
+// listSeries returns every directory depth levels below root which holds
+// at least one day partition, along with the earliest day held
+func listSeries(root string, depth int) ([]seriesDirectory, error) {
+	entries, err := ioutil.ReadDir(root)
+	if err != nil {
+		if errors.Is(err, os.ErrNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	if depth == 0 {
+		var firstDay time.Time
+		for i := range entries {
+			name := entries[i].Name()
+			if entries[i].IsDir() || !strings.HasSuffix(name, fileExtension) {
+				continue
+			}
+			day, err := time.Parse(dateFormat, strings.TrimSuffix(name, fileExtension))
+			if err != nil {
+				continue
+			}
+			if firstDay.IsZero() || day.Before(firstDay) {
+				firstDay = day
+			}
+		}
+		if firstDay.IsZero() {
+			return nil, nil
+		}
+		return []seriesDirectory{{firstDay: firstDay}}, nil
+	}
+	var resp []seriesDirectory
+	for i := range entries {
+		if !entries[i].IsDir() {
+			continue
+		}
+		children, err := listSeries(filepath.Join(root, entries[i].Name()), depth-1)
+		if err != nil {
+			return nil, err
+		}
+		for j := range children {
+			children[j].components = append([]string{entries[i].Name()}, children[j].components...)
+			resp = append(resp, children[j])
+		}
+	}
+	return resp, nil
+}
+
+// splitPair splits a BASE-QUOTE series directory name
+func splitPair(pair string) (base, quote string, ok bool) {
+	split := strings.SplitN(pair, "-", 2)
+	if len(split) != 2 || split[0] == "" || split[1] == "" {
+		return "", "", false
+	}
+	return split[0], split[1], true
+}
+
+// checkComponents ensures series fields are usable as path components
+func checkComponents(components ...string) error {
+	for i := range components {
+		if components[i] == "" ||
+			components[i] == "." ||
+			components[i] == ".." ||
+			strings.ContainsAny(components[i], `/\`) {
+			return fmt.Errorf("%w: invalid component %q", errInvalidSeries, components[i])
+		}
+	}
+	return nil
+}
@@ -0,0 +1,316 @@
+package timeseries
+
+import (
+	"bytes"
+	"errors"
+	"os"
+	"testing"
+	"time"
+)
+
+var (
+	testStart        = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	testCandleSeries = CandleSeries{Exchange: "binance", Asset: "spot", Base: "btc", Quote: "usdt", Interval: 3600}
+	testTradeSeries  = TradeSeries{Exchange: "binance", Asset: "spot", Base: "btc", Quote: "usdt"}
+)
+
+func TestOpen(t *testing.T) {
+	t.Parallel()
+	_, err := Open("")
+	if !errors.Is(err, errPathUnset) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errPathUnset)
+	}
+	dir := t.TempDir()
+	s, err := Open(dir)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	s2, err := Open(dir)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if s != s2 {
+		t.Error("expected stores to be shared per path")
+	}
+}
+
+func TestBlockEncoding(t *testing.T) {
+	t.Parallel()
+	columns := []column{
+		{typ: columnInt64, ints: []int64{10, 5, -3}},
+		{typ: columnFloat64, floats: []float64{1.5, 0, -2.25}},
+		{typ: columnString, strings: []string{"a", "", "abc"}},
+	}
+	var buf bytes.Buffer
+	if err := encodeBlock(&buf, columns); err != nil {
+		t.Fatal(err)
+	}
+	layout := []columnType{columnInt64, columnFloat64, columnString}
+	decoded, err := decodeBlock(buf.Bytes(), layout)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if decoded[0].ints[2] != -3 || decoded[1].floats[2] != -2.25 || decoded[2].strings[2] != "abc" {
+		t.Errorf("unexpected decoded block %+v", decoded)
+	}
+
+	_, err = decodeBlock(buf.Bytes(), layout[:2])
+	if !errors.Is(err, errInvalidBlock) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errInvalidBlock)
+	}
+	_, err = decodeBlock([]byte("nope!"), layout)
+	if !errors.Is(err, errInvalidBlock) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errInvalidBlock)
+	}
+
+	// a corrupt row count must not allocate beyond the payload
+	corrupt := append([]byte(blockMagic), blockVersion)
+	corrupt = append(corrupt, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0xff, 0x01, 3)
+	_, err = decodeBlock(corrupt, layout)
+	if !errors.Is(err, errInvalidBlock) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errInvalidBlock)
+	}
+	_, err = decodeBlock(buf.Bytes()[:buf.Len()-1], layout)
+	if !errors.Is(err, errInvalidBlock) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errInvalidBlock)
+	}
+
+	columns[1].floats = columns[1].floats[:1]
+	err = encodeBlock(&buf, columns)
+	if !errors.Is(err, errColumnLength) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errColumnLength)
+	}
+}
+
+func TestCandles(t *testing.T) {
+	t.Parallel()
+	s, err := Open(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = s.InsertCandles(CandleSeries{Exchange: "../binance", Asset: "spot", Base: "btc", Quote: "usdt", Interval: 60})
+	if !errors.Is(err, errInvalidSeries) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errInvalidSeries)
+	}
+	_, err = s.InsertCandles(CandleSeries{Exchange: "binance", Asset: "spot", Base: "btc", Quote: "usdt"})
+	if !errors.Is(err, errInvalidSeries) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errInvalidSeries)
+	}
+
+	var candles []Candle
+	for i := 0; i < 48; i++ {
+		candles = append(candles, Candle{
+			Timestamp: testStart.Add(time.Hour * time.Duration(i)),
+			Open:      float64(i),
+			Close:     float64(i),
+			Volume:    1,
+		})
+	}
+	inserted, err := s.InsertCandles(testCandleSeries, candles...)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if inserted != 48 {
+		t.Errorf("received: '%v' but expected: '%v'", inserted, 48)
+	}
+
+	// a second block for the same timestamp replaces the first when read
+	_, err = s.InsertCandles(testCandleSeries, Candle{
+		Timestamp:        testStart.Add(time.Hour),
+		Close:            1337,
+		ValidationIssues: "replaced",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := s.CandlesInRange(testCandleSeries, testStart.Add(time.Hour), testStart.Add(time.Hour*25))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if len(got) != 25 {
+		t.Fatalf("received: '%v' but expected: '%v'", len(got), 25)
+	}
+	if got[0].Close != 1337 || got[0].ValidationIssues != "replaced" {
+		t.Errorf("expected replaced candle, received %+v", got[0])
+	}
+	if !got[24].Timestamp.Equal(testStart.Add(time.Hour * 25)) {
+		t.Errorf("received: '%v' but expected: '%v'", got[24].Timestamp, testStart.Add(time.Hour*25))
+	}
+	for i := 1; i < len(got); i++ {
+		if !got[i-1].Timestamp.Before(got[i].Timestamp) {
+			t.Fatal("expected candles ordered by timestamp")
+		}
+	}
+
+	deleted, err := s.DeleteCandles(testCandleSeries, testStart.Add(time.Hour*23), testStart.Add(time.Hour*24))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if deleted != 2 {
+		t.Errorf("received: '%v' but expected: '%v'", deleted, 2)
+	}
+	got, err = s.CandlesInRange(testCandleSeries, testStart, testStart.AddDate(0, 0, 2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 46 {
+		t.Errorf("received: '%v' but expected: '%v'", len(got), 46)
+	}
+
+	deleted, err = s.DeleteCandles(testCandleSeries, testStart, testStart.AddDate(0, 0, 2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if deleted != 46 {
+		t.Errorf("received: '%v' but expected: '%v'", deleted, 46)
+	}
+	if _, err = os.Stat(testCandleSeries.partition(s.path, testStart)); !errors.Is(err, os.ErrNotExist) {
+		t.Errorf("expected empty partition to be removed, received: '%v'", err)
+	}
+}
+
+func TestTrades(t *testing.T) {
+	t.Parallel()
+	s, err := Open(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	trades := []Trade{
+		{ID: "1", TID: "a", Price: 1, Amount: 1, Side: "buy", Timestamp: testStart},
+		{ID: "2", TID: "a", Price: 2, Amount: 2, Side: "buy", Timestamp: testStart},
+		{ID: "3", Price: 3, Amount: 3, Side: "sell", Timestamp: testStart.Add(time.Hour * 25)},
+		{ID: "4", Price: 3, Amount: 3, Side: "SELL", Timestamp: testStart.Add(time.Hour * 25)},
+		{ID: "5", Price: 4, Amount: 4, Side: "buy", Timestamp: testStart.Add(time.Minute)},
+	}
+	inserted, err := s.InsertTrades(testTradeSeries, trades...)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if inserted != 3 {
+		t.Errorf("received: '%v' but expected: '%v'", inserted, 3)
+	}
+	inserted, err = s.InsertTrades(testTradeSeries, trades...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inserted != 0 {
+		t.Errorf("received: '%v' but expected: '%v'", inserted, 0)
+	}
+
+	got, err := s.TradesInRange(testTradeSeries, testStart, testStart.AddDate(0, 0, 2))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if len(got) != 3 {
+		t.Fatalf("received: '%v' but expected: '%v'", len(got), 3)
+	}
+	if got[0].ID != "1" || got[1].ID != "5" || got[2].ID != "3" || got[2].Side != "SELL" {
+		t.Errorf("unexpected trades %+v", got)
+	}
+
+	series, trade, err := s.TradeByID("5")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if series.Exchange != testTradeSeries.Exchange || series.Base != "BTC" || trade.Price != 4 {
+		t.Errorf("unexpected trade %+v %+v", series, trade)
+	}
+	_, _, err = s.TradeByID("1337")
+	if !errors.Is(err, ErrTradeNotFound) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrTradeNotFound)
+	}
+
+	deleted, err := s.DeleteTrades(testTradeSeries, got[1], got[2])
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if deleted != 2 {
+		t.Errorf("received: '%v' but expected: '%v'", deleted, 2)
+	}
+	got, err = s.TradesInRange(testTradeSeries, testStart, testStart.AddDate(0, 0, 2))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 1 || got[0].ID != "1" {
+		t.Errorf("unexpected trades %+v", got)
+	}
+}
+
+func TestCorruptPartition(t *testing.T) {
+	t.Parallel()
+	s, err := Open(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = s.InsertCandles(testCandleSeries, Candle{Timestamp: testStart})
+	if err != nil {
+		t.Fatal(err)
+	}
+	f, err := os.OpenFile(testCandleSeries.partition(s.path, testStart), os.O_WRONLY|os.O_APPEND, filePermission)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = f.WriteString("partial write"); err != nil {
+		t.Fatal(err)
+	}
+	if err = f.Close(); err != nil {
+		t.Fatal(err)
+	}
+	_, err = s.CandlesInRange(testCandleSeries, testStart, testStart.Add(time.Hour))
+	if err == nil {
+		t.Fatal("expected error reading corrupt partition")
+	}
+}
+
+func TestListSeries(t *testing.T) {
+	t.Parallel()
+	s, err := Open(t.TempDir())
+	if err != nil {
+		t.Fatal(err)
+	}
+	candleSeries, err := s.ListCandleSeries()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if len(candleSeries) != 0 {
+		t.Fatalf("received: '%v' but expected: '%v'", len(candleSeries), 0)
+	}
+
+	_, err = s.InsertCandles(testCandleSeries,
+		Candle{Timestamp: testStart.AddDate(0, 0, 2)},
+		Candle{Timestamp: testStart.Add(time.Hour)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = s.InsertTrades(testTradeSeries, Trade{ID: "1", Price: 1, Amount: 1, Timestamp: testStart.AddDate(0, 0, 1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	candleSeries, err = s.ListCandleSeries()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if len(candleSeries) != 1 {
+		t.Fatalf("received: '%v' but expected: '%v'", len(candleSeries), 1)
+	}
+	if candleSeries[0].Exchange != "binance" ||
+		candleSeries[0].Base != "BTC" ||
+		candleSeries[0].Quote != "USDT" ||
+		candleSeries[0].Interval != 3600 ||
+		!candleSeries[0].FirstDay.Equal(testStart) {
+		t.Errorf("unexpected series %+v", candleSeries[0])
+	}
+
+	tradeSeries, err := s.ListTradeSeries()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if len(tradeSeries) != 1 {
+		t.Fatalf("received: '%v' but expected: '%v'", len(tradeSeries), 1)
+	}
+	if tradeSeries[0].Asset != "spot" || !tradeSeries[0].FirstDay.Equal(testStart.AddDate(0, 0, 1)) {
+		t.Errorf("unexpected series %+v", tradeSeries[0])
+	}
+}
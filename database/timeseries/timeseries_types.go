@@ -0,0 +1,139 @@
+package timeseries
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+const (
+	blockMagic      = "GCTS"
+	blockVersion    = 1
+	fileExtension   = ".gcts"
+	dateFormat      = "2006-01-02"
+	candleDirectory = "candles"
+	tradeDirectory  = "trades"
+	maxStringLength = 1 << 20
+	dirPermission   = 0o770
+	filePermission  = 0o660
+)
+
+var (
+	// ErrTradeNotFound is returned when a trade ID does not exist in the store
+	ErrTradeNotFound = errors.New("trade not found")
+
+	errPathUnset         = errors.New("time series store path unset")
+	errInvalidSeries     = errors.New("invalid series")
+	errInvalidBlock      = errors.New("invalid time series block")
+	errColumnLength      = errors.New("column length mismatch")
+	errUnknownColumnType = errors.New("unknown column type")
+	// errTradeFound stops walking the store once a trade is found
+	errTradeFound = errors.New("trade found")
+
+	stores   = make(map[string]*Store)
+	storesMu sync.Mutex
+)
+
+// Store is an embedded, append-only columnar file store for candles and
+// trades. Data is partitioned into one file per exchange, asset, pair,
+// interval and UTC day. Each insert appends a gzip compressed block to the
+// day's file, deletes rewrite the affected days as a single block.
+type Store struct {
+	path       string
+	m          sync.Mutex
+	partitions map[string]*sync.RWMutex
+}
+
+// CandleSeries identifies a stored candle series, Exchange is the exchange's
+// database ID
+type CandleSeries struct {
+	Exchange string
+	Asset    string
+	Base     string
+	Quote    string
+	Interval int64
+}
+
+// StoredCandleSeries is a candle series held by the store and the first UTC
+// day it holds data for
+type StoredCandleSeries struct {
+	CandleSeries
+	FirstDay time.Time
+}
+
+// Candle holds a stored candle
+type Candle struct {
+	Timestamp        time.Time
+	Open             float64
+	High             float64
+	Low              float64
+	Close            float64
+	Volume           float64
+	SourceJobID      string
+	ValidationJobID  string
+	ValidationIssues string
+}
+
+// TradeSeries identifies a stored trade series, Exchange is the exchange's
+// database ID
+type TradeSeries struct {
+	Exchange string
+	Asset    string
+	Base     string
+	Quote    string
+}
+
+// StoredTradeSeries is a trade series held by the store and the first UTC
+// day it holds data for
+type StoredTradeSeries struct {
+	TradeSeries
+	FirstDay time.Time
+}
+
+// Trade holds a stored trade
+type Trade struct {
+	ID        string
+	TID       string
+	Price     float64
+	Amount    float64
+	Side      string
+	Timestamp time.Time
+}
+
+type columnType byte
+
+const (
+	columnInt64 columnType = iota + 1
+	columnFloat64
+	columnString
+)
+
+// column holds the values of one column of a block, only the slice matching
+// the column type is populated
+type column struct {
+	typ     columnType
+	ints    []int64
+	floats  []float64
+	strings []string
+}
+
+var candleLayout = []columnType{
+	columnInt64,   // timestamp
+	columnFloat64, // open
+	columnFloat64, // high
+	columnFloat64, // low
+	columnFloat64, // close
+	columnFloat64, // volume
+	columnString,  // source job ID
+	columnString,  // validation job ID
+	columnString,  // validation issues
+}
+
+var tradeLayout = []columnType{
+	columnInt64,   // timestamp
+	columnFloat64, // price
+	columnFloat64, // amount
+	columnString,  // ID
+	columnString,  // TID
+	columnString,  // side
+}
@@ -0,0 +1,286 @@
+package timeseries
+
+import (
+	"errors"
+	"io/fs"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// InsertTrades appends trades to their day partitions and returns the number
+// inserted. Trades already stored with the same TID, or without a TID and with
+// the same price, amount, side and timestamp, are ignored.
+func (s *Store) InsertTrades(series TradeSeries, trades ...Trade) (uint64, error) {
+	if err := series.validate(); err != nil {
+		return 0, err
+	}
+	order, groups := groupByDay(len(trades), func(i int) time.Time { return trades[i].Timestamp })
+	var inserted uint64
+	for _, day := range order {
+		partition := series.partition(s.path, day)
+		l := s.lock(partition)
+		l.Lock()
+		n, err := s.insertTrades(partition, trades, groups[day])
+		l.Unlock()
+		if err != nil {
+			return inserted, err
+		}
+		inserted += n
+	}
+	return inserted, nil
+}
+
+func (s *Store) insertTrades(partition string, trades []Trade, indexes []int) (uint64, error) {
+	existing, err := flattenTrades(readPartition(partition, tradeLayout))
+	if err != nil {
+		return 0, err
+	}
+	seen := make(map[string]bool, len(existing)+len(indexes))
+	for i := range existing {
+		seen[existing[i].key()] = true
+	}
+	columns := newTradeColumns(len(indexes))
+	for _, i := range indexes {
+		key := trades[i].key()
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		columns.add(&trades[i])
+	}
+	if columns[0].len() == 0 {
+		return 0, nil
+	}
+	return uint64(columns[0].len()), s.appendBlock(partition, columns)
+}
+
+// TradesInRange returns the trades between start and end inclusive, ordered
+// by timestamp
+func (s *Store) TradesInRange(series TradeSeries, start, end time.Time) ([]Trade, error) {
+	if err := series.validate(); err != nil {
+		return nil, err
+	}
+	var resp []Trade
+	for _, day := range days(start, end) {
+		trades, err := s.readTrades(series.partition(s.path, day))
+		if err != nil {
+			return nil, err
+		}
+		for i := range trades {
+			if trades[i].Timestamp.Before(start) || trades[i].Timestamp.After(end) {
+				continue
+			}
+			resp = append(resp, trades[i])
+		}
+	}
+	return resp, nil
+}
+
+// DeleteTrades removes trades by ID from the day partitions of their
+// timestamps and returns the number removed
+func (s *Store) DeleteTrades(series TradeSeries, trades ...Trade) (int64, error) {
+	if err := series.validate(); err != nil {
+		return 0, err
+	}
+	order, groups := groupByDay(len(trades), func(i int) time.Time { return trades[i].Timestamp })
+	var deleted int64
+	for _, day := range order {
+		ids := make(map[string]bool, len(groups[day]))
+		for _, i := range groups[day] {
+			ids[trades[i].ID] = true
+		}
+		partition := series.partition(s.path, day)
+		l := s.lock(partition)
+		l.Lock()
+		n, err := s.deleteTrades(partition, ids)
+		l.Unlock()
+		if err != nil {
+			return deleted, err
+		}
+		deleted += n
+	}
+	return deleted, nil
+}
+
+func (s *Store) deleteTrades(partition string, ids map[string]bool) (int64, error) {
+	trades, err := flattenTrades(readPartition(partition, tradeLayout))
+	if err != nil || len(trades) == 0 {
+		return 0, err
+	}
+	columns := newTradeColumns(len(trades))
+	for i := range trades {
+		if ids[trades[i].ID] {
+			continue
+		}
+		columns.add(&trades[i])
+	}
+	deleted := int64(len(trades) - columns[0].len())
+	if deleted == 0 {
+		return 0, nil
+	}
+	return deleted, s.rewritePartition(partition, columns)
+}
+
+// TradeByID searches every trade partition for a trade ID. This reads the
+// whole store and is intended for infrequent lookups.
+func (s *Store) TradeByID(id string) (TradeSeries, Trade, error) {
+	var series TradeSeries
+	var found Trade
+	root := filepath.Join(s.path, tradeDirectory)
+	err := filepath.WalkDir(root, func(path string, d fs.DirEntry, walkErr error) error {
+		if walkErr != nil {
+			if errors.Is(walkErr, fs.ErrNotExist) {
+				return nil
+			}
+			return walkErr
+		}
+		if d.IsDir() || filepath.Ext(path) != fileExtension {
+			return nil
+		}
+		trades, readErr := s.readTrades(path)
+		if readErr != nil {
+			return readErr
+		}
+		for i := range trades {
+			if trades[i].ID != id {
+				continue
+			}
+			rel, relErr := filepath.Rel(root, path)
+			if relErr != nil {
+				return relErr
+			}
+			parts := strings.Split(filepath.Dir(rel), string(filepath.Separator))
+			if len(parts) != 3 {
+				return errInvalidSeries
+			}
+			pair := strings.SplitN(parts[2], "-", 2)
+			if len(pair) != 2 {
+				return errInvalidSeries
+			}
+			series = TradeSeries{Exchange: parts[0], Asset: parts[1], Base: pair[0], Quote: pair[1]}
+			found = trades[i]
+			return errTradeFound
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, errTradeFound) {
+		return series, found, err
+	}
+	if found.ID == "" {
+		return series, found, ErrTradeNotFound
+	}
+	return series, found, nil
+}
+
+func (s *Store) readTrades(partition string) ([]Trade, error) {
+	l := s.lock(partition)
+	l.RLock()
+	defer l.RUnlock()
+	trades, err := flattenTrades(readPartition(partition, tradeLayout))
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(trades, func(i, j int) bool { return trades[i].Timestamp.Before(trades[j].Timestamp) })
+	return trades, nil
+}
+
+// flattenTrades returns the trades of every block in insertion order
+func flattenTrades(blocks [][]column, err error) ([]Trade, error) {
+	if err != nil {
+		return nil, err
+	}
+	var resp []Trade
+	for _, b := range blocks {
+		for i := range b[0].ints {
+			resp = append(resp, Trade{
+				Timestamp: time.Unix(0, b[0].ints[i]).UTC(),
+				Price:     b[1].floats[i],
+				Amount:    b[2].floats[i],
+				ID:        b[3].strings[i],
+				TID:       b[4].strings[i],
+				Side:      b[5].strings[i],
+			})
+		}
+	}
+	return resp, nil
+}
+
+// key returns the uniqueness key of a trade, mirroring the SQL trade table
+// constraints
+func (t *Trade) key() string {
+	if t.TID != "" {
+		return "tid:" + t.TID
+	}
+	return "trade:" +
+		strconv.FormatFloat(t.Price, 'g', -1, 64) + ":" +
+		strconv.FormatFloat(t.Amount, 'g', -1, 64) + ":" +
+		strings.ToUpper(t.Side) + ":" +
+		strconv.FormatInt(t.Timestamp.UnixNano(), 10)
+}
+
+type tradeColumns []column
+
+func newTradeColumns(size int) tradeColumns {
+	columns := make(tradeColumns, len(tradeLayout))
+	for i := range columns {
+		columns[i].typ = tradeLayout[i]
+	}
+	columns[0].ints = make([]int64, 0, size)
+	columns[1].floats = make([]float64, 0, size)
+	columns[2].floats = make([]float64, 0, size)
+	for i := 3; i <= 5; i++ {
+		columns[i].strings = make([]string, 0, size)
+	}
+	return columns
+}
+
+func (c tradeColumns) add(t *Trade) {
+	c[0].ints = append(c[0].ints, t.Timestamp.UnixNano())
+	c[1].floats = append(c[1].floats, t.Price)
+	c[2].floats = append(c[2].floats, t.Amount)
+	c[3].strings = append(c[3].strings, t.ID)
+	c[4].strings = append(c[4].strings, t.TID)
+	c[5].strings = append(c[5].strings, strings.ToUpper(t.Side))
+}
+
+// ListTradeSeries returns every trade series held by the store along with
+// the first day each holds data for
+func (s *Store) ListTradeSeries() ([]StoredTradeSeries, error) {
+	dirs, err := listSeries(filepath.Join(s.path, tradeDirectory), 3)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]StoredTradeSeries, 0, len(dirs))
+	for i := range dirs {
+		base, quote, ok := splitPair(dirs[i].components[2])
+		if !ok {
+			continue
+		}
+		resp = append(resp, StoredTradeSeries{
+			TradeSeries: TradeSeries{
+				Exchange: dirs[i].components[0],
+				Asset:    dirs[i].components[1],
+				Base:     base,
+				Quote:    quote,
+			},
+			FirstDay: dirs[i].firstDay,
+		})
+	}
+	return resp, nil
+}
+
+func (t *TradeSeries) validate() error {
+	return checkComponents(t.Exchange, t.Asset, t.Base, t.Quote)
+}
+
+func (t *TradeSeries) partition(root string, day time.Time) string {
+	return filepath.Join(root,
+		tradeDirectory,
+		t.Exchange,
+		strings.ToLower(t.Asset),
+		strings.ToUpper(t.Base)+"-"+strings.ToUpper(t.Quote),
+		day.Format(dateFormat)+fileExtension)
+}
@@ -346,6 +346,13 @@ func (d *Dispatcher) unsubscribe(id uuid.UUID, usedChan chan interface{}) error
 	return errors.New("dispatcher channel not found in uuid reference slice")
 }
 
+// hasSubscribers returns whether any channel is subscribed to the ID
+func (d *Dispatcher) hasSubscribers(id uuid.UUID) bool {
+	d.rMtx.RLock()
+	defer d.rMtx.RUnlock()
+	return len(d.routes[id]) > 0
+}
+
 // GetNewID returns a new ID
 func (d *Dispatcher) getNewID() (uuid.UUID, error) {
 	// Generate new uuid
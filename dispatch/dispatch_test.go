@@ -198,6 +198,10 @@ func TestMux(t *testing.T) {
 	if err == nil {
 		t.Error("error cannot be nil")
 	}
+
+	if mux.HasSubscribers(uuid.UUID{}) {
+		t.Error("nil mux cannot have subscribers")
+	}
 	mux = cpyMux
 
 	err = mux.Publish(nil, nil)
@@ -233,6 +237,10 @@ func TestSubscribe(t *testing.T) {
 		t.Fatal(err)
 	}
 
+	if mux.HasSubscribers(itemID) {
+		t.Error("expected no subscribers")
+	}
+
 	var pipes []Pipe
 	for i := 0; i < 1000; i++ {
 		newPipe, err := mux.Subscribe(itemID)
@@ -241,6 +249,9 @@ func TestSubscribe(t *testing.T) {
 		}
 		pipes = append(pipes, newPipe)
 	}
+	if !mux.HasSubscribers(itemID) {
+		t.Error("expected subscribers")
+	}
 
 	for i := range pipes {
 		err := pipes[i].Release()
@@ -248,6 +259,9 @@ func TestSubscribe(t *testing.T) {
 			t.Error(err)
 		}
 	}
+	if mux.HasSubscribers(itemID) {
+		t.Error("expected no subscribers")
+	}
 }
 
 func TestPublish(t *testing.T) {
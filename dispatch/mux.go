@@ -62,6 +62,15 @@ func (m *Mux) Publish(ids []uuid.UUID, data interface{}) error {
 	return nil
 }
 
+// HasSubscribers returns whether any pipe is subscribed to the ID, allowing
+// publishers to skip building payloads nobody will receive
+func (m *Mux) HasSubscribers(id uuid.UUID) bool {
+	if m == nil {
+		return false
+	}
+	return m.d.hasSubscribers(id)
+}
+
 // GetID a new unique ID to track routing information in the dispatch system
 func (m *Mux) GetID() (uuid.UUID, error) {
 	if m == nil {
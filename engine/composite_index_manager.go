@@ -0,0 +1,540 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetupCompositeIndexManager applies configuration parameters before running
+func SetupCompositeIndexManager(em iExchangeManager, dcm iDatabaseConnectionManager, cfg *config.CompositeIndexManager) (*CompositeIndexManager, error) {
+	if em == nil {
+		return nil, errNilExchangeManager
+	}
+	if cfg == nil {
+		return nil, errNilCompositeIndexConfig
+	}
+	if len(cfg.Indices) == 0 {
+		return nil, errNoCompositeIndices
+	}
+	if cfg.SaveCandles && dcm == nil {
+		return nil, errNilDatabaseConnectionManager
+	}
+	c := &CompositeIndexManager{
+		shutdown:          make(chan struct{}),
+		iExchangeManager:  em,
+		verbose:           cfg.Verbose,
+		exchangeName:      cfg.ExchangeName,
+		valuationCurrency: currency.NewCode(cfg.ValuationCurrency),
+		publishInterval:   cfg.PublishInterval,
+		staleAfter:        cfg.StaleAfter,
+		maxDeviation:      cfg.MaxDeviation,
+		minimumSources:    cfg.MinimumSources,
+		saveCandles:       cfg.SaveCandles,
+		candleInterval:    kline.Interval(cfg.CandleInterval),
+		tickerStreams:     make(map[string]bool),
+		tradeStreams:      make(map[string]bool),
+	}
+	if c.saveCandles {
+		c.candleSaver = func(item *kline.Item) error {
+			db := dcm.GetInstance()
+			if db == nil || !db.IsConnected() {
+				return fmt.Errorf("%s %w", CompositeIndexManagerName, database.ErrDatabaseSupportDisabled)
+			}
+			return storeCompositeIndexCandles(item)
+		}
+	}
+	for i := range cfg.Indices {
+		c.indices = append(c.indices, &compositeIndex{
+			pair:      cfg.Indices[i].Pair,
+			asset:     cfg.Indices[i].Asset,
+			exchanges: cfg.Indices[i].Exchanges,
+			sources:   make(map[string]*CompositeIndexSource),
+		})
+	}
+	return c, nil
+}
+
+// Start runs the subsystem
+func (c *CompositeIndexManager) Start() error {
+	if c == nil {
+		return fmt.Errorf("%s %w", CompositeIndexManagerName, ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&c.started, 0, 1) {
+		return fmt.Errorf("%s %w", CompositeIndexManagerName, ErrSubSystemAlreadyStarted)
+	}
+	log.Debugf(log.Ticker, "Composite index manager %s", MsgSubSystemStarting)
+	c.wg.Add(1)
+	go c.run()
+	log.Debugf(log.Ticker, "Composite index manager %s", MsgSubSystemStarted)
+	return nil
+}
+
+// Stop stops the subsystem
+func (c *CompositeIndexManager) Stop() error {
+	if c == nil {
+		return fmt.Errorf("%s %w", CompositeIndexManagerName, ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&c.started) == 0 {
+		return fmt.Errorf("%s %w", CompositeIndexManagerName, ErrSubSystemNotStarted)
+	}
+	log.Debugf(log.Ticker, "Composite index manager %s", MsgSubSystemShuttingDown)
+	close(c.shutdown)
+	c.wg.Wait()
+	c.shutdown = make(chan struct{})
+	atomic.StoreInt32(&c.started, 0)
+	log.Debugf(log.Ticker, "Composite index manager %s", MsgSubSystemShutdown)
+	return nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (c *CompositeIndexManager) IsRunning() bool {
+	if c == nil {
+		return false
+	}
+	return atomic.LoadInt32(&c.started) == 1
+}
+
+// GetExchangeName returns the synthetic exchange name index prices are
+// published and stored under
+func (c *CompositeIndexManager) GetExchangeName() string {
+	if c == nil {
+		return ""
+	}
+	return c.exchangeName
+}
+
+// GetIndexPrice returns the latest calculated index price for a currency pair
+func (c *CompositeIndexManager) GetIndexPrice(p currency.Pair, a asset.Item) (*CompositeIndexPrice, error) {
+	if !c.IsRunning() {
+		return nil, fmt.Errorf("%s %w", CompositeIndexManagerName, ErrSubSystemNotStarted)
+	}
+	for i := range c.indices {
+		if !c.indices[i].matches(p, a) {
+			continue
+		}
+		c.indices[i].m.Lock()
+		price := c.indices[i].price
+		c.indices[i].m.Unlock()
+		if price == nil {
+			return nil, fmt.Errorf("%w for %s %s", errCompositeIndexPriceNotReady, p, a)
+		}
+		cpy := *price
+		return &cpy, nil
+	}
+	return nil, fmt.Errorf("%w for %s %s", errCompositeIndexNotFound, p, a)
+}
+
+// GetIndexPrices returns the latest calculated price of every index that has
+// one
+func (c *CompositeIndexManager) GetIndexPrices() ([]CompositeIndexPrice, error) {
+	if !c.IsRunning() {
+		return nil, fmt.Errorf("%s %w", CompositeIndexManagerName, ErrSubSystemNotStarted)
+	}
+	var prices []CompositeIndexPrice
+	for i := range c.indices {
+		c.indices[i].m.Lock()
+		if c.indices[i].price != nil {
+			prices = append(prices, *c.indices[i].price)
+		}
+		c.indices[i].m.Unlock()
+	}
+	return prices, nil
+}
+
+// GetValue returns the value of an amount of currency in the configured
+// valuation currency using the spot index price of the currency
+func (c *CompositeIndexManager) GetValue(code currency.Code, amount float64) (value float64, valuation currency.Code, err error) {
+	if !c.IsRunning() {
+		return 0, currency.Code{}, fmt.Errorf("%s %w", CompositeIndexManagerName, ErrSubSystemNotStarted)
+	}
+	if code.Item == c.valuationCurrency.Item {
+		return amount, c.valuationCurrency, nil
+	}
+	price, err := c.GetIndexPrice(currency.NewPair(code, c.valuationCurrency), asset.Spot)
+	if err != nil {
+		return 0, currency.Code{}, fmt.Errorf("%w for %s in %s: %v", errNoCompositeIndexValuation, code, c.valuationCurrency, err)
+	}
+	return amount * price.Price, c.valuationCurrency, nil
+}
+
+// run subscribes to exchange streams as they become available and
+// recalculates index prices every publish interval
+func (c *CompositeIndexManager) run() {
+	defer c.wg.Done()
+	timer := time.NewTicker(c.publishInterval)
+	defer timer.Stop()
+	c.subscribe()
+	for {
+		select {
+		case <-c.shutdown:
+			return
+		case <-timer.C:
+			c.subscribe()
+			now := time.Now()
+			for i := range c.indices {
+				c.publish(c.indices[i], now)
+			}
+		}
+	}
+}
+
+// subscribe attaches to the ticker and trade streams of enabled exchanges
+// used by an index. Ticker streams only exist once an exchange has processed
+// a ticker, so exchanges are retried until they can be subscribed to
+func (c *CompositeIndexManager) subscribe() {
+	exchs, err := c.GetExchanges()
+	if err != nil {
+		log.Errorf(log.Ticker, "Composite index manager cannot get exchanges: %v", err)
+		return
+	}
+	for x := range exchs {
+		if !exchs[x].IsEnabled() {
+			continue
+		}
+		name := strings.ToLower(exchs[x].GetName())
+		if !c.isSource(name) {
+			continue
+		}
+		c.m.Lock()
+		tickerStream, tradeStream := c.tickerStreams[name], c.tradeStreams[name]
+		c.m.Unlock()
+		if !tickerStream {
+			pipe, err := ticker.SubscribeToExchangeTickers(name)
+			if err == nil {
+				c.seed(name)
+				c.m.Lock()
+				c.tickerStreams[name] = true
+				c.m.Unlock()
+				c.wg.Add(1)
+				go c.watch(name, pipe, c.tickerStreams)
+			} else if c.verbose {
+				log.Debugf(log.Ticker, "Composite index manager waiting on %s tickers: %v", name, err)
+			}
+		}
+		if !tradeStream {
+			pipe, err := trade.SubscribeToExchangeTrades(name)
+			if err != nil {
+				log.Errorf(log.Ticker, "Composite index manager cannot subscribe to %s trades: %v", name, err)
+				continue
+			}
+			c.m.Lock()
+			c.tradeStreams[name] = true
+			c.m.Unlock()
+			c.wg.Add(1)
+			go c.watch(name, pipe, c.tradeStreams)
+		}
+	}
+}
+
+// isSource returns whether an exchange is used by any index
+func (c *CompositeIndexManager) isSource(exch string) bool {
+	for i := range c.indices {
+		if c.indices[i].usesExchange(exch) {
+			return true
+		}
+	}
+	return false
+}
+
+// seed loads the tickers an exchange has already processed, as the ticker
+// stream only publishes subsequent updates
+func (c *CompositeIndexManager) seed(exch string) {
+	for i := range c.indices {
+		if !c.indices[i].usesExchange(exch) {
+			continue
+		}
+		t, err := ticker.GetTicker(exch, c.indices[i].pair, c.indices[i].asset)
+		if err != nil {
+			continue
+		}
+		c.updateTicker(t)
+	}
+}
+
+// watch routes stream updates to the indices until shutdown or until the
+// stream is closed, in which case the exchange will be resubscribed
+func (c *CompositeIndexManager) watch(exch string, pipe dispatch.Pipe, streams map[string]bool) {
+	defer c.wg.Done()
+	defer func() {
+		if err := pipe.Release(); err != nil {
+			log.Error(log.DispatchMgr, err)
+		}
+		c.m.Lock()
+		delete(streams, exch)
+		c.m.Unlock()
+	}()
+	for {
+		select {
+		case <-c.shutdown:
+			return
+		case data, ok := <-pipe.C:
+			if !ok {
+				return
+			}
+			d, ok := data.(*interface{})
+			if !ok {
+				continue
+			}
+			switch update := (*d).(type) {
+			case ticker.Price:
+				c.updateTicker(&update)
+			case trade.Data:
+				c.updateTrade(&update)
+			}
+		}
+	}
+}
+
+// updateTicker stores an exchange ticker's last price and volume against any
+// matching index
+func (c *CompositeIndexManager) updateTicker(t *ticker.Price) {
+	if t.Last <= 0 {
+		return
+	}
+	exch := strings.ToLower(t.ExchangeName)
+	for i := range c.indices {
+		if !c.indices[i].matches(t.Pair, t.AssetType) || !c.indices[i].usesExchange(exch) {
+			continue
+		}
+		lastUpdated := t.LastUpdated
+		if lastUpdated.IsZero() {
+			lastUpdated = time.Now()
+		}
+		c.indices[i].m.Lock()
+		source := c.indices[i].source(exch)
+		if !lastUpdated.Before(source.LastUpdated) {
+			source.Price = t.Last
+			source.LastUpdated = lastUpdated
+		}
+		source.Volume = t.Volume
+		c.indices[i].m.Unlock()
+	}
+}
+
+// updateTrade stores an exchange trade's price against any matching index
+// and accumulates the traded amount for the index candle
+func (c *CompositeIndexManager) updateTrade(t *trade.Data) {
+	if t.Price <= 0 {
+		return
+	}
+	exch := strings.ToLower(t.Exchange)
+	for i := range c.indices {
+		if !c.indices[i].matches(t.CurrencyPair, t.AssetType) || !c.indices[i].usesExchange(exch) {
+			continue
+		}
+		c.indices[i].m.Lock()
+		source := c.indices[i].source(exch)
+		if !t.Timestamp.Before(source.LastUpdated) {
+			source.Price = t.Price
+			source.LastUpdated = t.Timestamp
+		}
+		c.indices[i].volume += t.Amount
+		c.indices[i].m.Unlock()
+	}
+}
+
+// publish calculates an index price from its sources, pushes it through the
+// ticker service and adds it to the index candle
+func (c *CompositeIndexManager) publish(idx *compositeIndex, now time.Time) {
+	idx.m.Lock()
+	sources := make([]CompositeIndexSource, 0, len(idx.sources))
+	for _, source := range idx.sources {
+		sources = append(sources, *source)
+	}
+	idx.m.Unlock()
+	sort.Slice(sources, func(i, j int) bool {
+		return sources[i].Exchange < sources[j].Exchange
+	})
+	price, err := calculateCompositeIndexPrice(sources, now, c.staleAfter, c.maxDeviation, c.minimumSources)
+	if err != nil {
+		if c.verbose {
+			log.Debugf(log.Ticker, "Composite index manager %s %s: %v", idx.pair, idx.asset, err)
+		}
+		return
+	}
+	result := &CompositeIndexPrice{
+		Exchange:    c.exchangeName,
+		Pair:        idx.pair,
+		Asset:       idx.asset,
+		Price:       price,
+		Sources:     sources,
+		LastUpdated: now,
+	}
+	idx.m.Lock()
+	idx.price = result
+	volume := idx.volume
+	idx.volume = 0
+	completed := idx.addToCandle(price, volume, now, c.candleInterval)
+	idx.m.Unlock()
+
+	err = ticker.ProcessTicker(&ticker.Price{
+		Last:         price,
+		Close:        price,
+		Pair:         idx.pair,
+		ExchangeName: c.exchangeName,
+		AssetType:    idx.asset,
+		LastUpdated:  now,
+	})
+	if err != nil {
+		log.Errorf(log.Ticker, "Composite index manager cannot publish %s %s: %v", idx.pair, idx.asset, err)
+	}
+	if c.verbose {
+		log.Debugf(log.Ticker, "Composite index manager %s %s %s price: %v", c.exchangeName, idx.pair, idx.asset, price)
+	}
+	if completed == nil || c.candleSaver == nil {
+		return
+	}
+	err = c.candleSaver(&kline.Item{
+		Exchange: c.exchangeName,
+		Pair:     idx.pair,
+		Asset:    idx.asset,
+		Interval: c.candleInterval,
+		Candles:  []kline.Candle{*completed},
+	})
+	if err != nil {
+		log.Errorf(log.Ticker, "Composite index manager cannot save %s %s candle: %v", idx.pair, idx.asset, err)
+	}
+}
+
+// matches returns whether the index is for the currency pair and asset
+func (idx *compositeIndex) matches(p currency.Pair, a asset.Item) bool {
+	return idx.asset == a &&
+		idx.pair.Base.Item == p.Base.Item &&
+		idx.pair.Quote.Item == p.Quote.Item
+}
+
+// usesExchange returns whether an exchange is a source for the index
+func (idx *compositeIndex) usesExchange(exch string) bool {
+	return len(idx.exchanges) == 0 || common.StringDataCompareInsensitive(idx.exchanges, exch)
+}
+
+// source returns the index source for an exchange, creating it if required.
+// The index lock must be held
+func (idx *compositeIndex) source(exch string) *CompositeIndexSource {
+	source, ok := idx.sources[exch]
+	if !ok {
+		source = &CompositeIndexSource{Exchange: exch}
+		idx.sources[exch] = source
+	}
+	return source
+}
+
+// addToCandle updates the index candle with a price and traded volume and
+// returns the previous candle once a new interval has started. The index lock
+// must be held
+func (idx *compositeIndex) addToCandle(price, volume float64, now time.Time, interval kline.Interval) *kline.Candle {
+	start := now.Truncate(interval.Duration())
+	var completed *kline.Candle
+	if idx.candle != nil && !idx.candle.Time.Equal(start) {
+		completed = idx.candle
+		idx.candle = nil
+	}
+	if idx.candle == nil {
+		idx.candle = &kline.Candle{
+			Time:   start,
+			Open:   price,
+			High:   price,
+			Low:    price,
+			Close:  price,
+			Volume: volume,
+		}
+		return completed
+	}
+	idx.candle.High = math.Max(idx.candle.High, price)
+	idx.candle.Low = math.Min(idx.candle.Low, price)
+	idx.candle.Close = price
+	idx.candle.Volume += volume
+	return completed
+}
+
+// calculateCompositeIndexPrice returns the volume weighted median price of
+// the sources. Sources which have not updated within staleAfter are excluded,
+// followed by sources deviating from the median of the remaining prices by
+// more than maxDeviation percent. The exclusion reason is set on each
+// excluded source. When no remaining source reports volume every price is
+// weighted equally
+func calculateCompositeIndexPrice(sources []CompositeIndexSource, now time.Time, staleAfter time.Duration, maxDeviation float64, minimumSources int) (float64, error) {
+	var prices []float64
+	for i := range sources {
+		sources[i].ExcludedReason = ""
+		if sources[i].Price <= 0 || now.Sub(sources[i].LastUpdated) > staleAfter {
+			sources[i].ExcludedReason = CompositeIndexSourceStale
+			continue
+		}
+		prices = append(prices, sources[i].Price)
+	}
+	median := medianFloat(prices)
+	type weighted struct {
+		price, weight float64
+	}
+	var included []weighted
+	var totalWeight float64
+	for i := range sources {
+		if sources[i].ExcludedReason != "" {
+			continue
+		}
+		if math.Abs(sources[i].Price-median)/median*100 > maxDeviation {
+			sources[i].ExcludedReason = CompositeIndexSourceOutlier
+			continue
+		}
+		included = append(included, weighted{sources[i].Price, sources[i].Volume})
+		totalWeight += sources[i].Volume
+	}
+	if len(included) == 0 || len(included) < minimumSources {
+		return 0, fmt.Errorf("%w, %d of %d required", errCompositeIndexNotEnoughPrice, len(included), minimumSources)
+	}
+	if totalWeight <= 0 {
+		totalWeight = float64(len(included))
+		for i := range included {
+			included[i].weight = 1
+		}
+	}
+	sort.Slice(included, func(i, j int) bool {
+		return included[i].price < included[j].price
+	})
+	half := totalWeight / 2
+	var cumulative float64
+	for i := range included {
+		cumulative += included[i].weight
+		if cumulative < half {
+			continue
+		}
+		if cumulative == half && i+1 < len(included) {
+			return (included[i].price + included[i+1].price) / 2, nil
+		}
+		return included[i].price, nil
+	}
+	return included[len(included)-1].price, nil
+}
+
+// storeCompositeIndexCandles saves index candles to the database, adding the
+// synthetic exchange to the exchange table on first use
+func storeCompositeIndexCandles(item *kline.Item) error {
+	_, err := kline.StoreInDatabase(item, false)
+	if !errors.Is(err, exchange.ErrNoExchangeFound) {
+		return err
+	}
+	err = exchange.Insert(exchange.Details{Name: item.Exchange})
+	if err != nil {
+		return err
+	}
+	_, err = kline.StoreInDatabase(item, false)
+	return err
+}
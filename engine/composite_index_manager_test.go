@@ -0,0 +1,265 @@
+package engine
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+)
+
+func compositeIndexTestConfig() *config.CompositeIndexManager {
+	return &config.CompositeIndexManager{
+		ExchangeName:      "compositeindextest",
+		ValuationCurrency: "USD",
+		PublishInterval:   time.Hour,
+		StaleAfter:        time.Minute,
+		MaxDeviation:      5,
+		MinimumSources:    2,
+		CandleInterval:    time.Minute,
+		Indices: []config.CompositeIndex{
+			{
+				Pair:      currency.NewPair(currency.BTC, currency.USD),
+				Asset:     asset.Spot,
+				Exchanges: []string{"ExchA", "exchb", "exchc"},
+			},
+		},
+	}
+}
+
+func TestSetupCompositeIndexManager(t *testing.T) {
+	t.Parallel()
+	_, err := SetupCompositeIndexManager(nil, nil, nil)
+	if !errors.Is(err, errNilExchangeManager) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errNilExchangeManager)
+	}
+
+	_, err = SetupCompositeIndexManager(&fakeConsolidatedExchangeManager{}, nil, nil)
+	if !errors.Is(err, errNilCompositeIndexConfig) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errNilCompositeIndexConfig)
+	}
+
+	_, err = SetupCompositeIndexManager(&fakeConsolidatedExchangeManager{}, nil, &config.CompositeIndexManager{})
+	if !errors.Is(err, errNoCompositeIndices) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errNoCompositeIndices)
+	}
+
+	cfg := compositeIndexTestConfig()
+	cfg.SaveCandles = true
+	_, err = SetupCompositeIndexManager(&fakeConsolidatedExchangeManager{}, nil, cfg)
+	if !errors.Is(err, errNilDatabaseConnectionManager) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errNilDatabaseConnectionManager)
+	}
+
+	c, err := SetupCompositeIndexManager(&fakeConsolidatedExchangeManager{}, &DatabaseConnectionManager{}, cfg)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if len(c.indices) != 1 || c.candleSaver == nil || c.GetExchangeName() != cfg.ExchangeName {
+		t.Error("unexpected values")
+	}
+	err = c.candleSaver(&kline.Item{})
+	if !errors.Is(err, database.ErrDatabaseSupportDisabled) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, database.ErrDatabaseSupportDisabled)
+	}
+
+	// the engine passes its database manager even when the database is
+	// disabled
+	var dcm *DatabaseConnectionManager
+	c, err = SetupCompositeIndexManager(&fakeConsolidatedExchangeManager{}, dcm, cfg)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	err = c.candleSaver(&kline.Item{})
+	if !errors.Is(err, database.ErrDatabaseSupportDisabled) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, database.ErrDatabaseSupportDisabled)
+	}
+}
+
+func TestCompositeIndexManagerStartStop(t *testing.T) {
+	t.Parallel()
+	err := (*CompositeIndexManager)(nil).Start()
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrNilSubsystem)
+	}
+
+	err = (*CompositeIndexManager)(nil).Stop()
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrNilSubsystem)
+	}
+
+	if (*CompositeIndexManager)(nil).IsRunning() {
+		t.Error("expected false")
+	}
+
+	c, err := SetupCompositeIndexManager(&fakeConsolidatedExchangeManager{exchs: []exchange.IBotExchange{}}, nil, compositeIndexTestConfig())
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+
+	err = c.Stop()
+	if !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrSubSystemNotStarted)
+	}
+
+	err = c.Start()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+
+	err = c.Start()
+	if !errors.Is(err, ErrSubSystemAlreadyStarted) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrSubSystemAlreadyStarted)
+	}
+
+	if !c.IsRunning() {
+		t.Error("expected true")
+	}
+
+	err = c.Stop()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+}
+
+func TestCalculateCompositeIndexPrice(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	sources := []CompositeIndexSource{
+		{Exchange: "a", Price: 100, Volume: 1, LastUpdated: now},
+		{Exchange: "b", Price: 101, Volume: 10, LastUpdated: now},
+		{Exchange: "c", Price: 102, Volume: 1, LastUpdated: now},
+		{Exchange: "d", Price: 150, Volume: 100, LastUpdated: now},
+		{Exchange: "e", Price: 100, Volume: 100, LastUpdated: now.Add(-time.Hour)},
+	}
+	price, err := calculateCompositeIndexPrice(sources, now, time.Minute, 5, 3)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if price != 101 {
+		t.Errorf("received '%v' expected '%v'", price, 101)
+	}
+	if sources[3].ExcludedReason != CompositeIndexSourceOutlier {
+		t.Errorf("received '%v' expected '%v'", sources[3].ExcludedReason, CompositeIndexSourceOutlier)
+	}
+	if sources[4].ExcludedReason != CompositeIndexSourceStale {
+		t.Errorf("received '%v' expected '%v'", sources[4].ExcludedReason, CompositeIndexSourceStale)
+	}
+	if sources[0].ExcludedReason != "" {
+		t.Errorf("received '%v' expected no exclusion", sources[0].ExcludedReason)
+	}
+
+	// Equal weighting when no volume is reported
+	price, err = calculateCompositeIndexPrice([]CompositeIndexSource{
+		{Price: 100, LastUpdated: now},
+		{Price: 102, LastUpdated: now},
+	}, now, time.Minute, 5, 1)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if price != 101 {
+		t.Errorf("received '%v' expected '%v'", price, 101)
+	}
+
+	_, err = calculateCompositeIndexPrice(sources, now, time.Minute, 5, 4)
+	if !errors.Is(err, errCompositeIndexNotEnoughPrice) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errCompositeIndexNotEnoughPrice)
+	}
+
+	_, err = calculateCompositeIndexPrice(nil, now, time.Minute, 5, 1)
+	if !errors.Is(err, errCompositeIndexNotEnoughPrice) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errCompositeIndexNotEnoughPrice)
+	}
+}
+
+func TestCompositeIndexManagerPublish(t *testing.T) {
+	t.Parallel()
+	c, err := SetupCompositeIndexManager(&fakeConsolidatedExchangeManager{}, nil, compositeIndexTestConfig())
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	var saved []kline.Item
+	c.candleSaver = func(item *kline.Item) error {
+		saved = append(saved, *item)
+		return nil
+	}
+	c.started = 1
+
+	p := currency.NewPair(currency.BTC, currency.USD)
+	_, err = c.GetIndexPrice(p, asset.Spot)
+	if !errors.Is(err, errCompositeIndexPriceNotReady) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errCompositeIndexPriceNotReady)
+	}
+	_, err = c.GetIndexPrice(p, asset.Futures)
+	if !errors.Is(err, errCompositeIndexNotFound) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errCompositeIndexNotFound)
+	}
+
+	now := time.Now().Truncate(time.Minute)
+	c.updateTicker(&ticker.Price{ExchangeName: "exchA", Pair: p, AssetType: asset.Spot, Last: 100, Volume: 5, LastUpdated: now})
+	c.updateTicker(&ticker.Price{ExchangeName: "notused", Pair: p, AssetType: asset.Spot, Last: 1, LastUpdated: now})
+	c.publish(c.indices[0], now)
+	_, err = c.GetIndexPrice(p, asset.Spot)
+	if !errors.Is(err, errCompositeIndexPriceNotReady) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errCompositeIndexPriceNotReady)
+	}
+
+	c.updateTrade(&trade.Data{Exchange: "EXCHB", CurrencyPair: p, AssetType: asset.Spot, Price: 102, Amount: 2, Timestamp: now})
+	c.publish(c.indices[0], now)
+	price, err := c.GetIndexPrice(p, asset.Spot)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if price.Price != 100 || len(price.Sources) != 2 || price.Exchange != c.exchangeName {
+		t.Errorf("unexpected index price %+v", price)
+	}
+
+	tick, err := ticker.GetTicker(c.exchangeName, p, asset.Spot)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if tick.Last != 100 {
+		t.Errorf("received '%v' expected '%v'", tick.Last, 100)
+	}
+
+	value, valuation, err := c.GetValue(currency.BTC, 2)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if value != 200 || !valuation.Match(currency.USD) {
+		t.Errorf("received '%v %v' expected '%v %v'", value, valuation, 200, currency.USD)
+	}
+	_, _, err = c.GetValue(currency.ETH, 2)
+	if !errors.Is(err, errNoCompositeIndexValuation) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errNoCompositeIndexValuation)
+	}
+
+	if len(saved) != 0 {
+		t.Fatal("expected no saved candles")
+	}
+	c.publish(c.indices[0], now.Add(time.Minute))
+	if len(saved) != 1 {
+		t.Fatalf("received '%v' expected '%v'", len(saved), 1)
+	}
+	if saved[0].Exchange != c.exchangeName ||
+		!saved[0].Candles[0].Time.Equal(now) ||
+		saved[0].Candles[0].Close != 100 ||
+		saved[0].Candles[0].Volume != 2 {
+		t.Errorf("unexpected candle %+v", saved[0])
+	}
+
+	prices, err := c.GetIndexPrices()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if len(prices) != 1 {
+		t.Errorf("received '%v' expected '%v'", len(prices), 1)
+	}
+}
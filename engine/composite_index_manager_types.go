@@ -0,0 +1,91 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+// CompositeIndexManagerName is an exported subsystem name
+const CompositeIndexManagerName = "composite_index"
+
+// Reasons an exchange is excluded from an index price calculation
+const (
+	CompositeIndexSourceStale   = "stale"
+	CompositeIndexSourceOutlier = "outlier"
+)
+
+var (
+	errNilCompositeIndexConfig      = errors.New("nil composite index config")
+	errNoCompositeIndices           = errors.New("no composite indices configured")
+	errCompositeIndexNotFound       = errors.New("composite index not found")
+	errCompositeIndexPriceNotReady  = errors.New("composite index price not calculated")
+	errCompositeIndexNotEnoughPrice = errors.New("not enough composite index sources")
+	errNoCompositeIndexValuation    = errors.New("no composite index valuation")
+)
+
+// CompositeIndexManager calculates fair reference prices for currency pairs
+// from the ticker and trade streams of multiple exchanges. Index prices are
+// published through the ticker service under a synthetic exchange name so
+// they can be subscribed to and used like any other ticker, and can be stored
+// as candles in the database
+type CompositeIndexManager struct {
+	started  int32
+	shutdown chan struct{}
+	wg       sync.WaitGroup
+	iExchangeManager
+	verbose           bool
+	exchangeName      string
+	valuationCurrency currency.Code
+	publishInterval   time.Duration
+	staleAfter        time.Duration
+	maxDeviation      float64
+	minimumSources    int
+	saveCandles       bool
+	candleInterval    kline.Interval
+	candleSaver       func(*kline.Item) error
+
+	indices       []*compositeIndex
+	tickerStreams map[string]bool
+	tradeStreams  map[string]bool
+	m             sync.Mutex
+}
+
+// compositeIndex holds the latest exchange prices for a currency pair and the
+// index candle being built
+type compositeIndex struct {
+	pair      currency.Pair
+	asset     asset.Item
+	exchanges []string
+	sources   map[string]*CompositeIndexSource
+	price     *CompositeIndexPrice
+	candle    *kline.Candle
+	volume    float64
+	m         sync.Mutex
+}
+
+// CompositeIndexPrice holds a calculated index price and the exchange prices
+// it was calculated from
+type CompositeIndexPrice struct {
+	Exchange    string
+	Pair        currency.Pair
+	Asset       asset.Item
+	Price       float64
+	Sources     []CompositeIndexSource
+	LastUpdated time.Time
+}
+
+// CompositeIndexSource holds an exchange's latest price for an index. Volume
+// is the exchange's reported ticker volume and is used to weight the price.
+// ExcludedReason is set when the exchange was left out of the calculation
+type CompositeIndexSource struct {
+	Exchange       string
+	Price          float64
+	Volume         float64
+	LastUpdated    time.Time
+	ExcludedReason string
+}
@@ -0,0 +1,281 @@
+package engine
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetupConsolidatedOrderbookManager applies configuration parameters before
+// running
+func SetupConsolidatedOrderbookManager(em iExchangeManager, discoveryDelay time.Duration, verbose bool) (*ConsolidatedOrderbookManager, error) {
+	if em == nil {
+		return nil, errNilExchangeManager
+	}
+	if discoveryDelay <= 0 {
+		discoveryDelay = DefaultConsolidatedOrderbookDiscoveryDelay
+	}
+	return &ConsolidatedOrderbookManager{
+		shutdown:         make(chan struct{}),
+		iExchangeManager: em,
+		discoveryDelay:   discoveryDelay,
+		verbose:          verbose,
+		books:            make(map[consolidatedKey]*consolidatedBook),
+	}, nil
+}
+
+// Start runs the subsystem
+func (c *ConsolidatedOrderbookManager) Start() error {
+	if c == nil {
+		return fmt.Errorf("%s %w", ConsolidatedOrderbookManagerName, ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&c.started, 0, 1) {
+		return fmt.Errorf("%s %w", ConsolidatedOrderbookManagerName, ErrSubSystemAlreadyStarted)
+	}
+	log.Debugf(log.OrderBook, "Consolidated orderbook manager %s", MsgSubSystemStarting)
+	c.wg.Add(1)
+	go c.monitor()
+	log.Debugf(log.OrderBook, "Consolidated orderbook manager %s", MsgSubSystemStarted)
+	return nil
+}
+
+// Stop stops the subsystem
+func (c *ConsolidatedOrderbookManager) Stop() error {
+	if c == nil {
+		return fmt.Errorf("%s %w", ConsolidatedOrderbookManagerName, ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&c.started) == 0 {
+		return fmt.Errorf("%s %w", ConsolidatedOrderbookManagerName, ErrSubSystemNotStarted)
+	}
+	log.Debugf(log.OrderBook, "Consolidated orderbook manager %s", MsgSubSystemShuttingDown)
+	close(c.shutdown)
+	c.wg.Wait()
+	c.m.Lock()
+	c.books = make(map[consolidatedKey]*consolidatedBook)
+	c.m.Unlock()
+	c.shutdown = make(chan struct{})
+	atomic.StoreInt32(&c.started, 0)
+	log.Debugf(log.OrderBook, "Consolidated orderbook manager %s", MsgSubSystemShutdown)
+	return nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (c *ConsolidatedOrderbookManager) IsRunning() bool {
+	if c == nil {
+		return false
+	}
+	return atomic.LoadInt32(&c.started) == 1
+}
+
+// GetConsolidatedOrderbook returns the merged orderbook for a currency pair
+// across all enabled exchanges. When convertQuote is set, pairs sharing the
+// base currency but quoted in a different currency are converted into the
+// requested quote currency. Exchanges can be filtered by name, an empty list
+// returns all tracked exchanges.
+func (c *ConsolidatedOrderbookManager) GetConsolidatedOrderbook(p currency.Pair, a asset.Item, convertQuote bool, exchanges []string) (*orderbook.Consolidated, error) {
+	book, err := c.getBook(p, a, convertQuote)
+	if err != nil {
+		return nil, err
+	}
+	return book.retrieve(exchanges)
+}
+
+// getBook returns the tracked consolidated book for the supplied parameters,
+// creating and populating it on first request
+func (c *ConsolidatedOrderbookManager) getBook(p currency.Pair, a asset.Item, convertQuote bool) (*consolidatedBook, error) {
+	if !c.IsRunning() {
+		return nil, fmt.Errorf("%s %w", ConsolidatedOrderbookManagerName, ErrSubSystemNotStarted)
+	}
+	if p.IsEmpty() {
+		return nil, errCurrencyPairUnset
+	}
+	if !a.IsValid() {
+		return nil, fmt.Errorf("%s %w", a, asset.ErrNotSupported)
+	}
+	key := consolidatedKey{
+		Base:         p.Base.Item,
+		Quote:        p.Quote.Item,
+		Asset:        a,
+		ConvertQuote: convertQuote,
+	}
+	c.m.Lock()
+	defer c.m.Unlock()
+	book, ok := c.books[key]
+	if !ok {
+		book = &consolidatedBook{
+			pair:         p,
+			asset:        a,
+			convertQuote: convertQuote,
+			venues:       make(map[string]*consolidatedVenue),
+		}
+		c.books[key] = book
+		c.discover(book)
+	}
+	return book, nil
+}
+
+// monitor periodically attaches exchange orderbooks that were not available
+// when a consolidated book was first requested
+func (c *ConsolidatedOrderbookManager) monitor() {
+	defer c.wg.Done()
+	timer := time.NewTicker(c.discoveryDelay)
+	defer timer.Stop()
+	for {
+		select {
+		case <-c.shutdown:
+			return
+		case <-timer.C:
+			c.m.Lock()
+			for _, book := range c.books {
+				c.discover(book)
+			}
+			c.m.Unlock()
+		}
+	}
+}
+
+// discover finds enabled exchanges that have an orderbook matching the
+// consolidated book and starts watching any that are not yet tracked
+func (c *ConsolidatedOrderbookManager) discover(book *consolidatedBook) {
+	exchs, err := c.GetExchanges()
+	if err != nil {
+		log.Errorf(log.OrderBook, "Consolidated orderbook manager cannot get exchanges: %v", err)
+		return
+	}
+	for x := range exchs {
+		if !exchs[x].IsEnabled() {
+			continue
+		}
+		name := exchs[x].GetName()
+		book.m.Lock()
+		_, tracked := book.venues[strings.ToLower(name)]
+		book.m.Unlock()
+		if tracked {
+			continue
+		}
+		pairs, err := exchs[x].GetEnabledPairs(book.asset)
+		if err != nil {
+			continue
+		}
+		v := book.match(name, pairs)
+		if v == nil {
+			continue
+		}
+		book.m.Lock()
+		book.venues[strings.ToLower(name)] = v
+		book.m.Unlock()
+		if c.verbose {
+			log.Debugf(log.OrderBook,
+				"Consolidated orderbook manager tracking %s %s %s for %s",
+				name,
+				v.pair,
+				book.asset,
+				book.pair)
+		}
+		c.wg.Add(1)
+		go c.watch(book, v)
+	}
+}
+
+// watch updates the consolidated book each time the underlying exchange depth
+// changes
+func (c *ConsolidatedOrderbookManager) watch(book *consolidatedBook, v *consolidatedVenue) {
+	defer c.wg.Done()
+	v.depth.WatchUpdates(c.shutdown, func() {
+		if err := book.update(v); err != nil && c.verbose {
+			log.Errorf(log.OrderBook,
+				"Consolidated orderbook manager %s %s update error: %v",
+				v.exchange,
+				v.pair,
+				err)
+		}
+	})
+}
+
+// match returns a venue for an exchange's enabled pairs that is deployed in
+// the orderbook service, an exact quote currency match is preferred
+func (b *consolidatedBook) match(exchName string, pairs currency.Pairs) *consolidatedVenue {
+	var candidates currency.Pairs
+	for x := range pairs {
+		if pairs[x].Base.Item != b.pair.Base.Item {
+			continue
+		}
+		if pairs[x].Quote.Item == b.pair.Quote.Item {
+			candidates = append(currency.Pairs{pairs[x]}, candidates...)
+			continue
+		}
+		if b.convertQuote {
+			candidates = append(candidates, pairs[x])
+		}
+	}
+	for x := range candidates {
+		depth, err := orderbook.GetDepth(exchName, candidates[x], b.asset)
+		if err != nil {
+			continue
+		}
+		return &consolidatedVenue{
+			exchange: exchName,
+			pair:     candidates[x],
+			depth:    depth,
+		}
+	}
+	return nil
+}
+
+// update stores the latest depth snapshot, converted into the consolidated
+// quote currency if required, and alerts waiting routines
+func (b *consolidatedBook) update(v *consolidatedVenue) error {
+	snapshot := v.depth.Retrieve()
+	if v.pair.Quote.Item != b.pair.Quote.Item {
+		rate, err := currency.ConvertCurrency(1, v.pair.Quote, b.pair.Quote)
+		if err == nil {
+			snapshot, err = snapshot.ConvertQuote(b.pair.Quote, rate)
+		}
+		if err != nil {
+			b.m.Lock()
+			v.snapshot = nil
+			b.m.Unlock()
+			return err
+		}
+	}
+	b.m.Lock()
+	v.snapshot = snapshot
+	b.m.Unlock()
+	b.Alert()
+	return nil
+}
+
+// retrieve consolidates the latest snapshots for the tracked exchanges,
+// filtered by exchange name if supplied
+func (b *consolidatedBook) retrieve(exchanges []string) (*orderbook.Consolidated, error) {
+	if b == nil {
+		return nil, errNilConsolidatedBook
+	}
+	b.m.Lock()
+	books := make([]*orderbook.Base, 0, len(b.venues))
+	for name, v := range b.venues {
+		if v.snapshot == nil {
+			continue
+		}
+		if len(exchanges) != 0 && !common.StringDataCompareInsensitive(exchanges, name) {
+			continue
+		}
+		books = append(books, v.snapshot)
+	}
+	b.m.Unlock()
+	if len(books) == 0 {
+		return nil, fmt.Errorf("%w for %s %s", errNoConsolidatedVenues, b.pair, b.asset)
+	}
+	sort.Slice(books, func(i, j int) bool {
+		return books[i].Exchange < books[j].Exchange
+	})
+	return orderbook.Consolidate(b.pair, b.asset, books...)
+}
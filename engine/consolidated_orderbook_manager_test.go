@@ -0,0 +1,256 @@
+package engine
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+type fakeConsolidatedExchangeManager struct {
+	exchs []exchange.IBotExchange
+}
+
+func (f *fakeConsolidatedExchangeManager) GetExchanges() ([]exchange.IBotExchange, error) {
+	if f.exchs == nil {
+		return nil, errManager
+	}
+	return f.exchs, nil
+}
+
+func (f *fakeConsolidatedExchangeManager) GetExchangeByName(string) (exchange.IBotExchange, error) {
+	return nil, errManager
+}
+
+type fakeConsolidatedExchange struct {
+	exchange.IBotExchange
+	name     string
+	disabled bool
+	pairs    currency.Pairs
+}
+
+func (f *fakeConsolidatedExchange) GetName() string {
+	return f.name
+}
+
+func (f *fakeConsolidatedExchange) IsEnabled() bool {
+	return !f.disabled
+}
+
+func (f *fakeConsolidatedExchange) GetEnabledPairs(a asset.Item) (currency.Pairs, error) {
+	if a != asset.Spot {
+		return nil, asset.ErrNotSupported
+	}
+	return f.pairs, nil
+}
+
+func TestSetupConsolidatedOrderbookManager(t *testing.T) {
+	t.Parallel()
+	_, err := SetupConsolidatedOrderbookManager(nil, 0, false)
+	if !errors.Is(err, errNilExchangeManager) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errNilExchangeManager)
+	}
+
+	m, err := SetupConsolidatedOrderbookManager(&fakeConsolidatedExchangeManager{}, 0, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+
+	if m.discoveryDelay != DefaultConsolidatedOrderbookDiscoveryDelay {
+		t.Fatal("unexpected value")
+	}
+}
+
+func TestConsolidatedOrderbookManagerStartStop(t *testing.T) {
+	t.Parallel()
+	err := (*ConsolidatedOrderbookManager)(nil).Start()
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrNilSubsystem)
+	}
+
+	err = (*ConsolidatedOrderbookManager)(nil).Stop()
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrNilSubsystem)
+	}
+
+	if (*ConsolidatedOrderbookManager)(nil).IsRunning() {
+		t.Fatal("nil subsystem should not be running")
+	}
+
+	m, err := SetupConsolidatedOrderbookManager(&fakeConsolidatedExchangeManager{}, time.Minute, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+
+	err = m.Stop()
+	if !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrSubSystemNotStarted)
+	}
+
+	err = m.Start()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+
+	err = m.Start()
+	if !errors.Is(err, ErrSubSystemAlreadyStarted) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrSubSystemAlreadyStarted)
+	}
+
+	if !m.IsRunning() {
+		t.Fatal("this should be running")
+	}
+
+	err = m.Stop()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+
+	// Restart to ensure the shutdown channel is reset
+	err = m.Start()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+
+	err = m.Stop()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+}
+
+func TestGetConsolidatedOrderbook(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.BTC, currency.USD)
+	usdt := currency.NewPair(currency.BTC, currency.USDT)
+	em := &fakeConsolidatedExchangeManager{
+		exchs: []exchange.IBotExchange{
+			&fakeConsolidatedExchange{name: "ConsolidatedOne", pairs: currency.Pairs{p}},
+			&fakeConsolidatedExchange{name: "ConsolidatedTwo", pairs: currency.Pairs{usdt, p}},
+			&fakeConsolidatedExchange{name: "ConsolidatedThree", pairs: currency.Pairs{usdt}},
+			&fakeConsolidatedExchange{name: "ConsolidatedFour", pairs: currency.Pairs{p}, disabled: true},
+		},
+	}
+
+	m, err := SetupConsolidatedOrderbookManager(em, time.Minute, true)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+
+	_, err = m.GetConsolidatedOrderbook(p, asset.Spot, false, nil)
+	if !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrSubSystemNotStarted)
+	}
+
+	err = m.Start()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	defer func() {
+		if err = m.Stop(); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	_, err = m.GetConsolidatedOrderbook(currency.Pair{}, asset.Spot, false, nil)
+	if !errors.Is(err, errCurrencyPairUnset) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errCurrencyPairUnset)
+	}
+
+	_, err = m.GetConsolidatedOrderbook(p, asset.Item("bad"), false, nil)
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, asset.ErrNotSupported)
+	}
+
+	_, err = m.GetConsolidatedOrderbook(p, asset.Spot, false, nil)
+	if !errors.Is(err, errNoConsolidatedVenues) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errNoConsolidatedVenues)
+	}
+
+	depths := make(map[string]*orderbook.Depth)
+	for _, name := range []string{"ConsolidatedOne", "ConsolidatedTwo", "ConsolidatedFour"} {
+		depths[name], err = orderbook.DeployDepth(name, p, asset.Spot)
+		if !errors.Is(err, nil) {
+			t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+		}
+	}
+
+	depths["ConsolidatedOne"].LoadSnapshot(
+		[]orderbook.Item{{Price: 100, Amount: 1}},
+		[]orderbook.Item{{Price: 101, Amount: 1}},
+		0, time.Now(), true)
+
+	// Book has already been created, force discovery rather than waiting on
+	// the ticker.
+	m.m.Lock()
+	for _, book := range m.books {
+		m.discover(book)
+	}
+	m.m.Unlock()
+
+	book, err := m.getBook(p, asset.Spot, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+
+	wait := book.Wait(nil)
+	depths["ConsolidatedTwo"].LoadSnapshot(
+		[]orderbook.Item{{Price: 100, Amount: 2}},
+		[]orderbook.Item{{Price: 102, Amount: 2}},
+		0, time.Now(), true)
+
+	select {
+	case <-wait:
+	case <-time.After(time.Second * 5):
+		t.Fatal("consolidated book should have been alerted")
+	}
+
+	var c *orderbook.Consolidated
+	for i := 0; i < 100; i++ {
+		c, err = m.GetConsolidatedOrderbook(p, asset.Spot, false, nil)
+		if err == nil && len(c.Exchanges) == 2 && len(c.Asks) == 2 {
+			break
+		}
+		time.Sleep(time.Millisecond * 10)
+	}
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+
+	if len(c.Exchanges) != 2 || c.Exchanges[0] != "ConsolidatedOne" {
+		t.Fatalf("unexpected exchanges %v", c.Exchanges)
+	}
+
+	if len(c.Bids) != 1 || c.Bids[0].Amount != 3 || len(c.Bids[0].Venues) != 2 {
+		t.Fatalf("unexpected bids %+v", c.Bids)
+	}
+
+	if len(c.Asks) != 2 || c.Asks[0].Price != 101 {
+		t.Fatalf("unexpected asks %+v", c.Asks)
+	}
+
+	c, err = m.GetConsolidatedOrderbook(p, asset.Spot, false, []string{"consolidatedtwo"})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+
+	if len(c.Exchanges) != 1 || c.Exchanges[0] != "ConsolidatedTwo" {
+		t.Fatalf("unexpected exchanges %v", c.Exchanges)
+	}
+
+	_, err = m.GetConsolidatedOrderbook(p, asset.Spot, false, []string{"ConsolidatedFour"})
+	if !errors.Is(err, errNoConsolidatedVenues) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errNoConsolidatedVenues)
+	}
+}
+
+func TestConsolidatedBookRetrieve(t *testing.T) {
+	t.Parallel()
+	_, err := (*consolidatedBook)(nil).retrieve(nil)
+	if !errors.Is(err, errNilConsolidatedBook) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errNilConsolidatedBook)
+	}
+}
@@ -0,0 +1,67 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/alert"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+const (
+	// ConsolidatedOrderbookManagerName is an exported subsystem name
+	ConsolidatedOrderbookManagerName = "consolidated_orderbook_manager"
+	// DefaultConsolidatedOrderbookDiscoveryDelay defines how often tracked
+	// consolidated books check for newly available exchange orderbooks
+	DefaultConsolidatedOrderbookDiscoveryDelay = time.Second * 10
+)
+
+var (
+	errNoConsolidatedVenues = errors.New("no exchange orderbooks available for consolidation")
+	errNilConsolidatedBook  = errors.New("consolidated orderbook is nil")
+)
+
+// ConsolidatedOrderbookManager merges the orderbook depth of the same
+// currency pair across enabled exchanges
+type ConsolidatedOrderbookManager struct {
+	started  int32
+	shutdown chan struct{}
+	wg       sync.WaitGroup
+	iExchangeManager
+	discoveryDelay time.Duration
+	verbose        bool
+
+	books map[consolidatedKey]*consolidatedBook
+	m     sync.Mutex
+}
+
+// consolidatedKey defines the lookup for a tracked consolidated book
+type consolidatedKey struct {
+	Base         *currency.Item
+	Quote        *currency.Item
+	Asset        asset.Item
+	ConvertQuote bool
+}
+
+// consolidatedBook tracks the exchange depths that make up a consolidated
+// orderbook and alerts waiting routines when any of them change
+type consolidatedBook struct {
+	alert.Notice
+	pair         currency.Pair
+	asset        asset.Item
+	convertQuote bool
+	venues       map[string]*consolidatedVenue
+	m            sync.Mutex
+}
+
+// consolidatedVenue holds an exchange depth and its latest normalised
+// snapshot
+type consolidatedVenue struct {
+	exchange string
+	pair     currency.Pair
+	depth    *orderbook.Depth
+	snapshot *orderbook.Base
+}
@@ -0,0 +1,400 @@
+package engine
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/file"
+	"github.com/thrasher-corp/gocryptotrader/common/file/parquet"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/candle"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// candleExportColumns matches the column order read by the backtester's csv
+// candle loader
+var candleExportColumns = []parquet.Column{
+	{Name: "timestamp", Type: parquet.Timestamp},
+	{Name: "volume", Type: parquet.Double},
+	{Name: "open", Type: parquet.Double},
+	{Name: "high", Type: parquet.Double},
+	{Name: "low", Type: parquet.Double},
+	{Name: "close", Type: parquet.Double},
+}
+
+// tradeExportColumns matches the column order read by the backtester's csv
+// trade loader, the trade ID is appended and ignored by the loader
+var tradeExportColumns = []parquet.Column{
+	{Name: "timestamp", Type: parquet.Timestamp},
+	{Name: "price", Type: parquet.Double},
+	{Name: "amount", Type: parquet.Double},
+	{Name: "side", Type: parquet.String},
+	{Name: "trade_id", Type: parquet.String},
+}
+
+// SetupDataExportManager creates a data export manager which writes files to
+// the supplied directory
+func SetupDataExportManager(dcm iDatabaseConnectionManager, directory string) (*DataExportManager, error) {
+	if dcm == nil {
+		return nil, errNilDatabaseConnectionManager
+	}
+	if directory == "" {
+		return nil, errDirectoryUnset
+	}
+	return &DataExportManager{
+		databaseConnectionInstance: dcm.GetInstance(),
+		directory:                  directory,
+		candleLoader:               kline.LoadFromDatabase,
+		tradeLoader:                trade.GetTradesInRange,
+	}, nil
+}
+
+// Export streams the requested data from the database to one file, or one
+// file per day when partitioned. Days without data do not produce a file.
+func (m *DataExportManager) Export(r *DataExportRequest) (*DataExportResult, error) {
+	if m == nil {
+		return nil, fmt.Errorf("%s %w", DataExportManagerName, ErrNilSubsystem)
+	}
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+	if m.databaseConnectionInstance == nil || !m.databaseConnectionInstance.IsConnected() {
+		return nil, database.ErrDatabaseNotConnected
+	}
+
+	columns := candleExportColumns
+	if r.DataType == DataExportTrades {
+		columns = tradeExportColumns
+	}
+
+	resp := &DataExportResult{}
+	var current *exportFile
+	for day := r.Start; day.Before(r.End); {
+		next := day.Truncate(kline.OneDay.Duration()).Add(kline.OneDay.Duration())
+		if next.After(r.End) {
+			next = r.End
+		}
+
+		rows, err := m.loadRows(r, day, next)
+		if err != nil {
+			return nil, closeExportFileOnError(current, err)
+		}
+
+		if current != nil && r.PartitionByDay && len(rows) > 0 {
+			if err = current.close(); err != nil {
+				return nil, err
+			}
+			resp.Files = append(resp.Files, ExportedFile{Path: current.path, Rows: current.rows})
+			current = nil
+		}
+
+		if current == nil && len(rows) > 0 {
+			current, err = m.createExportFile(r, day, columns)
+			if err != nil {
+				return nil, err
+			}
+		}
+
+		for i := range rows {
+			if err = current.writer.write(rows[i]...); err != nil {
+				return nil, closeExportFileOnError(current, err)
+			}
+			current.rows++
+		}
+		day = next
+	}
+
+	if current != nil {
+		if err := current.close(); err != nil {
+			return nil, err
+		}
+		resp.Files = append(resp.Files, ExportedFile{Path: current.path, Rows: current.rows})
+	}
+	if len(resp.Files) == 0 {
+		return nil, fmt.Errorf("%w for %s %s %s %s between %s and %s",
+			errNoDataToExport,
+			r.Exchange,
+			r.Asset,
+			r.Pair,
+			r.DataType,
+			r.Start.Format(common.SimpleTimeFormat),
+			r.End.Format(common.SimpleTimeFormat))
+	}
+	return resp, nil
+}
+
+// loadRows returns the column ordered values for data between start
+// inclusive and end exclusive
+func (m *DataExportManager) loadRows(r *DataExportRequest, start, end time.Time) ([][]interface{}, error) {
+	var rows [][]interface{}
+	switch r.DataType {
+	case DataExportCandles:
+		item, err := m.candleLoader(r.Exchange, r.Pair, r.Asset, r.Interval, start, end)
+		if err != nil {
+			if errors.Is(err, candle.ErrNoCandleDataFound) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		for i := range item.Candles {
+			c := &item.Candles[i]
+			if c.Time.Before(start) || !c.Time.Before(end) {
+				continue
+			}
+			rows = append(rows, []interface{}{c.Time, c.Volume, c.Open, c.High, c.Low, c.Close})
+		}
+	case DataExportTrades:
+		trades, err := m.tradeLoader(r.Exchange, r.Asset.String(), r.Pair.Base.String(), r.Pair.Quote.String(), start, end)
+		if err != nil {
+			return nil, err
+		}
+		for i := range trades {
+			t := &trades[i]
+			if t.Timestamp.Before(start) || !t.Timestamp.Before(end) {
+				continue
+			}
+			rows = append(rows, []interface{}{t.Timestamp, t.Price, t.Amount, t.Side.String(), t.TID})
+		}
+	}
+	return rows, nil
+}
+
+// createExportFile opens a file for the request and wraps it with the
+// requested compression and format
+func (m *DataExportManager) createExportFile(r *DataExportRequest, day time.Time, columns []parquet.Column) (*exportFile, error) {
+	path := filepath.Join(m.directory, r.fileName(day))
+	f, err := file.Writer(path)
+	if err != nil {
+		return nil, err
+	}
+	ef := &exportFile{path: path, file: f}
+	var w io.Writer = f
+	if r.Gzip {
+		ef.gzip = gzip.NewWriter(f)
+		w = ef.gzip
+	}
+	ef.buffer = bufio.NewWriter(w)
+
+	switch r.Format {
+	case DataExportCSV:
+		ef.writer = &csvExportWriter{w: csv.NewWriter(ef.buffer)}
+	case DataExportJSONLines:
+		ef.writer = &jsonLinesExportWriter{w: ef.buffer, columns: columns}
+	case DataExportParquet:
+		var pw *parquet.Writer
+		pw, err = parquet.NewWriter(ef.buffer, columns...)
+		if err != nil {
+			return nil, closeExportFileOnError(ef, err)
+		}
+		ef.writer = &parquetExportWriter{w: pw}
+	}
+	return ef, nil
+}
+
+// validate checks the request and normalises its time range to UTC
+func (r *DataExportRequest) validate() error {
+	if r == nil {
+		return errNilDataExportRequest
+	}
+	if r.Exchange == "" {
+		return errExchangeNameUnset
+	}
+	if r.Pair.IsEmpty() {
+		return errCurrencyPairUnset
+	}
+	if !r.Asset.IsValid() {
+		return fmt.Errorf("%v %w", r.Asset, asset.ErrNotSupported)
+	}
+	switch r.DataType {
+	case DataExportCandles:
+		if r.Interval <= 0 {
+			return kline.ErrUnsetInterval
+		}
+	case DataExportTrades:
+	default:
+		return fmt.Errorf("%w: %s", errInvalidDataExportType, r.DataType)
+	}
+	switch r.Format {
+	case DataExportCSV, DataExportJSONLines, DataExportParquet:
+	default:
+		return fmt.Errorf("%w: %s", errInvalidDataExportFormat, r.Format)
+	}
+	if err := common.StartEndTimeCheck(r.Start, r.End); err != nil {
+		return err
+	}
+	r.Start = r.Start.UTC()
+	r.End = r.End.UTC()
+	return nil
+}
+
+// fileName returns the exported file name, partitioned files are named by
+// day and unpartitioned files by the requested range
+func (r *DataExportRequest) fileName(day time.Time) string {
+	const dateFormat = "2006-01-02"
+	name := strings.Join([]string{
+		strings.ReplaceAll(strings.ToLower(r.Exchange), " ", ""),
+		r.Asset.String(),
+		r.Pair.Format("-", true).String(),
+		string(r.DataType),
+	}, "_")
+	if r.DataType == DataExportCandles {
+		name += "_" + r.Interval.Short()
+	}
+	if r.PartitionByDay {
+		name += "_" + day.Format(dateFormat)
+	} else {
+		name += "_" + r.Start.Format(dateFormat) + "_" + r.End.Format(dateFormat)
+	}
+	name += "." + string(r.Format)
+	if r.Gzip {
+		name += ".gz"
+	}
+	return name
+}
+
+// exportFile holds an open export file and its writer chain
+type exportFile struct {
+	path   string
+	file   *os.File
+	gzip   *gzip.Writer
+	buffer *bufio.Writer
+	writer exportWriter
+	rows   int64
+}
+
+// close flushes and closes each writer in the chain
+func (e *exportFile) close() error {
+	var errs common.Errors
+	if e.writer != nil {
+		if err := e.writer.close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if err := e.buffer.Flush(); err != nil {
+		errs = append(errs, err)
+	}
+	if e.gzip != nil {
+		if err := e.gzip.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if err := e.file.Close(); err != nil {
+		errs = append(errs, err)
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// closeExportFileOnError closes and removes a partially written file,
+// returning the original error
+func closeExportFileOnError(e *exportFile, err error) error {
+	if e == nil {
+		return err
+	}
+	if closeErr := e.close(); closeErr != nil {
+		log.Errorf(log.Global, "%s could not close %s: %v", DataExportManagerName, e.path, closeErr)
+	}
+	if removeErr := os.Remove(e.path); removeErr != nil {
+		log.Errorf(log.Global, "%s could not remove %s: %v", DataExportManagerName, e.path, removeErr)
+	}
+	return err
+}
+
+// csvExportWriter writes rows without a header so files can be read by the
+// backtester and candle import
+type csvExportWriter struct {
+	w *csv.Writer
+}
+
+func (c *csvExportWriter) write(values ...interface{}) error {
+	record := make([]string, len(values))
+	for i := range values {
+		record[i] = formatExportValue(values[i])
+	}
+	return c.w.Write(record)
+}
+
+func (c *csvExportWriter) close() error {
+	c.w.Flush()
+	return c.w.Error()
+}
+
+// jsonLinesExportWriter writes one JSON object per row with fields in column
+// order
+type jsonLinesExportWriter struct {
+	w       io.Writer
+	columns []parquet.Column
+	line    []byte
+}
+
+func (j *jsonLinesExportWriter) write(values ...interface{}) error {
+	j.line = append(j.line[:0], '{')
+	for i := range values {
+		if i > 0 {
+			j.line = append(j.line, ',')
+		}
+		j.line = strconv.AppendQuote(j.line, j.columns[i].Name)
+		j.line = append(j.line, ':')
+		value := values[i]
+		if t, ok := value.(time.Time); ok {
+			value = t.Unix()
+		}
+		v, err := json.Marshal(value)
+		if err != nil {
+			return err
+		}
+		j.line = append(j.line, v...)
+	}
+	j.line = append(j.line, '}', '\n')
+	_, err := j.w.Write(j.line)
+	return err
+}
+
+func (j *jsonLinesExportWriter) close() error {
+	return nil
+}
+
+// parquetExportWriter writes rows to a parquet file
+type parquetExportWriter struct {
+	w *parquet.Writer
+}
+
+func (p *parquetExportWriter) write(values ...interface{}) error {
+	return p.w.WriteRow(values...)
+}
+
+func (p *parquetExportWriter) close() error {
+	return p.w.Close()
+}
+
+// formatExportValue formats a row value for text output, timestamps are
+// written as unix seconds
+func formatExportValue(v interface{}) string {
+	switch val := v.(type) {
+	case time.Time:
+		return strconv.FormatInt(val.Unix(), 10)
+	case int64:
+		return strconv.FormatInt(val, 10)
+	case float64:
+		return strconv.FormatFloat(val, 'f', -1, 64)
+	case string:
+		return val
+	}
+	return fmt.Sprint(v)
+}
@@ -0,0 +1,343 @@
+package engine
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/backtester/common"
+	"github.com/thrasher-corp/gocryptotrader/backtester/data/kline/csv"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/candle"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+	"github.com/xitongsys/parquet-go-source/buffer"
+	"github.com/xitongsys/parquet-go/reader"
+)
+
+var dataExportStart = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func setupDataExportTest(t *testing.T) *DataExportManager {
+	t.Helper()
+	dbInst := &database.Instance{}
+	dbInst.SetConnected(true)
+	return &DataExportManager{
+		databaseConnectionInstance: dbInst,
+		directory:                  t.TempDir(),
+		candleLoader: func(exch string, p currency.Pair, a asset.Item, i kline.Interval, start, end time.Time) (kline.Item, error) {
+			item := kline.Item{Exchange: exch, Pair: p, Asset: a, Interval: i}
+			for ts := dataExportStart; ts.Before(dataExportStart.Add(kline.OneDay.Duration() * 2)); ts = ts.Add(i.Duration()) {
+				// loaders include the end boundary, exports must not
+				if ts.Before(start) || ts.After(end) {
+					continue
+				}
+				item.Candles = append(item.Candles, kline.Candle{
+					Time:   ts,
+					Open:   1,
+					High:   2,
+					Low:    0.5,
+					Close:  1.5,
+					Volume: 1337,
+				})
+			}
+			if len(item.Candles) == 0 {
+				return item, candle.ErrNoCandleDataFound
+			}
+			return item, nil
+		},
+		tradeLoader: func(exch, a, base, quote string, start, end time.Time) ([]trade.Data, error) {
+			if !start.Equal(dataExportStart) {
+				return nil, nil
+			}
+			return []trade.Data{
+				{TID: "1", Timestamp: start, Price: 1337, Amount: 1, Side: order.Buy},
+				{TID: "2", Timestamp: start.Add(time.Minute), Price: 1338, Amount: 2, Side: order.Sell},
+			}, nil
+		},
+	}
+}
+
+func TestSetupDataExportManager(t *testing.T) {
+	t.Parallel()
+	_, err := SetupDataExportManager(nil, "")
+	if !errors.Is(err, errNilDatabaseConnectionManager) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errNilDatabaseConnectionManager)
+	}
+	_, err = SetupDataExportManager(&DatabaseConnectionManager{}, "")
+	if !errors.Is(err, errDirectoryUnset) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errDirectoryUnset)
+	}
+	m, err := SetupDataExportManager(&DatabaseConnectionManager{}, t.TempDir())
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if m.candleLoader == nil || m.tradeLoader == nil {
+		t.Error("expected loaders to be set")
+	}
+}
+
+func TestDataExportRequestValidate(t *testing.T) {
+	t.Parallel()
+	var r *DataExportRequest
+	err := r.validate()
+	if !errors.Is(err, errNilDataExportRequest) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errNilDataExportRequest)
+	}
+	r = &DataExportRequest{}
+	err = r.validate()
+	if !errors.Is(err, errExchangeNameUnset) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errExchangeNameUnset)
+	}
+	r.Exchange = testExchange
+	err = r.validate()
+	if !errors.Is(err, errCurrencyPairUnset) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errCurrencyPairUnset)
+	}
+	r.Pair = currency.NewPair(currency.BTC, currency.USD)
+	err = r.validate()
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, asset.ErrNotSupported)
+	}
+	r.Asset = asset.Spot
+	err = r.validate()
+	if !errors.Is(err, errInvalidDataExportType) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errInvalidDataExportType)
+	}
+	r.DataType = DataExportCandles
+	err = r.validate()
+	if !errors.Is(err, kline.ErrUnsetInterval) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, kline.ErrUnsetInterval)
+	}
+	r.Interval = kline.OneHour
+	err = r.validate()
+	if !errors.Is(err, errInvalidDataExportFormat) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errInvalidDataExportFormat)
+	}
+	r.Format = DataExportCSV
+	r.Start = dataExportStart
+	r.End = dataExportStart.Add(-time.Hour)
+	err = r.validate()
+	if err == nil {
+		t.Fatal("expected invalid time range error")
+	}
+	r.End = dataExportStart.Add(time.Hour)
+	err = r.validate()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+}
+
+func TestDataExportFileName(t *testing.T) {
+	t.Parallel()
+	r := &DataExportRequest{
+		Exchange: "BTC Markets",
+		Asset:    asset.Spot,
+		Pair:     currency.NewPair(currency.BTC, currency.AUD),
+		DataType: DataExportCandles,
+		Interval: kline.OneHour,
+		Start:    dataExportStart,
+		End:      dataExportStart.AddDate(0, 0, 2),
+		Format:   DataExportParquet,
+	}
+	if name := r.fileName(dataExportStart); name != "btcmarkets_spot_BTC-AUD_candles_1h_2021-01-01_2021-01-03.parquet" {
+		t.Errorf("received: '%v'", name)
+	}
+	r.DataType = DataExportTrades
+	r.PartitionByDay = true
+	r.Gzip = true
+	r.Format = DataExportJSONLines
+	if name := r.fileName(dataExportStart.AddDate(0, 0, 1)); name != "btcmarkets_spot_BTC-AUD_trades_2021-01-02.jsonl.gz" {
+		t.Errorf("received: '%v'", name)
+	}
+}
+
+func TestDataExportCandlesCSV(t *testing.T) {
+	t.Parallel()
+	m := setupDataExportTest(t)
+	p := currency.NewPair(currency.BTC, currency.USD)
+	resp, err := m.Export(&DataExportRequest{
+		Exchange:       testExchange,
+		Asset:          asset.Spot,
+		Pair:           p,
+		DataType:       DataExportCandles,
+		Interval:       kline.OneHour,
+		Start:          dataExportStart,
+		End:            dataExportStart.AddDate(0, 0, 3),
+		Format:         DataExportCSV,
+		PartitionByDay: true,
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if len(resp.Files) != 2 {
+		t.Fatalf("received: '%v' but expected: '%v'", len(resp.Files), 2)
+	}
+	for i := range resp.Files {
+		if resp.Files[i].Rows != 24 {
+			t.Errorf("received: '%v' but expected: '%v'", resp.Files[i].Rows, 24)
+		}
+		data, loadErr := csv.LoadData(common.DataCandle, resp.Files[i].Path, testExchange, kline.OneHour.Duration(), p, asset.Spot, "", 0)
+		if !errors.Is(loadErr, nil) {
+			t.Fatalf("received: '%v' but expected: '%v'", loadErr, nil)
+		}
+		if len(data.Item.Candles) != 24 {
+			t.Fatalf("received: '%v' but expected: '%v'", len(data.Item.Candles), 24)
+		}
+		expected := dataExportStart.AddDate(0, 0, i)
+		if !data.Item.Candles[0].Time.Equal(expected) {
+			t.Errorf("received: '%v' but expected: '%v'", data.Item.Candles[0].Time, expected)
+		}
+		if data.Item.Candles[0].Volume != 1337 || data.Item.Candles[0].Low != 0.5 {
+			t.Errorf("unexpected candle %+v", data.Item.Candles[0])
+		}
+	}
+}
+
+func TestDataExportTradesJSONLines(t *testing.T) {
+	t.Parallel()
+	m := setupDataExportTest(t)
+	resp, err := m.Export(&DataExportRequest{
+		Exchange: testExchange,
+		Asset:    asset.Spot,
+		Pair:     currency.NewPair(currency.BTC, currency.USD),
+		DataType: DataExportTrades,
+		Start:    dataExportStart,
+		End:      dataExportStart.AddDate(0, 0, 2),
+		Format:   DataExportJSONLines,
+		Gzip:     true,
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if len(resp.Files) != 1 || resp.Files[0].Rows != 2 {
+		t.Fatalf("unexpected files %+v", resp.Files)
+	}
+	f, err := os.Open(resp.Files[0].Path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err = f.Close(); err != nil {
+			t.Error(err)
+		}
+	}()
+	gz, err := gzip.NewReader(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	scanner := bufio.NewScanner(gz)
+	var lines []map[string]interface{}
+	for scanner.Scan() {
+		line := make(map[string]interface{})
+		if err = json.Unmarshal(scanner.Bytes(), &line); err != nil {
+			t.Fatal(err)
+		}
+		lines = append(lines, line)
+	}
+	if len(lines) != 2 {
+		t.Fatalf("received: '%v' but expected: '%v'", len(lines), 2)
+	}
+	if lines[1]["side"] != order.Sell.String() || lines[1]["trade_id"] != "2" || lines[1]["price"] != 1338.0 {
+		t.Errorf("unexpected trade %v", lines[1])
+	}
+}
+
+func TestDataExportParquet(t *testing.T) {
+	t.Parallel()
+	m := setupDataExportTest(t)
+	resp, err := m.Export(&DataExportRequest{
+		Exchange: testExchange,
+		Asset:    asset.Spot,
+		Pair:     currency.NewPair(currency.BTC, currency.USD),
+		DataType: DataExportCandles,
+		Interval: kline.OneHour,
+		Start:    dataExportStart,
+		End:      dataExportStart.AddDate(0, 0, 1),
+		Format:   DataExportParquet,
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if len(resp.Files) != 1 || resp.Files[0].Rows != 24 {
+		t.Fatalf("unexpected files %+v", resp.Files)
+	}
+	b, err := os.ReadFile(resp.Files[0].Path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pf, err := buffer.NewBufferFile(b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pr, err := reader.NewParquetColumnReader(pf, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pr.GetNumRows() != 24 {
+		t.Errorf("received: '%v' but expected: '%v'", pr.GetNumRows(), 24)
+	}
+	values, _, _, err := pr.ReadColumnByIndex(0, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(values) != 1 || values[0] != dataExportStart.UnixNano()/int64(time.Millisecond) {
+		t.Errorf("received: '%v' but expected: '%v'", values, dataExportStart.UnixNano()/int64(time.Millisecond))
+	}
+}
+
+func TestDataExportErrors(t *testing.T) {
+	t.Parallel()
+	var m *DataExportManager
+	_, err := m.Export(nil)
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrNilSubsystem)
+	}
+
+	m = setupDataExportTest(t)
+	r := &DataExportRequest{
+		Exchange: testExchange,
+		Asset:    asset.Spot,
+		Pair:     currency.NewPair(currency.BTC, currency.USD),
+		DataType: DataExportCandles,
+		Interval: kline.OneHour,
+		Start:    dataExportStart.AddDate(0, 0, 5),
+		End:      dataExportStart.AddDate(0, 0, 6),
+		Format:   DataExportCSV,
+	}
+	_, err = m.Export(r)
+	if !errors.Is(err, errNoDataToExport) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errNoDataToExport)
+	}
+
+	errLoad := errors.New("load error")
+	m.tradeLoader = func(string, string, string, string, time.Time, time.Time) ([]trade.Data, error) {
+		return nil, errLoad
+	}
+	r.DataType = DataExportTrades
+	_, err = m.Export(r)
+	if !errors.Is(err, errLoad) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errLoad)
+	}
+
+	m.databaseConnectionInstance = &database.Instance{}
+	_, err = m.Export(r)
+	if !errors.Is(err, database.ErrDatabaseNotConnected) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, database.ErrDatabaseNotConnected)
+	}
+
+	files, err := os.ReadDir(m.directory)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 0 {
+		t.Errorf("expected no files to be written, received %v", filepath.Join(m.directory, files[0].Name()))
+	}
+}
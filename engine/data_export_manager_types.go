@@ -0,0 +1,87 @@
+package engine
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+)
+
+// DataExportManagerName is an exported subsystem name
+const DataExportManagerName = "data_export"
+
+// DefaultDataExportDirectory is the directory, relative to the data
+// directory, exported files are written to
+const DefaultDataExportDirectory = "export"
+
+// DataExportType defines the stored data to export
+type DataExportType string
+
+// Exportable data types
+const (
+	DataExportCandles DataExportType = "candles"
+	DataExportTrades  DataExportType = "trades"
+)
+
+// DataExportFormat defines the file format to export to
+type DataExportFormat string
+
+// Supported export formats
+const (
+	DataExportCSV       DataExportFormat = "csv"
+	DataExportJSONLines DataExportFormat = "jsonl"
+	DataExportParquet   DataExportFormat = "parquet"
+)
+
+var (
+	errNilDataExportRequest    = errors.New("nil data export request")
+	errInvalidDataExportType   = errors.New("invalid data export type")
+	errInvalidDataExportFormat = errors.New("invalid data export format")
+	errNoDataToExport          = errors.New("no data found to export")
+)
+
+// DataExportManager streams stored candle and trade data from the database
+// to CSV, JSON Lines or Parquet files. Data is loaded one day at a time so
+// large date ranges do not need to be held in memory.
+type DataExportManager struct {
+	databaseConnectionInstance database.IDatabase
+	directory                  string
+	candleLoader               func(string, currency.Pair, asset.Item, kline.Interval, time.Time, time.Time) (kline.Item, error)
+	tradeLoader                func(string, string, string, string, time.Time, time.Time) ([]trade.Data, error)
+}
+
+// DataExportRequest defines the data to export and how it is written.
+// Start is inclusive and End is exclusive.
+type DataExportRequest struct {
+	Exchange       string
+	Asset          asset.Item
+	Pair           currency.Pair
+	DataType       DataExportType
+	Interval       kline.Interval
+	Start          time.Time
+	End            time.Time
+	Format         DataExportFormat
+	Gzip           bool
+	PartitionByDay bool
+}
+
+// DataExportResult holds the files written by an export
+type DataExportResult struct {
+	Files []ExportedFile
+}
+
+// ExportedFile holds the location and row count of an exported file
+type ExportedFile struct {
+	Path string
+	Rows int64
+}
+
+// exportWriter writes rows of column ordered values to a file format
+type exportWriter interface {
+	write(values ...interface{}) error
+	close() error
+}
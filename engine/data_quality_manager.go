@@ -0,0 +1,489 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/candle"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/dataquality"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+)
+
+// SetupDataQualityManager creates a data quality manager which reads stored
+// data from, and saves findings to, the connected database
+func SetupDataQualityManager(dcm iDatabaseConnectionManager) (*DataQualityManager, error) {
+	if dcm == nil {
+		return nil, errNilDatabaseConnectionManager
+	}
+	m := &DataQualityManager{
+		databaseConnectionInstance: dcm.GetInstance(),
+		candleLoader:               kline.LoadFromDatabase,
+		tradeLoader:                trade.GetTradesInRange,
+	}
+	store, err := dataquality.Setup(m.databaseConnectionInstance)
+	if err != nil {
+		return nil, err
+	}
+	if store != nil {
+		m.findingStore = store
+	}
+	return m, nil
+}
+
+// Scan checks the stored data for the request and returns a report of all
+// findings along with a quality score for the range
+func (m *DataQualityManager) Scan(r *DataQualityRequest) (*DataQualityReport, error) {
+	if m == nil {
+		return nil, fmt.Errorf("%s %w", DataQualityManagerName, ErrNilSubsystem)
+	}
+	if err := r.validate(); err != nil {
+		return nil, err
+	}
+	if m.databaseConnectionInstance == nil || !m.databaseConnectionInstance.IsConnected() {
+		return nil, database.ErrDatabaseNotConnected
+	}
+	if r.Store && m.findingStore == nil {
+		return nil, fmt.Errorf("cannot store findings, %w", database.ErrDatabaseNotConnected)
+	}
+
+	s := newDataQualityScan(r)
+	var err error
+	switch r.DataType {
+	case DataQualityCandles:
+		err = m.scanCandles(r, s)
+	case DataQualityTrades:
+		err = m.scanTrades(r, s)
+	}
+	if err != nil {
+		return nil, err
+	}
+	report := s.complete()
+
+	if r.Store {
+		findings := make([]*dataquality.Finding, len(report.Findings))
+		for i := range report.Findings {
+			findings[i] = &dataquality.Finding{
+				CheckType: string(report.Findings[i].Check),
+				StartTime: report.Findings[i].Start,
+				EndTime:   report.Findings[i].End,
+				Detail:    report.Findings[i].Detail,
+			}
+		}
+		err = m.findingStore.Replace(r.Exchange,
+			r.Asset.String(),
+			r.Pair.Base.String(),
+			r.Pair.Quote.String(),
+			string(r.DataType),
+			int64(r.Interval.Duration().Seconds()),
+			r.Start,
+			r.End,
+			findings...)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return report, nil
+}
+
+// scanCandles loads all stored candles for the range and runs the candle
+// checks, candles are then compared against candles built from any stored
+// trades one chunk at a time
+func (m *DataQualityManager) scanCandles(r *DataQualityRequest, s *dataQualityScan) error {
+	item, err := m.candleLoader(r.Exchange, r.Pair, r.Asset, r.Interval, r.Start, r.End)
+	if err != nil && !errors.Is(err, candle.ErrNoCandleDataFound) {
+		return err
+	}
+	sort.Slice(item.Candles, func(i, j int) bool {
+		return item.Candles[i].Time.Before(item.Candles[j].Time)
+	})
+
+	candles := make(map[int64]*kline.Candle)
+	unique := make([]*kline.Candle, 0, len(item.Candles))
+	for i := range item.Candles {
+		c := &item.Candles[i]
+		if c.Time.Before(r.Start) || !c.Time.Before(r.End) {
+			continue
+		}
+		bucket := s.bucket(c.Time)
+		s.counts[bucket]++
+		if s.counts[bucket] == 1 {
+			candles[bucket] = c
+			unique = append(unique, c)
+		}
+	}
+
+	var ranges []float64
+	var zeroVolumeStart time.Time
+	var zeroVolumeCount int64
+	for i := range unique {
+		c := unique[i]
+		bucket := s.bucket(c.Time)
+		start := time.Unix(bucket, 0).UTC()
+		if n := s.counts[bucket]; n > 1 {
+			s.add(DataQualityDuplicate, start, start.Add(s.interval), fmt.Sprintf("%d candles stored for interval", n))
+		}
+
+		if issues := candleInconsistencies(c); len(issues) > 0 {
+			s.add(DataQualityInconsistent, start, start.Add(s.interval), strings.Join(issues, ", "))
+		} else if c.High > c.Low {
+			ranges = append(ranges, c.High-c.Low)
+		}
+
+		if c.Volume == 0 {
+			if zeroVolumeCount > 0 && !start.Equal(zeroVolumeStart.Add(s.interval*time.Duration(zeroVolumeCount))) {
+				s.zeroVolumeRun(zeroVolumeStart, zeroVolumeCount, r.ZeroVolumeRun)
+				zeroVolumeCount = 0
+			}
+			if zeroVolumeCount == 0 {
+				zeroVolumeStart = start
+			}
+			zeroVolumeCount++
+		} else if zeroVolumeCount > 0 {
+			s.zeroVolumeRun(zeroVolumeStart, zeroVolumeCount, r.ZeroVolumeRun)
+			zeroVolumeCount = 0
+		}
+	}
+	s.zeroVolumeRun(zeroVolumeStart, zeroVolumeCount, r.ZeroVolumeRun)
+
+	if median := medianFloat(ranges); median > 0 {
+		limit := median * r.WickMultiplier
+		for i := range unique {
+			c := unique[i]
+			upper := c.High - math.Max(c.Open, c.Close)
+			lower := math.Min(c.Open, c.Close) - c.Low
+			if upper <= limit && lower <= limit {
+				continue
+			}
+			start := time.Unix(s.bucket(c.Time), 0).UTC()
+			s.add(DataQualityOutlierWick, start, start.Add(s.interval),
+				fmt.Sprintf("upper wick %v lower wick %v exceeds %v times median range %v", upper, lower, r.WickMultiplier, median))
+		}
+	}
+	s.gaps()
+
+	return s.forEachChunk(func(start, end time.Time) error {
+		trades, err := m.loadTrades(r, start, end)
+		if err != nil || len(trades) == 0 {
+			return err
+		}
+		built, err := trade.ConvertTradesToCandles(r.Interval, trades...)
+		if err != nil {
+			return err
+		}
+		for i := range built.Candles {
+			bucket := s.bucket(built.Candles[i].Time)
+			stored, ok := candles[bucket]
+			if !ok {
+				continue
+			}
+			diffs := candleDifferences(stored, &built.Candles[i], r.MismatchTolerance)
+			if len(diffs) == 0 {
+				continue
+			}
+			bucketStart := time.Unix(bucket, 0).UTC()
+			s.add(DataQualityTradeMismatch, bucketStart, bucketStart.Add(s.interval), strings.Join(diffs, ", "))
+		}
+		return nil
+	})
+}
+
+// scanTrades loads stored trades one chunk at a time and checks for
+// intervals without trades, duplicate trades and inconsistent values
+func (m *DataQualityManager) scanTrades(r *DataQualityRequest, s *dataQualityScan) error {
+	err := s.forEachChunk(func(start, end time.Time) error {
+		trades, err := m.loadTrades(r, start, end)
+		if err != nil {
+			return err
+		}
+		seen := make(map[string]int64, len(trades))
+		for i := range trades {
+			t := &trades[i]
+			bucket := s.bucket(t.Timestamp)
+			s.counts[bucket]++
+			bucketStart := time.Unix(bucket, 0).UTC()
+			key := t.TID
+			if key == "" {
+				key = strconv.FormatInt(t.Timestamp.UnixNano(), 10) + "-" +
+					strconv.FormatFloat(t.Price, 'f', -1, 64) + "-" +
+					strconv.FormatFloat(t.Amount, 'f', -1, 64) + "-" +
+					t.Side.String()
+			}
+			seen[key]++
+			if seen[key] == 2 {
+				s.add(DataQualityDuplicate, bucketStart, bucketStart.Add(s.interval),
+					fmt.Sprintf("trade at %s price %v amount %v stored more than once",
+						t.Timestamp.Format(common.SimpleTimeFormat), t.Price, t.Amount))
+			}
+			var issues []string
+			if t.Price <= 0 {
+				issues = append(issues, "price not positive")
+			}
+			if t.Amount <= 0 {
+				issues = append(issues, "amount not positive")
+			}
+			if len(issues) > 0 {
+				s.add(DataQualityInconsistent, bucketStart, bucketStart.Add(s.interval),
+					fmt.Sprintf("trade at %s %s", t.Timestamp.Format(common.SimpleTimeFormat), strings.Join(issues, ", ")))
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return err
+	}
+	s.gaps()
+	return nil
+}
+
+// loadTrades returns stored trades between start inclusive and end
+// exclusive ordered by time
+func (m *DataQualityManager) loadTrades(r *DataQualityRequest, start, end time.Time) ([]trade.Data, error) {
+	trades, err := m.tradeLoader(r.Exchange, r.Asset.String(), r.Pair.Base.String(), r.Pair.Quote.String(), start, end)
+	if err != nil {
+		return nil, err
+	}
+	filtered := trades[:0]
+	for i := range trades {
+		if trades[i].Timestamp.Before(start) || !trades[i].Timestamp.Before(end) {
+			continue
+		}
+		filtered = append(filtered, trades[i])
+	}
+	sort.Sort(trade.ByDate(filtered))
+	return filtered, nil
+}
+
+// validate checks the request, applies default thresholds and aligns its
+// time range to the interval. Ranges ending in the future are cut to now so
+// intervals yet to occur are not reported as gaps
+func (r *DataQualityRequest) validate() error {
+	if r == nil {
+		return errNilDataQualityRequest
+	}
+	if r.Exchange == "" {
+		return errExchangeNameUnset
+	}
+	if r.Pair.IsEmpty() {
+		return errCurrencyPairUnset
+	}
+	if !r.Asset.IsValid() {
+		return fmt.Errorf("%v %w", r.Asset, asset.ErrNotSupported)
+	}
+	switch r.DataType {
+	case DataQualityCandles, DataQualityTrades:
+	default:
+		return fmt.Errorf("%w: %s", errInvalidDataQualityType, r.DataType)
+	}
+	if r.Interval <= 0 {
+		return kline.ErrUnsetInterval
+	}
+	if r.ZeroVolumeRun < 0 || r.WickMultiplier < 0 || r.MismatchTolerance < 0 {
+		return fmt.Errorf("%w: thresholds cannot be negative", errInvalidDataQualityArg)
+	}
+	if r.ZeroVolumeRun == 0 {
+		r.ZeroVolumeRun = defaultDataQualityZeroVolumeRun
+	}
+	if r.WickMultiplier == 0 {
+		r.WickMultiplier = defaultDataQualityWickMultiplier
+	}
+	if r.MismatchTolerance == 0 {
+		r.MismatchTolerance = defaultDataQualityMismatchTolerance
+	}
+	if err := common.StartEndTimeCheck(r.Start, r.End); err != nil {
+		return err
+	}
+	if now := time.Now(); r.End.After(now) {
+		r.End = now
+	}
+	r.Start = r.Start.UTC().Truncate(r.Interval.Duration())
+	r.End = r.End.UTC().Truncate(r.Interval.Duration())
+	if !r.End.After(r.Start) {
+		return fmt.Errorf("%w: date range does not contain a complete %s interval", errInvalidDataQualityArg, r.Interval)
+	}
+	return nil
+}
+
+// dataQualityScan tracks the findings and the intervals they affect while
+// scanning a request
+type dataQualityScan struct {
+	report   *DataQualityReport
+	interval time.Duration
+	counts   map[int64]int64
+	affected map[int64]struct{}
+}
+
+func newDataQualityScan(r *DataQualityRequest) *dataQualityScan {
+	return &dataQualityScan{
+		report: &DataQualityReport{
+			Exchange:          r.Exchange,
+			Asset:             r.Asset,
+			Pair:              r.Pair,
+			DataType:          r.DataType,
+			Interval:          r.Interval,
+			Start:             r.Start,
+			End:               r.End,
+			ExpectedIntervals: int64(r.End.Sub(r.Start) / r.Interval.Duration()),
+			CheckCounts:       make(map[DataQualityCheck]int64, len(dataQualityChecks)),
+		},
+		interval: r.Interval.Duration(),
+		counts:   make(map[int64]int64),
+		affected: make(map[int64]struct{}),
+	}
+}
+
+// bucket returns the unix time of the interval containing t
+func (s *dataQualityScan) bucket(t time.Time) int64 {
+	return t.Truncate(s.interval).Unix()
+}
+
+// add records a finding and marks every interval it covers as affected
+func (s *dataQualityScan) add(check DataQualityCheck, start, end time.Time, detail string) {
+	s.report.Findings = append(s.report.Findings, DataQualityFinding{
+		Check:  check,
+		Start:  start,
+		End:    end,
+		Detail: detail,
+	})
+	s.report.CheckCounts[check]++
+	for t := start; t.Before(end); t = t.Add(s.interval) {
+		s.affected[t.Unix()] = struct{}{}
+	}
+}
+
+// zeroVolumeRun records a run of zero volume candles when it is long enough
+func (s *dataQualityScan) zeroVolumeRun(start time.Time, count, minimum int64) {
+	if count == 0 || count < minimum {
+		return
+	}
+	s.add(DataQualityZeroVolume, start, start.Add(s.interval*time.Duration(count)),
+		fmt.Sprintf("%d consecutive candles without volume", count))
+}
+
+// gaps records each run of consecutive intervals without data
+func (s *dataQualityScan) gaps() {
+	var gapStart time.Time
+	var missing int64
+	for t := s.report.Start; t.Before(s.report.End); t = t.Add(s.interval) {
+		if s.counts[t.Unix()] > 0 {
+			if missing > 0 {
+				s.add(DataQualityGap, gapStart, t, fmt.Sprintf("%d intervals without data", missing))
+				missing = 0
+			}
+			continue
+		}
+		if missing == 0 {
+			gapStart = t
+		}
+		missing++
+	}
+	if missing > 0 {
+		s.add(DataQualityGap, gapStart, s.report.End, fmt.Sprintf("%d intervals without data", missing))
+	}
+}
+
+// forEachChunk calls fn for consecutive chunks of the scanned range. Chunks
+// are one day, or one interval when intervals are longer than a day, so
+// trades are never held in memory for the whole range
+func (s *dataQualityScan) forEachChunk(fn func(start, end time.Time) error) error {
+	chunk := kline.OneDay.Duration()
+	if s.interval > chunk {
+		chunk = s.interval
+	}
+	for start := s.report.Start; start.Before(s.report.End); {
+		end := start.Truncate(chunk).Add(chunk)
+		if end.After(s.report.End) {
+			end = s.report.End
+		}
+		if err := fn(start, end); err != nil {
+			return err
+		}
+		start = end
+	}
+	return nil
+}
+
+// complete sorts the findings and calculates the quality score
+func (s *dataQualityScan) complete() *DataQualityReport {
+	order := make(map[DataQualityCheck]int, len(dataQualityChecks))
+	for i := range dataQualityChecks {
+		order[dataQualityChecks[i]] = i
+	}
+	sort.SliceStable(s.report.Findings, func(i, j int) bool {
+		if !s.report.Findings[i].Start.Equal(s.report.Findings[j].Start) {
+			return s.report.Findings[i].Start.Before(s.report.Findings[j].Start)
+		}
+		return order[s.report.Findings[i].Check] < order[s.report.Findings[j].Check]
+	})
+	for k, v := range s.counts {
+		if v > 0 && k >= s.report.Start.Unix() && k < s.report.End.Unix() {
+			s.report.IntervalsWithData++
+		}
+	}
+	s.report.AffectedIntervals = int64(len(s.affected))
+	if s.report.ExpectedIntervals > 0 {
+		s.report.Score = float64(s.report.ExpectedIntervals-s.report.AffectedIntervals) / float64(s.report.ExpectedIntervals) * 100
+	}
+	return s.report
+}
+
+// candleInconsistencies returns the impossible values of a candle
+func candleInconsistencies(c *kline.Candle) []string {
+	var issues []string
+	if c.Open <= 0 || c.High <= 0 || c.Low <= 0 || c.Close <= 0 {
+		issues = append(issues, "price not positive")
+	}
+	if c.High < c.Low {
+		issues = append(issues, "high below low")
+	}
+	if c.High < c.Open || c.High < c.Close {
+		issues = append(issues, "high below open or close")
+	}
+	if c.Low > c.Open || c.Low > c.Close {
+		issues = append(issues, "low above open or close")
+	}
+	if c.Volume < 0 {
+		issues = append(issues, "negative volume")
+	}
+	return issues
+}
+
+// candleDifferences returns the prices of a stored candle which differ from
+// the candle built from trades by more than the tolerance percentage
+func candleDifferences(stored, built *kline.Candle, tolerance float64) []string {
+	var diffs []string
+	compare := func(name string, a, b float64) {
+		if b == 0 {
+			return
+		}
+		if diff := math.Abs(a-b) / b * 100; diff > tolerance {
+			diffs = append(diffs, fmt.Sprintf("%s %v differs from trades %v by %.2f%%", name, a, b, diff))
+		}
+	}
+	compare("open", stored.Open, built.Open)
+	compare("high", stored.High, built.High)
+	compare("low", stored.Low, built.Low)
+	compare("close", stored.Close, built.Close)
+	return diffs
+}
+
+// medianFloat returns the median of the values, the values are sorted
+func medianFloat(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sort.Float64s(values)
+	mid := len(values) / 2
+	if len(values)%2 == 0 {
+		return (values[mid-1] + values[mid]) / 2
+	}
+	return values[mid]
+}
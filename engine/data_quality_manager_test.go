@@ -0,0 +1,316 @@
+package engine
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/candle"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/dataquality"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+)
+
+var dataQualityStart = time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+
+type fakeDataQualityStore struct {
+	dataType string
+	interval int64
+	findings []*dataquality.Finding
+}
+
+func (f *fakeDataQualityStore) Replace(_, _, _, _, dataType string, interval int64, _, _ time.Time, findings ...*dataquality.Finding) error {
+	f.dataType = dataType
+	f.interval = interval
+	f.findings = findings
+	return nil
+}
+
+func (f *fakeDataQualityStore) GetInRange(_, _, _, _, _ string, _ int64, _, _ time.Time) ([]dataquality.Finding, error) {
+	return nil, nil
+}
+
+func dataQualityTestHour(h int) time.Time {
+	return dataQualityStart.Add(time.Hour * time.Duration(h))
+}
+
+func setupDataQualityTest(t *testing.T) *DataQualityManager {
+	t.Helper()
+	dbInst := &database.Instance{}
+	dbInst.SetConnected(true)
+	return &DataQualityManager{
+		databaseConnectionInstance: dbInst,
+		candleLoader: func(exch string, p currency.Pair, a asset.Item, i kline.Interval, start, end time.Time) (kline.Item, error) {
+			item := kline.Item{Exchange: exch, Pair: p, Asset: a, Interval: i}
+			for h := 0; h < 24; h++ {
+				c := kline.Candle{Time: dataQualityTestHour(h), Open: 10, High: 11, Low: 9, Close: 10.5, Volume: 1}
+				switch h {
+				case 3:
+					continue
+				case 5:
+					item.Candles = append(item.Candles, c)
+				case 8, 9, 10:
+					c.Volume = 0
+				case 12:
+					c.High = 100
+				case 15:
+					c.High = 10
+				}
+				item.Candles = append(item.Candles, c)
+			}
+			return item, nil
+		},
+		tradeLoader: func(exch, a, base, quote string, start, end time.Time) ([]trade.Data, error) {
+			if !start.Equal(dataQualityStart) {
+				return nil, nil
+			}
+			return []trade.Data{
+				{Timestamp: dataQualityTestHour(20), Price: 20, Amount: 1, Side: order.Buy},
+				{Timestamp: dataQualityTestHour(20).Add(time.Minute), Price: 21, Amount: 1, Side: order.Buy},
+				{Timestamp: dataQualityTestHour(21), Price: 10, Amount: 1, Side: order.Buy},
+				{Timestamp: dataQualityTestHour(21).Add(time.Minute), Price: 11, Amount: 1, Side: order.Sell},
+				{Timestamp: dataQualityTestHour(21).Add(time.Minute * 2), Price: 9, Amount: 1, Side: order.Sell},
+				{Timestamp: dataQualityTestHour(21).Add(time.Minute * 3), Price: 10.5, Amount: 1, Side: order.Buy},
+				{Timestamp: dataQualityTestHour(21).Add(time.Minute * 3), Price: 10.5, Amount: 1, Side: order.Buy},
+				{Timestamp: dataQualityTestHour(22), Price: 0, Amount: 1, Side: order.Buy},
+			}, nil
+		},
+	}
+}
+
+func dataQualityTestRequest(dataType DataQualityDataType) *DataQualityRequest {
+	return &DataQualityRequest{
+		Exchange: testExchange,
+		Asset:    asset.Spot,
+		Pair:     currency.NewPair(currency.BTC, currency.USD),
+		DataType: dataType,
+		Interval: kline.OneHour,
+		Start:    dataQualityStart,
+		End:      dataQualityStart.Add(kline.OneDay.Duration()),
+	}
+}
+
+func TestSetupDataQualityManager(t *testing.T) {
+	t.Parallel()
+	_, err := SetupDataQualityManager(nil)
+	if !errors.Is(err, errNilDatabaseConnectionManager) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errNilDatabaseConnectionManager)
+	}
+	m, err := SetupDataQualityManager(&DatabaseConnectionManager{})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if m.candleLoader == nil || m.tradeLoader == nil {
+		t.Error("expected loaders to be set")
+	}
+	if m.findingStore != nil {
+		t.Error("expected no finding store without a database connection")
+	}
+}
+
+func TestDataQualityRequestValidate(t *testing.T) {
+	t.Parallel()
+	var r *DataQualityRequest
+	err := r.validate()
+	if !errors.Is(err, errNilDataQualityRequest) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errNilDataQualityRequest)
+	}
+	r = &DataQualityRequest{}
+	err = r.validate()
+	if !errors.Is(err, errExchangeNameUnset) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errExchangeNameUnset)
+	}
+	r.Exchange = testExchange
+	err = r.validate()
+	if !errors.Is(err, errCurrencyPairUnset) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errCurrencyPairUnset)
+	}
+	r.Pair = currency.NewPair(currency.BTC, currency.USD)
+	err = r.validate()
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, asset.ErrNotSupported)
+	}
+	r.Asset = asset.Spot
+	err = r.validate()
+	if !errors.Is(err, errInvalidDataQualityType) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errInvalidDataQualityType)
+	}
+	r.DataType = DataQualityTrades
+	err = r.validate()
+	if !errors.Is(err, kline.ErrUnsetInterval) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, kline.ErrUnsetInterval)
+	}
+	r.Interval = kline.OneHour
+	r.WickMultiplier = -1
+	err = r.validate()
+	if !errors.Is(err, errInvalidDataQualityArg) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errInvalidDataQualityArg)
+	}
+	r.WickMultiplier = 0
+	r.Start = dataQualityStart.Add(time.Minute)
+	r.End = dataQualityStart.Add(time.Minute * 30)
+	err = r.validate()
+	if !errors.Is(err, errInvalidDataQualityArg) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errInvalidDataQualityArg)
+	}
+	r.End = dataQualityStart.Add(time.Hour*2 + time.Minute)
+	err = r.validate()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if !r.Start.Equal(dataQualityStart) || !r.End.Equal(dataQualityStart.Add(time.Hour*2)) {
+		t.Errorf("expected range to be aligned to the interval, received %v %v", r.Start, r.End)
+	}
+	if r.ZeroVolumeRun != defaultDataQualityZeroVolumeRun ||
+		r.WickMultiplier != defaultDataQualityWickMultiplier ||
+		r.MismatchTolerance != defaultDataQualityMismatchTolerance {
+		t.Error("expected default thresholds to be applied")
+	}
+}
+
+func TestDataQualityScanCandles(t *testing.T) {
+	t.Parallel()
+	var m *DataQualityManager
+	_, err := m.Scan(nil)
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrNilSubsystem)
+	}
+
+	m = setupDataQualityTest(t)
+	report, err := m.Scan(dataQualityTestRequest(DataQualityCandles))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	expected := map[DataQualityCheck]int64{
+		DataQualityGap:           1,
+		DataQualityDuplicate:     1,
+		DataQualityZeroVolume:    1,
+		DataQualityOutlierWick:   1,
+		DataQualityInconsistent:  1,
+		DataQualityTradeMismatch: 1,
+	}
+	for k, v := range expected {
+		if report.CheckCounts[k] != v {
+			t.Errorf("received: '%v' %v findings but expected: '%v'", report.CheckCounts[k], k, v)
+		}
+	}
+	if len(report.Findings) != 6 {
+		t.Fatalf("received: '%v' but expected: '%v'", len(report.Findings), 6)
+	}
+	if report.Findings[0].Check != DataQualityGap || !report.Findings[0].Start.Equal(dataQualityTestHour(3)) {
+		t.Errorf("expected findings to be ordered by time, received %+v", report.Findings[0])
+	}
+	if report.ExpectedIntervals != 24 || report.IntervalsWithData != 23 {
+		t.Errorf("received: '%v' '%v' but expected: '%v' '%v'", report.ExpectedIntervals, report.IntervalsWithData, 24, 23)
+	}
+	// gap, duplicate, three zero volume, wick, inconsistent and mismatch
+	if report.AffectedIntervals != 8 {
+		t.Errorf("received: '%v' but expected: '%v'", report.AffectedIntervals, 8)
+	}
+	if expectedScore := float64(16) / 24 * 100; report.Score != expectedScore {
+		t.Errorf("received: '%v' but expected: '%v'", report.Score, expectedScore)
+	}
+
+	r := dataQualityTestRequest(DataQualityCandles)
+	r.ZeroVolumeRun = 4
+	report, err = m.Scan(r)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if report.CheckCounts[DataQualityZeroVolume] != 0 {
+		t.Errorf("received: '%v' but expected: '%v'", report.CheckCounts[DataQualityZeroVolume], 0)
+	}
+
+	m.candleLoader = func(string, currency.Pair, asset.Item, kline.Interval, time.Time, time.Time) (kline.Item, error) {
+		return kline.Item{}, candle.ErrNoCandleDataFound
+	}
+	report, err = m.Scan(dataQualityTestRequest(DataQualityCandles))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if report.Score != 0 || report.CheckCounts[DataQualityGap] != 1 {
+		t.Errorf("expected a single gap over the whole range, received score %v and %v gaps", report.Score, report.CheckCounts[DataQualityGap])
+	}
+}
+
+func TestDataQualityScanTrades(t *testing.T) {
+	t.Parallel()
+	m := setupDataQualityTest(t)
+	report, err := m.Scan(dataQualityTestRequest(DataQualityTrades))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if report.IntervalsWithData != 3 {
+		t.Errorf("received: '%v' but expected: '%v'", report.IntervalsWithData, 3)
+	}
+	// hours zero to 19 and 23 are missing
+	if report.CheckCounts[DataQualityGap] != 2 {
+		t.Errorf("received: '%v' but expected: '%v'", report.CheckCounts[DataQualityGap], 2)
+	}
+	if report.CheckCounts[DataQualityDuplicate] != 1 {
+		t.Errorf("received: '%v' but expected: '%v'", report.CheckCounts[DataQualityDuplicate], 1)
+	}
+	if report.CheckCounts[DataQualityInconsistent] != 1 {
+		t.Errorf("received: '%v' but expected: '%v'", report.CheckCounts[DataQualityInconsistent], 1)
+	}
+	if report.AffectedIntervals != 23 {
+		t.Errorf("received: '%v' but expected: '%v'", report.AffectedIntervals, 23)
+	}
+}
+
+func TestDataQualityScanStore(t *testing.T) {
+	t.Parallel()
+	m := setupDataQualityTest(t)
+	r := dataQualityTestRequest(DataQualityCandles)
+	r.Store = true
+	_, err := m.Scan(r)
+	if !errors.Is(err, database.ErrDatabaseNotConnected) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, database.ErrDatabaseNotConnected)
+	}
+
+	store := &fakeDataQualityStore{}
+	m.findingStore = store
+	r = dataQualityTestRequest(DataQualityCandles)
+	r.Store = true
+	report, err := m.Scan(r)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if len(store.findings) != len(report.Findings) {
+		t.Fatalf("received: '%v' but expected: '%v'", len(store.findings), len(report.Findings))
+	}
+	if store.dataType != string(DataQualityCandles) || store.interval != 3600 {
+		t.Errorf("unexpected stored scope %v %v", store.dataType, store.interval)
+	}
+	if store.findings[0].CheckType != string(DataQualityGap) {
+		t.Errorf("received: '%v' but expected: '%v'", store.findings[0].CheckType, DataQualityGap)
+	}
+}
+
+func TestCandleInconsistencies(t *testing.T) {
+	t.Parallel()
+	if issues := candleInconsistencies(&kline.Candle{Open: 1, High: 2, Low: 0.5, Close: 1.5}); len(issues) != 0 {
+		t.Errorf("received: '%v' but expected no issues", issues)
+	}
+	issues := candleInconsistencies(&kline.Candle{Open: 1, High: 0.5, Low: 2, Close: -1, Volume: -1})
+	if len(issues) != 5 {
+		t.Errorf("received: '%v' but expected: '%v'", len(issues), 5)
+	}
+}
+
+func TestMedianFloat(t *testing.T) {
+	t.Parallel()
+	if m := medianFloat(nil); m != 0 {
+		t.Errorf("received: '%v' but expected: '%v'", m, 0)
+	}
+	if m := medianFloat([]float64{3, 1, 2}); m != 2 {
+		t.Errorf("received: '%v' but expected: '%v'", m, 2)
+	}
+	if m := medianFloat([]float64{4, 1, 2, 3}); m != 2.5 {
+		t.Errorf("received: '%v' but expected: '%v'", m, 2.5)
+	}
+}
@@ -0,0 +1,132 @@
+package engine
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/dataquality"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+)
+
+// DataQualityManagerName is an exported subsystem name
+const DataQualityManagerName = "data_quality"
+
+// DataQualityDataType defines the stored data to scan
+type DataQualityDataType string
+
+// Scannable data types
+const (
+	DataQualityCandles DataQualityDataType = "candles"
+	DataQualityTrades  DataQualityDataType = "trades"
+)
+
+// DataQualityCheck defines a type of issue found in stored data
+type DataQualityCheck string
+
+// Data quality checks
+const (
+	// DataQualityGap is one or more consecutive intervals without data
+	DataQualityGap DataQualityCheck = "gap"
+	// DataQualityDuplicate is more than one candle in an interval or a
+	// trade stored more than once
+	DataQualityDuplicate DataQualityCheck = "duplicate"
+	// DataQualityZeroVolume is a run of consecutive candles without volume
+	DataQualityZeroVolume DataQualityCheck = "zero_volume"
+	// DataQualityOutlierWick is a candle wick far larger than the median
+	// candle range of the scanned data
+	DataQualityOutlierWick DataQualityCheck = "outlier_wick"
+	// DataQualityInconsistent is a candle or trade with impossible values
+	// such as a high below the close or a non-positive price
+	DataQualityInconsistent DataQualityCheck = "inconsistent"
+	// DataQualityTradeMismatch is a candle which differs from the candle
+	// built from stored trades for the same interval
+	DataQualityTradeMismatch DataQualityCheck = "trade_mismatch"
+)
+
+// dataQualityChecks lists all checks in report order
+var dataQualityChecks = []DataQualityCheck{
+	DataQualityGap,
+	DataQualityDuplicate,
+	DataQualityZeroVolume,
+	DataQualityOutlierWick,
+	DataQualityInconsistent,
+	DataQualityTradeMismatch,
+}
+
+const (
+	defaultDataQualityZeroVolumeRun     = 3
+	defaultDataQualityWickMultiplier    = 10.0
+	defaultDataQualityMismatchTolerance = 0.5
+)
+
+var (
+	errNilDataQualityRequest  = errors.New("nil data quality request")
+	errInvalidDataQualityType = errors.New("invalid data quality data type")
+	errInvalidDataQualityArg  = errors.New("invalid data quality argument")
+)
+
+// DataQualityManager scans stored candle and trade data for gaps,
+// duplicates, zero volume runs, outlier wicks, inconsistent values and
+// differences between stored candles and candles built from stored trades.
+// Findings can be saved to the database and are summarised as a score
+type DataQualityManager struct {
+	databaseConnectionInstance database.IDatabase
+	findingStore               dataquality.IDBService
+	candleLoader               func(string, currency.Pair, asset.Item, kline.Interval, time.Time, time.Time) (kline.Item, error)
+	tradeLoader                func(string, string, string, string, time.Time, time.Time) ([]trade.Data, error)
+}
+
+// DataQualityRequest defines the stored data to scan and the check
+// thresholds. Start is inclusive and End is exclusive. Zero thresholds use
+// the defaults
+type DataQualityRequest struct {
+	Exchange string
+	Asset    asset.Item
+	Pair     currency.Pair
+	DataType DataQualityDataType
+	Interval kline.Interval
+	Start    time.Time
+	End      time.Time
+	// ZeroVolumeRun is the number of consecutive zero volume candles
+	// reported as a finding
+	ZeroVolumeRun int64
+	// WickMultiplier is the multiple of the median candle range a wick must
+	// exceed to be reported as an outlier
+	WickMultiplier float64
+	// MismatchTolerance is the percentage difference allowed between a
+	// stored candle and the candle built from stored trades
+	MismatchTolerance float64
+	// Store saves the findings to the database, replacing any findings
+	// previously stored for the scanned range
+	Store bool
+}
+
+// DataQualityReport holds the findings and quality score of a scan
+type DataQualityReport struct {
+	Exchange          string
+	Asset             asset.Item
+	Pair              currency.Pair
+	DataType          DataQualityDataType
+	Interval          kline.Interval
+	Start             time.Time
+	End               time.Time
+	ExpectedIntervals int64
+	IntervalsWithData int64
+	AffectedIntervals int64
+	// Score is the percentage of expected intervals without any finding
+	Score       float64
+	CheckCounts map[DataQualityCheck]int64
+	Findings    []DataQualityFinding
+}
+
+// DataQualityFinding is an issue found over a range of stored data
+type DataQualityFinding struct {
+	Check  DataQualityCheck
+	Start  time.Time
+	End    time.Time
+	Detail string
+}
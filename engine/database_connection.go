@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/database"
+	dbmysql "github.com/thrasher-corp/gocryptotrader/database/drivers/mysql"
 	dbpsql "github.com/thrasher-corp/gocryptotrader/database/drivers/postgres"
 	dbsqlite3 "github.com/thrasher-corp/gocryptotrader/database/drivers/sqlite3"
 	"github.com/thrasher-corp/gocryptotrader/log"
@@ -93,6 +94,13 @@ func (m *DatabaseConnectionManager) Start(wg *sync.WaitGroup) (err error) {
 				m.cfg.Database,
 				m.cfg.Driver)
 			m.dbConn, err = dbpsql.Connect(&m.cfg)
+		case database.DBMySQL:
+			log.Debugf(log.DatabaseMgr,
+				"Attempting to establish database connection to host %s/%s utilising %s driver\n",
+				m.cfg.Host,
+				m.cfg.Database,
+				m.cfg.Driver)
+			m.dbConn, err = dbmysql.Connect(&m.cfg)
 		case database.DBSQLite,
 			database.DBSQLite3:
 			log.Debugf(log.DatabaseMgr,
@@ -18,6 +18,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/database/repository/candle"
 	"github.com/thrasher-corp/gocryptotrader/database/repository/datahistoryjob"
 	"github.com/thrasher-corp/gocryptotrader/database/repository/datahistoryjobresult"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/tradebar"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
@@ -54,6 +55,10 @@ func SetupDataHistoryManager(em iExchangeManager, dcm iDatabaseConnectionManager
 	if err != nil {
 		return nil, err
 	}
+	tb, err := tradebar.Setup(db)
+	if err != nil {
+		return nil, err
+	}
 
 	return &DataHistoryManager{
 		exchangeManager:            em,
@@ -62,6 +67,7 @@ func SetupDataHistoryManager(em iExchangeManager, dcm iDatabaseConnectionManager
 		interval:                   time.NewTicker(cfg.CheckInterval),
 		jobDB:                      dhj,
 		jobResultDB:                dhjr,
+		barDB:                      tb,
 		maxJobsPerCycle:            cfg.MaxJobsPerCycle,
 		verbose:                    cfg.Verbose,
 		maxResultInsertions:        cfg.MaxResultInsertions,
@@ -158,6 +164,7 @@ func (m *DataHistoryManager) PrepareJobs() ([]*DataHistoryJob, error) {
 		}()
 		return nil, fmt.Errorf("error retrieving jobs, has everything been setup? Data history manager will shut down. %w", err)
 	}
+	jobs = m.scheduleJobs(time.Now(), jobs...)
 	err = m.compareJobsToData(jobs...)
 	if err != nil {
 		return nil, err
@@ -166,6 +173,137 @@ func (m *DataHistoryManager) PrepareJobs() ([]*DataHistoryJob, error) {
 	return jobs, nil
 }
 
+// scheduleJobs removes recurring jobs which are not yet due to run, discards
+// results belonging to previous runs and discovers the start date of
+// backfill to inception jobs
+func (m *DataHistoryManager) scheduleJobs(now time.Time, jobs ...*DataHistoryJob) []*DataHistoryJob {
+	response := make([]*DataHistoryJob, 0, len(jobs))
+	for i := range jobs {
+		if jobs[i].NextRunDate.After(now) {
+			if m.verbose {
+				log.Debugf(log.DataHistory, "job %s next run scheduled for %v", jobs[i].Nickname, jobs[i].NextRunDate)
+			}
+			continue
+		}
+		if jobs[i].BackfillToInception {
+			err := m.discoverInception(jobs[i])
+			if err != nil {
+				log.Error(log.DataHistory, err)
+				continue
+			}
+		}
+		if !jobs[i].NextRunDate.IsZero() {
+			for k, results := range jobs[i].Results {
+				current := results[:0]
+				for x := range results {
+					if !results[x].Date.Before(jobs[i].NextRunDate) {
+						current = append(current, results[x])
+					}
+				}
+				if len(current) == 0 {
+					delete(jobs[i].Results, k)
+					continue
+				}
+				jobs[i].Results[k] = current
+			}
+		}
+		response = append(response, jobs[i])
+	}
+	return response
+}
+
+// discoverInception searches for the earliest available data between the
+// job's start and end dates, sets it as the job's start date and saves the job
+func (m *DataHistoryManager) discoverInception(job *DataHistoryJob) error {
+	exch, err := m.exchangeManager.GetExchangeByName(job.Exchange)
+	if err != nil {
+		return fmt.Errorf("job %s cannot discover inception: %w", job.Nickname, err)
+	}
+	var probe func(start, end time.Time) (time.Time, error)
+	switch job.DataType {
+	case dataHistoryCandleDataType:
+		probe = func(start, end time.Time) (time.Time, error) {
+			candles, err := exch.GetHistoricCandles(context.TODO(), job.Pair, job.Asset, start, end, job.Interval)
+			if err != nil {
+				return time.Time{}, err
+			}
+			var earliest time.Time
+			for i := range candles.Candles {
+				if earliest.IsZero() || candles.Candles[i].Time.Before(earliest) {
+					earliest = candles.Candles[i].Time
+				}
+			}
+			return earliest, nil
+		}
+	case dataHistoryTradeDataType:
+		probe = func(start, end time.Time) (time.Time, error) {
+			trades, err := exch.GetHistoricTrades(context.TODO(), job.Pair, job.Asset, start, end)
+			if err != nil {
+				return time.Time{}, err
+			}
+			var earliest time.Time
+			for i := range trades {
+				if earliest.IsZero() || trades[i].Timestamp.Before(earliest) {
+					earliest = trades[i].Timestamp
+				}
+			}
+			return earliest, nil
+		}
+	default:
+		return fmt.Errorf("job %s %w", job.Nickname, errInceptionUnsupported)
+	}
+	window := job.Interval.Duration() * time.Duration(job.RequestSizeLimit)
+	earliest, err := findEarliestData(job.StartDate, job.EndDate, window, probe)
+	if err != nil {
+		return fmt.Errorf("job %s %s %s %s %w", job.Nickname, job.Exchange, job.Asset, job.Pair, err)
+	}
+	job.StartDate = earliest.Truncate(job.Interval.Duration())
+	job.BackfillToInception = false
+	log.Infof(log.DataHistory, "job %s discovered data inception at %v", job.Nickname, job.StartDate)
+	return m.jobDB.Upsert(m.convertJobToDBModel(job))
+}
+
+// findEarliestData performs a binary search over windows between start and
+// end to find the earliest data returned by probe. It assumes that once data
+// is available it remains available for all later windows. probe returns
+// the earliest timestamp within the window or a zero time if there is none
+func findEarliestData(start, end time.Time, window time.Duration, probe func(start, end time.Time) (time.Time, error)) (time.Time, error) {
+	if err := common.StartEndTimeCheck(start, end); err != nil {
+		return time.Time{}, err
+	}
+	if window <= 0 {
+		return time.Time{}, kline.ErrUnsupportedInterval
+	}
+	windows := int64(end.Sub(start) / window)
+	if end.Sub(start)%window != 0 {
+		windows++
+	}
+	var earliest time.Time
+	low, high := int64(0), windows
+	for low < high {
+		mid := low + (high-low)/2
+		windowStart := start.Add(window * time.Duration(mid))
+		windowEnd := windowStart.Add(window)
+		if windowEnd.After(end) {
+			windowEnd = end
+		}
+		found, err := probe(windowStart, windowEnd)
+		if err != nil {
+			return time.Time{}, err
+		}
+		if found.IsZero() {
+			low = mid + 1
+			continue
+		}
+		earliest = found
+		high = mid
+	}
+	if earliest.IsZero() {
+		return time.Time{}, errNoInceptionData
+	}
+	return earliest, nil
+}
+
 func (m *DataHistoryManager) compareJobsToData(jobs ...*DataHistoryJob) error {
 	if m == nil {
 		return ErrNilSubsystem
@@ -190,7 +328,7 @@ func (m *DataHistoryManager) compareJobsToData(jobs ...*DataHistoryJob) error {
 				return fmt.Errorf("%s could not load candle data: %w", jobs[i].Nickname, err)
 			}
 			jobs[i].rangeHolder.SetHasDataFromCandles(candles.Candles)
-		case dataHistoryTradeDataType:
+		case dataHistoryTradeDataType, dataHistoryConvertTradesToBarsDataType:
 			for x := range jobs[i].rangeHolder.Ranges {
 				results, ok := jobs[i].Results[jobs[i].rangeHolder.Ranges[x].Start.Time]
 				if !ok {
@@ -441,6 +579,8 @@ ranges:
 			result, err = m.convertTradesToCandles(job, job.rangeHolder.Ranges[i].Start.Time, job.rangeHolder.Ranges[i].End.Time)
 		case dataHistoryConvertCandlesDataType:
 			result, err = m.convertCandleData(job, job.rangeHolder.Ranges[i].Start.Time, job.rangeHolder.Ranges[i].End.Time)
+		case dataHistoryConvertTradesToBarsDataType:
+			result, err = m.convertTradesToBars(job, job.rangeHolder.Ranges[i].Start.Time, job.rangeHolder.Ranges[i].End.Time)
 		default:
 			return errUnknownDataType
 		}
@@ -609,6 +749,14 @@ func (m *DataHistoryManager) completeJob(job *DataHistoryJob, allResultsSuccessf
 		job.Status = dataHistoryIntervalIssuesFound
 	}
 	log.Infof(log.DataHistory, "job %s finished! Status: %s", job.Nickname, job.Status)
+	if job.RecurrenceInterval > 0 {
+		scheduleNextRun(job, time.Now())
+		log.Infof(log.DataHistory, "job %s next run scheduled for %v covering %v - %v",
+			job.Nickname,
+			job.NextRunDate.Format(common.SimpleTimeFormatWithTimezone),
+			job.StartDate.Format(common.SimpleTimeFormatWithTimezone),
+			job.EndDate.Format(common.SimpleTimeFormatWithTimezone))
+	}
 	if job.Status != dataHistoryStatusFailed {
 		newJobs, err := m.jobDB.GetRelatedUpcomingJobs(job.Nickname)
 		if err != nil && !errors.Is(err, sql.ErrNoRows) {
@@ -630,6 +778,48 @@ func (m *DataHistoryManager) completeJob(job *DataHistoryJob, allResultsSuccessf
 	return nil
 }
 
+// scheduleNextRun sets the next run date and date range of a recurring job
+// and sets it back to active. Each run fetches from the previous end date,
+// or the rolling window, up to the next run date less the settle delay
+func scheduleNextRun(job *DataHistoryJob, now time.Time) {
+	start := job.EndDate
+	next := now.Truncate(job.RecurrenceInterval).Add(job.RecurrenceInterval)
+	end := next.Add(-job.SettleDelay).Truncate(job.Interval.Duration())
+	for !end.After(start) {
+		next = next.Add(job.RecurrenceInterval)
+		end = next.Add(-job.SettleDelay).Truncate(job.Interval.Duration())
+	}
+	if job.RollingWindow > 0 {
+		start = end.Add(-job.RollingWindow)
+	}
+	job.StartDate = start
+	job.EndDate = end
+	job.NextRunDate = next
+	job.Status = dataHistoryStatusActive
+}
+
+// applyJobSchedule sets unset start and end dates of scheduled jobs. The end
+// date defaults to now less the settle delay and the start date defaults to
+// the rolling window or the earliest date searched for inception
+func applyJobSchedule(job *DataHistoryJob, now time.Time) error {
+	if job.RecurrenceInterval < 0 || job.SettleDelay < 0 || job.RollingWindow < 0 {
+		return fmt.Errorf("job %s %w", job.Nickname, errInvalidJobSchedule)
+	}
+	if job.EndDate.IsZero() &&
+		(job.RecurrenceInterval > 0 || job.RollingWindow > 0 || job.BackfillToInception) {
+		job.EndDate = now.Add(-job.SettleDelay).Truncate(job.Interval.Duration())
+	}
+	if job.StartDate.IsZero() {
+		switch {
+		case job.RollingWindow > 0:
+			job.StartDate = job.EndDate.Add(-job.RollingWindow)
+		case job.BackfillToInception:
+			job.StartDate = defaultDataHistoryInceptionDate
+		}
+	}
+	return nil
+}
+
 func (m *DataHistoryManager) saveCandlesInBatches(job *DataHistoryJob, candles *kline.Item, r *DataHistoryJobResult) error {
 	if !m.IsRunning() {
 		return ErrSubSystemNotStarted
@@ -838,6 +1028,75 @@ func (m *DataHistoryManager) convertTradesToCandles(job *DataHistoryJob, startRa
 	return r, err
 }
 
+// convertTradesToBars samples stored trades within the range into bars and
+// replaces any bars previously stored for the range. Trades at the end of the
+// range which do not complete a bar are not carried into the next range
+func (m *DataHistoryManager) convertTradesToBars(job *DataHistoryJob, startRange, endRange time.Time) (*DataHistoryJobResult, error) {
+	if !m.IsRunning() {
+		return nil, ErrSubSystemNotStarted
+	}
+	if job == nil {
+		return nil, errNilJob
+	}
+	if err := common.StartEndTimeCheck(startRange, endRange); err != nil {
+		return nil, err
+	}
+	id, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+	r := &DataHistoryJobResult{
+		ID:                id,
+		JobID:             job.ID,
+		IntervalStartDate: startRange,
+		IntervalEndDate:   endRange,
+		Status:            dataHistoryStatusComplete,
+		Date:              time.Now(),
+	}
+	trades, err := m.tradeLoader(job.Exchange, job.Asset.String(), job.Pair.Base.String(), job.Pair.Quote.String(), startRange, endRange)
+	if err != nil {
+		r.Result = "could not get trades in range: " + err.Error()
+		r.Status = dataHistoryStatusFailed
+		return r, nil
+	}
+	bars, err := trade.ConvertTradesToBars(job.BarType, job.BarThreshold, trades...)
+	if err != nil {
+		r.Result = "could not convert trades in range: " + err.Error()
+		r.Status = dataHistoryStatusFailed
+		return r, nil
+	}
+	dbBars := make([]*tradebar.Bar, len(bars))
+	for i := range bars {
+		dbBars[i] = &tradebar.Bar{
+			StartTime:   bars[i].Start,
+			EndTime:     bars[i].End,
+			Open:        bars[i].Open,
+			High:        bars[i].High,
+			Low:         bars[i].Low,
+			Close:       bars[i].Close,
+			Volume:      bars[i].Volume,
+			Notional:    bars[i].Notional,
+			Imbalance:   bars[i].Imbalance,
+			TradeCount:  bars[i].Trades,
+			SourceJobID: job.ID.String(),
+		}
+	}
+	err = m.barDB.Replace(job.Exchange,
+		job.Asset.String(),
+		job.Pair.Base.String(),
+		job.Pair.Quote.String(),
+		string(job.BarType),
+		job.BarThreshold,
+		startRange,
+		endRange,
+		dbBars...)
+	if err != nil {
+		r.Result = "could not save bars in range: " + err.Error()
+		r.Status = dataHistoryStatusFailed
+	}
+	return r, nil
+}
+
 func (m *DataHistoryManager) convertCandleData(job *DataHistoryJob, startRange, endRange time.Time) (*DataHistoryJobResult, error) {
 	if !m.IsRunning() {
 		return nil, ErrSubSystemNotStarted
@@ -1119,6 +1378,10 @@ func (m *DataHistoryManager) UpsertJob(job *DataHistoryJob, insertOnly bool) err
 		}
 	}
 
+	err = applyJobSchedule(job, time.Now())
+	if err != nil {
+		return err
+	}
 	err = m.validateJob(job)
 	if err != nil {
 		return err
@@ -1136,13 +1399,16 @@ func (m *DataHistoryManager) UpsertJob(job *DataHistoryJob, insertOnly bool) err
 			return err
 		}
 	}
-	interval := job.Interval
-	if job.DataType == dataHistoryConvertCandlesDataType {
-		interval = job.ConversionInterval
-	}
-	job.rangeHolder, err = kline.CalculateCandleDateRanges(job.StartDate, job.EndDate, interval, uint32(job.RequestSizeLimit))
-	if err != nil {
-		return err
+	if !job.BackfillToInception {
+		// ranges for backfill jobs are calculated once inception is discovered
+		interval := job.Interval
+		if job.DataType == dataHistoryConvertCandlesDataType {
+			interval = job.ConversionInterval
+		}
+		job.rangeHolder, err = kline.CalculateCandleDateRanges(job.StartDate, job.EndDate, interval, uint32(job.RequestSizeLimit))
+		if err != nil {
+			return err
+		}
 	}
 
 	dbJob := m.convertJobToDBModel(job)
@@ -1157,6 +1423,54 @@ func (m *DataHistoryManager) UpsertJob(job *DataHistoryJob, insertOnly bool) err
 	return m.jobDB.SetRelationshipByNickname(job.PrerequisiteJobNickname, job.Nickname, int64(dataHistoryStatusPaused))
 }
 
+// UpsertJobsForEnabledPairs upserts a copy of the job for every enabled pair
+// of the job's exchange and asset, the job's pair is ignored and each
+// nickname is suffixed with its pair
+func (m *DataHistoryManager) UpsertJobsForEnabledPairs(job *DataHistoryJob, insertOnly bool) ([]*DataHistoryJob, error) {
+	if m == nil {
+		return nil, ErrNilSubsystem
+	}
+	if !m.IsRunning() {
+		return nil, ErrSubSystemNotStarted
+	}
+	if job == nil {
+		return nil, errNilJob
+	}
+	if job.Nickname == "" {
+		return nil, fmt.Errorf("upsert jobs %w", errNicknameUnset)
+	}
+	exch, err := m.exchangeManager.GetExchangeByName(job.Exchange)
+	if err != nil {
+		return nil, fmt.Errorf("upsert jobs %s %w", job.Nickname, err)
+	}
+	pairs, err := exch.GetEnabledPairs(job.Asset)
+	if err != nil {
+		return nil, fmt.Errorf("upsert jobs %s exchange %s asset %s %w", job.Nickname, job.Exchange, job.Asset, err)
+	}
+	if len(pairs) == 0 {
+		return nil, fmt.Errorf("upsert jobs %s exchange %s asset %s %w", job.Nickname, job.Exchange, job.Asset, errCurrencyNotEnabled)
+	}
+	var jobs []*DataHistoryJob
+	var errs common.Errors
+	for i := range pairs {
+		pairJob := *job
+		pairJob.ID = uuid.Nil
+		pairJob.Results = nil
+		pairJob.Pair = pairs[i]
+		pairJob.Nickname = strings.ToLower(fmt.Sprintf("%s-%s-%s", job.Nickname, pairs[i].Base, pairs[i].Quote))
+		err = m.UpsertJob(&pairJob, insertOnly)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		jobs = append(jobs, &pairJob)
+	}
+	if len(errs) > 0 {
+		return jobs, errs
+	}
+	return jobs, nil
+}
+
 func (m *DataHistoryManager) validateJob(job *DataHistoryJob) error {
 	if !m.IsRunning() {
 		return ErrSubSystemNotStarted
@@ -1238,6 +1552,24 @@ func (m *DataHistoryManager) validateJob(job *DataHistoryJob) error {
 		return fmt.Errorf("job conversion interval %s %s %w %s", job.Nickname, job.ConversionInterval.Word(), kline.ErrUnsupportedInterval, job.Exchange)
 	}
 
+	if job.DataType == dataHistoryConvertTradesToBarsDataType {
+		if job.Interval <= 0 {
+			return fmt.Errorf("job conversion interval %s %s %w %s", job.Nickname, job.Interval.Word(), kline.ErrUnsupportedInterval, job.Exchange)
+		}
+		if !job.BarType.Valid() {
+			return fmt.Errorf("job %s %w '%s'", job.Nickname, trade.ErrInvalidBarType, job.BarType)
+		}
+		if job.BarThreshold <= 0 {
+			return fmt.Errorf("job %s %w", job.Nickname, trade.ErrInvalidBarThreshold)
+		}
+	}
+
+	if job.BackfillToInception &&
+		job.DataType != dataHistoryCandleDataType &&
+		job.DataType != dataHistoryTradeDataType {
+		return fmt.Errorf("job %s %w: %s", job.Nickname, errInceptionUnsupported, job.DataType)
+	}
+
 	if job.DataType == dataHistoryCandleValidationDataType {
 		if job.DecimalPlaceComparison < 0 {
 			log.Warnf(log.DataHistory, "job %s decimal place comparison %v invalid. defaulting to %v decimal places when comparing data for validation", job.Nickname, job.DecimalPlaceComparison, defaultDecimalPlaceComparison)
@@ -1448,16 +1780,18 @@ func (m *DataHistoryManager) GenerateJobSummary(nickname string) (*DataHistoryJo
 	}
 
 	return &DataHistoryJobSummary{
-		Nickname:     job.Nickname,
-		Exchange:     job.Exchange,
-		Asset:        job.Asset,
-		Pair:         job.Pair,
-		StartDate:    job.StartDate,
-		EndDate:      job.EndDate,
-		Interval:     job.Interval,
-		Status:       job.Status,
-		DataType:     job.DataType,
-		ResultRanges: job.rangeHolder.DataSummary(true),
+		Nickname:           job.Nickname,
+		Exchange:           job.Exchange,
+		Asset:              job.Asset,
+		Pair:               job.Pair,
+		StartDate:          job.StartDate,
+		EndDate:            job.EndDate,
+		Interval:           job.Interval,
+		Status:             job.Status,
+		DataType:           job.DataType,
+		ResultRanges:       job.rangeHolder.DataSummary(true),
+		RecurrenceInterval: job.RecurrenceInterval,
+		NextRunDate:        job.NextRunDate,
 	}, nil
 }
 
@@ -1502,6 +1836,13 @@ func (m *DataHistoryManager) convertDBModelToJob(dbModel *datahistoryjob.DataHis
 		IssueTolerancePercentage: dbModel.IssueTolerancePercentage,
 		ReplaceOnIssue:           dbModel.ReplaceOnIssue,
 		PrerequisiteJobNickname:  dbModel.PrerequisiteJobNickname,
+		RecurrenceInterval:       time.Duration(dbModel.RecurrenceInterval),
+		SettleDelay:              time.Duration(dbModel.SettleDelay),
+		RollingWindow:            time.Duration(dbModel.RollingWindow),
+		BackfillToInception:      dbModel.BackfillToInception,
+		NextRunDate:              dbModel.NextRunDate,
+		BarType:                  trade.BarType(dbModel.BarType),
+		BarThreshold:             dbModel.BarThreshold,
 	}
 	if resp.PrerequisiteJobNickname != "" {
 		prereqID, err := uuid.FromString(dbModel.PrerequisiteJobID)
@@ -1587,6 +1928,13 @@ func (m *DataHistoryManager) convertJobToDBModel(job *DataHistoryJob) *datahisto
 		SecondarySourceExchangeName: job.SecondaryExchangeSource,
 		IssueTolerancePercentage:    job.IssueTolerancePercentage,
 		ReplaceOnIssue:              job.ReplaceOnIssue,
+		RecurrenceInterval:          int64(job.RecurrenceInterval),
+		SettleDelay:                 int64(job.SettleDelay),
+		RollingWindow:               int64(job.RollingWindow),
+		BackfillToInception:         job.BackfillToInception,
+		NextRunDate:                 job.NextRunDate,
+		BarType:                     string(job.BarType),
+		BarThreshold:                job.BarThreshold,
 	}
 	if job.ID != uuid.Nil {
 		model.ID = job.ID.String()
@@ -17,6 +17,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/database"
 	"github.com/thrasher-corp/gocryptotrader/database/repository/datahistoryjob"
 	"github.com/thrasher-corp/gocryptotrader/database/repository/datahistoryjobresult"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/tradebar"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
@@ -527,6 +528,22 @@ func TestValidateJob(t *testing.T) {
 		t.Errorf("error '%v', expected '%v'", err, nil)
 	}
 
+	dhj.DataType = dataHistoryConvertTradesToBarsDataType
+	err = m.validateJob(dhj)
+	if !errors.Is(err, trade.ErrInvalidBarType) {
+		t.Errorf("error '%v', expected '%v'", err, trade.ErrInvalidBarType)
+	}
+	dhj.BarType = trade.DollarBar
+	err = m.validateJob(dhj)
+	if !errors.Is(err, trade.ErrInvalidBarThreshold) {
+		t.Errorf("error '%v', expected '%v'", err, trade.ErrInvalidBarThreshold)
+	}
+	dhj.BarThreshold = 1000000
+	err = m.validateJob(dhj)
+	if !errors.Is(err, nil) {
+		t.Errorf("error '%v', expected '%v'", err, nil)
+	}
+
 	dhj.DataType = dataHistoryCandleValidationSecondarySourceType
 	err = m.validateJob(dhj)
 	if !errors.Is(err, errExchangeNameUnset) {
@@ -852,14 +869,21 @@ func TestConverters(t *testing.T) {
 	}
 
 	dhj := &DataHistoryJob{
-		ID:        id,
-		Nickname:  "TestProcessJobs",
-		Exchange:  testExchange,
-		Asset:     asset.Spot,
-		Pair:      currency.NewPair(currency.BTC, currency.USDT),
-		StartDate: time.Now().Add(-time.Hour * 24),
-		EndDate:   time.Now(),
-		Interval:  kline.OneHour,
+		ID:                  id,
+		Nickname:            "TestProcessJobs",
+		Exchange:            testExchange,
+		Asset:               asset.Spot,
+		Pair:                currency.NewPair(currency.BTC, currency.USDT),
+		StartDate:           time.Now().Add(-time.Hour * 24),
+		EndDate:             time.Now(),
+		Interval:            kline.OneHour,
+		RecurrenceInterval:  time.Hour,
+		SettleDelay:         time.Minute,
+		RollingWindow:       time.Hour * 24,
+		BackfillToInception: true,
+		NextRunDate:         time.Now().Add(time.Hour),
+		BarType:             trade.TickBar,
+		BarThreshold:        500,
 	}
 
 	dbJob := m.convertJobToDBModel(dhj)
@@ -880,7 +904,14 @@ func TestConverters(t *testing.T) {
 		dhj.Nickname != convertBack.Nickname ||
 		!dhj.StartDate.Equal(convertBack.StartDate) ||
 		dhj.Interval != convertBack.Interval ||
-		!dhj.Pair.Equal(convertBack.Pair) {
+		!dhj.Pair.Equal(convertBack.Pair) ||
+		dhj.RecurrenceInterval != convertBack.RecurrenceInterval ||
+		dhj.SettleDelay != convertBack.SettleDelay ||
+		dhj.RollingWindow != convertBack.RollingWindow ||
+		dhj.BackfillToInception != convertBack.BackfillToInception ||
+		!dhj.NextRunDate.Equal(convertBack.NextRunDate) ||
+		dhj.BarType != convertBack.BarType ||
+		dhj.BarThreshold != convertBack.BarThreshold {
 		t.Error("expected matching job")
 	}
 
@@ -1134,6 +1165,52 @@ func TestConvertJobTradesToCandles(t *testing.T) {
 	}
 }
 
+func TestConvertJobTradesToBars(t *testing.T) {
+	t.Parallel()
+	m, _ := createDHM(t)
+	_, err := m.convertTradesToBars(nil, time.Time{}, time.Time{})
+	if !errors.Is(err, errNilJob) {
+		t.Errorf("received %v expected %v", err, errNilJob)
+	}
+	j := &DataHistoryJob{
+		Nickname:     "",
+		Exchange:     testExchange,
+		Asset:        asset.Spot,
+		Pair:         currency.NewPair(currency.BTC, currency.USDT),
+		StartDate:    time.Now().Add(-kline.OneHour.Duration() * 2),
+		EndDate:      time.Now(),
+		Interval:     kline.OneHour,
+		BarType:      trade.VolumeBar,
+		BarThreshold: 1000,
+	}
+	_, err = m.convertTradesToBars(j, time.Time{}, time.Time{})
+	if !errors.Is(err, common.ErrDateUnset) {
+		t.Errorf("received %v expected %v", err, common.ErrDateUnset)
+	}
+	bars := &tradeBarService{}
+	m.barDB = bars
+	m.tradeLoader = dataHistoryTraderLoader
+	r, err := m.convertTradesToBars(j, j.StartDate, j.EndDate)
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+	if r.Status != dataHistoryStatusComplete {
+		t.Errorf("received %v expected %v", r.Status, dataHistoryStatusComplete)
+	}
+	if len(bars.bars) != 1 || bars.bars[0].Volume != 1337 || bars.bars[0].SourceJobID != j.ID.String() {
+		t.Errorf("unexpected stored bars %+v", bars.bars)
+	}
+
+	j.BarType = "renko"
+	r, err = m.convertTradesToBars(j, j.StartDate, j.EndDate)
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+	if r.Status != dataHistoryStatusFailed {
+		t.Errorf("received %v expected %v", r.Status, dataHistoryStatusFailed)
+	}
+}
+
 func TestUpscaleJobCandleData(t *testing.T) {
 	t.Parallel()
 	m, _ := createDHM(t)
@@ -1366,6 +1443,234 @@ func TestCompletionCheck(t *testing.T) {
 	if !errors.Is(err, errJobInvalid) {
 		t.Errorf("received %v expected %v", err, errJobInvalid)
 	}
+
+	j = &DataHistoryJob{
+		Status:             dataHistoryStatusActive,
+		Interval:           kline.OneHour,
+		StartDate:          time.Now().Add(-time.Hour * 3).Truncate(time.Hour),
+		EndDate:            time.Now().Add(-time.Hour).Truncate(time.Hour),
+		RecurrenceInterval: time.Hour,
+	}
+	err = m.completeJob(j, true, false)
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+	if j.Status != dataHistoryStatusActive {
+		t.Errorf("received %v expected %v", j.Status, dataHistoryStatusActive)
+	}
+	if !j.NextRunDate.After(time.Now()) {
+		t.Errorf("expected next run date in the future, received %v", j.NextRunDate)
+	}
+}
+
+func TestScheduleNextRun(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2021, 11, 1, 12, 30, 0, 0, time.UTC)
+	j := &DataHistoryJob{
+		Interval:           kline.FifteenMin,
+		StartDate:          time.Date(2021, 11, 1, 10, 0, 0, 0, time.UTC),
+		EndDate:            time.Date(2021, 11, 1, 12, 0, 0, 0, time.UTC),
+		Status:             dataHistoryStatusComplete,
+		RecurrenceInterval: time.Hour,
+		SettleDelay:        time.Minute * 5,
+	}
+	scheduleNextRun(j, now)
+	if !j.NextRunDate.Equal(time.Date(2021, 11, 1, 13, 0, 0, 0, time.UTC)) {
+		t.Errorf("received %v expected %v", j.NextRunDate, time.Date(2021, 11, 1, 13, 0, 0, 0, time.UTC))
+	}
+	if !j.StartDate.Equal(time.Date(2021, 11, 1, 12, 0, 0, 0, time.UTC)) {
+		t.Errorf("received %v expected %v", j.StartDate, time.Date(2021, 11, 1, 12, 0, 0, 0, time.UTC))
+	}
+	if !j.EndDate.Equal(time.Date(2021, 11, 1, 12, 45, 0, 0, time.UTC)) {
+		t.Errorf("received %v expected %v", j.EndDate, time.Date(2021, 11, 1, 12, 45, 0, 0, time.UTC))
+	}
+	if j.Status != dataHistoryStatusActive {
+		t.Errorf("received %v expected %v", j.Status, dataHistoryStatusActive)
+	}
+
+	// recurrence shorter than the candle interval waits until a full candle is available
+	j.Interval = kline.OneDay
+	j.StartDate = time.Date(2021, 10, 31, 0, 0, 0, 0, time.UTC)
+	j.EndDate = time.Date(2021, 11, 1, 0, 0, 0, 0, time.UTC)
+	scheduleNextRun(j, now)
+	if !j.EndDate.Equal(time.Date(2021, 11, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("received %v expected %v", j.EndDate, time.Date(2021, 11, 2, 0, 0, 0, 0, time.UTC))
+	}
+	if !j.NextRunDate.Equal(time.Date(2021, 11, 2, 1, 0, 0, 0, time.UTC)) {
+		t.Errorf("received %v expected %v", j.NextRunDate, time.Date(2021, 11, 2, 1, 0, 0, 0, time.UTC))
+	}
+
+	j.RollingWindow = time.Hour * 24 * 7
+	scheduleNextRun(j, j.NextRunDate)
+	if !j.StartDate.Equal(j.EndDate.Add(-j.RollingWindow)) {
+		t.Errorf("received %v expected %v", j.StartDate, j.EndDate.Add(-j.RollingWindow))
+	}
+}
+
+func TestApplyJobSchedule(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2021, 11, 1, 12, 30, 0, 0, time.UTC)
+	j := &DataHistoryJob{
+		Interval:    kline.OneHour,
+		SettleDelay: -time.Minute,
+	}
+	err := applyJobSchedule(j, now)
+	if !errors.Is(err, errInvalidJobSchedule) {
+		t.Errorf("received %v expected %v", err, errInvalidJobSchedule)
+	}
+
+	j.SettleDelay = time.Hour
+	err = applyJobSchedule(j, now)
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+	if !j.StartDate.IsZero() || !j.EndDate.IsZero() {
+		t.Error("expected unscheduled job dates to remain unset")
+	}
+
+	j.RollingWindow = time.Hour * 24
+	err = applyJobSchedule(j, now)
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+	if !j.EndDate.Equal(time.Date(2021, 11, 1, 11, 0, 0, 0, time.UTC)) {
+		t.Errorf("received %v expected %v", j.EndDate, time.Date(2021, 11, 1, 11, 0, 0, 0, time.UTC))
+	}
+	if !j.StartDate.Equal(time.Date(2021, 10, 31, 11, 0, 0, 0, time.UTC)) {
+		t.Errorf("received %v expected %v", j.StartDate, time.Date(2021, 10, 31, 11, 0, 0, 0, time.UTC))
+	}
+
+	j.StartDate = time.Time{}
+	j.RollingWindow = 0
+	j.BackfillToInception = true
+	err = applyJobSchedule(j, now)
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v expected %v", err, nil)
+	}
+	if !j.StartDate.Equal(defaultDataHistoryInceptionDate) {
+		t.Errorf("received %v expected %v", j.StartDate, defaultDataHistoryInceptionDate)
+	}
+}
+
+func TestScheduleJobs(t *testing.T) {
+	t.Parallel()
+	m, _ := createDHM(t)
+	now := time.Now()
+	waiting := &DataHistoryJob{
+		Nickname:           "waiting",
+		RecurrenceInterval: time.Hour,
+		NextRunDate:        now.Add(time.Minute),
+	}
+	due := &DataHistoryJob{
+		Nickname:           "due",
+		RecurrenceInterval: time.Hour,
+		NextRunDate:        now.Add(-time.Minute),
+		Results: map[time.Time][]DataHistoryJobResult{
+			startDate: {
+				{Date: now.Add(-time.Hour)},
+				{Date: now},
+			},
+			endDate: {
+				{Date: now.Add(-time.Hour)},
+			},
+		},
+	}
+	unscheduled := &DataHistoryJob{Nickname: "unscheduled"}
+	jobs := m.scheduleJobs(now, waiting, due, unscheduled)
+	if len(jobs) != 2 {
+		t.Fatalf("received %v expected %v", len(jobs), 2)
+	}
+	if jobs[0] != due || jobs[1] != unscheduled {
+		t.Error("expected waiting job to be removed")
+	}
+	if len(due.Results) != 1 || len(due.Results[startDate]) != 1 {
+		t.Errorf("expected results from previous runs to be removed, received %v", due.Results)
+	}
+}
+
+func TestFindEarliestData(t *testing.T) {
+	t.Parallel()
+	start := time.Date(2017, 1, 1, 0, 0, 0, 0, time.UTC)
+	end := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	inception := time.Date(2019, 3, 7, 13, 0, 0, 0, time.UTC)
+	var probes int
+	probe := func(s, e time.Time) (time.Time, error) {
+		probes++
+		if e.Before(inception) || e.Equal(inception) {
+			return time.Time{}, nil
+		}
+		if s.Before(inception) {
+			return inception, nil
+		}
+		return s, nil
+	}
+	_, err := findEarliestData(start, end, 0, probe)
+	if !errors.Is(err, kline.ErrUnsupportedInterval) {
+		t.Errorf("received %v expected %v", err, kline.ErrUnsupportedInterval)
+	}
+	_, err = findEarliestData(end, start, time.Hour, probe)
+	if !errors.Is(err, common.ErrStartAfterEnd) {
+		t.Errorf("received %v expected %v", err, common.ErrStartAfterEnd)
+	}
+
+	earliest, err := findEarliestData(start, end, time.Hour*500, probe)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received %v expected %v", err, nil)
+	}
+	if !earliest.Equal(inception) {
+		t.Errorf("received %v expected %v", earliest, inception)
+	}
+	if probes > 8 {
+		t.Errorf("expected a binary search, received %v probes", probes)
+	}
+
+	_, err = findEarliestData(start, inception, time.Hour*500, probe)
+	if !errors.Is(err, errNoInceptionData) {
+		t.Errorf("received %v expected %v", err, errNoInceptionData)
+	}
+
+	errProbe := errors.New("probe error")
+	_, err = findEarliestData(start, end, time.Hour*500, func(time.Time, time.Time) (time.Time, error) {
+		return time.Time{}, errProbe
+	})
+	if !errors.Is(err, errProbe) {
+		t.Errorf("received %v expected %v", err, errProbe)
+	}
+}
+
+func TestUpsertJobsForEnabledPairs(t *testing.T) {
+	t.Parallel()
+	m, _ := createDHM(t)
+	_, err := m.UpsertJobsForEnabledPairs(nil, false)
+	if !errors.Is(err, errNilJob) {
+		t.Errorf("received %v expected %v", err, errNilJob)
+	}
+	j := &DataHistoryJob{
+		Exchange: testExchange,
+		Asset:    asset.Spot,
+		Interval: kline.OneHour,
+		DataType: dataHistoryCandleDataType,
+	}
+	_, err = m.UpsertJobsForEnabledPairs(j, false)
+	if !errors.Is(err, errNicknameUnset) {
+		t.Errorf("received %v expected %v", err, errNicknameUnset)
+	}
+	j.Nickname = "hourly"
+	j.RecurrenceInterval = time.Hour
+	j.RollingWindow = time.Hour * 24
+	jobs, err := m.UpsertJobsForEnabledPairs(j, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received %v expected %v", err, nil)
+	}
+	if len(jobs) != 2 {
+		t.Fatalf("received %v expected %v", len(jobs), 2)
+	}
+	if jobs[0].Nickname != "hourly-btc-usd" || !jobs[0].Pair.Equal(currency.NewPair(currency.BTC, currency.USD)) {
+		t.Errorf("unexpected job %v %v", jobs[0].Nickname, jobs[0].Pair)
+	}
+	if jobs[1].Nickname != "hourly-btc-usdt" || jobs[1].ID == uuid.Nil {
+		t.Errorf("unexpected job %v %v", jobs[1].Nickname, jobs[1].ID)
+	}
 }
 
 func TestSaveCandlesInBatches(t *testing.T) {
@@ -1492,6 +1797,20 @@ func (d dataHistoryJobResultService) GetJobResultsBetween(_ string, _, _ time.Ti
 	return nil, nil
 }
 
+// tradeBarService stores replaced bars for inspection
+type tradeBarService struct {
+	bars []*tradebar.Bar
+}
+
+func (d *tradeBarService) Replace(_, _, _, _, _ string, _ float64, _, _ time.Time, bars ...*tradebar.Bar) error {
+	d.bars = bars
+	return nil
+}
+
+func (d *tradeBarService) GetInRange(_, _, _, _, _ string, _ float64, _, _ time.Time) ([]tradebar.Bar, error) {
+	return nil, nil
+}
+
 func dataHistoryTraderLoader(exch, a, base, quote string, start, _ time.Time) ([]trade.Data, error) {
 	cp, err := currency.NewPairFromStrings(base, quote)
 	if err != nil {
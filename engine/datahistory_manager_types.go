@@ -9,6 +9,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/database"
 	"github.com/thrasher-corp/gocryptotrader/database/repository/datahistoryjob"
 	"github.com/thrasher-corp/gocryptotrader/database/repository/datahistoryjobresult"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/tradebar"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
@@ -27,6 +28,7 @@ const (
 	dataHistoryConvertCandlesDataType
 	dataHistoryCandleValidationDataType
 	dataHistoryCandleValidationSecondarySourceType
+	dataHistoryConvertTradesToBarsDataType
 )
 
 // DataHistoryJob status descriptors
@@ -79,13 +81,15 @@ func (d dataHistoryDataType) String() string {
 		return "conversion validation"
 	case 5:
 		return "conversion validation secondary source"
+	case 6:
+		return "trade bar conversion"
 	}
 	return ""
 }
 
 // Valid ensures the value set is legitimate
 func (d dataHistoryDataType) Valid() bool {
-	return int64(d) >= 0 && int64(d) <= 5
+	return int64(d) >= 0 && int64(d) <= 6
 }
 
 var (
@@ -104,6 +108,9 @@ var (
 	errNilResult                  = errors.New("received nil job result")
 	errJobMustBeActiveOrPaused    = errors.New("job must be active or paused to be set as a prerequisite")
 	errNilCandles                 = errors.New("received nil candles")
+	errInvalidJobSchedule         = errors.New("job schedule durations cannot be negative")
+	errInceptionUnsupported       = errors.New("backfill to inception is only supported for candle and trade jobs")
+	errNoInceptionData            = errors.New("no data found when searching for inception date")
 
 	// defaultDataHistoryTradeInterval is the default interval size used to verify whether there is any database data
 	// for a trade job
@@ -116,6 +123,9 @@ var (
 	defaultDataHistoryTicker                 = time.Minute
 	defaultDataHistoryTradeRequestSize int64 = 10
 	defaultDecimalPlaceComparison      int64 = 3
+	// defaultDataHistoryInceptionDate is the earliest date searched when
+	// discovering the inception of a pair's data
+	defaultDataHistoryInceptionDate = time.Date(2009, 1, 3, 0, 0, 0, 0, time.UTC)
 )
 
 // DataHistoryManager is responsible for synchronising,
@@ -129,6 +139,7 @@ type DataHistoryManager struct {
 	interval                   *time.Ticker
 	jobDB                      datahistoryjob.IDBService
 	jobResultDB                datahistoryjobresult.IDBService
+	barDB                      tradebar.IDBService
 	maxJobsPerCycle            int64
 	maxResultInsertions        int64
 	verbose                    bool
@@ -166,6 +177,20 @@ type DataHistoryJob struct {
 	// Prerequisites mean this job is paused until the prerequisite job is completed
 	PrerequisiteJobID       uuid.UUID
 	PrerequisiteJobNickname string
+	// RecurrenceInterval reschedules a job once it completes, the next run will
+	// cover from the previous end date up to the next run date less SettleDelay
+	RecurrenceInterval time.Duration
+	SettleDelay        time.Duration
+	// RollingWindow anchors the start date of each run to the end date less the window
+	RollingWindow time.Duration
+	// BackfillToInception searches for the earliest available data
+	// before running and uses it as the start date
+	BackfillToInception bool
+	NextRunDate         time.Time
+	// BarType and BarThreshold define the bars trades are sampled into
+	// by a trade bar conversion job
+	BarType      trade.BarType
+	BarThreshold float64
 }
 
 // DataHistoryJobResult contains details on
@@ -196,4 +221,6 @@ type DataHistoryJobSummary struct {
 	OverwriteExistingData   bool
 	ConversionInterval      kline.Interval
 	PrerequisiteJobNickname string
+	RecurrenceInterval      time.Duration
+	NextRunDate             time.Time
 }
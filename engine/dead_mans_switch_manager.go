@@ -0,0 +1,368 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+const (
+	// DeadMansSwitchManagerName defines the manager name string
+	DeadMansSwitchManagerName = "dead_mans_switch"
+	// DefaultDeadMansSwitchTimeout defines the default duration an exchange
+	// waits for a refresh before cancelling all open orders
+	DefaultDeadMansSwitchTimeout = time.Minute
+	// DefaultDeadMansSwitchRefreshInterval defines the default duration
+	// between timer refreshes
+	DefaultDeadMansSwitchRefreshInterval = time.Second * 15
+)
+
+var (
+	errNilConnectionManager = errors.New("nil connection manager received")
+	errInvalidRefreshPeriod = errors.New("refresh interval must be shorter than the timeout")
+)
+
+// DeadMansSwitchManager arms and refreshes the exchange side auto-cancel
+// timer for every exchange and asset with open managed orders. Native support
+// is determined from the exchange capabilities when orders are first seen,
+// exchange assets without it have their orders cancelled via the order manager
+// when the connection manager reports a loss of connectivity.
+type DeadMansSwitchManager struct {
+	started  int32
+	shutdown chan struct{}
+	wg       sync.WaitGroup
+	iExchangeManager
+	orderManager      iDeadMansSwitchOrderManager
+	connectionManager iConnectionManager
+	timeout           time.Duration
+	refresh           time.Duration
+	verbose           bool
+
+	// armed, native and fallback are keyed by lower case exchange name and
+	// are only accessed by the monitor routine, or after it has returned
+	armed     map[string]map[asset.Item]bool
+	native    map[string]map[asset.Item]bool
+	fallback  map[string]map[asset.Item]bool
+	wasOnline bool
+}
+
+// SetupDeadMansSwitchManager applies configuration parameters before running
+func SetupDeadMansSwitchManager(em iExchangeManager, om iDeadMansSwitchOrderManager, cm iConnectionManager, timeout, refresh time.Duration, verbose bool) (*DeadMansSwitchManager, error) {
+	if em == nil {
+		return nil, errNilExchangeManager
+	}
+	if om == nil {
+		return nil, errNilOrderManager
+	}
+	if !om.IsRunning() {
+		return nil, fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
+	}
+	if cm == nil {
+		return nil, errNilConnectionManager
+	}
+	if !cm.IsRunning() {
+		return nil, fmt.Errorf("connection manager %w", ErrSubSystemNotStarted)
+	}
+	if timeout <= 0 {
+		log.Warnf(log.OrderMgr,
+			"Dead man's switch timeout is invalid, defaulting to: %s",
+			DefaultDeadMansSwitchTimeout)
+		timeout = DefaultDeadMansSwitchTimeout
+	}
+	if refresh <= 0 {
+		log.Warnf(log.OrderMgr,
+			"Dead man's switch refresh interval is invalid, defaulting to: %s",
+			DefaultDeadMansSwitchRefreshInterval)
+		refresh = DefaultDeadMansSwitchRefreshInterval
+	}
+	if refresh >= timeout {
+		return nil, fmt.Errorf("%w, refresh: %s timeout: %s",
+			errInvalidRefreshPeriod,
+			refresh,
+			timeout)
+	}
+	return &DeadMansSwitchManager{
+		shutdown:          make(chan struct{}),
+		iExchangeManager:  em,
+		orderManager:      om,
+		connectionManager: cm,
+		timeout:           timeout,
+		refresh:           refresh,
+		verbose:           verbose,
+		armed:             make(map[string]map[asset.Item]bool),
+		native:            make(map[string]map[asset.Item]bool),
+		fallback:          make(map[string]map[asset.Item]bool),
+		wasOnline:         true,
+	}, nil
+}
+
+// Start runs the subsystem
+func (d *DeadMansSwitchManager) Start() error {
+	log.Debugln(log.OrderMgr, "Dead man's switch manager starting...")
+	if d == nil {
+		return fmt.Errorf("%s %w", DeadMansSwitchManagerName, ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&d.started, 0, 1) {
+		return fmt.Errorf("%s %w", DeadMansSwitchManagerName, ErrSubSystemAlreadyStarted)
+	}
+	d.wg.Add(1)
+	go d.monitor()
+	log.Debugln(log.OrderMgr, "Dead man's switch manager started.")
+	return nil
+}
+
+// Stop stops the subsystem and disarms every timer it has armed so a
+// deliberate shutdown does not cancel orders the user intends to keep
+func (d *DeadMansSwitchManager) Stop() error {
+	if d == nil {
+		return fmt.Errorf("%s %w", DeadMansSwitchManagerName, ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&d.started) == 0 {
+		return fmt.Errorf("%s %w", DeadMansSwitchManagerName, ErrSubSystemNotStarted)
+	}
+	log.Debugf(log.OrderMgr, "Dead man's switch manager %s", MsgSubSystemShuttingDown)
+	close(d.shutdown)
+	d.wg.Wait()
+	d.shutdown = make(chan struct{})
+	for name, assets := range d.armed {
+		for a := range assets {
+			d.disarm(name, a)
+		}
+	}
+	log.Debugf(log.OrderMgr, "Dead man's switch manager %s", MsgSubSystemShutdown)
+	atomic.StoreInt32(&d.started, 0)
+	return nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (d *DeadMansSwitchManager) IsRunning() bool {
+	if d == nil {
+		return false
+	}
+	return atomic.LoadInt32(&d.started) == 1
+}
+
+func (d *DeadMansSwitchManager) monitor() {
+	defer d.wg.Done()
+	timer := time.NewTimer(0) // Prime firing of channel for initial sync.
+	for {
+		select {
+		case <-d.shutdown:
+			timer.Stop()
+			return
+		case <-timer.C:
+			d.process()
+			timer.Reset(d.refresh)
+		}
+	}
+}
+
+// process refreshes the timer for every exchange and asset with open orders,
+// disarms timers which are no longer required and triggers the fallback
+// cancellation when connectivity is lost
+func (d *DeadMansSwitchManager) process() {
+	orders, err := d.orderManager.GetOrdersActive(nil)
+	if err != nil {
+		log.Errorf(log.OrderMgr,
+			"Dead man's switch manager unable to get active orders: %v",
+			err)
+		return
+	}
+
+	open := make(map[string]map[asset.Item]bool)
+	for i := range orders {
+		name := strings.ToLower(orders[i].Exchange)
+		if open[name] == nil {
+			open[name] = make(map[asset.Item]bool)
+		}
+		open[name][orders[i].AssetType] = true
+		d.checkSupport(name, orders[i].AssetType)
+	}
+
+	// connectivity is only known while the connection manager is running
+	if d.connectionManager.IsRunning() && !d.connectionManager.IsOnline() {
+		if d.wasOnline {
+			d.wasOnline = false
+			d.cancelFallbackOrders(orders)
+		}
+		return
+	}
+	d.wasOnline = true
+
+	for name, assets := range open {
+		for a := range assets {
+			if !d.native[name][a] {
+				continue
+			}
+			d.arm(name, a)
+		}
+	}
+
+	for name, assets := range d.armed {
+		for a := range assets {
+			if !open[name][a] {
+				d.disarm(name, a)
+			}
+		}
+	}
+}
+
+// checkSupport determines from the exchange capabilities whether an exchange
+// asset supports a native timer, recording it for fallback cancellation when
+// it does not. Each exchange asset is only checked once so the fallback is in
+// place before any timer is set or connectivity is lost
+func (d *DeadMansSwitchManager) checkSupport(name string, a asset.Item) {
+	if d.native[name][a] || d.fallback[name][a] {
+		return
+	}
+	exch, err := d.GetExchangeByName(name)
+	if err != nil {
+		log.Errorf(log.OrderMgr, "Dead man's switch manager: %v", err)
+		return
+	}
+	caps, err := exch.GetCapabilities()
+	if err != nil {
+		log.Errorf(log.OrderMgr,
+			"Dead man's switch manager %s unable to get capabilities: %v",
+			exch.GetName(),
+			err)
+	} else if caps.DeadMansSwitch && caps.SupportsAsset(a) {
+		if d.native[name] == nil {
+			d.native[name] = make(map[asset.Item]bool)
+		}
+		d.native[name][a] = true
+		return
+	}
+	d.setFallback(name, a)
+	log.Warnf(log.OrderMgr,
+		"Dead man's switch manager %s %s has no native support, orders will be cancelled on loss of connectivity",
+		exch.GetName(),
+		a)
+}
+
+// setFallback records an exchange asset for fallback cancellation
+func (d *DeadMansSwitchManager) setFallback(name string, a asset.Item) {
+	delete(d.native[name], a)
+	if len(d.native[name]) == 0 {
+		delete(d.native, name)
+	}
+	if d.fallback[name] == nil {
+		d.fallback[name] = make(map[asset.Item]bool)
+	}
+	d.fallback[name][a] = true
+}
+
+// arm sets or refreshes the timer for an exchange asset with native support
+func (d *DeadMansSwitchManager) arm(name string, a asset.Item) {
+	exch, err := d.GetExchangeByName(name)
+	if err != nil {
+		log.Errorf(log.OrderMgr, "Dead man's switch manager: %v", err)
+		return
+	}
+	err = exch.SetDeadMansSwitch(context.TODO(), a, d.timeout)
+	if err != nil {
+		// the wrapper is implemented but rejects this asset, so the
+		// capabilities overstate support and the fallback is used instead
+		if errors.Is(err, common.ErrNotYetImplemented) ||
+			errors.Is(err, common.ErrFunctionNotSupported) ||
+			errors.Is(err, asset.ErrNotSupported) {
+			d.setFallback(name, a)
+			log.Warnf(log.OrderMgr,
+				"Dead man's switch manager %s %s rejected timer, orders will be cancelled on loss of connectivity: %v",
+				exch.GetName(),
+				a,
+				err)
+			return
+		}
+		log.Errorf(log.OrderMgr,
+			"Dead man's switch manager %s %s unable to set timer: %v",
+			exch.GetName(),
+			a,
+			err)
+		return
+	}
+	if d.armed[name] == nil {
+		d.armed[name] = make(map[asset.Item]bool)
+	}
+	d.armed[name][a] = true
+	if d.verbose {
+		log.Debugf(log.OrderMgr,
+			"Dead man's switch manager %s %s timer set for %s",
+			exch.GetName(),
+			a,
+			d.timeout)
+	}
+}
+
+// disarm clears the timer for an exchange asset
+func (d *DeadMansSwitchManager) disarm(name string, a asset.Item) {
+	delete(d.armed[name], a)
+	if len(d.armed[name]) == 0 {
+		delete(d.armed, name)
+	}
+	exch, err := d.GetExchangeByName(name)
+	if err != nil {
+		log.Errorf(log.OrderMgr, "Dead man's switch manager: %v", err)
+		return
+	}
+	err = exch.SetDeadMansSwitch(context.TODO(), a, 0)
+	if err != nil {
+		log.Errorf(log.OrderMgr,
+			"Dead man's switch manager %s %s unable to disarm timer: %v",
+			exch.GetName(),
+			a,
+			err)
+		return
+	}
+	if d.verbose {
+		log.Debugf(log.OrderMgr,
+			"Dead man's switch manager %s %s timer disarmed",
+			exch.GetName(),
+			a)
+	}
+}
+
+// cancelFallbackOrders cancels the active managed orders of exchange assets
+// lacking native support, orders of assets with a native timer are left for
+// the exchange to cancel
+func (d *DeadMansSwitchManager) cancelFallbackOrders(orders []order.Detail) {
+	var cancelled int
+	for i := range orders {
+		if !d.fallback[strings.ToLower(orders[i].Exchange)][orders[i].AssetType] {
+			continue
+		}
+		if cancelled == 0 {
+			log.Warnln(log.OrderMgr,
+				"Dead man's switch manager lost connectivity, cancelling orders without native support")
+		}
+		cancelled++
+		err := d.orderManager.Cancel(context.TODO(), &order.Cancel{
+			Exchange:      orders[i].Exchange,
+			ID:            orders[i].ID,
+			AccountID:     orders[i].AccountID,
+			ClientID:      orders[i].ClientID,
+			WalletAddress: orders[i].WalletAddress,
+			Type:          orders[i].Type,
+			Side:          orders[i].Side,
+			Pair:          orders[i].Pair,
+			AssetType:     orders[i].AssetType,
+		})
+		if err != nil {
+			log.Errorf(log.OrderMgr,
+				"Dead man's switch manager %s %s unable to cancel order %s: %v",
+				orders[i].Exchange,
+				orders[i].AssetType,
+				orders[i].ID,
+				err)
+		}
+	}
+}
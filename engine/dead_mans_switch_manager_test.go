@@ -0,0 +1,345 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/capability"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+var errDeadMansSwitch = errors.New("dead man's switch error")
+
+type dmsExchange struct {
+	exchange.IBotExchange
+	name        string
+	err         error
+	unsupported bool
+	timers      map[asset.Item]time.Duration
+}
+
+func (d *dmsExchange) GetName() string {
+	return d.name
+}
+
+func (d *dmsExchange) GetCapabilities() (*capability.Descriptor, error) {
+	return &capability.Descriptor{
+		Exchange:       d.name,
+		Assets:         asset.Items{asset.Spot, asset.Futures},
+		DeadMansSwitch: !d.unsupported,
+	}, nil
+}
+
+func (d *dmsExchange) SetDeadMansSwitch(_ context.Context, a asset.Item, timeout time.Duration) error {
+	if d.err != nil {
+		return d.err
+	}
+	d.timers[a] = timeout
+	return nil
+}
+
+type dmsExchangeManager struct {
+	exchs []exchange.IBotExchange
+}
+
+func (d *dmsExchangeManager) GetExchanges() ([]exchange.IBotExchange, error) {
+	return d.exchs, nil
+}
+
+func (d *dmsExchangeManager) GetExchangeByName(name string) (exchange.IBotExchange, error) {
+	for x := range d.exchs {
+		if strings.EqualFold(d.exchs[x].GetName(), name) {
+			return d.exchs[x], nil
+		}
+	}
+	return nil, ErrExchangeNotFound
+}
+
+type dmsOrderManager struct {
+	stopped   bool
+	orders    []order.Detail
+	err       error
+	cancelled []order.Cancel
+}
+
+func (d *dmsOrderManager) IsRunning() bool {
+	return !d.stopped
+}
+
+func (d *dmsOrderManager) GetOrdersActive(_ *order.Filter) ([]order.Detail, error) {
+	return d.orders, d.err
+}
+
+func (d *dmsOrderManager) Cancel(_ context.Context, c *order.Cancel) error {
+	d.cancelled = append(d.cancelled, *c)
+	return nil
+}
+
+type dmsConnectionManager struct {
+	stopped bool
+	offline bool
+}
+
+func (d *dmsConnectionManager) IsRunning() bool {
+	return !d.stopped
+}
+
+func (d *dmsConnectionManager) IsOnline() bool {
+	return !d.offline
+}
+
+func TestSetupDeadMansSwitchManager(t *testing.T) {
+	t.Parallel()
+	_, err := SetupDeadMansSwitchManager(nil, nil, nil, 0, 0, false)
+	if !errors.Is(err, errNilExchangeManager) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errNilExchangeManager)
+	}
+	em := &dmsExchangeManager{}
+	_, err = SetupDeadMansSwitchManager(em, nil, nil, 0, 0, false)
+	if !errors.Is(err, errNilOrderManager) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errNilOrderManager)
+	}
+	_, err = SetupDeadMansSwitchManager(em, &dmsOrderManager{stopped: true}, nil, 0, 0, false)
+	if !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrSubSystemNotStarted)
+	}
+	om := &dmsOrderManager{}
+	_, err = SetupDeadMansSwitchManager(em, om, nil, 0, 0, false)
+	if !errors.Is(err, errNilConnectionManager) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errNilConnectionManager)
+	}
+	_, err = SetupDeadMansSwitchManager(em, om, &dmsConnectionManager{stopped: true}, 0, 0, false)
+	if !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrSubSystemNotStarted)
+	}
+	cm := &dmsConnectionManager{}
+	_, err = SetupDeadMansSwitchManager(em, om, cm, time.Second, time.Minute, false)
+	if !errors.Is(err, errInvalidRefreshPeriod) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errInvalidRefreshPeriod)
+	}
+	d, err := SetupDeadMansSwitchManager(em, om, cm, 0, 0, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if d.timeout != DefaultDeadMansSwitchTimeout {
+		t.Errorf("received: '%v' but expected: '%v'", d.timeout, DefaultDeadMansSwitchTimeout)
+	}
+	if d.refresh != DefaultDeadMansSwitchRefreshInterval {
+		t.Errorf("received: '%v' but expected: '%v'", d.refresh, DefaultDeadMansSwitchRefreshInterval)
+	}
+}
+
+func TestDeadMansSwitchManagerStartStop(t *testing.T) {
+	t.Parallel()
+	var d *DeadMansSwitchManager
+	err := d.Start()
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrNilSubsystem)
+	}
+	err = d.Stop()
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrNilSubsystem)
+	}
+	if d.IsRunning() {
+		t.Error("expected nil manager to not be running")
+	}
+
+	exch := &dmsExchange{name: "dms", timers: make(map[asset.Item]time.Duration)}
+	om := &dmsOrderManager{
+		orders: []order.Detail{{Exchange: "dms", AssetType: asset.Spot}},
+	}
+	_, err = SetupDeadMansSwitchManager(&dmsExchangeManager{exchs: []exchange.IBotExchange{exch}},
+		om,
+		&dmsConnectionManager{},
+		time.Minute,
+		time.Hour,
+		false)
+	if !errors.Is(err, errInvalidRefreshPeriod) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errInvalidRefreshPeriod)
+	}
+	d, err = SetupDeadMansSwitchManager(&dmsExchangeManager{exchs: []exchange.IBotExchange{exch}},
+		om,
+		&dmsConnectionManager{},
+		time.Hour,
+		time.Minute,
+		false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	err = d.Stop()
+	if !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrSubSystemNotStarted)
+	}
+	d.process() // arm before starting so the state is deterministic
+	err = d.Start()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	err = d.Start()
+	if !errors.Is(err, ErrSubSystemAlreadyStarted) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrSubSystemAlreadyStarted)
+	}
+	if !d.IsRunning() {
+		t.Error("expected manager to be running")
+	}
+	err = d.Stop()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if timeout, ok := exch.timers[asset.Spot]; !ok || timeout != 0 {
+		t.Errorf("expected timer to be disarmed on stop, received: %v", timeout)
+	}
+}
+
+func TestDeadMansSwitchManagerProcess(t *testing.T) {
+	t.Parallel()
+	native := &dmsExchange{name: "Native", timers: make(map[asset.Item]time.Duration)}
+	unsupported := &dmsExchange{name: "Unsupported", unsupported: true, timers: make(map[asset.Item]time.Duration)}
+	om := &dmsOrderManager{
+		orders: []order.Detail{
+			{Exchange: "Native", AssetType: asset.Spot},
+			{Exchange: "Native", AssetType: asset.Futures},
+			{Exchange: "Unsupported", AssetType: asset.Spot},
+		},
+	}
+	cm := &dmsConnectionManager{}
+	d, err := SetupDeadMansSwitchManager(
+		&dmsExchangeManager{exchs: []exchange.IBotExchange{native, unsupported}},
+		om,
+		cm,
+		time.Minute,
+		time.Second,
+		true)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+
+	d.process()
+	if native.timers[asset.Spot] != time.Minute || native.timers[asset.Futures] != time.Minute {
+		t.Fatalf("expected timers to be armed, received: %v", native.timers)
+	}
+	if !d.fallback["unsupported"][asset.Spot] {
+		t.Fatal("expected unsupported exchange to be recorded for fallback")
+	}
+	if len(unsupported.timers) != 0 {
+		t.Fatalf("expected no timer to be set without native support, received: %v", unsupported.timers)
+	}
+
+	om.orders = om.orders[:1]
+	om.orders = append(om.orders, order.Detail{Exchange: "Unsupported", AssetType: asset.Spot})
+	d.process()
+	if native.timers[asset.Futures] != 0 {
+		t.Fatalf("expected futures timer to be disarmed, received: %v", native.timers[asset.Futures])
+	}
+	if d.armed["native"][asset.Futures] {
+		t.Fatal("expected futures to no longer be armed")
+	}
+
+	cm.offline = true
+	d.process()
+	if len(om.cancelled) != 1 || om.cancelled[0].Exchange != "Unsupported" {
+		t.Fatalf("expected fallback cancellation, received: %v", om.cancelled)
+	}
+	d.process() // still offline, orders should not be cancelled again
+	if len(om.cancelled) != 1 {
+		t.Fatalf("expected a single fallback cancellation, received: %v", om.cancelled)
+	}
+
+	cm.offline = false
+	native.err = errDeadMansSwitch
+	d.process()
+	if !d.wasOnline {
+		t.Fatal("expected connectivity to be restored")
+	}
+
+	om.err = errDeadMansSwitch
+	d.process()
+}
+
+func TestDeadMansSwitchManagerProcessOfflineBeforeArm(t *testing.T) {
+	t.Parallel()
+	unsupported := &dmsExchange{name: "Unsupported", unsupported: true}
+	om := &dmsOrderManager{
+		orders: []order.Detail{{Exchange: "Unsupported", AssetType: asset.Spot}},
+	}
+	d, err := SetupDeadMansSwitchManager(
+		&dmsExchangeManager{exchs: []exchange.IBotExchange{unsupported}},
+		om,
+		&dmsConnectionManager{offline: true},
+		time.Minute,
+		time.Second,
+		false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+
+	// connectivity is lost before a timer is ever set, the capability check
+	// must already have recorded the exchange for fallback cancellation
+	d.process()
+	if len(om.cancelled) != 1 || om.cancelled[0].Exchange != "Unsupported" {
+		t.Fatalf("expected fallback cancellation, received: %v", om.cancelled)
+	}
+}
+
+func TestDeadMansSwitchManagerArmRejected(t *testing.T) {
+	t.Parallel()
+	rejecting := &dmsExchange{name: "Rejecting", err: asset.ErrNotSupported}
+	om := &dmsOrderManager{
+		orders: []order.Detail{{Exchange: "Rejecting", AssetType: asset.Spot}},
+	}
+	d, err := SetupDeadMansSwitchManager(
+		&dmsExchangeManager{exchs: []exchange.IBotExchange{rejecting}},
+		om,
+		&dmsConnectionManager{},
+		time.Minute,
+		time.Second,
+		false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+
+	d.process()
+	if d.native["rejecting"][asset.Spot] || !d.fallback["rejecting"][asset.Spot] {
+		t.Fatal("expected rejected timer to be moved to fallback")
+	}
+}
+
+func TestDeadMansSwitchManagerFallbackAssetOnly(t *testing.T) {
+	t.Parallel()
+	exch := &dmsExchange{name: "Mixed", timers: make(map[asset.Item]time.Duration)}
+	om := &dmsOrderManager{
+		orders: []order.Detail{
+			{Exchange: "Mixed", AssetType: asset.Spot, ID: "1"},
+			{Exchange: "Mixed", AssetType: asset.Margin, ID: "2"},
+		},
+	}
+	cm := &dmsConnectionManager{}
+	d, err := SetupDeadMansSwitchManager(
+		&dmsExchangeManager{exchs: []exchange.IBotExchange{exch}},
+		om,
+		cm,
+		time.Minute,
+		time.Second,
+		false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+
+	d.process()
+	if !d.armed["mixed"][asset.Spot] || !d.fallback["mixed"][asset.Margin] {
+		t.Fatal("expected spot to be armed and margin to use the fallback")
+	}
+
+	// spot orders are protected by the exchange timer, only the margin order
+	// is cancelled
+	cm.offline = true
+	d.process()
+	if len(om.cancelled) != 1 || om.cancelled[0].ID != "2" || om.cancelled[0].AssetType != asset.Margin {
+		t.Fatalf("expected only the margin order to be cancelled, received: %v", om.cancelled)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"sync"
 
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/deposit"
 )
 
 // vars related to the deposit address helpers
@@ -18,42 +19,51 @@ var (
 // DepositAddressManager manages the exchange deposit address store
 type DepositAddressManager struct {
 	m     sync.Mutex
-	store map[string]map[string]string
+	store map[string]map[string][]deposit.Address
 }
 
 // SetupDepositAddressManager returns a DepositAddressManager
 func SetupDepositAddressManager() *DepositAddressManager {
 	return &DepositAddressManager{
-		store: make(map[string]map[string]string),
+		store: make(map[string]map[string][]deposit.Address),
 	}
 }
 
-// GetDepositAddressByExchangeAndCurrency returns a deposit address for the specified exchange and cryptocurrency
-// if it exists
-func (m *DepositAddressManager) GetDepositAddressByExchangeAndCurrency(exchName string, currencyItem currency.Code) (string, error) {
+// GetDepositAddressByExchangeAndCurrency returns a deposit address for the specified exchange, chain and cryptocurrency
+// if it exists. An empty chain returns the address for the exchange's default chain.
+func (m *DepositAddressManager) GetDepositAddressByExchangeAndCurrency(exchName, chain string, currencyItem currency.Code) (deposit.Address, error) {
 	m.m.Lock()
 	defer m.m.Unlock()
 
 	if len(m.store) == 0 {
-		return "", ErrDepositAddressStoreIsNil
+		return deposit.Address{}, ErrDepositAddressStoreIsNil
 	}
 
 	r, ok := m.store[strings.ToUpper(exchName)]
 	if !ok {
-		return "", ErrExchangeNotFound
+		return deposit.Address{}, ErrExchangeNotFound
 	}
 
-	addr, ok := r[strings.ToUpper(currencyItem.String())]
-	if !ok {
-		return "", ErrDepositAddressNotFound
+	addrs, ok := r[strings.ToUpper(currencyItem.String())]
+	if !ok || len(addrs) == 0 {
+		return deposit.Address{}, ErrDepositAddressNotFound
+	}
+
+	if chain == "" {
+		return addrs[0], nil
 	}
 
-	return addr, nil
+	for x := range addrs {
+		if strings.EqualFold(addrs[x].Chain, chain) {
+			return addrs[x], nil
+		}
+	}
+	return deposit.Address{}, fmt.Errorf("%s %w", chain, ErrDepositAddressNotFound)
 }
 
 // GetDepositAddressesByExchange returns a list of cryptocurrency addresses for the specified
 // exchange if they exist
-func (m *DepositAddressManager) GetDepositAddressesByExchange(exchName string) (map[string]string, error) {
+func (m *DepositAddressManager) GetDepositAddressesByExchange(exchName string) (map[string][]deposit.Address, error) {
 	m.m.Lock()
 	defer m.m.Unlock()
 
@@ -66,11 +76,16 @@ func (m *DepositAddressManager) GetDepositAddressesByExchange(exchName string) (
 		return nil, ErrDepositAddressNotFound
 	}
 
-	return r, nil
+	cpy := make(map[string][]deposit.Address, len(r))
+	for k, v := range r {
+		cpy[k] = append([]deposit.Address(nil), v...)
+	}
+	return cpy, nil
 }
 
-// Sync synchronises all deposit addresses
-func (m *DepositAddressManager) Sync(addresses map[string]map[string]string) error {
+// Sync synchronises all deposit addresses, the first address for each
+// currency is treated as the default chain address
+func (m *DepositAddressManager) Sync(addresses map[string]map[string][]deposit.Address) error {
 	if m == nil {
 		return fmt.Errorf("deposit address manager %w", ErrNilSubsystem)
 	}
@@ -81,7 +96,7 @@ func (m *DepositAddressManager) Sync(addresses map[string]map[string]string) err
 	}
 
 	for k, v := range addresses {
-		r := make(map[string]string)
+		r := make(map[string][]deposit.Address)
 		for w, x := range v {
 			r[strings.ToUpper(w)] = x
 		}
@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/deposit"
 )
 
 const (
@@ -22,26 +23,26 @@ func TestSetupDepositAddressManager(t *testing.T) {
 
 func TestSync(t *testing.T) {
 	m := SetupDepositAddressManager()
-	err := m.Sync(map[string]map[string]string{
+	err := m.Sync(map[string]map[string][]deposit.Address{
 		bitStamp: {
-			btc: address,
+			btc: {{Address: address}},
 		},
 	})
 	if err != nil {
 		t.Error(err)
 	}
-	r, err := m.GetDepositAddressByExchangeAndCurrency(bitStamp, currency.BTC)
+	r, err := m.GetDepositAddressByExchangeAndCurrency(bitStamp, "", currency.BTC)
 	if err != nil {
 		t.Error("unexpected result")
 	}
-	if r != address {
+	if r.Address != address {
 		t.Error("unexpected result")
 	}
 
 	m.store = nil
-	err = m.Sync(map[string]map[string]string{
+	err = m.Sync(map[string]map[string][]deposit.Address{
 		bitStamp: {
-			btc: address,
+			btc: {{Address: address}},
 		},
 	})
 	if !errors.Is(err, ErrDepositAddressStoreIsNil) {
@@ -49,9 +50,9 @@ func TestSync(t *testing.T) {
 	}
 
 	m = nil
-	err = m.Sync(map[string]map[string]string{
+	err = m.Sync(map[string]map[string][]deposit.Address{
 		bitStamp: {
-			btc: address,
+			btc: {{Address: address}},
 		},
 	})
 	if !errors.Is(err, ErrNilSubsystem) {
@@ -61,20 +62,49 @@ func TestSync(t *testing.T) {
 
 func TestGetDepositAddressByExchangeAndCurrency(t *testing.T) {
 	m := SetupDepositAddressManager()
-	_, err := m.GetDepositAddressByExchangeAndCurrency("", currency.BTC)
+	_, err := m.GetDepositAddressByExchangeAndCurrency("", "", currency.BTC)
 	if !errors.Is(err, ErrDepositAddressStoreIsNil) {
 		t.Errorf("received %v, expected %v", err, ErrDepositAddressStoreIsNil)
 	}
 
-	m.store = map[string]map[string]string{
+	m.store = map[string]map[string][]deposit.Address{
 		bitStamp: {
-			btc: address,
+			btc: {
+				{Address: address, Chain: "BTC"},
+				{Address: "0xb794f5ea0ba39494ce839613fffba74279579268", Chain: "BEP20"},
+			},
 		},
 	}
-	_, err = m.GetDepositAddressByExchangeAndCurrency(bitStamp, currency.BTC)
+	_, err = m.GetDepositAddressByExchangeAndCurrency("bla", "", currency.BTC)
+	if !errors.Is(err, ErrExchangeNotFound) {
+		t.Errorf("received %v, expected %v", err, ErrExchangeNotFound)
+	}
+
+	_, err = m.GetDepositAddressByExchangeAndCurrency(bitStamp, "", currency.LTC)
+	if !errors.Is(err, ErrDepositAddressNotFound) {
+		t.Errorf("received %v, expected %v", err, ErrDepositAddressNotFound)
+	}
+
+	r, err := m.GetDepositAddressByExchangeAndCurrency(bitStamp, "", currency.BTC)
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v, expected %v", err, nil)
+	}
+	if r.Address != address {
+		t.Error("expected default chain address")
+	}
+
+	r, err = m.GetDepositAddressByExchangeAndCurrency(bitStamp, "bep20", currency.BTC)
 	if !errors.Is(err, nil) {
 		t.Errorf("received %v, expected %v", err, nil)
 	}
+	if r.Chain != "BEP20" {
+		t.Error("unexpected chain")
+	}
+
+	_, err = m.GetDepositAddressByExchangeAndCurrency(bitStamp, "TRC20", currency.BTC)
+	if !errors.Is(err, ErrDepositAddressNotFound) {
+		t.Errorf("received %v, expected %v", err, ErrDepositAddressNotFound)
+	}
 }
 
 func TestGetDepositAddressesByExchange(t *testing.T) {
@@ -84,9 +114,9 @@ func TestGetDepositAddressesByExchange(t *testing.T) {
 		t.Errorf("received %v, expected %v", err, ErrDepositAddressStoreIsNil)
 	}
 
-	m.store = map[string]map[string]string{
+	m.store = map[string]map[string][]deposit.Address{
 		bitStamp: {
-			btc: address,
+			btc: {{Address: address}},
 		},
 	}
 	_, err = m.GetDepositAddressesByExchange(bitStamp)
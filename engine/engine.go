@@ -47,6 +47,14 @@ type Engine struct {
 	WithdrawManager         *WithdrawManager
 	dataHistoryManager      *DataHistoryManager
 	currencyStateManager    *CurrencyStateManager
+	feeManager              *FeeManager
+	consolidatedOrderbooks  *ConsolidatedOrderbookManager
+	orderbookHistory        *OrderbookHistoryManager
+	deadMansSwitch          *DeadMansSwitchManager
+	compositeIndex          *CompositeIndexManager
+	liveCandles             *LiveCandleManager
+	retention               *RetentionManager
+	symbolHistory           *SymbolHistoryManager
 	Settings                Settings
 	uptime                  time.Time
 	ServicesWG              sync.WaitGroup
@@ -146,11 +154,28 @@ func validateSettings(b *Engine, s *Settings, flagSet map[string]bool) {
 
 	b.Settings.EnableDataHistoryManager = (flagSet["datahistorymanager"] && b.Settings.EnableDatabaseManager) || b.Config.DataHistoryManager.Enabled
 
+	b.Settings.EnableOrderbookHistoryManager = flagSet["orderbookhistorymanager"] || b.Config.OrderbookHistory.Enabled
+
+	b.Settings.EnableDeadMansSwitchManager = flagSet["deadmansswitch"] || b.Config.DeadMansSwitch.Enabled
+
+	b.Settings.EnableCompositeIndexManager = flagSet["compositeindexmanager"] || b.Config.CompositeIndex.Enabled
+
+	b.Settings.EnableLiveCandleManager = flagSet["livecandlemanager"] || b.Config.LiveCandles.Enabled
+
+	b.Settings.EnableRetentionManager = flagSet["retentionmanager"] || b.Config.Retention.Enabled
+
+	b.Settings.EnableSymbolHistoryManager = flagSet["symbolhistorymanager"] || b.Config.SymbolHistory.Enabled
+
 	b.Settings.EnableCurrencyStateManager = (flagSet["currencystatemanager"] &&
 		b.Settings.EnableCurrencyStateManager) ||
 		b.Config.CurrencyStateManager.Enabled != nil &&
 			*b.Config.CurrencyStateManager.Enabled
 
+	b.Settings.EnableFeeManager = (flagSet["feemanager"] &&
+		b.Settings.EnableFeeManager) ||
+		b.Config.FeeManager.Enabled != nil &&
+			*b.Config.FeeManager.Enabled
+
 	b.Settings.EnableGCTScriptManager = b.Settings.EnableGCTScriptManager &&
 		(flagSet["gctscriptmanager"] || b.Config.GCTScript.Enabled)
 
@@ -248,6 +273,14 @@ func PrintSettings(s *Settings) {
 	gctlog.Debugf(gctlog.Global, "\t Enable portfolio manager: %v", s.EnablePortfolioManager)
 	gctlog.Debugf(gctlog.Global, "\t Enable data history manager: %v", s.EnableDataHistoryManager)
 	gctlog.Debugf(gctlog.Global, "\t Enable currency state manager: %v", s.EnableCurrencyStateManager)
+	gctlog.Debugf(gctlog.Global, "\t Enable fee manager: %v", s.EnableFeeManager)
+	gctlog.Debugf(gctlog.Global, "\t Enable consolidated orderbook manager: %v", s.EnableConsolidatedOrderbookManager)
+	gctlog.Debugf(gctlog.Global, "\t Enable orderbook history manager: %v", s.EnableOrderbookHistoryManager)
+	gctlog.Debugf(gctlog.Global, "\t Enable dead man's switch manager: %v", s.EnableDeadMansSwitchManager)
+	gctlog.Debugf(gctlog.Global, "\t Enable composite index manager: %v", s.EnableCompositeIndexManager)
+	gctlog.Debugf(gctlog.Global, "\t Enable live candle manager: %v", s.EnableLiveCandleManager)
+	gctlog.Debugf(gctlog.Global, "\t Enable retention manager: %v", s.EnableRetentionManager)
+	gctlog.Debugf(gctlog.Global, "\t Enable symbol history manager: %v", s.EnableSymbolHistoryManager)
 	gctlog.Debugf(gctlog.Global, "\t Portfolio manager sleep delay: %v\n", s.PortfolioManagerDelay)
 	gctlog.Debugf(gctlog.Global, "\t Enable gPRC: %v", s.EnableGRPC)
 	gctlog.Debugf(gctlog.Global, "\t Enable gRPC Proxy: %v", s.EnableGRPCProxy)
@@ -599,6 +632,179 @@ func (bot *Engine) Start() error {
 			}
 		}
 	}
+
+	if bot.Settings.EnableFeeManager {
+		bot.feeManager, err = SetupFeeManager(
+			bot.Config.FeeManager.Delay,
+			bot.ExchangeManager,
+			bot.Config.FeeManager.Verbose)
+		if err != nil {
+			gctlog.Errorf(gctlog.Global,
+				"%s unable to setup: %s",
+				FeeManagerName,
+				err)
+		} else {
+			err = bot.feeManager.Start()
+			if err != nil {
+				gctlog.Errorf(gctlog.Global,
+					"%s unable to start: %s",
+					FeeManagerName,
+					err)
+			}
+		}
+	}
+
+	if bot.Settings.EnableConsolidatedOrderbookManager {
+		bot.consolidatedOrderbooks, err = SetupConsolidatedOrderbookManager(
+			bot.ExchangeManager,
+			DefaultConsolidatedOrderbookDiscoveryDelay,
+			bot.Settings.Verbose)
+		if err != nil {
+			gctlog.Errorf(gctlog.Global,
+				"%s unable to setup: %s",
+				ConsolidatedOrderbookManagerName,
+				err)
+		} else {
+			err = bot.consolidatedOrderbooks.Start()
+			if err != nil {
+				gctlog.Errorf(gctlog.Global,
+					"%s unable to start: %s",
+					ConsolidatedOrderbookManagerName,
+					err)
+			}
+		}
+	}
+
+	if bot.Settings.EnableOrderbookHistoryManager {
+		bot.orderbookHistory, err = SetupOrderbookHistoryManager(
+			bot.ExchangeManager,
+			bot.DatabaseManager,
+			&bot.Config.OrderbookHistory,
+			bot.Settings.DataDir)
+		if err != nil {
+			gctlog.Errorf(gctlog.Global,
+				"%s unable to setup: %s",
+				OrderbookHistoryManagerName,
+				err)
+		} else {
+			err = bot.orderbookHistory.Start()
+			if err != nil {
+				gctlog.Errorf(gctlog.Global,
+					"%s unable to start: %s",
+					OrderbookHistoryManagerName,
+					err)
+			}
+		}
+	}
+
+	if bot.Settings.EnableDeadMansSwitchManager {
+		bot.deadMansSwitch, err = SetupDeadMansSwitchManager(
+			bot.ExchangeManager,
+			bot.OrderManager,
+			bot.connectionManager,
+			bot.Config.DeadMansSwitch.Timeout,
+			bot.Config.DeadMansSwitch.RefreshInterval,
+			bot.Config.DeadMansSwitch.Verbose)
+		if err != nil {
+			gctlog.Errorf(gctlog.Global,
+				"%s unable to setup: %s",
+				DeadMansSwitchManagerName,
+				err)
+		} else {
+			err = bot.deadMansSwitch.Start()
+			if err != nil {
+				gctlog.Errorf(gctlog.Global,
+					"%s unable to start: %s",
+					DeadMansSwitchManagerName,
+					err)
+			}
+		}
+	}
+
+	if bot.Settings.EnableCompositeIndexManager {
+		bot.compositeIndex, err = SetupCompositeIndexManager(
+			bot.ExchangeManager,
+			bot.DatabaseManager,
+			&bot.Config.CompositeIndex)
+		if err != nil {
+			gctlog.Errorf(gctlog.Global,
+				"%s unable to setup: %s",
+				CompositeIndexManagerName,
+				err)
+		} else {
+			bot.eventManager.setCompositeIndexManager(bot.compositeIndex)
+			err = bot.compositeIndex.Start()
+			if err != nil {
+				gctlog.Errorf(gctlog.Global,
+					"%s unable to start: %s",
+					CompositeIndexManagerName,
+					err)
+			}
+		}
+	}
+
+	if bot.Settings.EnableLiveCandleManager {
+		bot.liveCandles, err = SetupLiveCandleManager(
+			bot.ExchangeManager,
+			bot.DatabaseManager,
+			&bot.Config.LiveCandles)
+		if err != nil {
+			gctlog.Errorf(gctlog.Global,
+				"%s unable to setup: %s",
+				LiveCandleManagerName,
+				err)
+		} else {
+			err = bot.liveCandles.Start()
+			if err != nil {
+				gctlog.Errorf(gctlog.Global,
+					"%s unable to start: %s",
+					LiveCandleManagerName,
+					err)
+			}
+		}
+	}
+
+	if bot.Settings.EnableRetentionManager {
+		bot.retention, err = SetupRetentionManager(
+			bot.DatabaseManager,
+			&bot.Config.Retention,
+			bot.Settings.DataDir)
+		if err != nil {
+			gctlog.Errorf(gctlog.Global,
+				"%s unable to setup: %s",
+				RetentionManagerName,
+				err)
+		} else {
+			err = bot.retention.Start()
+			if err != nil {
+				gctlog.Errorf(gctlog.Global,
+					"%s unable to start: %s",
+					RetentionManagerName,
+					err)
+			}
+		}
+	}
+
+	if bot.Settings.EnableSymbolHistoryManager {
+		bot.symbolHistory, err = SetupSymbolHistoryManager(
+			bot.ExchangeManager,
+			bot.DatabaseManager,
+			&bot.Config.SymbolHistory)
+		if err != nil {
+			gctlog.Errorf(gctlog.Global,
+				"%s unable to setup: %s",
+				SymbolHistoryManagerName,
+				err)
+		} else {
+			err = bot.symbolHistory.Start()
+			if err != nil {
+				gctlog.Errorf(gctlog.Global,
+					"%s unable to start: %s",
+					SymbolHistoryManagerName,
+					err)
+			}
+		}
+	}
 	return nil
 }
 
@@ -618,6 +824,41 @@ func (bot *Engine) Stop() {
 			gctlog.Errorf(gctlog.Global, "GCTScript manager unable to stop. Error: %v", err)
 		}
 	}
+	if bot.symbolHistory.IsRunning() {
+		if err := bot.symbolHistory.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global,
+				"symbol history manager unable to stop. Error: %v",
+				err)
+		}
+	}
+	if bot.retention.IsRunning() {
+		if err := bot.retention.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global,
+				"retention manager unable to stop. Error: %v",
+				err)
+		}
+	}
+	if bot.liveCandles.IsRunning() {
+		if err := bot.liveCandles.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global,
+				"live candle manager unable to stop. Error: %v",
+				err)
+		}
+	}
+	if bot.compositeIndex.IsRunning() {
+		if err := bot.compositeIndex.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global,
+				"composite index manager unable to stop. Error: %v",
+				err)
+		}
+	}
+	if bot.deadMansSwitch.IsRunning() {
+		if err := bot.deadMansSwitch.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global,
+				"dead man's switch manager unable to stop. Error: %v",
+				err)
+		}
+	}
 	if bot.OrderManager.IsRunning() {
 		if err := bot.OrderManager.Stop(); err != nil {
 			gctlog.Errorf(gctlog.Global, "Order manager unable to stop. Error: %v", err)
@@ -685,6 +926,27 @@ func (bot *Engine) Stop() {
 				err)
 		}
 	}
+	if bot.feeManager.IsRunning() {
+		if err := bot.feeManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global,
+				"fee manager unable to stop. Error: %v",
+				err)
+		}
+	}
+	if bot.consolidatedOrderbooks.IsRunning() {
+		if err := bot.consolidatedOrderbooks.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global,
+				"consolidated orderbook manager unable to stop. Error: %v",
+				err)
+		}
+	}
+	if bot.orderbookHistory.IsRunning() {
+		if err := bot.orderbookHistory.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global,
+				"orderbook history manager unable to stop. Error: %v",
+				err)
+		}
+	}
 
 	if bot.Settings.EnableCoinmarketcapAnalysis ||
 		bot.Settings.EnableCurrencyConverter ||
@@ -15,30 +15,38 @@ type Settings struct {
 	CheckParamInteraction bool
 
 	// Core Settings
-	EnableDryRun                bool
-	EnableAllExchanges          bool
-	EnableAllPairs              bool
-	EnableCoinmarketcapAnalysis bool
-	EnablePortfolioManager      bool
-	EnableDataHistoryManager    bool
-	PortfolioManagerDelay       time.Duration
-	EnableGRPC                  bool
-	EnableGRPCProxy             bool
-	EnableWebsocketRPC          bool
-	EnableDeprecatedRPC         bool
-	EnableCommsRelayer          bool
-	EnableExchangeSyncManager   bool
-	EnableDepositAddressManager bool
-	EnableEventManager          bool
-	EnableOrderManager          bool
-	EnableConnectivityMonitor   bool
-	EnableDatabaseManager       bool
-	EnableGCTScriptManager      bool
-	EnableNTPClient             bool
-	EnableWebsocketRoutine      bool
-	EnableCurrencyStateManager  bool
-	EventManagerDelay           time.Duration
-	Verbose                     bool
+	EnableDryRun                       bool
+	EnableAllExchanges                 bool
+	EnableAllPairs                     bool
+	EnableCoinmarketcapAnalysis        bool
+	EnablePortfolioManager             bool
+	EnableDataHistoryManager           bool
+	PortfolioManagerDelay              time.Duration
+	EnableGRPC                         bool
+	EnableGRPCProxy                    bool
+	EnableWebsocketRPC                 bool
+	EnableDeprecatedRPC                bool
+	EnableCommsRelayer                 bool
+	EnableExchangeSyncManager          bool
+	EnableDepositAddressManager        bool
+	EnableEventManager                 bool
+	EnableOrderManager                 bool
+	EnableConnectivityMonitor          bool
+	EnableDatabaseManager              bool
+	EnableGCTScriptManager             bool
+	EnableNTPClient                    bool
+	EnableWebsocketRoutine             bool
+	EnableCurrencyStateManager         bool
+	EnableFeeManager                   bool
+	EnableConsolidatedOrderbookManager bool
+	EnableOrderbookHistoryManager      bool
+	EnableDeadMansSwitchManager        bool
+	EnableCompositeIndexManager        bool
+	EnableLiveCandleManager            bool
+	EnableRetentionManager             bool
+	EnableSymbolHistoryManager         bool
+	EventManagerDelay                  time.Duration
+	Verbose                            bool
 
 	// Exchange syncer settings
 	EnableTickerSyncing    bool
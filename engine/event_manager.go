@@ -232,8 +232,25 @@ func (m *eventManager) isValidEvent(exchange, item string, condition EventCondit
 	return nil
 }
 
+// setCompositeIndexManager allows events to be added for composite index
+// prices published under the index manager's synthetic exchange name
+func (m *eventManager) setCompositeIndexManager(c iCompositeIndexManager) {
+	if m == nil {
+		return
+	}
+	m.m.Lock()
+	m.compositeIndex = c
+	m.m.Unlock()
+}
+
 // isValidExchange validates the exchange
 func (m *eventManager) isValidExchange(exchangeName string) bool {
+	m.m.Lock()
+	c := m.compositeIndex
+	m.m.Unlock()
+	if c != nil && c.IsRunning() && strings.EqualFold(c.GetExchangeName(), exchangeName) {
+		return true
+	}
 	_, err := m.exchangeManager.GetExchangeByName(exchangeName)
 	return err == nil
 }
@@ -157,6 +157,39 @@ func TestEventManagerAdd(t *testing.T) {
 	}
 }
 
+func TestEventManagerAddCompositeIndex(t *testing.T) {
+	t.Parallel()
+	m, err := setupEventManager(&CommunicationManager{}, SetupExchangeManager(), 0, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	err = m.Start()
+	if !errors.Is(err, nil) {
+		t.Fatalf("error '%v', expected '%v'", err, nil)
+	}
+	cond := EventConditionParams{
+		Condition: ConditionGreaterThan,
+		Price:     1337,
+	}
+	_, err = m.Add("GCTIndex", ItemPrice, cond, currency.NewPair(currency.BTC, currency.USD), asset.Spot, ActionTest)
+	if !errors.Is(err, errExchangeDisabled) {
+		t.Errorf("error '%v', expected '%v'", err, errExchangeDisabled)
+	}
+
+	c := &CompositeIndexManager{exchangeName: "GCTIndex"}
+	m.setCompositeIndexManager(c)
+	_, err = m.Add("GCTIndex", ItemPrice, cond, currency.NewPair(currency.BTC, currency.USD), asset.Spot, ActionTest)
+	if !errors.Is(err, errExchangeDisabled) {
+		t.Errorf("error '%v', expected '%v'", err, errExchangeDisabled)
+	}
+
+	c.started = 1
+	_, err = m.Add("GCTIndex", ItemPrice, cond, currency.NewPair(currency.BTC, currency.USD), asset.Spot, ActionTest)
+	if !errors.Is(err, nil) {
+		t.Errorf("error '%v', expected '%v'", err, nil)
+	}
+}
+
 func TestEventManagerRemove(t *testing.T) {
 	t.Parallel()
 	em := SetupExchangeManager()
@@ -69,6 +69,7 @@ type eventManager struct {
 	verbose         bool
 	sleepDelay      time.Duration
 	exchangeManager iExchangeManager
+	compositeIndex  iCompositeIndexManager
 	shutdown        chan struct{}
 	m               sync.Mutex
 }
@@ -0,0 +1,224 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fee"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+const (
+	// FeeManagerName defines the manager name string
+	FeeManagerName = "fee_manager"
+	// DefaultFeeManagerDelay defines the default duration between fee
+	// schedule refreshes for each exchange
+	DefaultFeeManagerDelay = time.Hour
+)
+
+var errNoFeeSchedule = errors.New("no fee schedule could be determined")
+
+// FeeManager loads each exchange's fee schedule, either from the
+// authenticated account or from the exchange's default rates, and keeps it
+// refreshed so account tier changes are picked up
+type FeeManager struct {
+	started  int32
+	shutdown chan struct{}
+	wg       sync.WaitGroup
+	iExchangeManager
+	sleep   time.Duration
+	verbose bool
+}
+
+// SetupFeeManager applies configuration parameters before running
+func SetupFeeManager(interval time.Duration, em iExchangeManager, verbose bool) (*FeeManager, error) {
+	if em == nil {
+		return nil, errNilExchangeManager
+	}
+	if interval <= 0 {
+		log.Warnf(log.ExchangeSys,
+			"Fee manager interval is invalid, defaulting to: %s",
+			DefaultFeeManagerDelay)
+		interval = DefaultFeeManagerDelay
+	}
+	return &FeeManager{
+		shutdown:         make(chan struct{}),
+		iExchangeManager: em,
+		sleep:            interval,
+		verbose:          verbose,
+	}, nil
+}
+
+// Start runs the subsystem
+func (f *FeeManager) Start() error {
+	log.Debugln(log.ExchangeSys, "Fee manager starting...")
+	if f == nil {
+		return fmt.Errorf("%s %w", FeeManagerName, ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&f.started, 0, 1) {
+		return fmt.Errorf("%s %w", FeeManagerName, ErrSubSystemAlreadyStarted)
+	}
+	f.wg.Add(1)
+	go f.monitor()
+	log.Debugln(log.ExchangeSys, "Fee manager started.")
+	return nil
+}
+
+// Stop stops the subsystem
+func (f *FeeManager) Stop() error {
+	if f == nil {
+		return fmt.Errorf("%s %w", FeeManagerName, ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&f.started) == 0 {
+		return fmt.Errorf("%s %w", FeeManagerName, ErrSubSystemNotStarted)
+	}
+	log.Debugf(log.ExchangeSys, "Fee manager %s", MsgSubSystemShuttingDown)
+	close(f.shutdown)
+	f.wg.Wait()
+	f.shutdown = make(chan struct{})
+	log.Debugf(log.ExchangeSys, "Fee manager %s", MsgSubSystemShutdown)
+	atomic.StoreInt32(&f.started, 0)
+	return nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (f *FeeManager) IsRunning() bool {
+	if f == nil {
+		return false
+	}
+	return atomic.LoadInt32(&f.started) == 1
+}
+
+func (f *FeeManager) monitor() {
+	defer f.wg.Done()
+	timer := time.NewTimer(0) // Prime firing of channel for initial sync.
+	for {
+		select {
+		case <-f.shutdown:
+			return
+		case <-timer.C:
+			var wg sync.WaitGroup
+			exchs, err := f.GetExchanges()
+			if err != nil {
+				log.Errorf(log.Global,
+					"Fee manager failed to get exchanges error: %v",
+					err)
+			}
+			for x := range exchs {
+				wg.Add(1)
+				go func(exch exchange.IBotExchange) {
+					defer wg.Done()
+					if err := f.update(exch); err != nil {
+						log.Errorf(log.ExchangeSys, "Fee manager %s: %v",
+							exch.GetName(),
+							err)
+					}
+				}(exchs[x])
+			}
+			wg.Wait()
+			timer.Reset(f.sleep)
+		}
+	}
+}
+
+// update refreshes the fee schedule for an exchange from the authenticated
+// account. When that is unavailable the default rates are loaded once so
+// consumers always have a schedule to work from.
+func (f *FeeManager) update(exch exchange.IBotExchange) error {
+	b := exch.GetBase()
+	if b == nil {
+		return errExchangeBaseNotFound
+	}
+	if exch.GetAuthenticatedAPISupport(exchange.RestAuthentication) &&
+		b.AllowAuthenticatedRequest() {
+		err := exch.UpdateFeeSchedule(context.TODO())
+		if err == nil {
+			if f.verbose {
+				f.logSchedule(exch)
+			}
+			return nil
+		}
+		if !errors.Is(err, common.ErrNotYetImplemented) {
+			log.Errorf(log.ExchangeSys,
+				"Fee manager %s unable to update account fee schedule, using defaults: %v",
+				exch.GetName(),
+				err)
+		}
+	}
+	if b.Fees.IsLoaded() {
+		return nil
+	}
+	s, err := getDefaultFeeSchedule(exch)
+	if err != nil {
+		return err
+	}
+	err = b.Fees.Load(s)
+	if err != nil {
+		return err
+	}
+	if f.verbose {
+		f.logSchedule(exch)
+	}
+	return nil
+}
+
+func (f *FeeManager) logSchedule(exch exchange.IBotExchange) {
+	s, err := exch.GetFeeSchedule()
+	if err != nil {
+		return
+	}
+	log.Debugf(log.ExchangeSys,
+		"Fee manager %s schedule loaded. Authenticated: %v Tier: %q Thirty day volume: %v %v",
+		exch.GetName(),
+		s.Authenticated,
+		s.Tier,
+		s.ThirtyDayVolume,
+		s.VolumeCurrency)
+}
+
+// getDefaultFeeSchedule builds an unauthenticated fee schedule from the
+// exchange's offline trading fee rates for each enabled asset
+func getDefaultFeeSchedule(exch exchange.IBotExchange) (*fee.Schedule, error) {
+	s := &fee.Schedule{
+		Exchange:    exch.GetName(),
+		Commissions: make(map[asset.Item]fee.Commission),
+	}
+	assets := exch.GetAssetTypes(true)
+	for x := range assets {
+		pairs, err := exch.GetEnabledPairs(assets[x])
+		if err != nil || len(pairs) == 0 {
+			continue
+		}
+		maker, err := exch.GetFeeByType(context.TODO(), &exchange.FeeBuilder{
+			FeeType:       exchange.OfflineTradeFee,
+			Pair:          pairs[0],
+			IsMaker:       true,
+			PurchasePrice: 1,
+			Amount:        1,
+		})
+		if err != nil {
+			continue
+		}
+		taker, err := exch.GetFeeByType(context.TODO(), &exchange.FeeBuilder{
+			FeeType:       exchange.OfflineTradeFee,
+			Pair:          pairs[0],
+			PurchasePrice: 1,
+			Amount:        1,
+		})
+		if err != nil {
+			continue
+		}
+		s.Commissions[assets[x]] = fee.Commission{Maker: maker, Taker: taker}
+	}
+	if len(s.Commissions) == 0 {
+		return nil, errNoFeeSchedule
+	}
+	return s, nil
+}
@@ -0,0 +1,196 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fee"
+)
+
+var errFeeScheduleUpdate = errors.New("fee schedule update error")
+
+type feeExchange struct {
+	exchange.IBotExchange
+	base          *exchange.Base
+	authenticated bool
+	updateErr     error
+	feeErr        error
+}
+
+func newFeeExchange() *feeExchange {
+	return &feeExchange{base: &exchange.Base{Name: "feeExchange", Fees: fee.NewDefinitions()}}
+}
+
+func (f *feeExchange) GetName() string {
+	return f.base.Name
+}
+
+func (f *feeExchange) GetBase() *exchange.Base {
+	return f.base
+}
+
+func (f *feeExchange) GetAuthenticatedAPISupport(_ uint8) bool {
+	return f.authenticated
+}
+
+func (f *feeExchange) UpdateFeeSchedule(_ context.Context) error {
+	if f.updateErr != nil {
+		return f.updateErr
+	}
+	return f.base.Fees.Load(&fee.Schedule{
+		Exchange:      f.base.Name,
+		Tier:          "VIP1",
+		Commissions:   map[asset.Item]fee.Commission{asset.Spot: {Maker: 0.0009, Taker: 0.001}},
+		Authenticated: true,
+	})
+}
+
+func (f *feeExchange) GetFeeSchedule() (*fee.Schedule, error) {
+	return f.base.Fees.GetSchedule()
+}
+
+func (f *feeExchange) GetAssetTypes(_ bool) asset.Items {
+	return asset.Items{asset.Spot}
+}
+
+func (f *feeExchange) GetEnabledPairs(_ asset.Item) (currency.Pairs, error) {
+	return currency.Pairs{currency.NewPair(currency.BTC, currency.USDT)}, nil
+}
+
+func (f *feeExchange) GetFeeByType(_ context.Context, b *exchange.FeeBuilder) (float64, error) {
+	if f.feeErr != nil {
+		return 0, f.feeErr
+	}
+	if b.IsMaker {
+		return 0.001 * b.PurchasePrice * b.Amount, nil
+	}
+	return 0.002 * b.PurchasePrice * b.Amount, nil
+}
+
+func TestSetupFeeManager(t *testing.T) {
+	t.Parallel()
+	_, err := SetupFeeManager(0, nil, false)
+	if !errors.Is(err, errNilExchangeManager) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errNilExchangeManager)
+	}
+
+	m, err := SetupFeeManager(0, &ExchangeManager{}, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if m.sleep != DefaultFeeManagerDelay {
+		t.Fatal("unexpected value")
+	}
+}
+
+func TestFeeManagerStartStop(t *testing.T) {
+	t.Parallel()
+	var m *FeeManager
+	err := m.Start()
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrNilSubsystem)
+	}
+	err = m.Stop()
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrNilSubsystem)
+	}
+	if m.IsRunning() {
+		t.Fatal("nil manager should not be running")
+	}
+
+	m, err = SetupFeeManager(0, SetupExchangeManager(), false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	err = m.Stop()
+	if !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrSubSystemNotStarted)
+	}
+	err = m.Start()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	err = m.Start()
+	if !errors.Is(err, ErrSubSystemAlreadyStarted) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrSubSystemAlreadyStarted)
+	}
+	if !m.IsRunning() {
+		t.Fatal("manager should be running")
+	}
+	err = m.Stop()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+}
+
+func TestFeeManagerUpdate(t *testing.T) {
+	t.Parallel()
+	m := &FeeManager{}
+
+	// Defaults are loaded when the exchange is not authenticated
+	exch := newFeeExchange()
+	err := m.update(exch)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	s, err := exch.GetFeeSchedule()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if s.Authenticated {
+		t.Error("default schedule should not be authenticated")
+	}
+	if c := s.Commissions[asset.Spot]; c.Maker != 0.001 || c.Taker != 0.002 {
+		t.Errorf("unexpected default commission %+v", c)
+	}
+
+	// Authenticated schedule replaces the defaults
+	exch.authenticated = true
+	exch.base.SkipAuthCheck = true
+	err = m.update(exch)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	s, err = exch.GetFeeSchedule()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if !s.Authenticated || s.Tier != "VIP1" {
+		t.Errorf("unexpected schedule %+v", s)
+	}
+
+	// A failed refresh keeps the previously loaded schedule
+	exch.updateErr = errFeeScheduleUpdate
+	err = m.update(exch)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	s, err = exch.GetFeeSchedule()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if !s.Authenticated {
+		t.Error("previous schedule should be retained")
+	}
+
+	// Not implemented without default rates cannot determine a schedule
+	exch = newFeeExchange()
+	exch.authenticated = true
+	exch.base.SkipAuthCheck = true
+	exch.updateErr = common.ErrNotYetImplemented
+	exch.feeErr = common.ErrNotYetImplemented
+	err = m.update(exch)
+	if !errors.Is(err, errNoFeeSchedule) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errNoFeeSchedule)
+	}
+
+	err = m.update(&feeExchange{})
+	if !errors.Is(err, errExchangeBaseNotFound) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errExchangeBaseNotFound)
+	}
+}
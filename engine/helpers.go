@@ -27,6 +27,7 @@ import (
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/deposit"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stats"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
@@ -45,21 +46,29 @@ var (
 // GetSubsystemsStatus returns the status of various subsystems
 func (bot *Engine) GetSubsystemsStatus() map[string]bool {
 	return map[string]bool{
-		CommunicationsManagerName:     bot.CommunicationsManager.IsRunning(),
-		ConnectionManagerName:         bot.connectionManager.IsRunning(),
-		OrderManagerName:              bot.OrderManager.IsRunning(),
-		PortfolioManagerName:          bot.portfolioManager.IsRunning(),
-		NTPManagerName:                bot.ntpManager.IsRunning(),
-		DatabaseConnectionManagerName: bot.DatabaseManager.IsRunning(),
-		SyncManagerName:               bot.Settings.EnableExchangeSyncManager,
-		grpcName:                      bot.Settings.EnableGRPC,
-		grpcProxyName:                 bot.Settings.EnableGRPCProxy,
-		vm.Name:                       bot.gctScriptManager.IsRunning(),
-		DeprecatedName:                bot.Settings.EnableDeprecatedRPC,
-		WebsocketName:                 bot.Settings.EnableWebsocketRPC,
-		dispatch.Name:                 dispatch.IsRunning(),
-		dataHistoryManagerName:        bot.dataHistoryManager.IsRunning(),
-		CurrencyStateManagementName:   bot.currencyStateManager.IsRunning(),
+		CommunicationsManagerName:        bot.CommunicationsManager.IsRunning(),
+		ConnectionManagerName:            bot.connectionManager.IsRunning(),
+		OrderManagerName:                 bot.OrderManager.IsRunning(),
+		PortfolioManagerName:             bot.portfolioManager.IsRunning(),
+		NTPManagerName:                   bot.ntpManager.IsRunning(),
+		DatabaseConnectionManagerName:    bot.DatabaseManager.IsRunning(),
+		SyncManagerName:                  bot.Settings.EnableExchangeSyncManager,
+		grpcName:                         bot.Settings.EnableGRPC,
+		grpcProxyName:                    bot.Settings.EnableGRPCProxy,
+		vm.Name:                          bot.gctScriptManager.IsRunning(),
+		DeprecatedName:                   bot.Settings.EnableDeprecatedRPC,
+		WebsocketName:                    bot.Settings.EnableWebsocketRPC,
+		dispatch.Name:                    dispatch.IsRunning(),
+		dataHistoryManagerName:           bot.dataHistoryManager.IsRunning(),
+		CurrencyStateManagementName:      bot.currencyStateManager.IsRunning(),
+		FeeManagerName:                   bot.feeManager.IsRunning(),
+		ConsolidatedOrderbookManagerName: bot.consolidatedOrderbooks.IsRunning(),
+		OrderbookHistoryManagerName:      bot.orderbookHistory.IsRunning(),
+		DeadMansSwitchManagerName:        bot.deadMansSwitch.IsRunning(),
+		CompositeIndexManagerName:        bot.compositeIndex.IsRunning(),
+		LiveCandleManagerName:            bot.liveCandles.IsRunning(),
+		RetentionManagerName:             bot.retention.IsRunning(),
+		SymbolHistoryManagerName:         bot.symbolHistory.IsRunning(),
 	}
 }
 
@@ -278,6 +287,123 @@ func (bot *Engine) SetSubsystem(subSystemName string, enable bool) error {
 			return bot.currencyStateManager.Start()
 		}
 		return bot.currencyStateManager.Stop()
+	case FeeManagerName:
+		if enable {
+			if bot.feeManager == nil {
+				bot.feeManager, err = SetupFeeManager(
+					bot.Config.FeeManager.Delay,
+					bot.ExchangeManager,
+					bot.Config.FeeManager.Verbose)
+				if err != nil {
+					return err
+				}
+			}
+			return bot.feeManager.Start()
+		}
+		return bot.feeManager.Stop()
+	case ConsolidatedOrderbookManagerName:
+		if enable {
+			if bot.consolidatedOrderbooks == nil {
+				bot.consolidatedOrderbooks, err = SetupConsolidatedOrderbookManager(
+					bot.ExchangeManager,
+					DefaultConsolidatedOrderbookDiscoveryDelay,
+					bot.Settings.Verbose)
+				if err != nil {
+					return err
+				}
+			}
+			return bot.consolidatedOrderbooks.Start()
+		}
+		return bot.consolidatedOrderbooks.Stop()
+	case OrderbookHistoryManagerName:
+		if enable {
+			if bot.orderbookHistory == nil {
+				bot.orderbookHistory, err = SetupOrderbookHistoryManager(
+					bot.ExchangeManager,
+					bot.DatabaseManager,
+					&bot.Config.OrderbookHistory,
+					bot.Settings.DataDir)
+				if err != nil {
+					return err
+				}
+			}
+			return bot.orderbookHistory.Start()
+		}
+		return bot.orderbookHistory.Stop()
+	case DeadMansSwitchManagerName:
+		if enable {
+			if bot.deadMansSwitch == nil {
+				bot.deadMansSwitch, err = SetupDeadMansSwitchManager(
+					bot.ExchangeManager,
+					bot.OrderManager,
+					bot.connectionManager,
+					bot.Config.DeadMansSwitch.Timeout,
+					bot.Config.DeadMansSwitch.RefreshInterval,
+					bot.Config.DeadMansSwitch.Verbose)
+				if err != nil {
+					return err
+				}
+			}
+			return bot.deadMansSwitch.Start()
+		}
+		return bot.deadMansSwitch.Stop()
+	case CompositeIndexManagerName:
+		if enable {
+			if bot.compositeIndex == nil {
+				bot.compositeIndex, err = SetupCompositeIndexManager(
+					bot.ExchangeManager,
+					bot.DatabaseManager,
+					&bot.Config.CompositeIndex)
+				if err != nil {
+					return err
+				}
+				bot.eventManager.setCompositeIndexManager(bot.compositeIndex)
+			}
+			return bot.compositeIndex.Start()
+		}
+		return bot.compositeIndex.Stop()
+	case LiveCandleManagerName:
+		if enable {
+			if bot.liveCandles == nil {
+				bot.liveCandles, err = SetupLiveCandleManager(
+					bot.ExchangeManager,
+					bot.DatabaseManager,
+					&bot.Config.LiveCandles)
+				if err != nil {
+					return err
+				}
+			}
+			return bot.liveCandles.Start()
+		}
+		return bot.liveCandles.Stop()
+	case RetentionManagerName:
+		if enable {
+			if bot.retention == nil {
+				bot.retention, err = SetupRetentionManager(
+					bot.DatabaseManager,
+					&bot.Config.Retention,
+					bot.Settings.DataDir)
+				if err != nil {
+					return err
+				}
+			}
+			return bot.retention.Start()
+		}
+		return bot.retention.Stop()
+	case SymbolHistoryManagerName:
+		if enable {
+			if bot.symbolHistory == nil {
+				bot.symbolHistory, err = SetupSymbolHistoryManager(
+					bot.ExchangeManager,
+					bot.DatabaseManager,
+					&bot.Config.SymbolHistory)
+				if err != nil {
+					return err
+				}
+			}
+			return bot.symbolHistory.Start()
+		}
+		return bot.symbolHistory.Stop()
 	}
 	return fmt.Errorf("%s: %w", subSystemName, errSubsystemNotFound)
 }
@@ -565,6 +691,52 @@ func (bot *Engine) GetSpecificOrderbook(ctx context.Context, p currency.Pair, ex
 	return exch.FetchOrderbook(ctx, p, assetType)
 }
 
+// GetConsolidatedOrderbook returns the merged orderbook for a currency pair
+// across enabled exchanges from the consolidated orderbook manager
+func (bot *Engine) GetConsolidatedOrderbook(p currency.Pair, assetType asset.Item, convertQuote bool, exchanges []string) (*orderbook.Consolidated, error) {
+	return bot.consolidatedOrderbooks.GetConsolidatedOrderbook(p, assetType, convertQuote, exchanges)
+}
+
+// GetOrderbookHistory returns the captured orderbook snapshots for an exchange
+// orderbook between the start and end times from the orderbook history
+// manager
+func (bot *Engine) GetOrderbookHistory(exchangeName string, p currency.Pair, assetType asset.Item, start, end time.Time, fromDatabase bool) ([]orderbook.Base, error) {
+	return bot.orderbookHistory.GetOrderbookHistory(exchangeName, p, assetType, start, end, fromDatabase)
+}
+
+// GetOrderbookAnalytics returns analytics for the in memory orderbook of an
+// exchange, see orderbook.Base Analyse for parameter usage
+func (bot *Engine) GetOrderbookAnalytics(exchangeName string, p currency.Pair, assetType asset.Item, levels int, bps float64, impactAmounts []float64) (*orderbook.Analytics, error) {
+	depth, err := bot.getEnabledDepth(exchangeName, p, assetType)
+	if err != nil {
+		return nil, err
+	}
+	return depth.Analyse(levels, bps, impactAmounts)
+}
+
+// GetOrderbookSpreadStatistics samples the spread of the in memory orderbook
+// of an exchange for the duration or until the context is cancelled
+func (bot *Engine) GetOrderbookSpreadStatistics(ctx context.Context, exchangeName string, p currency.Pair, assetType asset.Item, duration time.Duration) (*orderbook.SpreadStatistics, error) {
+	depth, err := bot.getEnabledDepth(exchangeName, p, assetType)
+	if err != nil {
+		return nil, err
+	}
+	return depth.SampleSpread(duration, ctx.Done())
+}
+
+// getEnabledDepth returns the orderbook depth for an enabled exchange pair
+func (bot *Engine) getEnabledDepth(exchangeName string, p currency.Pair, assetType asset.Item) (*orderbook.Depth, error) {
+	exch, err := bot.GetExchangeByName(exchangeName)
+	if err != nil {
+		return nil, err
+	}
+	err = checkParams(exchangeName, exch, assetType, p)
+	if err != nil {
+		return nil, err
+	}
+	return orderbook.GetDepth(exch.GetName(), p, assetType)
+}
+
 // GetSpecificTicker returns a specific ticker given the currency,
 // exchangeName and assetType
 func (bot *Engine) GetSpecificTicker(ctx context.Context, p currency.Pair, exchangeName string, assetType asset.Item) (*ticker.Price, error) {
@@ -668,7 +840,7 @@ func (bot *Engine) GetCryptocurrenciesByExchange(exchangeName string, enabledExc
 }
 
 // GetCryptocurrencyDepositAddressesByExchange returns the cryptocurrency deposit addresses for a particular exchange
-func (bot *Engine) GetCryptocurrencyDepositAddressesByExchange(exchName string) (map[string]string, error) {
+func (bot *Engine) GetCryptocurrencyDepositAddressesByExchange(exchName string) (map[string][]deposit.Address, error) {
 	if bot.DepositAddressManager != nil {
 		return bot.DepositAddressManager.GetDepositAddressesByExchange(exchName)
 	}
@@ -682,22 +854,26 @@ func (bot *Engine) GetCryptocurrencyDepositAddressesByExchange(exchName string)
 }
 
 // GetExchangeCryptocurrencyDepositAddress returns the cryptocurrency deposit address for a particular
-// exchange
-func (bot *Engine) GetExchangeCryptocurrencyDepositAddress(ctx context.Context, exchName, accountID string, item currency.Code) (string, error) {
+// exchange and chain, an empty chain returns the address for the exchange's default chain
+func (bot *Engine) GetExchangeCryptocurrencyDepositAddress(ctx context.Context, exchName, accountID, chain string, item currency.Code) (*deposit.Address, error) {
 	if bot.DepositAddressManager != nil {
-		return bot.DepositAddressManager.GetDepositAddressByExchangeAndCurrency(exchName, item)
+		addr, err := bot.DepositAddressManager.GetDepositAddressByExchangeAndCurrency(exchName, chain, item)
+		if err != nil {
+			return nil, err
+		}
+		return &addr, nil
 	}
 
 	exch, err := bot.GetExchangeByName(exchName)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
-	return exch.GetDepositAddress(ctx, item, accountID)
+	return exch.GetDepositAddress(ctx, item, accountID, chain)
 }
 
 // GetExchangeCryptocurrencyDepositAddresses obtains an exchanges deposit cryptocurrency list
-func (bot *Engine) GetExchangeCryptocurrencyDepositAddresses() map[string]map[string]string {
-	result := make(map[string]map[string]string)
+func (bot *Engine) GetExchangeCryptocurrencyDepositAddresses() map[string]map[string][]deposit.Address {
+	result := make(map[string]map[string][]deposit.Address)
 	exchanges := bot.GetExchanges()
 	for x := range exchanges {
 		exchName := exchanges[x].GetName()
@@ -714,23 +890,62 @@ func (bot *Engine) GetExchangeCryptocurrencyDepositAddresses() map[string]map[st
 			continue
 		}
 
-		cryptoAddr := make(map[string]string)
+		cryptoAddr := make(map[string][]deposit.Address)
 		for y := range cryptoCurrencies {
 			cryptocurrency := cryptoCurrencies[y]
-			depositAddr, err := exchanges[x].GetDepositAddress(context.TODO(),
-				currency.NewCode(cryptocurrency),
-				"")
+			addrs, err := getDepositAddresses(context.TODO(), exchanges[x], currency.NewCode(cryptocurrency))
 			if err != nil {
 				log.Errorf(log.Global, "%s failed to get cryptocurrency deposit addresses. Err: %s\n", exchName, err)
 				continue
 			}
-			cryptoAddr[cryptocurrency] = depositAddr
+			cryptoAddr[cryptocurrency] = addrs
 		}
 		result[exchName] = cryptoAddr
 	}
 	return result
 }
 
+// getDepositAddresses returns a deposit address for every chain the currency
+// can be deposited on with the default chain address first. Exchanges which
+// do not support chain selection return their single address.
+func getDepositAddresses(ctx context.Context, exch exchange.IBotExchange, c currency.Code) ([]deposit.Address, error) {
+	networks, err := exch.GetAvailableTransferChains(ctx, c)
+	if err != nil || len(networks) == 0 {
+		var addr *deposit.Address
+		addr, err = exch.GetDepositAddress(ctx, c, "", "")
+		if err != nil {
+			return nil, err
+		}
+		return []deposit.Address{*addr}, nil
+	}
+
+	var addrs []deposit.Address
+	for x := range networks {
+		if !networks[x].DepositEnabled {
+			continue
+		}
+		addr, err := exch.GetDepositAddress(ctx, c, "", networks[x].Chain)
+		if err != nil {
+			log.Errorf(log.Global, "%s failed to get %s deposit address for chain %s. Err: %s\n",
+				exch.GetName(),
+				c,
+				networks[x].Chain,
+				err)
+			continue
+		}
+		addr.Chain = networks[x].Chain
+		if networks[x].IsDefault {
+			addrs = append([]deposit.Address{*addr}, addrs...)
+			continue
+		}
+		addrs = append(addrs, *addr)
+	}
+	if len(addrs) == 0 {
+		return nil, fmt.Errorf("%s %w", c, deposit.ErrNoNetworks)
+	}
+	return addrs, nil
+}
+
 // GetExchangeNames returns a list of enabled or disabled exchanges
 func (bot *Engine) GetExchangeNames(enabledOnly bool) []string {
 	exchanges := bot.GetExchanges()
@@ -96,8 +96,8 @@ func CreateTestBot(t *testing.T) *Engine {
 
 func TestGetSubsystemsStatus(t *testing.T) {
 	m := (&Engine{}).GetSubsystemsStatus()
-	if len(m) != 15 {
-		t.Fatalf("subsystem count is wrong expecting: %d but received: %d", 14, len(m))
+	if len(m) != 23 {
+		t.Fatalf("subsystem count is wrong expecting: %d but received: %d", 17, len(m))
 	}
 }
 
@@ -117,6 +117,7 @@ func TestGetRPCEndpoints(t *testing.T) {
 }
 
 func TestSetSubsystem(t *testing.T) {
+	tempDir := t.TempDir()
 	testCases := []struct {
 		Subsystem    string
 		Engine       *Engine
@@ -209,6 +210,60 @@ func TestSetSubsystem(t *testing.T) {
 			EnableError:  nil,
 			DisableError: nil,
 		},
+		{
+			Subsystem:    FeeManagerName,
+			Engine:       &Engine{Config: &config.Config{}, ExchangeManager: SetupExchangeManager()},
+			EnableError:  nil,
+			DisableError: nil,
+		},
+		{
+			Subsystem:    ConsolidatedOrderbookManagerName,
+			Engine:       &Engine{Config: &config.Config{}, ExchangeManager: SetupExchangeManager()},
+			EnableError:  nil,
+			DisableError: nil,
+		},
+		{
+			Subsystem: OrderbookHistoryManagerName,
+			Engine: &Engine{
+				Config: &config.Config{
+					OrderbookHistory: config.OrderbookHistoryManager{FileStorage: true},
+				},
+				ExchangeManager: SetupExchangeManager(),
+				Settings:        Settings{DataDir: tempDir},
+			},
+			EnableError:  nil,
+			DisableError: nil,
+		},
+		{
+			Subsystem:    DeadMansSwitchManagerName,
+			Engine:       &Engine{Config: &config.Config{}, ExchangeManager: SetupExchangeManager()},
+			EnableError:  ErrSubSystemNotStarted,
+			DisableError: ErrNilSubsystem,
+		},
+		{
+			Subsystem:    CompositeIndexManagerName,
+			Engine:       &Engine{Config: &config.Config{}, ExchangeManager: SetupExchangeManager()},
+			EnableError:  errNoCompositeIndices,
+			DisableError: ErrNilSubsystem,
+		},
+		{
+			Subsystem:    LiveCandleManagerName,
+			Engine:       &Engine{Config: &config.Config{}, ExchangeManager: SetupExchangeManager()},
+			EnableError:  errNoLiveCandleIntervals,
+			DisableError: ErrNilSubsystem,
+		},
+		{
+			Subsystem:    RetentionManagerName,
+			Engine:       &Engine{Config: &config.Config{}},
+			EnableError:  errNoRetentionPolicies,
+			DisableError: ErrNilSubsystem,
+		},
+		{
+			Subsystem:    SymbolHistoryManagerName,
+			Engine:       &Engine{Config: &config.Config{}, ExchangeManager: SetupExchangeManager()},
+			EnableError:  nil,
+			DisableError: nil,
+		},
 	}
 
 	for _, tt := range testCases {
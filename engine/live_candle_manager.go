@@ -0,0 +1,403 @@
+package engine
+
+import (
+	"fmt"
+	"math"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetupLiveCandleManager applies configuration parameters before running
+func SetupLiveCandleManager(em iExchangeManager, dcm iDatabaseConnectionManager, cfg *config.LiveCandleManager) (*LiveCandleManager, error) {
+	if em == nil {
+		return nil, errNilExchangeManager
+	}
+	if cfg == nil {
+		return nil, errNilLiveCandleConfig
+	}
+	if len(cfg.Intervals) == 0 {
+		return nil, errNoLiveCandleIntervals
+	}
+	if cfg.SaveToDatabase && dcm == nil {
+		return nil, errNilDatabaseConnectionManager
+	}
+	l := &LiveCandleManager{
+		shutdown:         make(chan struct{}),
+		iExchangeManager: em,
+		verbose:          cfg.Verbose,
+		exchanges:        cfg.Exchanges,
+		finaliseDelay:    cfg.FinaliseDelay,
+		mux:              dispatch.GetNewMux(),
+		builders:         make(map[liveCandleKey]*liveCandleBuilder),
+		streams:          make(map[string]bool),
+	}
+	for i := range cfg.Intervals {
+		l.intervals = append(l.intervals, kline.Interval(cfg.Intervals[i]))
+	}
+	if cfg.SaveToDatabase {
+		l.candleSaver = func(item *kline.Item) error {
+			db := dcm.GetInstance()
+			if db == nil || !db.IsConnected() {
+				return fmt.Errorf("%s %w", LiveCandleManagerName, database.ErrDatabaseSupportDisabled)
+			}
+			// Candles are replaced so that a candle previously built from
+			// stored trades or fetched by a data history job is updated
+			_, err := kline.StoreInDatabase(item, true)
+			return err
+		}
+	}
+	return l, nil
+}
+
+// Start runs the subsystem
+func (l *LiveCandleManager) Start() error {
+	if l == nil {
+		return fmt.Errorf("%s %w", LiveCandleManagerName, ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&l.started, 0, 1) {
+		return fmt.Errorf("%s %w", LiveCandleManagerName, ErrSubSystemAlreadyStarted)
+	}
+	log.Debugf(log.Trade, "Live candle manager %s", MsgSubSystemStarting)
+	l.wg.Add(1)
+	go l.run()
+	log.Debugf(log.Trade, "Live candle manager %s", MsgSubSystemStarted)
+	return nil
+}
+
+// Stop stops the subsystem, open candles are discarded
+func (l *LiveCandleManager) Stop() error {
+	if l == nil {
+		return fmt.Errorf("%s %w", LiveCandleManagerName, ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&l.started) == 0 {
+		return fmt.Errorf("%s %w", LiveCandleManagerName, ErrSubSystemNotStarted)
+	}
+	log.Debugf(log.Trade, "Live candle manager %s", MsgSubSystemShuttingDown)
+	close(l.shutdown)
+	l.wg.Wait()
+	l.m.Lock()
+	for _, b := range l.builders {
+		b.current = nil
+	}
+	l.m.Unlock()
+	l.shutdown = make(chan struct{})
+	atomic.StoreInt32(&l.started, 0)
+	log.Debugf(log.Trade, "Live candle manager %s", MsgSubSystemShutdown)
+	return nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (l *LiveCandleManager) IsRunning() bool {
+	if l == nil {
+		return false
+	}
+	return atomic.LoadInt32(&l.started) == 1
+}
+
+// Subscribe returns a dispatch pipe which receives each finalised candle for
+// an exchange currency pair, asset and interval
+func (l *LiveCandleManager) Subscribe(exch string, p currency.Pair, a asset.Item, interval kline.Interval) (dispatch.Pipe, error) {
+	if !l.IsRunning() {
+		return dispatch.Pipe{}, fmt.Errorf("%s %w", LiveCandleManagerName, ErrSubSystemNotStarted)
+	}
+	if exch == "" {
+		return dispatch.Pipe{}, errExchangeNameUnset
+	}
+	if p.IsEmpty() {
+		return dispatch.Pipe{}, errCurrencyPairUnset
+	}
+	if !a.IsValid() {
+		return dispatch.Pipe{}, fmt.Errorf("%s %w", a, asset.ErrNotSupported)
+	}
+	if !l.buildsInterval(interval) {
+		return dispatch.Pipe{}, fmt.Errorf("%w: %s", errLiveCandleInterval, interval)
+	}
+	l.m.Lock()
+	defer l.m.Unlock()
+	b := l.builder(exch, p, a, interval)
+	if b.id == uuid.Nil {
+		id, err := l.mux.GetID()
+		if err != nil {
+			return dispatch.Pipe{}, err
+		}
+		b.id = id
+	}
+	return l.mux.Subscribe(b.id)
+}
+
+// GetOpenCandles returns the candles currently being built, filtered by
+// exchange if supplied
+func (l *LiveCandleManager) GetOpenCandles(exch string) ([]LiveCandle, error) {
+	if !l.IsRunning() {
+		return nil, fmt.Errorf("%s %w", LiveCandleManagerName, ErrSubSystemNotStarted)
+	}
+	var candles []LiveCandle
+	l.m.Lock()
+	for k, b := range l.builders {
+		if b.current == nil || (exch != "" && !strings.EqualFold(exch, b.exchange)) {
+			continue
+		}
+		candles = append(candles, LiveCandle{
+			Exchange: b.exchange,
+			Pair:     b.pair,
+			Asset:    k.Asset,
+			Interval: k.Interval,
+			Candle:   *b.current,
+		})
+	}
+	l.m.Unlock()
+	return candles, nil
+}
+
+// run subscribes to exchange trade streams and finalises candles once their
+// interval has closed
+func (l *LiveCandleManager) run() {
+	defer l.wg.Done()
+	timer := time.NewTicker(liveCandleCheckInterval)
+	defer timer.Stop()
+	l.subscribe()
+	for {
+		select {
+		case <-l.shutdown:
+			return
+		case <-timer.C:
+			l.subscribe()
+			l.finalise(time.Now())
+		}
+	}
+}
+
+// subscribe attaches to the trade stream of each enabled exchange that is not
+// yet being watched
+func (l *LiveCandleManager) subscribe() {
+	exchs, err := l.GetExchanges()
+	if err != nil {
+		log.Errorf(log.Trade, "Live candle manager cannot get exchanges: %v", err)
+		return
+	}
+	for x := range exchs {
+		if !exchs[x].IsEnabled() {
+			continue
+		}
+		name := strings.ToLower(exchs[x].GetName())
+		if len(l.exchanges) != 0 && !common.StringDataCompareInsensitive(l.exchanges, name) {
+			continue
+		}
+		l.m.Lock()
+		watched := l.streams[name]
+		l.m.Unlock()
+		if watched {
+			continue
+		}
+		pipe, err := trade.SubscribeToExchangeTrades(name)
+		if err != nil {
+			log.Errorf(log.Trade, "Live candle manager cannot subscribe to %s trades: %v", name, err)
+			continue
+		}
+		l.m.Lock()
+		l.streams[name] = true
+		l.m.Unlock()
+		if l.verbose {
+			log.Debugf(log.Trade, "Live candle manager building candles from %s trades", name)
+		}
+		l.wg.Add(1)
+		go l.watch(name, pipe)
+	}
+}
+
+// watch applies trades from an exchange stream until shutdown or until the
+// stream is closed, in which case the exchange will be resubscribed
+func (l *LiveCandleManager) watch(exch string, pipe dispatch.Pipe) {
+	defer l.wg.Done()
+	defer func() {
+		if err := pipe.Release(); err != nil {
+			log.Error(log.DispatchMgr, err)
+		}
+		l.m.Lock()
+		delete(l.streams, exch)
+		l.m.Unlock()
+	}()
+	for {
+		select {
+		case <-l.shutdown:
+			return
+		case data, ok := <-pipe.C:
+			if !ok {
+				return
+			}
+			d, ok := data.(*interface{})
+			if !ok {
+				continue
+			}
+			t, ok := (*d).(trade.Data)
+			if !ok {
+				continue
+			}
+			l.publish(l.update(&t))
+		}
+	}
+}
+
+// update applies a trade to the open candle of each interval. Candles of an
+// earlier interval are returned as finalised when a trade for a later
+// interval arrives before the timer has finalised them
+func (l *LiveCandleManager) update(t *trade.Data) []LiveCandle {
+	if t.Price <= 0 || t.Timestamp.IsZero() {
+		return nil
+	}
+	var finalised []LiveCandle
+	l.m.Lock()
+	defer l.m.Unlock()
+	for i := range l.intervals {
+		b := l.builder(t.Exchange, t.CurrencyPair, t.AssetType, l.intervals[i])
+		start := t.Timestamp.Truncate(l.intervals[i].Duration())
+		if b.current != nil && start.After(b.current.Time) {
+			finalised = append(finalised, b.finalise(t.AssetType, l.intervals[i]))
+		}
+		if (!b.lastFinalised.IsZero() && !start.After(b.lastFinalised)) ||
+			(b.current != nil && start.Before(b.current.Time)) {
+			if l.verbose {
+				log.Debugf(log.Trade,
+					"Live candle manager ignoring late %s %s %s trade at %v for %s candle",
+					t.Exchange,
+					t.CurrencyPair,
+					t.AssetType,
+					t.Timestamp,
+					l.intervals[i])
+			}
+			continue
+		}
+		if b.current == nil {
+			// Exchange trade naming is preferred over subscription naming
+			b.exchange, b.pair = t.Exchange, t.CurrencyPair
+			b.current = &kline.Candle{
+				Time:   start,
+				Open:   t.Price,
+				High:   t.Price,
+				Low:    t.Price,
+				Close:  t.Price,
+				Volume: t.Amount,
+			}
+			continue
+		}
+		b.current.High = math.Max(b.current.High, t.Price)
+		b.current.Low = math.Min(b.current.Low, t.Price)
+		b.current.Close = t.Price
+		b.current.Volume += t.Amount
+	}
+	return finalised
+}
+
+// finalise publishes and saves every open candle whose interval closed at
+// least the finalise delay before the supplied time
+func (l *LiveCandleManager) finalise(now time.Time) {
+	var finalised []LiveCandle
+	l.m.Lock()
+	for k, b := range l.builders {
+		if b.current == nil ||
+			now.Before(b.current.Time.Add(k.Interval.Duration()+l.finaliseDelay)) {
+			continue
+		}
+		finalised = append(finalised, b.finalise(k.Asset, k.Interval))
+	}
+	l.m.Unlock()
+	l.publish(finalised)
+}
+
+// publish sends finalised candles to dispatch subscribers and saves them to
+// the database when enabled
+func (l *LiveCandleManager) publish(candles []LiveCandle) {
+	for i := range candles {
+		l.m.Lock()
+		id := l.builder(candles[i].Exchange, candles[i].Pair, candles[i].Asset, candles[i].Interval).id
+		l.m.Unlock()
+		if id != uuid.Nil {
+			if err := l.mux.Publish([]uuid.UUID{id}, &candles[i]); err != nil {
+				log.Errorf(log.Trade, "Live candle manager cannot publish candle: %v", err)
+			}
+		}
+		if l.verbose {
+			log.Debugf(log.Trade,
+				"Live candle manager finalised %s %s %s %s candle at %v",
+				candles[i].Exchange,
+				candles[i].Pair,
+				candles[i].Asset,
+				candles[i].Interval,
+				candles[i].Candle.Time)
+		}
+		if l.candleSaver == nil {
+			continue
+		}
+		err := l.candleSaver(&kline.Item{
+			Exchange: candles[i].Exchange,
+			Pair:     candles[i].Pair,
+			Asset:    candles[i].Asset,
+			Interval: candles[i].Interval,
+			Candles:  []kline.Candle{candles[i].Candle},
+		})
+		if err != nil {
+			log.Errorf(log.Trade,
+				"Live candle manager cannot save %s %s %s %s candle: %v",
+				candles[i].Exchange,
+				candles[i].Pair,
+				candles[i].Asset,
+				candles[i].Interval,
+				err)
+		}
+	}
+}
+
+// buildsInterval returns whether candles are built for an interval
+func (l *LiveCandleManager) buildsInterval(interval kline.Interval) bool {
+	for i := range l.intervals {
+		if l.intervals[i] == interval {
+			return true
+		}
+	}
+	return false
+}
+
+// builder returns the candle builder for the supplied parameters, creating
+// it if required. The manager lock must be held
+func (l *LiveCandleManager) builder(exch string, p currency.Pair, a asset.Item, interval kline.Interval) *liveCandleBuilder {
+	key := liveCandleKey{
+		Exchange: strings.ToLower(exch),
+		Base:     p.Base.Item,
+		Quote:    p.Quote.Item,
+		Asset:    a,
+		Interval: interval,
+	}
+	b, ok := l.builders[key]
+	if !ok {
+		b = &liveCandleBuilder{exchange: exch, pair: p}
+		l.builders[key] = b
+	}
+	return b
+}
+
+// finalise closes the open candle and returns it. The manager lock must be
+// held
+func (b *liveCandleBuilder) finalise(a asset.Item, interval kline.Interval) LiveCandle {
+	c := LiveCandle{
+		Exchange: b.exchange,
+		Pair:     b.pair,
+		Asset:    a,
+		Interval: interval,
+		Candle:   *b.current,
+	}
+	b.lastFinalised = b.current.Time
+	b.current = nil
+	return c
+}
@@ -0,0 +1,267 @@
+package engine
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+)
+
+func liveCandleTestConfig() *config.LiveCandleManager {
+	return &config.LiveCandleManager{
+		Intervals:     []time.Duration{time.Minute, time.Hour},
+		FinaliseDelay: time.Second,
+	}
+}
+
+func TestSetupLiveCandleManager(t *testing.T) {
+	t.Parallel()
+	_, err := SetupLiveCandleManager(nil, nil, nil)
+	if !errors.Is(err, errNilExchangeManager) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errNilExchangeManager)
+	}
+
+	_, err = SetupLiveCandleManager(&fakeConsolidatedExchangeManager{}, nil, nil)
+	if !errors.Is(err, errNilLiveCandleConfig) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errNilLiveCandleConfig)
+	}
+
+	_, err = SetupLiveCandleManager(&fakeConsolidatedExchangeManager{}, nil, &config.LiveCandleManager{})
+	if !errors.Is(err, errNoLiveCandleIntervals) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errNoLiveCandleIntervals)
+	}
+
+	cfg := liveCandleTestConfig()
+	cfg.SaveToDatabase = true
+	_, err = SetupLiveCandleManager(&fakeConsolidatedExchangeManager{}, nil, cfg)
+	if !errors.Is(err, errNilDatabaseConnectionManager) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errNilDatabaseConnectionManager)
+	}
+
+	l, err := SetupLiveCandleManager(&fakeConsolidatedExchangeManager{}, &DatabaseConnectionManager{}, cfg)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if len(l.intervals) != 2 || l.candleSaver == nil {
+		t.Error("unexpected values")
+	}
+	err = l.candleSaver(&kline.Item{})
+	if !errors.Is(err, database.ErrDatabaseSupportDisabled) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, database.ErrDatabaseSupportDisabled)
+	}
+
+	var dcm *DatabaseConnectionManager
+	l, err = SetupLiveCandleManager(&fakeConsolidatedExchangeManager{}, dcm, cfg)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	err = l.candleSaver(&kline.Item{})
+	if !errors.Is(err, database.ErrDatabaseSupportDisabled) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, database.ErrDatabaseSupportDisabled)
+	}
+}
+
+func TestLiveCandleManagerStartStop(t *testing.T) {
+	t.Parallel()
+	err := (*LiveCandleManager)(nil).Start()
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrNilSubsystem)
+	}
+
+	err = (*LiveCandleManager)(nil).Stop()
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrNilSubsystem)
+	}
+
+	if (*LiveCandleManager)(nil).IsRunning() {
+		t.Error("expected false")
+	}
+
+	l, err := SetupLiveCandleManager(&fakeConsolidatedExchangeManager{exchs: []exchange.IBotExchange{
+		&fakeConsolidatedExchange{name: "livecandletest"},
+		&fakeConsolidatedExchange{name: "disabled", disabled: true},
+	}}, nil, liveCandleTestConfig())
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+
+	err = l.Stop()
+	if !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrSubSystemNotStarted)
+	}
+
+	err = l.Start()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+
+	err = l.Start()
+	if !errors.Is(err, ErrSubSystemAlreadyStarted) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrSubSystemAlreadyStarted)
+	}
+
+	if !l.IsRunning() {
+		t.Error("expected true")
+	}
+
+	err = l.Stop()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+}
+
+func TestLiveCandleManagerBuild(t *testing.T) {
+	t.Parallel()
+	l, err := SetupLiveCandleManager(&fakeConsolidatedExchangeManager{}, nil, liveCandleTestConfig())
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	var saved []kline.Item
+	l.candleSaver = func(item *kline.Item) error {
+		saved = append(saved, *item)
+		return nil
+	}
+	l.started = 1
+
+	p := currency.NewPair(currency.BTC, currency.USD)
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, td := range []trade.Data{
+		{Price: 100, Amount: 1, Timestamp: start},
+		{Price: 105, Amount: 2, Timestamp: start.Add(time.Second * 10)},
+		{Price: 95, Amount: 3, Timestamp: start.Add(time.Second * 20)},
+		{Price: 101, Amount: 4, Timestamp: start.Add(time.Second * 59)},
+	} {
+		td.Exchange = "LiveCandleTest"
+		td.CurrencyPair = p
+		td.AssetType = asset.Spot
+		if finalised := l.update(&td); len(finalised) != 0 {
+			t.Fatalf("received '%v' expected '%v'", len(finalised), 0)
+		}
+	}
+
+	open, err := l.GetOpenCandles("livecandletest")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if len(open) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(open), 2)
+	}
+
+	// Closed intervals are not finalised until the finalise delay has passed
+	l.finalise(start.Add(time.Minute))
+	if len(saved) != 0 {
+		t.Fatalf("received '%v' expected '%v'", len(saved), 0)
+	}
+	l.finalise(start.Add(time.Minute + time.Second))
+	if len(saved) != 1 {
+		t.Fatalf("received '%v' expected '%v'", len(saved), 1)
+	}
+	c := saved[0].Candles[0]
+	if saved[0].Exchange != "LiveCandleTest" ||
+		saved[0].Interval != kline.OneMin ||
+		!c.Time.Equal(start) ||
+		c.Open != 100 || c.High != 105 || c.Low != 95 || c.Close != 101 || c.Volume != 10 {
+		t.Errorf("unexpected candle %+v", saved[0])
+	}
+
+	// Late trades for a finalised interval are ignored
+	late := trade.Data{Exchange: "LiveCandleTest", CurrencyPair: p, AssetType: asset.Spot, Price: 1, Amount: 1, Timestamp: start.Add(time.Second * 30)}
+	l.update(&late)
+	open, err = l.GetOpenCandles("")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if len(open) != 1 || open[0].Interval != kline.OneHour || open[0].Candle.Low != 1 {
+		t.Errorf("unexpected open candles %+v", open)
+	}
+
+	// A trade in a later interval finalises the open candle immediately
+	next := trade.Data{Exchange: "LiveCandleTest", CurrencyPair: p, AssetType: asset.Spot, Price: 110, Amount: 1, Timestamp: start.Add(time.Hour)}
+	finalised := l.update(&next)
+	if len(finalised) != 1 || finalised[0].Interval != kline.OneHour || finalised[0].Candle.Volume != 11 {
+		t.Fatalf("unexpected finalised candles %+v", finalised)
+	}
+}
+
+func TestLiveCandleManagerSubscribe(t *testing.T) {
+	// Not parallel as the dispatch service is toggled by other tests
+	l, err := SetupLiveCandleManager(&fakeConsolidatedExchangeManager{}, nil, liveCandleTestConfig())
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	p := currency.NewPair(currency.BTC, currency.USD)
+	_, err = l.Subscribe("livecandletest", p, asset.Spot, kline.OneMin)
+	if !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrSubSystemNotStarted)
+	}
+	l.started = 1
+
+	_, err = l.Subscribe("", p, asset.Spot, kline.OneMin)
+	if !errors.Is(err, errExchangeNameUnset) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errExchangeNameUnset)
+	}
+	_, err = l.Subscribe("livecandletest", currency.Pair{}, asset.Spot, kline.OneMin)
+	if !errors.Is(err, errCurrencyPairUnset) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errCurrencyPairUnset)
+	}
+	_, err = l.Subscribe("livecandletest", p, "bruh", kline.OneMin)
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, asset.ErrNotSupported)
+	}
+	_, err = l.Subscribe("livecandletest", p, asset.Spot, kline.FiveMin)
+	if !errors.Is(err, errLiveCandleInterval) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errLiveCandleInterval)
+	}
+
+	if !dispatch.IsRunning() {
+		err = dispatch.Start(dispatch.DefaultMaxWorkers, dispatch.DefaultJobsLimit)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	pipe, err := l.Subscribe("livecandletest", p, asset.Spot, kline.OneMin)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	defer func() {
+		if err = pipe.Release(); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	candle := LiveCandle{
+		Exchange: "livecandletest",
+		Pair:     p,
+		Asset:    asset.Spot,
+		Interval: kline.OneMin,
+		Candle:   kline.Candle{Close: 1337},
+	}
+	// dispatch drops data when the receiver is not ready within its
+	// timeout, so keep publishing until the candle is received
+	timeout := time.After(time.Second * 5)
+	for {
+		l.publish([]LiveCandle{candle})
+		select {
+		case data := <-pipe.C:
+			received, ok := (*data.(*interface{})).(LiveCandle)
+			if !ok {
+				t.Fatalf("received unexpected type %T", data)
+			}
+			if received.Candle.Close != 1337 {
+				t.Fatalf("received '%v' expected '%v'", received.Candle.Close, 1337)
+			}
+			return
+		case <-timeout:
+			t.Fatal("candle not received")
+		case <-time.After(time.Millisecond * 10):
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+const (
+	// LiveCandleManagerName is an exported subsystem name
+	LiveCandleManagerName = "live_candle"
+	// liveCandleCheckInterval defines how often open candles are checked for
+	// finalisation
+	liveCandleCheckInterval = time.Second
+)
+
+var (
+	errNilLiveCandleConfig   = errors.New("nil live candle config")
+	errNoLiveCandleIntervals = errors.New("no live candle intervals configured")
+	errLiveCandleInterval    = errors.New("live candle interval not built")
+)
+
+// LiveCandleManager builds candles for configured intervals from the trade
+// stream of enabled exchanges. Candles are finalised once their interval has
+// closed, published through dispatch and optionally upserted into the
+// database
+type LiveCandleManager struct {
+	started  int32
+	shutdown chan struct{}
+	wg       sync.WaitGroup
+	iExchangeManager
+	verbose       bool
+	intervals     []kline.Interval
+	exchanges     []string
+	finaliseDelay time.Duration
+	candleSaver   func(*kline.Item) error
+
+	mux      *dispatch.Mux
+	builders map[liveCandleKey]*liveCandleBuilder
+	streams  map[string]bool
+	m        sync.Mutex
+}
+
+// liveCandleKey defines the lookup for a candle being built
+type liveCandleKey struct {
+	Exchange string
+	Base     *currency.Item
+	Quote    *currency.Item
+	Asset    asset.Item
+	Interval kline.Interval
+}
+
+// liveCandleBuilder holds the open candle for an exchange, pair, asset and
+// interval along with the start of the last finalised candle so that late
+// trades are not applied to an interval which has already been published
+type liveCandleBuilder struct {
+	exchange      string
+	pair          currency.Pair
+	current       *kline.Candle
+	lastFinalised time.Time
+	id            uuid.UUID
+}
+
+// LiveCandle is a finalised candle built from live trades
+type LiveCandle struct {
+	Exchange string
+	Pair     currency.Pair
+	Asset    asset.Item
+	Interval kline.Interval
+	Candle   kline.Candle
+}
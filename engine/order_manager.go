@@ -300,10 +300,34 @@ func (m *OrderManager) Modify(ctx context.Context, mod *order.Modify) (*order.Mo
 		return nil, fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
 	}
 
+	err := m.populateModify(mod)
+	if err != nil {
+		return nil, err
+	}
+
+	// Get exchange instance and submit order modification request.
+	exch, err := m.orderStore.exchangeManager.GetExchangeByName(mod.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	res, err := exch.ModifyOrder(ctx, mod)
+	if err != nil {
+		m.notifyModifyFailure(mod)
+		return nil, err
+	}
+	return m.processModifiedOrder(mod, &res)
+}
+
+// populateModify fills in the order modification fields from the locally
+// managed order so the request can be sent to the exchange
+func (m *OrderManager) populateModify(mod *order.Modify) error {
+	if mod == nil {
+		return order.ErrModifyOrderIsNil
+	}
 	// Fetch details from locally managed order store.
 	det, err := m.orderStore.getByExchangeAndID(mod.Exchange, mod.ID)
 	if det == nil || err != nil {
-		return nil, fmt.Errorf("order does not exist: %w", err)
+		return fmt.Errorf("order does not exist: %w", err)
 	}
 
 	// Populate additional Modify fields as some of them are required by various
@@ -321,31 +345,31 @@ func (m *OrderManager) Modify(ctx context.Context, mod *order.Modify) (*order.Mo
 	if mod.Price == 0 {
 		mod.Price = det.Price
 	}
+	return nil
+}
 
-	// Get exchange instance and submit order modification request.
-	exch, err := m.orderStore.exchangeManager.GetExchangeByName(mod.Exchange)
-	if err != nil {
-		return nil, err
-	}
-	res, err := exch.ModifyOrder(ctx, mod)
-	if err != nil {
-		message := fmt.Sprintf(
-			"Order manager: Exchange %s order ID=%v: failed to modify",
-			mod.Exchange,
-			mod.ID,
-		)
-		m.orderStore.commsManager.PushEvent(base.Event{
-			Type:    "order",
-			Message: message,
-		})
-		return nil, err
-	}
+// notifyModifyFailure pushes an event when an exchange rejects an order
+// modification
+func (m *OrderManager) notifyModifyFailure(mod *order.Modify) {
+	message := fmt.Sprintf(
+		"Order manager: Exchange %s order ID=%v: failed to modify",
+		mod.Exchange,
+		mod.ID,
+	)
+	m.orderStore.commsManager.PushEvent(base.Event{
+		Type:    "order",
+		Message: message,
+	})
+}
 
+// processModifiedOrder applies a successful exchange order modification to the
+// local order store and notifies observers
+func (m *OrderManager) processModifiedOrder(mod *order.Modify, res *order.Modify) (*order.ModifyResponse, error) {
 	// If modification is successful, apply changes to local order store.
 	//
 	// XXX: This comes with a race condition, because [request -> changes] are not
 	// atomic.
-	err = m.orderStore.modifyExisting(mod.ID, &res)
+	err := m.orderStore.modifyExisting(mod.ID, res)
 
 	// Notify observers.
 	var message string
@@ -380,6 +404,29 @@ func (m *OrderManager) Submit(ctx context.Context, newOrder *order.Submit) (*Ord
 		return nil, err
 	}
 
+	err = checkExchangeOrderRules(exch, newOrder)
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := exch.SubmitOrder(ctx, newOrder)
+	if err != nil {
+		return nil, err
+	}
+
+	return m.processSubmittedOrder(newOrder, result)
+}
+
+// checkExchangeOrderRules ensures the exchange supports the order parameters,
+// will accept the order amounts and is currently trading the pair
+func checkExchangeOrderRules(exch exchange.IBotExchange, newOrder *order.Submit) error {
+	// Rejects order types and time in force options the exchange does not
+	// support before any request is sent
+	err := exch.CheckOrderCapabilities(newOrder)
+	if err != nil {
+		return fmt.Errorf("order manager: %w", err)
+	}
+
 	// Checks for exchange min max limits for order amounts before order
 	// execution can occur
 	err = exch.CheckOrderExecutionLimits(newOrder.AssetType,
@@ -388,7 +435,7 @@ func (m *OrderManager) Submit(ctx context.Context, newOrder *order.Submit) (*Ord
 		newOrder.Amount,
 		newOrder.Type)
 	if err != nil {
-		return nil, fmt.Errorf("order manager: exchange %s unable to place order: %w",
+		return fmt.Errorf("order manager: exchange %s unable to place order: %w",
 			newOrder.Exchange,
 			err)
 	}
@@ -397,19 +444,224 @@ func (m *OrderManager) Submit(ctx context.Context, newOrder *order.Submit) (*Ord
 	// the currency pair
 	err = exch.CanTradePair(newOrder.Pair, newOrder.AssetType)
 	if err != nil {
-		return nil, fmt.Errorf("order manager: exchange %s cannot trade pair %s %s: %w",
+		return fmt.Errorf("order manager: exchange %s cannot trade pair %s %s: %w",
 			newOrder.Exchange,
 			newOrder.Pair,
 			newOrder.AssetType,
 			err)
 	}
+	return nil
+}
 
-	result, err := exch.SubmitOrder(ctx, newOrder)
+// SubmitBatch sends multiple orders for a single exchange in as few requests as
+// the exchange allows and populates each placed order in the OrderManager.
+// Orders that fail validation or are rejected by the exchange do not prevent
+// the rest of the batch from being placed, results are returned in the same
+// sequence as the orders supplied.
+func (m *OrderManager) SubmitBatch(ctx context.Context, orders []*order.Submit) ([]OrderSubmitResult, error) {
+	if m == nil {
+		return nil, fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return nil, fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
+	}
+	if len(orders) == 0 {
+		return nil, order.ErrNoOrdersInBatch
+	}
+
+	var exchName string
+	for x := range orders {
+		if orders[x] == nil {
+			continue
+		}
+		if exchName == "" {
+			exchName = orders[x].Exchange
+			continue
+		}
+		if !strings.EqualFold(exchName, orders[x].Exchange) {
+			return nil, fmt.Errorf("%w: %s and %s", errBatchExchangeMismatch, exchName, orders[x].Exchange)
+		}
+	}
+	exch, err := m.orderStore.exchangeManager.GetExchangeByName(exchName)
 	if err != nil {
 		return nil, err
 	}
 
-	return m.processSubmittedOrder(newOrder, result)
+	results := make([]OrderSubmitResult, len(orders))
+	pending := make([]*order.Submit, 0, len(orders))
+	index := make([]int, 0, len(orders))
+	for x := range orders {
+		err = m.validate(orders[x])
+		if err == nil {
+			err = checkExchangeOrderRules(exch, orders[x])
+		}
+		if err != nil {
+			results[x].Error = err
+			continue
+		}
+		pending = append(pending, orders[x])
+		index = append(index, x)
+	}
+	if len(pending) == 0 {
+		return results, nil
+	}
+
+	placed, err := submitBatchOrders(ctx, exch, pending)
+	if err != nil {
+		return nil, err
+	}
+	for x := range placed {
+		r := &results[index[x]]
+		if placed[x].Error != nil {
+			r.Error = placed[x].Error
+			continue
+		}
+		r.OrderSubmitResponse, r.Error = m.processSubmittedOrder(pending[x], placed[x].SubmitResponse)
+	}
+	return results, nil
+}
+
+// ModifyBatch amends multiple orders for a single exchange in as few requests
+// as the exchange allows and applies each successful amendment to the
+// OrderManager. Results are returned in the same sequence as the amendments
+// supplied.
+func (m *OrderManager) ModifyBatch(ctx context.Context, mods []*order.Modify) ([]OrderModifyResult, error) {
+	if m == nil {
+		return nil, fmt.Errorf("order manager %w", ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return nil, fmt.Errorf("order manager %w", ErrSubSystemNotStarted)
+	}
+	if len(mods) == 0 {
+		return nil, order.ErrNoOrdersInBatch
+	}
+
+	var exchName string
+	for x := range mods {
+		if mods[x] == nil {
+			continue
+		}
+		if exchName == "" {
+			exchName = mods[x].Exchange
+			continue
+		}
+		if !strings.EqualFold(exchName, mods[x].Exchange) {
+			return nil, fmt.Errorf("%w: %s and %s", errBatchExchangeMismatch, exchName, mods[x].Exchange)
+		}
+	}
+	exch, err := m.orderStore.exchangeManager.GetExchangeByName(exchName)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]OrderModifyResult, len(mods))
+	pending := make([]order.Modify, 0, len(mods))
+	index := make([]int, 0, len(mods))
+	for x := range mods {
+		err = m.populateModify(mods[x])
+		if err != nil {
+			results[x].Error = err
+			continue
+		}
+		pending = append(pending, *mods[x])
+		index = append(index, x)
+	}
+	if len(pending) == 0 {
+		return results, nil
+	}
+
+	modified, err := modifyBatchOrders(ctx, exch, pending)
+	if err != nil {
+		return nil, err
+	}
+	for x := range modified {
+		r := &results[index[x]]
+		if modified[x].Error != nil {
+			m.notifyModifyFailure(&pending[x])
+			r.Error = modified[x].Error
+			continue
+		}
+		r.ModifyResponse, r.Error = m.processModifiedOrder(&pending[x], &modified[x].Modify)
+	}
+	return results, nil
+}
+
+// submitBatchOrders places orders through the exchange batch endpoint. When
+// the exchange does not support batch placement the orders are submitted
+// individually with bounded concurrency.
+func submitBatchOrders(ctx context.Context, exch exchange.IBotExchange, orders []*order.Submit) ([]order.SubmitResult, error) {
+	resp, err := exch.SubmitBatchOrders(ctx, orders)
+	switch {
+	case err == nil:
+		if len(resp.Results) != len(orders) {
+			return nil, fmt.Errorf("%s %w: %d results for %d orders",
+				exch.GetName(),
+				errBatchResultMismatch,
+				len(resp.Results),
+				len(orders))
+		}
+		return resp.Results, nil
+	case errors.Is(err, common.ErrNotYetImplemented),
+		errors.Is(err, common.ErrFunctionNotSupported):
+	default:
+		return nil, err
+	}
+
+	results := make([]order.SubmitResult, len(orders))
+	sem := make(chan struct{}, maxBatchFallbackWorkers)
+	var wg sync.WaitGroup
+	for x := range orders {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(x int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			results[x].SubmitResponse, results[x].Error = exch.SubmitOrder(ctx, orders[x])
+		}(x)
+	}
+	wg.Wait()
+	return results, nil
+}
+
+// modifyBatchOrders amends orders through the exchange batch endpoint. When
+// the exchange does not support batch amendment the orders are modified
+// individually with bounded concurrency.
+func modifyBatchOrders(ctx context.Context, exch exchange.IBotExchange, mods []order.Modify) ([]order.ModifyResult, error) {
+	resp, err := exch.ModifyBatchOrders(ctx, mods)
+	switch {
+	case err == nil:
+		if len(resp.Results) != len(mods) {
+			return nil, fmt.Errorf("%s %w: %d results for %d orders",
+				exch.GetName(),
+				errBatchResultMismatch,
+				len(resp.Results),
+				len(mods))
+		}
+		return resp.Results, nil
+	case errors.Is(err, common.ErrNotYetImplemented),
+		errors.Is(err, common.ErrFunctionNotSupported):
+	default:
+		return nil, err
+	}
+
+	results := make([]order.ModifyResult, len(mods))
+	sem := make(chan struct{}, maxBatchFallbackWorkers)
+	var wg sync.WaitGroup
+	for x := range mods {
+		wg.Add(1)
+		sem <- struct{}{}
+		go func(x int) {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+			results[x].Modify, results[x].Error = exch.ModifyOrder(ctx, &mods[x])
+		}(x)
+	}
+	wg.Wait()
+	return results, nil
 }
 
 // SubmitFakeOrder runs through the same process as order submission
@@ -871,7 +1123,7 @@ func (s *store) upsert(od *order.Detail) (resp *OrderUpsertResponse, err error)
 		return nil, errNilOrder
 	}
 	lName := strings.ToLower(od.Exchange)
-	_, err = s.exchangeManager.GetExchangeByName(lName)
+	exch, err := s.exchangeManager.GetExchangeByName(lName)
 	if err != nil {
 		return nil, err
 	}
@@ -879,6 +1131,7 @@ func (s *store) upsert(od *order.Detail) (resp *OrderUpsertResponse, err error)
 	defer s.m.Unlock()
 	r, ok := s.Orders[lName]
 	if !ok {
+		estimateOrderFee(exch, od, false)
 		od.GenerateInternalOrderID()
 		s.Orders[lName] = []*order.Detail{od}
 		resp = &OrderUpsertResponse{
@@ -889,7 +1142,9 @@ func (s *store) upsert(od *order.Detail) (resp *OrderUpsertResponse, err error)
 	}
 	for x := range r {
 		if r[x].ID == od.ID {
+			rested := r[x].IsActive()
 			r[x].UpdateOrderFromDetail(od)
+			estimateOrderFee(exch, r[x], rested)
 			resp = &OrderUpsertResponse{
 				OrderDetails: r[x].Copy(),
 				IsNewOrder:   false,
@@ -898,6 +1153,7 @@ func (s *store) upsert(od *order.Detail) (resp *OrderUpsertResponse, err error)
 		}
 	}
 	// Untracked websocket orders will not have internalIDs yet
+	estimateOrderFee(exch, od, false)
 	od.GenerateInternalOrderID()
 	s.Orders[lName] = append(s.Orders[lName], od)
 	resp = &OrderUpsertResponse{
@@ -907,6 +1163,61 @@ func (s *store) upsert(od *order.Detail) (resp *OrderUpsertResponse, err error)
 	return resp, nil
 }
 
+// estimateOrderFee sets the estimated fee of a filled order from the
+// exchange's loaded fee schedule when the exchange has not reported a fee.
+// The estimate is kept apart from the reported fee so it is never mistaken
+// for one. Trades which report their liquidity are charged at their own rate,
+// otherwise the order is charged as a whole, see orderFilledAsMaker.
+func estimateOrderFee(exch exchange.IBotExchange, od *order.Detail, rested bool) {
+	if od.Fee != 0 || od.ExecutedAmount <= 0 {
+		return
+	}
+	b := exch.GetBase()
+	if b == nil || !b.Fees.IsLoaded() {
+		return
+	}
+	var estimate float64
+	if len(od.Trades) > 0 {
+		for i := range od.Trades {
+			fee, err := b.Fees.CalculateTradingFee(od.AssetType, od.Pair, od.Trades[i].Price, od.Trades[i].Amount, od.Trades[i].IsMaker)
+			if err != nil {
+				return
+			}
+			estimate += fee
+		}
+	} else {
+		price := od.AverageExecutedPrice
+		if price <= 0 {
+			price = od.Price
+		}
+		fee, err := b.Fees.CalculateTradingFee(od.AssetType, od.Pair, price, od.ExecutedAmount, orderFilledAsMaker(od, rested))
+		if err != nil {
+			return
+		}
+		estimate = fee
+	}
+	od.EstimatedFee = estimate
+	od.EstimatedFeeAsset = od.FeeAsset
+	if od.EstimatedFeeAsset.IsEmpty() {
+		od.EstimatedFeeAsset = od.Pair.Quote
+	}
+}
+
+// orderFilledAsMaker reports whether an order is expected to have been filled
+// at the maker rate. Post only orders always are. Limit orders are when the
+// order manager tracked them resting on the book before they filled, a limit
+// order first seen filled may have crossed the spread so it is charged the
+// taker rate, as are market, immediate or cancel and fill or kill orders.
+func orderFilledAsMaker(od *order.Detail, rested bool) bool {
+	if od.PostOnly || od.Type == order.PostOnly {
+		return true
+	}
+	if od.ImmediateOrCancel || od.FillOrKill {
+		return false
+	}
+	return rested && od.Type == order.Limit
+}
+
 // getByExchange returns orders by exchange
 func (s *store) getByExchange(exchange string) ([]*order.Detail, error) {
 	s.m.RLock()
@@ -958,13 +1269,14 @@ func (s *store) add(det *order.Detail) error {
 	if det == nil {
 		return errors.New("order store: Order is nil")
 	}
-	_, err := s.exchangeManager.GetExchangeByName(det.Exchange)
+	exch, err := s.exchangeManager.GetExchangeByName(det.Exchange)
 	if err != nil {
 		return err
 	}
 	if s.exists(det) {
 		return ErrOrdersAlreadyExists
 	}
+	estimateOrderFee(exch, det, false)
 	// Untracked websocket orders will not have internalIDs yet
 	det.GenerateInternalOrderID()
 	s.m.Lock()
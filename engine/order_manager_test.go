@@ -3,7 +3,9 @@ package engine
 import (
 	"context"
 	"errors"
+	"strconv"
 	"sync"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -12,8 +14,12 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/capability"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/currencystate"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fee"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/simulator"
 )
 
 // omfExchange aka ordermanager fake exchange overrides exchange functions
@@ -387,6 +393,162 @@ func TestExists(t *testing.T) {
 	}
 }
 
+func TestEstimateOrderFee(t *testing.T) {
+	t.Parallel()
+	exch := newFeeExchange()
+	pair := currency.NewPair(currency.BTC, currency.USD)
+	od := &order.Detail{
+		AssetType:            asset.Spot,
+		Pair:                 pair,
+		Type:                 order.Market,
+		Price:                100,
+		AverageExecutedPrice: 100,
+		Amount:               2,
+		ExecutedAmount:       2,
+	}
+	// No schedule loaded, no estimate is made
+	estimateOrderFee(exch, od, false)
+	if od.EstimatedFee != 0 {
+		t.Errorf("received '%v' expected '%v'", od.EstimatedFee, 0)
+	}
+
+	err := exch.base.Fees.Load(&fee.Schedule{
+		Commissions: map[asset.Item]fee.Commission{asset.Spot: {Maker: 0.001, Taker: 0.002}},
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+
+	estimateOrderFee(exch, od, false)
+	if od.EstimatedFee != 0.4 {
+		t.Errorf("received '%v' expected '%v'", od.EstimatedFee, 0.4)
+	}
+	if od.EstimatedFeeAsset != currency.USD {
+		t.Errorf("received '%v' expected '%v'", od.EstimatedFeeAsset, currency.USD)
+	}
+	// The estimate is never reported as the exchange fee
+	if od.Fee != 0 || !od.FeeAsset.IsEmpty() {
+		t.Errorf("received '%v %v' expected '%v'", od.Fee, od.FeeAsset, "no fee")
+	}
+
+	// Post only orders are charged the maker rate
+	od = &order.Detail{
+		AssetType:      asset.Spot,
+		Pair:           pair,
+		Price:          100,
+		Amount:         1,
+		ExecutedAmount: 1,
+		PostOnly:       true,
+	}
+	estimateOrderFee(exch, od, false)
+	if od.EstimatedFee != 0.1 {
+		t.Errorf("received '%v' expected '%v'", od.EstimatedFee, 0.1)
+	}
+
+	// Limit orders are charged the maker rate once they have rested on the
+	// book and the taker rate when first seen filled
+	od = &order.Detail{
+		AssetType:      asset.Spot,
+		Pair:           pair,
+		Type:           order.Limit,
+		Price:          100,
+		Amount:         1,
+		ExecutedAmount: 1,
+	}
+	estimateOrderFee(exch, od, false)
+	if od.EstimatedFee != 0.2 {
+		t.Errorf("received '%v' expected '%v'", od.EstimatedFee, 0.2)
+	}
+	estimateOrderFee(exch, od, true)
+	if od.EstimatedFee != 0.1 {
+		t.Errorf("received '%v' expected '%v'", od.EstimatedFee, 0.1)
+	}
+	od.ImmediateOrCancel = true
+	estimateOrderFee(exch, od, true)
+	if od.EstimatedFee != 0.2 {
+		t.Errorf("received '%v' expected '%v'", od.EstimatedFee, 0.2)
+	}
+
+	// Trades are charged at the rate of their reported liquidity
+	od = &order.Detail{
+		AssetType:      asset.Spot,
+		Pair:           pair,
+		Type:           order.Market,
+		Price:          100,
+		Amount:         2,
+		ExecutedAmount: 2,
+		Trades: []order.TradeHistory{
+			{TID: "1", Price: 100, Amount: 1, IsMaker: true},
+			{TID: "2", Price: 100, Amount: 1},
+		},
+	}
+	estimateOrderFee(exch, od, false)
+	if od.EstimatedFee != 0.30000000000000004 {
+		t.Errorf("received '%v' expected '%v'", od.EstimatedFee, 0.30000000000000004)
+	}
+
+	// Unfilled orders and reported fees are not estimated
+	od = &order.Detail{AssetType: asset.Spot, Pair: pair, Price: 100, Amount: 1}
+	estimateOrderFee(exch, od, false)
+	if od.EstimatedFee != 0 {
+		t.Errorf("received '%v' expected '%v'", od.EstimatedFee, 0)
+	}
+	od.ExecutedAmount = 1
+	od.Fee = 1
+	estimateOrderFee(exch, od, false)
+	if od.Fee != 1 || od.EstimatedFee != 0 {
+		t.Errorf("received '%v %v' expected '%v %v'", od.Fee, od.EstimatedFee, 1, 0)
+	}
+}
+
+func TestUpsertEstimatesRestingOrderFee(t *testing.T) {
+	t.Parallel()
+	exch := newFeeExchange()
+	err := exch.base.Fees.Load(&fee.Schedule{
+		Commissions: map[asset.Item]fee.Commission{asset.Spot: {Maker: 0.001, Taker: 0.002}},
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	em := SetupExchangeManager()
+	em.Add(exch)
+	s := &store{
+		Orders:          make(map[string][]*order.Detail),
+		exchangeManager: em,
+	}
+	od := order.Detail{
+		Exchange:  exch.GetName(),
+		ID:        "resting",
+		AssetType: asset.Spot,
+		Pair:      currency.NewPair(currency.BTC, currency.USD),
+		Type:      order.Limit,
+		Status:    order.New,
+		Price:     100,
+		Amount:    1,
+	}
+	resp, err := s.upsert(&od)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if resp.OrderDetails.EstimatedFee != 0 {
+		t.Errorf("received '%v' expected '%v'", resp.OrderDetails.EstimatedFee, 0)
+	}
+
+	filled := od
+	filled.Status = order.Filled
+	filled.ExecutedAmount = 1
+	resp, err = s.upsert(&filled)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if resp.OrderDetails.EstimatedFee != 0.1 {
+		t.Errorf("received '%v' expected '%v'", resp.OrderDetails.EstimatedFee, 0.1)
+	}
+	if resp.OrderDetails.Fee != 0 {
+		t.Errorf("received '%v' expected '%v'", resp.OrderDetails.Fee, 0)
+	}
+}
+
 func TestStore_modifyOrder(t *testing.T) {
 	m := OrdersSetup(t)
 	pair := currency.Pair{
@@ -1153,3 +1315,376 @@ func Test_getActiveOrders(t *testing.T) {
 		t.Errorf("Test_getActiveOrders - Expected 0 results, got: %d", len(res))
 	}
 }
+
+func TestOrderManagerExchangeSimulator(t *testing.T) {
+	t.Parallel()
+	srv, err := simulator.NewServer(&simulator.Config{
+		APIKey:    "simulatorKey",
+		APISecret: "simulatorSecret",
+		Balances:  map[string]float64{"USDT": 100000, "BTC": 10},
+		Markets: []simulator.MarketConfig{{
+			Base:        "BTC",
+			Quote:       "USDT",
+			TickSize:    0.01,
+			StepSize:    0.0001,
+			MinNotional: 10,
+			Asks:        []simulator.Level{{Price: 50010, Amount: 1}},
+		}},
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if err = srv.Start(""); !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	t.Cleanup(func() {
+		if err := srv.Stop(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	var cfg config.Config
+	if err = cfg.LoadConfig(config.TestFile, true); err != nil {
+		t.Fatal(err)
+	}
+	exchCfg, err := cfg.GetExchangeConfig("Binance")
+	if err != nil {
+		t.Fatal(err)
+	}
+	exchCfg.API.OldEndPoints = nil
+	exchCfg.API.Endpoints = srv.BinanceEndpoints()
+	exchCfg.API.AuthenticatedSupport = true
+	exchCfg.API.Credentials.Key = "simulatorKey"
+	exchCfg.API.Credentials.Secret = "simulatorSecret"
+	exchCfg.Features.Enabled.Websocket = false
+
+	em := SetupExchangeManager()
+	exch, err := em.NewExchangeByName("Binance")
+	if err != nil {
+		t.Fatal(err)
+	}
+	exch.SetDefaults()
+	if err = exch.Setup(exchCfg); err != nil {
+		t.Fatal(err)
+	}
+	if err = exch.UpdateOrderExecutionLimits(context.Background(), asset.Spot); err != nil {
+		t.Fatal(err)
+	}
+	em.Add(exch)
+
+	var wg sync.WaitGroup
+	m, err := SetupOrderManager(em, &CommunicationManager{}, &wg, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	m.started = 1
+
+	pair := currency.NewPair(currency.BTC, currency.USDT)
+	resp, err := m.Submit(context.Background(), &order.Submit{
+		Exchange:  exch.GetName(),
+		Pair:      pair,
+		AssetType: asset.Spot,
+		Side:      order.Buy,
+		Type:      order.Limit,
+		Price:     49000,
+		Amount:    1,
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if !resp.IsOrderPlaced || resp.FullyMatched {
+		t.Fatalf("unexpected submit response %+v", resp)
+	}
+
+	// an external seller crosses the resting order
+	if err = srv.Exchange().AddLiquidity("BTCUSDT", simulator.Sell, 49000, 1); err != nil {
+		t.Fatal(err)
+	}
+	o, err := m.GetByExchangeAndID(exch.GetName(), resp.OrderID)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if err = m.FetchAndUpdateExchangeOrder(exch, o, asset.Spot); err != nil {
+		t.Fatal(err)
+	}
+	o, err = m.GetByExchangeAndID(exch.GetName(), resp.OrderID)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if o.Status != order.Filled || o.ExecutedAmount != 1 {
+		t.Fatalf("unexpected order %+v", o)
+	}
+
+	resp, err = m.Submit(context.Background(), &order.Submit{
+		Exchange:  exch.GetName(),
+		Pair:      pair,
+		AssetType: asset.Spot,
+		Side:      order.Sell,
+		Type:      order.Limit,
+		Price:     52000,
+		Amount:    0.5,
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	err = m.Cancel(context.Background(), &order.Cancel{
+		Exchange:  exch.GetName(),
+		ID:        resp.OrderID,
+		Pair:      pair,
+		AssetType: asset.Spot,
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	id, err := strconv.ParseInt(resp.OrderID, 10, 64)
+	if err != nil {
+		t.Fatal(err)
+	}
+	simulated, err := srv.Exchange().Order("BTCUSDT", id, "")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if simulated.Status != simulator.Cancelled {
+		t.Fatalf("received: '%v' but expected: '%v'", simulated.Status, simulator.Cancelled)
+	}
+}
+
+var errBatchRejected = errors.New("batch order rejected")
+
+// batchExchange records the peak number of concurrent single order requests
+// and optionally supports native batch placement
+type batchExchange struct {
+	omfExchange
+	native bool
+	active int32
+	peak   int32
+}
+
+func (b *batchExchange) SubmitOrder(_ context.Context, s *order.Submit) (order.SubmitResponse, error) {
+	n := atomic.AddInt32(&b.active, 1)
+	for {
+		p := atomic.LoadInt32(&b.peak)
+		if n <= p || atomic.CompareAndSwapInt32(&b.peak, p, n) {
+			break
+		}
+	}
+	time.Sleep(time.Millisecond)
+	atomic.AddInt32(&b.active, -1)
+	return order.SubmitResponse{IsOrderPlaced: true, OrderID: s.ClientOrderID}, nil
+}
+
+func (b *batchExchange) SubmitBatchOrders(ctx context.Context, s []*order.Submit) (order.SubmitBatchResponse, error) {
+	if !b.native {
+		return b.omfExchange.SubmitBatchOrders(ctx, s)
+	}
+	resp := order.SubmitBatchResponse{Results: make([]order.SubmitResult, len(s))}
+	for x := range s {
+		if x == 0 {
+			resp.Results[x].Error = errBatchRejected
+			continue
+		}
+		resp.Results[x].IsOrderPlaced = true
+		resp.Results[x].OrderID = "native" + s[x].ClientOrderID
+	}
+	return resp, nil
+}
+
+func batchOrdersSetup(t *testing.T, native bool) (*OrderManager, *batchExchange) {
+	t.Helper()
+	em := SetupExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exch.SetDefaults()
+	b := exch.GetBase()
+	b.Enabled = true
+	b.States = currencystate.NewCurrencyStates()
+	cp := currency.NewPair(currency.BTC, currency.USD)
+	b.CurrencyPairs.Pairs = map[asset.Item]*currency.PairStore{
+		asset.Spot: {
+			AssetEnabled:  convert.BoolPtr(true),
+			Enabled:       currency.Pairs{cp},
+			Available:     currency.Pairs{cp},
+			RequestFormat: &currency.PairFormat{Uppercase: true},
+			ConfigFormat:  &currency.PairFormat{Uppercase: true},
+		},
+	}
+	fake := &batchExchange{omfExchange: omfExchange{IBotExchange: exch}, native: native}
+	em.Add(fake)
+	var wg sync.WaitGroup
+	m, err := SetupOrderManager(em, &CommunicationManager{}, &wg, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	m.started = 1
+	return m, fake
+}
+
+func batchOrders(n int) []*order.Submit {
+	orders := make([]*order.Submit, n)
+	for x := range orders {
+		orders[x] = &order.Submit{
+			Exchange:      testExchange,
+			Pair:          currency.NewPair(currency.BTC, currency.USD),
+			AssetType:     asset.Spot,
+			Side:          order.Buy,
+			Type:          order.Limit,
+			Price:         float64(x + 1),
+			Amount:        1,
+			ClientOrderID: strconv.Itoa(x),
+		}
+	}
+	return orders
+}
+
+func TestCheckExchangeOrderRulesCapabilities(t *testing.T) {
+	_, exch := batchOrdersSetup(t, false)
+	exch.GetBase().Features.Supports.Orders = capability.Orders{
+		Types:       []order.Type{order.Limit},
+		TimeInForce: []capability.TimeInForce{capability.GoodTillCancel},
+	}
+	o := batchOrders(1)[0]
+	o.ImmediateOrCancel = true
+	err := checkExchangeOrderRules(exch, o)
+	if !errors.Is(err, capability.ErrTimeInForceNotSupported) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, capability.ErrTimeInForceNotSupported)
+	}
+	o.ImmediateOrCancel = false
+	o.Type = order.Market
+	err = checkExchangeOrderRules(exch, o)
+	if !errors.Is(err, capability.ErrOrderTypeNotSupported) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, capability.ErrOrderTypeNotSupported)
+	}
+	o.Type = order.Limit
+	o.AssetType = asset.Futures
+	err = checkExchangeOrderRules(exch, o)
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, asset.ErrNotSupported)
+	}
+	o.AssetType = asset.Spot
+	err = checkExchangeOrderRules(exch, o)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+}
+
+func TestSubmitBatch(t *testing.T) {
+	var m *OrderManager
+	_, err := m.SubmitBatch(context.Background(), nil)
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrNilSubsystem)
+	}
+
+	m, exch := batchOrdersSetup(t, false)
+	_, err = m.SubmitBatch(context.Background(), nil)
+	if !errors.Is(err, order.ErrNoOrdersInBatch) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, order.ErrNoOrdersInBatch)
+	}
+
+	orders := batchOrders(12)
+	orders[1].Exchange = "Binance"
+	_, err = m.SubmitBatch(context.Background(), orders)
+	if !errors.Is(err, errBatchExchangeMismatch) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errBatchExchangeMismatch)
+	}
+
+	// Unsupported batch placement fans out to single orders
+	orders[1].Exchange = testExchange
+	orders[2].Amount = 0
+	results, err := m.SubmitBatch(context.Background(), orders)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if len(results) != len(orders) {
+		t.Fatalf("received %d results but expected %d", len(results), len(orders))
+	}
+	if !errors.Is(results[2].Error, order.ErrAmountIsInvalid) {
+		t.Errorf("received: '%v' but expected: '%v'", results[2].Error, order.ErrAmountIsInvalid)
+	}
+	for x := range results {
+		if x == 2 {
+			continue
+		}
+		if results[x].Error != nil {
+			t.Fatalf("unexpected error for order %d: %v", x, results[x].Error)
+		}
+		if results[x].OrderID != strconv.Itoa(x) || results[x].InternalOrderID == "" {
+			t.Errorf("unexpected result for order %d: %+v", x, results[x].OrderSubmitResponse)
+		}
+	}
+	if peak := atomic.LoadInt32(&exch.peak); peak > maxBatchFallbackWorkers {
+		t.Errorf("received %d concurrent requests but expected at most %d", peak, maxBatchFallbackWorkers)
+	}
+	_, err = m.orderStore.getByExchangeAndID(testExchange, "11")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+
+	// Native batch placement keeps per order rejections
+	m, _ = batchOrdersSetup(t, true)
+	results, err = m.SubmitBatch(context.Background(), batchOrders(3))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if !errors.Is(results[0].Error, errBatchRejected) {
+		t.Errorf("received: '%v' but expected: '%v'", results[0].Error, errBatchRejected)
+	}
+	if results[2].Error != nil || results[2].OrderID != "native2" {
+		t.Errorf("unexpected result %+v", results[2])
+	}
+}
+
+func TestModifyBatch(t *testing.T) {
+	var m *OrderManager
+	_, err := m.ModifyBatch(context.Background(), nil)
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrNilSubsystem)
+	}
+
+	m, _ = batchOrdersSetup(t, false)
+	_, err = m.ModifyBatch(context.Background(), nil)
+	if !errors.Is(err, order.ErrNoOrdersInBatch) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, order.ErrNoOrdersInBatch)
+	}
+	err = m.orderStore.add(&order.Detail{
+		Exchange:  testExchange,
+		AssetType: asset.Spot,
+		Pair:      currency.NewPair(currency.BTC, currency.USD),
+		ID:        "fake_order_id",
+		Price:     8,
+		Amount:    128,
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+
+	_, err = m.ModifyBatch(context.Background(), []*order.Modify{
+		{Exchange: testExchange, ID: "fake_order_id"},
+		{Exchange: "Binance", ID: "fake_order_id"},
+	})
+	if !errors.Is(err, errBatchExchangeMismatch) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errBatchExchangeMismatch)
+	}
+
+	results, err := m.ModifyBatch(context.Background(), []*order.Modify{
+		{Exchange: testExchange, ID: "nonexistent_order_id", Price: 16},
+		{Exchange: testExchange, ID: "fake_order_id", Price: 16},
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if !errors.Is(results[0].Error, ErrOrderNotFound) {
+		t.Errorf("received: '%v' but expected: '%v'", results[0].Error, ErrOrderNotFound)
+	}
+	if results[1].Error != nil || results[1].OrderID != "modified_order_id" {
+		t.Fatalf("unexpected result %+v", results[1])
+	}
+	det, err := m.orderStore.getByExchangeAndID(testExchange, "modified_order_id")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if det.Price != 16 || det.Amount != 128 {
+		t.Errorf("unexpected order details price: %v amount: %v", det.Price, det.Amount)
+	}
+}
@@ -9,8 +9,13 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 )
 
-// OrderManagerName is an exported subsystem name
-const OrderManagerName = "orders"
+const (
+	// OrderManagerName is an exported subsystem name
+	OrderManagerName = "orders"
+	// maxBatchFallbackWorkers bounds the number of concurrent single order
+	// requests made when an exchange does not support batch endpoints
+	maxBatchFallbackWorkers = 5
+)
 
 // vars for the fund manager package
 var (
@@ -21,8 +26,10 @@ var (
 	ErrOrderNotFound            = errors.New("order does not exist")
 	errNilCommunicationsManager = errors.New("cannot start with nil communications manager")
 	// ErrOrderIDCannotBeEmpty occurs when an order does not have an ID
-	ErrOrderIDCannotBeEmpty = errors.New("orderID cannot be empty")
-	errNilOrder             = errors.New("nil order received")
+	ErrOrderIDCannotBeEmpty  = errors.New("orderID cannot be empty")
+	errNilOrder              = errors.New("nil order received")
+	errBatchExchangeMismatch = errors.New("batch orders must be for the same exchange")
+	errBatchResultMismatch   = errors.New("batch result count does not match request count")
 )
 
 type orderManagerConfig struct {
@@ -60,6 +67,20 @@ type OrderSubmitResponse struct {
 	InternalOrderID string
 }
 
+// OrderSubmitResult holds the outcome of a single order within a batch
+// submission, Error is set when that order was not placed
+type OrderSubmitResult struct {
+	*OrderSubmitResponse
+	Error error
+}
+
+// OrderModifyResult holds the outcome of a single amendment within a batch
+// modification, Error is set when that order was not modified
+type OrderModifyResult struct {
+	*order.ModifyResponse
+	Error error
+}
+
 // OrderUpsertResponse contains a copy of the resulting order details and a bool
 // indicating if the order details were inserted (true) or updated (false)
 type OrderUpsertResponse struct {
@@ -0,0 +1,640 @@
+package engine
+
+import (
+	"bufio"
+	"compress/gzip"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/orderbooksnapshot"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetupOrderbookHistoryManager applies configuration parameters before
+// running
+func SetupOrderbookHistoryManager(em iExchangeManager, dcm iDatabaseConnectionManager, cfg *config.OrderbookHistoryManager, dataDir string) (*OrderbookHistoryManager, error) {
+	if em == nil {
+		return nil, errNilExchangeManager
+	}
+	if cfg == nil {
+		return nil, errNilOrderbookHistoryConfig
+	}
+	if !cfg.FileStorage && !cfg.DatabaseStorage {
+		return nil, errOrderbookHistoryNoStorage
+	}
+	m := &OrderbookHistoryManager{
+		shutdown:         make(chan struct{}),
+		iExchangeManager: em,
+		verbose:          cfg.Verbose,
+		depth:            cfg.Depth,
+		interval:         cfg.Interval,
+		flushInterval:    cfg.FlushInterval,
+		retentionPeriod:  cfg.RetentionPeriod,
+		fileStorage:      cfg.FileStorage,
+		databaseStorage:  cfg.DatabaseStorage,
+		exchanges:        cfg.Exchanges,
+		books:            make(map[orderbookHistoryKey]*orderbookHistoryBook),
+		maxRetry:         orderbookHistoryMaxRetry,
+	}
+	if m.flushInterval <= 0 {
+		m.flushInterval = DefaultOrderbookHistoryFlushInterval
+	}
+	if m.fileStorage {
+		m.directory = cfg.Directory
+		if m.directory == "" {
+			if dataDir == "" {
+				return nil, errDirectoryUnset
+			}
+			m.directory = filepath.Join(dataDir, orderbookHistoryDirectory)
+		}
+	}
+	if m.databaseStorage {
+		if dcm == nil {
+			return nil, errNilDatabaseConnectionManager
+		}
+		db, err := orderbooksnapshot.Setup(dcm.GetInstance())
+		if err != nil {
+			return nil, err
+		}
+		if db == nil {
+			return nil, errOrderbookHistoryNoDatabase
+		}
+		m.dbService = db
+	}
+	return m, nil
+}
+
+// Start runs the subsystem
+func (m *OrderbookHistoryManager) Start() error {
+	if m == nil {
+		return fmt.Errorf("%s %w", OrderbookHistoryManagerName, ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 0, 1) {
+		return fmt.Errorf("%s %w", OrderbookHistoryManagerName, ErrSubSystemAlreadyStarted)
+	}
+	log.Debugf(log.OrderBook, "Orderbook history manager %s", MsgSubSystemStarting)
+	if m.fileStorage {
+		if err := common.CreateDir(m.directory); err != nil {
+			atomic.StoreInt32(&m.started, 0)
+			return err
+		}
+	}
+	m.wg.Add(1)
+	go m.run()
+	log.Debugf(log.OrderBook, "Orderbook history manager %s", MsgSubSystemStarted)
+	return nil
+}
+
+// Stop stops the subsystem, any captured snapshots are flushed to storage
+// before returning
+func (m *OrderbookHistoryManager) Stop() error {
+	if m == nil {
+		return fmt.Errorf("%s %w", OrderbookHistoryManagerName, ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return fmt.Errorf("%s %w", OrderbookHistoryManagerName, ErrSubSystemNotStarted)
+	}
+	log.Debugf(log.OrderBook, "Orderbook history manager %s", MsgSubSystemShuttingDown)
+	close(m.shutdown)
+	m.wg.Wait()
+	if err := m.flush(); err != nil {
+		log.Errorf(log.OrderBook, "Orderbook history manager flush error: %v", err)
+	}
+	m.m.Lock()
+	m.books = make(map[orderbookHistoryKey]*orderbookHistoryBook)
+	m.m.Unlock()
+	m.shutdown = make(chan struct{})
+	atomic.StoreInt32(&m.started, 0)
+	log.Debugf(log.OrderBook, "Orderbook history manager %s", MsgSubSystemShutdown)
+	return nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (m *OrderbookHistoryManager) IsRunning() bool {
+	if m == nil {
+		return false
+	}
+	return atomic.LoadInt32(&m.started) == 1
+}
+
+// GetOrderbookHistory returns the captured snapshots for an exchange
+// orderbook between the start and end times inclusive, ordered by time. When
+// fromDatabase is set, snapshots are read from the database rather than from
+// file storage.
+func (m *OrderbookHistoryManager) GetOrderbookHistory(exchName string, p currency.Pair, a asset.Item, start, end time.Time, fromDatabase bool) ([]orderbook.Base, error) {
+	if !m.IsRunning() {
+		return nil, fmt.Errorf("%s %w", OrderbookHistoryManagerName, ErrSubSystemNotStarted)
+	}
+	if fromDatabase {
+		if !m.databaseStorage {
+			return nil, errOrderbookHistoryDBStorage
+		}
+		return GetOrderbookHistoryFromDatabase(m.dbService, exchName, p, a, start, end)
+	}
+	if !m.fileStorage {
+		return nil, errOrderbookHistoryFileStorage
+	}
+	return ReadOrderbookHistory(m.directory, exchName, p, a, start, end)
+}
+
+// run handles discovery, interval capture, flushing and retention until
+// shutdown
+func (m *OrderbookHistoryManager) run() {
+	defer m.wg.Done()
+	discovery := time.NewTicker(DefaultOrderbookHistoryDiscoveryDelay)
+	defer discovery.Stop()
+	flush := time.NewTicker(m.flushInterval)
+	defer flush.Stop()
+	retention := time.NewTicker(DefaultOrderbookHistoryRetentionDelay)
+	defer retention.Stop()
+	var capture <-chan time.Time
+	if m.interval > 0 {
+		captureTicker := time.NewTicker(m.interval)
+		defer captureTicker.Stop()
+		capture = captureTicker.C
+	}
+
+	m.discover()
+	m.prune()
+	for {
+		select {
+		case <-m.shutdown:
+			return
+		case <-discovery.C:
+			m.discover()
+		case <-capture:
+			m.captureAll()
+		case <-flush.C:
+			if err := m.flush(); err != nil {
+				log.Errorf(log.OrderBook, "Orderbook history manager flush error: %v", err)
+			}
+		case <-retention.C:
+			m.prune()
+		}
+	}
+}
+
+// discover finds enabled exchange orderbooks that are not yet being captured
+func (m *OrderbookHistoryManager) discover() {
+	exchs, err := m.GetExchanges()
+	if err != nil {
+		log.Errorf(log.OrderBook, "Orderbook history manager cannot get exchanges: %v", err)
+		return
+	}
+	m.m.Lock()
+	defer m.m.Unlock()
+	for x := range exchs {
+		if !exchs[x].IsEnabled() {
+			continue
+		}
+		name := exchs[x].GetName()
+		if len(m.exchanges) != 0 && !common.StringDataCompareInsensitive(m.exchanges, name) {
+			continue
+		}
+		assets := exchs[x].GetAssetTypes(true)
+		for y := range assets {
+			pairs, err := exchs[x].GetEnabledPairs(assets[y])
+			if err != nil {
+				continue
+			}
+			for z := range pairs {
+				key := orderbookHistoryKey{
+					Exchange: strings.ToLower(name),
+					Base:     pairs[z].Base.Item,
+					Quote:    pairs[z].Quote.Item,
+					Asset:    assets[y],
+				}
+				if _, ok := m.books[key]; ok {
+					continue
+				}
+				depth, err := orderbook.GetDepth(name, pairs[z], assets[y])
+				if err != nil {
+					continue
+				}
+				book := &orderbookHistoryBook{
+					exchange: name,
+					pair:     pairs[z],
+					asset:    assets[y],
+					depth:    depth,
+				}
+				m.books[key] = book
+				if m.verbose {
+					log.Debugf(log.OrderBook,
+						"Orderbook history manager capturing %s %s %s",
+						name,
+						pairs[z],
+						assets[y])
+				}
+				if m.interval <= 0 {
+					m.wg.Add(1)
+					go m.watch(book)
+				}
+			}
+		}
+	}
+}
+
+// watch captures a snapshot each time the underlying exchange depth changes
+func (m *OrderbookHistoryManager) watch(book *orderbookHistoryBook) {
+	defer m.wg.Done()
+	book.depth.WatchUpdates(m.shutdown, func() {
+		book.m.Lock()
+		m.capture(book)
+		book.m.Unlock()
+	})
+}
+
+// captureAll queues a snapshot of every tracked book, the manager lock is
+// only held whilst the books are listed so discovery is not blocked by
+// capture
+func (m *OrderbookHistoryManager) captureAll() {
+	m.m.Lock()
+	books := make([]*orderbookHistoryBook, 0, len(m.books))
+	for _, book := range m.books {
+		books = append(books, book)
+	}
+	m.m.Unlock()
+	for x := range books {
+		books[x].m.Lock()
+		m.capture(books[x])
+		books[x].m.Unlock()
+	}
+}
+
+// capture queues a snapshot of the book for the next flush, books which have
+// not changed since the last capture are skipped. Must be called with the
+// book mutex held.
+func (m *OrderbookHistoryManager) capture(book *orderbookHistoryBook) {
+	snapshot := book.depth.RetrieveLevels(m.depth)
+	if len(snapshot.Bids) == 0 && len(snapshot.Asks) == 0 {
+		return
+	}
+	timestamp := snapshot.LastUpdated
+	if timestamp.IsZero() {
+		timestamp = time.Now()
+	} else if timestamp.Equal(book.lastUpdated) {
+		return
+	}
+	book.lastUpdated = timestamp
+	m.pendingM.Lock()
+	m.pending = append(m.pending, orderbooksnapshot.Snapshot{
+		Exchange:  book.exchange,
+		Base:      book.pair.Base.String(),
+		Quote:     book.pair.Quote.String(),
+		Asset:     book.asset.String(),
+		Bids:      snapshot.Bids,
+		Asks:      snapshot.Asks,
+		Timestamp: timestamp.UTC(),
+	})
+	m.pendingM.Unlock()
+}
+
+// flush writes all queued snapshots to the enabled storage. Snapshots which
+// fail to be written are queued again for the next flush.
+func (m *OrderbookHistoryManager) flush() error {
+	m.pendingM.Lock()
+	pending := m.pending
+	m.pending = nil
+	var fileQueue, databaseQueue []orderbooksnapshot.Snapshot
+	if m.fileStorage {
+		fileQueue = append(m.fileRetry, pending...)
+	}
+	if m.databaseStorage {
+		databaseQueue = append(m.databaseRetry, pending...)
+	}
+	m.fileRetry, m.databaseRetry = nil, nil
+	m.pendingM.Unlock()
+	if len(fileQueue) == 0 && len(databaseQueue) == 0 {
+		return nil
+	}
+	var errs common.Errors
+	var fileFailed, databaseFailed []orderbooksnapshot.Snapshot
+	if len(fileQueue) > 0 {
+		var err error
+		fileFailed, err = writeOrderbookHistory(m.directory, fileQueue)
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if len(databaseQueue) > 0 {
+		snapshots := make([]*orderbooksnapshot.Snapshot, len(databaseQueue))
+		for x := range databaseQueue {
+			snapshots[x] = &databaseQueue[x]
+		}
+		if err := m.dbService.Insert(snapshots...); err != nil {
+			errs = append(errs, err)
+			databaseFailed = databaseQueue
+		}
+	}
+	if len(fileFailed) > 0 || len(databaseFailed) > 0 {
+		m.pendingM.Lock()
+		m.fileRetry = m.limitRetry("file", fileFailed)
+		m.databaseRetry = m.limitRetry("database", databaseFailed)
+		m.pendingM.Unlock()
+	}
+	if m.verbose {
+		log.Debugf(log.OrderBook,
+			"Orderbook history manager flushed %d file and %d database snapshots",
+			len(fileQueue)-len(fileFailed),
+			len(databaseQueue)-len(databaseFailed))
+	}
+	if len(errs) > 0 {
+		return errs
+	}
+	return nil
+}
+
+// limitRetry keeps the most recent snapshots of a failed write up to the
+// retry limit so a storage outage cannot grow memory without bound
+func (m *OrderbookHistoryManager) limitRetry(storage string, failed []orderbooksnapshot.Snapshot) []orderbooksnapshot.Snapshot {
+	if len(failed) <= m.maxRetry {
+		return failed
+	}
+	log.Warnf(log.OrderBook,
+		"Orderbook history manager %s retry limit reached, dropping %d oldest snapshots",
+		storage,
+		len(failed)-m.maxRetry)
+	return failed[len(failed)-m.maxRetry:]
+}
+
+// prune removes snapshots older than the retention period from storage
+func (m *OrderbookHistoryManager) prune() {
+	if m.retentionPeriod <= 0 {
+		return
+	}
+	cutoff := time.Now().Add(-m.retentionPeriod).UTC()
+	if m.fileStorage {
+		removed, err := pruneOrderbookHistory(m.directory, cutoff)
+		if err != nil {
+			log.Errorf(log.OrderBook, "Orderbook history manager file retention error: %v", err)
+		}
+		if m.verbose && removed > 0 {
+			log.Debugf(log.OrderBook, "Orderbook history manager removed %d expired files", removed)
+		}
+	}
+	if m.databaseStorage {
+		removed, err := m.dbService.DeleteBefore(cutoff)
+		if err != nil {
+			log.Errorf(log.OrderBook, "Orderbook history manager database retention error: %v", err)
+		}
+		if m.verbose && removed > 0 {
+			log.Debugf(log.OrderBook, "Orderbook history manager removed %d expired database snapshots", removed)
+		}
+	}
+}
+
+// orderbookHistoryPath returns the directory which holds the daily snapshot
+// files for an exchange orderbook
+func orderbookHistoryPath(dir, exchName string, p currency.Pair, a asset.Item) string {
+	return filepath.Join(dir,
+		strings.ToLower(exchName),
+		strings.ToLower(a.String()),
+		strings.ToUpper(p.Base.String()+"-"+p.Quote.String()))
+}
+
+// writeOrderbookHistory appends snapshots to their daily gzip compressed JSON
+// lines files. Each write is appended as a new gzip member so existing data
+// is never rewritten. The snapshots of files which could not be written are
+// returned so they can be retried, snapshots which cannot be stored at all
+// are only reported in the error.
+func writeOrderbookHistory(dir string, snapshots []orderbooksnapshot.Snapshot) ([]orderbooksnapshot.Snapshot, error) {
+	files := make(map[string][]int)
+	var order []string
+	var errs common.Errors
+	for x := range snapshots {
+		p, err := currency.NewPairFromStrings(snapshots[x].Base, snapshots[x].Quote)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		a, err := asset.New(snapshots[x].Asset)
+		if err != nil {
+			errs = append(errs, err)
+			continue
+		}
+		path := filepath.Join(orderbookHistoryPath(dir, snapshots[x].Exchange, p, a),
+			snapshots[x].Timestamp.UTC().Format(orderbookHistoryDateFormat)+orderbookHistoryFileExtension)
+		if _, ok := files[path]; !ok {
+			order = append(order, path)
+		}
+		files[path] = append(files[path], x)
+	}
+	var failed []orderbooksnapshot.Snapshot
+	for x := range order {
+		indexes := files[order[x]]
+		records := make([]orderbookHistoryRecord, len(indexes))
+		for y := range indexes {
+			records[y] = orderbookHistoryRecord{
+				Timestamp: snapshots[indexes[y]].Timestamp,
+				Bids:      itemsToHistoryLevels(snapshots[indexes[y]].Bids),
+				Asks:      itemsToHistoryLevels(snapshots[indexes[y]].Asks),
+			}
+		}
+		if err := appendOrderbookHistoryFile(order[x], records); err != nil {
+			errs = append(errs, err)
+			for y := range indexes {
+				failed = append(failed, snapshots[indexes[y]])
+			}
+		}
+	}
+	if len(errs) > 0 {
+		return failed, errs
+	}
+	return nil, nil
+}
+
+// appendOrderbookHistoryFile writes records to the end of a gzip file
+func appendOrderbookHistoryFile(path string, records []orderbookHistoryRecord) error {
+	if err := common.CreateDir(filepath.Dir(path)); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	zw := gzip.NewWriter(f)
+	encoder := json.NewEncoder(zw)
+	for x := range records {
+		if err = encoder.Encode(&records[x]); err != nil {
+			break
+		}
+	}
+	if closeErr := zw.Close(); err == nil {
+		err = closeErr
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
+
+// ReadOrderbookHistory reads the snapshots captured to file storage for an
+// exchange orderbook between the start and end times inclusive, ordered by
+// time
+func ReadOrderbookHistory(dir, exchName string, p currency.Pair, a asset.Item, start, end time.Time) ([]orderbook.Base, error) {
+	if dir == "" {
+		return nil, errDirectoryUnset
+	}
+	if exchName == "" {
+		return nil, errExchangeNameUnset
+	}
+	if p.IsEmpty() {
+		return nil, errCurrencyPairUnset
+	}
+	if !a.IsValid() {
+		return nil, fmt.Errorf("%s %w", a, asset.ErrNotSupported)
+	}
+	if err := common.StartEndTimeCheck(start, end); err != nil {
+		return nil, err
+	}
+	path := orderbookHistoryPath(dir, exchName, p, a)
+	var resp []orderbook.Base
+	lastDay := end.UTC().Truncate(time.Hour * 24)
+	for day := start.UTC().Truncate(time.Hour * 24); !day.After(lastDay); day = day.AddDate(0, 0, 1) {
+		records, err := readOrderbookHistoryFile(filepath.Join(path, day.Format(orderbookHistoryDateFormat)+orderbookHistoryFileExtension))
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				continue
+			}
+			return nil, err
+		}
+		for x := range records {
+			if records[x].Timestamp.Before(start) || records[x].Timestamp.After(end) {
+				continue
+			}
+			resp = append(resp, orderbook.Base{
+				Exchange:    exchName,
+				Pair:        p,
+				Asset:       a,
+				Bids:        historyLevelsToItems(records[x].Bids),
+				Asks:        historyLevelsToItems(records[x].Asks),
+				LastUpdated: records[x].Timestamp,
+			})
+		}
+	}
+	sort.SliceStable(resp, func(i, j int) bool {
+		return resp[i].LastUpdated.Before(resp[j].LastUpdated)
+	})
+	return resp, nil
+}
+
+// readOrderbookHistoryFile decodes all records from a gzip JSON lines file,
+// multiple gzip members are read as one stream
+func readOrderbookHistoryFile(path string) ([]orderbookHistoryRecord, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	zr, err := gzip.NewReader(bufio.NewReader(f))
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+	var records []orderbookHistoryRecord
+	decoder := json.NewDecoder(zr)
+	for {
+		var record orderbookHistoryRecord
+		if err = decoder.Decode(&record); err != nil {
+			if errors.Is(err, io.EOF) {
+				return records, nil
+			}
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		records = append(records, record)
+	}
+}
+
+// pruneOrderbookHistory removes daily snapshot files which only contain
+// snapshots older than the cutoff
+func pruneOrderbookHistory(dir string, cutoff time.Time) (int, error) {
+	var removed int
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			if errors.Is(err, os.ErrNotExist) {
+				return nil
+			}
+			return err
+		}
+		if info.IsDir() || !strings.HasSuffix(info.Name(), orderbookHistoryFileExtension) {
+			return nil
+		}
+		day, err := time.Parse(orderbookHistoryDateFormat, strings.TrimSuffix(info.Name(), orderbookHistoryFileExtension))
+		if err != nil {
+			return nil
+		}
+		if !day.AddDate(0, 0, 1).After(cutoff) {
+			if err = os.Remove(path); err != nil {
+				return err
+			}
+			removed++
+		}
+		return nil
+	})
+	return removed, err
+}
+
+// GetOrderbookHistoryFromDatabase returns the snapshots stored in the
+// database for an exchange orderbook between the start and end times
+// inclusive, ordered by time
+func GetOrderbookHistoryFromDatabase(db orderbooksnapshot.IDBService, exchName string, p currency.Pair, a asset.Item, start, end time.Time) ([]orderbook.Base, error) {
+	if db == nil {
+		return nil, errOrderbookHistoryNoDatabase
+	}
+	if p.IsEmpty() {
+		return nil, errCurrencyPairUnset
+	}
+	if !a.IsValid() {
+		return nil, fmt.Errorf("%s %w", a, asset.ErrNotSupported)
+	}
+	if err := common.StartEndTimeCheck(start, end); err != nil {
+		return nil, err
+	}
+	snapshots, err := db.GetInRange(exchName, a.String(), p.Base.String(), p.Quote.String(), start, end)
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]orderbook.Base, len(snapshots))
+	for x := range snapshots {
+		resp[x] = orderbook.Base{
+			Exchange:    exchName,
+			Pair:        p,
+			Asset:       a,
+			Bids:        snapshots[x].Bids,
+			Asks:        snapshots[x].Asks,
+			LastUpdated: snapshots[x].Timestamp,
+		}
+	}
+	return resp, nil
+}
+
+// itemsToHistoryLevels converts orderbook items into price and amount pairs
+func itemsToHistoryLevels(items orderbook.Items) [][2]float64 {
+	levels := make([][2]float64, len(items))
+	for x := range items {
+		levels[x] = [2]float64{items[x].Price, items[x].Amount}
+	}
+	return levels
+}
+
+// historyLevelsToItems converts price and amount pairs into orderbook items
+func historyLevelsToItems(levels [][2]float64) orderbook.Items {
+	items := make(orderbook.Items, len(levels))
+	for x := range levels {
+		items[x] = orderbook.Item{Price: levels[x][0], Amount: levels[x][1]}
+	}
+	return items
+}
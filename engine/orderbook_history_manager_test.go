@@ -0,0 +1,472 @@
+package engine
+
+import (
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/orderbooksnapshot"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+type fakeOrderbookHistoryExchange struct {
+	fakeConsolidatedExchange
+}
+
+func (f *fakeOrderbookHistoryExchange) GetAssetTypes(bool) asset.Items {
+	return asset.Items{asset.Spot}
+}
+
+type fakeDatabaseConnectionManager struct{}
+
+func (f *fakeDatabaseConnectionManager) GetInstance() database.IDatabase {
+	return &database.Instance{}
+}
+
+func TestSetupOrderbookHistoryManager(t *testing.T) {
+	t.Parallel()
+	_, err := SetupOrderbookHistoryManager(nil, nil, nil, "")
+	if !errors.Is(err, errNilExchangeManager) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errNilExchangeManager)
+	}
+
+	em := &fakeConsolidatedExchangeManager{}
+	_, err = SetupOrderbookHistoryManager(em, nil, nil, "")
+	if !errors.Is(err, errNilOrderbookHistoryConfig) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errNilOrderbookHistoryConfig)
+	}
+
+	_, err = SetupOrderbookHistoryManager(em, nil, &config.OrderbookHistoryManager{}, "")
+	if !errors.Is(err, errOrderbookHistoryNoStorage) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errOrderbookHistoryNoStorage)
+	}
+
+	_, err = SetupOrderbookHistoryManager(em, nil, &config.OrderbookHistoryManager{FileStorage: true}, "")
+	if !errors.Is(err, errDirectoryUnset) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errDirectoryUnset)
+	}
+
+	_, err = SetupOrderbookHistoryManager(em, nil, &config.OrderbookHistoryManager{DatabaseStorage: true}, "")
+	if !errors.Is(err, errNilDatabaseConnectionManager) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errNilDatabaseConnectionManager)
+	}
+
+	_, err = SetupOrderbookHistoryManager(em, &fakeDatabaseConnectionManager{}, &config.OrderbookHistoryManager{DatabaseStorage: true}, "")
+	if !errors.Is(err, errOrderbookHistoryNoDatabase) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errOrderbookHistoryNoDatabase)
+	}
+
+	m, err := SetupOrderbookHistoryManager(em, nil, &config.OrderbookHistoryManager{FileStorage: true}, "datadir")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+
+	if m.directory != filepath.Join("datadir", orderbookHistoryDirectory) {
+		t.Fatal("unexpected value")
+	}
+
+	if m.flushInterval != DefaultOrderbookHistoryFlushInterval {
+		t.Fatal("unexpected value")
+	}
+}
+
+func TestOrderbookHistoryManagerStartStop(t *testing.T) {
+	t.Parallel()
+	err := (*OrderbookHistoryManager)(nil).Start()
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrNilSubsystem)
+	}
+
+	err = (*OrderbookHistoryManager)(nil).Stop()
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrNilSubsystem)
+	}
+
+	if (*OrderbookHistoryManager)(nil).IsRunning() {
+		t.Fatal("nil subsystem should not be running")
+	}
+
+	m, err := SetupOrderbookHistoryManager(&fakeConsolidatedExchangeManager{},
+		nil,
+		&config.OrderbookHistoryManager{FileStorage: true},
+		t.TempDir())
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+
+	err = m.Stop()
+	if !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrSubSystemNotStarted)
+	}
+
+	err = m.Start()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+
+	err = m.Start()
+	if !errors.Is(err, ErrSubSystemAlreadyStarted) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrSubSystemAlreadyStarted)
+	}
+
+	if !m.IsRunning() {
+		t.Fatal("this should be running")
+	}
+
+	err = m.Stop()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+
+	// Restart to ensure the shutdown channel is reset
+	err = m.Start()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+
+	err = m.Stop()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+}
+
+func TestOrderbookHistoryCapture(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.BTC, currency.USD)
+	em := &fakeConsolidatedExchangeManager{
+		exchs: []exchange.IBotExchange{
+			&fakeOrderbookHistoryExchange{fakeConsolidatedExchange{name: "HistoryOne", pairs: currency.Pairs{p}}},
+			&fakeOrderbookHistoryExchange{fakeConsolidatedExchange{name: "HistoryTwo", pairs: currency.Pairs{p}}},
+		},
+	}
+	m, err := SetupOrderbookHistoryManager(em,
+		nil,
+		&config.OrderbookHistoryManager{
+			FileStorage: true,
+			Depth:       2,
+			Interval:    time.Minute,
+			Exchanges:   []string{"historyone"},
+		},
+		t.TempDir())
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+
+	_, err = m.GetOrderbookHistory("HistoryOne", p, asset.Spot, time.Now().Add(-time.Hour), time.Now(), false)
+	if !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrSubSystemNotStarted)
+	}
+
+	depth, err := orderbook.DeployDepth("HistoryOne", p, asset.Spot)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	_, err = orderbook.DeployDepth("HistoryTwo", p, asset.Spot)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+
+	m.discover()
+	if len(m.books) != 1 {
+		t.Fatalf("expected %v tracked books but received %v", 1, len(m.books))
+	}
+
+	first := time.Now().Add(-time.Minute).UTC()
+	depth.LoadSnapshot(
+		[]orderbook.Item{{Price: 100, Amount: 1}, {Price: 99, Amount: 2}, {Price: 98, Amount: 3}},
+		[]orderbook.Item{{Price: 101, Amount: 1}, {Price: 102, Amount: 2}, {Price: 103, Amount: 3}},
+		0, first, true)
+
+	m.captureAll()
+	// Unchanged books should not be captured twice
+	m.captureAll()
+
+	if len(m.pending) != 1 {
+		t.Fatalf("expected %v pending snapshots but received %v", 1, len(m.pending))
+	}
+
+	if len(m.pending[0].Bids) != 2 || len(m.pending[0].Asks) != 2 {
+		t.Fatal("expected captured depth to be limited to two levels")
+	}
+
+	err = m.flush()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+
+	second := first.Add(time.Second)
+	depth.LoadSnapshot(
+		[]orderbook.Item{{Price: 100, Amount: 5}},
+		[]orderbook.Item{{Price: 101, Amount: 5}},
+		0, second, true)
+
+	m.captureAll()
+
+	// A second flush appends a new gzip member to the same daily file
+	err = m.flush()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+
+	err = m.Start()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	defer func() {
+		if err = m.Stop(); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	_, err = m.GetOrderbookHistory("HistoryOne", p, asset.Spot, time.Now().Add(-time.Hour), time.Now(), true)
+	if !errors.Is(err, errOrderbookHistoryDBStorage) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errOrderbookHistoryDBStorage)
+	}
+
+	history, err := m.GetOrderbookHistory("HistoryOne", p, asset.Spot, first.Add(-time.Hour), second, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+
+	if len(history) != 2 {
+		t.Fatalf("expected %v snapshots but received %v", 2, len(history))
+	}
+
+	if !history[0].LastUpdated.Equal(first) || !history[1].LastUpdated.Equal(second) {
+		t.Fatal("unexpected snapshot ordering")
+	}
+
+	if history[0].Bids[1].Price != 99 || history[0].Asks[1].Amount != 2 {
+		t.Fatal("unexpected snapshot levels")
+	}
+
+	if history[1].Bids[0].Amount != 5 {
+		t.Fatal("unexpected snapshot levels")
+	}
+
+	history, err = m.GetOrderbookHistory("HistoryOne", p, asset.Spot, second, second.Add(time.Hour), false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+
+	if len(history) != 1 {
+		t.Fatalf("expected %v snapshots but received %v", 1, len(history))
+	}
+}
+
+func TestOrderbookHistoryWatch(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.BTC, currency.USD)
+	em := &fakeConsolidatedExchangeManager{
+		exchs: []exchange.IBotExchange{
+			&fakeOrderbookHistoryExchange{fakeConsolidatedExchange{name: "HistoryWatch", pairs: currency.Pairs{p}}},
+		},
+	}
+	m, err := SetupOrderbookHistoryManager(em,
+		nil,
+		&config.OrderbookHistoryManager{FileStorage: true},
+		t.TempDir())
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+
+	depth, err := orderbook.DeployDepth("HistoryWatch", p, asset.Spot)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+
+	err = m.Start()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+
+	for i := 0; i < 3; i++ {
+		depth.LoadSnapshot(
+			[]orderbook.Item{{Price: 100, Amount: float64(i + 1)}},
+			[]orderbook.Item{{Price: 101, Amount: 1}},
+			0, time.Now(), true)
+		time.Sleep(time.Millisecond * 10)
+	}
+
+	err = m.Stop()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+
+	history, err := ReadOrderbookHistory(m.directory, "HistoryWatch", p, asset.Spot, time.Now().Add(-time.Hour), time.Now())
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+
+	if len(history) == 0 {
+		t.Fatal("expected orderbook updates to be captured")
+	}
+}
+
+func TestReadOrderbookHistory(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.BTC, currency.USD)
+	_, err := ReadOrderbookHistory("", "test", p, asset.Spot, time.Now().Add(-time.Hour), time.Now())
+	if !errors.Is(err, errDirectoryUnset) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errDirectoryUnset)
+	}
+
+	dir := t.TempDir()
+	_, err = ReadOrderbookHistory(dir, "", p, asset.Spot, time.Now().Add(-time.Hour), time.Now())
+	if !errors.Is(err, errExchangeNameUnset) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errExchangeNameUnset)
+	}
+
+	_, err = ReadOrderbookHistory(dir, "test", currency.Pair{}, asset.Spot, time.Now().Add(-time.Hour), time.Now())
+	if !errors.Is(err, errCurrencyPairUnset) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errCurrencyPairUnset)
+	}
+
+	_, err = ReadOrderbookHistory(dir, "test", p, asset.Item("bad"), time.Now().Add(-time.Hour), time.Now())
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, asset.ErrNotSupported)
+	}
+
+	history, err := ReadOrderbookHistory(dir, "test", p, asset.Spot, time.Now().Add(-time.Hour*72), time.Now())
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+
+	if len(history) != 0 {
+		t.Fatal("expected no history")
+	}
+}
+
+func TestPruneOrderbookHistory(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	p := currency.NewPair(currency.BTC, currency.USD)
+	now := time.Now().UTC()
+	old := now.AddDate(0, 0, -10)
+	_, err := writeOrderbookHistory(dir, []orderbooksnapshot.Snapshot{
+		{Exchange: "test", Base: "BTC", Quote: "USD", Asset: "spot", Timestamp: old},
+		{Exchange: "test", Base: "BTC", Quote: "USD", Asset: "spot", Timestamp: now},
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+
+	removed, err := pruneOrderbookHistory(dir, now.AddDate(0, 0, -1))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+
+	if removed != 1 {
+		t.Fatalf("expected %v removed files but received %v", 1, removed)
+	}
+
+	path := orderbookHistoryPath(dir, "test", p, asset.Spot)
+	if _, err = os.Stat(filepath.Join(path, now.Format(orderbookHistoryDateFormat)+orderbookHistoryFileExtension)); err != nil {
+		t.Fatal(err)
+	}
+
+	removed, err = pruneOrderbookHistory(filepath.Join(dir, "missing"), now)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+
+	if removed != 0 {
+		t.Fatalf("expected %v removed files but received %v", 0, removed)
+	}
+}
+
+var errSnapshotInsert = errors.New("snapshot insert error")
+
+type fakeOrderbookSnapshotDB struct {
+	orderbooksnapshot.IDBService
+	err      error
+	inserted int
+}
+
+func (f *fakeOrderbookSnapshotDB) Insert(snapshots ...*orderbooksnapshot.Snapshot) error {
+	if f.err != nil {
+		return f.err
+	}
+	f.inserted += len(snapshots)
+	return nil
+}
+
+func TestOrderbookHistoryFlushRetry(t *testing.T) {
+	t.Parallel()
+	dir := t.TempDir()
+	// A file in place of the storage directory causes file writes to fail
+	blocked := filepath.Join(dir, "blocked")
+	err := os.WriteFile(blocked, nil, 0600)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	db := &fakeOrderbookSnapshotDB{err: errSnapshotInsert}
+	m := &OrderbookHistoryManager{
+		fileStorage:     true,
+		databaseStorage: true,
+		directory:       blocked,
+		dbService:       db,
+		maxRetry:        orderbookHistoryMaxRetry,
+	}
+	now := time.Now().UTC()
+	m.pending = []orderbooksnapshot.Snapshot{
+		{Exchange: "test", Base: "BTC", Quote: "USD", Asset: "spot", Timestamp: now},
+	}
+	// Both storages fail and are reported together
+	if err = m.flush(); err == nil {
+		t.Fatal("expected flush error")
+	}
+	if len(m.fileRetry) != 1 || len(m.databaseRetry) != 1 {
+		t.Fatalf("expected failed snapshots to be queued again but received %v file and %v database", len(m.fileRetry), len(m.databaseRetry))
+	}
+
+	// Recovered file storage is retried without affecting the database queue
+	m.directory = dir
+	m.pending = []orderbooksnapshot.Snapshot{
+		{Exchange: "test", Base: "BTC", Quote: "USD", Asset: "spot", Timestamp: now.Add(time.Second)},
+	}
+	if err = m.flush(); err == nil {
+		t.Fatal("expected database insert error")
+	}
+	if len(m.fileRetry) != 0 || len(m.databaseRetry) != 2 {
+		t.Fatalf("expected %v file and %v database retries but received %v and %v", 0, 2, len(m.fileRetry), len(m.databaseRetry))
+	}
+	history, err := ReadOrderbookHistory(dir, "test", currency.NewPair(currency.BTC, currency.USD), asset.Spot, now.Add(-time.Minute), now.Add(time.Minute))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if len(history) != 2 {
+		t.Fatalf("expected %v snapshots but received %v", 2, len(history))
+	}
+
+	// Retries are limited to the most recent snapshots
+	m.maxRetry = 2
+	m.pending = []orderbooksnapshot.Snapshot{
+		{Exchange: "test", Base: "BTC", Quote: "USD", Asset: "spot", Timestamp: now.Add(time.Second * 2)},
+	}
+	if err = m.flush(); err == nil {
+		t.Fatal("expected database insert error")
+	}
+	if len(m.databaseRetry) != 2 {
+		t.Fatalf("expected %v database retries but received %v", 2, len(m.databaseRetry))
+	}
+	if !m.databaseRetry[1].Timestamp.Equal(now.Add(time.Second * 2)) {
+		t.Fatal("expected the oldest snapshot to be dropped")
+	}
+
+	db.err = nil
+	err = m.flush()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if db.inserted != 2 || len(m.databaseRetry) != 0 {
+		t.Fatalf("expected %v inserted snapshots but received %v", 2, db.inserted)
+	}
+}
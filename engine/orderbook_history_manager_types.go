@@ -0,0 +1,101 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/orderbooksnapshot"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+const (
+	// OrderbookHistoryManagerName is an exported subsystem name
+	OrderbookHistoryManagerName = "orderbook_history_manager"
+	// DefaultOrderbookHistoryDiscoveryDelay defines how often the manager
+	// checks for newly available exchange orderbooks to capture
+	DefaultOrderbookHistoryDiscoveryDelay = time.Second * 10
+	// DefaultOrderbookHistoryFlushInterval defines how often captured
+	// snapshots are written to storage when not configured
+	DefaultOrderbookHistoryFlushInterval = time.Second * 10
+	// DefaultOrderbookHistoryRetentionDelay defines how often expired
+	// snapshots are removed from storage
+	DefaultOrderbookHistoryRetentionDelay = time.Hour
+
+	orderbookHistoryDirectory     = "orderbookhistory"
+	orderbookHistoryDateFormat    = "2006-01-02"
+	orderbookHistoryFileExtension = ".jsonl.gz"
+	// orderbookHistoryMaxRetry limits the number of snapshots held for each
+	// storage after failed flushes, the oldest are dropped when exceeded
+	orderbookHistoryMaxRetry = 100000
+)
+
+var (
+	errNilOrderbookHistoryConfig   = errors.New("orderbook history config is nil")
+	errOrderbookHistoryNoStorage   = errors.New("no orderbook history storage enabled")
+	errOrderbookHistoryNoDatabase  = errors.New("orderbook history database storage requires a database connection")
+	errOrderbookHistoryFileStorage = errors.New("orderbook history file storage is not enabled")
+	errOrderbookHistoryDBStorage   = errors.New("orderbook history database storage is not enabled")
+	errDirectoryUnset              = errors.New("directory unset")
+)
+
+// OrderbookHistoryManager captures orderbook depth snapshots to compressed
+// files and/or the database
+type OrderbookHistoryManager struct {
+	started  int32
+	shutdown chan struct{}
+	wg       sync.WaitGroup
+	iExchangeManager
+	dbService orderbooksnapshot.IDBService
+
+	verbose         bool
+	depth           int
+	interval        time.Duration
+	flushInterval   time.Duration
+	retentionPeriod time.Duration
+	fileStorage     bool
+	databaseStorage bool
+	directory       string
+	exchanges       []string
+
+	books map[orderbookHistoryKey]*orderbookHistoryBook
+	m     sync.Mutex
+
+	pending []orderbooksnapshot.Snapshot
+	// Snapshots which failed to be written are retried on the next flush,
+	// each storage is tracked separately so a failure in one does not
+	// duplicate snapshots in the other
+	fileRetry     []orderbooksnapshot.Snapshot
+	databaseRetry []orderbooksnapshot.Snapshot
+	maxRetry      int
+	pendingM      sync.Mutex
+}
+
+// orderbookHistoryKey defines the lookup for a captured orderbook
+type orderbookHistoryKey struct {
+	Exchange string
+	Base     *currency.Item
+	Quote    *currency.Item
+	Asset    asset.Item
+}
+
+// orderbookHistoryBook holds a captured exchange depth and the update time of
+// its most recent snapshot
+type orderbookHistoryBook struct {
+	exchange    string
+	pair        currency.Pair
+	asset       asset.Item
+	depth       *orderbook.Depth
+	lastUpdated time.Time
+	m           sync.Mutex
+}
+
+// orderbookHistoryRecord is the compact line format used for file storage,
+// levels are stored as price and amount pairs
+type orderbookHistoryRecord struct {
+	Timestamp time.Time    `json:"t"`
+	Bids      [][2]float64 `json:"b"`
+	Asks      [][2]float64 `json:"a"`
+}
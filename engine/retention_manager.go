@@ -0,0 +1,604 @@
+package engine
+
+import (
+	"compress/gzip"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/candle"
+	tradesql "github.com/thrasher-corp/gocryptotrader/database/repository/trade"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetupRetentionManager applies configuration parameters before running
+func SetupRetentionManager(dcm iDatabaseConnectionManager, cfg *config.RetentionManager, dataDir string) (*RetentionManager, error) {
+	if dcm == nil {
+		return nil, errNilDatabaseConnectionManager
+	}
+	if cfg == nil {
+		return nil, errNilRetentionConfig
+	}
+	if len(cfg.Policies) == 0 {
+		return nil, errNoRetentionPolicies
+	}
+	m := &RetentionManager{
+		shutdown:                   make(chan struct{}),
+		iDatabaseConnectionManager: dcm,
+		verbose:                    cfg.Verbose,
+		checkInterval:              cfg.CheckInterval,
+		dryRun:                     cfg.DryRun,
+		policies:                   cfg.Policies,
+		listCandleSeries:           candle.ListSeries,
+		listTradeSeries:            tradesql.ListSeries,
+		loadCandles:                kline.LoadFromDatabase,
+		loadTrades:                 tradesql.GetInRange,
+		saveCandles:                kline.StoreInDatabase,
+		deleteCandles:              candle.DeleteCandles,
+		deleteTrades:               tradesql.DeleteTrades,
+		now:                        time.Now,
+	}
+	if m.checkInterval <= 0 {
+		m.checkInterval = time.Hour * 24
+	}
+	for i := range cfg.Policies {
+		if !cfg.Policies[i].Archive {
+			continue
+		}
+		m.archiveDirectory = cfg.ArchiveDirectory
+		if m.archiveDirectory == "" {
+			if dataDir == "" {
+				return nil, errDirectoryUnset
+			}
+			m.archiveDirectory = filepath.Join(dataDir, retentionArchiveDirectory)
+		}
+		break
+	}
+	return m, nil
+}
+
+// Start runs the subsystem, policies are applied immediately and then on
+// every check interval
+func (m *RetentionManager) Start() error {
+	if m == nil {
+		return fmt.Errorf("%s %w", RetentionManagerName, ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 0, 1) {
+		return fmt.Errorf("%s %w", RetentionManagerName, ErrSubSystemAlreadyStarted)
+	}
+	log.Debugf(log.DatabaseMgr, "Retention manager %s", MsgSubSystemStarting)
+	m.wg.Add(1)
+	go m.run()
+	log.Debugf(log.DatabaseMgr, "Retention manager %s", MsgSubSystemStarted)
+	return nil
+}
+
+// Stop stops the subsystem, waiting for any run in progress to complete
+func (m *RetentionManager) Stop() error {
+	if m == nil {
+		return fmt.Errorf("%s %w", RetentionManagerName, ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return fmt.Errorf("%s %w", RetentionManagerName, ErrSubSystemNotStarted)
+	}
+	log.Debugf(log.DatabaseMgr, "Retention manager %s", MsgSubSystemShuttingDown)
+	close(m.shutdown)
+	m.wg.Wait()
+	m.shutdown = make(chan struct{})
+	atomic.StoreInt32(&m.started, 0)
+	log.Debugf(log.DatabaseMgr, "Retention manager %s", MsgSubSystemShutdown)
+	return nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (m *RetentionManager) IsRunning() bool {
+	if m == nil {
+		return false
+	}
+	return atomic.LoadInt32(&m.started) == 1
+}
+
+func (m *RetentionManager) run() {
+	defer m.wg.Done()
+	t := time.NewTicker(m.checkInterval)
+	defer t.Stop()
+	for {
+		report, err := m.Run(m.dryRun)
+		if err != nil {
+			log.Errorf(log.DatabaseMgr, "Retention manager run error: %v", err)
+		} else {
+			m.logReport(report)
+		}
+		select {
+		case <-m.shutdown:
+			return
+		case <-t.C:
+		}
+	}
+}
+
+// logReport logs the outcome of each series a run applied a policy to
+func (m *RetentionManager) logReport(report *RetentionReport) {
+	for i := range report.Results {
+		r := &report.Results[i]
+		if r.Error != "" {
+			log.Errorf(log.DatabaseMgr,
+				"Retention manager %s %s %s %s %s error: %s",
+				r.DataType, r.Exchange, r.Asset, r.Pair, r.Interval, r.Error)
+			continue
+		}
+		if !m.verbose {
+			continue
+		}
+		log.Debugf(log.DatabaseMgr,
+			"Retention manager %s %s %s %s %s dry run: %v expired: %d downsampled: %d archived: %d deleted: %d",
+			r.DataType, r.Exchange, r.Asset, r.Pair, r.Interval,
+			report.DryRun, r.Expired, r.Downsampled, r.Archived, r.Deleted)
+	}
+}
+
+// Run applies the retention policies to all stored candle and trade series.
+// When dryRun is set nothing is written or deleted and the report contains
+// the counts which would have been affected
+func (m *RetentionManager) Run(dryRun bool) (*RetentionReport, error) {
+	if m == nil {
+		return nil, fmt.Errorf("%s %w", RetentionManagerName, ErrNilSubsystem)
+	}
+	db := m.GetInstance()
+	if db == nil || !db.IsConnected() {
+		return nil, database.ErrDatabaseNotConnected
+	}
+	if !atomic.CompareAndSwapInt32(&m.inRun, 0, 1) {
+		return nil, errRetentionRunInProgress
+	}
+	defer atomic.StoreInt32(&m.inRun, 0)
+
+	now := m.now().UTC()
+	report := &RetentionReport{
+		DryRun: dryRun,
+		Start:  now,
+	}
+	if m.hasPolicy(config.RetentionCandles) {
+		series, err := m.listCandleSeries()
+		if err != nil {
+			return nil, err
+		}
+		for i := range series {
+			pair, err := currency.NewPairFromStrings(series[i].Base, series[i].Quote)
+			if err != nil {
+				return nil, err
+			}
+			a := asset.Item(strings.ToLower(series[i].Asset))
+			interval := kline.Interval(time.Duration(series[i].Interval) * time.Second)
+			idx, ok := m.matchPolicy(config.RetentionCandles, series[i].Exchange, a, pair, interval)
+			if !ok || m.policies[idx].MaxAge == 0 {
+				continue
+			}
+			cutoff := retentionCutoff(&m.policies[idx], now)
+			if !series[i].Oldest.Before(cutoff) {
+				continue
+			}
+			result := RetentionResult{
+				Policy:   idx,
+				DataType: config.RetentionCandles,
+				Exchange: series[i].Exchange,
+				Asset:    a,
+				Pair:     pair,
+				Interval: interval,
+				Cutoff:   cutoff,
+			}
+			if err = m.applyCandlePolicy(&m.policies[idx], &series[i], &result, dryRun); err != nil {
+				result.Error = err.Error()
+			}
+			report.Results = append(report.Results, result)
+		}
+	}
+	if m.hasPolicy(config.RetentionTrades) {
+		series, err := m.listTradeSeries()
+		if err != nil {
+			return nil, err
+		}
+		for i := range series {
+			pair, err := currency.NewPairFromStrings(series[i].Base, series[i].Quote)
+			if err != nil {
+				return nil, err
+			}
+			a := asset.Item(strings.ToLower(series[i].AssetType))
+			idx, ok := m.matchPolicy(config.RetentionTrades, series[i].Exchange, a, pair, 0)
+			if !ok || m.policies[idx].MaxAge == 0 {
+				continue
+			}
+			cutoff := retentionCutoff(&m.policies[idx], now)
+			if !series[i].Oldest.Before(cutoff) {
+				continue
+			}
+			result := RetentionResult{
+				Policy:   idx,
+				DataType: config.RetentionTrades,
+				Exchange: series[i].Exchange,
+				Asset:    a,
+				Pair:     pair,
+				Cutoff:   cutoff,
+			}
+			if err = m.applyTradePolicy(&m.policies[idx], &series[i], &result, dryRun); err != nil {
+				result.Error = err.Error()
+			}
+			report.Results = append(report.Results, result)
+		}
+	}
+	report.End = m.now().UTC()
+	return report, nil
+}
+
+// hasPolicy returns whether any policy applies to the data type
+func (m *RetentionManager) hasPolicy(dataType string) bool {
+	for i := range m.policies {
+		if m.policies[i].DataType == dataType {
+			return true
+		}
+	}
+	return false
+}
+
+// matchPolicy returns the index of the first policy which applies to a
+// stored series
+func (m *RetentionManager) matchPolicy(dataType, exch string, a asset.Item, p currency.Pair, interval kline.Interval) (int, bool) {
+	for i := range m.policies {
+		policy := &m.policies[i]
+		if policy.DataType != dataType ||
+			(policy.Exchange != "" && !strings.EqualFold(policy.Exchange, exch)) ||
+			(policy.Asset != "" && policy.Asset != a) ||
+			(!policy.Pair.IsEmpty() && !policy.Pair.Equal(p)) ||
+			(policy.Interval != 0 && policy.Interval != interval.Duration()) {
+			continue
+		}
+		return i, true
+	}
+	return -1, false
+}
+
+// retentionCutoff returns the time before which data is expired. When
+// downsampling the cutoff is aligned to the downsample interval so that a
+// downsampled candle is never built from a partial interval
+func retentionCutoff(policy *config.RetentionPolicy, now time.Time) time.Time {
+	cutoff := now.Add(-policy.MaxAge)
+	if policy.DownsampleInterval > 0 {
+		cutoff = cutoff.Truncate(policy.DownsampleInterval)
+	}
+	return cutoff
+}
+
+// retentionChunk returns the duration of expired data processed at once,
+// a day or the nearest multiple of the downsample interval
+func retentionChunk(policy *config.RetentionPolicy) time.Duration {
+	const day = time.Hour * 24
+	if policy.DownsampleInterval <= 0 {
+		return day
+	}
+	if policy.DownsampleInterval >= day {
+		return policy.DownsampleInterval
+	}
+	return policy.DownsampleInterval * (day / policy.DownsampleInterval)
+}
+
+// applyCandlePolicy downsamples, archives and deletes the expired candles of
+// a stored series one chunk at a time
+func (m *RetentionManager) applyCandlePolicy(policy *config.RetentionPolicy, series *candle.StoredSeries, result *RetentionResult, dryRun bool) error {
+	downsample := kline.Interval(policy.DownsampleInterval)
+	if downsample > 0 &&
+		(downsample <= result.Interval || downsample.Duration()%result.Interval.Duration() != 0) {
+		return fmt.Errorf("%w: %s to %s", errRetentionDownsampleInterval, result.Interval, downsample)
+	}
+	chunk := retentionChunk(policy)
+	for start := series.Oldest.UTC().Truncate(chunk); start.Before(result.Cutoff); start = start.Add(chunk) {
+		end := start.Add(chunk)
+		if end.After(result.Cutoff) {
+			end = result.Cutoff
+		}
+		item, err := m.loadCandles(series.Exchange, result.Pair, result.Asset, result.Interval, start, end)
+		if err != nil {
+			if errors.Is(err, candle.ErrNoCandleDataFound) {
+				continue
+			}
+			return err
+		}
+		item.RemoveOutsideRange(start, end)
+		if len(item.Candles) == 0 {
+			continue
+		}
+		item.SortCandlesByTimestamp(false)
+		result.Expired += int64(len(item.Candles))
+
+		var downsampled *kline.Item
+		if downsample > 0 {
+			downsampled, err = downsampleCandles(&item, downsample)
+			if err != nil {
+				return err
+			}
+			result.Downsampled += int64(len(downsampled.Candles))
+		}
+		if policy.Archive {
+			if !dryRun {
+				if err = m.archiveCandles(&item); err != nil {
+					return err
+				}
+			}
+			result.Archived += int64(len(item.Candles))
+		}
+		if dryRun {
+			result.Deleted += int64(len(item.Candles))
+			continue
+		}
+		if downsampled != nil && len(downsampled.Candles) > 0 {
+			if _, err = m.saveCandles(downsampled, false); err != nil {
+				return err
+			}
+		}
+		deleted, err := m.deleteCandles(&candle.Item{
+			ExchangeID: series.ExchangeID,
+			Base:       series.Base,
+			Quote:      series.Quote,
+			Interval:   series.Interval,
+			Asset:      series.Asset,
+			Candles: []candle.Candle{
+				{Timestamp: item.Candles[0].Time},
+				{Timestamp: item.Candles[len(item.Candles)-1].Time},
+			},
+		})
+		if err != nil {
+			return err
+		}
+		result.Deleted += deleted
+	}
+	return nil
+}
+
+// applyTradePolicy downsamples, archives and deletes the expired trades of
+// a stored series one chunk at a time
+func (m *RetentionManager) applyTradePolicy(policy *config.RetentionPolicy, series *tradesql.StoredSeries, result *RetentionResult, dryRun bool) error {
+	chunk := retentionChunk(policy)
+	for start := series.Oldest.UTC().Truncate(chunk); start.Before(result.Cutoff); start = start.Add(chunk) {
+		end := start.Add(chunk)
+		if end.After(result.Cutoff) {
+			end = result.Cutoff
+		}
+		loaded, err := m.loadTrades(series.Exchange, series.AssetType, series.Base, series.Quote, start, end)
+		if err != nil {
+			return err
+		}
+		trades := loaded[:0]
+		for i := range loaded {
+			if loaded[i].Timestamp.Before(start) || !loaded[i].Timestamp.Before(end) {
+				continue
+			}
+			trades = append(trades, loaded[i])
+		}
+		if len(trades) == 0 {
+			continue
+		}
+		sort.Slice(trades, func(i, j int) bool {
+			return trades[i].Timestamp.Before(trades[j].Timestamp)
+		})
+		result.Expired += int64(len(trades))
+
+		var downsampled kline.Item
+		if policy.DownsampleInterval > 0 {
+			data := make([]trade.Data, len(trades))
+			for i := range trades {
+				data[i] = trade.Data{
+					Exchange:     series.Exchange,
+					CurrencyPair: result.Pair,
+					AssetType:    result.Asset,
+					Price:        trades[i].Price,
+					Amount:       trades[i].Amount,
+					Timestamp:    trades[i].Timestamp.UTC(),
+				}
+			}
+			downsampled, err = trade.ConvertTradesToCandles(kline.Interval(policy.DownsampleInterval), data...)
+			if err != nil {
+				return err
+			}
+			downsampled.SortCandlesByTimestamp(false)
+			result.Downsampled += int64(len(downsampled.Candles))
+		}
+		if policy.Archive {
+			if !dryRun {
+				if err = m.archiveTrades(series.Exchange, result.Asset, result.Pair, trades); err != nil {
+					return err
+				}
+			}
+			result.Archived += int64(len(trades))
+		}
+		if dryRun {
+			result.Deleted += int64(len(trades))
+			continue
+		}
+		if len(downsampled.Candles) > 0 {
+			if _, err = m.saveCandles(&downsampled, false); err != nil {
+				return err
+			}
+		}
+		for i := 0; i < len(trades); i += retentionDeleteBatchSize {
+			j := i + retentionDeleteBatchSize
+			if j > len(trades) {
+				j = len(trades)
+			}
+			if err = m.deleteTrades(trades[i:j]...); err != nil {
+				return err
+			}
+			result.Deleted += int64(j - i)
+		}
+	}
+	return nil
+}
+
+// downsampleCandles converts sorted candles into candles of a larger
+// interval. Candles missing from an interval which has data are filled with
+// flat candles without volume so that every converted candle is complete
+func downsampleCandles(item *kline.Item, interval kline.Interval) (*kline.Item, error) {
+	resp := &kline.Item{
+		Exchange: item.Exchange,
+		Pair:     item.Pair,
+		Asset:    item.Asset,
+		Interval: interval,
+	}
+	perInterval := int(interval.Duration() / item.Interval.Duration())
+	for i := 0; i < len(item.Candles); {
+		start := item.Candles[i].Time.Truncate(interval.Duration())
+		slots := make([]*kline.Candle, perInterval)
+		for ; i < len(item.Candles) && item.Candles[i].Time.Truncate(interval.Duration()).Equal(start); i++ {
+			slot := int(item.Candles[i].Time.Sub(start) / item.Interval.Duration())
+			slots[slot] = &item.Candles[i]
+		}
+		bundle := &kline.Item{
+			Exchange: item.Exchange,
+			Pair:     item.Pair,
+			Asset:    item.Asset,
+			Interval: item.Interval,
+			Candles:  make([]kline.Candle, perInterval),
+		}
+		var last *kline.Candle
+		for j := range slots {
+			if slots[j] != nil {
+				last = slots[j]
+				bundle.Candles[j] = *slots[j]
+				continue
+			}
+			price := 0.0
+			if last != nil {
+				price = last.Close
+			} else {
+				for k := j + 1; k < len(slots); k++ {
+					if slots[k] != nil {
+						price = slots[k].Open
+						break
+					}
+				}
+			}
+			bundle.Candles[j] = kline.Candle{
+				Time:  start.Add(item.Interval.Duration() * time.Duration(j)),
+				Open:  price,
+				High:  price,
+				Low:   price,
+				Close: price,
+			}
+		}
+		converted, err := kline.ConvertToNewInterval(bundle, interval)
+		if err != nil {
+			return nil, err
+		}
+		resp.Candles = append(resp.Candles, converted.Candles...)
+	}
+	return resp, nil
+}
+
+// archiveCandles appends candles to daily compressed files in the format
+// accepted by the candle CSV importer
+func (m *RetentionManager) archiveCandles(item *kline.Item) error {
+	if m.archiveDirectory == "" {
+		return errRetentionArchiveDirectoryNil
+	}
+	dir := filepath.Join(m.archiveDirectory,
+		config.RetentionCandles,
+		strings.ToLower(item.Exchange),
+		item.Asset.String(),
+		retentionPairDirectory(item.Pair),
+		item.Interval.Short())
+	days := make(map[string][][]string)
+	var order []string
+	for i := range item.Candles {
+		c := &item.Candles[i]
+		day := c.Time.UTC().Format("2006-01-02")
+		if _, ok := days[day]; !ok {
+			order = append(order, day)
+		}
+		days[day] = append(days[day], []string{
+			strconv.FormatInt(c.Time.Unix(), 10),
+			strconv.FormatFloat(c.Volume, 'f', -1, 64),
+			strconv.FormatFloat(c.Open, 'f', -1, 64),
+			strconv.FormatFloat(c.High, 'f', -1, 64),
+			strconv.FormatFloat(c.Low, 'f', -1, 64),
+			strconv.FormatFloat(c.Close, 'f', -1, 64),
+		})
+	}
+	for i := range order {
+		if err := appendRetentionArchive(dir, order[i], days[order[i]]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// archiveTrades appends trades to daily compressed files in the format
+// accepted by the trade CSV importer followed by the exchange trade ID
+func (m *RetentionManager) archiveTrades(exch string, a asset.Item, p currency.Pair, trades []tradesql.Data) error {
+	if m.archiveDirectory == "" {
+		return errRetentionArchiveDirectoryNil
+	}
+	dir := filepath.Join(m.archiveDirectory,
+		config.RetentionTrades,
+		strings.ToLower(exch),
+		a.String(),
+		retentionPairDirectory(p))
+	days := make(map[string][][]string)
+	var order []string
+	for i := range trades {
+		day := trades[i].Timestamp.UTC().Format("2006-01-02")
+		if _, ok := days[day]; !ok {
+			order = append(order, day)
+		}
+		days[day] = append(days[day], []string{
+			strconv.FormatInt(trades[i].Timestamp.Unix(), 10),
+			strconv.FormatFloat(trades[i].Price, 'f', -1, 64),
+			strconv.FormatFloat(trades[i].Amount, 'f', -1, 64),
+			trades[i].Side,
+			trades[i].TID,
+		})
+	}
+	for i := range order {
+		if err := appendRetentionArchive(dir, order[i], days[order[i]]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// retentionPairDirectory returns the archive folder name of a currency pair
+func retentionPairDirectory(p currency.Pair) string {
+	return p.Base.Upper().String() + "-" + p.Quote.Upper().String()
+}
+
+// appendRetentionArchive appends rows to a day's archive file as a new gzip
+// member, gzip readers treat the concatenated members as a single stream
+func appendRetentionArchive(dir, day string, rows [][]string) error {
+	if err := common.CreateDir(dir); err != nil {
+		return err
+	}
+	f, err := os.OpenFile(filepath.Join(dir, day+".csv.gz"), os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0600)
+	if err != nil {
+		return err
+	}
+	zw := gzip.NewWriter(f)
+	err = csv.NewWriter(zw).WriteAll(rows)
+	if closeErr := zw.Close(); err == nil {
+		err = closeErr
+	}
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
@@ -0,0 +1,425 @@
+package engine
+
+import (
+	"compress/gzip"
+	"encoding/csv"
+	"errors"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/candle"
+	tradesql "github.com/thrasher-corp/gocryptotrader/database/repository/trade"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+var retentionTestStart = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+
+type fakeConnectedDatabaseManager struct{}
+
+func (f *fakeConnectedDatabaseManager) GetInstance() database.IDatabase {
+	dbInst := &database.Instance{}
+	dbInst.SetConnected(true)
+	return dbInst
+}
+
+// retentionTestStore records writes made by the retention manager
+type retentionTestStore struct {
+	saved         []*kline.Item
+	deleted       []*candle.Item
+	deletedTrades int
+}
+
+func setupRetentionTest(t *testing.T) (*RetentionManager, *retentionTestStore) {
+	t.Helper()
+	store := &retentionTestStore{}
+	m, err := SetupRetentionManager(&fakeConnectedDatabaseManager{}, &config.RetentionManager{
+		Policies: []config.RetentionPolicy{
+			{DataType: config.RetentionCandles, Interval: kline.OneDay.Duration()},
+			{DataType: config.RetentionCandles, MaxAge: kline.OneDay.Duration(), DownsampleInterval: kline.OneHour.Duration(), Archive: true},
+			{DataType: config.RetentionTrades, Exchange: testExchange, MaxAge: kline.OneDay.Duration(), DownsampleInterval: kline.OneMin.Duration(), Archive: true},
+		},
+	}, t.TempDir())
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	m.now = func() time.Time {
+		return retentionTestStart.AddDate(0, 0, 10)
+	}
+	m.listCandleSeries = func() ([]candle.StoredSeries, error) {
+		return []candle.StoredSeries{
+			{Exchange: testExchange, Base: "BTC", Quote: "USD", Interval: 60, Asset: "spot", Oldest: retentionTestStart},
+			{Exchange: testExchange, Base: "BTC", Quote: "USD", Interval: 86400, Asset: "spot", Oldest: retentionTestStart},
+			{Exchange: testExchange, Base: "ETH", Quote: "USD", Interval: 60, Asset: "spot", Oldest: retentionTestStart.AddDate(0, 0, 10)},
+		}, nil
+	}
+	m.listTradeSeries = func() ([]tradesql.StoredSeries, error) {
+		return []tradesql.StoredSeries{
+			{Exchange: testExchange, Base: "BTC", Quote: "USD", AssetType: "spot", Oldest: retentionTestStart},
+			{Exchange: "binance", Base: "BTC", Quote: "USD", AssetType: "spot", Oldest: retentionTestStart},
+		}, nil
+	}
+	m.loadCandles = func(exch string, p currency.Pair, a asset.Item, i kline.Interval, start, end time.Time) (kline.Item, error) {
+		if i != kline.OneMin || !start.Equal(retentionTestStart) {
+			return kline.Item{}, candle.ErrNoCandleDataFound
+		}
+		item := kline.Item{Exchange: exch, Pair: p, Asset: a, Interval: i}
+		for x := 0; x < 120; x++ {
+			if x == 0 || x == 30 || x == 61 {
+				continue
+			}
+			item.Candles = append(item.Candles, kline.Candle{
+				Time:   retentionTestStart.Add(time.Minute * time.Duration(x)),
+				Open:   float64(x),
+				High:   float64(x) + 2,
+				Low:    float64(x) - 1,
+				Close:  float64(x) + 1,
+				Volume: 1,
+			})
+		}
+		return item, nil
+	}
+	m.loadTrades = func(exch, a, base, quote string, start, end time.Time) ([]tradesql.Data, error) {
+		if !start.Equal(retentionTestStart) {
+			return nil, nil
+		}
+		return []tradesql.Data{
+			{ID: "1", TID: "a", Exchange: exch, Base: base, Quote: quote, AssetType: a, Price: 10, Amount: 1, Side: "BUY", Timestamp: retentionTestStart},
+			{ID: "2", TID: "b", Exchange: exch, Base: base, Quote: quote, AssetType: a, Price: 12, Amount: 2, Side: "SELL", Timestamp: retentionTestStart.Add(time.Second * 30)},
+			{ID: "3", TID: "c", Exchange: exch, Base: base, Quote: quote, AssetType: a, Price: 11, Amount: 1, Side: "BUY", Timestamp: retentionTestStart.Add(time.Minute)},
+			{ID: "4", TID: "d", Exchange: exch, Base: base, Quote: quote, AssetType: a, Price: 11, Amount: 1, Side: "BUY", Timestamp: end},
+		}, nil
+	}
+	m.saveCandles = func(item *kline.Item, _ bool) (uint64, error) {
+		store.saved = append(store.saved, item)
+		return uint64(len(item.Candles)), nil
+	}
+	m.deleteCandles = func(item *candle.Item) (int64, error) {
+		store.deleted = append(store.deleted, item)
+		return 117, nil
+	}
+	m.deleteTrades = func(trades ...tradesql.Data) error {
+		store.deletedTrades += len(trades)
+		return nil
+	}
+	return m, store
+}
+
+func TestSetupRetentionManager(t *testing.T) {
+	t.Parallel()
+	_, err := SetupRetentionManager(nil, nil, "")
+	if !errors.Is(err, errNilDatabaseConnectionManager) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errNilDatabaseConnectionManager)
+	}
+
+	_, err = SetupRetentionManager(&fakeDatabaseConnectionManager{}, nil, "")
+	if !errors.Is(err, errNilRetentionConfig) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errNilRetentionConfig)
+	}
+
+	_, err = SetupRetentionManager(&fakeDatabaseConnectionManager{}, &config.RetentionManager{}, "")
+	if !errors.Is(err, errNoRetentionPolicies) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errNoRetentionPolicies)
+	}
+
+	cfg := &config.RetentionManager{
+		Policies: []config.RetentionPolicy{
+			{DataType: config.RetentionTrades, MaxAge: time.Hour},
+			{DataType: config.RetentionCandles, MaxAge: time.Hour, Archive: true},
+		},
+	}
+	_, err = SetupRetentionManager(&fakeDatabaseConnectionManager{}, cfg, "")
+	if !errors.Is(err, errDirectoryUnset) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errDirectoryUnset)
+	}
+
+	m, err := SetupRetentionManager(&fakeDatabaseConnectionManager{}, cfg, "data")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if m.archiveDirectory != filepath.Join("data", retentionArchiveDirectory) {
+		t.Errorf("received: '%v' but expected: '%v'", m.archiveDirectory, filepath.Join("data", retentionArchiveDirectory))
+	}
+	if m.checkInterval != time.Hour*24 {
+		t.Errorf("received: '%v' but expected: '%v'", m.checkInterval, time.Hour*24)
+	}
+
+	cfg.ArchiveDirectory = "archive"
+	m, err = SetupRetentionManager(&fakeDatabaseConnectionManager{}, cfg, "")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if m.archiveDirectory != "archive" {
+		t.Errorf("received: '%v' but expected: '%v'", m.archiveDirectory, "archive")
+	}
+}
+
+func TestRetentionManagerStartStop(t *testing.T) {
+	t.Parallel()
+	err := (*RetentionManager)(nil).Start()
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrNilSubsystem)
+	}
+	err = (*RetentionManager)(nil).Stop()
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrNilSubsystem)
+	}
+	if (*RetentionManager)(nil).IsRunning() {
+		t.Error("expected false")
+	}
+
+	m, err := SetupRetentionManager(&fakeDatabaseConnectionManager{}, &config.RetentionManager{
+		Policies: []config.RetentionPolicy{{DataType: config.RetentionTrades, MaxAge: time.Hour}},
+	}, "")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	err = m.Stop()
+	if !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrSubSystemNotStarted)
+	}
+	err = m.Start()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	err = m.Start()
+	if !errors.Is(err, ErrSubSystemAlreadyStarted) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrSubSystemAlreadyStarted)
+	}
+	if !m.IsRunning() {
+		t.Error("expected true")
+	}
+	err = m.Stop()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+}
+
+func TestRetentionManagerRunDryRun(t *testing.T) {
+	t.Parallel()
+	_, err := (*RetentionManager)(nil).Run(true)
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrNilSubsystem)
+	}
+
+	m, store := setupRetentionTest(t)
+	m.iDatabaseConnectionManager = &fakeDatabaseConnectionManager{}
+	_, err = m.Run(true)
+	if !errors.Is(err, database.ErrDatabaseNotConnected) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, database.ErrDatabaseNotConnected)
+	}
+
+	m.iDatabaseConnectionManager = &fakeConnectedDatabaseManager{}
+	m.inRun = 1
+	_, err = m.Run(true)
+	if !errors.Is(err, errRetentionRunInProgress) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errRetentionRunInProgress)
+	}
+	m.inRun = 0
+
+	report, err := m.Run(true)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if !report.DryRun || len(report.Results) != 2 {
+		t.Fatalf("unexpected report %+v", report)
+	}
+	c := report.Results[0]
+	if c.Policy != 1 || c.DataType != config.RetentionCandles || c.Interval != kline.OneMin ||
+		c.Expired != 117 || c.Downsampled != 2 || c.Archived != 117 || c.Deleted != 117 || c.Error != "" {
+		t.Errorf("unexpected candle result %+v", c)
+	}
+	if !c.Cutoff.Equal(retentionTestStart.AddDate(0, 0, 9)) {
+		t.Errorf("received: '%v' but expected: '%v'", c.Cutoff, retentionTestStart.AddDate(0, 0, 9))
+	}
+	tr := report.Results[1]
+	if tr.Policy != 2 || tr.DataType != config.RetentionTrades || tr.Exchange != testExchange ||
+		tr.Expired != 3 || tr.Downsampled != 2 || tr.Archived != 3 || tr.Deleted != 3 {
+		t.Errorf("unexpected trade result %+v", tr)
+	}
+	if len(store.saved) != 0 || len(store.deleted) != 0 || store.deletedTrades != 0 {
+		t.Error("dry run should not modify stored data")
+	}
+	if _, err = os.Stat(m.archiveDirectory); !os.IsNotExist(err) {
+		t.Errorf("dry run should not archive data, received: '%v'", err)
+	}
+}
+
+func TestRetentionManagerRun(t *testing.T) {
+	t.Parallel()
+	m, store := setupRetentionTest(t)
+	report, err := m.Run(false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if report.DryRun || len(report.Results) != 2 {
+		t.Fatalf("unexpected report %+v", report)
+	}
+	if report.Results[0].Deleted != 117 || report.Results[1].Deleted != 3 {
+		t.Errorf("unexpected results %+v", report.Results)
+	}
+	if len(store.saved) != 2 {
+		t.Fatalf("received: '%v' but expected: '%v'", len(store.saved), 2)
+	}
+	hourly := store.saved[0]
+	if hourly.Interval != kline.OneHour || len(hourly.Candles) != 2 {
+		t.Fatalf("unexpected downsampled candles %+v", hourly)
+	}
+	first := hourly.Candles[0]
+	if !first.Time.Equal(retentionTestStart) || first.Open != 1 || first.High != 61 ||
+		first.Low != 0 || first.Close != 60 || first.Volume != 58 {
+		t.Errorf("unexpected downsampled candle %+v", first)
+	}
+	if len(store.saved[1].Candles) != 2 || store.saved[1].Candles[0].Volume != 3 ||
+		store.saved[1].Candles[0].High != 12 {
+		t.Errorf("unexpected trade candles %+v", store.saved[1])
+	}
+	if len(store.deleted) != 1 ||
+		!store.deleted[0].Candles[0].Timestamp.Equal(retentionTestStart.Add(time.Minute)) ||
+		!store.deleted[0].Candles[1].Timestamp.Equal(retentionTestStart.Add(time.Minute*119)) {
+		t.Errorf("unexpected candle deletion %+v", store.deleted)
+	}
+	if store.deletedTrades != 3 {
+		t.Errorf("received: '%v' but expected: '%v'", store.deletedTrades, 3)
+	}
+
+	rows := readRetentionArchive(t, filepath.Join(m.archiveDirectory,
+		config.RetentionCandles, strings.ToLower(testExchange), "spot", "BTC-USD", "1m", "2020-01-01.csv.gz"))
+	if len(rows) != 117 || rows[0][0] != "1577836860" || rows[0][1] != "1" || rows[0][2] != "1" {
+		t.Errorf("unexpected candle archive %v", rows[0])
+	}
+	rows = readRetentionArchive(t, filepath.Join(m.archiveDirectory,
+		config.RetentionTrades, strings.ToLower(testExchange), "spot", "BTC-USD", "2020-01-01.csv.gz"))
+	if len(rows) != 3 || rows[1][1] != "12" || rows[1][3] != "SELL" || rows[1][4] != "b" {
+		t.Errorf("unexpected trade archive %v", rows)
+	}
+
+	// a second run appends to the existing archive
+	_, err = m.Run(false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	rows = readRetentionArchive(t, filepath.Join(m.archiveDirectory,
+		config.RetentionTrades, strings.ToLower(testExchange), "spot", "BTC-USD", "2020-01-01.csv.gz"))
+	if len(rows) != 6 {
+		t.Errorf("received: '%v' but expected: '%v'", len(rows), 6)
+	}
+}
+
+func TestRetentionManagerRunErrors(t *testing.T) {
+	t.Parallel()
+	m, _ := setupRetentionTest(t)
+	m.policies[1].DownsampleInterval = kline.OneMin.Duration() * 7
+	m.policies[1].MaxAge = kline.OneDay.Duration() * 365
+	m.policies[2].DownsampleInterval = 0
+	errTest := errors.New("test error")
+	m.deleteTrades = func(...tradesql.Data) error {
+		return errTest
+	}
+	report, err := m.Run(false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if len(report.Results) != 1 || report.Results[0].Error != errTest.Error() {
+		t.Errorf("unexpected report %+v", report.Results)
+	}
+
+	m.policies[1].MaxAge = kline.OneDay.Duration()
+	m.policies[1].Interval = 0
+	m.policies[0].Interval = time.Hour
+	report, err = m.Run(true)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if len(report.Results) != 3 || report.Results[1].Interval != kline.OneDay ||
+		report.Results[1].Error == "" {
+		t.Errorf("unexpected report %+v", report.Results)
+	}
+
+	m.listCandleSeries = func() ([]candle.StoredSeries, error) {
+		return nil, errTest
+	}
+	_, err = m.Run(true)
+	if !errors.Is(err, errTest) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errTest)
+	}
+}
+
+func TestDownsampleCandles(t *testing.T) {
+	t.Parallel()
+	item := &kline.Item{
+		Exchange: testExchange,
+		Pair:     currency.NewPair(currency.BTC, currency.USD),
+		Asset:    asset.Spot,
+		Interval: kline.OneMin,
+		Candles: []kline.Candle{
+			{Time: retentionTestStart.Add(time.Minute), Open: 5, High: 8, Low: 4, Close: 6, Volume: 1},
+			{Time: retentionTestStart.Add(time.Minute * 3), Open: 6, High: 7, Low: 3, Close: 4, Volume: 2},
+			{Time: retentionTestStart.Add(time.Minute * 10), Open: 4, High: 9, Low: 4, Close: 9, Volume: 3},
+		},
+	}
+	resp, err := downsampleCandles(item, kline.FiveMin)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if resp.Interval != kline.FiveMin || len(resp.Candles) != 2 {
+		t.Fatalf("unexpected response %+v", resp)
+	}
+	if !resp.Candles[0].Time.Equal(retentionTestStart) || resp.Candles[0].Open != 5 ||
+		resp.Candles[0].High != 8 || resp.Candles[0].Low != 3 || resp.Candles[0].Close != 4 ||
+		resp.Candles[0].Volume != 3 {
+		t.Errorf("unexpected candle %+v", resp.Candles[0])
+	}
+	if !resp.Candles[1].Time.Equal(retentionTestStart.Add(time.Minute*10)) ||
+		resp.Candles[1].Close != 9 || resp.Candles[1].Volume != 3 {
+		t.Errorf("unexpected candle %+v", resp.Candles[1])
+	}
+}
+
+func TestRetentionCutoff(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2021, 6, 15, 13, 47, 0, 0, time.UTC)
+	policy := &config.RetentionPolicy{MaxAge: time.Hour}
+	if c := retentionCutoff(policy, now); !c.Equal(now.Add(-time.Hour)) {
+		t.Errorf("received: '%v' but expected: '%v'", c, now.Add(-time.Hour))
+	}
+	if c := retentionChunk(policy); c != time.Hour*24 {
+		t.Errorf("received: '%v' but expected: '%v'", c, time.Hour*24)
+	}
+	policy.DownsampleInterval = time.Hour
+	if c := retentionCutoff(policy, now); !c.Equal(time.Date(2021, 6, 15, 12, 0, 0, 0, time.UTC)) {
+		t.Errorf("received: '%v' but expected: '%v'", c, time.Date(2021, 6, 15, 12, 0, 0, 0, time.UTC))
+	}
+	policy.DownsampleInterval = time.Minute * 7
+	if c := retentionChunk(policy); c != time.Minute*7*205 {
+		t.Errorf("received: '%v' but expected: '%v'", c, time.Minute*7*205)
+	}
+	policy.DownsampleInterval = time.Hour * 24 * 7
+	if c := retentionChunk(policy); c != policy.DownsampleInterval {
+		t.Errorf("received: '%v' but expected: '%v'", c, policy.DownsampleInterval)
+	}
+}
+
+func readRetentionArchive(t *testing.T, path string) [][]string {
+	t.Helper()
+	f, err := os.Open(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer f.Close()
+	zr, err := gzip.NewReader(f)
+	if err != nil {
+		t.Fatal(err)
+	}
+	rows, err := csv.NewReader(zr).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return rows
+}
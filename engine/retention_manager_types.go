@@ -0,0 +1,89 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/candle"
+	tradesql "github.com/thrasher-corp/gocryptotrader/database/repository/trade"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+const (
+	// RetentionManagerName is an exported subsystem name
+	RetentionManagerName = "retention"
+	// retentionArchiveDirectory is the folder in the data directory expired
+	// data is archived to when no directory is configured
+	retentionArchiveDirectory = "archive"
+	// retentionDeleteBatchSize is the number of trades deleted per query
+	retentionDeleteBatchSize = 500
+)
+
+var (
+	errNilRetentionConfig           = errors.New("nil retention config")
+	errNoRetentionPolicies          = errors.New("no retention policies configured")
+	errRetentionRunInProgress       = errors.New("retention run already in progress")
+	errRetentionDownsampleInterval  = errors.New("downsample interval must be a multiple of, and larger than, the candle interval")
+	errRetentionArchiveDirectoryNil = errors.New("archive directory unset")
+)
+
+// RetentionManager applies retention policies to stored candles and trades.
+// Data older than a policy's maximum age is optionally downsampled into
+// coarser candles and archived to compressed files before it is deleted
+type RetentionManager struct {
+	started  int32
+	inRun    int32
+	shutdown chan struct{}
+	wg       sync.WaitGroup
+	iDatabaseConnectionManager
+	verbose          bool
+	checkInterval    time.Duration
+	dryRun           bool
+	archiveDirectory string
+	policies         []config.RetentionPolicy
+
+	listCandleSeries func() ([]candle.StoredSeries, error)
+	listTradeSeries  func() ([]tradesql.StoredSeries, error)
+	loadCandles      func(string, currency.Pair, asset.Item, kline.Interval, time.Time, time.Time) (kline.Item, error)
+	loadTrades       func(string, string, string, string, time.Time, time.Time) ([]tradesql.Data, error)
+	saveCandles      func(*kline.Item, bool) (uint64, error)
+	deleteCandles    func(*candle.Item) (int64, error)
+	deleteTrades     func(...tradesql.Data) error
+	now              func() time.Time
+}
+
+// RetentionReport summarises a retention run. A dry run reports the data
+// which would be affected without modifying anything
+type RetentionReport struct {
+	DryRun  bool
+	Start   time.Time
+	End     time.Time
+	Results []RetentionResult
+}
+
+// RetentionResult holds the outcome of applying a policy to a stored series
+type RetentionResult struct {
+	// Policy is the index of the applied policy in the configuration
+	Policy   int
+	DataType string
+	Exchange string
+	Asset    asset.Item
+	Pair     currency.Pair
+	// Interval is the candle interval of the series, zero for trades
+	Interval kline.Interval
+	// Cutoff is the time before which data is expired
+	Cutoff time.Time
+	// Expired is the number of candles or trades older than the cutoff
+	Expired int64
+	// Downsampled is the number of candles built from expired data
+	Downsampled int64
+	// Archived is the number of candles or trades written to archive files
+	Archived int64
+	// Deleted is the number of candles or trades removed from storage
+	Deleted int64
+	Error   string
+}
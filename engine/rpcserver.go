@@ -10,6 +10,7 @@ import (
 	"net/http"
 	"os"
 	"path/filepath"
+	"sort"
 	"strconv"
 	"strings"
 	"time"
@@ -24,14 +25,18 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common/timeperiods"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/database"
-	"github.com/thrasher-corp/gocryptotrader/database/models/postgres"
-	"github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
 	"github.com/thrasher-corp/gocryptotrader/database/repository/audit"
 	exchangeDB "github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/fundingrate"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/symbolhistory"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/tradebar"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fill"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
@@ -51,22 +56,28 @@ import (
 )
 
 var (
-	errExchangeNotLoaded    = errors.New("exchange is not loaded/doesn't exist")
-	errExchangeNotEnabled   = errors.New("exchange is not enabled")
-	errExchangeBaseNotFound = errors.New("cannot get exchange base")
-	errInvalidArguments     = errors.New("invalid arguments received")
-	errExchangeNameUnset    = errors.New("exchange name unset")
-	errCurrencyPairUnset    = errors.New("currency pair unset")
-	errInvalidTimes         = errors.New("invalid start and end times")
-	errAssetTypeDisabled    = errors.New("asset type is disabled")
-	errAssetTypeUnset       = errors.New("asset type unset")
-	errDispatchSystem       = errors.New("dispatch system offline")
-	errCurrencyNotEnabled   = errors.New("currency not enabled")
-	errCurrencyPairInvalid  = errors.New("currency provided is not found in the available pairs list")
-	errNoTrades             = errors.New("no trades returned from supplied params")
-	errNilRequestData       = errors.New("nil request data received, cannot continue")
+	errExchangeNotLoaded     = errors.New("exchange is not loaded/doesn't exist")
+	errExchangeNotEnabled    = errors.New("exchange is not enabled")
+	errExchangeBaseNotFound  = errors.New("cannot get exchange base")
+	errInvalidArguments      = errors.New("invalid arguments received")
+	errExchangeNameUnset     = errors.New("exchange name unset")
+	errCurrencyPairUnset     = errors.New("currency pair unset")
+	errCurrencyNotSpecified  = errors.New("a currency must be specified")
+	errInvalidTimes          = errors.New("invalid start and end times")
+	errAssetTypeDisabled     = errors.New("asset type is disabled")
+	errAssetTypeUnset        = errors.New("asset type unset")
+	errDispatchSystem        = errors.New("dispatch system offline")
+	errCurrencyNotEnabled    = errors.New("currency not enabled")
+	errCurrencyPairInvalid   = errors.New("currency provided is not found in the available pairs list")
+	errNoTrades              = errors.New("no trades returned from supplied params")
+	errNilRequestData        = errors.New("nil request data received, cannot continue")
+	errInvalidSampleDuration = errors.New("invalid sample duration")
 )
 
+// maxSpreadSampleDuration limits how long a spread statistics request can
+// hold an RPC connection open
+const maxSpreadSampleDuration = time.Minute * 5
+
 // RPCServer struct
 type RPCServer struct {
 	gctrpc.UnimplementedGoCryptoTraderServer
@@ -601,6 +612,7 @@ func createAccountInfoRequest(h account.Holdings) (*gctrpc.GetAccountInfoRespons
 	for x := range h.Accounts {
 		var a gctrpc.Account
 		a.Id = h.Accounts[x].ID
+		a.AssetType = h.Accounts[x].AssetType.String()
 		for _, y := range h.Accounts[x].Currencies {
 			a.Currencies = append(a.Currencies, &gctrpc.AccountCurrencyInfo{
 				Currency:   y.CurrencyName.String(),
@@ -636,26 +648,11 @@ func (s *RPCServer) GetAccountInfoStream(r *gctrpc.GetAccountInfoRequest, stream
 		return err
 	}
 
-	var accounts []*gctrpc.Account
-	for x := range initAcc.Accounts {
-		var subAccounts []*gctrpc.AccountCurrencyInfo
-		for y := range initAcc.Accounts[x].Currencies {
-			subAccounts = append(subAccounts, &gctrpc.AccountCurrencyInfo{
-				Currency:   initAcc.Accounts[x].Currencies[y].CurrencyName.String(),
-				TotalValue: initAcc.Accounts[x].Currencies[y].TotalValue,
-				Hold:       initAcc.Accounts[x].Currencies[y].Hold,
-			})
-		}
-		accounts = append(accounts, &gctrpc.Account{
-			Id:         initAcc.Accounts[x].ID,
-			Currencies: subAccounts,
-		})
+	resp, err := createAccountInfoRequest(holdingsByAsset(initAcc, assetType))
+	if err != nil {
+		return err
 	}
-
-	err = stream.Send(&gctrpc.GetAccountInfoResponse{
-		Exchange: initAcc.Exchange,
-		Accounts: accounts,
-	})
+	err = stream.Send(resp)
 	if err != nil {
 		return err
 	}
@@ -672,38 +669,40 @@ func (s *RPCServer) GetAccountInfoStream(r *gctrpc.GetAccountInfoRequest, stream
 		}
 	}()
 
+	// Updates include balance changes received over authenticated
+	// websocket connections as well as REST account fetches
 	for {
 		data, ok := <-pipe.C
 		if !ok {
 			return errDispatchSystem
 		}
 
-		acc := (*data.(*interface{})).(account.Holdings)
-
-		var accounts []*gctrpc.Account
-		for x := range acc.Accounts {
-			var subAccounts []*gctrpc.AccountCurrencyInfo
-			for y := range acc.Accounts[x].Currencies {
-				subAccounts = append(subAccounts, &gctrpc.AccountCurrencyInfo{
-					Currency:   acc.Accounts[x].Currencies[y].CurrencyName.String(),
-					TotalValue: acc.Accounts[x].Currencies[y].TotalValue,
-					Hold:       acc.Accounts[x].Currencies[y].Hold,
-				})
-			}
-			accounts = append(accounts, &gctrpc.Account{
-				Id:         acc.Accounts[x].ID,
-				Currencies: subAccounts,
-			})
+		acc := holdingsByAsset((*data.(*interface{})).(account.Holdings), assetType)
+		if len(acc.Accounts) == 0 {
+			continue
 		}
-
-		err := stream.Send(&gctrpc.GetAccountInfoResponse{
-			Exchange: acc.Exchange,
-			Accounts: accounts,
-		})
+		resp, err := createAccountInfoRequest(acc)
 		if err != nil {
 			return err
 		}
+		err = stream.Send(resp)
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// holdingsByAsset returns the holdings filtered to the accounts for an asset,
+// accounts without an asset type are always included
+func holdingsByAsset(h account.Holdings, a asset.Item) account.Holdings {
+	filtered := account.Holdings{Exchange: h.Exchange}
+	for x := range h.Accounts {
+		if h.Accounts[x].AssetType != "" && h.Accounts[x].AssetType != a {
+			continue
+		}
+		filtered.Accounts = append(filtered.Accounts, h.Accounts[x])
 	}
+	return filtered
 }
 
 // GetConfig returns the bots config
@@ -736,22 +735,33 @@ func (s *RPCServer) GetPortfolioSummary(_ context.Context, _ *gctrpc.GetPortfoli
 	result := s.portfolioManager.GetPortfolioSummary()
 	var resp gctrpc.GetPortfolioSummaryResponse
 
+	// Holdings are valued with composite index prices when available
+	valuation := s.compositeIndex.IsRunning()
 	p := func(coins []portfolio.Coin) []*gctrpc.Coin {
 		var c []*gctrpc.Coin
 		for x := range coins {
-			c = append(c,
-				&gctrpc.Coin{
-					Coin:       coins[x].Coin.String(),
-					Balance:    coins[x].Balance,
-					Address:    coins[x].Address,
-					Percentage: coins[x].Percentage,
-				},
-			)
+			coin := &gctrpc.Coin{
+				Coin:       coins[x].Coin.String(),
+				Balance:    coins[x].Balance,
+				Address:    coins[x].Address,
+				Percentage: coins[x].Percentage,
+			}
+			if valuation {
+				value, code, err := s.compositeIndex.GetValue(coins[x].Coin, coins[x].Balance)
+				if err == nil {
+					coin.Value = value
+					resp.ValueCurrency = code.String()
+				}
+			}
+			c = append(c, coin)
 		}
 		return c
 	}
 
 	resp.CoinTotals = p(result.Totals)
+	for x := range resp.CoinTotals {
+		resp.TotalValue += resp.CoinTotals[x].Value
+	}
 	resp.CoinsOffline = p(result.Offline)
 	resp.CoinsOfflineSummary = make(map[string]*gctrpc.OfflineCoins)
 	for k, v := range result.OfflineSummary {
@@ -1471,6 +1481,131 @@ func (s *RPCServer) ModifyOrder(ctx context.Context, r *gctrpc.ModifyOrderReques
 	}, nil
 }
 
+// SubmitBatchOrders submits multiple orders for a single exchange and asset
+// type, returning the outcome of each order in the sequence supplied
+func (s *RPCServer) SubmitBatchOrders(ctx context.Context, r *gctrpc.SubmitBatchOrdersRequest) (*gctrpc.SubmitBatchOrdersResponse, error) {
+	if len(r.Orders) == 0 {
+		return nil, order.ErrNoOrdersInBatch
+	}
+	a, err := asset.New(r.AssetType)
+	if err != nil {
+		return nil, err
+	}
+
+	exch, err := s.GetExchangeByName(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+
+	submissions := make([]*order.Submit, len(r.Orders))
+	for x := range r.Orders {
+		if r.Orders[x].Pair == nil {
+			return nil, errCurrencyPairUnset
+		}
+		p := currency.Pair{
+			Delimiter: r.Orders[x].Pair.Delimiter,
+			Base:      currency.NewCode(r.Orders[x].Pair.Base),
+			Quote:     currency.NewCode(r.Orders[x].Pair.Quote),
+		}
+		err = checkParams(r.Exchange, exch, a, p)
+		if err != nil {
+			return nil, err
+		}
+		submissions[x] = &order.Submit{
+			Pair:          p,
+			Side:          order.Side(r.Orders[x].Side),
+			Type:          order.Type(r.Orders[x].OrderType),
+			Amount:        r.Orders[x].Amount,
+			Price:         r.Orders[x].Price,
+			ClientID:      r.Orders[x].ClientId,
+			ClientOrderID: r.Orders[x].ClientId,
+			Exchange:      r.Exchange,
+			AssetType:     a,
+		}
+	}
+
+	results, err := s.OrderManager.SubmitBatch(ctx, submissions)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &gctrpc.SubmitBatchOrdersResponse{
+		Results: make([]*gctrpc.SubmitBatchOrderResult, len(results)),
+	}
+	for x := range results {
+		result := &gctrpc.SubmitBatchOrderResult{}
+		if results[x].Error != nil {
+			result.Error = results[x].Error.Error()
+		}
+		if results[x].OrderSubmitResponse != nil {
+			result.OrderPlaced = results[x].IsOrderPlaced
+			result.OrderId = results[x].OrderID
+			result.InternalOrderId = results[x].InternalOrderID
+			for y := range results[x].Trades {
+				result.Trades = append(result.Trades, &gctrpc.Trades{
+					Amount:   results[x].Trades[y].Amount,
+					Price:    results[x].Trades[y].Price,
+					Fee:      results[x].Trades[y].Fee,
+					FeeAsset: results[x].Trades[y].FeeAsset,
+				})
+			}
+		}
+		resp.Results[x] = result
+	}
+	return resp, nil
+}
+
+// ModifyBatchOrders amends multiple orders for a single exchange and asset
+// type, returning the outcome of each amendment in the sequence supplied
+func (s *RPCServer) ModifyBatchOrders(ctx context.Context, r *gctrpc.ModifyBatchOrdersRequest) (*gctrpc.ModifyBatchOrdersResponse, error) {
+	if len(r.Orders) == 0 {
+		return nil, order.ErrNoOrdersInBatch
+	}
+	assetType, err := asset.New(r.Asset)
+	if err != nil {
+		return nil, err
+	}
+
+	exch, err := s.GetExchangeByName(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	if !exch.GetAssetTypes(true).Contains(assetType) {
+		return nil, fmt.Errorf("%s %w", assetType, errAssetTypeDisabled)
+	}
+
+	mods := make([]*order.Modify, len(r.Orders))
+	for x := range r.Orders {
+		mods[x] = &order.Modify{
+			Exchange:  r.Exchange,
+			AssetType: assetType,
+			ID:        r.Orders[x].OrderId,
+			Amount:    r.Orders[x].Amount,
+			Price:     r.Orders[x].Price,
+		}
+	}
+
+	results, err := s.OrderManager.ModifyBatch(ctx, mods)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &gctrpc.ModifyBatchOrdersResponse{
+		Results: make([]*gctrpc.ModifyBatchOrderResult, len(results)),
+	}
+	for x := range results {
+		result := &gctrpc.ModifyBatchOrderResult{OrderId: r.Orders[x].OrderId}
+		if results[x].Error != nil {
+			result.Error = results[x].Error.Error()
+		}
+		if results[x].ModifyResponse != nil {
+			result.ModifiedOrderId = results[x].ModifyResponse.OrderID
+		}
+		resp.Results[x] = result
+	}
+	return resp, nil
+}
+
 // GetEvents returns the stored events list
 func (s *RPCServer) GetEvents(_ context.Context, _ *gctrpc.GetEventsRequest) (*gctrpc.GetEventsResponse, error) {
 	return &gctrpc.GetEventsResponse{}, common.ErrNotYetImplemented
@@ -1530,7 +1665,23 @@ func (s *RPCServer) GetCryptocurrencyDepositAddresses(ctx context.Context, r *gc
 	}
 
 	result, err := s.GetCryptocurrencyDepositAddressesByExchange(r.Exchange)
-	return &gctrpc.GetCryptocurrencyDepositAddressesResponse{Addresses: result}, err
+	if err != nil {
+		return nil, err
+	}
+
+	addresses := make(map[string]*gctrpc.DepositAddresses, len(result))
+	for k, v := range result {
+		addrs := make([]*gctrpc.DepositAddress, len(v))
+		for x := range v {
+			addrs[x] = &gctrpc.DepositAddress{
+				Address: v[x].Address,
+				Tag:     v[x].Tag,
+				Chain:   v[x].Chain,
+			}
+		}
+		addresses[k] = &gctrpc.DepositAddresses{Addresses: addrs}
+	}
+	return &gctrpc.GetCryptocurrencyDepositAddressesResponse{Addresses: addresses}, nil
 }
 
 // GetCryptocurrencyDepositAddress returns a cryptocurrency deposit address
@@ -1544,8 +1695,51 @@ func (s *RPCServer) GetCryptocurrencyDepositAddress(ctx context.Context, r *gctr
 	addr, err := s.GetExchangeCryptocurrencyDepositAddress(ctx,
 		r.Exchange,
 		"",
+		r.Chain,
 		currency.NewCode(r.Cryptocurrency))
-	return &gctrpc.GetCryptocurrencyDepositAddressResponse{Address: addr}, err
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.GetCryptocurrencyDepositAddressResponse{
+		Address: addr.Address,
+		Tag:     addr.Tag,
+		Chain:   addr.Chain,
+	}, nil
+}
+
+// GetAvailableTransferChains returns the networks a cryptocurrency can be
+// deposited and withdrawn on for an exchange
+func (s *RPCServer) GetAvailableTransferChains(ctx context.Context, r *gctrpc.GetAvailableTransferChainsRequest) (*gctrpc.GetAvailableTransferChainsResponse, error) {
+	exch, err := s.GetExchangeByName(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+
+	if r.Cryptocurrency == "" {
+		return nil, errCurrencyNotSpecified
+	}
+
+	networks, err := exch.GetAvailableTransferChains(ctx, currency.NewCode(r.Cryptocurrency))
+	if err != nil {
+		return nil, err
+	}
+
+	chains := make([]*gctrpc.TransferChain, len(networks))
+	for x := range networks {
+		chains[x] = &gctrpc.TransferChain{
+			Chain:           networks[x].Chain,
+			Name:            networks[x].Name,
+			IsDefault:       networks[x].IsDefault,
+			DepositEnabled:  networks[x].DepositEnabled,
+			WithdrawEnabled: networks[x].WithdrawEnabled,
+			WithdrawFee:     networks[x].WithdrawFee,
+			WithdrawMinimum: networks[x].WithdrawMinimum,
+			WithdrawMaximum: networks[x].WithdrawMaximum,
+			Confirmations:   networks[x].Confirmations,
+			RequiresTag:     networks[x].RequiresTag,
+		}
+	}
+	return &gctrpc.GetAvailableTransferChainsResponse{Chains: chains}, nil
 }
 
 // WithdrawCryptocurrencyFunds withdraws cryptocurrency funds specified by
@@ -1565,6 +1759,7 @@ func (s *RPCServer) WithdrawCryptocurrencyFunds(ctx context.Context, r *gctrpc.W
 		Crypto: withdraw.CryptoRequest{
 			Address:    r.Address,
 			AddressTag: r.AddressTag,
+			Chain:      r.Chain,
 			FeeAmount:  r.Fee,
 		},
 	}
@@ -2121,6 +2316,87 @@ func (s *RPCServer) GetExchangeTickerStream(r *gctrpc.GetExchangeTickerStreamReq
 	}
 }
 
+// GetFillStream streams own trade executions received over authenticated
+// websocket connections for an exchange, optionally filtered by asset and
+// currency pair
+func (s *RPCServer) GetFillStream(r *gctrpc.GetFillStreamRequest, stream gctrpc.GoCryptoTrader_GetFillStreamServer) error {
+	if r.Exchange == "" {
+		return errExchangeNameUnset
+	}
+
+	if _, err := s.GetExchangeByName(r.Exchange); err != nil {
+		return err
+	}
+
+	var assetType asset.Item
+	if r.AssetType != "" {
+		var err error
+		assetType, err = asset.New(r.AssetType)
+		if err != nil {
+			return err
+		}
+	}
+
+	var pair currency.Pair
+	if r.Pair != nil {
+		var err error
+		pair, err = currency.NewPairFromStrings(r.Pair.Base, r.Pair.Quote)
+		if err != nil {
+			return err
+		}
+	}
+
+	pipe, err := fill.SubscribeToExchangeFills(r.Exchange)
+	if err != nil {
+		return err
+	}
+
+	defer func() {
+		pipeErr := pipe.Release()
+		if pipeErr != nil {
+			log.Error(log.DispatchMgr, pipeErr)
+		}
+	}()
+
+	for {
+		data, ok := <-pipe.C
+		if !ok {
+			return errDispatchSystem
+		}
+		f := (*data.(*interface{})).(fill.Data)
+		if assetType != "" && f.AssetType != assetType {
+			continue
+		}
+		if !pair.IsEmpty() && !f.Pair.Equal(pair) {
+			continue
+		}
+
+		err := stream.Send(&gctrpc.FillResponse{
+			Exchange:  f.Exchange,
+			AssetType: f.AssetType.String(),
+			Pair: &gctrpc.CurrencyPair{
+				Base:      f.Pair.Base.String(),
+				Quote:     f.Pair.Quote.String(),
+				Delimiter: f.Pair.Delimiter,
+			},
+			OrderId:       f.OrderID,
+			ClientOrderId: f.ClientOrderID,
+			TradeId:       f.TID,
+			Side:          f.Side.String(),
+			OrderType:     f.Type.String(),
+			Price:         f.Price,
+			Amount:        f.Amount,
+			Fee:           f.Fee,
+			FeeAsset:      f.FeeAsset,
+			IsMaker:       f.IsMaker,
+			Timestamp:     s.unixTimestamp(f.Timestamp),
+		})
+		if err != nil {
+			return err
+		}
+	}
+}
+
 // GetAuditEvent returns matching audit events from database
 func (s *RPCServer) GetAuditEvent(_ context.Context, r *gctrpc.GetAuditEventRequest) (*gctrpc.GetAuditEventResponse, error) {
 	start, err := time.Parse(common.SimpleTimeFormat, r.StartDate)
@@ -2142,28 +2418,13 @@ func (s *RPCServer) GetAuditEvent(_ context.Context, r *gctrpc.GetAuditEventRequ
 
 	resp := gctrpc.GetAuditEventResponse{}
 
-	switch v := events.(type) {
-	case postgres.AuditEventSlice:
-		for x := range v {
-			tempEvent := &gctrpc.AuditEvent{
-				Type:       v[x].Type,
-				Identifier: v[x].Identifier,
-				Message:    v[x].Message,
-				Timestamp:  v[x].CreatedAt.In(time.UTC).Format(common.SimpleTimeFormatWithTimezone),
-			}
-
-			resp.Events = append(resp.Events, tempEvent)
-		}
-	case sqlite3.AuditEventSlice:
-		for x := range v {
-			tempEvent := &gctrpc.AuditEvent{
-				Type:       v[x].Type,
-				Identifier: v[x].Identifier,
-				Message:    v[x].Message,
-				Timestamp:  v[x].CreatedAt,
-			}
-			resp.Events = append(resp.Events, tempEvent)
-		}
+	for x := range events {
+		resp.Events = append(resp.Events, &gctrpc.AuditEvent{
+			Type:       events[x].Type,
+			Identifier: events[x].Identifier,
+			Message:    events[x].Message,
+			Timestamp:  events[x].CreatedAt.In(time.UTC).Format(common.SimpleTimeFormatWithTimezone),
+		})
 	}
 
 	return &resp, nil
@@ -2741,6 +3002,13 @@ func (s *RPCServer) UpdateExchangeSupportedPairs(ctx context.Context, r *gctrpc.
 		return nil, err
 	}
 
+	if s.symbolHistory.IsRunning() {
+		_, err = s.symbolHistory.Record(exch.GetName())
+		if err != nil {
+			log.Errorf(log.DatabaseMgr, "%s unable to record pairs: %v", SymbolHistoryManagerName, err)
+		}
+	}
+
 	if exch.IsWebsocketEnabled() {
 		err = exch.FlushWebsocketChannels()
 		if err != nil {
@@ -3043,6 +3311,120 @@ func (s *RPCServer) ConvertTradesToCandles(_ context.Context, r *gctrpc.ConvertT
 	return resp, nil
 }
 
+// ConvertTradesToBars converts stored trades to tick, volume, dollar or
+// imbalance bars, optionally saving them to the database
+func (s *RPCServer) ConvertTradesToBars(_ context.Context, r *gctrpc.ConvertTradesToBarsRequest) (*gctrpc.ConvertTradesToBarsResponse, error) {
+	if r.End == "" || r.Start == "" || r.Exchange == "" || r.Pair == nil || r.AssetType == "" || r.Pair.String() == "" {
+		return nil, errInvalidArguments
+	}
+	barType := trade.BarType(strings.ToLower(r.BarType))
+	if !barType.Valid() {
+		return nil, fmt.Errorf("%w '%v'", trade.ErrInvalidBarType, r.BarType)
+	}
+	if r.BarThreshold <= 0 {
+		return nil, trade.ErrInvalidBarThreshold
+	}
+	start, err := time.Parse(common.SimpleTimeFormat, r.Start)
+	if err != nil {
+		return nil, fmt.Errorf("%w cannot parse start time %v", errInvalidTimes, err)
+	}
+	end, err := time.Parse(common.SimpleTimeFormat, r.End)
+	if err != nil {
+		return nil, fmt.Errorf("%w cannot parse end time %v", errInvalidTimes, err)
+	}
+	err = common.StartEndTimeCheck(start, end)
+	if err != nil {
+		return nil, err
+	}
+	p := currency.Pair{
+		Delimiter: r.Pair.Delimiter,
+		Base:      currency.NewCode(r.Pair.Base),
+		Quote:     currency.NewCode(r.Pair.Quote),
+	}
+
+	a, err := asset.New(r.AssetType)
+	if err != nil {
+		return nil, err
+	}
+
+	exch, err := s.GetExchangeByName(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+
+	err = checkParams(r.Exchange, exch, a, p)
+	if err != nil {
+		return nil, err
+	}
+
+	trades, err := trade.GetTradesInRange(r.Exchange, r.AssetType, r.Pair.Base, r.Pair.Quote, start, end)
+	if err != nil {
+		return nil, err
+	}
+	if len(trades) == 0 {
+		return nil, errNoTrades
+	}
+	bars, err := trade.ConvertTradesToBars(barType, r.BarThreshold, trades...)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &gctrpc.ConvertTradesToBarsResponse{
+		Exchange:     r.Exchange,
+		Pair:         r.Pair,
+		AssetType:    a.String(),
+		BarType:      string(barType),
+		BarThreshold: r.BarThreshold,
+		Bars:         make([]*gctrpc.TradeBar, len(bars)),
+	}
+	for i := range bars {
+		resp.Bars[i] = &gctrpc.TradeBar{
+			Start:     bars[i].Start.In(time.UTC).Format(common.SimpleTimeFormatWithTimezone),
+			End:       bars[i].End.In(time.UTC).Format(common.SimpleTimeFormatWithTimezone),
+			Open:      bars[i].Open,
+			High:      bars[i].High,
+			Low:       bars[i].Low,
+			Close:     bars[i].Close,
+			Volume:    bars[i].Volume,
+			Notional:  bars[i].Notional,
+			Imbalance: bars[i].Imbalance,
+			Trades:    bars[i].Trades,
+		}
+	}
+
+	if r.Sync {
+		var db *tradebar.DBService
+		db, err = tradebar.Setup(s.DatabaseManager.GetInstance())
+		if err != nil {
+			return nil, err
+		}
+		if db == nil {
+			return nil, database.ErrDatabaseNotConnected
+		}
+		dbBars := make([]*tradebar.Bar, len(bars))
+		for i := range bars {
+			dbBars[i] = &tradebar.Bar{
+				StartTime:  bars[i].Start,
+				EndTime:    bars[i].End,
+				Open:       bars[i].Open,
+				High:       bars[i].High,
+				Low:        bars[i].Low,
+				Close:      bars[i].Close,
+				Volume:     bars[i].Volume,
+				Notional:   bars[i].Notional,
+				Imbalance:  bars[i].Imbalance,
+				TradeCount: bars[i].Trades,
+			}
+		}
+		err = db.Replace(r.Exchange, a.String(), r.Pair.Base, r.Pair.Quote, string(barType), r.BarThreshold, start, end, dbBars...)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return resp, nil
+}
+
 // FindMissingSavedCandleIntervals is used to help determine what candle data is missing
 func (s *RPCServer) FindMissingSavedCandleIntervals(_ context.Context, r *gctrpc.FindMissingCandlePeriodsRequest) (*gctrpc.FindMissingIntervalsResponse, error) {
 	if r.End == "" || r.Start == "" || r.ExchangeName == "" || r.Pair == nil || r.AssetType == "" || r.Pair.String() == "" || r.Interval <= 0 {
@@ -3585,24 +3967,100 @@ func (s *RPCServer) UpsertDataHistoryJob(_ context.Context, r *gctrpc.UpsertData
 		return nil, err
 	}
 
-	start, err := time.Parse(common.SimpleTimeFormat, r.StartDate)
-	if err != nil {
-		return nil, fmt.Errorf("%w cannot parse start time %v", errInvalidTimes, err)
-	}
-	end, err := time.Parse(common.SimpleTimeFormat, r.EndDate)
+	job, err := dataHistoryJobFromRequest(r, a)
 	if err != nil {
-		return nil, fmt.Errorf("%w cannot parse end time %v", errInvalidTimes, err)
+		return nil, err
 	}
-	err = common.StartEndTimeCheck(start, end)
+	job.Pair = p
+
+	err = s.dataHistoryManager.UpsertJob(job, r.InsertOnly)
 	if err != nil {
 		return nil, err
 	}
 
-	job := DataHistoryJob{
-		Nickname:                 r.Nickname,
+	result, err := s.dataHistoryManager.GetByNickname(r.Nickname, false)
+	if err != nil {
+		return nil, fmt.Errorf("%s %w", r.Nickname, err)
+	}
+
+	return &gctrpc.UpsertDataHistoryJobResponse{
+		JobId:   result.ID.String(),
+		Message: "successfully upserted job: " + result.Nickname,
+	}, nil
+}
+
+// UpsertDataHistoryJobsForEnabledPairs upserts a data history job for every
+// enabled pair of an exchange asset, the request's pair is ignored
+func (s *RPCServer) UpsertDataHistoryJobsForEnabledPairs(_ context.Context, r *gctrpc.UpsertDataHistoryJobRequest) (*gctrpc.UpsertDataHistoryJobsResponse, error) {
+	if r == nil {
+		return nil, errNilRequestData
+	}
+	a, err := asset.New(r.Asset)
+	if err != nil {
+		return nil, err
+	}
+	e, err := s.GetExchangeByName(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	err = checkParams(r.Exchange, e, a, currency.Pair{})
+	if err != nil {
+		return nil, err
+	}
+
+	job, err := dataHistoryJobFromRequest(r, a)
+	if err != nil {
+		return nil, err
+	}
+	jobs, err := s.dataHistoryManager.UpsertJobsForEnabledPairs(job, r.InsertOnly)
+	if err != nil && len(jobs) == 0 {
+		return nil, err
+	}
+	if err != nil {
+		log.Errorf(log.GRPCSys, "upsert data history jobs for enabled pairs: %v", err)
+	}
+
+	resp := &gctrpc.UpsertDataHistoryJobsResponse{
+		Jobs: make([]*gctrpc.UpsertDataHistoryJobResponse, len(jobs)),
+	}
+	for i := range jobs {
+		resp.Jobs[i] = &gctrpc.UpsertDataHistoryJobResponse{
+			JobId:   jobs[i].ID.String(),
+			Message: "successfully upserted job: " + jobs[i].Nickname,
+		}
+	}
+	return resp, nil
+}
+
+// dataHistoryJobFromRequest converts an upsert request to a job, start and end
+// dates can be omitted for scheduled jobs and are set when the job is upserted
+func dataHistoryJobFromRequest(r *gctrpc.UpsertDataHistoryJobRequest, a asset.Item) (*DataHistoryJob, error) {
+	scheduled := r.RecurrenceInterval > 0 || r.RollingWindow > 0 || r.BackfillToInception
+	var start, end time.Time
+	var err error
+	if r.StartDate != "" || !scheduled {
+		start, err = time.Parse(common.SimpleTimeFormat, r.StartDate)
+		if err != nil {
+			return nil, fmt.Errorf("%w cannot parse start time %v", errInvalidTimes, err)
+		}
+	}
+	if r.EndDate != "" || !scheduled {
+		end, err = time.Parse(common.SimpleTimeFormat, r.EndDate)
+		if err != nil {
+			return nil, fmt.Errorf("%w cannot parse end time %v", errInvalidTimes, err)
+		}
+	}
+	if !start.IsZero() && !end.IsZero() {
+		err = common.StartEndTimeCheck(start, end)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return &DataHistoryJob{
+		Nickname:                 r.Nickname,
 		Exchange:                 r.Exchange,
 		Asset:                    a,
-		Pair:                     p,
 		StartDate:                start,
 		EndDate:                  end,
 		Interval:                 kline.Interval(r.Interval),
@@ -3618,21 +4076,12 @@ func (s *RPCServer) UpsertDataHistoryJob(_ context.Context, r *gctrpc.UpsertData
 		IssueTolerancePercentage: r.IssueTolerancePercentage,
 		ReplaceOnIssue:           r.ReplaceOnIssue,
 		PrerequisiteJobNickname:  r.PrerequisiteJobNickname,
-	}
-
-	err = s.dataHistoryManager.UpsertJob(&job, r.InsertOnly)
-	if err != nil {
-		return nil, err
-	}
-
-	result, err := s.dataHistoryManager.GetByNickname(r.Nickname, false)
-	if err != nil {
-		return nil, fmt.Errorf("%s %w", r.Nickname, err)
-	}
-
-	return &gctrpc.UpsertDataHistoryJobResponse{
-		JobId:   result.ID.String(),
-		Message: "successfully upserted job: " + result.Nickname,
+		RecurrenceInterval:       time.Duration(r.RecurrenceInterval),
+		SettleDelay:              time.Duration(r.SettleDelay),
+		RollingWindow:            time.Duration(r.RollingWindow),
+		BackfillToInception:      r.BackfillToInception,
+		BarType:                  trade.BarType(r.BarType),
+		BarThreshold:             r.BarThreshold,
 	}, nil
 }
 
@@ -3683,6 +4132,10 @@ func (s *RPCServer) GetDataHistoryJobDetails(_ context.Context, r *gctrpc.GetDat
 			}
 		}
 	}
+	var nextRunDate string
+	if !result.NextRunDate.IsZero() {
+		nextRunDate = result.NextRunDate.Format(common.SimpleTimeFormat)
+	}
 	return &gctrpc.DataHistoryJob{
 		Id:       result.ID.String(),
 		Nickname: result.Nickname,
@@ -3709,6 +4162,13 @@ func (s *RPCServer) GetDataHistoryJobDetails(_ context.Context, r *gctrpc.GetDat
 		IssueTolerancePercentage: result.IssueTolerancePercentage,
 		ReplaceOnIssue:           result.ReplaceOnIssue,
 		JobResults:               jobResults,
+		RecurrenceInterval:       int64(result.RecurrenceInterval),
+		SettleDelay:              int64(result.SettleDelay),
+		RollingWindow:            int64(result.RollingWindow),
+		BackfillToInception:      result.BackfillToInception,
+		NextRunDate:              nextRunDate,
+		BarType:                  string(result.BarType),
+		BarThreshold:             result.BarThreshold,
 	}, nil
 }
 
@@ -3746,6 +4206,8 @@ func (s *RPCServer) GetActiveDataHistoryJobs(_ context.Context, _ *gctrpc.GetInf
 			SecondaryExchangeName:    jobs[i].SecondaryExchangeSource,
 			IssueTolerancePercentage: jobs[i].IssueTolerancePercentage,
 			ReplaceOnIssue:           jobs[i].ReplaceOnIssue,
+			BarType:                  string(jobs[i].BarType),
+			BarThreshold:             jobs[i].BarThreshold,
 		})
 	}
 	return &gctrpc.DataHistoryJobs{Results: response}, nil
@@ -3800,6 +4262,8 @@ func (s *RPCServer) GetDataHistoryJobsBetween(_ context.Context, r *gctrpc.GetDa
 			SecondaryExchangeName:    jobs[i].SecondaryExchangeSource,
 			IssueTolerancePercentage: jobs[i].IssueTolerancePercentage,
 			ReplaceOnIssue:           jobs[i].ReplaceOnIssue,
+			BarType:                  string(jobs[i].BarType),
+			BarThreshold:             jobs[i].BarThreshold,
 		})
 	}
 	return &gctrpc.DataHistoryJobs{
@@ -3819,6 +4283,10 @@ func (s *RPCServer) GetDataHistoryJobSummary(_ context.Context, r *gctrpc.GetDat
 	if err != nil {
 		return nil, err
 	}
+	var nextRunDate string
+	if !job.NextRunDate.IsZero() {
+		nextRunDate = job.NextRunDate.Format(common.SimpleTimeFormat)
+	}
 	return &gctrpc.DataHistoryJob{
 		Nickname: job.Nickname,
 		Exchange: job.Exchange,
@@ -3837,6 +4305,8 @@ func (s *RPCServer) GetDataHistoryJobSummary(_ context.Context, r *gctrpc.GetDat
 		OverwriteExistingData:   job.OverwriteExistingData,
 		PrerequisiteJobNickname: job.PrerequisiteJobNickname,
 		ResultSummaries:         job.ResultRanges,
+		RecurrenceInterval:      int64(job.RecurrenceInterval),
+		NextRunDate:             nextRunDate,
 	}, nil
 }
 
@@ -3945,3 +4415,1381 @@ func (s *RPCServer) CurrencyStateTradingPair(_ context.Context, r *gctrpc.Curren
 		cp,
 		asset.Item(r.Asset))
 }
+
+// GetConsolidatedOrderbook returns the merged orderbook for a currency pair
+// across enabled exchanges
+func (s *RPCServer) GetConsolidatedOrderbook(_ context.Context, r *gctrpc.GetConsolidatedOrderbookRequest) (*gctrpc.ConsolidatedOrderbookResponse, error) {
+	p, a, err := consolidatedOrderbookParams(r)
+	if err != nil {
+		return nil, err
+	}
+	c, err := s.consolidatedOrderbooks.GetConsolidatedOrderbook(p, a, r.ConvertQuote, r.Exchanges)
+	if err != nil {
+		return nil, err
+	}
+	return consolidatedOrderbookToRPC(c, r.Depth), nil
+}
+
+// GetConsolidatedOrderbookStream streams the merged orderbook for a currency
+// pair across enabled exchanges each time an underlying orderbook changes
+func (s *RPCServer) GetConsolidatedOrderbookStream(r *gctrpc.GetConsolidatedOrderbookRequest, stream gctrpc.GoCryptoTrader_GetConsolidatedOrderbookStreamServer) error {
+	p, a, err := consolidatedOrderbookParams(r)
+	if err != nil {
+		return err
+	}
+	book, err := s.consolidatedOrderbooks.getBook(p, a, r.ConvertQuote)
+	if err != nil {
+		return err
+	}
+	for {
+		wait := book.Wait(stream.Context().Done())
+		c, err := book.retrieve(r.Exchanges)
+		if err != nil && !errors.Is(err, errNoConsolidatedVenues) {
+			return err
+		}
+		if c != nil {
+			err = stream.Send(consolidatedOrderbookToRPC(c, r.Depth))
+			if err != nil {
+				return err
+			}
+		}
+		if <-wait {
+			return stream.Context().Err()
+		}
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		default:
+		}
+	}
+}
+
+// consolidatedOrderbookParams validates and converts the consolidated
+// orderbook request parameters
+func consolidatedOrderbookParams(r *gctrpc.GetConsolidatedOrderbookRequest) (currency.Pair, asset.Item, error) {
+	if r.Pair == nil {
+		return currency.Pair{}, "", errCurrencyPairUnset
+	}
+	a, err := asset.New(r.AssetType)
+	if err != nil {
+		return currency.Pair{}, "", err
+	}
+	return currency.Pair{
+		Delimiter: r.Pair.Delimiter,
+		Base:      currency.NewCode(r.Pair.Base),
+		Quote:     currency.NewCode(r.Pair.Quote),
+	}, a, nil
+}
+
+// consolidatedOrderbookToRPC converts a consolidated orderbook to its RPC
+// response, depth limits the price levels returned for each side if set
+func consolidatedOrderbookToRPC(c *orderbook.Consolidated, depth int64) *gctrpc.ConsolidatedOrderbookResponse {
+	return &gctrpc.ConsolidatedOrderbookResponse{
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: c.Pair.Delimiter,
+			Base:      c.Pair.Base.String(),
+			Quote:     c.Pair.Quote.String(),
+		},
+		AssetType:      c.Asset.String(),
+		Exchanges:      c.Exchanges,
+		Bids:           consolidatedItemsToRPC(c.Bids, depth),
+		Asks:           consolidatedItemsToRPC(c.Asks, depth),
+		TotalBidAmount: c.Bids.TotalAmount(),
+		TotalAskAmount: c.Asks.TotalAmount(),
+		LastUpdated:    c.LastUpdated.Unix(),
+	}
+}
+
+func consolidatedItemsToRPC(items orderbook.ConsolidatedItems, depth int64) []*gctrpc.ConsolidatedOrderbookItem {
+	if depth > 0 && int64(len(items)) > depth {
+		items = items[:depth]
+	}
+	resp := make([]*gctrpc.ConsolidatedOrderbookItem, len(items))
+	for x := range items {
+		venues := make([]*gctrpc.ConsolidatedOrderbookVenue, len(items[x].Venues))
+		for y := range items[x].Venues {
+			venues[y] = &gctrpc.ConsolidatedOrderbookVenue{
+				Exchange: items[x].Venues[y].Exchange,
+				Amount:   items[x].Venues[y].Amount,
+			}
+		}
+		resp[x] = &gctrpc.ConsolidatedOrderbookItem{
+			Price:  items[x].Price,
+			Amount: items[x].Amount,
+			Venues: venues,
+		}
+	}
+	return resp
+}
+
+// GetOrderbookAnalytics returns imbalance, microprice, liquidity and market
+// impact analytics for an exchange orderbook
+func (s *RPCServer) GetOrderbookAnalytics(_ context.Context, r *gctrpc.GetOrderbookAnalyticsRequest) (*gctrpc.OrderbookAnalyticsResponse, error) {
+	if r.Pair == nil {
+		return nil, errCurrencyPairUnset
+	}
+	a, err := asset.New(r.AssetType)
+	if err != nil {
+		return nil, err
+	}
+	p := currency.Pair{
+		Delimiter: r.Pair.Delimiter,
+		Base:      currency.NewCode(r.Pair.Base),
+		Quote:     currency.NewCode(r.Pair.Quote),
+	}
+	analytics, err := s.Engine.GetOrderbookAnalytics(r.Exchange, p, a, int(r.Levels), r.BasisPoints, r.ImpactAmounts)
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.OrderbookAnalyticsResponse{
+		Exchange:   r.Exchange,
+		Pair:       r.Pair,
+		AssetType:  a.String(),
+		MidPrice:   analytics.MidPrice,
+		Microprice: analytics.Microprice,
+		Spread:     analytics.Spread,
+		SpreadBps:  analytics.SpreadBPS,
+		Imbalance:  analytics.Imbalance,
+		BuyImpact:  impactCurveToRPC(analytics.BuyImpact),
+		SellImpact: impactCurveToRPC(analytics.SellImpact),
+	}
+	if analytics.Liquidity != nil {
+		resp.Liquidity = &gctrpc.OrderbookLiquidity{
+			BasisPoints: analytics.Liquidity.BasisPoints,
+			BidAmount:   analytics.Liquidity.BidAmount,
+			BidValue:    analytics.Liquidity.BidValue,
+			AskAmount:   analytics.Liquidity.AskAmount,
+			AskValue:    analytics.Liquidity.AskValue,
+		}
+	}
+	return resp, nil
+}
+
+func impactCurveToRPC(curve []orderbook.ImpactPoint) []*gctrpc.OrderbookImpactPoint {
+	resp := make([]*gctrpc.OrderbookImpactPoint, len(curve))
+	for x := range curve {
+		resp[x] = &gctrpc.OrderbookImpactPoint{
+			Amount:       curve[x].Amount,
+			FilledAmount: curve[x].FilledAmount,
+			AveragePrice: curve[x].AveragePrice,
+			WorstPrice:   curve[x].WorstPrice,
+			SlippageBps:  curve[x].SlippageBPS,
+			FullyFilled:  curve[x].FullyFilled,
+		}
+	}
+	return resp
+}
+
+// GetOrderbookSpreadStatistics samples an exchange orderbook spread for the
+// requested number of seconds and returns its distribution
+func (s *RPCServer) GetOrderbookSpreadStatistics(ctx context.Context, r *gctrpc.GetOrderbookSpreadStatisticsRequest) (*gctrpc.OrderbookSpreadStatisticsResponse, error) {
+	if r.Pair == nil {
+		return nil, errCurrencyPairUnset
+	}
+	duration := time.Duration(r.SampleSeconds) * time.Second
+	if duration <= 0 || duration > maxSpreadSampleDuration {
+		return nil, fmt.Errorf("%w: must be between 1 and %v seconds",
+			errInvalidSampleDuration,
+			maxSpreadSampleDuration.Seconds())
+	}
+	a, err := asset.New(r.AssetType)
+	if err != nil {
+		return nil, err
+	}
+	p := currency.Pair{
+		Delimiter: r.Pair.Delimiter,
+		Base:      currency.NewCode(r.Pair.Base),
+		Quote:     currency.NewCode(r.Pair.Quote),
+	}
+	stats, err := s.Engine.GetOrderbookSpreadStatistics(ctx, r.Exchange, p, a, duration)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.OrderbookSpreadStatisticsResponse{
+		Exchange:  r.Exchange,
+		Pair:      r.Pair,
+		AssetType: a.String(),
+		Samples:   stats.Samples,
+		Min:       stats.Min,
+		Max:       stats.Max,
+		Mean:      stats.Mean,
+		StdDev:    stats.StdDev,
+		MeanBps:   stats.MeanBPS,
+		Last:      stats.Last,
+		Start:     stats.Start.In(time.UTC).Format(common.SimpleTimeFormatWithTimezone),
+		End:       stats.End.In(time.UTC).Format(common.SimpleTimeFormatWithTimezone),
+	}, nil
+}
+
+// GetOrderbookHistory returns captured orderbook snapshots for an exchange
+// orderbook between the start and end dates from the orderbook history
+// manager
+func (s *RPCServer) GetOrderbookHistory(_ context.Context, r *gctrpc.GetOrderbookHistoryRequest) (*gctrpc.GetOrderbookHistoryResponse, error) {
+	if r.Pair == nil {
+		return nil, errCurrencyPairUnset
+	}
+	a, err := asset.New(r.AssetType)
+	if err != nil {
+		return nil, err
+	}
+	start, err := time.Parse(common.SimpleTimeFormat, r.Start)
+	if err != nil {
+		return nil, fmt.Errorf("%w cannot parse start time %v", errInvalidTimes, err)
+	}
+	end, err := time.Parse(common.SimpleTimeFormat, r.End)
+	if err != nil {
+		return nil, fmt.Errorf("%w cannot parse end time %v", errInvalidTimes, err)
+	}
+	p := currency.Pair{
+		Delimiter: r.Pair.Delimiter,
+		Base:      currency.NewCode(r.Pair.Base),
+		Quote:     currency.NewCode(r.Pair.Quote),
+	}
+	history, err := s.Engine.GetOrderbookHistory(r.Exchange, p, a, start, end, r.UseDatabase)
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetOrderbookHistoryResponse{
+		Exchange:  r.Exchange,
+		Snapshots: make([]*gctrpc.OrderbookResponse, len(history)),
+	}
+	for x := range history {
+		resp.Snapshots[x] = &gctrpc.OrderbookResponse{
+			Pair:        r.Pair,
+			Bids:        orderbookItemsToRPC(history[x].Bids),
+			Asks:        orderbookItemsToRPC(history[x].Asks),
+			LastUpdated: s.unixTimestamp(history[x].LastUpdated),
+			AssetType:   a.String(),
+		}
+	}
+	return resp, nil
+}
+
+func orderbookItemsToRPC(items orderbook.Items) []*gctrpc.OrderbookItem {
+	resp := make([]*gctrpc.OrderbookItem, len(items))
+	for x := range items {
+		resp[x] = &gctrpc.OrderbookItem{
+			Amount: items[x].Amount,
+			Price:  items[x].Price,
+		}
+	}
+	return resp
+}
+
+// getFuturesMarketDataParams validates a futures market data request and
+// returns the exchange, pair and asset it refers to
+func (s *RPCServer) getFuturesMarketDataParams(exchName string, rp *gctrpc.CurrencyPair, assetType string) (exchange.IBotExchange, currency.Pair, asset.Item, error) {
+	if rp == nil {
+		return nil, currency.Pair{}, "", errCurrencyPairUnset
+	}
+	a, err := asset.New(assetType)
+	if err != nil {
+		return nil, currency.Pair{}, "", err
+	}
+	p := currency.Pair{
+		Delimiter: rp.Delimiter,
+		Base:      currency.NewCode(rp.Base),
+		Quote:     currency.NewCode(rp.Quote),
+	}
+	err = futures.CheckRequest(a, p)
+	if err != nil {
+		return nil, currency.Pair{}, "", err
+	}
+	exch, err := s.GetExchangeByName(exchName)
+	if err != nil {
+		return nil, currency.Pair{}, "", err
+	}
+	err = checkParams(exchName, exch, a, p)
+	if err != nil {
+		return nil, currency.Pair{}, "", err
+	}
+	return exch, p, a, nil
+}
+
+// GetFundingRate returns the latest and predicted funding rate for a futures
+// contract
+func (s *RPCServer) GetFundingRate(ctx context.Context, r *gctrpc.FuturesMarketDataRequest) (*gctrpc.GetFundingRateResponse, error) {
+	exch, p, a, err := s.getFuturesMarketDataParams(r.Exchange, r.Pair, r.AssetType)
+	if err != nil {
+		return nil, err
+	}
+	rate, err := exch.GetLatestFundingRate(ctx, a, p)
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetFundingRateResponse{
+		Exchange:  exch.GetName(),
+		Pair:      r.Pair,
+		AssetType: a.String(),
+		Latest: &gctrpc.FundingRate{
+			Rate: rate.Rate,
+			Time: rate.Time.In(time.UTC).Format(common.SimpleTimeFormatWithTimezone),
+		},
+		PredictedRate: rate.PredictedRate,
+	}
+	if !rate.NextFundingTime.IsZero() {
+		resp.NextFundingTime = rate.NextFundingTime.In(time.UTC).Format(common.SimpleTimeFormatWithTimezone)
+	}
+	return resp, nil
+}
+
+// GetFundingRateHistory returns funding rates for a futures contract over a
+// date range, either from the exchange or from the database
+func (s *RPCServer) GetFundingRateHistory(ctx context.Context, r *gctrpc.GetFundingRateHistoryRequest) (*gctrpc.GetFundingRateHistoryResponse, error) {
+	start, err := time.Parse(common.SimpleTimeFormat, r.Start)
+	if err != nil {
+		return nil, fmt.Errorf("%w cannot parse start time %v", errInvalidTimes, err)
+	}
+	end, err := time.Parse(common.SimpleTimeFormat, r.End)
+	if err != nil {
+		return nil, fmt.Errorf("%w cannot parse end time %v", errInvalidTimes, err)
+	}
+	err = common.StartEndTimeCheck(start, end)
+	if err != nil {
+		return nil, err
+	}
+	exch, p, a, err := s.getFuturesMarketDataParams(r.Exchange, r.Pair, r.AssetType)
+	if err != nil {
+		return nil, err
+	}
+
+	var rates []futures.FundingRate
+	if r.UseDb {
+		rates, err = s.loadFundingRates(exch.GetName(), p, a, start, end)
+	} else {
+		rates, err = exch.GetFundingRateHistory(ctx, a, p, start, end)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	if r.Sync && !r.UseDb {
+		err = s.storeFundingRates(rates)
+		if err != nil {
+			if errors.Is(err, exchangeDB.ErrNoExchangeFound) {
+				return nil, errors.New("exchange was not found in database, you can seed existing data or insert a new exchange via the dbseed")
+			}
+			return nil, err
+		}
+	}
+
+	resp := &gctrpc.GetFundingRateHistoryResponse{
+		Exchange:  exch.GetName(),
+		Pair:      r.Pair,
+		AssetType: a.String(),
+		Start:     r.Start,
+		End:       r.End,
+		Rates:     make([]*gctrpc.FundingRate, len(rates)),
+	}
+	for i := range rates {
+		resp.Rates[i] = &gctrpc.FundingRate{
+			Rate: rates[i].Rate,
+			Time: rates[i].Time.In(time.UTC).Format(common.SimpleTimeFormatWithTimezone),
+		}
+	}
+	return resp, nil
+}
+
+// fundingRateDBService returns a funding rate database service using the
+// engine database connection
+func (s *RPCServer) fundingRateDBService() (*fundingrate.DBService, error) {
+	db, err := fundingrate.Setup(s.DatabaseManager.GetInstance())
+	if err != nil {
+		return nil, err
+	}
+	if db == nil {
+		return nil, database.ErrDatabaseNotConnected
+	}
+	return db, nil
+}
+
+// loadFundingRates returns stored funding rates for an exchange pair
+func (s *RPCServer) loadFundingRates(exchName string, p currency.Pair, a asset.Item, start, end time.Time) ([]futures.FundingRate, error) {
+	db, err := s.fundingRateDBService()
+	if err != nil {
+		return nil, err
+	}
+	stored, err := db.GetInRange(exchName, a.String(), p.Base.String(), p.Quote.String(), start, end)
+	if err != nil {
+		return nil, err
+	}
+	rates := make([]futures.FundingRate, len(stored))
+	for i := range stored {
+		rates[i] = futures.FundingRate{
+			Exchange: exchName,
+			Asset:    a,
+			Pair:     p,
+			Rate:     stored[i].Rate,
+			Time:     stored[i].Timestamp,
+		}
+	}
+	return rates, nil
+}
+
+// storeFundingRates saves exchange funding rates to the database
+func (s *RPCServer) storeFundingRates(rates []futures.FundingRate) error {
+	if len(rates) == 0 {
+		return nil
+	}
+	db, err := s.fundingRateDBService()
+	if err != nil {
+		return err
+	}
+	stored := make([]*fundingrate.Rate, len(rates))
+	for i := range rates {
+		stored[i] = &fundingrate.Rate{
+			Exchange:  rates[i].Exchange,
+			Base:      rates[i].Pair.Base.String(),
+			Quote:     rates[i].Pair.Quote.String(),
+			Asset:     rates[i].Asset.String(),
+			Rate:      rates[i].Rate,
+			Timestamp: rates[i].Time,
+		}
+	}
+	return db.Insert(stored...)
+}
+
+// GetOpenInterest returns the current open interest for a futures contract
+func (s *RPCServer) GetOpenInterest(ctx context.Context, r *gctrpc.FuturesMarketDataRequest) (*gctrpc.GetOpenInterestResponse, error) {
+	exch, p, a, err := s.getFuturesMarketDataParams(r.Exchange, r.Pair, r.AssetType)
+	if err != nil {
+		return nil, err
+	}
+	oi, err := exch.GetFuturesOpenInterest(ctx, a, p)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.GetOpenInterestResponse{
+		Exchange:  exch.GetName(),
+		Pair:      r.Pair,
+		AssetType: a.String(),
+		Amount:    oi.Amount,
+		Value:     oi.Value,
+		Time:      oi.Time.In(time.UTC).Format(common.SimpleTimeFormatWithTimezone),
+	}, nil
+}
+
+// GetMarkPrice returns the current mark and index price for a futures
+// contract
+func (s *RPCServer) GetMarkPrice(ctx context.Context, r *gctrpc.FuturesMarketDataRequest) (*gctrpc.GetMarkPriceResponse, error) {
+	exch, p, a, err := s.getFuturesMarketDataParams(r.Exchange, r.Pair, r.AssetType)
+	if err != nil {
+		return nil, err
+	}
+	mp, err := exch.GetFuturesMarkPrice(ctx, a, p)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.GetMarkPriceResponse{
+		Exchange:   exch.GetName(),
+		Pair:       r.Pair,
+		AssetType:  a.String(),
+		MarkPrice:  mp.MarkPrice,
+		IndexPrice: mp.IndexPrice,
+		Time:       mp.Time.In(time.UTC).Format(common.SimpleTimeFormatWithTimezone),
+	}, nil
+}
+
+// GetFuturesPositions returns open futures positions for an exchange asset,
+// an unset pair returns positions for all contracts
+func (s *RPCServer) GetFuturesPositions(ctx context.Context, r *gctrpc.FuturesMarketDataRequest) (*gctrpc.GetFuturesPositionsResponse, error) {
+	a, err := asset.New(r.AssetType)
+	if err != nil {
+		return nil, err
+	}
+	err = futures.CheckAsset(a)
+	if err != nil {
+		return nil, err
+	}
+	var p currency.Pair
+	if r.Pair != nil {
+		p = currency.Pair{
+			Delimiter: r.Pair.Delimiter,
+			Base:      currency.NewCode(r.Pair.Base),
+			Quote:     currency.NewCode(r.Pair.Quote),
+		}
+	}
+	exch, err := s.GetExchangeByName(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	err = checkParams(r.Exchange, exch, a, p)
+	if err != nil {
+		return nil, err
+	}
+	positions, err := exch.GetFuturesPositions(ctx, a, p)
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetFuturesPositionsResponse{
+		Exchange:  exch.GetName(),
+		AssetType: a.String(),
+		Positions: make([]*gctrpc.FuturesPosition, len(positions)),
+	}
+	for i := range positions {
+		var updated string
+		if !positions[i].UpdateTime.IsZero() {
+			updated = positions[i].UpdateTime.In(time.UTC).Format(common.SimpleTimeFormatWithTimezone)
+		}
+		resp.Positions[i] = &gctrpc.FuturesPosition{
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: positions[i].Pair.Delimiter,
+				Base:      positions[i].Pair.Base.String(),
+				Quote:     positions[i].Pair.Quote.String(),
+			},
+			Side:             positions[i].Side.String(),
+			Size:             positions[i].Size,
+			EntryPrice:       positions[i].EntryPrice,
+			MarkPrice:        positions[i].MarkPrice,
+			LiquidationPrice: positions[i].LiquidationPrice,
+			UnrealisedPnl:    positions[i].UnrealisedPNL,
+			Leverage:         positions[i].Leverage,
+			MarginType:       positions[i].MarginType.String(),
+			Margin:           positions[i].Margin,
+			UpdateTime:       updated,
+		}
+	}
+	return resp, nil
+}
+
+// ChangeFuturesLeverage sets the leverage for a futures contract
+func (s *RPCServer) ChangeFuturesLeverage(ctx context.Context, r *gctrpc.ChangeFuturesLeverageRequest) (*gctrpc.GenericResponse, error) {
+	exch, p, a, err := s.getFuturesMarketDataParams(r.Exchange, r.Pair, r.AssetType)
+	if err != nil {
+		return nil, err
+	}
+	err = exch.ChangeFuturesLeverage(ctx, a, p, r.Leverage)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.GenericResponse{Status: MsgStatusSuccess,
+		Data: fmt.Sprintf("%v %v leverage set to %v", p, a, r.Leverage)}, nil
+}
+
+// ChangeFuturesMarginType sets the margin type for a futures contract
+func (s *RPCServer) ChangeFuturesMarginType(ctx context.Context, r *gctrpc.ChangeFuturesMarginTypeRequest) (*gctrpc.GenericResponse, error) {
+	exch, p, a, err := s.getFuturesMarketDataParams(r.Exchange, r.Pair, r.AssetType)
+	if err != nil {
+		return nil, err
+	}
+	marginType, err := futures.StringToMarginType(r.MarginType)
+	if err != nil {
+		return nil, err
+	}
+	err = exch.ChangeFuturesMarginType(ctx, a, p, marginType)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.GenericResponse{Status: MsgStatusSuccess,
+		Data: fmt.Sprintf("%v %v margin type set to %v", p, a, marginType)}, nil
+}
+
+// GetFuturesMarginInfo returns the leverage and margin settings for a futures
+// contract
+func (s *RPCServer) GetFuturesMarginInfo(ctx context.Context, r *gctrpc.FuturesMarketDataRequest) (*gctrpc.GetFuturesMarginInfoResponse, error) {
+	exch, p, a, err := s.getFuturesMarketDataParams(r.Exchange, r.Pair, r.AssetType)
+	if err != nil {
+		return nil, err
+	}
+	info, err := exch.GetFuturesMarginInfo(ctx, a, p)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.GetFuturesMarginInfoResponse{
+		Exchange:          exch.GetName(),
+		Pair:              r.Pair,
+		AssetType:         a.String(),
+		Leverage:          info.Leverage,
+		MaxLeverage:       info.MaxLeverage,
+		MarginType:        info.MarginType.String(),
+		Collateral:        info.Collateral.String(),
+		MarginBalance:     info.MarginBalance,
+		AvailableMargin:   info.AvailableMargin,
+		InitialMargin:     info.InitialMargin,
+		MaintenanceMargin: info.MaintenanceMargin,
+	}, nil
+}
+
+// TransferFunds moves funds between wallets or sub-accounts on an exchange
+func (s *RPCServer) TransferFunds(ctx context.Context, r *gctrpc.TransferFundsRequest) (*gctrpc.TransferFundsResponse, error) {
+	_, err := s.GetExchangeByName(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+
+	req := &account.TransferRequest{
+		Currency:       currency.NewCode(strings.ToUpper(r.Currency)),
+		Amount:         r.Amount,
+		FromSubAccount: r.FromSubAccount,
+		ToSubAccount:   r.ToSubAccount,
+	}
+	if r.FromAsset != "" {
+		req.FromAsset, err = asset.New(r.FromAsset)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if r.ToAsset != "" {
+		req.ToAsset, err = asset.New(r.ToAsset)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	resp, err := s.WithdrawManager.SubmitTransfer(ctx, r.Exchange, req)
+	if err != nil {
+		return nil, err
+	}
+
+	return &gctrpc.TransferFundsResponse{
+		Exchange: r.Exchange,
+		Id:       resp.ID,
+		Status:   resp.Status,
+		Time:     resp.Time.In(time.UTC).Format(common.SimpleTimeFormatWithTimezone),
+	}, nil
+}
+
+// GetMarginInterestRates returns the current margin borrow or lending rates
+// for a currency
+func (s *RPCServer) GetMarginInterestRates(ctx context.Context, r *gctrpc.MarginCurrencyRequest) (*gctrpc.GetMarginInterestRatesResponse, error) {
+	exch, err := s.GetExchangeByName(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	rates, err := exch.GetMarginInterestRates(ctx, currency.NewCode(r.Currency))
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetMarginInterestRatesResponse{
+		Exchange: exch.GetName(),
+		Rates:    make([]*gctrpc.MarginInterestRate, len(rates)),
+	}
+	for i := range rates {
+		resp.Rates[i] = &gctrpc.MarginInterestRate{
+			Currency: rates[i].Currency.String(),
+			Rate:     rates[i].Rate,
+			Time:     rates[i].Time.In(time.UTC).Format(common.SimpleTimeFormatWithTimezone),
+		}
+	}
+	return resp, nil
+}
+
+// MarginBorrow borrows funds against a margin account
+func (s *RPCServer) MarginBorrow(ctx context.Context, r *gctrpc.MarginLoanRequest) (*gctrpc.MarginLoanResponse, error) {
+	exch, req, err := s.getMarginLoanParams(r)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := exch.MarginBorrow(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return marginLoanResponseToRPC(exch.GetName(), resp), nil
+}
+
+// MarginRepay repays funds borrowed against a margin account
+func (s *RPCServer) MarginRepay(ctx context.Context, r *gctrpc.MarginLoanRequest) (*gctrpc.MarginLoanResponse, error) {
+	exch, req, err := s.getMarginLoanParams(r)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := exch.MarginRepay(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return marginLoanResponseToRPC(exch.GetName(), resp), nil
+}
+
+// SubmitMarginLendOffer offers funds on an exchange lending market
+func (s *RPCServer) SubmitMarginLendOffer(ctx context.Context, r *gctrpc.SubmitMarginLendOfferRequest) (*gctrpc.MarginLoanResponse, error) {
+	exch, err := s.GetExchangeByName(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	offer := &margin.LendOffer{
+		Currency:  currency.NewCode(r.Currency),
+		Amount:    r.Amount,
+		Rate:      r.Rate,
+		Period:    r.Period,
+		AutoRenew: r.AutoRenew,
+	}
+	err = offer.Validate()
+	if err != nil {
+		return nil, err
+	}
+	resp, err := exch.SubmitMarginLendOffer(ctx, offer)
+	if err != nil {
+		return nil, err
+	}
+	return marginLoanResponseToRPC(exch.GetName(), resp), nil
+}
+
+// CancelMarginLendOffer cancels an open lending offer
+func (s *RPCServer) CancelMarginLendOffer(ctx context.Context, r *gctrpc.CancelMarginLendOfferRequest) (*gctrpc.GenericResponse, error) {
+	exch, err := s.GetExchangeByName(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	err = exch.CancelMarginLendOffer(ctx, currency.NewCode(r.Currency), r.Id)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.GenericResponse{Status: MsgStatusSuccess,
+		Data: fmt.Sprintf("%v lend offer %v cancelled", r.Currency, r.Id)}, nil
+}
+
+// GetMarginLoans returns open lending offers and active borrowed or lent
+// funds
+func (s *RPCServer) GetMarginLoans(ctx context.Context, r *gctrpc.MarginCurrencyRequest) (*gctrpc.GetMarginLoansResponse, error) {
+	exch, err := s.GetExchangeByName(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	loans, err := exch.GetMarginLoans(ctx, currency.NewCode(r.Currency))
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetMarginLoansResponse{
+		Exchange: exch.GetName(),
+		Loans:    make([]*gctrpc.MarginLoan, len(loans)),
+	}
+	for i := range loans {
+		var tm string
+		if !loans[i].Time.IsZero() {
+			tm = loans[i].Time.In(time.UTC).Format(common.SimpleTimeFormatWithTimezone)
+		}
+		resp.Loans[i] = &gctrpc.MarginLoan{
+			Id:        loans[i].ID,
+			Side:      loans[i].Side.String(),
+			Status:    loans[i].Status.String(),
+			Currency:  loans[i].Currency.String(),
+			Amount:    loans[i].Amount,
+			Rate:      loans[i].Rate,
+			Period:    loans[i].Period,
+			AutoRenew: loans[i].AutoRenew,
+			Time:      tm,
+		}
+	}
+	return resp, nil
+}
+
+// GetMarginInterestHistory returns interest paid on borrowed funds and earned
+// on lent funds over a time range
+func (s *RPCServer) GetMarginInterestHistory(ctx context.Context, r *gctrpc.GetMarginInterestHistoryRequest) (*gctrpc.GetMarginInterestHistoryResponse, error) {
+	start, err := time.Parse(common.SimpleTimeFormat, r.Start)
+	if err != nil {
+		return nil, fmt.Errorf("%w cannot parse start time %v", errInvalidTimes, err)
+	}
+	end, err := time.Parse(common.SimpleTimeFormat, r.End)
+	if err != nil {
+		return nil, fmt.Errorf("%w cannot parse end time %v", errInvalidTimes, err)
+	}
+	err = common.StartEndTimeCheck(start, end)
+	if err != nil {
+		return nil, err
+	}
+	exch, err := s.GetExchangeByName(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	payments, err := exch.GetMarginInterestHistory(ctx, currency.NewCode(r.Currency), start, end)
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetMarginInterestHistoryResponse{
+		Exchange: exch.GetName(),
+		Start:    r.Start,
+		End:      r.End,
+		Payments: make([]*gctrpc.MarginInterestPayment, len(payments)),
+	}
+	for i := range payments {
+		resp.Payments[i] = &gctrpc.MarginInterestPayment{
+			Currency:  payments[i].Currency.String(),
+			Side:      payments[i].Side.String(),
+			Principal: payments[i].Principal,
+			Interest:  payments[i].Interest,
+			Rate:      payments[i].Rate,
+			Time:      payments[i].Time.In(time.UTC).Format(common.SimpleTimeFormatWithTimezone),
+		}
+	}
+	return resp, nil
+}
+
+// getMarginLoanParams validates a borrow or repay request
+func (s *RPCServer) getMarginLoanParams(r *gctrpc.MarginLoanRequest) (exchange.IBotExchange, *margin.LoanRequest, error) {
+	exch, err := s.GetExchangeByName(r.Exchange)
+	if err != nil {
+		return nil, nil, err
+	}
+	req := &margin.LoanRequest{
+		Currency: currency.NewCode(r.Currency),
+		Amount:   r.Amount,
+	}
+	if r.Pair != nil && r.Pair.Base != "" {
+		req.Pair = currency.NewPairWithDelimiter(r.Pair.Base, r.Pair.Quote, r.Pair.Delimiter)
+		err = checkParams(r.Exchange, exch, asset.Margin, req.Pair)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+	err = req.Validate()
+	if err != nil {
+		return nil, nil, err
+	}
+	return exch, req, nil
+}
+
+func marginLoanResponseToRPC(exchName string, resp *margin.LoanResponse) *gctrpc.MarginLoanResponse {
+	return &gctrpc.MarginLoanResponse{
+		Exchange: exchName,
+		Id:       resp.ID,
+		Currency: resp.Currency.String(),
+		Amount:   resp.Amount,
+		Time:     resp.Time.In(time.UTC).Format(common.SimpleTimeFormatWithTimezone),
+	}
+}
+
+// GetFeeSchedule returns the fee schedule loaded for an exchange, including
+// the account tier, commissions and transfer fees
+func (s *RPCServer) GetFeeSchedule(_ context.Context, r *gctrpc.GetFeeScheduleRequest) (*gctrpc.GetFeeScheduleResponse, error) {
+	exch, err := s.GetExchangeByName(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	sch, err := exch.GetFeeSchedule()
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetFeeScheduleResponse{
+		Exchange:        exch.GetName(),
+		Tier:            sch.Tier,
+		ThirtyDayVolume: sch.ThirtyDayVolume,
+		VolumeCurrency:  sch.VolumeCurrency.String(),
+		PairCommissions: make([]*gctrpc.FeeCommission, len(sch.PairCommissions)),
+		Tiers:           make([]*gctrpc.FeeTier, len(sch.Tiers)),
+		Discount: &gctrpc.FeeDiscount{
+			Currency: sch.Discount.Currency.String(),
+			Rate:     sch.Discount.Rate,
+			Enabled:  sch.Discount.Enabled,
+		},
+		Transfers:     make([]*gctrpc.TransferFee, len(sch.Transfers)),
+		Authenticated: sch.Authenticated,
+		LastUpdated:   sch.LastUpdated.In(time.UTC).Format(common.SimpleTimeFormatWithTimezone),
+	}
+	for a, c := range sch.Commissions {
+		resp.Commissions = append(resp.Commissions, &gctrpc.FeeCommission{
+			Asset: a.String(),
+			Maker: c.Maker,
+			Taker: c.Taker,
+		})
+	}
+	sort.Slice(resp.Commissions, func(i, j int) bool {
+		return resp.Commissions[i].Asset < resp.Commissions[j].Asset
+	})
+	for i := range sch.PairCommissions {
+		resp.PairCommissions[i] = &gctrpc.FeeCommission{
+			Asset: sch.PairCommissions[i].Asset.String(),
+			Pair:  sch.PairCommissions[i].Pair.String(),
+			Maker: sch.PairCommissions[i].Maker,
+			Taker: sch.PairCommissions[i].Taker,
+		}
+	}
+	for i := range sch.Tiers {
+		resp.Tiers[i] = &gctrpc.FeeTier{
+			Name:           sch.Tiers[i].Name,
+			Asset:          sch.Tiers[i].Asset.String(),
+			MinimumVolume:  sch.Tiers[i].MinimumVolume,
+			VolumeCurrency: sch.Tiers[i].VolumeCurrency.String(),
+			Maker:          sch.Tiers[i].Maker,
+			Taker:          sch.Tiers[i].Taker,
+		}
+	}
+	for i := range sch.Transfers {
+		resp.Transfers[i] = &gctrpc.TransferFee{
+			Currency:     sch.Transfers[i].Currency.String(),
+			Network:      sch.Transfers[i].Network,
+			Deposit:      sch.Transfers[i].Deposit,
+			Withdrawal:   sch.Transfers[i].Withdrawal,
+			IsPercentage: sch.Transfers[i].IsPercentage,
+		}
+	}
+	return resp, nil
+}
+
+// GetExchangeCapabilities returns the wrapper methods, assets, order
+// parameters, kline intervals and websocket channels supported by an exchange
+func (s *RPCServer) GetExchangeCapabilities(_ context.Context, r *gctrpc.GenericExchangeNameRequest) (*gctrpc.GetExchangeCapabilitiesResponse, error) {
+	if r.Exchange == "" {
+		return nil, errExchangeNameUnset
+	}
+
+	exch, err := s.GetExchangeByName(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+
+	caps, err := exch.GetCapabilities()
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &gctrpc.GetExchangeCapabilitiesResponse{
+		Exchange:          caps.Exchange,
+		Rest:              caps.REST,
+		Websocket:         caps.Websocket,
+		Methods:           caps.Methods,
+		Assets:            caps.Assets.Strings(),
+		WebsocketChannels: caps.WebsocketChannels,
+	}
+	for i := range caps.Orders.Types {
+		resp.OrderTypes = append(resp.OrderTypes, caps.Orders.Types[i].String())
+	}
+	for i := range caps.Orders.TimeInForce {
+		resp.TimeInForce = append(resp.TimeInForce, string(caps.Orders.TimeInForce[i]))
+	}
+	for i := range caps.Intervals {
+		resp.Intervals = append(resp.Intervals, caps.Intervals[i].Word())
+	}
+	return resp, nil
+}
+
+// ExportData writes stored candles or trades for an exchange, asset, pair and
+// date range to CSV, JSON Lines or Parquet files in the data directory
+func (s *RPCServer) ExportData(_ context.Context, r *gctrpc.ExportDataRequest) (*gctrpc.ExportDataResponse, error) {
+	if r.Exchange == "" {
+		return nil, errExchangeNameUnset
+	}
+	if r.Pair == nil {
+		return nil, errCurrencyPairUnset
+	}
+
+	exch, err := s.GetExchangeByName(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := asset.New(r.AssetType)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := currency.NewPairFromStrings(r.Pair.Base, r.Pair.Quote)
+	if err != nil {
+		return nil, err
+	}
+
+	start, err := time.Parse(common.SimpleTimeFormat, r.Start)
+	if err != nil {
+		return nil, fmt.Errorf("%w cannot parse start time %v", errInvalidTimes, err)
+	}
+	end, err := time.Parse(common.SimpleTimeFormat, r.End)
+	if err != nil {
+		return nil, fmt.Errorf("%w cannot parse end time %v", errInvalidTimes, err)
+	}
+
+	m, err := SetupDataExportManager(s.DatabaseManager, filepath.Join(s.Settings.DataDir, DefaultDataExportDirectory))
+	if err != nil {
+		return nil, err
+	}
+
+	result, err := m.Export(&DataExportRequest{
+		Exchange:       exch.GetName(),
+		Asset:          a,
+		Pair:           p,
+		DataType:       DataExportType(strings.ToLower(r.DataType)),
+		Interval:       kline.Interval(r.Interval),
+		Start:          start,
+		End:            end,
+		Format:         DataExportFormat(strings.ToLower(r.Format)),
+		Gzip:           r.Gzip,
+		PartitionByDay: r.PartitionByDay,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &gctrpc.ExportDataResponse{
+		Files: make([]*gctrpc.ExportedFile, len(result.Files)),
+	}
+	for i := range result.Files {
+		resp.Files[i] = &gctrpc.ExportedFile{
+			Path: result.Files[i].Path,
+			Rows: result.Files[i].Rows,
+		}
+	}
+	return resp, nil
+}
+
+// GetDataQualityReport scans stored candles or trades for an exchange, asset,
+// pair and date range and returns the findings along with a quality score
+func (s *RPCServer) GetDataQualityReport(_ context.Context, r *gctrpc.GetDataQualityReportRequest) (*gctrpc.GetDataQualityReportResponse, error) {
+	if r.Exchange == "" {
+		return nil, errExchangeNameUnset
+	}
+	if r.Pair == nil {
+		return nil, errCurrencyPairUnset
+	}
+
+	exch, err := s.GetExchangeByName(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+
+	a, err := asset.New(r.AssetType)
+	if err != nil {
+		return nil, err
+	}
+
+	p, err := currency.NewPairFromStrings(r.Pair.Base, r.Pair.Quote)
+	if err != nil {
+		return nil, err
+	}
+
+	start, err := time.Parse(common.SimpleTimeFormat, r.Start)
+	if err != nil {
+		return nil, fmt.Errorf("%w cannot parse start time %v", errInvalidTimes, err)
+	}
+	end, err := time.Parse(common.SimpleTimeFormat, r.End)
+	if err != nil {
+		return nil, fmt.Errorf("%w cannot parse end time %v", errInvalidTimes, err)
+	}
+
+	m, err := SetupDataQualityManager(s.DatabaseManager)
+	if err != nil {
+		return nil, err
+	}
+
+	report, err := m.Scan(&DataQualityRequest{
+		Exchange:          exch.GetName(),
+		Asset:             a,
+		Pair:              p,
+		DataType:          DataQualityDataType(strings.ToLower(r.DataType)),
+		Interval:          kline.Interval(r.Interval),
+		Start:             start,
+		End:               end,
+		ZeroVolumeRun:     r.ZeroVolumeRun,
+		WickMultiplier:    r.WickMultiplier,
+		MismatchTolerance: r.MismatchTolerance,
+		Store:             r.Store,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &gctrpc.GetDataQualityReportResponse{
+		Exchange:  report.Exchange,
+		AssetType: report.Asset.String(),
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: report.Pair.Delimiter,
+			Base:      report.Pair.Base.String(),
+			Quote:     report.Pair.Quote.String(),
+		},
+		DataType:          string(report.DataType),
+		Interval:          int64(report.Interval),
+		Start:             report.Start.Format(common.SimpleTimeFormat),
+		End:               report.End.Format(common.SimpleTimeFormat),
+		ExpectedIntervals: report.ExpectedIntervals,
+		IntervalsWithData: report.IntervalsWithData,
+		AffectedIntervals: report.AffectedIntervals,
+		Score:             report.Score,
+		CheckCounts:       make(map[string]int64, len(report.CheckCounts)),
+		Findings:          make([]*gctrpc.DataQualityFinding, len(report.Findings)),
+	}
+	for k, v := range report.CheckCounts {
+		resp.CheckCounts[string(k)] = v
+	}
+	for i := range report.Findings {
+		resp.Findings[i] = &gctrpc.DataQualityFinding{
+			Check:  string(report.Findings[i].Check),
+			Start:  report.Findings[i].Start.Format(common.SimpleTimeFormat),
+			End:    report.Findings[i].End.Format(common.SimpleTimeFormat),
+			Detail: report.Findings[i].Detail,
+		}
+	}
+	return resp, nil
+}
+
+// GetCompositeIndexPrices returns the latest composite index prices calculated
+// from multiple exchanges, filtered by currency pair if supplied
+func (s *RPCServer) GetCompositeIndexPrices(_ context.Context, r *gctrpc.GetCompositeIndexPricesRequest) (*gctrpc.GetCompositeIndexPricesResponse, error) {
+	var prices []CompositeIndexPrice
+	if r.Pair != nil {
+		a := asset.Spot
+		if r.AssetType != "" {
+			var err error
+			a, err = asset.New(r.AssetType)
+			if err != nil {
+				return nil, err
+			}
+		}
+		price, err := s.compositeIndex.GetIndexPrice(currency.Pair{
+			Delimiter: r.Pair.Delimiter,
+			Base:      currency.NewCode(r.Pair.Base),
+			Quote:     currency.NewCode(r.Pair.Quote),
+		}, a)
+		if err != nil {
+			return nil, err
+		}
+		prices = append(prices, *price)
+	} else {
+		var err error
+		prices, err = s.compositeIndex.GetIndexPrices()
+		if err != nil {
+			return nil, err
+		}
+	}
+	resp := &gctrpc.GetCompositeIndexPricesResponse{
+		Prices: make([]*gctrpc.CompositeIndexPrice, len(prices)),
+	}
+	for i := range prices {
+		sources := make([]*gctrpc.CompositeIndexSource, len(prices[i].Sources))
+		for j := range prices[i].Sources {
+			sources[j] = &gctrpc.CompositeIndexSource{
+				Exchange:       prices[i].Sources[j].Exchange,
+				Price:          prices[i].Sources[j].Price,
+				Volume:         prices[i].Sources[j].Volume,
+				LastUpdated:    s.unixTimestamp(prices[i].Sources[j].LastUpdated),
+				ExcludedReason: prices[i].Sources[j].ExcludedReason,
+			}
+		}
+		resp.Prices[i] = &gctrpc.CompositeIndexPrice{
+			Exchange: prices[i].Exchange,
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: prices[i].Pair.Delimiter,
+				Base:      prices[i].Pair.Base.String(),
+				Quote:     prices[i].Pair.Quote.String(),
+			},
+			AssetType:   prices[i].Asset.String(),
+			Price:       prices[i].Price,
+			LastUpdated: s.unixTimestamp(prices[i].LastUpdated),
+			Sources:     sources,
+		}
+	}
+	return resp, nil
+}
+
+// GetLiveCandleStream streams candles built from live trades for an exchange
+// currency pair as each interval is finalised
+func (s *RPCServer) GetLiveCandleStream(r *gctrpc.GetLiveCandleStreamRequest, stream gctrpc.GoCryptoTrader_GetLiveCandleStreamServer) error {
+	if r.Exchange == "" {
+		return errExchangeNameUnset
+	}
+	if _, err := s.GetExchangeByName(r.Exchange); err != nil {
+		return err
+	}
+	if r.Pair == nil {
+		return errCurrencyPairUnset
+	}
+	a, err := asset.New(r.AssetType)
+	if err != nil {
+		return err
+	}
+	pipe, err := s.liveCandles.Subscribe(r.Exchange,
+		currency.Pair{
+			Delimiter: r.Pair.Delimiter,
+			Base:      currency.NewCode(r.Pair.Base),
+			Quote:     currency.NewCode(r.Pair.Quote),
+		},
+		a,
+		kline.Interval(r.Interval))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		pipeErr := pipe.Release()
+		if pipeErr != nil {
+			log.Error(log.DispatchMgr, pipeErr)
+		}
+	}()
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return stream.Context().Err()
+		case data, ok := <-pipe.C:
+			if !ok {
+				return errDispatchSystem
+			}
+			c := (*data.(*interface{})).(LiveCandle)
+			err = stream.Send(&gctrpc.LiveCandleResponse{
+				Exchange: c.Exchange,
+				Pair: &gctrpc.CurrencyPair{
+					Delimiter: c.Pair.Delimiter,
+					Base:      c.Pair.Base.String(),
+					Quote:     c.Pair.Quote.String(),
+				},
+				AssetType: c.Asset.String(),
+				Interval:  int64(c.Interval),
+				Candle: &gctrpc.Candle{
+					Time:   c.Candle.Time.In(time.UTC).Format(common.SimpleTimeFormatWithTimezone),
+					Low:    c.Candle.Low,
+					High:   c.Candle.High,
+					Open:   c.Candle.Open,
+					Close:  c.Candle.Close,
+					Volume: c.Candle.Volume,
+				},
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// RunRetentionPolicies applies the configured retention policies to stored
+// candles and trades, a dry run reports what would be affected without
+// modifying any stored data
+func (s *RPCServer) RunRetentionPolicies(_ context.Context, r *gctrpc.RunRetentionPoliciesRequest) (*gctrpc.RunRetentionPoliciesResponse, error) {
+	m := s.retention
+	if m == nil {
+		var err error
+		m, err = SetupRetentionManager(s.DatabaseManager, &s.Config.Retention, s.Settings.DataDir)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	report, err := m.Run(r.DryRun)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &gctrpc.RunRetentionPoliciesResponse{
+		DryRun:  report.DryRun,
+		Start:   report.Start.Format(common.SimpleTimeFormat),
+		End:     report.End.Format(common.SimpleTimeFormat),
+		Results: make([]*gctrpc.RetentionResult, len(report.Results)),
+	}
+	for i := range report.Results {
+		resp.Results[i] = &gctrpc.RetentionResult{
+			Policy:    int64(report.Results[i].Policy),
+			DataType:  report.Results[i].DataType,
+			Exchange:  report.Results[i].Exchange,
+			AssetType: report.Results[i].Asset.String(),
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: report.Results[i].Pair.Delimiter,
+				Base:      report.Results[i].Pair.Base.String(),
+				Quote:     report.Results[i].Pair.Quote.String(),
+			},
+			Interval:    int64(report.Results[i].Interval),
+			Cutoff:      report.Results[i].Cutoff.Format(common.SimpleTimeFormat),
+			Expired:     report.Results[i].Expired,
+			Downsampled: report.Results[i].Downsampled,
+			Archived:    report.Results[i].Archived,
+			Deleted:     report.Results[i].Deleted,
+			Error:       report.Results[i].Error,
+		}
+	}
+	return resp, nil
+}
+
+// GetSymbolHistory returns the recorded listings, delistings and renames of
+// an exchange's pairs, optionally filtered by asset and pair
+func (s *RPCServer) GetSymbolHistory(_ context.Context, r *gctrpc.GetSymbolHistoryRequest) (*gctrpc.GetSymbolHistoryResponse, error) {
+	if r == nil || r.Exchange == "" {
+		return nil, errInvalidArguments
+	}
+	var assetType string
+	if r.AssetType != "" {
+		a, err := asset.New(r.AssetType)
+		if err != nil {
+			return nil, err
+		}
+		assetType = a.String()
+	}
+	var base, quote string
+	if r.Pair != nil {
+		base = r.Pair.Base
+		quote = r.Pair.Quote
+	}
+
+	db, err := symbolhistory.Setup(s.DatabaseManager.GetInstance())
+	if err != nil {
+		return nil, err
+	}
+	if db == nil {
+		return nil, database.ErrDatabaseNotConnected
+	}
+	events, err := db.GetEvents(r.Exchange, assetType, base, quote)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &gctrpc.GetSymbolHistoryResponse{
+		Events: make([]*gctrpc.SymbolHistoryEvent, len(events)),
+	}
+	for i := range events {
+		resp.Events[i] = &gctrpc.SymbolHistoryEvent{
+			Id:        events[i].ID,
+			Exchange:  events[i].Exchange,
+			AssetType: events[i].Asset,
+			Pair: &gctrpc.CurrencyPair{
+				Base:  events[i].Base,
+				Quote: events[i].Quote,
+			},
+			Event:         events[i].Event,
+			EffectiveDate: events[i].EffectiveDate.Format(common.SimpleTimeFormat),
+		}
+		if events[i].Event == symbolhistory.Renamed {
+			resp.Events[i].NewPair = &gctrpc.CurrencyPair{
+				Base:  events[i].NewBase,
+				Quote: events[i].NewQuote,
+			}
+		}
+	}
+	return resp, nil
+}
+
+// AddSymbolAlias declares that a pair continues under a new symbol from the
+// effective date. Stored candles and trades of the old pair are returned
+// with the new pair's data when querying across the change
+func (s *RPCServer) AddSymbolAlias(_ context.Context, r *gctrpc.AddSymbolAliasRequest) (*gctrpc.GenericResponse, error) {
+	if r == nil || r.Exchange == "" || r.EffectiveDate == "" {
+		return nil, errInvalidArguments
+	}
+	if r.OldPair == nil || r.OldPair.Base == "" || r.OldPair.Quote == "" ||
+		r.NewPair == nil || r.NewPair.Base == "" || r.NewPair.Quote == "" {
+		return nil, errCurrencyPairUnset
+	}
+	if r.AssetType == "" {
+		return nil, errAssetTypeUnset
+	}
+	a, err := asset.New(r.AssetType)
+	if err != nil {
+		return nil, err
+	}
+	effectiveDate, err := time.Parse(common.SimpleTimeFormat, r.EffectiveDate)
+	if err != nil {
+		return nil, fmt.Errorf("%w cannot parse effective date %v", errInvalidTimes, err)
+	}
+
+	exch, err := s.GetExchangeByName(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+	if !exch.GetAssetTypes(false).Contains(a) {
+		return nil, fmt.Errorf("%s %w", a, asset.ErrNotSupported)
+	}
+
+	db, err := symbolhistory.Setup(s.DatabaseManager.GetInstance())
+	if err != nil {
+		return nil, err
+	}
+	if db == nil {
+		return nil, database.ErrDatabaseNotConnected
+	}
+	err = db.Insert(&symbolhistory.Event{
+		Exchange:      exch.GetName(),
+		Asset:         a.String(),
+		Base:          r.OldPair.Base,
+		Quote:         r.OldPair.Quote,
+		Event:         symbolhistory.Renamed,
+		NewBase:       r.NewPair.Base,
+		NewQuote:      r.NewPair.Quote,
+		EffectiveDate: effectiveDate,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.GenericResponse{Status: MsgStatusSuccess}, nil
+}
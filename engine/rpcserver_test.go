@@ -23,14 +23,22 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/database/drivers"
 	"github.com/thrasher-corp/gocryptotrader/database/repository"
 	dbexchange "github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/orderbooksnapshot"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/symbolhistory"
 	sqltrade "github.com/thrasher-corp/gocryptotrader/database/repository/trade"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/tradebar"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/binance"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/currencystate"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/deposit"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fee"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/trade"
 	"github.com/thrasher-corp/gocryptotrader/gctrpc"
@@ -154,6 +162,200 @@ func (f fExchange) CanDeposit(c currency.Code, a asset.Item) error {
 	return nil
 }
 
+// GetLatestFundingRate overrides interface function
+func (f fExchange) GetLatestFundingRate(_ context.Context, a asset.Item, p currency.Pair) (*futures.LatestFundingRate, error) {
+	return &futures.LatestFundingRate{
+		FundingRate: futures.FundingRate{
+			Exchange: f.GetName(),
+			Asset:    a,
+			Pair:     p,
+			Rate:     0.0001,
+			Time:     time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+		},
+		PredictedRate:   0.0002,
+		NextFundingTime: time.Date(2021, 1, 1, 8, 0, 0, 0, time.UTC),
+	}, nil
+}
+
+// GetFundingRateHistory overrides interface function
+func (f fExchange) GetFundingRateHistory(_ context.Context, a asset.Item, p currency.Pair, start, _ time.Time) ([]futures.FundingRate, error) {
+	return []futures.FundingRate{
+		{
+			Exchange: f.GetName(),
+			Asset:    a,
+			Pair:     p,
+			Rate:     0.0001,
+			Time:     start,
+		},
+	}, nil
+}
+
+// GetFuturesOpenInterest overrides interface function
+func (f fExchange) GetFuturesOpenInterest(_ context.Context, a asset.Item, p currency.Pair) (*futures.OpenInterest, error) {
+	return &futures.OpenInterest{
+		Exchange: f.GetName(),
+		Asset:    a,
+		Pair:     p,
+		Amount:   1337,
+		Value:    1337000,
+		Time:     time.Now(),
+	}, nil
+}
+
+// GetFuturesMarkPrice overrides interface function
+func (f fExchange) GetFuturesMarkPrice(_ context.Context, a asset.Item, p currency.Pair) (*futures.MarkPrice, error) {
+	return &futures.MarkPrice{
+		Exchange:   f.GetName(),
+		Asset:      a,
+		Pair:       p,
+		MarkPrice:  1337,
+		IndexPrice: 1336,
+		Time:       time.Now(),
+	}, nil
+}
+
+func (f fExchange) GetFuturesPositions(_ context.Context, a asset.Item, p currency.Pair) ([]futures.Position, error) {
+	if p.IsEmpty() {
+		p = currency.NewPairWithDelimiter("BTC", "USD", "-")
+	}
+	return []futures.Position{{
+		Exchange:   f.GetName(),
+		Asset:      a,
+		Pair:       p,
+		Side:       futures.Long,
+		Size:       1337,
+		EntryPrice: 1336,
+		Leverage:   5,
+		MarginType: futures.Cross,
+		UpdateTime: time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+	}}, nil
+}
+
+func (f fExchange) ChangeFuturesLeverage(_ context.Context, a asset.Item, p currency.Pair, leverage float64) error {
+	return futures.CheckLeverage(a, p, leverage)
+}
+
+func (f fExchange) ChangeFuturesMarginType(_ context.Context, _ asset.Item, _ currency.Pair, marginType futures.MarginType) error {
+	if marginType != futures.Cross {
+		return futures.ErrMarginTypeNotSupported
+	}
+	return nil
+}
+
+func (f fExchange) GetFuturesMarginInfo(_ context.Context, a asset.Item, p currency.Pair) (*futures.MarginInfo, error) {
+	return &futures.MarginInfo{
+		Exchange:      f.GetName(),
+		Asset:         a,
+		Pair:          p,
+		Leverage:      5,
+		MaxLeverage:   100,
+		MarginType:    futures.Cross,
+		Collateral:    currency.BTC,
+		MarginBalance: 1337,
+	}, nil
+}
+
+func (f fExchange) TransferFunds(_ context.Context, _ *account.TransferRequest) (*account.TransferResponse, error) {
+	return &account.TransferResponse{
+		ID:     "1337",
+		Status: "success",
+		Time:   time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+	}, nil
+}
+
+func (f fExchange) GetMarginInterestRates(_ context.Context, c currency.Code) ([]margin.InterestRate, error) {
+	return []margin.InterestRate{{
+		Exchange: f.GetName(),
+		Currency: c,
+		Rate:     0.0002,
+		Time:     time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+	}}, nil
+}
+
+func (f fExchange) MarginBorrow(_ context.Context, r *margin.LoanRequest) (*margin.LoanResponse, error) {
+	return &margin.LoanResponse{
+		ID:       "1337",
+		Currency: r.Currency,
+		Amount:   r.Amount,
+		Time:     time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+	}, nil
+}
+
+func (f fExchange) SubmitMarginLendOffer(_ context.Context, o *margin.LendOffer) (*margin.LoanResponse, error) {
+	return &margin.LoanResponse{
+		ID:       "1338",
+		Currency: o.Currency,
+		Amount:   o.Amount,
+		Time:     time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC),
+	}, nil
+}
+
+func (f fExchange) GetMarginLoans(_ context.Context, c currency.Code) ([]margin.Loan, error) {
+	return []margin.Loan{{
+		ID:       "1338",
+		Side:     margin.Lend,
+		Status:   margin.Offered,
+		Currency: c,
+		Amount:   1,
+		Rate:     0.0002,
+		Period:   2,
+	}}, nil
+}
+
+func (f fExchange) GetMarginInterestHistory(_ context.Context, c currency.Code, start, _ time.Time) ([]margin.InterestPayment, error) {
+	return []margin.InterestPayment{{
+		Currency:  c,
+		Side:      margin.Borrow,
+		Principal: 1,
+		Interest:  0.0002,
+		Rate:      0.0002,
+		Time:      start,
+	}}, nil
+}
+
+func (f fExchange) GetAvailableTransferChains(_ context.Context, c currency.Code) ([]deposit.Network, error) {
+	return []deposit.Network{
+		{
+			Currency:        c,
+			Chain:           "ERC20",
+			IsDefault:       true,
+			DepositEnabled:  true,
+			WithdrawEnabled: true,
+			WithdrawFee:     10,
+			WithdrawMinimum: 20,
+			Confirmations:   12,
+		},
+		{
+			Currency:        c,
+			Chain:           "TRC20",
+			DepositEnabled:  true,
+			WithdrawEnabled: false,
+			WithdrawFee:     1,
+			WithdrawMinimum: 10,
+			Confirmations:   1,
+		},
+	}, nil
+}
+
+func (f fExchange) GetDepositAddress(_ context.Context, _ currency.Code, _, chain string) (*deposit.Address, error) {
+	return &deposit.Address{
+		Address: "0xb794f5ea0ba39494ce839613fffba74279579268",
+		Chain:   chain,
+	}, nil
+}
+
+// SubmitOrder overrides interface function, orders with a client order ID of
+// "reject" are refused
+func (f fExchange) SubmitOrder(_ context.Context, s *order.Submit) (order.SubmitResponse, error) {
+	if s.ClientOrderID == "reject" {
+		return order.SubmitResponse{}, errBatchRejected
+	}
+	return order.SubmitResponse{
+		IsOrderPlaced: true,
+		OrderID:       "fake" + s.ClientOrderID,
+	}, nil
+}
+
 // Sets up everything required to run any function inside rpcserver
 // Only use if you require a database, this makes tests slow
 func RPCTestSetup(t *testing.T) *Engine {
@@ -471,6 +673,147 @@ func TestConvertTradesToCandles(t *testing.T) {
 	}
 }
 
+func TestConvertTradesToBars(t *testing.T) {
+	engerino := RPCTestSetup(t)
+	defer CleanRPCTest(t, engerino)
+	s := RPCServer{Engine: engerino}
+	_, err := s.ConvertTradesToBars(context.Background(), &gctrpc.ConvertTradesToBarsRequest{})
+	if !errors.Is(err, errInvalidArguments) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidArguments)
+	}
+
+	req := &gctrpc.ConvertTradesToBarsRequest{
+		Exchange: testExchange,
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: currency.DashDelimiter,
+			Base:      currency.BTC.String(),
+			Quote:     currency.USD.String(),
+		},
+		AssetType:    asset.Spot.String(),
+		Start:        time.Date(2020, 0, 0, 0, 0, 0, 0, time.UTC).Format(common.SimpleTimeFormat),
+		End:          time.Date(2020, 0, 0, 1, 0, 0, 0, time.UTC).Format(common.SimpleTimeFormat),
+		BarType:      "renko",
+		BarThreshold: 1,
+	}
+	_, err = s.ConvertTradesToBars(context.Background(), req)
+	if !errors.Is(err, trade.ErrInvalidBarType) {
+		t.Errorf("received '%v' expected '%v'", err, trade.ErrInvalidBarType)
+	}
+
+	req.BarType = string(trade.TickBar)
+	req.BarThreshold = 0
+	_, err = s.ConvertTradesToBars(context.Background(), req)
+	if !errors.Is(err, trade.ErrInvalidBarThreshold) {
+		t.Errorf("received '%v' expected '%v'", err, trade.ErrInvalidBarThreshold)
+	}
+
+	req.BarThreshold = 1
+	_, err = s.ConvertTradesToBars(context.Background(), req)
+	if !errors.Is(err, errNoTrades) {
+		t.Errorf("received '%v' expected '%v'", err, errNoTrades)
+	}
+
+	for i := 0; i < 2; i++ {
+		err = sqltrade.Insert(sqltrade.Data{
+			Timestamp: time.Date(2020, 0, 0, 0, 30+i, 0, 0, time.UTC),
+			Exchange:  testExchange,
+			Base:      currency.BTC.String(),
+			Quote:     currency.USD.String(),
+			AssetType: asset.Spot.String(),
+			Price:     1337,
+			Amount:    1337,
+			Side:      order.Buy.String(),
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	req.Sync = true
+	resp, err := s.ConvertTradesToBars(context.Background(), req)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(resp.Bars) != 2 {
+		t.Fatalf("received '%v' expected '%v'", len(resp.Bars), 2)
+	}
+
+	db, err := tradebar.Setup(engerino.DatabaseManager.GetInstance())
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	stored, err := db.GetInRange(testExchange, asset.Spot.String(), currency.BTC.String(), currency.USD.String(), string(trade.TickBar), 1,
+		time.Date(2020, 0, 0, 0, 0, 0, 0, time.UTC), time.Date(2020, 0, 0, 1, 0, 0, 0, time.UTC))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(stored) != 2 {
+		t.Errorf("received '%v' expected '%v'", len(stored), 2)
+	}
+}
+
+func TestSymbolHistoryRPC(t *testing.T) {
+	engerino := RPCTestSetup(t)
+	defer CleanRPCTest(t, engerino)
+	s := RPCServer{Engine: engerino}
+	_, err := s.GetSymbolHistory(context.Background(), &gctrpc.GetSymbolHistoryRequest{})
+	if !errors.Is(err, errInvalidArguments) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidArguments)
+	}
+
+	_, err = s.AddSymbolAlias(context.Background(), &gctrpc.AddSymbolAliasRequest{})
+	if !errors.Is(err, errInvalidArguments) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidArguments)
+	}
+
+	req := &gctrpc.AddSymbolAliasRequest{
+		Exchange:      testExchange,
+		EffectiveDate: time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC).Format(common.SimpleTimeFormat),
+	}
+	_, err = s.AddSymbolAlias(context.Background(), req)
+	if !errors.Is(err, errCurrencyPairUnset) {
+		t.Errorf("received '%v' expected '%v'", err, errCurrencyPairUnset)
+	}
+
+	req.OldPair = &gctrpc.CurrencyPair{Base: currency.BTC.String(), Quote: currency.USD.String()}
+	req.NewPair = &gctrpc.CurrencyPair{Base: currency.XBT.String(), Quote: currency.USD.String()}
+	_, err = s.AddSymbolAlias(context.Background(), req)
+	if !errors.Is(err, errAssetTypeUnset) {
+		t.Errorf("received '%v' expected '%v'", err, errAssetTypeUnset)
+	}
+
+	req.AssetType = asset.Spot.String()
+	req.EffectiveDate = "bad"
+	_, err = s.AddSymbolAlias(context.Background(), req)
+	if !errors.Is(err, errInvalidTimes) {
+		t.Errorf("received '%v' expected '%v'", err, errInvalidTimes)
+	}
+
+	req.EffectiveDate = time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC).Format(common.SimpleTimeFormat)
+	_, err = s.AddSymbolAlias(context.Background(), req)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+
+	resp, err := s.GetSymbolHistory(context.Background(), &gctrpc.GetSymbolHistoryRequest{
+		Exchange:  testExchange,
+		AssetType: asset.Spot.String(),
+		Pair:      &gctrpc.CurrencyPair{Base: currency.BTC.String()},
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received '%v' expected '%v'", err, nil)
+	}
+	if len(resp.Events) != 1 {
+		t.Fatalf("received '%v' expected '%v'", len(resp.Events), 1)
+	}
+	if resp.Events[0].Event != symbolhistory.Renamed ||
+		resp.Events[0].NewPair == nil ||
+		resp.Events[0].NewPair.Base != currency.XBT.String() ||
+		resp.Events[0].EffectiveDate != req.EffectiveDate {
+		t.Errorf("unexpected event %+v", resp.Events[0])
+	}
+}
+
 func TestGetHistoricCandles(t *testing.T) {
 	engerino := RPCTestSetup(t)
 	defer CleanRPCTest(t, engerino)
@@ -1428,6 +1771,53 @@ func TestRPCServerUpsertDataHistoryJob(t *testing.T) {
 	}
 }
 
+func TestRPCServerUpsertDataHistoryJobsForEnabledPairs(t *testing.T) {
+	t.Parallel()
+	m, _ := createDHM(t)
+	em, ok := m.exchangeManager.(*ExchangeManager)
+	if !ok {
+		t.Fatal("expected exchange manager")
+	}
+	s := RPCServer{Engine: &Engine{dataHistoryManager: m, ExchangeManager: em}}
+	_, err := s.UpsertDataHistoryJobsForEnabledPairs(context.Background(), nil)
+	if !errors.Is(err, errNilRequestData) {
+		t.Errorf("received %v, expected %v", err, errNilRequestData)
+	}
+
+	job := &gctrpc.UpsertDataHistoryJobRequest{
+		Nickname:           "hellomoto",
+		Exchange:           testExchange,
+		Asset:              asset.Spot.String(),
+		Interval:           int64(kline.OneHour.Duration()),
+		RequestSizeLimit:   10,
+		DataType:           int64(dataHistoryCandleDataType),
+		MaxRetryAttempts:   3,
+		BatchSize:          500,
+		RecurrenceInterval: int64(time.Hour),
+		SettleDelay:        int64(time.Minute),
+	}
+	_, err = s.UpsertDataHistoryJobsForEnabledPairs(context.Background(), job)
+	if err == nil {
+		t.Error("expected error when start date cannot be determined")
+	}
+
+	job.RollingWindow = int64(time.Hour * 24)
+	resp, err := s.UpsertDataHistoryJobsForEnabledPairs(context.Background(), job)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received %v, expected %v", err, nil)
+	}
+	if len(resp.Jobs) != 2 {
+		t.Errorf("received %v, expected %v", len(resp.Jobs), 2)
+	}
+
+	job.RecurrenceInterval = 0
+	job.RollingWindow = 0
+	_, err = s.UpsertDataHistoryJobsForEnabledPairs(context.Background(), job)
+	if !errors.Is(err, errInvalidTimes) {
+		t.Errorf("received %v, expected %v", err, errInvalidTimes)
+	}
+}
+
 func TestGetDataHistoryJobDetails(t *testing.T) {
 	t.Parallel()
 	m, _ := createDHM(t)
@@ -1978,3 +2368,1041 @@ func TestCurrencyStateTradingPair(t *testing.T) {
 		t.Fatalf("received: %v, but expected: %v", err, nil)
 	}
 }
+
+func TestGetOrderbookAnalytics(t *testing.T) {
+	t.Parallel()
+	em := SetupExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := exch.GetBase()
+	b.Name = "analyticsExchange"
+	b.Enabled = true
+
+	cp := currency.NewPair(currency.LTC, currency.USD)
+	b.CurrencyPairs.Pairs = make(map[asset.Item]*currency.PairStore)
+	b.CurrencyPairs.Pairs[asset.Spot] = &currency.PairStore{
+		AssetEnabled: convert.BoolPtr(true),
+		ConfigFormat: &currency.PairFormat{},
+		Available:    currency.Pairs{cp},
+		Enabled:      currency.Pairs{cp},
+	}
+	em.Add(exch)
+	s := RPCServer{Engine: &Engine{ExchangeManager: em}}
+
+	_, err = s.GetOrderbookAnalytics(context.Background(), &gctrpc.GetOrderbookAnalyticsRequest{})
+	if !errors.Is(err, errCurrencyPairUnset) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errCurrencyPairUnset)
+	}
+
+	req := &gctrpc.GetOrderbookAnalyticsRequest{
+		Exchange:      "analyticsExchange",
+		Pair:          &gctrpc.CurrencyPair{Base: "ltc", Quote: "usd"},
+		AssetType:     "spot",
+		Levels:        1,
+		BasisPoints:   100,
+		ImpactAmounts: []float64{1, 2},
+	}
+	_, err = s.GetOrderbookAnalytics(context.Background(), req)
+	if err == nil {
+		t.Fatal("expected error when orderbook is not loaded")
+	}
+
+	depth, err := orderbook.DeployDepth("analyticsExchange", cp, asset.Spot)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	depth.LoadSnapshot(orderbook.Items{{Price: 99, Amount: 2}},
+		orderbook.Items{{Price: 101, Amount: 1}, {Price: 102, Amount: 1}},
+		0, time.Now(), true)
+
+	resp, err := s.GetOrderbookAnalytics(context.Background(), req)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if resp.MidPrice != 100 || resp.Liquidity == nil || len(resp.BuyImpact) != 2 || len(resp.SellImpact) != 2 {
+		t.Fatalf("unexpected response %+v", resp)
+	}
+
+	_, err = s.GetOrderbookSpreadStatistics(context.Background(), &gctrpc.GetOrderbookSpreadStatisticsRequest{
+		Exchange:  "analyticsExchange",
+		Pair:      &gctrpc.CurrencyPair{Base: "ltc", Quote: "usd"},
+		AssetType: "spot",
+	})
+	if !errors.Is(err, errInvalidSampleDuration) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errInvalidSampleDuration)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*50)
+	defer cancel()
+	stats, err := s.GetOrderbookSpreadStatistics(ctx, &gctrpc.GetOrderbookSpreadStatisticsRequest{
+		Exchange:      "analyticsExchange",
+		Pair:          &gctrpc.CurrencyPair{Base: "ltc", Quote: "usd"},
+		AssetType:     "spot",
+		SampleSeconds: 10,
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if stats.Samples == 0 || stats.Last != 2 {
+		t.Fatalf("unexpected response %+v", stats)
+	}
+}
+
+func TestGetOrderbookHistory(t *testing.T) {
+	t.Parallel()
+	s := RPCServer{Engine: &Engine{Config: &config.Config{}}}
+	_, err := s.GetOrderbookHistory(context.Background(), &gctrpc.GetOrderbookHistoryRequest{})
+	if !errors.Is(err, errCurrencyPairUnset) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errCurrencyPairUnset)
+	}
+
+	req := &gctrpc.GetOrderbookHistoryRequest{
+		Exchange:  "historyRPC",
+		Pair:      &gctrpc.CurrencyPair{Base: "btc", Quote: "usd"},
+		AssetType: "bad",
+	}
+	_, err = s.GetOrderbookHistory(context.Background(), req)
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, asset.ErrNotSupported)
+	}
+
+	req.AssetType = "spot"
+	_, err = s.GetOrderbookHistory(context.Background(), req)
+	if !errors.Is(err, errInvalidTimes) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errInvalidTimes)
+	}
+
+	now := time.Now().UTC().Truncate(time.Second)
+	req.Start = now.Add(-time.Hour).Format(common.SimpleTimeFormat)
+	req.End = now.Add(time.Second).Format(common.SimpleTimeFormat)
+	_, err = s.GetOrderbookHistory(context.Background(), req)
+	if !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrSubSystemNotStarted)
+	}
+
+	s.orderbookHistory, err = SetupOrderbookHistoryManager(&fakeConsolidatedExchangeManager{},
+		nil,
+		&config.OrderbookHistoryManager{FileStorage: true},
+		t.TempDir())
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	err = s.orderbookHistory.Start()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	defer func() {
+		if err = s.orderbookHistory.Stop(); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	_, err = writeOrderbookHistory(s.orderbookHistory.directory, []orderbooksnapshot.Snapshot{
+		{
+			Exchange:  "historyRPC",
+			Base:      "BTC",
+			Quote:     "USD",
+			Asset:     "spot",
+			Bids:      orderbook.Items{{Price: 100, Amount: 1}},
+			Asks:      orderbook.Items{{Price: 101, Amount: 1}},
+			Timestamp: now,
+		},
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+
+	resp, err := s.GetOrderbookHistory(context.Background(), req)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if len(resp.Snapshots) != 1 {
+		t.Fatalf("expected %v snapshots but received %v", 1, len(resp.Snapshots))
+	}
+	if resp.Snapshots[0].LastUpdated != now.Unix() ||
+		resp.Snapshots[0].Bids[0].Price != 100 ||
+		resp.Snapshots[0].Asks[0].Price != 101 {
+		t.Fatalf("unexpected response %+v", resp.Snapshots[0])
+	}
+}
+
+func setupFuturesRPCTest(t *testing.T) *RPCServer {
+	t.Helper()
+	em := SetupExchangeManager()
+	exch, err := em.NewExchangeByName(testExchange)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := exch.GetBase()
+	b.Name = fakeExchangeName
+	b.Enabled = true
+	cp := currency.NewPairWithDelimiter("BTC", "USD", "-")
+	b.CurrencyPairs.Pairs = make(map[asset.Item]*currency.PairStore)
+	b.CurrencyPairs.Pairs[asset.PerpetualSwap] = &currency.PairStore{
+		AssetEnabled:  convert.BoolPtr(true),
+		Enabled:       currency.Pairs{cp},
+		Available:     currency.Pairs{cp},
+		RequestFormat: &currency.PairFormat{Delimiter: "-", Uppercase: true},
+		ConfigFormat:  &currency.PairFormat{Delimiter: "-", Uppercase: true},
+	}
+	em.Add(fExchange{IBotExchange: exch})
+	return &RPCServer{Engine: &Engine{ExchangeManager: em}}
+}
+
+func TestGetFundingRate(t *testing.T) {
+	t.Parallel()
+	s := setupFuturesRPCTest(t)
+	_, err := s.GetFundingRate(context.Background(), &gctrpc.FuturesMarketDataRequest{})
+	if !errors.Is(err, errCurrencyPairUnset) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errCurrencyPairUnset)
+	}
+
+	req := &gctrpc.FuturesMarketDataRequest{
+		Exchange:  fakeExchangeName,
+		Pair:      &gctrpc.CurrencyPair{Delimiter: "-", Base: "BTC", Quote: "USD"},
+		AssetType: asset.Spot.String(),
+	}
+	_, err = s.GetFundingRate(context.Background(), req)
+	if !errors.Is(err, futures.ErrNotFuturesAsset) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, futures.ErrNotFuturesAsset)
+	}
+
+	req.AssetType = asset.PerpetualSwap.String()
+	resp, err := s.GetFundingRate(context.Background(), req)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if resp.Latest.Rate != 0.0001 || resp.PredictedRate != 0.0002 {
+		t.Errorf("unexpected response %+v", resp)
+	}
+	if resp.NextFundingTime != "2021-01-01 08:00:00 UTC" {
+		t.Errorf("received: '%v' but expected: '%v'", resp.NextFundingTime, "2021-01-01 08:00:00 UTC")
+	}
+}
+
+func TestGetFundingRateHistory(t *testing.T) {
+	t.Parallel()
+	s := setupFuturesRPCTest(t)
+	req := &gctrpc.GetFundingRateHistoryRequest{
+		Exchange:  fakeExchangeName,
+		Pair:      &gctrpc.CurrencyPair{Delimiter: "-", Base: "BTC", Quote: "USD"},
+		AssetType: asset.PerpetualSwap.String(),
+	}
+	_, err := s.GetFundingRateHistory(context.Background(), req)
+	if !errors.Is(err, errInvalidTimes) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errInvalidTimes)
+	}
+
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	req.Start = start.Format(common.SimpleTimeFormat)
+	req.End = start.Add(time.Hour * 24).Format(common.SimpleTimeFormat)
+	resp, err := s.GetFundingRateHistory(context.Background(), req)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if len(resp.Rates) != 1 || resp.Rates[0].Rate != 0.0001 {
+		t.Errorf("unexpected response %+v", resp.Rates)
+	}
+
+	req.Sync = true
+	_, err = s.GetFundingRateHistory(context.Background(), req)
+	if !errors.Is(err, database.ErrDatabaseNotConnected) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, database.ErrDatabaseNotConnected)
+	}
+
+	req.Sync = false
+	req.UseDb = true
+	_, err = s.GetFundingRateHistory(context.Background(), req)
+	if !errors.Is(err, database.ErrDatabaseNotConnected) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, database.ErrDatabaseNotConnected)
+	}
+}
+
+func TestGetOpenInterest(t *testing.T) {
+	t.Parallel()
+	s := setupFuturesRPCTest(t)
+	resp, err := s.GetOpenInterest(context.Background(), &gctrpc.FuturesMarketDataRequest{
+		Exchange:  fakeExchangeName,
+		Pair:      &gctrpc.CurrencyPair{Delimiter: "-", Base: "BTC", Quote: "USD"},
+		AssetType: asset.PerpetualSwap.String(),
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if resp.Amount != 1337 || resp.Value != 1337000 {
+		t.Errorf("unexpected response %+v", resp)
+	}
+}
+
+func TestGetMarkPrice(t *testing.T) {
+	t.Parallel()
+	s := setupFuturesRPCTest(t)
+	resp, err := s.GetMarkPrice(context.Background(), &gctrpc.FuturesMarketDataRequest{
+		Exchange:  fakeExchangeName,
+		Pair:      &gctrpc.CurrencyPair{Delimiter: "-", Base: "BTC", Quote: "USD"},
+		AssetType: asset.PerpetualSwap.String(),
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if resp.MarkPrice != 1337 || resp.IndexPrice != 1336 {
+		t.Errorf("unexpected response %+v", resp)
+	}
+}
+
+func TestGetFuturesPositions(t *testing.T) {
+	t.Parallel()
+	s := setupFuturesRPCTest(t)
+	_, err := s.GetFuturesPositions(context.Background(), &gctrpc.FuturesMarketDataRequest{
+		Exchange:  fakeExchangeName,
+		AssetType: asset.Spot.String(),
+	})
+	if !errors.Is(err, futures.ErrNotFuturesAsset) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, futures.ErrNotFuturesAsset)
+	}
+
+	resp, err := s.GetFuturesPositions(context.Background(), &gctrpc.FuturesMarketDataRequest{
+		Exchange:  fakeExchangeName,
+		AssetType: asset.PerpetualSwap.String(),
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if len(resp.Positions) != 1 {
+		t.Fatalf("received: '%v' but expected: '%v'", len(resp.Positions), 1)
+	}
+	if resp.Positions[0].Side != "long" || resp.Positions[0].MarginType != "cross" {
+		t.Errorf("unexpected response %+v", resp.Positions[0])
+	}
+	if resp.Positions[0].UpdateTime != "2021-01-01 00:00:00 UTC" {
+		t.Errorf("received: '%v' but expected: '%v'", resp.Positions[0].UpdateTime, "2021-01-01 00:00:00 UTC")
+	}
+
+	_, err = s.GetFuturesPositions(context.Background(), &gctrpc.FuturesMarketDataRequest{
+		Exchange:  fakeExchangeName,
+		Pair:      &gctrpc.CurrencyPair{Delimiter: "-", Base: "ETH", Quote: "USD"},
+		AssetType: asset.PerpetualSwap.String(),
+	})
+	if !errors.Is(err, errCurrencyPairInvalid) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errCurrencyPairInvalid)
+	}
+}
+
+func TestChangeFuturesLeverage(t *testing.T) {
+	t.Parallel()
+	s := setupFuturesRPCTest(t)
+	req := &gctrpc.ChangeFuturesLeverageRequest{
+		Exchange:  fakeExchangeName,
+		Pair:      &gctrpc.CurrencyPair{Delimiter: "-", Base: "BTC", Quote: "USD"},
+		AssetType: asset.PerpetualSwap.String(),
+	}
+	_, err := s.ChangeFuturesLeverage(context.Background(), req)
+	if !errors.Is(err, futures.ErrInvalidLeverage) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, futures.ErrInvalidLeverage)
+	}
+
+	req.Leverage = 10
+	_, err = s.ChangeFuturesLeverage(context.Background(), req)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+}
+
+func TestChangeFuturesMarginType(t *testing.T) {
+	t.Parallel()
+	s := setupFuturesRPCTest(t)
+	req := &gctrpc.ChangeFuturesMarginTypeRequest{
+		Exchange:   fakeExchangeName,
+		Pair:       &gctrpc.CurrencyPair{Delimiter: "-", Base: "BTC", Quote: "USD"},
+		AssetType:  asset.PerpetualSwap.String(),
+		MarginType: "isolated",
+	}
+	_, err := s.ChangeFuturesMarginType(context.Background(), req)
+	if !errors.Is(err, futures.ErrMarginTypeNotSupported) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, futures.ErrMarginTypeNotSupported)
+	}
+
+	req.MarginType = "crossed"
+	_, err = s.ChangeFuturesMarginType(context.Background(), req)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+}
+
+func TestGetFuturesMarginInfo(t *testing.T) {
+	t.Parallel()
+	s := setupFuturesRPCTest(t)
+	resp, err := s.GetFuturesMarginInfo(context.Background(), &gctrpc.FuturesMarketDataRequest{
+		Exchange:  fakeExchangeName,
+		Pair:      &gctrpc.CurrencyPair{Delimiter: "-", Base: "BTC", Quote: "USD"},
+		AssetType: asset.PerpetualSwap.String(),
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if resp.Leverage != 5 || resp.MaxLeverage != 100 || resp.Collateral != "BTC" {
+		t.Errorf("unexpected response %+v", resp)
+	}
+}
+
+func TestTransferFunds(t *testing.T) {
+	t.Parallel()
+	s := setupFuturesRPCTest(t)
+	exch, err := s.GetExchangeByName(fakeExchangeName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	exch.GetBase().Features.Supports.RESTCapabilities.FundsTransfer = true
+	s.WithdrawManager, err = SetupWithdrawManager(s.ExchangeManager, nil, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	req := &gctrpc.TransferFundsRequest{
+		Exchange:  fakeExchangeName,
+		Currency:  "btc",
+		Amount:    1,
+		FromAsset: "bad",
+	}
+	_, err = s.TransferFunds(context.Background(), req)
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, asset.ErrNotSupported)
+	}
+
+	req.FromAsset = asset.Spot.String()
+	req.ToAsset = asset.Margin.String()
+	req.Amount = 0
+	_, err = s.TransferFunds(context.Background(), req)
+	if !errors.Is(err, account.ErrInvalidTransferAmount) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, account.ErrInvalidTransferAmount)
+	}
+
+	req.Amount = 1
+	resp, err := s.TransferFunds(context.Background(), req)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if resp.Id != "1337" || resp.Time != "2021-01-01 00:00:00 UTC" {
+		t.Errorf("unexpected response %+v", resp)
+	}
+}
+
+func TestGetMarginInterestRates(t *testing.T) {
+	t.Parallel()
+	s := setupFuturesRPCTest(t)
+	resp, err := s.GetMarginInterestRates(context.Background(), &gctrpc.MarginCurrencyRequest{
+		Exchange: fakeExchangeName,
+		Currency: "BTC",
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if len(resp.Rates) != 1 || resp.Rates[0].Currency != "BTC" || resp.Rates[0].Time != "2021-01-01 00:00:00 UTC" {
+		t.Errorf("unexpected response %+v", resp)
+	}
+}
+
+func TestMarginBorrow(t *testing.T) {
+	t.Parallel()
+	s := setupFuturesRPCTest(t)
+	req := &gctrpc.MarginLoanRequest{
+		Exchange: fakeExchangeName,
+		Currency: "BTC",
+	}
+	_, err := s.MarginBorrow(context.Background(), req)
+	if !errors.Is(err, margin.ErrInvalidAmount) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, margin.ErrInvalidAmount)
+	}
+
+	req.Pair = &gctrpc.CurrencyPair{Delimiter: "-", Base: "BTC", Quote: "USD"}
+	req.Amount = 1
+	_, err = s.MarginBorrow(context.Background(), req)
+	if !errors.Is(err, errAssetTypeDisabled) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errAssetTypeDisabled)
+	}
+
+	req.Pair = nil
+	resp, err := s.MarginBorrow(context.Background(), req)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if resp.Id != "1337" || resp.Currency != "BTC" || resp.Amount != 1 {
+		t.Errorf("unexpected response %+v", resp)
+	}
+}
+
+func TestMarginRepay(t *testing.T) {
+	t.Parallel()
+	s := setupFuturesRPCTest(t)
+	_, err := s.MarginRepay(context.Background(), &gctrpc.MarginLoanRequest{
+		Exchange: fakeExchangeName,
+		Currency: "BTC",
+		Amount:   1,
+	})
+	if !errors.Is(err, common.ErrNotYetImplemented) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, common.ErrNotYetImplemented)
+	}
+}
+
+func TestSubmitMarginLendOffer(t *testing.T) {
+	t.Parallel()
+	s := setupFuturesRPCTest(t)
+	req := &gctrpc.SubmitMarginLendOfferRequest{
+		Exchange: fakeExchangeName,
+		Currency: "BTC",
+		Amount:   1,
+	}
+	_, err := s.SubmitMarginLendOffer(context.Background(), req)
+	if err == nil {
+		t.Fatal("expected error when rate is unset")
+	}
+
+	req.Rate = 0.0002
+	resp, err := s.SubmitMarginLendOffer(context.Background(), req)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if resp.Id != "1338" {
+		t.Errorf("unexpected response %+v", resp)
+	}
+}
+
+func TestCancelMarginLendOffer(t *testing.T) {
+	t.Parallel()
+	s := setupFuturesRPCTest(t)
+	_, err := s.CancelMarginLendOffer(context.Background(), &gctrpc.CancelMarginLendOfferRequest{
+		Exchange: fakeExchangeName,
+		Currency: "BTC",
+		Id:       "1338",
+	})
+	if !errors.Is(err, common.ErrNotYetImplemented) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, common.ErrNotYetImplemented)
+	}
+}
+
+func TestGetMarginLoans(t *testing.T) {
+	t.Parallel()
+	s := setupFuturesRPCTest(t)
+	resp, err := s.GetMarginLoans(context.Background(), &gctrpc.MarginCurrencyRequest{
+		Exchange: fakeExchangeName,
+		Currency: "BTC",
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if len(resp.Loans) != 1 ||
+		resp.Loans[0].Side != "lend" ||
+		resp.Loans[0].Status != "offered" ||
+		resp.Loans[0].Time != "" {
+		t.Errorf("unexpected response %+v", resp)
+	}
+}
+
+func TestGetMarginInterestHistory(t *testing.T) {
+	t.Parallel()
+	s := setupFuturesRPCTest(t)
+	req := &gctrpc.GetMarginInterestHistoryRequest{
+		Exchange: fakeExchangeName,
+		Currency: "BTC",
+	}
+	_, err := s.GetMarginInterestHistory(context.Background(), req)
+	if !errors.Is(err, errInvalidTimes) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errInvalidTimes)
+	}
+
+	req.Start = "2021-01-01 00:00:00"
+	req.End = "2021-01-02 00:00:00"
+	resp, err := s.GetMarginInterestHistory(context.Background(), req)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if len(resp.Payments) != 1 || resp.Payments[0].Time != "2021-01-01 00:00:00 UTC" {
+		t.Errorf("unexpected response %+v", resp)
+	}
+}
+
+func TestGetFeeSchedule(t *testing.T) {
+	t.Parallel()
+	s := setupFuturesRPCTest(t)
+	_, err := s.GetFeeSchedule(context.Background(), &gctrpc.GetFeeScheduleRequest{})
+	if !errors.Is(err, errExchangeNameIsEmpty) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errExchangeNameIsEmpty)
+	}
+
+	exch, err := s.GetExchangeByName(fakeExchangeName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b := exch.GetBase()
+	b.Fees = fee.NewDefinitions()
+	req := &gctrpc.GetFeeScheduleRequest{Exchange: fakeExchangeName}
+	_, err = s.GetFeeSchedule(context.Background(), req)
+	if !errors.Is(err, fee.ErrScheduleNotLoaded) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, fee.ErrScheduleNotLoaded)
+	}
+
+	err = b.Fees.Load(&fee.Schedule{
+		Tier: "VIP1",
+		Commissions: map[asset.Item]fee.Commission{
+			asset.Spot:          {Maker: 0.001, Taker: 0.002},
+			asset.PerpetualSwap: {Maker: -0.0001, Taker: 0.0005},
+		},
+		PairCommissions: []fee.PairCommission{{
+			Asset:      asset.Spot,
+			Pair:       currency.NewPair(currency.BTC, currency.USDT),
+			Commission: fee.Commission{Taker: 0.001},
+		}},
+		Tiers:     []fee.Tier{{Name: "VIP1", Asset: asset.Spot, MinimumVolume: 50}},
+		Discount:  fee.Discount{Currency: currency.BNB, Rate: 0.25, Enabled: true},
+		Transfers: []fee.Transfer{{Currency: currency.BTC, Network: "BTC", Withdrawal: 0.0005}},
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	resp, err := s.GetFeeSchedule(context.Background(), req)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if resp.Tier != "VIP1" ||
+		len(resp.Commissions) != 2 ||
+		len(resp.PairCommissions) != 1 ||
+		len(resp.Tiers) != 1 ||
+		len(resp.Transfers) != 1 ||
+		!resp.Discount.Enabled {
+		t.Errorf("unexpected response %v", resp)
+	}
+	if resp.Commissions[0].Asset != asset.PerpetualSwap.String() {
+		t.Errorf("received '%v' expected '%v'", resp.Commissions[0].Asset, asset.PerpetualSwap)
+	}
+}
+
+func TestGetAvailableTransferChains(t *testing.T) {
+	t.Parallel()
+	s := setupFuturesRPCTest(t)
+	_, err := s.GetAvailableTransferChains(context.Background(), &gctrpc.GetAvailableTransferChainsRequest{})
+	if !errors.Is(err, errExchangeNameIsEmpty) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errExchangeNameIsEmpty)
+	}
+
+	_, err = s.GetAvailableTransferChains(context.Background(), &gctrpc.GetAvailableTransferChainsRequest{
+		Exchange: fakeExchangeName,
+	})
+	if !errors.Is(err, errCurrencyNotSpecified) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errCurrencyNotSpecified)
+	}
+
+	resp, err := s.GetAvailableTransferChains(context.Background(), &gctrpc.GetAvailableTransferChainsRequest{
+		Exchange:       fakeExchangeName,
+		Cryptocurrency: "usdt",
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if len(resp.Chains) != 2 {
+		t.Fatalf("received: '%v' but expected: '%v'", len(resp.Chains), 2)
+	}
+	if !resp.Chains[0].IsDefault || resp.Chains[0].Chain != "ERC20" || resp.Chains[0].Confirmations != 12 {
+		t.Errorf("unexpected chain %v", resp.Chains[0])
+	}
+}
+
+func TestGetCryptocurrencyDepositAddress(t *testing.T) {
+	t.Parallel()
+	s := setupFuturesRPCTest(t)
+	resp, err := s.GetCryptocurrencyDepositAddress(context.Background(), &gctrpc.GetCryptocurrencyDepositAddressRequest{
+		Exchange:       fakeExchangeName,
+		Cryptocurrency: "usdt",
+		Chain:          "TRC20",
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if resp.Chain != "TRC20" || resp.Address == "" {
+		t.Errorf("unexpected response %v", resp)
+	}
+
+	s.DepositAddressManager = SetupDepositAddressManager()
+	err = s.DepositAddressManager.Sync(map[string]map[string][]deposit.Address{
+		fakeExchangeName: {
+			"USDT": {{Address: "1337", Chain: "ERC20"}, {Address: "1338", Chain: "TRC20"}},
+		},
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	resp, err = s.GetCryptocurrencyDepositAddress(context.Background(), &gctrpc.GetCryptocurrencyDepositAddressRequest{
+		Exchange:       fakeExchangeName,
+		Cryptocurrency: "usdt",
+		Chain:          "TRC20",
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if resp.Address != "1338" {
+		t.Errorf("received: '%v' but expected: '%v'", resp.Address, "1338")
+	}
+
+	addrs, err := s.GetCryptocurrencyDepositAddresses(context.Background(), &gctrpc.GetCryptocurrencyDepositAddressesRequest{
+		Exchange: fakeExchangeName,
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if len(addrs.Addresses["USDT"].Addresses) != 2 {
+		t.Errorf("unexpected response %v", addrs)
+	}
+}
+
+func TestSubmitBatchOrders(t *testing.T) {
+	t.Parallel()
+	s := setupFuturesRPCTest(t)
+	var wg sync.WaitGroup
+	var err error
+	s.OrderManager, err = SetupOrderManager(s.ExchangeManager, &CommunicationManager{}, &wg, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	s.OrderManager.started = 1
+
+	_, err = s.SubmitBatchOrders(context.Background(), &gctrpc.SubmitBatchOrdersRequest{})
+	if !errors.Is(err, order.ErrNoOrdersInBatch) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, order.ErrNoOrdersInBatch)
+	}
+
+	req := &gctrpc.SubmitBatchOrdersRequest{
+		Exchange:  fakeExchangeName,
+		AssetType: asset.PerpetualSwap.String(),
+		Orders:    []*gctrpc.BatchOrder{{}},
+	}
+	_, err = s.SubmitBatchOrders(context.Background(), req)
+	if !errors.Is(err, errCurrencyPairUnset) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errCurrencyPairUnset)
+	}
+
+	pair := &gctrpc.CurrencyPair{Delimiter: "-", Base: "BTC", Quote: "USD"}
+	req.Orders = []*gctrpc.BatchOrder{
+		{Pair: pair, Side: order.Buy.String(), OrderType: order.Limit.String(), Amount: 1, Price: 100, ClientId: "1"},
+		{Pair: pair, Side: order.Buy.String(), OrderType: order.Limit.String(), Amount: 1, Price: 99, ClientId: "reject"},
+		{Pair: pair, Side: order.Buy.String(), OrderType: order.Limit.String(), Price: 98, ClientId: "3"},
+	}
+	resp, err := s.SubmitBatchOrders(context.Background(), req)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if len(resp.Results) != len(req.Orders) {
+		t.Fatalf("received %d results but expected %d", len(resp.Results), len(req.Orders))
+	}
+	if !resp.Results[0].OrderPlaced || resp.Results[0].OrderId != "fake1" || resp.Results[0].InternalOrderId == "" {
+		t.Errorf("unexpected result %+v", resp.Results[0])
+	}
+	if resp.Results[1].OrderPlaced || resp.Results[1].Error != errBatchRejected.Error() {
+		t.Errorf("unexpected result %+v", resp.Results[1])
+	}
+	if resp.Results[2].OrderPlaced || resp.Results[2].Error == "" {
+		t.Errorf("unexpected result %+v", resp.Results[2])
+	}
+}
+
+func TestModifyBatchOrders(t *testing.T) {
+	t.Parallel()
+	s := setupFuturesRPCTest(t)
+	var wg sync.WaitGroup
+	var err error
+	s.OrderManager, err = SetupOrderManager(s.ExchangeManager, &CommunicationManager{}, &wg, false)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	s.OrderManager.started = 1
+
+	_, err = s.ModifyBatchOrders(context.Background(), &gctrpc.ModifyBatchOrdersRequest{})
+	if !errors.Is(err, order.ErrNoOrdersInBatch) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, order.ErrNoOrdersInBatch)
+	}
+
+	req := &gctrpc.ModifyBatchOrdersRequest{
+		Exchange: fakeExchangeName,
+		Asset:    asset.Spot.String(),
+		Orders:   []*gctrpc.BatchModifyOrder{{OrderId: "1", Price: 101}},
+	}
+	_, err = s.ModifyBatchOrders(context.Background(), req)
+	if !errors.Is(err, errAssetTypeDisabled) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errAssetTypeDisabled)
+	}
+
+	req.Asset = asset.PerpetualSwap.String()
+	resp, err := s.ModifyBatchOrders(context.Background(), req)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if len(resp.Results) != 1 || resp.Results[0].OrderId != "1" || resp.Results[0].Error == "" {
+		t.Errorf("unexpected response %+v", resp)
+	}
+}
+
+func TestGetFillStream(t *testing.T) {
+	t.Parallel()
+	s := setupFuturesRPCTest(t)
+	err := s.GetFillStream(&gctrpc.GetFillStreamRequest{}, nil)
+	if !errors.Is(err, errExchangeNameUnset) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errExchangeNameUnset)
+	}
+	err = s.GetFillStream(&gctrpc.GetFillStreamRequest{Exchange: "bruh"}, nil)
+	if !errors.Is(err, ErrExchangeNotFound) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrExchangeNotFound)
+	}
+	err = s.GetFillStream(&gctrpc.GetFillStreamRequest{
+		Exchange:  fakeExchangeName,
+		AssetType: "bruh",
+	}, nil)
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, asset.ErrNotSupported)
+	}
+}
+
+func TestHoldingsByAsset(t *testing.T) {
+	t.Parallel()
+	h := holdingsByAsset(account.Holdings{
+		Exchange: fakeExchangeName,
+		Accounts: []account.SubAccount{
+			{ID: "spot", AssetType: asset.Spot},
+			{ID: "futures", AssetType: asset.Futures},
+			{ID: "unset"},
+		},
+	}, asset.Spot)
+	if len(h.Accounts) != 2 || h.Accounts[0].ID != "spot" || h.Accounts[1].ID != "unset" {
+		t.Fatalf("received unexpected accounts %+v", h.Accounts)
+	}
+}
+
+func TestGetExchangeCapabilities(t *testing.T) {
+	t.Parallel()
+	s := setupFuturesRPCTest(t)
+	_, err := s.GetExchangeCapabilities(context.Background(), &gctrpc.GenericExchangeNameRequest{})
+	if !errors.Is(err, errExchangeNameUnset) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errExchangeNameUnset)
+	}
+
+	_, err = s.GetExchangeCapabilities(context.Background(), &gctrpc.GenericExchangeNameRequest{Exchange: "bruh"})
+	if !errors.Is(err, ErrExchangeNotFound) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrExchangeNotFound)
+	}
+
+	resp, err := s.GetExchangeCapabilities(context.Background(), &gctrpc.GenericExchangeNameRequest{Exchange: fakeExchangeName})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if resp.Exchange != fakeExchangeName {
+		t.Errorf("received: '%v' but expected: '%v'", resp.Exchange, fakeExchangeName)
+	}
+	if len(resp.Assets) != 1 || resp.Assets[0] != asset.PerpetualSwap.String() {
+		t.Errorf("received: '%v' but expected: '%v'", resp.Assets, asset.PerpetualSwap)
+	}
+	if len(resp.Methods) == 0 {
+		t.Error("expected supported methods")
+	}
+}
+
+func TestExportData(t *testing.T) {
+	t.Parallel()
+	s := setupFuturesRPCTest(t)
+	s.Settings.DataDir = t.TempDir()
+	_, err := s.ExportData(context.Background(), &gctrpc.ExportDataRequest{})
+	if !errors.Is(err, errExchangeNameUnset) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errExchangeNameUnset)
+	}
+
+	req := &gctrpc.ExportDataRequest{Exchange: fakeExchangeName}
+	_, err = s.ExportData(context.Background(), req)
+	if !errors.Is(err, errCurrencyPairUnset) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errCurrencyPairUnset)
+	}
+
+	req.Pair = &gctrpc.CurrencyPair{Base: "BTC", Quote: "USD"}
+	req.AssetType = "bruh"
+	_, err = s.ExportData(context.Background(), req)
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, asset.ErrNotSupported)
+	}
+
+	req.AssetType = asset.Spot.String()
+	_, err = s.ExportData(context.Background(), req)
+	if !errors.Is(err, errInvalidTimes) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errInvalidTimes)
+	}
+
+	req.Start = dataExportStart.Format(common.SimpleTimeFormat)
+	req.End = dataExportStart.AddDate(0, 0, 1).Format(common.SimpleTimeFormat)
+	req.DataType = "bruh"
+	_, err = s.ExportData(context.Background(), req)
+	if !errors.Is(err, errInvalidDataExportType) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errInvalidDataExportType)
+	}
+
+	req.DataType = string(DataExportTrades)
+	req.Format = string(DataExportParquet)
+	_, err = s.ExportData(context.Background(), req)
+	if !errors.Is(err, database.ErrDatabaseNotConnected) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, database.ErrDatabaseNotConnected)
+	}
+}
+
+func TestGetDataQualityReport(t *testing.T) {
+	t.Parallel()
+	s := setupFuturesRPCTest(t)
+	_, err := s.GetDataQualityReport(context.Background(), &gctrpc.GetDataQualityReportRequest{})
+	if !errors.Is(err, errExchangeNameUnset) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errExchangeNameUnset)
+	}
+
+	req := &gctrpc.GetDataQualityReportRequest{Exchange: fakeExchangeName}
+	_, err = s.GetDataQualityReport(context.Background(), req)
+	if !errors.Is(err, errCurrencyPairUnset) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errCurrencyPairUnset)
+	}
+
+	req.Pair = &gctrpc.CurrencyPair{Base: "BTC", Quote: "USD"}
+	req.AssetType = "bruh"
+	_, err = s.GetDataQualityReport(context.Background(), req)
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, asset.ErrNotSupported)
+	}
+
+	req.AssetType = asset.Spot.String()
+	_, err = s.GetDataQualityReport(context.Background(), req)
+	if !errors.Is(err, errInvalidTimes) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errInvalidTimes)
+	}
+
+	req.Start = dataQualityStart.Format(common.SimpleTimeFormat)
+	req.End = dataQualityStart.AddDate(0, 0, 1).Format(common.SimpleTimeFormat)
+	req.DataType = "bruh"
+	_, err = s.GetDataQualityReport(context.Background(), req)
+	if !errors.Is(err, errInvalidDataQualityType) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errInvalidDataQualityType)
+	}
+
+	req.DataType = string(DataQualityCandles)
+	req.Interval = int64(kline.OneHour)
+	_, err = s.GetDataQualityReport(context.Background(), req)
+	if !errors.Is(err, database.ErrDatabaseNotConnected) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, database.ErrDatabaseNotConnected)
+	}
+}
+
+func TestGetCompositeIndexPrices(t *testing.T) {
+	t.Parallel()
+	s := RPCServer{Engine: &Engine{Config: &config.Config{}}}
+	_, err := s.GetCompositeIndexPrices(context.Background(), &gctrpc.GetCompositeIndexPricesRequest{})
+	if !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrSubSystemNotStarted)
+	}
+
+	cfg := compositeIndexTestConfig()
+	cfg.ExchangeName = "rpccompositeindextest"
+	c, err := SetupCompositeIndexManager(&fakeConsolidatedExchangeManager{}, nil, cfg)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	c.started = 1
+	s.compositeIndex = c
+
+	req := &gctrpc.GetCompositeIndexPricesRequest{
+		Pair:      &gctrpc.CurrencyPair{Base: "BTC", Quote: "USD"},
+		AssetType: "bruh",
+	}
+	_, err = s.GetCompositeIndexPrices(context.Background(), req)
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, asset.ErrNotSupported)
+	}
+
+	req.AssetType = ""
+	_, err = s.GetCompositeIndexPrices(context.Background(), req)
+	if !errors.Is(err, errCompositeIndexPriceNotReady) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errCompositeIndexPriceNotReady)
+	}
+
+	now := time.Now()
+	p := currency.NewPair(currency.BTC, currency.USD)
+	c.updateTicker(&ticker.Price{ExchangeName: "exchA", Pair: p, AssetType: asset.Spot, Last: 100, LastUpdated: now})
+	c.updateTicker(&ticker.Price{ExchangeName: "exchB", Pair: p, AssetType: asset.Spot, Last: 102, LastUpdated: now})
+	c.publish(c.indices[0], now)
+
+	resp, err := s.GetCompositeIndexPrices(context.Background(), req)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if len(resp.Prices) != 1 || resp.Prices[0].Price != 101 || len(resp.Prices[0].Sources) != 2 {
+		t.Errorf("unexpected response %v", resp)
+	}
+
+	resp, err = s.GetCompositeIndexPrices(context.Background(), &gctrpc.GetCompositeIndexPricesRequest{})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if len(resp.Prices) != 1 {
+		t.Errorf("received '%v' expected '%v'", len(resp.Prices), 1)
+	}
+}
+
+func TestGetLiveCandleStream(t *testing.T) {
+	t.Parallel()
+	s := setupFuturesRPCTest(t)
+	err := s.GetLiveCandleStream(&gctrpc.GetLiveCandleStreamRequest{}, nil)
+	if !errors.Is(err, errExchangeNameUnset) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errExchangeNameUnset)
+	}
+	err = s.GetLiveCandleStream(&gctrpc.GetLiveCandleStreamRequest{Exchange: "bruh"}, nil)
+	if !errors.Is(err, ErrExchangeNotFound) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrExchangeNotFound)
+	}
+	req := &gctrpc.GetLiveCandleStreamRequest{Exchange: fakeExchangeName}
+	err = s.GetLiveCandleStream(req, nil)
+	if !errors.Is(err, errCurrencyPairUnset) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errCurrencyPairUnset)
+	}
+	req.Pair = &gctrpc.CurrencyPair{Base: "BTC", Quote: "USD"}
+	req.AssetType = "bruh"
+	err = s.GetLiveCandleStream(req, nil)
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, asset.ErrNotSupported)
+	}
+	req.AssetType = asset.Spot.String()
+	req.Interval = int64(kline.OneMin)
+	err = s.GetLiveCandleStream(req, nil)
+	if !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrSubSystemNotStarted)
+	}
+}
+
+func TestRunRetentionPolicies(t *testing.T) {
+	t.Parallel()
+	s := &RPCServer{Engine: &Engine{Config: &config.Config{}}}
+	_, err := s.RunRetentionPolicies(context.Background(), &gctrpc.RunRetentionPoliciesRequest{})
+	if !errors.Is(err, errNoRetentionPolicies) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errNoRetentionPolicies)
+	}
+
+	s.Config.Retention.Policies = []config.RetentionPolicy{{DataType: config.RetentionTrades, MaxAge: time.Hour}}
+	_, err = s.RunRetentionPolicies(context.Background(), &gctrpc.RunRetentionPoliciesRequest{})
+	if !errors.Is(err, database.ErrDatabaseNotConnected) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, database.ErrDatabaseNotConnected)
+	}
+
+	s.retention, _ = setupRetentionTest(t)
+	resp, err := s.RunRetentionPolicies(context.Background(), &gctrpc.RunRetentionPoliciesRequest{DryRun: true})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if !resp.DryRun || len(resp.Results) != 2 {
+		t.Fatalf("unexpected response %+v", resp)
+	}
+	if resp.Results[0].DataType != config.RetentionCandles ||
+		resp.Results[0].Interval != int64(kline.OneMin) ||
+		resp.Results[0].Pair.Base != "BTC" ||
+		resp.Results[0].Cutoff != "2020-01-10 00:00:00" ||
+		resp.Results[0].Expired != 117 {
+		t.Errorf("unexpected result %+v", resp.Results[0])
+	}
+}
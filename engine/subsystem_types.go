@@ -84,3 +84,23 @@ type iCurrencyPairSyncer interface {
 type iDatabaseConnectionManager interface {
 	GetInstance() database.IDatabase
 }
+
+// iCompositeIndexManager defines a limited scoped composite index manager
+type iCompositeIndexManager interface {
+	IsRunning() bool
+	GetExchangeName() string
+}
+
+// iDeadMansSwitchOrderManager defines a limited scoped order manager for
+// tracking open orders and cancelling them
+type iDeadMansSwitchOrderManager interface {
+	IsRunning() bool
+	GetOrdersActive(*order.Filter) ([]order.Detail, error)
+	Cancel(context.Context, *order.Cancel) error
+}
+
+// iConnectionManager defines a limited scoped connection manager
+type iConnectionManager interface {
+	IsRunning() bool
+	IsOnline() bool
+}
@@ -0,0 +1,267 @@
+package engine
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	exchangeDB "github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/symbolhistory"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// SetupSymbolHistoryManager applies configuration parameters before running
+func SetupSymbolHistoryManager(em iExchangeManager, dcm iDatabaseConnectionManager, cfg *config.SymbolHistoryManager) (*SymbolHistoryManager, error) {
+	if em == nil {
+		return nil, errNilExchangeManager
+	}
+	if dcm == nil {
+		return nil, errNilDatabaseConnectionManager
+	}
+	if cfg == nil {
+		return nil, errNilSymbolHistoryConfig
+	}
+	m := &SymbolHistoryManager{
+		shutdown:                   make(chan struct{}),
+		iExchangeManager:           em,
+		iDatabaseConnectionManager: dcm,
+		verbose:                    cfg.Verbose,
+		checkInterval:              cfg.CheckInterval,
+		insertExchange:             exchangeDB.Insert,
+		now:                        time.Now,
+	}
+	m.service = m.symbolHistoryService
+	if m.checkInterval <= 0 {
+		m.checkInterval = time.Hour
+	}
+	return m, nil
+}
+
+// Start runs the subsystem, available pairs are recorded immediately and then
+// on every check interval
+func (m *SymbolHistoryManager) Start() error {
+	if m == nil {
+		return fmt.Errorf("%s %w", SymbolHistoryManagerName, ErrNilSubsystem)
+	}
+	if !atomic.CompareAndSwapInt32(&m.started, 0, 1) {
+		return fmt.Errorf("%s %w", SymbolHistoryManagerName, ErrSubSystemAlreadyStarted)
+	}
+	log.Debugf(log.DatabaseMgr, "Symbol history manager %s", MsgSubSystemStarting)
+	m.wg.Add(1)
+	go m.run()
+	log.Debugf(log.DatabaseMgr, "Symbol history manager %s", MsgSubSystemStarted)
+	return nil
+}
+
+// Stop stops the subsystem, waiting for any run in progress to complete
+func (m *SymbolHistoryManager) Stop() error {
+	if m == nil {
+		return fmt.Errorf("%s %w", SymbolHistoryManagerName, ErrNilSubsystem)
+	}
+	if atomic.LoadInt32(&m.started) == 0 {
+		return fmt.Errorf("%s %w", SymbolHistoryManagerName, ErrSubSystemNotStarted)
+	}
+	log.Debugf(log.DatabaseMgr, "Symbol history manager %s", MsgSubSystemShuttingDown)
+	close(m.shutdown)
+	m.wg.Wait()
+	m.shutdown = make(chan struct{})
+	atomic.StoreInt32(&m.started, 0)
+	log.Debugf(log.DatabaseMgr, "Symbol history manager %s", MsgSubSystemShutdown)
+	return nil
+}
+
+// IsRunning safely checks whether the subsystem is running
+func (m *SymbolHistoryManager) IsRunning() bool {
+	if m == nil {
+		return false
+	}
+	return atomic.LoadInt32(&m.started) == 1
+}
+
+func (m *SymbolHistoryManager) run() {
+	defer m.wg.Done()
+	t := time.NewTicker(m.checkInterval)
+	defer t.Stop()
+	for {
+		_, err := m.Record("")
+		if err != nil {
+			log.Errorf(log.DatabaseMgr, "Symbol history manager record error: %v", err)
+		}
+		select {
+		case <-m.shutdown:
+			return
+		case <-t.C:
+		}
+	}
+}
+
+// Record compares the available pairs of an exchange, or every loaded
+// exchange when the name is empty, against the stored symbol history. Pairs
+// which have become available are recorded as listed and pairs which are no
+// longer available are recorded as delisted. The recorded events are returned
+func (m *SymbolHistoryManager) Record(exchangeName string) ([]symbolhistory.Event, error) {
+	if m == nil {
+		return nil, fmt.Errorf("%s %w", SymbolHistoryManagerName, ErrNilSubsystem)
+	}
+	svc, err := m.service()
+	if err != nil {
+		return nil, err
+	}
+	if !atomic.CompareAndSwapInt32(&m.inRun, 0, 1) {
+		return nil, errSymbolHistoryRunInProgress
+	}
+	defer atomic.StoreInt32(&m.inRun, 0)
+
+	var exchanges []exchange.IBotExchange
+	if exchangeName != "" {
+		var exch exchange.IBotExchange
+		exch, err = m.GetExchangeByName(exchangeName)
+		if err != nil {
+			return nil, err
+		}
+		exchanges = append(exchanges, exch)
+	} else {
+		exchanges, err = m.GetExchanges()
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	now := m.now().UTC()
+	var recorded []symbolhistory.Event
+	for i := range exchanges {
+		events, err := m.recordExchange(svc, exchanges[i], now)
+		if err != nil {
+			if exchangeName != "" {
+				return nil, err
+			}
+			log.Errorf(log.DatabaseMgr,
+				"Symbol history manager %s error: %v",
+				exchanges[i].GetName(),
+				err)
+			continue
+		}
+		for j := range events {
+			if m.verbose {
+				log.Debugf(log.DatabaseMgr,
+					"Symbol history manager %s %s %s-%s %s",
+					events[j].Exchange,
+					events[j].Asset,
+					events[j].Base,
+					events[j].Quote,
+					events[j].Event)
+			}
+			recorded = append(recorded, *events[j])
+		}
+	}
+	return recorded, nil
+}
+
+// recordExchange stores listing and delisting events for each enabled asset
+// of an exchange. Assets without available pairs are skipped so that pairs
+// which have not been fetched are not recorded as delisted
+func (m *SymbolHistoryManager) recordExchange(svc symbolhistory.IDBService, exch exchange.IBotExchange, now time.Time) ([]*symbolhistory.Event, error) {
+	name := exch.GetName()
+	var events []*symbolhistory.Event
+	assets := exch.GetAssetTypes(true)
+	for i := range assets {
+		available, err := exch.GetAvailablePairs(assets[i])
+		if err != nil {
+			return nil, err
+		}
+		if len(available) == 0 {
+			continue
+		}
+		history, err := svc.GetEvents(name, assets[i].String(), "", "")
+		if errors.Is(err, sql.ErrNoRows) {
+			// an exchange which has never been stored has no history
+			history = nil
+			err = m.insertExchange(exchangeDB.Details{Name: name})
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		listed := listedSymbols(history)
+		for j := range available {
+			symbol := [2]string{
+				strings.ToUpper(available[j].Base.String()),
+				strings.ToUpper(available[j].Quote.String()),
+			}
+			if _, ok := listed[symbol]; ok {
+				delete(listed, symbol)
+				continue
+			}
+			events = append(events, &symbolhistory.Event{
+				Exchange:      name,
+				Asset:         assets[i].String(),
+				Base:          symbol[0],
+				Quote:         symbol[1],
+				Event:         symbolhistory.Listed,
+				EffectiveDate: now,
+			})
+		}
+		delisted := make([][2]string, 0, len(listed))
+		for symbol := range listed {
+			delisted = append(delisted, symbol)
+		}
+		sort.Slice(delisted, func(i, j int) bool {
+			if delisted[i][0] == delisted[j][0] {
+				return delisted[i][1] < delisted[j][1]
+			}
+			return delisted[i][0] < delisted[j][0]
+		})
+		for j := range delisted {
+			events = append(events, &symbolhistory.Event{
+				Exchange:      name,
+				Asset:         assets[i].String(),
+				Base:          delisted[j][0],
+				Quote:         delisted[j][1],
+				Event:         symbolhistory.Delisted,
+				EffectiveDate: now,
+			})
+		}
+	}
+	if len(events) == 0 {
+		return nil, nil
+	}
+	return events, svc.Insert(events...)
+}
+
+// listedSymbols replays symbol history events in order, returning the base
+// and quote of each symbol which is currently listed. A rename delists the
+// previous symbol and lists the new symbol
+func listedSymbols(history []symbolhistory.Event) map[[2]string]struct{} {
+	listed := make(map[[2]string]struct{})
+	for i := range history {
+		symbol := [2]string{history[i].Base, history[i].Quote}
+		switch history[i].Event {
+		case symbolhistory.Listed:
+			listed[symbol] = struct{}{}
+		case symbolhistory.Delisted:
+			delete(listed, symbol)
+		case symbolhistory.Renamed:
+			delete(listed, symbol)
+			listed[[2]string{history[i].NewBase, history[i].NewQuote}] = struct{}{}
+		}
+	}
+	return listed
+}
+
+func (m *SymbolHistoryManager) symbolHistoryService() (symbolhistory.IDBService, error) {
+	svc, err := symbolhistory.Setup(m.GetInstance())
+	if err != nil {
+		return nil, err
+	}
+	if svc == nil {
+		return nil, database.ErrDatabaseNotConnected
+	}
+	return svc, nil
+}
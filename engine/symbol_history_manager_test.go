@@ -0,0 +1,231 @@
+package engine
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	exchangeDB "github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/symbolhistory"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+type fakeSymbolHistoryExchange struct {
+	fakeOrderbookHistoryExchange
+}
+
+func (f *fakeSymbolHistoryExchange) GetAvailablePairs(a asset.Item) (currency.Pairs, error) {
+	return f.GetEnabledPairs(a)
+}
+
+// fakeSymbolHistoryService stores events in memory
+type fakeSymbolHistoryService struct {
+	events   []symbolhistory.Event
+	noExists bool
+}
+
+func (f *fakeSymbolHistoryService) Insert(events ...*symbolhistory.Event) error {
+	for i := range events {
+		f.events = append(f.events, *events[i])
+	}
+	return nil
+}
+
+func (f *fakeSymbolHistoryService) GetEvents(exchangeName, assetType, _, _ string) ([]symbolhistory.Event, error) {
+	if f.noExists {
+		f.noExists = false
+		return nil, fmt.Errorf("could not retrieve exchange '%v', %w", exchangeName, sql.ErrNoRows)
+	}
+	var resp []symbolhistory.Event
+	for i := range f.events {
+		if f.events[i].Exchange == exchangeName && f.events[i].Asset == assetType {
+			resp = append(resp, f.events[i])
+		}
+	}
+	return resp, nil
+}
+
+func (f *fakeSymbolHistoryService) Segments(_, _, base, quote string, startDate, endDate time.Time) ([]symbolhistory.Segment, error) {
+	return []symbolhistory.Segment{{Base: base, Quote: quote, Start: startDate, End: endDate}}, nil
+}
+
+func TestSetupSymbolHistoryManager(t *testing.T) {
+	t.Parallel()
+	_, err := SetupSymbolHistoryManager(nil, nil, nil)
+	if !errors.Is(err, errNilExchangeManager) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errNilExchangeManager)
+	}
+
+	_, err = SetupSymbolHistoryManager(&fakeConsolidatedExchangeManager{}, nil, nil)
+	if !errors.Is(err, errNilDatabaseConnectionManager) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errNilDatabaseConnectionManager)
+	}
+
+	_, err = SetupSymbolHistoryManager(&fakeConsolidatedExchangeManager{}, &fakeDatabaseConnectionManager{}, nil)
+	if !errors.Is(err, errNilSymbolHistoryConfig) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errNilSymbolHistoryConfig)
+	}
+
+	m, err := SetupSymbolHistoryManager(&fakeConsolidatedExchangeManager{}, &fakeDatabaseConnectionManager{}, &config.SymbolHistoryManager{})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if m.checkInterval != time.Hour {
+		t.Errorf("received: '%v' but expected: '%v'", m.checkInterval, time.Hour)
+	}
+}
+
+func TestSymbolHistoryManagerStartStop(t *testing.T) {
+	t.Parallel()
+	err := (*SymbolHistoryManager)(nil).Start()
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrNilSubsystem)
+	}
+
+	err = (*SymbolHistoryManager)(nil).Stop()
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrNilSubsystem)
+	}
+
+	if (*SymbolHistoryManager)(nil).IsRunning() {
+		t.Error("expected false")
+	}
+
+	m, err := SetupSymbolHistoryManager(&fakeConsolidatedExchangeManager{}, &fakeDatabaseConnectionManager{}, &config.SymbolHistoryManager{})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+
+	err = m.Stop()
+	if !errors.Is(err, ErrSubSystemNotStarted) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrSubSystemNotStarted)
+	}
+
+	err = m.Start()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+
+	err = m.Start()
+	if !errors.Is(err, ErrSubSystemAlreadyStarted) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrSubSystemAlreadyStarted)
+	}
+
+	if !m.IsRunning() {
+		t.Error("expected true")
+	}
+
+	err = m.Stop()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+}
+
+func TestSymbolHistoryManagerRecord(t *testing.T) {
+	t.Parallel()
+	_, err := (*SymbolHistoryManager)(nil).Record("")
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrNilSubsystem)
+	}
+
+	exch := &fakeSymbolHistoryExchange{}
+	exch.name = "symbolhistorytest"
+	exch.pairs = currency.Pairs{
+		currency.NewPair(currency.BTC, currency.USDT),
+		currency.NewPair(currency.LUNA, currency.USDT),
+	}
+	em := &fakeConsolidatedExchangeManager{exchs: []exchange.IBotExchange{exch}}
+	m, err := SetupSymbolHistoryManager(em, &fakeDatabaseConnectionManager{}, &config.SymbolHistoryManager{})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+
+	_, err = m.Record("")
+	if !errors.Is(err, database.ErrDatabaseNotConnected) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, database.ErrDatabaseNotConnected)
+	}
+
+	svc := &fakeSymbolHistoryService{noExists: true}
+	m.service = func() (symbolhistory.IDBService, error) {
+		return svc, nil
+	}
+	var inserted []exchangeDB.Details
+	m.insertExchange = func(d exchangeDB.Details) error {
+		inserted = append(inserted, d)
+		return nil
+	}
+	start := time.Date(2022, 5, 1, 0, 0, 0, 0, time.UTC)
+	m.now = func() time.Time {
+		return start
+	}
+
+	_, err = m.Record(exch.name)
+	if !errors.Is(err, errManager) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errManager)
+	}
+
+	events, err := m.Record("")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if len(inserted) != 1 || inserted[0].Name != exch.name {
+		t.Errorf("unexpected inserted exchanges %+v", inserted)
+	}
+	if len(events) != 2 || events[0].Event != symbolhistory.Listed || events[1].Base != currency.LUNA.String() {
+		t.Fatalf("unexpected events %+v", events)
+	}
+
+	events, err = m.Record("")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if len(events) != 0 {
+		t.Fatalf("received: '%v' but expected: '%v'", len(events), 0)
+	}
+
+	// LUNA is renamed to LUNC and ETH is listed
+	lunc := currency.NewCode("LUNC")
+	svc.events = append(svc.events, symbolhistory.Event{
+		Exchange:      exch.name,
+		Asset:         asset.Spot.String(),
+		Base:          currency.LUNA.String(),
+		Quote:         currency.USDT.String(),
+		Event:         symbolhistory.Renamed,
+		NewBase:       lunc.String(),
+		NewQuote:      currency.USDT.String(),
+		EffectiveDate: start.AddDate(0, 0, 1),
+	})
+	exch.pairs = currency.Pairs{
+		currency.NewPair(currency.ETH, currency.USDT),
+		currency.NewPair(lunc, currency.USDT),
+	}
+	events, err = m.Record("")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if len(events) != 2 {
+		t.Fatalf("received: '%v' but expected: '%v'", len(events), 2)
+	}
+	if events[0].Event != symbolhistory.Listed || events[0].Base != currency.ETH.String() {
+		t.Errorf("unexpected event %+v", events[0])
+	}
+	if events[1].Event != symbolhistory.Delisted || events[1].Base != currency.BTC.String() {
+		t.Errorf("unexpected event %+v", events[1])
+	}
+
+	// assets without available pairs are not delisted
+	exch.pairs = nil
+	events, err = m.Record("")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if len(events) != 0 {
+		t.Fatalf("received: '%v' but expected: '%v'", len(events), 0)
+	}
+}
@@ -0,0 +1,35 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	exchangeDB "github.com/thrasher-corp/gocryptotrader/database/repository/exchange"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/symbolhistory"
+)
+
+// SymbolHistoryManagerName is an exported subsystem name
+const SymbolHistoryManagerName = "symbol_history"
+
+var (
+	errNilSymbolHistoryConfig     = errors.New("nil symbol history config")
+	errSymbolHistoryRunInProgress = errors.New("symbol history run already in progress")
+)
+
+// SymbolHistoryManager records when pairs are listed and delisted on each
+// exchange by comparing available pairs against the stored symbol history
+type SymbolHistoryManager struct {
+	started  int32
+	inRun    int32
+	shutdown chan struct{}
+	wg       sync.WaitGroup
+	iExchangeManager
+	iDatabaseConnectionManager
+	verbose       bool
+	checkInterval time.Duration
+
+	service        func() (symbolhistory.IDBService, error)
+	insertExchange func(exchangeDB.Details) error
+	now            func() time.Time
+}
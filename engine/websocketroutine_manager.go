@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"errors"
 	"fmt"
 	"sync/atomic"
 
@@ -8,6 +9,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fill"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
@@ -245,6 +247,20 @@ func (m *websocketRoutineManager) WebsocketDataHandler(exchName string, data int
 		if m.verbose {
 			m.printAccountHoldingsChangeSummary(d)
 		}
+		err := account.ProcessChange(&d)
+		if err != nil {
+			if errors.Is(err, account.ErrHoldingsNotFound) {
+				// The change cannot be applied until the holdings have been
+				// fetched, the next fetch will include it.
+				if m.verbose {
+					log.Warnf(log.WebsocketMgr, "%s websocket %v", exchName, err)
+				}
+				return nil
+			}
+			return err
+		}
+	case []fill.Data:
+		return m.processFills(d)
 	default:
 		if m.verbose {
 			log.Warnf(log.WebsocketMgr,
@@ -256,6 +272,47 @@ func (m *websocketRoutineManager) WebsocketDataHandler(exchName string, data int
 	return nil
 }
 
+// processFills publishes own trade executions and attaches each fill to the
+// order it executed against when the order is tracked by the order manager
+func (m *websocketRoutineManager) processFills(fills []fill.Data) error {
+	if len(fills) == 0 {
+		return nil
+	}
+	if m.verbose {
+		for i := range fills {
+			m.printFillSummary(&fills[i])
+		}
+	}
+	err := fill.Process(fills...)
+	if err != nil {
+		return err
+	}
+	for i := range fills {
+		od, err := m.orderManager.GetByExchangeAndID(fills[i].Exchange, fills[i].OrderID)
+		if err != nil {
+			// Fills for orders not submitted through the order manager are
+			// not tracked
+			continue
+		}
+		var found bool
+		for x := range od.Trades {
+			if od.Trades[x].TID == fills[i].TID {
+				found = true
+				break
+			}
+		}
+		if found {
+			continue
+		}
+		od.Trades = append(od.Trades, fills[i].TradeHistory)
+		err = m.orderManager.UpdateExistingOrder(od)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // FormatCurrency is a method that formats and returns a currency pair
 // based on the user currency display preferences
 func (m *websocketRoutineManager) FormatCurrency(p currency.Pair) currency.Pair {
@@ -311,6 +368,26 @@ func (m *websocketRoutineManager) printOrderSummary(o *order.Detail) {
 		o.RemainingAmount)
 }
 
+// printFillSummary logs an own trade execution
+func (m *websocketRoutineManager) printFillSummary(f *fill.Data) {
+	if m == nil || atomic.LoadInt32(&m.started) == 0 || f == nil {
+		return
+	}
+	log.Debugf(log.WebsocketMgr,
+		"Order Fill: %s %s %s %s OrderID:%s TradeID:%s Price:%f Amount:%f Fee:%f %s Maker:%v",
+		f.Exchange,
+		f.AssetType,
+		f.Pair,
+		f.Side,
+		f.OrderID,
+		f.TID,
+		f.Price,
+		f.Amount,
+		f.Fee,
+		f.FeeAsset,
+		f.IsMaker)
+}
+
 // printAccountHoldingsChangeSummary this function will be deprecated when a
 // account holdings update is done.
 func (m *websocketRoutineManager) printAccountHoldingsChangeSummary(o account.Change) {
@@ -7,7 +7,9 @@ import (
 
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fill"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
@@ -257,4 +259,57 @@ func TestWebsocketRoutineManagerHandleData(t *testing.T) {
 	if err != nil {
 		t.Error(err)
 	}
+
+	err = m.WebsocketDataHandler(exchName, account.Change{
+		Exchange: exchName,
+		Currency: currency.BTC,
+		Asset:    asset.Spot,
+		Amount:   1,
+	})
+	if !errors.Is(err, nil) {
+		t.Errorf("error '%v', expected '%v'", err, nil)
+	}
+	err = m.WebsocketDataHandler(exchName, account.Change{Exchange: exchName})
+	if err == nil {
+		t.Error("Expected error")
+	}
+
+	fills := []fill.Data{
+		{
+			TradeHistory: order.TradeHistory{
+				Exchange: exchName,
+				TID:      "1",
+				Price:    1337,
+				Amount:   1,
+			},
+			OrderID: orderID,
+		},
+		{
+			TradeHistory: order.TradeHistory{
+				Exchange: exchName,
+				TID:      "2",
+			},
+			OrderID: "untracked",
+		},
+	}
+	err = m.WebsocketDataHandler(exchName, fills)
+	if !errors.Is(err, nil) {
+		t.Errorf("error '%v', expected '%v'", err, nil)
+	}
+	// Duplicate fills should not be attached twice
+	err = m.WebsocketDataHandler(exchName, fills)
+	if !errors.Is(err, nil) {
+		t.Errorf("error '%v', expected '%v'", err, nil)
+	}
+	updated, err = m.orderManager.GetByExchangeAndID(origOrder.Exchange, origOrder.ID)
+	if err != nil {
+		t.Error(err)
+	}
+	if len(updated.Trades) != 1 || updated.Trades[0].TID != "1" {
+		t.Errorf("expected fill to be attached to order, received: %+v", updated.Trades)
+	}
+	err = m.WebsocketDataHandler(exchName, []fill.Data{{}})
+	if err == nil {
+		t.Error("Expected error")
+	}
 }
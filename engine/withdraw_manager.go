@@ -6,9 +6,14 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/audit"
 	dbwithdraw "github.com/thrasher-corp/gocryptotrader/database/repository/withdraw"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/currencystate"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/deposit"
 	"github.com/thrasher-corp/gocryptotrader/log"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 )
@@ -77,6 +82,11 @@ func (m *WithdrawManager) SubmitWithdrawal(ctx context.Context, req *withdraw.Re
 				resp.Exchange.ID = ret.ID
 			}
 		} else if req.Type == withdraw.Crypto {
+			err = validateWithdrawalNetwork(ctx, exch, req)
+			if err != nil {
+				return nil, err
+			}
+			resp.RequestDetails.Crypto.Chain = req.Crypto.Chain
 			ret, err = exch.WithdrawCryptocurrencyFunds(ctx, req)
 			if err != nil {
 				resp.Exchange.Status = err.Error()
@@ -93,6 +103,68 @@ func (m *WithdrawManager) SubmitWithdrawal(ctx context.Context, req *withdraw.Re
 	return resp, err
 }
 
+// validateWithdrawalNetwork checks a cryptocurrency withdrawal against the
+// networks the exchange supports for the currency. When a chain is not set the
+// exchange's default network is applied to the request.
+func validateWithdrawalNetwork(ctx context.Context, exch exchange.IBotExchange, req *withdraw.Request) error {
+	networks, err := exch.GetAvailableTransferChains(ctx, req.Currency)
+	if err != nil {
+		if errors.Is(err, common.ErrNotYetImplemented) ||
+			errors.Is(err, common.ErrFunctionNotSupported) {
+			return nil
+		}
+		return err
+	}
+	if req.Crypto.Chain == "" {
+		n, err := deposit.FindNetwork(networks, "")
+		if err != nil {
+			return err
+		}
+		req.Crypto.Chain = n.Chain
+	}
+	return req.Validate(req.NetworkCheck(networks))
+}
+
+// SubmitTransfer performs validation and submits a request to move funds
+// between wallets or sub-accounts on an exchange. Each submitted transfer is
+// recorded as an audit event
+func (m *WithdrawManager) SubmitTransfer(ctx context.Context, exchName string, req *account.TransferRequest) (*account.TransferResponse, error) {
+	if m == nil {
+		return nil, ErrNilSubsystem
+	}
+	err := req.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	exch, err := m.exchangeManager.GetExchangeByName(exchName)
+	if err != nil {
+		return nil, err
+	}
+	if !exch.GetBase().GetSupportedFeatures().RESTCapabilities.FundsTransfer {
+		return nil, fmt.Errorf("%s %w", exchName, errFundsTransferNotSupported)
+	}
+
+	if m.isDryRun {
+		log.Warnln(log.Global, "Dry run enabled, no transfer request will be submitted or have an event created")
+		return &account.TransferResponse{
+			ID:     withdraw.DryRunID.String(),
+			Status: "dryrun",
+			Time:   time.Now(),
+		}, nil
+	}
+
+	resp, err := exch.TransferFunds(ctx, req)
+	if err != nil {
+		log.Errorf(log.Global, "%s transfer of %s failed: %v", exchName, req, err)
+		audit.Event(exchName, transferAuditType, fmt.Sprintf("%s failed: %v", req, err))
+		return nil, err
+	}
+	log.Infof(log.Global, "%s transfer of %s submitted, ID: %s status: %s", exchName, req, resp.ID, resp.Status)
+	audit.Event(exchName, transferAuditType, fmt.Sprintf("%s submitted, ID: %s status: %s", req, resp.ID, resp.Status))
+	return resp, nil
+}
+
 // WithdrawalEventByID returns a withdrawal request by ID
 func (m *WithdrawManager) WithdrawalEventByID(id string) (*withdraw.Response, error) {
 	if m == nil {
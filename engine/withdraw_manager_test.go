@@ -10,6 +10,8 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/binance"
 	"github.com/thrasher-corp/gocryptotrader/portfolio"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/banking"
@@ -127,6 +129,106 @@ func TestSubmitWithdrawal(t *testing.T) {
 	}
 }
 
+func TestValidateWithdrawalNetwork(t *testing.T) {
+	t.Parallel()
+	exch := fExchange{}
+	req := &withdraw.Request{
+		Exchange: "fake",
+		Currency: currency.BTC,
+		Amount:   100,
+		Type:     withdraw.Crypto,
+		Crypto: withdraw.CryptoRequest{
+			Address: "0xb794f5ea0ba39494ce839613fffba74279579268",
+		},
+	}
+	err := validateWithdrawalNetwork(context.Background(), exch, req)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received %v, expected %v", err, nil)
+	}
+	if req.Crypto.Chain != "ERC20" {
+		t.Errorf("received %v, expected %v", req.Crypto.Chain, "ERC20")
+	}
+
+	req.Amount = 10
+	err = validateWithdrawalNetwork(context.Background(), exch, req)
+	if err == nil {
+		t.Error("expected error for amount below network minimum")
+	}
+
+	req.Amount = 100
+	req.Crypto.Chain = "TRC20"
+	err = validateWithdrawalNetwork(context.Background(), exch, req)
+	if err == nil {
+		t.Error("expected error for network with withdrawals disabled")
+	}
+
+	em := SetupExchangeManager()
+	nyi, err := em.NewExchangeByName(testExchange)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Crypto.Chain = ""
+	err = validateWithdrawalNetwork(context.Background(), nyi, req)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received %v, expected %v", err, nil)
+	}
+	if req.Crypto.Chain != "" {
+		t.Error("chain should not be set when networks are unsupported")
+	}
+}
+
+func TestSubmitTransfer(t *testing.T) {
+	t.Parallel()
+	var m *WithdrawManager
+	_, err := m.SubmitTransfer(context.Background(), exchangeName, nil)
+	if !errors.Is(err, ErrNilSubsystem) {
+		t.Errorf("received %v, expected %v", err, ErrNilSubsystem)
+	}
+
+	em := SetupExchangeManager()
+	b := new(binance.Binance)
+	b.SetDefaults()
+	em.Add(b)
+	m, err = SetupWithdrawManager(em, nil, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = m.SubmitTransfer(context.Background(), exchangeName, nil)
+	if !errors.Is(err, account.ErrTransferRequestIsNil) {
+		t.Errorf("received %v, expected %v", err, account.ErrTransferRequestIsNil)
+	}
+
+	req := &account.TransferRequest{
+		Currency: currency.USDT,
+		Amount:   1,
+		ToAsset:  asset.USDTMarginedFutures,
+	}
+	_, err = m.SubmitTransfer(context.Background(), "bob", req)
+	if !errors.Is(err, ErrExchangeNotFound) {
+		t.Errorf("received %v, expected %v", err, ErrExchangeNotFound)
+	}
+
+	_, err = m.SubmitTransfer(context.Background(), exchangeName, req)
+	if !errors.Is(err, exchange.ErrAuthenticatedRequestWithoutCredentialsSet) {
+		t.Errorf("received %v, expected %v", err, exchange.ErrAuthenticatedRequestWithoutCredentialsSet)
+	}
+
+	m.isDryRun = true
+	resp, err := m.SubmitTransfer(context.Background(), exchangeName, req)
+	if !errors.Is(err, nil) {
+		t.Errorf("received %v, expected %v", err, nil)
+	}
+	if resp.ID != withdraw.DryRunID.String() {
+		t.Errorf("received %v, expected %v", resp.ID, withdraw.DryRunID)
+	}
+
+	b.Features.Supports.RESTCapabilities.FundsTransfer = false
+	_, err = m.SubmitTransfer(context.Background(), exchangeName, req)
+	if !errors.Is(err, errFundsTransferNotSupported) {
+		t.Errorf("received %v, expected %v", err, errFundsTransferNotSupported)
+	}
+}
+
 func TestWithdrawEventByID(t *testing.T) {
 	t.Parallel()
 	em, pm := withdrawManagerTestHelper(t)
@@ -7,8 +7,13 @@ import (
 var (
 	// ErrWithdrawRequestNotFound message to display when no record is found
 	ErrWithdrawRequestNotFound = errors.New("request not found")
+
+	errFundsTransferNotSupported = errors.New("exchange does not support funds transfers")
 )
 
+// transferAuditType is the audit event type recorded for funds transfers
+const transferAuditType = "transfer"
+
 // WithdrawManager is responsible for performing withdrawal requests and
 // saving them to the database
 type WithdrawManager struct {
@@ -71,6 +71,103 @@ func GetHoldings(exch string, assetType asset.Item) (Holdings, error) {
 	return Holdings{}, fmt.Errorf("%v holdings data not found for %s", assetType, exch)
 }
 
+// ProcessChange applies an incoming balance change to the stored holdings and
+// publishes the updated holdings to any subscribers. A change to an amount
+// can only be applied to a currency which has already been loaded, a
+// change with a balance will add the currency or account when not found.
+func ProcessChange(c *Change) error {
+	if c == nil {
+		return errChangeIsNil
+	}
+	if c.Exchange == "" {
+		return errExchangeUnset
+	}
+	if c.Currency.IsEmpty() {
+		return fmt.Errorf("%s %w", c.Exchange, errCurrencyUnset)
+	}
+	if !c.Asset.IsValid() {
+		return fmt.Errorf("%s %w: %v", c.Exchange, errAssetIsInvalid, c.Asset)
+	}
+	return service.Change(c)
+}
+
+// Change applies a balance change to an exchange's holdings
+func (s *Service) Change(c *Change) error {
+	exch := strings.ToLower(c.Exchange)
+	s.Lock()
+	defer s.Unlock()
+	acc, ok := s.accounts[exch]
+	if !ok {
+		if c.Balance == nil {
+			return fmt.Errorf("%s %w", c.Exchange, ErrHoldingsNotFound)
+		}
+		id, err := s.mux.GetID()
+		if err != nil {
+			return err
+		}
+		acc = &Account{h: &Holdings{Exchange: c.Exchange}, ID: id}
+		s.accounts[exch] = acc
+	}
+
+	// Copy the accounts so holdings previously returned are not mutated
+	accounts := make([]SubAccount, len(acc.h.Accounts))
+	copy(accounts, acc.h.Accounts)
+	// Accounts loaded without an asset type apply to every asset
+	target := -1
+	for x := range accounts {
+		if (accounts[x].AssetType == c.Asset || accounts[x].AssetType == "") &&
+			(c.Account == "" || accounts[x].ID == c.Account) {
+			target = x
+			break
+		}
+	}
+	if target == -1 {
+		if c.Balance == nil {
+			return fmt.Errorf("%s %s %s account %w",
+				c.Exchange,
+				c.Asset,
+				c.Account,
+				ErrHoldingsNotFound)
+		}
+		accounts = append(accounts, SubAccount{ID: c.Account, AssetType: c.Asset})
+		target = len(accounts) - 1
+	}
+
+	currencies := make([]Balance, len(accounts[target].Currencies))
+	copy(currencies, accounts[target].Currencies)
+	found := false
+	for y := range currencies {
+		if !currencies[y].CurrencyName.Match(c.Currency) {
+			continue
+		}
+		if c.Balance != nil {
+			currencies[y].TotalValue = c.Balance.TotalValue
+			currencies[y].Hold = c.Balance.Hold
+		} else {
+			currencies[y].TotalValue += c.Amount
+		}
+		found = true
+		break
+	}
+	if !found {
+		if c.Balance == nil {
+			return fmt.Errorf("%s %s %s %w",
+				c.Exchange,
+				c.Asset,
+				c.Currency,
+				ErrHoldingsNotFound)
+		}
+		currencies = append(currencies, Balance{
+			CurrencyName: c.Currency,
+			TotalValue:   c.Balance.TotalValue,
+			Hold:         c.Balance.Hold,
+		})
+	}
+	accounts[target].Currencies = currencies
+	acc.h = &Holdings{Exchange: acc.h.Exchange, Accounts: accounts}
+	return s.mux.Publish([]uuid.UUID{acc.ID}, acc.h)
+}
+
 // Update updates holdings with new account info
 func (s *Service) Update(a *Holdings) error {
 	exch := strings.ToLower(a.Exchange)
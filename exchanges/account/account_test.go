@@ -1,6 +1,7 @@
 package account
 
 import (
+	"errors"
 	"sync"
 	"testing"
 	"time"
@@ -135,6 +136,95 @@ func TestHoldings(t *testing.T) {
 	wg.Wait()
 }
 
+func TestProcessChange(t *testing.T) {
+	err := dispatch.Start(dispatch.DefaultMaxWorkers, dispatch.DefaultJobsLimit)
+	if err != nil && !dispatch.IsRunning() {
+		t.Fatal(err)
+	}
+
+	err = ProcessChange(nil)
+	if !errors.Is(err, errChangeIsNil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errChangeIsNil)
+	}
+	err = ProcessChange(&Change{})
+	if !errors.Is(err, errExchangeUnset) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errExchangeUnset)
+	}
+	err = ProcessChange(&Change{Exchange: "ChangeTest"})
+	if !errors.Is(err, errCurrencyUnset) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errCurrencyUnset)
+	}
+	err = ProcessChange(&Change{Exchange: "ChangeTest", Currency: currency.BTC})
+	if !errors.Is(err, errAssetIsInvalid) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errAssetIsInvalid)
+	}
+	err = ProcessChange(&Change{
+		Exchange: "ChangeTest",
+		Currency: currency.BTC,
+		Asset:    asset.Spot,
+		Amount:   1,
+	})
+	if !errors.Is(err, ErrHoldingsNotFound) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrHoldingsNotFound)
+	}
+
+	err = ProcessChange(&Change{
+		Exchange: "ChangeTest",
+		Currency: currency.BTC,
+		Asset:    asset.Spot,
+		Balance:  &Balance{TotalValue: 10, Hold: 2},
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+
+	h, err := GetHoldings("ChangeTest", asset.Spot)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+
+	err = ProcessChange(&Change{
+		Exchange: "ChangeTest",
+		Currency: currency.BTC,
+		Asset:    asset.Spot,
+		Amount:   -4,
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if h.Accounts[0].Currencies[0].TotalValue != 10 {
+		t.Fatal("previously returned holdings should not be mutated")
+	}
+
+	err = ProcessChange(&Change{
+		Exchange: "ChangeTest",
+		Currency: currency.ETH,
+		Asset:    asset.Spot,
+		Amount:   1,
+	})
+	if !errors.Is(err, ErrHoldingsNotFound) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrHoldingsNotFound)
+	}
+	err = ProcessChange(&Change{
+		Exchange: "ChangeTest",
+		Currency: currency.ETH,
+		Asset:    asset.Futures,
+		Amount:   1,
+	})
+	if !errors.Is(err, ErrHoldingsNotFound) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrHoldingsNotFound)
+	}
+
+	h, err = GetHoldings("ChangeTest", asset.Spot)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if h.Accounts[0].Currencies[0].TotalValue != 6 ||
+		h.Accounts[0].Currencies[0].Hold != 2 {
+		t.Fatalf("received unexpected balance %+v", h.Accounts[0].Currencies[0])
+	}
+}
+
 func TestBalance_Available(t *testing.T) {
 	t.Parallel()
 
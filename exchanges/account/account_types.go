@@ -1,6 +1,7 @@
 package account
 
 import (
+	"errors"
 	"sync"
 
 	"github.com/gofrs/uuid"
@@ -12,6 +13,15 @@ import (
 // Vars for the ticker package
 var (
 	service *Service
+
+	// ErrHoldingsNotFound is returned when a change is received for holdings
+	// which have not yet been loaded
+	ErrHoldingsNotFound = errors.New("holdings not found")
+
+	errChangeIsNil    = errors.New("change is nil")
+	errExchangeUnset  = errors.New("exchange name unset")
+	errCurrencyUnset  = errors.New("currency unset")
+	errAssetIsInvalid = errors.New("asset is invalid")
 )
 
 // Service holds ticker information for each individual exchange
@@ -48,11 +58,14 @@ type Balance struct {
 	Hold         float64
 }
 
-// Change defines incoming balance change on currency holdings
+// Change defines incoming balance change on currency holdings. Amount is the
+// signed change to the currency's total value, exchanges which send the
+// resulting balance instead set Balance which replaces the stored values.
 type Change struct {
 	Exchange string
 	Currency currency.Code
 	Asset    asset.Item
 	Amount   float64
 	Account  string
+	Balance  *Balance
 }
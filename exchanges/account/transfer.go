@@ -0,0 +1,87 @@
+package account
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+var (
+	// ErrTransferRequestIsNil is returned when a nil transfer request is
+	// supplied
+	ErrTransferRequestIsNil = errors.New("transfer request is nil")
+	// ErrInvalidTransferAmount is returned when a transfer amount is not
+	// greater than zero
+	ErrInvalidTransferAmount = errors.New("transfer amount must be greater than zero")
+	// ErrTransferRouteNotSupported is returned when an exchange cannot move
+	// funds between the requested wallets or sub-accounts
+	ErrTransferRouteNotSupported = errors.New("transfer route not supported")
+
+	errTransferCurrencyUnset = errors.New("transfer currency unset")
+	errTransferSameAccount   = errors.New("transfer source and destination are the same")
+)
+
+// TransferRequest defines a movement of funds between wallets or
+// sub-accounts held on the same exchange. An empty asset refers to the
+// exchange's default wallet and an empty sub-account refers to the main
+// account
+type TransferRequest struct {
+	Currency       currency.Code
+	Amount         float64
+	FromAsset      asset.Item
+	ToAsset        asset.Item
+	FromSubAccount string
+	ToSubAccount   string
+}
+
+// TransferResponse holds the exchange reply for a completed transfer
+type TransferResponse struct {
+	ID     string
+	Status string
+	Time   time.Time
+}
+
+// Validate checks the transfer request for missing or conflicting details
+func (t *TransferRequest) Validate() error {
+	if t == nil {
+		return ErrTransferRequestIsNil
+	}
+	if t.Currency.IsEmpty() {
+		return errTransferCurrencyUnset
+	}
+	if t.Amount <= 0 {
+		return fmt.Errorf("%v %w", t.Amount, ErrInvalidTransferAmount)
+	}
+	if t.FromAsset != "" && !t.FromAsset.IsValid() {
+		return fmt.Errorf("%v %w", t.FromAsset, asset.ErrNotSupported)
+	}
+	if t.ToAsset != "" && !t.ToAsset.IsValid() {
+		return fmt.Errorf("%v %w", t.ToAsset, asset.ErrNotSupported)
+	}
+	if t.FromAsset == t.ToAsset && t.FromSubAccount == t.ToSubAccount {
+		return errTransferSameAccount
+	}
+	return nil
+}
+
+// String returns a human readable description of the transfer route
+func (t *TransferRequest) String() string {
+	return fmt.Sprintf("%v %v from %s to %s",
+		t.Amount,
+		t.Currency,
+		transferLocation(t.FromAsset, t.FromSubAccount),
+		transferLocation(t.ToAsset, t.ToSubAccount))
+}
+
+func transferLocation(a asset.Item, subAccount string) string {
+	if a == "" {
+		a = "default"
+	}
+	if subAccount == "" {
+		return a.String()
+	}
+	return subAccount + ":" + a.String()
+}
@@ -0,0 +1,70 @@
+package account
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+func TestTransferRequestValidate(t *testing.T) {
+	t.Parallel()
+	var req *TransferRequest
+	err := req.Validate()
+	if !errors.Is(err, ErrTransferRequestIsNil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrTransferRequestIsNil)
+	}
+
+	req = &TransferRequest{}
+	err = req.Validate()
+	if !errors.Is(err, errTransferCurrencyUnset) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errTransferCurrencyUnset)
+	}
+
+	req.Currency = currency.BTC
+	err = req.Validate()
+	if !errors.Is(err, ErrInvalidTransferAmount) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrInvalidTransferAmount)
+	}
+
+	req.Amount = 1
+	req.FromAsset = "bad"
+	err = req.Validate()
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, asset.ErrNotSupported)
+	}
+
+	req.FromAsset = asset.Spot
+	req.ToAsset = asset.Spot
+	err = req.Validate()
+	if !errors.Is(err, errTransferSameAccount) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errTransferSameAccount)
+	}
+
+	req.ToSubAccount = "sub"
+	err = req.Validate()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+
+	req.ToSubAccount = ""
+	req.ToAsset = asset.Margin
+	err = req.Validate()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+}
+
+func TestTransferRequestString(t *testing.T) {
+	t.Parallel()
+	req := &TransferRequest{
+		Currency:     currency.BTC,
+		Amount:       1.5,
+		ToAsset:      asset.Margin,
+		ToSubAccount: "sub",
+	}
+	if s := req.String(); s != "1.5 BTC from default to sub:margin" {
+		t.Errorf("received: '%v' but expected: '%v'", s, "1.5 BTC from default to sub:margin")
+	}
+}
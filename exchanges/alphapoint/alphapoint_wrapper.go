@@ -12,6 +12,7 @@ import (
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/deposit"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
@@ -319,18 +320,18 @@ func (a *Alphapoint) GetOrderInfo(ctx context.Context, orderID string, pair curr
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (a *Alphapoint) GetDepositAddress(ctx context.Context, cryptocurrency currency.Code, _ string) (string, error) {
+func (a *Alphapoint) GetDepositAddress(ctx context.Context, cryptocurrency currency.Code, _, _ string) (*deposit.Address, error) {
 	addreses, err := a.GetDepositAddresses(ctx)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	for x := range addreses {
 		if addreses[x].Name == cryptocurrency.String() {
-			return addreses[x].DepositAddress, nil
+			return &deposit.Address{Address: addreses[x].DepositAddress}, nil
 		}
 	}
-	return "", errors.New("associated currency address not found")
+	return nil, errors.New("associated currency address not found")
 }
 
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
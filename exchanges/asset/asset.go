@@ -31,6 +31,7 @@ const (
 	DownsideProfitContract = Item("downsideprofitcontract")
 	CoinMarginedFutures    = Item("coinmarginedfutures")
 	USDTMarginedFutures    = Item("usdtmarginedfutures")
+	Options                = Item("options")
 )
 
 var supported = Items{
@@ -46,6 +47,7 @@ var supported = Items{
 	DownsideProfitContract,
 	CoinMarginedFutures,
 	USDTMarginedFutures,
+	Options,
 }
 
 // Supported returns a list of supported asset types
@@ -99,6 +101,22 @@ func (a Item) IsValid() bool {
 	return false
 }
 
+// IsFutures returns whether the asset type is a futures contract, this
+// includes perpetual contracts
+func (a Item) IsFutures() bool {
+	switch a {
+	case PerpetualContract,
+		PerpetualSwap,
+		Futures,
+		UpsideProfitContract,
+		DownsideProfitContract,
+		CoinMarginedFutures,
+		USDTMarginedFutures:
+		return true
+	}
+	return false
+}
+
 // New takes an input matches to relevant package assets
 func New(input string) (Item, error) {
 	input = strings.ToLower(input)
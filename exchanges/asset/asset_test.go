@@ -61,6 +61,16 @@ func TestIsValid(t *testing.T) {
 	}
 }
 
+func TestIsFutures(t *testing.T) {
+	if Spot.IsFutures() || Margin.IsFutures() || Options.IsFutures() || Item("rawr").IsFutures() {
+		t.Fatal("TestIsFutures returned an unexpected result")
+	}
+
+	if !PerpetualSwap.IsFutures() || !USDTMarginedFutures.IsFutures() {
+		t.Fatal("TestIsFutures returned an unexpected result")
+	}
+}
+
 func TestNew(t *testing.T) {
 	_, err := New("Spota")
 	if err == nil {
@@ -57,17 +57,28 @@ const (
 	allOrders         = "/api/v3/allOrders"
 	accountInfo       = "/api/v3/account"
 	marginAccountInfo = "/sapi/v1/margin/account"
+	universalTransfer = "/sapi/v1/asset/transfer"
+
+	// Margin borrowing endpoints
+	marginLoan                = "/sapi/v1/margin/loan"
+	marginRepay               = "/sapi/v1/margin/repay"
+	marginInterestHistory     = "/sapi/v1/margin/interestHistory"
+	marginInterestRateHistory = "/sapi/v1/margin/interestRateHistory"
+	marginInterestPageSize    = 100
+	assetTradeFee             = "/sapi/v1/asset/tradeFee"
+	bnbBurn                   = "/sapi/v1/bnbBurn"
 
 	// Withdraw API endpoints
 	withdrawEndpoint                       = "/wapi/v3/withdraw.html"
 	depositHistory                         = "/wapi/v3/depositHistory.html"
 	withdrawalHistory                      = "/wapi/v3/withdrawHistory.html"
-	depositAddress                         = "/wapi/v3/depositAddress.html"
 	accountStatus                          = "/wapi/v3/accountStatus.html"
 	systemStatus                           = "/wapi/v3/systemStatus.html"
 	dustLog                                = "/wapi/v3/userAssetDribbletLog.html"
 	tradeFee                               = "/wapi/v3/tradeFee.html"
 	assetDetail                            = "/wapi/v3/assetDetail.html"
+	allCoinsInfo                           = "/sapi/v1/capital/config/getall"
+	capitalDepositAddress                  = "/sapi/v1/capital/deposit/address"
 	undocumentedInterestHistory            = "/gateway-api/v1/public/isolated-margin/pair/vip-level"
 	undocumentedCrossMarginInterestHistory = "/gateway-api/v1/friendly/margin/vip/spec/list-all"
 
@@ -711,6 +722,31 @@ func (b *Binance) GetAccount(ctx context.Context) (*Account, error) {
 	return &resp.Account, nil
 }
 
+// UniversalTransfer moves funds between the spot, margin and futures wallets,
+// transferType is a route such as MAIN_UMFUTURE or CMFUTURE_MAIN
+func (b *Binance) UniversalTransfer(ctx context.Context, transferType string, c currency.Code, amount float64) (*UniversalTransferResponse, error) {
+	if transferType == "" {
+		return nil, errTransferTypeUnset
+	}
+	if c.IsEmpty() {
+		return nil, errTransferCurrencyUnset
+	}
+	if amount <= 0 {
+		return nil, errAmountMustBeGreaterThanZero
+	}
+	params := url.Values{}
+	params.Set("type", transferType)
+	params.Set("asset", c.Upper().String())
+	params.Set("amount", strconv.FormatFloat(amount, 'f', -1, 64))
+
+	var resp UniversalTransferResponse
+	return &resp, b.SendAuthHTTPRequest(ctx,
+		exchange.RestSpotSupplementary,
+		http.MethodPost, universalTransfer,
+		params, spotDefaultRate,
+		&resp)
+}
+
 // GetMarginAccount returns account information for margin accounts
 func (b *Binance) GetMarginAccount(ctx context.Context) (*MarginAccount, error) {
 	var resp MarginAccount
@@ -727,6 +763,122 @@ func (b *Binance) GetMarginAccount(ctx context.Context) (*MarginAccount, error)
 	return &resp, nil
 }
 
+// MarginAccountBorrow borrows funds into the cross margin account or, when an
+// isolated symbol is supplied, into that isolated margin account
+func (b *Binance) MarginAccountBorrow(ctx context.Context, c currency.Code, amount float64, isolatedSymbol string) (*MarginTransactionResponse, error) {
+	return b.marginLoanTransaction(ctx, marginLoan, c, amount, isolatedSymbol)
+}
+
+// MarginAccountRepay repays borrowed funds in the cross margin account or,
+// when an isolated symbol is supplied, in that isolated margin account
+func (b *Binance) MarginAccountRepay(ctx context.Context, c currency.Code, amount float64, isolatedSymbol string) (*MarginTransactionResponse, error) {
+	return b.marginLoanTransaction(ctx, marginRepay, c, amount, isolatedSymbol)
+}
+
+func (b *Binance) marginLoanTransaction(ctx context.Context, path string, c currency.Code, amount float64, isolatedSymbol string) (*MarginTransactionResponse, error) {
+	if c.IsEmpty() {
+		return nil, errMarginCurrencyUnset
+	}
+	if amount <= 0 {
+		return nil, errAmountMustBeGreaterThanZero
+	}
+	params := url.Values{}
+	params.Set("asset", c.Upper().String())
+	params.Set("amount", strconv.FormatFloat(amount, 'f', -1, 64))
+	if isolatedSymbol != "" {
+		params.Set("isIsolated", "TRUE")
+		params.Set("symbol", isolatedSymbol)
+	}
+
+	var resp MarginTransactionResponse
+	return &resp, b.SendAuthHTTPRequest(ctx,
+		exchange.RestSpotSupplementary,
+		http.MethodPost, path,
+		params, spotDefaultRate,
+		&resp)
+}
+
+// GetMarginInterestPaymentHistory returns a page of the interest charged on
+// borrowed margin funds, an empty currency returns all currencies. Pages start
+// at 1 and hold at most 100 rows.
+func (b *Binance) GetMarginInterestPaymentHistory(ctx context.Context, c currency.Code, startTime, endTime time.Time, page, size int64) (*MarginInterestHistory, error) {
+	params := url.Values{}
+	if !c.IsEmpty() {
+		params.Set("asset", c.Upper().String())
+	}
+	if !startTime.IsZero() {
+		params.Set("startTime", timeString(startTime))
+	}
+	if !endTime.IsZero() {
+		params.Set("endTime", timeString(endTime))
+	}
+	if page > 0 {
+		params.Set("current", strconv.FormatInt(page, 10))
+	}
+	if size > 0 {
+		params.Set("size", strconv.FormatInt(size, 10))
+	}
+
+	var resp MarginInterestHistory
+	return &resp, b.SendAuthHTTPRequest(ctx,
+		exchange.RestSpotSupplementary,
+		http.MethodGet, marginInterestHistory,
+		params, spotDefaultRate,
+		&resp)
+}
+
+// GetMarginInterestRateHistory returns the daily borrow rate history for a
+// currency at the account's VIP level
+func (b *Binance) GetMarginInterestRateHistory(ctx context.Context, c currency.Code, startTime, endTime time.Time, limit int64) ([]MarginInterestRate, error) {
+	if c.IsEmpty() {
+		return nil, errMarginCurrencyUnset
+	}
+	params := url.Values{}
+	params.Set("asset", c.Upper().String())
+	if !startTime.IsZero() {
+		params.Set("startTime", timeString(startTime))
+	}
+	if !endTime.IsZero() {
+		params.Set("endTime", timeString(endTime))
+	}
+	if limit > 0 {
+		params.Set("limit", strconv.FormatInt(limit, 10))
+	}
+
+	var resp []MarginInterestRate
+	return resp, b.SendAuthHTTPRequest(ctx,
+		exchange.RestSpotSupplementary,
+		http.MethodGet, marginInterestRateHistory,
+		params, spotDefaultRate,
+		&resp)
+}
+
+// GetTradeFee returns the account maker and taker commission for a symbol, or
+// all symbols when the symbol is empty
+func (b *Binance) GetTradeFee(ctx context.Context, symbol string) ([]TradeFee, error) {
+	params := url.Values{}
+	if symbol != "" {
+		params.Set("symbol", symbol)
+	}
+	var resp []TradeFee
+	return resp, b.SendAuthHTTPRequest(ctx,
+		exchange.RestSpotSupplementary,
+		http.MethodGet, assetTradeFee,
+		params, spotDefaultRate,
+		&resp)
+}
+
+// GetBNBBurnStatus returns whether BNB is used to pay spot trading fees and
+// margin interest
+func (b *Binance) GetBNBBurnStatus(ctx context.Context) (*BNBBurnStatus, error) {
+	var resp BNBBurnStatus
+	return &resp, b.SendAuthHTTPRequest(ctx,
+		exchange.RestSpotSupplementary,
+		http.MethodGet, bnbBurn,
+		url.Values{}, spotDefaultRate,
+		&resp)
+}
+
 // SendHTTPRequest sends an unauthenticated request
 func (b *Binance) SendHTTPRequest(ctx context.Context, ePath exchange.URL, path string, f request.EndpointLimit, result interface{}) error {
 	endpointPath, err := b.API.Endpoints.GetURL(ePath)
@@ -898,8 +1050,9 @@ func getCryptocurrencyWithdrawalFee(c currency.Code) float64 {
 	return WithdrawalFees[c]
 }
 
-// WithdrawCrypto sends cryptocurrency to the address of your choosing
-func (b *Binance) WithdrawCrypto(ctx context.Context, asset, address, addressTag, name, amount string) (string, error) {
+// WithdrawCrypto sends cryptocurrency to the address of your choosing on the
+// network to withdraw on, if left empty the coin's default network is used
+func (b *Binance) WithdrawCrypto(ctx context.Context, asset, address, addressTag, network, name, amount string) (string, error) {
 	var resp WithdrawResponse
 
 	params := url.Values{}
@@ -912,6 +1065,9 @@ func (b *Binance) WithdrawCrypto(ctx context.Context, asset, address, addressTag
 	if len(addressTag) > 0 {
 		params.Set("addressTag", addressTag)
 	}
+	if len(network) > 0 {
+		params.Set("network", network)
+	}
 
 	if err := b.SendAuthHTTPRequest(ctx,
 		exchange.RestSpotSupplementary,
@@ -972,24 +1128,31 @@ func (b *Binance) WithdrawStatus(ctx context.Context, c currency.Code, status st
 	return response.WithdrawList, nil
 }
 
-// GetDepositAddressForCurrency retrieves the wallet address for a given currency
-func (b *Binance) GetDepositAddressForCurrency(ctx context.Context, currency string) (string, error) {
-	resp := struct {
-		Address    string `json:"address"`
-		Success    bool   `json:"success"`
-		AddressTag string `json:"addressTag"`
-	}{}
-
+// GetDepositAddressForCurrency retrieves the wallet address for a given
+// currency on the supplied network, if left empty the coin's default network
+// is used
+func (b *Binance) GetDepositAddressForCurrency(ctx context.Context, currency, network string) (*DepositAddress, error) {
 	params := url.Values{}
-	params.Set("asset", currency)
-	params.Set("status", "true")
+	params.Set("coin", currency)
+	if network != "" {
+		params.Set("network", network)
+	}
 	params.Set("recvWindow", "10000")
+	var resp DepositAddress
+	return &resp, b.SendAuthHTTPRequest(ctx,
+		exchange.RestSpotSupplementary,
+		http.MethodGet, capitalDepositAddress,
+		params, spotDefaultRate, &resp)
+}
 
-	return resp.Address,
-		b.SendAuthHTTPRequest(ctx,
-			exchange.RestSpotSupplementary,
-			http.MethodGet, depositAddress,
-			params, spotDefaultRate, &resp)
+// GetAllCoinsInfo returns details about all supported coins including the
+// networks they can be deposited and withdrawn on
+func (b *Binance) GetAllCoinsInfo(ctx context.Context) ([]CoinInfo, error) {
+	var resp []CoinInfo
+	return resp, b.SendAuthHTTPRequest(ctx,
+		exchange.RestSpotSupplementary,
+		http.MethodGet, allCoinsInfo,
+		url.Values{}, spotDefaultRate, &resp)
 }
 
 // GetWsAuthStreamKey will retrieve a key to use for authorised WS streaming
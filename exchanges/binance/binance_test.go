@@ -14,8 +14,13 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/core"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fee"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fill"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 )
@@ -1721,6 +1726,98 @@ func TestSubmitOrder(t *testing.T) {
 	}
 }
 
+func TestSubmitBatchOrders(t *testing.T) {
+	t.Parallel()
+	_, err := b.SubmitBatchOrders(context.Background(), nil)
+	if !errors.Is(err, order.ErrNoOrdersInBatch) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, order.ErrNoOrdersInBatch)
+	}
+	_, err = b.SubmitBatchOrders(context.Background(), []*order.Submit{{AssetType: asset.Spot}})
+	if !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, common.ErrFunctionNotSupported)
+	}
+
+	orders := []*order.Submit{
+		{
+			Pair:      currency.NewPair(currency.BTC, currency.USDT),
+			Side:      order.Buy,
+			Type:      order.Limit,
+			Price:     1,
+			Amount:    1,
+			AssetType: asset.USDTMarginedFutures,
+		},
+		{
+			Pair:      currency.NewPair(currency.BTC, currency.USDT),
+			Side:      order.Sell,
+			Type:      order.Limit,
+			Price:     1000000,
+			Amount:    1,
+			AssetType: asset.USDTMarginedFutures,
+		},
+	}
+	if mockTests {
+		// the mock has no batch order route, a failed request is recorded
+		// against each of its orders rather than discarding the batch
+		resp, err := b.SubmitBatchOrders(context.Background(), orders)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(resp.Results) != len(orders) {
+			t.Fatalf("received %d results but expected %d", len(resp.Results), len(orders))
+		}
+		for x := range resp.Results {
+			if resp.Results[x].Error == nil || resp.Results[x].IsOrderPlaced {
+				t.Errorf("expected order %d to record the request error", x)
+			}
+		}
+		return
+	}
+	if !areTestAPIKeysSet() || !canManipulateRealOrders {
+		t.Skip("skipping test: api keys not set or canManipulateRealOrders set to false")
+	}
+	resp, err := b.SubmitBatchOrders(context.Background(), orders)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Results) != len(orders) {
+		t.Fatalf("received %d results but expected %d", len(resp.Results), len(orders))
+	}
+}
+
+func TestUFuturesBatchOrderData(t *testing.T) {
+	t.Parallel()
+	s := &order.Submit{
+		Pair:       currency.NewPair(currency.BTC, currency.USDT),
+		Side:       order.Sell,
+		Type:       order.Limit,
+		Price:      50000,
+		Amount:     0.1,
+		ReduceOnly: true,
+		AssetType:  asset.USDTMarginedFutures,
+	}
+	d, err := uFuturesBatchOrderData(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.Side != "SELL" || d.OrderType != "LIMIT" || d.TimeInForce != "GTC" || d.ReduceOnly != "true" {
+		t.Errorf("unexpected batch order data %+v", d)
+	}
+	s.Type = order.Market
+	s.Price = 0
+	d, err = uFuturesBatchOrderData(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d.OrderType != "MARKET" || d.TimeInForce != "" {
+		t.Errorf("unexpected batch order data %+v", d)
+	}
+	s.Amount = 0
+	_, err = uFuturesBatchOrderData(s)
+	if !errors.Is(err, order.ErrAmountIsInvalid) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, order.ErrAmountIsInvalid)
+	}
+}
+
 func TestCancelExchangeOrder(t *testing.T) {
 	t.Parallel()
 
@@ -2005,7 +2102,7 @@ func TestWithdrawInternationalBank(t *testing.T) {
 
 func TestGetDepositAddress(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetDepositAddress(context.Background(), currency.BTC, "")
+	_, err := b.GetDepositAddress(context.Background(), currency.BTC, "", "")
 	switch {
 	case areTestAPIKeysSet() && err != nil:
 		t.Error("GetDepositAddress() error", err)
@@ -2016,6 +2113,17 @@ func TestGetDepositAddress(t *testing.T) {
 	}
 }
 
+func TestGetAvailableTransferChains(t *testing.T) {
+	t.Parallel()
+	if !areTestAPIKeysSet() {
+		t.Skip("skipping test: api keys not set")
+	}
+	_, err := b.GetAvailableTransferChains(context.Background(), currency.USDT)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
 func TestWSSubscriptionHandling(t *testing.T) {
 	t.Parallel()
 	pressXToJSON := []byte(`{
@@ -2578,6 +2686,22 @@ func TestWsOrderExecutionReport(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
+	if _, ok := (<-b.Websocket.DataHandler).(*order.Detail); !ok {
+		t.Fatal("expected order detail to be sent before the fill")
+	}
+	res = <-b.Websocket.DataHandler
+	fills, ok := res.([]fill.Data)
+	if !ok {
+		t.Fatalf("expected type []fill.Data, found %T", res)
+	}
+	if len(fills) != 1 ||
+		fills[0].TID != "726946523" ||
+		fills[0].OrderID != "5341783271" ||
+		fills[0].Amount != 0.000286 ||
+		fills[0].Price != 52436.85 ||
+		fills[0].FeeAsset != "BTC" {
+		t.Errorf("unexpected fill received: %+v", fills)
+	}
 }
 
 func TestWsOutboundAccountPosition(t *testing.T) {
@@ -2588,3 +2712,307 @@ func TestWsOutboundAccountPosition(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestGetLatestFundingRate(t *testing.T) {
+	t.Parallel()
+	_, err := b.GetLatestFundingRate(context.Background(), asset.Spot, currency.NewPair(currency.BTC, currency.USDT))
+	if !errors.Is(err, futures.ErrNotFuturesAsset) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, futures.ErrNotFuturesAsset)
+	}
+	_, err = b.GetLatestFundingRate(context.Background(), asset.USDTMarginedFutures, currency.NewPair(currency.BTC, currency.USDT))
+	if err != nil {
+		t.Error(err)
+	}
+	_, err = b.GetLatestFundingRate(context.Background(), asset.CoinMarginedFutures, currency.NewPairWithDelimiter("BTCUSD", "PERP", "_"))
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetFundingRateHistory(t *testing.T) {
+	t.Parallel()
+	start := time.Now().Add(-time.Hour * 24 * 7)
+	_, err := b.GetFundingRateHistory(context.Background(), asset.USDTMarginedFutures, currency.NewPair(currency.BTC, currency.USDT), start, time.Time{})
+	if !errors.Is(err, common.ErrDateUnset) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, common.ErrDateUnset)
+	}
+	if mockTests {
+		t.Skip("skipping test: no mock data recorded for futures routes")
+	}
+	_, err = b.GetFundingRateHistory(context.Background(), asset.USDTMarginedFutures, currency.NewPair(currency.BTC, currency.USDT), start, time.Now())
+	if err != nil {
+		t.Error(err)
+	}
+	_, err = b.GetFundingRateHistory(context.Background(), asset.CoinMarginedFutures, currency.NewPairWithDelimiter("BTCUSD", "PERP", "_"), start, time.Now())
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetFuturesOpenInterest(t *testing.T) {
+	t.Parallel()
+	if mockTests {
+		t.Skip("skipping test: no mock data recorded for futures routes")
+	}
+	_, err := b.GetFuturesOpenInterest(context.Background(), asset.USDTMarginedFutures, currency.NewPair(currency.BTC, currency.USDT))
+	if err != nil {
+		t.Error(err)
+	}
+	_, err = b.GetFuturesOpenInterest(context.Background(), asset.CoinMarginedFutures, currency.NewPairWithDelimiter("BTCUSD", "PERP", "_"))
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetFuturesMarkPrice(t *testing.T) {
+	t.Parallel()
+	if mockTests {
+		t.Skip("skipping test: no mock data recorded for futures routes")
+	}
+	_, err := b.GetFuturesMarkPrice(context.Background(), asset.USDTMarginedFutures, currency.NewPair(currency.BTC, currency.USDT))
+	if err != nil {
+		t.Error(err)
+	}
+	_, err = b.GetFuturesMarkPrice(context.Background(), asset.CoinMarginedFutures, currency.NewPairWithDelimiter("BTCUSD", "PERP", "_"))
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetFuturesPositions(t *testing.T) {
+	t.Parallel()
+	_, err := b.GetFuturesPositions(context.Background(), asset.Spot, currency.Pair{})
+	if !errors.Is(err, futures.ErrNotFuturesAsset) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, futures.ErrNotFuturesAsset)
+	}
+	if !areTestAPIKeysSet() {
+		t.Skip("skipping test: api keys not set")
+	}
+	_, err = b.GetFuturesPositions(context.Background(), asset.USDTMarginedFutures, currency.Pair{})
+	if err != nil {
+		t.Error(err)
+	}
+	_, err = b.GetFuturesPositions(context.Background(), asset.CoinMarginedFutures, currency.NewPairWithDelimiter("BTCUSD", "PERP", "_"))
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestChangeFuturesLeverage(t *testing.T) {
+	t.Parallel()
+	err := b.ChangeFuturesLeverage(context.Background(), asset.USDTMarginedFutures, currency.NewPair(currency.BTC, currency.USDT), 0)
+	if !errors.Is(err, futures.ErrInvalidLeverage) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, futures.ErrInvalidLeverage)
+	}
+	err = b.ChangeFuturesLeverage(context.Background(), asset.USDTMarginedFutures, currency.NewPair(currency.BTC, currency.USDT), 2.5)
+	if !errors.Is(err, errFractionalLeverage) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errFractionalLeverage)
+	}
+	if !areTestAPIKeysSet() || !canManipulateRealOrders {
+		t.Skip("skipping test: api keys not set or canManipulateRealOrders set to false")
+	}
+	err = b.ChangeFuturesLeverage(context.Background(), asset.USDTMarginedFutures, currency.NewPair(currency.BTC, currency.USDT), 2)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestChangeFuturesMarginType(t *testing.T) {
+	t.Parallel()
+	err := b.ChangeFuturesMarginType(context.Background(), asset.USDTMarginedFutures, currency.NewPair(currency.BTC, currency.USDT), futures.UnknownMarginType)
+	if !errors.Is(err, futures.ErrMarginTypeNotSupported) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, futures.ErrMarginTypeNotSupported)
+	}
+	if !areTestAPIKeysSet() || !canManipulateRealOrders {
+		t.Skip("skipping test: api keys not set or canManipulateRealOrders set to false")
+	}
+	err = b.ChangeFuturesMarginType(context.Background(), asset.CoinMarginedFutures, currency.NewPairWithDelimiter("BTCUSD", "PERP", "_"), futures.Isolated)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetFuturesMarginInfo(t *testing.T) {
+	t.Parallel()
+	if !areTestAPIKeysSet() {
+		t.Skip("skipping test: api keys not set")
+	}
+	_, err := b.GetFuturesMarginInfo(context.Background(), asset.USDTMarginedFutures, currency.NewPair(currency.BTC, currency.USDT))
+	if err != nil {
+		t.Error(err)
+	}
+	_, err = b.GetFuturesMarginInfo(context.Background(), asset.CoinMarginedFutures, currency.NewPairWithDelimiter("BTCUSD", "PERP", "_"))
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestTransferFunds(t *testing.T) {
+	t.Parallel()
+	_, err := b.TransferFunds(context.Background(), &account.TransferRequest{
+		Currency:     currency.USDT,
+		Amount:       1,
+		ToSubAccount: "sub",
+	})
+	if !errors.Is(err, account.ErrTransferRouteNotSupported) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, account.ErrTransferRouteNotSupported)
+	}
+	_, err = b.TransferFunds(context.Background(), &account.TransferRequest{
+		Currency: currency.USDT,
+		Amount:   1,
+		ToAsset:  asset.Futures,
+	})
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, asset.ErrNotSupported)
+	}
+	if !areTestAPIKeysSet() || !canManipulateRealOrders {
+		t.Skip("skipping test: api keys not set or canManipulateRealOrders set to false")
+	}
+	_, err = b.TransferFunds(context.Background(), &account.TransferRequest{
+		Currency:  currency.USDT,
+		Amount:    1,
+		FromAsset: asset.Spot,
+		ToAsset:   asset.USDTMarginedFutures,
+	})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestTransferWallet(t *testing.T) {
+	t.Parallel()
+	for a, expected := range map[asset.Item]string{
+		"":                        "MAIN",
+		asset.Spot:                "MAIN",
+		asset.Margin:              "MARGIN",
+		asset.USDTMarginedFutures: "UMFUTURE",
+		asset.CoinMarginedFutures: "CMFUTURE",
+	} {
+		w, err := transferWallet(a)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if w != expected {
+			t.Errorf("received: '%v' but expected: '%v'", w, expected)
+		}
+	}
+	_, err := transferWallet(asset.Futures)
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, asset.ErrNotSupported)
+	}
+}
+
+func TestGetMarginInterestRates(t *testing.T) {
+	t.Parallel()
+	_, err := b.GetMarginInterestRates(context.Background(), currency.Code{})
+	if !errors.Is(err, margin.ErrCurrencyIsEmpty) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, margin.ErrCurrencyIsEmpty)
+	}
+	if !areTestAPIKeysSet() {
+		t.Skip("skipping test: api keys not set")
+	}
+	_, err = b.GetMarginInterestRates(context.Background(), currency.BTC)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMarginBorrow(t *testing.T) {
+	t.Parallel()
+	_, err := b.MarginBorrow(context.Background(), &margin.LoanRequest{Currency: currency.USDT})
+	if !errors.Is(err, margin.ErrInvalidAmount) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, margin.ErrInvalidAmount)
+	}
+	_, err = b.MarginAccountBorrow(context.Background(), currency.Code{}, 1, "")
+	if !errors.Is(err, errMarginCurrencyUnset) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errMarginCurrencyUnset)
+	}
+	if !areTestAPIKeysSet() || !canManipulateRealOrders {
+		t.Skip("skipping test: api keys not set or canManipulateRealOrders set to false")
+	}
+	_, err = b.MarginBorrow(context.Background(), &margin.LoanRequest{
+		Currency: currency.USDT,
+		Amount:   1,
+		Pair:     currency.NewPair(currency.BTC, currency.USDT),
+	})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMarginRepay(t *testing.T) {
+	t.Parallel()
+	_, err := b.MarginRepay(context.Background(), nil)
+	if !errors.Is(err, margin.ErrLoanRequestIsNil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, margin.ErrLoanRequestIsNil)
+	}
+	_, err = b.MarginAccountRepay(context.Background(), currency.USDT, 0, "")
+	if !errors.Is(err, errAmountMustBeGreaterThanZero) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errAmountMustBeGreaterThanZero)
+	}
+	if !areTestAPIKeysSet() || !canManipulateRealOrders {
+		t.Skip("skipping test: api keys not set or canManipulateRealOrders set to false")
+	}
+	_, err = b.MarginRepay(context.Background(), &margin.LoanRequest{
+		Currency: currency.USDT,
+		Amount:   1,
+	})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetMarginLoans(t *testing.T) {
+	t.Parallel()
+	if !areTestAPIKeysSet() {
+		t.Skip("skipping test: api keys not set")
+	}
+	_, err := b.GetMarginLoans(context.Background(), currency.Code{})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetMarginInterestHistory(t *testing.T) {
+	t.Parallel()
+	if !areTestAPIKeysSet() {
+		t.Skip("skipping test: api keys not set")
+	}
+	_, err := b.GetMarginInterestHistory(context.Background(), currency.USDT, time.Now().Add(-time.Hour*24*7), time.Now())
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSpotFeeTiers(t *testing.T) {
+	t.Parallel()
+	s := fee.Schedule{Tiers: spotFeeTiers}
+	if err := s.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	tier, err := s.GetTierForVolume(asset.Spot, 600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tier.Name != "VIP2" {
+		t.Errorf("received '%v' expected 'VIP2'", tier.Name)
+	}
+}
+
+func TestUpdateFeeSchedule(t *testing.T) {
+	t.Parallel()
+	if !areTestAPIKeysSet() {
+		t.Skip("skipping test: api keys not set")
+	}
+	err := b.UpdateFeeSchedule(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := b.GetFeeSchedule()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !s.Authenticated || len(s.Commissions) == 0 {
+		t.Errorf("unexpected fee schedule %+v", s)
+	}
+}
@@ -1,14 +1,36 @@
 package binance
 
 import (
+	"errors"
 	"sync"
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fee"
 )
 
-const wsRateLimitMilliseconds = 250
+const (
+	wsRateLimitMilliseconds = 250
+	// fundingRateHistoryLimit is the maximum number of funding rates returned
+	// per request
+	fundingRateHistoryLimit = 1000
+	// commissionBasisPoints converts account commissions, returned in basis
+	// points, to a fraction of the traded value
+	commissionBasisPoints = 10000
+	// bnbFeeDiscount is the reduction in spot trading fees when paid in BNB
+	bnbFeeDiscount = 0.25
+)
+
+var (
+	errUnexpectedMarkPriceResponse = errors.New("unexpected mark price response")
+	errFractionalLeverage          = errors.New("leverage must be a whole number")
+	errPositionNotFound            = errors.New("position not found")
+	errTransferTypeUnset           = errors.New("transfer type unset")
+	errTransferCurrencyUnset       = errors.New("transfer currency unset")
+	errAmountMustBeGreaterThanZero = errors.New("amount must be greater than zero")
+	errMarginCurrencyUnset         = errors.New("margin currency unset")
+)
 
 // withdrawals status codes description
 const (
@@ -479,6 +501,22 @@ type KlinesRequestParams struct {
 	EndTime   time.Time
 }
 
+// spotFeeTiers holds the spot and margin VIP commission tiers by thirty day
+// trading volume in BTC
+// Prone to change
+var spotFeeTiers = []fee.Tier{
+	{Name: "VIP0", Asset: asset.Spot, MinimumVolume: 0, VolumeCurrency: currency.BTC, Commission: fee.Commission{Maker: 0.001, Taker: 0.001}},
+	{Name: "VIP1", Asset: asset.Spot, MinimumVolume: 50, VolumeCurrency: currency.BTC, Commission: fee.Commission{Maker: 0.0009, Taker: 0.001}},
+	{Name: "VIP2", Asset: asset.Spot, MinimumVolume: 500, VolumeCurrency: currency.BTC, Commission: fee.Commission{Maker: 0.0008, Taker: 0.001}},
+	{Name: "VIP3", Asset: asset.Spot, MinimumVolume: 1500, VolumeCurrency: currency.BTC, Commission: fee.Commission{Maker: 0.0007, Taker: 0.001}},
+	{Name: "VIP4", Asset: asset.Spot, MinimumVolume: 4500, VolumeCurrency: currency.BTC, Commission: fee.Commission{Maker: 0.0007, Taker: 0.0009}},
+	{Name: "VIP5", Asset: asset.Spot, MinimumVolume: 10000, VolumeCurrency: currency.BTC, Commission: fee.Commission{Maker: 0.0006, Taker: 0.0008}},
+	{Name: "VIP6", Asset: asset.Spot, MinimumVolume: 20000, VolumeCurrency: currency.BTC, Commission: fee.Commission{Maker: 0.0005, Taker: 0.0007}},
+	{Name: "VIP7", Asset: asset.Spot, MinimumVolume: 40000, VolumeCurrency: currency.BTC, Commission: fee.Commission{Maker: 0.0004, Taker: 0.0006}},
+	{Name: "VIP8", Asset: asset.Spot, MinimumVolume: 80000, VolumeCurrency: currency.BTC, Commission: fee.Commission{Maker: 0.0003, Taker: 0.0005}},
+	{Name: "VIP9", Asset: asset.Spot, MinimumVolume: 150000, VolumeCurrency: currency.BTC, Commission: fee.Commission{Maker: 0.0002, Taker: 0.0004}},
+}
+
 // WithdrawalFees the large list of predefined withdrawal fees
 // Prone to change
 var WithdrawalFees = map[currency.Code]float64{
@@ -655,6 +693,52 @@ type WithdrawResponse struct {
 	ID      string `json:"id"`
 }
 
+// UniversalTransferResponse holds the transaction ID of a wallet transfer
+type UniversalTransferResponse struct {
+	TransactionID int64 `json:"tranId"`
+}
+
+// MarginTransactionResponse holds the transaction ID of a margin borrow or
+// repayment
+type MarginTransactionResponse struct {
+	TransactionID int64 `json:"tranId"`
+}
+
+// MarginInterestHistory holds interest charged on borrowed margin funds
+type MarginInterestHistory struct {
+	Rows []struct {
+		IsolatedSymbol      string  `json:"isolatedSymbol"`
+		Asset               string  `json:"asset"`
+		Interest            float64 `json:"interest,string"`
+		InterestAccuredTime int64   `json:"interestAccuredTime"`
+		InterestRate        float64 `json:"interestRate,string"`
+		Principal           float64 `json:"principal,string"`
+		Type                string  `json:"type"`
+	} `json:"rows"`
+	Total int64 `json:"total"`
+}
+
+// MarginInterestRate holds a daily margin borrow rate for a VIP level
+type MarginInterestRate struct {
+	Asset             string  `json:"asset"`
+	DailyInterestRate float64 `json:"dailyInterestRate,string"`
+	Timestamp         int64   `json:"timestamp"`
+	VIPLevel          int64   `json:"vipLevel"`
+}
+
+// TradeFee holds the account commission rates for a symbol
+type TradeFee struct {
+	Symbol          string  `json:"symbol"`
+	MakerCommission float64 `json:"makerCommission,string"`
+	TakerCommission float64 `json:"takerCommission,string"`
+}
+
+// BNBBurnStatus holds whether BNB is used to pay fees and interest
+type BNBBurnStatus struct {
+	SpotBNBBurn     bool `json:"spotBNBBurn"`
+	InterestBNBBurn bool `json:"interestBNBBurn"`
+}
+
 // WithdrawStatusResponse defines a withdrawal status response
 type WithdrawStatusResponse struct {
 	Amount         float64 `json:"amount"`
@@ -668,6 +752,51 @@ type WithdrawStatusResponse struct {
 	Network        string  `json:"network"`
 }
 
+// DepositAddress stores a deposit address for a coin and network
+type DepositAddress struct {
+	Address string `json:"address"`
+	Coin    string `json:"coin"`
+	Tag     string `json:"tag"`
+	URL     string `json:"url"`
+}
+
+// CoinInfo stores information about a supported coin and the networks it can
+// be transferred on
+type CoinInfo struct {
+	Coin              string        `json:"coin"`
+	DepositAllEnable  bool          `json:"depositAllEnable"`
+	WithdrawAllEnable bool          `json:"withdrawAllEnable"`
+	Free              float64       `json:"free,string"`
+	Freeze            float64       `json:"freeze,string"`
+	Locked            float64       `json:"locked,string"`
+	Name              string        `json:"name"`
+	NetworkList       []NetworkInfo `json:"networkList"`
+	Trading           bool          `json:"trading"`
+}
+
+// NetworkInfo stores the deposit and withdrawal details of a coin on a
+// network
+type NetworkInfo struct {
+	AddressRegex        string  `json:"addressRegex"`
+	Coin                string  `json:"coin"`
+	DepositDescription  string  `json:"depositDesc"`
+	DepositEnable       bool    `json:"depositEnable"`
+	IsDefault           bool    `json:"isDefault"`
+	MemoRegex           string  `json:"memoRegex"`
+	MinimumConfirmation int64   `json:"minConfirm"`
+	Name                string  `json:"name"`
+	Network             string  `json:"network"`
+	ResetAddressStatus  bool    `json:"resetAddressStatus"`
+	SameAddress         bool    `json:"sameAddress"`
+	SpecialTips         string  `json:"specialTips"`
+	UnlockConfirm       int64   `json:"unLockConfirm"`
+	WithdrawDescription string  `json:"withdrawDesc"`
+	WithdrawEnable      bool    `json:"withdrawEnable"`
+	WithdrawFee         float64 `json:"withdrawFee,string"`
+	WithdrawMinimum     float64 `json:"withdrawMin,string"`
+	WithdrawMaximum     float64 `json:"withdrawMax,string"`
+}
+
 // UserAccountStream contains a key to maintain an authorised
 // websocket connection
 type UserAccountStream struct {
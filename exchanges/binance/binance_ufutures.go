@@ -65,6 +65,10 @@ const (
 	ufuturesADLQuantile           = "/fapi/v1/adlQuantile"
 )
 
+// uFuturesMaxBatchOrders is the maximum number of orders accepted by a single
+// batch order request
+const uFuturesMaxBatchOrders = 5
+
 // UServerTime gets the server time
 func (b *Binance) UServerTime(ctx context.Context) (time.Time, error) {
 	var data struct {
@@ -378,15 +382,15 @@ func (b *Binance) UGetFundingHistory(ctx context.Context, symbol currency.Pair,
 		}
 		params.Set("symbol", symbolValue)
 	}
-	if limit > 0 && limit < 1000 {
+	if limit > 0 && limit <= 1000 {
 		params.Set("limit", strconv.FormatInt(limit, 10))
 	}
 	if !startTime.IsZero() && !endTime.IsZero() {
 		if startTime.After(endTime) {
 			return resp, errors.New("startTime cannot be after endTime")
 		}
-		params.Set("start_time", strconv.FormatInt(startTime.Unix(), 10))
-		params.Set("end_time", strconv.FormatInt(endTime.Unix(), 10))
+		params.Set("startTime", timeString(startTime))
+		params.Set("endTime", timeString(endTime))
 	}
 	return resp, b.SendHTTPRequest(ctx, exchange.RestUSDTMargined, ufuturesFundingRateHistory+params.Encode(), uFuturesDefaultRate, &resp)
 }
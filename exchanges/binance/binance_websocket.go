@@ -12,7 +12,9 @@ import (
 
 	"github.com/gorilla/websocket"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fill"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
@@ -200,7 +202,17 @@ func (b *Binance) wsHandleData(respRaw []byte) error {
 						b.Name,
 						err)
 				}
-				b.Websocket.DataHandler <- data
+				for i := range data.Data.Currencies {
+					b.Websocket.DataHandler <- account.Change{
+						Exchange: b.Name,
+						Currency: currency.NewCode(data.Data.Currencies[i].Asset),
+						Asset:    asset.Spot,
+						Balance: &account.Balance{
+							TotalValue: data.Data.Currencies[i].Available + data.Data.Currencies[i].Locked,
+							Hold:       data.Data.Currencies[i].Locked,
+						},
+					}
+				}
 				return nil
 			case "balanceUpdate":
 				var data wsBalanceUpdate
@@ -210,7 +222,12 @@ func (b *Binance) wsHandleData(respRaw []byte) error {
 						b.Name,
 						err)
 				}
-				b.Websocket.DataHandler <- data
+				b.Websocket.DataHandler <- account.Change{
+					Exchange: b.Name,
+					Currency: currency.NewCode(data.Data.Asset),
+					Asset:    asset.Spot,
+					Amount:   data.Data.BalanceDelta,
+				}
 				return nil
 			case "executionReport":
 				var data wsOrderUpdate
@@ -283,6 +300,27 @@ func (b *Binance) wsHandleData(respRaw []byte) error {
 					LastUpdated:          data.Data.TransactionTime,
 					Pair:                 pair,
 				}
+				if data.Data.CurrentExecutionType == "TRADE" {
+					b.Websocket.DataHandler <- []fill.Data{{
+						TradeHistory: order.TradeHistory{
+							Price:     data.Data.LastExecutedPrice,
+							Amount:    data.Data.LastExecutedQuantity,
+							Fee:       data.Data.Commission,
+							Exchange:  b.Name,
+							TID:       strconv.FormatInt(data.Data.TradeID, 10),
+							Type:      orderType,
+							Side:      orderSide,
+							Timestamp: data.Data.TransactionTime,
+							IsMaker:   data.Data.IsMaker,
+							FeeAsset:  data.Data.CommissionAsset,
+							Total:     data.Data.LastQuoteAssetTransactedQuantity,
+						},
+						OrderID:       orderID,
+						ClientOrderID: clientOrderID,
+						Pair:          pair,
+						AssetType:     assetType,
+					}}
+				}
 				return nil
 			case "listStatus":
 				var data wsListStatus
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"sort"
 	"strconv"
 	"strings"
@@ -16,7 +17,12 @@ import (
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/capability"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/deposit"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fee"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
@@ -137,6 +143,7 @@ func (b *Binance) SetDefaults() {
 				UserTradeHistory:    true,
 				TradeFee:            true,
 				CryptoWithdrawalFee: true,
+				FundsTransfer:       true,
 			},
 			WebsocketCapabilities: protocol.Features{
 				TradeFetching:          true,
@@ -156,6 +163,10 @@ func (b *Binance) SetDefaults() {
 				DateRanges: true,
 				Intervals:  true,
 			},
+			Orders: capability.Orders{
+				Types:       []order.Type{order.Limit, order.Market},
+				TimeInForce: []capability.TimeInForce{capability.GoodTillCancel},
+			},
 		},
 		Enabled: exchange.FeaturesEnabled{
 			AutoPairUpdates: true,
@@ -1006,6 +1017,100 @@ func (b *Binance) SubmitOrder(ctx context.Context, s *order.Submit) (order.Submi
 	return submitOrderResponse, nil
 }
 
+// SubmitBatchOrders submits multiple orders in as few requests as possible,
+// only USDT margined futures support batch placement
+func (b *Binance) SubmitBatchOrders(ctx context.Context, s []*order.Submit) (order.SubmitBatchResponse, error) {
+	a, err := order.BatchAssetType(s)
+	if err != nil {
+		return order.SubmitBatchResponse{}, err
+	}
+	if a != asset.USDTMarginedFutures {
+		return order.SubmitBatchResponse{}, fmt.Errorf("%s %w", a, common.ErrFunctionNotSupported)
+	}
+	resp := order.SubmitBatchResponse{Results: make([]order.SubmitResult, len(s))}
+	for x := 0; x < len(s); x += uFuturesMaxBatchOrders {
+		end := x + uFuturesMaxBatchOrders
+		if end > len(s) {
+			end = len(s)
+		}
+		var data []PlaceBatchOrderData
+		var index []int
+		for y := x; y < end; y++ {
+			d, err := uFuturesBatchOrderData(s[y])
+			if err != nil {
+				resp.Results[y].Error = err
+				continue
+			}
+			data = append(data, d)
+			index = append(index, y)
+		}
+		if len(data) == 0 {
+			continue
+		}
+		placed, err := b.UPlaceBatchOrders(ctx, data)
+		if err == nil && len(placed) != len(data) {
+			err = fmt.Errorf("%s batch order response count %d does not match request count %d",
+				b.Name,
+				len(placed),
+				len(data))
+		}
+		if err != nil {
+			// orders in earlier requests may have been placed, so the
+			// failure is recorded against this request's orders only
+			for y := range index {
+				resp.Results[index[y]].Error = err
+			}
+			continue
+		}
+		for y := range placed {
+			r := &resp.Results[index[y]]
+			if placed[y].Code != 0 {
+				r.Error = fmt.Errorf("%s code: %d msg: %s", b.Name, placed[y].Code, placed[y].Message)
+				continue
+			}
+			r.OrderID = strconv.FormatInt(placed[y].OrderID, 10)
+			r.IsOrderPlaced = true
+			r.FullyMatched = placed[y].OriginalQuantity > 0 &&
+				placed[y].ExecutedQuantity == placed[y].OriginalQuantity
+		}
+	}
+	return resp, nil
+}
+
+// uFuturesBatchOrderData converts an order submission into batch order data
+func uFuturesBatchOrderData(s *order.Submit) (PlaceBatchOrderData, error) {
+	if err := s.Validate(); err != nil {
+		return PlaceBatchOrderData{}, err
+	}
+	d := PlaceBatchOrderData{
+		Symbol:           s.Pair.String(),
+		Quantity:         s.Amount,
+		Price:            s.Price,
+		NewClientOrderID: s.ClientOrderID,
+	}
+	switch s.Side {
+	case order.Buy:
+		d.Side = "BUY"
+	case order.Sell:
+		d.Side = "SELL"
+	default:
+		return PlaceBatchOrderData{}, fmt.Errorf("invalid side")
+	}
+	switch s.Type {
+	case order.Limit:
+		d.OrderType = "LIMIT"
+		d.TimeInForce = "GTC"
+	case order.Market:
+		d.OrderType = "MARKET"
+	default:
+		return PlaceBatchOrderData{}, errors.New("invalid type, check api docs for updates")
+	}
+	if s.ReduceOnly {
+		d.ReduceOnly = "true"
+	}
+	return d, nil
+}
+
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
 func (b *Binance) ModifyOrder(ctx context.Context, action *order.Modify) (order.Modify, error) {
@@ -1217,8 +1322,49 @@ func (b *Binance) GetOrderInfo(ctx context.Context, orderID string, pair currenc
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (b *Binance) GetDepositAddress(ctx context.Context, cryptocurrency currency.Code, _ string) (string, error) {
-	return b.GetDepositAddressForCurrency(ctx, cryptocurrency.String())
+func (b *Binance) GetDepositAddress(ctx context.Context, cryptocurrency currency.Code, _, chain string) (*deposit.Address, error) {
+	addr, err := b.GetDepositAddressForCurrency(ctx, cryptocurrency.String(), chain)
+	if err != nil {
+		return nil, err
+	}
+	return &deposit.Address{
+		Address: addr.Address,
+		Tag:     addr.Tag,
+		Chain:   chain,
+	}, nil
+}
+
+// GetAvailableTransferChains returns the networks the cryptocurrency can be
+// deposited and withdrawn on
+func (b *Binance) GetAvailableTransferChains(ctx context.Context, cryptocurrency currency.Code) ([]deposit.Network, error) {
+	coins, err := b.GetAllCoinsInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for x := range coins {
+		if !strings.EqualFold(coins[x].Coin, cryptocurrency.String()) {
+			continue
+		}
+		networks := make([]deposit.Network, len(coins[x].NetworkList))
+		for y := range coins[x].NetworkList {
+			n := &coins[x].NetworkList[y]
+			networks[y] = deposit.Network{
+				Currency:        cryptocurrency,
+				Chain:           n.Network,
+				Name:            n.Name,
+				IsDefault:       n.IsDefault,
+				DepositEnabled:  n.DepositEnable,
+				WithdrawEnabled: n.WithdrawEnable,
+				WithdrawFee:     n.WithdrawFee,
+				WithdrawMinimum: n.WithdrawMinimum,
+				WithdrawMaximum: n.WithdrawMaximum,
+				Confirmations:   n.MinimumConfirmation,
+				RequiresTag:     n.SameAddress,
+			}
+		}
+		return networks, nil
+	}
+	return nil, fmt.Errorf("%s %w", cryptocurrency, deposit.ErrNoNetworks)
 }
 
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
@@ -1232,6 +1378,7 @@ func (b *Binance) WithdrawCryptocurrencyFunds(ctx context.Context, withdrawReque
 		withdrawRequest.Currency.String(),
 		withdrawRequest.Crypto.Address,
 		withdrawRequest.Crypto.AddressTag,
+		withdrawRequest.Crypto.Chain,
 		withdrawRequest.Description, amountStr)
 	if err != nil {
 		return nil, err
@@ -1735,3 +1882,682 @@ func (b *Binance) UpdateOrderExecutionLimits(ctx context.Context, a asset.Item)
 	}
 	return b.LoadLimits(limits)
 }
+
+// GetLatestFundingRate returns the latest settled funding rate for a
+// perpetual contract along with the predicted rate for the next funding time
+func (b *Binance) GetLatestFundingRate(ctx context.Context, a asset.Item, p currency.Pair) (*futures.LatestFundingRate, error) {
+	if err := futures.CheckRequest(a, p); err != nil {
+		return nil, err
+	}
+	history, err := b.fundingRateHistory(ctx, a, p, 1, time.Time{}, time.Time{})
+	if err != nil {
+		return nil, err
+	}
+	resp := &futures.LatestFundingRate{
+		FundingRate: futures.FundingRate{
+			Exchange: b.Name,
+			Asset:    a,
+			Pair:     p,
+		},
+	}
+	if len(history) > 0 {
+		resp.Rate = history[len(history)-1].FundingRate
+		resp.Time = time.Unix(0, history[len(history)-1].FundingTime*int64(time.Millisecond))
+	}
+	switch a {
+	case asset.USDTMarginedFutures:
+		mp, err := b.UGetMarkPrice(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		if len(mp) != 1 {
+			return nil, fmt.Errorf("%w %v", errUnexpectedMarkPriceResponse, p)
+		}
+		resp.PredictedRate = mp[0].LastFundingRate
+		resp.NextFundingTime = time.Unix(0, mp[0].NextFundingTime*int64(time.Millisecond))
+	case asset.CoinMarginedFutures:
+		mp, err := b.coinMarginedMarkPrice(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		if mp.LastFundingRate != "" {
+			resp.PredictedRate, err = strconv.ParseFloat(mp.LastFundingRate, 64)
+			if err != nil {
+				return nil, err
+			}
+		}
+		resp.NextFundingTime = time.Unix(0, mp.NextFundingTime*int64(time.Millisecond))
+	}
+	return resp, nil
+}
+
+// GetFundingRateHistory returns the funding rate history for a perpetual
+// contract between the start and end times
+func (b *Binance) GetFundingRateHistory(ctx context.Context, a asset.Item, p currency.Pair, start, end time.Time) ([]futures.FundingRate, error) {
+	if err := futures.CheckRequest(a, p); err != nil {
+		return nil, err
+	}
+	if err := common.StartEndTimeCheck(start, end); err != nil {
+		return nil, err
+	}
+	var resp []futures.FundingRate
+	for {
+		history, err := b.fundingRateHistory(ctx, a, p, fundingRateHistoryLimit, start, end)
+		if err != nil {
+			return nil, err
+		}
+		for i := range history {
+			resp = append(resp, futures.FundingRate{
+				Exchange: b.Name,
+				Asset:    a,
+				Pair:     p,
+				Rate:     history[i].FundingRate,
+				Time:     time.Unix(0, history[i].FundingTime*int64(time.Millisecond)),
+			})
+		}
+		if len(history) < fundingRateHistoryLimit {
+			break
+		}
+		start = time.Unix(0, (history[len(history)-1].FundingTime+1)*int64(time.Millisecond))
+		if !start.Before(end) {
+			break
+		}
+	}
+	futures.SortFundingRates(resp)
+	return resp, nil
+}
+
+// GetFuturesOpenInterest returns the open interest for a futures contract
+func (b *Binance) GetFuturesOpenInterest(ctx context.Context, a asset.Item, p currency.Pair) (*futures.OpenInterest, error) {
+	if err := futures.CheckRequest(a, p); err != nil {
+		return nil, err
+	}
+	resp := &futures.OpenInterest{
+		Exchange: b.Name,
+		Asset:    a,
+		Pair:     p,
+	}
+	switch a {
+	case asset.USDTMarginedFutures:
+		oi, err := b.UOpenInterest(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		resp.Amount = oi.OpenInterest
+		resp.Time = time.Unix(0, oi.Time*int64(time.Millisecond))
+	case asset.CoinMarginedFutures:
+		oi, err := b.GetOpenInterest(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		resp.Amount = oi.OpenInterest
+		resp.Time = time.Unix(0, oi.Time*int64(time.Millisecond))
+	default:
+		return nil, fmt.Errorf("%w %v", asset.ErrNotSupported, a)
+	}
+	return resp, nil
+}
+
+// GetFuturesMarkPrice returns the mark and index price for a futures contract
+func (b *Binance) GetFuturesMarkPrice(ctx context.Context, a asset.Item, p currency.Pair) (*futures.MarkPrice, error) {
+	if err := futures.CheckRequest(a, p); err != nil {
+		return nil, err
+	}
+	resp := &futures.MarkPrice{
+		Exchange: b.Name,
+		Asset:    a,
+		Pair:     p,
+	}
+	switch a {
+	case asset.USDTMarginedFutures:
+		mp, err := b.UGetMarkPrice(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		if len(mp) != 1 {
+			return nil, fmt.Errorf("%w %v", errUnexpectedMarkPriceResponse, p)
+		}
+		resp.MarkPrice = mp[0].MarkPrice
+		resp.IndexPrice = mp[0].IndexPrice
+		resp.Time = time.Unix(0, mp[0].Time*int64(time.Millisecond))
+	case asset.CoinMarginedFutures:
+		mp, err := b.coinMarginedMarkPrice(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		resp.MarkPrice = mp.MarkPrice
+		resp.IndexPrice = mp.IndexPrice
+		resp.Time = time.Unix(0, mp.Time*int64(time.Millisecond))
+	default:
+		return nil, fmt.Errorf("%w %v", asset.ErrNotSupported, a)
+	}
+	return resp, nil
+}
+
+// fundingRateHistory returns funding rate history for the futures asset
+// type
+func (b *Binance) fundingRateHistory(ctx context.Context, a asset.Item, p currency.Pair, limit int64, start, end time.Time) ([]FundingRateHistory, error) {
+	switch a {
+	case asset.USDTMarginedFutures:
+		return b.UGetFundingHistory(ctx, p, limit, start, end)
+	case asset.CoinMarginedFutures:
+		return b.FuturesGetFundingHistory(ctx, p, limit, start, end)
+	default:
+		return nil, fmt.Errorf("%w %v", asset.ErrNotSupported, a)
+	}
+}
+
+// coinMarginedMarkPrice returns the mark price data for a single coin
+// margined contract
+func (b *Binance) coinMarginedMarkPrice(ctx context.Context, p currency.Pair) (*IndexMarkPrice, error) {
+	symbol, err := b.FormatSymbol(p, asset.CoinMarginedFutures)
+	if err != nil {
+		return nil, err
+	}
+	mp, err := b.GetIndexAndMarkPrice(ctx, symbol, "")
+	if err != nil {
+		return nil, err
+	}
+	if len(mp) != 1 {
+		return nil, fmt.Errorf("%w %v", errUnexpectedMarkPriceResponse, p)
+	}
+	return &mp[0], nil
+}
+
+// GetFuturesPositions returns open futures positions for an asset, an empty
+// pair returns positions for all contracts
+func (b *Binance) GetFuturesPositions(ctx context.Context, a asset.Item, p currency.Pair) ([]futures.Position, error) {
+	if err := futures.CheckAsset(a); err != nil {
+		return nil, err
+	}
+	var positions []futures.Position
+	switch a {
+	case asset.USDTMarginedFutures:
+		info, err := b.UPositionsInfoV2(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		for i := range info {
+			if info[i].PositionAmount == 0 {
+				continue
+			}
+			cp, err := b.futuresSymbolToPair(info[i].Symbol, a)
+			if err != nil {
+				return nil, err
+			}
+			mt, err := futures.StringToMarginType(info[i].MarginType)
+			if err != nil {
+				return nil, err
+			}
+			positions = append(positions, futures.Position{
+				Exchange:         b.Name,
+				Asset:            a,
+				Pair:             cp,
+				Side:             futures.SideFromSize(info[i].PositionAmount),
+				Size:             math.Abs(info[i].PositionAmount),
+				EntryPrice:       info[i].EntryPrice,
+				MarkPrice:        info[i].MarkPrice,
+				LiquidationPrice: info[i].LiquidationPrice,
+				UnrealisedPNL:    info[i].UnrealizedProfit,
+				Leverage:         info[i].Leverage,
+				MarginType:       mt,
+				Margin:           info[i].IsolatedMargin,
+			})
+		}
+	case asset.CoinMarginedFutures:
+		info, err := b.coinMarginedPositions(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		for i := range info {
+			if info[i].PositionAmount == 0 {
+				continue
+			}
+			cp, err := b.futuresSymbolToPair(info[i].Symbol, a)
+			if err != nil {
+				return nil, err
+			}
+			mt, err := futures.StringToMarginType(info[i].MarginType)
+			if err != nil {
+				return nil, err
+			}
+			positions = append(positions, futures.Position{
+				Exchange:         b.Name,
+				Asset:            a,
+				Pair:             cp,
+				Side:             futures.SideFromSize(info[i].PositionAmount),
+				Size:             math.Abs(info[i].PositionAmount),
+				EntryPrice:       info[i].EntryPrice,
+				MarkPrice:        info[i].MarkPrice,
+				LiquidationPrice: info[i].LiquidationPrice,
+				UnrealisedPNL:    info[i].UnrealizedProfit,
+				Leverage:         info[i].Leverage,
+				MarginType:       mt,
+				Margin:           info[i].IsolatedMargin,
+			})
+		}
+	default:
+		return nil, fmt.Errorf("%w %v", asset.ErrNotSupported, a)
+	}
+	return positions, nil
+}
+
+// ChangeFuturesLeverage sets the initial leverage for a futures contract
+func (b *Binance) ChangeFuturesLeverage(ctx context.Context, a asset.Item, p currency.Pair, leverage float64) error {
+	if err := futures.CheckLeverage(a, p, leverage); err != nil {
+		return err
+	}
+	if leverage != math.Trunc(leverage) {
+		return fmt.Errorf("%v %w", leverage, errFractionalLeverage)
+	}
+	var err error
+	switch a {
+	case asset.USDTMarginedFutures:
+		_, err = b.UChangeInitialLeverageRequest(ctx, p, int64(leverage))
+	case asset.CoinMarginedFutures:
+		_, err = b.FuturesChangeInitialLeverage(ctx, p, int64(leverage))
+	default:
+		return fmt.Errorf("%w %v", asset.ErrNotSupported, a)
+	}
+	return err
+}
+
+// ChangeFuturesMarginType sets the margin type for a futures contract
+func (b *Binance) ChangeFuturesMarginType(ctx context.Context, a asset.Item, p currency.Pair, marginType futures.MarginType) error {
+	if err := futures.CheckRequest(a, p); err != nil {
+		return err
+	}
+	var mt string
+	switch marginType {
+	case futures.Isolated:
+		mt = "ISOLATED"
+	case futures.Cross:
+		mt = "CROSSED"
+	default:
+		return fmt.Errorf("%q %w", marginType, futures.ErrMarginTypeNotSupported)
+	}
+	switch a {
+	case asset.USDTMarginedFutures:
+		return b.UChangeInitialMarginType(ctx, p, mt)
+	case asset.CoinMarginedFutures:
+		_, err := b.FuturesChangeMarginType(ctx, p, mt)
+		return err
+	default:
+		return fmt.Errorf("%w %v", asset.ErrNotSupported, a)
+	}
+}
+
+// GetFuturesMarginInfo returns the leverage and margin settings for a futures
+// contract along with the margin balances of its collateral asset
+func (b *Binance) GetFuturesMarginInfo(ctx context.Context, a asset.Item, p currency.Pair) (*futures.MarginInfo, error) {
+	if err := futures.CheckRequest(a, p); err != nil {
+		return nil, err
+	}
+	resp := &futures.MarginInfo{
+		Exchange: b.Name,
+		Asset:    a,
+		Pair:     p,
+	}
+	switch a {
+	case asset.USDTMarginedFutures:
+		info, err := b.UPositionsInfoV2(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		if len(info) == 0 {
+			return nil, fmt.Errorf("%w %v", errPositionNotFound, p)
+		}
+		resp.Leverage = info[0].Leverage
+		resp.MarginType, err = futures.StringToMarginType(info[0].MarginType)
+		if err != nil {
+			return nil, err
+		}
+		brackets, err := b.UGetNotionalAndLeverageBrackets(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		if len(brackets) > 0 && len(brackets[0].Brackets) > 0 {
+			resp.MaxLeverage = brackets[0].Brackets[0].InitialLeverage
+		}
+		acc, err := b.UAccountInformationV2(ctx)
+		if err != nil {
+			return nil, err
+		}
+		resp.Collateral = p.Quote
+		for i := range acc.Assets {
+			if !resp.Collateral.Match(currency.NewCode(acc.Assets[i].Asset)) {
+				continue
+			}
+			resp.MarginBalance = acc.Assets[i].MarginBalance
+			resp.AvailableMargin = acc.Assets[i].AvailableBalance
+			resp.InitialMargin = acc.Assets[i].InitialMargin
+			resp.MaintenanceMargin = acc.Assets[i].MaintMargin
+			break
+		}
+	case asset.CoinMarginedFutures:
+		info, err := b.coinMarginedPositions(ctx, p)
+		if err != nil {
+			return nil, err
+		}
+		if len(info) == 0 {
+			return nil, fmt.Errorf("%w %v", errPositionNotFound, p)
+		}
+		resp.Leverage = info[0].Leverage
+		resp.MarginType, err = futures.StringToMarginType(info[0].MarginType)
+		if err != nil {
+			return nil, err
+		}
+		// coin margined contracts are named after their underlying index
+		// e.g. BTCUSD_PERP and are collateralised in the base currency
+		underlying := p.Base.Upper().String()
+		brackets, err := b.FuturesNotionalBracket(ctx, underlying)
+		if err != nil {
+			return nil, err
+		}
+		if len(brackets) > 0 && len(brackets[0].Brackets) > 0 {
+			resp.MaxLeverage = brackets[0].Brackets[0].InitialLeverage
+		}
+		acc, err := b.GetFuturesAccountInfo(ctx)
+		if err != nil {
+			return nil, err
+		}
+		resp.Collateral = currency.NewCode(strings.TrimSuffix(underlying, currency.USD.String()))
+		for i := range acc.Assets {
+			if !resp.Collateral.Match(currency.NewCode(acc.Assets[i].Asset)) {
+				continue
+			}
+			resp.MarginBalance = acc.Assets[i].MarginBalance
+			resp.AvailableMargin = acc.Assets[i].AvailableBalance
+			resp.InitialMargin = acc.Assets[i].InitialMargin
+			resp.MaintenanceMargin = acc.Assets[i].MaintMargin
+			break
+		}
+	default:
+		return nil, fmt.Errorf("%w %v", asset.ErrNotSupported, a)
+	}
+	return resp, nil
+}
+
+// coinMarginedPositions returns coin margined position information, filtered
+// to a single contract when a pair is supplied
+func (b *Binance) coinMarginedPositions(ctx context.Context, p currency.Pair) ([]FuturesPositionInformation, error) {
+	info, err := b.FuturesPositionsInfo(ctx, "", "")
+	if err != nil {
+		return nil, err
+	}
+	if p.IsEmpty() {
+		return info, nil
+	}
+	symbol, err := b.FormatSymbol(p, asset.CoinMarginedFutures)
+	if err != nil {
+		return nil, err
+	}
+	filtered := info[:0]
+	for i := range info {
+		if info[i].Symbol == symbol {
+			filtered = append(filtered, info[i])
+		}
+	}
+	return filtered, nil
+}
+
+// futuresSymbolToPair matches an exchange futures symbol to an available pair
+func (b *Binance) futuresSymbolToPair(symbol string, a asset.Item) (currency.Pair, error) {
+	avail, err := b.GetAvailablePairs(a)
+	if err != nil {
+		return currency.Pair{}, err
+	}
+	pFmt, err := b.GetPairFormat(a, true)
+	if err != nil {
+		return currency.Pair{}, err
+	}
+	return currency.NewPairFromFormattedPairs(symbol, avail, pFmt)
+}
+
+// TransferFunds moves funds between the spot, margin and futures wallets,
+// sub-account transfers are not supported
+func (b *Binance) TransferFunds(ctx context.Context, req *account.TransferRequest) (*account.TransferResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	if req.FromSubAccount != "" || req.ToSubAccount != "" {
+		return nil, fmt.Errorf("%s %w", req, account.ErrTransferRouteNotSupported)
+	}
+	from, err := transferWallet(req.FromAsset)
+	if err != nil {
+		return nil, err
+	}
+	to, err := transferWallet(req.ToAsset)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := b.UniversalTransfer(ctx, from+"_"+to, req.Currency, req.Amount)
+	if err != nil {
+		return nil, err
+	}
+	return &account.TransferResponse{
+		ID:     strconv.FormatInt(resp.TransactionID, 10),
+		Status: "success",
+		Time:   time.Now(),
+	}, nil
+}
+
+// transferWallet returns the universal transfer wallet name for an asset, an
+// empty asset refers to the spot wallet
+func transferWallet(a asset.Item) (string, error) {
+	switch a {
+	case "", asset.Spot:
+		return "MAIN", nil
+	case asset.Margin:
+		return "MARGIN", nil
+	case asset.USDTMarginedFutures:
+		return "UMFUTURE", nil
+	case asset.CoinMarginedFutures:
+		return "CMFUTURE", nil
+	}
+	return "", fmt.Errorf("%w %v", asset.ErrNotSupported, a)
+}
+
+// GetMarginInterestRates returns the current daily cross margin borrow rate
+// for a currency at the account's VIP level
+func (b *Binance) GetMarginInterestRates(ctx context.Context, c currency.Code) ([]margin.InterestRate, error) {
+	if c.IsEmpty() {
+		return nil, margin.ErrCurrencyIsEmpty
+	}
+	history, err := b.GetMarginInterestRateHistory(ctx, c, time.Time{}, time.Time{}, 0)
+	if err != nil {
+		return nil, err
+	}
+	if len(history) == 0 {
+		return nil, nil
+	}
+	latest := history[0]
+	for i := range history[1:] {
+		if history[i+1].Timestamp > latest.Timestamp {
+			latest = history[i+1]
+		}
+	}
+	return []margin.InterestRate{{
+		Exchange: b.Name,
+		Currency: c.Upper(),
+		Rate:     latest.DailyInterestRate,
+		Time:     time.Unix(0, latest.Timestamp*int64(time.Millisecond)),
+	}}, nil
+}
+
+// MarginBorrow borrows funds into the cross margin account or the isolated
+// margin account of the request pair
+func (b *Binance) MarginBorrow(ctx context.Context, r *margin.LoanRequest) (*margin.LoanResponse, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	symbol, err := b.isolatedMarginSymbol(r.Pair)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := b.MarginAccountBorrow(ctx, r.Currency, r.Amount, symbol)
+	if err != nil {
+		return nil, err
+	}
+	return &margin.LoanResponse{
+		ID:       strconv.FormatInt(resp.TransactionID, 10),
+		Currency: r.Currency,
+		Amount:   r.Amount,
+		Time:     time.Now(),
+	}, nil
+}
+
+// MarginRepay repays funds borrowed in the cross margin account or the
+// isolated margin account of the request pair
+func (b *Binance) MarginRepay(ctx context.Context, r *margin.LoanRequest) (*margin.LoanResponse, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	symbol, err := b.isolatedMarginSymbol(r.Pair)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := b.MarginAccountRepay(ctx, r.Currency, r.Amount, symbol)
+	if err != nil {
+		return nil, err
+	}
+	return &margin.LoanResponse{
+		ID:       strconv.FormatInt(resp.TransactionID, 10),
+		Currency: r.Currency,
+		Amount:   r.Amount,
+		Time:     time.Now(),
+	}, nil
+}
+
+// isolatedMarginSymbol returns the exchange symbol for an isolated margin
+// pair, an empty pair refers to the cross margin account
+func (b *Binance) isolatedMarginSymbol(p currency.Pair) (string, error) {
+	if p.IsEmpty() {
+		return "", nil
+	}
+	fPair, err := b.FormatExchangeCurrency(p, asset.Margin)
+	if err != nil {
+		return "", err
+	}
+	return fPair.String(), nil
+}
+
+// GetMarginLoans returns the outstanding cross margin borrows
+func (b *Binance) GetMarginLoans(ctx context.Context, c currency.Code) ([]margin.Loan, error) {
+	acc, err := b.GetMarginAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var loans []margin.Loan
+	for i := range acc.UserAssets {
+		if acc.UserAssets[i].Borrowed <= 0 {
+			continue
+		}
+		code := currency.NewCode(acc.UserAssets[i].Asset)
+		if !c.IsEmpty() && !c.Match(code) {
+			continue
+		}
+		loans = append(loans, margin.Loan{
+			Side:     margin.Borrow,
+			Status:   margin.Active,
+			Currency: code,
+			Amount:   acc.UserAssets[i].Borrowed,
+		})
+	}
+	return loans, nil
+}
+
+// GetMarginInterestHistory returns the interest charged on borrowed cross and
+// isolated margin funds, pages are requested until every payment in the time
+// range has been returned
+func (b *Binance) GetMarginInterestHistory(ctx context.Context, c currency.Code, start, end time.Time) ([]margin.InterestPayment, error) {
+	var payments []margin.InterestPayment
+	for page := int64(1); ; page++ {
+		history, err := b.GetMarginInterestPaymentHistory(ctx, c, start, end, page, marginInterestPageSize)
+		if err != nil {
+			return nil, err
+		}
+		for i := range history.Rows {
+			payments = append(payments, margin.InterestPayment{
+				Currency:  currency.NewCode(history.Rows[i].Asset),
+				Side:      margin.Borrow,
+				Principal: history.Rows[i].Principal,
+				Interest:  history.Rows[i].Interest,
+				Rate:      history.Rows[i].InterestRate,
+				Time:      time.Unix(0, history.Rows[i].InterestAccuredTime*int64(time.Millisecond)),
+			})
+		}
+		if len(history.Rows) < marginInterestPageSize || int64(len(payments)) >= history.Total {
+			break
+		}
+	}
+	return margin.FilterInterestPayments(payments, start, end), nil
+}
+
+// UpdateFeeSchedule retrieves the account commission rates, VIP tier, BNB
+// discount and withdrawal fees and loads them into the fee definitions
+func (b *Binance) UpdateFeeSchedule(ctx context.Context) error {
+	acc, err := b.GetAccount(ctx)
+	if err != nil {
+		return err
+	}
+	commission := fee.Commission{
+		Maker: float64(acc.MakerCommission) / commissionBasisPoints,
+		Taker: float64(acc.TakerCommission) / commissionBasisPoints,
+	}
+	s := &fee.Schedule{
+		Exchange:       b.Name,
+		VolumeCurrency: currency.BTC,
+		Commissions: map[asset.Item]fee.Commission{
+			asset.Spot:   commission,
+			asset.Margin: commission,
+		},
+		Tiers:         spotFeeTiers,
+		Discount:      fee.Discount{Currency: currency.BNB, Rate: bnbFeeDiscount},
+		Authenticated: true,
+	}
+	for i := range spotFeeTiers {
+		if spotFeeTiers[i].Commission == commission {
+			s.Tier = spotFeeTiers[i].Name
+			break
+		}
+	}
+
+	burn, err := b.GetBNBBurnStatus(ctx)
+	if err != nil {
+		return err
+	}
+	s.Discount.Enabled = burn.SpotBNBBurn
+
+	symbolFees, err := b.GetTradeFee(ctx, "")
+	if err != nil {
+		return err
+	}
+	for i := range symbolFees {
+		c := fee.Commission{
+			Maker: symbolFees[i].MakerCommission,
+			Taker: symbolFees[i].TakerCommission,
+		}
+		if c == commission {
+			continue
+		}
+		var cp currency.Pair
+		cp, err = currency.NewPairFromString(symbolFees[i].Symbol)
+		if err != nil {
+			return err
+		}
+		s.PairCommissions = append(s.PairCommissions, fee.PairCommission{
+			Asset:      asset.Spot,
+			Pair:       cp,
+			Commission: c,
+		})
+	}
+
+	for c, f := range WithdrawalFees {
+		s.Transfers = append(s.Transfers, fee.Transfer{
+			Currency:   c,
+			Withdrawal: f,
+		})
+	}
+	return b.Fees.Load(s)
+}
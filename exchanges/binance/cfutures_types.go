@@ -164,7 +164,7 @@ type PlaceBatchOrderData struct {
 	TimeInForce      string  `json:"timeInForce,omitempty"`
 	Quantity         float64 `json:"quantity"`
 	ReduceOnly       string  `json:"reduceOnly,omitempty"`
-	Price            float64 `json:"price"`
+	Price            float64 `json:"price,omitempty"`
 	NewClientOrderID string  `json:"newClientOrderId,omitempty"`
 	StopPrice        float64 `json:"stopPrice,omitempty"`
 	ActivationPrice  float64 `json:"activationPrice,omitempty"`
@@ -353,6 +353,7 @@ type FuturesAccountInformation struct {
 		PositionSide           string  `json:"positionSide"`
 		EntryPrice             float64 `json:"entryPrice,string"`
 		MaxQty                 float64 `json:"maxQty,string"`
+		AvailableBalance       float64 `json:"availableBalance,string"`
 	} `json:"assets"`
 	Positions []struct {
 		Symbol                 string  `json:"symbol"`
@@ -412,12 +413,12 @@ type FuturesPositionInformation struct {
 	EntryPrice       float64 `json:"entryPrice,string"`
 	MarkPrice        float64 `json:"markPrice,string"`
 	UnrealizedProfit float64 `json:"unRealizedProfit,string"`
-	LiquidationPrice float64 `json:"liquidation,string"`
-	Leverage         int64   `json:"leverage"`
-	MaxQty           float64 `json:"maxQty"`
+	LiquidationPrice float64 `json:"liquidationPrice,string"`
+	Leverage         float64 `json:"leverage,string"`
+	MaxQty           float64 `json:"maxQty,string"`
 	MarginType       string  `json:"marginType"`
 	IsolatedMargin   float64 `json:"isolatedMargin,string"`
-	IsAutoAddMargin  bool    `json:"isAutoAddMargin"`
+	IsAutoAddMargin  bool    `json:"isAutoAddMargin,string"`
 	PositionSide     string  `json:"positionSide"`
 }
 
@@ -1081,11 +1081,15 @@ func (b *Bitfinex) WalletTransfer(ctx context.Context, amount float64, currency,
 }
 
 // WithdrawCryptocurrency requests a withdrawal from one of your wallets.
-// For FIAT, use WithdrawFIAT
-func (b *Bitfinex) WithdrawCryptocurrency(ctx context.Context, wallet, address, paymentID string, amount float64, c currency.Code) (Withdrawal, error) {
+// method selects the chain to withdraw on e.g. tetherusx, if left empty the
+// currency's default method is used. For FIAT, use WithdrawFIAT
+func (b *Bitfinex) WithdrawCryptocurrency(ctx context.Context, wallet, address, paymentID, method string, amount float64, c currency.Code) (Withdrawal, error) {
 	var response []Withdrawal
 	req := make(map[string]interface{})
-	req["withdraw_type"] = b.ConvertSymbolToWithdrawalType(c)
+	if method == "" {
+		method = b.ConvertSymbolToWithdrawalType(c)
+	}
+	req["withdraw_type"] = method
 	req["walletselected"] = wallet
 	req["amount"] = strconv.FormatFloat(amount, 'f', -1, 64)
 	req["address"] = address
@@ -2,6 +2,7 @@ package bitfinex
 
 import (
 	"context"
+	"errors"
 	"log"
 	"net/http"
 	"os"
@@ -13,8 +14,10 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/core"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/sharedtestvalues"
@@ -1035,13 +1038,13 @@ func TestGetDepositAddress(t *testing.T) {
 	t.Parallel()
 	if areTestAPIKeysSet() {
 		_, err := b.GetDepositAddress(context.Background(),
-			currency.BTC, "deposit")
+			currency.BTC, "deposit", "")
 		if err != nil {
 			t.Error("GetDepositAddress() error", err)
 		}
 	} else {
 		_, err := b.GetDepositAddress(context.Background(),
-			currency.BTC, "deposit")
+			currency.BTC, "deposit", "")
 		if err == nil {
 			t.Error("GetDepositAddress() error cannot be nil")
 		}
@@ -1313,6 +1316,37 @@ func TestWsOrderSnapshot(t *testing.T) {
 	}
 }
 
+func TestWsTradeExecutionUpdate(t *testing.T) {
+	b.WsAddSubscriptionChannel(0, "account", "N/A")
+	pressXToJSON := `[0,"tu",[402088407,"tETHUSD",1574963975602,34938060782,-0.2,153.57,"EXCHANGE LIMIT",0,-1,-0.061668,"USD"]]`
+	err := b.wsHandleData([]byte(pressXToJSON))
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestWalletChange(t *testing.T) {
+	t.Parallel()
+	c := walletChange("Bitfinex", &WsWallet{
+		Type:             "margin",
+		Currency:         "BTC",
+		Balance:          2,
+		BalanceAvailable: 1.5,
+	})
+	if c.Asset != asset.Margin ||
+		c.Account != "margin" ||
+		!c.Currency.Match(currency.BTC) ||
+		c.Balance == nil ||
+		c.Balance.TotalValue != 2 ||
+		c.Balance.Hold != 0.5 {
+		t.Errorf("unexpected change: %+v", c)
+	}
+	c = walletChange("Bitfinex", &WsWallet{Type: "exchange", Currency: "USD", Balance: 10})
+	if c.Asset != asset.Spot || c.Balance.Hold != 0 {
+		t.Errorf("unexpected change: %+v", c)
+	}
+}
+
 func TestWsNotifications(t *testing.T) {
 	pressXToJSON := `[0,"n",[1575282446099,"fon-req",null,null,[41238905,null,null,null,-1000,null,null,null,null,null,null,null,null,null,0.002,2,null,null,null,null,null],null,"SUCCESS","Submitting funding bid of 1000.0 USD at 0.2000 for 2 days."]]`
 	err := b.wsHandleData([]byte(pressXToJSON))
@@ -1653,3 +1687,152 @@ func TestReOrderbyID(t *testing.T) {
 		}
 	}
 }
+
+func TestTransferFunds(t *testing.T) {
+	t.Parallel()
+	_, err := b.TransferFunds(context.Background(), &account.TransferRequest{
+		Currency:       currency.BTC,
+		Amount:         0.01,
+		FromSubAccount: "sub",
+	})
+	if !errors.Is(err, account.ErrTransferRouteNotSupported) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, account.ErrTransferRouteNotSupported)
+	}
+	_, err = b.TransferFunds(context.Background(), &account.TransferRequest{
+		Currency: currency.BTC,
+		Amount:   0.01,
+		ToAsset:  asset.Futures,
+	})
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, asset.ErrNotSupported)
+	}
+	if !areTestAPIKeysSet() || !canManipulateRealOrders {
+		t.Skip("skipping test: api keys not set or canManipulateRealOrders set to false")
+	}
+	_, err = b.TransferFunds(context.Background(), &account.TransferRequest{
+		Currency: currency.BTC,
+		Amount:   0.01,
+		ToAsset:  asset.Margin,
+	})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetMarginInterestRates(t *testing.T) {
+	t.Parallel()
+	_, err := b.GetMarginInterestRates(context.Background(), currency.Code{})
+	if !errors.Is(err, margin.ErrCurrencyIsEmpty) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, margin.ErrCurrencyIsEmpty)
+	}
+	rates, err := b.GetMarginInterestRates(context.Background(), currency.USD)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(rates) != 1 {
+		t.Errorf("received: '%v' but expected: '%v'", len(rates), 1)
+	}
+}
+
+func TestSubmitMarginLendOffer(t *testing.T) {
+	t.Parallel()
+	_, err := b.SubmitMarginLendOffer(context.Background(), &margin.LendOffer{
+		Currency:  currency.USD,
+		Amount:    50,
+		Rate:      0.0002,
+		AutoRenew: true,
+	})
+	if !errors.Is(err, errAutoRenewNotSupported) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errAutoRenewNotSupported)
+	}
+	_, err = b.SubmitMarginLendOffer(context.Background(), &margin.LendOffer{
+		Currency: currency.USD,
+		Amount:   50,
+		Rate:     0.0002,
+		Period:   31,
+	})
+	if !errors.Is(err, errInvalidLendingPeriod) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errInvalidLendingPeriod)
+	}
+	if !areTestAPIKeysSet() || !canManipulateRealOrders {
+		t.Skip("skipping test: api keys not set or canManipulateRealOrders set to false")
+	}
+	_, err = b.SubmitMarginLendOffer(context.Background(), &margin.LendOffer{
+		Currency: currency.USD,
+		Amount:   50,
+		Rate:     0.0002,
+	})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCancelMarginLendOffer(t *testing.T) {
+	t.Parallel()
+	err := b.CancelMarginLendOffer(context.Background(), currency.USD, "abc")
+	if err == nil {
+		t.Fatal("expected error for a non numeric offer ID")
+	}
+	if !areTestAPIKeysSet() || !canManipulateRealOrders {
+		t.Skip("skipping test: api keys not set or canManipulateRealOrders set to false")
+	}
+	err = b.CancelMarginLendOffer(context.Background(), currency.USD, "1")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetMarginLoans(t *testing.T) {
+	t.Parallel()
+	if !areTestAPIKeysSet() {
+		t.Skip("skipping test: api keys not set")
+	}
+	_, err := b.GetMarginLoans(context.Background(), currency.USD)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestAnnualPercentageToDailyRate(t *testing.T) {
+	t.Parallel()
+	if r := annualPercentageToDailyRate(7.3); r != 0.0002 {
+		t.Errorf("received: '%v' but expected: '%v'", r, 0.0002)
+	}
+}
+
+func TestParseDecimalTimestamp(t *testing.T) {
+	t.Parallel()
+	tm, err := parseDecimalTimestamp("1444141857.5")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !tm.Equal(time.Unix(1444141857, 500000000)) {
+		t.Errorf("received: '%v' but expected: '%v'", tm, time.Unix(1444141857, 500000000))
+	}
+	tm, err = parseDecimalTimestamp("")
+	if err != nil || !tm.IsZero() {
+		t.Errorf("received: '%v' '%v' but expected a zero time", tm, err)
+	}
+	_, err = parseDecimalTimestamp("abc")
+	if err == nil {
+		t.Error("expected error for an invalid timestamp")
+	}
+}
+
+func TestUpdateFeeSchedule(t *testing.T) {
+	t.Parallel()
+	if !areTestAPIKeysSet() {
+		t.Skip()
+	}
+	err := b.UpdateFeeSchedule(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := b.GetFeeSchedule()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !s.Authenticated || len(s.Commissions) == 0 {
+		t.Errorf("unexpected fee schedule %+v", s)
+	}
+}
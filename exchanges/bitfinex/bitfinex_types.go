@@ -7,7 +7,24 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 )
 
-var errTypeAssert = errors.New("type assertion failed")
+var (
+	errTypeAssert            = errors.New("type assertion failed")
+	errAutoRenewNotSupported = errors.New("auto renewing lending offers is not supported")
+	errInvalidLendingPeriod  = errors.New("lending period must be between 2 and 30 days")
+	errNoAccountFees         = errors.New("no account fee information returned")
+)
+
+// Lending offer period limits in days
+const (
+	minLendingPeriod     = 2
+	maxLendingPeriod     = 30
+	daysPerYear          = 365
+	percentageMultiplier = 100
+)
+
+// totalUSDVolume is the currency label of the combined thirty day volume in
+// the account summary
+const totalUSDVolume = "Total (USD)"
 
 // AccountV2Data stores account v2 data
 type AccountV2Data struct {
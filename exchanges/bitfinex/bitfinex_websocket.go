@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"hash/crc32"
+	"math"
 	"net/http"
 	"sort"
 	"strconv"
@@ -17,7 +18,9 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common/crypto"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fill"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
@@ -587,7 +590,7 @@ func (b *Bitfinex) wsHandleData(respRaw []byte) error {
 				}
 			case wsTradeExecuted, wsTradeExecutionUpdate:
 				if tradeData, ok := d[2].([]interface{}); ok && len(tradeData) > 4 {
-					b.Websocket.DataHandler <- WebsocketTradeData{
+					td := WebsocketTradeData{
 						TradeID:        int64(tradeData[0].(float64)),
 						Pair:           tradeData[1].(string),
 						Timestamp:      int64(tradeData[2].(float64)),
@@ -600,6 +603,12 @@ func (b *Bitfinex) wsHandleData(respRaw []byte) error {
 						Fee:            tradeData[9].(float64),
 						FeeCurrency:    tradeData[10].(string),
 					}
+					b.Websocket.DataHandler <- td
+					// Only the update carries the fee, so the fill is sent once
+					// the execution has been confirmed
+					if d[1].(string) == wsTradeExecutionUpdate {
+						return b.wsHandleFill(&td)
+					}
 				}
 			case wsFundingOrderSnapshot:
 				var snapshot []WsFundingOffer
@@ -764,6 +773,9 @@ func (b *Bitfinex) wsHandleData(respRaw []byte) error {
 							snapshot = append(snapshot, wallet)
 						}
 						b.Websocket.DataHandler <- snapshot
+						for i := range snapshot {
+							b.Websocket.DataHandler <- walletChange(b.Name, &snapshot[i])
+						}
 					}
 				}
 			case wsWalletUpdate:
@@ -772,13 +784,15 @@ func (b *Bitfinex) wsHandleData(respRaw []byte) error {
 					if _, ok := data[4].(float64); ok {
 						balanceAvailable = data[4].(float64)
 					}
-					b.Websocket.DataHandler <- WsWallet{
+					wallet := WsWallet{
 						Type:              data[0].(string),
 						Currency:          data[1].(string),
 						Balance:           data[2].(float64),
 						UnsettledInterest: data[3].(float64),
 						BalanceAvailable:  balanceAvailable,
 					}
+					b.Websocket.DataHandler <- wallet
+					b.Websocket.DataHandler <- walletChange(b.Name, &wallet)
 				}
 			case wsBalanceUpdate:
 				if data, ok := d[2].([]interface{}); ok && len(data) > 0 {
@@ -891,6 +905,77 @@ func (b *Bitfinex) wsHandleFundingOffer(data []interface{}) {
 	b.Websocket.DataHandler <- fo
 }
 
+// wsHandleFill normalises an own trade execution update into a fill
+func (b *Bitfinex) wsHandleFill(td *WebsocketTradeData) error {
+	pair, assetType, err := b.GetRequestFormattedPairAndAssetType(td.Pair[1:])
+	if err != nil {
+		return err
+	}
+	oID := strconv.FormatInt(td.OrderID, 10)
+	oType, err := order.StringToOrderType(td.OrderType)
+	if err != nil {
+		b.Websocket.DataHandler <- order.ClassificationError{
+			Exchange: b.Name,
+			OrderID:  oID,
+			Err:      err,
+		}
+	}
+	side := order.Buy
+	amount := td.AmountExecuted
+	if amount < 0 {
+		side = order.Sell
+		amount = -amount
+	}
+	b.Websocket.DataHandler <- []fill.Data{{
+		TradeHistory: order.TradeHistory{
+			Price:     td.PriceExecuted,
+			Amount:    amount,
+			Fee:       math.Abs(td.Fee),
+			Exchange:  b.Name,
+			TID:       strconv.FormatInt(td.TradeID, 10),
+			Type:      oType,
+			Side:      side,
+			Timestamp: time.Unix(0, td.Timestamp*int64(time.Millisecond)),
+			IsMaker:   td.Maker,
+			FeeAsset:  td.FeeCurrency,
+			Total:     td.PriceExecuted * amount,
+		},
+		OrderID:   oID,
+		Pair:      pair,
+		AssetType: assetType,
+	}}
+	return nil
+}
+
+// walletChange converts a wallet update into an account change. Bitfinex
+// returns a null available balance when it has not been calculated, in which
+// case the held amount is left at zero.
+func walletChange(exch string, w *WsWallet) account.Change {
+	var a asset.Item
+	switch w.Type {
+	case "margin":
+		a = asset.Margin
+	case "funding":
+		a = asset.MarginFunding
+	default:
+		a = asset.Spot
+	}
+	var hold float64
+	if w.BalanceAvailable != 0 {
+		hold = w.Balance - w.BalanceAvailable
+	}
+	return account.Change{
+		Exchange: exch,
+		Currency: currency.NewCode(w.Currency),
+		Asset:    a,
+		Account:  w.Type,
+		Balance: &account.Balance{
+			TotalValue: w.Balance,
+			Hold:       hold,
+		},
+	}
+}
+
 func (b *Bitfinex) wsHandleOrder(data []interface{}) {
 	var od order.Detail
 	var err error
@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/url"
 	"sort"
 	"strconv"
 	"strings"
@@ -12,12 +13,17 @@ import (
 	"unicode"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/convert"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/capability"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/deposit"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fee"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
@@ -112,6 +118,7 @@ func (b *Bitfinex) SetDefaults() {
 				FiatWithdrawalFee:   true,
 				CryptoDepositFee:    true,
 				CryptoWithdrawalFee: true,
+				FundsTransfer:       true,
 			},
 			WebsocketCapabilities: protocol.Features{
 				AccountBalance:         true,
@@ -137,6 +144,10 @@ func (b *Bitfinex) SetDefaults() {
 				DateRanges: true,
 				Intervals:  true,
 			},
+			Orders: capability.Orders{
+				Types:       []order.Type{order.Limit, order.Market},
+				TimeInForce: []capability.TimeInForce{capability.GoodTillCancel},
+			},
 		},
 		Enabled: exchange.FeaturesEnabled{
 			AutoPairUpdates: true,
@@ -731,19 +742,31 @@ func (b *Bitfinex) GetOrderInfo(ctx context.Context, orderID string, pair curren
 	return orderDetail, common.ErrNotYetImplemented
 }
 
-// GetDepositAddress returns a deposit address for a specified currency
-func (b *Bitfinex) GetDepositAddress(ctx context.Context, c currency.Code, accountID string) (string, error) {
+// GetDepositAddress returns a deposit address for a specified currency. The
+// chain is the Bitfinex deposit method e.g. tetherusx, if left empty the
+// currency's default method is used.
+func (b *Bitfinex) GetDepositAddress(ctx context.Context, c currency.Code, accountID, chain string) (*deposit.Address, error) {
 	if accountID == "" {
 		accountID = "deposit"
 	}
 
-	method, err := b.ConvertSymbolToDepositMethod(ctx, c)
-	if err != nil {
-		return "", err
+	method := strings.ToLower(chain)
+	if method == "" {
+		var err error
+		method, err = b.ConvertSymbolToDepositMethod(ctx, c)
+		if err != nil {
+			return nil, err
+		}
 	}
 
 	resp, err := b.NewDeposit(ctx, method, accountID, 0)
-	return resp.Address, err
+	if err != nil {
+		return nil, err
+	}
+	return &deposit.Address{
+		Address: resp.Address,
+		Chain:   chain,
+	}, nil
 }
 
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is submitted
@@ -759,6 +782,7 @@ func (b *Bitfinex) WithdrawCryptocurrencyFunds(ctx context.Context, withdrawRequ
 		walletType,
 		withdrawRequest.Crypto.Address,
 		withdrawRequest.Description,
+		strings.ToLower(withdrawRequest.Crypto.Chain),
 		withdrawRequest.Amount,
 		withdrawRequest.Currency)
 	if err != nil {
@@ -1120,3 +1144,281 @@ func (b *Bitfinex) fixCasing(in currency.Pair, a asset.Item) (string, error) {
 	runes[0] = unicode.ToLower(runes[0])
 	return string(runes), nil
 }
+
+// TransferFunds moves funds between the exchange, margin and funding wallets,
+// sub-account transfers are not supported
+func (b *Bitfinex) TransferFunds(ctx context.Context, req *account.TransferRequest) (*account.TransferResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	if req.FromSubAccount != "" || req.ToSubAccount != "" {
+		return nil, fmt.Errorf("%s %w", req, account.ErrTransferRouteNotSupported)
+	}
+	from, err := transferWallet(req.FromAsset)
+	if err != nil {
+		return nil, err
+	}
+	to, err := transferWallet(req.ToAsset)
+	if err != nil {
+		return nil, err
+	}
+	resp, err := b.WalletTransfer(ctx, req.Amount, req.Currency.String(), from, to)
+	if err != nil {
+		return nil, err
+	}
+	return &account.TransferResponse{
+		Status: resp.Status,
+		Time:   time.Now(),
+	}, nil
+}
+
+// transferWallet returns the wallet name for an asset, an empty asset refers
+// to the exchange wallet
+func transferWallet(a asset.Item) (string, error) {
+	switch a {
+	case "", asset.Spot:
+		return "exchange", nil
+	case asset.Margin:
+		return "margin", nil
+	case asset.MarginFunding:
+		return "funding", nil
+	}
+	return "", fmt.Errorf("%w %v", asset.ErrNotSupported, a)
+}
+
+// GetMarginInterestRates returns the latest flash return rate for a funding
+// currency converted to a daily rate
+func (b *Bitfinex) GetMarginInterestRates(ctx context.Context, c currency.Code) ([]margin.InterestRate, error) {
+	if c.IsEmpty() {
+		return nil, margin.ErrCurrencyIsEmpty
+	}
+	lends, err := b.GetLends(ctx, c.Upper().String(), url.Values{"limit_lends": {"1"}})
+	if err != nil {
+		return nil, err
+	}
+	rates := make([]margin.InterestRate, len(lends))
+	for i := range lends {
+		rates[i] = margin.InterestRate{
+			Exchange: b.Name,
+			Currency: c.Upper(),
+			Rate:     annualPercentageToDailyRate(lends[i].Rate),
+			Time:     time.Unix(lends[i].Timestamp, 0),
+		}
+	}
+	return rates, nil
+}
+
+// SubmitMarginLendOffer offers funds to the margin funding market
+func (b *Bitfinex) SubmitMarginLendOffer(ctx context.Context, o *margin.LendOffer) (*margin.LoanResponse, error) {
+	if err := o.Validate(); err != nil {
+		return nil, err
+	}
+	if o.AutoRenew {
+		return nil, errAutoRenewNotSupported
+	}
+	period := o.Period
+	if period == 0 {
+		period = minLendingPeriod
+	}
+	if period < minLendingPeriod || period > maxLendingPeriod {
+		return nil, fmt.Errorf("%v %w", period, errInvalidLendingPeriod)
+	}
+	resp, err := b.NewOffer(ctx,
+		o.Currency.Upper().String(),
+		o.Amount,
+		o.Rate*daysPerYear*percentageMultiplier,
+		period,
+		"lend")
+	if err != nil {
+		return nil, err
+	}
+	return &margin.LoanResponse{
+		ID:       strconv.FormatInt(resp.ID, 10),
+		Currency: o.Currency,
+		Amount:   o.Amount,
+		Time:     time.Now(),
+	}, nil
+}
+
+// CancelMarginLendOffer cancels an open margin funding offer
+func (b *Bitfinex) CancelMarginLendOffer(ctx context.Context, _ currency.Code, id string) error {
+	offerID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return err
+	}
+	_, err = b.CancelOffer(ctx, offerID)
+	return err
+}
+
+// GetMarginLoans returns open funding offers, funds lent out as active
+// credits and funding borrowed for margin positions
+func (b *Bitfinex) GetMarginLoans(ctx context.Context, c currency.Code) ([]margin.Loan, error) {
+	offers, err := b.GetActiveOffers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	credits, err := b.GetActiveCredits(ctx)
+	if err != nil {
+		return nil, err
+	}
+	funds, err := b.GetActiveMarginFunding(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	loans := make([]margin.Loan, 0, len(offers)+len(credits)+len(funds))
+	appendOffers := func(o []Offer, status margin.Status) error {
+		for i := range o {
+			code := currency.NewCode(o[i].Currency)
+			if !c.IsEmpty() && !c.Match(code) {
+				continue
+			}
+			side := margin.Lend
+			if o[i].Direction == "loan" {
+				side = margin.Borrow
+			}
+			tm, err := parseDecimalTimestamp(o[i].Timestamp)
+			if err != nil {
+				return err
+			}
+			loans = append(loans, margin.Loan{
+				ID:       strconv.FormatInt(o[i].ID, 10),
+				Side:     side,
+				Status:   status,
+				Currency: code,
+				Amount:   o[i].RemainingAmount,
+				Rate:     annualPercentageToDailyRate(o[i].Rate),
+				Period:   o[i].Period,
+				Time:     tm,
+			})
+		}
+		return nil
+	}
+	if err = appendOffers(offers, margin.Offered); err != nil {
+		return nil, err
+	}
+	if err = appendOffers(credits, margin.Active); err != nil {
+		return nil, err
+	}
+	for i := range funds {
+		code := currency.NewCode(funds[i].Currency)
+		if !c.IsEmpty() && !c.Match(code) {
+			continue
+		}
+		var tm time.Time
+		tm, err = parseDecimalTimestamp(funds[i].Timestamp)
+		if err != nil {
+			return nil, err
+		}
+		loans = append(loans, margin.Loan{
+			ID:       strconv.FormatInt(funds[i].ID, 10),
+			Side:     margin.Borrow,
+			Status:   margin.Active,
+			Currency: code,
+			Amount:   funds[i].Amount,
+			Rate:     annualPercentageToDailyRate(funds[i].Rate),
+			Period:   int64(funds[i].Period),
+			Time:     tm,
+		})
+	}
+	return loans, nil
+}
+
+// annualPercentageToDailyRate converts a funding rate quoted as a percentage
+// per 365 days to a daily fraction
+func annualPercentageToDailyRate(rate float64) float64 {
+	return rate / daysPerYear / percentageMultiplier
+}
+
+// parseDecimalTimestamp parses a unix timestamp with fractional seconds e.g.
+// "1444141857.0", an empty string returns a zero time
+func parseDecimalTimestamp(ts string) (time.Time, error) {
+	if ts == "" {
+		return time.Time{}, nil
+	}
+	f, err := strconv.ParseFloat(ts, 64)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return convert.TimeFromUnixTimestampDecimal(f), nil
+}
+
+// UpdateFeeSchedule retrieves the account commission rates, per currency
+// overrides, thirty day volume and withdrawal fees and loads them into the fee
+// definitions
+func (b *Bitfinex) UpdateFeeSchedule(ctx context.Context) error {
+	infos, err := b.GetAccountFees(ctx)
+	if err != nil {
+		return err
+	}
+	if len(infos) == 0 {
+		return errNoAccountFees
+	}
+	commission := fee.Commission{
+		Maker: infos[0].MakerFees / percentageMultiplier,
+		Taker: infos[0].TakerFees / percentageMultiplier,
+	}
+	s := &fee.Schedule{
+		Exchange:       b.Name,
+		VolumeCurrency: currency.USD,
+		Commissions: map[asset.Item]fee.Commission{
+			asset.Spot:   commission,
+			asset.Margin: commission,
+		},
+		Authenticated: true,
+	}
+
+	pairs, err := b.GetEnabledPairs(asset.Spot)
+	if err != nil {
+		return err
+	}
+	for i := range infos[0].Fees {
+		c := fee.Commission{
+			Maker: infos[0].Fees[i].MakerFees / percentageMultiplier,
+			Taker: infos[0].Fees[i].TakerFees / percentageMultiplier,
+		}
+		if c == commission {
+			continue
+		}
+		code := currency.NewCode(infos[0].Fees[i].Pairs)
+		for j := range pairs {
+			if !pairs[j].Base.Match(code) {
+				continue
+			}
+			s.PairCommissions = append(s.PairCommissions, fee.PairCommission{
+				Asset:      asset.Spot,
+				Pair:       pairs[j],
+				Commission: c,
+			})
+		}
+	}
+
+	summary, err := b.GetAccountSummary(ctx)
+	if err != nil {
+		return err
+	}
+	for i := range summary.TradeVolumePer30D {
+		if summary.TradeVolumePer30D[i].Currency == totalUSDVolume {
+			s.ThirtyDayVolume = summary.TradeVolumePer30D[i].Volume
+			break
+		}
+	}
+
+	withdrawalFees, err := b.GetWithdrawalFees(ctx)
+	if err != nil {
+		return err
+	}
+	for k := range withdrawalFees.Withdraw {
+		code := currency.NewCode(k)
+		var f float64
+		f, err = b.GetCryptocurrencyWithdrawalFee(code, withdrawalFees)
+		if err != nil {
+			return err
+		}
+		s.Transfers = append(s.Transfers, fee.Transfer{
+			Currency:   code,
+			Withdrawal: f,
+		})
+	}
+	return b.Fees.Load(s)
+}
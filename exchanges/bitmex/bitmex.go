@@ -429,14 +429,14 @@ func (b *Bitmex) CreateBulkOrders(ctx context.Context, params OrderNewBulkParams
 		&orders)
 }
 
-// CancelAllOrdersAfterTime closes all positions after a certain time period
-func (b *Bitmex) CancelAllOrdersAfterTime(ctx context.Context, params OrderCancelAllAfterParams) ([]Order, error) {
-	var cancelledOrder []Order
-
-	return cancelledOrder, b.SendAuthenticatedHTTPRequest(ctx, exchange.RestSpot, http.MethodPost,
+// CancelAllOrdersAfterTime cancels all open orders when the timer is not
+// refreshed within the timeout, a timeout of zero disarms the timer
+func (b *Bitmex) CancelAllOrdersAfterTime(ctx context.Context, params OrderCancelAllAfterParams) (*CancelAllAfterResponse, error) {
+	var resp CancelAllAfterResponse
+	return &resp, b.SendAuthenticatedHTTPRequest(ctx, exchange.RestSpot, http.MethodPost,
 		bitmexEndpointCancelOrderAfter,
 		params,
-		&cancelledOrder)
+		&resp)
 }
 
 // ClosePosition closes a position WARNING deprecated use /order endpoint
@@ -520,7 +520,7 @@ func (p OrderNewBulkParams) IsNil() bool {
 // endpoint
 type OrderCancelAllAfterParams struct {
 	// Timeout in ms. Set to 0 to cancel this timer.
-	Timeout float64 `json:"timeout,omitempty"`
+	Timeout float64 `json:"timeout"`
 }
 
 // VerifyData verifies outgoing data sets
@@ -628,7 +628,7 @@ func (p PositionGetParams) IsNil() bool {
 // endpoint
 type PositionIsolateMarginParams struct {
 	// Enabled - True for isolated margin, false for cross margin.
-	Enabled bool `json:"enabled,omitempty"`
+	Enabled bool `json:"enabled"`
 
 	// Symbol - Position symbol to isolate.
 	Symbol string `json:"symbol,omitempty"`
@@ -2,6 +2,7 @@ package bitmex
 
 import (
 	"context"
+	"errors"
 	"log"
 	"net/http"
 	"os"
@@ -16,6 +17,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/sharedtestvalues"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
@@ -376,11 +378,32 @@ func TestCancelAllOrdersAfterTime(t *testing.T) {
 	}
 	_, err := b.CancelAllOrdersAfterTime(context.Background(),
 		OrderCancelAllAfterParams{})
-	if err == nil {
+	switch {
+	case areTestAPIKeysSet() && err != nil:
+		t.Error("CancelAllOrdersAfterTime() error", err)
+	case !areTestAPIKeysSet() && err == nil:
 		t.Error("CancelAllOrdersAfterTime() Expected error")
 	}
 }
 
+func TestSetDeadMansSwitch(t *testing.T) {
+	t.Parallel()
+	err := b.SetDeadMansSwitch(context.Background(), asset.Spot, time.Minute)
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, asset.ErrNotSupported)
+	}
+	if areTestAPIKeysSet() && !canManipulateRealOrders {
+		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
+	}
+	err = b.SetDeadMansSwitch(context.Background(), asset.PerpetualContract, 0)
+	switch {
+	case areTestAPIKeysSet() && err != nil:
+		t.Error("SetDeadMansSwitch() error", err)
+	case !areTestAPIKeysSet() && err == nil:
+		t.Error("SetDeadMansSwitch() Expected error")
+	}
+}
+
 func TestClosePosition(t *testing.T) {
 	t.Parallel()
 	_, err := b.ClosePosition(context.Background(), OrderClosePositionParams{})
@@ -809,12 +832,12 @@ func TestWithdrawInternationalBank(t *testing.T) {
 func TestGetDepositAddress(t *testing.T) {
 	t.Parallel()
 	if areTestAPIKeysSet() {
-		_, err := b.GetDepositAddress(context.Background(), currency.BTC, "")
+		_, err := b.GetDepositAddress(context.Background(), currency.BTC, "", "")
 		if err != nil {
 			t.Error("GetDepositAddress() error", err)
 		}
 	} else {
-		_, err := b.GetDepositAddress(context.Background(), currency.BTC, "")
+		_, err := b.GetDepositAddress(context.Background(), currency.BTC, "", "")
 		if err == nil {
 			t.Error("GetDepositAddress() error cannot be nil")
 		}
@@ -899,6 +922,30 @@ func TestWsInsertExectuionUpdate(t *testing.T) {
 	}
 }
 
+func TestWsMarginUpdate(t *testing.T) {
+	t.Parallel()
+	pressXToJSON := []byte(`{"table":"margin",
+   "action":"partial",
+   "data":[{
+    "account":2,"currency":"XBt","riskLimit":1000000000000,"amount":1000000,"realisedPnl":0,
+    "walletBalance":1000000,"marginBalance":1000000,"availableMargin":1000000,"withdrawableMargin":1000000,
+    "timestamp":"2017-04-04T22:07:46.035Z"
+   }]}`)
+	err := b.wsHandleData(pressXToJSON)
+	if err != nil {
+		t.Error(err)
+	}
+	pressXToJSON = []byte(`{"table":"margin",
+   "action":"update",
+   "data":[{
+    "account":2,"currency":"XBt","availableMargin":999000,"timestamp":"2017-04-04T22:08:46.035Z"
+   }]}`)
+	err = b.wsHandleData(pressXToJSON)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
 func TestWSConnectionHandling(t *testing.T) {
 	t.Parallel()
 	pressXToJSON := []byte(`{"info":"Welcome to the BitMEX Realtime API.","version":"1.1.0",
@@ -1134,3 +1181,114 @@ func TestUpdateTickers(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestGetLatestFundingRate(t *testing.T) {
+	t.Parallel()
+	cp := currency.NewPair(currency.XBT, currency.USD)
+	_, err := b.GetLatestFundingRate(context.Background(), asset.Spot, cp)
+	if !errors.Is(err, futures.ErrNotFuturesAsset) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, futures.ErrNotFuturesAsset)
+	}
+	_, err = b.GetLatestFundingRate(context.Background(), asset.PerpetualContract, cp)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetFundingRateHistory(t *testing.T) {
+	t.Parallel()
+	cp := currency.NewPair(currency.XBT, currency.USD)
+	_, err := b.GetFundingRateHistory(context.Background(), asset.PerpetualContract, cp, time.Now().Add(-time.Hour*24*7), time.Now())
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetFuturesOpenInterest(t *testing.T) {
+	t.Parallel()
+	cp := currency.NewPair(currency.XBT, currency.USD)
+	_, err := b.GetFuturesOpenInterest(context.Background(), asset.PerpetualContract, cp)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetFuturesMarkPrice(t *testing.T) {
+	t.Parallel()
+	cp := currency.NewPair(currency.XBT, currency.USD)
+	_, err := b.GetFuturesMarkPrice(context.Background(), asset.PerpetualContract, cp)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetFuturesPositions(t *testing.T) {
+	t.Parallel()
+	_, err := b.GetFuturesPositions(context.Background(), asset.Spot, currency.Pair{})
+	if !errors.Is(err, futures.ErrNotFuturesAsset) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, futures.ErrNotFuturesAsset)
+	}
+	if !areTestAPIKeysSet() {
+		t.Skip("skipping test: api keys not set")
+	}
+	_, err = b.GetFuturesPositions(context.Background(), asset.PerpetualContract, currency.NewPair(currency.XBT, currency.USD))
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestChangeFuturesLeverage(t *testing.T) {
+	t.Parallel()
+	cp := currency.NewPair(currency.XBT, currency.USD)
+	err := b.ChangeFuturesLeverage(context.Background(), asset.PerpetualContract, cp, 0)
+	if !errors.Is(err, futures.ErrInvalidLeverage) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, futures.ErrInvalidLeverage)
+	}
+	if !areTestAPIKeysSet() || !canManipulateRealOrders {
+		t.Skip("skipping test: api keys not set or canManipulateRealOrders set to false")
+	}
+	err = b.ChangeFuturesLeverage(context.Background(), asset.PerpetualContract, cp, 2)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestChangeFuturesMarginType(t *testing.T) {
+	t.Parallel()
+	cp := currency.NewPair(currency.XBT, currency.USD)
+	err := b.ChangeFuturesMarginType(context.Background(), asset.PerpetualContract, cp, futures.UnknownMarginType)
+	if !errors.Is(err, futures.ErrMarginTypeNotSupported) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, futures.ErrMarginTypeNotSupported)
+	}
+	if !areTestAPIKeysSet() || !canManipulateRealOrders {
+		t.Skip("skipping test: api keys not set or canManipulateRealOrders set to false")
+	}
+	err = b.ChangeFuturesMarginType(context.Background(), asset.PerpetualContract, cp, futures.Cross)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetFuturesMarginInfo(t *testing.T) {
+	t.Parallel()
+	if !areTestAPIKeysSet() {
+		t.Skip("skipping test: api keys not set")
+	}
+	_, err := b.GetFuturesMarginInfo(context.Background(), asset.PerpetualContract, currency.NewPair(currency.XBT, currency.USD))
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSettlementAmount(t *testing.T) {
+	t.Parallel()
+	if v := settlementAmount(150000000, "XBt"); v != 1.5 {
+		t.Errorf("received: '%v' but expected: '%v'", v, 1.5)
+	}
+	if v := settlementAmount(2500000, "USDt"); v != 2.5 {
+		t.Errorf("received: '%v' but expected: '%v'", v, 2.5)
+	}
+	if v := settlementAmount(7, "USD"); v != 7 {
+		t.Errorf("received: '%v' but expected: '%v'", v, 7)
+	}
+}
@@ -1,12 +1,22 @@
 package bitmex
 
 import (
+	"errors"
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 )
 
+// fundingHistoryLimit is the maximum number of funding rates returned per
+// request
+const fundingHistoryLimit = 500
+
+var (
+	errInstrumentNotFound = errors.New("instrument not found")
+	errPositionNotFound   = errors.New("position not found")
+)
+
 // RequestError allows for a general error capture from requests
 type RequestError struct {
 	Error struct {
@@ -284,6 +294,13 @@ type Notification struct {
 	WaitForVisibility bool   `json:"waitForVisibility"`
 }
 
+// CancelAllAfterResponse contains the time the open orders will be cancelled
+// unless the timer is refreshed
+type CancelAllAfterResponse struct {
+	Now        time.Time `json:"now"`
+	CancelTime time.Time `json:"cancelTime"`
+}
+
 // Order Placement, Cancellation, Amending, and History
 type Order struct {
 	Account               int64     `json:"account"`
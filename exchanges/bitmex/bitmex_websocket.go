@@ -13,7 +13,9 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common/crypto"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fill"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
@@ -331,6 +333,37 @@ func (b *Bitmex) wsHandleData(respRaw []byte) error {
 						},
 					},
 				}
+				if response.Data[i].ExecType != "Trade" {
+					continue
+				}
+				var oType order.Type
+				oType, err = order.StringToOrderType(response.Data[i].OrdType)
+				if err != nil {
+					b.Websocket.DataHandler <- order.ClassificationError{
+						Exchange: b.Name,
+						OrderID:  response.Data[i].OrderID,
+						Err:      err,
+					}
+				}
+				b.Websocket.DataHandler <- []fill.Data{{
+					TradeHistory: order.TradeHistory{
+						Price:     response.Data[i].LastPx,
+						Amount:    response.Data[i].LastQuantity,
+						Fee:       response.Data[i].ExecComm,
+						Exchange:  b.Name,
+						TID:       response.Data[i].ExecID,
+						Type:      oType,
+						Side:      oSide,
+						Timestamp: response.Data[i].TransactTime,
+						IsMaker:   response.Data[i].LastLiquidityInd == "AddedLiquidity",
+						FeeAsset:  response.Data[i].SettlCurrency,
+						Total:     response.Data[i].ExecCost,
+					},
+					OrderID:       response.Data[i].OrderID,
+					ClientOrderID: response.Data[i].ClOrdID,
+					Pair:          p,
+					AssetType:     a,
+				}}
 			}
 		case bitmexWSOrder:
 			var response WsOrderResponse
@@ -446,7 +479,20 @@ func (b *Bitmex) wsHandleData(respRaw []byte) error {
 			if err != nil {
 				return err
 			}
-			b.Websocket.DataHandler <- response
+			for i := range response.Data {
+				// updates only carry the fields which have changed
+				if response.Data[i].WalletBalance == nil {
+					continue
+				}
+				b.Websocket.DataHandler <- account.Change{
+					Exchange: b.Name,
+					Currency: currency.NewCode(response.Data[i].Currency),
+					Asset:    asset.PerpetualContract,
+					Balance: &account.Balance{
+						TotalValue: *response.Data[i].WalletBalance,
+					},
+				}
+			}
 		case bitmexWSPosition:
 			var response WsPositionResponse
 			err = json.Unmarshal(respRaw, &response)
@@ -377,7 +377,7 @@ type WsMarginResponseData struct {
 	IndicativeTax      float64     `json:"indicativeTax"`
 	UnrealisedProfit   float64     `json:"unrealisedProfit"`
 	SyntheticMargin    interface{} `json:"syntheticMargin"`
-	WalletBalance      float64     `json:"walletBalance"`
+	WalletBalance      *float64    `json:"walletBalance"`
 	MarginBalance      float64     `json:"marginBalance"`
 	MarginBalancePcnt  float64     `json:"marginBalancePcnt"`
 	MarginLeverage     float64     `json:"marginLeverage"`
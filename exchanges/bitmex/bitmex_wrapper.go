@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"math"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
 	"time"
@@ -16,6 +17,8 @@ import (
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/deposit"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
@@ -100,6 +103,7 @@ func (b *Bitmex) SetDefaults() {
 				CryptoWithdrawal:    true,
 				TradeFee:            true,
 				CryptoWithdrawalFee: true,
+				DeadMansSwitch:      true,
 			},
 			WebsocketCapabilities: protocol.Features{
 				TradeFetching:          true,
@@ -655,6 +659,19 @@ func (b *Bitmex) CancelAllOrders(ctx context.Context, _ *order.Cancel) (order.Ca
 	return cancelAllOrdersResponse, nil
 }
 
+// SetDeadMansSwitch arms or refreshes the account wide timer which cancels all
+// open orders when it is not refreshed within the timeout, a zero timeout
+// disarms the timer
+func (b *Bitmex) SetDeadMansSwitch(ctx context.Context, a asset.Item, timeout time.Duration) error {
+	if !b.SupportsAsset(a) {
+		return fmt.Errorf("%s %w", a, asset.ErrNotSupported)
+	}
+	_, err := b.CancelAllOrdersAfterTime(ctx, OrderCancelAllAfterParams{
+		Timeout: float64(timeout.Milliseconds()),
+	})
+	return err
+}
+
 // GetOrderInfo returns order information based on order ID
 func (b *Bitmex) GetOrderInfo(ctx context.Context, orderID string, pair currency.Pair, assetType asset.Item) (order.Detail, error) {
 	var orderDetail order.Detail
@@ -662,8 +679,12 @@ func (b *Bitmex) GetOrderInfo(ctx context.Context, orderID string, pair currency
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (b *Bitmex) GetDepositAddress(ctx context.Context, cryptocurrency currency.Code, _ string) (string, error) {
-	return b.GetCryptoDepositAddress(ctx, cryptocurrency.String())
+func (b *Bitmex) GetDepositAddress(ctx context.Context, cryptocurrency currency.Code, _, _ string) (*deposit.Address, error) {
+	addr, err := b.GetCryptoDepositAddress(ctx, cryptocurrency.String())
+	if err != nil {
+		return nil, err
+	}
+	return &deposit.Address{Address: addr}, nil
 }
 
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
@@ -837,3 +858,296 @@ func (b *Bitmex) GetHistoricCandles(ctx context.Context, pair currency.Pair, a a
 func (b *Bitmex) GetHistoricCandlesExtended(ctx context.Context, pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
 	return kline.Item{}, common.ErrFunctionNotSupported
 }
+
+// GetLatestFundingRate returns the latest funding rate for a perpetual
+// contract along with the predicted rate for the next funding time
+func (b *Bitmex) GetLatestFundingRate(ctx context.Context, a asset.Item, p currency.Pair) (*futures.LatestFundingRate, error) {
+	if err := futures.CheckRequest(a, p); err != nil {
+		return nil, err
+	}
+	fPair, err := b.FormatExchangeCurrency(p, a)
+	if err != nil {
+		return nil, err
+	}
+	history, err := b.GetFullFundingHistory(ctx, fPair.String(), "1", "", "", "", true, time.Time{}, time.Time{})
+	if err != nil {
+		return nil, err
+	}
+	inst, err := b.getInstrument(ctx, fPair)
+	if err != nil {
+		return nil, err
+	}
+	resp := &futures.LatestFundingRate{
+		FundingRate: futures.FundingRate{
+			Exchange: b.Name,
+			Asset:    a,
+			Pair:     p,
+		},
+		PredictedRate:   inst.FundingRate,
+		NextFundingTime: inst.FundingTimestamp,
+	}
+	if len(history) > 0 {
+		resp.Rate = history[0].FundingRate
+		resp.Time = history[0].Timestamp
+	}
+	return resp, nil
+}
+
+// GetFundingRateHistory returns the funding rate history for a perpetual
+// contract between the start and end times
+func (b *Bitmex) GetFundingRateHistory(ctx context.Context, a asset.Item, p currency.Pair, start, end time.Time) ([]futures.FundingRate, error) {
+	if err := futures.CheckRequest(a, p); err != nil {
+		return nil, err
+	}
+	if err := common.StartEndTimeCheck(start, end); err != nil {
+		return nil, err
+	}
+	fPair, err := b.FormatExchangeCurrency(p, a)
+	if err != nil {
+		return nil, err
+	}
+	var resp []futures.FundingRate
+	for offset := 0; ; offset += fundingHistoryLimit {
+		history, err := b.GetFullFundingHistory(ctx,
+			fPair.String(),
+			strconv.Itoa(fundingHistoryLimit),
+			"",
+			"",
+			strconv.Itoa(offset),
+			false,
+			start,
+			end)
+		if err != nil {
+			return nil, err
+		}
+		for i := range history {
+			resp = append(resp, futures.FundingRate{
+				Exchange: b.Name,
+				Asset:    a,
+				Pair:     p,
+				Rate:     history[i].FundingRate,
+				Time:     history[i].Timestamp,
+			})
+		}
+		if len(history) < fundingHistoryLimit {
+			break
+		}
+	}
+	futures.SortFundingRates(resp)
+	return resp, nil
+}
+
+// GetFuturesOpenInterest returns the open interest for a futures contract
+func (b *Bitmex) GetFuturesOpenInterest(ctx context.Context, a asset.Item, p currency.Pair) (*futures.OpenInterest, error) {
+	if err := futures.CheckRequest(a, p); err != nil {
+		return nil, err
+	}
+	fPair, err := b.FormatExchangeCurrency(p, a)
+	if err != nil {
+		return nil, err
+	}
+	inst, err := b.getInstrument(ctx, fPair)
+	if err != nil {
+		return nil, err
+	}
+	return &futures.OpenInterest{
+		Exchange: b.Name,
+		Asset:    a,
+		Pair:     p,
+		Amount:   float64(inst.OpenInterest),
+		Time:     inst.Timestamp,
+	}, nil
+}
+
+// GetFuturesMarkPrice returns the mark and index price for a futures contract
+func (b *Bitmex) GetFuturesMarkPrice(ctx context.Context, a asset.Item, p currency.Pair) (*futures.MarkPrice, error) {
+	if err := futures.CheckRequest(a, p); err != nil {
+		return nil, err
+	}
+	fPair, err := b.FormatExchangeCurrency(p, a)
+	if err != nil {
+		return nil, err
+	}
+	inst, err := b.getInstrument(ctx, fPair)
+	if err != nil {
+		return nil, err
+	}
+	return &futures.MarkPrice{
+		Exchange:   b.Name,
+		Asset:      a,
+		Pair:       p,
+		MarkPrice:  inst.MarkPrice,
+		IndexPrice: inst.IndicativeSettlePrice,
+		Time:       inst.Timestamp,
+	}, nil
+}
+
+// getInstrument returns the instrument details for a formatted pair
+func (b *Bitmex) getInstrument(ctx context.Context, fPair currency.Pair) (*Instrument, error) {
+	instruments, err := b.GetInstruments(ctx, &GenericRequestParams{
+		Symbol: fPair.String(),
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(instruments) != 1 {
+		return nil, fmt.Errorf("%w %v", errInstrumentNotFound, fPair)
+	}
+	return &instruments[0], nil
+}
+
+// GetFuturesPositions returns open futures positions for an asset, an empty
+// pair returns positions for all contracts
+func (b *Bitmex) GetFuturesPositions(ctx context.Context, a asset.Item, p currency.Pair) ([]futures.Position, error) {
+	if err := futures.CheckAsset(a); err != nil {
+		return nil, err
+	}
+	var params PositionGetParams
+	if !p.IsEmpty() {
+		fPair, err := b.FormatExchangeCurrency(p, a)
+		if err != nil {
+			return nil, err
+		}
+		params.Filter = "{\"symbol\":\"" + fPair.String() + "\"}"
+	}
+	positions, err := b.GetPositions(ctx, params)
+	if err != nil {
+		return nil, err
+	}
+	avail, err := b.GetAvailablePairs(a)
+	if err != nil {
+		return nil, err
+	}
+	pFmt, err := b.GetPairFormat(a, true)
+	if err != nil {
+		return nil, err
+	}
+	var resp []futures.Position
+	for i := range positions {
+		if !positions[i].IsOpen || positions[i].CurrentQty == 0 {
+			continue
+		}
+		cp, err := currency.NewPairFromFormattedPairs(positions[i].Symbol, avail, pFmt)
+		if err != nil {
+			return nil, err
+		}
+		resp = append(resp, futures.Position{
+			Exchange:         b.Name,
+			Asset:            a,
+			Pair:             cp,
+			Side:             futures.SideFromSize(float64(positions[i].CurrentQty)),
+			Size:             math.Abs(float64(positions[i].CurrentQty)),
+			EntryPrice:       positions[i].AvgEntryPrice,
+			MarkPrice:        positions[i].MarkPrice,
+			LiquidationPrice: positions[i].LiquidationPrice,
+			UnrealisedPNL:    settlementAmount(positions[i].UnrealisedPnl, positions[i].Currency),
+			Leverage:         positions[i].Leverage,
+			MarginType:       positionMarginType(&positions[i]),
+			Margin:           settlementAmount(positions[i].PosMargin, positions[i].Currency),
+			UpdateTime:       positions[i].Timestamp,
+		})
+	}
+	return resp, nil
+}
+
+// ChangeFuturesLeverage sets the leverage for a futures contract. Bitmex
+// enables isolated margin on the position when a leverage is set
+func (b *Bitmex) ChangeFuturesLeverage(ctx context.Context, a asset.Item, p currency.Pair, leverage float64) error {
+	if err := futures.CheckLeverage(a, p, leverage); err != nil {
+		return err
+	}
+	fPair, err := b.FormatExchangeCurrency(p, a)
+	if err != nil {
+		return err
+	}
+	_, err = b.LeveragePosition(ctx, PositionUpdateLeverageParams{
+		Leverage: leverage,
+		Symbol:   fPair.String(),
+	})
+	return err
+}
+
+// ChangeFuturesMarginType sets the margin type for a futures contract
+func (b *Bitmex) ChangeFuturesMarginType(ctx context.Context, a asset.Item, p currency.Pair, marginType futures.MarginType) error {
+	if err := futures.CheckRequest(a, p); err != nil {
+		return err
+	}
+	if marginType != futures.Isolated && marginType != futures.Cross {
+		return fmt.Errorf("%q %w", marginType, futures.ErrMarginTypeNotSupported)
+	}
+	fPair, err := b.FormatExchangeCurrency(p, a)
+	if err != nil {
+		return err
+	}
+	_, err = b.IsolatePosition(ctx, PositionIsolateMarginParams{
+		Enabled: marginType == futures.Isolated,
+		Symbol:  fPair.String(),
+	})
+	return err
+}
+
+// GetFuturesMarginInfo returns the leverage and margin settings for a futures
+// contract along with the margin balances of its settlement currency
+func (b *Bitmex) GetFuturesMarginInfo(ctx context.Context, a asset.Item, p currency.Pair) (*futures.MarginInfo, error) {
+	if err := futures.CheckRequest(a, p); err != nil {
+		return nil, err
+	}
+	fPair, err := b.FormatExchangeCurrency(p, a)
+	if err != nil {
+		return nil, err
+	}
+	positions, err := b.GetPositions(ctx, PositionGetParams{
+		Filter: "{\"symbol\":\"" + fPair.String() + "\"}",
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(positions) == 0 {
+		return nil, fmt.Errorf("%w %v", errPositionNotFound, p)
+	}
+	inst, err := b.getInstrument(ctx, fPair)
+	if err != nil {
+		return nil, err
+	}
+	margin, err := b.GetUserMargin(ctx, positions[0].Currency)
+	if err != nil {
+		return nil, err
+	}
+	resp := &futures.MarginInfo{
+		Exchange:          b.Name,
+		Asset:             a,
+		Pair:              p,
+		Leverage:          positions[0].Leverage,
+		MarginType:        positionMarginType(&positions[0]),
+		Collateral:        currency.NewCode(inst.SettlCurrency),
+		MarginBalance:     settlementAmount(margin.MarginBalance, margin.Currency),
+		AvailableMargin:   settlementAmount(margin.AvailableMargin, margin.Currency),
+		InitialMargin:     settlementAmount(margin.InitMargin, margin.Currency),
+		MaintenanceMargin: settlementAmount(margin.MaintMargin, margin.Currency),
+	}
+	if inst.InitMargin > 0 {
+		resp.MaxLeverage = 1 / inst.InitMargin
+	}
+	return resp, nil
+}
+
+// positionMarginType returns the margin type of a position
+func positionMarginType(p *Position) futures.MarginType {
+	if p.CrossMargin {
+		return futures.Cross
+	}
+	return futures.Isolated
+}
+
+// settlementAmount converts an amount denominated in the smallest unit of a
+// settlement currency e.g. XBt satoshis to a whole currency amount
+func settlementAmount(amount int64, settlementCurrency string) float64 {
+	switch {
+	case strings.EqualFold(settlementCurrency, "XBt"):
+		return float64(amount) / 1e8
+	case strings.EqualFold(settlementCurrency, "USDt"):
+		return float64(amount) / 1e6
+	default:
+		return float64(amount)
+	}
+}
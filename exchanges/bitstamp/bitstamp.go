@@ -54,6 +54,7 @@ const (
 	bitstampAPIReturnType         = "string"
 	bitstampAPITradingPairsInfo   = "trading-pairs-info"
 	bitstampOHLC                  = "ohlc"
+	bitstampAPIWSAuthToken        = "websocket_token"
 
 	bitstampRateInterval = time.Minute * 10
 	bitstampRequestRate  = 8000
@@ -228,6 +229,17 @@ func (b *Bitstamp) GetEURUSDConversionRate(ctx context.Context) (EURUSDConversio
 	return rate, b.SendHTTPRequest(ctx, exchange.RestSpot, path, &rate)
 }
 
+// GetWebsocketToken returns a token and user ID used to subscribe to private
+// websocket channels
+func (b *Bitstamp) GetWebsocketToken(ctx context.Context) (*WebsocketAuthResponse, error) {
+	var resp WebsocketAuthResponse
+	err := b.SendAuthenticatedHTTPRequest(ctx, exchange.RestSpot, bitstampAPIWSAuthToken, true, nil, &resp)
+	if err != nil {
+		return nil, err
+	}
+	return &resp, nil
+}
+
 // GetBalance returns full balance of currency held on the exchange
 func (b *Bitstamp) GetBalance(ctx context.Context) (Balances, error) {
 	var balance map[string]string
@@ -187,6 +187,7 @@ type websocketEventRequest struct {
 
 type websocketData struct {
 	Channel string `json:"channel"`
+	Auth    string `json:"auth,omitempty"`
 }
 
 type websocketResponse struct {
@@ -212,6 +213,30 @@ type websocketTradeData struct {
 	ID             int64   `json:"id"`
 }
 
+type websocketMyTradeResponse struct {
+	websocketResponse
+	Data websocketMyTrade `json:"data"`
+}
+
+type websocketMyTrade struct {
+	ID             int64   `json:"id"`
+	Amount         float64 `json:"amount,string"`
+	Price          float64 `json:"price,string"`
+	Microtimestamp int64   `json:"microtimestamp,string"`
+	Fee            float64 `json:"fee,string"`
+	OrderID        int64   `json:"order_id"`
+	ClientOrderID  string  `json:"client_order_id"`
+	Side           string  `json:"side"`
+}
+
+// WebsocketAuthResponse holds the token required to subscribe to private
+// websocket channels
+type WebsocketAuthResponse struct {
+	Token     string `json:"token"`
+	UserID    int64  `json:"user_id"`
+	ValidSecs int64  `json:"valid_sec"`
+}
+
 type websocketOrderBookResponse struct {
 	websocketResponse
 	Data websocketOrderBook `json:"data"`
@@ -13,6 +13,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fill"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
@@ -21,7 +22,8 @@ import (
 )
 
 const (
-	bitstampWSURL = "wss://ws.bitstamp.net"
+	bitstampWSURL           = "wss://ws.bitstamp.net"
+	bitstampWSPrivateTrades = "private-my_trades_"
 )
 
 // WsConnect connects to a websocket feed
@@ -123,6 +125,9 @@ func (b *Bitstamp) wsHandleData(respRaw []byte) error {
 			return err
 		}
 	case "trade":
+		if strings.HasPrefix(wsResponse.Channel, bitstampWSPrivateTrades) {
+			return b.wsProcessMyTrade(respRaw, wsResponse.Channel)
+		}
 		if !b.IsSaveTradeDataEnabled() {
 			return nil
 		}
@@ -183,8 +188,65 @@ func (b *Bitstamp) wsHandleData(respRaw []byte) error {
 	return nil
 }
 
+// wsProcessMyTrade sends a private account trade to the datahandler as a fill
+func (b *Bitstamp) wsProcessMyTrade(respRaw []byte, channel string) error {
+	var resp websocketMyTradeResponse
+	err := json.Unmarshal(respRaw, &resp)
+	if err != nil {
+		return err
+	}
+	// private channels are suffixed with the user ID e.g.
+	// private-my_trades_btcusd-123
+	currencyPair := strings.TrimPrefix(channel, bitstampWSPrivateTrades)
+	if i := strings.Index(currencyPair, "-"); i > 0 {
+		currencyPair = currencyPair[:i]
+	}
+	pFmt, err := b.GetPairFormat(asset.Spot, true)
+	if err != nil {
+		return err
+	}
+	enabledPairs, err := b.GetEnabledPairs(asset.Spot)
+	if err != nil {
+		return err
+	}
+	p, err := currency.NewPairFromFormattedPairs(currencyPair, enabledPairs, pFmt)
+	if err != nil {
+		return err
+	}
+	orderID := strconv.FormatInt(resp.Data.OrderID, 10)
+	side, err := order.StringToOrderSide(resp.Data.Side)
+	if err != nil {
+		b.Websocket.DataHandler <- order.ClassificationError{
+			Exchange: b.Name,
+			OrderID:  orderID,
+			Err:      err,
+		}
+	}
+	b.Websocket.DataHandler <- []fill.Data{{
+		TradeHistory: order.TradeHistory{
+			Price:     resp.Data.Price,
+			Amount:    resp.Data.Amount,
+			Fee:       resp.Data.Fee,
+			Exchange:  b.Name,
+			TID:       strconv.FormatInt(resp.Data.ID, 10),
+			Side:      side,
+			Timestamp: time.Unix(0, resp.Data.Microtimestamp*int64(time.Microsecond)),
+			FeeAsset:  p.Quote.String(),
+			Total:     resp.Data.Price * resp.Data.Amount,
+		},
+		OrderID:       orderID,
+		ClientOrderID: resp.Data.ClientOrderID,
+		Pair:          p,
+		AssetType:     asset.Spot,
+	}}
+	return nil
+}
+
 func (b *Bitstamp) generateDefaultSubscriptions() ([]stream.ChannelSubscription, error) {
 	var channels = []string{"live_trades_", "order_book_"}
+	if b.Websocket.CanUseAuthenticatedEndpoints() {
+		channels = append(channels, bitstampWSPrivateTrades)
+	}
 	enabledCurrencies, err := b.GetEnabledPairs(asset.Spot)
 	if err != nil {
 		return nil, err
@@ -208,6 +270,7 @@ func (b *Bitstamp) generateDefaultSubscriptions() ([]stream.ChannelSubscription,
 // Subscribe sends a websocket message to receive data from the channel
 func (b *Bitstamp) Subscribe(channelsToSubscribe []stream.ChannelSubscription) error {
 	var errs common.Errors
+	var auth *WebsocketAuthResponse
 	for i := range channelsToSubscribe {
 		req := websocketEventRequest{
 			Event: "bts:subscribe",
@@ -215,6 +278,18 @@ func (b *Bitstamp) Subscribe(channelsToSubscribe []stream.ChannelSubscription) e
 				Channel: channelsToSubscribe[i].Channel,
 			},
 		}
+		if strings.HasPrefix(req.Data.Channel, bitstampWSPrivateTrades) {
+			if auth == nil {
+				var err error
+				auth, err = b.GetWebsocketToken(context.TODO())
+				if err != nil {
+					errs = append(errs, err)
+					continue
+				}
+			}
+			req.Data.Channel += "-" + strconv.FormatInt(auth.UserID, 10)
+			req.Data.Auth = auth.Token
+		}
 		err := b.Websocket.Conn.SendJSONMessage(req)
 		if err != nil {
 			errs = append(errs, err)
@@ -231,6 +306,7 @@ func (b *Bitstamp) Subscribe(channelsToSubscribe []stream.ChannelSubscription) e
 // Unsubscribe sends a websocket message to stop receiving data from the channel
 func (b *Bitstamp) Unsubscribe(channelsToUnsubscribe []stream.ChannelSubscription) error {
 	var errs common.Errors
+	var auth *WebsocketAuthResponse
 	for i := range channelsToUnsubscribe {
 		req := websocketEventRequest{
 			Event: "bts:unsubscribe",
@@ -238,6 +314,18 @@ func (b *Bitstamp) Unsubscribe(channelsToUnsubscribe []stream.ChannelSubscriptio
 				Channel: channelsToUnsubscribe[i].Channel,
 			},
 		}
+		if strings.HasPrefix(req.Data.Channel, bitstampWSPrivateTrades) {
+			if auth == nil {
+				var err error
+				auth, err = b.GetWebsocketToken(context.TODO())
+				if err != nil {
+					errs = append(errs, err)
+					continue
+				}
+			}
+			req.Data.Channel += "-" + strconv.FormatInt(auth.UserID, 10)
+			req.Data.Auth = auth.Token
+		}
 		err := b.Websocket.Conn.SendJSONMessage(req)
 		if err != nil {
 			errs = append(errs, err)
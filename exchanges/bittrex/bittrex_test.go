@@ -14,6 +14,7 @@ import (
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/sharedtestvalues"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 )
 
@@ -52,6 +53,7 @@ func TestMain(m *testing.M) {
 		b.Verbose || len(b.BaseCurrencies) < 1 {
 		log.Fatal("Bittrex Setup values not set correctly")
 	}
+	b.Websocket.DataHandler = sharedtestvalues.GetWebsocketInterfaceChannelOverride()
 
 	os.Exit(m.Run())
 }
@@ -620,12 +622,12 @@ func TestWithdrawInternationalBank(t *testing.T) {
 
 func TestGetDepositAddress(t *testing.T) {
 	if areTestAPIKeysSet() {
-		_, err := b.GetDepositAddress(context.Background(), currency.BTC, "")
+		_, err := b.GetDepositAddress(context.Background(), currency.BTC, "", "")
 		if err != nil {
 			t.Error(err)
 		}
 	} else {
-		_, err := b.GetDepositAddress(context.Background(), currency.BTC, "")
+		_, err := b.GetDepositAddress(context.Background(), currency.BTC, "", "")
 		if err == nil {
 			t.Error("error cannot be nil")
 		}
@@ -656,3 +658,26 @@ func TestGetHistoricTrades(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestWsProcessUpdateExecution(t *testing.T) {
+	t.Parallel()
+	err := b.WsProcessUpdateExecution(&ExecutionUpdateMessage{
+		Deltas: []ExecutionData{{
+			ID:           "1",
+			MarketSymbol: currPair,
+			ExecutedAt:   time.Now(),
+			Quantity:     1,
+			Rate:         2,
+			OrderID:      "1337",
+		}},
+	})
+	if err != nil {
+		t.Error(err)
+	}
+	err = b.WsProcessUpdateExecution(&ExecutionUpdateMessage{
+		Deltas: []ExecutionData{{OrderID: "1337"}},
+	})
+	if err == nil {
+		t.Error("expected error for missing market symbol")
+	}
+}
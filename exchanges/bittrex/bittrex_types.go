@@ -268,6 +268,32 @@ type OrderUpdateMessage struct {
 	Delta     OrderData `json:"delta"`
 }
 
+// BalanceUpdateMessage holds a websocket balance update
+type BalanceUpdateMessage struct {
+	AccountID string      `json:"accountId"`
+	Sequence  int         `json:"sequence"`
+	Delta     BalanceData `json:"delta"`
+}
+
+// ExecutionUpdateMessage holds websocket executions of the account's orders
+type ExecutionUpdateMessage struct {
+	AccountID string          `json:"accountId"`
+	Sequence  int             `json:"sequence"`
+	Deltas    []ExecutionData `json:"deltas"`
+}
+
+// ExecutionData holds a single execution of an order
+type ExecutionData struct {
+	ID           string    `json:"id"`
+	MarketSymbol string    `json:"marketSymbol"`
+	ExecutedAt   time.Time `json:"executedAt"`
+	Quantity     float64   `json:"quantity,string"`
+	Rate         float64   `json:"rate,string"`
+	OrderID      string    `json:"orderId"`
+	Commission   float64   `json:"commission,string"`
+	IsTaker      bool      `json:"isTaker"`
+}
+
 // WsPendingRequest holds pending requests
 type WsPendingRequest struct {
 	WsEventRequest
@@ -20,7 +20,9 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common/crypto"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fill"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
@@ -37,6 +39,8 @@ const (
 	wsOrderbook           = "orderbook"
 	wsMarketSummary       = "market_summary"
 	wsOrders              = "order"
+	wsBalance             = "balance"
+	wsExecution           = "execution"
 	wsHeartbeat           = "heartbeat"
 	authenticate          = "Authenticate"
 	subscribe             = "subscribe"
@@ -54,6 +58,8 @@ var defaultSpotSubscribedChannels = []string{
 
 var defaultSpotSubscribedChannelsAuth = []string{
 	wsOrders,
+	wsBalance,
+	wsExecution,
 }
 
 // TickerCache holds ticker and market summary data
@@ -502,6 +508,27 @@ func (b *Bittrex) wsHandleData(respRaw []byte) error {
 					return err
 				}
 			}
+		case "balance":
+			for j := range response.Message[i].Arguments {
+				var balanceUpdate BalanceUpdateMessage
+				err = b.wsDecodeMessage(response.Message[i].Arguments[j], &balanceUpdate)
+				if err != nil {
+					return err
+				}
+				b.WsProcessUpdateBalance(&balanceUpdate)
+			}
+		case "execution":
+			for j := range response.Message[i].Arguments {
+				var executionUpdate ExecutionUpdateMessage
+				err = b.wsDecodeMessage(response.Message[i].Arguments[j], &executionUpdate)
+				if err != nil {
+					return err
+				}
+				err = b.WsProcessUpdateExecution(&executionUpdate)
+				if err != nil {
+					return err
+				}
+			}
 		}
 	}
 	return nil
@@ -624,3 +651,45 @@ func (b *Bittrex) WsProcessUpdateOrder(data *OrderUpdateMessage) error {
 	}
 	return nil
 }
+
+// WsProcessUpdateBalance processes an update on an account balance
+func (b *Bittrex) WsProcessUpdateBalance(data *BalanceUpdateMessage) {
+	b.Websocket.DataHandler <- account.Change{
+		Exchange: b.Name,
+		Currency: currency.NewCode(data.Delta.CurrencySymbol),
+		Asset:    asset.Spot,
+		Balance: &account.Balance{
+			TotalValue: data.Delta.Total,
+			Hold:       data.Delta.Total - data.Delta.Available,
+		},
+	}
+}
+
+// WsProcessUpdateExecution processes executions of the account's orders
+func (b *Bittrex) WsProcessUpdateExecution(data *ExecutionUpdateMessage) error {
+	fills := make([]fill.Data, len(data.Deltas))
+	for i := range data.Deltas {
+		pair, err := currency.NewPairFromString(data.Deltas[i].MarketSymbol)
+		if err != nil {
+			return err
+		}
+		fills[i] = fill.Data{
+			TradeHistory: order.TradeHistory{
+				Price:     data.Deltas[i].Rate,
+				Amount:    data.Deltas[i].Quantity,
+				Fee:       data.Deltas[i].Commission,
+				Exchange:  b.Name,
+				TID:       data.Deltas[i].ID,
+				Timestamp: data.Deltas[i].ExecutedAt,
+				IsMaker:   !data.Deltas[i].IsTaker,
+				FeeAsset:  pair.Quote.String(),
+				Total:     data.Deltas[i].Rate * data.Deltas[i].Quantity,
+			},
+			OrderID:   data.Deltas[i].OrderID,
+			Pair:      pair,
+			AssetType: asset.Spot,
+		}
+	}
+	b.Websocket.DataHandler <- fills
+	return nil
+}
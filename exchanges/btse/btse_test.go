@@ -347,7 +347,7 @@ func TestGetDepositAddress(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys not set, skipping test")
 	}
-	_, err := b.GetDepositAddress(context.Background(), currency.XRP, "")
+	_, err := b.GetDepositAddress(context.Background(), currency.XRP, "", "")
 	if err != nil {
 		t.Error(err)
 	}
@@ -677,6 +677,12 @@ func TestWsOrderNotification(t *testing.T) {
 			t.Error(err)
 		}
 	}
+
+	pressXToJSON := []byte(`{"topic": "notificationApi","data": [{"symbol": "BTC-USD","orderID": "1234","orderMode": "MODE_BUY","orderType": "TYPE_LIMIT","price": "1","size": "1","status": "ORDER_PARTIALLY_TRANSACTED","timestamp": "1580349090693","type": "","triggerPrice": "0","avgFillPrice": "1","fillSize": "0.5","clOrderID": "abc","maker": true}]}`)
+	err := b.wsHandleData(pressXToJSON)
+	if err != nil {
+		t.Error(err)
+	}
 }
 
 func TestStatusToStandardStatus(t *testing.T) {
@@ -340,6 +340,10 @@ type wsOrderUpdate struct {
 	Timestamp         int64   `json:"timestamp,string"`
 	TriggerPrice      float64 `json:"triggerPrice,string"`
 	Type              string  `json:"type"`
+	AvgFillPrice      float64 `json:"avgFillPrice,string"`
+	FillSize          float64 `json:"fillSize,string"`
+	ClientOrderID     string  `json:"clOrderID"`
+	Maker             bool    `json:"maker"`
 }
 
 // ErrorResponse contains errors received from API
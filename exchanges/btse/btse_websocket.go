@@ -14,6 +14,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fill"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
@@ -219,6 +220,25 @@ func (b *BTSE) wsHandleData(respRaw []byte) error {
 				Date:         time.Unix(0, notification.Data[i].Timestamp*int64(time.Millisecond)),
 				Pair:         p,
 			}
+			if (oStatus == order.Filled || oStatus == order.PartiallyFilled) &&
+				notification.Data[i].FillSize > 0 {
+				b.Websocket.DataHandler <- []fill.Data{{
+					TradeHistory: order.TradeHistory{
+						Price:     notification.Data[i].AvgFillPrice,
+						Amount:    notification.Data[i].FillSize,
+						Exchange:  b.Name,
+						Type:      oType,
+						Side:      oSide,
+						Timestamp: time.Unix(0, notification.Data[i].Timestamp*int64(time.Millisecond)),
+						IsMaker:   notification.Data[i].Maker,
+						Total:     notification.Data[i].AvgFillPrice * notification.Data[i].FillSize,
+					},
+					OrderID:       notification.Data[i].OrderID,
+					ClientOrderID: notification.Data[i].ClientOrderID,
+					Pair:          p,
+					AssetType:     a,
+				}}
+			}
 		}
 	case strings.Contains(topic, "tradeHistory"):
 		if !b.IsSaveTradeDataEnabled() {
@@ -0,0 +1,80 @@
+package exchange
+
+//go:generate go run ../cmd/exchange_capabilities -path . -out capabilities_generated.go
+
+import (
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/capability"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// GetCapabilities returns the exchange's capability descriptor. Supported
+// wrapper methods are derived from the exchange source by
+// cmd/exchange_capabilities, exchanges which have not been generated report
+// every method as supported.
+func (b *Base) GetCapabilities() (*capability.Descriptor, error) {
+	d := b.staticCapabilities()
+	for x := range kline.SupportedIntervals {
+		if b.klineIntervalEnabled(kline.SupportedIntervals[x]) {
+			d.Intervals = append(d.Intervals, kline.SupportedIntervals[x])
+		}
+	}
+	if b.Websocket != nil && b.Websocket.GenerateSubs != nil {
+		subs, err := b.Websocket.GenerateSubs()
+		if err != nil {
+			return nil, err
+		}
+		for x := range subs {
+			if !common.StringDataCompare(d.WebsocketChannels, subs[x].Channel) {
+				d.WebsocketChannels = append(d.WebsocketChannels, subs[x].Channel)
+			}
+		}
+		sort.Strings(d.WebsocketChannels)
+	}
+	return d, nil
+}
+
+// CheckOrderCapabilities rejects an order submission the exchange cannot
+// accept. Only the declared methods, assets, order types and time in force
+// options are consulted so that submissions do not generate subscriptions.
+func (b *Base) CheckOrderCapabilities(s *order.Submit) error {
+	return b.staticCapabilities().ValidateSubmit(s)
+}
+
+// staticCapabilities returns the capability descriptor without the intervals
+// and websocket channels which depend on the exchange's current configuration
+func (b *Base) staticCapabilities() *capability.Descriptor {
+	return &capability.Descriptor{
+		Exchange:  b.Name,
+		REST:      b.Features.Supports.REST,
+		Websocket: b.Features.Supports.Websocket,
+		Methods:   supportedMethods(b.Name),
+		Assets:    b.GetAssetTypes(false),
+		// the timer is set via REST by SetDeadMansSwitch
+		DeadMansSwitch: b.Features.Supports.RESTCapabilities.DeadMansSwitch,
+		Orders: capability.Orders{
+			Types:       append([]order.Type(nil), b.Features.Supports.Orders.Types...),
+			TimeInForce: append([]capability.TimeInForce(nil), b.Features.Supports.Orders.TimeInForce...),
+		},
+	}
+}
+
+// supportedMethods returns the IBotExchange methods implemented by an exchange
+func supportedMethods(exch string) []string {
+	unsupported := unsupportedMethods[strings.ToLower(exch)]
+	t := reflect.TypeOf((*IBotExchange)(nil)).Elem()
+	methods := make([]string, 0, t.NumMethod())
+	for x := 0; x < t.NumMethod(); x++ {
+		name := t.Method(x).Name
+		if common.StringDataCompare(unsupported, name) {
+			continue
+		}
+		methods = append(methods, name)
+	}
+	return methods
+}
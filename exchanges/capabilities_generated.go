@@ -0,0 +1,934 @@
+// Code generated by cmd/exchange_capabilities; DO NOT EDIT.
+
+package exchange
+
+// unsupportedMethods lists the IBotExchange methods each exchange has not
+// implemented, keyed by lower case exchange name
+var unsupportedMethods = map[string][]string{
+	"alphapoint": {
+		"AuthenticateWebsocket",
+		"CancelBatchOrders",
+		"CancelMarginLendOffer",
+		"ChangeFuturesLeverage",
+		"ChangeFuturesMarginType",
+		"FetchTradablePairs",
+		"GetAvailableTransferChains",
+		"GetDefaultConfig",
+		"GetFeeByType",
+		"GetFundingHistory",
+		"GetFundingRateHistory",
+		"GetFuturesMarginInfo",
+		"GetFuturesMarkPrice",
+		"GetFuturesOpenInterest",
+		"GetFuturesPositions",
+		"GetHistoricCandles",
+		"GetHistoricCandlesExtended",
+		"GetHistoricTrades",
+		"GetLatestFundingRate",
+		"GetMarginInterestHistory",
+		"GetMarginInterestRates",
+		"GetMarginLoans",
+		"GetOptionsChain",
+		"GetOptionsGreeks",
+		"GetRecentTrades",
+		"GetWithdrawalsHistory",
+		"MarginBorrow",
+		"MarginRepay",
+		"ModifyBatchOrders",
+		"ModifyOrder",
+		"SetDeadMansSwitch",
+		"Setup",
+		"Start",
+		"SubmitBatchOrders",
+		"SubmitMarginLendOffer",
+		"TransferFunds",
+		"UpdateCurrencyStates",
+		"UpdateFeeSchedule",
+		"UpdateOrderExecutionLimits",
+		"UpdateTickers",
+		"UpdateTradablePairs",
+		"WithdrawCryptocurrencyFunds",
+		"WithdrawFiatFunds",
+		"WithdrawFiatFundsToInternationalBank",
+	},
+	"binance": {
+		"AuthenticateWebsocket",
+		"CancelBatchOrders",
+		"CancelMarginLendOffer",
+		"GetFundingHistory",
+		"GetOptionsChain",
+		"GetOptionsGreeks",
+		"ModifyBatchOrders",
+		"ModifyOrder",
+		"SetDeadMansSwitch",
+		"SubmitMarginLendOffer",
+		"UpdateCurrencyStates",
+		"WithdrawFiatFunds",
+		"WithdrawFiatFundsToInternationalBank",
+	},
+	"bitfinex": {
+		"CancelBatchOrders",
+		"ChangeFuturesLeverage",
+		"ChangeFuturesMarginType",
+		"GetAvailableTransferChains",
+		"GetFundingHistory",
+		"GetFundingRateHistory",
+		"GetFuturesMarginInfo",
+		"GetFuturesMarkPrice",
+		"GetFuturesOpenInterest",
+		"GetFuturesPositions",
+		"GetLatestFundingRate",
+		"GetMarginInterestHistory",
+		"GetOptionsChain",
+		"GetOptionsGreeks",
+		"GetOrderInfo",
+		"GetWithdrawalsHistory",
+		"MarginBorrow",
+		"MarginRepay",
+		"ModifyBatchOrders",
+		"SetDeadMansSwitch",
+		"SubmitBatchOrders",
+		"UpdateCurrencyStates",
+		"UpdateOrderExecutionLimits",
+	},
+	"bitflyer": {
+		"AuthenticateWebsocket",
+		"CancelAllOrders",
+		"CancelBatchOrders",
+		"CancelMarginLendOffer",
+		"CancelOrder",
+		"ChangeFuturesLeverage",
+		"ChangeFuturesMarginType",
+		"GetActiveOrders",
+		"GetAvailableTransferChains",
+		"GetDepositAddress",
+		"GetFundingHistory",
+		"GetFundingRateHistory",
+		"GetFuturesMarginInfo",
+		"GetFuturesMarkPrice",
+		"GetFuturesOpenInterest",
+		"GetFuturesPositions",
+		"GetHistoricCandles",
+		"GetHistoricCandlesExtended",
+		"GetHistoricTrades",
+		"GetLatestFundingRate",
+		"GetMarginInterestHistory",
+		"GetMarginInterestRates",
+		"GetMarginLoans",
+		"GetOptionsChain",
+		"GetOptionsGreeks",
+		"GetOrderHistory",
+		"GetOrderInfo",
+		"GetWithdrawalsHistory",
+		"MarginBorrow",
+		"MarginRepay",
+		"ModifyBatchOrders",
+		"ModifyOrder",
+		"SetDeadMansSwitch",
+		"SubmitBatchOrders",
+		"SubmitMarginLendOffer",
+		"SubmitOrder",
+		"TransferFunds",
+		"UpdateAccountInfo",
+		"UpdateCurrencyStates",
+		"UpdateFeeSchedule",
+		"UpdateOrderExecutionLimits",
+		"UpdateTickers",
+		"WithdrawCryptocurrencyFunds",
+		"WithdrawFiatFunds",
+		"WithdrawFiatFundsToInternationalBank",
+	},
+	"bithumb": {
+		"AuthenticateWebsocket",
+		"CancelBatchOrders",
+		"CancelMarginLendOffer",
+		"ChangeFuturesLeverage",
+		"ChangeFuturesMarginType",
+		"GetAvailableTransferChains",
+		"GetFundingHistory",
+		"GetFundingRateHistory",
+		"GetFuturesMarginInfo",
+		"GetFuturesMarkPrice",
+		"GetFuturesOpenInterest",
+		"GetFuturesPositions",
+		"GetHistoricTrades",
+		"GetLatestFundingRate",
+		"GetMarginInterestHistory",
+		"GetMarginInterestRates",
+		"GetMarginLoans",
+		"GetOptionsChain",
+		"GetOptionsGreeks",
+		"GetOrderInfo",
+		"GetWithdrawalsHistory",
+		"MarginBorrow",
+		"MarginRepay",
+		"ModifyBatchOrders",
+		"SetDeadMansSwitch",
+		"SubmitBatchOrders",
+		"SubmitMarginLendOffer",
+		"TransferFunds",
+		"UpdateFeeSchedule",
+		"WithdrawFiatFundsToInternationalBank",
+	},
+	"bitmex": {
+		"CancelBatchOrders",
+		"CancelMarginLendOffer",
+		"GetAvailableTransferChains",
+		"GetFundingHistory",
+		"GetHistoricCandles",
+		"GetHistoricCandlesExtended",
+		"GetMarginInterestHistory",
+		"GetMarginInterestRates",
+		"GetMarginLoans",
+		"GetOptionsChain",
+		"GetOptionsGreeks",
+		"GetOrderInfo",
+		"GetWithdrawalsHistory",
+		"MarginBorrow",
+		"MarginRepay",
+		"ModifyBatchOrders",
+		"SubmitBatchOrders",
+		"SubmitMarginLendOffer",
+		"TransferFunds",
+		"UpdateCurrencyStates",
+		"UpdateFeeSchedule",
+		"UpdateOrderExecutionLimits",
+		"WithdrawFiatFunds",
+		"WithdrawFiatFundsToInternationalBank",
+	},
+	"bitstamp": {
+		"AuthenticateWebsocket",
+		"CancelBatchOrders",
+		"CancelMarginLendOffer",
+		"ChangeFuturesLeverage",
+		"ChangeFuturesMarginType",
+		"GetAvailableTransferChains",
+		"GetFundingHistory",
+		"GetFundingRateHistory",
+		"GetFuturesMarginInfo",
+		"GetFuturesMarkPrice",
+		"GetFuturesOpenInterest",
+		"GetFuturesPositions",
+		"GetHistoricTrades",
+		"GetLatestFundingRate",
+		"GetMarginInterestHistory",
+		"GetMarginInterestRates",
+		"GetMarginLoans",
+		"GetOptionsChain",
+		"GetOptionsGreeks",
+		"GetOrderInfo",
+		"GetWithdrawalsHistory",
+		"MarginBorrow",
+		"MarginRepay",
+		"ModifyBatchOrders",
+		"ModifyOrder",
+		"SetDeadMansSwitch",
+		"SubmitBatchOrders",
+		"SubmitMarginLendOffer",
+		"TransferFunds",
+		"UpdateCurrencyStates",
+		"UpdateFeeSchedule",
+		"UpdateOrderExecutionLimits",
+		"UpdateTickers",
+	},
+	"bittrex": {
+		"AuthenticateWebsocket",
+		"CancelBatchOrders",
+		"CancelMarginLendOffer",
+		"ChangeFuturesLeverage",
+		"ChangeFuturesMarginType",
+		"GetAvailableTransferChains",
+		"GetFundingRateHistory",
+		"GetFuturesMarginInfo",
+		"GetFuturesMarkPrice",
+		"GetFuturesOpenInterest",
+		"GetFuturesPositions",
+		"GetHistoricCandlesExtended",
+		"GetHistoricTrades",
+		"GetLatestFundingRate",
+		"GetMarginInterestHistory",
+		"GetMarginInterestRates",
+		"GetMarginLoans",
+		"GetOptionsChain",
+		"GetOptionsGreeks",
+		"GetWithdrawalsHistory",
+		"MarginBorrow",
+		"MarginRepay",
+		"ModifyBatchOrders",
+		"ModifyOrder",
+		"SetDeadMansSwitch",
+		"SubmitBatchOrders",
+		"SubmitMarginLendOffer",
+		"TransferFunds",
+		"UpdateCurrencyStates",
+		"UpdateFeeSchedule",
+		"UpdateOrderExecutionLimits",
+		"UpdateTickers",
+		"WithdrawFiatFunds",
+		"WithdrawFiatFundsToInternationalBank",
+	},
+	"btc markets": {
+		"AuthenticateWebsocket",
+		"CancelBatchOrders",
+		"CancelMarginLendOffer",
+		"ChangeFuturesLeverage",
+		"ChangeFuturesMarginType",
+		"GetAvailableTransferChains",
+		"GetFundingHistory",
+		"GetFundingRateHistory",
+		"GetFuturesMarginInfo",
+		"GetFuturesMarkPrice",
+		"GetFuturesOpenInterest",
+		"GetFuturesPositions",
+		"GetHistoricTrades",
+		"GetLatestFundingRate",
+		"GetMarginInterestHistory",
+		"GetMarginInterestRates",
+		"GetMarginLoans",
+		"GetOptionsChain",
+		"GetOptionsGreeks",
+		"GetWithdrawalsHistory",
+		"MarginBorrow",
+		"MarginRepay",
+		"ModifyBatchOrders",
+		"ModifyOrder",
+		"SetDeadMansSwitch",
+		"SubmitBatchOrders",
+		"SubmitMarginLendOffer",
+		"TransferFunds",
+		"UpdateCurrencyStates",
+		"UpdateFeeSchedule",
+		"UpdateOrderExecutionLimits",
+		"WithdrawFiatFundsToInternationalBank",
+	},
+	"btse": {
+		"AuthenticateWebsocket",
+		"CancelBatchOrders",
+		"CancelMarginLendOffer",
+		"ChangeFuturesLeverage",
+		"ChangeFuturesMarginType",
+		"GetAvailableTransferChains",
+		"GetFundingHistory",
+		"GetFundingRateHistory",
+		"GetFuturesMarginInfo",
+		"GetFuturesMarkPrice",
+		"GetFuturesOpenInterest",
+		"GetFuturesPositions",
+		"GetHistoricTrades",
+		"GetLatestFundingRate",
+		"GetMarginInterestHistory",
+		"GetMarginInterestRates",
+		"GetMarginLoans",
+		"GetOptionsChain",
+		"GetOptionsGreeks",
+		"GetWithdrawalsHistory",
+		"MarginBorrow",
+		"MarginRepay",
+		"ModifyBatchOrders",
+		"ModifyOrder",
+		"SetDeadMansSwitch",
+		"SubmitBatchOrders",
+		"SubmitMarginLendOffer",
+		"TransferFunds",
+		"UpdateCurrencyStates",
+		"UpdateFeeSchedule",
+		"UpdateOrderExecutionLimits",
+		"WithdrawFiatFunds",
+		"WithdrawFiatFundsToInternationalBank",
+	},
+	"coinbasepro": {
+		"AuthenticateWebsocket",
+		"CancelBatchOrders",
+		"CancelMarginLendOffer",
+		"ChangeFuturesLeverage",
+		"ChangeFuturesMarginType",
+		"GetAvailableTransferChains",
+		"GetDepositAddress",
+		"GetFundingHistory",
+		"GetFundingRateHistory",
+		"GetFuturesMarginInfo",
+		"GetFuturesMarkPrice",
+		"GetFuturesOpenInterest",
+		"GetFuturesPositions",
+		"GetHistoricTrades",
+		"GetLatestFundingRate",
+		"GetMarginInterestHistory",
+		"GetMarginInterestRates",
+		"GetMarginLoans",
+		"GetOptionsChain",
+		"GetOptionsGreeks",
+		"GetWithdrawalsHistory",
+		"MarginBorrow",
+		"MarginRepay",
+		"ModifyBatchOrders",
+		"ModifyOrder",
+		"SetDeadMansSwitch",
+		"SubmitBatchOrders",
+		"SubmitMarginLendOffer",
+		"TransferFunds",
+		"UpdateCurrencyStates",
+		"UpdateFeeSchedule",
+		"UpdateOrderExecutionLimits",
+		"UpdateTickers",
+	},
+	"coinbene": {
+		"CancelBatchOrders",
+		"CancelMarginLendOffer",
+		"ChangeFuturesLeverage",
+		"ChangeFuturesMarginType",
+		"GetAvailableTransferChains",
+		"GetDepositAddress",
+		"GetFundingHistory",
+		"GetFundingRateHistory",
+		"GetFuturesMarginInfo",
+		"GetFuturesMarkPrice",
+		"GetFuturesOpenInterest",
+		"GetFuturesPositions",
+		"GetHistoricTrades",
+		"GetLatestFundingRate",
+		"GetMarginInterestHistory",
+		"GetMarginInterestRates",
+		"GetMarginLoans",
+		"GetOptionsChain",
+		"GetOptionsGreeks",
+		"GetWithdrawalsHistory",
+		"MarginBorrow",
+		"MarginRepay",
+		"ModifyBatchOrders",
+		"ModifyOrder",
+		"SetDeadMansSwitch",
+		"SubmitBatchOrders",
+		"SubmitMarginLendOffer",
+		"TransferFunds",
+		"UpdateCurrencyStates",
+		"UpdateFeeSchedule",
+		"UpdateOrderExecutionLimits",
+		"WithdrawCryptocurrencyFunds",
+		"WithdrawFiatFunds",
+		"WithdrawFiatFundsToInternationalBank",
+	},
+	"coinut": {
+		"CancelBatchOrders",
+		"CancelMarginLendOffer",
+		"ChangeFuturesLeverage",
+		"ChangeFuturesMarginType",
+		"GetAvailableTransferChains",
+		"GetDepositAddress",
+		"GetFundingHistory",
+		"GetFundingRateHistory",
+		"GetFuturesMarginInfo",
+		"GetFuturesMarkPrice",
+		"GetFuturesOpenInterest",
+		"GetFuturesPositions",
+		"GetHistoricCandles",
+		"GetHistoricCandlesExtended",
+		"GetHistoricTrades",
+		"GetLatestFundingRate",
+		"GetMarginInterestHistory",
+		"GetMarginInterestRates",
+		"GetMarginLoans",
+		"GetOptionsChain",
+		"GetOptionsGreeks",
+		"GetOrderInfo",
+		"GetWithdrawalsHistory",
+		"MarginBorrow",
+		"MarginRepay",
+		"ModifyBatchOrders",
+		"ModifyOrder",
+		"SetDeadMansSwitch",
+		"SubmitBatchOrders",
+		"SubmitMarginLendOffer",
+		"TransferFunds",
+		"UpdateCurrencyStates",
+		"UpdateFeeSchedule",
+		"UpdateOrderExecutionLimits",
+		"UpdateTickers",
+		"WithdrawCryptocurrencyFunds",
+		"WithdrawFiatFunds",
+		"WithdrawFiatFundsToInternationalBank",
+	},
+	"exmo": {
+		"AuthenticateWebsocket",
+		"CancelBatchOrders",
+		"CancelMarginLendOffer",
+		"ChangeFuturesLeverage",
+		"ChangeFuturesMarginType",
+		"GetAvailableTransferChains",
+		"GetFundingHistory",
+		"GetFundingRateHistory",
+		"GetFuturesMarginInfo",
+		"GetFuturesMarkPrice",
+		"GetFuturesOpenInterest",
+		"GetFuturesPositions",
+		"GetHistoricCandles",
+		"GetHistoricCandlesExtended",
+		"GetHistoricTrades",
+		"GetLatestFundingRate",
+		"GetMarginInterestHistory",
+		"GetMarginInterestRates",
+		"GetMarginLoans",
+		"GetOptionsChain",
+		"GetOptionsGreeks",
+		"GetOrderInfo",
+		"GetWithdrawalsHistory",
+		"MarginBorrow",
+		"MarginRepay",
+		"ModifyBatchOrders",
+		"ModifyOrder",
+		"SetDeadMansSwitch",
+		"SubmitBatchOrders",
+		"SubmitMarginLendOffer",
+		"TransferFunds",
+		"UpdateCurrencyStates",
+		"UpdateFeeSchedule",
+		"UpdateOrderExecutionLimits",
+		"WithdrawFiatFunds",
+		"WithdrawFiatFundsToInternationalBank",
+	},
+	"ftx": {
+		"CancelBatchOrders",
+		"GetOptionsGreeks",
+		"GetWithdrawalsHistory",
+		"MarginBorrow",
+		"MarginRepay",
+		"ModifyBatchOrders",
+		"SetDeadMansSwitch",
+		"SubmitBatchOrders",
+		"UpdateCurrencyStates",
+		"WithdrawFiatFunds",
+		"WithdrawFiatFundsToInternationalBank",
+	},
+	"gateio": {
+		"CancelBatchOrders",
+		"CancelMarginLendOffer",
+		"ChangeFuturesLeverage",
+		"ChangeFuturesMarginType",
+		"GetAvailableTransferChains",
+		"GetFundingHistory",
+		"GetFundingRateHistory",
+		"GetFuturesMarginInfo",
+		"GetFuturesMarkPrice",
+		"GetFuturesOpenInterest",
+		"GetFuturesPositions",
+		"GetHistoricTrades",
+		"GetLatestFundingRate",
+		"GetMarginInterestHistory",
+		"GetMarginInterestRates",
+		"GetMarginLoans",
+		"GetOptionsChain",
+		"GetOptionsGreeks",
+		"GetWithdrawalsHistory",
+		"MarginBorrow",
+		"MarginRepay",
+		"ModifyBatchOrders",
+		"ModifyOrder",
+		"SetDeadMansSwitch",
+		"SubmitBatchOrders",
+		"SubmitMarginLendOffer",
+		"TransferFunds",
+		"UpdateCurrencyStates",
+		"UpdateFeeSchedule",
+		"UpdateOrderExecutionLimits",
+		"WithdrawFiatFunds",
+		"WithdrawFiatFundsToInternationalBank",
+	},
+	"gemini": {
+		"AuthenticateWebsocket",
+		"CancelBatchOrders",
+		"CancelMarginLendOffer",
+		"ChangeFuturesLeverage",
+		"ChangeFuturesMarginType",
+		"GetAvailableTransferChains",
+		"GetFundingHistory",
+		"GetFundingRateHistory",
+		"GetFuturesMarginInfo",
+		"GetFuturesMarkPrice",
+		"GetFuturesOpenInterest",
+		"GetFuturesPositions",
+		"GetHistoricCandles",
+		"GetHistoricCandlesExtended",
+		"GetLatestFundingRate",
+		"GetMarginInterestHistory",
+		"GetMarginInterestRates",
+		"GetMarginLoans",
+		"GetOptionsChain",
+		"GetOptionsGreeks",
+		"GetOrderInfo",
+		"GetWithdrawalsHistory",
+		"MarginBorrow",
+		"MarginRepay",
+		"ModifyBatchOrders",
+		"ModifyOrder",
+		"SetDeadMansSwitch",
+		"SubmitBatchOrders",
+		"SubmitMarginLendOffer",
+		"TransferFunds",
+		"UpdateCurrencyStates",
+		"UpdateFeeSchedule",
+		"UpdateOrderExecutionLimits",
+		"UpdateTickers",
+		"WithdrawFiatFunds",
+		"WithdrawFiatFundsToInternationalBank",
+	},
+	"hitbtc": {
+		"CancelBatchOrders",
+		"CancelMarginLendOffer",
+		"ChangeFuturesLeverage",
+		"ChangeFuturesMarginType",
+		"GetAvailableTransferChains",
+		"GetFundingHistory",
+		"GetFundingRateHistory",
+		"GetFuturesMarginInfo",
+		"GetFuturesMarkPrice",
+		"GetFuturesOpenInterest",
+		"GetFuturesPositions",
+		"GetLatestFundingRate",
+		"GetMarginInterestHistory",
+		"GetMarginInterestRates",
+		"GetMarginLoans",
+		"GetOptionsChain",
+		"GetOptionsGreeks",
+		"GetOrderInfo",
+		"GetWithdrawalsHistory",
+		"MarginBorrow",
+		"MarginRepay",
+		"ModifyBatchOrders",
+		"ModifyOrder",
+		"SetDeadMansSwitch",
+		"SubmitBatchOrders",
+		"SubmitMarginLendOffer",
+		"TransferFunds",
+		"UpdateCurrencyStates",
+		"UpdateFeeSchedule",
+		"UpdateOrderExecutionLimits",
+		"WithdrawFiatFunds",
+		"WithdrawFiatFundsToInternationalBank",
+	},
+	"huobi": {
+		"CancelBatchOrders",
+		"CancelMarginLendOffer",
+		"GetFundingHistory",
+		"GetFuturesMarkPrice",
+		"GetHistoricTrades",
+		"GetMarginInterestHistory",
+		"GetMarginInterestRates",
+		"GetMarginLoans",
+		"GetWithdrawalsHistory",
+		"MarginBorrow",
+		"MarginRepay",
+		"ModifyBatchOrders",
+		"ModifyOrder",
+		"SetDeadMansSwitch",
+		"SubmitMarginLendOffer",
+		"TransferFunds",
+		"UpdateCurrencyStates",
+		"UpdateFeeSchedule",
+		"UpdateOrderExecutionLimits",
+		"UpdateTickers",
+		"WithdrawFiatFunds",
+		"WithdrawFiatFundsToInternationalBank",
+	},
+	"itbit": {
+		"AuthenticateWebsocket",
+		"CancelBatchOrders",
+		"CancelMarginLendOffer",
+		"ChangeFuturesLeverage",
+		"ChangeFuturesMarginType",
+		"FetchTradablePairs",
+		"GetAvailableTransferChains",
+		"GetDepositAddress",
+		"GetFundingHistory",
+		"GetFundingRateHistory",
+		"GetFuturesMarginInfo",
+		"GetFuturesMarkPrice",
+		"GetFuturesOpenInterest",
+		"GetFuturesPositions",
+		"GetHistoricCandles",
+		"GetHistoricCandlesExtended",
+		"GetHistoricTrades",
+		"GetLatestFundingRate",
+		"GetMarginInterestHistory",
+		"GetMarginInterestRates",
+		"GetMarginLoans",
+		"GetOptionsChain",
+		"GetOptionsGreeks",
+		"GetOrderInfo",
+		"GetWithdrawalsHistory",
+		"MarginBorrow",
+		"MarginRepay",
+		"ModifyBatchOrders",
+		"ModifyOrder",
+		"SetDeadMansSwitch",
+		"SubmitBatchOrders",
+		"SubmitMarginLendOffer",
+		"TransferFunds",
+		"UpdateCurrencyStates",
+		"UpdateFeeSchedule",
+		"UpdateOrderExecutionLimits",
+		"UpdateTickers",
+		"UpdateTradablePairs",
+		"WithdrawCryptocurrencyFunds",
+		"WithdrawFiatFunds",
+		"WithdrawFiatFundsToInternationalBank",
+	},
+	"kraken": {
+		"CancelMarginLendOffer",
+		"ChangeFuturesLeverage",
+		"ChangeFuturesMarginType",
+		"GetFundingHistory",
+		"GetFundingRateHistory",
+		"GetFuturesMarginInfo",
+		"GetFuturesMarkPrice",
+		"GetFuturesOpenInterest",
+		"GetFuturesPositions",
+		"GetHistoricTrades",
+		"GetLatestFundingRate",
+		"GetMarginInterestRates",
+		"GetOptionsChain",
+		"GetOptionsGreeks",
+		"MarginBorrow",
+		"MarginRepay",
+		"ModifyBatchOrders",
+		"ModifyOrder",
+		"SubmitBatchOrders",
+		"SubmitMarginLendOffer",
+		"TransferFunds",
+		"UpdateCurrencyStates",
+		"UpdateFeeSchedule",
+		"UpdateOrderExecutionLimits",
+	},
+	"lbank": {
+		"AuthenticateWebsocket",
+		"CancelBatchOrders",
+		"CancelMarginLendOffer",
+		"ChangeFuturesLeverage",
+		"ChangeFuturesMarginType",
+		"GetAvailableTransferChains",
+		"GetDepositAddress",
+		"GetFundingHistory",
+		"GetFundingRateHistory",
+		"GetFuturesMarginInfo",
+		"GetFuturesMarkPrice",
+		"GetFuturesOpenInterest",
+		"GetFuturesPositions",
+		"GetLatestFundingRate",
+		"GetMarginInterestHistory",
+		"GetMarginInterestRates",
+		"GetMarginLoans",
+		"GetOptionsChain",
+		"GetOptionsGreeks",
+		"GetWithdrawalsHistory",
+		"MarginBorrow",
+		"MarginRepay",
+		"ModifyBatchOrders",
+		"ModifyOrder",
+		"SetDeadMansSwitch",
+		"SubmitBatchOrders",
+		"SubmitMarginLendOffer",
+		"TransferFunds",
+		"UpdateCurrencyStates",
+		"UpdateFeeSchedule",
+		"UpdateOrderExecutionLimits",
+		"WithdrawFiatFunds",
+		"WithdrawFiatFundsToInternationalBank",
+	},
+	"localbitcoins": {
+		"AuthenticateWebsocket",
+		"CancelBatchOrders",
+		"CancelMarginLendOffer",
+		"ChangeFuturesLeverage",
+		"ChangeFuturesMarginType",
+		"GetAvailableTransferChains",
+		"GetFundingHistory",
+		"GetFundingRateHistory",
+		"GetFuturesMarginInfo",
+		"GetFuturesMarkPrice",
+		"GetFuturesOpenInterest",
+		"GetFuturesPositions",
+		"GetHistoricCandles",
+		"GetHistoricCandlesExtended",
+		"GetHistoricTrades",
+		"GetLatestFundingRate",
+		"GetMarginInterestHistory",
+		"GetMarginInterestRates",
+		"GetMarginLoans",
+		"GetOptionsChain",
+		"GetOptionsGreeks",
+		"GetOrderInfo",
+		"GetWithdrawalsHistory",
+		"MarginBorrow",
+		"MarginRepay",
+		"ModifyBatchOrders",
+		"ModifyOrder",
+		"SetDeadMansSwitch",
+		"SubmitBatchOrders",
+		"SubmitMarginLendOffer",
+		"TransferFunds",
+		"UpdateCurrencyStates",
+		"UpdateFeeSchedule",
+		"UpdateOrderExecutionLimits",
+		"WithdrawFiatFunds",
+		"WithdrawFiatFundsToInternationalBank",
+	},
+	"okcoin international": {
+		"CancelBatchOrders",
+		"CancelMarginLendOffer",
+		"ChangeFuturesLeverage",
+		"ChangeFuturesMarginType",
+		"GetAvailableTransferChains",
+		"GetFundingRateHistory",
+		"GetFuturesMarginInfo",
+		"GetFuturesMarkPrice",
+		"GetFuturesOpenInterest",
+		"GetFuturesPositions",
+		"GetHistoricTrades",
+		"GetLatestFundingRate",
+		"GetMarginInterestHistory",
+		"GetMarginInterestRates",
+		"GetMarginLoans",
+		"GetOptionsChain",
+		"GetOptionsGreeks",
+		"GetWithdrawalsHistory",
+		"MarginBorrow",
+		"MarginRepay",
+		"ModifyBatchOrders",
+		"ModifyOrder",
+		"SetDeadMansSwitch",
+		"SubmitBatchOrders",
+		"SubmitMarginLendOffer",
+		"TransferFunds",
+		"UpdateCurrencyStates",
+		"UpdateFeeSchedule",
+		"UpdateOrderExecutionLimits",
+		"WithdrawFiatFunds",
+		"WithdrawFiatFundsToInternationalBank",
+	},
+	"okex": {
+		"CancelBatchOrders",
+		"CancelMarginLendOffer",
+		"GetAvailableTransferChains",
+		"GetFundingRateHistory",
+		"GetFuturesMarkPrice",
+		"GetFuturesOpenInterest",
+		"GetHistoricTrades",
+		"GetLatestFundingRate",
+		"GetMarginInterestHistory",
+		"GetMarginInterestRates",
+		"GetMarginLoans",
+		"GetWithdrawalsHistory",
+		"MarginBorrow",
+		"MarginRepay",
+		"ModifyBatchOrders",
+		"ModifyOrder",
+		"SetDeadMansSwitch",
+		"SubmitBatchOrders",
+		"SubmitMarginLendOffer",
+		"TransferFunds",
+		"UpdateCurrencyStates",
+		"UpdateFeeSchedule",
+		"UpdateOrderExecutionLimits",
+		"WithdrawFiatFunds",
+		"WithdrawFiatFundsToInternationalBank",
+	},
+	"poloniex": {
+		"AuthenticateWebsocket",
+		"CancelBatchOrders",
+		"ChangeFuturesLeverage",
+		"ChangeFuturesMarginType",
+		"GetAvailableTransferChains",
+		"GetFundingHistory",
+		"GetFundingRateHistory",
+		"GetFuturesMarginInfo",
+		"GetFuturesMarkPrice",
+		"GetFuturesOpenInterest",
+		"GetFuturesPositions",
+		"GetLatestFundingRate",
+		"GetOptionsChain",
+		"GetOptionsGreeks",
+		"GetWithdrawalsHistory",
+		"MarginBorrow",
+		"MarginRepay",
+		"ModifyBatchOrders",
+		"SetDeadMansSwitch",
+		"SubmitBatchOrders",
+		"TransferFunds",
+		"UpdateCurrencyStates",
+		"UpdateOrderExecutionLimits",
+		"WithdrawFiatFunds",
+		"WithdrawFiatFundsToInternationalBank",
+	},
+	"yobit": {
+		"AuthenticateWebsocket",
+		"CancelBatchOrders",
+		"CancelMarginLendOffer",
+		"ChangeFuturesLeverage",
+		"ChangeFuturesMarginType",
+		"GetAvailableTransferChains",
+		"GetFundingHistory",
+		"GetFundingRateHistory",
+		"GetFuturesMarginInfo",
+		"GetFuturesMarkPrice",
+		"GetFuturesOpenInterest",
+		"GetFuturesPositions",
+		"GetHistoricCandles",
+		"GetHistoricCandlesExtended",
+		"GetHistoricTrades",
+		"GetLatestFundingRate",
+		"GetMarginInterestHistory",
+		"GetMarginInterestRates",
+		"GetMarginLoans",
+		"GetOptionsChain",
+		"GetOptionsGreeks",
+		"GetOrderInfo",
+		"GetWithdrawalsHistory",
+		"MarginBorrow",
+		"MarginRepay",
+		"ModifyBatchOrders",
+		"ModifyOrder",
+		"SetDeadMansSwitch",
+		"SubmitBatchOrders",
+		"SubmitMarginLendOffer",
+		"TransferFunds",
+		"UpdateCurrencyStates",
+		"UpdateFeeSchedule",
+		"UpdateOrderExecutionLimits",
+		"WithdrawFiatFunds",
+		"WithdrawFiatFundsToInternationalBank",
+	},
+	"zb": {
+		"AuthenticateWebsocket",
+		"CancelBatchOrders",
+		"CancelMarginLendOffer",
+		"ChangeFuturesLeverage",
+		"ChangeFuturesMarginType",
+		"GetAvailableTransferChains",
+		"GetFundingHistory",
+		"GetFundingRateHistory",
+		"GetFuturesMarginInfo",
+		"GetFuturesMarkPrice",
+		"GetFuturesOpenInterest",
+		"GetFuturesPositions",
+		"GetHistoricTrades",
+		"GetLatestFundingRate",
+		"GetMarginInterestHistory",
+		"GetMarginInterestRates",
+		"GetMarginLoans",
+		"GetOptionsChain",
+		"GetOptionsGreeks",
+		"GetOrderInfo",
+		"GetWithdrawalsHistory",
+		"MarginBorrow",
+		"MarginRepay",
+		"ModifyBatchOrders",
+		"ModifyOrder",
+		"SetDeadMansSwitch",
+		"SubmitBatchOrders",
+		"SubmitMarginLendOffer",
+		"TransferFunds",
+		"UpdateCurrencyStates",
+		"UpdateFeeSchedule",
+		"UpdateOrderExecutionLimits",
+		"WithdrawFiatFunds",
+		"WithdrawFiatFundsToInternationalBank",
+	},
+}
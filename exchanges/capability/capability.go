@@ -0,0 +1,102 @@
+package capability
+
+import (
+	"fmt"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// SupportsMethod returns whether the exchange implements the wrapper method
+func (d *Descriptor) SupportsMethod(method string) bool {
+	return common.StringDataCompare(d.Methods, method)
+}
+
+// SupportsAsset returns whether the exchange supports the asset
+func (d *Descriptor) SupportsAsset(a asset.Item) bool {
+	return d.Assets.Contains(a)
+}
+
+// SupportsInterval returns whether the kline interval is enabled for the
+// exchange
+func (d *Descriptor) SupportsInterval(i kline.Interval) bool {
+	for x := range d.Intervals {
+		if d.Intervals[x] == i {
+			return true
+		}
+	}
+	return false
+}
+
+// SupportsOrderType returns whether the exchange accepts the order type, an
+// undeclared list of order types supports all
+func (o *Orders) SupportsOrderType(t order.Type) bool {
+	if len(o.Types) == 0 {
+		return true
+	}
+	for x := range o.Types {
+		if o.Types[x] == t {
+			return true
+		}
+	}
+	return false
+}
+
+// SupportsTimeInForce returns whether the exchange accepts the time in force
+// option, an undeclared list of options supports all
+func (o *Orders) SupportsTimeInForce(tif TimeInForce) bool {
+	if len(o.TimeInForce) == 0 {
+		return true
+	}
+	for x := range o.TimeInForce {
+		if o.TimeInForce[x] == tif {
+			return true
+		}
+	}
+	return false
+}
+
+// ValidateSubmit rejects an order submission that the exchange cannot accept
+// before it is sent
+func (d *Descriptor) ValidateSubmit(s *order.Submit) error {
+	if d == nil {
+		return errNilDescriptor
+	}
+	if s == nil {
+		return order.ErrSubmissionIsNil
+	}
+	if !d.SupportsMethod("SubmitOrder") {
+		return fmt.Errorf("%s %w: SubmitOrder", d.Exchange, ErrMethodNotSupported)
+	}
+	if !d.SupportsAsset(s.AssetType) {
+		return fmt.Errorf("%s %w: %v", d.Exchange, asset.ErrNotSupported, s.AssetType)
+	}
+	if !d.SupportsOrderType(s.Type) {
+		return fmt.Errorf("%s %w: %v", d.Exchange, ErrOrderTypeNotSupported, s.Type)
+	}
+	for _, tif := range requestedTimeInForce(s) {
+		if !d.SupportsTimeInForce(tif) {
+			return fmt.Errorf("%s %w: %v", d.Exchange, ErrTimeInForceNotSupported, tif)
+		}
+	}
+	return nil
+}
+
+// requestedTimeInForce returns the time in force options set on a submission,
+// an order without any is good till cancelled which is not validated as it is
+// the default for every exchange
+func requestedTimeInForce(s *order.Submit) []TimeInForce {
+	var tif []TimeInForce
+	if s.ImmediateOrCancel || s.Type == order.ImmediateOrCancel {
+		tif = append(tif, ImmediateOrCancel)
+	}
+	if s.FillOrKill || s.Type == order.FillOrKill {
+		tif = append(tif, FillOrKill)
+	}
+	if s.PostOnly || s.Type == order.PostOnly {
+		tif = append(tif, PostOnly)
+	}
+	return tif
+}
@@ -0,0 +1,95 @@
+package capability
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+func TestSupportsOrderType(t *testing.T) {
+	t.Parallel()
+	var o Orders
+	if !o.SupportsOrderType(order.Stop) {
+		t.Error("undeclared order types should support all")
+	}
+	o.Types = []order.Type{order.Limit}
+	if !o.SupportsOrderType(order.Limit) {
+		t.Error("expected limit to be supported")
+	}
+	if o.SupportsOrderType(order.Market) {
+		t.Error("expected market to not be supported")
+	}
+}
+
+func TestSupportsTimeInForce(t *testing.T) {
+	t.Parallel()
+	var o Orders
+	if !o.SupportsTimeInForce(FillOrKill) {
+		t.Error("undeclared time in force options should support all")
+	}
+	o.TimeInForce = []TimeInForce{GoodTillCancel, PostOnly}
+	if !o.SupportsTimeInForce(PostOnly) {
+		t.Error("expected post only to be supported")
+	}
+	if o.SupportsTimeInForce(ImmediateOrCancel) {
+		t.Error("expected immediate or cancel to not be supported")
+	}
+}
+
+func TestValidateSubmit(t *testing.T) {
+	t.Parallel()
+	var d *Descriptor
+	err := d.ValidateSubmit(nil)
+	if !errors.Is(err, errNilDescriptor) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errNilDescriptor)
+	}
+
+	d = &Descriptor{Exchange: "test"}
+	err = d.ValidateSubmit(nil)
+	if !errors.Is(err, order.ErrSubmissionIsNil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, order.ErrSubmissionIsNil)
+	}
+
+	s := &order.Submit{AssetType: asset.Spot, Type: order.Limit}
+	err = d.ValidateSubmit(s)
+	if !errors.Is(err, ErrMethodNotSupported) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrMethodNotSupported)
+	}
+
+	d.Methods = []string{"SubmitOrder"}
+	err = d.ValidateSubmit(s)
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, asset.ErrNotSupported)
+	}
+
+	d.Assets = asset.Items{asset.Spot}
+	d.Orders = Orders{
+		Types:       []order.Type{order.Limit},
+		TimeInForce: []TimeInForce{GoodTillCancel, ImmediateOrCancel},
+	}
+	err = d.ValidateSubmit(s)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+
+	s.Type = order.Market
+	err = d.ValidateSubmit(s)
+	if !errors.Is(err, ErrOrderTypeNotSupported) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrOrderTypeNotSupported)
+	}
+
+	s.Type = order.Limit
+	s.ImmediateOrCancel = true
+	err = d.ValidateSubmit(s)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+
+	s.PostOnly = true
+	err = d.ValidateSubmit(s)
+	if !errors.Is(err, ErrTimeInForceNotSupported) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrTimeInForceNotSupported)
+	}
+}
@@ -0,0 +1,58 @@
+package capability
+
+import (
+	"errors"
+
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// TimeInForce defines how long an order remains active before it is
+// executed or expires
+type TimeInForce string
+
+// Time in force options
+const (
+	GoodTillCancel    TimeInForce = "GTC"
+	ImmediateOrCancel TimeInForce = "IOC"
+	FillOrKill        TimeInForce = "FOK"
+	PostOnly          TimeInForce = "POST_ONLY"
+)
+
+var (
+	// ErrMethodNotSupported is returned when an exchange does not implement
+	// the wrapper method required for a request
+	ErrMethodNotSupported = errors.New("method not supported")
+	// ErrOrderTypeNotSupported is returned when an exchange does not accept
+	// the submitted order type
+	ErrOrderTypeNotSupported = errors.New("order type not supported")
+	// ErrTimeInForceNotSupported is returned when an exchange does not accept
+	// the submitted time in force option
+	ErrTimeInForceNotSupported = errors.New("time in force not supported")
+
+	errNilDescriptor = errors.New("capability descriptor is nil")
+)
+
+// Orders defines the order parameters an exchange accepts on submission.
+// Empty fields have not been declared by the exchange and are not validated.
+type Orders struct {
+	Types       []order.Type
+	TimeInForce []TimeInForce
+}
+
+// Descriptor enumerates everything an exchange supports
+type Descriptor struct {
+	Exchange  string
+	REST      bool
+	Websocket bool
+	// Methods lists the exchange wrapper methods which are implemented
+	Methods []string
+	Assets  asset.Items
+	// DeadMansSwitch is set when the exchange supports a native timer which
+	// cancels all orders if it is not refreshed, see SetDeadMansSwitch
+	DeadMansSwitch bool
+	Orders
+	Intervals         []kline.Interval
+	WebsocketChannels []string
+}
@@ -609,7 +609,7 @@ func TestWithdrawInternationalBank(t *testing.T) {
 }
 
 func TestGetDepositAddress(t *testing.T) {
-	_, err := c.GetDepositAddress(context.Background(), currency.BTC, "")
+	_, err := c.GetDepositAddress(context.Background(), currency.BTC, "", "")
 	if err == nil {
 		t.Error("GetDepositAddress() error", err)
 	}
@@ -880,6 +880,27 @@ func TestWsOrders(t *testing.T) {
 		t.Error(err)
 	}
 
+	pressXToJSON = []byte(`{
+    "type": "match",
+    "trade_id": 11,
+    "sequence": 51,
+    "maker_order_id": "ac928c66-ca53-498f-9c13-a110027a60e8",
+    "taker_order_id": "132fb6ae-456b-4654-b4e0-d681ac05cea1",
+    "time": "2014-11-07T08:19:27.028459Z",
+    "product_id": "BTC-USD",
+    "size": "5.23512",
+    "price": "400.23",
+    "side": "sell",
+    "user_id": "5844eceecf7e803e259d0365",
+    "profile_id": "765d1549-9660-4be2-97d4-fa2d65fa3352",
+    "taker_user_id": "5844eceecf7e803e259d0365",
+    "taker_profile_id": "765d1549-9660-4be2-97d4-fa2d65fa3352"
+}`)
+	err = c.wsHandleData(pressXToJSON)
+	if err != nil {
+		t.Error(err)
+	}
+
 	pressXToJSON = []byte(`{
     "type": "change",
     "time": "2014-11-07T08:19:27.028459Z",
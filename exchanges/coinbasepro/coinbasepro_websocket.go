@@ -15,6 +15,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fill"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
@@ -213,22 +214,35 @@ func (c *CoinbasePro) wsHandleData(respRaw []byte) error {
 		}
 
 		if wsOrder.UserID != "" {
+			// matches on the user channel carry both order IDs, the taker
+			// user ID is only set when the user's order was the taker
+			isMaker := wsOrder.TakerUserID == ""
+			orderID := wsOrder.TakerOrderID
+			if isMaker {
+				orderID = wsOrder.MakerOrderID
+			}
+			tradeHistory := order.TradeHistory{
+				Price:     wsOrder.Price,
+				Amount:    wsOrder.Size,
+				Exchange:  c.Name,
+				TID:       strconv.FormatInt(wsOrder.TradeID, 10),
+				Side:      oSide,
+				Timestamp: wsOrder.Time,
+				IsMaker:   isMaker,
+				Total:     wsOrder.Price * wsOrder.Size,
+			}
 			c.Websocket.DataHandler <- &order.Detail{
-				ID:        wsOrder.OrderID,
+				ID:        orderID,
 				Pair:      p,
 				AssetType: a,
-				Trades: []order.TradeHistory{
-					{
-						Price:     wsOrder.Price,
-						Amount:    wsOrder.Size,
-						Exchange:  c.Name,
-						TID:       strconv.FormatInt(wsOrder.TradeID, 10),
-						Side:      oSide,
-						Timestamp: wsOrder.Time,
-						IsMaker:   wsOrder.TakerUserID == "",
-					},
-				},
+				Trades:    []order.TradeHistory{tradeHistory},
 			}
+			c.Websocket.DataHandler <- []fill.Data{{
+				TradeHistory: tradeHistory,
+				OrderID:      orderID,
+				Pair:         p,
+				AssetType:    a,
+			}}
 		} else {
 			if !c.IsSaveTradeDataEnabled() {
 				return nil
@@ -653,6 +653,32 @@ func TestWsUserOrder(t *testing.T) {
 	if err != nil {
 		t.Error(err)
 	}
+
+	pressXToJSON = []byte(`{
+    "topic": "user.order",
+    "data": [{
+      "orderId": "580721369818955776", 
+      "direction": "openLong", 
+      "leverage": "20", 
+      "symbol": "BTCUSDT", 
+      "orderType": "limit", 
+      "quantity": "7", 
+      "orderPrice": "146.30", 
+      "orderValue": "0.0010", 
+      "fee": "0.0001", 
+      "filledQuantity": "2", 
+      "averagePrice": "146.30", 
+      "orderTime": "2019-05-22T03:39:24.0Z", 
+      "status": "partiallyFilled",
+      "lastFillQuantity": "2",
+      "lastFillPrice": "146.30",
+      "lastFillTime": "2019-05-22T03:40:24.0Z"
+    }]
+}`)
+	err = c.wsHandleData(pressXToJSON)
+	if err != nil {
+		t.Error(err)
+	}
 }
 
 func TestGetHistoricCandles(t *testing.T) {
@@ -181,7 +181,7 @@ type WsKLineData struct {
 
 // WsUserData stores websocket user data
 type WsUserData struct {
-	Asset     string    `json:"string"`
+	Asset     string    `json:"asset"`
 	Available float64   `json:"availableBalance,string"`
 	Locked    float64   `json:"frozenBalance,string"`
 	Total     float64   `json:"balance,string"`
@@ -13,7 +13,9 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common/crypto"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fill"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
@@ -339,7 +341,17 @@ func (c *Coinbene) wsHandleData(respRaw []byte) error {
 		if err != nil {
 			return err
 		}
-		c.Websocket.DataHandler <- userInfo
+		for i := range userInfo.Data {
+			c.Websocket.DataHandler <- account.Change{
+				Exchange: c.Name,
+				Currency: currency.NewCode(userInfo.Data[i].Asset),
+				Asset:    assetType,
+				Balance: &account.Balance{
+					TotalValue: userInfo.Data[i].Total,
+					Hold:       userInfo.Data[i].Locked,
+				},
+			}
+		}
 	case strings.Contains(result[topic].(string), "user.position"):
 		var position WsPosition
 		err = json.Unmarshal(respRaw, &position)
@@ -404,6 +416,31 @@ func (c *Coinbene) wsHandleData(respRaw []byte) error {
 				Leverage:        float64(orders.Data[i].Leverage),
 				Pair:            newPair,
 			}
+			if orders.Data[i].LastFillQuantity > 0 {
+				side := order.Buy
+				if orders.Data[i].Direction == "openShort" ||
+					orders.Data[i].Direction == "closeLong" {
+					side = order.Sell
+				}
+				fillTime := orders.Data[i].OrderTime
+				if t, err := time.Parse(time.RFC3339, orders.Data[i].LastFillTime); err == nil {
+					fillTime = t
+				}
+				c.Websocket.DataHandler <- []fill.Data{{
+					TradeHistory: order.TradeHistory{
+						Price:     orders.Data[i].LastFillPrice,
+						Amount:    orders.Data[i].LastFillQuantity,
+						Exchange:  c.Name,
+						Type:      oType,
+						Side:      side,
+						Timestamp: fillTime,
+						Total:     orders.Data[i].LastFillPrice * orders.Data[i].LastFillQuantity,
+					},
+					OrderID:   orders.Data[i].OrderID,
+					Pair:      newPair,
+					AssetType: assetType,
+				}}
+			}
 		}
 	default:
 		c.Websocket.DataHandler <- stream.UnhandledMessageWarning{
@@ -14,7 +14,9 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common/crypto"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fill"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
@@ -178,6 +180,20 @@ func (c *COINUT) wsHandleData(respRaw []byte) error {
 		if err != nil {
 			return err
 		}
+		balances := map[currency.Code]float64{
+			currency.BTC: userBalance.Btc,
+			currency.LTC: userBalance.Ltc,
+			currency.ETC: userBalance.Etc,
+			currency.ETH: userBalance.Eth,
+		}
+		for code, total := range balances {
+			c.Websocket.DataHandler <- account.Change{
+				Exchange: c.Name,
+				Currency: code,
+				Asset:    asset.Spot,
+				Balance:  &account.Balance{TotalValue: total},
+			}
+		}
 	case "user_open_orders":
 		var openOrders WsUserOpenOrdersResponse
 		err := json.Unmarshal(respRaw, &openOrders)
@@ -374,6 +390,20 @@ func (c *COINUT) wsHandleData(respRaw []byte) error {
 			return err
 		}
 		c.Websocket.DataHandler <- o
+		if orderContainer.Reply == "order_filled" {
+			tradeHistory := o.Trades[0]
+			tradeHistory.Side = o.Side
+			tradeHistory.Fee = orderContainer.Commission.Amount
+			tradeHistory.FeeAsset = orderContainer.Commission.Currency
+			tradeHistory.Total = tradeHistory.Price * tradeHistory.Amount
+			c.Websocket.DataHandler <- []fill.Data{{
+				TradeHistory:  tradeHistory,
+				OrderID:       o.ID,
+				ClientOrderID: strconv.FormatInt(orderContainer.Order.ClientOrderID, 10),
+				Pair:          o.Pair,
+				AssetType:     o.AssetType,
+			}}
+		}
 	default:
 		c.Websocket.DataHandler <- stream.UnhandledMessageWarning{Message: c.Name + stream.UnhandledMessage + string(respRaw)}
 		return nil
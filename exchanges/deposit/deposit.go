@@ -0,0 +1,46 @@
+package deposit
+
+import (
+	"fmt"
+	"strings"
+)
+
+// FindNetwork returns the network matching the chain. When the chain is empty
+// the default network is returned, or the only network when just one is
+// available.
+func FindNetwork(networks []Network, chain string) (Network, error) {
+	if len(networks) == 0 {
+		return Network{}, ErrNoNetworks
+	}
+	if chain != "" {
+		for i := range networks {
+			if strings.EqualFold(networks[i].Chain, chain) {
+				return networks[i], nil
+			}
+		}
+		return Network{}, fmt.Errorf("%s %w, available: %s",
+			chain,
+			ErrNetworkNotFound,
+			strings.Join(Chains(networks), ", "))
+	}
+	if len(networks) == 1 {
+		return networks[0], nil
+	}
+	for i := range networks {
+		if networks[i].IsDefault {
+			return networks[i], nil
+		}
+	}
+	return Network{}, fmt.Errorf("%w: %s",
+		ErrNetworkNotSet,
+		strings.Join(Chains(networks), ", "))
+}
+
+// Chains returns the chain identifiers of the networks
+func Chains(networks []Network) []string {
+	chains := make([]string, len(networks))
+	for i := range networks {
+		chains[i] = networks[i].Chain
+	}
+	return chains
+}
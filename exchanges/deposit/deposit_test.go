@@ -0,0 +1,63 @@
+package deposit
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+)
+
+func TestFindNetwork(t *testing.T) {
+	t.Parallel()
+	_, err := FindNetwork(nil, "")
+	if !errors.Is(err, ErrNoNetworks) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrNoNetworks)
+	}
+
+	networks := []Network{
+		{Currency: currency.USDT, Chain: "ETH"},
+		{Currency: currency.USDT, Chain: "TRX"},
+	}
+	_, err = FindNetwork(networks, "")
+	if !errors.Is(err, ErrNetworkNotSet) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrNetworkNotSet)
+	}
+
+	_, err = FindNetwork(networks, "BSC")
+	if !errors.Is(err, ErrNetworkNotFound) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrNetworkNotFound)
+	}
+
+	n, err := FindNetwork(networks, "trx")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if n.Chain != "TRX" {
+		t.Errorf("received '%v' expected '%v'", n.Chain, "TRX")
+	}
+
+	networks[0].IsDefault = true
+	n, err = FindNetwork(networks, "")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if n.Chain != "ETH" {
+		t.Errorf("received '%v' expected '%v'", n.Chain, "ETH")
+	}
+
+	n, err = FindNetwork(networks[1:], "")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if n.Chain != "TRX" {
+		t.Errorf("received '%v' expected '%v'", n.Chain, "TRX")
+	}
+}
+
+func TestChains(t *testing.T) {
+	t.Parallel()
+	chains := Chains([]Network{{Chain: "ETH"}, {Chain: "TRX"}})
+	if len(chains) != 2 || chains[0] != "ETH" || chains[1] != "TRX" {
+		t.Errorf("unexpected chains %v", chains)
+	}
+}
@@ -0,0 +1,53 @@
+package deposit
+
+import (
+	"errors"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+)
+
+var (
+	// ErrNetworkNotFound is returned when a chain is not supported for a
+	// currency
+	ErrNetworkNotFound = errors.New("network not found")
+	// ErrNetworkNotSet is returned when a currency is available on multiple
+	// networks without a default and a chain has not been specified
+	ErrNetworkNotSet = errors.New("network must be set, multiple networks are available")
+	// ErrNoNetworks is returned when no networks are available for a
+	// currency
+	ErrNoNetworks = errors.New("no networks available")
+)
+
+// Address holds a deposit address and the network it belongs to
+type Address struct {
+	Address string
+	// Tag is the memo, payment ID or destination tag required by some
+	// currencies in addition to the address
+	Tag string
+	// Chain is the network identifier e.g. TRX, an empty chain is the
+	// exchange default network
+	Chain string
+}
+
+// Network defines a blockchain network a currency can be deposited to or
+// withdrawn from and its transfer limits
+type Network struct {
+	Currency currency.Code
+	// Chain is the exchange's identifier for the network which is passed to
+	// deposit address lookups and withdrawals e.g. TRX or ERC20
+	Chain string
+	// Name is a readable name for the network e.g. Tron (TRC20)
+	Name            string
+	IsDefault       bool
+	DepositEnabled  bool
+	WithdrawEnabled bool
+	WithdrawFee     float64
+	WithdrawMinimum float64
+	// WithdrawMaximum is zero when the exchange does not define a limit
+	WithdrawMaximum float64
+	// Confirmations is the number of blocks required before a deposit is
+	// credited
+	Confirmations int64
+	// RequiresTag is set when a memo or destination tag must be provided
+	RequiresTag bool
+}
@@ -16,9 +16,16 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common/crypto"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/currencystate"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/deposit"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fee"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/options"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
@@ -621,6 +628,7 @@ func (b *Base) SetupDefaults(exch *config.ExchangeConfig) error {
 	}
 	b.CanVerifyOrderbook = !exch.OrderbookConfig.VerificationBypass
 	b.States = currencystate.NewCurrencyStates()
+	b.Fees = fee.NewDefinitions()
 	return err
 }
 
@@ -1391,3 +1399,159 @@ func (a *AssetWebsocketSupport) IsAssetWebsocketSupported(aType asset.Item) bool
 func (b *Base) UpdateCurrencyStates(ctx context.Context, a asset.Item) error {
 	return common.ErrNotYetImplemented
 }
+
+// GetLatestFundingRate returns the latest funding rate for a perpetual
+// contract this is overridable
+func (b *Base) GetLatestFundingRate(_ context.Context, _ asset.Item, _ currency.Pair) (*futures.LatestFundingRate, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// GetFundingRateHistory returns the funding rate history for a perpetual
+// contract this is overridable
+func (b *Base) GetFundingRateHistory(_ context.Context, _ asset.Item, _ currency.Pair, _, _ time.Time) ([]futures.FundingRate, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// GetFuturesOpenInterest returns the open interest for a futures contract
+// this is overridable
+func (b *Base) GetFuturesOpenInterest(_ context.Context, _ asset.Item, _ currency.Pair) (*futures.OpenInterest, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// GetFuturesMarkPrice returns the mark and index price for a futures contract
+// this is overridable
+func (b *Base) GetFuturesMarkPrice(_ context.Context, _ asset.Item, _ currency.Pair) (*futures.MarkPrice, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// GetFuturesPositions returns open futures positions for an asset, an empty
+// pair returns positions for all contracts
+// this is overridable
+func (b *Base) GetFuturesPositions(_ context.Context, _ asset.Item, _ currency.Pair) ([]futures.Position, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// ChangeFuturesLeverage sets the leverage for a futures contract
+// this is overridable
+func (b *Base) ChangeFuturesLeverage(_ context.Context, _ asset.Item, _ currency.Pair, _ float64) error {
+	return common.ErrNotYetImplemented
+}
+
+// ChangeFuturesMarginType sets the margin type for a futures contract
+// this is overridable
+func (b *Base) ChangeFuturesMarginType(_ context.Context, _ asset.Item, _ currency.Pair, _ futures.MarginType) error {
+	return common.ErrNotYetImplemented
+}
+
+// GetFuturesMarginInfo returns the leverage and margin settings for a futures
+// contract
+// this is overridable
+func (b *Base) GetFuturesMarginInfo(_ context.Context, _ asset.Item, _ currency.Pair) (*futures.MarginInfo, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// TransferFunds moves funds between wallets or sub-accounts on the exchange
+// this is overridable
+func (b *Base) TransferFunds(_ context.Context, _ *account.TransferRequest) (*account.TransferResponse, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// GetOptionsChain returns the listed option contracts for an underlying
+// this is overridable
+func (b *Base) GetOptionsChain(_ context.Context, _ currency.Pair) ([]options.Contract, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// GetOptionsGreeks returns the greeks and implied volatility for an option
+// contract
+// this is overridable
+func (b *Base) GetOptionsGreeks(_ context.Context, _ currency.Pair) (*options.Greeks, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// GetMarginInterestRates returns the current daily borrow rates, an empty
+// currency returns all currencies
+// this is overridable
+func (b *Base) GetMarginInterestRates(_ context.Context, _ currency.Code) ([]margin.InterestRate, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// MarginBorrow borrows funds into a margin account
+// this is overridable
+func (b *Base) MarginBorrow(_ context.Context, _ *margin.LoanRequest) (*margin.LoanResponse, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// MarginRepay repays borrowed margin funds
+// this is overridable
+func (b *Base) MarginRepay(_ context.Context, _ *margin.LoanRequest) (*margin.LoanResponse, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// SubmitMarginLendOffer offers funds to the exchange's lending market
+// this is overridable
+func (b *Base) SubmitMarginLendOffer(_ context.Context, _ *margin.LendOffer) (*margin.LoanResponse, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// CancelMarginLendOffer cancels an open lending offer
+// this is overridable
+func (b *Base) CancelMarginLendOffer(_ context.Context, _ currency.Code, _ string) error {
+	return common.ErrNotYetImplemented
+}
+
+// GetMarginLoans returns open lending offers and active borrowed and lent
+// loans, an empty currency returns all currencies
+// this is overridable
+func (b *Base) GetMarginLoans(_ context.Context, _ currency.Code) ([]margin.Loan, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// GetMarginInterestHistory returns interest paid on borrowed funds and earned
+// on lent funds
+// this is overridable
+func (b *Base) GetMarginInterestHistory(_ context.Context, _ currency.Code, _, _ time.Time) ([]margin.InterestPayment, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// UpdateFeeSchedule retrieves the maker and taker commissions, volume tiers,
+// token discounts and transfer fees for the account and loads them into the
+// fee definitions
+// this is overridable
+func (b *Base) UpdateFeeSchedule(_ context.Context) error {
+	return common.ErrNotYetImplemented
+}
+
+// GetFeeSchedule returns the loaded fee schedule
+func (b *Base) GetFeeSchedule() (*fee.Schedule, error) {
+	return b.Fees.GetSchedule()
+}
+
+// GetAvailableTransferChains returns the networks a currency can be deposited
+// to or withdrawn from, including their fees and limits
+// this is overridable
+func (b *Base) GetAvailableTransferChains(_ context.Context, _ currency.Code) ([]deposit.Network, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// SubmitBatchOrders submits multiple orders in a single request, results are
+// returned in the same sequence as the orders supplied
+// this is overridable
+func (b *Base) SubmitBatchOrders(_ context.Context, _ []*order.Submit) (order.SubmitBatchResponse, error) {
+	return order.SubmitBatchResponse{}, common.ErrNotYetImplemented
+}
+
+// ModifyBatchOrders amends multiple orders in a single request, results are
+// returned in the same sequence as the amendments supplied
+// this is overridable
+func (b *Base) ModifyBatchOrders(_ context.Context, _ []order.Modify) (order.ModifyBatchResponse, error) {
+	return order.ModifyBatchResponse{}, common.ErrNotYetImplemented
+}
+
+// SetDeadMansSwitch arms or refreshes the exchange's server side timer which
+// cancels all open orders for the asset when it is not refreshed within the
+// timeout, a zero timeout disarms the timer
+// this is overridable
+func (b *Base) SetDeadMansSwitch(_ context.Context, _ asset.Item, _ time.Duration) error {
+	return common.ErrNotYetImplemented
+}
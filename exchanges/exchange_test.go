@@ -6,6 +6,7 @@ import (
 	"net"
 	"net/http"
 	"os"
+	"reflect"
 	"strings"
 	"testing"
 	"time"
@@ -14,8 +15,14 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common/convert"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/capability"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fee"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
@@ -28,6 +35,8 @@ const (
 	defaultTestCurrencyPair = "BTC-USD"
 )
 
+var errTest = errors.New("test error")
+
 func TestMain(m *testing.M) {
 	c := log.GenDefaultSettings()
 	log.RWM.Lock()
@@ -1798,6 +1807,122 @@ func TestGetBase(t *testing.T) {
 	}
 }
 
+func TestGetCapabilities(t *testing.T) {
+	t.Parallel()
+	b := Base{
+		Name: "Bitfinex",
+		CurrencyPairs: currency.PairsManager{
+			Pairs: map[asset.Item]*currency.PairStore{
+				asset.Spot: {},
+			},
+		},
+		Features: Features{
+			Supports: FeaturesSupported{
+				REST: true,
+				RESTCapabilities: protocol.Features{
+					DeadMansSwitch: true,
+				},
+				Orders: capability.Orders{
+					Types: []order.Type{order.Limit},
+				},
+			},
+			Enabled: FeaturesEnabled{
+				Kline: kline.ExchangeCapabilitiesEnabled{
+					Intervals: map[string]bool{kline.OneHour.Word(): true},
+				},
+			},
+		},
+		Websocket: &stream.Websocket{
+			GenerateSubs: func() ([]stream.ChannelSubscription, error) {
+				return []stream.ChannelSubscription{
+					{Channel: "trades"},
+					{Channel: "book"},
+					{Channel: "trades"},
+				}, nil
+			},
+		},
+	}
+	d, err := b.GetCapabilities()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if d.Exchange != "Bitfinex" || !d.REST || d.Websocket || !d.DeadMansSwitch {
+		t.Errorf("unexpected descriptor: %+v", d)
+	}
+	if !d.SupportsAsset(asset.Spot) || d.SupportsAsset(asset.Futures) {
+		t.Error("expected only spot to be supported")
+	}
+	if len(d.Intervals) != 1 || !d.SupportsInterval(kline.OneHour) {
+		t.Errorf("unexpected intervals: %v", d.Intervals)
+	}
+	if len(d.WebsocketChannels) != 2 || d.WebsocketChannels[0] != "book" {
+		t.Errorf("unexpected websocket channels: %v", d.WebsocketChannels)
+	}
+	if !d.SupportsMethod("SubmitOrder") {
+		t.Error("expected SubmitOrder to be supported")
+	}
+	for _, method := range unsupportedMethods["bitfinex"] {
+		if d.SupportsMethod(method) {
+			t.Errorf("expected %s to not be supported", method)
+		}
+	}
+	d.Orders.Types[0] = order.Market
+	if b.Features.Supports.Orders.Types[0] != order.Limit {
+		t.Error("descriptor should not share order types with the exchange")
+	}
+
+	b.Name = "unknown"
+	d, err = b.GetCapabilities()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if len(d.Methods) != reflect.TypeOf((*IBotExchange)(nil)).Elem().NumMethod() {
+		t.Error("expected ungenerated exchanges to report every method")
+	}
+
+	b.Websocket.GenerateSubs = func() ([]stream.ChannelSubscription, error) {
+		return nil, errTest
+	}
+	_, err = b.GetCapabilities()
+	if !errors.Is(err, errTest) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errTest)
+	}
+}
+
+func TestCheckOrderCapabilities(t *testing.T) {
+	t.Parallel()
+	b := Base{
+		Name: "Bitfinex",
+		CurrencyPairs: currency.PairsManager{
+			Pairs: map[asset.Item]*currency.PairStore{
+				asset.Spot: {},
+			},
+		},
+		Features: Features{
+			Supports: FeaturesSupported{
+				Orders: capability.Orders{
+					Types: []order.Type{order.Limit},
+				},
+			},
+		},
+		Websocket: &stream.Websocket{
+			GenerateSubs: func() ([]stream.ChannelSubscription, error) {
+				return nil, errTest
+			},
+		},
+	}
+	s := &order.Submit{AssetType: asset.Spot, Type: order.Limit}
+	err := b.CheckOrderCapabilities(s)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	s.Type = order.Market
+	err = b.CheckOrderCapabilities(s)
+	if !errors.Is(err, capability.ErrOrderTypeNotSupported) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, capability.ErrOrderTypeNotSupported)
+	}
+}
+
 func TestGetAssetType(t *testing.T) {
 	var b Base
 	p := currency.NewPair(currency.BTC, currency.USD)
@@ -2446,3 +2571,155 @@ func TestGetGetURLTypeFromString(t *testing.T) {
 		})
 	}
 }
+
+func TestFuturesMarketDataDefaults(t *testing.T) {
+	t.Parallel()
+	var b Base
+	ctx := context.Background()
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	_, err := b.GetLatestFundingRate(ctx, asset.PerpetualSwap, p)
+	if !errors.Is(err, common.ErrNotYetImplemented) {
+		t.Fatalf("received: %v but expected: %v", err, common.ErrNotYetImplemented)
+	}
+	_, err = b.GetFundingRateHistory(ctx, asset.PerpetualSwap, p, time.Time{}, time.Time{})
+	if !errors.Is(err, common.ErrNotYetImplemented) {
+		t.Fatalf("received: %v but expected: %v", err, common.ErrNotYetImplemented)
+	}
+	_, err = b.GetFuturesOpenInterest(ctx, asset.PerpetualSwap, p)
+	if !errors.Is(err, common.ErrNotYetImplemented) {
+		t.Fatalf("received: %v but expected: %v", err, common.ErrNotYetImplemented)
+	}
+	_, err = b.GetFuturesMarkPrice(ctx, asset.PerpetualSwap, p)
+	if !errors.Is(err, common.ErrNotYetImplemented) {
+		t.Fatalf("received: %v but expected: %v", err, common.ErrNotYetImplemented)
+	}
+}
+
+func TestFuturesPositionManagementDefaults(t *testing.T) {
+	t.Parallel()
+	var b Base
+	ctx := context.Background()
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	_, err := b.GetFuturesPositions(ctx, asset.PerpetualSwap, p)
+	if !errors.Is(err, common.ErrNotYetImplemented) {
+		t.Fatalf("received: %v but expected: %v", err, common.ErrNotYetImplemented)
+	}
+	err = b.ChangeFuturesLeverage(ctx, asset.PerpetualSwap, p, 10)
+	if !errors.Is(err, common.ErrNotYetImplemented) {
+		t.Fatalf("received: %v but expected: %v", err, common.ErrNotYetImplemented)
+	}
+	err = b.ChangeFuturesMarginType(ctx, asset.PerpetualSwap, p, futures.Isolated)
+	if !errors.Is(err, common.ErrNotYetImplemented) {
+		t.Fatalf("received: %v but expected: %v", err, common.ErrNotYetImplemented)
+	}
+	_, err = b.GetFuturesMarginInfo(ctx, asset.PerpetualSwap, p)
+	if !errors.Is(err, common.ErrNotYetImplemented) {
+		t.Fatalf("received: %v but expected: %v", err, common.ErrNotYetImplemented)
+	}
+}
+
+func TestTransferFunds(t *testing.T) {
+	t.Parallel()
+	var b Base
+	_, err := b.TransferFunds(context.Background(), &account.TransferRequest{})
+	if !errors.Is(err, common.ErrNotYetImplemented) {
+		t.Fatalf("received: %v but expected: %v", err, common.ErrNotYetImplemented)
+	}
+}
+
+func TestBatchOrders(t *testing.T) {
+	t.Parallel()
+	var b Base
+	_, err := b.SubmitBatchOrders(context.Background(), []*order.Submit{{}})
+	if !errors.Is(err, common.ErrNotYetImplemented) {
+		t.Fatalf("received: %v but expected: %v", err, common.ErrNotYetImplemented)
+	}
+	_, err = b.ModifyBatchOrders(context.Background(), []order.Modify{{}})
+	if !errors.Is(err, common.ErrNotYetImplemented) {
+		t.Fatalf("received: %v but expected: %v", err, common.ErrNotYetImplemented)
+	}
+}
+
+func TestSetDeadMansSwitch(t *testing.T) {
+	t.Parallel()
+	var b Base
+	err := b.SetDeadMansSwitch(context.Background(), asset.Spot, time.Minute)
+	if !errors.Is(err, common.ErrNotYetImplemented) {
+		t.Fatalf("received: %v but expected: %v", err, common.ErrNotYetImplemented)
+	}
+}
+
+func TestOptionsMarketDataDefaults(t *testing.T) {
+	t.Parallel()
+	var b Base
+	_, err := b.GetOptionsChain(context.Background(), currency.NewPair(currency.BTC, currency.USD))
+	if !errors.Is(err, common.ErrNotYetImplemented) {
+		t.Fatalf("received: %v but expected: %v", err, common.ErrNotYetImplemented)
+	}
+	_, err = b.GetOptionsGreeks(context.Background(), currency.NewPair(currency.BTC, currency.USD))
+	if !errors.Is(err, common.ErrNotYetImplemented) {
+		t.Fatalf("received: %v but expected: %v", err, common.ErrNotYetImplemented)
+	}
+}
+
+func TestMarginLendingDefaults(t *testing.T) {
+	t.Parallel()
+	var b Base
+	_, err := b.GetMarginInterestRates(context.Background(), currency.BTC)
+	if !errors.Is(err, common.ErrNotYetImplemented) {
+		t.Fatalf("received: %v but expected: %v", err, common.ErrNotYetImplemented)
+	}
+	_, err = b.MarginBorrow(context.Background(), &margin.LoanRequest{})
+	if !errors.Is(err, common.ErrNotYetImplemented) {
+		t.Fatalf("received: %v but expected: %v", err, common.ErrNotYetImplemented)
+	}
+	_, err = b.MarginRepay(context.Background(), &margin.LoanRequest{})
+	if !errors.Is(err, common.ErrNotYetImplemented) {
+		t.Fatalf("received: %v but expected: %v", err, common.ErrNotYetImplemented)
+	}
+	_, err = b.SubmitMarginLendOffer(context.Background(), &margin.LendOffer{})
+	if !errors.Is(err, common.ErrNotYetImplemented) {
+		t.Fatalf("received: %v but expected: %v", err, common.ErrNotYetImplemented)
+	}
+	err = b.CancelMarginLendOffer(context.Background(), currency.BTC, "1")
+	if !errors.Is(err, common.ErrNotYetImplemented) {
+		t.Fatalf("received: %v but expected: %v", err, common.ErrNotYetImplemented)
+	}
+	_, err = b.GetMarginLoans(context.Background(), currency.BTC)
+	if !errors.Is(err, common.ErrNotYetImplemented) {
+		t.Fatalf("received: %v but expected: %v", err, common.ErrNotYetImplemented)
+	}
+	_, err = b.GetMarginInterestHistory(context.Background(), currency.BTC, time.Time{}, time.Time{})
+	if !errors.Is(err, common.ErrNotYetImplemented) {
+		t.Fatalf("received: %v but expected: %v", err, common.ErrNotYetImplemented)
+	}
+}
+
+func TestFeeScheduleDefaults(t *testing.T) {
+	t.Parallel()
+	var b Base
+	err := b.UpdateFeeSchedule(context.Background())
+	if !errors.Is(err, common.ErrNotYetImplemented) {
+		t.Fatalf("received: %v but expected: %v", err, common.ErrNotYetImplemented)
+	}
+	_, err = b.GetFeeSchedule()
+	if err == nil {
+		t.Fatal("expected error when fee definitions are unset")
+	}
+	b.Fees = fee.NewDefinitions()
+	_, err = b.GetFeeSchedule()
+	if !errors.Is(err, fee.ErrScheduleNotLoaded) {
+		t.Fatalf("received: %v but expected: %v", err, fee.ErrScheduleNotLoaded)
+	}
+	err = b.Fees.Load(&fee.Schedule{Exchange: "test"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s, err := b.GetFeeSchedule()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.Exchange != "test" {
+		t.Errorf("received %v expected test", s.Exchange)
+	}
+}
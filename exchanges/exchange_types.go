@@ -7,7 +7,9 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/capability"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/currencystate"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fee"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
@@ -167,6 +169,7 @@ type FeaturesSupported struct {
 	WebsocketCapabilities protocol.Features
 	WithdrawPermissions   uint32
 	Kline                 kline.ExchangeCapabilitiesSupported
+	Orders                capability.Orders
 }
 
 // Endpoints stores running url endpoints for exchanges
@@ -233,6 +236,8 @@ type Base struct {
 
 	AssetWebsocketSupport
 	*currencystate.States
+	// Fees holds the fee schedule loaded by UpdateFeeSchedule
+	Fees *fee.Definitions
 }
 
 // url lookup consts
@@ -428,12 +428,12 @@ func TestWithdrawInternationalBank(t *testing.T) {
 
 func TestGetDepositAddress(t *testing.T) {
 	if areTestAPIKeysSet() {
-		_, err := e.GetDepositAddress(context.Background(), currency.LTC, "")
+		_, err := e.GetDepositAddress(context.Background(), currency.LTC, "", "")
 		if err != nil {
 			t.Error("GetDepositAddress() error", err)
 		}
 	} else {
-		_, err := e.GetDepositAddress(context.Background(), currency.LTC, "")
+		_, err := e.GetDepositAddress(context.Background(), currency.LTC, "", "")
 		if err == nil {
 			t.Error("GetDepositAddress() error cannot be nil")
 		}
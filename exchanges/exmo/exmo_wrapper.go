@@ -16,6 +16,7 @@ import (
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/deposit"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
@@ -525,19 +526,19 @@ func (e *EXMO) GetOrderInfo(ctx context.Context, orderID string, pair currency.P
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (e *EXMO) GetDepositAddress(ctx context.Context, cryptocurrency currency.Code, _ string) (string, error) {
+func (e *EXMO) GetDepositAddress(ctx context.Context, cryptocurrency currency.Code, _, _ string) (*deposit.Address, error) {
 	fullAddr, err := e.GetCryptoDepositAddress(ctx)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	// TODO: Protect map with mutex
 	addr, ok := fullAddr[cryptocurrency.String()]
 	if !ok {
-		return "", fmt.Errorf("currency %s could not be found, please generate via the exmo website", cryptocurrency.String())
+		return nil, fmt.Errorf("currency %s could not be found, please generate via the exmo website", cryptocurrency.String())
 	}
 
-	return addr, nil
+	return &deposit.Address{Address: addr}, nil
 }
 
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
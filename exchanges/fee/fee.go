@@ -0,0 +1,252 @@
+package fee
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+// NewDefinitions returns an empty set of fee definitions
+func NewDefinitions() *Definitions {
+	return &Definitions{}
+}
+
+// Validate checks the rates in a fee schedule
+func (s *Schedule) Validate() error {
+	if s == nil {
+		return errNilSchedule
+	}
+	for a, c := range s.Commissions {
+		if err := c.validate(); err != nil {
+			return fmt.Errorf("%v %w", a, err)
+		}
+	}
+	for i := range s.PairCommissions {
+		if s.PairCommissions[i].Asset == "" {
+			return fmt.Errorf("%v %w", s.PairCommissions[i].Pair, errAssetNotSet)
+		}
+		if err := s.PairCommissions[i].validate(); err != nil {
+			return fmt.Errorf("%v %v %w",
+				s.PairCommissions[i].Asset,
+				s.PairCommissions[i].Pair,
+				err)
+		}
+	}
+	for i := range s.Tiers {
+		if err := s.Tiers[i].validate(); err != nil {
+			return fmt.Errorf("tier %v %w", s.Tiers[i].Name, err)
+		}
+	}
+	if s.Discount.Rate < 0 || s.Discount.Rate > 1 {
+		return errInvalidDiscount
+	}
+	for i := range s.Transfers {
+		if s.Transfers[i].Currency.IsEmpty() {
+			return errCurrencyIsEmpty
+		}
+		if s.Transfers[i].Deposit < 0 || s.Transfers[i].Withdrawal < 0 {
+			return fmt.Errorf("%v %w", s.Transfers[i].Currency, errInvalidTransferFee)
+		}
+	}
+	return nil
+}
+
+func (c Commission) validate() error {
+	if c.Maker <= -1 || c.Maker >= 1 {
+		return errInvalidMakerRate
+	}
+	if c.Taker < 0 || c.Taker >= 1 {
+		return errInvalidTakerRate
+	}
+	return nil
+}
+
+// GetTierForVolume returns the highest tier for an asset which the thirty day
+// volume qualifies for
+func (s *Schedule) GetTierForVolume(a asset.Item, volume float64) (Tier, error) {
+	if s == nil {
+		return Tier{}, errNilSchedule
+	}
+	var (
+		found bool
+		tier  Tier
+	)
+	for i := range s.Tiers {
+		if s.Tiers[i].Asset != a || volume < s.Tiers[i].MinimumVolume {
+			continue
+		}
+		if !found || s.Tiers[i].MinimumVolume > tier.MinimumVolume {
+			tier = s.Tiers[i]
+			found = true
+		}
+	}
+	if !found {
+		return Tier{}, fmt.Errorf("%v %w", a, ErrCommissionNotFound)
+	}
+	return tier, nil
+}
+
+// Load validates and stores a fee schedule, replacing any previously loaded
+// schedule
+func (d *Definitions) Load(s *Schedule) error {
+	if d == nil {
+		return errNilDefinitions
+	}
+	if err := s.Validate(); err != nil {
+		return err
+	}
+
+	cpy := s.copy()
+	if cpy.LastUpdated.IsZero() {
+		cpy.LastUpdated = time.Now()
+	}
+
+	pairs := make(map[asset.Item]map[*currency.Item]map[*currency.Item]Commission)
+	for i := range cpy.PairCommissions {
+		pc := &cpy.PairCommissions[i]
+		m1, ok := pairs[pc.Asset]
+		if !ok {
+			m1 = make(map[*currency.Item]map[*currency.Item]Commission)
+			pairs[pc.Asset] = m1
+		}
+		m2, ok := m1[pc.Pair.Base.Item]
+		if !ok {
+			m2 = make(map[*currency.Item]Commission)
+			m1[pc.Pair.Base.Item] = m2
+		}
+		m2[pc.Pair.Quote.Item] = pc.Commission
+	}
+
+	transfers := make(map[*currency.Item]map[string]Transfer)
+	for i := range cpy.Transfers {
+		m1, ok := transfers[cpy.Transfers[i].Currency.Item]
+		if !ok {
+			m1 = make(map[string]Transfer)
+			transfers[cpy.Transfers[i].Currency.Item] = m1
+		}
+		m1[strings.ToUpper(cpy.Transfers[i].Network)] = cpy.Transfers[i]
+	}
+
+	d.mtx.Lock()
+	d.schedule = cpy
+	d.pairs = pairs
+	d.transfers = transfers
+	d.mtx.Unlock()
+	return nil
+}
+
+// IsLoaded returns whether a fee schedule has been loaded
+func (d *Definitions) IsLoaded() bool {
+	if d == nil {
+		return false
+	}
+	d.mtx.RLock()
+	defer d.mtx.RUnlock()
+	return d.schedule != nil
+}
+
+// GetSchedule returns a copy of the loaded fee schedule
+func (d *Definitions) GetSchedule() (*Schedule, error) {
+	if d == nil {
+		return nil, errNilDefinitions
+	}
+	d.mtx.RLock()
+	defer d.mtx.RUnlock()
+	if d.schedule == nil {
+		return nil, ErrScheduleNotLoaded
+	}
+	return d.schedule.copy(), nil
+}
+
+// GetCommission returns the commission for a pair, falling back to the asset
+// commission when the pair has no override
+func (d *Definitions) GetCommission(a asset.Item, p currency.Pair) (Commission, error) {
+	if d == nil {
+		return Commission{}, errNilDefinitions
+	}
+	d.mtx.RLock()
+	defer d.mtx.RUnlock()
+	if d.schedule == nil {
+		return Commission{}, ErrScheduleNotLoaded
+	}
+	if c, ok := d.pairs[a][p.Base.Item][p.Quote.Item]; ok {
+		return c, nil
+	}
+	if c, ok := d.schedule.Commissions[a]; ok {
+		return c, nil
+	}
+	return Commission{}, fmt.Errorf("%v %v %w", a, p, ErrCommissionNotFound)
+}
+
+// CalculateTradingFee returns the fee in quote currency for trading an amount
+// of a pair at a price, including any enabled token discount
+func (d *Definitions) CalculateTradingFee(a asset.Item, p currency.Pair, price, amount float64, isMaker bool) (float64, error) {
+	if price <= 0 || amount <= 0 {
+		return 0, errInvalidAmount
+	}
+	c, err := d.GetCommission(a, p)
+	if err != nil {
+		return 0, err
+	}
+	rate := c.Taker
+	if isMaker {
+		rate = c.Maker
+	}
+	d.mtx.RLock()
+	if d.schedule.Discount.Enabled {
+		rate *= 1 - d.schedule.Discount.Rate
+	}
+	d.mtx.RUnlock()
+	return rate * price * amount, nil
+}
+
+// GetTransfer returns the deposit and withdrawal fees for a currency on a
+// network, falling back to the entry without a network
+func (d *Definitions) GetTransfer(c currency.Code, network string) (Transfer, error) {
+	if d == nil {
+		return Transfer{}, errNilDefinitions
+	}
+	d.mtx.RLock()
+	defer d.mtx.RUnlock()
+	if d.schedule == nil {
+		return Transfer{}, ErrScheduleNotLoaded
+	}
+	m1, ok := d.transfers[c.Item]
+	if ok {
+		if t, ok := m1[strings.ToUpper(network)]; ok {
+			return t, nil
+		}
+		if t, ok := m1[""]; ok {
+			return t, nil
+		}
+	}
+	if network != "" {
+		return Transfer{}, fmt.Errorf("%v %v %w", c, network, ErrTransferNotFound)
+	}
+	return Transfer{}, fmt.Errorf("%v %w", c, ErrTransferNotFound)
+}
+
+// copy returns a deep copy of the schedule
+func (s *Schedule) copy() *Schedule {
+	cpy := *s
+	if s.Commissions != nil {
+		cpy.Commissions = make(map[asset.Item]Commission, len(s.Commissions))
+		for k, v := range s.Commissions {
+			cpy.Commissions[k] = v
+		}
+	}
+	cpy.PairCommissions = append([]PairCommission(nil), s.PairCommissions...)
+	cpy.Tiers = append([]Tier(nil), s.Tiers...)
+	sort.SliceStable(cpy.Tiers, func(i, j int) bool {
+		if cpy.Tiers[i].Asset != cpy.Tiers[j].Asset {
+			return cpy.Tiers[i].Asset < cpy.Tiers[j].Asset
+		}
+		return cpy.Tiers[i].MinimumVolume < cpy.Tiers[j].MinimumVolume
+	})
+	cpy.Transfers = append([]Transfer(nil), s.Transfers...)
+	return &cpy
+}
@@ -0,0 +1,264 @@
+package fee
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+func testSchedule() *Schedule {
+	return &Schedule{
+		Exchange: "test",
+		Tier:     "VIP0",
+		Commissions: map[asset.Item]Commission{
+			asset.Spot: {Maker: 0.001, Taker: 0.002},
+		},
+		PairCommissions: []PairCommission{
+			{
+				Asset:      asset.Spot,
+				Pair:       currency.NewPair(currency.BTC, currency.USDT),
+				Commission: Commission{Maker: -0.0001, Taker: 0.0005},
+			},
+		},
+		Tiers: []Tier{
+			{Name: "VIP1", Asset: asset.Spot, MinimumVolume: 50, Commission: Commission{Maker: 0.0009, Taker: 0.001}},
+			{Name: "VIP0", Asset: asset.Spot, Commission: Commission{Maker: 0.001, Taker: 0.001}},
+		},
+		Discount: Discount{Currency: currency.BNB, Rate: 0.25},
+		Transfers: []Transfer{
+			{Currency: currency.BTC, Withdrawal: 0.0005},
+			{Currency: currency.USDT, Network: "TRC20", Withdrawal: 1},
+		},
+	}
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+	var s *Schedule
+	if err := s.Validate(); !errors.Is(err, errNilSchedule) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errNilSchedule)
+	}
+
+	s = testSchedule()
+	if err := s.Validate(); err != nil {
+		t.Fatal(err)
+	}
+
+	s.Commissions[asset.Spot] = Commission{Maker: -1}
+	if err := s.Validate(); !errors.Is(err, errInvalidMakerRate) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errInvalidMakerRate)
+	}
+
+	s = testSchedule()
+	s.PairCommissions[0].Taker = -0.1
+	if err := s.Validate(); !errors.Is(err, errInvalidTakerRate) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errInvalidTakerRate)
+	}
+
+	s = testSchedule()
+	s.PairCommissions[0].Asset = ""
+	if err := s.Validate(); !errors.Is(err, errAssetNotSet) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errAssetNotSet)
+	}
+
+	s = testSchedule()
+	s.Tiers[0].Taker = 1
+	if err := s.Validate(); !errors.Is(err, errInvalidTakerRate) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errInvalidTakerRate)
+	}
+
+	s = testSchedule()
+	s.Discount.Rate = 1.5
+	if err := s.Validate(); !errors.Is(err, errInvalidDiscount) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errInvalidDiscount)
+	}
+
+	s = testSchedule()
+	s.Transfers[0].Currency = currency.Code{}
+	if err := s.Validate(); !errors.Is(err, errCurrencyIsEmpty) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errCurrencyIsEmpty)
+	}
+
+	s = testSchedule()
+	s.Transfers[0].Withdrawal = -1
+	if err := s.Validate(); !errors.Is(err, errInvalidTransferFee) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errInvalidTransferFee)
+	}
+}
+
+func TestLoadAndGetSchedule(t *testing.T) {
+	t.Parallel()
+	var d *Definitions
+	if err := d.Load(testSchedule()); !errors.Is(err, errNilDefinitions) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errNilDefinitions)
+	}
+	if d.IsLoaded() {
+		t.Fatal("expected nil definitions to not be loaded")
+	}
+	if _, err := d.GetSchedule(); !errors.Is(err, errNilDefinitions) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errNilDefinitions)
+	}
+
+	d = NewDefinitions()
+	if _, err := d.GetSchedule(); !errors.Is(err, ErrScheduleNotLoaded) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrScheduleNotLoaded)
+	}
+
+	s := testSchedule()
+	if err := d.Load(s); err != nil {
+		t.Fatal(err)
+	}
+	if !d.IsLoaded() {
+		t.Fatal("expected schedule to be loaded")
+	}
+
+	// Changes to the loaded schedule must not alter the stored copy
+	s.Commissions[asset.Spot] = Commission{Maker: 0.5, Taker: 0.5}
+	s.Tiers[0].Name = "changed"
+
+	got, err := d.GetSchedule()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Commissions[asset.Spot].Taker != 0.002 {
+		t.Errorf("received '%v' expected '0.002'", got.Commissions[asset.Spot].Taker)
+	}
+	if got.LastUpdated.IsZero() {
+		t.Error("expected last updated to be set")
+	}
+	if got.Tiers[0].Name != "VIP0" || got.Tiers[1].Name != "VIP1" {
+		t.Errorf("expected tiers to be sorted by volume, received %+v", got.Tiers)
+	}
+}
+
+func TestGetCommission(t *testing.T) {
+	t.Parallel()
+	d := NewDefinitions()
+	btcusdt := currency.NewPair(currency.BTC, currency.USDT)
+	if _, err := d.GetCommission(asset.Spot, btcusdt); !errors.Is(err, ErrScheduleNotLoaded) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrScheduleNotLoaded)
+	}
+	if err := d.Load(testSchedule()); err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := d.GetCommission(asset.Spot, btcusdt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Maker != -0.0001 || c.Taker != 0.0005 {
+		t.Errorf("expected pair override, received %+v", c)
+	}
+
+	c, err = d.GetCommission(asset.Spot, currency.NewPair(currency.ETH, currency.USDT))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Maker != 0.001 || c.Taker != 0.002 {
+		t.Errorf("expected asset commission, received %+v", c)
+	}
+
+	_, err = d.GetCommission(asset.Margin, btcusdt)
+	if !errors.Is(err, ErrCommissionNotFound) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrCommissionNotFound)
+	}
+}
+
+func TestCalculateTradingFee(t *testing.T) {
+	t.Parallel()
+	d := NewDefinitions()
+	pair := currency.NewPair(currency.ETH, currency.USDT)
+	_, err := d.CalculateTradingFee(asset.Spot, pair, 0, 1, false)
+	if !errors.Is(err, errInvalidAmount) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errInvalidAmount)
+	}
+	s := testSchedule()
+	if err = d.Load(s); err != nil {
+		t.Fatal(err)
+	}
+
+	f, err := d.CalculateTradingFee(asset.Spot, pair, 100, 2, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f != 0.4 {
+		t.Errorf("received '%v' expected '0.4'", f)
+	}
+
+	s.Discount.Enabled = true
+	if err = d.Load(s); err != nil {
+		t.Fatal(err)
+	}
+	f, err = d.CalculateTradingFee(asset.Spot, pair, 100, 2, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f != 0.15 {
+		t.Errorf("received '%v' expected '0.15'", f)
+	}
+}
+
+func TestGetTransfer(t *testing.T) {
+	t.Parallel()
+	d := NewDefinitions()
+	if _, err := d.GetTransfer(currency.BTC, ""); !errors.Is(err, ErrScheduleNotLoaded) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrScheduleNotLoaded)
+	}
+	if err := d.Load(testSchedule()); err != nil {
+		t.Fatal(err)
+	}
+
+	tr, err := d.GetTransfer(currency.BTC, "BTC")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tr.Withdrawal != 0.0005 {
+		t.Errorf("received '%v' expected '0.0005'", tr.Withdrawal)
+	}
+
+	tr, err = d.GetTransfer(currency.USDT, "trc20")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tr.Withdrawal != 1 {
+		t.Errorf("received '%v' expected '1'", tr.Withdrawal)
+	}
+
+	_, err = d.GetTransfer(currency.USDT, "ERC20")
+	if !errors.Is(err, ErrTransferNotFound) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrTransferNotFound)
+	}
+	_, err = d.GetTransfer(currency.ETH, "")
+	if !errors.Is(err, ErrTransferNotFound) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrTransferNotFound)
+	}
+}
+
+func TestGetTierForVolume(t *testing.T) {
+	t.Parallel()
+	var s *Schedule
+	if _, err := s.GetTierForVolume(asset.Spot, 0); !errors.Is(err, errNilSchedule) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errNilSchedule)
+	}
+	s = testSchedule()
+	tier, err := s.GetTierForVolume(asset.Spot, 10)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tier.Name != "VIP0" {
+		t.Errorf("received '%v' expected 'VIP0'", tier.Name)
+	}
+	tier, err = s.GetTierForVolume(asset.Spot, 51)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tier.Name != "VIP1" {
+		t.Errorf("received '%v' expected 'VIP1'", tier.Name)
+	}
+	_, err = s.GetTierForVolume(asset.Futures, 51)
+	if !errors.Is(err, ErrCommissionNotFound) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrCommissionNotFound)
+	}
+}
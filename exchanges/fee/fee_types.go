@@ -0,0 +1,105 @@
+package fee
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+var (
+	// ErrScheduleNotLoaded is returned when a fee schedule has not yet been
+	// loaded for an exchange
+	ErrScheduleNotLoaded = errors.New("fee schedule not loaded")
+	// ErrCommissionNotFound is returned when no commission is defined for an
+	// asset or pair
+	ErrCommissionNotFound = errors.New("commission not found")
+	// ErrTransferNotFound is returned when no deposit or withdrawal fee is
+	// defined for a currency
+	ErrTransferNotFound = errors.New("transfer fee not found")
+
+	errNilDefinitions     = errors.New("fee definitions are nil")
+	errNilSchedule        = errors.New("fee schedule is nil")
+	errInvalidMakerRate   = errors.New("maker rate must be greater than -1 and less than 1")
+	errInvalidTakerRate   = errors.New("taker rate must be greater than or equal to 0 and less than 1")
+	errInvalidDiscount    = errors.New("discount rate must be between 0 and 1")
+	errInvalidTransferFee = errors.New("transfer fees cannot be negative")
+	errAssetNotSet        = errors.New("asset not set")
+	errCurrencyIsEmpty    = errors.New("currency is empty")
+	errInvalidAmount      = errors.New("price and amount must be greater than zero")
+)
+
+// Commission defines maker and taker rates as a fraction of the traded value
+// e.g. 0.001 for 0.1%. A negative maker rate is a rebate.
+type Commission struct {
+	Maker float64
+	Taker float64
+}
+
+// PairCommission overrides the asset commission for a specific pair
+type PairCommission struct {
+	Asset asset.Item
+	Pair  currency.Pair
+	Commission
+}
+
+// Tier defines the commission applied once the thirty day trading volume
+// reaches the minimum volume
+type Tier struct {
+	Name           string
+	Asset          asset.Item
+	MinimumVolume  float64
+	VolumeCurrency currency.Code
+	Commission
+}
+
+// Discount defines a reduction in trading fees when they are paid in an
+// exchange token e.g. BNB on Binance
+type Discount struct {
+	Currency currency.Code
+	// Rate is the fraction taken off the commission e.g. 0.25 for 25%
+	Rate    float64
+	Enabled bool
+}
+
+// Transfer defines the deposit and withdrawal fees for a currency on a
+// network. An empty network applies to all networks without an explicit
+// entry.
+type Transfer struct {
+	Currency   currency.Code
+	Network    string
+	Deposit    float64
+	Withdrawal float64
+	// IsPercentage defines the fees as a fraction of the transferred amount
+	// instead of a fixed amount of the currency
+	IsPercentage bool
+}
+
+// Schedule is the full fee model for an exchange account
+type Schedule struct {
+	Exchange string
+	// Tier is the current account tier e.g. VIP1
+	Tier            string
+	ThirtyDayVolume float64
+	VolumeCurrency  currency.Code
+	Commissions     map[asset.Item]Commission
+	PairCommissions []PairCommission
+	Tiers           []Tier
+	Discount        Discount
+	Transfers       []Transfer
+	// Authenticated is set when the schedule was retrieved from the account
+	// rather than from public or default values
+	Authenticated bool
+	LastUpdated   time.Time
+}
+
+// Definitions holds the loaded fee schedule for an exchange and indexes it
+// for lookups
+type Definitions struct {
+	schedule  *Schedule
+	pairs     map[asset.Item]map[*currency.Item]map[*currency.Item]Commission
+	transfers map[*currency.Item]map[string]Transfer
+	mtx       sync.RWMutex
+}
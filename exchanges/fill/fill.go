@@ -0,0 +1,80 @@
+package fill
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+)
+
+func init() {
+	service = new(Service)
+	service.exchanges = make(map[string]uuid.UUID)
+	service.mux = dispatch.GetNewMux()
+}
+
+// SubscribeToExchangeFills subscribes to own trade executions for an
+// exchange, the subscription can be made before any fill is received
+func SubscribeToExchangeFills(exchange string) (dispatch.Pipe, error) {
+	if exchange == "" {
+		return dispatch.Pipe{}, errExchangeNameUnset
+	}
+	id, err := service.getID(exchange)
+	if err != nil {
+		return dispatch.Pipe{}, err
+	}
+	return service.mux.Subscribe(id)
+}
+
+// Process validates and publishes own trade executions to any subscribers,
+// each fill is published individually
+func Process(fills ...Data) error {
+	if len(fills) == 0 {
+		return errNoFills
+	}
+	for i := range fills {
+		if err := fills[i].Validate(); err != nil {
+			return err
+		}
+	}
+	for i := range fills {
+		id, err := service.getID(fills[i].Exchange)
+		if err != nil {
+			return err
+		}
+		err = service.mux.Publish([]uuid.UUID{id}, &fills[i])
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Validate checks the fill has the minimum required fields
+func (d *Data) Validate() error {
+	if d.Exchange == "" {
+		return errExchangeNameUnset
+	}
+	if d.OrderID == "" {
+		return fmt.Errorf("%s %w", d.Exchange, errOrderIDUnset)
+	}
+	return nil
+}
+
+// getID returns the dispatch ID for an exchange, creating one when required
+func (s *Service) getID(exchange string) (uuid.UUID, error) {
+	exchange = strings.ToLower(exchange)
+	s.Lock()
+	defer s.Unlock()
+	id, ok := s.exchanges[exchange]
+	if ok {
+		return id, nil
+	}
+	id, err := s.mux.GetID()
+	if err != nil {
+		return uuid.UUID{}, err
+	}
+	s.exchanges[exchange] = id
+	return id, nil
+}
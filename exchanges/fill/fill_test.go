@@ -0,0 +1,100 @@
+package fill
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+func TestMain(m *testing.M) {
+	err := dispatch.Start(dispatch.DefaultMaxWorkers, dispatch.DefaultJobsLimit)
+	if err != nil {
+		panic(err)
+	}
+	m.Run()
+}
+
+func TestSubscribeToExchangeFills(t *testing.T) {
+	t.Parallel()
+	_, err := SubscribeToExchangeFills("")
+	if !errors.Is(err, errExchangeNameUnset) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errExchangeNameUnset)
+	}
+	pipe, err := SubscribeToExchangeFills("subscribe")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	err = pipe.Release()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+}
+
+func TestProcess(t *testing.T) {
+	t.Parallel()
+	err := Process()
+	if !errors.Is(err, errNoFills) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errNoFills)
+	}
+	err = Process(Data{})
+	if !errors.Is(err, errExchangeNameUnset) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errExchangeNameUnset)
+	}
+	err = Process(Data{TradeHistory: order.TradeHistory{Exchange: "Process"}})
+	if !errors.Is(err, errOrderIDUnset) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errOrderIDUnset)
+	}
+
+	pipe, err := SubscribeToExchangeFills("PROCESS")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	defer func() {
+		if err = pipe.Release(); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	fill := Data{
+		TradeHistory: order.TradeHistory{
+			Exchange:  "Process",
+			TID:       "1337",
+			Price:     1000,
+			Amount:    0.5,
+			Side:      order.Buy,
+			Timestamp: time.Now(),
+		},
+		OrderID:   "1",
+		Pair:      currency.NewPair(currency.BTC, currency.USDT),
+		AssetType: asset.Spot,
+	}
+
+	// dispatch drops data when the receiver is not ready within its
+	// handshake timeout so keep publishing until the fill is received
+	timeout := time.After(time.Second * 5)
+	for {
+		err = Process(fill)
+		if !errors.Is(err, nil) {
+			t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+		}
+		select {
+		case data := <-pipe.C:
+			received, ok := (*data.(*interface{})).(Data)
+			if !ok {
+				t.Fatalf("received unexpected type %T", data)
+			}
+			if received.TID != "1337" || received.OrderID != "1" {
+				t.Fatalf("received unexpected fill %+v", received)
+			}
+			return
+		case <-timeout:
+			t.Fatal("timed out waiting for fill")
+		case <-time.After(time.Millisecond * 10):
+		}
+	}
+}
@@ -0,0 +1,38 @@
+package fill
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+var (
+	service *Service
+
+	errNoFills           = errors.New("no fills received")
+	errExchangeNameUnset = errors.New("exchange name unset")
+	errOrderIDUnset      = errors.New("order ID unset")
+)
+
+// Service holds the fill routing information for each individual exchange
+type Service struct {
+	exchanges map[string]uuid.UUID
+	mux       *dispatch.Mux
+	sync.Mutex
+}
+
+// Data defines a single own trade execution received from an exchange. The
+// embedded trade history is the same type returned on an order's trades so a
+// fill can be attached directly to the order it executed against.
+type Data struct {
+	order.TradeHistory
+	OrderID       string
+	ClientOrderID string
+	Pair          currency.Pair
+	AssetType     asset.Item
+}
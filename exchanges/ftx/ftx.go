@@ -127,6 +127,14 @@ const (
 
 	ratePeriod = time.Second
 	rateLimit  = 30
+
+	// fundingRateLimit is the maximum number of funding rates returned per
+	// request
+	fundingRateLimit = 500
+
+	// hoursPerDay converts the hourly lending rates used by FTX to daily
+	// rates
+	hoursPerDay = 24
 )
 
 var (
@@ -139,6 +147,7 @@ var (
 	errSubaccountTransferSourceDestinationMustNotBeEqual = errors.New("subaccount transfer source and destination must not be the same value")
 	errUnrecognisedOrderStatus                           = errors.New("unrecognised order status received")
 	errInvalidOrderAmounts                               = errors.New("filled amount should not exceed order amount")
+	errLendingPeriodNotSupported                         = errors.New("lending offers do not support a fixed period")
 
 	validResolutionData = []int64{15, 60, 300, 900, 3600, 14400, 86400}
 )
@@ -525,12 +534,18 @@ func (f *FTX) GetAllWalletBalances(ctx context.Context) (AllWalletBalances, erro
 	return resp.Data, f.SendAuthHTTPRequest(ctx, exchange.RestSpot, http.MethodGet, getAllWalletBalances, nil, &resp)
 }
 
-// FetchDepositAddress gets deposit address for a given coin
-func (f *FTX) FetchDepositAddress(ctx context.Context, coin currency.Code) (DepositData, error) {
+// FetchDepositAddress gets deposit address for a given coin on the supplied
+// chain, if left empty the coin's default chain is used
+func (f *FTX) FetchDepositAddress(ctx context.Context, coin currency.Code, chain string) (DepositData, error) {
 	resp := struct {
 		Data DepositData `json:"result"`
 	}{}
-	return resp.Data, f.SendAuthHTTPRequest(ctx, exchange.RestSpot, http.MethodGet, getDepositAddress+coin.Upper().String(), nil, &resp)
+	vals := url.Values{}
+	if chain != "" {
+		vals.Set("method", strings.ToLower(chain))
+	}
+	path := common.EncodeURLValues(getDepositAddress+coin.Upper().String(), vals)
+	return resp.Data, f.SendAuthHTTPRequest(ctx, exchange.RestSpot, http.MethodGet, path, nil, &resp)
 }
 
 // FetchDepositHistory gets deposit history
@@ -549,8 +564,9 @@ func (f *FTX) FetchWithdrawalHistory(ctx context.Context) ([]TransactionData, er
 	return resp.Data, f.SendAuthHTTPRequest(ctx, exchange.RestSpot, http.MethodGet, getWithdrawalHistory, nil, &resp)
 }
 
-// Withdraw sends a withdrawal request
-func (f *FTX) Withdraw(ctx context.Context, coin currency.Code, address, tag, password, code string, size float64) (TransactionData, error) {
+// Withdraw sends a withdrawal request on the supplied chain, if left empty the
+// coin's default chain is used
+func (f *FTX) Withdraw(ctx context.Context, coin currency.Code, address, tag, password, code, chain string, size float64) (TransactionData, error) {
 	req := make(map[string]interface{})
 	req["coin"] = coin.Upper().String()
 	req["address"] = address
@@ -564,6 +580,9 @@ func (f *FTX) Withdraw(ctx context.Context, coin currency.Code, address, tag, pa
 	if password != "" {
 		req["password"] = password
 	}
+	if chain != "" {
+		req["method"] = strings.ToLower(chain)
+	}
 	resp := struct {
 		Data TransactionData `json:"result"`
 	}{}
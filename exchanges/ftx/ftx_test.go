@@ -8,12 +8,18 @@ import (
 	"testing"
 	"time"
 
+	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/core"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/mock"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/options"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/sharedtestvalues"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
@@ -435,7 +441,7 @@ func TestFetchDepositAddress(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip()
 	}
-	_, err := f.FetchDepositAddress(context.Background(), currency.NewCode("tUsD"))
+	_, err := f.FetchDepositAddress(context.Background(), currency.NewCode("tUsD"), "")
 	if err != nil {
 		t.Error(err)
 	}
@@ -469,7 +475,7 @@ func TestWithdraw(t *testing.T) {
 		t.Skip("skipping test, either api keys or canManipulateRealOrders isnt set correctly")
 	}
 	_, err := f.Withdraw(context.Background(),
-		currency.NewCode("bTc"), core.BitcoinDonationAddress, "", "", "957378", 0.0009)
+		currency.NewCode("bTc"), core.BitcoinDonationAddress, "", "", "957378", "", 0.0009)
 	if err != nil {
 		t.Error(err)
 	}
@@ -1174,7 +1180,18 @@ func TestGetDepositAddress(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := f.GetDepositAddress(context.Background(), currency.NewCode("FTT"), "")
+	_, err := f.GetDepositAddress(context.Background(), currency.NewCode("FTT"), "", "")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetAvailableTransferChains(t *testing.T) {
+	t.Parallel()
+	if !areTestAPIKeysSet() {
+		t.Skip("API keys required but not set, skipping test")
+	}
+	_, err := f.GetAvailableTransferChains(context.Background(), currency.USDT)
 	if err != nil {
 		t.Error(err)
 	}
@@ -1684,3 +1701,287 @@ func TestUpdateOrderExecutionLimits(t *testing.T) {
 			err)
 	}
 }
+
+func TestGetLatestFundingRate(t *testing.T) {
+	t.Parallel()
+	cp, err := currency.NewPairFromString(futuresPair)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = f.GetLatestFundingRate(context.Background(), asset.Spot, cp)
+	if !errors.Is(err, futures.ErrNotFuturesAsset) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, futures.ErrNotFuturesAsset)
+	}
+	_, err = f.GetLatestFundingRate(context.Background(), asset.Futures, cp)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetFundingRateHistory(t *testing.T) {
+	t.Parallel()
+	cp, err := currency.NewPairFromString(futuresPair)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = f.GetFundingRateHistory(context.Background(), asset.Futures, cp, time.Now().Add(-time.Hour*24*30), time.Now())
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetFuturesOpenInterest(t *testing.T) {
+	t.Parallel()
+	cp, err := currency.NewPairFromString(futuresPair)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = f.GetFuturesOpenInterest(context.Background(), asset.Futures, cp)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetFuturesMarkPrice(t *testing.T) {
+	t.Parallel()
+	cp, err := currency.NewPairFromString(futuresPair)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = f.GetFuturesMarkPrice(context.Background(), asset.Futures, cp)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetFuturesPositions(t *testing.T) {
+	t.Parallel()
+	_, err := f.GetFuturesPositions(context.Background(), asset.Spot, currency.Pair{})
+	if !errors.Is(err, futures.ErrNotFuturesAsset) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, futures.ErrNotFuturesAsset)
+	}
+	if !areTestAPIKeysSet() {
+		t.Skip("skipping test: api keys not set")
+	}
+	_, err = f.GetFuturesPositions(context.Background(), asset.Futures, currency.Pair{})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestChangeFuturesLeverage(t *testing.T) {
+	t.Parallel()
+	cp, err := currency.NewPairFromString(futuresPair)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = f.ChangeFuturesLeverage(context.Background(), asset.Futures, cp, -1)
+	if !errors.Is(err, futures.ErrInvalidLeverage) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, futures.ErrInvalidLeverage)
+	}
+	if !areTestAPIKeysSet() || !canManipulateRealOrders {
+		t.Skip("skipping test: api keys not set or canManipulateRealOrders set to false")
+	}
+	err = f.ChangeFuturesLeverage(context.Background(), asset.Futures, cp, 5)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestChangeFuturesMarginType(t *testing.T) {
+	t.Parallel()
+	cp, err := currency.NewPairFromString(futuresPair)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = f.ChangeFuturesMarginType(context.Background(), asset.Futures, cp, futures.Isolated)
+	if !errors.Is(err, futures.ErrMarginTypeNotSupported) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, futures.ErrMarginTypeNotSupported)
+	}
+	err = f.ChangeFuturesMarginType(context.Background(), asset.Futures, cp, futures.Cross)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+}
+
+func TestGetFuturesMarginInfo(t *testing.T) {
+	t.Parallel()
+	if !areTestAPIKeysSet() {
+		t.Skip("skipping test: api keys not set")
+	}
+	cp, err := currency.NewPairFromString(futuresPair)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = f.GetFuturesMarginInfo(context.Background(), asset.Futures, cp)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestTransferFunds(t *testing.T) {
+	t.Parallel()
+	_, err := f.TransferFunds(context.Background(), &account.TransferRequest{
+		Currency:  currency.USD,
+		Amount:    1,
+		FromAsset: asset.Spot,
+		ToAsset:   asset.Futures,
+	})
+	if !errors.Is(err, account.ErrTransferRouteNotSupported) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, account.ErrTransferRouteNotSupported)
+	}
+	if !areTestAPIKeysSet() || !canManipulateRealOrders {
+		t.Skip("skipping test, either api keys or canManipulateRealOrders isn't set")
+	}
+	_, err = f.TransferFunds(context.Background(), &account.TransferRequest{
+		Currency:     currency.USD,
+		Amount:       1,
+		ToSubAccount: "test",
+	})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetMarginInterestRates(t *testing.T) {
+	t.Parallel()
+	if !areTestAPIKeysSet() {
+		t.Skip()
+	}
+	_, err := f.GetMarginInterestRates(context.Background(), currency.BTC)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSubmitMarginLendOffer(t *testing.T) {
+	t.Parallel()
+	_, err := f.SubmitMarginLendOffer(context.Background(), &margin.LendOffer{
+		Currency: currency.BTC,
+		Amount:   0.1,
+		Rate:     0.0001,
+		Period:   2,
+	})
+	if !errors.Is(err, errLendingPeriodNotSupported) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errLendingPeriodNotSupported)
+	}
+	if !areTestAPIKeysSet() || !canManipulateRealOrders {
+		t.Skip()
+	}
+	_, err = f.SubmitMarginLendOffer(context.Background(), &margin.LendOffer{
+		Currency: currency.BTC,
+		Amount:   0.1,
+		Rate:     0.0001,
+	})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestCancelMarginLendOffer(t *testing.T) {
+	t.Parallel()
+	err := f.CancelMarginLendOffer(context.Background(), currency.Code{}, "")
+	if !errors.Is(err, margin.ErrCurrencyIsEmpty) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, margin.ErrCurrencyIsEmpty)
+	}
+	if !areTestAPIKeysSet() || !canManipulateRealOrders {
+		t.Skip()
+	}
+	if err = f.CancelMarginLendOffer(context.Background(), currency.BTC, ""); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetMarginLoans(t *testing.T) {
+	t.Parallel()
+	if !areTestAPIKeysSet() {
+		t.Skip()
+	}
+	_, err := f.GetMarginLoans(context.Background(), currency.Code{})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetMarginInterestHistory(t *testing.T) {
+	t.Parallel()
+	if !areTestAPIKeysSet() {
+		t.Skip()
+	}
+	_, err := f.GetMarginInterestHistory(context.Background(),
+		currency.USD,
+		time.Now().Add(-time.Hour*24),
+		time.Now())
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestUpdateFeeSchedule(t *testing.T) {
+	t.Parallel()
+	if !areTestAPIKeysSet() {
+		t.Skip()
+	}
+	err := f.UpdateFeeSchedule(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+const optionsMockFile = "../../testdata/http_mock/ftx/ftx.json"
+
+// newOptionsMockExchange returns an FTX instance which sends its options
+// REST requests to the recorded responses
+func newOptionsMockExchange(t *testing.T) *FTX {
+	t.Helper()
+	var m FTX
+	m.SetDefaults()
+	m.Verbose = false
+	serverURL, client, err := mock.NewVCRServer(optionsMockFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m.SetHTTPClient(client)
+	err = m.API.Endpoints.SetRunning(exchange.RestSpot.String(), serverURL+"/api")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &m
+}
+
+func TestGetOptionsChain(t *testing.T) {
+	t.Parallel()
+	m := newOptionsMockExchange(t)
+	_, err := m.GetOptionsChain(context.Background(), currency.Pair{})
+	if !errors.Is(err, options.ErrPairIsEmpty) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, options.ErrPairIsEmpty)
+	}
+
+	chain, err := m.GetOptionsChain(context.Background(), currency.NewPair(currency.BTC, currency.USD))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// duplicate requests for a contract and other underlyings are excluded
+	if len(chain) != 3 {
+		t.Fatalf("received: '%v' but expected: '%v'", len(chain), 3)
+	}
+	expected := currency.NewPairWithDelimiter("BTC-USD", "210625-40000-C", currency.DashDelimiter)
+	if !chain[0].Pair.Equal(expected) {
+		t.Errorf("received: '%v' but expected: '%v'", chain[0].Pair, expected)
+	}
+	if chain[1].Type != options.Put || chain[2].Strike != 45000 {
+		t.Errorf("unexpected contracts %+v", chain)
+	}
+	expiry := time.Date(2021, 6, 25, 3, 0, 0, 0, time.UTC)
+	if !chain[0].Expiry.Equal(expiry) {
+		t.Errorf("received: '%v' but expected: '%v'", chain[0].Expiry, expiry)
+	}
+}
+
+func TestGetOptionsGreeks(t *testing.T) {
+	t.Parallel()
+	_, err := f.GetOptionsGreeks(context.Background(), currency.NewPairWithDelimiter("BTC-USD", "210625-40000-C", currency.DashDelimiter))
+	if !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, common.ErrFunctionNotSupported)
+	}
+}
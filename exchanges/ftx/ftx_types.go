@@ -233,9 +233,9 @@ type WalletCoinsData struct {
 	Hidden           bool        `json:"hidden"`
 	IsETF            bool        `json:"isEtf"`
 	IsToken          bool        `json:"isToken"`
-	Methods          []interface{}
-	ID               string `json:"id"`
-	Name             string `json:"name"`
+	Methods          []string    `json:"methods"`
+	ID               string      `json:"id"`
+	Name             string      `json:"name"`
 }
 
 // WalletBalance stores balances data
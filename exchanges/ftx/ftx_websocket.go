@@ -17,6 +17,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fill"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
@@ -378,7 +379,38 @@ func (f *FTX) wsHandleData(respRaw []byte) error {
 			if err != nil {
 				return err
 			}
-			f.Websocket.DataHandler <- resultData.FillsData
+			var pair currency.Pair
+			pair, err = currency.NewPairFromString(resultData.FillsData.Market)
+			if err != nil {
+				return err
+			}
+			var assetType asset.Item
+			assetType, err = f.GetPairAssetType(pair)
+			if err != nil {
+				return err
+			}
+			var side order.Side
+			side, err = order.StringToOrderSide(resultData.FillsData.Side)
+			if err != nil {
+				return err
+			}
+			f.Websocket.DataHandler <- []fill.Data{{
+				TradeHistory: order.TradeHistory{
+					Price:     resultData.FillsData.Price,
+					Amount:    resultData.FillsData.Size,
+					Fee:       resultData.FillsData.Fee,
+					Exchange:  f.Name,
+					TID:       strconv.FormatInt(resultData.FillsData.TradeID, 10),
+					Side:      side,
+					Timestamp: resultData.FillsData.Time,
+					IsMaker:   resultData.FillsData.Liquidity == "maker",
+					FeeAsset:  resultData.FillsData.FeeCurrency,
+					Total:     resultData.FillsData.Price * resultData.FillsData.Size,
+				},
+				OrderID:   strconv.FormatInt(resultData.FillsData.OrderID, 10),
+				Pair:      pair,
+				AssetType: assetType,
+			}}
 		default:
 			f.Websocket.DataHandler <- stream.UnhandledMessageWarning{Message: f.Name + stream.UnhandledMessage + string(respRaw)}
 		}
@@ -7,6 +7,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fill"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
 )
@@ -152,7 +153,7 @@ func TestFTX_wsHandleData_wsFills(t *testing.T) {
            "type": "update",
            "data": {
                "id": 1234567890,
-               "market": "MARKET",
+               "market": "BTC/USDT",
                "future": "FUTURE",
                "baseCurrency": "BTC",
                "quoteCurrency": "USDT",
@@ -170,26 +171,26 @@ func TestFTX_wsHandleData_wsFills(t *testing.T) {
            }
         }`
 	p := parseRaw(t, input)
-	x, ok := p.(WsFills)
+	fills, ok := p.([]fill.Data)
 	if !ok {
-		t.Fatalf("have %T, want ftx.WsFills", p)
+		t.Fatalf("have %T, want []fill.Data", p)
 	}
-	if x.ID != 1234567890 ||
-		x.Market != "MARKET" ||
-		x.Future != "FUTURE" ||
-		x.BaseCurrency != "BTC" ||
-		x.QuoteCurrency != "USDT" ||
-		x.Type != "order" ||
-		x.Side != "sell" ||
+	if len(fills) != 1 {
+		t.Fatalf("have %d fills, want 1", len(fills))
+	}
+	x := fills[0]
+	if !x.Pair.Equal(currency.NewPair(currency.BTC, currency.USDT)) ||
+		x.AssetType != asset.Spot ||
+		x.Side != order.Sell ||
 		x.Price != 32768 ||
-		x.Size != 2 ||
-		x.OrderID != 23456789012 ||
-		!x.Time.Equal(time.Unix(1628346762, 373010000).UTC()) ||
-		x.TradeID != 3456789012 ||
-		x.FeeRate != 8 ||
+		x.Amount != 2 ||
+		x.Total != 65536 ||
+		x.OrderID != "23456789012" ||
+		!x.Timestamp.Equal(time.Unix(1628346762, 373010000).UTC()) ||
+		x.TID != "3456789012" ||
 		x.Fee != 16 ||
-		x.FeeCurrency != "FTT" ||
-		x.Liquidity != "maker" {
+		x.FeeAsset != "FTT" ||
+		!x.IsMaker {
 		t.Error("parsed values do not match")
 	}
 }
@@ -16,7 +16,13 @@ import (
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/capability"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/deposit"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fee"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/options"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
@@ -110,6 +116,7 @@ func (f *FTX) SetDefaults() {
 				FiatDepositFee:      true,
 				FiatWithdrawalFee:   true,
 				CryptoWithdrawalFee: true,
+				FundsTransfer:       true,
 			},
 			WebsocketCapabilities: protocol.Features{
 				OrderbookFetching: true,
@@ -124,6 +131,10 @@ func (f *FTX) SetDefaults() {
 				DateRanges: true,
 				Intervals:  true,
 			},
+			Orders: capability.Orders{
+				Types:       []order.Type{order.Limit, order.Market},
+				TimeInForce: []capability.TimeInForce{capability.GoodTillCancel, capability.ImmediateOrCancel, capability.PostOnly},
+			},
 		},
 		Enabled: exchange.FeaturesEnabled{
 			AutoPairUpdates: true,
@@ -824,12 +835,48 @@ func (f *FTX) GetOrderInfo(ctx context.Context, orderID string, pair currency.Pa
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (f *FTX) GetDepositAddress(ctx context.Context, cryptocurrency currency.Code, _ string) (string, error) {
-	a, err := f.FetchDepositAddress(ctx, cryptocurrency)
+func (f *FTX) GetDepositAddress(ctx context.Context, cryptocurrency currency.Code, _, chain string) (*deposit.Address, error) {
+	a, err := f.FetchDepositAddress(ctx, cryptocurrency, chain)
+	if err != nil {
+		return nil, err
+	}
+	return &deposit.Address{
+		Address: a.Address,
+		Tag:     a.Tag,
+		Chain:   chain,
+	}, nil
+}
+
+// GetAvailableTransferChains returns the networks the cryptocurrency can be
+// deposited and withdrawn on. FTX does not publish per network limits.
+func (f *FTX) GetAvailableTransferChains(ctx context.Context, cryptocurrency currency.Code) ([]deposit.Network, error) {
+	coins, err := f.GetCoins(ctx)
 	if err != nil {
-		return "", err
+		return nil, err
+	}
+	for x := range coins {
+		if !strings.EqualFold(coins[x].ID, cryptocurrency.String()) {
+			continue
+		}
+		if len(coins[x].Methods) == 0 {
+			break
+		}
+		// FTX lists the default method first
+		networks := make([]deposit.Network, len(coins[x].Methods))
+		for y := range coins[x].Methods {
+			networks[y] = deposit.Network{
+				Currency:        cryptocurrency,
+				Chain:           coins[x].Methods[y],
+				Name:            coins[x].Methods[y],
+				IsDefault:       y == 0,
+				DepositEnabled:  coins[x].CanDeposit,
+				WithdrawEnabled: coins[x].CanWithdraw,
+				RequiresTag:     coins[x].HasTag,
+			}
+		}
+		return networks, nil
 	}
-	return a.Address, nil
+	return nil, fmt.Errorf("%s %w", cryptocurrency, deposit.ErrNoNetworks)
 }
 
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
@@ -844,6 +891,7 @@ func (f *FTX) WithdrawCryptocurrencyFunds(ctx context.Context, withdrawRequest *
 		withdrawRequest.Crypto.AddressTag,
 		withdrawRequest.TradePassword,
 		strconv.FormatInt(withdrawRequest.OneTimePassword, 10),
+		withdrawRequest.Crypto.Chain,
 		withdrawRequest.Amount)
 	if err != nil {
 		return nil, err
@@ -1214,3 +1262,466 @@ func (f *FTX) UpdateOrderExecutionLimits(ctx context.Context, _ asset.Item) erro
 	}
 	return f.LoadLimits(limits)
 }
+
+// GetLatestFundingRate returns the latest funding rate for a perpetual
+// contract along with the predicted rate for the next funding time
+func (f *FTX) GetLatestFundingRate(ctx context.Context, a asset.Item, p currency.Pair) (*futures.LatestFundingRate, error) {
+	if err := futures.CheckRequest(a, p); err != nil {
+		return nil, err
+	}
+	fPair, err := f.FormatExchangeCurrency(p, a)
+	if err != nil {
+		return nil, err
+	}
+	rates, err := f.GetFundingRates(ctx, time.Time{}, time.Time{}, fPair.String())
+	if err != nil {
+		return nil, err
+	}
+	stats, err := f.GetFutureStats(ctx, fPair.String())
+	if err != nil {
+		return nil, err
+	}
+	resp := &futures.LatestFundingRate{
+		FundingRate: futures.FundingRate{
+			Exchange: f.Name,
+			Asset:    a,
+			Pair:     p,
+		},
+		PredictedRate:   stats.NextFundingRate,
+		NextFundingTime: stats.NextFundingTime,
+	}
+	for i := range rates {
+		if rates[i].Time.After(resp.Time) {
+			resp.Rate = rates[i].Rate
+			resp.Time = rates[i].Time
+		}
+	}
+	return resp, nil
+}
+
+// GetFundingRateHistory returns the funding rate history for a perpetual
+// contract between the start and end times
+func (f *FTX) GetFundingRateHistory(ctx context.Context, a asset.Item, p currency.Pair, start, end time.Time) ([]futures.FundingRate, error) {
+	if err := futures.CheckRequest(a, p); err != nil {
+		return nil, err
+	}
+	if err := common.StartEndTimeCheck(start, end); err != nil {
+		return nil, err
+	}
+	fPair, err := f.FormatExchangeCurrency(p, a)
+	if err != nil {
+		return nil, err
+	}
+	var resp []futures.FundingRate
+	// results are returned newest first so the end time is moved back until
+	// the range is covered
+	for {
+		rates, err := f.GetFundingRates(ctx, start, end, fPair.String())
+		if err != nil {
+			return nil, err
+		}
+		oldest := end
+		for i := range rates {
+			resp = append(resp, futures.FundingRate{
+				Exchange: f.Name,
+				Asset:    a,
+				Pair:     p,
+				Rate:     rates[i].Rate,
+				Time:     rates[i].Time,
+			})
+			if rates[i].Time.Before(oldest) {
+				oldest = rates[i].Time
+			}
+		}
+		if len(rates) < fundingRateLimit {
+			break
+		}
+		end = oldest.Add(-time.Second)
+		if !start.Before(end) {
+			break
+		}
+	}
+	futures.SortFundingRates(resp)
+	return resp, nil
+}
+
+// GetFuturesOpenInterest returns the open interest for a futures contract
+func (f *FTX) GetFuturesOpenInterest(ctx context.Context, a asset.Item, p currency.Pair) (*futures.OpenInterest, error) {
+	if err := futures.CheckRequest(a, p); err != nil {
+		return nil, err
+	}
+	fPair, err := f.FormatExchangeCurrency(p, a)
+	if err != nil {
+		return nil, err
+	}
+	stats, err := f.GetFutureStats(ctx, fPair.String())
+	if err != nil {
+		return nil, err
+	}
+	future, err := f.GetFuture(ctx, fPair.String())
+	if err != nil {
+		return nil, err
+	}
+	return &futures.OpenInterest{
+		Exchange: f.Name,
+		Asset:    a,
+		Pair:     p,
+		Amount:   stats.OpenInterest,
+		Value:    stats.OpenInterest * future.Mark,
+		Time:     time.Now(),
+	}, nil
+}
+
+// GetFuturesMarkPrice returns the mark and index price for a futures contract
+func (f *FTX) GetFuturesMarkPrice(ctx context.Context, a asset.Item, p currency.Pair) (*futures.MarkPrice, error) {
+	if err := futures.CheckRequest(a, p); err != nil {
+		return nil, err
+	}
+	fPair, err := f.FormatExchangeCurrency(p, a)
+	if err != nil {
+		return nil, err
+	}
+	future, err := f.GetFuture(ctx, fPair.String())
+	if err != nil {
+		return nil, err
+	}
+	return &futures.MarkPrice{
+		Exchange:   f.Name,
+		Asset:      a,
+		Pair:       p,
+		MarkPrice:  future.Mark,
+		IndexPrice: future.Index,
+		Time:       time.Now(),
+	}, nil
+}
+
+// GetFuturesPositions returns open futures positions, an empty pair returns
+// positions for all contracts. FTX applies a single leverage to the account
+// and all positions share cross margin
+func (f *FTX) GetFuturesPositions(ctx context.Context, a asset.Item, p currency.Pair) ([]futures.Position, error) {
+	if err := futures.CheckAsset(a); err != nil {
+		return nil, err
+	}
+	if a != asset.Futures {
+		return nil, fmt.Errorf("%w %v", asset.ErrNotSupported, a)
+	}
+	acc, err := f.GetAccountInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	positions, err := f.GetPositions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var resp []futures.Position
+	for i := range positions {
+		if positions[i].Size == 0 {
+			continue
+		}
+		cp, err := currency.NewPairFromString(positions[i].Future)
+		if err != nil {
+			return nil, err
+		}
+		if !p.IsEmpty() && !cp.Equal(p) {
+			continue
+		}
+		side, err := futures.StringToPositionSide(positions[i].Side)
+		if err != nil {
+			return nil, err
+		}
+		resp = append(resp, futures.Position{
+			Exchange:         f.Name,
+			Asset:            a,
+			Pair:             cp,
+			Side:             side,
+			Size:             positions[i].Size,
+			EntryPrice:       positions[i].EntryPrice,
+			LiquidationPrice: positions[i].EstimatedLiquidationPrice,
+			UnrealisedPNL:    positions[i].UnrealizedPnL,
+			Leverage:         acc.Leverage,
+			MarginType:       futures.Cross,
+			Margin:           positions[i].CollateralUsed,
+		})
+	}
+	return resp, nil
+}
+
+// ChangeFuturesLeverage sets the leverage for a futures contract. FTX only
+// supports account wide leverage so this changes the leverage for all
+// contracts
+func (f *FTX) ChangeFuturesLeverage(ctx context.Context, a asset.Item, p currency.Pair, leverage float64) error {
+	if err := futures.CheckLeverage(a, p, leverage); err != nil {
+		return err
+	}
+	if a != asset.Futures {
+		return fmt.Errorf("%w %v", asset.ErrNotSupported, a)
+	}
+	return f.ChangeAccountLeverage(ctx, leverage)
+}
+
+// ChangeFuturesMarginType sets the margin type for a futures contract, FTX
+// only supports cross margin
+func (f *FTX) ChangeFuturesMarginType(_ context.Context, a asset.Item, p currency.Pair, marginType futures.MarginType) error {
+	if err := futures.CheckRequest(a, p); err != nil {
+		return err
+	}
+	if a != asset.Futures {
+		return fmt.Errorf("%w %v", asset.ErrNotSupported, a)
+	}
+	if marginType != futures.Cross {
+		return fmt.Errorf("%q %w", marginType, futures.ErrMarginTypeNotSupported)
+	}
+	return nil
+}
+
+// GetFuturesMarginInfo returns the leverage and margin settings for a futures
+// contract. FTX margin is shared across the account so balances are account
+// wide and denominated in USD
+func (f *FTX) GetFuturesMarginInfo(ctx context.Context, a asset.Item, p currency.Pair) (*futures.MarginInfo, error) {
+	if err := futures.CheckRequest(a, p); err != nil {
+		return nil, err
+	}
+	if a != asset.Futures {
+		return nil, fmt.Errorf("%w %v", asset.ErrNotSupported, a)
+	}
+	acc, err := f.GetAccountInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &futures.MarginInfo{
+		Exchange:          f.Name,
+		Asset:             a,
+		Pair:              p,
+		Leverage:          acc.Leverage,
+		MarginType:        futures.Cross,
+		Collateral:        currency.USD,
+		MarginBalance:     acc.Collateral,
+		AvailableMargin:   acc.FreeCollateral,
+		InitialMargin:     acc.InitialMarginRequirement * acc.TotalPositionSize,
+		MaintenanceMargin: acc.MaintenanceMarginRequirement * acc.TotalPositionSize,
+	}, nil
+}
+
+// TransferFunds moves funds between sub-accounts, spot and futures share a
+// single wallet per account so asset to asset transfers are not supported
+func (f *FTX) TransferFunds(ctx context.Context, req *account.TransferRequest) (*account.TransferResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, err
+	}
+	if req.FromAsset != req.ToAsset && req.FromAsset != "" && req.ToAsset != "" {
+		return nil, fmt.Errorf("%s %w", req, account.ErrTransferRouteNotSupported)
+	}
+	resp, err := f.SubaccountTransfer(ctx, req.Currency, req.FromSubAccount, req.ToSubAccount, req.Amount)
+	if err != nil {
+		return nil, err
+	}
+	return &account.TransferResponse{
+		ID:     strconv.FormatInt(resp.ID, 10),
+		Status: resp.Status,
+		Time:   resp.Time,
+	}, nil
+}
+
+// GetMarginInterestRates returns the estimated daily borrow rate for a
+// currency, or all currencies when c is empty
+func (f *FTX) GetMarginInterestRates(ctx context.Context, c currency.Code) ([]margin.InterestRate, error) {
+	rates, err := f.GetMarginBorrowRates(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tn := time.Now()
+	resp := make([]margin.InterestRate, 0, len(rates))
+	for i := range rates {
+		code := currency.NewCode(rates[i].Coin)
+		if !c.IsEmpty() && !c.Match(code) {
+			continue
+		}
+		resp = append(resp, margin.InterestRate{
+			Exchange: f.Name,
+			Currency: code,
+			Rate:     rates[i].Estimate * hoursPerDay,
+			Time:     tn,
+		})
+	}
+	return resp, nil
+}
+
+// SubmitMarginLendOffer sets the lending offer for a currency. FTX holds a
+// single offer per currency which renews every hour until cancelled, so the
+// currency code is returned as the offer ID
+func (f *FTX) SubmitMarginLendOffer(ctx context.Context, o *margin.LendOffer) (*margin.LoanResponse, error) {
+	if err := o.Validate(); err != nil {
+		return nil, err
+	}
+	if o.Period != 0 {
+		return nil, errLendingPeriodNotSupported
+	}
+	err := f.SubmitLendingOffer(ctx, o.Currency, o.Amount, o.Rate/hoursPerDay)
+	if err != nil {
+		return nil, err
+	}
+	return &margin.LoanResponse{
+		ID:       o.Currency.Upper().String(),
+		Currency: o.Currency,
+		Amount:   o.Amount,
+		Time:     time.Now(),
+	}, nil
+}
+
+// CancelMarginLendOffer cancels the lending offer for a currency by setting
+// its size to zero
+func (f *FTX) CancelMarginLendOffer(ctx context.Context, c currency.Code, _ string) error {
+	if c.IsEmpty() {
+		return margin.ErrCurrencyIsEmpty
+	}
+	return f.SubmitLendingOffer(ctx, c, 0, 0)
+}
+
+// GetMarginLoans returns lending offers and the amounts currently lent out
+func (f *FTX) GetMarginLoans(ctx context.Context, c currency.Code) ([]margin.Loan, error) {
+	offers, err := f.GetMarginLendingOffers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	info, err := f.GetLendingInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var loans []margin.Loan
+	for i := range offers {
+		code := currency.NewCode(offers[i].Coin)
+		if offers[i].Size == 0 || (!c.IsEmpty() && !c.Match(code)) {
+			continue
+		}
+		loans = append(loans, margin.Loan{
+			ID:        code.Upper().String(),
+			Side:      margin.Lend,
+			Status:    margin.Offered,
+			Currency:  code,
+			Amount:    offers[i].Size,
+			Rate:      offers[i].Rate * hoursPerDay,
+			AutoRenew: true,
+		})
+	}
+	for i := range info {
+		code := currency.NewCode(info[i].Coin)
+		if info[i].Locked == 0 || (!c.IsEmpty() && !c.Match(code)) {
+			continue
+		}
+		loans = append(loans, margin.Loan{
+			ID:        code.Upper().String(),
+			Side:      margin.Lend,
+			Status:    margin.Active,
+			Currency:  code,
+			Amount:    info[i].Locked,
+			Rate:      info[i].MinRate * hoursPerDay,
+			AutoRenew: true,
+		})
+	}
+	return loans, nil
+}
+
+// GetMarginInterestHistory returns hourly interest paid on borrowings and
+// earned on lending
+func (f *FTX) GetMarginInterestHistory(ctx context.Context, c currency.Code, start, end time.Time) ([]margin.InterestPayment, error) {
+	borrowed, err := f.GetMarginBorrowHistory(ctx, start, end)
+	if err != nil {
+		return nil, err
+	}
+	lent, err := f.GetMarginLendingHistory(ctx, c, start, end)
+	if err != nil {
+		return nil, err
+	}
+	payments := make([]margin.InterestPayment, 0, len(borrowed)+len(lent))
+	for i := range borrowed {
+		code := currency.NewCode(borrowed[i].Coin)
+		if !c.IsEmpty() && !c.Match(code) {
+			continue
+		}
+		payments = append(payments, margin.InterestPayment{
+			Currency:  code,
+			Side:      margin.Borrow,
+			Principal: borrowed[i].Size,
+			Interest:  borrowed[i].Cost,
+			Rate:      borrowed[i].Rate * hoursPerDay,
+			Time:      borrowed[i].Time,
+		})
+	}
+	for i := range lent {
+		payments = append(payments, margin.InterestPayment{
+			Currency:  currency.NewCode(lent[i].Coin),
+			Side:      margin.Lend,
+			Principal: lent[i].Size,
+			Interest:  lent[i].Cost,
+			Rate:      lent[i].Rate * hoursPerDay,
+			Time:      lent[i].Time,
+		})
+	}
+	return margin.FilterInterestPayments(payments, start, end), nil
+}
+
+// UpdateFeeSchedule retrieves the account commission rates, which already
+// include any FTT staking discount, and loads them into the fee definitions
+func (f *FTX) UpdateFeeSchedule(ctx context.Context) error {
+	info, err := f.GetAccountInfo(ctx)
+	if err != nil {
+		return err
+	}
+	commission := fee.Commission{Maker: info.MakerFee, Taker: info.TakerFee}
+	assets := f.GetAssetTypes(false)
+	s := &fee.Schedule{
+		Exchange:      f.Name,
+		Commissions:   make(map[asset.Item]fee.Commission, len(assets)),
+		Authenticated: true,
+	}
+	for i := range assets {
+		s.Commissions[assets[i]] = commission
+	}
+	return f.Fees.Load(s)
+}
+
+// GetOptionsChain returns the option contracts which have open quote
+// requests for an underlying e.g. BTC-USD. FTX options are traded by request
+// for quote, so only contracts with an open request are returned
+func (f *FTX) GetOptionsChain(ctx context.Context, underlying currency.Pair) ([]options.Contract, error) {
+	if underlying.IsEmpty() {
+		return nil, options.ErrPairIsEmpty
+	}
+	requests, err := f.GetQuoteRequests(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[string]bool)
+	var chain []options.Contract
+	for x := range requests {
+		if !underlying.Base.Match(currency.NewCode(requests[x].Option.Underlying)) {
+			continue
+		}
+		var t options.OptionType
+		t, err = options.StringToOptionType(requests[x].Option.OptionType)
+		if err != nil {
+			return nil, err
+		}
+		var c options.Contract
+		c, err = options.NewContract(underlying,
+			requests[x].Option.Expiry,
+			requests[x].Option.Strike,
+			t)
+		if err != nil {
+			return nil, err
+		}
+		if seen[c.Pair.String()] {
+			continue
+		}
+		seen[c.Pair.String()] = true
+		chain = append(chain, c)
+	}
+	return chain, nil
+}
+
+// GetOptionsGreeks is not supported as FTX does not publish greeks or
+// implied volatility for its options
+func (f *FTX) GetOptionsGreeks(_ context.Context, _ currency.Pair) (*options.Greeks, error) {
+	return nil, common.ErrFunctionNotSupported
+}
@@ -0,0 +1,131 @@
+package futures
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+// CheckAsset verifies that an asset is a futures contract type
+func CheckAsset(a asset.Item) error {
+	if !a.IsFutures() {
+		return fmt.Errorf("%v %w", a, ErrNotFuturesAsset)
+	}
+	return nil
+}
+
+// CheckRequest verifies that a futures market data request is for a futures
+// asset and a contract
+func CheckRequest(a asset.Item, p currency.Pair) error {
+	if err := CheckAsset(a); err != nil {
+		return err
+	}
+	if p.IsEmpty() {
+		return ErrPairIsEmpty
+	}
+	return nil
+}
+
+// Validate checks that a funding rate can be stored
+func (f *FundingRate) Validate() error {
+	if f.Exchange == "" {
+		return errExchangeNameEmpty
+	}
+	if err := CheckRequest(f.Asset, f.Pair); err != nil {
+		return err
+	}
+	if f.Time.IsZero() {
+		return fmt.Errorf("%v %v %w", f.Exchange, f.Pair, errFundingTimeUnset)
+	}
+	return nil
+}
+
+// SortFundingRates sorts funding rates by time ascending
+func SortFundingRates(rates []FundingRate) {
+	sort.Slice(rates, func(i, j int) bool {
+		return rates[i].Time.Before(rates[j].Time)
+	})
+}
+
+// FilterFundingRates returns the funding rates which fall within the start
+// and end times inclusive, sorted by time ascending. Zero start or end times
+// are not bounded. This allows exchanges which only support paginated
+// history to return a consistent range.
+func FilterFundingRates(rates []FundingRate, start, end time.Time) []FundingRate {
+	filtered := make([]FundingRate, 0, len(rates))
+	for i := range rates {
+		if !start.IsZero() && rates[i].Time.Before(start) {
+			continue
+		}
+		if !end.IsZero() && rates[i].Time.After(end) {
+			continue
+		}
+		filtered = append(filtered, rates[i])
+	}
+	SortFundingRates(filtered)
+	return filtered
+}
+
+// CheckLeverage verifies that a leverage request is for a futures contract
+// and a positive leverage
+func CheckLeverage(a asset.Item, p currency.Pair, leverage float64) error {
+	if err := CheckRequest(a, p); err != nil {
+		return err
+	}
+	if leverage <= 0 {
+		return fmt.Errorf("%v %w", leverage, ErrInvalidLeverage)
+	}
+	return nil
+}
+
+// String implements the stringer interface
+func (m MarginType) String() string {
+	return string(m)
+}
+
+// StringToMarginType converts a case insensitive margin type to a MarginType,
+// common exchange aliases such as "crossed" are accepted
+func StringToMarginType(s string) (MarginType, error) {
+	switch strings.ToLower(s) {
+	case "isolated":
+		return Isolated, nil
+	case "cross", "crossed":
+		return Cross, nil
+	default:
+		return UnknownMarginType, fmt.Errorf("%q %w", s, errInvalidMarginType)
+	}
+}
+
+// String implements the stringer interface
+func (p PositionSide) String() string {
+	return string(p)
+}
+
+// StringToPositionSide converts a case insensitive position side to a
+// PositionSide, buy and sell are accepted as long and short respectively
+func StringToPositionSide(s string) (PositionSide, error) {
+	switch strings.ToLower(s) {
+	case "long", "buy":
+		return Long, nil
+	case "short", "sell":
+		return Short, nil
+	default:
+		return UnknownSide, fmt.Errorf("%q %w", s, errInvalidPositionSide)
+	}
+}
+
+// SideFromSize returns the position side implied by a signed position size
+func SideFromSize(size float64) PositionSide {
+	switch {
+	case size > 0:
+		return Long
+	case size < 0:
+		return Short
+	default:
+		return UnknownSide
+	}
+}
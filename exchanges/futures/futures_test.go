@@ -0,0 +1,150 @@
+package futures
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+var testPair = currency.NewPair(currency.BTC, currency.USDT)
+
+func TestCheckRequest(t *testing.T) {
+	t.Parallel()
+	err := CheckRequest(asset.Spot, testPair)
+	if !errors.Is(err, ErrNotFuturesAsset) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrNotFuturesAsset)
+	}
+	err = CheckRequest(asset.USDTMarginedFutures, currency.Pair{})
+	if !errors.Is(err, ErrPairIsEmpty) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrPairIsEmpty)
+	}
+	err = CheckRequest(asset.USDTMarginedFutures, testPair)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+}
+
+func TestFundingRateValidate(t *testing.T) {
+	t.Parallel()
+	f := &FundingRate{}
+	err := f.Validate()
+	if !errors.Is(err, errExchangeNameEmpty) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errExchangeNameEmpty)
+	}
+	f.Exchange = "test"
+	f.Asset = asset.Spot
+	err = f.Validate()
+	if !errors.Is(err, ErrNotFuturesAsset) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrNotFuturesAsset)
+	}
+	f.Asset = asset.PerpetualSwap
+	f.Pair = testPair
+	err = f.Validate()
+	if !errors.Is(err, errFundingTimeUnset) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errFundingTimeUnset)
+	}
+	f.Time = time.Now()
+	err = f.Validate()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+}
+
+func TestFilterFundingRates(t *testing.T) {
+	t.Parallel()
+	tt := time.Date(2021, 10, 1, 0, 0, 0, 0, time.UTC)
+	rates := []FundingRate{
+		{Rate: 3, Time: tt.Add(time.Hour * 16)},
+		{Rate: 1, Time: tt},
+		{Rate: 2, Time: tt.Add(time.Hour * 8)},
+	}
+	filtered := FilterFundingRates(rates, time.Time{}, time.Time{})
+	if len(filtered) != 3 || filtered[0].Rate != 1 || filtered[2].Rate != 3 {
+		t.Fatalf("unexpected funding rates %+v", filtered)
+	}
+	filtered = FilterFundingRates(rates, tt.Add(time.Hour), tt.Add(time.Hour*8))
+	if len(filtered) != 1 || filtered[0].Rate != 2 {
+		t.Fatalf("unexpected funding rates %+v", filtered)
+	}
+}
+
+func TestCheckAsset(t *testing.T) {
+	t.Parallel()
+	err := CheckAsset(asset.Spot)
+	if !errors.Is(err, ErrNotFuturesAsset) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrNotFuturesAsset)
+	}
+	err = CheckAsset(asset.CoinMarginedFutures)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+}
+
+func TestCheckLeverage(t *testing.T) {
+	t.Parallel()
+	err := CheckLeverage(asset.Spot, testPair, 1)
+	if !errors.Is(err, ErrNotFuturesAsset) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrNotFuturesAsset)
+	}
+	err = CheckLeverage(asset.USDTMarginedFutures, testPair, 0)
+	if !errors.Is(err, ErrInvalidLeverage) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrInvalidLeverage)
+	}
+	err = CheckLeverage(asset.USDTMarginedFutures, testPair, 2.5)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+}
+
+func TestStringToMarginType(t *testing.T) {
+	t.Parallel()
+	_, err := StringToMarginType("portfolio")
+	if !errors.Is(err, errInvalidMarginType) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errInvalidMarginType)
+	}
+	m, err := StringToMarginType("CROSSED")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if m != Cross {
+		t.Errorf("received: '%v' but expected: '%v'", m, Cross)
+	}
+	m, err = StringToMarginType("Isolated")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if m != Isolated {
+		t.Errorf("received: '%v' but expected: '%v'", m, Isolated)
+	}
+}
+
+func TestStringToPositionSide(t *testing.T) {
+	t.Parallel()
+	_, err := StringToPositionSide("both")
+	if !errors.Is(err, errInvalidPositionSide) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errInvalidPositionSide)
+	}
+	s, err := StringToPositionSide("SELL")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if s != Short {
+		t.Errorf("received: '%v' but expected: '%v'", s, Short)
+	}
+}
+
+func TestSideFromSize(t *testing.T) {
+	t.Parallel()
+	if s := SideFromSize(1); s != Long {
+		t.Errorf("received: '%v' but expected: '%v'", s, Long)
+	}
+	if s := SideFromSize(-1); s != Short {
+		t.Errorf("received: '%v' but expected: '%v'", s, Short)
+	}
+	if s := SideFromSize(0); s != UnknownSide {
+		t.Errorf("received: '%v' but expected: '%v'", s, UnknownSide)
+	}
+}
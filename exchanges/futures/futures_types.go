@@ -0,0 +1,125 @@
+package futures
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+var (
+	// ErrNotFuturesAsset is returned when a futures specific request is made
+	// for a non-futures asset type
+	ErrNotFuturesAsset = errors.New("asset type is not a futures contract")
+	// ErrPairIsEmpty is returned when a request is made without a contract
+	ErrPairIsEmpty = errors.New("currency pair is empty")
+
+	// ErrInvalidLeverage is returned when leverage is not a positive number
+	ErrInvalidLeverage = errors.New("leverage must be greater than zero")
+	// ErrMarginTypeNotSupported is returned when an exchange does not allow
+	// the requested margin type for an asset
+	ErrMarginTypeNotSupported = errors.New("margin type not supported")
+
+	errExchangeNameEmpty   = errors.New("exchange name is empty")
+	errFundingTimeUnset    = errors.New("funding rate time is unset")
+	errInvalidMarginType   = errors.New("invalid margin type")
+	errInvalidPositionSide = errors.New("invalid position side")
+)
+
+// MarginType defines whether margin is allocated to a single position or
+// shared across all positions in an account
+type MarginType string
+
+// Margin types
+const (
+	UnknownMarginType MarginType = ""
+	Isolated          MarginType = "isolated"
+	Cross             MarginType = "cross"
+)
+
+// PositionSide defines the direction of an open futures position
+type PositionSide string
+
+// Position sides
+const (
+	UnknownSide PositionSide = ""
+	Long        PositionSide = "long"
+	Short       PositionSide = "short"
+)
+
+// FundingRate holds a funding rate for a perpetual futures contract at a
+// funding time
+type FundingRate struct {
+	Exchange string
+	Asset    asset.Item
+	Pair     currency.Pair
+	Rate     float64
+	Time     time.Time
+}
+
+// LatestFundingRate holds the most recent funding rate of a contract along
+// with the predicted rate for the next funding time where the exchange
+// supplies one
+type LatestFundingRate struct {
+	FundingRate
+	PredictedRate   float64
+	NextFundingTime time.Time
+}
+
+// OpenInterest holds the open interest of a contract. Amount is the number
+// of contracts open and Value is the amount denominated in the settlement
+// currency where the exchange supplies it
+type OpenInterest struct {
+	Exchange string
+	Asset    asset.Item
+	Pair     currency.Pair
+	Amount   float64
+	Value    float64
+	Time     time.Time
+}
+
+// MarkPrice holds the mark and index price of a contract
+type MarkPrice struct {
+	Exchange   string
+	Asset      asset.Item
+	Pair       currency.Pair
+	MarkPrice  float64
+	IndexPrice float64
+	Time       time.Time
+}
+
+// Position holds an open futures position. Size is always positive with the
+// direction held in Side
+type Position struct {
+	Exchange         string
+	Asset            asset.Item
+	Pair             currency.Pair
+	Side             PositionSide
+	Size             float64
+	EntryPrice       float64
+	MarkPrice        float64
+	LiquidationPrice float64
+	UnrealisedPNL    float64
+	Leverage         float64
+	MarginType       MarginType
+	Margin           float64
+	UpdateTime       time.Time
+}
+
+// MarginInfo holds the leverage and margin settings of a contract along with
+// the account margin balances available to it. Balances are denominated in
+// the collateral currency
+type MarginInfo struct {
+	Exchange          string
+	Asset             asset.Item
+	Pair              currency.Pair
+	Leverage          float64
+	MaxLeverage       float64
+	MarginType        MarginType
+	Collateral        currency.Code
+	MarginBalance     float64
+	AvailableMargin   float64
+	InitialMargin     float64
+	MaintenanceMargin float64
+}
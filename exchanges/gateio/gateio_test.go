@@ -472,12 +472,12 @@ func TestWithdrawInternationalBank(t *testing.T) {
 
 func TestGetDepositAddress(t *testing.T) {
 	if areTestAPIKeysSet() {
-		_, err := g.GetDepositAddress(context.Background(), currency.ETC, "")
+		_, err := g.GetDepositAddress(context.Background(), currency.ETC, "", "")
 		if err != nil {
 			t.Error("Test Fail - GetDepositAddress error", err)
 		}
 	} else {
-		_, err := g.GetDepositAddress(context.Background(), currency.ETC, "")
+		_, err := g.GetDepositAddress(context.Background(), currency.ETC, "", "")
 		if err == nil {
 			t.Error("Test Fail - GetDepositAddress error cannot be nil")
 		}
@@ -15,6 +15,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common/crypto"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
@@ -218,7 +219,19 @@ func (g *Gateio) wsHandleData(respRaw []byte) error {
 		if err != nil {
 			return err
 		}
-		g.Websocket.DataHandler <- balance
+		for i := range balance.Parameters {
+			for code, data := range balance.Parameters[i] {
+				g.Websocket.DataHandler <- account.Change{
+					Exchange: g.Name,
+					Currency: currency.NewCode(code),
+					Asset:    asset.Spot,
+					Balance: &account.Balance{
+						TotalValue: data.Available + data.Freeze,
+						Hold:       data.Freeze,
+					},
+				}
+			}
+		}
 	case strings.Contains(result.Method, "order.update"):
 		var orderUpdate wsOrderUpdate
 		err = json.Unmarshal(respRaw, &orderUpdate)
@@ -545,7 +545,7 @@ func TestWithdrawInternationalBank(t *testing.T) {
 
 func TestGetDepositAddress(t *testing.T) {
 	t.Parallel()
-	_, err := g.GetDepositAddress(context.Background(), currency.BTC, "")
+	_, err := g.GetDepositAddress(context.Background(), currency.BTC, "", "")
 	if err == nil {
 		t.Error("GetDepositAddress error cannot be nil")
 	}
@@ -696,6 +696,38 @@ func TestWsOrderEventSubscriptionResponse(t *testing.T) {
   "avg_execution_price" : "0",
   "original_amount" : "500",
   "socket_sequence" : 32307
+} ]`)
+	err = g.wsHandleData(pressXToJSON)
+	if err != nil {
+		t.Error(err)
+	}
+
+	pressXToJSON = []byte(`[ {
+  "type" : "fill",
+  "order_id" : "556309",
+  "api_session" : "UI",
+  "symbol" : "ethbtc",
+  "side" : "sell",
+  "order_type" : "exchange limit",
+  "timestamp" : "1478729284",
+  "timestampms" : 1478729284169,
+  "is_live" : true,
+  "is_cancelled" : false,
+  "is_hidden" : false,
+  "avg_execution_price" : "0.01514",
+  "executed_amount" : "0.481",
+  "remaining_amount" : "0.519",
+  "original_amount" : "1",
+  "price" : "0.01514",
+  "fill" : {
+    "trade_id" : "557315",
+    "liquidity" : "Maker",
+    "price" : "0.01514",
+    "amount" : "0.481",
+    "fee" : "0.0000036370",
+    "fee_currency" : "BTC"
+  },
+  "socket_sequence" : 471177
 } ]`)
 	err = g.wsHandleData(pressXToJSON)
 	if err != nil {
@@ -17,6 +17,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fill"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
@@ -327,6 +328,26 @@ func (g *Gemini) wsHandleData(respRaw []byte) error {
 				Date:            time.Unix(0, result[i].Timestampms*int64(time.Millisecond)),
 				Pair:            pair,
 			}
+			if result[i].Type == "fill" {
+				g.Websocket.DataHandler <- []fill.Data{{
+					TradeHistory: order.TradeHistory{
+						Price:     result[i].Fill.Price,
+						Amount:    result[i].Fill.Amount,
+						Fee:       result[i].Fill.Fee,
+						Exchange:  g.Name,
+						TID:       result[i].Fill.TradeID,
+						Type:      oType,
+						Side:      oSide,
+						Timestamp: time.Unix(0, result[i].Timestampms*int64(time.Millisecond)),
+						IsMaker:   result[i].Fill.Liquidity == "Maker",
+						FeeAsset:  result[i].Fill.FeeCurrency,
+						Total:     result[i].Fill.Price * result[i].Fill.Amount,
+					},
+					OrderID:   result[i].OrderID,
+					Pair:      pair,
+					AssetType: asset.Spot,
+				}}
+			}
 		}
 		return nil
 	}
@@ -443,12 +443,12 @@ func TestWithdrawInternationalBank(t *testing.T) {
 
 func TestGetDepositAddress(t *testing.T) {
 	if areTestAPIKeysSet() {
-		_, err := h.GetDepositAddress(context.Background(), currency.BTC, "")
+		_, err := h.GetDepositAddress(context.Background(), currency.BTC, "", "")
 		if err != nil {
 			t.Error("GetDepositAddress() error", err)
 		}
 	} else {
-		_, err := h.GetDepositAddress(context.Background(), currency.BTC, "")
+		_, err := h.GetDepositAddress(context.Background(), currency.BTC, "", "")
 		if err == nil {
 			t.Error("GetDepositAddress() error cannot be nil")
 		}
@@ -768,6 +768,29 @@ func TestWsOrderNotification(t *testing.T) {
 	}
 }
 
+func TestWsTradingBalanceJSON(t *testing.T) {
+	pressXToJSON := []byte(`{
+  "jsonrpc": "2.0",
+  "result": [
+    {
+      "currency": "BTC",
+      "available": "0.0504600",
+      "reserved": "0.0010000"
+    },
+    {
+      "currency": "ETH",
+      "available": "30.8504600",
+      "reserved": "0"
+    }
+  ],
+  "id": 9999
+}`)
+	err := h.wsHandleData(pressXToJSON)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
 func TestWsSubmitOrderJSON(t *testing.T) {
 	pressXToJSON := []byte(`{
   "jsonrpc": "2.0",
@@ -14,7 +14,9 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common/crypto"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fill"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
@@ -245,7 +247,17 @@ func (h *HitBTC) wsHandleData(respRaw []byte) error {
 		if err != nil {
 			return err
 		}
-		h.Websocket.DataHandler <- trades
+		for i := range trades.Result {
+			h.Websocket.DataHandler <- account.Change{
+				Exchange: h.Name,
+				Currency: trades.Result[i].Currency,
+				Asset:    asset.Spot,
+				Balance: &account.Balance{
+					TotalValue: trades.Result[i].Available + trades.Result[i].Reserved,
+					Hold:       trades.Result[i].Reserved,
+				},
+			}
+		}
 	case "report":
 		var o wsReportResponse
 		err := json.Unmarshal(respRaw, &o)
@@ -402,6 +414,25 @@ func (h *HitBTC) wsHandleOrderData(o *wsOrderData) error {
 		Pair:            p,
 		Trades:          trades,
 	}
+	if o.ReportType == "trade" && o.TradeID > 0 {
+		h.Websocket.DataHandler <- []fill.Data{{
+			TradeHistory: order.TradeHistory{
+				Price:     o.TradePrice,
+				Amount:    o.TradeQuantity,
+				Fee:       o.TradeFee,
+				Exchange:  h.Name,
+				TID:       strconv.FormatFloat(o.TradeID, 'f', -1, 64),
+				Type:      oType,
+				Side:      oSide,
+				Timestamp: o.UpdatedAt,
+				Total:     o.TradePrice * o.TradeQuantity,
+			},
+			OrderID:       o.ID,
+			ClientOrderID: o.ClientOrderID,
+			Pair:          p,
+			AssetType:     a,
+		}}
+	}
 	return nil
 }
 
@@ -1,5 +1,17 @@
 package huobi
 
+import "errors"
+
+// swapFundingHistoryPageSize is the maximum number of historical funding
+// rates returned per page
+const swapFundingHistoryPageSize = 50
+
+var (
+	errNoSwapData         = errors.New("no swap data returned")
+	errFractionalLeverage = errors.New("leverage must be a whole number")
+	errBatchSizeExceeded  = errors.New("batch order size exceeded")
+)
+
 // WsSwapReqKline stores req kline data for swap websocket
 type WsSwapReqKline struct {
 	Rep  string `json:"rep"`
@@ -766,6 +778,15 @@ type AvailableLeverageData struct {
 	Timestamp int64 `json:"timestamp"`
 }
 
+// SwapSwitchLeverageData stores the result of a swap leverage switch
+type SwapSwitchLeverageData struct {
+	Data struct {
+		ContractCode string `json:"contract_code"`
+		LeverageRate int64  `json:"lever_rate"`
+	} `json:"data"`
+	Timestamp int64 `json:"ts"`
+}
+
 // FinancialRecordData stores an accounts financial records
 type FinancialRecordData struct {
 	Data struct {
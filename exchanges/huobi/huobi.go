@@ -16,6 +16,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 )
 
@@ -26,6 +27,10 @@ const (
 	huobiAPIVersion  = "1"
 	huobiAPIVersion2 = "2"
 
+	// huobiMaxBatchOrders is the maximum number of orders accepted by a
+	// single spot batch order request
+	huobiMaxBatchOrders = 10
+
 	// Spot endpoints
 	huobiMarketHistoryKline    = "market/history/kline"
 	huobiMarketDetail          = "market/detail"
@@ -37,6 +42,7 @@ const (
 	huobiMarketTradeHistory    = "market/history/trade"
 	huobiSymbols               = "common/symbols"
 	huobiCurrencies            = "common/currencys"
+	huobiCurrenciesReference   = "reference/currencies"
 	huobiTimestamp             = "common/timestamp"
 	huobiAccounts              = "account/accounts"
 	huobiAccountBalance        = "account/accounts/%s/balance"
@@ -44,6 +50,7 @@ const (
 	huobiAccountWithdrawQuota  = "account/withdraw/quota"
 	huobiAggregatedBalance     = "subuser/aggregate-balance"
 	huobiOrderPlace            = "order/orders/place"
+	huobiBatchOrders           = "order/batch-orders"
 	huobiOrderCancel           = "order/orders/%s/submitcancel"
 	huobiOrderCancelBatch      = "order/orders/batchcancel"
 	huobiBatchCancelOpenOrders = "order/orders/batchCancelOpenOrders"
@@ -306,6 +313,20 @@ func (h *HUOBI) GetCurrencies(ctx context.Context) ([]string, error) {
 	return result.Currencies, err
 }
 
+// GetCurrenciesIncludingChains returns currency and chain data, an empty
+// currency will return all currencies
+func (h *HUOBI) GetCurrenciesIncludingChains(ctx context.Context, curr currency.Code) ([]CurrenciesChainData, error) {
+	resp := struct {
+		Data []CurrenciesChainData `json:"data"`
+	}{}
+	vals := url.Values{}
+	if !curr.IsEmpty() {
+		vals.Set("currency", curr.Lower().String())
+	}
+	path := common.EncodeURLValues("/v"+huobiAPIVersion2+"/"+huobiCurrenciesReference, vals)
+	return resp.Data, h.SendHTTPRequest(ctx, exchange.RestSpot, path, &resp)
+}
+
 // GetTimestamp returns the Huobi server time
 func (h *HUOBI) GetTimestamp(ctx context.Context) (int64, error) {
 	type response struct {
@@ -404,6 +425,68 @@ func (h *HUOBI) SpotNewOrder(ctx context.Context, arg *SpotNewOrderRequestParams
 	return result.OrderID, err
 }
 
+// SpotBatchOrders places up to ten spot orders in a single request, the
+// results are returned in the same sequence as the orders supplied
+func (h *HUOBI) SpotBatchOrders(ctx context.Context, args []SpotNewOrderRequestParams) ([]BatchOrderResult, error) {
+	if len(args) == 0 {
+		return nil, order.ErrNoOrdersInBatch
+	}
+	if len(args) > huobiMaxBatchOrders {
+		return nil, fmt.Errorf("%w: %d orders exceeds the maximum of %d",
+			errBatchSizeExceeded,
+			len(args),
+			huobiMaxBatchOrders)
+	}
+	type batchOrder struct {
+		AccountID     int    `json:"account-id,string"`
+		Amount        string `json:"amount"`
+		Price         string `json:"price,omitempty"`
+		Source        string `json:"source,omitempty"`
+		Symbol        string `json:"symbol"`
+		Type          string `json:"type"`
+		ClientOrderID string `json:"client-order-id,omitempty"`
+	}
+	data := make([]batchOrder, len(args))
+	for x := range args {
+		symbolValue, err := h.FormatSymbol(args[x].Symbol, asset.Spot)
+		if err != nil {
+			return nil, err
+		}
+		data[x] = batchOrder{
+			AccountID:     args[x].AccountID,
+			Amount:        strconv.FormatFloat(args[x].Amount, 'f', -1, 64),
+			Source:        args[x].Source,
+			Symbol:        symbolValue,
+			Type:          string(args[x].Type),
+			ClientOrderID: args[x].ClientOrderID,
+		}
+		if args[x].Type != SpotNewOrderRequestTypeBuyMarket &&
+			args[x].Type != SpotNewOrderRequestTypeSellMarket {
+			data[x].Price = strconv.FormatFloat(args[x].Price, 'f', -1, 64)
+		}
+	}
+	result := struct {
+		Response
+		Data []BatchOrderResult `json:"data"`
+	}{}
+	err := h.SendAuthenticatedHTTPRequest(ctx,
+		exchange.RestSpot,
+		http.MethodPost,
+		huobiBatchOrders,
+		nil,
+		data,
+		&result,
+		false,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if result.ErrorMessage != "" {
+		return nil, errors.New(result.ErrorMessage)
+	}
+	return result.Data, nil
+}
+
 // CancelExistingOrder cancels an order on Huobi
 func (h *HUOBI) CancelExistingOrder(ctx context.Context, orderID int64) (int64, error) {
 	resp := struct {
@@ -713,8 +796,9 @@ func (h *HUOBI) GetMarginAccountBalance(ctx context.Context, symbol currency.Pai
 	return resp.Balances, err
 }
 
-// Withdraw withdraws the desired amount and currency
-func (h *HUOBI) Withdraw(ctx context.Context, c currency.Code, address, addrTag string, amount, fee float64) (int64, error) {
+// Withdraw withdraws the desired amount and currency on the supplied chain, if
+// left empty the currency's default chain is used
+func (h *HUOBI) Withdraw(ctx context.Context, c currency.Code, address, addrTag, chain string, amount, fee float64) (int64, error) {
 	resp := struct {
 		WithdrawID int64 `json:"data"`
 	}{}
@@ -725,17 +809,19 @@ func (h *HUOBI) Withdraw(ctx context.Context, c currency.Code, address, addrTag
 		Currency string `json:"currency"`
 		Fee      string `json:"fee,omitempty"`
 		AddrTag  string `json:"addr-tag,omitempty"`
+		Chain    string `json:"chain,omitempty"`
 	}{
 		Address:  address,
 		Currency: c.Lower().String(),
 		Amount:   strconv.FormatFloat(amount, 'f', -1, 64),
+		Chain:    chain,
 	}
 
 	if fee > 0 {
 		data.Fee = strconv.FormatFloat(fee, 'f', -1, 64)
 	}
 
-	if c == currency.XRP {
+	if addrTag != "" {
 		data.AddrTag = addrTag
 	}
 
@@ -756,8 +842,9 @@ func (h *HUOBI) CancelWithdraw(ctx context.Context, withdrawID int64) (int64, er
 	return resp.WithdrawID, err
 }
 
-// QueryDepositAddress returns the deposit address for a specified currency
-func (h *HUOBI) QueryDepositAddress(ctx context.Context, cryptocurrency string) (DepositAddress, error) {
+// QueryDepositAddress returns the deposit addresses for a specified currency,
+// one for each chain it can be deposited on
+func (h *HUOBI) QueryDepositAddress(ctx context.Context, cryptocurrency string) ([]DepositAddress, error) {
 	resp := struct {
 		DepositAddress []DepositAddress `json:"data"`
 	}{}
@@ -767,12 +854,12 @@ func (h *HUOBI) QueryDepositAddress(ctx context.Context, cryptocurrency string)
 
 	err := h.SendAuthenticatedHTTPRequest(ctx, exchange.RestSpot, http.MethodGet, huobiAccountDepositAddress, vals, nil, &resp, true)
 	if err != nil {
-		return DepositAddress{}, err
+		return nil, err
 	}
 	if len(resp.DepositAddress) == 0 {
-		return DepositAddress{}, errors.New("deposit address data isn't populated")
+		return nil, errors.New("deposit address data isn't populated")
 	}
-	return resp.DepositAddress[0], nil
+	return resp.DepositAddress, nil
 }
 
 // QueryWithdrawQuotas returns the users cryptocurrency withdraw quotas
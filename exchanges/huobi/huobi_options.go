@@ -0,0 +1,44 @@
+package huobi
+
+import (
+	"context"
+	"net/url"
+
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+)
+
+const (
+	// Unauth
+	oContractInfo = "option-api/v1/option_contract_info?"
+	oMarketIndex  = "option-api/v1/option_market_index?"
+)
+
+// OGetContractInfo gets contract info for options, an empty symbol or trade
+// partition returns contracts for all underlyings
+func (h *HUOBI) OGetContractInfo(ctx context.Context, symbol, tradePartition, contractCode string) (OContractInfoData, error) {
+	var resp OContractInfoData
+	params := url.Values{}
+	if symbol != "" {
+		params.Set("symbol", symbol)
+	}
+	if tradePartition != "" {
+		params.Set("trade_partition", tradePartition)
+	}
+	if contractCode != "" {
+		params.Set("contract_code", contractCode)
+	}
+	path := oContractInfo + params.Encode()
+	return resp, h.SendHTTPRequest(ctx, exchange.RestFutures, path, &resp)
+}
+
+// OGetMarketIndex gets the greeks, implied volatility and mark price of an
+// option contract
+func (h *HUOBI) OGetMarketIndex(ctx context.Context, contractCode string) (OMarketIndexData, error) {
+	var resp OMarketIndexData
+	params := url.Values{}
+	if contractCode != "" {
+		params.Set("contract_code", contractCode)
+	}
+	path := oMarketIndex + params.Encode()
+	return resp, h.SendHTTPRequest(ctx, exchange.RestFutures, path, &resp)
+}
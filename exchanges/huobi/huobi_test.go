@@ -2,6 +2,7 @@ package huobi
 
 import (
 	"context"
+	"errors"
 	"log"
 	"os"
 	"strconv"
@@ -14,8 +15,12 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/core"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/mock"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/options"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/sharedtestvalues"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
@@ -1721,6 +1726,28 @@ func TestGetCurrencies(t *testing.T) {
 	}
 }
 
+func TestGetCurrenciesIncludingChains(t *testing.T) {
+	t.Parallel()
+	r, err := h.GetCurrenciesIncludingChains(context.Background(), currency.USDT)
+	if err != nil {
+		t.Error(err)
+	}
+	if len(r) != 1 {
+		t.Error("expected 1 result")
+	}
+}
+
+func TestGetAvailableTransferChains(t *testing.T) {
+	t.Parallel()
+	c, err := h.GetAvailableTransferChains(context.Background(), currency.USDT)
+	if err != nil {
+		t.Error(err)
+	}
+	if len(c) < 2 {
+		t.Error("expected more than one chain")
+	}
+}
+
 func TestGet24HrMarketSummary(t *testing.T) {
 	t.Parallel()
 	cp, err := currency.NewPairFromString("ethusdt")
@@ -2029,6 +2056,84 @@ func TestSubmitOrder(t *testing.T) {
 	}
 }
 
+func TestSpotBatchOrders(t *testing.T) {
+	t.Parallel()
+	_, err := h.SpotBatchOrders(context.Background(), nil)
+	if !errors.Is(err, order.ErrNoOrdersInBatch) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, order.ErrNoOrdersInBatch)
+	}
+	_, err = h.SpotBatchOrders(context.Background(), make([]SpotNewOrderRequestParams, huobiMaxBatchOrders+1))
+	if !errors.Is(err, errBatchSizeExceeded) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errBatchSizeExceeded)
+	}
+}
+
+func TestSubmitBatchOrders(t *testing.T) {
+	t.Parallel()
+	_, err := h.SubmitBatchOrders(context.Background(), []*order.Submit{{AssetType: asset.CoinMarginedFutures}})
+	if !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, common.ErrFunctionNotSupported)
+	}
+
+	if !areTestAPIKeysSet() || !canManipulateRealOrders {
+		t.Skip("skipping test: api keys not set or canManipulateRealOrders set to false")
+	}
+	accounts, err := h.GetAccounts(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	accountID := strconv.FormatInt(accounts[0].ID, 10)
+	orders := []*order.Submit{
+		{
+			Pair:      currency.NewPair(currency.BTC, currency.USDT),
+			Side:      order.Buy,
+			Type:      order.Limit,
+			Price:     1,
+			Amount:    1,
+			ClientID:  accountID,
+			AssetType: asset.Spot,
+		},
+		{
+			Pair:      currency.NewPair(currency.BTC, currency.USDT),
+			Side:      order.Buy,
+			Type:      order.Limit,
+			Price:     2,
+			Amount:    1,
+			ClientID:  accountID,
+			AssetType: asset.Spot,
+		},
+	}
+	resp, err := h.SubmitBatchOrders(context.Background(), orders)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Results) != len(orders) {
+		t.Fatalf("received %d results but expected %d", len(resp.Results), len(orders))
+	}
+}
+
+func TestSpotNewOrderParams(t *testing.T) {
+	t.Parallel()
+	_, err := spotNewOrderParams(&order.Submit{ClientID: "meow"})
+	if err == nil {
+		t.Fatal("expected error for invalid account ID")
+	}
+	p, err := spotNewOrderParams(&order.Submit{
+		Pair:     currency.NewPair(currency.BTC, currency.USDT),
+		Side:     order.Sell,
+		Type:     order.Limit,
+		Price:    50000,
+		Amount:   1,
+		ClientID: "1337",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.AccountID != 1337 || p.Type != SpotNewOrderRequestTypeSellLimit || p.Price != 50000 {
+		t.Errorf("unexpected order params %+v", p)
+	}
+}
+
 func TestCancelExchangeOrder(t *testing.T) {
 	if areTestAPIKeysSet() && !canManipulateRealOrders {
 		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
@@ -2418,6 +2523,40 @@ func TestWsAccountUpdate(t *testing.T) {
 	}
 }
 
+func TestWsAccountChanges(t *testing.T) {
+	t.Parallel()
+	m := HUOBI{Base: exchange.Base{Name: "HuobiAccountChanges"}}
+	err := account.Process(&account.Holdings{
+		Exchange: m.Name,
+		Accounts: []account.SubAccount{{
+			AssetType:  asset.Spot,
+			Currencies: []account.Balance{{CurrencyName: currency.BTC, TotalValue: 2, Hold: 1}},
+		}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	changes := m.wsAccountChanges([]WsAuthenticatedAccountsResponseDataList{
+		{Currency: "btc", Type: "frozen", Balance: 0.5},
+		{Currency: "usdt", Type: "trade", Balance: 100},
+		{Currency: "usdt", Type: "frozen", Balance: 10},
+	})
+	if len(changes) != 2 {
+		t.Fatalf("received: '%v' but expected: '%v'", len(changes), 2)
+	}
+	if !changes[0].Currency.Match(currency.BTC) ||
+		changes[0].Asset != asset.Spot ||
+		changes[0].Balance.TotalValue != 2 ||
+		changes[0].Balance.Hold != 0.5 {
+		t.Errorf("unexpected change: %+v %+v", changes[0], changes[0].Balance)
+	}
+	if !changes[1].Currency.Match(currency.USDT) ||
+		changes[1].Balance.TotalValue != 100 ||
+		changes[1].Balance.Hold != 10 {
+		t.Errorf("unexpected change: %+v %+v", changes[1], changes[1].Balance)
+	}
+}
+
 func TestWsOrderUpdate(t *testing.T) {
 	pressXToJSON := []byte(`{
   "op": "notify",
@@ -2677,3 +2816,199 @@ func TestGetHistoricTrades(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestGetLatestFundingRate(t *testing.T) {
+	t.Parallel()
+	cp, err := currency.NewPairFromString("BTC-USD")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = h.GetLatestFundingRate(context.Background(), asset.Spot, cp)
+	if !errors.Is(err, futures.ErrNotFuturesAsset) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, futures.ErrNotFuturesAsset)
+	}
+	_, err = h.GetLatestFundingRate(context.Background(), asset.CoinMarginedFutures, cp)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetFundingRateHistory(t *testing.T) {
+	t.Parallel()
+	cp, err := currency.NewPairFromString("BTC-USD")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = h.GetFundingRateHistory(context.Background(), asset.CoinMarginedFutures, cp, time.Now().Add(-time.Hour*24*7), time.Now())
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetFuturesOpenInterest(t *testing.T) {
+	t.Parallel()
+	cp, err := currency.NewPairFromString("BTC-USD")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = h.GetFuturesOpenInterest(context.Background(), asset.Futures, cp)
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, asset.ErrNotSupported)
+	}
+	_, err = h.GetFuturesOpenInterest(context.Background(), asset.CoinMarginedFutures, cp)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetFuturesPositions(t *testing.T) {
+	t.Parallel()
+	_, err := h.GetFuturesPositions(context.Background(), asset.Futures, currency.Pair{})
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, asset.ErrNotSupported)
+	}
+	if !areTestAPIKeysSet() {
+		t.Skip("skipping test: api keys not set")
+	}
+	_, err = h.GetFuturesPositions(context.Background(), asset.CoinMarginedFutures, currency.Pair{})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestChangeFuturesLeverage(t *testing.T) {
+	t.Parallel()
+	cp, err := currency.NewPairFromString("BTC-USD")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = h.ChangeFuturesLeverage(context.Background(), asset.CoinMarginedFutures, cp, 2.5)
+	if !errors.Is(err, errFractionalLeverage) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errFractionalLeverage)
+	}
+	if !areTestAPIKeysSet() || !canManipulateRealOrders {
+		t.Skip("skipping test: api keys not set or canManipulateRealOrders set to false")
+	}
+	err = h.ChangeFuturesLeverage(context.Background(), asset.CoinMarginedFutures, cp, 5)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestChangeFuturesMarginType(t *testing.T) {
+	t.Parallel()
+	cp, err := currency.NewPairFromString("BTC-USD")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = h.ChangeFuturesMarginType(context.Background(), asset.CoinMarginedFutures, cp, futures.Cross)
+	if !errors.Is(err, futures.ErrMarginTypeNotSupported) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, futures.ErrMarginTypeNotSupported)
+	}
+	err = h.ChangeFuturesMarginType(context.Background(), asset.CoinMarginedFutures, cp, futures.Isolated)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+}
+
+func TestGetFuturesMarginInfo(t *testing.T) {
+	t.Parallel()
+	if !areTestAPIKeysSet() {
+		t.Skip("skipping test: api keys not set")
+	}
+	cp, err := currency.NewPairFromString("BTC-USD")
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = h.GetFuturesMarginInfo(context.Background(), asset.CoinMarginedFutures, cp)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+const optionsMockFile = "../../testdata/http_mock/huobi/huobi.json"
+
+var optionsPair = currency.NewPairWithDelimiter("BTC-USDT", "210625-40000-C", currency.UnderscoreDelimiter)
+
+// newOptionsMockExchange returns a HUOBI instance which sends its options
+// REST requests to the recorded responses
+func newOptionsMockExchange(t *testing.T) *HUOBI {
+	t.Helper()
+	var m HUOBI
+	m.SetDefaults()
+	m.Verbose = false
+	serverURL, client, err := mock.NewVCRServer(optionsMockFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m.SetHTTPClient(client)
+	err = m.API.Endpoints.SetRunning(exchange.RestFutures.String(), serverURL+"/")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &m
+}
+
+func TestGetOptionsChain(t *testing.T) {
+	t.Parallel()
+	m := newOptionsMockExchange(t)
+	_, err := m.GetOptionsChain(context.Background(), currency.Pair{})
+	if !errors.Is(err, options.ErrPairIsEmpty) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, options.ErrPairIsEmpty)
+	}
+
+	chain, err := m.GetOptionsChain(context.Background(), currency.NewPair(currency.BTC, currency.USDT))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(chain) != 3 {
+		t.Fatalf("received: '%v' but expected: '%v'", len(chain), 3)
+	}
+	if !chain[0].Pair.Equal(optionsPair) {
+		t.Errorf("received: '%v' but expected: '%v'", chain[0].Pair, optionsPair)
+	}
+	if chain[0].Strike != 40000 || chain[0].Type != options.Call {
+		t.Errorf("unexpected contract %+v", chain[0])
+	}
+	if chain[1].Type != options.Put {
+		t.Errorf("received: '%v' but expected: '%v'", chain[1].Type, options.Put)
+	}
+	expiry := time.Date(2021, 6, 25, 0, 0, 0, 0, time.UTC)
+	if !chain[0].Expiry.Equal(expiry) {
+		t.Errorf("received: '%v' but expected: '%v'", chain[0].Expiry, expiry)
+	}
+}
+
+func TestGetOptionsGreeks(t *testing.T) {
+	t.Parallel()
+	m := newOptionsMockExchange(t)
+	_, err := m.GetOptionsGreeks(context.Background(), currency.NewPair(currency.BTC, currency.USDT))
+	if err == nil {
+		t.Fatal("expected error for a non option contract")
+	}
+
+	greeks, err := m.GetOptionsGreeks(context.Background(), optionsPair)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if greeks.Delta != 0.5512 || greeks.Gamma != 0.00004 || greeks.Theta != -41.2531 || greeks.Vega != 85.1203 {
+		t.Errorf("unexpected greeks %+v", greeks)
+	}
+	if greeks.MarkIV != 0.8823 || greeks.BidIV != 0.8712 || greeks.AskIV != 0.8934 {
+		t.Errorf("unexpected implied volatility %+v", greeks)
+	}
+	if greeks.MarkPrice != 4348.12 || greeks.Asset != asset.Options || !greeks.Pair.Equal(optionsPair) {
+		t.Errorf("unexpected contract details %+v", greeks)
+	}
+}
+
+func TestOptionContractCode(t *testing.T) {
+	t.Parallel()
+	c, err := options.ParseContract(optionsPair)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if code := optionContractCode(&c); code != "BTC-USDT-210625-C-40000" {
+		t.Errorf("received: '%v' but expected: '%v'", code, "BTC-USDT-210625-C-40000")
+	}
+}
@@ -695,6 +695,17 @@ type SpotNewOrderRequestParams struct {
 	Source    string                        `json:"source"`            // Order source, api: API call, margin-api: loan asset transaction
 	Symbol    currency.Pair                 `json:"symbol"`            // The symbol to use; example btcusdt, bccbtc......
 	Type      SpotNewOrderRequestParamsType `json:"type"`              // 订单类型, buy-market: 市价买, sell-market: 市价卖, buy-limit: 限价买, sell-limit: 限价卖
+	// ClientOrderID is only sent with batch order placement
+	ClientOrderID string `json:"client-order-id,omitempty"`
+}
+
+// BatchOrderResult stores the outcome of a single order within a batch
+// order placement, the error fields are set when the order was rejected
+type BatchOrderResult struct {
+	OrderID       int64  `json:"order-id"`
+	ClientOrderID string `json:"client-order-id"`
+	ErrorCode     string `json:"err-code"`
+	ErrorMessage  string `json:"err-msg"`
 }
 
 // DepositAddress stores the users deposit address info
@@ -705,6 +716,37 @@ type DepositAddress struct {
 	Chain      string `json:"chain"`
 }
 
+// CurrenciesChainData stores a currency and the chains it can be transferred
+// on
+type CurrenciesChainData struct {
+	Currency   string      `json:"currency"`
+	AssetType  uint8       `json:"assetType"`
+	InstStatus string      `json:"instStatus"`
+	ChainData  []ChainData `json:"chains"`
+}
+
+// ChainData stores the deposit and withdrawal details of a chain
+type ChainData struct {
+	Chain                     string  `json:"chain"`
+	DisplayName               string  `json:"displayName"`
+	BaseChain                 string  `json:"baseChain"`
+	BaseChainProtocol         string  `json:"baseChainProtocol"`
+	IsDynamic                 bool    `json:"isDynamic"`
+	NumberOfConfirmations     int64   `json:"numOfConfirmations"`
+	NumberOfFastConfirmations int64   `json:"numOfFastConfirmations"`
+	DepositStatus             string  `json:"depositStatus"`
+	MinimumDepositAmount      float64 `json:"minDepositAmt,string"`
+	WithdrawStatus            string  `json:"withdrawStatus"`
+	MinimumWithdrawAmount     float64 `json:"minWithdrawAmt,string"`
+	WithdrawPrecision         int64   `json:"withdrawPrecision"`
+	MaximumWithdrawAmount     float64 `json:"maxWithdrawAmt,string"`
+	WithdrawQuotaPerDay       float64 `json:"withdrawQuotaPerDay,string"`
+	WithdrawFeeType           string  `json:"withdrawFeeType"`
+	TransactFeeWithdraw       float64 `json:"transactFeeWithdraw,string"`
+	AddressWithTag            bool    `json:"addrWithTag"`
+	AddressDepositTag         bool    `json:"addrDepositTag"`
+}
+
 // ChainQuota stores the users currency chain quota
 type ChainQuota struct {
 	Chain                         string  `json:"chain"`
@@ -951,6 +993,7 @@ type WsAuthenticatedOrdersUpdateResponseData struct {
 	Role             string  `json:"role"`
 	OrderState       string  `json:"order-state"`
 	OrderType        string  `json:"order-type"`
+	ClientOrderID    string  `json:"client-order-id"`
 }
 
 // WsAuthenticatedOrdersResponse response from Orders authenticated subscription
@@ -15,7 +15,9 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common/crypto"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fill"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
@@ -255,7 +257,10 @@ func (h *HUOBI) wsHandleData(respRaw []byte) error {
 		if err != nil {
 			return err
 		}
-		h.Websocket.DataHandler <- response
+		changes := h.wsAccountChanges(response.Data.List)
+		for i := range changes {
+			h.Websocket.DataHandler <- changes[i]
+		}
 
 	case strings.Contains(init.Topic, "orders") &&
 		strings.Contains(init.Topic, "update"):
@@ -317,6 +322,26 @@ func (h *HUOBI) wsHandleData(respRaw []byte) error {
 			LastUpdated:     time.Unix(response.TS*1000, 0),
 			Pair:            p,
 		}
+		// the amounts of an update with a match ID are for that trade
+		if response.Data.MatchID != 0 && response.Data.FilledAmount > 0 {
+			h.Websocket.DataHandler <- []fill.Data{{
+				TradeHistory: order.TradeHistory{
+					Price:     response.Data.Price,
+					Amount:    response.Data.FilledAmount,
+					Exchange:  h.Name,
+					TID:       strconv.FormatInt(response.Data.MatchID, 10),
+					Type:      oType,
+					Side:      oSide,
+					Timestamp: time.Unix(0, response.TS*int64(time.Millisecond)),
+					IsMaker:   response.Data.Role == "maker",
+					Total:     response.Data.FilledCashAmount,
+				},
+				OrderID:       orderID,
+				ClientOrderID: response.Data.ClientOrderID,
+				Pair:          p,
+				AssetType:     a,
+			}}
+		}
 
 	case strings.Contains(init.Topic, "orders"):
 		var response WsOldOrderUpdate
@@ -763,3 +788,52 @@ func (h *HUOBI) wsGetOrderDetails(orderID string) (*WsAuthenticatedOrderDetailRe
 	}
 	return &response, nil
 }
+
+// wsAccountChanges converts an account update to balance changes. An update
+// only lists the balance types which changed, so the available or frozen
+// balance not included is taken from the stored holdings
+func (h *HUOBI) wsAccountChanges(list []WsAuthenticatedAccountsResponseDataList) []account.Change {
+	var changes []account.Change
+	index := make(map[string]int)
+	for i := range list {
+		code := currency.NewCode(list[i].Currency)
+		x, ok := index[code.String()]
+		if !ok {
+			x = len(changes)
+			index[code.String()] = x
+			changes = append(changes, account.Change{
+				Exchange: h.Name,
+				Currency: code,
+				Asset:    asset.Spot,
+				Balance:  h.storedBalance(code),
+			})
+		}
+		if list[i].Type == "frozen" {
+			changes[x].Balance.Hold = list[i].Balance
+		} else {
+			changes[x].Balance.TotalValue = list[i].Balance
+		}
+	}
+	return changes
+}
+
+// storedBalance returns a copy of the stored spot balance for a currency, or
+// an empty balance when holdings have not been loaded
+func (h *HUOBI) storedBalance(code currency.Code) *account.Balance {
+	holdings, err := account.GetHoldings(h.Name, asset.Spot)
+	if err != nil {
+		return &account.Balance{}
+	}
+	for i := range holdings.Accounts {
+		if holdings.Accounts[i].AssetType != asset.Spot {
+			continue
+		}
+		for j := range holdings.Accounts[i].Currencies {
+			if holdings.Accounts[i].Currencies[j].CurrencyName.Match(code) {
+				b := holdings.Accounts[i].Currencies[j]
+				return &account.Balance{TotalValue: b.TotalValue, Hold: b.Hold}
+			}
+		}
+	}
+	return &account.Balance{}
+}
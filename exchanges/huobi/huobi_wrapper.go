@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"sort"
 	"strconv"
 	"strings"
@@ -16,7 +17,10 @@ import (
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/deposit"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/options"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
@@ -829,30 +833,10 @@ func (h *HUOBI) SubmitOrder(ctx context.Context, s *order.Submit) (order.SubmitR
 	}
 	switch s.AssetType {
 	case asset.Spot:
-		accountID, err := strconv.ParseInt(s.ClientID, 10, 64)
+		params, err := spotNewOrderParams(s)
 		if err != nil {
 			return submitOrderResponse, err
 		}
-		var formattedType SpotNewOrderRequestParamsType
-		var params = SpotNewOrderRequestParams{
-			Amount:    s.Amount,
-			Source:    "api",
-			Symbol:    s.Pair,
-			AccountID: int(accountID),
-		}
-		switch {
-		case s.Side == order.Buy && s.Type == order.Market:
-			formattedType = SpotNewOrderRequestTypeBuyMarket
-		case s.Side == order.Sell && s.Type == order.Market:
-			formattedType = SpotNewOrderRequestTypeSellMarket
-		case s.Side == order.Buy && s.Type == order.Limit:
-			formattedType = SpotNewOrderRequestTypeBuyLimit
-			params.Price = s.Price
-		case s.Side == order.Sell && s.Type == order.Limit:
-			formattedType = SpotNewOrderRequestTypeSellLimit
-			params.Price = s.Price
-		}
-		params.Type = formattedType
 		response, err := h.SpotNewOrder(ctx, &params)
 		if err != nil {
 			return submitOrderResponse, err
@@ -928,6 +912,98 @@ func (h *HUOBI) SubmitOrder(ctx context.Context, s *order.Submit) (order.SubmitR
 	return submitOrderResponse, nil
 }
 
+// SubmitBatchOrders submits multiple orders in as few requests as possible,
+// only spot supports batch placement
+func (h *HUOBI) SubmitBatchOrders(ctx context.Context, s []*order.Submit) (order.SubmitBatchResponse, error) {
+	a, err := order.BatchAssetType(s)
+	if err != nil {
+		return order.SubmitBatchResponse{}, err
+	}
+	if a != asset.Spot {
+		return order.SubmitBatchResponse{}, fmt.Errorf("%s %w", a, common.ErrFunctionNotSupported)
+	}
+	resp := order.SubmitBatchResponse{Results: make([]order.SubmitResult, len(s))}
+	for x := 0; x < len(s); x += huobiMaxBatchOrders {
+		end := x + huobiMaxBatchOrders
+		if end > len(s) {
+			end = len(s)
+		}
+		var params []SpotNewOrderRequestParams
+		var index []int
+		for y := x; y < end; y++ {
+			if err = s[y].Validate(); err != nil {
+				resp.Results[y].Error = err
+				continue
+			}
+			p, err := spotNewOrderParams(s[y])
+			if err != nil {
+				resp.Results[y].Error = err
+				continue
+			}
+			p.ClientOrderID = s[y].ClientOrderID
+			params = append(params, p)
+			index = append(index, y)
+		}
+		if len(params) == 0 {
+			continue
+		}
+		placed, err := h.SpotBatchOrders(ctx, params)
+		if err == nil && len(placed) != len(params) {
+			err = fmt.Errorf("%s batch order response count %d does not match request count %d",
+				h.Name,
+				len(placed),
+				len(params))
+		}
+		if err != nil {
+			// orders in earlier requests may have been placed, so the
+			// failure is recorded against this request's orders only
+			for y := range index {
+				resp.Results[index[y]].Error = err
+			}
+			continue
+		}
+		for y := range placed {
+			r := &resp.Results[index[y]]
+			if placed[y].ErrorCode != "" || placed[y].ErrorMessage != "" {
+				r.Error = fmt.Errorf("%s code: %s msg: %s", h.Name, placed[y].ErrorCode, placed[y].ErrorMessage)
+				continue
+			}
+			r.OrderID = strconv.FormatInt(placed[y].OrderID, 10)
+			r.IsOrderPlaced = true
+			r.FullyMatched = s[index[y]].Type == order.Market
+		}
+	}
+	return resp, nil
+}
+
+// spotNewOrderParams converts an order submission into spot order parameters,
+// the submission ClientID is used as the account ID
+func spotNewOrderParams(s *order.Submit) (SpotNewOrderRequestParams, error) {
+	accountID, err := strconv.ParseInt(s.ClientID, 10, 64)
+	if err != nil {
+		return SpotNewOrderRequestParams{}, err
+	}
+	params := SpotNewOrderRequestParams{
+		Amount:    s.Amount,
+		Source:    "api",
+		Symbol:    s.Pair,
+		AccountID: int(accountID),
+	}
+	switch {
+	case s.Side == order.Buy && s.Type == order.Market:
+		params.Type = SpotNewOrderRequestTypeBuyMarket
+	case s.Side == order.Sell && s.Type == order.Market:
+		params.Type = SpotNewOrderRequestTypeSellMarket
+	case s.Side == order.Buy && s.Type == order.Limit:
+		params.Type = SpotNewOrderRequestTypeBuyLimit
+		params.Price = s.Price
+	case s.Side == order.Sell && s.Type == order.Limit:
+		params.Type = SpotNewOrderRequestTypeSellLimit
+		params.Price = s.Price
+	}
+	return params, nil
+}
+
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
 func (h *HUOBI) ModifyOrder(ctx context.Context, action *order.Modify) (order.Modify, error) {
@@ -1203,9 +1279,57 @@ func (h *HUOBI) GetOrderInfo(ctx context.Context, orderID string, pair currency.
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (h *HUOBI) GetDepositAddress(ctx context.Context, cryptocurrency currency.Code, _ string) (string, error) {
+func (h *HUOBI) GetDepositAddress(ctx context.Context, cryptocurrency currency.Code, _, chain string) (*deposit.Address, error) {
 	resp, err := h.QueryDepositAddress(ctx, cryptocurrency.Lower().String())
-	return resp.Address, err
+	if err != nil {
+		return nil, err
+	}
+	for x := range resp {
+		if chain == "" || strings.EqualFold(resp[x].Chain, chain) {
+			return &deposit.Address{
+				Address: resp[x].Address,
+				Tag:     resp[x].AddressTag,
+				Chain:   resp[x].Chain,
+			}, nil
+		}
+	}
+	return nil, fmt.Errorf("%s %s %w", cryptocurrency, chain, deposit.ErrNetworkNotFound)
+}
+
+// GetAvailableTransferChains returns the networks the cryptocurrency can be
+// deposited and withdrawn on
+func (h *HUOBI) GetAvailableTransferChains(ctx context.Context, cryptocurrency currency.Code) ([]deposit.Network, error) {
+	resp, err := h.GetCurrenciesIncludingChains(ctx, cryptocurrency)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp) == 0 || len(resp[0].ChainData) == 0 {
+		return nil, fmt.Errorf("%s %w", cryptocurrency, deposit.ErrNoNetworks)
+	}
+	networks := make([]deposit.Network, len(resp[0].ChainData))
+	for x := range resp[0].ChainData {
+		c := &resp[0].ChainData[x]
+		networks[x] = deposit.Network{
+			Currency:        cryptocurrency,
+			Chain:           c.Chain,
+			Name:            c.DisplayName,
+			DepositEnabled:  c.DepositStatus == "allowed",
+			WithdrawEnabled: c.WithdrawStatus == "allowed",
+			WithdrawFee:     c.TransactFeeWithdraw,
+			WithdrawMinimum: c.MinimumWithdrawAmount,
+			WithdrawMaximum: c.MaximumWithdrawAmount,
+			Confirmations:   c.NumberOfConfirmations,
+			RequiresTag:     c.AddressWithTag,
+		}
+	}
+	// Huobi does not flag a default chain, the currency's own chain shares
+	// its name and is used when a chain is not specified
+	for x := range networks {
+		if strings.EqualFold(networks[x].Chain, cryptocurrency.String()) {
+			networks[x].IsDefault = true
+		}
+	}
+	return networks, nil
 }
 
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
@@ -1218,6 +1342,7 @@ func (h *HUOBI) WithdrawCryptocurrencyFunds(ctx context.Context, withdrawRequest
 		withdrawRequest.Currency,
 		withdrawRequest.Crypto.Address,
 		withdrawRequest.Crypto.AddressTag,
+		withdrawRequest.Crypto.Chain,
 		withdrawRequest.Amount,
 		withdrawRequest.Crypto.FeeAmount)
 	if err != nil {
@@ -1721,3 +1846,321 @@ func compatibleVars(side, orderPriceType string, status int64) (OrderVars, error
 	}
 	return resp, nil
 }
+
+// GetLatestFundingRate returns the latest settled funding rate for a
+// perpetual swap along with the rate for the current funding period
+func (h *HUOBI) GetLatestFundingRate(ctx context.Context, a asset.Item, p currency.Pair) (*futures.LatestFundingRate, error) {
+	if err := futures.CheckRequest(a, p); err != nil {
+		return nil, err
+	}
+	if a != asset.CoinMarginedFutures {
+		return nil, fmt.Errorf("%w %v", asset.ErrNotSupported, a)
+	}
+	history, err := h.GetHistoricalFundingRates(ctx, p, 1, 1)
+	if err != nil {
+		return nil, err
+	}
+	current, err := h.GetSwapFundingRates(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+	resp := &futures.LatestFundingRate{
+		FundingRate: futures.FundingRate{
+			Exchange: h.Name,
+			Asset:    a,
+			Pair:     p,
+		},
+		PredictedRate: current.FundingRate,
+	}
+	if len(history.Data.Data) > 0 {
+		resp.Rate = history.Data.Data[0].RealizedRate
+		resp.Time = time.Unix(0, history.Data.Data[0].FundingTime*int64(time.Millisecond))
+	}
+	if current.FundingTime != "" {
+		next, err := strconv.ParseInt(current.FundingTime, 10, 64)
+		if err != nil {
+			return nil, err
+		}
+		resp.NextFundingTime = time.Unix(0, next*int64(time.Millisecond))
+	}
+	return resp, nil
+}
+
+// GetFundingRateHistory returns the funding rate history for a perpetual
+// swap between the start and end times
+func (h *HUOBI) GetFundingRateHistory(ctx context.Context, a asset.Item, p currency.Pair, start, end time.Time) ([]futures.FundingRate, error) {
+	if err := futures.CheckRequest(a, p); err != nil {
+		return nil, err
+	}
+	if a != asset.CoinMarginedFutures {
+		return nil, fmt.Errorf("%w %v", asset.ErrNotSupported, a)
+	}
+	if err := common.StartEndTimeCheck(start, end); err != nil {
+		return nil, err
+	}
+	var resp []futures.FundingRate
+	// pages are returned newest first so pages are requested until the start
+	// time has been passed
+	for page := int64(1); ; page++ {
+		history, err := h.GetHistoricalFundingRates(ctx, p, swapFundingHistoryPageSize, page)
+		if err != nil {
+			return nil, err
+		}
+		passedStart := false
+		for i := range history.Data.Data {
+			t := time.Unix(0, history.Data.Data[i].FundingTime*int64(time.Millisecond))
+			if t.Before(start) {
+				passedStart = true
+			}
+			resp = append(resp, futures.FundingRate{
+				Exchange: h.Name,
+				Asset:    a,
+				Pair:     p,
+				Rate:     history.Data.Data[i].RealizedRate,
+				Time:     t,
+			})
+		}
+		if passedStart || page >= history.Data.TotalPage {
+			break
+		}
+	}
+	return futures.FilterFundingRates(resp, start, end), nil
+}
+
+// GetFuturesOpenInterest returns the open interest for a perpetual swap,
+// the value is denominated in the settlement currency
+func (h *HUOBI) GetFuturesOpenInterest(ctx context.Context, a asset.Item, p currency.Pair) (*futures.OpenInterest, error) {
+	if err := futures.CheckRequest(a, p); err != nil {
+		return nil, err
+	}
+	if a != asset.CoinMarginedFutures {
+		return nil, fmt.Errorf("%w %v", asset.ErrNotSupported, a)
+	}
+	oi, err := h.SwapOpenInterestInformation(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+	if len(oi.Data) == 0 {
+		return nil, fmt.Errorf("%w for %v", errNoSwapData, p)
+	}
+	return &futures.OpenInterest{
+		Exchange: h.Name,
+		Asset:    a,
+		Pair:     p,
+		Amount:   oi.Data[0].Volume,
+		Value:    oi.Data[0].Amount,
+		Time:     time.Now(),
+	}, nil
+}
+
+// GetFuturesPositions returns open coin margined swap positions, an empty
+// pair returns positions for all contracts. Huobi swap positions are
+// isolated per contract
+func (h *HUOBI) GetFuturesPositions(ctx context.Context, a asset.Item, p currency.Pair) ([]futures.Position, error) {
+	if err := futures.CheckAsset(a); err != nil {
+		return nil, err
+	}
+	if a != asset.CoinMarginedFutures {
+		return nil, fmt.Errorf("%w %v", asset.ErrNotSupported, a)
+	}
+	positions, err := h.GetSwapPositionsInfo(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+	if len(positions.Data) == 0 {
+		return nil, nil
+	}
+	// liquidation prices are only supplied with account information
+	accounts, err := h.GetSwapAccountInfo(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+	liquidationPrices := make(map[string]float64, len(accounts.Data))
+	for i := range accounts.Data {
+		liquidationPrices[accounts.Data[i].ContractCode] = accounts.Data[i].LiquidationPrice
+	}
+	resp := make([]futures.Position, 0, len(positions.Data))
+	for i := range positions.Data {
+		if positions.Data[i].Volume == 0 {
+			continue
+		}
+		cp, err := currency.NewPairFromString(positions.Data[i].ContractCode)
+		if err != nil {
+			return nil, err
+		}
+		side, err := futures.StringToPositionSide(positions.Data[i].Direction)
+		if err != nil {
+			return nil, err
+		}
+		resp = append(resp, futures.Position{
+			Exchange:         h.Name,
+			Asset:            a,
+			Pair:             cp,
+			Side:             side,
+			Size:             positions.Data[i].Volume,
+			EntryPrice:       positions.Data[i].CostHold,
+			MarkPrice:        positions.Data[i].LastPrice,
+			LiquidationPrice: liquidationPrices[positions.Data[i].ContractCode],
+			UnrealisedPNL:    positions.Data[i].ProfitUnreal,
+			Leverage:         positions.Data[i].LeverRate,
+			MarginType:       futures.Isolated,
+			Margin:           positions.Data[i].PositionMargin,
+		})
+	}
+	return resp, nil
+}
+
+// ChangeFuturesLeverage sets the leverage for a coin margined swap contract
+func (h *HUOBI) ChangeFuturesLeverage(ctx context.Context, a asset.Item, p currency.Pair, leverage float64) error {
+	if err := futures.CheckLeverage(a, p, leverage); err != nil {
+		return err
+	}
+	if a != asset.CoinMarginedFutures {
+		return fmt.Errorf("%w %v", asset.ErrNotSupported, a)
+	}
+	if leverage != math.Trunc(leverage) {
+		return fmt.Errorf("%v %w", leverage, errFractionalLeverage)
+	}
+	_, err := h.SwitchSwapLeverageRate(ctx, p, int64(leverage))
+	return err
+}
+
+// ChangeFuturesMarginType sets the margin type for a coin margined swap
+// contract, Huobi only supports isolated margin for these contracts
+func (h *HUOBI) ChangeFuturesMarginType(_ context.Context, a asset.Item, p currency.Pair, marginType futures.MarginType) error {
+	if err := futures.CheckRequest(a, p); err != nil {
+		return err
+	}
+	if a != asset.CoinMarginedFutures {
+		return fmt.Errorf("%w %v", asset.ErrNotSupported, a)
+	}
+	if marginType != futures.Isolated {
+		return fmt.Errorf("%q %w", marginType, futures.ErrMarginTypeNotSupported)
+	}
+	return nil
+}
+
+// GetFuturesMarginInfo returns the leverage and margin settings for a coin
+// margined swap contract, balances are denominated in the base currency
+func (h *HUOBI) GetFuturesMarginInfo(ctx context.Context, a asset.Item, p currency.Pair) (*futures.MarginInfo, error) {
+	if err := futures.CheckRequest(a, p); err != nil {
+		return nil, err
+	}
+	if a != asset.CoinMarginedFutures {
+		return nil, fmt.Errorf("%w %v", asset.ErrNotSupported, a)
+	}
+	acc, err := h.GetSwapAccountInfo(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+	if len(acc.Data) == 0 {
+		return nil, fmt.Errorf("%w for %v", errNoSwapData, p)
+	}
+	resp := &futures.MarginInfo{
+		Exchange:        h.Name,
+		Asset:           a,
+		Pair:            p,
+		Leverage:        acc.Data[0].LeverageRate,
+		MarginType:      futures.Isolated,
+		Collateral:      p.Base,
+		MarginBalance:   acc.Data[0].MarginBalance,
+		AvailableMargin: acc.Data[0].MarginAvailable,
+		InitialMargin:   acc.Data[0].MarginPosition + acc.Data[0].MarginFrozen,
+	}
+	levels, err := h.GetAvailableLeverage(ctx, p)
+	if err != nil {
+		return nil, err
+	}
+	for i := range levels.Data {
+		for _, l := range strings.Split(levels.Data[i].AvailableLeverage, ",") {
+			lev, err := strconv.ParseFloat(strings.TrimSpace(l), 64)
+			if err != nil {
+				return nil, err
+			}
+			if lev > resp.MaxLeverage {
+				resp.MaxLeverage = lev
+			}
+		}
+	}
+	return resp, nil
+}
+
+// GetOptionsChain returns the listed option contracts for an underlying e.g.
+// BTC-USDT
+func (h *HUOBI) GetOptionsChain(ctx context.Context, underlying currency.Pair) ([]options.Contract, error) {
+	if underlying.IsEmpty() {
+		return nil, options.ErrPairIsEmpty
+	}
+	underlying = underlying.Upper()
+	info, err := h.OGetContractInfo(ctx,
+		underlying.Base.String(),
+		underlying.Quote.String(),
+		"")
+	if err != nil {
+		return nil, err
+	}
+
+	chain := make([]options.Contract, 0, len(info.Data))
+	for x := range info.Data {
+		var t options.OptionType
+		t, err = options.StringToOptionType(info.Data[x].OptionRightType)
+		if err != nil {
+			return nil, err
+		}
+		var expiry time.Time
+		expiry, err = time.Parse("20060102", info.Data[x].DeliveryDate)
+		if err != nil {
+			return nil, err
+		}
+		var c options.Contract
+		c, err = options.NewContract(underlying, expiry, info.Data[x].ExercisePrice, t)
+		if err != nil {
+			return nil, err
+		}
+		chain = append(chain, c)
+	}
+	return chain, nil
+}
+
+// GetOptionsGreeks returns the greeks and implied volatility for an option
+// contract
+func (h *HUOBI) GetOptionsGreeks(ctx context.Context, p currency.Pair) (*options.Greeks, error) {
+	contract, err := options.ParseContract(p)
+	if err != nil {
+		return nil, err
+	}
+
+	index, err := h.OGetMarketIndex(ctx, optionContractCode(&contract))
+	if err != nil {
+		return nil, err
+	}
+	if len(index.Data) == 0 {
+		return nil, fmt.Errorf("%w for %v", errNoOptionData, p)
+	}
+
+	return &options.Greeks{
+		Exchange:  h.Name,
+		Asset:     asset.Options,
+		Pair:      p,
+		Delta:     index.Data[0].Delta,
+		Gamma:     index.Data[0].Gamma,
+		Theta:     index.Data[0].Theta,
+		Vega:      index.Data[0].Vega,
+		MarkIV:    index.Data[0].IVMarkPrice,
+		BidIV:     index.Data[0].IVBidOne,
+		AskIV:     index.Data[0].IVAskOne,
+		MarkPrice: index.Data[0].MarkPrice,
+		Time:      time.Unix(0, index.Timestamp*int64(time.Millisecond)),
+	}, nil
+}
+
+// optionContractCode converts an option contract to the exchange contract
+// code, which places the option type before the strike e.g.
+// BTC-USDT-210625-C-40000
+func optionContractCode(c *options.Contract) string {
+	return c.Underlying.Base.Upper().String() + currency.DashDelimiter +
+		c.Underlying.Quote.Upper().String() + currency.DashDelimiter +
+		c.Expiry.UTC().Format("060102") + currency.DashDelimiter +
+		c.Type.Short() + currency.DashDelimiter +
+		strconv.FormatFloat(c.Strike, 'f', -1, 64)
+}
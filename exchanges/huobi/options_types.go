@@ -0,0 +1,49 @@
+package huobi
+
+import "errors"
+
+var errNoOptionData = errors.New("no option data returned")
+
+// OContractInfoData stores contract info data for options
+type OContractInfoData struct {
+	Data []struct {
+		Symbol          string  `json:"symbol"`
+		ContractCode    string  `json:"contract_code"`
+		ContractType    string  `json:"contract_type"`
+		ContractSize    float64 `json:"contract_size"`
+		PriceTick       float64 `json:"price_tick"`
+		DeliveryDate    string  `json:"delivery_date"`
+		CreateDate      string  `json:"create_date"`
+		ContractStatus  int64   `json:"contract_status"`
+		OptionRightType string  `json:"option_right_type"`
+		ExercisePrice   float64 `json:"exercise_price"`
+		DeliveryAsset   string  `json:"delivery_asset"`
+		QuoteAsset      string  `json:"quote_asset"`
+		TradePartition  string  `json:"trade_partition"`
+	} `json:"data"`
+	Timestamp int64 `json:"ts"`
+}
+
+// OMarketIndexData stores the greeks and implied volatility of option
+// contracts
+type OMarketIndexData struct {
+	Data []struct {
+		Symbol          string  `json:"symbol"`
+		ContractCode    string  `json:"contract_code"`
+		TradePartition  string  `json:"trade_partition"`
+		OptionRightType string  `json:"option_right_type"`
+		IVLastPrice     float64 `json:"iv_last_price"`
+		IVAskOne        float64 `json:"iv_ask_one"`
+		IVBidOne        float64 `json:"iv_bid_one"`
+		IVMarkPrice     float64 `json:"iv_mark_price"`
+		Delta           float64 `json:"delta"`
+		Gamma           float64 `json:"gamma"`
+		Theta           float64 `json:"theta"`
+		Vega            float64 `json:"vega"`
+		AskOne          float64 `json:"ask_one"`
+		BidOne          float64 `json:"bid_one"`
+		LastPrice       float64 `json:"last_price"`
+		MarkPrice       float64 `json:"mark_price"`
+	} `json:"data"`
+	Timestamp int64 `json:"ts"`
+}
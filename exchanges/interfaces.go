@@ -9,8 +9,14 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/capability"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/currencystate"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/deposit"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fee"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/options"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
@@ -54,18 +60,23 @@ type IBotExchange interface {
 	SupportsWithdrawPermissions(permissions uint32) bool
 	GetFundingHistory(ctx context.Context) ([]FundHistory, error)
 	SubmitOrder(ctx context.Context, s *order.Submit) (order.SubmitResponse, error)
+	SubmitBatchOrders(ctx context.Context, s []*order.Submit) (order.SubmitBatchResponse, error)
 	ModifyOrder(ctx context.Context, action *order.Modify) (order.Modify, error)
+	ModifyBatchOrders(ctx context.Context, m []order.Modify) (order.ModifyBatchResponse, error)
 	CancelOrder(ctx context.Context, o *order.Cancel) error
 	CancelBatchOrders(ctx context.Context, o []order.Cancel) (order.CancelBatchResponse, error)
 	CancelAllOrders(ctx context.Context, orders *order.Cancel) (order.CancelAllResponse, error)
+	SetDeadMansSwitch(ctx context.Context, a asset.Item, timeout time.Duration) error
 	GetOrderInfo(ctx context.Context, orderID string, pair currency.Pair, assetType asset.Item) (order.Detail, error)
-	GetDepositAddress(ctx context.Context, cryptocurrency currency.Code, accountID string) (string, error)
+	GetDepositAddress(ctx context.Context, cryptocurrency currency.Code, accountID, chain string) (*deposit.Address, error)
+	GetAvailableTransferChains(ctx context.Context, cryptocurrency currency.Code) ([]deposit.Network, error)
 	GetOrderHistory(ctx context.Context, getOrdersRequest *order.GetOrdersRequest) ([]order.Detail, error)
 	GetWithdrawalsHistory(ctx context.Context, code currency.Code) ([]WithdrawalHistory, error)
 	GetActiveOrders(ctx context.Context, getOrdersRequest *order.GetOrdersRequest) ([]order.Detail, error)
 	WithdrawCryptocurrencyFunds(ctx context.Context, withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error)
 	WithdrawFiatFunds(ctx context.Context, withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error)
 	WithdrawFiatFundsToInternationalBank(ctx context.Context, withdrawRequest *withdraw.Request) (*withdraw.ExchangeResponse, error)
+	TransferFunds(ctx context.Context, transferRequest *account.TransferRequest) (*account.TransferResponse, error)
 	SetHTTPClientUserAgent(ua string)
 	GetHTTPClientUserAgent() string
 	SetClientProxyAddress(addr string) error
@@ -73,6 +84,8 @@ type IBotExchange interface {
 	GetSubscriptions() ([]stream.ChannelSubscription, error)
 	GetDefaultConfig() (*config.ExchangeConfig, error)
 	GetBase() *Base
+	GetCapabilities() (*capability.Descriptor, error)
+	CheckOrderCapabilities(s *order.Submit) error
 	SupportsAsset(assetType asset.Item) bool
 	GetHistoricCandles(ctx context.Context, p currency.Pair, a asset.Item, timeStart, timeEnd time.Time, interval kline.Interval) (kline.Item, error)
 	GetHistoricCandlesExtended(ctx context.Context, p currency.Pair, a asset.Item, timeStart, timeEnd time.Time, interval kline.Interval) (kline.Item, error)
@@ -93,6 +106,11 @@ type IBotExchange interface {
 	UpdateOrderExecutionLimits(ctx context.Context, a asset.Item) error
 
 	CurrencyStateManagement
+	FuturesMarketData
+	FuturesPositionManagement
+	OptionsMarketData
+	MarginLending
+	FeeManagement
 }
 
 // CurrencyStateManagement defines functionality for currency state management
@@ -104,3 +122,45 @@ type CurrencyStateManagement interface {
 	CanWithdraw(c currency.Code, a asset.Item) error
 	CanDeposit(c currency.Code, a asset.Item) error
 }
+
+// FuturesMarketData defines functionality for futures contract market data
+type FuturesMarketData interface {
+	GetLatestFundingRate(ctx context.Context, a asset.Item, p currency.Pair) (*futures.LatestFundingRate, error)
+	GetFundingRateHistory(ctx context.Context, a asset.Item, p currency.Pair, start, end time.Time) ([]futures.FundingRate, error)
+	GetFuturesOpenInterest(ctx context.Context, a asset.Item, p currency.Pair) (*futures.OpenInterest, error)
+	GetFuturesMarkPrice(ctx context.Context, a asset.Item, p currency.Pair) (*futures.MarkPrice, error)
+}
+
+// FuturesPositionManagement defines functionality for managing futures
+// positions, leverage and margin settings
+type FuturesPositionManagement interface {
+	GetFuturesPositions(ctx context.Context, a asset.Item, p currency.Pair) ([]futures.Position, error)
+	ChangeFuturesLeverage(ctx context.Context, a asset.Item, p currency.Pair, leverage float64) error
+	ChangeFuturesMarginType(ctx context.Context, a asset.Item, p currency.Pair, marginType futures.MarginType) error
+	GetFuturesMarginInfo(ctx context.Context, a asset.Item, p currency.Pair) (*futures.MarginInfo, error)
+}
+
+// OptionsMarketData defines functionality for options contract market data
+type OptionsMarketData interface {
+	GetOptionsChain(ctx context.Context, underlying currency.Pair) ([]options.Contract, error)
+	GetOptionsGreeks(ctx context.Context, p currency.Pair) (*options.Greeks, error)
+}
+
+// MarginLending defines functionality for borrowing and repaying margin funds
+// and lending idle balances
+type MarginLending interface {
+	GetMarginInterestRates(ctx context.Context, c currency.Code) ([]margin.InterestRate, error)
+	MarginBorrow(ctx context.Context, r *margin.LoanRequest) (*margin.LoanResponse, error)
+	MarginRepay(ctx context.Context, r *margin.LoanRequest) (*margin.LoanResponse, error)
+	SubmitMarginLendOffer(ctx context.Context, o *margin.LendOffer) (*margin.LoanResponse, error)
+	CancelMarginLendOffer(ctx context.Context, c currency.Code, id string) error
+	GetMarginLoans(ctx context.Context, c currency.Code) ([]margin.Loan, error)
+	GetMarginInterestHistory(ctx context.Context, c currency.Code, start, end time.Time) ([]margin.InterestPayment, error)
+}
+
+// FeeManagement defines functionality for retrieving the structured fee
+// schedule for an exchange account
+type FeeManagement interface {
+	UpdateFeeSchedule(ctx context.Context) error
+	GetFeeSchedule() (*fee.Schedule, error)
+}
@@ -442,7 +442,7 @@ func TestWithdrawInternationalBank(t *testing.T) {
 }
 
 func TestGetDepositAddress(t *testing.T) {
-	_, err := i.GetDepositAddress(context.Background(), currency.BTC, "")
+	_, err := i.GetDepositAddress(context.Background(), currency.BTC, "", "")
 	if err == nil {
 		t.Error("GetDepositAddress() error cannot be nil")
 	}
@@ -371,12 +371,11 @@ func ConvertToNewInterval(item *Item, newInterval Interval) (*Item, error) {
 		lowest = candleBundles[i][0].Low
 		highest = candleBundles[i][0].High
 		for j := range candleBundles[i] {
-			volume += candleBundles[i][j].Volume
 			if candleBundles[i][j].Low < lowest {
 				lowest = candleBundles[i][j].Low
 			}
 			if candleBundles[i][j].High > highest {
-				lowest = candleBundles[i][j].High
+				highest = candleBundles[i][j].High
 			}
 			volume += candleBundles[i][j].Volume
 		}
@@ -953,12 +953,12 @@ func TestConvertToNewInterval(t *testing.T) {
 	if len(newCandle.Candles) != 1 {
 		t.Error("expected one candle")
 	}
-	if newCandle.Candles[0].Open != 1337 &&
-		newCandle.Candles[0].High != 2000 &&
-		newCandle.Candles[0].Low != 1332 &&
-		newCandle.Candles[0].Close != 6969 &&
+	if newCandle.Candles[0].Open != 1337 ||
+		newCandle.Candles[0].High != 2000 ||
+		newCandle.Candles[0].Low != 1332 ||
+		newCandle.Candles[0].Close != 6969 ||
 		newCandle.Candles[0].Volume != (2520+6420+1337) {
-		t.Error("unexpected updoot")
+		t.Errorf("unexpected updoot %+v", newCandle.Candles[0])
 	}
 
 	old.Candles = append(old.Candles, Candle{
@@ -757,23 +757,23 @@ func (k *Kraken) GetLedgers(ctx context.Context, args ...GetLedgersOptions) (Led
 	params := url.Values{}
 
 	if args != nil {
-		if args[0].Aclass == "" {
+		if args[0].Aclass != "" {
 			params.Set("aclass", args[0].Aclass)
 		}
 
-		if args[0].Asset == "" {
+		if args[0].Asset != "" {
 			params.Set("asset", args[0].Asset)
 		}
 
-		if args[0].Type == "" {
+		if args[0].Type != "" {
 			params.Set("type", args[0].Type)
 		}
 
-		if args[0].Start == "" {
+		if args[0].Start != "" {
 			params.Set("start", args[0].Start)
 		}
 
-		if args[0].End == "" {
+		if args[0].End != "" {
 			params.Set("end", args[0].End)
 		}
 
@@ -928,6 +928,28 @@ func (k *Kraken) CancelExistingOrder(ctx context.Context, txid string) (CancelOr
 	return response.Result, GetError(response.Error)
 }
 
+// CancelAllOrdersAfter arms a timer which cancels all spot orders when it is
+// not refreshed within the timeout in seconds, a timeout of zero disarms it
+func (k *Kraken) CancelAllOrdersAfter(ctx context.Context, timeout int64) (*CancelAllOrdersAfterResponse, error) {
+	if timeout < 0 {
+		return nil, errInvalidTimeout
+	}
+	values := url.Values{
+		"timeout": {strconv.FormatInt(timeout, 10)},
+	}
+
+	var response struct {
+		Error  []string                     `json:"error"`
+		Result CancelAllOrdersAfterResponse `json:"result"`
+	}
+
+	if err := k.SendAuthenticatedHTTPRequest(ctx, exchange.RestSpot, krakenOrderCancelAfter, values, &response); err != nil {
+		return nil, err
+	}
+
+	return &response.Result, GetError(response.Error)
+}
+
 // GetError parse Exchange errors in response and return the first one
 // Error format from API doc:
 //   error = array of error messages in the format of:
@@ -1099,7 +1121,7 @@ func calculateTradingFee(currency string, feePair map[string]TradeVolumeFee, pur
 }
 
 // GetCryptoDepositAddress returns a deposit address for a cryptocurrency
-func (k *Kraken) GetCryptoDepositAddress(ctx context.Context, method, code string) (string, error) {
+func (k *Kraken) GetCryptoDepositAddress(ctx context.Context, method, code string) (*DepositAddress, error) {
 	var resp = struct {
 		Error  []string         `json:"error"`
 		Result []DepositAddress `json:"result"`
@@ -1111,14 +1133,13 @@ func (k *Kraken) GetCryptoDepositAddress(ctx context.Context, method, code strin
 
 	err := k.SendAuthenticatedHTTPRequest(ctx, exchange.RestSpot, krakenDepositAddresses, values, &resp)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
-	for _, a := range resp.Result {
-		return a.Address, nil
+	if len(resp.Result) == 0 {
+		return nil, errors.New("no addresses returned")
 	}
-
-	return "", errors.New("no addresses returned")
+	return &resp.Result[0], nil
 }
 
 // WithdrawStatus gets the status of recent withdrawals
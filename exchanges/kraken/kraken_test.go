@@ -2,6 +2,7 @@ package kraken
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
@@ -19,6 +20,7 @@ import (
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/sharedtestvalues"
@@ -290,6 +292,40 @@ func TestFuturesCancelAllOrdersAfter(t *testing.T) {
 	}
 }
 
+func TestCancelAllOrdersAfter(t *testing.T) {
+	t.Parallel()
+	if !areTestAPIKeysSet() || !canManipulateRealOrders {
+		t.Skip("skipping test: api keys not set or canManipulateRealOrders")
+	}
+	_, err := k.CancelAllOrdersAfter(context.Background(), 60)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestSetDeadMansSwitch(t *testing.T) {
+	t.Parallel()
+	err := k.SetDeadMansSwitch(context.Background(), asset.Spot, -time.Second)
+	if !errors.Is(err, errInvalidTimeout) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errInvalidTimeout)
+	}
+	err = k.SetDeadMansSwitch(context.Background(), asset.Margin, time.Minute)
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, asset.ErrNotSupported)
+	}
+	if !areTestAPIKeysSet() || !canManipulateRealOrders {
+		t.Skip("skipping test: api keys not set or canManipulateRealOrders")
+	}
+	err = k.SetDeadMansSwitch(context.Background(), asset.Spot, time.Minute)
+	if err != nil {
+		t.Error(err)
+	}
+	err = k.SetDeadMansSwitch(context.Background(), asset.Spot, 0)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
 func TestFuturesOpenOrders(t *testing.T) {
 	t.Parallel()
 	if !areTestAPIKeysSet() {
@@ -1086,18 +1122,30 @@ func TestWithdrawInternationalBank(t *testing.T) {
 func TestGetDepositAddress(t *testing.T) {
 	t.Parallel()
 	if areTestAPIKeysSet() {
-		_, err := k.GetDepositAddress(context.Background(), currency.BTC, "")
+		_, err := k.GetDepositAddress(context.Background(), currency.BTC, "", "")
 		if err != nil {
 			t.Error("GetDepositAddress() error", err)
 		}
 	} else {
-		_, err := k.GetDepositAddress(context.Background(), currency.BTC, "")
+		_, err := k.GetDepositAddress(context.Background(), currency.BTC, "", "")
 		if err == nil {
 			t.Error("GetDepositAddress() error can not be nil")
 		}
 	}
 }
 
+// TestGetAvailableTransferChains wrapper test
+func TestGetAvailableTransferChains(t *testing.T) {
+	t.Parallel()
+	_, err := k.GetAvailableTransferChains(context.Background(), currency.USDT)
+	if areTestAPIKeysSet() && err != nil {
+		t.Error("GetAvailableTransferChains() error", err)
+	}
+	if !areTestAPIKeysSet() && err == nil {
+		t.Error("GetAvailableTransferChains() error can not be nil")
+	}
+}
+
 // TestWithdrawStatus wrapper test
 func TestWithdrawStatus(t *testing.T) {
 	t.Parallel()
@@ -2074,3 +2122,62 @@ func TestChecksumCalculation(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestGetMarginLoans(t *testing.T) {
+	t.Parallel()
+	if !areTestAPIKeysSet() {
+		t.Skip("skipping test: api keys not set")
+	}
+	_, err := k.GetMarginLoans(context.Background(), currency.Code{})
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetMarginInterestHistory(t *testing.T) {
+	t.Parallel()
+	if !areTestAPIKeysSet() {
+		t.Skip("skipping test: api keys not set")
+	}
+	_, err := k.GetMarginInterestHistory(context.Background(), currency.USD, time.Now().Add(-time.Hour*24*7), time.Now())
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestMarginLendingNotSupported(t *testing.T) {
+	t.Parallel()
+	_, err := k.MarginBorrow(context.Background(), &margin.LoanRequest{Currency: currency.USD, Amount: 1})
+	if !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, common.ErrFunctionNotSupported)
+	}
+	_, err = k.SubmitMarginLendOffer(context.Background(), &margin.LendOffer{Currency: currency.USD, Amount: 1, Rate: 0.0002})
+	if !errors.Is(err, common.ErrFunctionNotSupported) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, common.ErrFunctionNotSupported)
+	}
+}
+
+func TestPositionToLoan(t *testing.T) {
+	t.Parallel()
+	long := Position{Type: "buy", Time: 1609459200.5, Cost: 29000, Margin: 5800, Volume: 1}
+	loan := positionToLoan("long", &long, currency.XBT, currency.USD)
+	if loan.Currency != currency.USD || loan.Amount != 23200 || loan.Side != margin.Borrow || loan.Status != margin.Active {
+		t.Errorf("unexpected long loan %+v", loan)
+	}
+	if !loan.Time.Equal(time.Unix(1609459200, 5e8)) {
+		t.Errorf("received: '%v' but expected: '%v'", loan.Time, time.Unix(1609459200, 5e8))
+	}
+	short := Position{Type: "sell", Cost: 29000, Margin: 5800, Volume: 2, VolumeClosed: 0.5}
+	loan = positionToLoan("short", &short, currency.XBT, currency.USD)
+	if loan.Currency != currency.XBT || loan.Amount != 1.5 {
+		t.Errorf("unexpected short loan %+v", loan)
+	}
+}
+
+func TestLedgerToInterestPayment(t *testing.T) {
+	t.Parallel()
+	p := ledgerToInterestPayment(&LedgerInfo{Type: krakenLedgerRollover, Asset: "NOTSEEDED", Fee: 0.0016, Time: 1609459200})
+	if p.Currency.String() != "NOTSEEDED" || p.Interest != 0.0016 || p.Side != margin.Borrow || !p.Time.Equal(time.Unix(1609459200, 0)) {
+		t.Errorf("unexpected payment %+v", p)
+	}
+}
@@ -1,6 +1,7 @@
 package kraken
 
 import (
+	"errors"
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/currency"
@@ -30,6 +31,7 @@ const (
 	krakenQueryLedgers     = "QueryLedgers"
 	krakenTradeVolume      = "TradeVolume"
 	krakenOrderCancel      = "CancelOrder"
+	krakenOrderCancelAfter = "CancelAllOrdersAfter"
 	krakenOrderPlace       = "AddOrder"
 	krakenWithdrawInfo     = "WithdrawInfo"
 	krakenWithdraw         = "Withdraw"
@@ -39,6 +41,12 @@ const (
 	krakenWithdrawCancel   = "WithdrawCancel"
 	krakenWebsocketToken   = "GetWebSocketsToken"
 
+	// krakenLedgerRollover is the ledger type for interest charged on
+	// margin positions
+	krakenLedgerRollover = "rollover"
+	// krakenLedgerPageSize is the number of entries returned per ledger page
+	krakenLedgerPageSize = 50
+
 	// Futures
 	futuresTickers      = "/api/v3/tickers"
 	futuresOrderbook    = "/api/v3/orderbook"
@@ -73,6 +81,8 @@ const (
 
 var (
 	assetTranslator assetTranslatorStore
+
+	errInvalidTimeout = errors.New("timeout cannot be negative")
 )
 
 // GenericResponse stores general response data for functions that only return success
@@ -417,6 +427,12 @@ type CancelOrderResponse struct {
 	Pending interface{} `json:"pending"`
 }
 
+// CancelAllOrdersAfterResponse type
+type CancelAllOrdersAfterResponse struct {
+	CurrentTime time.Time `json:"currentTime"`
+	TriggerTime time.Time `json:"triggerTime"`
+}
+
 // DepositFees the large list of predefined deposit fees
 // Prone to change
 var DepositFees = map[currency.Code]float64{
@@ -457,6 +473,7 @@ var WithdrawalFees = map[currency.Code]float64{
 // DepositAddress defines a deposit address
 type DepositAddress struct {
 	Address    string `json:"address"`
+	Tag        string `json:"tag"`
 	ExpireTime int64  `json:"expiretm,string"`
 	New        bool   `json:"new"`
 }
@@ -18,6 +18,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fill"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
@@ -525,12 +526,27 @@ func (k *Kraken) wsProcessOwnTrades(ownOrders interface{}) error {
 					Type:      oType,
 					Side:      oSide,
 					Timestamp: convert.TimeFromUnixTimestampDecimal(val.Time),
+					Total:     val.Cost,
 				}
 				k.Websocket.DataHandler <- &order.Detail{
 					Exchange: k.Name,
 					ID:       val.OrderTransactionID,
 					Trades:   []order.TradeHistory{trade},
 				}
+				p, err := currency.NewPairFromString(val.Pair)
+				if err != nil {
+					return err
+				}
+				a, err := k.GetPairAssetType(p)
+				if err != nil {
+					return err
+				}
+				k.Websocket.DataHandler <- []fill.Data{{
+					TradeHistory: trade,
+					OrderID:      val.OrderTransactionID,
+					Pair:         p,
+					AssetType:    a,
+				}}
 			}
 		}
 		return nil
@@ -2,7 +2,6 @@ package kraken
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"sort"
 	"strconv"
@@ -17,7 +16,10 @@ import (
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/capability"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/deposit"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
@@ -125,6 +127,7 @@ func (k *Kraken) SetDefaults() {
 				FiatWithdrawalFee:   true,
 				CryptoDepositFee:    true,
 				CryptoWithdrawalFee: true,
+				DeadMansSwitch:      true,
 			},
 			WebsocketCapabilities: protocol.Features{
 				TickerFetching:     true,
@@ -148,6 +151,10 @@ func (k *Kraken) SetDefaults() {
 				DateRanges: true,
 				Intervals:  true,
 			},
+			Orders: capability.Orders{
+				Types:       []order.Type{order.Limit, order.Market},
+				TimeInForce: []capability.TimeInForce{capability.GoodTillCancel},
+			},
 		},
 		Enabled: exchange.FeaturesEnabled{
 			AutoPairUpdates: true,
@@ -857,6 +864,34 @@ func (k *Kraken) CancelAllOrders(ctx context.Context, req *order.Cancel) (order.
 	return cancelAllOrdersResponse, nil
 }
 
+// SetDeadMansSwitch arms or refreshes the timer which cancels all open orders
+// for the asset when it is not refreshed within the timeout, a zero timeout
+// disarms the timer
+func (k *Kraken) SetDeadMansSwitch(ctx context.Context, a asset.Item, timeout time.Duration) error {
+	if timeout < 0 {
+		return errInvalidTimeout
+	}
+	seconds := int64(timeout / time.Second)
+	if timeout > 0 && seconds == 0 {
+		seconds = 1
+	}
+	switch a {
+	case asset.Spot:
+		_, err := k.CancelAllOrdersAfter(ctx, seconds)
+		return err
+	case asset.Futures:
+		resp, err := k.FuturesCancelAllOrdersAfter(ctx, seconds)
+		if err != nil {
+			return err
+		}
+		if resp.Result != "" && resp.Result != "success" {
+			return fmt.Errorf("%s %s", k.Name, resp.Result)
+		}
+		return nil
+	}
+	return fmt.Errorf("%s %w", a, asset.ErrNotSupported)
+}
+
 // GetOrderInfo returns information on a current open order
 func (k *Kraken) GetOrderInfo(ctx context.Context, orderID string, pair currency.Pair, assetType asset.Item) (order.Detail, error) {
 	var orderDetail order.Detail
@@ -978,19 +1013,56 @@ func (k *Kraken) GetOrderInfo(ctx context.Context, orderID string, pair currency
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (k *Kraken) GetDepositAddress(ctx context.Context, cryptocurrency currency.Code, _ string) (string, error) {
+// Kraken deposit methods are the chains a currency can be deposited on
+func (k *Kraken) GetDepositAddress(ctx context.Context, cryptocurrency currency.Code, _, chain string) (*deposit.Address, error) {
 	methods, err := k.GetDepositMethods(ctx, cryptocurrency.String())
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 	var method string
-	for _, m := range methods {
-		method = m.Method
+	for x := range methods {
+		if chain == "" || strings.EqualFold(methods[x].Method, chain) {
+			method = methods[x].Method
+			break
+		}
 	}
 	if method == "" {
-		return "", errors.New("method not found")
+		return nil, fmt.Errorf("%s %s %w", cryptocurrency, chain, deposit.ErrNetworkNotFound)
+	}
+	addr, err := k.GetCryptoDepositAddress(ctx, method, cryptocurrency.String())
+	if err != nil {
+		return nil, err
 	}
-	return k.GetCryptoDepositAddress(ctx, method, cryptocurrency.String())
+	return &deposit.Address{
+		Address: addr.Address,
+		Tag:     addr.Tag,
+		Chain:   method,
+	}, nil
+}
+
+// GetAvailableTransferChains returns the networks the cryptocurrency can be
+// deposited on. Kraken withdrawals are sent to the network of the address
+// registered against the withdrawal key.
+func (k *Kraken) GetAvailableTransferChains(ctx context.Context, cryptocurrency currency.Code) ([]deposit.Network, error) {
+	methods, err := k.GetDepositMethods(ctx, cryptocurrency.String())
+	if err != nil {
+		return nil, err
+	}
+	if len(methods) == 0 {
+		return nil, fmt.Errorf("%s %w", cryptocurrency, deposit.ErrNoNetworks)
+	}
+	networks := make([]deposit.Network, len(methods))
+	for x := range methods {
+		networks[x] = deposit.Network{
+			Currency:        cryptocurrency,
+			Chain:           methods[x].Method,
+			Name:            methods[x].Method,
+			IsDefault:       x == 0,
+			DepositEnabled:  true,
+			WithdrawEnabled: true,
+		}
+	}
+	return networks, nil
 }
 
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal
@@ -1504,3 +1576,140 @@ func compatibleFillOrderType(fillType string) (order.Type, error) {
 	}
 	return resp, nil
 }
+
+// GetMarginInterestRates is not supported, Kraken publishes its rollover rates
+// but does not provide them via its API
+func (k *Kraken) GetMarginInterestRates(_ context.Context, _ currency.Code) ([]margin.InterestRate, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// MarginBorrow is not supported, Kraken borrows funds automatically when a
+// leveraged order is placed
+func (k *Kraken) MarginBorrow(_ context.Context, _ *margin.LoanRequest) (*margin.LoanResponse, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// MarginRepay is not supported, borrowed funds are repaid when the margin
+// position is closed
+func (k *Kraken) MarginRepay(_ context.Context, _ *margin.LoanRequest) (*margin.LoanResponse, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// SubmitMarginLendOffer is not supported, Kraken does not have a lending
+// market
+func (k *Kraken) SubmitMarginLendOffer(_ context.Context, _ *margin.LendOffer) (*margin.LoanResponse, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// CancelMarginLendOffer is not supported, Kraken does not have a lending
+// market
+func (k *Kraken) CancelMarginLendOffer(_ context.Context, _ currency.Code, _ string) error {
+	return common.ErrFunctionNotSupported
+}
+
+// GetMarginLoans returns the funds borrowed by open margin positions, an empty
+// currency returns all currencies
+func (k *Kraken) GetMarginLoans(ctx context.Context, c currency.Code) ([]margin.Loan, error) {
+	positions, err := k.OpenPositions(ctx, false)
+	if err != nil {
+		return nil, err
+	}
+	if len(positions) == 0 {
+		return nil, nil
+	}
+	pairs, err := k.GetAssetPairs(ctx, nil, "")
+	if err != nil {
+		return nil, err
+	}
+	loans := make([]margin.Loan, 0, len(positions))
+	for id := range positions {
+		p := positions[id]
+		pair, ok := pairs[p.Pair]
+		if !ok {
+			return nil, fmt.Errorf("%s unable to find asset pair %s for position %s", k.Name, p.Pair, id)
+		}
+		loan := positionToLoan(id, &p, krakenCurrency(pair.Base), krakenCurrency(pair.Quote))
+		if !c.IsEmpty() && !c.Match(loan.Currency) {
+			continue
+		}
+		loans = append(loans, loan)
+	}
+	sort.Slice(loans, func(i, j int) bool { return loans[i].Time.Before(loans[j].Time) })
+	return loans, nil
+}
+
+// GetMarginInterestHistory returns the rollover fees charged on margin
+// positions, ledger pages are requested until every entry in the time range
+// has been returned
+func (k *Kraken) GetMarginInterestHistory(ctx context.Context, c currency.Code, start, end time.Time) ([]margin.InterestPayment, error) {
+	opts := GetLedgersOptions{Type: krakenLedgerRollover}
+	if !c.IsEmpty() {
+		opts.Asset = c.Upper().String()
+		if a := assetTranslator.LookupCurrency(opts.Asset); a != "" {
+			opts.Asset = a
+		}
+	}
+	if !start.IsZero() {
+		opts.Start = strconv.FormatInt(start.Unix(), 10)
+	}
+	if !end.IsZero() {
+		opts.End = strconv.FormatInt(end.Unix(), 10)
+	}
+	var payments []margin.InterestPayment
+	for {
+		ledgers, err := k.GetLedgers(ctx, opts)
+		if err != nil {
+			return nil, err
+		}
+		for id := range ledgers.Ledger {
+			l := ledgers.Ledger[id]
+			payments = append(payments, ledgerToInterestPayment(&l))
+		}
+		opts.Ofs += int64(len(ledgers.Ledger))
+		if len(ledgers.Ledger) < krakenLedgerPageSize || opts.Ofs >= ledgers.Count {
+			break
+		}
+	}
+	sort.Slice(payments, func(i, j int) bool { return payments[i].Time.Before(payments[j].Time) })
+	return margin.FilterInterestPayments(payments, start, end), nil
+}
+
+// positionToLoan converts an open margin position into the funds it has
+// borrowed, long positions borrow the quote currency to buy with and short
+// positions borrow the base currency to sell
+func positionToLoan(id string, p *Position, base, quote currency.Code) margin.Loan {
+	loan := margin.Loan{
+		ID:     id,
+		Side:   margin.Borrow,
+		Status: margin.Active,
+		Time:   convert.TimeFromUnixTimestampDecimal(p.Time),
+	}
+	if strings.EqualFold(p.Type, order.Sell.Lower()) {
+		loan.Currency = base
+		loan.Amount = p.Volume - p.VolumeClosed
+	} else {
+		loan.Currency = quote
+		loan.Amount = p.Cost - p.Margin
+	}
+	return loan
+}
+
+// ledgerToInterestPayment converts a rollover ledger entry into an interest
+// payment, the rollover charge is held in the entry's fee
+func ledgerToInterestPayment(l *LedgerInfo) margin.InterestPayment {
+	return margin.InterestPayment{
+		Currency: krakenCurrency(l.Asset),
+		Side:     margin.Borrow,
+		Interest: l.Fee,
+		Time:     convert.TimeFromUnixTimestampDecimal(l.Time),
+	}
+}
+
+// krakenCurrency converts a Kraken asset name into its currency code, asset
+// names which have not been seeded are used as is
+func krakenCurrency(a string) currency.Code {
+	if alt := assetTranslator.LookupAltname(a); alt != "" {
+		return currency.NewCode(alt)
+	}
+	return currency.NewCode(a)
+}
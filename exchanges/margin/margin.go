@@ -0,0 +1,94 @@
+package margin
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Validate checks that a borrow or repay request can be submitted
+func (l *LoanRequest) Validate() error {
+	if l == nil {
+		return ErrLoanRequestIsNil
+	}
+	if l.Currency.IsEmpty() {
+		return ErrCurrencyIsEmpty
+	}
+	if l.Amount <= 0 {
+		return fmt.Errorf("%v %w", l.Amount, ErrInvalidAmount)
+	}
+	return nil
+}
+
+// String returns a readable description of the request e.g. 1.5 BTC or
+// 1.5 BTC isolated BTC-USDT
+func (l *LoanRequest) String() string {
+	s := fmt.Sprintf("%v %v", l.Amount, l.Currency)
+	if !l.Pair.IsEmpty() {
+		s += " isolated " + l.Pair.String()
+	}
+	return s
+}
+
+// Validate checks that a lending offer can be submitted
+func (l *LendOffer) Validate() error {
+	if l == nil {
+		return ErrLendOfferIsNil
+	}
+	if l.Currency.IsEmpty() {
+		return ErrCurrencyIsEmpty
+	}
+	if l.Amount <= 0 {
+		return fmt.Errorf("%v %w", l.Amount, ErrInvalidAmount)
+	}
+	if l.Rate <= 0 {
+		return fmt.Errorf("%v %w", l.Rate, errInvalidRate)
+	}
+	if l.Period < 0 {
+		return fmt.Errorf("%v %w", l.Period, errInvalidPeriod)
+	}
+	return nil
+}
+
+// String implements the stringer interface
+func (s Side) String() string {
+	return string(s)
+}
+
+// String implements the stringer interface
+func (s Status) String() string {
+	return string(s)
+}
+
+// StringToSide converts a side string to a loan Side
+func StringToSide(s string) (Side, error) {
+	switch strings.ToLower(s) {
+	case "borrow", "borrowed", "used":
+		return Borrow, nil
+	case "lend", "lent", "provided":
+		return Lend, nil
+	default:
+		return UnknownSide, fmt.Errorf("%q %w", s, errInvalidSide)
+	}
+}
+
+// FilterInterestPayments returns the payments which fall within the start and
+// end times inclusive, sorted by time ascending. Zero start or end times are
+// not bounded.
+func FilterInterestPayments(payments []InterestPayment, start, end time.Time) []InterestPayment {
+	filtered := make([]InterestPayment, 0, len(payments))
+	for i := range payments {
+		if !start.IsZero() && payments[i].Time.Before(start) {
+			continue
+		}
+		if !end.IsZero() && payments[i].Time.After(end) {
+			continue
+		}
+		filtered = append(filtered, payments[i])
+	}
+	sort.Slice(filtered, func(i, j int) bool {
+		return filtered[i].Time.Before(filtered[j].Time)
+	})
+	return filtered
+}
@@ -0,0 +1,124 @@
+package margin
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+)
+
+func TestLoanRequestValidate(t *testing.T) {
+	t.Parallel()
+	var l *LoanRequest
+	err := l.Validate()
+	if !errors.Is(err, ErrLoanRequestIsNil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrLoanRequestIsNil)
+	}
+	l = &LoanRequest{}
+	err = l.Validate()
+	if !errors.Is(err, ErrCurrencyIsEmpty) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrCurrencyIsEmpty)
+	}
+	l.Currency = currency.BTC
+	err = l.Validate()
+	if !errors.Is(err, ErrInvalidAmount) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrInvalidAmount)
+	}
+	l.Amount = 1.5
+	err = l.Validate()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+}
+
+func TestLoanRequestString(t *testing.T) {
+	t.Parallel()
+	l := &LoanRequest{Currency: currency.BTC, Amount: 1.5}
+	if s := l.String(); s != "1.5 BTC" {
+		t.Errorf("received: '%v' but expected: '%v'", s, "1.5 BTC")
+	}
+	l.Pair = currency.NewPairWithDelimiter("BTC", "USDT", "-")
+	if s := l.String(); s != "1.5 BTC isolated BTC-USDT" {
+		t.Errorf("received: '%v' but expected: '%v'", s, "1.5 BTC isolated BTC-USDT")
+	}
+}
+
+func TestLendOfferValidate(t *testing.T) {
+	t.Parallel()
+	var l *LendOffer
+	err := l.Validate()
+	if !errors.Is(err, ErrLendOfferIsNil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrLendOfferIsNil)
+	}
+	l = &LendOffer{}
+	err = l.Validate()
+	if !errors.Is(err, ErrCurrencyIsEmpty) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrCurrencyIsEmpty)
+	}
+	l.Currency = currency.USD
+	err = l.Validate()
+	if !errors.Is(err, ErrInvalidAmount) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrInvalidAmount)
+	}
+	l.Amount = 100
+	err = l.Validate()
+	if !errors.Is(err, errInvalidRate) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errInvalidRate)
+	}
+	l.Rate = 0.0002
+	l.Period = -1
+	err = l.Validate()
+	if !errors.Is(err, errInvalidPeriod) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errInvalidPeriod)
+	}
+	l.Period = 2
+	err = l.Validate()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+}
+
+func TestStringToSide(t *testing.T) {
+	t.Parallel()
+	s, err := StringToSide("Provided")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if s != Lend {
+		t.Errorf("received: '%v' but expected: '%v'", s, Lend)
+	}
+	s, err = StringToSide("borrow")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if s != Borrow {
+		t.Errorf("received: '%v' but expected: '%v'", s, Borrow)
+	}
+	_, err = StringToSide("short")
+	if !errors.Is(err, errInvalidSide) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errInvalidSide)
+	}
+}
+
+func TestFilterInterestPayments(t *testing.T) {
+	t.Parallel()
+	tt := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	payments := []InterestPayment{
+		{Time: tt.Add(time.Hour * 2)},
+		{Time: tt},
+		{Time: tt.Add(time.Hour)},
+		{Time: tt.Add(time.Hour * 3)},
+	}
+	filtered := FilterInterestPayments(payments, tt.Add(time.Hour), tt.Add(time.Hour*2))
+	if len(filtered) != 2 {
+		t.Fatalf("received: '%v' but expected: '%v'", len(filtered), 2)
+	}
+	if !filtered[0].Time.Equal(tt.Add(time.Hour)) {
+		t.Errorf("received: '%v' but expected: '%v'", filtered[0].Time, tt.Add(time.Hour))
+	}
+	filtered = FilterInterestPayments(payments, time.Time{}, time.Time{})
+	if len(filtered) != 4 || !filtered[0].Time.Equal(tt) {
+		t.Errorf("unexpected unbounded filter result %v", filtered)
+	}
+}
@@ -0,0 +1,107 @@
+package margin
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+)
+
+var (
+	// ErrLoanRequestIsNil is returned when a nil borrow or repay request is
+	// submitted
+	ErrLoanRequestIsNil = errors.New("loan request is nil")
+	// ErrLendOfferIsNil is returned when a nil lending offer is submitted
+	ErrLendOfferIsNil = errors.New("lending offer is nil")
+	// ErrCurrencyIsEmpty is returned when a request is made without a
+	// currency
+	ErrCurrencyIsEmpty = errors.New("currency is empty")
+	// ErrInvalidAmount is returned when a loan amount is not a positive number
+	ErrInvalidAmount = errors.New("amount must be greater than zero")
+
+	errInvalidRate   = errors.New("lending rate must be greater than zero")
+	errInvalidPeriod = errors.New("lending period cannot be negative")
+	errInvalidSide   = errors.New("invalid loan side")
+)
+
+// Side defines whether funds have been borrowed from or lent to the
+// exchange's lending market
+type Side string
+
+// Loan sides
+const (
+	UnknownSide Side = ""
+	Borrow      Side = "borrow"
+	Lend        Side = "lend"
+)
+
+// Status defines whether a loan is waiting to be matched or is active
+type Status string
+
+// Loan statuses
+const (
+	UnknownStatus Status = ""
+	Offered       Status = "offered"
+	Active        Status = "active"
+)
+
+// LoanRequest holds the details required to borrow or repay funds. Pair is
+// only set when borrowing into or repaying an isolated margin account
+type LoanRequest struct {
+	Currency currency.Code
+	Amount   float64
+	Pair     currency.Pair
+}
+
+// LoanResponse holds the exchange's response to a borrow, repay or lending
+// offer request
+type LoanResponse struct {
+	ID       string
+	Currency currency.Code
+	Amount   float64
+	Time     time.Time
+}
+
+// LendOffer holds the details of an offer to lend funds. Rate is the daily
+// interest rate expressed as a fraction e.g. 0.0002 for 0.02% per day and
+// Period is the loan duration in days, zero uses the exchange default
+type LendOffer struct {
+	Currency  currency.Code
+	Amount    float64
+	Rate      float64
+	Period    int64
+	AutoRenew bool
+}
+
+// Loan holds an open lending offer or an active borrowed or lent loan
+type Loan struct {
+	ID        string
+	Side      Side
+	Status    Status
+	Currency  currency.Code
+	Amount    float64
+	Rate      float64
+	Period    int64
+	AutoRenew bool
+	Time      time.Time
+}
+
+// InterestRate holds the daily borrow rate for a currency, expressed as a
+// fraction
+type InterestRate struct {
+	Exchange string
+	Currency currency.Code
+	Rate     float64
+	Time     time.Time
+}
+
+// InterestPayment holds interest paid on borrowed funds or earned on lent
+// funds
+type InterestPayment struct {
+	Currency  currency.Code
+	Side      Side
+	Principal float64
+	Interest  float64
+	Rate      float64
+	Time      time.Time
+}
@@ -31,15 +31,17 @@ const (
 	okGroupSwapSubsection    = "swap"
 	okGroupETTSubsection     = "ett"
 	okGroupMarginSubsection  = "margin"
+	okGroupOptionSubsection  = "option"
 	// Futures based endpoints
-	okGroupFuturePosition = "position"
-	okGroupFutureLeverage = "leverage"
-	okGroupFutureOrder    = "order"
-	okGroupFutureHolds    = "holds"
-	okGroupIndices        = "index"
-	okGroupRate           = "rate"
-	okGroupEsimtatedPrice = "estimated_price"
-	okGroupOpenInterest   = "open_interest"
+	okGroupFuturePosition   = "position"
+	okGroupFutureLeverage   = "leverage"
+	okGroupFutureMarginMode = "margin_mode"
+	okGroupFutureOrder      = "order"
+	okGroupFutureHolds      = "holds"
+	okGroupIndices          = "index"
+	okGroupRate             = "rate"
+	okGroupEsimtatedPrice   = "estimated_price"
+	okGroupOpenInterest     = "open_interest"
 	// Perpetual swap based endpoints
 	okGroupSettings              = "settings"
 	okGroupDepth                 = "depth"
@@ -54,6 +56,20 @@ const (
 	okGroupMarginPairData  = "accounts/%s/availability"
 	okGroupMarginPairsData = "accounts/availability"
 	okGroupInstruments     = "instruments"
+	// Option endpoints
+	okGroupOptionUnderlying = "underlying"
+	okGroupOptionSummary    = "summary"
+	okGroupOptionOrder      = "order"
+	// Futures margin modes
+	marginModeCrossed = "crossed"
+	marginModeFixed   = "fixed"
+)
+
+var (
+	errInvalidOptionInstrument  = errors.New("invalid option instrument")
+	errInvalidFuturesInstrument = errors.New("invalid futures instrument")
+	errInvalidMarginMode        = errors.New("invalid margin mode")
+	errFractionalLeverage       = errors.New("leverage must be a whole number")
 )
 
 // OKEX bases all account, spot and margin methods off okgroup implementation
@@ -240,6 +256,13 @@ func (o *OKEX) SetFuturesLeverage(ctx context.Context, request okgroup.SetFuture
 	return resp, o.SendHTTPRequest(ctx, exchange.RestSpot, http.MethodPost, okGroupFuturesSubsection, requestURL, request, &resp, true)
 }
 
+// SetFuturesMarginMode sets the margin mode of all futures contracts for an
+// underlying, e.g. BTC-USD, to either crossed or fixed
+func (o *OKEX) SetFuturesMarginMode(ctx context.Context, request okgroup.SetFuturesMarginModeRequest) (resp okgroup.SetFuturesMarginModeResponse, _ error) {
+	requestURL := fmt.Sprintf("%v/%v", okgroup.OKGroupAccounts, okGroupFutureMarginMode)
+	return resp, o.SendHTTPRequest(ctx, exchange.RestSpot, http.MethodPost, okGroupFuturesSubsection, requestURL, request, &resp, true)
+}
+
 // GetFuturesBillDetails Shows the account’s historical coin in flow and out flow.
 // All paginated requests return the latest information (newest) as the first page sorted by newest (in chronological time) first.
 func (o *OKEX) GetFuturesBillDetails(ctx context.Context, request okgroup.GetSpotBillDetailsForCurrencyRequest) (resp []okgroup.GetSpotBillDetailsForCurrencyResponse, _ error) {
@@ -586,3 +609,39 @@ func (o *OKEX) GetETTSettlementPriceHistory(ctx context.Context, ett string) (re
 	requestURL := fmt.Sprintf("%v/%v", okGroupDefinePrice, ett)
 	return resp, o.SendHTTPRequest(ctx, exchange.RestSpot, http.MethodGet, okGroupETTSubsection, requestURL, nil, &resp, false)
 }
+
+// GetOptionUnderlyings returns the underlying indices which have listed option
+// contracts e.g. BTC-USD. This is a public endpoint, no identity verification
+// is needed.
+func (o *OKEX) GetOptionUnderlyings(ctx context.Context) (resp []string, _ error) {
+	return resp, o.SendHTTPRequest(ctx, exchange.RestSpot, http.MethodGet, okGroupOptionSubsection, okGroupOptionUnderlying, nil, &resp, false)
+}
+
+// GetOptionInstruments returns the listed option contracts for an underlying.
+// This is a public endpoint, no identity verification is needed.
+func (o *OKEX) GetOptionInstruments(ctx context.Context, underlying string) (resp []okgroup.OptionInstrument, _ error) {
+	requestURL := fmt.Sprintf("%v/%v", okGroupInstruments, underlying)
+	return resp, o.SendHTTPRequest(ctx, exchange.RestSpot, http.MethodGet, okGroupOptionSubsection, requestURL, nil, &resp, false)
+}
+
+// GetOptionMarketSummary returns the market data, greeks and implied
+// volatility of all option contracts for an underlying. This is a public
+// endpoint, no identity verification is needed.
+func (o *OKEX) GetOptionMarketSummary(ctx context.Context, underlying string) (resp []okgroup.OptionSummary, _ error) {
+	requestURL := fmt.Sprintf("%v/%v/%v", okGroupInstruments, underlying, okGroupOptionSummary)
+	return resp, o.SendHTTPRequest(ctx, exchange.RestSpot, http.MethodGet, okGroupOptionSubsection, requestURL, nil, &resp, false)
+}
+
+// GetOptionInstrumentSummary returns the market data, greeks and implied
+// volatility of a single option contract. This is a public endpoint, no
+// identity verification is needed.
+func (o *OKEX) GetOptionInstrumentSummary(ctx context.Context, underlying, instrumentID string) (resp okgroup.OptionSummary, _ error) {
+	requestURL := fmt.Sprintf("%v/%v/%v/%v", okGroupInstruments, underlying, okGroupOptionSummary, instrumentID)
+	return resp, o.SendHTTPRequest(ctx, exchange.RestSpot, http.MethodGet, okGroupOptionSubsection, requestURL, nil, &resp, false)
+}
+
+// PlaceOptionOrder places an order for an option contract. Only limit orders
+// are supported.
+func (o *OKEX) PlaceOptionOrder(ctx context.Context, request *okgroup.PlaceOptionOrderRequest) (resp okgroup.PlaceOptionOrderResponse, _ error) {
+	return resp, o.SendHTTPRequest(ctx, exchange.RestSpot, http.MethodPost, okGroupOptionSubsection, okGroupOptionOrder, request, &resp, true)
+}
@@ -3,6 +3,7 @@ package okex
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log"
 	"net/http"
@@ -19,11 +20,15 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/mock"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/okgroup"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/options"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/sharedtestvalues"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 )
 
@@ -2104,6 +2109,38 @@ func TestWsUserOrders(t *testing.T) {
 	if err != nil {
 		t.Error(err)
 	}
+
+	pressXToJSON = []byte(`{
+    "table":"spot/order",
+    "data":[
+        {
+            "client_oid":"",
+            "filled_notional":"0.5826",
+            "filled_size":"0.1",
+            "instrument_id":"ETC-USDT",
+            "last_fill_id":"5829034",
+            "last_fill_px":"5.826",
+            "last_fill_qty":"0.1",
+            "last_fill_time":"2019-09-24T06:45:12.394Z",
+            "margin_trading":"1",
+            "notional":"",
+            "order_id":"3576398568830976",
+            "order_type":"0",
+            "price":"5.826",
+            "side":"buy",
+            "size":"0.1",
+            "state":"2",
+            "status":"filled",
+            "timestamp":"2019-09-24T06:45:12.394Z",
+            "type":"limit",
+            "created_at":"2019-09-24T06:45:11.394Z"
+        }
+    ]
+}`)
+	err = o.WsHandleData(pressXToJSON)
+	if err != nil {
+		t.Error(err)
+	}
 }
 
 func TestWsAlgoOrders(t *testing.T) {
@@ -2325,3 +2362,342 @@ func TestUpdateTickers(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+const optionsMockFile = "../../testdata/http_mock/okex/okex.json"
+
+var optionsPair = currency.NewPairWithDelimiter("BTC-USD", "210625-40000-C", currency.UnderscoreDelimiter)
+
+// newOptionsMockExchange returns an OKEX instance which sends its REST
+// requests to the recorded options responses
+func newOptionsMockExchange(t *testing.T) *OKEX {
+	t.Helper()
+	var m OKEX
+	m.SetDefaults()
+	m.Verbose = false
+	serverURL, client, err := mock.NewVCRServer(optionsMockFile)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m.SetHTTPClient(client)
+	err = m.API.Endpoints.SetRunning(exchange.RestSpot.String(), serverURL+"/"+okExAPIPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m.CurrencyPairs.StorePairs(asset.Options, currency.Pairs{optionsPair}, false)
+	m.CurrencyPairs.StorePairs(asset.Options, currency.Pairs{optionsPair}, true)
+	return &m
+}
+
+func TestFetchTradablePairsOptions(t *testing.T) {
+	t.Parallel()
+	m := newOptionsMockExchange(t)
+	pairs, err := m.FetchTradablePairs(context.Background(), asset.Options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pairs) != 3 {
+		t.Fatalf("received: '%v' but expected: '%v'", len(pairs), 3)
+	}
+	if pairs[0] != "BTC-USD_210625-40000-C" {
+		t.Errorf("received: '%v' but expected: '%v'", pairs[0], "BTC-USD_210625-40000-C")
+	}
+}
+
+func TestGetOptionsChain(t *testing.T) {
+	t.Parallel()
+	m := newOptionsMockExchange(t)
+	_, err := m.GetOptionsChain(context.Background(), currency.Pair{})
+	if !errors.Is(err, options.ErrPairIsEmpty) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, options.ErrPairIsEmpty)
+	}
+
+	chain, err := m.GetOptionsChain(context.Background(), currency.NewPair(currency.BTC, currency.USD))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(chain) != 3 {
+		t.Fatalf("received: '%v' but expected: '%v'", len(chain), 3)
+	}
+	if !chain[0].Pair.Equal(optionsPair) {
+		t.Errorf("received: '%v' but expected: '%v'", chain[0].Pair, optionsPair)
+	}
+	if chain[0].Strike != 40000 || chain[0].Type != options.Call {
+		t.Errorf("unexpected contract %+v", chain[0])
+	}
+	if chain[1].Type != options.Put {
+		t.Errorf("received: '%v' but expected: '%v'", chain[1].Type, options.Put)
+	}
+	expiry := time.Date(2021, 6, 25, 8, 0, 0, 0, time.UTC)
+	if !chain[0].Expiry.Equal(expiry) {
+		t.Errorf("received: '%v' but expected: '%v'", chain[0].Expiry, expiry)
+	}
+}
+
+func TestGetOptionsGreeks(t *testing.T) {
+	t.Parallel()
+	m := newOptionsMockExchange(t)
+	_, err := m.GetOptionsGreeks(context.Background(), currency.NewPair(currency.BTC, currency.USD))
+	if err == nil {
+		t.Fatal("expected error for a non option contract")
+	}
+
+	greeks, err := m.GetOptionsGreeks(context.Background(), optionsPair)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if greeks.Delta != 0.5512 || greeks.Gamma != 1.7623 || greeks.Theta != -0.0011 || greeks.Vega != 0.0009 {
+		t.Errorf("unexpected greeks %+v", greeks)
+	}
+	if greeks.MarkIV != 0.8823 || greeks.BidIV != 0.8712 || greeks.AskIV != 0.8934 {
+		t.Errorf("unexpected implied volatility %+v", greeks)
+	}
+	if greeks.Asset != asset.Options || !greeks.Pair.Equal(optionsPair) {
+		t.Errorf("unexpected contract details %+v", greeks)
+	}
+}
+
+func TestUpdateTickersOptions(t *testing.T) {
+	t.Parallel()
+	m := newOptionsMockExchange(t)
+	err := m.UpdateTickers(context.Background(), asset.Options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tick, err := ticker.GetTicker(m.Name, optionsPair, asset.Options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tick.Last != 0.1205 || tick.MarkPrice != 0.1204 {
+		t.Errorf("unexpected ticker prices %+v", tick)
+	}
+	if tick.ImpliedVolatility != 0.8823 || tick.Delta != 0.5512 {
+		t.Errorf("unexpected ticker greeks %+v", tick)
+	}
+}
+
+func TestUpdateOrderbookOptions(t *testing.T) {
+	t.Parallel()
+	m := newOptionsMockExchange(t)
+	ob, err := m.UpdateOrderbook(context.Background(), optionsPair, asset.Options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ob.Bids) != 2 || len(ob.Asks) != 2 {
+		t.Fatalf("received: '%v' bids '%v' asks but expected: '2' bids '2' asks", len(ob.Bids), len(ob.Asks))
+	}
+	if ob.Bids[0].Price != 0.1195 || ob.Asks[0].Price != 0.1215 {
+		t.Errorf("unexpected top of book %+v %+v", ob.Bids[0], ob.Asks[0])
+	}
+}
+
+func TestSubmitOrderOptions(t *testing.T) {
+	t.Parallel()
+	m := newOptionsMockExchange(t)
+	_, err := m.SubmitOrder(context.Background(), &order.Submit{
+		Pair:      optionsPair,
+		Side:      order.Buy,
+		Type:      order.Market,
+		Amount:    1,
+		AssetType: asset.Options,
+	})
+	if !errors.Is(err, order.ErrTypeIsInvalid) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, order.ErrTypeIsInvalid)
+	}
+}
+
+func TestOptionInstrumentToPair(t *testing.T) {
+	t.Parallel()
+	_, err := optionInstrumentToPair("BTC-USD-SWAP", currency.UnderscoreDelimiter)
+	if !errors.Is(err, errInvalidOptionInstrument) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errInvalidOptionInstrument)
+	}
+	p, err := optionInstrumentToPair("BTC-USD-210625-40000-C", currency.UnderscoreDelimiter)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.String() != "BTC-USD_210625-40000-C" {
+		t.Errorf("received: '%v' but expected: '%v'", p, "BTC-USD_210625-40000-C")
+	}
+}
+
+var (
+	futuresCrossPair = currency.NewPairWithDelimiter("BTC-USD", "210625", currency.UnderscoreDelimiter)
+	futuresFixedPair = currency.NewPairWithDelimiter("ETH-USDT", "210625", currency.UnderscoreDelimiter)
+)
+
+// newFuturesMockExchange returns an OKEX instance which sends its
+// authenticated REST requests to the recorded futures responses
+func newFuturesMockExchange(t *testing.T) *OKEX {
+	t.Helper()
+	m := newOptionsMockExchange(t)
+	m.SkipAuthCheck = true
+	m.API.Credentials.Key = "key"
+	m.API.Credentials.Secret = "secret"
+	m.API.Credentials.ClientID = "passphrase"
+	return m
+}
+
+func TestGetFuturesPositionsWrapper(t *testing.T) {
+	t.Parallel()
+	m := newFuturesMockExchange(t)
+	_, err := m.GetFuturesPositions(context.Background(), asset.Spot, futuresCrossPair)
+	if !errors.Is(err, futures.ErrNotFuturesAsset) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, futures.ErrNotFuturesAsset)
+	}
+	_, err = m.GetFuturesPositions(context.Background(), asset.PerpetualSwap, futuresCrossPair)
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, asset.ErrNotSupported)
+	}
+
+	positions, err := m.GetFuturesPositions(context.Background(), asset.Futures, currency.Pair{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the cross holding is long only and the fixed holding has both sides
+	if len(positions) != 3 {
+		t.Fatalf("received: '%v' but expected: '%v'", len(positions), 3)
+	}
+
+	positions, err = m.GetFuturesPositions(context.Background(), asset.Futures, futuresCrossPair)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(positions) != 1 {
+		t.Fatalf("received: '%v' but expected: '%v'", len(positions), 1)
+	}
+	if !positions[0].Pair.Equal(futuresCrossPair) {
+		t.Errorf("received: '%v' but expected: '%v'", positions[0].Pair, futuresCrossPair)
+	}
+	if positions[0].Side != futures.Long {
+		t.Errorf("received: '%v' but expected: '%v'", positions[0].Side, futures.Long)
+	}
+	if positions[0].Size != 5 {
+		t.Errorf("received: '%v' but expected: '%v'", positions[0].Size, 5)
+	}
+	if positions[0].MarginType != futures.Cross {
+		t.Errorf("received: '%v' but expected: '%v'", positions[0].MarginType, futures.Cross)
+	}
+	if positions[0].LiquidationPrice != 49512.3 {
+		t.Errorf("received: '%v' but expected: '%v'", positions[0].LiquidationPrice, 49512.3)
+	}
+
+	positions, err = m.GetFuturesPositions(context.Background(), asset.Futures, futuresFixedPair)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(positions) != 2 {
+		t.Fatalf("received: '%v' but expected: '%v'", len(positions), 2)
+	}
+	if positions[1].Side != futures.Short {
+		t.Errorf("received: '%v' but expected: '%v'", positions[1].Side, futures.Short)
+	}
+	if positions[1].Leverage != 3 {
+		t.Errorf("received: '%v' but expected: '%v'", positions[1].Leverage, 3)
+	}
+	if positions[1].MarginType != futures.Isolated {
+		t.Errorf("received: '%v' but expected: '%v'", positions[1].MarginType, futures.Isolated)
+	}
+}
+
+func TestChangeFuturesLeverageWrapper(t *testing.T) {
+	t.Parallel()
+	m := newFuturesMockExchange(t)
+	err := m.ChangeFuturesLeverage(context.Background(), asset.Futures, futuresCrossPair, 0)
+	if !errors.Is(err, futures.ErrInvalidLeverage) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, futures.ErrInvalidLeverage)
+	}
+	err = m.ChangeFuturesLeverage(context.Background(), asset.PerpetualSwap, futuresCrossPair, 20)
+	if !errors.Is(err, asset.ErrNotSupported) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, asset.ErrNotSupported)
+	}
+	err = m.ChangeFuturesLeverage(context.Background(), asset.Futures, futuresCrossPair, 2.5)
+	if !errors.Is(err, errFractionalLeverage) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errFractionalLeverage)
+	}
+	err = m.ChangeFuturesLeverage(context.Background(), asset.Futures, futuresCrossPair, 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = m.ChangeFuturesLeverage(context.Background(), asset.Futures, futuresFixedPair, 20)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestChangeFuturesMarginTypeWrapper(t *testing.T) {
+	t.Parallel()
+	m := newFuturesMockExchange(t)
+	err := m.ChangeFuturesMarginType(context.Background(), asset.Futures, currency.Pair{}, futures.Isolated)
+	if !errors.Is(err, futures.ErrPairIsEmpty) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, futures.ErrPairIsEmpty)
+	}
+	err = m.ChangeFuturesMarginType(context.Background(), asset.Futures, futuresCrossPair, futures.UnknownMarginType)
+	if !errors.Is(err, futures.ErrMarginTypeNotSupported) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, futures.ErrMarginTypeNotSupported)
+	}
+	err = m.ChangeFuturesMarginType(context.Background(), asset.Futures, futuresCrossPair, futures.Isolated)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestGetFuturesMarginInfoWrapper(t *testing.T) {
+	t.Parallel()
+	m := newFuturesMockExchange(t)
+	_, err := m.GetFuturesMarginInfo(context.Background(), asset.Spot, futuresCrossPair)
+	if !errors.Is(err, futures.ErrNotFuturesAsset) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, futures.ErrNotFuturesAsset)
+	}
+
+	info, err := m.GetFuturesMarginInfo(context.Background(), asset.Futures, futuresCrossPair)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.MarginType != futures.Cross {
+		t.Errorf("received: '%v' but expected: '%v'", info.MarginType, futures.Cross)
+	}
+	if info.Leverage != 10 {
+		t.Errorf("received: '%v' but expected: '%v'", info.Leverage, 10)
+	}
+	if !info.Collateral.Match(currency.BTC) {
+		t.Errorf("received: '%v' but expected: '%v'", info.Collateral, currency.BTC)
+	}
+	if info.AvailableMargin != 1.5 {
+		t.Errorf("received: '%v' but expected: '%v'", info.AvailableMargin, 1.5)
+	}
+
+	info, err = m.GetFuturesMarginInfo(context.Background(), asset.Futures, futuresFixedPair)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.MarginType != futures.Isolated {
+		t.Errorf("received: '%v' but expected: '%v'", info.MarginType, futures.Isolated)
+	}
+	if info.Leverage != 5 {
+		t.Errorf("received: '%v' but expected: '%v'", info.Leverage, 5)
+	}
+	if !info.Collateral.Match(currency.USDT) {
+		t.Errorf("received: '%v' but expected: '%v'", info.Collateral, currency.USDT)
+	}
+}
+
+func TestMarginModeConversion(t *testing.T) {
+	t.Parallel()
+	_, err := marginModeToMarginType("isolated")
+	if !errors.Is(err, errInvalidMarginMode) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errInvalidMarginMode)
+	}
+	for _, m := range []futures.MarginType{futures.Cross, futures.Isolated} {
+		mode, err := marginTypeToMarginMode(m)
+		if err != nil {
+			t.Fatal(err)
+		}
+		back, err := marginModeToMarginType(mode)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if back != m {
+			t.Errorf("received: '%v' but expected: '%v'", back, m)
+		}
+	}
+}
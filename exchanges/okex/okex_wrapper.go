@@ -4,17 +4,22 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"math"
 	"sort"
+	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/futures"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/okgroup"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/options"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
@@ -122,6 +127,24 @@ func (o *OKEX) SetDefaults() {
 		log.Errorln(log.ExchangeSys, err)
 	}
 
+	// Option contracts are formatted as the underlying and the expiry, strike
+	// and type e.g. BTC-USD_210625-40000-C
+	option := currency.PairStore{
+		RequestFormat: &currency.PairFormat{
+			Uppercase: true,
+			Delimiter: currency.DashDelimiter,
+		},
+		ConfigFormat: &currency.PairFormat{
+			Uppercase: true,
+			Delimiter: currency.UnderscoreDelimiter,
+		},
+	}
+
+	err = o.StoreAssetPairFormat(asset.Options, option)
+	if err != nil {
+		log.Errorln(log.ExchangeSys, err)
+	}
+
 	o.Features = exchange.Features{
 		Supports: exchange.FeaturesSupported{
 			REST:      true,
@@ -354,6 +377,26 @@ func (o *OKEX) FetchTradablePairs(ctx context.Context, i asset.Item) ([]string,
 	case asset.Index:
 		// This is updated in futures index
 		return nil, errors.New("index updated in futures")
+	case asset.Options:
+		underlyings, err := o.GetOptionUnderlyings(ctx)
+		if err != nil {
+			return nil, err
+		}
+
+		for x := range underlyings {
+			prods, err := o.GetOptionInstruments(ctx, underlyings[x])
+			if err != nil {
+				return nil, err
+			}
+			for y := range prods {
+				p, err := optionInstrumentToPair(prods[y].InstrumentID, format.Delimiter)
+				if err != nil {
+					return nil, err
+				}
+				pairs = append(pairs, p.String())
+			}
+		}
+		return pairs, nil
 	}
 
 	return nil, fmt.Errorf("%s invalid asset type", o.Name)
@@ -533,6 +576,63 @@ func (o *OKEX) UpdateTickers(ctx context.Context, a asset.Item) error {
 				return err
 			}
 		}
+
+	case asset.Options:
+		enabled, err := o.GetEnabledPairs(asset.Options)
+		if err != nil {
+			return err
+		}
+
+		// Option summaries are returned per underlying so only request the
+		// underlyings which have enabled contracts
+		var underlyings []string
+		for x := range enabled {
+			u := enabled[x].Base.Upper().String()
+			if common.StringDataContains(underlyings, u) {
+				continue
+			}
+			underlyings = append(underlyings, u)
+		}
+
+		for x := range underlyings {
+			resp, err := o.GetOptionMarketSummary(ctx, underlyings[x])
+			if err != nil {
+				return err
+			}
+
+			for j := range resp {
+				nC, err := optionInstrumentToPair(resp[j].InstrumentID, currency.UnderscoreDelimiter)
+				if err != nil {
+					return err
+				}
+				if !enabled.Contains(nC, true) {
+					continue
+				}
+
+				err = ticker.ProcessTicker(&ticker.Price{
+					Last:              resp[j].Last,
+					High:              resp[j].High24h,
+					Low:               resp[j].Low24h,
+					Bid:               resp[j].BestBid,
+					BidSize:           resp[j].BestBidSize,
+					Ask:               resp[j].BestAsk,
+					AskSize:           resp[j].BestAskSize,
+					Volume:            resp[j].Volume24h,
+					MarkPrice:         resp[j].MarkPrice,
+					ImpliedVolatility: resp[j].MarkVolatility,
+					Delta:             resp[j].Delta,
+					Gamma:             resp[j].Gamma,
+					Theta:             resp[j].Theta,
+					Vega:              resp[j].Vega,
+					Pair:              nC,
+					LastUpdated:       resp[j].Timestamp,
+					ExchangeName:      o.Name,
+					AssetType:         a})
+				if err != nil {
+					return err
+				}
+			}
+		}
 	}
 
 	return nil
@@ -666,3 +766,434 @@ func (o *OKEX) GetRecentTrades(ctx context.Context, p currency.Pair, assetType a
 func (o *OKEX) CancelBatchOrders(_ context.Context, _ []order.Cancel) (order.CancelBatchResponse, error) {
 	return order.CancelBatchResponse{}, common.ErrNotYetImplemented
 }
+
+// SubmitOrder submits a new order, option contracts are placed via the
+// option trading endpoint and all other assets via the spot endpoint
+func (o *OKEX) SubmitOrder(ctx context.Context, s *order.Submit) (order.SubmitResponse, error) {
+	if s == nil || s.AssetType != asset.Options {
+		return o.OKGroup.SubmitOrder(ctx, s)
+	}
+
+	err := s.Validate()
+	if err != nil {
+		return order.SubmitResponse{}, err
+	}
+
+	if s.Type != order.Limit {
+		return order.SubmitResponse{}, fmt.Errorf("%s %w %v for options", o.Name, order.ErrTypeIsInvalid, s.Type)
+	}
+
+	fPair, err := o.FormatExchangeCurrency(s.Pair, s.AssetType)
+	if err != nil {
+		return order.SubmitResponse{}, err
+	}
+
+	resp, err := o.PlaceOptionOrder(ctx, &okgroup.PlaceOptionOrderRequest{
+		ClientOid:    s.ClientID,
+		InstrumentID: fPair.String(),
+		Side:         s.Side.Lower(),
+		Price:        s.Price,
+		Size:         s.Amount,
+	})
+	if err != nil {
+		return order.SubmitResponse{}, err
+	}
+
+	return order.SubmitResponse{
+		IsOrderPlaced: resp.Result,
+		OrderID:       resp.OrderID,
+	}, nil
+}
+
+// GetOptionsChain returns the listed option contracts for an underlying
+func (o *OKEX) GetOptionsChain(ctx context.Context, underlying currency.Pair) ([]options.Contract, error) {
+	if underlying.IsEmpty() {
+		return nil, options.ErrPairIsEmpty
+	}
+
+	format, err := o.GetPairFormat(asset.Options, false)
+	if err != nil {
+		return nil, err
+	}
+
+	underlying.Delimiter = currency.DashDelimiter
+	instruments, err := o.GetOptionInstruments(ctx, underlying.Upper().String())
+	if err != nil {
+		return nil, err
+	}
+
+	chain := make([]options.Contract, 0, len(instruments))
+	for x := range instruments {
+		var t options.OptionType
+		t, err = options.StringToOptionType(instruments[x].OptionType)
+		if err != nil {
+			return nil, err
+		}
+		var c options.Contract
+		c, err = options.NewContract(underlying,
+			instruments[x].Delivery,
+			instruments[x].Strike,
+			t)
+		if err != nil {
+			return nil, err
+		}
+		c.Pair, err = optionInstrumentToPair(instruments[x].InstrumentID, format.Delimiter)
+		if err != nil {
+			return nil, err
+		}
+		chain = append(chain, c)
+	}
+	return chain, nil
+}
+
+// GetOptionsGreeks returns the greeks and implied volatility for an option
+// contract
+func (o *OKEX) GetOptionsGreeks(ctx context.Context, p currency.Pair) (*options.Greeks, error) {
+	contract, err := options.ParseContract(p)
+	if err != nil {
+		return nil, err
+	}
+
+	fPair, err := o.FormatExchangeCurrency(p, asset.Options)
+	if err != nil {
+		return nil, err
+	}
+
+	summary, err := o.GetOptionInstrumentSummary(ctx,
+		contract.Underlying.String(),
+		fPair.String())
+	if err != nil {
+		return nil, err
+	}
+
+	return &options.Greeks{
+		Exchange:  o.Name,
+		Asset:     asset.Options,
+		Pair:      p,
+		Delta:     summary.Delta,
+		Gamma:     summary.Gamma,
+		Theta:     summary.Theta,
+		Vega:      summary.Vega,
+		MarkIV:    summary.MarkVolatility,
+		BidIV:     summary.BidVolatility,
+		AskIV:     summary.AskVolatility,
+		MarkPrice: summary.MarkPrice,
+		Time:      summary.Timestamp,
+	}, nil
+}
+
+// optionInstrumentToPair converts an option instrument ID e.g.
+// BTC-USD-210625-40000-C to a currency pair with the underlying as the base
+func optionInstrumentToPair(instrumentID, delimiter string) (currency.Pair, error) {
+	s := strings.Split(instrumentID, currency.DashDelimiter)
+	if len(s) != 5 {
+		return currency.Pair{}, fmt.Errorf("%w %s", errInvalidOptionInstrument, instrumentID)
+	}
+	return currency.NewPairWithDelimiter(s[0]+currency.DashDelimiter+s[1],
+		s[2]+currency.DashDelimiter+s[3]+currency.DashDelimiter+s[4],
+		delimiter).Upper(), nil
+}
+
+// GetFuturesPositions returns the open positions for a futures contract or
+// all futures contracts when the pair is empty. OKEx holds long and short
+// positions of a contract together, each side is returned as a position.
+func (o *OKEX) GetFuturesPositions(ctx context.Context, a asset.Item, p currency.Pair) ([]futures.Position, error) {
+	if err := futures.CheckAsset(a); err != nil {
+		return nil, err
+	}
+	if a != asset.Futures {
+		return nil, fmt.Errorf("%w %v", asset.ErrNotSupported, a)
+	}
+	var holdings []okgroup.GetFuturePostionsDetails
+	if p.IsEmpty() {
+		resp, err := o.GetFuturesPostions(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for i := range resp.Holding {
+			holdings = append(holdings, resp.Holding[i]...)
+		}
+	} else {
+		instrumentID, _, err := o.futuresInstrument(p)
+		if err != nil {
+			return nil, err
+		}
+		resp, err := o.GetFuturesPostionsForCurrency(ctx, instrumentID)
+		if err != nil {
+			return nil, err
+		}
+		holdings = resp.Holding
+	}
+
+	format, err := o.GetPairFormat(asset.Futures, false)
+	if err != nil {
+		return nil, err
+	}
+	positions := make([]futures.Position, 0, len(holdings))
+	for i := range holdings {
+		h := &holdings[i]
+		cp, err := futuresInstrumentToPair(h.InstrumentID, format.Delimiter)
+		if err != nil {
+			return nil, err
+		}
+		marginType, err := marginModeToMarginType(h.MarginMode)
+		if err != nil {
+			return nil, err
+		}
+		var updated time.Time
+		if h.UpdatedAt != "" {
+			updated, err = time.Parse(time.RFC3339, h.UpdatedAt)
+			if err != nil {
+				return nil, err
+			}
+		}
+		for _, side := range []futures.PositionSide{futures.Long, futures.Short} {
+			pos := futures.Position{
+				Exchange:   o.Name,
+				Asset:      a,
+				Pair:       cp,
+				Side:       side,
+				MarginType: marginType,
+				UpdateTime: updated,
+			}
+			fields := []floatField{{h.Last, &pos.MarkPrice}}
+			if side == futures.Long {
+				fields = append(fields,
+					floatField{h.LongQty, &pos.Size},
+					floatField{h.LongAvgCost, &pos.EntryPrice},
+					floatField{h.LongLiquiPrice, &pos.LiquidationPrice},
+					floatField{h.LongUnrealisedPnl, &pos.UnrealisedPNL},
+					floatField{h.LongLeverage, &pos.Leverage},
+					floatField{h.LongMargin, &pos.Margin})
+			} else {
+				fields = append(fields,
+					floatField{h.ShortQty, &pos.Size},
+					floatField{h.ShortAvgCost, &pos.EntryPrice},
+					floatField{h.ShortLiquiPrice, &pos.LiquidationPrice},
+					floatField{h.ShortUnrealisedPnl, &pos.UnrealisedPNL},
+					floatField{h.ShortLeverage, &pos.Leverage},
+					floatField{h.ShortMargin, &pos.Margin})
+			}
+			err = parseFloatFields(fields)
+			if err != nil {
+				return nil, err
+			}
+			if pos.Size == 0 {
+				continue
+			}
+			positions = append(positions, pos)
+		}
+	}
+	return positions, nil
+}
+
+// ChangeFuturesLeverage sets the leverage for a futures contract. In crossed
+// margin mode OKEx applies leverage to every contract of the underlying, in
+// fixed margin mode it is set for both directions of the contract.
+func (o *OKEX) ChangeFuturesLeverage(ctx context.Context, a asset.Item, p currency.Pair, leverage float64) error {
+	if err := futures.CheckLeverage(a, p, leverage); err != nil {
+		return err
+	}
+	if a != asset.Futures {
+		return fmt.Errorf("%w %v", asset.ErrNotSupported, a)
+	}
+	if leverage != math.Trunc(leverage) {
+		return fmt.Errorf("%v %w", leverage, errFractionalLeverage)
+	}
+	instrumentID, underlying, err := o.futuresInstrument(p)
+	if err != nil {
+		return err
+	}
+	settings, err := o.GetFuturesLeverage(ctx, underlying)
+	if err != nil {
+		return err
+	}
+	marginType, err := marginModeToMarginType(settings.MarginMode)
+	if err != nil {
+		return err
+	}
+	if marginType == futures.Cross {
+		_, err = o.SetFuturesLeverage(ctx, okgroup.SetFuturesLeverageRequest{
+			Currency: underlying,
+			Leverage: int64(leverage),
+		})
+		return err
+	}
+	for _, direction := range []futures.PositionSide{futures.Long, futures.Short} {
+		_, err = o.SetFuturesLeverage(ctx, okgroup.SetFuturesLeverageRequest{
+			Currency:     underlying,
+			InstrumentID: instrumentID,
+			Direction:    direction.String(),
+			Leverage:     int64(leverage),
+		})
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ChangeFuturesMarginType sets the margin type for a futures contract, OKEx
+// applies the margin mode to every contract of the underlying
+func (o *OKEX) ChangeFuturesMarginType(ctx context.Context, a asset.Item, p currency.Pair, marginType futures.MarginType) error {
+	if err := futures.CheckRequest(a, p); err != nil {
+		return err
+	}
+	if a != asset.Futures {
+		return fmt.Errorf("%w %v", asset.ErrNotSupported, a)
+	}
+	mode, err := marginTypeToMarginMode(marginType)
+	if err != nil {
+		return err
+	}
+	_, underlying, err := o.futuresInstrument(p)
+	if err != nil {
+		return err
+	}
+	_, err = o.SetFuturesMarginMode(ctx, okgroup.SetFuturesMarginModeRequest{
+		Underlying: underlying,
+		MarginMode: mode,
+	})
+	return err
+}
+
+// GetFuturesMarginInfo returns the leverage and margin settings for a futures
+// contract. OKEx does not publish maximum leverage so it is left unset.
+func (o *OKEX) GetFuturesMarginInfo(ctx context.Context, a asset.Item, p currency.Pair) (*futures.MarginInfo, error) {
+	if err := futures.CheckRequest(a, p); err != nil {
+		return nil, err
+	}
+	if a != asset.Futures {
+		return nil, fmt.Errorf("%w %v", asset.ErrNotSupported, a)
+	}
+	_, underlying, err := o.futuresInstrument(p)
+	if err != nil {
+		return nil, err
+	}
+	settings, err := o.GetFuturesLeverage(ctx, underlying)
+	if err != nil {
+		return nil, err
+	}
+	marginType, err := marginModeToMarginType(settings.MarginMode)
+	if err != nil {
+		return nil, err
+	}
+	acc, err := o.GetFuturesAccountOfACurrency(ctx, underlying)
+	if err != nil {
+		return nil, err
+	}
+	resp := &futures.MarginInfo{
+		Exchange:   o.Name,
+		Asset:      a,
+		Pair:       p,
+		Leverage:   float64(settings.Leverage),
+		MarginType: marginType,
+		Collateral: futuresCollateral(underlying),
+	}
+	err = parseFloatFields([]floatField{
+		{acc.Equity, &resp.MarginBalance},
+		{acc.TotalAvailBalance, &resp.AvailableMargin},
+		{acc.Margin, &resp.InitialMargin},
+	})
+	if err != nil {
+		return nil, err
+	}
+	if marginType == futures.Isolated {
+		// fixed margin leverage is held per contract and is only returned
+		// with its positions
+		positions, err := o.GetFuturesPositions(ctx, a, p)
+		if err != nil {
+			return nil, err
+		}
+		for i := range positions {
+			if positions[i].Leverage > resp.Leverage {
+				resp.Leverage = positions[i].Leverage
+			}
+		}
+	}
+	return resp, nil
+}
+
+// futuresInstrument returns the OKEx instrument ID of a futures contract e.g.
+// BTC-USD-210625 along with its underlying e.g. BTC-USD
+func (o *OKEX) futuresInstrument(p currency.Pair) (instrumentID, underlying string, err error) {
+	fPair, err := o.FormatExchangeCurrency(p, asset.Futures)
+	if err != nil {
+		return "", "", err
+	}
+	instrumentID = fPair.String()
+	i := strings.LastIndex(instrumentID, currency.DashDelimiter)
+	if i <= 0 {
+		return "", "", fmt.Errorf("%w %s", errInvalidFuturesInstrument, instrumentID)
+	}
+	return instrumentID, instrumentID[:i], nil
+}
+
+// futuresInstrumentToPair converts a futures instrument ID e.g.
+// BTC-USD-210625 to a currency pair with the underlying as the base
+func futuresInstrumentToPair(instrumentID, delimiter string) (currency.Pair, error) {
+	s := strings.Split(instrumentID, currency.DashDelimiter)
+	if len(s) != 3 {
+		return currency.Pair{}, fmt.Errorf("%w %s", errInvalidFuturesInstrument, instrumentID)
+	}
+	return currency.NewPairWithDelimiter(s[0]+currency.DashDelimiter+s[1],
+		s[2],
+		delimiter).Upper(), nil
+}
+
+// futuresCollateral returns the margin currency of an underlying, USDT
+// margined contracts are collateralised in USDT and all others in the coin
+func futuresCollateral(underlying string) currency.Code {
+	s := strings.Split(underlying, currency.DashDelimiter)
+	if len(s) == 2 && strings.EqualFold(s[1], currency.USDT.String()) {
+		return currency.USDT
+	}
+	return currency.NewCode(s[0])
+}
+
+// marginModeToMarginType converts an OKEx futures margin mode to a margin
+// type
+func marginModeToMarginType(mode string) (futures.MarginType, error) {
+	switch mode {
+	case marginModeCrossed:
+		return futures.Cross, nil
+	case marginModeFixed:
+		return futures.Isolated, nil
+	default:
+		return futures.UnknownMarginType, fmt.Errorf("%q %w", mode, errInvalidMarginMode)
+	}
+}
+
+// marginTypeToMarginMode converts a margin type to an OKEx futures margin mode
+func marginTypeToMarginMode(m futures.MarginType) (string, error) {
+	switch m {
+	case futures.Cross:
+		return marginModeCrossed, nil
+	case futures.Isolated:
+		return marginModeFixed, nil
+	default:
+		return "", fmt.Errorf("%q %w", m, futures.ErrMarginTypeNotSupported)
+	}
+}
+
+// floatField pairs an OKEx string encoded number with its destination
+type floatField struct {
+	value string
+	dst   *float64
+}
+
+// parseFloatFields parses string encoded numbers into their destinations,
+// empty values are left unset
+func parseFloatFields(fields []floatField) error {
+	for i := range fields {
+		if fields[i].value == "" {
+			continue
+		}
+		v, err := strconv.ParseFloat(fields[i].value, 64)
+		if err != nil {
+			return err
+		}
+		*fields[i].dst = v
+	}
+	return nil
+}
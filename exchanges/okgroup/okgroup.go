@@ -326,6 +326,9 @@ func (o *OKGroup) GetOrderBook(ctx context.Context, request GetOrderBookRequest,
 	case asset.PerpetualSwap:
 		endpoint = "depth"
 		requestType = "swap"
+	case asset.Options:
+		endpoint = OKGroupGetSpotOrderBook
+		requestType = "option"
 	default:
 		return resp, errors.New("unhandled asset type")
 	}
@@ -561,6 +561,8 @@ type GetFuturePostionsDetails struct {
 	LongPnlRatio         string `json:"long_pnl_ratio"`
 	LongQty              string `json:"long_qty"`
 	LongSettlementPrice  string `json:"long_settlement_price"`
+	LongUnrealisedPnl    string `json:"long_unrealised_pnl"`
+	Last                 string `json:"last"`
 	MarginMode           string `json:"margin_mode"`
 	RealisedPnl          string `json:"realised_pnl"`
 	ShortAvailQty        string `json:"short_avail_qty"`
@@ -571,6 +573,7 @@ type GetFuturePostionsDetails struct {
 	ShortPnlRatio        string `json:"short_pnl_ratio"`
 	ShortQty             string `json:"short_qty"`
 	ShortSettlementPrice string `json:"short_settlement_price"`
+	ShortUnrealisedPnl   string `json:"short_unrealised_pnl"`
 	UpdatedAt            string `json:"updated_at"`
 }
 
@@ -642,6 +645,19 @@ type SetFutureLeverageShortLongData struct {
 	Short int `json:"short"`
 }
 
+// SetFuturesMarginModeRequest request data for SetFuturesMarginMode
+type SetFuturesMarginModeRequest struct {
+	Underlying string `json:"underlying"`  // Underlying index, e.g. "BTC-USD"
+	MarginMode string `json:"margin_mode"` // crossed or fixed
+}
+
+// SetFuturesMarginModeResponse returned data for SetFuturesMarginMode
+type SetFuturesMarginModeResponse struct {
+	Underlying string `json:"underlying"`
+	MarginMode string `json:"margin_mode"`
+	Result     string `json:"result"`
+}
+
 // PlaceFuturesOrderRequest request data for PlaceFuturesOrder
 type PlaceFuturesOrderRequest struct {
 	ClientOid    string  `json:"client_oid,omitempty"`         // [optional] 	the order ID customized by yourself
@@ -1493,6 +1509,7 @@ type WebsocketSpotOrderResponse struct {
 		FilledNotional float64   `json:"filled_notional,string"`
 		FilledSize     float64   `json:"filled_size,string"`
 		InstrumentID   string    `json:"instrument_id"`
+		LastFillID     string    `json:"last_fill_id"`
 		LastFillPx     float64   `json:"last_fill_px,string"`
 		LastFillQty    float64   `json:"last_fill_qty,string"`
 		LastFillTime   time.Time `json:"last_fill_time"`
@@ -1510,6 +1527,79 @@ type WebsocketSpotOrderResponse struct {
 	} `json:"data"`
 }
 
+// WebsocketSpotAccountResponse holds spot account balance updates
+type WebsocketSpotAccountResponse struct {
+	Table string `json:"table"`
+	Data  []struct {
+		Balance   float64 `json:"balance,string"`
+		Available float64 `json:"available,string"`
+		Currency  string  `json:"currency"`
+		ID        string  `json:"id"`
+		Hold      float64 `json:"hold,string"`
+	} `json:"data"`
+}
+
+// OptionInstrument holds the contract details for a listed option
+type OptionInstrument struct {
+	InstrumentID       string    `json:"instrument_id"`
+	Underlying         string    `json:"underlying"`
+	SettlementCurrency string    `json:"settlement_currency"`
+	ContractValue      float64   `json:"contract_val,string"`
+	OptionType         string    `json:"option_type"`
+	Strike             float64   `json:"strike,string"`
+	TickSize           float64   `json:"tick_size,string"`
+	LotSize            float64   `json:"lot_size,string"`
+	Listing            time.Time `json:"listing"`
+	Delivery           time.Time `json:"delivery"`
+	State              int64     `json:"state,string"`
+}
+
+// OptionSummary holds the market summary, greeks and implied volatility
+// for an option contract
+type OptionSummary struct {
+	InstrumentID   string    `json:"instrument_id"`
+	Underlying     string    `json:"underlying"`
+	BestAsk        float64   `json:"best_ask,string"`
+	BestBid        float64   `json:"best_bid,string"`
+	BestAskSize    float64   `json:"best_ask_size,string"`
+	BestBidSize    float64   `json:"best_bid_size,string"`
+	Delta          float64   `json:"delta,string"`
+	Gamma          float64   `json:"gamma,string"`
+	Theta          float64   `json:"theta,string"`
+	Vega           float64   `json:"vega,string"`
+	BidVolatility  float64   `json:"bid_vol,string"`
+	AskVolatility  float64   `json:"ask_vol,string"`
+	MarkVolatility float64   `json:"mark_vol,string"`
+	Last           float64   `json:"last,string"`
+	MarkPrice      float64   `json:"mark_price,string"`
+	OpenInterest   float64   `json:"open_interest,string"`
+	EstimatedPrice float64   `json:"estimated_price,string"`
+	High24h        float64   `json:"high_24h,string"`
+	Low24h         float64   `json:"low_24h,string"`
+	Volume24h      float64   `json:"volume_24h,string"`
+	Timestamp      time.Time `json:"timestamp"`
+}
+
+// PlaceOptionOrderRequest request data for PlaceOptionOrder
+type PlaceOptionOrderRequest struct {
+	ClientOid    string  `json:"client_oid,omitempty"`
+	InstrumentID string  `json:"instrument_id"`
+	Side         string  `json:"side"` // buy or sell
+	Price        float64 `json:"price,string"`
+	Size         float64 `json:"size,string"`
+	OrderType    int64   `json:"order_type,string,omitempty"` // 0: normal 1: post only 2: fill or kill 3: immediate or cancel
+	MatchPrice   int64   `json:"match_price,string,omitempty"`
+}
+
+// PlaceOptionOrderResponse response data for PlaceOptionOrder
+type PlaceOptionOrderResponse struct {
+	ClientOid    string `json:"client_oid"`
+	OrderID      string `json:"order_id"`
+	ErrorCode    string `json:"error_code"`
+	ErrorMessage string `json:"error_message"`
+	Result       bool   `json:"result,string"`
+}
+
 // WebsocketErrorResponse yo
 type WebsocketErrorResponse struct {
 	Event     string `json:"event"`
@@ -15,7 +15,9 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common/crypto"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fill"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
@@ -276,6 +278,10 @@ func (o *OKGroup) WsHandleData(respRaw []byte) error {
 			return o.wsProcessTrades(respRaw)
 		case okGroupWsOrder:
 			return o.wsProcessOrder(respRaw)
+		case okGroupWsAccount:
+			if dataResponse.Table == okGroupWsSpotAccount {
+				return o.wsProcessSpotAccount(respRaw)
+			}
 		}
 		o.Websocket.DataHandler <- stream.UnhandledMessageWarning{
 			Message: o.Name + stream.UnhandledMessage + string(respRaw),
@@ -390,6 +396,45 @@ func (o *OKGroup) wsProcessOrder(respRaw []byte) error {
 			Date:              resp.Data[i].CreatedAt,
 			Pair:              pair,
 		}
+		if resp.Data[i].LastFillQty > 0 {
+			o.Websocket.DataHandler <- []fill.Data{{
+				TradeHistory: order.TradeHistory{
+					Price:     resp.Data[i].LastFillPx,
+					Amount:    resp.Data[i].LastFillQty,
+					Exchange:  o.Name,
+					TID:       resp.Data[i].LastFillID,
+					Type:      oType,
+					Side:      oSide,
+					Timestamp: resp.Data[i].LastFillTime,
+					Total:     resp.Data[i].LastFillPx * resp.Data[i].LastFillQty,
+				},
+				OrderID:       resp.Data[i].OrderID,
+				ClientOrderID: resp.Data[i].ClientOid,
+				Pair:          pair,
+				AssetType:     o.GetAssetTypeFromTableName(resp.Table),
+			}}
+		}
+	}
+	return nil
+}
+
+// wsProcessSpotAccount sends spot balance updates to the datahandler
+func (o *OKGroup) wsProcessSpotAccount(respRaw []byte) error {
+	var resp WebsocketSpotAccountResponse
+	err := json.Unmarshal(respRaw, &resp)
+	if err != nil {
+		return err
+	}
+	for i := range resp.Data {
+		o.Websocket.DataHandler <- account.Change{
+			Exchange: o.Name,
+			Currency: currency.NewCode(resp.Data[i].Currency),
+			Asset:    asset.Spot,
+			Balance: &account.Balance{
+				TotalValue: resp.Data[i].Balance,
+				Hold:       resp.Data[i].Hold,
+			},
+		}
 	}
 	return nil
 }
@@ -0,0 +1,131 @@
+package options
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+// CheckAsset verifies that an asset is an options contract type
+func CheckAsset(a asset.Item) error {
+	if a != asset.Options {
+		return fmt.Errorf("%v %w", a, ErrNotOptionsAsset)
+	}
+	return nil
+}
+
+// CheckRequest verifies that an options market data request is for the
+// options asset and a contract
+func CheckRequest(a asset.Item, p currency.Pair) error {
+	if err := CheckAsset(a); err != nil {
+		return err
+	}
+	if p.IsEmpty() {
+		return ErrPairIsEmpty
+	}
+	return nil
+}
+
+// String implements the stringer interface
+func (o OptionType) String() string {
+	return string(o)
+}
+
+// Short returns the single letter code used in contract names
+func (o OptionType) Short() string {
+	switch o {
+	case Call:
+		return "C"
+	case Put:
+		return "P"
+	}
+	return ""
+}
+
+// StringToOptionType converts an exchange option type to an OptionType
+func StringToOptionType(s string) (OptionType, error) {
+	switch strings.ToLower(s) {
+	case "call", "c":
+		return Call, nil
+	case "put", "p":
+		return Put, nil
+	default:
+		return UnknownType, fmt.Errorf("%q %w", s, errInvalidOptionType)
+	}
+}
+
+// NewContract returns an option contract with its currency pair
+// representation populated
+func NewContract(underlying currency.Pair, expiry time.Time, strike float64, t OptionType) (Contract, error) {
+	if underlying.IsEmpty() {
+		return Contract{}, ErrPairIsEmpty
+	}
+	if expiry.IsZero() {
+		return Contract{}, errExpiryUnset
+	}
+	if strike <= 0 {
+		return Contract{}, errInvalidStrike
+	}
+	if t != Call && t != Put {
+		return Contract{}, fmt.Errorf("%q %w", t, errInvalidOptionType)
+	}
+	underlying = underlying.Upper()
+	underlying.Delimiter = currency.DashDelimiter
+	return Contract{
+		Pair: currency.NewPairWithDelimiter(
+			underlying.String(),
+			expiry.UTC().Format(expiryFormat)+
+				currency.DashDelimiter+
+				strconv.FormatFloat(strike, 'f', -1, 64)+
+				currency.DashDelimiter+
+				t.Short(),
+			currency.DashDelimiter).Upper(),
+		Underlying: underlying,
+		Expiry:     expiry,
+		Strike:     strike,
+		Type:       t,
+	}, nil
+}
+
+// ParseContract decodes an option contract from its currency pair
+// representation, the expiry is returned as midnight UTC of the expiry date
+func ParseContract(p currency.Pair) (Contract, error) {
+	if p.IsEmpty() {
+		return Contract{}, ErrPairIsEmpty
+	}
+	underlying := strings.Split(p.Base.String(), currency.DashDelimiter)
+	details := strings.Split(p.Quote.String(), currency.DashDelimiter)
+	if len(underlying) != 2 || len(details) != 3 {
+		return Contract{}, fmt.Errorf("%v %w", p, ErrInvalidContract)
+	}
+	expiry, err := time.Parse(expiryFormat, details[0])
+	if err != nil {
+		return Contract{}, fmt.Errorf("%v %w: %v", p, ErrInvalidContract, err)
+	}
+	strike, err := strconv.ParseFloat(details[1], 64)
+	if err != nil {
+		return Contract{}, fmt.Errorf("%v %w: %v", p, ErrInvalidContract, err)
+	}
+	t, err := StringToOptionType(details[2])
+	if err != nil {
+		return Contract{}, err
+	}
+	c, err := NewContract(currency.NewPairWithDelimiter(underlying[0], underlying[1], currency.DashDelimiter),
+		expiry,
+		strike,
+		t)
+	if err != nil {
+		return Contract{}, err
+	}
+	c.Pair = p
+	return c, nil
+}
+
+// IsExpired returns whether the contract has expired at the supplied time
+func (c *Contract) IsExpired(t time.Time) bool {
+	return !c.Expiry.After(t)
+}
@@ -0,0 +1,124 @@
+package options
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+func TestCheckRequest(t *testing.T) {
+	t.Parallel()
+	err := CheckRequest(asset.Futures, currency.Pair{})
+	if !errors.Is(err, ErrNotOptionsAsset) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrNotOptionsAsset)
+	}
+	err = CheckRequest(asset.Options, currency.Pair{})
+	if !errors.Is(err, ErrPairIsEmpty) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrPairIsEmpty)
+	}
+	err = CheckRequest(asset.Options, currency.NewPair(currency.BTC, currency.USD))
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+}
+
+func TestStringToOptionType(t *testing.T) {
+	t.Parallel()
+	for s, expected := range map[string]OptionType{
+		"C":    Call,
+		"call": Call,
+		"P":    Put,
+		"PUT":  Put,
+	} {
+		o, err := StringToOptionType(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if o != expected {
+			t.Errorf("received: '%v' but expected: '%v'", o, expected)
+		}
+	}
+	_, err := StringToOptionType("straddle")
+	if !errors.Is(err, errInvalidOptionType) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errInvalidOptionType)
+	}
+	if UnknownType.Short() != "" || Call.Short() != "C" || Put.Short() != "P" {
+		t.Error("unexpected short option type")
+	}
+}
+
+func TestNewContract(t *testing.T) {
+	t.Parallel()
+	underlying := currency.NewPair(currency.BTC, currency.USD)
+	expiry := time.Date(2021, 6, 25, 8, 0, 0, 0, time.UTC)
+	_, err := NewContract(currency.Pair{}, expiry, 40000, Call)
+	if !errors.Is(err, ErrPairIsEmpty) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrPairIsEmpty)
+	}
+	_, err = NewContract(underlying, time.Time{}, 40000, Call)
+	if !errors.Is(err, errExpiryUnset) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errExpiryUnset)
+	}
+	_, err = NewContract(underlying, expiry, 0, Call)
+	if !errors.Is(err, errInvalidStrike) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errInvalidStrike)
+	}
+	_, err = NewContract(underlying, expiry, 40000, UnknownType)
+	if !errors.Is(err, errInvalidOptionType) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errInvalidOptionType)
+	}
+	c, err := NewContract(underlying, expiry, 40000.5, Put)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Pair.String() != "BTC-USD-210625-40000.5-P" {
+		t.Errorf("received: '%v' but expected: '%v'", c.Pair, "BTC-USD-210625-40000.5-P")
+	}
+	if c.IsExpired(expiry.Add(-time.Hour)) || !c.IsExpired(expiry) {
+		t.Error("unexpected expiry result")
+	}
+}
+
+func TestParseContract(t *testing.T) {
+	t.Parallel()
+	_, err := ParseContract(currency.Pair{})
+	if !errors.Is(err, ErrPairIsEmpty) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrPairIsEmpty)
+	}
+	p, err := currency.NewPairDelimiter("BTC-USD_210625-40000", currency.UnderscoreDelimiter)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = ParseContract(p)
+	if !errors.Is(err, ErrInvalidContract) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrInvalidContract)
+	}
+	p, err = currency.NewPairDelimiter("BTC-USD_2106-40000-C", currency.UnderscoreDelimiter)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = ParseContract(p)
+	if !errors.Is(err, ErrInvalidContract) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrInvalidContract)
+	}
+	p, err = currency.NewPairDelimiter("BTC-USD_210625-40000-C", currency.UnderscoreDelimiter)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := ParseContract(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Type != Call || c.Strike != 40000 || !c.Underlying.Equal(currency.NewPair(currency.BTC, currency.USD)) {
+		t.Errorf("unexpected contract %+v", c)
+	}
+	if !c.Expiry.Equal(time.Date(2021, 6, 25, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("received: '%v' but expected: '%v'", c.Expiry, time.Date(2021, 6, 25, 0, 0, 0, 0, time.UTC))
+	}
+	if !c.Pair.Equal(p) {
+		t.Errorf("received: '%v' but expected: '%v'", c.Pair, p)
+	}
+}
@@ -0,0 +1,67 @@
+package options
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+var (
+	// ErrNotOptionsAsset is returned when an options specific request is made
+	// for a non-options asset type
+	ErrNotOptionsAsset = errors.New("asset type is not an options contract")
+	// ErrPairIsEmpty is returned when a request is made without a contract
+	ErrPairIsEmpty = errors.New("currency pair is empty")
+	// ErrInvalidContract is returned when a currency pair does not describe an
+	// option contract
+	ErrInvalidContract = errors.New("invalid option contract")
+
+	errInvalidOptionType = errors.New("invalid option type")
+	errInvalidStrike     = errors.New("strike price must be greater than zero")
+	errExpiryUnset       = errors.New("expiry time is unset")
+)
+
+// expiryFormat is the date layout used when an option expiry is encoded in a
+// currency pair
+const expiryFormat = "060102"
+
+// OptionType defines whether an option grants the right to buy or sell the
+// underlying
+type OptionType string
+
+// Option types
+const (
+	UnknownType OptionType = ""
+	Call        OptionType = "call"
+	Put         OptionType = "put"
+)
+
+// Contract describes a single option contract. The contract is represented in
+// a currency pair with the underlying as the base and the expiry, strike and
+// type as the quote e.g. BTC-USD-210625-40000-C
+type Contract struct {
+	Pair       currency.Pair
+	Underlying currency.Pair
+	Expiry     time.Time
+	Strike     float64
+	Type       OptionType
+}
+
+// Greeks holds the sensitivities and implied volatilities of an option
+// contract, volatilities are expressed as a fraction e.g. 0.85 for 85%
+type Greeks struct {
+	Exchange  string
+	Asset     asset.Item
+	Pair      currency.Pair
+	Delta     float64
+	Gamma     float64
+	Theta     float64
+	Vega      float64
+	MarkIV    float64
+	BidIV     float64
+	AskIV     float64
+	MarkPrice float64
+	Time      time.Time
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/gofrs/uuid"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/options"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/validate"
 )
 
@@ -99,6 +100,25 @@ func TestValidate(t *testing.T) {
 				AssetType: asset.Spot},
 			ValidOpts: validate.Check(func() error { return nil }),
 		}, // valid order!
+		{
+			ExpectedErr: nil,
+			Submit: &Submit{Pair: currency.NewPairWithDelimiter("BTC-USD", "210625-40000-C", "-"),
+				Side:      Buy,
+				Type:      Limit,
+				Amount:    1,
+				Price:     0.052,
+				AssetType: asset.Options},
+			ValidOpts: validate.Check(func() error { return nil }),
+		}, // valid options order
+		{
+			ExpectedErr: options.ErrInvalidContract,
+			Submit: &Submit{Pair: testPair,
+				Side:      Buy,
+				Type:      Limit,
+				Amount:    1,
+				Price:     0.052,
+				AssetType: asset.Options},
+		}, // options order for a pair which is not a contract
 	}
 
 	for x := range tester {
@@ -851,6 +871,8 @@ func TestUpdateOrderFromDetail(t *testing.T) {
 		ExecutedAmount:    1,
 		RemainingAmount:   1,
 		Fee:               1,
+		EstimatedFee:      1,
+		EstimatedFeeAsset: currency.BTC,
 		Exchange:          "1",
 		InternalOrderID:   "1",
 		ID:                "1",
@@ -912,6 +934,9 @@ func TestUpdateOrderFromDetail(t *testing.T) {
 	if od.Fee != 1 {
 		t.Error("Failed to update")
 	}
+	if od.EstimatedFee != 1 || od.EstimatedFeeAsset != currency.BTC {
+		t.Error("Failed to update")
+	}
 	if od.Exchange != "test" {
 		t.Error("Should not be able to update exchange via modify")
 	}
@@ -1373,3 +1398,26 @@ func TestDetail_Copy(t *testing.T) {
 		}
 	}
 }
+
+func TestBatchAssetType(t *testing.T) {
+	t.Parallel()
+	_, err := BatchAssetType(nil)
+	if !errors.Is(err, ErrNoOrdersInBatch) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrNoOrdersInBatch)
+	}
+	_, err = BatchAssetType([]*Submit{{AssetType: asset.Spot}, nil})
+	if !errors.Is(err, ErrSubmissionIsNil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrSubmissionIsNil)
+	}
+	_, err = BatchAssetType([]*Submit{{AssetType: asset.Spot}, {AssetType: asset.Futures}})
+	if !errors.Is(err, ErrBatchAssetMismatch) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrBatchAssetMismatch)
+	}
+	a, err := BatchAssetType([]*Submit{{AssetType: asset.Spot}, {AssetType: asset.Spot}})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if a != asset.Spot {
+		t.Fatalf("received: '%v' but expected: '%v'", a, asset.Spot)
+	}
+}
@@ -21,6 +21,8 @@ var (
 	ErrAmountIsInvalid            = errors.New("order amount is equal or less than zero")
 	ErrPriceMustBeSetIfLimitOrder = errors.New("order price must be set if limit order type is desired")
 	ErrOrderIDNotSet              = errors.New("order id or client order id is not set")
+	ErrNoOrdersInBatch            = errors.New("no orders in batch")
+	ErrBatchAssetMismatch         = errors.New("batch orders must share the same asset type")
 )
 
 // Submit contains all properties of an order that may be required
@@ -114,6 +116,32 @@ type ModifyResponse struct {
 	OrderID string
 }
 
+// SubmitBatchResponse returns the outcome of each order in a batch submission
+// in the same sequence as they were requested
+type SubmitBatchResponse struct {
+	Results []SubmitResult
+}
+
+// SubmitResult holds the exchange response for a single order within a batch
+// submission, Error is set when the exchange rejected that order
+type SubmitResult struct {
+	SubmitResponse
+	Error error
+}
+
+// ModifyBatchResponse returns the outcome of each order in a batch
+// modification in the same sequence as they were requested
+type ModifyBatchResponse struct {
+	Results []ModifyResult
+}
+
+// ModifyResult holds the exchange response for a single order within a batch
+// modification, Error is set when the exchange rejected that amendment
+type ModifyResult struct {
+	Modify
+	Error error
+}
+
 // Detail contains all properties of an order
 // Each exchange has their own requirements, so not all fields
 // are required to be populated
@@ -136,6 +164,8 @@ type Detail struct {
 	CostAsset            currency.Code
 	Fee                  float64
 	FeeAsset             currency.Code
+	EstimatedFee         float64
+	EstimatedFeeAsset    currency.Code
 	Exchange             string
 	InternalOrderID      string
 	ID                   string
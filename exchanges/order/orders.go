@@ -10,6 +10,8 @@ import (
 	"github.com/gofrs/uuid"
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/options"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/validate"
 )
 
@@ -27,6 +29,12 @@ func (s *Submit) Validate(opt ...validate.Checker) error {
 		return ErrAssetNotSet
 	}
 
+	if s.AssetType == asset.Options {
+		if _, err := options.ParseContract(s.Pair); err != nil {
+			return err
+		}
+	}
+
 	if s.Side != Buy &&
 		s.Side != Sell &&
 		s.Side != Bid &&
@@ -104,6 +112,14 @@ func (d *Detail) UpdateOrderFromDetail(m *Detail) {
 		d.Fee = m.Fee
 		updated = true
 	}
+	if m.EstimatedFee > 0 && m.EstimatedFee != d.EstimatedFee {
+		d.EstimatedFee = m.EstimatedFee
+		updated = true
+	}
+	if !m.EstimatedFeeAsset.IsEmpty() && m.EstimatedFeeAsset != d.EstimatedFeeAsset {
+		d.EstimatedFeeAsset = m.EstimatedFeeAsset
+		updated = true
+	}
 	if m.AccountID != "" && m.AccountID != d.AccountID {
 		d.AccountID = m.AccountID
 		updated = true
@@ -893,3 +909,25 @@ func (m *Modify) Validate(opt ...validate.Checker) error {
 	}
 	return nil
 }
+
+// BatchAssetType returns the asset type shared by all orders in a batch
+// submission, exchanges only accept batches for a single product type
+func BatchAssetType(s []*Submit) (asset.Item, error) {
+	if len(s) == 0 {
+		return "", ErrNoOrdersInBatch
+	}
+	var a asset.Item
+	for x := range s {
+		if s[x] == nil {
+			return "", ErrSubmissionIsNil
+		}
+		if x == 0 {
+			a = s[x].AssetType
+			continue
+		}
+		if s[x].AssetType != a {
+			return "", fmt.Errorf("%w: %s and %s", ErrBatchAssetMismatch, a, s[x].AssetType)
+		}
+	}
+	return a, nil
+}
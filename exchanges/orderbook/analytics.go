@@ -0,0 +1,341 @@
+package orderbook
+
+import (
+	"fmt"
+	"math"
+	"time"
+)
+
+// basisPoints defines the multiplier to express a ratio in basis points
+const basisPoints = 10000
+
+// Liquidity defines the cumulative amount and quote value available on each
+// side of the book within a distance of the mid price
+type Liquidity struct {
+	BasisPoints float64
+	BidAmount   float64
+	BidValue    float64
+	AskAmount   float64
+	AskValue    float64
+}
+
+// ImpactPoint defines the expected execution of a market order of a
+// specified base amount against the current book
+type ImpactPoint struct {
+	Amount       float64
+	FilledAmount float64
+	AveragePrice float64
+	WorstPrice   float64
+	// SlippageBPS is the distance between the average fill price and the mid
+	// price expressed in basis points
+	SlippageBPS float64
+	// FullyFilled denotes whether the book had sufficient liquidity to
+	// execute the entire amount
+	FullyFilled bool
+}
+
+// Analytics defines a summary of the current orderbook state
+type Analytics struct {
+	MidPrice   float64
+	Microprice float64
+	Spread     float64
+	SpreadBPS  float64
+	Imbalance  float64
+	Liquidity  *Liquidity
+	BuyImpact  []ImpactPoint
+	SellImpact []ImpactPoint
+}
+
+// SpreadStatistics defines the distribution of the bid ask spread sampled
+// over a period of time
+type SpreadStatistics struct {
+	Samples int64
+	Min     float64
+	Max     float64
+	Mean    float64
+	StdDev  float64
+	MeanBPS float64
+	Last    float64
+	Start   time.Time
+	End     time.Time
+
+	// m2 is the running sum of squared differences from the mean
+	m2 float64
+}
+
+// MidPrice returns the average of the best bid and best ask prices
+func (b *Base) MidPrice() (float64, error) {
+	if err := b.checkTopOfBook(); err != nil {
+		return 0, err
+	}
+	return (b.Bids[0].Price + b.Asks[0].Price) / 2, nil
+}
+
+// Spread returns the difference between the best ask and best bid prices and
+// the spread expressed in basis points of the mid price
+func (b *Base) Spread() (spread, bps float64, err error) {
+	if err = b.checkTopOfBook(); err != nil {
+		return 0, 0, err
+	}
+	return calculateSpread(b.Bids[0].Price, b.Asks[0].Price)
+}
+
+// Microprice returns the top of book price weighted by the amount on the
+// opposing side, skewing the mid price towards the side more likely to be
+// consumed
+func (b *Base) Microprice() (float64, error) {
+	if err := b.checkTopOfBook(); err != nil {
+		return 0, err
+	}
+	bid, ask := b.Bids[0], b.Asks[0]
+	total := bid.Amount + ask.Amount
+	if total <= 0 {
+		return 0, errAmountInvalid
+	}
+	return (bid.Price*ask.Amount + ask.Price*bid.Amount) / total, nil
+}
+
+// Imbalance returns the normalised difference between bid and ask amounts for
+// the top number of levels, ranging from -1 (all asks) to 1 (all bids). A zero
+// levels value uses the entire book.
+func (b *Base) Imbalance(levels int) (float64, error) {
+	if levels < 0 {
+		return 0, fmt.Errorf("%w: %d", errInvalidLevels, levels)
+	}
+	if err := b.checkTopOfBook(); err != nil {
+		return 0, err
+	}
+	bidAmount := b.Bids.amountToLevel(levels)
+	askAmount := b.Asks.amountToLevel(levels)
+	total := bidAmount + askAmount
+	if total <= 0 {
+		return 0, errAmountInvalid
+	}
+	return (bidAmount - askAmount) / total, nil
+}
+
+// LiquidityWithinBPS returns the cumulative liquidity on each side of the
+// book that is priced within the supplied basis points of the mid price
+func (b *Base) LiquidityWithinBPS(bps float64) (*Liquidity, error) {
+	if bps <= 0 {
+		return nil, fmt.Errorf("%w: %v", errInvalidBasisPoints, bps)
+	}
+	mid, err := b.MidPrice()
+	if err != nil {
+		return nil, err
+	}
+	distance := mid * bps / basisPoints
+	l := &Liquidity{BasisPoints: bps}
+	for x := range b.Bids {
+		if b.Bids[x].Price < mid-distance {
+			break
+		}
+		l.BidAmount += b.Bids[x].Amount
+		l.BidValue += b.Bids[x].Amount * b.Bids[x].Price
+	}
+	for x := range b.Asks {
+		if b.Asks[x].Price > mid+distance {
+			break
+		}
+		l.AskAmount += b.Asks[x].Amount
+		l.AskValue += b.Asks[x].Amount * b.Asks[x].Price
+	}
+	return l, nil
+}
+
+// ImpactCurve returns the expected execution of market orders for each of the
+// supplied base amounts, buying consumes the asks and selling the bids
+func (b *Base) ImpactCurve(buy bool, amounts ...float64) ([]ImpactPoint, error) {
+	if len(amounts) == 0 {
+		return nil, errNoImpactAmounts
+	}
+	mid, err := b.MidPrice()
+	if err != nil {
+		return nil, err
+	}
+	side := b.Bids
+	if buy {
+		side = b.Asks
+	}
+	curve := make([]ImpactPoint, len(amounts))
+	for x := range amounts {
+		if amounts[x] <= 0 {
+			return nil, fmt.Errorf("%w: %v", errAmountInvalid, amounts[x])
+		}
+		curve[x] = side.impact(amounts[x], mid, buy)
+	}
+	return curve, nil
+}
+
+// Analyse returns a summary of the book using the supplied number of levels
+// for imbalance, basis points for liquidity and amounts for impact curves.
+// Liquidity and impact curves are omitted when their parameters are unset.
+func (b *Base) Analyse(levels int, bps float64, amounts []float64) (*Analytics, error) {
+	mid, err := b.MidPrice()
+	if err != nil {
+		return nil, err
+	}
+	a := &Analytics{MidPrice: mid}
+	a.Spread, a.SpreadBPS, err = b.Spread()
+	if err != nil {
+		return nil, err
+	}
+	a.Microprice, err = b.Microprice()
+	if err != nil {
+		return nil, err
+	}
+	a.Imbalance, err = b.Imbalance(levels)
+	if err != nil {
+		return nil, err
+	}
+	if bps > 0 {
+		a.Liquidity, err = b.LiquidityWithinBPS(bps)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(amounts) != 0 {
+		a.BuyImpact, err = b.ImpactCurve(true, amounts...)
+		if err != nil {
+			return nil, err
+		}
+		a.SellImpact, err = b.ImpactCurve(false, amounts...)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return a, nil
+}
+
+// checkTopOfBook ensures both sides of the book are populated with prices
+func (b *Base) checkTopOfBook() error {
+	if len(b.Bids) == 0 || len(b.Asks) == 0 {
+		return fmt.Errorf("%w for %s %s %s", errNoLiquidity, b.Exchange, b.Pair, b.Asset)
+	}
+	if b.Bids[0].Price <= 0 || b.Asks[0].Price <= 0 {
+		return errPriceNotSet
+	}
+	return nil
+}
+
+// amountToLevel returns the cumulative amount up to and including the level,
+// a zero level returns the amount for all levels
+func (elem Items) amountToLevel(levels int) float64 {
+	if levels == 0 || levels > len(elem) {
+		levels = len(elem)
+	}
+	var amount float64
+	for x := 0; x < levels; x++ {
+		amount += elem[x].Amount
+	}
+	return amount
+}
+
+// impact walks the side of the book to execute a base amount
+func (elem Items) impact(amount, mid float64, buy bool) ImpactPoint {
+	p := ImpactPoint{Amount: amount}
+	var nominal float64
+	remaining := amount
+	for x := range elem {
+		fill := elem[x].Amount
+		if remaining < fill {
+			fill = remaining
+		}
+		nominal += fill * elem[x].Price
+		remaining -= fill
+		p.WorstPrice = elem[x].Price
+		if remaining <= 0 {
+			break
+		}
+	}
+	p.FilledAmount = amount - remaining
+	p.FullyFilled = remaining <= 0
+	if p.FilledAmount > 0 {
+		p.AveragePrice = nominal / p.FilledAmount
+		if buy {
+			p.SlippageBPS = (p.AveragePrice - mid) / mid * basisPoints
+		} else {
+			p.SlippageBPS = (mid - p.AveragePrice) / mid * basisPoints
+		}
+	}
+	return p
+}
+
+// calculateSpread returns the absolute spread and the spread in basis points
+// of the mid price
+func calculateSpread(bid, ask float64) (spread, bps float64, err error) {
+	if bid <= 0 || ask <= 0 {
+		return 0, 0, errPriceNotSet
+	}
+	spread = ask - bid
+	return spread, spread / ((bid + ask) / 2) * basisPoints, nil
+}
+
+// Add records a spread sample, updating the running statistics
+func (s *SpreadStatistics) Add(spread, bps float64, t time.Time) {
+	if s.Samples == 0 {
+		s.Min, s.Max, s.Start = spread, spread, t
+	}
+	s.Samples++
+	if spread < s.Min {
+		s.Min = spread
+	}
+	if spread > s.Max {
+		s.Max = spread
+	}
+	delta := spread - s.Mean
+	s.Mean += delta / float64(s.Samples)
+	s.m2 += delta * (spread - s.Mean)
+	s.MeanBPS += (bps - s.MeanBPS) / float64(s.Samples)
+	if s.Samples > 1 {
+		s.StdDev = math.Sqrt(s.m2 / float64(s.Samples-1))
+	}
+	s.Last = spread
+	s.End = t
+}
+
+// Analyse returns a summary of the current depth, see Base.Analyse
+func (d *Depth) Analyse(levels int, bps float64, amounts []float64) (*Analytics, error) {
+	return d.Retrieve().Analyse(levels, bps, amounts)
+}
+
+// Spread returns the current spread and the spread in basis points of the mid
+// price without copying the depth
+func (d *Depth) Spread() (spread, bps float64, err error) {
+	d.m.Lock()
+	defer d.m.Unlock()
+	if d.bids.head == nil || d.asks.head == nil {
+		return 0, 0, fmt.Errorf("%w for %s %s %s", errNoLiquidity, d.exchange, d.pair, d.asset)
+	}
+	return calculateSpread(d.bids.head.Value.Price, d.asks.head.Value.Price)
+}
+
+// SampleSpread records the spread each time the depth changes for the
+// supplied duration, sampling stops early if the kick channel is closed
+func (d *Depth) SampleSpread(duration time.Duration, kick <-chan struct{}) (*SpreadStatistics, error) {
+	if duration <= 0 {
+		return nil, fmt.Errorf("%w: %v", errInvalidSampleDuration, duration)
+	}
+	done := make(chan struct{})
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+	go func() {
+		select {
+		case <-timer.C:
+		case <-kick:
+		}
+		close(done)
+	}()
+
+	stats := &SpreadStatistics{}
+	d.WatchUpdates(done, func() {
+		spread, bps, err := d.Spread()
+		if err == nil {
+			stats.Add(spread, bps, time.Now())
+		}
+	})
+	if stats.Samples == 0 {
+		return nil, fmt.Errorf("%w for %s %s %s", errNoLiquidity, d.exchange, d.pair, d.asset)
+	}
+	return stats, nil
+}
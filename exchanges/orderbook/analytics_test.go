@@ -0,0 +1,261 @@
+package orderbook
+
+import (
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+func analyticsSetup() *Base {
+	return &Base{
+		Exchange: "a",
+		Pair:     currency.NewPair(currency.BTC, currency.USD),
+		Asset:    asset.Spot,
+		Bids: Items{
+			{Price: 99, Amount: 3},
+			{Price: 98, Amount: 2},
+			{Price: 90, Amount: 5},
+		},
+		Asks: Items{
+			{Price: 101, Amount: 1},
+			{Price: 102, Amount: 2},
+			{Price: 110, Amount: 5},
+		},
+	}
+}
+
+func TestMidPriceAndSpread(t *testing.T) {
+	t.Parallel()
+	_, err := (&Base{}).MidPrice()
+	if !errors.Is(err, errNoLiquidity) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errNoLiquidity)
+	}
+
+	b := analyticsSetup()
+	mid, err := b.MidPrice()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if mid != 100 {
+		t.Fatalf("received: '%v' but expected: '%v'", mid, 100)
+	}
+
+	spread, bps, err := b.Spread()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if spread != 2 || bps != 200 {
+		t.Fatalf("received: '%v' '%v' but expected: '%v' '%v'", spread, bps, 2, 200)
+	}
+
+	b.Bids[0].Price = 0
+	_, _, err = b.Spread()
+	if !errors.Is(err, errPriceNotSet) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errPriceNotSet)
+	}
+}
+
+func TestMicroprice(t *testing.T) {
+	t.Parallel()
+	b := analyticsSetup()
+	micro, err := b.Microprice()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	// Heavier bid side skews the price towards the ask
+	if micro != 100.5 {
+		t.Fatalf("received: '%v' but expected: '%v'", micro, 100.5)
+	}
+
+	b.Bids[0].Amount, b.Asks[0].Amount = 0, 0
+	_, err = b.Microprice()
+	if !errors.Is(err, errAmountInvalid) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errAmountInvalid)
+	}
+}
+
+func TestImbalance(t *testing.T) {
+	t.Parallel()
+	b := analyticsSetup()
+	_, err := b.Imbalance(-1)
+	if !errors.Is(err, errInvalidLevels) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errInvalidLevels)
+	}
+
+	imb, err := b.Imbalance(1)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if imb != 0.5 {
+		t.Fatalf("received: '%v' but expected: '%v'", imb, 0.5)
+	}
+
+	imb, err = b.Imbalance(2)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if imb != 0.25 {
+		t.Fatalf("received: '%v' but expected: '%v'", imb, 0.25)
+	}
+
+	imb, err = b.Imbalance(0)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if imb != 0.1111111111111111 {
+		t.Fatalf("received: '%v' but expected: '%v'", imb, 0.1111111111111111)
+	}
+}
+
+func TestLiquidityWithinBPS(t *testing.T) {
+	t.Parallel()
+	b := analyticsSetup()
+	_, err := b.LiquidityWithinBPS(0)
+	if !errors.Is(err, errInvalidBasisPoints) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errInvalidBasisPoints)
+	}
+
+	l, err := b.LiquidityWithinBPS(200)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if l.BidAmount != 5 || l.BidValue != 493 {
+		t.Fatalf("unexpected bid liquidity %+v", l)
+	}
+	if l.AskAmount != 3 || l.AskValue != 305 {
+		t.Fatalf("unexpected ask liquidity %+v", l)
+	}
+}
+
+func TestImpactCurve(t *testing.T) {
+	t.Parallel()
+	b := analyticsSetup()
+	_, err := b.ImpactCurve(true)
+	if !errors.Is(err, errNoImpactAmounts) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errNoImpactAmounts)
+	}
+
+	_, err = b.ImpactCurve(true, -1)
+	if !errors.Is(err, errAmountInvalid) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errAmountInvalid)
+	}
+
+	curve, err := b.ImpactCurve(true, 1, 3, 100)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+
+	if curve[0].AveragePrice != 101 || curve[0].WorstPrice != 101 || curve[0].SlippageBPS != 100 || !curve[0].FullyFilled {
+		t.Fatalf("unexpected impact %+v", curve[0])
+	}
+
+	expected := (101 + 204.0) / 3
+	if curve[1].AveragePrice != expected || curve[1].WorstPrice != 102 {
+		t.Fatalf("unexpected impact %+v", curve[1])
+	}
+
+	if curve[2].FullyFilled || curve[2].FilledAmount != 8 || curve[2].WorstPrice != 110 {
+		t.Fatalf("unexpected impact %+v", curve[2])
+	}
+
+	curve, err = b.ImpactCurve(false, 4)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if curve[0].AveragePrice != 98.75 || curve[0].SlippageBPS != 125 {
+		t.Fatalf("unexpected impact %+v", curve[0])
+	}
+}
+
+func TestAnalyse(t *testing.T) {
+	t.Parallel()
+	_, err := (&Base{}).Analyse(0, 0, nil)
+	if !errors.Is(err, errNoLiquidity) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errNoLiquidity)
+	}
+
+	a, err := analyticsSetup().Analyse(1, 0, nil)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if a.Liquidity != nil || a.BuyImpact != nil || a.SellImpact != nil {
+		t.Fatal("unset parameters should not be analysed")
+	}
+
+	a, err = analyticsSetup().Analyse(1, 200, []float64{1})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if a.MidPrice != 100 || a.Imbalance != 0.5 || a.Liquidity == nil || len(a.BuyImpact) != 1 || len(a.SellImpact) != 1 {
+		t.Fatalf("unexpected analytics %+v", a)
+	}
+}
+
+func TestSpreadStatistics(t *testing.T) {
+	t.Parallel()
+	var s SpreadStatistics
+	now := time.Now()
+	s.Add(2, 200, now)
+	s.Add(4, 400, now.Add(time.Second))
+	s.Add(6, 600, now.Add(time.Second*2))
+	if s.Samples != 3 || s.Min != 2 || s.Max != 6 || s.Mean != 4 || s.MeanBPS != 400 || s.Last != 6 {
+		t.Fatalf("unexpected statistics %+v", s)
+	}
+	if s.StdDev != 2 {
+		t.Fatalf("received: '%v' but expected: '%v'", s.StdDev, 2)
+	}
+	if !s.Start.Equal(now) || !s.End.Equal(now.Add(time.Second*2)) {
+		t.Fatal("unexpected sample window")
+	}
+}
+
+func TestDepthSampleSpread(t *testing.T) {
+	t.Parallel()
+	d := newDepth(id)
+	_, err := d.SampleSpread(0, nil)
+	if !errors.Is(err, errInvalidSampleDuration) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errInvalidSampleDuration)
+	}
+
+	_, _, err = d.Spread()
+	if !errors.Is(err, errNoLiquidity) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errNoLiquidity)
+	}
+
+	_, err = d.SampleSpread(time.Millisecond, nil)
+	if !errors.Is(err, errNoLiquidity) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errNoLiquidity)
+	}
+
+	d.LoadSnapshot(Items{{Price: 99, Amount: 1}}, Items{{Price: 101, Amount: 1}}, 0, time.Now(), true)
+	a, err := d.Analyse(0, 0, nil)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if a.Spread != 2 {
+		t.Fatalf("received: '%v' but expected: '%v'", a.Spread, 2)
+	}
+
+	kick := make(chan struct{})
+	go func() {
+		time.Sleep(time.Millisecond * 50)
+		d.LoadSnapshot(Items{{Price: 98, Amount: 1}}, Items{{Price: 102, Amount: 1}}, 0, time.Now(), true)
+		time.Sleep(time.Millisecond * 50)
+		close(kick)
+	}()
+
+	s, err := d.SampleSpread(time.Minute, kick)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if s.Samples < 2 || s.Min != 2 || s.Max != 4 {
+		t.Fatalf("unexpected statistics %+v", s)
+	}
+	if math.IsNaN(s.StdDev) {
+		t.Fatal("standard deviation should be a number")
+	}
+}
@@ -0,0 +1,157 @@
+package orderbook
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+// Venue defines an individual exchange's contribution to a consolidated price
+// level
+type Venue struct {
+	Exchange string
+	Amount   float64
+}
+
+// ConsolidatedItem defines a price level which aggregates liquidity across
+// exchanges while retaining the per exchange amounts
+type ConsolidatedItem struct {
+	Price  float64
+	Amount float64
+	Venues []Venue
+}
+
+// ConsolidatedItems defines a slice of consolidated orderbook items
+type ConsolidatedItems []ConsolidatedItem
+
+// Consolidated defines the merged depth of the same currency pair across
+// multiple exchanges
+type Consolidated struct {
+	Bids ConsolidatedItems
+	Asks ConsolidatedItems
+
+	Pair        currency.Pair
+	Asset       asset.Item
+	Exchanges   []string
+	LastUpdated time.Time
+}
+
+// Consolidate merges the supplied orderbooks into one book, price levels that
+// match across exchanges are combined and attributed to each contributing
+// exchange. All books must share the pair and asset type, any quote currency
+// normalisation needs to be applied before consolidation.
+func Consolidate(p currency.Pair, a asset.Item, books ...*Base) (*Consolidated, error) {
+	if len(books) == 0 {
+		return nil, errNoOrderbooksToConsolidate
+	}
+	c := &Consolidated{Pair: p, Asset: a}
+	var bids, asks int
+	for x := range books {
+		if books[x] == nil {
+			return nil, fmt.Errorf("%w: nil orderbook", errConsolidationMismatch)
+		}
+		if books[x].Asset != a || !books[x].Pair.Equal(p) {
+			return nil, fmt.Errorf("%w: %s %s %s",
+				errConsolidationMismatch,
+				books[x].Exchange,
+				books[x].Pair,
+				books[x].Asset)
+		}
+		if books[x].LastUpdated.After(c.LastUpdated) {
+			c.LastUpdated = books[x].LastUpdated
+		}
+		c.Exchanges = append(c.Exchanges, books[x].Exchange)
+		bids += len(books[x].Bids)
+		asks += len(books[x].Asks)
+	}
+	c.Bids = consolidateSide(books, bids, true)
+	c.Asks = consolidateSide(books, asks, false)
+	return c, nil
+}
+
+// consolidateSide flattens one side of each book into a single price ordered
+// list, combining equal price levels
+func consolidateSide(books []*Base, size int, bid bool) ConsolidatedItems {
+	if size == 0 {
+		return nil
+	}
+	type level struct {
+		Item
+		exchange string
+	}
+	levels := make([]level, 0, size)
+	for x := range books {
+		side := books[x].Asks
+		if bid {
+			side = books[x].Bids
+		}
+		for y := range side {
+			levels = append(levels, level{Item: side[y], exchange: books[x].Exchange})
+		}
+	}
+	sort.SliceStable(levels, func(i, j int) bool {
+		if bid {
+			return levels[i].Price > levels[j].Price
+		}
+		return levels[i].Price < levels[j].Price
+	})
+	merged := make(ConsolidatedItems, 0, len(levels))
+	for x := range levels {
+		last := len(merged) - 1
+		if last < 0 || merged[last].Price != levels[x].Price {
+			merged = append(merged, ConsolidatedItem{Price: levels[x].Price})
+			last++
+		}
+		merged[last].Amount += levels[x].Amount
+		merged[last].Venues = addVenueAmount(merged[last].Venues,
+			levels[x].exchange,
+			levels[x].Amount)
+	}
+	return merged
+}
+
+// addVenueAmount attributes an amount to an exchange at a price level, an
+// exchange with price duplication will have its amounts combined
+func addVenueAmount(venues []Venue, exchange string, amount float64) []Venue {
+	for x := range venues {
+		if venues[x].Exchange == exchange {
+			venues[x].Amount += amount
+			return venues
+		}
+	}
+	return append(venues, Venue{Exchange: exchange, Amount: amount})
+}
+
+// ConvertQuote returns a copy of the orderbook priced in a different quote
+// currency using the supplied conversion rate
+func (b *Base) ConvertQuote(quote currency.Code, rate float64) (*Base, error) {
+	if rate <= 0 {
+		return nil, fmt.Errorf("%w: %v", errInvalidConversionRate, rate)
+	}
+	cpy := *b
+	cpy.Pair.Quote = quote
+	cpy.Bids = make(Items, len(b.Bids))
+	for x := range b.Bids {
+		cpy.Bids[x] = b.Bids[x]
+		cpy.Bids[x].Price *= rate
+	}
+	cpy.Asks = make(Items, len(b.Asks))
+	for x := range b.Asks {
+		cpy.Asks[x] = b.Asks[x]
+		cpy.Asks[x].Price *= rate
+	}
+	return &cpy, nil
+}
+
+// TotalAmount returns the combined amount across all exchanges for a side of
+// the consolidated book
+func (c ConsolidatedItems) TotalAmount() float64 {
+	var total float64
+	for x := range c {
+		total += c[x].Amount
+	}
+	return total
+}
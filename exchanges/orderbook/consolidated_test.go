@@ -0,0 +1,164 @@
+package orderbook
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+func TestConsolidate(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.BTC, currency.USD)
+	_, err := Consolidate(p, asset.Spot)
+	if !errors.Is(err, errNoOrderbooksToConsolidate) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errNoOrderbooksToConsolidate)
+	}
+
+	_, err = Consolidate(p, asset.Spot, nil)
+	if !errors.Is(err, errConsolidationMismatch) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errConsolidationMismatch)
+	}
+
+	_, err = Consolidate(p, asset.Spot, &Base{
+		Exchange: "one",
+		Pair:     currency.NewPair(currency.BTC, currency.AUD),
+		Asset:    asset.Spot,
+	})
+	if !errors.Is(err, errConsolidationMismatch) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errConsolidationMismatch)
+	}
+
+	_, err = Consolidate(p, asset.Spot, &Base{
+		Exchange: "one",
+		Pair:     p,
+		Asset:    asset.Futures,
+	})
+	if !errors.Is(err, errConsolidationMismatch) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errConsolidationMismatch)
+	}
+
+	early := time.Now().Add(-time.Minute)
+	late := time.Now()
+	c, err := Consolidate(p, asset.Spot,
+		&Base{
+			Exchange:    "one",
+			Pair:        p,
+			Asset:       asset.Spot,
+			LastUpdated: early,
+			Bids:        Items{{Price: 100, Amount: 1}, {Price: 99, Amount: 2}},
+			Asks:        Items{{Price: 101, Amount: 1}, {Price: 102, Amount: 2}},
+		},
+		&Base{
+			Exchange:    "two",
+			Pair:        p,
+			Asset:       asset.Spot,
+			LastUpdated: late,
+			Bids:        Items{{Price: 100.5, Amount: 3}, {Price: 99, Amount: 4}},
+			Asks:        Items{{Price: 101, Amount: 5}, {Price: 103, Amount: 6}},
+		})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+
+	if !c.LastUpdated.Equal(late) {
+		t.Fatalf("received: '%v' but expected: '%v'", c.LastUpdated, late)
+	}
+
+	if len(c.Exchanges) != 2 {
+		t.Fatalf("received: '%v' but expected: '%v'", len(c.Exchanges), 2)
+	}
+
+	expectedBids := []float64{100.5, 100, 99}
+	if len(c.Bids) != len(expectedBids) {
+		t.Fatalf("received: '%v' but expected: '%v'", len(c.Bids), len(expectedBids))
+	}
+	for x := range expectedBids {
+		if c.Bids[x].Price != expectedBids[x] {
+			t.Fatalf("received: '%v' but expected: '%v'", c.Bids[x].Price, expectedBids[x])
+		}
+	}
+
+	if c.Bids[2].Amount != 6 || len(c.Bids[2].Venues) != 2 {
+		t.Fatalf("unexpected merged bid level %+v", c.Bids[2])
+	}
+
+	if c.Bids[2].Venues[0].Exchange != "one" || c.Bids[2].Venues[0].Amount != 2 {
+		t.Fatalf("unexpected venue attribution %+v", c.Bids[2].Venues[0])
+	}
+
+	expectedAsks := []float64{101, 102, 103}
+	if len(c.Asks) != len(expectedAsks) {
+		t.Fatalf("received: '%v' but expected: '%v'", len(c.Asks), len(expectedAsks))
+	}
+	for x := range expectedAsks {
+		if c.Asks[x].Price != expectedAsks[x] {
+			t.Fatalf("received: '%v' but expected: '%v'", c.Asks[x].Price, expectedAsks[x])
+		}
+	}
+
+	if c.Asks[0].Amount != 6 || len(c.Asks[0].Venues) != 2 {
+		t.Fatalf("unexpected merged ask level %+v", c.Asks[0])
+	}
+
+	if total := c.Asks.TotalAmount(); total != 14 {
+		t.Fatalf("received: '%v' but expected: '%v'", total, 14)
+	}
+}
+
+func TestConsolidatePriceDuplication(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPair(currency.BTC, currency.USD)
+	c, err := Consolidate(p, asset.Spot, &Base{
+		Exchange:         "one",
+		Pair:             p,
+		Asset:            asset.Spot,
+		PriceDuplication: true,
+		Bids:             Items{{Price: 100, Amount: 1}, {Price: 100, Amount: 2}},
+	})
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+
+	if len(c.Bids) != 1 || len(c.Bids[0].Venues) != 1 || c.Bids[0].Venues[0].Amount != 3 {
+		t.Fatalf("unexpected duplicated price level %+v", c.Bids)
+	}
+
+	if c.Asks != nil {
+		t.Fatal("expected no asks")
+	}
+}
+
+func TestConvertQuote(t *testing.T) {
+	t.Parallel()
+	b := &Base{
+		Exchange: "one",
+		Pair:     currency.NewPairWithDelimiter("BTC", "AUD", "/"),
+		Asset:    asset.Spot,
+		Bids:     Items{{Price: 100, Amount: 1}},
+		Asks:     Items{{Price: 200, Amount: 1}},
+	}
+	_, err := b.ConvertQuote(currency.USD, 0)
+	if !errors.Is(err, errInvalidConversionRate) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errInvalidConversionRate)
+	}
+
+	cpy, err := b.ConvertQuote(currency.USD, 0.5)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+
+	if cpy.Pair.Quote != currency.USD || cpy.Pair.Delimiter != "/" {
+		t.Fatalf("unexpected pair %v", cpy.Pair)
+	}
+
+	if cpy.Bids[0].Price != 50 || cpy.Asks[0].Price != 100 {
+		t.Fatalf("unexpected conversion bid %v ask %v", cpy.Bids[0].Price, cpy.Asks[0].Price)
+	}
+
+	if b.Bids[0].Price != 100 || b.Asks[0].Price != 200 {
+		t.Fatal("original orderbook should not be modified")
+	}
+}
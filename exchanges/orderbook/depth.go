@@ -23,7 +23,7 @@ type Depth struct {
 	mux *dispatch.Mux
 	id  uuid.UUID
 
-	options
+	depthOptions
 	m sync.Mutex
 }
 
@@ -44,6 +44,27 @@ func (d *Depth) Publish() {
 	}
 }
 
+// WatchUpdates calls fn once and then each time the depth changes, it
+// returns when the kick channel is closed
+func (d *Depth) WatchUpdates(kick <-chan struct{}, fn func()) {
+	for {
+		// Wait is registered before fn is called so that no alert is missed
+		// whilst the depth is being read.
+		wait := d.Wait(kick)
+		fn()
+		if <-wait {
+			return
+		}
+		// A kick can be missed if the alert fires before the wait channel
+		// is read, so check for it directly.
+		select {
+		case <-kick:
+			return
+		default:
+		}
+	}
+}
+
 // GetAskLength returns length of asks
 func (d *Depth) GetAskLength() int {
 	d.m.Lock()
@@ -77,6 +98,25 @@ func (d *Depth) Retrieve() *Base {
 	}
 }
 
+// RetrieveLevels returns a copy of the orderbook base limited to the supplied
+// number of levels on each side, zero returns the full spread
+func (d *Depth) RetrieveLevels(levels int) *Base {
+	d.m.Lock()
+	defer d.m.Unlock()
+	return &Base{
+		Bids:             d.bids.retrieveLevels(levels),
+		Asks:             d.asks.retrieveLevels(levels),
+		Exchange:         d.exchange,
+		Asset:            d.asset,
+		Pair:             d.pair,
+		LastUpdated:      d.lastUpdated,
+		LastUpdateID:     d.lastUpdateID,
+		PriceDuplication: d.priceDuplication,
+		IsFundingRate:    d.isFundingRate,
+		VerifyOrderbook:  d.VerifyOrderbook,
+	}
+}
+
 // TotalBidAmounts returns the total amount of bids and the total orderbook
 // bids value
 func (d *Depth) TotalBidAmounts() (liquidity, value float64) {
@@ -239,7 +279,7 @@ func (d *Depth) UpdateInsertByID(bidUpdts, askUpdts Items, lastUpdateID int64, l
 // AssignOptions assigns the initial options for the depth instance
 func (d *Depth) AssignOptions(b *Base) {
 	d.m.Lock()
-	d.options = options{
+	d.depthOptions = depthOptions{
 		exchange:         b.Exchange,
 		pair:             b.Pair,
 		asset:            b.Asset,
@@ -41,7 +41,7 @@ func TestRetrieve(t *testing.T) {
 	d := newDepth(id)
 	d.asks.load([]Item{{Price: 1337}}, d.stack)
 	d.bids.load([]Item{{Price: 1337}}, d.stack)
-	d.options = options{
+	d.depthOptions = depthOptions{
 		exchange:         "THE BIG ONE!!!!!!",
 		pair:             currency.NewPair(currency.THETA, currency.USD),
 		asset:            "Silly asset",
@@ -54,9 +54,9 @@ func TestRetrieve(t *testing.T) {
 		idAligned:        true,
 	}
 
-	// If we add anymore options to the options struct later this will complain
+	// If we add anymore options to the depthOptions struct later this will complain
 	// generally want to return a full carbon copy
-	mirrored := reflect.Indirect(reflect.ValueOf(d.options))
+	mirrored := reflect.Indirect(reflect.ValueOf(d.depthOptions))
 	for n := 0; n < mirrored.NumField(); n++ {
 		structVal := mirrored.Field(n)
 		if structVal.IsZero() {
@@ -74,6 +74,33 @@ func TestRetrieve(t *testing.T) {
 	}
 }
 
+func TestRetrieveLevels(t *testing.T) {
+	d := newDepth(id)
+	d.asks.load([]Item{{Price: 1337}, {Price: 1338}, {Price: 1339}}, d.stack)
+	d.bids.load([]Item{{Price: 1336}, {Price: 1335}, {Price: 1334}}, d.stack)
+
+	limited := d.RetrieveLevels(2)
+	if len(limited.Asks) != 2 {
+		t.Fatalf("expected len %v, but received %v", 2, len(limited.Asks))
+	}
+	if len(limited.Bids) != 2 {
+		t.Fatalf("expected len %v, but received %v", 2, len(limited.Bids))
+	}
+	if limited.Asks[1].Price != 1338 || limited.Bids[1].Price != 1335 {
+		t.Fatal("unexpected level ordering")
+	}
+
+	full := d.RetrieveLevels(0)
+	if len(full.Asks) != 3 || len(full.Bids) != 3 {
+		t.Fatal("expected full depth to be returned")
+	}
+
+	full = d.RetrieveLevels(100)
+	if len(full.Asks) != 3 || len(full.Bids) != 3 {
+		t.Fatal("expected full depth to be returned")
+	}
+}
+
 func TestTotalAmounts(t *testing.T) {
 	d := newDepth(id)
 
@@ -305,3 +332,31 @@ func TestPublish(t *testing.T) {
 	d := Depth{}
 	d.Publish()
 }
+
+func TestWatchUpdates(t *testing.T) {
+	d := newDepth(id)
+	kick := make(chan struct{})
+	calls := make(chan struct{}, 10)
+	done := make(chan struct{})
+	go func() {
+		d.WatchUpdates(kick, func() { calls <- struct{}{} })
+		close(done)
+	}()
+	<-calls
+	// an alert is only sent once the watcher is waiting, keep loading until
+	// the second call is received
+	for received := false; !received; {
+		d.LoadSnapshot([]Item{{Price: 1337, Amount: 1}}, nil, 0, time.Now(), false)
+		select {
+		case <-calls:
+			received = true
+		case <-time.After(time.Millisecond * 10):
+		}
+	}
+	close(kick)
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("watcher did not return when kicked")
+	}
+}
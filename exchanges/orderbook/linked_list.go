@@ -165,6 +165,22 @@ func (ll *linkedList) retrieve() Items {
 	return depth
 }
 
+// retrieveLevels returns a slice of contents from the linked list limited to
+// the supplied level count, zero or a count beyond the length returns the full
+// list
+func (ll *linkedList) retrieveLevels(levels int) Items {
+	if levels <= 0 || levels > ll.length {
+		levels = ll.length
+	}
+	depth := make(Items, levels)
+	iterator := 0
+	for tip := ll.head; tip != nil && iterator < levels; tip = tip.Next {
+		depth[iterator] = tip.Value
+		iterator++
+	}
+	return depth
+}
+
 // updateInsertByPrice amends, inserts, moves and cleaves length of depth by
 // updates
 func (ll *linkedList) updateInsertByPrice(updts Items, stack *stack, maxChainLength int, compare func(float64, float64) bool, tn now) {
@@ -10,6 +10,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/dispatch"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/options"
 	"github.com/thrasher-corp/gocryptotrader/log"
 )
 
@@ -317,6 +318,12 @@ func (b *Base) Process() error {
 		return errAssetTypeNotSet
 	}
 
+	if b.Asset == asset.Options {
+		if _, err := options.ParseContract(b.Pair); err != nil {
+			return err
+		}
+	}
+
 	if b.LastUpdated.IsZero() {
 		b.LastUpdated = time.Now()
 	}
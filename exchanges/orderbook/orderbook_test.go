@@ -13,6 +13,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/dispatch"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/options"
 )
 
 func TestMain(m *testing.M) {
@@ -720,3 +721,40 @@ func BenchmarkCopySlice(b *testing.B) {
 		copy(cpy, s)
 	}
 }
+
+func TestProcessOptionsOrderbook(t *testing.T) {
+	t.Parallel()
+	c, err := currency.NewPairDelimiter("BTC-USD_210625-40000-C", currency.UnderscoreDelimiter)
+	if err != nil {
+		t.Fatal(err)
+	}
+	base := &Base{
+		Pair:            c,
+		Asks:            []Item{{Price: 0.053, Amount: 10}, {Price: 0.0535, Amount: 5}},
+		Bids:            []Item{{Price: 0.051, Amount: 10}, {Price: 0.0505, Amount: 5}},
+		Exchange:        "OptionsExchange",
+		Asset:           asset.Options,
+		VerifyOrderbook: true,
+	}
+	err = base.Process()
+	if err != nil {
+		t.Fatal(err)
+	}
+	ob, err := Get("OptionsExchange", c, asset.Options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ob.Asks) != 2 || len(ob.Bids) != 2 || ob.Bids[0].Price != 0.051 {
+		t.Errorf("unexpected options orderbook %+v", ob)
+	}
+	_, err = Get("OptionsExchange", c, asset.Futures)
+	if !errors.Is(err, errCannotFindOrderbook) {
+		t.Fatalf("expecting %s error but received %v", errCannotFindOrderbook, err)
+	}
+
+	base.Pair = currency.NewPair(currency.BTC, currency.USD)
+	err = base.Process()
+	if !errors.Is(err, options.ErrInvalidContract) {
+		t.Fatalf("expecting %s error but received %v", options.ErrInvalidContract, err)
+	}
+}
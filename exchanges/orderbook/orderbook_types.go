@@ -32,6 +32,16 @@ var (
 	errIDDuplication       = errors.New("id duplication")
 	errPeriodUnset         = errors.New("funding rate period is unset")
 	errNotEnoughLiquidity  = errors.New("not enough liquidity")
+
+	errNoOrderbooksToConsolidate = errors.New("no orderbooks to consolidate")
+	errConsolidationMismatch     = errors.New("orderbook does not match consolidation parameters")
+	errInvalidConversionRate     = errors.New("invalid quote conversion rate")
+
+	errNoLiquidity           = errors.New("orderbook side has no liquidity")
+	errInvalidLevels         = errors.New("invalid number of levels")
+	errInvalidBasisPoints    = errors.New("basis points must be greater than zero")
+	errNoImpactAmounts       = errors.New("no impact amounts supplied")
+	errInvalidSampleDuration = errors.New("invalid sample duration")
 )
 
 var service = Service{
@@ -103,7 +113,7 @@ func (a byOBPrice) Len() int           { return len(a) }
 func (a byOBPrice) Swap(i, j int)      { a[i], a[j] = a[j], a[i] }
 func (a byOBPrice) Less(i, j int) bool { return a[i].Price < a[j].Price }
 
-type options struct {
+type depthOptions struct {
 	exchange         string
 	pair             currency.Pair
 	asset            asset.Item
@@ -58,7 +58,7 @@ func (d *Depth) GetUnsafe() Unsafe {
 		AskHead:        &d.asks.linkedList.head,
 		m:              &d.m,
 		Notice:         &d.Notice,
-		UpdatedViaREST: &d.options.restSnapshot,
-		LastUpdated:    &d.options.lastUpdated,
+		UpdatedViaREST: &d.depthOptions.restSnapshot,
+		LastUpdated:    &d.depthOptions.lastUpdated,
 	}
 }
@@ -50,8 +50,13 @@ const (
 	poloniexLendingHistory       = "returnLendingHistory"
 	poloniexAutoRenew            = "toggleAutoRenew"
 	poloniexMaxOrderbookDepth    = 100
+
+	minLoanDuration = 2
+	maxLoanDuration = 60
 )
 
+var errInvalidLoanDuration = errors.New("loan duration must be between 2 and 60 days")
+
 // Poloniex is the overarching type across the poloniex package
 type Poloniex struct {
 	exchange.Base
@@ -14,7 +14,9 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fee"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/sharedtestvalues"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
@@ -511,7 +513,7 @@ func TestWithdrawInternationalBank(t *testing.T) {
 
 func TestGetDepositAddress(t *testing.T) {
 	t.Parallel()
-	_, err := p.GetDepositAddress(context.Background(), currency.DASH, "")
+	_, err := p.GetDepositAddress(context.Background(), currency.DASH, "", "")
 	switch {
 	case areTestAPIKeysSet() && err != nil:
 		t.Error("GetDepositAddress()", err)
@@ -1058,3 +1060,134 @@ func TestUpdateTickers(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestGetMarginInterestRates(t *testing.T) {
+	t.Parallel()
+	_, err := p.GetMarginInterestRates(context.Background(), currency.Code{})
+	if !errors.Is(err, margin.ErrCurrencyIsEmpty) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, margin.ErrCurrencyIsEmpty)
+	}
+	rates, err := p.GetMarginInterestRates(context.Background(), currency.BTC)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if mockTests && (len(rates) != 1 || rates[0].Rate <= 0) {
+		t.Errorf("expected a single positive rate, received %+v", rates)
+	}
+}
+
+func TestSubmitMarginLendOffer(t *testing.T) {
+	t.Parallel()
+	_, err := p.SubmitMarginLendOffer(context.Background(), &margin.LendOffer{
+		Currency: currency.BTC,
+		Amount:   0.5,
+		Rate:     0.0002,
+		Period:   61,
+	})
+	if !errors.Is(err, errInvalidLoanDuration) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errInvalidLoanDuration)
+	}
+	if areTestAPIKeysSet() && !canManipulateRealOrders && !mockTests {
+		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
+	}
+	resp, err := p.SubmitMarginLendOffer(context.Background(), &margin.LendOffer{
+		Currency: currency.BTC,
+		Amount:   0.5,
+		Rate:     0.0002,
+	})
+	switch {
+	case areTestAPIKeysSet() && err != nil:
+		t.Error(err)
+	case !areTestAPIKeysSet() && !mockTests && err == nil:
+		t.Error("Expecting an error when no keys are set")
+	case mockTests && err != nil:
+		t.Error(err)
+	case mockTests && resp.ID != "1002013188":
+		t.Errorf("received '%v' expected '1002013188'", resp.ID)
+	}
+}
+
+func TestCancelMarginLendOffer(t *testing.T) {
+	t.Parallel()
+	if areTestAPIKeysSet() && !canManipulateRealOrders && !mockTests {
+		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
+	}
+	err := p.CancelMarginLendOffer(context.Background(), currency.BTC, "1002013188")
+	switch {
+	case areTestAPIKeysSet() && err != nil:
+		t.Error(err)
+	case !areTestAPIKeysSet() && !mockTests && err == nil:
+		t.Error("Expecting an error when no keys are set")
+	case mockTests && err != nil:
+		t.Error(err)
+	}
+}
+
+func TestGetMarginLoans(t *testing.T) {
+	t.Parallel()
+	loans, err := p.GetMarginLoans(context.Background(), currency.Code{})
+	switch {
+	case areTestAPIKeysSet() && err != nil:
+		t.Error(err)
+	case !areTestAPIKeysSet() && !mockTests && err == nil:
+		t.Error("Expecting an error when no keys are set")
+	case mockTests && err != nil:
+		t.Error(err)
+	case mockTests && len(loans) != 3:
+		t.Errorf("received %v loans expected 3", len(loans))
+	case mockTests && (loans[0].Status != margin.Offered ||
+		loans[1].Side != margin.Lend ||
+		loans[2].Side != margin.Borrow ||
+		loans[2].Period != 2):
+		t.Errorf("unexpected loans %+v", loans)
+	}
+}
+
+func TestGetMarginInterestHistory(t *testing.T) {
+	t.Parallel()
+	history, err := p.GetMarginInterestHistory(context.Background(),
+		currency.BTC,
+		time.Unix(1609459200, 0),
+		time.Unix(1609545600, 0))
+	switch {
+	case areTestAPIKeysSet() && err != nil:
+		t.Error(err)
+	case !areTestAPIKeysSet() && !mockTests && err == nil:
+		t.Error("Expecting an error when no keys are set")
+	case mockTests && err != nil:
+		t.Error(err)
+	case mockTests && (len(history) != 1 || history[0].Interest != 0.00000005):
+		t.Errorf("unexpected interest history %+v", history)
+	}
+}
+
+func TestUpdateFeeSchedule(t *testing.T) {
+	t.Parallel()
+	err := p.UpdateFeeSchedule(context.Background())
+	switch {
+	case areTestAPIKeysSet() && err != nil:
+		t.Error(err)
+	case !areTestAPIKeysSet() && !mockTests && err == nil:
+		t.Error("Expecting an error when no keys are set")
+	case mockTests && err != nil:
+		t.Error(err)
+	}
+	if !mockTests {
+		return
+	}
+	c, err := p.Fees.GetCommission(asset.Spot, currency.NewPair(currency.BTC, currency.LTC))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Maker != 0.0015 || c.Taker != 0.0025 {
+		t.Errorf("unexpected commission %+v", c)
+	}
+	_, err = p.Fees.GetTransfer(currency.BTC, "")
+	if err != nil {
+		t.Error(err)
+	}
+	_, err = p.Fees.GetCommission(asset.Futures, currency.NewPair(currency.BTC, currency.LTC))
+	if !errors.Is(err, fee.ErrCommissionNotFound) {
+		t.Errorf("received: '%v' but expected: '%v'", err, fee.ErrCommissionNotFound)
+	}
+}
@@ -292,10 +292,12 @@ type MarginPosition struct {
 // LoanOffer holds loan offer information
 type LoanOffer struct {
 	ID        int64   `json:"id"`
+	Currency  string  `json:"currency"`
 	Rate      float64 `json:"rate,string"`
 	Amount    float64 `json:"amount,string"`
 	Duration  int64   `json:"duration"`
-	AutoRenew bool    `json:"autoRenew"`
+	Range     int64   `json:"range"`
+	AutoRenew int64   `json:"autoRenew"` // 0 or 1
 	Date      string  `json:"date"`
 }
 
@@ -17,6 +17,7 @@ import (
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fill"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stream"
@@ -937,14 +938,18 @@ func (p *Poloniex) processAccountBalanceUpdate(notification []interface{}) error
 		return err
 	}
 
-	// TODO: Integrate with exchange account system
 	// NOTES: This will affect free amount, a rest call might be needed to get
-	// locked and total amounts periodically.
+	// locked and total amounts periodically. Holdings fetched via REST only
+	// cover the exchange wallet so it is left unnamed to match them.
+	var wallet string
+	if walletType != "e" {
+		wallet = deriveWalletType(walletType)
+	}
 	p.Websocket.DataHandler <- account.Change{
 		Exchange: p.Name,
 		Currency: code,
 		Asset:    asset.Spot,
-		Account:  deriveWalletType(walletType),
+		Account:  wallet,
 		Amount:   amount,
 	}
 	return nil
@@ -1030,22 +1035,32 @@ func (p *Poloniex) processAccountTrades(notification []interface{}) error {
 		return err
 	}
 
+	tradeDetail := order.TradeHistory{
+		Price:     rate,
+		Amount:    amount,
+		Fee:       totalFee,
+		Exchange:  p.Name,
+		TID:       strconv.FormatFloat(tradeID, 'f', -1, 64),
+		Timestamp: timeParse,
+		Total:     tradeTotal,
+	}
+	id := strconv.FormatFloat(orderID, 'f', -1, 64)
 	p.Websocket.DataHandler <- &order.Modify{
-		Exchange: p.Name,
-		ID:       strconv.FormatFloat(orderID, 'f', -1, 64),
-		Fee:      totalFee,
-		Trades: []order.TradeHistory{{
-			Price:     rate,
-			Amount:    amount,
-			Fee:       totalFee,
-			Exchange:  p.Name,
-			TID:       strconv.FormatFloat(tradeID, 'f', -1, 64),
-			Timestamp: timeParse,
-			Total:     tradeTotal,
-		}},
+		Exchange:      p.Name,
+		ID:            id,
+		Fee:           totalFee,
+		Trades:        []order.TradeHistory{tradeDetail},
 		AssetType:     asset.Spot,
 		ClientOrderID: clientOrderID,
 	}
+	// The trade notification does not include the pair, fills are matched to
+	// orders by ID
+	p.Websocket.DataHandler <- []fill.Data{{
+		TradeHistory:  tradeDetail,
+		OrderID:       id,
+		ClientOrderID: clientOrderID,
+		AssetType:     asset.Spot,
+	}}
 	return nil
 }
 
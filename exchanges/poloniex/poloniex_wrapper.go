@@ -15,7 +15,11 @@ import (
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/capability"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/deposit"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/fee"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/margin"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
@@ -113,6 +117,10 @@ func (p *Poloniex) SetDefaults() {
 			Kline: kline.ExchangeCapabilitiesSupported{
 				Intervals: true,
 			},
+			Orders: capability.Orders{
+				Types:       []order.Type{order.Limit, order.Market},
+				TimeInForce: []capability.TimeInForce{capability.GoodTillCancel},
+			},
 		},
 		Enabled: exchange.FeaturesEnabled{
 			AutoPairUpdates: true,
@@ -692,19 +700,19 @@ func (p *Poloniex) GetOrderInfo(ctx context.Context, orderID string, pair curren
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (p *Poloniex) GetDepositAddress(ctx context.Context, cryptocurrency currency.Code, _ string) (string, error) {
+func (p *Poloniex) GetDepositAddress(ctx context.Context, cryptocurrency currency.Code, _, _ string) (*deposit.Address, error) {
 	a, err := p.GetDepositAddresses(ctx)
 	if err != nil {
-		return "", err
+		return nil, err
 	}
 
 	address, ok := a.Addresses[cryptocurrency.Upper().String()]
 	if !ok {
-		return "", fmt.Errorf("cannot find deposit address for %s",
+		return nil, fmt.Errorf("cannot find deposit address for %s",
 			cryptocurrency)
 	}
 
-	return address, nil
+	return &deposit.Address{Address: address}, nil
 }
 
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
@@ -911,3 +919,195 @@ func (p *Poloniex) GetHistoricCandles(ctx context.Context, pair currency.Pair, a
 func (p *Poloniex) GetHistoricCandlesExtended(ctx context.Context, pair currency.Pair, a asset.Item, start, end time.Time, interval kline.Interval) (kline.Item, error) {
 	return p.GetHistoricCandles(ctx, pair, a, start, end, interval)
 }
+
+// GetMarginInterestRates returns the lowest daily rate offered on the lending
+// market for a currency
+func (p *Poloniex) GetMarginInterestRates(ctx context.Context, c currency.Code) ([]margin.InterestRate, error) {
+	if c.IsEmpty() {
+		return nil, margin.ErrCurrencyIsEmpty
+	}
+	book, err := p.GetLoanOrders(ctx, c.Upper().String())
+	if err != nil {
+		return nil, err
+	}
+	if len(book.Offers) == 0 {
+		return nil, nil
+	}
+	rate := book.Offers[0].Rate
+	for i := range book.Offers[1:] {
+		if book.Offers[i+1].Rate < rate {
+			rate = book.Offers[i+1].Rate
+		}
+	}
+	return []margin.InterestRate{{
+		Exchange: p.Name,
+		Currency: c.Upper(),
+		Rate:     rate,
+		Time:     time.Now(),
+	}}, nil
+}
+
+// SubmitMarginLendOffer places a loan offer on the lending market
+func (p *Poloniex) SubmitMarginLendOffer(ctx context.Context, o *margin.LendOffer) (*margin.LoanResponse, error) {
+	if err := o.Validate(); err != nil {
+		return nil, err
+	}
+	period := o.Period
+	if period == 0 {
+		period = minLoanDuration
+	}
+	if period < minLoanDuration || period > maxLoanDuration {
+		return nil, fmt.Errorf("%v %w", period, errInvalidLoanDuration)
+	}
+	id, err := p.CreateLoanOffer(ctx,
+		o.Currency.Upper().String(),
+		o.Amount,
+		o.Rate,
+		int(period),
+		o.AutoRenew)
+	if err != nil {
+		return nil, err
+	}
+	return &margin.LoanResponse{
+		ID:       strconv.FormatInt(id, 10),
+		Currency: o.Currency,
+		Amount:   o.Amount,
+		Time:     time.Now(),
+	}, nil
+}
+
+// CancelMarginLendOffer cancels an open loan offer
+func (p *Poloniex) CancelMarginLendOffer(ctx context.Context, _ currency.Code, id string) error {
+	offerID, err := strconv.ParseInt(id, 10, 64)
+	if err != nil {
+		return err
+	}
+	_, err = p.CancelLoanOffer(ctx, offerID)
+	return err
+}
+
+// GetMarginLoans returns open loan offers along with active loans provided to
+// and used from the lending market
+func (p *Poloniex) GetMarginLoans(ctx context.Context, c currency.Code) ([]margin.Loan, error) {
+	offers, err := p.GetOpenLoanOffers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	active, err := p.GetActiveLoans(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var loans []margin.Loan
+	appendLoans := func(code currency.Code, l []LoanOffer, side margin.Side, status margin.Status) error {
+		if !c.IsEmpty() && !c.Match(code) {
+			return nil
+		}
+		for i := range l {
+			tm, err := time.Parse(common.SimpleTimeFormat, l[i].Date)
+			if err != nil {
+				return err
+			}
+			period := l[i].Duration
+			if period == 0 {
+				period = l[i].Range
+			}
+			loans = append(loans, margin.Loan{
+				ID:        strconv.FormatInt(l[i].ID, 10),
+				Side:      side,
+				Status:    status,
+				Currency:  code,
+				Amount:    l[i].Amount,
+				Rate:      l[i].Rate,
+				Period:    period,
+				AutoRenew: l[i].AutoRenew == 1,
+				Time:      tm,
+			})
+		}
+		return nil
+	}
+
+	for k, v := range offers {
+		if err = appendLoans(currency.NewCode(k), v, margin.Lend, margin.Offered); err != nil {
+			return nil, err
+		}
+	}
+	for i := range active.Provided {
+		if err = appendLoans(currency.NewCode(active.Provided[i].Currency), active.Provided[i:i+1], margin.Lend, margin.Active); err != nil {
+			return nil, err
+		}
+	}
+	for i := range active.Used {
+		if err = appendLoans(currency.NewCode(active.Used[i].Currency), active.Used[i:i+1], margin.Borrow, margin.Active); err != nil {
+			return nil, err
+		}
+	}
+	sort.Slice(loans, func(i, j int) bool {
+		return loans[i].Time.Before(loans[j].Time)
+	})
+	return loans, nil
+}
+
+// GetMarginInterestHistory returns the interest earned, net of fees, on
+// completed loans provided to the lending market
+func (p *Poloniex) GetMarginInterestHistory(ctx context.Context, c currency.Code, start, end time.Time) ([]margin.InterestPayment, error) {
+	var startStr, endStr string
+	if !start.IsZero() {
+		startStr = strconv.FormatInt(start.Unix(), 10)
+	}
+	if !end.IsZero() {
+		endStr = strconv.FormatInt(end.Unix(), 10)
+	}
+	history, err := p.GetLendingHistory(ctx, startStr, endStr)
+	if err != nil {
+		return nil, err
+	}
+	payments := make([]margin.InterestPayment, 0, len(history))
+	for i := range history {
+		code := currency.NewCode(history[i].Currency)
+		if !c.IsEmpty() && !c.Match(code) {
+			continue
+		}
+		var tm time.Time
+		tm, err = time.Parse(common.SimpleTimeFormat, history[i].Close)
+		if err != nil {
+			return nil, err
+		}
+		payments = append(payments, margin.InterestPayment{
+			Currency:  code,
+			Side:      margin.Lend,
+			Principal: history[i].Amount,
+			Interest:  history[i].Earned,
+			Rate:      history[i].Rate,
+			Time:      tm,
+		})
+	}
+	return margin.FilterInterestPayments(payments, start, end), nil
+}
+
+// UpdateFeeSchedule retrieves the account commission rates and thirty day
+// volume and loads them with the withdrawal fees into the fee definitions
+func (p *Poloniex) UpdateFeeSchedule(ctx context.Context) error {
+	info, err := p.GetFeeInfo(ctx)
+	if err != nil {
+		return err
+	}
+	commission := fee.Commission{Maker: info.MakerFee, Taker: info.TakerFee}
+	s := &fee.Schedule{
+		Exchange:        p.Name,
+		ThirtyDayVolume: info.ThirtyDayVolume,
+		VolumeCurrency:  currency.USD,
+		Commissions: map[asset.Item]fee.Commission{
+			asset.Spot:   commission,
+			asset.Margin: commission,
+		},
+		Authenticated: true,
+	}
+	for c, f := range WithdrawalFees {
+		s.Transfers = append(s.Transfers, fee.Transfer{
+			Currency:   c,
+			Withdrawal: f,
+		})
+	}
+	return p.Fees.Load(s)
+}
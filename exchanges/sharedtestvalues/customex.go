@@ -10,6 +10,7 @@ import (
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/deposit"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
@@ -148,10 +149,18 @@ func (c *CustomEx) SubmitOrder(ctx context.Context, s *order.Submit) (order.Subm
 	return order.SubmitResponse{}, nil
 }
 
+func (c *CustomEx) SubmitBatchOrders(ctx context.Context, s []*order.Submit) (order.SubmitBatchResponse, error) {
+	return order.SubmitBatchResponse{}, nil
+}
+
 func (c *CustomEx) ModifyOrder(ctx context.Context, action *order.Modify) (order.Modify, error) {
 	return order.Modify{}, nil
 }
 
+func (c *CustomEx) ModifyBatchOrders(ctx context.Context, m []order.Modify) (order.ModifyBatchResponse, error) {
+	return order.ModifyBatchResponse{}, nil
+}
+
 func (c *CustomEx) CancelOrder(ctx context.Context, o *order.Cancel) error {
 	return nil
 }
@@ -160,6 +169,10 @@ func (c *CustomEx) CancelBatchOrders(ctx context.Context, o []order.Cancel) (ord
 	return order.CancelBatchResponse{}, nil
 }
 
+func (c *CustomEx) SetDeadMansSwitch(ctx context.Context, a asset.Item, timeout time.Duration) error {
+	return nil
+}
+
 func (c *CustomEx) CancelAllOrders(ctx context.Context, orders *order.Cancel) (order.CancelAllResponse, error) {
 	return order.CancelAllResponse{}, nil
 }
@@ -168,8 +181,8 @@ func (c *CustomEx) GetOrderInfo(ctx context.Context, orderID string, pair curren
 	return order.Detail{}, nil
 }
 
-func (c *CustomEx) GetDepositAddress(ctx context.Context, cryptocurrency currency.Code, accountID string) (string, error) {
-	return "", nil
+func (c *CustomEx) GetDepositAddress(ctx context.Context, cryptocurrency currency.Code, accountID, _ string) (*deposit.Address, error) {
+	return &deposit.Address{}, nil
 }
 
 func (c *CustomEx) GetOrderHistory(ctx context.Context, getOrdersRequest *order.GetOrdersRequest) ([]order.Detail, error) {
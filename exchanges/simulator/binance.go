@@ -0,0 +1,638 @@
+package simulator
+
+import (
+	"crypto/hmac"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common/crypto"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// binanceRouter returns the handler serving the Binance spot API
+func (s *Server) binanceRouter() http.Handler {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/api/v3/ping", s.binancePing)
+	mux.HandleFunc("/api/v3/time", s.binanceTime)
+	mux.HandleFunc("/api/v3/exchangeInfo", s.binanceExchangeInfo)
+	mux.HandleFunc("/api/v3/depth", s.binanceDepth)
+	mux.HandleFunc("/api/v3/trades", s.binanceTrades)
+	mux.HandleFunc("/api/v3/ticker/24hr", s.binanceTicker)
+	mux.HandleFunc("/api/v3/order", s.binanceOrder)
+	mux.HandleFunc("/api/v3/order/test", s.binanceOrderTest)
+	mux.HandleFunc("/api/v3/openOrders", s.binanceOpenOrders)
+	mux.HandleFunc("/api/v3/allOrders", s.binanceAllOrders)
+	mux.HandleFunc("/api/v3/account", s.binanceAccount)
+	mux.HandleFunc("/api/v3/userDataStream", s.binanceUserDataStream)
+	mux.HandleFunc("/stream", s.binanceWebsocket)
+	mux.HandleFunc("/ws", s.binanceWebsocket)
+	mux.HandleFunc("/", func(w http.ResponseWriter, r *http.Request) {
+		writeBinanceError(w, http.StatusNotFound, binanceCodeUnknownEndpoint,
+			"unsupported endpoint "+r.Method+" "+r.URL.Path)
+	})
+	return mux
+}
+
+func (s *Server) binancePing(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, struct{}{})
+}
+
+func (s *Server) binanceTime(w http.ResponseWriter, _ *http.Request) {
+	writeJSON(w, struct {
+		ServerTime int64 `json:"serverTime"`
+	}{ServerTime: milliseconds(time.Now())})
+}
+
+func (s *Server) binanceExchangeInfo(w http.ResponseWriter, _ *http.Request) {
+	markets := s.exchange.Markets()
+	resp := binanceExchangeInfo{
+		Timezone:        "UTC",
+		ServerTime:      milliseconds(time.Now()),
+		RateLimits:      []interface{}{},
+		ExchangeFilters: []interface{}{},
+		Symbols:         make([]binanceSymbol, len(markets)),
+	}
+	for i := range markets {
+		m := markets[i]
+		maxQuantity := m.MaxQuantity
+		if maxQuantity == 0 {
+			maxQuantity = 9000000000
+		}
+		resp.Symbols[i] = binanceSymbol{
+			Symbol:               m.Symbol(),
+			Status:               "TRADING",
+			BaseAsset:            m.Base,
+			BaseAssetPrecision:   8,
+			QuoteAsset:           m.Quote,
+			QuotePrecision:       8,
+			QuoteAssetPrecision:  8,
+			OrderTypes:           []string{string(Limit), string(Market)},
+			IsSpotTradingAllowed: true,
+			Permissions:          []string{"SPOT"},
+			Filters: []map[string]interface{}{
+				{"filterType": "PRICE_FILTER", "minPrice": formatFloat(m.TickSize), "maxPrice": formatFloat(1000000000), "tickSize": formatFloat(m.TickSize)},
+				{"filterType": "PERCENT_PRICE", "multiplierUp": "5", "multiplierDown": "0.2", "avgPriceMins": 5},
+				{"filterType": "LOT_SIZE", "minQty": formatFloat(m.MinQuantity), "maxQty": formatFloat(maxQuantity), "stepSize": formatFloat(m.StepSize)},
+				{"filterType": "MIN_NOTIONAL", "minNotional": formatFloat(m.MinNotional), "applyToMarket": true, "avgPriceMins": 5},
+				{"filterType": "ICEBERG_PARTS", "limit": 10},
+				{"filterType": "MARKET_LOT_SIZE", "minQty": formatFloat(m.MinQuantity), "maxQty": formatFloat(maxQuantity), "stepSize": formatFloat(m.StepSize)},
+				{"filterType": "MAX_NUM_ORDERS", "maxNumOrders": 200},
+				{"filterType": "MAX_NUM_ALGO_ORDERS", "maxNumAlgoOrders": 5},
+			},
+		}
+	}
+	writeJSON(w, resp)
+}
+
+func (s *Server) binanceDepth(w http.ResponseWriter, r *http.Request) {
+	params := r.URL.Query()
+	limit := defaultDepthLimit
+	if l := params.Get("limit"); l != "" {
+		var err error
+		limit, err = strconv.Atoi(l)
+		if err != nil || limit <= 0 {
+			writeBinanceError(w, http.StatusBadRequest, binanceCodeIllegalParameter, "Illegal value for parameter 'limit'.")
+			return
+		}
+	}
+	bids, asks, updateID, err := s.exchange.Depth(params.Get("symbol"), limit)
+	if err != nil {
+		writeExchangeError(w, err)
+		return
+	}
+	writeJSON(w, binanceDepth{
+		LastUpdateID: updateID,
+		Bids:         formatLevels(bids),
+		Asks:         formatLevels(asks),
+	})
+}
+
+func (s *Server) binanceTrades(w http.ResponseWriter, r *http.Request) {
+	params := r.URL.Query()
+	limit := 500
+	if l := params.Get("limit"); l != "" {
+		var err error
+		limit, err = strconv.Atoi(l)
+		if err != nil || limit <= 0 {
+			writeBinanceError(w, http.StatusBadRequest, binanceCodeIllegalParameter, "Illegal value for parameter 'limit'.")
+			return
+		}
+	}
+	trades, err := s.exchange.Trades(params.Get("symbol"), limit)
+	if err != nil {
+		writeExchangeError(w, err)
+		return
+	}
+	resp := make([]binanceTrade, len(trades))
+	for i := range trades {
+		resp[i] = binanceTrade{
+			ID:           trades[i].ID,
+			Price:        formatFloat(trades[i].Price),
+			Quantity:     formatFloat(trades[i].Quantity),
+			QuoteQty:     formatFloat(trades[i].Price * trades[i].Quantity),
+			Time:         milliseconds(trades[i].Time),
+			IsBuyerMaker: trades[i].BuyerMaker,
+			IsBestMatch:  true,
+		}
+	}
+	writeJSON(w, resp)
+}
+
+func (s *Server) binanceTicker(w http.ResponseWriter, r *http.Request) {
+	symbol := r.URL.Query().Get("symbol")
+	if symbol == "" {
+		tickers := s.exchange.Tickers()
+		resp := make([]binanceTicker, len(tickers))
+		for i := range tickers {
+			resp[i] = toBinanceTicker(&tickers[i])
+		}
+		writeJSON(w, resp)
+		return
+	}
+	t, err := s.exchange.Ticker(symbol)
+	if err != nil {
+		writeExchangeError(w, err)
+		return
+	}
+	writeJSON(w, toBinanceTicker(&t))
+}
+
+func (s *Server) binanceOrder(w http.ResponseWriter, r *http.Request) {
+	params, ok := s.binanceAuthenticate(w, r)
+	if !ok {
+		return
+	}
+	switch r.Method {
+	case http.MethodPost:
+		req, err := binanceOrderRequest(params)
+		if err != nil {
+			writeBinanceError(w, http.StatusBadRequest, binanceCodeMandatoryParam, err.Error())
+			return
+		}
+		o, fills, err := s.exchange.SubmitOrder(req)
+		if err != nil {
+			writeExchangeError(w, err)
+			return
+		}
+		resp := binanceNewOrder{
+			Symbol:              o.Symbol,
+			OrderID:             o.ID,
+			OrderListID:         -1,
+			ClientOrderID:       o.ClientOrderID,
+			TransactTime:        milliseconds(o.Updated),
+			Price:               formatFloat(o.Price),
+			OrigQty:             formatFloat(o.Quantity),
+			ExecutedQty:         formatFloat(o.ExecutedQuantity),
+			CummulativeQuoteQty: formatFloat(o.CumulativeQuote),
+			Status:              string(o.Status),
+			TimeInForce:         string(o.TimeInForce),
+			Type:                string(o.Type),
+			Side:                string(o.Side),
+			Fills:               make([]binanceFill, len(fills)),
+		}
+		for i := range fills {
+			resp.Fills[i] = binanceFill{
+				Price:           formatFloat(fills[i].Price),
+				Qty:             formatFloat(fills[i].Quantity),
+				Commission:      formatFloat(fills[i].Commission),
+				CommissionAsset: fills[i].CommissionAsset,
+				TradeID:         fills[i].TradeID,
+			}
+		}
+		writeJSON(w, resp)
+	case http.MethodDelete:
+		id, clientOrderID, err := binanceOrderIdentifier(params)
+		if err != nil {
+			writeBinanceError(w, http.StatusBadRequest, binanceCodeMandatoryParam, err.Error())
+			return
+		}
+		o, err := s.exchange.CancelOrder(params.Get("symbol"), id, clientOrderID)
+		if err != nil {
+			if errors.Is(err, ErrOrderNotFound) || errors.Is(err, errOrderNotOpen) {
+				writeBinanceError(w, http.StatusBadRequest, binanceCodeCancelRejected, "Unknown order sent.")
+				return
+			}
+			writeExchangeError(w, err)
+			return
+		}
+		writeJSON(w, binanceCancelledOrder{
+			Symbol:              o.Symbol,
+			OrigClientOrderID:   o.ClientOrderID,
+			OrderID:             o.ID,
+			OrderListID:         -1,
+			ClientOrderID:       o.ClientOrderID,
+			Price:               formatFloat(o.Price),
+			OrigQty:             formatFloat(o.Quantity),
+			ExecutedQty:         formatFloat(o.ExecutedQuantity),
+			CummulativeQuoteQty: formatFloat(o.CumulativeQuote),
+			Status:              string(o.Status),
+			TimeInForce:         string(o.TimeInForce),
+			Type:                string(o.Type),
+			Side:                string(o.Side),
+		})
+	case http.MethodGet:
+		id, clientOrderID, err := binanceOrderIdentifier(params)
+		if err != nil {
+			writeBinanceError(w, http.StatusBadRequest, binanceCodeMandatoryParam, err.Error())
+			return
+		}
+		o, err := s.exchange.Order(params.Get("symbol"), id, clientOrderID)
+		if err != nil {
+			writeExchangeError(w, err)
+			return
+		}
+		writeJSON(w, toBinanceOrder(&o))
+	default:
+		writeBinanceError(w, http.StatusMethodNotAllowed, binanceCodeUnknownEndpoint,
+			"unsupported method "+r.Method)
+	}
+}
+
+func (s *Server) binanceOrderTest(w http.ResponseWriter, r *http.Request) {
+	params, ok := s.binanceAuthenticate(w, r)
+	if !ok {
+		return
+	}
+	if _, err := binanceOrderRequest(params); err != nil {
+		writeBinanceError(w, http.StatusBadRequest, binanceCodeMandatoryParam, err.Error())
+		return
+	}
+	writeJSON(w, struct{}{})
+}
+
+func (s *Server) binanceOpenOrders(w http.ResponseWriter, r *http.Request) {
+	params, ok := s.binanceAuthenticate(w, r)
+	if !ok {
+		return
+	}
+	orders, err := s.exchange.OpenOrders(params.Get("symbol"))
+	if err != nil {
+		writeExchangeError(w, err)
+		return
+	}
+	writeBinanceOrders(w, orders)
+}
+
+func (s *Server) binanceAllOrders(w http.ResponseWriter, r *http.Request) {
+	params, ok := s.binanceAuthenticate(w, r)
+	if !ok {
+		return
+	}
+	symbol := params.Get("symbol")
+	if symbol == "" {
+		writeBinanceError(w, http.StatusBadRequest, binanceCodeMandatoryParam,
+			"Mandatory parameter 'symbol' was not sent, was empty/null, or malformed.")
+		return
+	}
+	orders, err := s.exchange.Orders(symbol)
+	if err != nil {
+		writeExchangeError(w, err)
+		return
+	}
+	if from := params.Get("orderId"); from != "" {
+		id, err := strconv.ParseInt(from, 10, 64)
+		if err != nil {
+			writeBinanceError(w, http.StatusBadRequest, binanceCodeIllegalParameter, "Illegal value for parameter 'orderId'.")
+			return
+		}
+		filtered := orders[:0]
+		for i := range orders {
+			if orders[i].ID >= id {
+				filtered = append(filtered, orders[i])
+			}
+		}
+		orders = filtered
+	}
+	if l := params.Get("limit"); l != "" {
+		limit, err := strconv.Atoi(l)
+		if err != nil || limit <= 0 {
+			writeBinanceError(w, http.StatusBadRequest, binanceCodeIllegalParameter, "Illegal value for parameter 'limit'.")
+			return
+		}
+		if len(orders) > limit {
+			orders = orders[len(orders)-limit:]
+		}
+	}
+	writeBinanceOrders(w, orders)
+}
+
+func (s *Server) binanceAccount(w http.ResponseWriter, r *http.Request) {
+	if _, ok := s.binanceAuthenticate(w, r); !ok {
+		return
+	}
+	maker, taker := s.exchange.Fees()
+	balances := s.exchange.Balances()
+	resp := binanceAccount{
+		MakerCommission: int64(maker * 10000),
+		TakerCommission: int64(taker * 10000),
+		CanTrade:        true,
+		CanWithdraw:     false,
+		CanDeposit:      true,
+		UpdateTime:      milliseconds(s.exchange.LastUpdated()),
+		AccountType:     "SPOT",
+		Balances:        make([]binanceBalance, len(balances)),
+		Permissions:     []string{"SPOT"},
+	}
+	for i := range balances {
+		resp.Balances[i] = binanceBalance{
+			Asset:  balances[i].Asset,
+			Free:   formatFloat(balances[i].Free),
+			Locked: formatFloat(balances[i].Locked),
+		}
+	}
+	writeJSON(w, resp)
+}
+
+func (s *Server) binanceUserDataStream(w http.ResponseWriter, r *http.Request) {
+	if !s.binanceAPIKey(w, r) {
+		return
+	}
+	switch r.Method {
+	case http.MethodPost:
+		key, err := s.newListenKey()
+		if err != nil {
+			writeBinanceError(w, http.StatusInternalServerError, binanceCodeUnknown, err.Error())
+			return
+		}
+		writeJSON(w, binanceListenKey{ListenKey: key})
+	case http.MethodPut, http.MethodDelete:
+		key := r.URL.Query().Get("listenKey")
+		s.m.Lock()
+		_, ok := s.listenKeys[key]
+		if ok {
+			if r.Method == http.MethodPut {
+				s.listenKeys[key] = time.Now()
+			} else {
+				delete(s.listenKeys, key)
+			}
+		}
+		s.m.Unlock()
+		if !ok {
+			writeBinanceError(w, http.StatusBadRequest, binanceCodeInvalidListenKey, "This listenKey does not exist.")
+			return
+		}
+		writeJSON(w, struct{}{})
+	default:
+		writeBinanceError(w, http.StatusMethodNotAllowed, binanceCodeUnknownEndpoint,
+			"unsupported method "+r.Method)
+	}
+}
+
+// binanceAPIKey verifies the API key header, any key is accepted when the
+// server is configured without credentials
+func (s *Server) binanceAPIKey(w http.ResponseWriter, r *http.Request) bool {
+	if s.apiKey == "" || r.Header.Get(binanceAPIKeyHeader) == s.apiKey {
+		return true
+	}
+	writeBinanceError(w, http.StatusUnauthorized, binanceCodeInvalidAPIKey,
+		"Invalid API-key, IP, or permissions for action.")
+	return false
+}
+
+// binanceAuthenticate verifies the API key, request signature and timestamp
+// of a signed request and returns the combined query and body parameters
+func (s *Server) binanceAuthenticate(w http.ResponseWriter, r *http.Request) (url.Values, bool) {
+	if !s.binanceAPIKey(w, r) {
+		return nil, false
+	}
+	payload := r.URL.RawQuery
+	if r.Body != nil {
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			writeBinanceError(w, http.StatusBadRequest, binanceCodeIllegalParameter, err.Error())
+			return nil, false
+		}
+		if len(body) > 0 {
+			if payload != "" {
+				payload += "&"
+			}
+			payload += string(body)
+		}
+	}
+	params, err := url.ParseQuery(payload)
+	if err != nil {
+		writeBinanceError(w, http.StatusBadRequest, binanceCodeIllegalParameter, err.Error())
+		return nil, false
+	}
+
+	signature := params.Get("signature")
+	if signature == "" {
+		writeBinanceError(w, http.StatusBadRequest, binanceCodeMandatoryParam,
+			"Mandatory parameter 'signature' was not sent, was empty/null, or malformed.")
+		return nil, false
+	}
+	if s.apiKey != "" {
+		parts := strings.Split(payload, "&")
+		signed := parts[:0]
+		for i := range parts {
+			if !strings.HasPrefix(parts[i], "signature=") {
+				signed = append(signed, parts[i])
+			}
+		}
+		expected, err := crypto.GetHMAC(crypto.HashSHA256,
+			[]byte(strings.Join(signed, "&")),
+			[]byte(s.apiSecret))
+		if err != nil {
+			writeBinanceError(w, http.StatusInternalServerError, binanceCodeUnknown, err.Error())
+			return nil, false
+		}
+		if !hmac.Equal([]byte(crypto.HexEncodeToString(expected)), []byte(signature)) {
+			writeBinanceError(w, http.StatusBadRequest, binanceCodeBadSignature,
+				"Signature for this request is not valid.")
+			return nil, false
+		}
+	}
+
+	timestamp, err := strconv.ParseInt(params.Get("timestamp"), 10, 64)
+	if err != nil {
+		writeBinanceError(w, http.StatusBadRequest, binanceCodeMandatoryParam,
+			"Mandatory parameter 'timestamp' was not sent, was empty/null, or malformed.")
+		return nil, false
+	}
+	recvWindow := int64(binanceDefaultRecvWindow)
+	if rw := params.Get("recvWindow"); rw != "" {
+		recvWindow, err = strconv.ParseInt(rw, 10, 64)
+		if err != nil {
+			writeBinanceError(w, http.StatusBadRequest, binanceCodeIllegalParameter,
+				"Illegal value for parameter 'recvWindow'.")
+			return nil, false
+		}
+	}
+	now := milliseconds(time.Now())
+	if timestamp > now+binanceMaxFutureOffset || now-timestamp > recvWindow {
+		writeBinanceError(w, http.StatusBadRequest, binanceCodeBadTimestamp,
+			"Timestamp for this request is outside of the recvWindow.")
+		return nil, false
+	}
+	return params, true
+}
+
+// binanceOrderRequest converts order placement parameters
+func binanceOrderRequest(params url.Values) (*OrderRequest, error) {
+	for _, p := range []string{"symbol", "side", "type", "quantity"} {
+		if params.Get(p) == "" {
+			return nil, fmt.Errorf("mandatory parameter '%s' was not sent, was empty/null, or malformed", p)
+		}
+	}
+	quantity, err := strconv.ParseFloat(params.Get("quantity"), 64)
+	if err != nil {
+		return nil, errors.New("illegal value for parameter 'quantity'")
+	}
+	r := &OrderRequest{
+		Symbol:        params.Get("symbol"),
+		ClientOrderID: params.Get("newClientOrderId"),
+		Side:          Side(params.Get("side")),
+		Type:          OrderType(params.Get("type")),
+		TimeInForce:   TimeInForce(params.Get("timeInForce")),
+		Quantity:      quantity,
+	}
+	if p := params.Get("price"); p != "" {
+		r.Price, err = strconv.ParseFloat(p, 64)
+		if err != nil {
+			return nil, errors.New("illegal value for parameter 'price'")
+		}
+	}
+	return r, nil
+}
+
+// binanceOrderIdentifier returns the order ID or original client order ID
+// supplied with a request
+func binanceOrderIdentifier(params url.Values) (id int64, clientOrderID string, err error) {
+	if params.Get("symbol") == "" {
+		return 0, "", errors.New("mandatory parameter 'symbol' was not sent, was empty/null, or malformed")
+	}
+	clientOrderID = params.Get("origClientOrderId")
+	if v := params.Get("orderId"); v != "" {
+		id, err = strconv.ParseInt(v, 10, 64)
+		if err != nil {
+			return 0, "", errors.New("illegal value for parameter 'orderId'")
+		}
+	}
+	if id == 0 && clientOrderID == "" {
+		return 0, "", errors.New("param 'origClientOrderId' or 'orderId' must be sent, but both were empty/null")
+	}
+	return id, clientOrderID, nil
+}
+
+func writeBinanceOrders(w http.ResponseWriter, orders []Order) {
+	resp := make([]binanceOrder, len(orders))
+	for i := range orders {
+		resp[i] = toBinanceOrder(&orders[i])
+	}
+	writeJSON(w, resp)
+}
+
+func toBinanceOrder(o *Order) binanceOrder {
+	return binanceOrder{
+		Symbol:              o.Symbol,
+		OrderID:             o.ID,
+		OrderListID:         -1,
+		ClientOrderID:       o.ClientOrderID,
+		Price:               formatFloat(o.Price),
+		OrigQty:             formatFloat(o.Quantity),
+		ExecutedQty:         formatFloat(o.ExecutedQuantity),
+		CummulativeQuoteQty: formatFloat(o.CumulativeQuote),
+		Status:              string(o.Status),
+		TimeInForce:         string(o.TimeInForce),
+		Type:                string(o.Type),
+		Side:                string(o.Side),
+		StopPrice:           formatFloat(0),
+		IcebergQty:          formatFloat(0),
+		Time:                milliseconds(o.Created),
+		UpdateTime:          milliseconds(o.Updated),
+		IsWorking:           o.isOpen(),
+		OrigQuoteOrderQty:   formatFloat(0),
+	}
+}
+
+func toBinanceTicker(t *Ticker) binanceTicker {
+	return binanceTicker{
+		Symbol:             t.Symbol,
+		PriceChange:        formatFloat(t.PriceChange),
+		PriceChangePercent: strconv.FormatFloat(t.PricePercent, 'f', 3, 64),
+		WeightedAvgPrice:   formatFloat(t.WeightedAvgPrice),
+		PrevClosePrice:     formatFloat(t.PrevClosePrice),
+		LastPrice:          formatFloat(t.LastPrice),
+		LastQty:            formatFloat(t.LastQuantity),
+		BidPrice:           formatFloat(t.BidPrice),
+		BidQty:             formatFloat(t.BidQuantity),
+		AskPrice:           formatFloat(t.AskPrice),
+		AskQty:             formatFloat(t.AskQuantity),
+		OpenPrice:          formatFloat(t.OpenPrice),
+		HighPrice:          formatFloat(t.HighPrice),
+		LowPrice:           formatFloat(t.LowPrice),
+		Volume:             formatFloat(t.Volume),
+		QuoteVolume:        formatFloat(t.QuoteVolume),
+		OpenTime:           milliseconds(t.OpenTime),
+		CloseTime:          milliseconds(t.CloseTime),
+		FirstID:            t.FirstTradeID,
+		LastID:             t.LastTradeID,
+		Count:              t.Count,
+	}
+}
+
+// writeExchangeError converts simulated exchange errors to Binance errors
+func writeExchangeError(w http.ResponseWriter, err error) {
+	code := binanceCodeUnknown
+	switch {
+	case errors.Is(err, ErrUnknownSymbol):
+		writeBinanceError(w, http.StatusBadRequest, binanceCodeInvalidSymbol, "Invalid symbol.")
+		return
+	case errors.Is(err, ErrOrderNotFound):
+		writeBinanceError(w, http.StatusBadRequest, binanceCodeNoSuchOrder, "Order does not exist.")
+		return
+	case errors.Is(err, ErrInsufficientBalance),
+		errors.Is(err, errDuplicateClientOrderID),
+		errors.Is(err, errOrderNotOpen):
+		code = binanceCodeNewOrderRejected
+	case errors.Is(err, errInvalidQuantity),
+		errors.Is(err, errInvalidPrice),
+		errors.Is(err, errMinNotional):
+		code = binanceCodeFilterFailure
+	case errors.Is(err, errInvalidSide),
+		errors.Is(err, errInvalidOrderType),
+		errors.Is(err, errInvalidTimeInForce):
+		code = binanceCodeIllegalParameter
+	}
+	writeBinanceError(w, http.StatusBadRequest, code, err.Error())
+}
+
+func writeBinanceError(w http.ResponseWriter, status, code int, msg string) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(binanceError{Code: code, Msg: msg}); err != nil {
+		log.Errorf(log.ExchangeSys, "Exchange simulator failed to write error response: %v", err)
+	}
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(v); err != nil {
+		log.Errorf(log.ExchangeSys, "Exchange simulator failed to write response: %v", err)
+	}
+}
+
+func formatLevels(l []Level) [][2]string {
+	resp := make([][2]string, len(l))
+	for i := range l {
+		resp[i] = [2]string{formatFloat(l[i].Price), formatFloat(l[i].Amount)}
+	}
+	return resp
+}
+
+// formatFloat formats a value with the fixed eight decimal places Binance
+// uses for prices and quantities
+func formatFloat(v float64) string {
+	return strconv.FormatFloat(v, 'f', 8, 64)
+}
+
+func milliseconds(t time.Time) int64 {
+	return t.UnixNano() / int64(time.Millisecond)
+}
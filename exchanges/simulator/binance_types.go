@@ -0,0 +1,324 @@
+package simulator
+
+const (
+	binanceAPIKeyHeader      = "X-MBX-APIKEY"
+	binanceDefaultRecvWindow = 5000
+	binanceMaxFutureOffset   = 1000
+	binanceDepthStream       = "depth"
+	binanceTradeStream       = "trade"
+	binanceTickerStream      = "ticker"
+	binanceMethodSubscribe   = "SUBSCRIBE"
+	binanceMethodUnsubscribe = "UNSUBSCRIBE"
+	binanceMethodList        = "LIST_SUBSCRIPTIONS"
+)
+
+// Binance error codes returned by the simulator
+const (
+	binanceCodeUnknown          = -1000
+	binanceCodeBadTimestamp     = -1021
+	binanceCodeBadSignature     = -1022
+	binanceCodeIllegalParameter = -1100
+	binanceCodeMandatoryParam   = -1102
+	binanceCodeInvalidSymbol    = -1121
+	binanceCodeInvalidListenKey = -1125
+	binanceCodeFilterFailure    = -1013
+	binanceCodeNewOrderRejected = -2010
+	binanceCodeCancelRejected   = -2011
+	binanceCodeNoSuchOrder      = -2013
+	binanceCodeInvalidAPIKey    = -2015
+	binanceCodeUnknownEndpoint  = -1
+)
+
+// binanceError defines the Binance error response
+type binanceError struct {
+	Code int    `json:"code"`
+	Msg  string `json:"msg"`
+}
+
+// binanceExchangeInfo defines the exchangeInfo response
+type binanceExchangeInfo struct {
+	Timezone        string          `json:"timezone"`
+	ServerTime      int64           `json:"serverTime"`
+	RateLimits      []interface{}   `json:"rateLimits"`
+	ExchangeFilters []interface{}   `json:"exchangeFilters"`
+	Symbols         []binanceSymbol `json:"symbols"`
+}
+
+// binanceSymbol defines a listed symbol, filters are emitted in the order
+// the Binance client expects when loading execution limits
+type binanceSymbol struct {
+	Symbol                     string                   `json:"symbol"`
+	Status                     string                   `json:"status"`
+	BaseAsset                  string                   `json:"baseAsset"`
+	BaseAssetPrecision         int                      `json:"baseAssetPrecision"`
+	QuoteAsset                 string                   `json:"quoteAsset"`
+	QuotePrecision             int                      `json:"quotePrecision"`
+	QuoteAssetPrecision        int                      `json:"quoteAssetPrecision"`
+	OrderTypes                 []string                 `json:"orderTypes"`
+	IcebergAllowed             bool                     `json:"icebergAllowed"`
+	OCOAllowed                 bool                     `json:"ocoAllowed"`
+	QuoteOrderQtyMarketAllowed bool                     `json:"quoteOrderQtyMarketAllowed"`
+	IsSpotTradingAllowed       bool                     `json:"isSpotTradingAllowed"`
+	IsMarginTradingAllowed     bool                     `json:"isMarginTradingAllowed"`
+	Filters                    []map[string]interface{} `json:"filters"`
+	Permissions                []string                 `json:"permissions"`
+}
+
+// binanceDepth defines the depth response
+type binanceDepth struct {
+	LastUpdateID int64       `json:"lastUpdateId"`
+	Bids         [][2]string `json:"bids"`
+	Asks         [][2]string `json:"asks"`
+}
+
+// binanceTrade defines a recent trade response
+type binanceTrade struct {
+	ID           int64  `json:"id"`
+	Price        string `json:"price"`
+	Quantity     string `json:"qty"`
+	QuoteQty     string `json:"quoteQty"`
+	Time         int64  `json:"time"`
+	IsBuyerMaker bool   `json:"isBuyerMaker"`
+	IsBestMatch  bool   `json:"isBestMatch"`
+}
+
+// binanceTicker defines the 24 hour ticker response
+type binanceTicker struct {
+	Symbol             string `json:"symbol"`
+	PriceChange        string `json:"priceChange"`
+	PriceChangePercent string `json:"priceChangePercent"`
+	WeightedAvgPrice   string `json:"weightedAvgPrice"`
+	PrevClosePrice     string `json:"prevClosePrice"`
+	LastPrice          string `json:"lastPrice"`
+	LastQty            string `json:"lastQty"`
+	BidPrice           string `json:"bidPrice"`
+	BidQty             string `json:"bidQty"`
+	AskPrice           string `json:"askPrice"`
+	AskQty             string `json:"askQty"`
+	OpenPrice          string `json:"openPrice"`
+	HighPrice          string `json:"highPrice"`
+	LowPrice           string `json:"lowPrice"`
+	Volume             string `json:"volume"`
+	QuoteVolume        string `json:"quoteVolume"`
+	OpenTime           int64  `json:"openTime"`
+	CloseTime          int64  `json:"closeTime"`
+	FirstID            int64  `json:"firstId"`
+	LastID             int64  `json:"lastId"`
+	Count              int64  `json:"count"`
+}
+
+// binanceOrder defines the order query response
+type binanceOrder struct {
+	Symbol              string `json:"symbol"`
+	OrderID             int64  `json:"orderId"`
+	OrderListID         int64  `json:"orderListId"`
+	ClientOrderID       string `json:"clientOrderId"`
+	Price               string `json:"price"`
+	OrigQty             string `json:"origQty"`
+	ExecutedQty         string `json:"executedQty"`
+	CummulativeQuoteQty string `json:"cummulativeQuoteQty"`
+	Status              string `json:"status"`
+	TimeInForce         string `json:"timeInForce"`
+	Type                string `json:"type"`
+	Side                string `json:"side"`
+	StopPrice           string `json:"stopPrice"`
+	IcebergQty          string `json:"icebergQty"`
+	Time                int64  `json:"time"`
+	UpdateTime          int64  `json:"updateTime"`
+	IsWorking           bool   `json:"isWorking"`
+	OrigQuoteOrderQty   string `json:"origQuoteOrderQty"`
+}
+
+// binanceNewOrder defines the full new order response
+type binanceNewOrder struct {
+	Symbol              string        `json:"symbol"`
+	OrderID             int64         `json:"orderId"`
+	OrderListID         int64         `json:"orderListId"`
+	ClientOrderID       string        `json:"clientOrderId"`
+	TransactTime        int64         `json:"transactTime"`
+	Price               string        `json:"price"`
+	OrigQty             string        `json:"origQty"`
+	ExecutedQty         string        `json:"executedQty"`
+	CummulativeQuoteQty string        `json:"cummulativeQuoteQty"`
+	Status              string        `json:"status"`
+	TimeInForce         string        `json:"timeInForce"`
+	Type                string        `json:"type"`
+	Side                string        `json:"side"`
+	Fills               []binanceFill `json:"fills"`
+}
+
+// binanceFill defines a fill within a new order response
+type binanceFill struct {
+	Price           string `json:"price"`
+	Qty             string `json:"qty"`
+	Commission      string `json:"commission"`
+	CommissionAsset string `json:"commissionAsset"`
+	TradeID         int64  `json:"tradeId"`
+}
+
+// binanceCancelledOrder defines the cancel order response
+type binanceCancelledOrder struct {
+	Symbol              string `json:"symbol"`
+	OrigClientOrderID   string `json:"origClientOrderId"`
+	OrderID             int64  `json:"orderId"`
+	OrderListID         int64  `json:"orderListId"`
+	ClientOrderID       string `json:"clientOrderId"`
+	Price               string `json:"price"`
+	OrigQty             string `json:"origQty"`
+	ExecutedQty         string `json:"executedQty"`
+	CummulativeQuoteQty string `json:"cummulativeQuoteQty"`
+	Status              string `json:"status"`
+	TimeInForce         string `json:"timeInForce"`
+	Type                string `json:"type"`
+	Side                string `json:"side"`
+}
+
+// binanceAccount defines the account response
+type binanceAccount struct {
+	MakerCommission  int64            `json:"makerCommission"`
+	TakerCommission  int64            `json:"takerCommission"`
+	BuyerCommission  int64            `json:"buyerCommission"`
+	SellerCommission int64            `json:"sellerCommission"`
+	CanTrade         bool             `json:"canTrade"`
+	CanWithdraw      bool             `json:"canWithdraw"`
+	CanDeposit       bool             `json:"canDeposit"`
+	UpdateTime       int64            `json:"updateTime"`
+	AccountType      string           `json:"accountType"`
+	Balances         []binanceBalance `json:"balances"`
+	Permissions      []string         `json:"permissions"`
+}
+
+// binanceBalance defines an account balance
+type binanceBalance struct {
+	Asset  string `json:"asset"`
+	Free   string `json:"free"`
+	Locked string `json:"locked"`
+}
+
+// binanceListenKey defines the user data stream response
+type binanceListenKey struct {
+	ListenKey string `json:"listenKey"`
+}
+
+// binanceWsRequest defines a websocket subscription request
+type binanceWsRequest struct {
+	Method string   `json:"method"`
+	Params []string `json:"params"`
+	ID     int64    `json:"id"`
+}
+
+// binanceWsResponse defines a websocket subscription response
+type binanceWsResponse struct {
+	Result interface{} `json:"result"`
+	ID     int64       `json:"id"`
+}
+
+// binanceWsMessage defines the combined stream envelope
+type binanceWsMessage struct {
+	Stream string      `json:"stream"`
+	Data   interface{} `json:"data"`
+}
+
+// binanceWsDepth defines a diff depth stream event
+type binanceWsDepth struct {
+	Event         string      `json:"e"`
+	EventTime     int64       `json:"E"`
+	Symbol        string      `json:"s"`
+	FirstUpdateID int64       `json:"U"`
+	LastUpdateID  int64       `json:"u"`
+	Bids          [][2]string `json:"b"`
+	Asks          [][2]string `json:"a"`
+}
+
+// binanceWsTrade defines a trade stream event
+type binanceWsTrade struct {
+	Event         string `json:"e"`
+	EventTime     int64  `json:"E"`
+	Symbol        string `json:"s"`
+	TradeID       int64  `json:"t"`
+	Price         string `json:"p"`
+	Quantity      string `json:"q"`
+	BuyerOrderID  int64  `json:"b"`
+	SellerOrderID int64  `json:"a"`
+	TradeTime     int64  `json:"T"`
+	BuyerMaker    bool   `json:"m"`
+	BestMatch     bool   `json:"M"`
+}
+
+// binanceWsTicker defines a 24 hour ticker stream event
+type binanceWsTicker struct {
+	Event              string `json:"e"`
+	EventTime          int64  `json:"E"`
+	Symbol             string `json:"s"`
+	PriceChange        string `json:"p"`
+	PriceChangePercent string `json:"P"`
+	WeightedAvgPrice   string `json:"w"`
+	PrevClosePrice     string `json:"x"`
+	LastPrice          string `json:"c"`
+	LastQty            string `json:"Q"`
+	BidPrice           string `json:"b"`
+	BidQty             string `json:"B"`
+	AskPrice           string `json:"a"`
+	AskQty             string `json:"A"`
+	OpenPrice          string `json:"o"`
+	HighPrice          string `json:"h"`
+	LowPrice           string `json:"l"`
+	Volume             string `json:"v"`
+	QuoteVolume        string `json:"q"`
+	OpenTime           int64  `json:"O"`
+	CloseTime          int64  `json:"C"`
+	FirstID            int64  `json:"F"`
+	LastID             int64  `json:"L"`
+	Count              int64  `json:"n"`
+}
+
+// binanceWsExecutionReport defines a user data order update event
+type binanceWsExecutionReport struct {
+	Event                    string  `json:"e"`
+	EventTime                int64   `json:"E"`
+	Symbol                   string  `json:"s"`
+	ClientOrderID            string  `json:"c"`
+	Side                     string  `json:"S"`
+	OrderType                string  `json:"o"`
+	TimeInForce              string  `json:"f"`
+	Quantity                 string  `json:"q"`
+	Price                    string  `json:"p"`
+	StopPrice                string  `json:"P"`
+	IcebergQuantity          string  `json:"F"`
+	OrderListID              int64   `json:"g"`
+	CancelledClientOrderID   string  `json:"C"`
+	ExecutionType            string  `json:"x"`
+	Status                   string  `json:"X"`
+	RejectReason             string  `json:"r"`
+	OrderID                  int64   `json:"i"`
+	LastExecutedQuantity     string  `json:"l"`
+	CumulativeFilledQuantity string  `json:"z"`
+	LastExecutedPrice        string  `json:"L"`
+	Commission               string  `json:"n"`
+	CommissionAsset          *string `json:"N"`
+	TransactionTime          int64   `json:"T"`
+	TradeID                  int64   `json:"t"`
+	Ignore                   int64   `json:"I"`
+	IsOnBook                 bool    `json:"w"`
+	IsMaker                  bool    `json:"m"`
+	Ignore2                  bool    `json:"M"`
+	CreationTime             int64   `json:"O"`
+	CumulativeQuoteQuantity  string  `json:"Z"`
+	LastQuoteQuantity        string  `json:"Y"`
+	QuoteOrderQuantity       string  `json:"Q"`
+}
+
+// binanceWsAccountPosition defines a user data balance update event
+type binanceWsAccountPosition struct {
+	Event      string                  `json:"e"`
+	EventTime  int64                   `json:"E"`
+	LastUpdate int64                   `json:"u"`
+	Balances   []binanceWsPositionItem `json:"B"`
+}
+
+// binanceWsPositionItem defines a single asset balance update
+type binanceWsPositionItem struct {
+	Asset  string `json:"a"`
+	Free   string `json:"f"`
+	Locked string `json:"l"`
+}
@@ -0,0 +1,812 @@
+package simulator
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// batch collects the changes made by a single exchange operation so that
+// they can be published together
+type batch struct {
+	orders   []OrderUpdate
+	fills    []Fill
+	trades   []Trade
+	bids     map[float64]struct{}
+	asks     map[float64]struct{}
+	balances map[string]struct{}
+}
+
+// NewExchange returns a simulated exchange seeded from the supplied config.
+// Zero fees are replaced with the defaults.
+func NewExchange(cfg *Config) (*Exchange, error) {
+	if cfg == nil {
+		return nil, errNilConfig
+	}
+	if len(cfg.Markets) == 0 {
+		return nil, errNoMarkets
+	}
+	if cfg.MakerFee < 0 || cfg.TakerFee < 0 {
+		return nil, fmt.Errorf("%w: negative fee", errInvalidMarket)
+	}
+	e := &Exchange{
+		makerFee:    cfg.MakerFee,
+		takerFee:    cfg.TakerFee,
+		markets:     make(map[string]*market),
+		balances:    make(map[string]*Balance),
+		orders:      make(map[int64]*Order),
+		subscribers: make(map[int64]func(interface{})),
+		updated:     time.Now(),
+	}
+	if e.makerFee == 0 {
+		e.makerFee = DefaultMakerFee
+	}
+	if e.takerFee == 0 {
+		e.takerFee = DefaultTakerFee
+	}
+
+	for i := range cfg.Markets {
+		mc := cfg.Markets[i]
+		if mc.Base == "" || mc.Quote == "" {
+			return nil, fmt.Errorf("%w: base and quote required", errInvalidMarket)
+		}
+		if mc.TickSize < 0 || mc.StepSize < 0 || mc.MinQuantity < 0 ||
+			mc.MaxQuantity < 0 || mc.MinNotional < 0 {
+			return nil, fmt.Errorf("%w %s: negative filter value", errInvalidMarket, mc.Symbol())
+		}
+		mc.Base = strings.ToUpper(mc.Base)
+		mc.Quote = strings.ToUpper(mc.Quote)
+		symbol := mc.Symbol()
+		if _, ok := e.markets[symbol]; ok {
+			return nil, fmt.Errorf("%w %s", errDuplicateMarket, symbol)
+		}
+		m := &market{MarketConfig: mc, symbol: symbol}
+		m.Bids, m.Asks = nil, nil
+		e.markets[symbol] = m
+		e.symbols = append(e.symbols, symbol)
+		for j := range mc.Bids {
+			if err := e.addLiquidity(m, Buy, mc.Bids[j].Price, mc.Bids[j].Amount, &batch{}); err != nil {
+				return nil, err
+			}
+		}
+		for j := range mc.Asks {
+			if err := e.addLiquidity(m, Sell, mc.Asks[j].Price, mc.Asks[j].Amount, &batch{}); err != nil {
+				return nil, err
+			}
+		}
+	}
+	sort.Strings(e.symbols)
+
+	for a, amount := range cfg.Balances {
+		if amount < 0 {
+			return nil, fmt.Errorf("%w %s: negative balance", errInvalidAmount, a)
+		}
+		e.balance(a).Free = amount
+	}
+	return e, nil
+}
+
+// Symbol returns the exchange symbol for the market
+func (c *MarketConfig) Symbol() string {
+	return strings.ToUpper(c.Base + c.Quote)
+}
+
+// Subscribe registers a function which receives every published event:
+// *DepthUpdate, *Trade, *OrderUpdate, *BalanceUpdate and *Ticker. Functions
+// are called synchronously in publishing order while the exchange is locked
+// and therefore must not call back into the exchange.
+func (e *Exchange) Subscribe(fn func(interface{})) int64 {
+	e.m.Lock()
+	defer e.m.Unlock()
+	e.nextSubscriberID++
+	e.subscribers[e.nextSubscriberID] = fn
+	return e.nextSubscriberID
+}
+
+// Unsubscribe removes a subscription
+func (e *Exchange) Unsubscribe(id int64) {
+	e.m.Lock()
+	delete(e.subscribers, id)
+	e.m.Unlock()
+}
+
+// Fees returns the maker and taker commission rates
+func (e *Exchange) Fees() (maker, taker float64) {
+	return e.makerFee, e.takerFee
+}
+
+// Markets returns the configuration of all listed markets without their
+// seeded liquidity
+func (e *Exchange) Markets() []MarketConfig {
+	e.m.Lock()
+	defer e.m.Unlock()
+	resp := make([]MarketConfig, len(e.symbols))
+	for i := range e.symbols {
+		resp[i] = e.markets[e.symbols[i]].MarketConfig
+	}
+	return resp
+}
+
+// LastUpdated returns the time the account was last changed
+func (e *Exchange) LastUpdated() time.Time {
+	e.m.Lock()
+	defer e.m.Unlock()
+	return e.updated
+}
+
+// Deposit credits an asset to the account
+func (e *Exchange) Deposit(a string, amount float64) error {
+	if a == "" {
+		return errInvalidAsset
+	}
+	if amount <= 0 {
+		return fmt.Errorf("%w %v", errInvalidAmount, amount)
+	}
+	e.m.Lock()
+	defer e.m.Unlock()
+	bal := e.balance(a)
+	bal.Free += amount
+	b := &batch{}
+	b.balanceChanged(bal.Asset)
+	e.publish(nil, b)
+	return nil
+}
+
+// Balances returns all account balances sorted by asset
+func (e *Exchange) Balances() []Balance {
+	e.m.Lock()
+	defer e.m.Unlock()
+	resp := make([]Balance, 0, len(e.balances))
+	for _, b := range e.balances {
+		resp = append(resp, *b)
+	}
+	sort.Slice(resp, func(i, j int) bool { return resp[i].Asset < resp[j].Asset })
+	return resp
+}
+
+// Balance returns the account balance for an asset
+func (e *Exchange) Balance(a string) Balance {
+	e.m.Lock()
+	defer e.m.Unlock()
+	if b, ok := e.balances[strings.ToUpper(a)]; ok {
+		return *b
+	}
+	return Balance{Asset: strings.ToUpper(a)}
+}
+
+// Depth returns the aggregated orderbook for a symbol and the update ID it
+// reflects, a zero limit returns the full book
+func (e *Exchange) Depth(symbol string, limit int) (bids, asks []Level, updateID int64, err error) {
+	e.m.Lock()
+	defer e.m.Unlock()
+	m, ok := e.markets[symbol]
+	if !ok {
+		return nil, nil, 0, fmt.Errorf("%w %s", ErrUnknownSymbol, symbol)
+	}
+	return levels(m.bids, limit), levels(m.asks, limit), m.updateID, nil
+}
+
+// Trades returns the most recent public trades for a symbol in execution
+// order, a zero limit returns all retained trades
+func (e *Exchange) Trades(symbol string, limit int) ([]Trade, error) {
+	e.m.Lock()
+	defer e.m.Unlock()
+	m, ok := e.markets[symbol]
+	if !ok {
+		return nil, fmt.Errorf("%w %s", ErrUnknownSymbol, symbol)
+	}
+	trades := m.trades
+	if limit > 0 && len(trades) > limit {
+		trades = trades[len(trades)-limit:]
+	}
+	resp := make([]Trade, len(trades))
+	copy(resp, trades)
+	return resp, nil
+}
+
+// Ticker returns the rolling 24 hour statistics for a symbol
+func (e *Exchange) Ticker(symbol string) (Ticker, error) {
+	e.m.Lock()
+	defer e.m.Unlock()
+	m, ok := e.markets[symbol]
+	if !ok {
+		return Ticker{}, fmt.Errorf("%w %s", ErrUnknownSymbol, symbol)
+	}
+	return m.ticker(time.Now()), nil
+}
+
+// Tickers returns the rolling 24 hour statistics for all symbols
+func (e *Exchange) Tickers() []Ticker {
+	e.m.Lock()
+	defer e.m.Unlock()
+	now := time.Now()
+	resp := make([]Ticker, len(e.symbols))
+	for i := range e.symbols {
+		resp[i] = e.markets[e.symbols[i]].ticker(now)
+	}
+	return resp
+}
+
+// Order returns an account order by ID or client order ID
+func (e *Exchange) Order(symbol string, id int64, clientOrderID string) (Order, error) {
+	e.m.Lock()
+	defer e.m.Unlock()
+	o, err := e.find(symbol, id, clientOrderID)
+	if err != nil {
+		return Order{}, err
+	}
+	return *o, nil
+}
+
+// OpenOrders returns open account orders for a symbol, an empty symbol
+// returns open orders for all symbols
+func (e *Exchange) OpenOrders(symbol string) ([]Order, error) {
+	return e.accountOrders(symbol, true)
+}
+
+// Orders returns all account orders for a symbol, an empty symbol returns
+// orders for all symbols
+func (e *Exchange) Orders(symbol string) ([]Order, error) {
+	return e.accountOrders(symbol, false)
+}
+
+func (e *Exchange) accountOrders(symbol string, openOnly bool) ([]Order, error) {
+	e.m.Lock()
+	defer e.m.Unlock()
+	if symbol != "" {
+		if _, ok := e.markets[symbol]; !ok {
+			return nil, fmt.Errorf("%w %s", ErrUnknownSymbol, symbol)
+		}
+	}
+	var resp []Order
+	for _, o := range e.orders {
+		if (symbol != "" && o.Symbol != symbol) || (openOnly && !o.isOpen()) {
+			continue
+		}
+		resp = append(resp, *o)
+	}
+	sort.Slice(resp, func(i, j int) bool { return resp[i].ID < resp[j].ID })
+	return resp, nil
+}
+
+// SubmitOrder places an account order, it is matched immediately against
+// resting orders and any remainder of a good till cancel limit order is
+// added to the book. The resulting order and the fills generated on
+// submission are returned.
+func (e *Exchange) SubmitOrder(r *OrderRequest) (*Order, []Fill, error) {
+	if r == nil {
+		return nil, nil, fmt.Errorf("%w: nil request", errInvalidQuantity)
+	}
+	e.m.Lock()
+	defer e.m.Unlock()
+	m, ok := e.markets[r.Symbol]
+	if !ok {
+		return nil, nil, fmt.Errorf("%w %s", ErrUnknownSymbol, r.Symbol)
+	}
+	o, err := e.newOrder(m, r)
+	if err != nil {
+		return nil, nil, err
+	}
+	if r.ClientOrderID != "" {
+		for _, existing := range e.orders {
+			if existing.ClientOrderID == r.ClientOrderID && existing.isOpen() {
+				return nil, nil, fmt.Errorf("%w: %s", errDuplicateClientOrderID, r.ClientOrderID)
+			}
+		}
+	}
+
+	b := &batch{}
+	if o.TimeInForce == FillOrKill && m.available(o) < o.Quantity-quantityTolerance {
+		e.nextOrderID++
+		o.ID = e.nextOrderID
+		e.setClientOrderID(o)
+		o.Status = Expired
+		e.orders[o.ID] = o
+		b.orderUpdate(o, ExecutionNew, nil)
+		b.orderUpdate(o, ExecutionExpired, nil)
+		e.publish(m, b)
+		resp := *o
+		return &resp, nil, nil
+	}
+
+	base, quote := e.balance(m.Base), e.balance(m.Quote)
+	switch {
+	case o.Side == Sell:
+		o.locked = o.Quantity
+	case o.Type == Market:
+		o.locked = m.cost(o, o.Quantity)
+	default:
+		o.locked = o.Quantity * o.Price
+	}
+	funding := quote
+	if o.Side == Sell {
+		funding = base
+	}
+	if funding.Free < o.locked-quantityTolerance {
+		return nil, nil, fmt.Errorf("%w: %s free %v required %v",
+			ErrInsufficientBalance, funding.Asset, funding.Free, o.locked)
+	}
+	funding.Free = clean(funding.Free - o.locked)
+	funding.Locked += o.locked
+	b.balanceChanged(funding.Asset)
+
+	e.nextOrderID++
+	o.ID = e.nextOrderID
+	e.setClientOrderID(o)
+	e.orders[o.ID] = o
+	b.orderUpdate(o, ExecutionNew, nil)
+
+	e.match(m, o, b)
+	if o.isOpen() {
+		if o.Type == Limit && o.TimeInForce == GoodTillCancel {
+			m.insert(o)
+			b.levelChanged(o.Side, o.Price)
+		} else {
+			o.Status = Expired
+			e.release(m, o, b)
+			b.orderUpdate(o, ExecutionExpired, nil)
+		}
+	}
+	e.publish(m, b)
+	resp := *o
+	return &resp, b.fills, nil
+}
+
+// CancelOrder cancels an open account order by ID or client order ID
+func (e *Exchange) CancelOrder(symbol string, id int64, clientOrderID string) (*Order, error) {
+	e.m.Lock()
+	defer e.m.Unlock()
+	o, err := e.find(symbol, id, clientOrderID)
+	if err != nil {
+		return nil, err
+	}
+	if !o.isOpen() {
+		return nil, fmt.Errorf("%w: %d %s", errOrderNotOpen, o.ID, o.Status)
+	}
+	m := e.markets[o.Symbol]
+	b := &batch{}
+	if m.remove(o) {
+		b.levelChanged(o.Side, o.Price)
+	}
+	o.Status = Cancelled
+	o.Updated = time.Now()
+	e.release(m, o, b)
+	b.orderUpdate(o, ExecutionCancelled, nil)
+	e.publish(m, b)
+	resp := *o
+	return &resp, nil
+}
+
+// AddLiquidity places a good till cancel limit order on behalf of another
+// market participant. It has no balance effect and will execute against
+// resting account orders it crosses, which allows tests to drive fills.
+func (e *Exchange) AddLiquidity(symbol string, side Side, price, quantity float64) error {
+	e.m.Lock()
+	defer e.m.Unlock()
+	m, ok := e.markets[symbol]
+	if !ok {
+		return fmt.Errorf("%w %s", ErrUnknownSymbol, symbol)
+	}
+	b := &batch{}
+	if err := e.addLiquidity(m, side, price, quantity, b); err != nil {
+		return err
+	}
+	e.publish(m, b)
+	return nil
+}
+
+// ClearLiquidity removes all resting orders placed by other market
+// participants for a symbol
+func (e *Exchange) ClearLiquidity(symbol string) error {
+	e.m.Lock()
+	defer e.m.Unlock()
+	m, ok := e.markets[symbol]
+	if !ok {
+		return fmt.Errorf("%w %s", ErrUnknownSymbol, symbol)
+	}
+	b := &batch{}
+	m.bids = clearExternal(m.bids, Buy, b)
+	m.asks = clearExternal(m.asks, Sell, b)
+	e.publish(m, b)
+	return nil
+}
+
+func clearExternal(resting []*Order, s Side, b *batch) []*Order {
+	target := resting[:0]
+	for i := range resting {
+		if resting[i].external {
+			b.levelChanged(s, resting[i].Price)
+			continue
+		}
+		target = append(target, resting[i])
+	}
+	return target
+}
+
+func (e *Exchange) addLiquidity(m *market, side Side, price, quantity float64, b *batch) error {
+	if side != Buy && side != Sell {
+		return fmt.Errorf("%w %q", errInvalidSide, side)
+	}
+	if price <= 0 {
+		return fmt.Errorf("%w %v", errInvalidPrice, price)
+	}
+	if quantity <= 0 {
+		return fmt.Errorf("%w %v", errInvalidQuantity, quantity)
+	}
+	now := time.Now()
+	e.nextOrderID++
+	o := &Order{
+		ID:          e.nextOrderID,
+		Symbol:      m.symbol,
+		Side:        side,
+		Type:        Limit,
+		TimeInForce: GoodTillCancel,
+		Price:       price,
+		Quantity:    quantity,
+		Status:      New,
+		Created:     now,
+		Updated:     now,
+		external:    true,
+	}
+	e.match(m, o, b)
+	if o.isOpen() {
+		m.insert(o)
+		b.levelChanged(side, price)
+	}
+	return nil
+}
+
+// newOrder validates a request against the market filters
+func (e *Exchange) newOrder(m *market, r *OrderRequest) (*Order, error) {
+	if r.Side != Buy && r.Side != Sell {
+		return nil, fmt.Errorf("%w %q", errInvalidSide, r.Side)
+	}
+	tif := r.TimeInForce
+	switch r.Type {
+	case Limit:
+		if tif == "" {
+			tif = GoodTillCancel
+		}
+		if tif != GoodTillCancel && tif != ImmediateOrCancel && tif != FillOrKill {
+			return nil, fmt.Errorf("%w %q", errInvalidTimeInForce, tif)
+		}
+		if r.Price <= 0 || !isIncrement(r.Price, m.TickSize) {
+			return nil, fmt.Errorf("%w %v", errInvalidPrice, r.Price)
+		}
+	case Market:
+		tif = GoodTillCancel
+	default:
+		return nil, fmt.Errorf("%w %q", errInvalidOrderType, r.Type)
+	}
+	if r.Quantity <= 0 ||
+		!isIncrement(r.Quantity, m.StepSize) ||
+		r.Quantity < m.MinQuantity ||
+		(m.MaxQuantity > 0 && r.Quantity > m.MaxQuantity) {
+		return nil, fmt.Errorf("%w %v", errInvalidQuantity, r.Quantity)
+	}
+	price := r.Price
+	if r.Type == Market {
+		price = 0
+		if resting := m.opposite(r.Side); len(resting) > 0 {
+			price = resting[0].Price
+		}
+	}
+	if m.MinNotional > 0 && price > 0 && price*r.Quantity < m.MinNotional {
+		return nil, fmt.Errorf("%w %v", errMinNotional, m.MinNotional)
+	}
+	now := time.Now()
+	o := &Order{
+		ClientOrderID: r.ClientOrderID,
+		Symbol:        m.symbol,
+		Side:          r.Side,
+		Type:          r.Type,
+		TimeInForce:   tif,
+		Quantity:      r.Quantity,
+		Status:        New,
+		Created:       now,
+		Updated:       now,
+	}
+	if r.Type == Limit {
+		o.Price = r.Price
+	}
+	return o, nil
+}
+
+func (e *Exchange) setClientOrderID(o *Order) {
+	if o.ClientOrderID == "" {
+		o.ClientOrderID = clientOrderIDPrefix + strconv.FormatInt(o.ID, 10)
+	}
+}
+
+// find returns an account order by ID or client order ID
+func (e *Exchange) find(symbol string, id int64, clientOrderID string) (*Order, error) {
+	if _, ok := e.markets[symbol]; !ok {
+		return nil, fmt.Errorf("%w %s", ErrUnknownSymbol, symbol)
+	}
+	if id != 0 {
+		o, ok := e.orders[id]
+		if !ok || o.Symbol != symbol {
+			return nil, fmt.Errorf("%w: %d", ErrOrderNotFound, id)
+		}
+		return o, nil
+	}
+	var match *Order
+	for _, o := range e.orders {
+		if o.Symbol == symbol && o.ClientOrderID == clientOrderID &&
+			(match == nil || o.ID > match.ID) {
+			match = o
+		}
+	}
+	if clientOrderID == "" || match == nil {
+		return nil, fmt.Errorf("%w: %q", ErrOrderNotFound, clientOrderID)
+	}
+	return match, nil
+}
+
+// match executes an incoming order against the resting orders it crosses
+func (e *Exchange) match(m *market, taker *Order, b *batch) {
+	for taker.remaining() > 0 {
+		resting := m.opposite(taker.Side)
+		if len(resting) == 0 || !crosses(taker, resting[0].Price) {
+			return
+		}
+		maker := resting[0]
+		quantity := math.Min(taker.remaining(), maker.remaining())
+		price := maker.Price
+		now := time.Now()
+
+		e.nextTradeID++
+		t := Trade{
+			ID:         e.nextTradeID,
+			Symbol:     m.symbol,
+			Price:      price,
+			Quantity:   quantity,
+			BuyerMaker: maker.Side == Buy,
+			Time:       now,
+		}
+		if maker.Side == Buy {
+			t.BuyerOrderID, t.SellerOrderID = maker.ID, taker.ID
+		} else {
+			t.BuyerOrderID, t.SellerOrderID = taker.ID, maker.ID
+		}
+		m.trades = append(m.trades, t)
+		if len(m.trades) > maxTradeHistory {
+			m.trades = m.trades[len(m.trades)-maxTradeHistory:]
+		}
+		b.trades = append(b.trades, t)
+
+		e.execute(m, maker, t, true, b)
+		e.execute(m, taker, t, false, b)
+		if maker.remaining() == 0 {
+			m.remove(maker)
+		}
+		b.levelChanged(maker.Side, price)
+	}
+}
+
+// execute applies a trade to an order and settles account balances
+func (e *Exchange) execute(m *market, o *Order, t Trade, maker bool, b *batch) {
+	o.ExecutedQuantity += t.Quantity
+	o.CumulativeQuote += t.Quantity * t.Price
+	o.Updated = t.Time
+	o.Status = PartiallyFilled
+	if o.remaining() == 0 {
+		o.Status = Filled
+	}
+	if o.external {
+		return
+	}
+
+	rate := e.takerFee
+	if maker {
+		rate = e.makerFee
+	}
+	f := Fill{
+		TradeID:  t.ID,
+		Price:    t.Price,
+		Quantity: t.Quantity,
+		Maker:    maker,
+	}
+	base, quote := e.balance(m.Base), e.balance(m.Quote)
+	if o.Side == Buy {
+		spent := t.Quantity * t.Price
+		o.locked = clean(o.locked - spent)
+		quote.Locked = clean(quote.Locked - spent)
+		f.Commission = t.Quantity * rate
+		f.CommissionAsset = base.Asset
+		base.Free += t.Quantity - f.Commission
+	} else {
+		o.locked = clean(o.locked - t.Quantity)
+		base.Locked = clean(base.Locked - t.Quantity)
+		f.Commission = t.Quantity * t.Price * rate
+		f.CommissionAsset = quote.Asset
+		quote.Free += t.Quantity*t.Price - f.Commission
+	}
+	b.balanceChanged(base.Asset)
+	b.balanceChanged(quote.Asset)
+	if o.Status == Filled {
+		// release funds reserved at the limit price that were not required
+		// due to price improvement
+		e.release(m, o, b)
+	}
+	if !maker {
+		b.fills = append(b.fills, f)
+	}
+	b.orderUpdate(o, ExecutionTrade, &f)
+}
+
+// release returns any funds still reserved by an account order
+func (e *Exchange) release(m *market, o *Order, b *batch) {
+	if o.external || o.locked == 0 {
+		return
+	}
+	a := m.Quote
+	if o.Side == Sell {
+		a = m.Base
+	}
+	bal := e.balance(a)
+	bal.Locked = clean(bal.Locked - o.locked)
+	bal.Free += o.locked
+	o.locked = 0
+	b.balanceChanged(bal.Asset)
+}
+
+// balance returns the account balance for an asset, creating it if required
+func (e *Exchange) balance(a string) *Balance {
+	a = strings.ToUpper(a)
+	bal, ok := e.balances[a]
+	if !ok {
+		bal = &Balance{Asset: a}
+		e.balances[a] = bal
+	}
+	return bal
+}
+
+// publish sends the batched changes to subscribers, a market update
+// increments the market update ID when levels have changed
+func (e *Exchange) publish(m *market, b *batch) {
+	now := time.Now()
+	if m != nil && (len(b.bids) > 0 || len(b.asks) > 0) {
+		m.updateID++
+		u := &DepthUpdate{
+			Symbol:   m.symbol,
+			UpdateID: m.updateID,
+			Bids:     m.changedLevels(Buy, b.bids),
+			Asks:     m.changedLevels(Sell, b.asks),
+			Time:     now,
+		}
+		e.send(u)
+	}
+	for i := range b.trades {
+		t := b.trades[i]
+		e.send(&t)
+	}
+	for i := range b.orders {
+		b.orders[i].Time = now
+		e.send(&b.orders[i])
+	}
+	if len(b.balances) > 0 {
+		e.updated = now
+		u := &BalanceUpdate{Time: now}
+		for a := range b.balances {
+			u.Balances = append(u.Balances, *e.balances[a])
+		}
+		sort.Slice(u.Balances, func(i, j int) bool {
+			return u.Balances[i].Asset < u.Balances[j].Asset
+		})
+		e.send(u)
+	}
+	if m != nil && (len(b.bids) > 0 || len(b.asks) > 0 || len(b.trades) > 0) {
+		t := m.ticker(now)
+		e.send(&t)
+	}
+}
+
+func (e *Exchange) send(event interface{}) {
+	for _, fn := range e.subscribers {
+		fn(event)
+	}
+}
+
+// changedLevels returns the current aggregated amount of changed price
+// levels sorted by priority
+func (m *market) changedLevels(s Side, prices map[float64]struct{}) []Level {
+	resp := make([]Level, 0, len(prices))
+	for p := range prices {
+		resp = append(resp, Level{Price: p, Amount: m.levelAmount(s, p)})
+	}
+	sort.Slice(resp, func(i, j int) bool {
+		if s == Buy {
+			return resp[i].Price > resp[j].Price
+		}
+		return resp[i].Price < resp[j].Price
+	})
+	return resp
+}
+
+// ticker calculates rolling statistics for the market
+func (m *market) ticker(now time.Time) Ticker {
+	t := Ticker{
+		Symbol:       m.symbol,
+		OpenTime:     now.Add(-statisticsPeriod),
+		CloseTime:    now,
+		FirstTradeID: -1,
+		LastTradeID:  -1,
+	}
+	if len(m.bids) > 0 {
+		t.BidPrice = m.bids[0].Price
+		t.BidQuantity = m.levelAmount(Buy, t.BidPrice)
+	}
+	if len(m.asks) > 0 {
+		t.AskPrice = m.asks[0].Price
+		t.AskQuantity = m.levelAmount(Sell, t.AskPrice)
+	}
+	for i := range m.trades {
+		tr := m.trades[i]
+		if tr.Time.Before(t.OpenTime) {
+			t.PrevClosePrice = tr.Price
+			continue
+		}
+		if t.Count == 0 {
+			t.OpenPrice = tr.Price
+			t.HighPrice = tr.Price
+			t.LowPrice = tr.Price
+			t.FirstTradeID = tr.ID
+		}
+		t.Count++
+		t.HighPrice = math.Max(t.HighPrice, tr.Price)
+		t.LowPrice = math.Min(t.LowPrice, tr.Price)
+		t.Volume += tr.Quantity
+		t.QuoteVolume += tr.Quantity * tr.Price
+		t.LastPrice = tr.Price
+		t.LastQuantity = tr.Quantity
+		t.LastTradeID = tr.ID
+	}
+	if t.Volume > 0 {
+		t.WeightedAvgPrice = t.QuoteVolume / t.Volume
+	}
+	if t.OpenPrice > 0 {
+		t.PriceChange = t.LastPrice - t.OpenPrice
+		t.PricePercent = t.PriceChange / t.OpenPrice * 100
+	}
+	return t
+}
+
+func (b *batch) orderUpdate(o *Order, x ExecutionType, f *Fill) {
+	if o.external {
+		return
+	}
+	b.orders = append(b.orders, OrderUpdate{Order: *o, ExecutionType: x, Fill: f})
+}
+
+func (b *batch) levelChanged(s Side, price float64) {
+	if s == Buy {
+		if b.bids == nil {
+			b.bids = make(map[float64]struct{})
+		}
+		b.bids[price] = struct{}{}
+		return
+	}
+	if b.asks == nil {
+		b.asks = make(map[float64]struct{})
+	}
+	b.asks[price] = struct{}{}
+}
+
+func (b *batch) balanceChanged(a string) {
+	if b.balances == nil {
+		b.balances = make(map[string]struct{})
+	}
+	b.balances[a] = struct{}{}
+}
+
+// clean removes floating point residue from values that should be zero
+func clean(v float64) float64 {
+	if math.Abs(v) < quantityTolerance {
+		return 0
+	}
+	return v
+}
@@ -0,0 +1,404 @@
+package simulator
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+const testSymbol = "BTCUSDT"
+
+func testConfig() *Config {
+	return &Config{
+		Balances: map[string]float64{"usdt": 100000, "btc": 10},
+		Markets: []MarketConfig{{
+			Base:        "btc",
+			Quote:       "usdt",
+			TickSize:    0.01,
+			StepSize:    0.0001,
+			MinQuantity: 0.0001,
+			MinNotional: 10,
+			Bids:        []Level{{Price: 49990, Amount: 1}, {Price: 49980, Amount: 2}},
+			Asks:        []Level{{Price: 50010, Amount: 1}, {Price: 50020, Amount: 2}},
+		}},
+	}
+}
+
+func newTestExchange(t *testing.T) *Exchange {
+	t.Helper()
+	e, err := NewExchange(testConfig())
+	if err != nil {
+		t.Fatal(err)
+	}
+	return e
+}
+
+func isEqual(a, b float64) bool {
+	return math.Abs(a-b) < 1e-8
+}
+
+func TestNewExchange(t *testing.T) {
+	t.Parallel()
+	_, err := NewExchange(nil)
+	if !errors.Is(err, errNilConfig) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errNilConfig)
+	}
+	_, err = NewExchange(&Config{})
+	if !errors.Is(err, errNoMarkets) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errNoMarkets)
+	}
+	_, err = NewExchange(&Config{Markets: []MarketConfig{{Base: "BTC"}}})
+	if !errors.Is(err, errInvalidMarket) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errInvalidMarket)
+	}
+	_, err = NewExchange(&Config{Markets: []MarketConfig{
+		{Base: "BTC", Quote: "USDT"}, {Base: "btc", Quote: "usdt"},
+	}})
+	if !errors.Is(err, errDuplicateMarket) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errDuplicateMarket)
+	}
+	_, err = NewExchange(&Config{
+		Markets:  []MarketConfig{{Base: "BTC", Quote: "USDT"}},
+		Balances: map[string]float64{"BTC": -1},
+	})
+	if !errors.Is(err, errInvalidAmount) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errInvalidAmount)
+	}
+
+	e := newTestExchange(t)
+	maker, taker := e.Fees()
+	if maker != DefaultMakerFee || taker != DefaultTakerFee {
+		t.Fatalf("received fees %v %v", maker, taker)
+	}
+	if b := e.Balance("USDT"); b.Free != 100000 {
+		t.Fatalf("received: '%v' but expected: '%v'", b.Free, 100000)
+	}
+	bids, asks, updateID, err := e.Depth(testSymbol, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(bids) != 2 || len(asks) != 2 || bids[0].Price != 49990 || asks[0].Price != 50010 {
+		t.Fatalf("unexpected seeded book %+v %+v", bids, asks)
+	}
+	if updateID != 0 {
+		t.Fatalf("received: '%v' but expected: '%v'", updateID, 0)
+	}
+}
+
+func TestSubmitOrderValidation(t *testing.T) {
+	t.Parallel()
+	e := newTestExchange(t)
+	for _, tc := range []struct {
+		req *OrderRequest
+		err error
+	}{
+		{&OrderRequest{Symbol: "LTCUSDT"}, ErrUnknownSymbol},
+		{&OrderRequest{Symbol: testSymbol, Side: "LONG"}, errInvalidSide},
+		{&OrderRequest{Symbol: testSymbol, Side: Buy, Type: "STOP"}, errInvalidOrderType},
+		{&OrderRequest{Symbol: testSymbol, Side: Buy, Type: Limit, TimeInForce: "GTX", Price: 1, Quantity: 1}, errInvalidTimeInForce},
+		{&OrderRequest{Symbol: testSymbol, Side: Buy, Type: Limit, Price: 1.001, Quantity: 1}, errInvalidPrice},
+		{&OrderRequest{Symbol: testSymbol, Side: Buy, Type: Limit, Price: 100, Quantity: 0.00015}, errInvalidQuantity},
+		{&OrderRequest{Symbol: testSymbol, Side: Buy, Type: Limit, Price: 100, Quantity: 0.0001}, errMinNotional},
+		{&OrderRequest{Symbol: testSymbol, Side: Buy, Type: Limit, Price: 40000, Quantity: 3}, ErrInsufficientBalance},
+		{&OrderRequest{Symbol: testSymbol, Side: Sell, Type: Limit, Price: 60000, Quantity: 11}, ErrInsufficientBalance},
+	} {
+		_, _, err := e.SubmitOrder(tc.req)
+		if !errors.Is(err, tc.err) {
+			t.Fatalf("received: '%v' but expected: '%v'", err, tc.err)
+		}
+	}
+}
+
+func TestSubmitLimitOrderRests(t *testing.T) {
+	t.Parallel()
+	e := newTestExchange(t)
+	var updates []interface{}
+	e.Subscribe(func(ev interface{}) { updates = append(updates, ev) })
+
+	o, fills, err := e.SubmitOrder(&OrderRequest{
+		Symbol:        testSymbol,
+		ClientOrderID: "test",
+		Side:          Buy,
+		Type:          Limit,
+		Price:         49000,
+		Quantity:      1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if o.Status != New || len(fills) != 0 || o.TimeInForce != GoodTillCancel {
+		t.Fatalf("unexpected order %+v fills %v", o, fills)
+	}
+	b := e.Balance("USDT")
+	if !isEqual(b.Free, 51000) || !isEqual(b.Locked, 49000) {
+		t.Fatalf("unexpected balance %+v", b)
+	}
+	bids, _, updateID, err := e.Depth(testSymbol, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(bids) != 3 || bids[2].Price != 49000 || updateID != 1 {
+		t.Fatalf("unexpected book %+v update %v", bids, updateID)
+	}
+
+	_, _, err = e.SubmitOrder(&OrderRequest{
+		Symbol: testSymbol, ClientOrderID: "test", Side: Buy, Type: Limit, Price: 49000, Quantity: 0.1,
+	})
+	if !errors.Is(err, errDuplicateClientOrderID) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errDuplicateClientOrderID)
+	}
+
+	var depth *DepthUpdate
+	var order *OrderUpdate
+	var balance *BalanceUpdate
+	for i := range updates {
+		switch ev := updates[i].(type) {
+		case *DepthUpdate:
+			depth = ev
+		case *OrderUpdate:
+			order = ev
+		case *BalanceUpdate:
+			balance = ev
+		}
+	}
+	if depth == nil || depth.UpdateID != 1 || len(depth.Bids) != 1 || depth.Bids[0].Amount != 1 {
+		t.Fatalf("unexpected depth update %+v", depth)
+	}
+	if order == nil || order.ExecutionType != ExecutionNew || order.Order.ClientOrderID != "test" {
+		t.Fatalf("unexpected order update %+v", order)
+	}
+	if balance == nil || len(balance.Balances) != 1 || balance.Balances[0].Asset != "USDT" {
+		t.Fatalf("unexpected balance update %+v", balance)
+	}
+}
+
+func TestSubmitOrderMatches(t *testing.T) {
+	t.Parallel()
+	e := newTestExchange(t)
+	var trades []*Trade
+	e.Subscribe(func(ev interface{}) {
+		if tr, ok := ev.(*Trade); ok {
+			trades = append(trades, tr)
+		}
+	})
+
+	// crosses two ask levels with price improvement on the first
+	o, fills, err := e.SubmitOrder(&OrderRequest{
+		Symbol: testSymbol, Side: Buy, Type: Limit, Price: 50020, Quantity: 1.5,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if o.Status != Filled || len(fills) != 2 || len(trades) != 2 {
+		t.Fatalf("unexpected order %+v fills %+v trades %v", o, fills, len(trades))
+	}
+	if fills[0].Price != 50010 || fills[1].Price != 50020 || fills[0].Maker {
+		t.Fatalf("unexpected fills %+v", fills)
+	}
+	cost := 50010 + 0.5*50020
+	if !isEqual(o.CumulativeQuote, cost) {
+		t.Fatalf("received: '%v' but expected: '%v'", o.CumulativeQuote, cost)
+	}
+	usdt, btc := e.Balance("USDT"), e.Balance("BTC")
+	if !isEqual(usdt.Free, 100000-cost) || usdt.Locked != 0 {
+		t.Fatalf("unexpected quote balance %+v", usdt)
+	}
+	if !isEqual(btc.Free, 10+1.5*(1-DefaultTakerFee)) {
+		t.Fatalf("unexpected base balance %+v", btc)
+	}
+	_, asks, _, err := e.Depth(testSymbol, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(asks) != 1 || !isEqual(asks[0].Amount, 1.5) {
+		t.Fatalf("unexpected asks %+v", asks)
+	}
+
+	tick, err := e.Ticker(testSymbol)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tick.Count != 2 || tick.LastPrice != 50020 || tick.OpenPrice != 50010 || !isEqual(tick.Volume, 1.5) {
+		t.Fatalf("unexpected ticker %+v", tick)
+	}
+	recent, err := e.Trades(testSymbol, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(recent) != 1 || recent[0].Price != 50020 {
+		t.Fatalf("unexpected trades %+v", recent)
+	}
+}
+
+func TestTimeInForce(t *testing.T) {
+	t.Parallel()
+	e := newTestExchange(t)
+	o, fills, err := e.SubmitOrder(&OrderRequest{
+		Symbol: testSymbol, Side: Sell, Type: Limit, TimeInForce: FillOrKill, Price: 49980, Quantity: 4,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if o.Status != Expired || len(fills) != 0 || e.Balance("BTC").Locked != 0 {
+		t.Fatalf("unexpected fill or kill result %+v", o)
+	}
+
+	o, fills, err = e.SubmitOrder(&OrderRequest{
+		Symbol: testSymbol, Side: Sell, Type: Limit, TimeInForce: ImmediateOrCancel, Price: 49990, Quantity: 2,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if o.Status != Expired || len(fills) != 1 || o.ExecutedQuantity != 1 {
+		t.Fatalf("unexpected immediate or cancel result %+v", o)
+	}
+	btc := e.Balance("BTC")
+	if !isEqual(btc.Free, 9) || btc.Locked != 0 {
+		t.Fatalf("unexpected base balance %+v", btc)
+	}
+
+	o, _, err = e.SubmitOrder(&OrderRequest{
+		Symbol: testSymbol, Side: Sell, Type: Market, Quantity: 5,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if o.Status != Expired || o.ExecutedQuantity != 2 {
+		t.Fatalf("unexpected market result %+v", o)
+	}
+	bids, _, _, err := e.Depth(testSymbol, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(bids) != 0 {
+		t.Fatalf("expected empty bids received %+v", bids)
+	}
+}
+
+func TestCancelOrder(t *testing.T) {
+	t.Parallel()
+	e := newTestExchange(t)
+	o, _, err := e.SubmitOrder(&OrderRequest{
+		Symbol: testSymbol, Side: Sell, Type: Limit, Price: 51000, Quantity: 2,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = e.CancelOrder("LTCUSDT", o.ID, "")
+	if !errors.Is(err, ErrUnknownSymbol) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrUnknownSymbol)
+	}
+	_, err = e.CancelOrder(testSymbol, 1337, "")
+	if !errors.Is(err, ErrOrderNotFound) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrOrderNotFound)
+	}
+	open, err := e.OpenOrders("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(open) != 1 {
+		t.Fatalf("received: '%v' but expected: '%v'", len(open), 1)
+	}
+	c, err := e.CancelOrder(testSymbol, 0, o.ClientOrderID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Status != Cancelled {
+		t.Fatalf("received: '%v' but expected: '%v'", c.Status, Cancelled)
+	}
+	btc := e.Balance("BTC")
+	if btc.Free != 10 || btc.Locked != 0 {
+		t.Fatalf("unexpected balance %+v", btc)
+	}
+	_, err = e.CancelOrder(testSymbol, o.ID, "")
+	if !errors.Is(err, errOrderNotOpen) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errOrderNotOpen)
+	}
+	open, err = e.OpenOrders(testSymbol)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(open) != 0 {
+		t.Fatalf("received: '%v' but expected: '%v'", len(open), 0)
+	}
+	all, err := e.Orders(testSymbol)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(all) != 1 {
+		t.Fatalf("received: '%v' but expected: '%v'", len(all), 1)
+	}
+}
+
+func TestAddLiquidityFillsAccountOrder(t *testing.T) {
+	t.Parallel()
+	e := newTestExchange(t)
+	o, _, err := e.SubmitOrder(&OrderRequest{
+		Symbol: testSymbol, Side: Buy, Type: Limit, Price: 49995, Quantity: 2,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var fill *OrderUpdate
+	e.Subscribe(func(ev interface{}) {
+		if u, ok := ev.(*OrderUpdate); ok && u.ExecutionType == ExecutionTrade {
+			fill = u
+		}
+	})
+	err = e.AddLiquidity(testSymbol, Sell, 49990, 0.5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fill == nil || !fill.Fill.Maker || fill.Fill.Price != 49995 || fill.Order.Status != PartiallyFilled {
+		t.Fatalf("unexpected fill %+v", fill)
+	}
+	got, err := e.Order(testSymbol, o.ID, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.ExecutedQuantity != 0.5 {
+		t.Fatalf("received: '%v' but expected: '%v'", got.ExecutedQuantity, 0.5)
+	}
+	if btc := e.Balance("BTC"); !isEqual(btc.Free, 10+0.5*(1-DefaultMakerFee)) {
+		t.Fatalf("unexpected balance %+v", btc)
+	}
+
+	err = e.ClearLiquidity(testSymbol)
+	if err != nil {
+		t.Fatal(err)
+	}
+	bids, asks, _, err := e.Depth(testSymbol, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(asks) != 0 || len(bids) != 1 || !isEqual(bids[0].Amount, 1.5) {
+		t.Fatalf("unexpected book after clearing %+v %+v", bids, asks)
+	}
+	err = e.AddLiquidity(testSymbol, "LONG", 1, 1)
+	if !errors.Is(err, errInvalidSide) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errInvalidSide)
+	}
+}
+
+func TestDeposit(t *testing.T) {
+	t.Parallel()
+	e := newTestExchange(t)
+	err := e.Deposit("", 1)
+	if !errors.Is(err, errInvalidAsset) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errInvalidAsset)
+	}
+	err = e.Deposit("eth", 0)
+	if !errors.Is(err, errInvalidAmount) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errInvalidAmount)
+	}
+	err = e.Deposit("eth", 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b := e.Balance("ETH"); b.Free != 5 {
+		t.Fatalf("received: '%v' but expected: '%v'", b.Free, 5)
+	}
+	if len(e.Balances()) != 3 {
+		t.Fatalf("received: '%v' but expected: '%v'", len(e.Balances()), 3)
+	}
+}
@@ -0,0 +1,147 @@
+package simulator
+
+import (
+	"math"
+	"sort"
+)
+
+// insert adds a resting order to the book in price-time priority
+func (m *market) insert(o *Order) {
+	if o.Side == Buy {
+		i := sort.Search(len(m.bids), func(i int) bool {
+			return m.bids[i].Price < o.Price
+		})
+		m.bids = append(m.bids, nil)
+		copy(m.bids[i+1:], m.bids[i:])
+		m.bids[i] = o
+		return
+	}
+	i := sort.Search(len(m.asks), func(i int) bool {
+		return m.asks[i].Price > o.Price
+	})
+	m.asks = append(m.asks, nil)
+	copy(m.asks[i+1:], m.asks[i:])
+	m.asks[i] = o
+}
+
+// remove removes a resting order from the book, returns false if the order
+// is not resting
+func (m *market) remove(o *Order) bool {
+	side := &m.asks
+	if o.Side == Buy {
+		side = &m.bids
+	}
+	for i := range *side {
+		if (*side)[i] == o {
+			*side = append((*side)[:i], (*side)[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+// opposite returns the resting orders an incoming order can match against
+func (m *market) opposite(s Side) []*Order {
+	if s == Buy {
+		return m.asks
+	}
+	return m.bids
+}
+
+// crosses returns true if an incoming order will execute against a resting
+// order at the supplied price
+func crosses(o *Order, price float64) bool {
+	if o.Type == Market {
+		return true
+	}
+	if o.Side == Buy {
+		return o.Price >= price
+	}
+	return o.Price <= price
+}
+
+// available returns the quantity that can be immediately executed for an
+// incoming order
+func (m *market) available(o *Order) float64 {
+	var total float64
+	resting := m.opposite(o.Side)
+	for i := range resting {
+		if !crosses(o, resting[i].Price) {
+			break
+		}
+		total += resting[i].remaining()
+	}
+	return total
+}
+
+// cost returns the quote value required to immediately execute the supplied
+// quantity against the book
+func (m *market) cost(o *Order, quantity float64) float64 {
+	var total float64
+	resting := m.opposite(o.Side)
+	for i := range resting {
+		if quantity <= quantityTolerance || !crosses(o, resting[i].Price) {
+			break
+		}
+		amount := math.Min(quantity, resting[i].remaining())
+		total += amount * resting[i].Price
+		quantity -= amount
+	}
+	return total
+}
+
+// levelAmount returns the aggregated resting quantity for a side at a price
+func (m *market) levelAmount(s Side, price float64) float64 {
+	resting := m.asks
+	if s == Buy {
+		resting = m.bids
+	}
+	var total float64
+	for i := range resting {
+		if resting[i].Price == price {
+			total += resting[i].remaining()
+		}
+	}
+	return total
+}
+
+// levels returns aggregated price levels for a side, a zero limit returns
+// the full side
+func levels(resting []*Order, limit int) []Level {
+	var out []Level
+	for i := range resting {
+		if len(out) > 0 && out[len(out)-1].Price == resting[i].Price {
+			out[len(out)-1].Amount += resting[i].remaining()
+			continue
+		}
+		if limit > 0 && len(out) == limit {
+			break
+		}
+		out = append(out, Level{Price: resting[i].Price, Amount: resting[i].remaining()})
+	}
+	return out
+}
+
+// remaining returns the unexecuted quantity of an order
+func (o *Order) remaining() float64 {
+	r := o.Quantity - o.ExecutedQuantity
+	if r < quantityTolerance {
+		return 0
+	}
+	return r
+}
+
+// isOpen returns true if the order can still be executed
+func (o *Order) isOpen() bool {
+	return o.Status == New || o.Status == PartiallyFilled
+}
+
+// isIncrement returns true if the value is a multiple of the increment, a
+// zero increment allows any value
+func isIncrement(value, increment float64) bool {
+	if increment <= 0 {
+		return true
+	}
+	r := value / increment
+	return math.Abs(r-math.Round(r)) < incrementTolerance*math.Max(1, r)
+}
@@ -0,0 +1,146 @@
+package simulator
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// NewServer returns a server for a simulated exchange seeded from the
+// supplied config. When no API key is configured authenticated endpoints
+// accept any credentials.
+func NewServer(cfg *Config) (*Server, error) {
+	e, err := NewExchange(cfg)
+	if err != nil {
+		return nil, err
+	}
+	return &Server{
+		exchange:   e,
+		apiKey:     cfg.APIKey,
+		apiSecret:  cfg.APISecret,
+		listenKeys: make(map[string]time.Time),
+		clients:    make(map[*wsClient]struct{}),
+	}, nil
+}
+
+// Start starts serving on the supplied address, an empty address listens on
+// a random local port
+func (s *Server) Start(address string) error {
+	if s == nil {
+		return fmt.Errorf("%w: nil server", errServerNotStarted)
+	}
+	if !atomic.CompareAndSwapInt32(&s.started, 0, 1) {
+		return errServerAlreadyStarted
+	}
+	if address == "" {
+		address = DefaultAddress
+	}
+	l, err := net.Listen("tcp", address)
+	if err != nil {
+		atomic.StoreInt32(&s.started, 0)
+		return err
+	}
+	s.listener = l
+	s.httpServer = &http.Server{
+		Handler:           s.binanceRouter(),
+		ReadHeaderTimeout: time.Second * 10,
+	}
+	s.subscriptionID = s.exchange.Subscribe(s.dispatch)
+	go func(srv *http.Server) {
+		if err := srv.Serve(l); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.Errorf(log.ExchangeSys, "Exchange simulator server failure: %v", err)
+		}
+	}(s.httpServer)
+	return nil
+}
+
+// Stop stops the server and disconnects all websocket clients, the
+// exchange state is retained
+func (s *Server) Stop() error {
+	if s == nil {
+		return fmt.Errorf("%w: nil server", errServerNotStarted)
+	}
+	if !atomic.CompareAndSwapInt32(&s.started, 1, 0) {
+		return errServerNotStarted
+	}
+	s.exchange.Unsubscribe(s.subscriptionID)
+	s.m.Lock()
+	for c := range s.clients {
+		c.close()
+	}
+	s.m.Unlock()
+	return s.httpServer.Close()
+}
+
+// IsRunning returns whether the server is serving requests
+func (s *Server) IsRunning() bool {
+	return s != nil && atomic.LoadInt32(&s.started) == 1
+}
+
+// Exchange returns the simulated exchange so that its state can be seeded
+// and inspected
+func (s *Server) Exchange() *Exchange {
+	return s.exchange
+}
+
+// Address returns the listening address
+func (s *Server) Address() string {
+	if !s.IsRunning() {
+		return ""
+	}
+	return s.listener.Addr().String()
+}
+
+// URL returns the REST API base URL
+func (s *Server) URL() string {
+	if !s.IsRunning() {
+		return ""
+	}
+	return "http://" + s.Address()
+}
+
+// WebsocketURL returns the combined stream websocket URL
+func (s *Server) WebsocketURL() string {
+	if !s.IsRunning() {
+		return ""
+	}
+	return "ws://" + s.Address() + "/stream"
+}
+
+// BinanceEndpoints returns the endpoint overrides which point a Binance
+// exchange config at the server via API.Endpoints. Futures endpoints are
+// included so that no requests reach the live exchange, they are not
+// simulated.
+func (s *Server) BinanceEndpoints() map[string]string {
+	if !s.IsRunning() {
+		return nil
+	}
+	return map[string]string{
+		exchange.RestSpot.String():              s.URL(),
+		exchange.RestSpotSupplementary.String(): s.URL(),
+		exchange.RestUSDTMargined.String():      s.URL(),
+		exchange.RestCoinMargined.String():      s.URL(),
+		exchange.WebsocketSpot.String():         s.WebsocketURL(),
+	}
+}
+
+// newListenKey generates and registers a user data stream listen key
+func (s *Server) newListenKey() (string, error) {
+	b := make([]byte, listenKeyLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	key := hex.EncodeToString(b)
+	s.m.Lock()
+	s.listenKeys[key] = time.Now()
+	s.m.Unlock()
+	return key, nil
+}
@@ -0,0 +1,371 @@
+package simulator
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/binance"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+const (
+	testAPIKey    = "simulatorKey"
+	testAPISecret = "simulatorSecret"
+)
+
+var testPair = currency.NewPair(currency.BTC, currency.USDT)
+
+func newTestServer(t *testing.T) *Server {
+	t.Helper()
+	cfg := testConfig()
+	cfg.APIKey = testAPIKey
+	cfg.APISecret = testAPISecret
+	s, err := NewServer(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = s.Start(""); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if s.IsRunning() {
+			if err := s.Stop(); err != nil {
+				t.Error(err)
+			}
+		}
+	})
+	return s
+}
+
+// newTestBinance returns a Binance exchange whose endpoints are pointed at
+// the simulator through the exchange config
+func newTestBinance(t *testing.T, s *Server, secret string, ws bool) *binance.Binance {
+	t.Helper()
+	var cfg config.Config
+	if err := cfg.LoadConfig("../../testdata/configtest.json", true); err != nil {
+		t.Fatal(err)
+	}
+	exchCfg, err := cfg.GetExchangeConfig("Binance")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c := *exchCfg
+	c.API.OldEndPoints = nil
+	c.API.Endpoints = s.BinanceEndpoints()
+	c.API.AuthenticatedSupport = true
+	c.API.AuthenticatedWebsocketSupport = ws
+	c.API.Credentials.Key = testAPIKey
+	c.API.Credentials.Secret = secret
+	c.Features.Enabled.Websocket = ws
+	c.Verbose = false
+
+	b := new(binance.Binance)
+	b.SetDefaults()
+	b.Verbose = false
+	if err = b.Setup(&c); err != nil {
+		t.Fatal(err)
+	}
+	b.SkipAuthCheck = true
+	if err = b.UpdateOrderExecutionLimits(context.Background(), asset.Spot); err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+func TestServerStartStop(t *testing.T) {
+	t.Parallel()
+	var s *Server
+	err := s.Start("")
+	if !errors.Is(err, errServerNotStarted) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errServerNotStarted)
+	}
+	_, err = NewServer(nil)
+	if !errors.Is(err, errNilConfig) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errNilConfig)
+	}
+	s = newTestServer(t)
+	err = s.Start("")
+	if !errors.Is(err, errServerAlreadyStarted) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errServerAlreadyStarted)
+	}
+	endpoints := s.BinanceEndpoints()
+	if endpoints[exchange.RestSpotSupplementary.String()] != s.URL() ||
+		endpoints[exchange.WebsocketSpot.String()] != s.WebsocketURL() {
+		t.Fatalf("unexpected endpoints %v", endpoints)
+	}
+	if err = s.Stop(); err != nil {
+		t.Fatal(err)
+	}
+	err = s.Stop()
+	if !errors.Is(err, errServerNotStarted) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errServerNotStarted)
+	}
+	if s.URL() != "" || s.BinanceEndpoints() != nil {
+		t.Fatal("expected no addresses once stopped")
+	}
+}
+
+func TestBinanceREST(t *testing.T) {
+	t.Parallel()
+	s := newTestServer(t)
+	b := newTestBinance(t, s, testAPISecret, false)
+	ctx := context.Background()
+
+	pairs, err := b.FetchTradablePairs(ctx, asset.Spot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pairs) != 1 || pairs[0] != "BTC-USDT" {
+		t.Fatalf("unexpected pairs %v", pairs)
+	}
+	limits, err := b.GetOrderExecutionLimits(asset.Spot, testPair)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = limits.Conforms(100, 0.01, order.Limit)
+	if !errors.Is(err, order.ErrNotionalValue) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, order.ErrNotionalValue)
+	}
+
+	ob, err := b.UpdateOrderbook(ctx, testPair, asset.Spot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ob.Bids) != 2 || ob.Bids[0].Price != 49990 || ob.Asks[0].Price != 50010 {
+		t.Fatalf("unexpected orderbook %+v", ob)
+	}
+
+	resp, err := b.SubmitOrder(ctx, &order.Submit{
+		Exchange:      b.Name,
+		Pair:          testPair,
+		AssetType:     asset.Spot,
+		Side:          order.Buy,
+		Type:          order.Limit,
+		Price:         50010,
+		Amount:        0.5,
+		ClientOrderID: "fill",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !resp.IsOrderPlaced || !resp.FullyMatched || len(resp.Trades) != 1 || resp.Trades[0].FeeAsset != "BTC" {
+		t.Fatalf("unexpected submit response %+v", resp)
+	}
+
+	tick, err := b.UpdateTicker(ctx, testPair, asset.Spot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tick.Last != 50010 || tick.Bid != 49990 {
+		t.Fatalf("unexpected ticker %+v", tick)
+	}
+
+	resp, err = b.SubmitOrder(ctx, &order.Submit{
+		Exchange:  b.Name,
+		Pair:      testPair,
+		AssetType: asset.Spot,
+		Side:      order.Sell,
+		Type:      order.Limit,
+		Price:     51000,
+		Amount:    1,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !resp.IsOrderPlaced || resp.FullyMatched {
+		t.Fatalf("unexpected submit response %+v", resp)
+	}
+
+	info, err := b.GetOrderInfo(ctx, resp.OrderID, testPair, asset.Spot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Status != order.New || info.Price != 51000 || info.Side != order.Sell {
+		t.Fatalf("unexpected order info %+v", info)
+	}
+
+	active, err := b.GetActiveOrders(ctx, &order.GetOrdersRequest{
+		Pairs: currency.Pairs{testPair}, AssetType: asset.Spot, Type: order.AnyType, Side: order.AnySide,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(active) != 1 || active[0].ID != resp.OrderID {
+		t.Fatalf("unexpected active orders %+v", active)
+	}
+
+	acc, err := b.UpdateAccountInfo(ctx, asset.Spot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	balances := make(map[string]float64)
+	for i := range acc.Accounts {
+		for j := range acc.Accounts[i].Currencies {
+			balances[acc.Accounts[i].Currencies[j].CurrencyName.String()] = acc.Accounts[i].Currencies[j].Hold
+		}
+	}
+	if balances["BTC"] != 1 {
+		t.Fatalf("unexpected held balances %v", balances)
+	}
+
+	err = b.CancelOrder(ctx, &order.Cancel{
+		Exchange: b.Name, ID: resp.OrderID, Pair: testPair, AssetType: asset.Spot,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = b.CancelOrder(ctx, &order.Cancel{
+		Exchange: b.Name, ID: resp.OrderID, Pair: testPair, AssetType: asset.Spot,
+	})
+	if err == nil {
+		t.Fatal("expected error cancelling a cancelled order")
+	}
+
+	history, err := b.GetOrderHistory(ctx, &order.GetOrdersRequest{
+		Pairs: currency.Pairs{testPair}, AssetType: asset.Spot, Type: order.AnyType, Side: order.AnySide,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(history) != 2 {
+		t.Fatalf("received: '%v' but expected: '%v'", len(history), 2)
+	}
+
+	_, err = b.SubmitOrder(ctx, &order.Submit{
+		Exchange:  b.Name,
+		Pair:      testPair,
+		AssetType: asset.Spot,
+		Side:      order.Buy,
+		Type:      order.Limit,
+		Price:     40000,
+		Amount:    10,
+	})
+	if err == nil {
+		t.Fatal("expected insufficient balance error")
+	}
+}
+
+func TestBinanceAuthentication(t *testing.T) {
+	t.Parallel()
+	s := newTestServer(t)
+	b := newTestBinance(t, s, "wrongSecret", false)
+	_, err := b.UpdateAccountInfo(context.Background(), asset.Spot)
+	if err == nil {
+		t.Fatal("expected signature error")
+	}
+}
+
+func TestBinanceWebsocket(t *testing.T) {
+	t.Parallel()
+	s := newTestServer(t)
+	b := newTestBinance(t, s, testAPISecret, true)
+	if err := b.Websocket.Connect(); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		if err := b.Websocket.Shutdown(); err != nil {
+			t.Error(err)
+		}
+	})
+
+	// wait for the subscriptions to be processed by the server so that
+	// published events are routed to the client
+	waitFor(t, func() bool {
+		s.m.Lock()
+		defer s.m.Unlock()
+		for c := range s.clients {
+			if c.listenKey == "" {
+				continue
+			}
+			if _, ok := c.subscribed("btcusdt@depth"); ok {
+				return true
+			}
+		}
+		return false
+	})
+
+	e := s.Exchange()
+	// the first depth update triggers a REST snapshot, the second is applied
+	// to it
+	if err := e.AddLiquidity(testSymbol, Buy, 49000, 1); err != nil {
+		t.Fatal(err)
+	}
+	if err := e.AddLiquidity(testSymbol, Sell, 52000, 3); err != nil {
+		t.Fatal(err)
+	}
+	resp, err := b.SubmitOrder(context.Background(), &order.Submit{
+		Exchange:  b.Name,
+		Pair:      testPair,
+		AssetType: asset.Spot,
+		Side:      order.Sell,
+		Type:      order.Limit,
+		Price:     50000,
+		Amount:    2,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var filled bool
+	timeout := time.After(time.Second * 10)
+	for !filled {
+		select {
+		case d := <-b.Websocket.ToRoutine:
+			if o, ok := d.(*order.Detail); ok && o.ID == resp.OrderID && o.Status == order.New {
+				// a new buyer crosses the account order which is now the best
+				// ask
+				if err = e.AddLiquidity(testSymbol, Buy, 50000, 2); err != nil {
+					t.Fatal(err)
+				}
+			}
+			if o, ok := d.(*order.Detail); ok && o.ID == resp.OrderID && o.Status == order.Filled {
+				if o.ExecutedAmount != 2 || o.AverageExecutedPrice != 50000 {
+					t.Fatalf("unexpected filled order %+v", o)
+				}
+				filled = true
+			}
+		case <-timeout:
+			t.Fatal("timed out waiting for order fill")
+		}
+	}
+
+	waitFor(t, func() bool {
+		ob, err := orderbook.Get(b.Name, testPair, asset.Spot)
+		if err != nil {
+			return false
+		}
+		bids, asks, _, err := e.Depth(testSymbol, 0)
+		if err != nil || len(ob.Bids) != len(bids) || len(ob.Asks) != len(asks) {
+			return false
+		}
+		for i := range bids {
+			if ob.Bids[i].Price != bids[i].Price || ob.Bids[i].Amount != bids[i].Amount {
+				return false
+			}
+		}
+		for i := range asks {
+			if ob.Asks[i].Price != asks[i].Price || ob.Asks[i].Amount != asks[i].Amount {
+				return false
+			}
+		}
+		return true
+	})
+}
+
+func waitFor(t *testing.T, condition func() bool) {
+	t.Helper()
+	deadline := time.Now().Add(time.Second * 10)
+	for !condition() {
+		if time.Now().After(deadline) {
+			t.Fatal("timed out waiting for condition")
+		}
+		time.Sleep(time.Millisecond * 10)
+	}
+}
@@ -0,0 +1,308 @@
+package simulator
+
+import (
+	"errors"
+	"net"
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+)
+
+const (
+	// DefaultMakerFee is the maker commission rate applied when not configured
+	DefaultMakerFee = 0.001
+	// DefaultTakerFee is the taker commission rate applied when not configured
+	DefaultTakerFee = 0.001
+	// DefaultAddress is the listening address used when one is not supplied,
+	// a random free port is selected
+	DefaultAddress = "127.0.0.1:0"
+
+	defaultDepthLimit   = 100
+	maxTradeHistory     = 1000
+	statisticsPeriod    = time.Hour * 24
+	quantityTolerance   = 1e-12
+	incrementTolerance  = 1e-9
+	maxClientMessages   = 4096
+	listenKeyLength     = 32
+	clientOrderIDPrefix = "sim"
+)
+
+// Side defines the direction of an order
+type Side string
+
+// OrderType defines the execution type of an order
+type OrderType string
+
+// TimeInForce defines how long an order remains active
+type TimeInForce string
+
+// Status defines the state of an order
+type Status string
+
+// ExecutionType defines the reason an order update was generated
+type ExecutionType string
+
+// Order sides
+const (
+	Buy  Side = "BUY"
+	Sell Side = "SELL"
+)
+
+// Order types
+const (
+	Limit  OrderType = "LIMIT"
+	Market OrderType = "MARKET"
+)
+
+// Time in force types
+const (
+	GoodTillCancel    TimeInForce = "GTC"
+	ImmediateOrCancel TimeInForce = "IOC"
+	FillOrKill        TimeInForce = "FOK"
+)
+
+// Order statuses
+const (
+	New             Status = "NEW"
+	PartiallyFilled Status = "PARTIALLY_FILLED"
+	Filled          Status = "FILLED"
+	Cancelled       Status = "CANCELED"
+	Rejected        Status = "REJECTED"
+	Expired         Status = "EXPIRED"
+)
+
+// Execution types
+const (
+	ExecutionNew       ExecutionType = "NEW"
+	ExecutionTrade     ExecutionType = "TRADE"
+	ExecutionCancelled ExecutionType = "CANCELED"
+	ExecutionExpired   ExecutionType = "EXPIRED"
+)
+
+var (
+	// ErrUnknownSymbol is returned when a symbol is not listed on the
+	// simulated exchange
+	ErrUnknownSymbol = errors.New("unknown symbol")
+	// ErrOrderNotFound is returned when an order cannot be located
+	ErrOrderNotFound = errors.New("order does not exist")
+	// ErrInsufficientBalance is returned when the account cannot fund an
+	// order
+	ErrInsufficientBalance = errors.New("account has insufficient balance for requested action")
+
+	errNilConfig              = errors.New("simulator config is nil")
+	errNoMarkets              = errors.New("no markets configured")
+	errInvalidMarket          = errors.New("invalid market")
+	errDuplicateMarket        = errors.New("duplicate market")
+	errInvalidSide            = errors.New("invalid side")
+	errInvalidOrderType       = errors.New("invalid order type")
+	errInvalidTimeInForce     = errors.New("invalid time in force")
+	errInvalidQuantity        = errors.New("invalid quantity")
+	errInvalidPrice           = errors.New("invalid price")
+	errMinNotional            = errors.New("order value below minimum notional")
+	errDuplicateClientOrderID = errors.New("duplicate order sent")
+	errOrderNotOpen           = errors.New("order is not open")
+	errInvalidAsset           = errors.New("invalid asset")
+	errInvalidAmount          = errors.New("invalid amount")
+	errServerAlreadyStarted   = errors.New("simulator server already started")
+	errServerNotStarted       = errors.New("simulator server not started")
+)
+
+// Config defines the initial state of a simulated exchange
+type Config struct {
+	APIKey    string             `json:"apiKey"`
+	APISecret string             `json:"apiSecret"`
+	MakerFee  float64            `json:"makerFee"`
+	TakerFee  float64            `json:"takerFee"`
+	Balances  map[string]float64 `json:"balances"`
+	Markets   []MarketConfig     `json:"markets"`
+}
+
+// MarketConfig defines a tradable market and the external liquidity it is
+// seeded with
+type MarketConfig struct {
+	Base        string  `json:"base"`
+	Quote       string  `json:"quote"`
+	TickSize    float64 `json:"tickSize"`
+	StepSize    float64 `json:"stepSize"`
+	MinQuantity float64 `json:"minQuantity"`
+	MaxQuantity float64 `json:"maxQuantity"`
+	MinNotional float64 `json:"minNotional"`
+	Bids        []Level `json:"bids"`
+	Asks        []Level `json:"asks"`
+}
+
+// Level defines an aggregated orderbook price level
+type Level struct {
+	Price  float64 `json:"price"`
+	Amount float64 `json:"amount"`
+}
+
+// Balance defines an account balance for an asset
+type Balance struct {
+	Asset  string
+	Free   float64
+	Locked float64
+}
+
+// OrderRequest defines a request to place an order on the simulated exchange
+type OrderRequest struct {
+	Symbol        string
+	ClientOrderID string
+	Side          Side
+	Type          OrderType
+	TimeInForce   TimeInForce
+	Price         float64
+	Quantity      float64
+}
+
+// Order defines an order held by the simulated exchange
+type Order struct {
+	ID               int64
+	ClientOrderID    string
+	Symbol           string
+	Side             Side
+	Type             OrderType
+	TimeInForce      TimeInForce
+	Price            float64
+	Quantity         float64
+	ExecutedQuantity float64
+	CumulativeQuote  float64
+	Status           Status
+	Created          time.Time
+	Updated          time.Time
+
+	// external orders provide liquidity on behalf of other market
+	// participants and do not affect account balances
+	external bool
+	locked   float64
+}
+
+// Fill defines a single execution against an account order
+type Fill struct {
+	TradeID         int64
+	Price           float64
+	Quantity        float64
+	Commission      float64
+	CommissionAsset string
+	Maker           bool
+}
+
+// Trade defines a public market trade
+type Trade struct {
+	ID            int64
+	Symbol        string
+	Price         float64
+	Quantity      float64
+	BuyerOrderID  int64
+	SellerOrderID int64
+	BuyerMaker    bool
+	Time          time.Time
+}
+
+// Ticker defines rolling 24 hour market statistics
+type Ticker struct {
+	Symbol           string
+	PriceChange      float64
+	PricePercent     float64
+	WeightedAvgPrice float64
+	PrevClosePrice   float64
+	LastPrice        float64
+	LastQuantity     float64
+	BidPrice         float64
+	BidQuantity      float64
+	AskPrice         float64
+	AskQuantity      float64
+	OpenPrice        float64
+	HighPrice        float64
+	LowPrice         float64
+	Volume           float64
+	QuoteVolume      float64
+	OpenTime         time.Time
+	CloseTime        time.Time
+	FirstTradeID     int64
+	LastTradeID      int64
+	Count            int64
+}
+
+// DepthUpdate is published when price levels change, a zero amount
+// indicates the level has been removed
+type DepthUpdate struct {
+	Symbol   string
+	UpdateID int64
+	Bids     []Level
+	Asks     []Level
+	Time     time.Time
+}
+
+// OrderUpdate is published when an account order changes
+type OrderUpdate struct {
+	Order         Order
+	ExecutionType ExecutionType
+	Fill          *Fill
+	Time          time.Time
+}
+
+// BalanceUpdate is published when account balances change
+type BalanceUpdate struct {
+	Balances []Balance
+	Time     time.Time
+}
+
+// Exchange is an in memory exchange with a price-time priority matching
+// engine and a single trading account
+type Exchange struct {
+	m           sync.Mutex
+	makerFee    float64
+	takerFee    float64
+	markets     map[string]*market
+	symbols     []string
+	balances    map[string]*Balance
+	orders      map[int64]*Order
+	nextOrderID int64
+	nextTradeID int64
+	updated     time.Time
+
+	subscribers      map[int64]func(interface{})
+	nextSubscriberID int64
+}
+
+// market holds the state of a single tradable market
+type market struct {
+	MarketConfig
+	symbol   string
+	bids     []*Order
+	asks     []*Order
+	updateID int64
+	trades   []Trade
+}
+
+// Server serves a simulated exchange over the Binance spot REST and
+// websocket APIs
+type Server struct {
+	exchange  *Exchange
+	apiKey    string
+	apiSecret string
+
+	started        int32
+	listener       net.Listener
+	httpServer     *http.Server
+	subscriptionID int64
+
+	m          sync.Mutex
+	listenKeys map[string]time.Time
+	clients    map[*wsClient]struct{}
+}
+
+// wsClient holds a websocket connection and its stream subscriptions
+type wsClient struct {
+	conn      *websocket.Conn
+	send      chan []byte
+	done      chan struct{}
+	closeOnce sync.Once
+	listenKey string
+
+	m       sync.Mutex
+	streams map[string]struct{}
+}
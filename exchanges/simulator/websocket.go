@@ -0,0 +1,299 @@
+package simulator
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/gorilla/websocket"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+var upgrader = websocket.Upgrader{
+	CheckOrigin: func(*http.Request) bool { return true },
+}
+
+// binanceWebsocket upgrades a connection and serves the Binance combined
+// stream protocol. Streams can be supplied via the streams query parameter
+// or subscribed to after connecting, a valid listen key enables user data
+// events.
+func (s *Server) binanceWebsocket(w http.ResponseWriter, r *http.Request) {
+	conn, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		log.Errorf(log.ExchangeSys, "Exchange simulator websocket upgrade failure: %v", err)
+		return
+	}
+	c := &wsClient{
+		conn:    conn,
+		send:    make(chan []byte, maxClientMessages),
+		done:    make(chan struct{}),
+		streams: make(map[string]struct{}),
+	}
+	if streams := r.URL.Query().Get("streams"); streams != "" {
+		s.m.Lock()
+		for _, name := range strings.Split(streams, "/") {
+			if _, ok := s.listenKeys[name]; ok {
+				c.listenKey = name
+				continue
+			}
+			c.streams[strings.ToLower(name)] = struct{}{}
+		}
+		s.m.Unlock()
+	}
+
+	s.m.Lock()
+	s.clients[c] = struct{}{}
+	s.m.Unlock()
+
+	go c.writer()
+	s.readClient(c)
+
+	s.m.Lock()
+	delete(s.clients, c)
+	s.m.Unlock()
+	c.close()
+}
+
+// readClient handles subscription requests until the connection closes
+func (s *Server) readClient(c *wsClient) {
+	for {
+		_, msg, err := c.conn.ReadMessage()
+		if err != nil {
+			return
+		}
+		var req binanceWsRequest
+		if err := json.Unmarshal(msg, &req); err != nil {
+			c.write(binanceError{Code: binanceCodeIllegalParameter, Msg: "Invalid JSON: " + err.Error()})
+			continue
+		}
+		resp := binanceWsResponse{ID: req.ID}
+		c.m.Lock()
+		switch strings.ToUpper(req.Method) {
+		case binanceMethodSubscribe:
+			for i := range req.Params {
+				c.streams[strings.ToLower(req.Params[i])] = struct{}{}
+			}
+		case binanceMethodUnsubscribe:
+			for i := range req.Params {
+				delete(c.streams, strings.ToLower(req.Params[i]))
+			}
+		case binanceMethodList:
+			streams := make([]string, 0, len(c.streams))
+			for k := range c.streams {
+				streams = append(streams, k)
+			}
+			sort.Strings(streams)
+			resp.Result = streams
+		default:
+			c.m.Unlock()
+			c.write(binanceError{Code: binanceCodeIllegalParameter, Msg: "Unknown method " + req.Method})
+			continue
+		}
+		c.m.Unlock()
+		c.write(resp)
+	}
+}
+
+// dispatch converts exchange events to Binance stream messages and sends
+// them to subscribed clients
+func (s *Server) dispatch(event interface{}) {
+	var symbol, stream string
+	var data interface{}
+	userData := false
+	switch ev := event.(type) {
+	case *DepthUpdate:
+		symbol, stream = ev.Symbol, binanceDepthStream
+		data = binanceWsDepth{
+			Event:         "depthUpdate",
+			EventTime:     milliseconds(ev.Time),
+			Symbol:        ev.Symbol,
+			FirstUpdateID: ev.UpdateID,
+			LastUpdateID:  ev.UpdateID,
+			Bids:          formatLevels(ev.Bids),
+			Asks:          formatLevels(ev.Asks),
+		}
+	case *Trade:
+		symbol, stream = ev.Symbol, binanceTradeStream
+		data = binanceWsTrade{
+			Event:         "trade",
+			EventTime:     milliseconds(ev.Time),
+			Symbol:        ev.Symbol,
+			TradeID:       ev.ID,
+			Price:         formatFloat(ev.Price),
+			Quantity:      formatFloat(ev.Quantity),
+			BuyerOrderID:  ev.BuyerOrderID,
+			SellerOrderID: ev.SellerOrderID,
+			TradeTime:     milliseconds(ev.Time),
+			BuyerMaker:    ev.BuyerMaker,
+			BestMatch:     true,
+		}
+	case *Ticker:
+		symbol, stream = ev.Symbol, binanceTickerStream
+		t := toBinanceTicker(ev)
+		data = binanceWsTicker{
+			Event:              "24hrTicker",
+			EventTime:          milliseconds(ev.CloseTime),
+			Symbol:             t.Symbol,
+			PriceChange:        t.PriceChange,
+			PriceChangePercent: t.PriceChangePercent,
+			WeightedAvgPrice:   t.WeightedAvgPrice,
+			PrevClosePrice:     t.PrevClosePrice,
+			LastPrice:          t.LastPrice,
+			LastQty:            t.LastQty,
+			BidPrice:           t.BidPrice,
+			BidQty:             t.BidQty,
+			AskPrice:           t.AskPrice,
+			AskQty:             t.AskQty,
+			OpenPrice:          t.OpenPrice,
+			HighPrice:          t.HighPrice,
+			LowPrice:           t.LowPrice,
+			Volume:             t.Volume,
+			QuoteVolume:        t.QuoteVolume,
+			OpenTime:           t.OpenTime,
+			CloseTime:          t.CloseTime,
+			FirstID:            t.FirstID,
+			LastID:             t.LastID,
+			Count:              t.Count,
+		}
+	case *OrderUpdate:
+		userData = true
+		data = toBinanceExecutionReport(ev)
+	case *BalanceUpdate:
+		userData = true
+		u := binanceWsAccountPosition{
+			Event:      "outboundAccountPosition",
+			EventTime:  milliseconds(ev.Time),
+			LastUpdate: milliseconds(ev.Time),
+			Balances:   make([]binanceWsPositionItem, len(ev.Balances)),
+		}
+		for i := range ev.Balances {
+			u.Balances[i] = binanceWsPositionItem{
+				Asset:  ev.Balances[i].Asset,
+				Free:   formatFloat(ev.Balances[i].Free),
+				Locked: formatFloat(ev.Balances[i].Locked),
+			}
+		}
+		data = u
+	default:
+		return
+	}
+
+	prefix := strings.ToLower(symbol) + "@" + stream
+	s.m.Lock()
+	defer s.m.Unlock()
+	for c := range s.clients {
+		if userData {
+			if c.listenKey != "" {
+				c.write(binanceWsMessage{Stream: c.listenKey, Data: data})
+			}
+			continue
+		}
+		if name, ok := c.subscribed(prefix); ok {
+			c.write(binanceWsMessage{Stream: name, Data: data})
+		}
+	}
+}
+
+func toBinanceExecutionReport(u *OrderUpdate) binanceWsExecutionReport {
+	o := &u.Order
+	r := binanceWsExecutionReport{
+		Event:                    "executionReport",
+		EventTime:                milliseconds(u.Time),
+		Symbol:                   o.Symbol,
+		ClientOrderID:            o.ClientOrderID,
+		Side:                     string(o.Side),
+		OrderType:                string(o.Type),
+		TimeInForce:              string(o.TimeInForce),
+		Quantity:                 formatFloat(o.Quantity),
+		Price:                    formatFloat(o.Price),
+		StopPrice:                formatFloat(0),
+		IcebergQuantity:          formatFloat(0),
+		OrderListID:              -1,
+		ExecutionType:            string(u.ExecutionType),
+		Status:                   string(o.Status),
+		RejectReason:             "NONE",
+		OrderID:                  o.ID,
+		LastExecutedQuantity:     formatFloat(0),
+		CumulativeFilledQuantity: formatFloat(o.ExecutedQuantity),
+		LastExecutedPrice:        formatFloat(0),
+		Commission:               formatFloat(0),
+		TransactionTime:          milliseconds(o.Updated),
+		TradeID:                  -1,
+		IsOnBook:                 o.isOpen(),
+		CreationTime:             milliseconds(o.Created),
+		CumulativeQuoteQuantity:  formatFloat(o.CumulativeQuote),
+		LastQuoteQuantity:        formatFloat(0),
+		QuoteOrderQuantity:       formatFloat(0),
+	}
+	if o.Status == Cancelled {
+		r.CancelledClientOrderID = o.ClientOrderID
+	}
+	if u.Fill != nil {
+		r.LastExecutedQuantity = formatFloat(u.Fill.Quantity)
+		r.LastExecutedPrice = formatFloat(u.Fill.Price)
+		r.LastQuoteQuantity = formatFloat(u.Fill.Quantity * u.Fill.Price)
+		r.Commission = formatFloat(u.Fill.Commission)
+		asset := u.Fill.CommissionAsset
+		r.CommissionAsset = &asset
+		r.TradeID = u.Fill.TradeID
+		r.IsMaker = u.Fill.Maker
+	}
+	return r
+}
+
+// subscribed returns the subscribed stream name matching a stream prefix,
+// this allows update speed suffixes such as depth@100ms
+func (c *wsClient) subscribed(prefix string) (string, bool) {
+	c.m.Lock()
+	defer c.m.Unlock()
+	if _, ok := c.streams[prefix]; ok {
+		return prefix, true
+	}
+	for name := range c.streams {
+		if strings.HasPrefix(name, prefix+"@") {
+			return name, true
+		}
+	}
+	return "", false
+}
+
+// write queues a message for the client, slow clients are disconnected so
+// that they resynchronise rather than silently missing updates
+func (c *wsClient) write(v interface{}) {
+	msg, err := json.Marshal(v)
+	if err != nil {
+		log.Errorf(log.ExchangeSys, "Exchange simulator websocket marshal failure: %v", err)
+		return
+	}
+	select {
+	case <-c.done:
+	case c.send <- msg:
+	default:
+		log.Warnf(log.ExchangeSys, "Exchange simulator websocket client too slow, disconnecting")
+		c.close()
+	}
+}
+
+func (c *wsClient) writer() {
+	for {
+		select {
+		case <-c.done:
+			return
+		case msg := <-c.send:
+			if err := c.conn.WriteMessage(websocket.TextMessage, msg); err != nil {
+				c.close()
+				return
+			}
+		}
+	}
+}
+
+func (c *wsClient) close() {
+	c.closeOnce.Do(func() {
+		close(c.done)
+		if err := c.conn.Close(); err != nil {
+			log.Errorf(log.ExchangeSys, "Exchange simulator websocket close failure: %v", err)
+		}
+	})
+}
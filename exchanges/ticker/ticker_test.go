@@ -416,3 +416,35 @@ func TestGetAssociation(t *testing.T) {
 
 	service.mux = cpyMux
 }
+
+func TestProcessOptionsTicker(t *testing.T) {
+	t.Parallel()
+	p, err := currency.NewPairDelimiter("BTC-USD_210625-40000-C", currency.UnderscoreDelimiter)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ProcessTicker(&Price{
+		Pair:              p,
+		Bid:               0.051,
+		Ask:               0.053,
+		MarkPrice:         0.052,
+		ImpliedVolatility: 0.85,
+		Delta:             0.42,
+		ExchangeName:      "optionsexchange",
+		AssetType:         asset.Options,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tick, err := GetTicker("optionsexchange", p, asset.Options)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tick.MarkPrice != 0.052 || tick.ImpliedVolatility != 0.85 || tick.Delta != 0.42 {
+		t.Errorf("unexpected options ticker %+v", tick)
+	}
+	_, err = GetTicker("optionsexchange", p, asset.Futures)
+	if err == nil {
+		t.Error("expected error retrieving options ticker as futures")
+	}
+}
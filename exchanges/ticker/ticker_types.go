@@ -55,6 +55,14 @@ type Price struct {
 	AskPeriod             float64
 	AskSize               float64
 	FlashReturnRateAmount float64
+
+	// Options field variables, implied volatility is expressed as a fraction
+	MarkPrice         float64
+	ImpliedVolatility float64
+	Delta             float64
+	Gamma             float64
+	Theta             float64
+	Vega              float64
 }
 
 // Ticker struct holds the ticker information for a currency pair and type
@@ -0,0 +1,200 @@
+package trade
+
+import (
+	"fmt"
+	"math"
+	"sort"
+
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// Valid returns whether the bar type is supported
+func (b BarType) Valid() bool {
+	switch b {
+	case TickBar, VolumeBar, DollarBar, ImbalanceBar:
+		return true
+	}
+	return false
+}
+
+// NewBarBuilder returns a bar builder which closes a bar once the measure of
+// the bar type reaches the threshold
+func NewBarBuilder(barType BarType, threshold float64) (*BarBuilder, error) {
+	if !barType.Valid() {
+		return nil, fmt.Errorf("%w '%v'", ErrInvalidBarType, barType)
+	}
+	if threshold <= 0 {
+		return nil, ErrInvalidBarThreshold
+	}
+	return &BarBuilder{
+		barType:   barType,
+		threshold: threshold,
+	}, nil
+}
+
+// Add processes trades in timestamp order and returns any bars they close.
+// Trades sharing a timestamp are never split across bars, so a bar which has
+// reached its threshold is only returned once a later trade is received or
+// the builder is flushed
+func (b *BarBuilder) Add(trades ...Data) ([]Bar, error) {
+	var closed []Bar
+	for i := range trades {
+		if b.bar != nil {
+			if trades[i].Timestamp.Before(b.bar.End) {
+				return closed, fmt.Errorf("%w %v before %v", errTradeOutOfOrder, trades[i].Timestamp, b.bar.End)
+			}
+			if b.complete() && !trades[i].Timestamp.Equal(b.bar.End) {
+				closed = append(closed, *b.bar)
+				b.bar = nil
+				b.measure = 0
+			}
+		}
+		b.add(&trades[i])
+	}
+	return closed, nil
+}
+
+// Flush returns the current bar if it has reached its threshold and resets
+// the builder. A bar which has not reached its threshold is retained
+func (b *BarBuilder) Flush() (Bar, bool) {
+	if b.bar == nil || !b.complete() {
+		return Bar{}, false
+	}
+	bar := *b.bar
+	b.bar = nil
+	b.measure = 0
+	return bar, true
+}
+
+// Current returns a copy of the bar being built, if any trades have been
+// received since the last bar closed
+func (b *BarBuilder) Current() (Bar, bool) {
+	if b.bar == nil {
+		return Bar{}, false
+	}
+	return *b.bar, true
+}
+
+func (b *BarBuilder) add(t *Data) {
+	price := math.Abs(t.Price)
+	amount := math.Abs(t.Amount)
+	sign := b.tradeSign(t.Side, price)
+	if b.bar == nil {
+		b.bar = &Bar{
+			Exchange:     t.Exchange,
+			CurrencyPair: t.CurrencyPair,
+			AssetType:    t.AssetType,
+			Type:         b.barType,
+			Threshold:    b.threshold,
+			Start:        t.Timestamp,
+			Open:         price,
+			High:         price,
+			Low:          price,
+		}
+	}
+	b.bar.End = t.Timestamp
+	b.bar.Close = price
+	if price > b.bar.High {
+		b.bar.High = price
+	}
+	if price < b.bar.Low {
+		b.bar.Low = price
+	}
+	b.bar.Volume += amount
+	b.bar.Notional += price * amount
+	b.bar.Imbalance += sign * amount
+	b.bar.Trades++
+
+	switch b.barType {
+	case TickBar:
+		b.measure++
+	case VolumeBar:
+		b.measure += amount
+	case DollarBar:
+		b.measure += price * amount
+	case ImbalanceBar:
+		b.measure = math.Abs(b.bar.Imbalance)
+	}
+}
+
+func (b *BarBuilder) complete() bool {
+	return b.measure >= b.threshold
+}
+
+// tradeSign classifies a trade as a buy or sell by its side. Trades without
+// a side use the tick rule, a price rise is a buy and a fall is a sell, with
+// an unchanged price keeping the previous classification
+func (b *BarBuilder) tradeSign(side order.Side, price float64) float64 {
+	var sign float64
+	switch side {
+	case order.Buy, order.Bid:
+		sign = 1
+	case order.Sell, order.Ask:
+		sign = -1
+	default:
+		switch {
+		case b.lastPrice == 0:
+		case price > b.lastPrice:
+			sign = 1
+		case price < b.lastPrice:
+			sign = -1
+		default:
+			sign = b.lastSign
+		}
+	}
+	b.lastPrice = price
+	b.lastSign = sign
+	return sign
+}
+
+// ConvertTradesToBars samples trades into bars of the supplied type. Trades
+// remaining after the last bar which do not reach the threshold are not
+// returned
+func ConvertTradesToBars(barType BarType, threshold float64, trades ...Data) ([]Bar, error) {
+	if len(trades) == 0 {
+		return nil, ErrNoTradesSupplied
+	}
+	builder, err := NewBarBuilder(barType, threshold)
+	if err != nil {
+		return nil, err
+	}
+	sorted := make([]Data, len(trades))
+	copy(sorted, trades)
+	sort.Stable(ByDate(sorted))
+	bars, err := builder.Add(sorted...)
+	if err != nil {
+		return nil, err
+	}
+	if bar, ok := builder.Flush(); ok {
+		bars = append(bars, bar)
+	}
+	return bars, nil
+}
+
+// ConvertBarsToCandles converts bars to a kline item so they can be used
+// where candles are expected. Each candle is timestamped by its bar's first
+// trade, the interval is nominal as bars are not bound to time
+func ConvertBarsToCandles(interval kline.Interval, bars ...Bar) (kline.Item, error) {
+	if len(bars) == 0 {
+		return kline.Item{}, ErrNoBarsSupplied
+	}
+	item := kline.Item{
+		Exchange: bars[0].Exchange,
+		Pair:     bars[0].CurrencyPair,
+		Asset:    bars[0].AssetType,
+		Interval: interval,
+		Candles:  make([]kline.Candle, len(bars)),
+	}
+	for i := range bars {
+		item.Candles[i] = kline.Candle{
+			Time:   bars[i].Start,
+			Open:   bars[i].Open,
+			High:   bars[i].High,
+			Low:    bars[i].Low,
+			Close:  bars[i].Close,
+			Volume: bars[i].Volume,
+		}
+	}
+	return item, nil
+}
@@ -0,0 +1,176 @@
+package trade
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+func barTestTrades() []Data {
+	cp := currency.NewPair(currency.BTC, currency.USD)
+	start := time.Date(2021, 1, 1, 0, 0, 0, 0, time.UTC)
+	prices := []float64{10, 11, 12, 11, 10, 10, 13}
+	amounts := []float64{1, 2, 1, 3, 1, 2, 1}
+	sides := []order.Side{order.Buy, order.Buy, "", order.Sell, "", "", order.Buy}
+	trades := make([]Data, len(prices))
+	for i := range prices {
+		trades[i] = Data{
+			Exchange:     "test",
+			CurrencyPair: cp,
+			AssetType:    asset.Spot,
+			Price:        prices[i],
+			Amount:       amounts[i],
+			Side:         sides[i],
+			Timestamp:    start.Add(time.Second * time.Duration(i)),
+		}
+	}
+	return trades
+}
+
+func TestNewBarBuilder(t *testing.T) {
+	t.Parallel()
+	_, err := NewBarBuilder("renko", 1)
+	if !errors.Is(err, ErrInvalidBarType) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrInvalidBarType)
+	}
+	_, err = NewBarBuilder(TickBar, 0)
+	if !errors.Is(err, ErrInvalidBarThreshold) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrInvalidBarThreshold)
+	}
+	_, err = NewBarBuilder(TickBar, 1)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+}
+
+func TestConvertTradesToBars(t *testing.T) {
+	t.Parallel()
+	_, err := ConvertTradesToBars(TickBar, 2)
+	if !errors.Is(err, ErrNoTradesSupplied) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrNoTradesSupplied)
+	}
+
+	trades := barTestTrades()
+	bars, err := ConvertTradesToBars(TickBar, 2, trades...)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	// the seventh trade does not complete a bar
+	if len(bars) != 3 {
+		t.Fatalf("received: '%v' but expected: '%v'", len(bars), 3)
+	}
+	if bars[0].Open != 10 || bars[0].Close != 11 || bars[0].High != 11 ||
+		bars[0].Low != 10 || bars[0].Volume != 3 || bars[0].Notional != 32 ||
+		bars[0].Trades != 2 || !bars[0].Start.Equal(trades[0].Timestamp) ||
+		!bars[0].End.Equal(trades[1].Timestamp) {
+		t.Errorf("unexpected bar %+v", bars[0])
+	}
+
+	bars, err = ConvertTradesToBars(VolumeBar, 4, trades...)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if len(bars) != 2 || bars[0].Volume != 4 || bars[1].Volume != 4 {
+		t.Errorf("unexpected volume bars %+v", bars)
+	}
+
+	bars, err = ConvertTradesToBars(DollarBar, 40, trades...)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if len(bars) != 2 || bars[0].Notional != 44 || bars[1].Notional != 43 {
+		t.Errorf("unexpected dollar bars %+v", bars)
+	}
+
+	// buys of 1, 2 and a tick rule buy of 1 reach an imbalance of 4, then
+	// a sell of 3 and a tick rule sell of 1 reach an imbalance of -4
+	bars, err = ConvertTradesToBars(ImbalanceBar, 4, trades...)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if len(bars) != 2 || bars[0].Imbalance != 4 || bars[1].Imbalance != -4 || bars[1].Trades != 2 {
+		t.Errorf("unexpected imbalance bars %+v", bars)
+	}
+
+	// trades sharing a timestamp are kept in the same bar
+	trades[2].Timestamp = trades[1].Timestamp
+	bars, err = ConvertTradesToBars(TickBar, 2, trades...)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if len(bars) != 3 || bars[0].Trades != 3 || !bars[1].Start.After(bars[0].End) {
+		t.Errorf("unexpected bars for shared timestamps %+v", bars)
+	}
+}
+
+func TestBarBuilderAdd(t *testing.T) {
+	t.Parallel()
+	b, err := NewBarBuilder(TickBar, 2)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	trades := barTestTrades()
+	bars, err := b.Add(trades[:2]...)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if len(bars) != 0 {
+		t.Fatalf("received: '%v' but expected: '%v'", len(bars), 0)
+	}
+	current, ok := b.Current()
+	if !ok || current.Trades != 2 {
+		t.Errorf("unexpected current bar %+v", current)
+	}
+	bars, err = b.Add(trades[2])
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if len(bars) != 1 || bars[0].Trades != 2 {
+		t.Fatalf("unexpected closed bars %+v", bars)
+	}
+	_, err = b.Add(trades[0])
+	if !errors.Is(err, errTradeOutOfOrder) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errTradeOutOfOrder)
+	}
+	if _, ok = b.Flush(); ok {
+		t.Error("expected an incomplete bar to be retained")
+	}
+	_, err = b.Add(trades[3])
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	bar, ok := b.Flush()
+	if !ok || bar.Trades != 2 {
+		t.Errorf("unexpected flushed bar %+v", bar)
+	}
+	if _, ok = b.Current(); ok {
+		t.Error("expected no current bar after flush")
+	}
+}
+
+func TestConvertBarsToCandles(t *testing.T) {
+	t.Parallel()
+	_, err := ConvertBarsToCandles(kline.OneMin)
+	if !errors.Is(err, ErrNoBarsSupplied) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrNoBarsSupplied)
+	}
+	bars, err := ConvertTradesToBars(TickBar, 2, barTestTrades()...)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	item, err := ConvertBarsToCandles(kline.OneMin, bars...)
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if len(item.Candles) != len(bars) || item.Exchange != "test" || item.Asset != asset.Spot {
+		t.Fatalf("unexpected item %+v", item)
+	}
+	if !item.Candles[1].Time.Equal(bars[1].Start) || item.Candles[1].Close != bars[1].Close {
+		t.Errorf("unexpected candle %+v", item.Candles[1])
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"errors"
 	"fmt"
 	"sort"
+	"strings"
 	"sync"
 	"sync/atomic"
 	"time"
@@ -13,6 +14,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/database"
 	tradesql "github.com/thrasher-corp/gocryptotrader/database/repository/trade"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
@@ -27,22 +29,20 @@ func (p *Processor) setup(wg *sync.WaitGroup) {
 	go p.Run(wg)
 }
 
-// AddTradesToBuffer will push trade data onto the buffer
+// AddTradesToBuffer will push trade data onto the buffer when the database is
+// enabled, valid trades are also published to any exchange trade feed
+// subscribers
 func AddTradesToBuffer(exchangeName string, data ...Data) error {
 	cfg := database.DB.GetConfig()
-	if database.DB == nil || cfg == nil || !cfg.Enabled {
+	saveTrades := database.DB != nil && cfg != nil && cfg.Enabled
+	publish := feed.hasSubscribers(exchangeName)
+	if !saveTrades && !publish {
 		return nil
 	}
 	if len(data) == 0 {
 		return nil
 	}
 	var errs common.Errors
-	if atomic.AddInt32(&processor.started, 0) == 0 {
-		var wg sync.WaitGroup
-		wg.Add(1)
-		processor.setup(&wg)
-		wg.Wait()
-	}
 	var validDatas []Data
 	for i := range data {
 		if data[i].Price == 0 ||
@@ -75,6 +75,24 @@ func AddTradesToBuffer(exchangeName string, data ...Data) error {
 		data[i].ID = uu
 		validDatas = append(validDatas, data[i])
 	}
+	if publish {
+		if err := feed.publish(validDatas...); err != nil {
+			log.Errorf(log.Trade, "%s trade feed publish error: %v", exchangeName, err)
+		}
+	}
+
+	if !saveTrades {
+		if len(errs) > 0 {
+			return errs
+		}
+		return nil
+	}
+	if atomic.AddInt32(&processor.started, 0) == 0 {
+		var wg sync.WaitGroup
+		wg.Add(1)
+		processor.setup(&wg)
+		wg.Wait()
+	}
 	processor.mutex.Lock()
 	processor.buffer = append(processor.buffer, validDatas...)
 	processor.mutex.Unlock()
@@ -84,6 +102,63 @@ func AddTradesToBuffer(exchangeName string, data ...Data) error {
 	return nil
 }
 
+// SubscribeToExchangeTrades subscribes to public trades received for an
+// exchange, the subscription can be made before any trade is received. Trades
+// are only published for exchanges with trade processing enabled
+func SubscribeToExchangeTrades(exchange string) (dispatch.Pipe, error) {
+	if exchange == "" {
+		return dispatch.Pipe{}, errExchangeNameUnset
+	}
+	id, err := feed.getID(exchange)
+	if err != nil {
+		return dispatch.Pipe{}, err
+	}
+	return feed.mux.Subscribe(id)
+}
+
+// getID returns the dispatch ID for an exchange, creating one when required
+func (f *tradeFeed) getID(exchange string) (uuid.UUID, error) {
+	exchange = strings.ToLower(exchange)
+	f.Lock()
+	defer f.Unlock()
+	id, ok := f.exchanges[exchange]
+	if ok {
+		return id, nil
+	}
+	id, err := f.mux.GetID()
+	if err != nil {
+		return uuid.UUID{}, err
+	}
+	f.exchanges[exchange] = id
+	return id, nil
+}
+
+// hasSubscribers returns whether an exchange's feed currently has any
+// subscribers
+func (f *tradeFeed) hasSubscribers(exchange string) bool {
+	f.Lock()
+	id, ok := f.exchanges[strings.ToLower(exchange)]
+	f.Unlock()
+	return ok && f.mux.HasSubscribers(id)
+}
+
+// publish sends each trade to its exchange feed, trades for exchanges without
+// subscribers are not published
+func (f *tradeFeed) publish(trades ...Data) error {
+	for i := range trades {
+		f.Lock()
+		id, ok := f.exchanges[strings.ToLower(trades[i].Exchange)]
+		f.Unlock()
+		if !ok || !f.mux.HasSubscribers(id) {
+			continue
+		}
+		if err := f.mux.Publish([]uuid.UUID{id}, &trades[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // Run will save trade data to the database in batches
 func (p *Processor) Run(wg *sync.WaitGroup) {
 	wg.Done()
@@ -1,6 +1,7 @@
 package trade
 
 import (
+	"errors"
 	"sync"
 	"sync/atomic"
 	"testing"
@@ -11,6 +12,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/database"
 	"github.com/thrasher-corp/gocryptotrader/database/drivers"
 	sqltrade "github.com/thrasher-corp/gocryptotrader/database/repository/trade"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
@@ -98,6 +100,68 @@ func TestAddTradesToBuffer(t *testing.T) {
 	processor.mutex.Unlock()
 }
 
+func TestSubscribeToExchangeTrades(t *testing.T) {
+	t.Parallel()
+	if !dispatch.IsRunning() {
+		err := dispatch.Start(dispatch.DefaultMaxWorkers, dispatch.DefaultJobsLimit)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	_, err := SubscribeToExchangeTrades("")
+	if !errors.Is(err, errExchangeNameUnset) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errExchangeNameUnset)
+	}
+	if feed.hasSubscribers("feed") {
+		t.Fatal("expected no feed subscribers")
+	}
+	pipe, err := SubscribeToExchangeTrades("FEED")
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+	if !feed.hasSubscribers("feed") {
+		t.Fatal("expected feed subscribers")
+	}
+	defer func() {
+		if err = pipe.Release(); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	cp := currency.NewPair(currency.BTC, currency.USD)
+	// dispatch drops data when the receiver is not ready within its
+	// handshake timeout so keep publishing until the trade is received
+	timeout := time.After(time.Second * 5)
+	for {
+		err = AddTradesToBuffer("feed", Data{
+			TID:          "1337",
+			Timestamp:    time.Now(),
+			Exchange:     "feed",
+			CurrencyPair: cp,
+			AssetType:    asset.Spot,
+			Price:        -1337,
+			Amount:       1,
+		})
+		if !errors.Is(err, nil) {
+			t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+		}
+		select {
+		case data := <-pipe.C:
+			received, ok := (*data.(*interface{})).(Data)
+			if !ok {
+				t.Fatalf("received unexpected type %T", data)
+			}
+			if received.TID != "1337" || received.Price != 1337 || received.Side != order.Sell {
+				t.Fatalf("received unexpected trade %+v", received)
+			}
+			return
+		case <-timeout:
+			t.Fatal("timed out waiting for trade")
+		case <-time.After(time.Millisecond * 10):
+		}
+	}
+}
+
 func TestSqlDataToTrade(t *testing.T) {
 	t.Parallel()
 	uuiderino, _ := uuid.NewV4()
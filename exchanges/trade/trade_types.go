@@ -7,6 +7,7 @@ import (
 
 	"github.com/gofrs/uuid"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 )
@@ -17,11 +18,40 @@ const DefaultProcessorIntervalTime = time.Second * 15
 
 var (
 	processor Processor
+	feed      = &tradeFeed{
+		exchanges: make(map[string]uuid.UUID),
+		mux:       dispatch.GetNewMux(),
+	}
 	// BufferProcessorIntervalTime is the interval to save trade buffer data to the database.
 	// Change this by changing the runtime param `-tradeprocessinginterval=15s`
 	BufferProcessorIntervalTime = DefaultProcessorIntervalTime
 	// ErrNoTradesSupplied is returned when an attempt is made to process trades, but is an empty slice
 	ErrNoTradesSupplied = errors.New("no trades supplied")
+
+	// ErrNoBarsSupplied is returned when an attempt is made to convert bars,
+	// but is an empty slice
+	ErrNoBarsSupplied = errors.New("no bars supplied")
+	// ErrInvalidBarType is returned when a bar type is not supported
+	ErrInvalidBarType = errors.New("invalid bar type")
+	// ErrInvalidBarThreshold is returned when a bar threshold is not positive
+	ErrInvalidBarThreshold = errors.New("bar threshold must be greater than zero")
+
+	errExchangeNameUnset = errors.New("exchange name unset")
+	errTradeOutOfOrder   = errors.New("trade is older than the previous trade")
+)
+
+// Information-driven bar types. A bar is closed once the cumulative measure of
+// its trades reaches the bar threshold
+const (
+	// TickBar closes after a number of trades
+	TickBar BarType = "tick"
+	// VolumeBar closes after an amount of the base currency is traded
+	VolumeBar BarType = "volume"
+	// DollarBar closes after an amount of the quote currency is traded
+	DollarBar BarType = "dollar"
+	// ImbalanceBar closes once the absolute signed volume, where buys are
+	// positive and sells are negative, reaches the threshold
+	ImbalanceBar BarType = "imbalance"
 )
 
 // Data defines trade data
@@ -46,6 +76,14 @@ type Processor struct {
 	buffer                  []Data
 }
 
+// tradeFeed holds the dispatch routing information for each exchange's
+// public trades
+type tradeFeed struct {
+	exchanges map[string]uuid.UUID
+	mux       *dispatch.Mux
+	sync.Mutex
+}
+
 // ByDate sorts trades by date ascending
 type ByDate []Data
 
@@ -60,3 +98,40 @@ func (b ByDate) Less(i, j int) bool {
 func (b ByDate) Swap(i, j int) {
 	b[i], b[j] = b[j], b[i]
 }
+
+// BarType is the measure used to sample trades into bars
+type BarType string
+
+// Bar is an OHLCV bar sampled from trades by trade activity rather than by
+// time. Start and End are the timestamps of the first and last trade
+type Bar struct {
+	Exchange     string
+	CurrencyPair currency.Pair
+	AssetType    asset.Item
+	Type         BarType
+	Threshold    float64
+	Start        time.Time
+	End          time.Time
+	Open         float64
+	High         float64
+	Low          float64
+	Close        float64
+	// Volume is the traded amount of the base currency
+	Volume float64
+	// Notional is the traded amount of the quote currency
+	Notional float64
+	// Imbalance is the signed volume of the bar, buys are positive
+	Imbalance float64
+	Trades    int64
+}
+
+// BarBuilder samples a stream of trades into bars. It can be fed stored
+// trades or live trades as they are received
+type BarBuilder struct {
+	barType   BarType
+	threshold float64
+	bar       *Bar
+	measure   float64
+	lastPrice float64
+	lastSign  float64
+}
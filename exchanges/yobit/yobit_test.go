@@ -488,12 +488,12 @@ func TestWithdrawInternationalBank(t *testing.T) {
 
 func TestGetDepositAddress(t *testing.T) {
 	if areTestAPIKeysSet() {
-		_, err := y.GetDepositAddress(context.Background(), currency.BTC, "")
+		_, err := y.GetDepositAddress(context.Background(), currency.BTC, "", "")
 		if err != nil {
 			t.Error("GetDepositAddress() Expected error")
 		}
 	} else {
-		_, err := y.GetDepositAddress(context.Background(), currency.BTC, "")
+		_, err := y.GetDepositAddress(context.Background(), currency.BTC, "", "")
 		if err == nil {
 			t.Error("GetDepositAddress() error")
 		}
@@ -111,6 +111,42 @@ type GoCryptoTraderClient interface {
 	CurrencyStateDeposit(ctx context.Context, in *CurrencyStateDepositRequest, opts ...grpc.CallOption) (*GenericResponse, error)
 	CurrencyStateWithdraw(ctx context.Context, in *CurrencyStateWithdrawRequest, opts ...grpc.CallOption) (*GenericResponse, error)
 	CurrencyStateTradingPair(ctx context.Context, in *CurrencyStateTradingPairRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	GetConsolidatedOrderbook(ctx context.Context, in *GetConsolidatedOrderbookRequest, opts ...grpc.CallOption) (*ConsolidatedOrderbookResponse, error)
+	GetConsolidatedOrderbookStream(ctx context.Context, in *GetConsolidatedOrderbookRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetConsolidatedOrderbookStreamClient, error)
+	GetOrderbookAnalytics(ctx context.Context, in *GetOrderbookAnalyticsRequest, opts ...grpc.CallOption) (*OrderbookAnalyticsResponse, error)
+	GetOrderbookSpreadStatistics(ctx context.Context, in *GetOrderbookSpreadStatisticsRequest, opts ...grpc.CallOption) (*OrderbookSpreadStatisticsResponse, error)
+	GetOrderbookHistory(ctx context.Context, in *GetOrderbookHistoryRequest, opts ...grpc.CallOption) (*GetOrderbookHistoryResponse, error)
+	GetFundingRate(ctx context.Context, in *FuturesMarketDataRequest, opts ...grpc.CallOption) (*GetFundingRateResponse, error)
+	GetFundingRateHistory(ctx context.Context, in *GetFundingRateHistoryRequest, opts ...grpc.CallOption) (*GetFundingRateHistoryResponse, error)
+	GetOpenInterest(ctx context.Context, in *FuturesMarketDataRequest, opts ...grpc.CallOption) (*GetOpenInterestResponse, error)
+	GetMarkPrice(ctx context.Context, in *FuturesMarketDataRequest, opts ...grpc.CallOption) (*GetMarkPriceResponse, error)
+	GetFuturesPositions(ctx context.Context, in *FuturesMarketDataRequest, opts ...grpc.CallOption) (*GetFuturesPositionsResponse, error)
+	ChangeFuturesLeverage(ctx context.Context, in *ChangeFuturesLeverageRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	ChangeFuturesMarginType(ctx context.Context, in *ChangeFuturesMarginTypeRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	GetFuturesMarginInfo(ctx context.Context, in *FuturesMarketDataRequest, opts ...grpc.CallOption) (*GetFuturesMarginInfoResponse, error)
+	TransferFunds(ctx context.Context, in *TransferFundsRequest, opts ...grpc.CallOption) (*TransferFundsResponse, error)
+	GetMarginInterestRates(ctx context.Context, in *MarginCurrencyRequest, opts ...grpc.CallOption) (*GetMarginInterestRatesResponse, error)
+	MarginBorrow(ctx context.Context, in *MarginLoanRequest, opts ...grpc.CallOption) (*MarginLoanResponse, error)
+	MarginRepay(ctx context.Context, in *MarginLoanRequest, opts ...grpc.CallOption) (*MarginLoanResponse, error)
+	SubmitMarginLendOffer(ctx context.Context, in *SubmitMarginLendOfferRequest, opts ...grpc.CallOption) (*MarginLoanResponse, error)
+	CancelMarginLendOffer(ctx context.Context, in *CancelMarginLendOfferRequest, opts ...grpc.CallOption) (*GenericResponse, error)
+	GetMarginLoans(ctx context.Context, in *MarginCurrencyRequest, opts ...grpc.CallOption) (*GetMarginLoansResponse, error)
+	GetMarginInterestHistory(ctx context.Context, in *GetMarginInterestHistoryRequest, opts ...grpc.CallOption) (*GetMarginInterestHistoryResponse, error)
+	GetFeeSchedule(ctx context.Context, in *GetFeeScheduleRequest, opts ...grpc.CallOption) (*GetFeeScheduleResponse, error)
+	GetAvailableTransferChains(ctx context.Context, in *GetAvailableTransferChainsRequest, opts ...grpc.CallOption) (*GetAvailableTransferChainsResponse, error)
+	SubmitBatchOrders(ctx context.Context, in *SubmitBatchOrdersRequest, opts ...grpc.CallOption) (*SubmitBatchOrdersResponse, error)
+	ModifyBatchOrders(ctx context.Context, in *ModifyBatchOrdersRequest, opts ...grpc.CallOption) (*ModifyBatchOrdersResponse, error)
+	GetFillStream(ctx context.Context, in *GetFillStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetFillStreamClient, error)
+	GetExchangeCapabilities(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GetExchangeCapabilitiesResponse, error)
+	ExportData(ctx context.Context, in *ExportDataRequest, opts ...grpc.CallOption) (*ExportDataResponse, error)
+	UpsertDataHistoryJobsForEnabledPairs(ctx context.Context, in *UpsertDataHistoryJobRequest, opts ...grpc.CallOption) (*UpsertDataHistoryJobsResponse, error)
+	GetDataQualityReport(ctx context.Context, in *GetDataQualityReportRequest, opts ...grpc.CallOption) (*GetDataQualityReportResponse, error)
+	GetCompositeIndexPrices(ctx context.Context, in *GetCompositeIndexPricesRequest, opts ...grpc.CallOption) (*GetCompositeIndexPricesResponse, error)
+	GetLiveCandleStream(ctx context.Context, in *GetLiveCandleStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetLiveCandleStreamClient, error)
+	RunRetentionPolicies(ctx context.Context, in *RunRetentionPoliciesRequest, opts ...grpc.CallOption) (*RunRetentionPoliciesResponse, error)
+	ConvertTradesToBars(ctx context.Context, in *ConvertTradesToBarsRequest, opts ...grpc.CallOption) (*ConvertTradesToBarsResponse, error)
+	GetSymbolHistory(ctx context.Context, in *GetSymbolHistoryRequest, opts ...grpc.CallOption) (*GetSymbolHistoryResponse, error)
+	AddSymbolAlias(ctx context.Context, in *AddSymbolAliasRequest, opts ...grpc.CallOption) (*GenericResponse, error)
 }
 
 type goCryptoTraderClient struct {
@@ -1096,2090 +1132,3284 @@ func (c *goCryptoTraderClient) CurrencyStateTradingPair(ctx context.Context, in
 	return out, nil
 }
 
-// GoCryptoTraderServer is the server API for GoCryptoTrader service.
-// All implementations must embed UnimplementedGoCryptoTraderServer
-// for forward compatibility
-type GoCryptoTraderServer interface {
-	GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error)
-	GetSubsystems(context.Context, *GetSubsystemsRequest) (*GetSusbsytemsResponse, error)
-	EnableSubsystem(context.Context, *GenericSubsystemRequest) (*GenericResponse, error)
-	DisableSubsystem(context.Context, *GenericSubsystemRequest) (*GenericResponse, error)
-	GetRPCEndpoints(context.Context, *GetRPCEndpointsRequest) (*GetRPCEndpointsResponse, error)
-	GetCommunicationRelayers(context.Context, *GetCommunicationRelayersRequest) (*GetCommunicationRelayersResponse, error)
-	GetExchanges(context.Context, *GetExchangesRequest) (*GetExchangesResponse, error)
-	DisableExchange(context.Context, *GenericExchangeNameRequest) (*GenericResponse, error)
-	GetExchangeInfo(context.Context, *GenericExchangeNameRequest) (*GetExchangeInfoResponse, error)
-	GetExchangeOTPCode(context.Context, *GenericExchangeNameRequest) (*GetExchangeOTPResponse, error)
-	GetExchangeOTPCodes(context.Context, *GetExchangeOTPsRequest) (*GetExchangeOTPsResponse, error)
-	EnableExchange(context.Context, *GenericExchangeNameRequest) (*GenericResponse, error)
-	GetTicker(context.Context, *GetTickerRequest) (*TickerResponse, error)
-	GetTickers(context.Context, *GetTickersRequest) (*GetTickersResponse, error)
-	GetOrderbook(context.Context, *GetOrderbookRequest) (*OrderbookResponse, error)
-	GetOrderbooks(context.Context, *GetOrderbooksRequest) (*GetOrderbooksResponse, error)
-	GetAccountInfo(context.Context, *GetAccountInfoRequest) (*GetAccountInfoResponse, error)
-	UpdateAccountInfo(context.Context, *GetAccountInfoRequest) (*GetAccountInfoResponse, error)
-	GetAccountInfoStream(*GetAccountInfoRequest, GoCryptoTrader_GetAccountInfoStreamServer) error
-	GetConfig(context.Context, *GetConfigRequest) (*GetConfigResponse, error)
-	GetPortfolio(context.Context, *GetPortfolioRequest) (*GetPortfolioResponse, error)
-	GetPortfolioSummary(context.Context, *GetPortfolioSummaryRequest) (*GetPortfolioSummaryResponse, error)
-	AddPortfolioAddress(context.Context, *AddPortfolioAddressRequest) (*GenericResponse, error)
-	RemovePortfolioAddress(context.Context, *RemovePortfolioAddressRequest) (*GenericResponse, error)
-	GetForexProviders(context.Context, *GetForexProvidersRequest) (*GetForexProvidersResponse, error)
-	GetForexRates(context.Context, *GetForexRatesRequest) (*GetForexRatesResponse, error)
-	GetOrders(context.Context, *GetOrdersRequest) (*GetOrdersResponse, error)
-	GetOrder(context.Context, *GetOrderRequest) (*OrderDetails, error)
-	SubmitOrder(context.Context, *SubmitOrderRequest) (*SubmitOrderResponse, error)
-	SimulateOrder(context.Context, *SimulateOrderRequest) (*SimulateOrderResponse, error)
-	WhaleBomb(context.Context, *WhaleBombRequest) (*SimulateOrderResponse, error)
-	CancelOrder(context.Context, *CancelOrderRequest) (*GenericResponse, error)
-	CancelBatchOrders(context.Context, *CancelBatchOrdersRequest) (*CancelBatchOrdersResponse, error)
-	CancelAllOrders(context.Context, *CancelAllOrdersRequest) (*CancelAllOrdersResponse, error)
-	GetEvents(context.Context, *GetEventsRequest) (*GetEventsResponse, error)
-	AddEvent(context.Context, *AddEventRequest) (*AddEventResponse, error)
-	RemoveEvent(context.Context, *RemoveEventRequest) (*GenericResponse, error)
-	GetCryptocurrencyDepositAddresses(context.Context, *GetCryptocurrencyDepositAddressesRequest) (*GetCryptocurrencyDepositAddressesResponse, error)
-	GetCryptocurrencyDepositAddress(context.Context, *GetCryptocurrencyDepositAddressRequest) (*GetCryptocurrencyDepositAddressResponse, error)
-	WithdrawFiatFunds(context.Context, *WithdrawFiatRequest) (*WithdrawResponse, error)
-	WithdrawCryptocurrencyFunds(context.Context, *WithdrawCryptoRequest) (*WithdrawResponse, error)
-	WithdrawalEventByID(context.Context, *WithdrawalEventByIDRequest) (*WithdrawalEventByIDResponse, error)
-	WithdrawalEventsByExchange(context.Context, *WithdrawalEventsByExchangeRequest) (*WithdrawalEventsByExchangeResponse, error)
-	WithdrawalEventsByDate(context.Context, *WithdrawalEventsByDateRequest) (*WithdrawalEventsByExchangeResponse, error)
-	GetLoggerDetails(context.Context, *GetLoggerDetailsRequest) (*GetLoggerDetailsResponse, error)
-	SetLoggerDetails(context.Context, *SetLoggerDetailsRequest) (*GetLoggerDetailsResponse, error)
-	GetExchangePairs(context.Context, *GetExchangePairsRequest) (*GetExchangePairsResponse, error)
-	SetExchangePair(context.Context, *SetExchangePairRequest) (*GenericResponse, error)
-	GetOrderbookStream(*GetOrderbookStreamRequest, GoCryptoTrader_GetOrderbookStreamServer) error
-	GetExchangeOrderbookStream(*GetExchangeOrderbookStreamRequest, GoCryptoTrader_GetExchangeOrderbookStreamServer) error
-	GetTickerStream(*GetTickerStreamRequest, GoCryptoTrader_GetTickerStreamServer) error
-	GetExchangeTickerStream(*GetExchangeTickerStreamRequest, GoCryptoTrader_GetExchangeTickerStreamServer) error
-	GetAuditEvent(context.Context, *GetAuditEventRequest) (*GetAuditEventResponse, error)
-	GCTScriptExecute(context.Context, *GCTScriptExecuteRequest) (*GenericResponse, error)
-	GCTScriptUpload(context.Context, *GCTScriptUploadRequest) (*GenericResponse, error)
-	GCTScriptReadScript(context.Context, *GCTScriptReadScriptRequest) (*GCTScriptQueryResponse, error)
-	GCTScriptStatus(context.Context, *GCTScriptStatusRequest) (*GCTScriptStatusResponse, error)
-	GCTScriptQuery(context.Context, *GCTScriptQueryRequest) (*GCTScriptQueryResponse, error)
-	GCTScriptStop(context.Context, *GCTScriptStopRequest) (*GenericResponse, error)
-	GCTScriptStopAll(context.Context, *GCTScriptStopAllRequest) (*GenericResponse, error)
-	GCTScriptListAll(context.Context, *GCTScriptListAllRequest) (*GCTScriptStatusResponse, error)
-	GCTScriptAutoLoadToggle(context.Context, *GCTScriptAutoLoadRequest) (*GenericResponse, error)
-	GetHistoricCandles(context.Context, *GetHistoricCandlesRequest) (*GetHistoricCandlesResponse, error)
-	SetExchangeAsset(context.Context, *SetExchangeAssetRequest) (*GenericResponse, error)
-	SetAllExchangePairs(context.Context, *SetExchangeAllPairsRequest) (*GenericResponse, error)
-	UpdateExchangeSupportedPairs(context.Context, *UpdateExchangeSupportedPairsRequest) (*GenericResponse, error)
-	GetExchangeAssets(context.Context, *GetExchangeAssetsRequest) (*GetExchangeAssetsResponse, error)
-	WebsocketGetInfo(context.Context, *WebsocketGetInfoRequest) (*WebsocketGetInfoResponse, error)
-	WebsocketSetEnabled(context.Context, *WebsocketSetEnabledRequest) (*GenericResponse, error)
-	WebsocketGetSubscriptions(context.Context, *WebsocketGetSubscriptionsRequest) (*WebsocketGetSubscriptionsResponse, error)
-	WebsocketSetProxy(context.Context, *WebsocketSetProxyRequest) (*GenericResponse, error)
-	WebsocketSetURL(context.Context, *WebsocketSetURLRequest) (*GenericResponse, error)
-	GetRecentTrades(context.Context, *GetSavedTradesRequest) (*SavedTradesResponse, error)
-	GetHistoricTrades(*GetSavedTradesRequest, GoCryptoTrader_GetHistoricTradesServer) error
-	GetSavedTrades(context.Context, *GetSavedTradesRequest) (*SavedTradesResponse, error)
-	ConvertTradesToCandles(context.Context, *ConvertTradesToCandlesRequest) (*GetHistoricCandlesResponse, error)
-	FindMissingSavedCandleIntervals(context.Context, *FindMissingCandlePeriodsRequest) (*FindMissingIntervalsResponse, error)
-	FindMissingSavedTradeIntervals(context.Context, *FindMissingTradePeriodsRequest) (*FindMissingIntervalsResponse, error)
-	SetExchangeTradeProcessing(context.Context, *SetExchangeTradeProcessingRequest) (*GenericResponse, error)
-	UpsertDataHistoryJob(context.Context, *UpsertDataHistoryJobRequest) (*UpsertDataHistoryJobResponse, error)
-	GetDataHistoryJobDetails(context.Context, *GetDataHistoryJobDetailsRequest) (*DataHistoryJob, error)
-	GetActiveDataHistoryJobs(context.Context, *GetInfoRequest) (*DataHistoryJobs, error)
-	GetDataHistoryJobsBetween(context.Context, *GetDataHistoryJobsBetweenRequest) (*DataHistoryJobs, error)
-	GetDataHistoryJobSummary(context.Context, *GetDataHistoryJobDetailsRequest) (*DataHistoryJob, error)
-	SetDataHistoryJobStatus(context.Context, *SetDataHistoryJobStatusRequest) (*GenericResponse, error)
-	UpdateDataHistoryJobPrerequisite(context.Context, *UpdateDataHistoryJobPrerequisiteRequest) (*GenericResponse, error)
-	GetManagedOrders(context.Context, *GetOrdersRequest) (*GetOrdersResponse, error)
-	ModifyOrder(context.Context, *ModifyOrderRequest) (*ModifyOrderResponse, error)
-	CurrencyStateGetAll(context.Context, *CurrencyStateGetAllRequest) (*CurrencyStateResponse, error)
-	CurrencyStateTrading(context.Context, *CurrencyStateTradingRequest) (*GenericResponse, error)
-	CurrencyStateDeposit(context.Context, *CurrencyStateDepositRequest) (*GenericResponse, error)
-	CurrencyStateWithdraw(context.Context, *CurrencyStateWithdrawRequest) (*GenericResponse, error)
-	CurrencyStateTradingPair(context.Context, *CurrencyStateTradingPairRequest) (*GenericResponse, error)
-	mustEmbedUnimplementedGoCryptoTraderServer()
+func (c *goCryptoTraderClient) GetConsolidatedOrderbook(ctx context.Context, in *GetConsolidatedOrderbookRequest, opts ...grpc.CallOption) (*ConsolidatedOrderbookResponse, error) {
+	out := new(ConsolidatedOrderbookResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetConsolidatedOrderbook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-// UnimplementedGoCryptoTraderServer must be embedded to have forward compatible implementations.
-type UnimplementedGoCryptoTraderServer struct {
+func (c *goCryptoTraderClient) GetConsolidatedOrderbookStream(ctx context.Context, in *GetConsolidatedOrderbookRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetConsolidatedOrderbookStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GoCryptoTrader_ServiceDesc.Streams[6], "/gctrpc.GoCryptoTrader/GetConsolidatedOrderbookStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &goCryptoTraderGetConsolidatedOrderbookStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
 
-func (UnimplementedGoCryptoTraderServer) GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetInfo not implemented")
-}
-func (UnimplementedGoCryptoTraderServer) GetSubsystems(context.Context, *GetSubsystemsRequest) (*GetSusbsytemsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSubsystems not implemented")
-}
-func (UnimplementedGoCryptoTraderServer) EnableSubsystem(context.Context, *GenericSubsystemRequest) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EnableSubsystem not implemented")
+type GoCryptoTrader_GetConsolidatedOrderbookStreamClient interface {
+	Recv() (*ConsolidatedOrderbookResponse, error)
+	grpc.ClientStream
 }
-func (UnimplementedGoCryptoTraderServer) DisableSubsystem(context.Context, *GenericSubsystemRequest) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DisableSubsystem not implemented")
+
+type goCryptoTraderGetConsolidatedOrderbookStreamClient struct {
+	grpc.ClientStream
 }
-func (UnimplementedGoCryptoTraderServer) GetRPCEndpoints(context.Context, *GetRPCEndpointsRequest) (*GetRPCEndpointsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRPCEndpoints not implemented")
+
+func (x *goCryptoTraderGetConsolidatedOrderbookStreamClient) Recv() (*ConsolidatedOrderbookResponse, error) {
+	m := new(ConsolidatedOrderbookResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
-func (UnimplementedGoCryptoTraderServer) GetCommunicationRelayers(context.Context, *GetCommunicationRelayersRequest) (*GetCommunicationRelayersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCommunicationRelayers not implemented")
+
+func (c *goCryptoTraderClient) GetOrderbookAnalytics(ctx context.Context, in *GetOrderbookAnalyticsRequest, opts ...grpc.CallOption) (*OrderbookAnalyticsResponse, error) {
+	out := new(OrderbookAnalyticsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetOrderbookAnalytics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServer) GetExchanges(context.Context, *GetExchangesRequest) (*GetExchangesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetExchanges not implemented")
+
+func (c *goCryptoTraderClient) GetOrderbookSpreadStatistics(ctx context.Context, in *GetOrderbookSpreadStatisticsRequest, opts ...grpc.CallOption) (*OrderbookSpreadStatisticsResponse, error) {
+	out := new(OrderbookSpreadStatisticsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetOrderbookSpreadStatistics", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServer) DisableExchange(context.Context, *GenericExchangeNameRequest) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DisableExchange not implemented")
+
+func (c *goCryptoTraderClient) GetOrderbookHistory(ctx context.Context, in *GetOrderbookHistoryRequest, opts ...grpc.CallOption) (*GetOrderbookHistoryResponse, error) {
+	out := new(GetOrderbookHistoryResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetOrderbookHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServer) GetExchangeInfo(context.Context, *GenericExchangeNameRequest) (*GetExchangeInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetExchangeInfo not implemented")
+
+func (c *goCryptoTraderClient) GetFundingRate(ctx context.Context, in *FuturesMarketDataRequest, opts ...grpc.CallOption) (*GetFundingRateResponse, error) {
+	out := new(GetFundingRateResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetFundingRate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServer) GetExchangeOTPCode(context.Context, *GenericExchangeNameRequest) (*GetExchangeOTPResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetExchangeOTPCode not implemented")
+
+func (c *goCryptoTraderClient) GetFundingRateHistory(ctx context.Context, in *GetFundingRateHistoryRequest, opts ...grpc.CallOption) (*GetFundingRateHistoryResponse, error) {
+	out := new(GetFundingRateHistoryResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetFundingRateHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServer) GetExchangeOTPCodes(context.Context, *GetExchangeOTPsRequest) (*GetExchangeOTPsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetExchangeOTPCodes not implemented")
+
+func (c *goCryptoTraderClient) GetOpenInterest(ctx context.Context, in *FuturesMarketDataRequest, opts ...grpc.CallOption) (*GetOpenInterestResponse, error) {
+	out := new(GetOpenInterestResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetOpenInterest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServer) EnableExchange(context.Context, *GenericExchangeNameRequest) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EnableExchange not implemented")
+
+func (c *goCryptoTraderClient) GetMarkPrice(ctx context.Context, in *FuturesMarketDataRequest, opts ...grpc.CallOption) (*GetMarkPriceResponse, error) {
+	out := new(GetMarkPriceResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetMarkPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServer) GetTicker(context.Context, *GetTickerRequest) (*TickerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTicker not implemented")
+
+func (c *goCryptoTraderClient) GetFuturesPositions(ctx context.Context, in *FuturesMarketDataRequest, opts ...grpc.CallOption) (*GetFuturesPositionsResponse, error) {
+	out := new(GetFuturesPositionsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetFuturesPositions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServer) GetTickers(context.Context, *GetTickersRequest) (*GetTickersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTickers not implemented")
+
+func (c *goCryptoTraderClient) ChangeFuturesLeverage(ctx context.Context, in *ChangeFuturesLeverageRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/ChangeFuturesLeverage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServer) GetOrderbook(context.Context, *GetOrderbookRequest) (*OrderbookResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOrderbook not implemented")
+
+func (c *goCryptoTraderClient) ChangeFuturesMarginType(ctx context.Context, in *ChangeFuturesMarginTypeRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/ChangeFuturesMarginType", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServer) GetOrderbooks(context.Context, *GetOrderbooksRequest) (*GetOrderbooksResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOrderbooks not implemented")
+
+func (c *goCryptoTraderClient) GetFuturesMarginInfo(ctx context.Context, in *FuturesMarketDataRequest, opts ...grpc.CallOption) (*GetFuturesMarginInfoResponse, error) {
+	out := new(GetFuturesMarginInfoResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetFuturesMarginInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServer) GetAccountInfo(context.Context, *GetAccountInfoRequest) (*GetAccountInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAccountInfo not implemented")
+
+func (c *goCryptoTraderClient) TransferFunds(ctx context.Context, in *TransferFundsRequest, opts ...grpc.CallOption) (*TransferFundsResponse, error) {
+	out := new(TransferFundsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/TransferFunds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServer) UpdateAccountInfo(context.Context, *GetAccountInfoRequest) (*GetAccountInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateAccountInfo not implemented")
+
+func (c *goCryptoTraderClient) GetMarginInterestRates(ctx context.Context, in *MarginCurrencyRequest, opts ...grpc.CallOption) (*GetMarginInterestRatesResponse, error) {
+	out := new(GetMarginInterestRatesResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetMarginInterestRates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServer) GetAccountInfoStream(*GetAccountInfoRequest, GoCryptoTrader_GetAccountInfoStreamServer) error {
-	return status.Errorf(codes.Unimplemented, "method GetAccountInfoStream not implemented")
+
+func (c *goCryptoTraderClient) MarginBorrow(ctx context.Context, in *MarginLoanRequest, opts ...grpc.CallOption) (*MarginLoanResponse, error) {
+	out := new(MarginLoanResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/MarginBorrow", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServer) GetConfig(context.Context, *GetConfigRequest) (*GetConfigResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetConfig not implemented")
+
+func (c *goCryptoTraderClient) MarginRepay(ctx context.Context, in *MarginLoanRequest, opts ...grpc.CallOption) (*MarginLoanResponse, error) {
+	out := new(MarginLoanResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/MarginRepay", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServer) GetPortfolio(context.Context, *GetPortfolioRequest) (*GetPortfolioResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPortfolio not implemented")
+
+func (c *goCryptoTraderClient) SubmitMarginLendOffer(ctx context.Context, in *SubmitMarginLendOfferRequest, opts ...grpc.CallOption) (*MarginLoanResponse, error) {
+	out := new(MarginLoanResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/SubmitMarginLendOffer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServer) GetPortfolioSummary(context.Context, *GetPortfolioSummaryRequest) (*GetPortfolioSummaryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPortfolioSummary not implemented")
+
+func (c *goCryptoTraderClient) CancelMarginLendOffer(ctx context.Context, in *CancelMarginLendOfferRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/CancelMarginLendOffer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServer) AddPortfolioAddress(context.Context, *AddPortfolioAddressRequest) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddPortfolioAddress not implemented")
-}
-func (UnimplementedGoCryptoTraderServer) RemovePortfolioAddress(context.Context, *RemovePortfolioAddressRequest) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemovePortfolioAddress not implemented")
-}
-func (UnimplementedGoCryptoTraderServer) GetForexProviders(context.Context, *GetForexProvidersRequest) (*GetForexProvidersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetForexProviders not implemented")
-}
-func (UnimplementedGoCryptoTraderServer) GetForexRates(context.Context, *GetForexRatesRequest) (*GetForexRatesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetForexRates not implemented")
-}
-func (UnimplementedGoCryptoTraderServer) GetOrders(context.Context, *GetOrdersRequest) (*GetOrdersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOrders not implemented")
-}
-func (UnimplementedGoCryptoTraderServer) GetOrder(context.Context, *GetOrderRequest) (*OrderDetails, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOrder not implemented")
-}
-func (UnimplementedGoCryptoTraderServer) SubmitOrder(context.Context, *SubmitOrderRequest) (*SubmitOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitOrder not implemented")
-}
-func (UnimplementedGoCryptoTraderServer) SimulateOrder(context.Context, *SimulateOrderRequest) (*SimulateOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SimulateOrder not implemented")
-}
-func (UnimplementedGoCryptoTraderServer) WhaleBomb(context.Context, *WhaleBombRequest) (*SimulateOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WhaleBomb not implemented")
-}
-func (UnimplementedGoCryptoTraderServer) CancelOrder(context.Context, *CancelOrderRequest) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
-}
-func (UnimplementedGoCryptoTraderServer) CancelBatchOrders(context.Context, *CancelBatchOrdersRequest) (*CancelBatchOrdersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelBatchOrders not implemented")
-}
-func (UnimplementedGoCryptoTraderServer) CancelAllOrders(context.Context, *CancelAllOrdersRequest) (*CancelAllOrdersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelAllOrders not implemented")
-}
-func (UnimplementedGoCryptoTraderServer) GetEvents(context.Context, *GetEventsRequest) (*GetEventsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetEvents not implemented")
-}
-func (UnimplementedGoCryptoTraderServer) AddEvent(context.Context, *AddEventRequest) (*AddEventResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddEvent not implemented")
-}
-func (UnimplementedGoCryptoTraderServer) RemoveEvent(context.Context, *RemoveEventRequest) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveEvent not implemented")
-}
-func (UnimplementedGoCryptoTraderServer) GetCryptocurrencyDepositAddresses(context.Context, *GetCryptocurrencyDepositAddressesRequest) (*GetCryptocurrencyDepositAddressesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCryptocurrencyDepositAddresses not implemented")
-}
-func (UnimplementedGoCryptoTraderServer) GetCryptocurrencyDepositAddress(context.Context, *GetCryptocurrencyDepositAddressRequest) (*GetCryptocurrencyDepositAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCryptocurrencyDepositAddress not implemented")
-}
-func (UnimplementedGoCryptoTraderServer) WithdrawFiatFunds(context.Context, *WithdrawFiatRequest) (*WithdrawResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WithdrawFiatFunds not implemented")
-}
-func (UnimplementedGoCryptoTraderServer) WithdrawCryptocurrencyFunds(context.Context, *WithdrawCryptoRequest) (*WithdrawResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WithdrawCryptocurrencyFunds not implemented")
-}
-func (UnimplementedGoCryptoTraderServer) WithdrawalEventByID(context.Context, *WithdrawalEventByIDRequest) (*WithdrawalEventByIDResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WithdrawalEventByID not implemented")
-}
-func (UnimplementedGoCryptoTraderServer) WithdrawalEventsByExchange(context.Context, *WithdrawalEventsByExchangeRequest) (*WithdrawalEventsByExchangeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WithdrawalEventsByExchange not implemented")
-}
-func (UnimplementedGoCryptoTraderServer) WithdrawalEventsByDate(context.Context, *WithdrawalEventsByDateRequest) (*WithdrawalEventsByExchangeResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WithdrawalEventsByDate not implemented")
-}
-func (UnimplementedGoCryptoTraderServer) GetLoggerDetails(context.Context, *GetLoggerDetailsRequest) (*GetLoggerDetailsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetLoggerDetails not implemented")
-}
-func (UnimplementedGoCryptoTraderServer) SetLoggerDetails(context.Context, *SetLoggerDetailsRequest) (*GetLoggerDetailsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetLoggerDetails not implemented")
-}
-func (UnimplementedGoCryptoTraderServer) GetExchangePairs(context.Context, *GetExchangePairsRequest) (*GetExchangePairsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetExchangePairs not implemented")
-}
-func (UnimplementedGoCryptoTraderServer) SetExchangePair(context.Context, *SetExchangePairRequest) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetExchangePair not implemented")
-}
-func (UnimplementedGoCryptoTraderServer) GetOrderbookStream(*GetOrderbookStreamRequest, GoCryptoTrader_GetOrderbookStreamServer) error {
-	return status.Errorf(codes.Unimplemented, "method GetOrderbookStream not implemented")
-}
-func (UnimplementedGoCryptoTraderServer) GetExchangeOrderbookStream(*GetExchangeOrderbookStreamRequest, GoCryptoTrader_GetExchangeOrderbookStreamServer) error {
-	return status.Errorf(codes.Unimplemented, "method GetExchangeOrderbookStream not implemented")
-}
-func (UnimplementedGoCryptoTraderServer) GetTickerStream(*GetTickerStreamRequest, GoCryptoTrader_GetTickerStreamServer) error {
-	return status.Errorf(codes.Unimplemented, "method GetTickerStream not implemented")
-}
-func (UnimplementedGoCryptoTraderServer) GetExchangeTickerStream(*GetExchangeTickerStreamRequest, GoCryptoTrader_GetExchangeTickerStreamServer) error {
-	return status.Errorf(codes.Unimplemented, "method GetExchangeTickerStream not implemented")
-}
-func (UnimplementedGoCryptoTraderServer) GetAuditEvent(context.Context, *GetAuditEventRequest) (*GetAuditEventResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAuditEvent not implemented")
-}
-func (UnimplementedGoCryptoTraderServer) GCTScriptExecute(context.Context, *GCTScriptExecuteRequest) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptExecute not implemented")
+
+func (c *goCryptoTraderClient) GetMarginLoans(ctx context.Context, in *MarginCurrencyRequest, opts ...grpc.CallOption) (*GetMarginLoansResponse, error) {
+	out := new(GetMarginLoansResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetMarginLoans", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServer) GCTScriptUpload(context.Context, *GCTScriptUploadRequest) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptUpload not implemented")
+
+func (c *goCryptoTraderClient) GetMarginInterestHistory(ctx context.Context, in *GetMarginInterestHistoryRequest, opts ...grpc.CallOption) (*GetMarginInterestHistoryResponse, error) {
+	out := new(GetMarginInterestHistoryResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetMarginInterestHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServer) GCTScriptReadScript(context.Context, *GCTScriptReadScriptRequest) (*GCTScriptQueryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptReadScript not implemented")
+
+func (c *goCryptoTraderClient) GetFeeSchedule(ctx context.Context, in *GetFeeScheduleRequest, opts ...grpc.CallOption) (*GetFeeScheduleResponse, error) {
+	out := new(GetFeeScheduleResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetFeeSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServer) GCTScriptStatus(context.Context, *GCTScriptStatusRequest) (*GCTScriptStatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptStatus not implemented")
+
+func (c *goCryptoTraderClient) GetAvailableTransferChains(ctx context.Context, in *GetAvailableTransferChainsRequest, opts ...grpc.CallOption) (*GetAvailableTransferChainsResponse, error) {
+	out := new(GetAvailableTransferChainsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetAvailableTransferChains", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServer) GCTScriptQuery(context.Context, *GCTScriptQueryRequest) (*GCTScriptQueryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptQuery not implemented")
+
+func (c *goCryptoTraderClient) SubmitBatchOrders(ctx context.Context, in *SubmitBatchOrdersRequest, opts ...grpc.CallOption) (*SubmitBatchOrdersResponse, error) {
+	out := new(SubmitBatchOrdersResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/SubmitBatchOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServer) GCTScriptStop(context.Context, *GCTScriptStopRequest) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptStop not implemented")
+
+func (c *goCryptoTraderClient) ModifyBatchOrders(ctx context.Context, in *ModifyBatchOrdersRequest, opts ...grpc.CallOption) (*ModifyBatchOrdersResponse, error) {
+	out := new(ModifyBatchOrdersResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/ModifyBatchOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServer) GCTScriptStopAll(context.Context, *GCTScriptStopAllRequest) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptStopAll not implemented")
+
+func (c *goCryptoTraderClient) GetFillStream(ctx context.Context, in *GetFillStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetFillStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GoCryptoTrader_ServiceDesc.Streams[7], "/gctrpc.GoCryptoTrader/GetFillStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &goCryptoTraderGetFillStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (UnimplementedGoCryptoTraderServer) GCTScriptListAll(context.Context, *GCTScriptListAllRequest) (*GCTScriptStatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptListAll not implemented")
+
+type GoCryptoTrader_GetFillStreamClient interface {
+	Recv() (*FillResponse, error)
+	grpc.ClientStream
 }
-func (UnimplementedGoCryptoTraderServer) GCTScriptAutoLoadToggle(context.Context, *GCTScriptAutoLoadRequest) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptAutoLoadToggle not implemented")
+
+type goCryptoTraderGetFillStreamClient struct {
+	grpc.ClientStream
 }
-func (UnimplementedGoCryptoTraderServer) GetHistoricCandles(context.Context, *GetHistoricCandlesRequest) (*GetHistoricCandlesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetHistoricCandles not implemented")
+
+func (x *goCryptoTraderGetFillStreamClient) Recv() (*FillResponse, error) {
+	m := new(FillResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
-func (UnimplementedGoCryptoTraderServer) SetExchangeAsset(context.Context, *SetExchangeAssetRequest) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetExchangeAsset not implemented")
+
+func (c *goCryptoTraderClient) GetExchangeCapabilities(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GetExchangeCapabilitiesResponse, error) {
+	out := new(GetExchangeCapabilitiesResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetExchangeCapabilities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServer) SetAllExchangePairs(context.Context, *SetExchangeAllPairsRequest) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetAllExchangePairs not implemented")
+
+func (c *goCryptoTraderClient) ExportData(ctx context.Context, in *ExportDataRequest, opts ...grpc.CallOption) (*ExportDataResponse, error) {
+	out := new(ExportDataResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/ExportData", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServer) UpdateExchangeSupportedPairs(context.Context, *UpdateExchangeSupportedPairsRequest) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateExchangeSupportedPairs not implemented")
+
+func (c *goCryptoTraderClient) UpsertDataHistoryJobsForEnabledPairs(ctx context.Context, in *UpsertDataHistoryJobRequest, opts ...grpc.CallOption) (*UpsertDataHistoryJobsResponse, error) {
+	out := new(UpsertDataHistoryJobsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/UpsertDataHistoryJobsForEnabledPairs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServer) GetExchangeAssets(context.Context, *GetExchangeAssetsRequest) (*GetExchangeAssetsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetExchangeAssets not implemented")
+
+func (c *goCryptoTraderClient) GetDataQualityReport(ctx context.Context, in *GetDataQualityReportRequest, opts ...grpc.CallOption) (*GetDataQualityReportResponse, error) {
+	out := new(GetDataQualityReportResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetDataQualityReport", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServer) WebsocketGetInfo(context.Context, *WebsocketGetInfoRequest) (*WebsocketGetInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WebsocketGetInfo not implemented")
+
+func (c *goCryptoTraderClient) GetCompositeIndexPrices(ctx context.Context, in *GetCompositeIndexPricesRequest, opts ...grpc.CallOption) (*GetCompositeIndexPricesResponse, error) {
+	out := new(GetCompositeIndexPricesResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetCompositeIndexPrices", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServer) WebsocketSetEnabled(context.Context, *WebsocketSetEnabledRequest) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WebsocketSetEnabled not implemented")
+
+func (c *goCryptoTraderClient) GetLiveCandleStream(ctx context.Context, in *GetLiveCandleStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetLiveCandleStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &GoCryptoTrader_ServiceDesc.Streams[8], "/gctrpc.GoCryptoTrader/GetLiveCandleStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &goCryptoTraderGetLiveCandleStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
 }
-func (UnimplementedGoCryptoTraderServer) WebsocketGetSubscriptions(context.Context, *WebsocketGetSubscriptionsRequest) (*WebsocketGetSubscriptionsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WebsocketGetSubscriptions not implemented")
+
+type GoCryptoTrader_GetLiveCandleStreamClient interface {
+	Recv() (*LiveCandleResponse, error)
+	grpc.ClientStream
 }
-func (UnimplementedGoCryptoTraderServer) WebsocketSetProxy(context.Context, *WebsocketSetProxyRequest) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WebsocketSetProxy not implemented")
+
+type goCryptoTraderGetLiveCandleStreamClient struct {
+	grpc.ClientStream
 }
-func (UnimplementedGoCryptoTraderServer) WebsocketSetURL(context.Context, *WebsocketSetURLRequest) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WebsocketSetURL not implemented")
+
+func (x *goCryptoTraderGetLiveCandleStreamClient) Recv() (*LiveCandleResponse, error) {
+	m := new(LiveCandleResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
-func (UnimplementedGoCryptoTraderServer) GetRecentTrades(context.Context, *GetSavedTradesRequest) (*SavedTradesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRecentTrades not implemented")
+
+func (c *goCryptoTraderClient) RunRetentionPolicies(ctx context.Context, in *RunRetentionPoliciesRequest, opts ...grpc.CallOption) (*RunRetentionPoliciesResponse, error) {
+	out := new(RunRetentionPoliciesResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/RunRetentionPolicies", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServer) GetHistoricTrades(*GetSavedTradesRequest, GoCryptoTrader_GetHistoricTradesServer) error {
-	return status.Errorf(codes.Unimplemented, "method GetHistoricTrades not implemented")
+
+func (c *goCryptoTraderClient) ConvertTradesToBars(ctx context.Context, in *ConvertTradesToBarsRequest, opts ...grpc.CallOption) (*ConvertTradesToBarsResponse, error) {
+	out := new(ConvertTradesToBarsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/ConvertTradesToBars", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServer) GetSavedTrades(context.Context, *GetSavedTradesRequest) (*SavedTradesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSavedTrades not implemented")
+
+func (c *goCryptoTraderClient) GetSymbolHistory(ctx context.Context, in *GetSymbolHistoryRequest, opts ...grpc.CallOption) (*GetSymbolHistoryResponse, error) {
+	out := new(GetSymbolHistoryResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetSymbolHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServer) ConvertTradesToCandles(context.Context, *ConvertTradesToCandlesRequest) (*GetHistoricCandlesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ConvertTradesToCandles not implemented")
+
+func (c *goCryptoTraderClient) AddSymbolAlias(ctx context.Context, in *AddSymbolAliasRequest, opts ...grpc.CallOption) (*GenericResponse, error) {
+	out := new(GenericResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/AddSymbolAlias", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
-func (UnimplementedGoCryptoTraderServer) FindMissingSavedCandleIntervals(context.Context, *FindMissingCandlePeriodsRequest) (*FindMissingIntervalsResponse, error) {
+
+// GoCryptoTraderServer is the server API for GoCryptoTrader service.
+// All implementations must embed UnimplementedGoCryptoTraderServer
+// for forward compatibility
+type GoCryptoTraderServer interface {
+	GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error)
+	GetSubsystems(context.Context, *GetSubsystemsRequest) (*GetSusbsytemsResponse, error)
+	EnableSubsystem(context.Context, *GenericSubsystemRequest) (*GenericResponse, error)
+	DisableSubsystem(context.Context, *GenericSubsystemRequest) (*GenericResponse, error)
+	GetRPCEndpoints(context.Context, *GetRPCEndpointsRequest) (*GetRPCEndpointsResponse, error)
+	GetCommunicationRelayers(context.Context, *GetCommunicationRelayersRequest) (*GetCommunicationRelayersResponse, error)
+	GetExchanges(context.Context, *GetExchangesRequest) (*GetExchangesResponse, error)
+	DisableExchange(context.Context, *GenericExchangeNameRequest) (*GenericResponse, error)
+	GetExchangeInfo(context.Context, *GenericExchangeNameRequest) (*GetExchangeInfoResponse, error)
+	GetExchangeOTPCode(context.Context, *GenericExchangeNameRequest) (*GetExchangeOTPResponse, error)
+	GetExchangeOTPCodes(context.Context, *GetExchangeOTPsRequest) (*GetExchangeOTPsResponse, error)
+	EnableExchange(context.Context, *GenericExchangeNameRequest) (*GenericResponse, error)
+	GetTicker(context.Context, *GetTickerRequest) (*TickerResponse, error)
+	GetTickers(context.Context, *GetTickersRequest) (*GetTickersResponse, error)
+	GetOrderbook(context.Context, *GetOrderbookRequest) (*OrderbookResponse, error)
+	GetOrderbooks(context.Context, *GetOrderbooksRequest) (*GetOrderbooksResponse, error)
+	GetAccountInfo(context.Context, *GetAccountInfoRequest) (*GetAccountInfoResponse, error)
+	UpdateAccountInfo(context.Context, *GetAccountInfoRequest) (*GetAccountInfoResponse, error)
+	GetAccountInfoStream(*GetAccountInfoRequest, GoCryptoTrader_GetAccountInfoStreamServer) error
+	GetConfig(context.Context, *GetConfigRequest) (*GetConfigResponse, error)
+	GetPortfolio(context.Context, *GetPortfolioRequest) (*GetPortfolioResponse, error)
+	GetPortfolioSummary(context.Context, *GetPortfolioSummaryRequest) (*GetPortfolioSummaryResponse, error)
+	AddPortfolioAddress(context.Context, *AddPortfolioAddressRequest) (*GenericResponse, error)
+	RemovePortfolioAddress(context.Context, *RemovePortfolioAddressRequest) (*GenericResponse, error)
+	GetForexProviders(context.Context, *GetForexProvidersRequest) (*GetForexProvidersResponse, error)
+	GetForexRates(context.Context, *GetForexRatesRequest) (*GetForexRatesResponse, error)
+	GetOrders(context.Context, *GetOrdersRequest) (*GetOrdersResponse, error)
+	GetOrder(context.Context, *GetOrderRequest) (*OrderDetails, error)
+	SubmitOrder(context.Context, *SubmitOrderRequest) (*SubmitOrderResponse, error)
+	SimulateOrder(context.Context, *SimulateOrderRequest) (*SimulateOrderResponse, error)
+	WhaleBomb(context.Context, *WhaleBombRequest) (*SimulateOrderResponse, error)
+	CancelOrder(context.Context, *CancelOrderRequest) (*GenericResponse, error)
+	CancelBatchOrders(context.Context, *CancelBatchOrdersRequest) (*CancelBatchOrdersResponse, error)
+	CancelAllOrders(context.Context, *CancelAllOrdersRequest) (*CancelAllOrdersResponse, error)
+	GetEvents(context.Context, *GetEventsRequest) (*GetEventsResponse, error)
+	AddEvent(context.Context, *AddEventRequest) (*AddEventResponse, error)
+	RemoveEvent(context.Context, *RemoveEventRequest) (*GenericResponse, error)
+	GetCryptocurrencyDepositAddresses(context.Context, *GetCryptocurrencyDepositAddressesRequest) (*GetCryptocurrencyDepositAddressesResponse, error)
+	GetCryptocurrencyDepositAddress(context.Context, *GetCryptocurrencyDepositAddressRequest) (*GetCryptocurrencyDepositAddressResponse, error)
+	WithdrawFiatFunds(context.Context, *WithdrawFiatRequest) (*WithdrawResponse, error)
+	WithdrawCryptocurrencyFunds(context.Context, *WithdrawCryptoRequest) (*WithdrawResponse, error)
+	WithdrawalEventByID(context.Context, *WithdrawalEventByIDRequest) (*WithdrawalEventByIDResponse, error)
+	WithdrawalEventsByExchange(context.Context, *WithdrawalEventsByExchangeRequest) (*WithdrawalEventsByExchangeResponse, error)
+	WithdrawalEventsByDate(context.Context, *WithdrawalEventsByDateRequest) (*WithdrawalEventsByExchangeResponse, error)
+	GetLoggerDetails(context.Context, *GetLoggerDetailsRequest) (*GetLoggerDetailsResponse, error)
+	SetLoggerDetails(context.Context, *SetLoggerDetailsRequest) (*GetLoggerDetailsResponse, error)
+	GetExchangePairs(context.Context, *GetExchangePairsRequest) (*GetExchangePairsResponse, error)
+	SetExchangePair(context.Context, *SetExchangePairRequest) (*GenericResponse, error)
+	GetOrderbookStream(*GetOrderbookStreamRequest, GoCryptoTrader_GetOrderbookStreamServer) error
+	GetExchangeOrderbookStream(*GetExchangeOrderbookStreamRequest, GoCryptoTrader_GetExchangeOrderbookStreamServer) error
+	GetTickerStream(*GetTickerStreamRequest, GoCryptoTrader_GetTickerStreamServer) error
+	GetExchangeTickerStream(*GetExchangeTickerStreamRequest, GoCryptoTrader_GetExchangeTickerStreamServer) error
+	GetAuditEvent(context.Context, *GetAuditEventRequest) (*GetAuditEventResponse, error)
+	GCTScriptExecute(context.Context, *GCTScriptExecuteRequest) (*GenericResponse, error)
+	GCTScriptUpload(context.Context, *GCTScriptUploadRequest) (*GenericResponse, error)
+	GCTScriptReadScript(context.Context, *GCTScriptReadScriptRequest) (*GCTScriptQueryResponse, error)
+	GCTScriptStatus(context.Context, *GCTScriptStatusRequest) (*GCTScriptStatusResponse, error)
+	GCTScriptQuery(context.Context, *GCTScriptQueryRequest) (*GCTScriptQueryResponse, error)
+	GCTScriptStop(context.Context, *GCTScriptStopRequest) (*GenericResponse, error)
+	GCTScriptStopAll(context.Context, *GCTScriptStopAllRequest) (*GenericResponse, error)
+	GCTScriptListAll(context.Context, *GCTScriptListAllRequest) (*GCTScriptStatusResponse, error)
+	GCTScriptAutoLoadToggle(context.Context, *GCTScriptAutoLoadRequest) (*GenericResponse, error)
+	GetHistoricCandles(context.Context, *GetHistoricCandlesRequest) (*GetHistoricCandlesResponse, error)
+	SetExchangeAsset(context.Context, *SetExchangeAssetRequest) (*GenericResponse, error)
+	SetAllExchangePairs(context.Context, *SetExchangeAllPairsRequest) (*GenericResponse, error)
+	UpdateExchangeSupportedPairs(context.Context, *UpdateExchangeSupportedPairsRequest) (*GenericResponse, error)
+	GetExchangeAssets(context.Context, *GetExchangeAssetsRequest) (*GetExchangeAssetsResponse, error)
+	WebsocketGetInfo(context.Context, *WebsocketGetInfoRequest) (*WebsocketGetInfoResponse, error)
+	WebsocketSetEnabled(context.Context, *WebsocketSetEnabledRequest) (*GenericResponse, error)
+	WebsocketGetSubscriptions(context.Context, *WebsocketGetSubscriptionsRequest) (*WebsocketGetSubscriptionsResponse, error)
+	WebsocketSetProxy(context.Context, *WebsocketSetProxyRequest) (*GenericResponse, error)
+	WebsocketSetURL(context.Context, *WebsocketSetURLRequest) (*GenericResponse, error)
+	GetRecentTrades(context.Context, *GetSavedTradesRequest) (*SavedTradesResponse, error)
+	GetHistoricTrades(*GetSavedTradesRequest, GoCryptoTrader_GetHistoricTradesServer) error
+	GetSavedTrades(context.Context, *GetSavedTradesRequest) (*SavedTradesResponse, error)
+	ConvertTradesToCandles(context.Context, *ConvertTradesToCandlesRequest) (*GetHistoricCandlesResponse, error)
+	FindMissingSavedCandleIntervals(context.Context, *FindMissingCandlePeriodsRequest) (*FindMissingIntervalsResponse, error)
+	FindMissingSavedTradeIntervals(context.Context, *FindMissingTradePeriodsRequest) (*FindMissingIntervalsResponse, error)
+	SetExchangeTradeProcessing(context.Context, *SetExchangeTradeProcessingRequest) (*GenericResponse, error)
+	UpsertDataHistoryJob(context.Context, *UpsertDataHistoryJobRequest) (*UpsertDataHistoryJobResponse, error)
+	GetDataHistoryJobDetails(context.Context, *GetDataHistoryJobDetailsRequest) (*DataHistoryJob, error)
+	GetActiveDataHistoryJobs(context.Context, *GetInfoRequest) (*DataHistoryJobs, error)
+	GetDataHistoryJobsBetween(context.Context, *GetDataHistoryJobsBetweenRequest) (*DataHistoryJobs, error)
+	GetDataHistoryJobSummary(context.Context, *GetDataHistoryJobDetailsRequest) (*DataHistoryJob, error)
+	SetDataHistoryJobStatus(context.Context, *SetDataHistoryJobStatusRequest) (*GenericResponse, error)
+	UpdateDataHistoryJobPrerequisite(context.Context, *UpdateDataHistoryJobPrerequisiteRequest) (*GenericResponse, error)
+	GetManagedOrders(context.Context, *GetOrdersRequest) (*GetOrdersResponse, error)
+	ModifyOrder(context.Context, *ModifyOrderRequest) (*ModifyOrderResponse, error)
+	CurrencyStateGetAll(context.Context, *CurrencyStateGetAllRequest) (*CurrencyStateResponse, error)
+	CurrencyStateTrading(context.Context, *CurrencyStateTradingRequest) (*GenericResponse, error)
+	CurrencyStateDeposit(context.Context, *CurrencyStateDepositRequest) (*GenericResponse, error)
+	CurrencyStateWithdraw(context.Context, *CurrencyStateWithdrawRequest) (*GenericResponse, error)
+	CurrencyStateTradingPair(context.Context, *CurrencyStateTradingPairRequest) (*GenericResponse, error)
+	GetConsolidatedOrderbook(context.Context, *GetConsolidatedOrderbookRequest) (*ConsolidatedOrderbookResponse, error)
+	GetConsolidatedOrderbookStream(*GetConsolidatedOrderbookRequest, GoCryptoTrader_GetConsolidatedOrderbookStreamServer) error
+	GetOrderbookAnalytics(context.Context, *GetOrderbookAnalyticsRequest) (*OrderbookAnalyticsResponse, error)
+	GetOrderbookSpreadStatistics(context.Context, *GetOrderbookSpreadStatisticsRequest) (*OrderbookSpreadStatisticsResponse, error)
+	GetOrderbookHistory(context.Context, *GetOrderbookHistoryRequest) (*GetOrderbookHistoryResponse, error)
+	GetFundingRate(context.Context, *FuturesMarketDataRequest) (*GetFundingRateResponse, error)
+	GetFundingRateHistory(context.Context, *GetFundingRateHistoryRequest) (*GetFundingRateHistoryResponse, error)
+	GetOpenInterest(context.Context, *FuturesMarketDataRequest) (*GetOpenInterestResponse, error)
+	GetMarkPrice(context.Context, *FuturesMarketDataRequest) (*GetMarkPriceResponse, error)
+	GetFuturesPositions(context.Context, *FuturesMarketDataRequest) (*GetFuturesPositionsResponse, error)
+	ChangeFuturesLeverage(context.Context, *ChangeFuturesLeverageRequest) (*GenericResponse, error)
+	ChangeFuturesMarginType(context.Context, *ChangeFuturesMarginTypeRequest) (*GenericResponse, error)
+	GetFuturesMarginInfo(context.Context, *FuturesMarketDataRequest) (*GetFuturesMarginInfoResponse, error)
+	TransferFunds(context.Context, *TransferFundsRequest) (*TransferFundsResponse, error)
+	GetMarginInterestRates(context.Context, *MarginCurrencyRequest) (*GetMarginInterestRatesResponse, error)
+	MarginBorrow(context.Context, *MarginLoanRequest) (*MarginLoanResponse, error)
+	MarginRepay(context.Context, *MarginLoanRequest) (*MarginLoanResponse, error)
+	SubmitMarginLendOffer(context.Context, *SubmitMarginLendOfferRequest) (*MarginLoanResponse, error)
+	CancelMarginLendOffer(context.Context, *CancelMarginLendOfferRequest) (*GenericResponse, error)
+	GetMarginLoans(context.Context, *MarginCurrencyRequest) (*GetMarginLoansResponse, error)
+	GetMarginInterestHistory(context.Context, *GetMarginInterestHistoryRequest) (*GetMarginInterestHistoryResponse, error)
+	GetFeeSchedule(context.Context, *GetFeeScheduleRequest) (*GetFeeScheduleResponse, error)
+	GetAvailableTransferChains(context.Context, *GetAvailableTransferChainsRequest) (*GetAvailableTransferChainsResponse, error)
+	SubmitBatchOrders(context.Context, *SubmitBatchOrdersRequest) (*SubmitBatchOrdersResponse, error)
+	ModifyBatchOrders(context.Context, *ModifyBatchOrdersRequest) (*ModifyBatchOrdersResponse, error)
+	GetFillStream(*GetFillStreamRequest, GoCryptoTrader_GetFillStreamServer) error
+	GetExchangeCapabilities(context.Context, *GenericExchangeNameRequest) (*GetExchangeCapabilitiesResponse, error)
+	ExportData(context.Context, *ExportDataRequest) (*ExportDataResponse, error)
+	UpsertDataHistoryJobsForEnabledPairs(context.Context, *UpsertDataHistoryJobRequest) (*UpsertDataHistoryJobsResponse, error)
+	GetDataQualityReport(context.Context, *GetDataQualityReportRequest) (*GetDataQualityReportResponse, error)
+	GetCompositeIndexPrices(context.Context, *GetCompositeIndexPricesRequest) (*GetCompositeIndexPricesResponse, error)
+	GetLiveCandleStream(*GetLiveCandleStreamRequest, GoCryptoTrader_GetLiveCandleStreamServer) error
+	RunRetentionPolicies(context.Context, *RunRetentionPoliciesRequest) (*RunRetentionPoliciesResponse, error)
+	ConvertTradesToBars(context.Context, *ConvertTradesToBarsRequest) (*ConvertTradesToBarsResponse, error)
+	GetSymbolHistory(context.Context, *GetSymbolHistoryRequest) (*GetSymbolHistoryResponse, error)
+	AddSymbolAlias(context.Context, *AddSymbolAliasRequest) (*GenericResponse, error)
+	mustEmbedUnimplementedGoCryptoTraderServer()
+}
+
+// UnimplementedGoCryptoTraderServer must be embedded to have forward compatible implementations.
+type UnimplementedGoCryptoTraderServer struct {
+}
+
+func (UnimplementedGoCryptoTraderServer) GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInfo not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetSubsystems(context.Context, *GetSubsystemsRequest) (*GetSusbsytemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubsystems not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) EnableSubsystem(context.Context, *GenericSubsystemRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableSubsystem not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) DisableSubsystem(context.Context, *GenericSubsystemRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableSubsystem not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetRPCEndpoints(context.Context, *GetRPCEndpointsRequest) (*GetRPCEndpointsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRPCEndpoints not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetCommunicationRelayers(context.Context, *GetCommunicationRelayersRequest) (*GetCommunicationRelayersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommunicationRelayers not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetExchanges(context.Context, *GetExchangesRequest) (*GetExchangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExchanges not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) DisableExchange(context.Context, *GenericExchangeNameRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableExchange not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetExchangeInfo(context.Context, *GenericExchangeNameRequest) (*GetExchangeInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExchangeInfo not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetExchangeOTPCode(context.Context, *GenericExchangeNameRequest) (*GetExchangeOTPResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExchangeOTPCode not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetExchangeOTPCodes(context.Context, *GetExchangeOTPsRequest) (*GetExchangeOTPsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExchangeOTPCodes not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) EnableExchange(context.Context, *GenericExchangeNameRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableExchange not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetTicker(context.Context, *GetTickerRequest) (*TickerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTicker not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetTickers(context.Context, *GetTickersRequest) (*GetTickersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTickers not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetOrderbook(context.Context, *GetOrderbookRequest) (*OrderbookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderbook not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetOrderbooks(context.Context, *GetOrderbooksRequest) (*GetOrderbooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderbooks not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetAccountInfo(context.Context, *GetAccountInfoRequest) (*GetAccountInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountInfo not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) UpdateAccountInfo(context.Context, *GetAccountInfoRequest) (*GetAccountInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAccountInfo not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetAccountInfoStream(*GetAccountInfoRequest, GoCryptoTrader_GetAccountInfoStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetAccountInfoStream not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetConfig(context.Context, *GetConfigRequest) (*GetConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConfig not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetPortfolio(context.Context, *GetPortfolioRequest) (*GetPortfolioResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPortfolio not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetPortfolioSummary(context.Context, *GetPortfolioSummaryRequest) (*GetPortfolioSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPortfolioSummary not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) AddPortfolioAddress(context.Context, *AddPortfolioAddressRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPortfolioAddress not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) RemovePortfolioAddress(context.Context, *RemovePortfolioAddressRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePortfolioAddress not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetForexProviders(context.Context, *GetForexProvidersRequest) (*GetForexProvidersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetForexProviders not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetForexRates(context.Context, *GetForexRatesRequest) (*GetForexRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetForexRates not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetOrders(context.Context, *GetOrdersRequest) (*GetOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrders not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetOrder(context.Context, *GetOrderRequest) (*OrderDetails, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrder not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) SubmitOrder(context.Context, *SubmitOrderRequest) (*SubmitOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitOrder not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) SimulateOrder(context.Context, *SimulateOrderRequest) (*SimulateOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateOrder not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) WhaleBomb(context.Context, *WhaleBombRequest) (*SimulateOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WhaleBomb not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) CancelOrder(context.Context, *CancelOrderRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) CancelBatchOrders(context.Context, *CancelBatchOrdersRequest) (*CancelBatchOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelBatchOrders not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) CancelAllOrders(context.Context, *CancelAllOrdersRequest) (*CancelAllOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAllOrders not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetEvents(context.Context, *GetEventsRequest) (*GetEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEvents not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) AddEvent(context.Context, *AddEventRequest) (*AddEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddEvent not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) RemoveEvent(context.Context, *RemoveEventRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveEvent not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetCryptocurrencyDepositAddresses(context.Context, *GetCryptocurrencyDepositAddressesRequest) (*GetCryptocurrencyDepositAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCryptocurrencyDepositAddresses not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetCryptocurrencyDepositAddress(context.Context, *GetCryptocurrencyDepositAddressRequest) (*GetCryptocurrencyDepositAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCryptocurrencyDepositAddress not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) WithdrawFiatFunds(context.Context, *WithdrawFiatRequest) (*WithdrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawFiatFunds not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) WithdrawCryptocurrencyFunds(context.Context, *WithdrawCryptoRequest) (*WithdrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawCryptocurrencyFunds not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) WithdrawalEventByID(context.Context, *WithdrawalEventByIDRequest) (*WithdrawalEventByIDResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawalEventByID not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) WithdrawalEventsByExchange(context.Context, *WithdrawalEventsByExchangeRequest) (*WithdrawalEventsByExchangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawalEventsByExchange not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) WithdrawalEventsByDate(context.Context, *WithdrawalEventsByDateRequest) (*WithdrawalEventsByExchangeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawalEventsByDate not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetLoggerDetails(context.Context, *GetLoggerDetailsRequest) (*GetLoggerDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLoggerDetails not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) SetLoggerDetails(context.Context, *SetLoggerDetailsRequest) (*GetLoggerDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLoggerDetails not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetExchangePairs(context.Context, *GetExchangePairsRequest) (*GetExchangePairsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExchangePairs not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) SetExchangePair(context.Context, *SetExchangePairRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetExchangePair not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetOrderbookStream(*GetOrderbookStreamRequest, GoCryptoTrader_GetOrderbookStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetOrderbookStream not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetExchangeOrderbookStream(*GetExchangeOrderbookStreamRequest, GoCryptoTrader_GetExchangeOrderbookStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetExchangeOrderbookStream not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetTickerStream(*GetTickerStreamRequest, GoCryptoTrader_GetTickerStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetTickerStream not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetExchangeTickerStream(*GetExchangeTickerStreamRequest, GoCryptoTrader_GetExchangeTickerStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetExchangeTickerStream not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetAuditEvent(context.Context, *GetAuditEventRequest) (*GetAuditEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuditEvent not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GCTScriptExecute(context.Context, *GCTScriptExecuteRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptExecute not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GCTScriptUpload(context.Context, *GCTScriptUploadRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptUpload not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GCTScriptReadScript(context.Context, *GCTScriptReadScriptRequest) (*GCTScriptQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptReadScript not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GCTScriptStatus(context.Context, *GCTScriptStatusRequest) (*GCTScriptStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptStatus not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GCTScriptQuery(context.Context, *GCTScriptQueryRequest) (*GCTScriptQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptQuery not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GCTScriptStop(context.Context, *GCTScriptStopRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptStop not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GCTScriptStopAll(context.Context, *GCTScriptStopAllRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptStopAll not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GCTScriptListAll(context.Context, *GCTScriptListAllRequest) (*GCTScriptStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptListAll not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GCTScriptAutoLoadToggle(context.Context, *GCTScriptAutoLoadRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptAutoLoadToggle not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetHistoricCandles(context.Context, *GetHistoricCandlesRequest) (*GetHistoricCandlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHistoricCandles not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) SetExchangeAsset(context.Context, *SetExchangeAssetRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetExchangeAsset not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) SetAllExchangePairs(context.Context, *SetExchangeAllPairsRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetAllExchangePairs not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) UpdateExchangeSupportedPairs(context.Context, *UpdateExchangeSupportedPairsRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateExchangeSupportedPairs not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetExchangeAssets(context.Context, *GetExchangeAssetsRequest) (*GetExchangeAssetsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExchangeAssets not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) WebsocketGetInfo(context.Context, *WebsocketGetInfoRequest) (*WebsocketGetInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WebsocketGetInfo not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) WebsocketSetEnabled(context.Context, *WebsocketSetEnabledRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WebsocketSetEnabled not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) WebsocketGetSubscriptions(context.Context, *WebsocketGetSubscriptionsRequest) (*WebsocketGetSubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WebsocketGetSubscriptions not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) WebsocketSetProxy(context.Context, *WebsocketSetProxyRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WebsocketSetProxy not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) WebsocketSetURL(context.Context, *WebsocketSetURLRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WebsocketSetURL not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetRecentTrades(context.Context, *GetSavedTradesRequest) (*SavedTradesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRecentTrades not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetHistoricTrades(*GetSavedTradesRequest, GoCryptoTrader_GetHistoricTradesServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetHistoricTrades not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetSavedTrades(context.Context, *GetSavedTradesRequest) (*SavedTradesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSavedTrades not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) ConvertTradesToCandles(context.Context, *ConvertTradesToCandlesRequest) (*GetHistoricCandlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertTradesToCandles not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) FindMissingSavedCandleIntervals(context.Context, *FindMissingCandlePeriodsRequest) (*FindMissingIntervalsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method FindMissingSavedCandleIntervals not implemented")
 }
-func (UnimplementedGoCryptoTraderServer) FindMissingSavedTradeIntervals(context.Context, *FindMissingTradePeriodsRequest) (*FindMissingIntervalsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method FindMissingSavedTradeIntervals not implemented")
+func (UnimplementedGoCryptoTraderServer) FindMissingSavedTradeIntervals(context.Context, *FindMissingTradePeriodsRequest) (*FindMissingIntervalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FindMissingSavedTradeIntervals not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) SetExchangeTradeProcessing(context.Context, *SetExchangeTradeProcessingRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetExchangeTradeProcessing not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) UpsertDataHistoryJob(context.Context, *UpsertDataHistoryJobRequest) (*UpsertDataHistoryJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertDataHistoryJob not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetDataHistoryJobDetails(context.Context, *GetDataHistoryJobDetailsRequest) (*DataHistoryJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDataHistoryJobDetails not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetActiveDataHistoryJobs(context.Context, *GetInfoRequest) (*DataHistoryJobs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetActiveDataHistoryJobs not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetDataHistoryJobsBetween(context.Context, *GetDataHistoryJobsBetweenRequest) (*DataHistoryJobs, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDataHistoryJobsBetween not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetDataHistoryJobSummary(context.Context, *GetDataHistoryJobDetailsRequest) (*DataHistoryJob, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDataHistoryJobSummary not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) SetDataHistoryJobStatus(context.Context, *SetDataHistoryJobStatusRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetDataHistoryJobStatus not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) UpdateDataHistoryJobPrerequisite(context.Context, *UpdateDataHistoryJobPrerequisiteRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDataHistoryJobPrerequisite not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetManagedOrders(context.Context, *GetOrdersRequest) (*GetOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetManagedOrders not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) ModifyOrder(context.Context, *ModifyOrderRequest) (*ModifyOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModifyOrder not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) CurrencyStateGetAll(context.Context, *CurrencyStateGetAllRequest) (*CurrencyStateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CurrencyStateGetAll not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) CurrencyStateTrading(context.Context, *CurrencyStateTradingRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CurrencyStateTrading not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) CurrencyStateDeposit(context.Context, *CurrencyStateDepositRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CurrencyStateDeposit not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) CurrencyStateWithdraw(context.Context, *CurrencyStateWithdrawRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CurrencyStateWithdraw not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) CurrencyStateTradingPair(context.Context, *CurrencyStateTradingPairRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CurrencyStateTradingPair not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetConsolidatedOrderbook(context.Context, *GetConsolidatedOrderbookRequest) (*ConsolidatedOrderbookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConsolidatedOrderbook not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetConsolidatedOrderbookStream(*GetConsolidatedOrderbookRequest, GoCryptoTrader_GetConsolidatedOrderbookStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetConsolidatedOrderbookStream not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetOrderbookAnalytics(context.Context, *GetOrderbookAnalyticsRequest) (*OrderbookAnalyticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderbookAnalytics not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetOrderbookSpreadStatistics(context.Context, *GetOrderbookSpreadStatisticsRequest) (*OrderbookSpreadStatisticsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderbookSpreadStatistics not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetOrderbookHistory(context.Context, *GetOrderbookHistoryRequest) (*GetOrderbookHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderbookHistory not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetFundingRate(context.Context, *FuturesMarketDataRequest) (*GetFundingRateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFundingRate not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetFundingRateHistory(context.Context, *GetFundingRateHistoryRequest) (*GetFundingRateHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFundingRateHistory not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetOpenInterest(context.Context, *FuturesMarketDataRequest) (*GetOpenInterestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOpenInterest not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetMarkPrice(context.Context, *FuturesMarketDataRequest) (*GetMarkPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMarkPrice not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetFuturesPositions(context.Context, *FuturesMarketDataRequest) (*GetFuturesPositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFuturesPositions not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) ChangeFuturesLeverage(context.Context, *ChangeFuturesLeverageRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeFuturesLeverage not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) ChangeFuturesMarginType(context.Context, *ChangeFuturesMarginTypeRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ChangeFuturesMarginType not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetFuturesMarginInfo(context.Context, *FuturesMarketDataRequest) (*GetFuturesMarginInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFuturesMarginInfo not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) TransferFunds(context.Context, *TransferFundsRequest) (*TransferFundsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TransferFunds not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetMarginInterestRates(context.Context, *MarginCurrencyRequest) (*GetMarginInterestRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMarginInterestRates not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) MarginBorrow(context.Context, *MarginLoanRequest) (*MarginLoanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarginBorrow not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) MarginRepay(context.Context, *MarginLoanRequest) (*MarginLoanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MarginRepay not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) SubmitMarginLendOffer(context.Context, *SubmitMarginLendOfferRequest) (*MarginLoanResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitMarginLendOffer not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) CancelMarginLendOffer(context.Context, *CancelMarginLendOfferRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelMarginLendOffer not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetMarginLoans(context.Context, *MarginCurrencyRequest) (*GetMarginLoansResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMarginLoans not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetMarginInterestHistory(context.Context, *GetMarginInterestHistoryRequest) (*GetMarginInterestHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMarginInterestHistory not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetFeeSchedule(context.Context, *GetFeeScheduleRequest) (*GetFeeScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFeeSchedule not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetAvailableTransferChains(context.Context, *GetAvailableTransferChainsRequest) (*GetAvailableTransferChainsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAvailableTransferChains not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) SubmitBatchOrders(context.Context, *SubmitBatchOrdersRequest) (*SubmitBatchOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitBatchOrders not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) ModifyBatchOrders(context.Context, *ModifyBatchOrdersRequest) (*ModifyBatchOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModifyBatchOrders not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetFillStream(*GetFillStreamRequest, GoCryptoTrader_GetFillStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetFillStream not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetExchangeCapabilities(context.Context, *GenericExchangeNameRequest) (*GetExchangeCapabilitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExchangeCapabilities not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) ExportData(context.Context, *ExportDataRequest) (*ExportDataResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ExportData not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) UpsertDataHistoryJobsForEnabledPairs(context.Context, *UpsertDataHistoryJobRequest) (*UpsertDataHistoryJobsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpsertDataHistoryJobsForEnabledPairs not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetDataQualityReport(context.Context, *GetDataQualityReportRequest) (*GetDataQualityReportResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDataQualityReport not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetCompositeIndexPrices(context.Context, *GetCompositeIndexPricesRequest) (*GetCompositeIndexPricesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCompositeIndexPrices not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetLiveCandleStream(*GetLiveCandleStreamRequest, GoCryptoTrader_GetLiveCandleStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetLiveCandleStream not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) RunRetentionPolicies(context.Context, *RunRetentionPoliciesRequest) (*RunRetentionPoliciesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RunRetentionPolicies not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) ConvertTradesToBars(context.Context, *ConvertTradesToBarsRequest) (*ConvertTradesToBarsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConvertTradesToBars not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) GetSymbolHistory(context.Context, *GetSymbolHistoryRequest) (*GetSymbolHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSymbolHistory not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) AddSymbolAlias(context.Context, *AddSymbolAliasRequest) (*GenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddSymbolAlias not implemented")
+}
+func (UnimplementedGoCryptoTraderServer) mustEmbedUnimplementedGoCryptoTraderServer() {}
+
+// UnsafeGoCryptoTraderServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to GoCryptoTraderServer will
+// result in compilation errors.
+type UnsafeGoCryptoTraderServer interface {
+	mustEmbedUnimplementedGoCryptoTraderServer()
+}
+
+func RegisterGoCryptoTraderServer(s grpc.ServiceRegistrar, srv GoCryptoTraderServer) {
+	s.RegisterService(&GoCryptoTrader_ServiceDesc, srv)
+}
+
+func _GoCryptoTrader_GetInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetInfo(ctx, req.(*GetInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetSubsystems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSubsystemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetSubsystems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetSubsystems",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetSubsystems(ctx, req.(*GetSubsystemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_EnableSubsystem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenericSubsystemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).EnableSubsystem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/EnableSubsystem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).EnableSubsystem(ctx, req.(*GenericSubsystemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_DisableSubsystem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenericSubsystemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).DisableSubsystem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/DisableSubsystem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).DisableSubsystem(ctx, req.(*GenericSubsystemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetRPCEndpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRPCEndpointsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetRPCEndpoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetRPCEndpoints",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetRPCEndpoints(ctx, req.(*GetRPCEndpointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetCommunicationRelayers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommunicationRelayersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetCommunicationRelayers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetCommunicationRelayers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetCommunicationRelayers(ctx, req.(*GetCommunicationRelayersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetExchanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExchangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetExchanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetExchanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetExchanges(ctx, req.(*GetExchangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_DisableExchange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenericExchangeNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).DisableExchange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/DisableExchange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).DisableExchange(ctx, req.(*GenericExchangeNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetExchangeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenericExchangeNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetExchangeInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetExchangeInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetExchangeInfo(ctx, req.(*GenericExchangeNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGoCryptoTraderServer) SetExchangeTradeProcessing(context.Context, *SetExchangeTradeProcessingRequest) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetExchangeTradeProcessing not implemented")
+
+func _GoCryptoTrader_GetExchangeOTPCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenericExchangeNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetExchangeOTPCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetExchangeOTPCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetExchangeOTPCode(ctx, req.(*GenericExchangeNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGoCryptoTraderServer) UpsertDataHistoryJob(context.Context, *UpsertDataHistoryJobRequest) (*UpsertDataHistoryJobResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpsertDataHistoryJob not implemented")
+
+func _GoCryptoTrader_GetExchangeOTPCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExchangeOTPsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetExchangeOTPCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetExchangeOTPCodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetExchangeOTPCodes(ctx, req.(*GetExchangeOTPsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGoCryptoTraderServer) GetDataHistoryJobDetails(context.Context, *GetDataHistoryJobDetailsRequest) (*DataHistoryJob, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDataHistoryJobDetails not implemented")
+
+func _GoCryptoTrader_EnableExchange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenericExchangeNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).EnableExchange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/EnableExchange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).EnableExchange(ctx, req.(*GenericExchangeNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGoCryptoTraderServer) GetActiveDataHistoryJobs(context.Context, *GetInfoRequest) (*DataHistoryJobs, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetActiveDataHistoryJobs not implemented")
+
+func _GoCryptoTrader_GetTicker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTickerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetTicker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetTicker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetTicker(ctx, req.(*GetTickerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGoCryptoTraderServer) GetDataHistoryJobsBetween(context.Context, *GetDataHistoryJobsBetweenRequest) (*DataHistoryJobs, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDataHistoryJobsBetween not implemented")
+
+func _GoCryptoTrader_GetTickers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTickersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetTickers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetTickers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetTickers(ctx, req.(*GetTickersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGoCryptoTraderServer) GetDataHistoryJobSummary(context.Context, *GetDataHistoryJobDetailsRequest) (*DataHistoryJob, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetDataHistoryJobSummary not implemented")
+
+func _GoCryptoTrader_GetOrderbook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderbookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetOrderbook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetOrderbook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetOrderbook(ctx, req.(*GetOrderbookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetOrderbooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderbooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetOrderbooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetOrderbooks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetOrderbooks(ctx, req.(*GetOrderbooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetAccountInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetAccountInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetAccountInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetAccountInfo(ctx, req.(*GetAccountInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_UpdateAccountInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).UpdateAccountInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/UpdateAccountInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).UpdateAccountInfo(ctx, req.(*GetAccountInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetAccountInfoStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetAccountInfoRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServer).GetAccountInfoStream(m, &goCryptoTraderGetAccountInfoStreamServer{stream})
+}
+
+type GoCryptoTrader_GetAccountInfoStreamServer interface {
+	Send(*GetAccountInfoResponse) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderGetAccountInfoStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderGetAccountInfoStreamServer) Send(m *GetAccountInfoResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoCryptoTrader_GetConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetConfig(ctx, req.(*GetConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetPortfolio_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPortfolioRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetPortfolio(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetPortfolio",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetPortfolio(ctx, req.(*GetPortfolioRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetPortfolioSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPortfolioSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetPortfolioSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetPortfolioSummary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetPortfolioSummary(ctx, req.(*GetPortfolioSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGoCryptoTraderServer) SetDataHistoryJobStatus(context.Context, *SetDataHistoryJobStatusRequest) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetDataHistoryJobStatus not implemented")
+
+func _GoCryptoTrader_AddPortfolioAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPortfolioAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).AddPortfolioAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/AddPortfolioAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).AddPortfolioAddress(ctx, req.(*AddPortfolioAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGoCryptoTraderServer) UpdateDataHistoryJobPrerequisite(context.Context, *UpdateDataHistoryJobPrerequisiteRequest) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateDataHistoryJobPrerequisite not implemented")
+
+func _GoCryptoTrader_RemovePortfolioAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemovePortfolioAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).RemovePortfolioAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/RemovePortfolioAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).RemovePortfolioAddress(ctx, req.(*RemovePortfolioAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGoCryptoTraderServer) GetManagedOrders(context.Context, *GetOrdersRequest) (*GetOrdersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetManagedOrders not implemented")
+
+func _GoCryptoTrader_GetForexProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetForexProvidersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetForexProviders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetForexProviders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetForexProviders(ctx, req.(*GetForexProvidersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGoCryptoTraderServer) ModifyOrder(context.Context, *ModifyOrderRequest) (*ModifyOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ModifyOrder not implemented")
+
+func _GoCryptoTrader_GetForexRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetForexRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetForexRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetForexRates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetForexRates(ctx, req.(*GetForexRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGoCryptoTraderServer) CurrencyStateGetAll(context.Context, *CurrencyStateGetAllRequest) (*CurrencyStateResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CurrencyStateGetAll not implemented")
+
+func _GoCryptoTrader_GetOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetOrders(ctx, req.(*GetOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGoCryptoTraderServer) CurrencyStateTrading(context.Context, *CurrencyStateTradingRequest) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CurrencyStateTrading not implemented")
+
+func _GoCryptoTrader_GetOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetOrder(ctx, req.(*GetOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGoCryptoTraderServer) CurrencyStateDeposit(context.Context, *CurrencyStateDepositRequest) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CurrencyStateDeposit not implemented")
+
+func _GoCryptoTrader_SubmitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).SubmitOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/SubmitOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).SubmitOrder(ctx, req.(*SubmitOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGoCryptoTraderServer) CurrencyStateWithdraw(context.Context, *CurrencyStateWithdrawRequest) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CurrencyStateWithdraw not implemented")
+
+func _GoCryptoTrader_SimulateOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).SimulateOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/SimulateOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).SimulateOrder(ctx, req.(*SimulateOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGoCryptoTraderServer) CurrencyStateTradingPair(context.Context, *CurrencyStateTradingPairRequest) (*GenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CurrencyStateTradingPair not implemented")
+
+func _GoCryptoTrader_WhaleBomb_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WhaleBombRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).WhaleBomb(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/WhaleBomb",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).WhaleBomb(ctx, req.(*WhaleBombRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (UnimplementedGoCryptoTraderServer) mustEmbedUnimplementedGoCryptoTraderServer() {}
 
-// UnsafeGoCryptoTraderServer may be embedded to opt out of forward compatibility for this service.
-// Use of this interface is not recommended, as added methods to GoCryptoTraderServer will
-// result in compilation errors.
-type UnsafeGoCryptoTraderServer interface {
-	mustEmbedUnimplementedGoCryptoTraderServer()
+func _GoCryptoTrader_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).CancelOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/CancelOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).CancelOrder(ctx, req.(*CancelOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterGoCryptoTraderServer(s grpc.ServiceRegistrar, srv GoCryptoTraderServer) {
-	s.RegisterService(&GoCryptoTrader_ServiceDesc, srv)
+func _GoCryptoTrader_CancelBatchOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelBatchOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).CancelBatchOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/CancelBatchOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).CancelBatchOrders(ctx, req.(*CancelBatchOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetInfoRequest)
+func _GoCryptoTrader_CancelAllOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelAllOrdersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetInfo(ctx, in)
+		return srv.(GoCryptoTraderServer).CancelAllOrders(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetInfo",
+		FullMethod: "/gctrpc.GoCryptoTrader/CancelAllOrders",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetInfo(ctx, req.(*GetInfoRequest))
+		return srv.(GoCryptoTraderServer).CancelAllOrders(ctx, req.(*CancelAllOrdersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetSubsystems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSubsystemsRequest)
+func _GoCryptoTrader_GetEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetSubsystems(ctx, in)
+		return srv.(GoCryptoTraderServer).GetEvents(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetSubsystems",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetEvents",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetSubsystems(ctx, req.(*GetSubsystemsRequest))
+		return srv.(GoCryptoTraderServer).GetEvents(ctx, req.(*GetEventsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_EnableSubsystem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GenericSubsystemRequest)
+func _GoCryptoTrader_AddEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddEventRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).EnableSubsystem(ctx, in)
+		return srv.(GoCryptoTraderServer).AddEvent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/EnableSubsystem",
+		FullMethod: "/gctrpc.GoCryptoTrader/AddEvent",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).EnableSubsystem(ctx, req.(*GenericSubsystemRequest))
+		return srv.(GoCryptoTraderServer).AddEvent(ctx, req.(*AddEventRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_DisableSubsystem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GenericSubsystemRequest)
+func _GoCryptoTrader_RemoveEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveEventRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).DisableSubsystem(ctx, in)
+		return srv.(GoCryptoTraderServer).RemoveEvent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/DisableSubsystem",
+		FullMethod: "/gctrpc.GoCryptoTrader/RemoveEvent",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).DisableSubsystem(ctx, req.(*GenericSubsystemRequest))
+		return srv.(GoCryptoTraderServer).RemoveEvent(ctx, req.(*RemoveEventRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetRPCEndpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRPCEndpointsRequest)
+func _GoCryptoTrader_GetCryptocurrencyDepositAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCryptocurrencyDepositAddressesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetRPCEndpoints(ctx, in)
+		return srv.(GoCryptoTraderServer).GetCryptocurrencyDepositAddresses(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetRPCEndpoints",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetCryptocurrencyDepositAddresses",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetRPCEndpoints(ctx, req.(*GetRPCEndpointsRequest))
+		return srv.(GoCryptoTraderServer).GetCryptocurrencyDepositAddresses(ctx, req.(*GetCryptocurrencyDepositAddressesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetCommunicationRelayers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCommunicationRelayersRequest)
+func _GoCryptoTrader_GetCryptocurrencyDepositAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCryptocurrencyDepositAddressRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetCommunicationRelayers(ctx, in)
+		return srv.(GoCryptoTraderServer).GetCryptocurrencyDepositAddress(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetCommunicationRelayers",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetCryptocurrencyDepositAddress",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetCommunicationRelayers(ctx, req.(*GetCommunicationRelayersRequest))
+		return srv.(GoCryptoTraderServer).GetCryptocurrencyDepositAddress(ctx, req.(*GetCryptocurrencyDepositAddressRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetExchanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetExchangesRequest)
+func _GoCryptoTrader_WithdrawFiatFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawFiatRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetExchanges(ctx, in)
+		return srv.(GoCryptoTraderServer).WithdrawFiatFunds(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetExchanges",
+		FullMethod: "/gctrpc.GoCryptoTrader/WithdrawFiatFunds",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetExchanges(ctx, req.(*GetExchangesRequest))
+		return srv.(GoCryptoTraderServer).WithdrawFiatFunds(ctx, req.(*WithdrawFiatRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_DisableExchange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GenericExchangeNameRequest)
+func _GoCryptoTrader_WithdrawCryptocurrencyFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawCryptoRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).DisableExchange(ctx, in)
+		return srv.(GoCryptoTraderServer).WithdrawCryptocurrencyFunds(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/DisableExchange",
+		FullMethod: "/gctrpc.GoCryptoTrader/WithdrawCryptocurrencyFunds",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).DisableExchange(ctx, req.(*GenericExchangeNameRequest))
+		return srv.(GoCryptoTraderServer).WithdrawCryptocurrencyFunds(ctx, req.(*WithdrawCryptoRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetExchangeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GenericExchangeNameRequest)
+func _GoCryptoTrader_WithdrawalEventByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawalEventByIDRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetExchangeInfo(ctx, in)
+		return srv.(GoCryptoTraderServer).WithdrawalEventByID(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetExchangeInfo",
+		FullMethod: "/gctrpc.GoCryptoTrader/WithdrawalEventByID",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetExchangeInfo(ctx, req.(*GenericExchangeNameRequest))
+		return srv.(GoCryptoTraderServer).WithdrawalEventByID(ctx, req.(*WithdrawalEventByIDRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetExchangeOTPCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GenericExchangeNameRequest)
+func _GoCryptoTrader_WithdrawalEventsByExchange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawalEventsByExchangeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetExchangeOTPCode(ctx, in)
+		return srv.(GoCryptoTraderServer).WithdrawalEventsByExchange(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetExchangeOTPCode",
+		FullMethod: "/gctrpc.GoCryptoTrader/WithdrawalEventsByExchange",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetExchangeOTPCode(ctx, req.(*GenericExchangeNameRequest))
+		return srv.(GoCryptoTraderServer).WithdrawalEventsByExchange(ctx, req.(*WithdrawalEventsByExchangeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetExchangeOTPCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetExchangeOTPsRequest)
+func _GoCryptoTrader_WithdrawalEventsByDate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawalEventsByDateRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetExchangeOTPCodes(ctx, in)
+		return srv.(GoCryptoTraderServer).WithdrawalEventsByDate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetExchangeOTPCodes",
+		FullMethod: "/gctrpc.GoCryptoTrader/WithdrawalEventsByDate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetExchangeOTPCodes(ctx, req.(*GetExchangeOTPsRequest))
+		return srv.(GoCryptoTraderServer).WithdrawalEventsByDate(ctx, req.(*WithdrawalEventsByDateRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_EnableExchange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GenericExchangeNameRequest)
+func _GoCryptoTrader_GetLoggerDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLoggerDetailsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).EnableExchange(ctx, in)
+		return srv.(GoCryptoTraderServer).GetLoggerDetails(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/EnableExchange",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetLoggerDetails",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).EnableExchange(ctx, req.(*GenericExchangeNameRequest))
+		return srv.(GoCryptoTraderServer).GetLoggerDetails(ctx, req.(*GetLoggerDetailsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetTicker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTickerRequest)
+func _GoCryptoTrader_SetLoggerDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLoggerDetailsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetTicker(ctx, in)
+		return srv.(GoCryptoTraderServer).SetLoggerDetails(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetTicker",
+		FullMethod: "/gctrpc.GoCryptoTrader/SetLoggerDetails",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetTicker(ctx, req.(*GetTickerRequest))
+		return srv.(GoCryptoTraderServer).SetLoggerDetails(ctx, req.(*SetLoggerDetailsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetTickers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTickersRequest)
+func _GoCryptoTrader_GetExchangePairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExchangePairsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetTickers(ctx, in)
+		return srv.(GoCryptoTraderServer).GetExchangePairs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetTickers",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetExchangePairs",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetTickers(ctx, req.(*GetTickersRequest))
+		return srv.(GoCryptoTraderServer).GetExchangePairs(ctx, req.(*GetExchangePairsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetOrderbook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetOrderbookRequest)
+func _GoCryptoTrader_SetExchangePair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetExchangePairRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetOrderbook(ctx, in)
+		return srv.(GoCryptoTraderServer).SetExchangePair(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetOrderbook",
+		FullMethod: "/gctrpc.GoCryptoTrader/SetExchangePair",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetOrderbook(ctx, req.(*GetOrderbookRequest))
+		return srv.(GoCryptoTraderServer).SetExchangePair(ctx, req.(*SetExchangePairRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetOrderbooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetOrderbooksRequest)
+func _GoCryptoTrader_GetOrderbookStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetOrderbookStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServer).GetOrderbookStream(m, &goCryptoTraderGetOrderbookStreamServer{stream})
+}
+
+type GoCryptoTrader_GetOrderbookStreamServer interface {
+	Send(*OrderbookResponse) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderGetOrderbookStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderGetOrderbookStreamServer) Send(m *OrderbookResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoCryptoTrader_GetExchangeOrderbookStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetExchangeOrderbookStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServer).GetExchangeOrderbookStream(m, &goCryptoTraderGetExchangeOrderbookStreamServer{stream})
+}
+
+type GoCryptoTrader_GetExchangeOrderbookStreamServer interface {
+	Send(*OrderbookResponse) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderGetExchangeOrderbookStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderGetExchangeOrderbookStreamServer) Send(m *OrderbookResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoCryptoTrader_GetTickerStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetTickerStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServer).GetTickerStream(m, &goCryptoTraderGetTickerStreamServer{stream})
+}
+
+type GoCryptoTrader_GetTickerStreamServer interface {
+	Send(*TickerResponse) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderGetTickerStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderGetTickerStreamServer) Send(m *TickerResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoCryptoTrader_GetExchangeTickerStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetExchangeTickerStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServer).GetExchangeTickerStream(m, &goCryptoTraderGetExchangeTickerStreamServer{stream})
+}
+
+type GoCryptoTrader_GetExchangeTickerStreamServer interface {
+	Send(*TickerResponse) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderGetExchangeTickerStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderGetExchangeTickerStreamServer) Send(m *TickerResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoCryptoTrader_GetAuditEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuditEventRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetOrderbooks(ctx, in)
+		return srv.(GoCryptoTraderServer).GetAuditEvent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetOrderbooks",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetAuditEvent",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetOrderbooks(ctx, req.(*GetOrderbooksRequest))
+		return srv.(GoCryptoTraderServer).GetAuditEvent(ctx, req.(*GetAuditEventRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetAccountInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAccountInfoRequest)
+func _GoCryptoTrader_GCTScriptExecute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCTScriptExecuteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetAccountInfo(ctx, in)
+		return srv.(GoCryptoTraderServer).GCTScriptExecute(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetAccountInfo",
+		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptExecute",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetAccountInfo(ctx, req.(*GetAccountInfoRequest))
+		return srv.(GoCryptoTraderServer).GCTScriptExecute(ctx, req.(*GCTScriptExecuteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_UpdateAccountInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAccountInfoRequest)
+func _GoCryptoTrader_GCTScriptUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCTScriptUploadRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).UpdateAccountInfo(ctx, in)
+		return srv.(GoCryptoTraderServer).GCTScriptUpload(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/UpdateAccountInfo",
+		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptUpload",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).UpdateAccountInfo(ctx, req.(*GetAccountInfoRequest))
+		return srv.(GoCryptoTraderServer).GCTScriptUpload(ctx, req.(*GCTScriptUploadRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetAccountInfoStream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetAccountInfoRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(GoCryptoTraderServer).GetAccountInfoStream(m, &goCryptoTraderGetAccountInfoStreamServer{stream})
-}
-
-type GoCryptoTrader_GetAccountInfoStreamServer interface {
-	Send(*GetAccountInfoResponse) error
-	grpc.ServerStream
-}
-
-type goCryptoTraderGetAccountInfoStreamServer struct {
-	grpc.ServerStream
-}
-
-func (x *goCryptoTraderGetAccountInfoStreamServer) Send(m *GetAccountInfoResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _GoCryptoTrader_GetConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetConfigRequest)
+func _GoCryptoTrader_GCTScriptReadScript_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCTScriptReadScriptRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetConfig(ctx, in)
+		return srv.(GoCryptoTraderServer).GCTScriptReadScript(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetConfig",
+		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptReadScript",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetConfig(ctx, req.(*GetConfigRequest))
+		return srv.(GoCryptoTraderServer).GCTScriptReadScript(ctx, req.(*GCTScriptReadScriptRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetPortfolio_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPortfolioRequest)
+func _GoCryptoTrader_GCTScriptStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCTScriptStatusRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetPortfolio(ctx, in)
+		return srv.(GoCryptoTraderServer).GCTScriptStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetPortfolio",
+		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptStatus",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetPortfolio(ctx, req.(*GetPortfolioRequest))
+		return srv.(GoCryptoTraderServer).GCTScriptStatus(ctx, req.(*GCTScriptStatusRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetPortfolioSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPortfolioSummaryRequest)
+func _GoCryptoTrader_GCTScriptQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCTScriptQueryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetPortfolioSummary(ctx, in)
+		return srv.(GoCryptoTraderServer).GCTScriptQuery(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetPortfolioSummary",
+		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptQuery",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetPortfolioSummary(ctx, req.(*GetPortfolioSummaryRequest))
+		return srv.(GoCryptoTraderServer).GCTScriptQuery(ctx, req.(*GCTScriptQueryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_AddPortfolioAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddPortfolioAddressRequest)
+func _GoCryptoTrader_GCTScriptStop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCTScriptStopRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).AddPortfolioAddress(ctx, in)
+		return srv.(GoCryptoTraderServer).GCTScriptStop(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/AddPortfolioAddress",
+		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptStop",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).AddPortfolioAddress(ctx, req.(*AddPortfolioAddressRequest))
+		return srv.(GoCryptoTraderServer).GCTScriptStop(ctx, req.(*GCTScriptStopRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_RemovePortfolioAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemovePortfolioAddressRequest)
+func _GoCryptoTrader_GCTScriptStopAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCTScriptStopAllRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).RemovePortfolioAddress(ctx, in)
+		return srv.(GoCryptoTraderServer).GCTScriptStopAll(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/RemovePortfolioAddress",
+		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptStopAll",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).RemovePortfolioAddress(ctx, req.(*RemovePortfolioAddressRequest))
+		return srv.(GoCryptoTraderServer).GCTScriptStopAll(ctx, req.(*GCTScriptStopAllRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetForexProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetForexProvidersRequest)
+func _GoCryptoTrader_GCTScriptListAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCTScriptListAllRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetForexProviders(ctx, in)
+		return srv.(GoCryptoTraderServer).GCTScriptListAll(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetForexProviders",
+		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptListAll",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetForexProviders(ctx, req.(*GetForexProvidersRequest))
+		return srv.(GoCryptoTraderServer).GCTScriptListAll(ctx, req.(*GCTScriptListAllRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetForexRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetForexRatesRequest)
+func _GoCryptoTrader_GCTScriptAutoLoadToggle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCTScriptAutoLoadRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetForexRates(ctx, in)
+		return srv.(GoCryptoTraderServer).GCTScriptAutoLoadToggle(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetForexRates",
+		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptAutoLoadToggle",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetForexRates(ctx, req.(*GetForexRatesRequest))
+		return srv.(GoCryptoTraderServer).GCTScriptAutoLoadToggle(ctx, req.(*GCTScriptAutoLoadRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetOrdersRequest)
+func _GoCryptoTrader_GetHistoricCandles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHistoricCandlesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetOrders(ctx, in)
+		return srv.(GoCryptoTraderServer).GetHistoricCandles(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetOrders",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetHistoricCandles",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetOrders(ctx, req.(*GetOrdersRequest))
+		return srv.(GoCryptoTraderServer).GetHistoricCandles(ctx, req.(*GetHistoricCandlesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetOrderRequest)
+func _GoCryptoTrader_SetExchangeAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetExchangeAssetRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetOrder(ctx, in)
+		return srv.(GoCryptoTraderServer).SetExchangeAsset(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetOrder",
+		FullMethod: "/gctrpc.GoCryptoTrader/SetExchangeAsset",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetOrder(ctx, req.(*GetOrderRequest))
+		return srv.(GoCryptoTraderServer).SetExchangeAsset(ctx, req.(*SetExchangeAssetRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_SubmitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SubmitOrderRequest)
+func _GoCryptoTrader_SetAllExchangePairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetExchangeAllPairsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).SubmitOrder(ctx, in)
+		return srv.(GoCryptoTraderServer).SetAllExchangePairs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/SubmitOrder",
+		FullMethod: "/gctrpc.GoCryptoTrader/SetAllExchangePairs",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).SubmitOrder(ctx, req.(*SubmitOrderRequest))
+		return srv.(GoCryptoTraderServer).SetAllExchangePairs(ctx, req.(*SetExchangeAllPairsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_SimulateOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SimulateOrderRequest)
+func _GoCryptoTrader_UpdateExchangeSupportedPairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateExchangeSupportedPairsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).SimulateOrder(ctx, in)
+		return srv.(GoCryptoTraderServer).UpdateExchangeSupportedPairs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/SimulateOrder",
+		FullMethod: "/gctrpc.GoCryptoTrader/UpdateExchangeSupportedPairs",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).SimulateOrder(ctx, req.(*SimulateOrderRequest))
+		return srv.(GoCryptoTraderServer).UpdateExchangeSupportedPairs(ctx, req.(*UpdateExchangeSupportedPairsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_WhaleBomb_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WhaleBombRequest)
+func _GoCryptoTrader_GetExchangeAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExchangeAssetsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).WhaleBomb(ctx, in)
+		return srv.(GoCryptoTraderServer).GetExchangeAssets(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/WhaleBomb",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetExchangeAssets",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).WhaleBomb(ctx, req.(*WhaleBombRequest))
+		return srv.(GoCryptoTraderServer).GetExchangeAssets(ctx, req.(*GetExchangeAssetsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CancelOrderRequest)
+func _GoCryptoTrader_WebsocketGetInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebsocketGetInfoRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).CancelOrder(ctx, in)
+		return srv.(GoCryptoTraderServer).WebsocketGetInfo(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/CancelOrder",
+		FullMethod: "/gctrpc.GoCryptoTrader/WebsocketGetInfo",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).CancelOrder(ctx, req.(*CancelOrderRequest))
+		return srv.(GoCryptoTraderServer).WebsocketGetInfo(ctx, req.(*WebsocketGetInfoRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_CancelBatchOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CancelBatchOrdersRequest)
+func _GoCryptoTrader_WebsocketSetEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebsocketSetEnabledRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).CancelBatchOrders(ctx, in)
+		return srv.(GoCryptoTraderServer).WebsocketSetEnabled(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/CancelBatchOrders",
+		FullMethod: "/gctrpc.GoCryptoTrader/WebsocketSetEnabled",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).CancelBatchOrders(ctx, req.(*CancelBatchOrdersRequest))
+		return srv.(GoCryptoTraderServer).WebsocketSetEnabled(ctx, req.(*WebsocketSetEnabledRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_CancelAllOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CancelAllOrdersRequest)
+func _GoCryptoTrader_WebsocketGetSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebsocketGetSubscriptionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).CancelAllOrders(ctx, in)
+		return srv.(GoCryptoTraderServer).WebsocketGetSubscriptions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/CancelAllOrders",
+		FullMethod: "/gctrpc.GoCryptoTrader/WebsocketGetSubscriptions",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).CancelAllOrders(ctx, req.(*CancelAllOrdersRequest))
+		return srv.(GoCryptoTraderServer).WebsocketGetSubscriptions(ctx, req.(*WebsocketGetSubscriptionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetEventsRequest)
+func _GoCryptoTrader_WebsocketSetProxy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebsocketSetProxyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetEvents(ctx, in)
+		return srv.(GoCryptoTraderServer).WebsocketSetProxy(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetEvents",
+		FullMethod: "/gctrpc.GoCryptoTrader/WebsocketSetProxy",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetEvents(ctx, req.(*GetEventsRequest))
+		return srv.(GoCryptoTraderServer).WebsocketSetProxy(ctx, req.(*WebsocketSetProxyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_AddEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddEventRequest)
+func _GoCryptoTrader_WebsocketSetURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebsocketSetURLRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).AddEvent(ctx, in)
+		return srv.(GoCryptoTraderServer).WebsocketSetURL(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/AddEvent",
+		FullMethod: "/gctrpc.GoCryptoTrader/WebsocketSetURL",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).AddEvent(ctx, req.(*AddEventRequest))
+		return srv.(GoCryptoTraderServer).WebsocketSetURL(ctx, req.(*WebsocketSetURLRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_RemoveEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveEventRequest)
+func _GoCryptoTrader_GetRecentTrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSavedTradesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).RemoveEvent(ctx, in)
+		return srv.(GoCryptoTraderServer).GetRecentTrades(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/RemoveEvent",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetRecentTrades",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).RemoveEvent(ctx, req.(*RemoveEventRequest))
+		return srv.(GoCryptoTraderServer).GetRecentTrades(ctx, req.(*GetSavedTradesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetCryptocurrencyDepositAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCryptocurrencyDepositAddressesRequest)
+func _GoCryptoTrader_GetHistoricTrades_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetSavedTradesRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServer).GetHistoricTrades(m, &goCryptoTraderGetHistoricTradesServer{stream})
+}
+
+type GoCryptoTrader_GetHistoricTradesServer interface {
+	Send(*SavedTradesResponse) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderGetHistoricTradesServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderGetHistoricTradesServer) Send(m *SavedTradesResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoCryptoTrader_GetSavedTrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSavedTradesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetCryptocurrencyDepositAddresses(ctx, in)
+		return srv.(GoCryptoTraderServer).GetSavedTrades(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetCryptocurrencyDepositAddresses",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetSavedTrades",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetCryptocurrencyDepositAddresses(ctx, req.(*GetCryptocurrencyDepositAddressesRequest))
+		return srv.(GoCryptoTraderServer).GetSavedTrades(ctx, req.(*GetSavedTradesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetCryptocurrencyDepositAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCryptocurrencyDepositAddressRequest)
+func _GoCryptoTrader_ConvertTradesToCandles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConvertTradesToCandlesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetCryptocurrencyDepositAddress(ctx, in)
+		return srv.(GoCryptoTraderServer).ConvertTradesToCandles(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetCryptocurrencyDepositAddress",
+		FullMethod: "/gctrpc.GoCryptoTrader/ConvertTradesToCandles",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetCryptocurrencyDepositAddress(ctx, req.(*GetCryptocurrencyDepositAddressRequest))
+		return srv.(GoCryptoTraderServer).ConvertTradesToCandles(ctx, req.(*ConvertTradesToCandlesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_WithdrawFiatFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WithdrawFiatRequest)
+func _GoCryptoTrader_FindMissingSavedCandleIntervals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindMissingCandlePeriodsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).WithdrawFiatFunds(ctx, in)
+		return srv.(GoCryptoTraderServer).FindMissingSavedCandleIntervals(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/WithdrawFiatFunds",
+		FullMethod: "/gctrpc.GoCryptoTrader/FindMissingSavedCandleIntervals",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).WithdrawFiatFunds(ctx, req.(*WithdrawFiatRequest))
+		return srv.(GoCryptoTraderServer).FindMissingSavedCandleIntervals(ctx, req.(*FindMissingCandlePeriodsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_WithdrawCryptocurrencyFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WithdrawCryptoRequest)
+func _GoCryptoTrader_FindMissingSavedTradeIntervals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FindMissingTradePeriodsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).WithdrawCryptocurrencyFunds(ctx, in)
+		return srv.(GoCryptoTraderServer).FindMissingSavedTradeIntervals(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/WithdrawCryptocurrencyFunds",
+		FullMethod: "/gctrpc.GoCryptoTrader/FindMissingSavedTradeIntervals",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).WithdrawCryptocurrencyFunds(ctx, req.(*WithdrawCryptoRequest))
+		return srv.(GoCryptoTraderServer).FindMissingSavedTradeIntervals(ctx, req.(*FindMissingTradePeriodsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_WithdrawalEventByID_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WithdrawalEventByIDRequest)
+func _GoCryptoTrader_SetExchangeTradeProcessing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetExchangeTradeProcessingRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).WithdrawalEventByID(ctx, in)
+		return srv.(GoCryptoTraderServer).SetExchangeTradeProcessing(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/WithdrawalEventByID",
+		FullMethod: "/gctrpc.GoCryptoTrader/SetExchangeTradeProcessing",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).WithdrawalEventByID(ctx, req.(*WithdrawalEventByIDRequest))
+		return srv.(GoCryptoTraderServer).SetExchangeTradeProcessing(ctx, req.(*SetExchangeTradeProcessingRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_WithdrawalEventsByExchange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WithdrawalEventsByExchangeRequest)
+func _GoCryptoTrader_UpsertDataHistoryJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertDataHistoryJobRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).WithdrawalEventsByExchange(ctx, in)
+		return srv.(GoCryptoTraderServer).UpsertDataHistoryJob(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/WithdrawalEventsByExchange",
+		FullMethod: "/gctrpc.GoCryptoTrader/UpsertDataHistoryJob",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).WithdrawalEventsByExchange(ctx, req.(*WithdrawalEventsByExchangeRequest))
+		return srv.(GoCryptoTraderServer).UpsertDataHistoryJob(ctx, req.(*UpsertDataHistoryJobRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_WithdrawalEventsByDate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WithdrawalEventsByDateRequest)
+func _GoCryptoTrader_GetDataHistoryJobDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDataHistoryJobDetailsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).WithdrawalEventsByDate(ctx, in)
+		return srv.(GoCryptoTraderServer).GetDataHistoryJobDetails(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/WithdrawalEventsByDate",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetDataHistoryJobDetails",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).WithdrawalEventsByDate(ctx, req.(*WithdrawalEventsByDateRequest))
+		return srv.(GoCryptoTraderServer).GetDataHistoryJobDetails(ctx, req.(*GetDataHistoryJobDetailsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetLoggerDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetLoggerDetailsRequest)
+func _GoCryptoTrader_GetActiveDataHistoryJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInfoRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetLoggerDetails(ctx, in)
+		return srv.(GoCryptoTraderServer).GetActiveDataHistoryJobs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetLoggerDetails",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetActiveDataHistoryJobs",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetLoggerDetails(ctx, req.(*GetLoggerDetailsRequest))
+		return srv.(GoCryptoTraderServer).GetActiveDataHistoryJobs(ctx, req.(*GetInfoRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_SetLoggerDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetLoggerDetailsRequest)
+func _GoCryptoTrader_GetDataHistoryJobsBetween_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDataHistoryJobsBetweenRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).SetLoggerDetails(ctx, in)
+		return srv.(GoCryptoTraderServer).GetDataHistoryJobsBetween(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/SetLoggerDetails",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetDataHistoryJobsBetween",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).SetLoggerDetails(ctx, req.(*SetLoggerDetailsRequest))
+		return srv.(GoCryptoTraderServer).GetDataHistoryJobsBetween(ctx, req.(*GetDataHistoryJobsBetweenRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetExchangePairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetExchangePairsRequest)
+func _GoCryptoTrader_GetDataHistoryJobSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDataHistoryJobDetailsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetExchangePairs(ctx, in)
+		return srv.(GoCryptoTraderServer).GetDataHistoryJobSummary(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetExchangePairs",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetDataHistoryJobSummary",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetExchangePairs(ctx, req.(*GetExchangePairsRequest))
+		return srv.(GoCryptoTraderServer).GetDataHistoryJobSummary(ctx, req.(*GetDataHistoryJobDetailsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_SetExchangePair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetExchangePairRequest)
+func _GoCryptoTrader_SetDataHistoryJobStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetDataHistoryJobStatusRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).SetExchangePair(ctx, in)
+		return srv.(GoCryptoTraderServer).SetDataHistoryJobStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/SetExchangePair",
+		FullMethod: "/gctrpc.GoCryptoTrader/SetDataHistoryJobStatus",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).SetExchangePair(ctx, req.(*SetExchangePairRequest))
+		return srv.(GoCryptoTraderServer).SetDataHistoryJobStatus(ctx, req.(*SetDataHistoryJobStatusRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetOrderbookStream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetOrderbookStreamRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _GoCryptoTrader_UpdateDataHistoryJobPrerequisite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDataHistoryJobPrerequisiteRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(GoCryptoTraderServer).GetOrderbookStream(m, &goCryptoTraderGetOrderbookStreamServer{stream})
-}
-
-type GoCryptoTrader_GetOrderbookStreamServer interface {
-	Send(*OrderbookResponse) error
-	grpc.ServerStream
-}
-
-type goCryptoTraderGetOrderbookStreamServer struct {
-	grpc.ServerStream
-}
-
-func (x *goCryptoTraderGetOrderbookStreamServer) Send(m *OrderbookResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _GoCryptoTrader_GetExchangeOrderbookStream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetExchangeOrderbookStreamRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).UpdateDataHistoryJobPrerequisite(ctx, in)
 	}
-	return srv.(GoCryptoTraderServer).GetExchangeOrderbookStream(m, &goCryptoTraderGetExchangeOrderbookStreamServer{stream})
-}
-
-type GoCryptoTrader_GetExchangeOrderbookStreamServer interface {
-	Send(*OrderbookResponse) error
-	grpc.ServerStream
-}
-
-type goCryptoTraderGetExchangeOrderbookStreamServer struct {
-	grpc.ServerStream
-}
-
-func (x *goCryptoTraderGetExchangeOrderbookStreamServer) Send(m *OrderbookResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _GoCryptoTrader_GetTickerStream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetTickerStreamRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/UpdateDataHistoryJobPrerequisite",
 	}
-	return srv.(GoCryptoTraderServer).GetTickerStream(m, &goCryptoTraderGetTickerStreamServer{stream})
-}
-
-type GoCryptoTrader_GetTickerStreamServer interface {
-	Send(*TickerResponse) error
-	grpc.ServerStream
-}
-
-type goCryptoTraderGetTickerStreamServer struct {
-	grpc.ServerStream
-}
-
-func (x *goCryptoTraderGetTickerStreamServer) Send(m *TickerResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _GoCryptoTrader_GetExchangeTickerStream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetExchangeTickerStreamRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).UpdateDataHistoryJobPrerequisite(ctx, req.(*UpdateDataHistoryJobPrerequisiteRequest))
 	}
-	return srv.(GoCryptoTraderServer).GetExchangeTickerStream(m, &goCryptoTraderGetExchangeTickerStreamServer{stream})
-}
-
-type GoCryptoTrader_GetExchangeTickerStreamServer interface {
-	Send(*TickerResponse) error
-	grpc.ServerStream
-}
-
-type goCryptoTraderGetExchangeTickerStreamServer struct {
-	grpc.ServerStream
-}
-
-func (x *goCryptoTraderGetExchangeTickerStreamServer) Send(m *TickerResponse) error {
-	return x.ServerStream.SendMsg(m)
+	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetAuditEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAuditEventRequest)
+func _GoCryptoTrader_GetManagedOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrdersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetAuditEvent(ctx, in)
+		return srv.(GoCryptoTraderServer).GetManagedOrders(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetAuditEvent",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetManagedOrders",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetAuditEvent(ctx, req.(*GetAuditEventRequest))
+		return srv.(GoCryptoTraderServer).GetManagedOrders(ctx, req.(*GetOrdersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GCTScriptExecute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GCTScriptExecuteRequest)
+func _GoCryptoTrader_ModifyOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModifyOrderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GCTScriptExecute(ctx, in)
+		return srv.(GoCryptoTraderServer).ModifyOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptExecute",
+		FullMethod: "/gctrpc.GoCryptoTrader/ModifyOrder",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GCTScriptExecute(ctx, req.(*GCTScriptExecuteRequest))
+		return srv.(GoCryptoTraderServer).ModifyOrder(ctx, req.(*ModifyOrderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GCTScriptUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GCTScriptUploadRequest)
+func _GoCryptoTrader_CurrencyStateGetAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CurrencyStateGetAllRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GCTScriptUpload(ctx, in)
+		return srv.(GoCryptoTraderServer).CurrencyStateGetAll(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptUpload",
+		FullMethod: "/gctrpc.GoCryptoTrader/CurrencyStateGetAll",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GCTScriptUpload(ctx, req.(*GCTScriptUploadRequest))
+		return srv.(GoCryptoTraderServer).CurrencyStateGetAll(ctx, req.(*CurrencyStateGetAllRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GCTScriptReadScript_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GCTScriptReadScriptRequest)
+func _GoCryptoTrader_CurrencyStateTrading_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CurrencyStateTradingRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GCTScriptReadScript(ctx, in)
+		return srv.(GoCryptoTraderServer).CurrencyStateTrading(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptReadScript",
+		FullMethod: "/gctrpc.GoCryptoTrader/CurrencyStateTrading",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GCTScriptReadScript(ctx, req.(*GCTScriptReadScriptRequest))
+		return srv.(GoCryptoTraderServer).CurrencyStateTrading(ctx, req.(*CurrencyStateTradingRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GCTScriptStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GCTScriptStatusRequest)
+func _GoCryptoTrader_CurrencyStateDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CurrencyStateDepositRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GCTScriptStatus(ctx, in)
+		return srv.(GoCryptoTraderServer).CurrencyStateDeposit(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptStatus",
+		FullMethod: "/gctrpc.GoCryptoTrader/CurrencyStateDeposit",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GCTScriptStatus(ctx, req.(*GCTScriptStatusRequest))
+		return srv.(GoCryptoTraderServer).CurrencyStateDeposit(ctx, req.(*CurrencyStateDepositRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GCTScriptQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GCTScriptQueryRequest)
+func _GoCryptoTrader_CurrencyStateWithdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CurrencyStateWithdrawRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GCTScriptQuery(ctx, in)
+		return srv.(GoCryptoTraderServer).CurrencyStateWithdraw(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptQuery",
+		FullMethod: "/gctrpc.GoCryptoTrader/CurrencyStateWithdraw",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GCTScriptQuery(ctx, req.(*GCTScriptQueryRequest))
+		return srv.(GoCryptoTraderServer).CurrencyStateWithdraw(ctx, req.(*CurrencyStateWithdrawRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GCTScriptStop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GCTScriptStopRequest)
+func _GoCryptoTrader_CurrencyStateTradingPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CurrencyStateTradingPairRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GCTScriptStop(ctx, in)
+		return srv.(GoCryptoTraderServer).CurrencyStateTradingPair(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptStop",
+		FullMethod: "/gctrpc.GoCryptoTrader/CurrencyStateTradingPair",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GCTScriptStop(ctx, req.(*GCTScriptStopRequest))
+		return srv.(GoCryptoTraderServer).CurrencyStateTradingPair(ctx, req.(*CurrencyStateTradingPairRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GCTScriptStopAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GCTScriptStopAllRequest)
+func _GoCryptoTrader_GetConsolidatedOrderbook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConsolidatedOrderbookRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GCTScriptStopAll(ctx, in)
+		return srv.(GoCryptoTraderServer).GetConsolidatedOrderbook(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptStopAll",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetConsolidatedOrderbook",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GCTScriptStopAll(ctx, req.(*GCTScriptStopAllRequest))
+		return srv.(GoCryptoTraderServer).GetConsolidatedOrderbook(ctx, req.(*GetConsolidatedOrderbookRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GCTScriptListAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GCTScriptListAllRequest)
+func _GoCryptoTrader_GetConsolidatedOrderbookStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetConsolidatedOrderbookRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServer).GetConsolidatedOrderbookStream(m, &goCryptoTraderGetConsolidatedOrderbookStreamServer{stream})
+}
+
+type GoCryptoTrader_GetConsolidatedOrderbookStreamServer interface {
+	Send(*ConsolidatedOrderbookResponse) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderGetConsolidatedOrderbookStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderGetConsolidatedOrderbookStreamServer) Send(m *ConsolidatedOrderbookResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoCryptoTrader_GetOrderbookAnalytics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderbookAnalyticsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GCTScriptListAll(ctx, in)
+		return srv.(GoCryptoTraderServer).GetOrderbookAnalytics(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptListAll",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetOrderbookAnalytics",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GCTScriptListAll(ctx, req.(*GCTScriptListAllRequest))
+		return srv.(GoCryptoTraderServer).GetOrderbookAnalytics(ctx, req.(*GetOrderbookAnalyticsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GCTScriptAutoLoadToggle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GCTScriptAutoLoadRequest)
+func _GoCryptoTrader_GetOrderbookSpreadStatistics_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderbookSpreadStatisticsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GCTScriptAutoLoadToggle(ctx, in)
+		return srv.(GoCryptoTraderServer).GetOrderbookSpreadStatistics(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptAutoLoadToggle",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetOrderbookSpreadStatistics",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GCTScriptAutoLoadToggle(ctx, req.(*GCTScriptAutoLoadRequest))
+		return srv.(GoCryptoTraderServer).GetOrderbookSpreadStatistics(ctx, req.(*GetOrderbookSpreadStatisticsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetHistoricCandles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetHistoricCandlesRequest)
+func _GoCryptoTrader_GetOrderbookHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderbookHistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetHistoricCandles(ctx, in)
+		return srv.(GoCryptoTraderServer).GetOrderbookHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetHistoricCandles",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetOrderbookHistory",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetHistoricCandles(ctx, req.(*GetHistoricCandlesRequest))
+		return srv.(GoCryptoTraderServer).GetOrderbookHistory(ctx, req.(*GetOrderbookHistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_SetExchangeAsset_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetExchangeAssetRequest)
+func _GoCryptoTrader_GetFundingRate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FuturesMarketDataRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).SetExchangeAsset(ctx, in)
+		return srv.(GoCryptoTraderServer).GetFundingRate(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/SetExchangeAsset",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetFundingRate",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).SetExchangeAsset(ctx, req.(*SetExchangeAssetRequest))
+		return srv.(GoCryptoTraderServer).GetFundingRate(ctx, req.(*FuturesMarketDataRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_SetAllExchangePairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetExchangeAllPairsRequest)
+func _GoCryptoTrader_GetFundingRateHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFundingRateHistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).SetAllExchangePairs(ctx, in)
+		return srv.(GoCryptoTraderServer).GetFundingRateHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/SetAllExchangePairs",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetFundingRateHistory",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).SetAllExchangePairs(ctx, req.(*SetExchangeAllPairsRequest))
+		return srv.(GoCryptoTraderServer).GetFundingRateHistory(ctx, req.(*GetFundingRateHistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_UpdateExchangeSupportedPairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateExchangeSupportedPairsRequest)
+func _GoCryptoTrader_GetOpenInterest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FuturesMarketDataRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).UpdateExchangeSupportedPairs(ctx, in)
+		return srv.(GoCryptoTraderServer).GetOpenInterest(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/UpdateExchangeSupportedPairs",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetOpenInterest",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).UpdateExchangeSupportedPairs(ctx, req.(*UpdateExchangeSupportedPairsRequest))
+		return srv.(GoCryptoTraderServer).GetOpenInterest(ctx, req.(*FuturesMarketDataRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetExchangeAssets_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetExchangeAssetsRequest)
+func _GoCryptoTrader_GetMarkPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FuturesMarketDataRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetExchangeAssets(ctx, in)
+		return srv.(GoCryptoTraderServer).GetMarkPrice(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetExchangeAssets",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetMarkPrice",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetExchangeAssets(ctx, req.(*GetExchangeAssetsRequest))
+		return srv.(GoCryptoTraderServer).GetMarkPrice(ctx, req.(*FuturesMarketDataRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_WebsocketGetInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WebsocketGetInfoRequest)
+func _GoCryptoTrader_GetFuturesPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FuturesMarketDataRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).WebsocketGetInfo(ctx, in)
+		return srv.(GoCryptoTraderServer).GetFuturesPositions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/WebsocketGetInfo",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetFuturesPositions",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).WebsocketGetInfo(ctx, req.(*WebsocketGetInfoRequest))
+		return srv.(GoCryptoTraderServer).GetFuturesPositions(ctx, req.(*FuturesMarketDataRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_WebsocketSetEnabled_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WebsocketSetEnabledRequest)
+func _GoCryptoTrader_ChangeFuturesLeverage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeFuturesLeverageRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).WebsocketSetEnabled(ctx, in)
+		return srv.(GoCryptoTraderServer).ChangeFuturesLeverage(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/WebsocketSetEnabled",
+		FullMethod: "/gctrpc.GoCryptoTrader/ChangeFuturesLeverage",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).WebsocketSetEnabled(ctx, req.(*WebsocketSetEnabledRequest))
+		return srv.(GoCryptoTraderServer).ChangeFuturesLeverage(ctx, req.(*ChangeFuturesLeverageRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_WebsocketGetSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WebsocketGetSubscriptionsRequest)
+func _GoCryptoTrader_ChangeFuturesMarginType_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ChangeFuturesMarginTypeRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).WebsocketGetSubscriptions(ctx, in)
+		return srv.(GoCryptoTraderServer).ChangeFuturesMarginType(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/WebsocketGetSubscriptions",
+		FullMethod: "/gctrpc.GoCryptoTrader/ChangeFuturesMarginType",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).WebsocketGetSubscriptions(ctx, req.(*WebsocketGetSubscriptionsRequest))
+		return srv.(GoCryptoTraderServer).ChangeFuturesMarginType(ctx, req.(*ChangeFuturesMarginTypeRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_WebsocketSetProxy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WebsocketSetProxyRequest)
+func _GoCryptoTrader_GetFuturesMarginInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FuturesMarketDataRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).WebsocketSetProxy(ctx, in)
+		return srv.(GoCryptoTraderServer).GetFuturesMarginInfo(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/WebsocketSetProxy",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetFuturesMarginInfo",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).WebsocketSetProxy(ctx, req.(*WebsocketSetProxyRequest))
+		return srv.(GoCryptoTraderServer).GetFuturesMarginInfo(ctx, req.(*FuturesMarketDataRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_WebsocketSetURL_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WebsocketSetURLRequest)
+func _GoCryptoTrader_TransferFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TransferFundsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).WebsocketSetURL(ctx, in)
+		return srv.(GoCryptoTraderServer).TransferFunds(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/WebsocketSetURL",
+		FullMethod: "/gctrpc.GoCryptoTrader/TransferFunds",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).WebsocketSetURL(ctx, req.(*WebsocketSetURLRequest))
+		return srv.(GoCryptoTraderServer).TransferFunds(ctx, req.(*TransferFundsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetRecentTrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSavedTradesRequest)
+func _GoCryptoTrader_GetMarginInterestRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarginCurrencyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetRecentTrades(ctx, in)
+		return srv.(GoCryptoTraderServer).GetMarginInterestRates(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetRecentTrades",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetMarginInterestRates",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetRecentTrades(ctx, req.(*GetSavedTradesRequest))
+		return srv.(GoCryptoTraderServer).GetMarginInterestRates(ctx, req.(*MarginCurrencyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetHistoricTrades_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetSavedTradesRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
-	}
-	return srv.(GoCryptoTraderServer).GetHistoricTrades(m, &goCryptoTraderGetHistoricTradesServer{stream})
-}
-
-type GoCryptoTrader_GetHistoricTradesServer interface {
-	Send(*SavedTradesResponse) error
-	grpc.ServerStream
-}
-
-type goCryptoTraderGetHistoricTradesServer struct {
-	grpc.ServerStream
-}
-
-func (x *goCryptoTraderGetHistoricTradesServer) Send(m *SavedTradesResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _GoCryptoTrader_GetSavedTrades_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSavedTradesRequest)
+func _GoCryptoTrader_MarginBorrow_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarginLoanRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetSavedTrades(ctx, in)
+		return srv.(GoCryptoTraderServer).MarginBorrow(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetSavedTrades",
+		FullMethod: "/gctrpc.GoCryptoTrader/MarginBorrow",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetSavedTrades(ctx, req.(*GetSavedTradesRequest))
+		return srv.(GoCryptoTraderServer).MarginBorrow(ctx, req.(*MarginLoanRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_ConvertTradesToCandles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ConvertTradesToCandlesRequest)
+func _GoCryptoTrader_MarginRepay_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarginLoanRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).ConvertTradesToCandles(ctx, in)
+		return srv.(GoCryptoTraderServer).MarginRepay(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/ConvertTradesToCandles",
+		FullMethod: "/gctrpc.GoCryptoTrader/MarginRepay",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).ConvertTradesToCandles(ctx, req.(*ConvertTradesToCandlesRequest))
+		return srv.(GoCryptoTraderServer).MarginRepay(ctx, req.(*MarginLoanRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_FindMissingSavedCandleIntervals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FindMissingCandlePeriodsRequest)
+func _GoCryptoTrader_SubmitMarginLendOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitMarginLendOfferRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).FindMissingSavedCandleIntervals(ctx, in)
+		return srv.(GoCryptoTraderServer).SubmitMarginLendOffer(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/FindMissingSavedCandleIntervals",
+		FullMethod: "/gctrpc.GoCryptoTrader/SubmitMarginLendOffer",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).FindMissingSavedCandleIntervals(ctx, req.(*FindMissingCandlePeriodsRequest))
+		return srv.(GoCryptoTraderServer).SubmitMarginLendOffer(ctx, req.(*SubmitMarginLendOfferRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_FindMissingSavedTradeIntervals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(FindMissingTradePeriodsRequest)
+func _GoCryptoTrader_CancelMarginLendOffer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelMarginLendOfferRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).FindMissingSavedTradeIntervals(ctx, in)
+		return srv.(GoCryptoTraderServer).CancelMarginLendOffer(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/FindMissingSavedTradeIntervals",
+		FullMethod: "/gctrpc.GoCryptoTrader/CancelMarginLendOffer",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).FindMissingSavedTradeIntervals(ctx, req.(*FindMissingTradePeriodsRequest))
+		return srv.(GoCryptoTraderServer).CancelMarginLendOffer(ctx, req.(*CancelMarginLendOfferRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_SetExchangeTradeProcessing_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetExchangeTradeProcessingRequest)
+func _GoCryptoTrader_GetMarginLoans_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MarginCurrencyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).SetExchangeTradeProcessing(ctx, in)
+		return srv.(GoCryptoTraderServer).GetMarginLoans(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/SetExchangeTradeProcessing",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetMarginLoans",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).SetExchangeTradeProcessing(ctx, req.(*SetExchangeTradeProcessingRequest))
+		return srv.(GoCryptoTraderServer).GetMarginLoans(ctx, req.(*MarginCurrencyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_UpsertDataHistoryJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpsertDataHistoryJobRequest)
+func _GoCryptoTrader_GetMarginInterestHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMarginInterestHistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).UpsertDataHistoryJob(ctx, in)
+		return srv.(GoCryptoTraderServer).GetMarginInterestHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/UpsertDataHistoryJob",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetMarginInterestHistory",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).UpsertDataHistoryJob(ctx, req.(*UpsertDataHistoryJobRequest))
+		return srv.(GoCryptoTraderServer).GetMarginInterestHistory(ctx, req.(*GetMarginInterestHistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetDataHistoryJobDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetDataHistoryJobDetailsRequest)
+func _GoCryptoTrader_GetFeeSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFeeScheduleRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetDataHistoryJobDetails(ctx, in)
+		return srv.(GoCryptoTraderServer).GetFeeSchedule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetDataHistoryJobDetails",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetFeeSchedule",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetDataHistoryJobDetails(ctx, req.(*GetDataHistoryJobDetailsRequest))
+		return srv.(GoCryptoTraderServer).GetFeeSchedule(ctx, req.(*GetFeeScheduleRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetActiveDataHistoryJobs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetInfoRequest)
+func _GoCryptoTrader_GetAvailableTransferChains_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAvailableTransferChainsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetActiveDataHistoryJobs(ctx, in)
+		return srv.(GoCryptoTraderServer).GetAvailableTransferChains(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetActiveDataHistoryJobs",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetAvailableTransferChains",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetActiveDataHistoryJobs(ctx, req.(*GetInfoRequest))
+		return srv.(GoCryptoTraderServer).GetAvailableTransferChains(ctx, req.(*GetAvailableTransferChainsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetDataHistoryJobsBetween_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetDataHistoryJobsBetweenRequest)
+func _GoCryptoTrader_SubmitBatchOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitBatchOrdersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetDataHistoryJobsBetween(ctx, in)
+		return srv.(GoCryptoTraderServer).SubmitBatchOrders(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetDataHistoryJobsBetween",
+		FullMethod: "/gctrpc.GoCryptoTrader/SubmitBatchOrders",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetDataHistoryJobsBetween(ctx, req.(*GetDataHistoryJobsBetweenRequest))
+		return srv.(GoCryptoTraderServer).SubmitBatchOrders(ctx, req.(*SubmitBatchOrdersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetDataHistoryJobSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetDataHistoryJobDetailsRequest)
+func _GoCryptoTrader_ModifyBatchOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModifyBatchOrdersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetDataHistoryJobSummary(ctx, in)
+		return srv.(GoCryptoTraderServer).ModifyBatchOrders(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetDataHistoryJobSummary",
+		FullMethod: "/gctrpc.GoCryptoTrader/ModifyBatchOrders",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetDataHistoryJobSummary(ctx, req.(*GetDataHistoryJobDetailsRequest))
+		return srv.(GoCryptoTraderServer).ModifyBatchOrders(ctx, req.(*ModifyBatchOrdersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_SetDataHistoryJobStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetDataHistoryJobStatusRequest)
+func _GoCryptoTrader_GetFillStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetFillStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServer).GetFillStream(m, &goCryptoTraderGetFillStreamServer{stream})
+}
+
+type GoCryptoTrader_GetFillStreamServer interface {
+	Send(*FillResponse) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderGetFillStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderGetFillStreamServer) Send(m *FillResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoCryptoTrader_GetExchangeCapabilities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenericExchangeNameRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).SetDataHistoryJobStatus(ctx, in)
+		return srv.(GoCryptoTraderServer).GetExchangeCapabilities(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/SetDataHistoryJobStatus",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetExchangeCapabilities",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).SetDataHistoryJobStatus(ctx, req.(*SetDataHistoryJobStatusRequest))
+		return srv.(GoCryptoTraderServer).GetExchangeCapabilities(ctx, req.(*GenericExchangeNameRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_UpdateDataHistoryJobPrerequisite_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(UpdateDataHistoryJobPrerequisiteRequest)
+func _GoCryptoTrader_ExportData_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExportDataRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).UpdateDataHistoryJobPrerequisite(ctx, in)
+		return srv.(GoCryptoTraderServer).ExportData(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/UpdateDataHistoryJobPrerequisite",
+		FullMethod: "/gctrpc.GoCryptoTrader/ExportData",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).UpdateDataHistoryJobPrerequisite(ctx, req.(*UpdateDataHistoryJobPrerequisiteRequest))
+		return srv.(GoCryptoTraderServer).ExportData(ctx, req.(*ExportDataRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetManagedOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetOrdersRequest)
+func _GoCryptoTrader_UpsertDataHistoryJobsForEnabledPairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpsertDataHistoryJobRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetManagedOrders(ctx, in)
+		return srv.(GoCryptoTraderServer).UpsertDataHistoryJobsForEnabledPairs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetManagedOrders",
+		FullMethod: "/gctrpc.GoCryptoTrader/UpsertDataHistoryJobsForEnabledPairs",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetManagedOrders(ctx, req.(*GetOrdersRequest))
+		return srv.(GoCryptoTraderServer).UpsertDataHistoryJobsForEnabledPairs(ctx, req.(*UpsertDataHistoryJobRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_ModifyOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ModifyOrderRequest)
+func _GoCryptoTrader_GetDataQualityReport_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDataQualityReportRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).ModifyOrder(ctx, in)
+		return srv.(GoCryptoTraderServer).GetDataQualityReport(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/ModifyOrder",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetDataQualityReport",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).ModifyOrder(ctx, req.(*ModifyOrderRequest))
+		return srv.(GoCryptoTraderServer).GetDataQualityReport(ctx, req.(*GetDataQualityReportRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_CurrencyStateGetAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CurrencyStateGetAllRequest)
+func _GoCryptoTrader_GetCompositeIndexPrices_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCompositeIndexPricesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).CurrencyStateGetAll(ctx, in)
+		return srv.(GoCryptoTraderServer).GetCompositeIndexPrices(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/CurrencyStateGetAll",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetCompositeIndexPrices",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).CurrencyStateGetAll(ctx, req.(*CurrencyStateGetAllRequest))
+		return srv.(GoCryptoTraderServer).GetCompositeIndexPrices(ctx, req.(*GetCompositeIndexPricesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_CurrencyStateTrading_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CurrencyStateTradingRequest)
+func _GoCryptoTrader_GetLiveCandleStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetLiveCandleStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServer).GetLiveCandleStream(m, &goCryptoTraderGetLiveCandleStreamServer{stream})
+}
+
+type GoCryptoTrader_GetLiveCandleStreamServer interface {
+	Send(*LiveCandleResponse) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderGetLiveCandleStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderGetLiveCandleStreamServer) Send(m *LiveCandleResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoCryptoTrader_RunRetentionPolicies_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RunRetentionPoliciesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).CurrencyStateTrading(ctx, in)
+		return srv.(GoCryptoTraderServer).RunRetentionPolicies(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/CurrencyStateTrading",
+		FullMethod: "/gctrpc.GoCryptoTrader/RunRetentionPolicies",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).CurrencyStateTrading(ctx, req.(*CurrencyStateTradingRequest))
+		return srv.(GoCryptoTraderServer).RunRetentionPolicies(ctx, req.(*RunRetentionPoliciesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_CurrencyStateDeposit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CurrencyStateDepositRequest)
+func _GoCryptoTrader_ConvertTradesToBars_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ConvertTradesToBarsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).CurrencyStateDeposit(ctx, in)
+		return srv.(GoCryptoTraderServer).ConvertTradesToBars(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/CurrencyStateDeposit",
+		FullMethod: "/gctrpc.GoCryptoTrader/ConvertTradesToBars",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).CurrencyStateDeposit(ctx, req.(*CurrencyStateDepositRequest))
+		return srv.(GoCryptoTraderServer).ConvertTradesToBars(ctx, req.(*ConvertTradesToBarsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_CurrencyStateWithdraw_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CurrencyStateWithdrawRequest)
+func _GoCryptoTrader_GetSymbolHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSymbolHistoryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).CurrencyStateWithdraw(ctx, in)
+		return srv.(GoCryptoTraderServer).GetSymbolHistory(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/CurrencyStateWithdraw",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetSymbolHistory",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).CurrencyStateWithdraw(ctx, req.(*CurrencyStateWithdrawRequest))
+		return srv.(GoCryptoTraderServer).GetSymbolHistory(ctx, req.(*GetSymbolHistoryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_CurrencyStateTradingPair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CurrencyStateTradingPairRequest)
+func _GoCryptoTrader_AddSymbolAlias_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddSymbolAliasRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).CurrencyStateTradingPair(ctx, in)
+		return srv.(GoCryptoTraderServer).AddSymbolAlias(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/CurrencyStateTradingPair",
+		FullMethod: "/gctrpc.GoCryptoTrader/AddSymbolAlias",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).CurrencyStateTradingPair(ctx, req.(*CurrencyStateTradingPairRequest))
+		return srv.(GoCryptoTraderServer).AddSymbolAlias(ctx, req.(*AddSymbolAliasRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -3539,6 +4769,138 @@ var GoCryptoTrader_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CurrencyStateTradingPair",
 			Handler:    _GoCryptoTrader_CurrencyStateTradingPair_Handler,
 		},
+		{
+			MethodName: "GetConsolidatedOrderbook",
+			Handler:    _GoCryptoTrader_GetConsolidatedOrderbook_Handler,
+		},
+		{
+			MethodName: "GetOrderbookAnalytics",
+			Handler:    _GoCryptoTrader_GetOrderbookAnalytics_Handler,
+		},
+		{
+			MethodName: "GetOrderbookSpreadStatistics",
+			Handler:    _GoCryptoTrader_GetOrderbookSpreadStatistics_Handler,
+		},
+		{
+			MethodName: "GetOrderbookHistory",
+			Handler:    _GoCryptoTrader_GetOrderbookHistory_Handler,
+		},
+		{
+			MethodName: "GetFundingRate",
+			Handler:    _GoCryptoTrader_GetFundingRate_Handler,
+		},
+		{
+			MethodName: "GetFundingRateHistory",
+			Handler:    _GoCryptoTrader_GetFundingRateHistory_Handler,
+		},
+		{
+			MethodName: "GetOpenInterest",
+			Handler:    _GoCryptoTrader_GetOpenInterest_Handler,
+		},
+		{
+			MethodName: "GetMarkPrice",
+			Handler:    _GoCryptoTrader_GetMarkPrice_Handler,
+		},
+		{
+			MethodName: "GetFuturesPositions",
+			Handler:    _GoCryptoTrader_GetFuturesPositions_Handler,
+		},
+		{
+			MethodName: "ChangeFuturesLeverage",
+			Handler:    _GoCryptoTrader_ChangeFuturesLeverage_Handler,
+		},
+		{
+			MethodName: "ChangeFuturesMarginType",
+			Handler:    _GoCryptoTrader_ChangeFuturesMarginType_Handler,
+		},
+		{
+			MethodName: "GetFuturesMarginInfo",
+			Handler:    _GoCryptoTrader_GetFuturesMarginInfo_Handler,
+		},
+		{
+			MethodName: "TransferFunds",
+			Handler:    _GoCryptoTrader_TransferFunds_Handler,
+		},
+		{
+			MethodName: "GetMarginInterestRates",
+			Handler:    _GoCryptoTrader_GetMarginInterestRates_Handler,
+		},
+		{
+			MethodName: "MarginBorrow",
+			Handler:    _GoCryptoTrader_MarginBorrow_Handler,
+		},
+		{
+			MethodName: "MarginRepay",
+			Handler:    _GoCryptoTrader_MarginRepay_Handler,
+		},
+		{
+			MethodName: "SubmitMarginLendOffer",
+			Handler:    _GoCryptoTrader_SubmitMarginLendOffer_Handler,
+		},
+		{
+			MethodName: "CancelMarginLendOffer",
+			Handler:    _GoCryptoTrader_CancelMarginLendOffer_Handler,
+		},
+		{
+			MethodName: "GetMarginLoans",
+			Handler:    _GoCryptoTrader_GetMarginLoans_Handler,
+		},
+		{
+			MethodName: "GetMarginInterestHistory",
+			Handler:    _GoCryptoTrader_GetMarginInterestHistory_Handler,
+		},
+		{
+			MethodName: "GetFeeSchedule",
+			Handler:    _GoCryptoTrader_GetFeeSchedule_Handler,
+		},
+		{
+			MethodName: "GetAvailableTransferChains",
+			Handler:    _GoCryptoTrader_GetAvailableTransferChains_Handler,
+		},
+		{
+			MethodName: "SubmitBatchOrders",
+			Handler:    _GoCryptoTrader_SubmitBatchOrders_Handler,
+		},
+		{
+			MethodName: "ModifyBatchOrders",
+			Handler:    _GoCryptoTrader_ModifyBatchOrders_Handler,
+		},
+		{
+			MethodName: "GetExchangeCapabilities",
+			Handler:    _GoCryptoTrader_GetExchangeCapabilities_Handler,
+		},
+		{
+			MethodName: "ExportData",
+			Handler:    _GoCryptoTrader_ExportData_Handler,
+		},
+		{
+			MethodName: "UpsertDataHistoryJobsForEnabledPairs",
+			Handler:    _GoCryptoTrader_UpsertDataHistoryJobsForEnabledPairs_Handler,
+		},
+		{
+			MethodName: "GetDataQualityReport",
+			Handler:    _GoCryptoTrader_GetDataQualityReport_Handler,
+		},
+		{
+			MethodName: "GetCompositeIndexPrices",
+			Handler:    _GoCryptoTrader_GetCompositeIndexPrices_Handler,
+		},
+		{
+			MethodName: "RunRetentionPolicies",
+			Handler:    _GoCryptoTrader_RunRetentionPolicies_Handler,
+		},
+		{
+			MethodName: "ConvertTradesToBars",
+			Handler:    _GoCryptoTrader_ConvertTradesToBars_Handler,
+		},
+		{
+			MethodName: "GetSymbolHistory",
+			Handler:    _GoCryptoTrader_GetSymbolHistory_Handler,
+		},
+		{
+			MethodName: "AddSymbolAlias",
+			Handler:    _GoCryptoTrader_AddSymbolAlias_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -3571,6 +4933,21 @@ var GoCryptoTrader_ServiceDesc = grpc.ServiceDesc{
 			Handler:       _GoCryptoTrader_GetHistoricTrades_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetConsolidatedOrderbookStream",
+			Handler:       _GoCryptoTrader_GetConsolidatedOrderbookStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetFillStream",
+			Handler:       _GoCryptoTrader_GetFillStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetLiveCandleStream",
+			Handler:       _GoCryptoTrader_GetLiveCandleStream_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "rpc.proto",
 }
@@ -11,24 +11,28 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/gctscript/modules/ta/indicators"
 	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/withdraw"
 )
 
 var exchangeModule = map[string]objects.Object{
-	"orderbook":      &objects.UserFunction{Name: "orderbook", Value: ExchangeOrderbook},
-	"ticker":         &objects.UserFunction{Name: "ticker", Value: ExchangeTicker},
-	"exchanges":      &objects.UserFunction{Name: "exchanges", Value: ExchangeExchanges},
-	"pairs":          &objects.UserFunction{Name: "pairs", Value: ExchangePairs},
-	"accountinfo":    &objects.UserFunction{Name: "accountinfo", Value: ExchangeAccountInfo},
-	"depositaddress": &objects.UserFunction{Name: "depositaddress", Value: ExchangeDepositAddress},
-	"orderquery":     &objects.UserFunction{Name: "orderquery", Value: ExchangeOrderQuery},
-	"ordercancel":    &objects.UserFunction{Name: "ordercancel", Value: ExchangeOrderCancel},
-	"ordersubmit":    &objects.UserFunction{Name: "ordersubmit", Value: ExchangeOrderSubmit},
-	"withdrawcrypto": &objects.UserFunction{Name: "withdrawcrypto", Value: ExchangeWithdrawCrypto},
-	"withdrawfiat":   &objects.UserFunction{Name: "withdrawfiat", Value: ExchangeWithdrawFiat},
-	"ohlcv":          &objects.UserFunction{Name: "ohlcv", Value: exchangeOHLCV},
+	"orderbook":             &objects.UserFunction{Name: "orderbook", Value: ExchangeOrderbook},
+	"consolidatedorderbook": &objects.UserFunction{Name: "consolidatedorderbook", Value: ExchangeConsolidatedOrderbook},
+	"orderbookanalytics":    &objects.UserFunction{Name: "orderbookanalytics", Value: ExchangeOrderbookAnalytics},
+	"spreadstatistics":      &objects.UserFunction{Name: "spreadstatistics", Value: ExchangeSpreadStatistics},
+	"ticker":                &objects.UserFunction{Name: "ticker", Value: ExchangeTicker},
+	"exchanges":             &objects.UserFunction{Name: "exchanges", Value: ExchangeExchanges},
+	"pairs":                 &objects.UserFunction{Name: "pairs", Value: ExchangePairs},
+	"accountinfo":           &objects.UserFunction{Name: "accountinfo", Value: ExchangeAccountInfo},
+	"depositaddress":        &objects.UserFunction{Name: "depositaddress", Value: ExchangeDepositAddress},
+	"orderquery":            &objects.UserFunction{Name: "orderquery", Value: ExchangeOrderQuery},
+	"ordercancel":           &objects.UserFunction{Name: "ordercancel", Value: ExchangeOrderCancel},
+	"ordersubmit":           &objects.UserFunction{Name: "ordersubmit", Value: ExchangeOrderSubmit},
+	"withdrawcrypto":        &objects.UserFunction{Name: "withdrawcrypto", Value: ExchangeWithdrawCrypto},
+	"withdrawfiat":          &objects.UserFunction{Name: "withdrawfiat", Value: ExchangeWithdrawFiat},
+	"ohlcv":                 &objects.UserFunction{Name: "ohlcv", Value: exchangeOHLCV},
 }
 
 // ExchangeOrderbook returns orderbook for requested exchange & currencypair
@@ -98,6 +102,238 @@ func ExchangeOrderbook(args ...objects.Object) (objects.Object, error) {
 	}, nil
 }
 
+// ExchangeConsolidatedOrderbook returns the merged orderbook for a currency
+// pair across enabled exchanges, any arguments after the convert quote flag
+// filter the exchanges used
+func ExchangeConsolidatedOrderbook(args ...objects.Object) (objects.Object, error) {
+	if len(args) < 4 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	currencyPair, ok := objects.ToString(args[0])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, currencyPair)
+	}
+	delimiter, ok := objects.ToString(args[1])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, delimiter)
+	}
+	assetTypeParam, ok := objects.ToString(args[2])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, assetTypeParam)
+	}
+	convertQuote, ok := objects.ToBool(args[3])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, convertQuote)
+	}
+
+	var exchanges []string
+	for x := 4; x < len(args); x++ {
+		exchangeName, ok := objects.ToString(args[x])
+		if !ok {
+			return nil, fmt.Errorf(ErrParameterConvertFailed, exchangeName)
+		}
+		exchanges = append(exchanges, exchangeName)
+	}
+
+	pair, err := currency.NewPairDelimiter(currencyPair, delimiter)
+	if err != nil {
+		return nil, err
+	}
+
+	assetType, err := asset.New(assetTypeParam)
+	if err != nil {
+		return nil, err
+	}
+
+	ob, err := wrappers.GetWrapper().
+		ConsolidatedOrderbook(pair, assetType, convertQuote, exchanges)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make(map[string]objects.Object, 5)
+	data["pair"] = &objects.String{Value: ob.Pair.String()}
+	data["asset"] = &objects.String{Value: ob.Asset.String()}
+	data["asks"] = consolidatedItemsToObject(ob.Asks)
+	data["bids"] = consolidatedItemsToObject(ob.Bids)
+	var exchs objects.Array
+	for x := range ob.Exchanges {
+		exchs.Value = append(exchs.Value, &objects.String{Value: ob.Exchanges[x]})
+	}
+	data["exchanges"] = &exchs
+
+	return &objects.Map{
+		Value: data,
+	}, nil
+}
+
+func consolidatedItemsToObject(items orderbook.ConsolidatedItems) *objects.Array {
+	var side objects.Array
+	for x := range items {
+		venues := make(map[string]objects.Object, len(items[x].Venues))
+		for y := range items[x].Venues {
+			venues[items[x].Venues[y].Exchange] = &objects.Float{Value: items[x].Venues[y].Amount}
+		}
+		temp := make(map[string]objects.Object, 3)
+		temp["amount"] = &objects.Float{Value: items[x].Amount}
+		temp["price"] = &objects.Float{Value: items[x].Price}
+		temp["venues"] = &objects.Map{Value: venues}
+		side.Value = append(side.Value, &objects.Map{Value: temp})
+	}
+	return &side
+}
+
+// ExchangeOrderbookAnalytics returns imbalance, microprice, liquidity and
+// market impact analytics for an exchange orderbook, any arguments after the
+// basis points are base amounts used to calculate market impact
+func ExchangeOrderbookAnalytics(args ...objects.Object) (objects.Object, error) {
+	if len(args) < 6 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	exchangeName, pair, assetType, err := parseExchangePairAsset(args[:4])
+	if err != nil {
+		return nil, err
+	}
+	levels, ok := objects.ToInt(args[4])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, levels)
+	}
+	bps, ok := objects.ToFloat64(args[5])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, bps)
+	}
+	var impactAmounts []float64
+	for x := 6; x < len(args); x++ {
+		amount, ok := objects.ToFloat64(args[x])
+		if !ok {
+			return nil, fmt.Errorf(ErrParameterConvertFailed, amount)
+		}
+		impactAmounts = append(impactAmounts, amount)
+	}
+
+	a, err := wrappers.GetWrapper().
+		OrderbookAnalytics(exchangeName, pair, assetType, levels, bps, impactAmounts)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make(map[string]objects.Object, 9)
+	data["midprice"] = &objects.Float{Value: a.MidPrice}
+	data["microprice"] = &objects.Float{Value: a.Microprice}
+	data["spread"] = &objects.Float{Value: a.Spread}
+	data["spreadbps"] = &objects.Float{Value: a.SpreadBPS}
+	data["imbalance"] = &objects.Float{Value: a.Imbalance}
+	if a.Liquidity != nil {
+		data["liquidity"] = &objects.Map{Value: map[string]objects.Object{
+			"bidamount": &objects.Float{Value: a.Liquidity.BidAmount},
+			"bidvalue":  &objects.Float{Value: a.Liquidity.BidValue},
+			"askamount": &objects.Float{Value: a.Liquidity.AskAmount},
+			"askvalue":  &objects.Float{Value: a.Liquidity.AskValue},
+		}}
+	}
+	data["buyimpact"] = impactCurveToObject(a.BuyImpact)
+	data["sellimpact"] = impactCurveToObject(a.SellImpact)
+
+	return &objects.Map{
+		Value: data,
+	}, nil
+}
+
+func impactCurveToObject(curve []orderbook.ImpactPoint) *objects.Array {
+	var r objects.Array
+	for x := range curve {
+		temp := make(map[string]objects.Object, 6)
+		temp["amount"] = &objects.Float{Value: curve[x].Amount}
+		temp["filled"] = &objects.Float{Value: curve[x].FilledAmount}
+		temp["averageprice"] = &objects.Float{Value: curve[x].AveragePrice}
+		temp["worstprice"] = &objects.Float{Value: curve[x].WorstPrice}
+		temp["slippagebps"] = &objects.Float{Value: curve[x].SlippageBPS}
+		if curve[x].FullyFilled {
+			temp["fullyfilled"] = objects.TrueValue
+		} else {
+			temp["fullyfilled"] = objects.FalseValue
+		}
+		r.Value = append(r.Value, &objects.Map{Value: temp})
+	}
+	return &r
+}
+
+// ExchangeSpreadStatistics samples an exchange orderbook spread for the
+// supplied duration e.g. "30s" and returns its distribution
+func ExchangeSpreadStatistics(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 5 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	exchangeName, pair, assetType, err := parseExchangePairAsset(args[:4])
+	if err != nil {
+		return nil, err
+	}
+	durationStr, ok := objects.ToString(args[4])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, durationStr)
+	}
+	duration, err := time.ParseDuration(durationStr)
+	if err != nil {
+		return nil, err
+	}
+
+	stats, err := wrappers.GetWrapper().
+		SpreadStatistics(context.TODO(), exchangeName, pair, assetType, duration)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make(map[string]objects.Object, 9)
+	data["samples"] = &objects.Int{Value: stats.Samples}
+	data["min"] = &objects.Float{Value: stats.Min}
+	data["max"] = &objects.Float{Value: stats.Max}
+	data["mean"] = &objects.Float{Value: stats.Mean}
+	data["stddev"] = &objects.Float{Value: stats.StdDev}
+	data["meanbps"] = &objects.Float{Value: stats.MeanBPS}
+	data["last"] = &objects.Float{Value: stats.Last}
+	data["start"] = &objects.Time{Value: stats.Start}
+	data["end"] = &objects.Time{Value: stats.End}
+
+	return &objects.Map{
+		Value: data,
+	}, nil
+}
+
+// parseExchangePairAsset converts the exchange, currency pair, delimiter and
+// asset script arguments
+func parseExchangePairAsset(args []objects.Object) (string, currency.Pair, asset.Item, error) {
+	exchangeName, ok := objects.ToString(args[0])
+	if !ok {
+		return "", currency.Pair{}, "", fmt.Errorf(ErrParameterConvertFailed, exchangeName)
+	}
+	currencyPair, ok := objects.ToString(args[1])
+	if !ok {
+		return "", currency.Pair{}, "", fmt.Errorf(ErrParameterConvertFailed, currencyPair)
+	}
+	delimiter, ok := objects.ToString(args[2])
+	if !ok {
+		return "", currency.Pair{}, "", fmt.Errorf(ErrParameterConvertFailed, delimiter)
+	}
+	assetTypeParam, ok := objects.ToString(args[3])
+	if !ok {
+		return "", currency.Pair{}, "", fmt.Errorf(ErrParameterConvertFailed, assetTypeParam)
+	}
+
+	pair, err := currency.NewPairDelimiter(currencyPair, delimiter)
+	if err != nil {
+		return "", currency.Pair{}, "", err
+	}
+
+	assetType, err := asset.New(assetTypeParam)
+	if err != nil {
+		return "", currency.Pair{}, "", err
+	}
+	return exchangeName, pair, assetType, nil
+}
+
 // ExchangeTicker returns ticker data for requested exchange and currency pair
 func ExchangeTicker(args ...objects.Object) (objects.Object, error) {
 	if len(args) != 4 {
@@ -481,9 +717,10 @@ func ExchangeOrderSubmit(args ...objects.Object) (objects.Object, error) {
 	}, nil
 }
 
-// ExchangeDepositAddress returns deposit address (if supported by exchange)
+// ExchangeDepositAddress returns deposit address (if supported by exchange),
+// an optional chain can be supplied for currencies on multiple networks
 func ExchangeDepositAddress(args ...objects.Object) (objects.Object, error) {
-	if len(args) != 2 {
+	if len(args) != 2 && len(args) != 3 {
 		return nil, objects.ErrWrongNumArguments
 	}
 
@@ -496,19 +733,35 @@ func ExchangeDepositAddress(args ...objects.Object) (objects.Object, error) {
 		return nil, fmt.Errorf(ErrParameterConvertFailed, currencyCode)
 	}
 
+	var chain string
+	if len(args) == 3 {
+		chain, ok = objects.ToString(args[2])
+		if !ok {
+			return nil, fmt.Errorf(ErrParameterConvertFailed, chain)
+		}
+	}
+
 	currCode := currency.NewCode(currencyCode)
 
-	rtn, err := wrappers.GetWrapper().DepositAddress(exchangeName, currCode)
+	rtn, err := wrappers.GetWrapper().DepositAddress(exchangeName, chain, currCode)
 	if err != nil {
 		return nil, err
 	}
 
-	return &objects.String{Value: rtn}, nil
+	data := make(map[string]objects.Object, 3)
+	data["address"] = &objects.String{Value: rtn.Address}
+	data["tag"] = &objects.String{Value: rtn.Tag}
+	data["chain"] = &objects.String{Value: rtn.Chain}
+
+	return &objects.Map{
+		Value: data,
+	}, nil
 }
 
-// ExchangeWithdrawCrypto submit request to withdraw crypto assets
+// ExchangeWithdrawCrypto submit request to withdraw crypto assets, an
+// optional chain can be supplied for currencies on multiple networks
 func ExchangeWithdrawCrypto(args ...objects.Object) (objects.Object, error) {
-	if len(args) != 7 {
+	if len(args) != 7 && len(args) != 8 {
 		return nil, objects.ErrWrongNumArguments
 	}
 
@@ -540,12 +793,20 @@ func ExchangeWithdrawCrypto(args ...objects.Object) (objects.Object, error) {
 	if !ok {
 		return nil, fmt.Errorf(ErrParameterConvertFailed, description)
 	}
+	var chain string
+	if len(args) == 8 {
+		chain, ok = objects.ToString(args[7])
+		if !ok {
+			return nil, fmt.Errorf(ErrParameterConvertFailed, chain)
+		}
+	}
 
 	withdrawRequest := &withdraw.Request{
 		Exchange: exchangeName,
 		Crypto: withdraw.CryptoRequest{
 			Address:    address,
 			AddressTag: addressTag,
+			Chain:      chain,
 			FeeAmount:  feeAmount,
 		},
 		Currency:    currency.NewCode(cur),
@@ -64,6 +64,67 @@ func TestExchangeOrderbook(t *testing.T) {
 	}
 }
 
+func TestExchangeConsolidatedOrderbook(t *testing.T) {
+	t.Parallel()
+	_, err := ExchangeConsolidatedOrderbook(currencyPair, delimiter, assetType, tv)
+	if err != nil {
+		t.Error(err)
+	}
+
+	_, err = ExchangeConsolidatedOrderbook(currencyPair, delimiter, assetType, fv, exch)
+	if err != nil {
+		t.Error(err)
+	}
+
+	_, err = ExchangeConsolidatedOrderbook(currencyPair, delimiter, blank, fv)
+	if err == nil {
+		t.Error("expected error with invalid asset type")
+	}
+
+	_, err = ExchangeConsolidatedOrderbook()
+	if !errors.Is(err, objects.ErrWrongNumArguments) {
+		t.Error(err)
+	}
+}
+
+func TestExchangeOrderbookAnalytics(t *testing.T) {
+	t.Parallel()
+	_, err := ExchangeOrderbookAnalytics(exch, currencyPair, delimiter, assetType,
+		&objects.Int{Value: 1}, &objects.Float{Value: 100}, &objects.Float{Value: 0.5})
+	if err != nil {
+		t.Error(err)
+	}
+
+	_, err = ExchangeOrderbookAnalytics(exch, currencyPair, delimiter, blank,
+		&objects.Int{Value: 1}, &objects.Float{Value: 100})
+	if err == nil {
+		t.Error("expected error with invalid asset type")
+	}
+
+	_, err = ExchangeOrderbookAnalytics()
+	if !errors.Is(err, objects.ErrWrongNumArguments) {
+		t.Error(err)
+	}
+}
+
+func TestExchangeSpreadStatistics(t *testing.T) {
+	t.Parallel()
+	_, err := ExchangeSpreadStatistics(exch, currencyPair, delimiter, assetType, &objects.String{Value: "1s"})
+	if err != nil {
+		t.Error(err)
+	}
+
+	_, err = ExchangeSpreadStatistics(exch, currencyPair, delimiter, assetType, &objects.String{Value: "bad"})
+	if err == nil {
+		t.Error("expected error with invalid duration")
+	}
+
+	_, err = ExchangeSpreadStatistics()
+	if !errors.Is(err, objects.ErrWrongNumArguments) {
+		t.Error(err)
+	}
+}
+
 func TestExchangeTicker(t *testing.T) {
 	t.Parallel()
 	_, err := ExchangeTicker(exch, currencyPair, delimiter, assetType)
@@ -254,6 +315,19 @@ func TestExchangeDepositAddress(t *testing.T) {
 	if err != nil && !errors.Is(err, errTestFailed) {
 		t.Error(err)
 	}
+
+	chain := &objects.String{Value: "BEP20"}
+	rtn, err := ExchangeDepositAddress(exch, currCode, chain)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, ok := rtn.(*objects.Map)
+	if !ok {
+		t.Fatal("expected map")
+	}
+	if c, _ := objects.ToString(m.Value["chain"]); c != "BEP20" {
+		t.Errorf("received '%v' expected '%v'", c, "BEP20")
+	}
 }
 
 func TestExchangeWithdrawCrypto(t *testing.T) {
@@ -272,6 +346,12 @@ func TestExchangeWithdrawCrypto(t *testing.T) {
 	if err != nil {
 		t.Error(err)
 	}
+
+	chain := &objects.String{Value: "BEP20"}
+	_, err = ExchangeWithdrawCrypto(exch, currCode, address, address, amount, amount, desc, chain)
+	if err != nil {
+		t.Error(err)
+	}
 }
 
 func TestExchangeWithdrawFiat(t *testing.T) {
@@ -12,6 +12,7 @@ import (
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/deposit"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
@@ -48,6 +49,22 @@ func (e Exchange) Orderbook(ctx context.Context, exch string, pair currency.Pair
 	return engine.Bot.GetSpecificOrderbook(ctx, pair, exch, item)
 }
 
+// ConsolidatedOrderbook returns the merged orderbook for a pair and asset
+// across enabled exchanges
+func (e Exchange) ConsolidatedOrderbook(pair currency.Pair, item asset.Item, convertQuote bool, exchanges []string) (*orderbook.Consolidated, error) {
+	return engine.Bot.GetConsolidatedOrderbook(pair, item, convertQuote, exchanges)
+}
+
+// OrderbookAnalytics returns analytics for an exchange orderbook
+func (e Exchange) OrderbookAnalytics(exch string, pair currency.Pair, item asset.Item, levels int, bps float64, impactAmounts []float64) (*orderbook.Analytics, error) {
+	return engine.Bot.GetOrderbookAnalytics(exch, pair, item, levels, bps, impactAmounts)
+}
+
+// SpreadStatistics samples an exchange orderbook spread for the duration
+func (e Exchange) SpreadStatistics(ctx context.Context, exch string, pair currency.Pair, item asset.Item, duration time.Duration) (*orderbook.SpreadStatistics, error) {
+	return engine.Bot.GetOrderbookSpreadStatistics(ctx, exch, pair, item, duration)
+}
+
 // Ticker returns ticker for provided currency pair & asset type
 func (e Exchange) Ticker(ctx context.Context, exch string, pair currency.Pair, item asset.Item) (*ticker.Price, error) {
 	ex, err := e.GetExchange(exch)
@@ -135,11 +152,16 @@ func (e Exchange) AccountInformation(ctx context.Context, exch string, assetType
 }
 
 // DepositAddress gets the address required to deposit funds for currency type
-func (e Exchange) DepositAddress(exch string, currencyCode currency.Code) (out string, err error) {
+// on the supplied chain, an empty chain returns the default chain address
+func (e Exchange) DepositAddress(exch, chain string, currencyCode currency.Code) (*deposit.Address, error) {
 	if currencyCode.IsEmpty() {
-		return "", errors.New("currency code is empty")
+		return nil, errors.New("currency code is empty")
+	}
+	addr, err := engine.Bot.DepositAddressManager.GetDepositAddressByExchangeAndCurrency(exch, chain, currencyCode)
+	if err != nil {
+		return nil, err
 	}
-	return engine.Bot.DepositAddressManager.GetDepositAddressByExchangeAndCurrency(exch, currencyCode)
+	return &addr, nil
 }
 
 // WithdrawalFiatFunds withdraw funds from exchange to requested fiat source
@@ -8,6 +8,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/deposit"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
@@ -68,6 +69,66 @@ func (w Wrapper) Orderbook(ctx context.Context, exch string, pair currency.Pair,
 	}, nil
 }
 
+// ConsolidatedOrderbook validator for test execution/scripts
+func (w Wrapper) ConsolidatedOrderbook(pair currency.Pair, item asset.Item, convertQuote bool, exchanges []string) (*orderbook.Consolidated, error) {
+	for x := range exchanges {
+		if exchanges[x] == exchError.String() {
+			return nil, errTestFailed
+		}
+	}
+
+	venues := make([]orderbook.Venue, len(exchanges))
+	for x := range exchanges {
+		venues[x] = orderbook.Venue{Exchange: exchanges[x], Amount: 1}
+	}
+
+	return &orderbook.Consolidated{
+		Pair:      pair,
+		Asset:     item,
+		Exchanges: exchanges,
+		Bids: orderbook.ConsolidatedItems{
+			{
+				Price:  1,
+				Amount: float64(len(venues)),
+				Venues: venues,
+			},
+		},
+		Asks: orderbook.ConsolidatedItems{
+			{
+				Price:  1,
+				Amount: float64(len(venues)),
+				Venues: venues,
+			},
+		},
+	}, nil
+}
+
+// OrderbookAnalytics validator for test execution/scripts
+func (w Wrapper) OrderbookAnalytics(exch string, pair currency.Pair, item asset.Item, levels int, bps float64, impactAmounts []float64) (*orderbook.Analytics, error) {
+	if exch == exchError.String() {
+		return nil, errTestFailed
+	}
+
+	return (&orderbook.Base{
+		Exchange: exch,
+		Asset:    item,
+		Pair:     pair,
+		Bids:     []orderbook.Item{{Amount: 1, Price: 1}},
+		Asks:     []orderbook.Item{{Amount: 1, Price: 2}},
+	}).Analyse(levels, bps, impactAmounts)
+}
+
+// SpreadStatistics validator for test execution/scripts
+func (w Wrapper) SpreadStatistics(ctx context.Context, exch string, pair currency.Pair, item asset.Item, duration time.Duration) (*orderbook.SpreadStatistics, error) {
+	if exch == exchError.String() {
+		return nil, errTestFailed
+	}
+
+	stats := &orderbook.SpreadStatistics{}
+	stats.Add(1, 100, time.Now())
+	return stats, nil
+}
+
 // Ticker validator for test execution/scripts
 func (w Wrapper) Ticker(ctx context.Context, exch string, pair currency.Pair, item asset.Item) (*ticker.Price, error) {
 	if exch == exchError.String() {
@@ -216,12 +277,12 @@ func (w Wrapper) AccountInformation(ctx context.Context, exch string, assetType
 }
 
 // DepositAddress validator for test execution/scripts
-func (w Wrapper) DepositAddress(exch string, _ currency.Code) (string, error) {
+func (w Wrapper) DepositAddress(exch, chain string, _ currency.Code) (*deposit.Address, error) {
 	if exch == exchError.String() {
-		return exch, errTestFailed
+		return nil, errTestFailed
 	}
 
-	return exch, nil
+	return &deposit.Address{Address: exch, Chain: chain}, nil
 }
 
 // WithdrawalCryptoFunds validator for test execution/scripts
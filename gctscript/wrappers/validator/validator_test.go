@@ -112,12 +112,12 @@ func TestWrapper_CancelOrder(t *testing.T) {
 }
 
 func TestWrapper_DepositAddress(t *testing.T) {
-	_, err := testWrapper.DepositAddress(exchError.String(), currency.NewCode("BTC"))
+	_, err := testWrapper.DepositAddress(exchError.String(), "", currency.NewCode("BTC"))
 	if err == nil {
 		t.Fatal("expected DepositAddress to return error on invalid name")
 	}
 
-	_, err = testWrapper.DepositAddress(exchName, currency.NewCode("BTC"))
+	_, err = testWrapper.DepositAddress(exchName, "", currency.NewCode("BTC"))
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -142,6 +142,60 @@ func TestWrapper_Orderbook(t *testing.T) {
 	}
 }
 
+func TestWrapper_ConsolidatedOrderbook(t *testing.T) {
+	t.Parallel()
+	c, err := currency.NewPairDelimiter(pairs, delimiter)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ob, err := testWrapper.ConsolidatedOrderbook(c, assetType, false, []string{exchName})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ob.Bids) != 1 || len(ob.Bids[0].Venues) != 1 {
+		t.Fatalf("unexpected bids %+v", ob.Bids)
+	}
+
+	_, err = testWrapper.ConsolidatedOrderbook(c, assetType, false, []string{exchError.String()})
+	if err == nil {
+		t.Fatal("expected ConsolidatedOrderbook to return error with invalid name")
+	}
+}
+
+func TestWrapper_OrderbookAnalytics(t *testing.T) {
+	t.Parallel()
+	c, err := currency.NewPairDelimiter(pairs, delimiter)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = testWrapper.OrderbookAnalytics(exchName, c, assetType, 1, 100, []float64{1})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = testWrapper.OrderbookAnalytics(exchError.String(), c, assetType, 1, 100, nil)
+	if err == nil {
+		t.Fatal("expected OrderbookAnalytics to return error with invalid name")
+	}
+}
+
+func TestWrapper_SpreadStatistics(t *testing.T) {
+	t.Parallel()
+	c, err := currency.NewPairDelimiter(pairs, delimiter)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = testWrapper.SpreadStatistics(context.Background(), exchName, c, assetType, time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = testWrapper.SpreadStatistics(context.Background(), exchError.String(), c, assetType, time.Second)
+	if err == nil {
+		t.Fatal("expected SpreadStatistics to return error with invalid name")
+	}
+}
+
 func TestWrapper_Pairs(t *testing.T) {
 	t.Parallel()
 	_, err := testWrapper.Pairs(exchName, false, assetType)
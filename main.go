@@ -56,6 +56,14 @@ func main() {
 	flag.BoolVar(&settings.EnableNTPClient, "ntpclient", true, "enables the NTP client to check system clock drift")
 	flag.BoolVar(&settings.EnableDispatcher, "dispatch", true, "enables the dispatch system")
 	flag.BoolVar(&settings.EnableCurrencyStateManager, "currencystatemanager", true, "enables the currency state manager")
+	flag.BoolVar(&settings.EnableFeeManager, "feemanager", true, "enables the fee manager which loads and refreshes exchange fee schedules")
+	flag.BoolVar(&settings.EnableConsolidatedOrderbookManager, "consolidatedorderbookmanager", true, "enables the consolidated orderbook manager")
+	flag.BoolVar(&settings.EnableOrderbookHistoryManager, "orderbookhistorymanager", false, "enables the orderbook history manager which captures orderbook snapshots to file and/or database storage")
+	flag.BoolVar(&settings.EnableDeadMansSwitchManager, "deadmansswitch", false, "enables the dead man's switch manager which arms exchange auto-cancel timers while managed orders are open")
+	flag.BoolVar(&settings.EnableCompositeIndexManager, "compositeindexmanager", false, "enables the composite index manager which calculates reference prices from multiple exchanges")
+	flag.BoolVar(&settings.EnableLiveCandleManager, "livecandlemanager", false, "enables the live candle manager which builds candles from websocket trades")
+	flag.BoolVar(&settings.EnableRetentionManager, "retentionmanager", false, "enables the retention manager which downsamples, archives and prunes stored candles and trades")
+	flag.BoolVar(&settings.EnableSymbolHistoryManager, "symbolhistorymanager", false, "enables the symbol history manager which records pair listings and delistings")
 	flag.IntVar(&settings.DispatchMaxWorkerAmount, "dispatchworkers", dispatch.DefaultMaxWorkers, "sets the dispatch package max worker generation limit")
 	flag.IntVar(&settings.DispatchJobsLimit, "dispatchjobslimit", dispatch.DefaultJobsLimit, "sets the dispatch package max jobs limit")
 
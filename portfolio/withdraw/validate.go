@@ -2,9 +2,11 @@ package withdraw
 
 import (
 	"errors"
+	"fmt"
 	"strings"
 
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/deposit"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/validate"
 )
 
@@ -81,3 +83,31 @@ func (r *Request) validateCrypto() []string {
 	}
 	return resp
 }
+
+// NetworkCheck returns a validation check ensuring a crypto withdrawal can be
+// sent on the requested network and is within the network limits. An empty
+// chain is checked against the default network.
+func (r *Request) NetworkCheck(networks []deposit.Network) validate.Checker {
+	return validate.Check(func() error {
+		if r.Type != Crypto {
+			return nil
+		}
+		n, err := deposit.FindNetwork(networks, r.Crypto.Chain)
+		if err != nil {
+			return err
+		}
+		if !n.WithdrawEnabled {
+			return fmt.Errorf("%v %v %w", r.Currency, n.Chain, ErrNetworkWithdrawalsDisabled)
+		}
+		if r.Amount < n.WithdrawMinimum {
+			return fmt.Errorf("%v %v %w %v", r.Currency, n.Chain, errAmountBelowNetworkMinimum, n.WithdrawMinimum)
+		}
+		if n.WithdrawMaximum > 0 && r.Amount > n.WithdrawMaximum {
+			return fmt.Errorf("%v %v %w %v", r.Currency, n.Chain, errAmountAboveNetworkMaximum, n.WithdrawMaximum)
+		}
+		if n.RequiresTag && r.Crypto.AddressTag == "" {
+			return fmt.Errorf("%v %v %w", r.Currency, n.Chain, errAddressTagRequired)
+		}
+		return nil
+	})
+}
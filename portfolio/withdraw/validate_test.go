@@ -8,6 +8,7 @@ import (
 
 	"github.com/thrasher-corp/gocryptotrader/core"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/deposit"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/validate"
 	"github.com/thrasher-corp/gocryptotrader/portfolio"
 	"github.com/thrasher-corp/gocryptotrader/portfolio/banking"
@@ -327,3 +328,59 @@ func TestValidateCrypto(t *testing.T) {
 		})
 	}
 }
+
+func TestNetworkCheck(t *testing.T) {
+	t.Parallel()
+	networks := []deposit.Network{
+		{Chain: "ETH", WithdrawEnabled: true, WithdrawMinimum: 10, IsDefault: true},
+		{Chain: "TRX", WithdrawEnabled: true, WithdrawMinimum: 1, WithdrawMaximum: 100},
+		{Chain: "BSC", WithdrawEnabled: false},
+		{Chain: "XRP", WithdrawEnabled: true, RequiresTag: true},
+	}
+	r := &Request{Type: Crypto, Currency: currency.USDT, Amount: 5}
+	err := r.NetworkCheck(networks).Check()
+	if !errors.Is(err, errAmountBelowNetworkMinimum) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errAmountBelowNetworkMinimum)
+	}
+
+	r.Crypto.Chain = "trx"
+	err = r.NetworkCheck(networks).Check()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+
+	r.Amount = 101
+	err = r.NetworkCheck(networks).Check()
+	if !errors.Is(err, errAmountAboveNetworkMaximum) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errAmountAboveNetworkMaximum)
+	}
+
+	r.Crypto.Chain = "BSC"
+	err = r.NetworkCheck(networks).Check()
+	if !errors.Is(err, ErrNetworkWithdrawalsDisabled) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, ErrNetworkWithdrawalsDisabled)
+	}
+
+	r.Crypto.Chain = "XRP"
+	err = r.NetworkCheck(networks).Check()
+	if !errors.Is(err, errAddressTagRequired) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, errAddressTagRequired)
+	}
+
+	r.Crypto.Chain = "SOL"
+	err = r.NetworkCheck(networks).Check()
+	if !errors.Is(err, deposit.ErrNetworkNotFound) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, deposit.ErrNetworkNotFound)
+	}
+
+	err = r.Validate(r.NetworkCheck(networks))
+	if err == nil {
+		t.Fatal("expected network check error from Validate")
+	}
+
+	r.Type = Fiat
+	err = r.NetworkCheck(networks).Check()
+	if !errors.Is(err, nil) {
+		t.Fatalf("received: '%v' but expected: '%v'", err, nil)
+	}
+}
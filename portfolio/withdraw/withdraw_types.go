@@ -54,13 +54,23 @@ var (
 	Cache = cache.New(CacheSize)
 	// DryRunID uuid to use for dryruns
 	DryRunID, _ = uuid.FromString("3e7e2c25-5a0b-429b-95a1-0960079dce56")
+	// ErrNetworkWithdrawalsDisabled occurs when withdrawals are suspended on
+	// the requested network
+	ErrNetworkWithdrawalsDisabled = errors.New("withdrawals are disabled for network")
+
+	errAmountBelowNetworkMinimum = errors.New("amount is below the network withdrawal minimum")
+	errAmountAboveNetworkMaximum = errors.New("amount is above the network withdrawal maximum")
+	errAddressTagRequired        = errors.New("address tag is required for network")
 )
 
 // CryptoRequest stores the info required for a crypto withdrawal request
 type CryptoRequest struct {
 	Address    string
 	AddressTag string
-	FeeAmount  float64
+	// Chain is the network to withdraw on e.g. TRX, an empty chain uses the
+	// exchange default network
+	Chain     string
+	FeeAmount float64
 }
 
 // FiatRequest used for fiat withdrawal requests